@@ -7,6 +7,7 @@
 package v1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -356,18 +357,22 @@ var File_teslatrack_v1_authorize_proto protoreflect.FileDescriptor
 
 const file_teslatrack_v1_authorize_proto_rawDesc = "" +
 	"\n" +
-	"\x1dteslatrack/v1/authorize.proto\x12\x11api.teslatrack.v1\x1a\x1cgoogle/api/annotations.proto\"\x98\x01\n" +
-	"\x16CreateAuthorizeRequest\x12\x1a\n" +
-	"\bclientId\x18\x01 \x01(\tR\bclientId\x12\"\n" +
-	"\fclientSecret\x18\x02 \x01(\tR\fclientSecret\x12\x1c\n" +
-	"\tgrantType\x18\x03 \x01(\tR\tgrantType\x12 \n" +
-	"\vredirectURI\x18\x04 \x01(\tR\vredirectURI\"\x16\n" +
-	"\x14CreateAuthorizeReply\"%\n" +
-	"\x0fCallbackRequest\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\tR\x04code\"\x0f\n" +
-	"\rCallbackReply\"-\n" +
-	"\x0fRedirectRequest\x12\x1a\n" +
-	"\bclientId\x18\x01 \x01(\tR\bclientId\"\xdd\x01\n" +
+	"\x1dteslatrack/v1/authorize.proto\x12\x11api.teslatrack.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\xfb\x01\n" +
+	"\x16CreateAuthorizeRequest\x12&\n" +
+	"\bclientId\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\bclientId\x12.\n" +
+	"\fclientSecret\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x02R\fclientSecret\x12]\n" +
+	"\tgrantType\x18\x03 \x01(\tB?\xfaB<r:R\x12authorization_codeR\x12client_credentialsR\rrefresh_token\xd0\x01\x01R\tgrantType\x12*\n" +
+	"\vredirectURI\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x88\x01\x01R\vredirectURI\"\x16\n" +
+	"\x14CreateAuthorizeReply\"1\n" +
+	"\x0fCallbackRequest\x12\x1e\n" +
+	"\x04code\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x04R\x04code\"\x0f\n" +
+	"\rCallbackReply\"9\n" +
+	"\x0fRedirectRequest\x12&\n" +
+	"\bclientId\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\x80\x01R\bclientId\"\xdd\x01\n" +
 	"\rRedirectReply\x12\x14\n" +
 	"\x05scope\x18\x01 \x01(\tR\x05scope\x12\x14\n" +
 	"\x05state\x18\x02 \x01(\tR\x05state\x12\x14\n" +
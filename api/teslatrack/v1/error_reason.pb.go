@@ -0,0 +1,343 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        v5.29.3
+// source: teslatrack/v1/error_reason.proto
+
+package v1
+
+import (
+	_ "github.com/go-kratos/kratos/v2/errors"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ErrorReason is the reason of every error returned by the teslatrack services.
+// It is sent as the reason of Kratos errors over HTTP and as the ErrorInfo reason over gRPC,
+// so that clients can react on it instead of parsing messages.
+type ErrorReason int32
+
+const (
+	ErrorReason_ERROR_REASON_UNSPECIFIED ErrorReason = 0
+	// The request failed validation, the metadata maps the invalid fields to their violation.
+	ErrorReason_VALIDATOR ErrorReason = 1
+	// No valid access token was sent.
+	ErrorReason_UNAUTHORIZED ErrorReason = 2
+	// The account or password is wrong.
+	ErrorReason_SIGNIN_FAILED ErrorReason = 10
+	// No secret to sign access tokens is configured.
+	ErrorReason_SIGNIN_UNAVAILABLE ErrorReason = 11
+	// The account is empty or the password too short.
+	ErrorReason_ACCOUNT_INVALID ErrorReason = 12
+	// The account is already registered.
+	ErrorReason_ACCOUNT_EXISTS ErrorReason = 13
+	// The invitation code matches no user.
+	ErrorReason_INVITATION_INVALID ErrorReason = 14
+	// The user does not exist.
+	ErrorReason_USER_NOT_FOUND ErrorReason = 15
+	// The time zone is not a known IANA time zone.
+	ErrorReason_TIME_ZONE_INVALID ErrorReason = 16
+	// No OAuth client is registered with the client ID.
+	ErrorReason_CLIENT_NOT_FOUND ErrorReason = 20
+	// An OAuth client is already registered with the client ID.
+	ErrorReason_CLIENT_EXISTS ErrorReason = 21
+	// No Tesla account of the user is authorized.
+	ErrorReason_TESLA_NOT_AUTHORIZED ErrorReason = 22
+	// The Tesla access token expired or was revoked, the Tesla account must be authorized again.
+	ErrorReason_TESLA_TOKEN_EXPIRED ErrorReason = 23
+	// Tesla rate limited the requests of the account, retry later.
+	ErrorReason_TESLA_RATE_LIMITED ErrorReason = 24
+	// The Tesla API failed or could not be reached.
+	ErrorReason_TESLA_UNAVAILABLE ErrorReason = 25
+	// The OAuth state of the callback is unknown, expired or already used.
+	ErrorReason_AUTHORIZE_STATE_INVALID ErrorReason = 26
+	// Tesla rejected the authorization code of the callback.
+	ErrorReason_AUTHORIZE_CODE_INVALID ErrorReason = 27
+	// The vehicle does not exist or belongs to another user.
+	ErrorReason_VEHICLE_NOT_FOUND ErrorReason = 30
+	// The vehicle is asleep or offline and was not woken up.
+	ErrorReason_VEHICLE_ASLEEP ErrorReason = 31
+	// The vehicle was refreshed less than a minute ago.
+	ErrorReason_VEHICLE_REFRESH_RATE_LIMITED ErrorReason = 32
+	// The vehicle could not be refreshed from Tesla.
+	ErrorReason_VEHICLE_REFRESH_FAILED ErrorReason = 33
+	// The vehicle command or its parameters are unknown.
+	ErrorReason_VEHICLE_COMMAND_INVALID ErrorReason = 34
+	// The vehicle rejected the command, e.g., because it is not charging.
+	ErrorReason_VEHICLE_COMMAND_FAILED ErrorReason = 35
+	// The drive does not exist or belongs to another user.
+	ErrorReason_DRIVE_NOT_FOUND ErrorReason = 40
+	// The charging session does not exist or belongs to another user.
+	ErrorReason_CHARGE_NOT_FOUND ErrorReason = 41
+	// The sort order of drives or charging sessions is unknown.
+	ErrorReason_HISTORY_SORT_INVALID ErrorReason = 42
+	// The cursor of drives or charging sessions is invalid.
+	ErrorReason_HISTORY_CURSOR_INVALID ErrorReason = 43
+	// There are too many tags or a tag is too long.
+	ErrorReason_TAG_INVALID ErrorReason = 44
+	// The records cannot be merged.
+	ErrorReason_MERGE_INVALID ErrorReason = 45
+	// The record cannot be split at the time.
+	ErrorReason_SPLIT_INVALID ErrorReason = 46
+	// The export parameters are invalid.
+	ErrorReason_INVALID_EXPORT_REQUEST ErrorReason = 47
+	// The geofence does not exist or belongs to another user.
+	ErrorReason_GEOFENCE_NOT_FOUND ErrorReason = 50
+	// The geofence shape is invalid.
+	ErrorReason_GEOFENCE_INVALID ErrorReason = 51
+	// The tariff does not exist or belongs to another user.
+	ErrorReason_TARIFF_NOT_FOUND ErrorReason = 52
+	// The tariff is invalid.
+	ErrorReason_TARIFF_INVALID ErrorReason = 53
+	// The month is not YYYY-MM.
+	ErrorReason_INVALID_MONTH ErrorReason = 54
+	// The tire pressure unit is unknown.
+	ErrorReason_INVALID_PRESSURE_UNIT ErrorReason = 55
+	// The timeline cursor is invalid.
+	ErrorReason_TIMELINE_CURSOR_INVALID ErrorReason = 60
+	// The timeline entry type is unknown.
+	ErrorReason_TIMELINE_TYPE_INVALID ErrorReason = 61
+	// The statistics granularity is unknown.
+	ErrorReason_GRANULARITY_INVALID ErrorReason = 62
+	// The live event type is unknown.
+	ErrorReason_LIVE_EVENT_TYPE_INVALID ErrorReason = 70
+	// The last event id is not a positive integer.
+	ErrorReason_INVALID_LAST_EVENT_ID ErrorReason = 71
+	// The client fell behind the live events and should resume from the last event id.
+	ErrorReason_LIVE_STREAM_OVERFLOW ErrorReason = 72
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "ERROR_REASON_UNSPECIFIED",
+		1:  "VALIDATOR",
+		2:  "UNAUTHORIZED",
+		10: "SIGNIN_FAILED",
+		11: "SIGNIN_UNAVAILABLE",
+		12: "ACCOUNT_INVALID",
+		13: "ACCOUNT_EXISTS",
+		14: "INVITATION_INVALID",
+		15: "USER_NOT_FOUND",
+		16: "TIME_ZONE_INVALID",
+		20: "CLIENT_NOT_FOUND",
+		21: "CLIENT_EXISTS",
+		22: "TESLA_NOT_AUTHORIZED",
+		23: "TESLA_TOKEN_EXPIRED",
+		24: "TESLA_RATE_LIMITED",
+		25: "TESLA_UNAVAILABLE",
+		26: "AUTHORIZE_STATE_INVALID",
+		27: "AUTHORIZE_CODE_INVALID",
+		30: "VEHICLE_NOT_FOUND",
+		31: "VEHICLE_ASLEEP",
+		32: "VEHICLE_REFRESH_RATE_LIMITED",
+		33: "VEHICLE_REFRESH_FAILED",
+		34: "VEHICLE_COMMAND_INVALID",
+		35: "VEHICLE_COMMAND_FAILED",
+		40: "DRIVE_NOT_FOUND",
+		41: "CHARGE_NOT_FOUND",
+		42: "HISTORY_SORT_INVALID",
+		43: "HISTORY_CURSOR_INVALID",
+		44: "TAG_INVALID",
+		45: "MERGE_INVALID",
+		46: "SPLIT_INVALID",
+		47: "INVALID_EXPORT_REQUEST",
+		50: "GEOFENCE_NOT_FOUND",
+		51: "GEOFENCE_INVALID",
+		52: "TARIFF_NOT_FOUND",
+		53: "TARIFF_INVALID",
+		54: "INVALID_MONTH",
+		55: "INVALID_PRESSURE_UNIT",
+		60: "TIMELINE_CURSOR_INVALID",
+		61: "TIMELINE_TYPE_INVALID",
+		62: "GRANULARITY_INVALID",
+		70: "LIVE_EVENT_TYPE_INVALID",
+		71: "INVALID_LAST_EVENT_ID",
+		72: "LIVE_STREAM_OVERFLOW",
+	}
+	ErrorReason_value = map[string]int32{
+		"ERROR_REASON_UNSPECIFIED":     0,
+		"VALIDATOR":                    1,
+		"UNAUTHORIZED":                 2,
+		"SIGNIN_FAILED":                10,
+		"SIGNIN_UNAVAILABLE":           11,
+		"ACCOUNT_INVALID":              12,
+		"ACCOUNT_EXISTS":               13,
+		"INVITATION_INVALID":           14,
+		"USER_NOT_FOUND":               15,
+		"TIME_ZONE_INVALID":            16,
+		"CLIENT_NOT_FOUND":             20,
+		"CLIENT_EXISTS":                21,
+		"TESLA_NOT_AUTHORIZED":         22,
+		"TESLA_TOKEN_EXPIRED":          23,
+		"TESLA_RATE_LIMITED":           24,
+		"TESLA_UNAVAILABLE":            25,
+		"AUTHORIZE_STATE_INVALID":      26,
+		"AUTHORIZE_CODE_INVALID":       27,
+		"VEHICLE_NOT_FOUND":            30,
+		"VEHICLE_ASLEEP":               31,
+		"VEHICLE_REFRESH_RATE_LIMITED": 32,
+		"VEHICLE_REFRESH_FAILED":       33,
+		"VEHICLE_COMMAND_INVALID":      34,
+		"VEHICLE_COMMAND_FAILED":       35,
+		"DRIVE_NOT_FOUND":              40,
+		"CHARGE_NOT_FOUND":             41,
+		"HISTORY_SORT_INVALID":         42,
+		"HISTORY_CURSOR_INVALID":       43,
+		"TAG_INVALID":                  44,
+		"MERGE_INVALID":                45,
+		"SPLIT_INVALID":                46,
+		"INVALID_EXPORT_REQUEST":       47,
+		"GEOFENCE_NOT_FOUND":           50,
+		"GEOFENCE_INVALID":             51,
+		"TARIFF_NOT_FOUND":             52,
+		"TARIFF_INVALID":               53,
+		"INVALID_MONTH":                54,
+		"INVALID_PRESSURE_UNIT":        55,
+		"TIMELINE_CURSOR_INVALID":      60,
+		"TIMELINE_TYPE_INVALID":        61,
+		"GRANULARITY_INVALID":          62,
+		"LIVE_EVENT_TYPE_INVALID":      70,
+		"INVALID_LAST_EVENT_ID":        71,
+		"LIVE_STREAM_OVERFLOW":         72,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_teslatrack_v1_error_reason_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_teslatrack_v1_error_reason_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_teslatrack_v1_error_reason_proto_rawDescGZIP(), []int{0}
+}
+
+var File_teslatrack_v1_error_reason_proto protoreflect.FileDescriptor
+
+const file_teslatrack_v1_error_reason_proto_rawDesc = "" +
+	"\n" +
+	" teslatrack/v1/error_reason.proto\x12\x11api.teslatrack.v1\x1a\x13errors/errors.proto*\xae\n" +
+	"\n" +
+	"\vErrorReason\x12\x1c\n" +
+	"\x18ERROR_REASON_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\tVALIDATOR\x10\x01\x1a\x04\xa8E\x90\x03\x12\x16\n" +
+	"\fUNAUTHORIZED\x10\x02\x1a\x04\xa8E\x91\x03\x12\x17\n" +
+	"\rSIGNIN_FAILED\x10\n" +
+	"\x1a\x04\xa8E\x91\x03\x12\x1c\n" +
+	"\x12SIGNIN_UNAVAILABLE\x10\v\x1a\x04\xa8E\xf7\x03\x12\x19\n" +
+	"\x0fACCOUNT_INVALID\x10\f\x1a\x04\xa8E\x90\x03\x12\x18\n" +
+	"\x0eACCOUNT_EXISTS\x10\r\x1a\x04\xa8E\x99\x03\x12\x1c\n" +
+	"\x12INVITATION_INVALID\x10\x0e\x1a\x04\xa8E\x90\x03\x12\x18\n" +
+	"\x0eUSER_NOT_FOUND\x10\x0f\x1a\x04\xa8E\x94\x03\x12\x1b\n" +
+	"\x11TIME_ZONE_INVALID\x10\x10\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
+	"\x10CLIENT_NOT_FOUND\x10\x14\x1a\x04\xa8E\x94\x03\x12\x17\n" +
+	"\rCLIENT_EXISTS\x10\x15\x1a\x04\xa8E\x99\x03\x12\x1e\n" +
+	"\x14TESLA_NOT_AUTHORIZED\x10\x16\x1a\x04\xa8E\x93\x03\x12\x1d\n" +
+	"\x13TESLA_TOKEN_EXPIRED\x10\x17\x1a\x04\xa8E\x91\x03\x12\x1c\n" +
+	"\x12TESLA_RATE_LIMITED\x10\x18\x1a\x04\xa8E\xad\x03\x12\x1b\n" +
+	"\x11TESLA_UNAVAILABLE\x10\x19\x1a\x04\xa8E\xf7\x03\x12!\n" +
+	"\x17AUTHORIZE_STATE_INVALID\x10\x1a\x1a\x04\xa8E\x90\x03\x12 \n" +
+	"\x16AUTHORIZE_CODE_INVALID\x10\x1b\x1a\x04\xa8E\x90\x03\x12\x1b\n" +
+	"\x11VEHICLE_NOT_FOUND\x10\x1e\x1a\x04\xa8E\x94\x03\x12\x18\n" +
+	"\x0eVEHICLE_ASLEEP\x10\x1f\x1a\x04\xa8E\x99\x03\x12&\n" +
+	"\x1cVEHICLE_REFRESH_RATE_LIMITED\x10 \x1a\x04\xa8E\xad\x03\x12 \n" +
+	"\x16VEHICLE_REFRESH_FAILED\x10!\x1a\x04\xa8E\xf7\x03\x12!\n" +
+	"\x17VEHICLE_COMMAND_INVALID\x10\"\x1a\x04\xa8E\x90\x03\x12 \n" +
+	"\x16VEHICLE_COMMAND_FAILED\x10#\x1a\x04\xa8E\x99\x03\x12\x19\n" +
+	"\x0fDRIVE_NOT_FOUND\x10(\x1a\x04\xa8E\x94\x03\x12\x1a\n" +
+	"\x10CHARGE_NOT_FOUND\x10)\x1a\x04\xa8E\x94\x03\x12\x1e\n" +
+	"\x14HISTORY_SORT_INVALID\x10*\x1a\x04\xa8E\x90\x03\x12 \n" +
+	"\x16HISTORY_CURSOR_INVALID\x10+\x1a\x04\xa8E\x90\x03\x12\x15\n" +
+	"\vTAG_INVALID\x10,\x1a\x04\xa8E\x90\x03\x12\x17\n" +
+	"\rMERGE_INVALID\x10-\x1a\x04\xa8E\x90\x03\x12\x17\n" +
+	"\rSPLIT_INVALID\x10.\x1a\x04\xa8E\x90\x03\x12 \n" +
+	"\x16INVALID_EXPORT_REQUEST\x10/\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
+	"\x12GEOFENCE_NOT_FOUND\x102\x1a\x04\xa8E\x94\x03\x12\x1a\n" +
+	"\x10GEOFENCE_INVALID\x103\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
+	"\x10TARIFF_NOT_FOUND\x104\x1a\x04\xa8E\x94\x03\x12\x18\n" +
+	"\x0eTARIFF_INVALID\x105\x1a\x04\xa8E\x90\x03\x12\x17\n" +
+	"\rINVALID_MONTH\x106\x1a\x04\xa8E\x90\x03\x12\x1f\n" +
+	"\x15INVALID_PRESSURE_UNIT\x107\x1a\x04\xa8E\x90\x03\x12!\n" +
+	"\x17TIMELINE_CURSOR_INVALID\x10<\x1a\x04\xa8E\x90\x03\x12\x1f\n" +
+	"\x15TIMELINE_TYPE_INVALID\x10=\x1a\x04\xa8E\x90\x03\x12\x1d\n" +
+	"\x13GRANULARITY_INVALID\x10>\x1a\x04\xa8E\x90\x03\x12!\n" +
+	"\x17LIVE_EVENT_TYPE_INVALID\x10F\x1a\x04\xa8E\x90\x03\x12\x1f\n" +
+	"\x15INVALID_LAST_EVENT_ID\x10G\x1a\x04\xa8E\x90\x03\x12\x1e\n" +
+	"\x14LIVE_STREAM_OVERFLOW\x10H\x1a\x04\xa8E\xad\x03\x1a\x04\xa0E\xf4\x03B6\n" +
+	"\x11api.teslatrack.v1P\x01Z\x1fteslatrack/api/teslatrack/v1;v1b\x06proto3"
+
+var (
+	file_teslatrack_v1_error_reason_proto_rawDescOnce sync.Once
+	file_teslatrack_v1_error_reason_proto_rawDescData []byte
+)
+
+func file_teslatrack_v1_error_reason_proto_rawDescGZIP() []byte {
+	file_teslatrack_v1_error_reason_proto_rawDescOnce.Do(func() {
+		file_teslatrack_v1_error_reason_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_teslatrack_v1_error_reason_proto_rawDesc), len(file_teslatrack_v1_error_reason_proto_rawDesc)))
+	})
+	return file_teslatrack_v1_error_reason_proto_rawDescData
+}
+
+var file_teslatrack_v1_error_reason_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_teslatrack_v1_error_reason_proto_goTypes = []any{
+	(ErrorReason)(0), // 0: api.teslatrack.v1.ErrorReason
+}
+var file_teslatrack_v1_error_reason_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_teslatrack_v1_error_reason_proto_init() }
+func file_teslatrack_v1_error_reason_proto_init() {
+	if File_teslatrack_v1_error_reason_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_teslatrack_v1_error_reason_proto_rawDesc), len(file_teslatrack_v1_error_reason_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_teslatrack_v1_error_reason_proto_goTypes,
+		DependencyIndexes: file_teslatrack_v1_error_reason_proto_depIdxs,
+		EnumInfos:         file_teslatrack_v1_error_reason_proto_enumTypes,
+	}.Build()
+	File_teslatrack_v1_error_reason_proto = out.File
+	file_teslatrack_v1_error_reason_proto_goTypes = nil
+	file_teslatrack_v1_error_reason_proto_depIdxs = nil
+}
@@ -0,0 +1,640 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        v5.29.3
+// source: teslatrack/v1/geofence.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GeofenceInfo is a geofence in the requested datum.
+type GeofenceInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique ID of the geofence.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The display name, e.g., Home.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The category: home, work, charger or other. Home and work classify charging sessions.
+	Category string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	// The shape: circle or polygon.
+	Shape string `protobuf:"bytes,4,opt,name=shape,proto3" json:"shape,omitempty"`
+	// The circle center.
+	Center *Coordinate `protobuf:"bytes,5,opt,name=center,proto3" json:"center,omitempty"`
+	// The circle radius in metres.
+	Radius float64 `protobuf:"fixed64,6,opt,name=radius,proto3" json:"radius,omitempty"`
+	// The polygon vertices.
+	Polygon       []*Coordinate `protobuf:"bytes,7,rep,name=polygon,proto3" json:"polygon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeofenceInfo) Reset() {
+	*x = GeofenceInfo{}
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeofenceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeofenceInfo) ProtoMessage() {}
+
+func (x *GeofenceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeofenceInfo.ProtoReflect.Descriptor instead.
+func (*GeofenceInfo) Descriptor() ([]byte, []int) {
+	return file_teslatrack_v1_geofence_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GeofenceInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GeofenceInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GeofenceInfo) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GeofenceInfo) GetShape() string {
+	if x != nil {
+		return x.Shape
+	}
+	return ""
+}
+
+func (x *GeofenceInfo) GetCenter() *Coordinate {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *GeofenceInfo) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *GeofenceInfo) GetPolygon() []*Coordinate {
+	if x != nil {
+		return x.Polygon
+	}
+	return nil
+}
+
+// The request message for creating a geofence.
+type CreateGeofenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The geofence to create, its coordinates are in coord_type.
+	Geofence *GeofenceInfo `protobuf:"bytes,1,opt,name=geofence,proto3" json:"geofence,omitempty"`
+	// The datum of the coordinates in the request and the reply.
+	CoordType     CoordType `protobuf:"varint,2,opt,name=coord_type,json=coordType,proto3,enum=api.teslatrack.v1.CoordType" json:"coord_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGeofenceRequest) Reset() {
+	*x = CreateGeofenceRequest{}
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGeofenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGeofenceRequest) ProtoMessage() {}
+
+func (x *CreateGeofenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGeofenceRequest.ProtoReflect.Descriptor instead.
+func (*CreateGeofenceRequest) Descriptor() ([]byte, []int) {
+	return file_teslatrack_v1_geofence_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateGeofenceRequest) GetGeofence() *GeofenceInfo {
+	if x != nil {
+		return x.Geofence
+	}
+	return nil
+}
+
+func (x *CreateGeofenceRequest) GetCoordType() CoordType {
+	if x != nil {
+		return x.CoordType
+	}
+	return CoordType_COORD_TYPE_UNSPECIFIED
+}
+
+// The request message for updating a geofence.
+type UpdateGeofenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the geofence.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The new geofence, its coordinates are in coord_type.
+	Geofence *GeofenceInfo `protobuf:"bytes,2,opt,name=geofence,proto3" json:"geofence,omitempty"`
+	// The datum of the coordinates in the request and the reply.
+	CoordType     CoordType `protobuf:"varint,3,opt,name=coord_type,json=coordType,proto3,enum=api.teslatrack.v1.CoordType" json:"coord_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGeofenceRequest) Reset() {
+	*x = UpdateGeofenceRequest{}
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGeofenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGeofenceRequest) ProtoMessage() {}
+
+func (x *UpdateGeofenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGeofenceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGeofenceRequest) Descriptor() ([]byte, []int) {
+	return file_teslatrack_v1_geofence_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateGeofenceRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateGeofenceRequest) GetGeofence() *GeofenceInfo {
+	if x != nil {
+		return x.Geofence
+	}
+	return nil
+}
+
+func (x *UpdateGeofenceRequest) GetCoordType() CoordType {
+	if x != nil {
+		return x.CoordType
+	}
+	return CoordType_COORD_TYPE_UNSPECIFIED
+}
+
+// The request message for deleting a geofence.
+type DeleteGeofenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the geofence.
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGeofenceRequest) Reset() {
+	*x = DeleteGeofenceRequest{}
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGeofenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGeofenceRequest) ProtoMessage() {}
+
+func (x *DeleteGeofenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGeofenceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGeofenceRequest) Descriptor() ([]byte, []int) {
+	return file_teslatrack_v1_geofence_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteGeofenceRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// The reply message for deleting a geofence. Currently empty.
+type DeleteGeofenceReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGeofenceReply) Reset() {
+	*x = DeleteGeofenceReply{}
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGeofenceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGeofenceReply) ProtoMessage() {}
+
+func (x *DeleteGeofenceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGeofenceReply.ProtoReflect.Descriptor instead.
+func (*DeleteGeofenceReply) Descriptor() ([]byte, []int) {
+	return file_teslatrack_v1_geofence_proto_rawDescGZIP(), []int{4}
+}
+
+// The request message for getting a geofence.
+type GetGeofenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the geofence.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The datum of the coordinates in the reply.
+	CoordType     CoordType `protobuf:"varint,2,opt,name=coord_type,json=coordType,proto3,enum=api.teslatrack.v1.CoordType" json:"coord_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGeofenceRequest) Reset() {
+	*x = GetGeofenceRequest{}
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGeofenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGeofenceRequest) ProtoMessage() {}
+
+func (x *GetGeofenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGeofenceRequest.ProtoReflect.Descriptor instead.
+func (*GetGeofenceRequest) Descriptor() ([]byte, []int) {
+	return file_teslatrack_v1_geofence_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetGeofenceRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetGeofenceRequest) GetCoordType() CoordType {
+	if x != nil {
+		return x.CoordType
+	}
+	return CoordType_COORD_TYPE_UNSPECIFIED
+}
+
+// The reply message containing a single geofence.
+type GeofenceReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Geofence      *GeofenceInfo          `protobuf:"bytes,1,opt,name=geofence,proto3" json:"geofence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeofenceReply) Reset() {
+	*x = GeofenceReply{}
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeofenceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeofenceReply) ProtoMessage() {}
+
+func (x *GeofenceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeofenceReply.ProtoReflect.Descriptor instead.
+func (*GeofenceReply) Descriptor() ([]byte, []int) {
+	return file_teslatrack_v1_geofence_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GeofenceReply) GetGeofence() *GeofenceInfo {
+	if x != nil {
+		return x.Geofence
+	}
+	return nil
+}
+
+// The request message for listing geofences.
+type ListGeofencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The datum of the coordinates in the reply.
+	CoordType     CoordType `protobuf:"varint,1,opt,name=coord_type,json=coordType,proto3,enum=api.teslatrack.v1.CoordType" json:"coord_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGeofencesRequest) Reset() {
+	*x = ListGeofencesRequest{}
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGeofencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGeofencesRequest) ProtoMessage() {}
+
+func (x *ListGeofencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGeofencesRequest.ProtoReflect.Descriptor instead.
+func (*ListGeofencesRequest) Descriptor() ([]byte, []int) {
+	return file_teslatrack_v1_geofence_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListGeofencesRequest) GetCoordType() CoordType {
+	if x != nil {
+		return x.CoordType
+	}
+	return CoordType_COORD_TYPE_UNSPECIFIED
+}
+
+// The reply message containing the geofences of the user.
+type ListGeofencesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Geofences     []*GeofenceInfo        `protobuf:"bytes,1,rep,name=geofences,proto3" json:"geofences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGeofencesReply) Reset() {
+	*x = ListGeofencesReply{}
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGeofencesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGeofencesReply) ProtoMessage() {}
+
+func (x *ListGeofencesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_teslatrack_v1_geofence_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGeofencesReply.ProtoReflect.Descriptor instead.
+func (*ListGeofencesReply) Descriptor() ([]byte, []int) {
+	return file_teslatrack_v1_geofence_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListGeofencesReply) GetGeofences() []*GeofenceInfo {
+	if x != nil {
+		return x.Geofences
+	}
+	return nil
+}
+
+var File_teslatrack_v1_geofence_proto protoreflect.FileDescriptor
+
+const file_teslatrack_v1_geofence_proto_rawDesc = "" +
+	"\n" +
+	"\x1cteslatrack/v1/geofence.proto\x12\x11api.teslatrack.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17teslatrack/v1/geo.proto\x1a\x17validate/validate.proto\"\xd9\x02\n" +
+	"\fGeofenceInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x04name\x12@\n" +
+	"\bcategory\x18\x03 \x01(\tB$\xfaB!r\x1fR\x04homeR\x04workR\achargerR\x05other\xd0\x01\x01R\bcategory\x12,\n" +
+	"\x05shape\x18\x04 \x01(\tB\x16\xfaB\x13r\x11R\x06circleR\apolygonR\x05shape\x125\n" +
+	"\x06center\x18\x05 \x01(\v2\x1d.api.teslatrack.v1.CoordinateR\x06center\x12/\n" +
+	"\x06radius\x18\x06 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00j\xf8@)\x00\x00\x00\x00\x00\x00\x00\x00R\x06radius\x12B\n" +
+	"\apolygon\x18\a \x03(\v2\x1d.api.teslatrack.v1.CoordinateB\t\xfaB\x06\x92\x01\x03\x10\xe8\aR\apolygon\"\xa5\x01\n" +
+	"\x15CreateGeofenceRequest\x12E\n" +
+	"\bgeofence\x18\x01 \x01(\v2\x1f.api.teslatrack.v1.GeofenceInfoB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bgeofence\x12E\n" +
+	"\n" +
+	"coord_type\x18\x02 \x01(\x0e2\x1c.api.teslatrack.v1.CoordTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\tcoordType\"\xbe\x01\n" +
+	"\x15UpdateGeofenceRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12E\n" +
+	"\bgeofence\x18\x02 \x01(\v2\x1f.api.teslatrack.v1.GeofenceInfoB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bgeofence\x12E\n" +
+	"\n" +
+	"coord_type\x18\x03 \x01(\x0e2\x1c.api.teslatrack.v1.CoordTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\tcoordType\"0\n" +
+	"\x15DeleteGeofenceRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"\x15\n" +
+	"\x13DeleteGeofenceReply\"t\n" +
+	"\x12GetGeofenceRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12E\n" +
+	"\n" +
+	"coord_type\x18\x02 \x01(\x0e2\x1c.api.teslatrack.v1.CoordTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\tcoordType\"L\n" +
+	"\rGeofenceReply\x12;\n" +
+	"\bgeofence\x18\x01 \x01(\v2\x1f.api.teslatrack.v1.GeofenceInfoR\bgeofence\"]\n" +
+	"\x14ListGeofencesRequest\x12E\n" +
+	"\n" +
+	"coord_type\x18\x01 \x01(\x0e2\x1c.api.teslatrack.v1.CoordTypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\tcoordType\"S\n" +
+	"\x12ListGeofencesReply\x12=\n" +
+	"\tgeofences\x18\x01 \x03(\v2\x1f.api.teslatrack.v1.GeofenceInfoR\tgeofences2\x80\x05\n" +
+	"\bGeofence\x12z\n" +
+	"\x0eCreateGeofence\x12(.api.teslatrack.v1.CreateGeofenceRequest\x1a .api.teslatrack.v1.GeofenceReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/v1/geofences\x12\x7f\n" +
+	"\x0eUpdateGeofence\x12(.api.teslatrack.v1.UpdateGeofenceRequest\x1a .api.teslatrack.v1.GeofenceReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/api/v1/geofences/{id}\x12\x82\x01\n" +
+	"\x0eDeleteGeofence\x12(.api.teslatrack.v1.DeleteGeofenceRequest\x1a&.api.teslatrack.v1.DeleteGeofenceReply\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/geofences/{id}\x12v\n" +
+	"\vGetGeofence\x12%.api.teslatrack.v1.GetGeofenceRequest\x1a .api.teslatrack.v1.GeofenceReply\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/geofences/{id}\x12z\n" +
+	"\rListGeofences\x12'.api.teslatrack.v1.ListGeofencesRequest\x1a%.api.teslatrack.v1.ListGeofencesReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/geofencesB6\n" +
+	"\x11api.teslatrack.v1P\x01Z\x1fteslatrack/api/teslatrack/v1;v1b\x06proto3"
+
+var (
+	file_teslatrack_v1_geofence_proto_rawDescOnce sync.Once
+	file_teslatrack_v1_geofence_proto_rawDescData []byte
+)
+
+func file_teslatrack_v1_geofence_proto_rawDescGZIP() []byte {
+	file_teslatrack_v1_geofence_proto_rawDescOnce.Do(func() {
+		file_teslatrack_v1_geofence_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_teslatrack_v1_geofence_proto_rawDesc), len(file_teslatrack_v1_geofence_proto_rawDesc)))
+	})
+	return file_teslatrack_v1_geofence_proto_rawDescData
+}
+
+var file_teslatrack_v1_geofence_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_teslatrack_v1_geofence_proto_goTypes = []any{
+	(*GeofenceInfo)(nil),          // 0: api.teslatrack.v1.GeofenceInfo
+	(*CreateGeofenceRequest)(nil), // 1: api.teslatrack.v1.CreateGeofenceRequest
+	(*UpdateGeofenceRequest)(nil), // 2: api.teslatrack.v1.UpdateGeofenceRequest
+	(*DeleteGeofenceRequest)(nil), // 3: api.teslatrack.v1.DeleteGeofenceRequest
+	(*DeleteGeofenceReply)(nil),   // 4: api.teslatrack.v1.DeleteGeofenceReply
+	(*GetGeofenceRequest)(nil),    // 5: api.teslatrack.v1.GetGeofenceRequest
+	(*GeofenceReply)(nil),         // 6: api.teslatrack.v1.GeofenceReply
+	(*ListGeofencesRequest)(nil),  // 7: api.teslatrack.v1.ListGeofencesRequest
+	(*ListGeofencesReply)(nil),    // 8: api.teslatrack.v1.ListGeofencesReply
+	(*Coordinate)(nil),            // 9: api.teslatrack.v1.Coordinate
+	(CoordType)(0),                // 10: api.teslatrack.v1.CoordType
+}
+var file_teslatrack_v1_geofence_proto_depIdxs = []int32{
+	9,  // 0: api.teslatrack.v1.GeofenceInfo.center:type_name -> api.teslatrack.v1.Coordinate
+	9,  // 1: api.teslatrack.v1.GeofenceInfo.polygon:type_name -> api.teslatrack.v1.Coordinate
+	0,  // 2: api.teslatrack.v1.CreateGeofenceRequest.geofence:type_name -> api.teslatrack.v1.GeofenceInfo
+	10, // 3: api.teslatrack.v1.CreateGeofenceRequest.coord_type:type_name -> api.teslatrack.v1.CoordType
+	0,  // 4: api.teslatrack.v1.UpdateGeofenceRequest.geofence:type_name -> api.teslatrack.v1.GeofenceInfo
+	10, // 5: api.teslatrack.v1.UpdateGeofenceRequest.coord_type:type_name -> api.teslatrack.v1.CoordType
+	10, // 6: api.teslatrack.v1.GetGeofenceRequest.coord_type:type_name -> api.teslatrack.v1.CoordType
+	0,  // 7: api.teslatrack.v1.GeofenceReply.geofence:type_name -> api.teslatrack.v1.GeofenceInfo
+	10, // 8: api.teslatrack.v1.ListGeofencesRequest.coord_type:type_name -> api.teslatrack.v1.CoordType
+	0,  // 9: api.teslatrack.v1.ListGeofencesReply.geofences:type_name -> api.teslatrack.v1.GeofenceInfo
+	1,  // 10: api.teslatrack.v1.Geofence.CreateGeofence:input_type -> api.teslatrack.v1.CreateGeofenceRequest
+	2,  // 11: api.teslatrack.v1.Geofence.UpdateGeofence:input_type -> api.teslatrack.v1.UpdateGeofenceRequest
+	3,  // 12: api.teslatrack.v1.Geofence.DeleteGeofence:input_type -> api.teslatrack.v1.DeleteGeofenceRequest
+	5,  // 13: api.teslatrack.v1.Geofence.GetGeofence:input_type -> api.teslatrack.v1.GetGeofenceRequest
+	7,  // 14: api.teslatrack.v1.Geofence.ListGeofences:input_type -> api.teslatrack.v1.ListGeofencesRequest
+	6,  // 15: api.teslatrack.v1.Geofence.CreateGeofence:output_type -> api.teslatrack.v1.GeofenceReply
+	6,  // 16: api.teslatrack.v1.Geofence.UpdateGeofence:output_type -> api.teslatrack.v1.GeofenceReply
+	4,  // 17: api.teslatrack.v1.Geofence.DeleteGeofence:output_type -> api.teslatrack.v1.DeleteGeofenceReply
+	6,  // 18: api.teslatrack.v1.Geofence.GetGeofence:output_type -> api.teslatrack.v1.GeofenceReply
+	8,  // 19: api.teslatrack.v1.Geofence.ListGeofences:output_type -> api.teslatrack.v1.ListGeofencesReply
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_teslatrack_v1_geofence_proto_init() }
+func file_teslatrack_v1_geofence_proto_init() {
+	if File_teslatrack_v1_geofence_proto != nil {
+		return
+	}
+	file_teslatrack_v1_geo_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_teslatrack_v1_geofence_proto_rawDesc), len(file_teslatrack_v1_geofence_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_teslatrack_v1_geofence_proto_goTypes,
+		DependencyIndexes: file_teslatrack_v1_geofence_proto_depIdxs,
+		MessageInfos:      file_teslatrack_v1_geofence_proto_msgTypes,
+	}.Build()
+	File_teslatrack_v1_geofence_proto = out.File
+	file_teslatrack_v1_geofence_proto_goTypes = nil
+	file_teslatrack_v1_geofence_proto_depIdxs = nil
+}
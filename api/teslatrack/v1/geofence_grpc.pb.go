@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: teslatrack/v1/geofence.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Geofence_CreateGeofence_FullMethodName = "/api.teslatrack.v1.Geofence/CreateGeofence"
+	Geofence_UpdateGeofence_FullMethodName = "/api.teslatrack.v1.Geofence/UpdateGeofence"
+	Geofence_DeleteGeofence_FullMethodName = "/api.teslatrack.v1.Geofence/DeleteGeofence"
+	Geofence_GetGeofence_FullMethodName    = "/api.teslatrack.v1.Geofence/GetGeofence"
+	Geofence_ListGeofences_FullMethodName  = "/api.teslatrack.v1.Geofence/ListGeofences"
+)
+
+// GeofenceClient is the client API for Geofence service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// The Geofence service manages the named areas of the signed in user.
+// Drives, charging sessions and parking periods are tagged with the geofences they start and end in.
+type GeofenceClient interface {
+	// CreateGeofence creates a circle or polygon geofence.
+	CreateGeofence(ctx context.Context, in *CreateGeofenceRequest, opts ...grpc.CallOption) (*GeofenceReply, error)
+	// UpdateGeofence replaces the name, category and shape of a geofence.
+	UpdateGeofence(ctx context.Context, in *UpdateGeofenceRequest, opts ...grpc.CallOption) (*GeofenceReply, error)
+	// DeleteGeofence deletes a geofence.
+	DeleteGeofence(ctx context.Context, in *DeleteGeofenceRequest, opts ...grpc.CallOption) (*DeleteGeofenceReply, error)
+	// GetGeofence gets a geofence.
+	GetGeofence(ctx context.Context, in *GetGeofenceRequest, opts ...grpc.CallOption) (*GeofenceReply, error)
+	// ListGeofences lists the geofences of the user.
+	ListGeofences(ctx context.Context, in *ListGeofencesRequest, opts ...grpc.CallOption) (*ListGeofencesReply, error)
+}
+
+type geofenceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGeofenceClient(cc grpc.ClientConnInterface) GeofenceClient {
+	return &geofenceClient{cc}
+}
+
+func (c *geofenceClient) CreateGeofence(ctx context.Context, in *CreateGeofenceRequest, opts ...grpc.CallOption) (*GeofenceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeofenceReply)
+	err := c.cc.Invoke(ctx, Geofence_CreateGeofence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geofenceClient) UpdateGeofence(ctx context.Context, in *UpdateGeofenceRequest, opts ...grpc.CallOption) (*GeofenceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeofenceReply)
+	err := c.cc.Invoke(ctx, Geofence_UpdateGeofence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geofenceClient) DeleteGeofence(ctx context.Context, in *DeleteGeofenceRequest, opts ...grpc.CallOption) (*DeleteGeofenceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGeofenceReply)
+	err := c.cc.Invoke(ctx, Geofence_DeleteGeofence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geofenceClient) GetGeofence(ctx context.Context, in *GetGeofenceRequest, opts ...grpc.CallOption) (*GeofenceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeofenceReply)
+	err := c.cc.Invoke(ctx, Geofence_GetGeofence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geofenceClient) ListGeofences(ctx context.Context, in *ListGeofencesRequest, opts ...grpc.CallOption) (*ListGeofencesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGeofencesReply)
+	err := c.cc.Invoke(ctx, Geofence_ListGeofences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GeofenceServer is the server API for Geofence service.
+// All implementations must embed UnimplementedGeofenceServer
+// for forward compatibility.
+//
+// The Geofence service manages the named areas of the signed in user.
+// Drives, charging sessions and parking periods are tagged with the geofences they start and end in.
+type GeofenceServer interface {
+	// CreateGeofence creates a circle or polygon geofence.
+	CreateGeofence(context.Context, *CreateGeofenceRequest) (*GeofenceReply, error)
+	// UpdateGeofence replaces the name, category and shape of a geofence.
+	UpdateGeofence(context.Context, *UpdateGeofenceRequest) (*GeofenceReply, error)
+	// DeleteGeofence deletes a geofence.
+	DeleteGeofence(context.Context, *DeleteGeofenceRequest) (*DeleteGeofenceReply, error)
+	// GetGeofence gets a geofence.
+	GetGeofence(context.Context, *GetGeofenceRequest) (*GeofenceReply, error)
+	// ListGeofences lists the geofences of the user.
+	ListGeofences(context.Context, *ListGeofencesRequest) (*ListGeofencesReply, error)
+	mustEmbedUnimplementedGeofenceServer()
+}
+
+// UnimplementedGeofenceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGeofenceServer struct{}
+
+func (UnimplementedGeofenceServer) CreateGeofence(context.Context, *CreateGeofenceRequest) (*GeofenceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGeofence not implemented")
+}
+func (UnimplementedGeofenceServer) UpdateGeofence(context.Context, *UpdateGeofenceRequest) (*GeofenceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGeofence not implemented")
+}
+func (UnimplementedGeofenceServer) DeleteGeofence(context.Context, *DeleteGeofenceRequest) (*DeleteGeofenceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGeofence not implemented")
+}
+func (UnimplementedGeofenceServer) GetGeofence(context.Context, *GetGeofenceRequest) (*GeofenceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGeofence not implemented")
+}
+func (UnimplementedGeofenceServer) ListGeofences(context.Context, *ListGeofencesRequest) (*ListGeofencesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGeofences not implemented")
+}
+func (UnimplementedGeofenceServer) mustEmbedUnimplementedGeofenceServer() {}
+func (UnimplementedGeofenceServer) testEmbeddedByValue()                  {}
+
+// UnsafeGeofenceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GeofenceServer will
+// result in compilation errors.
+type UnsafeGeofenceServer interface {
+	mustEmbedUnimplementedGeofenceServer()
+}
+
+func RegisterGeofenceServer(s grpc.ServiceRegistrar, srv GeofenceServer) {
+	// If the following call pancis, it indicates UnimplementedGeofenceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Geofence_ServiceDesc, srv)
+}
+
+func _Geofence_CreateGeofence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGeofenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeofenceServer).CreateGeofence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Geofence_CreateGeofence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeofenceServer).CreateGeofence(ctx, req.(*CreateGeofenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Geofence_UpdateGeofence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGeofenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeofenceServer).UpdateGeofence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Geofence_UpdateGeofence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeofenceServer).UpdateGeofence(ctx, req.(*UpdateGeofenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Geofence_DeleteGeofence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGeofenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeofenceServer).DeleteGeofence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Geofence_DeleteGeofence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeofenceServer).DeleteGeofence(ctx, req.(*DeleteGeofenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Geofence_GetGeofence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGeofenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeofenceServer).GetGeofence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Geofence_GetGeofence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeofenceServer).GetGeofence(ctx, req.(*GetGeofenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Geofence_ListGeofences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGeofencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeofenceServer).ListGeofences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Geofence_ListGeofences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeofenceServer).ListGeofences(ctx, req.(*ListGeofencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Geofence_ServiceDesc is the grpc.ServiceDesc for Geofence service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Geofence_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.teslatrack.v1.Geofence",
+	HandlerType: (*GeofenceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateGeofence",
+			Handler:    _Geofence_CreateGeofence_Handler,
+		},
+		{
+			MethodName: "UpdateGeofence",
+			Handler:    _Geofence_UpdateGeofence_Handler,
+		},
+		{
+			MethodName: "DeleteGeofence",
+			Handler:    _Geofence_DeleteGeofence_Handler,
+		},
+		{
+			MethodName: "GetGeofence",
+			Handler:    _Geofence_GetGeofence_Handler,
+		},
+		{
+			MethodName: "ListGeofences",
+			Handler:    _Geofence_ListGeofences_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "teslatrack/v1/geofence.proto",
+}
@@ -7,6 +7,7 @@
 package v1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -145,10 +146,10 @@ var File_teslatrack_v1_signin_proto protoreflect.FileDescriptor
 
 const file_teslatrack_v1_signin_proto_rawDesc = "" +
 	"\n" +
-	"\x1ateslatrack/v1/signin.proto\x12\x11api.teslatrack.v1\x1a\x1cgoogle/api/annotations.proto\"I\n" +
-	"\x11IdentifierRequest\x12\x18\n" +
-	"\aaccount\x18\x01 \x01(\tR\aaccount\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"v\n" +
+	"\x1ateslatrack/v1/signin.proto\x12\x11api.teslatrack.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"_\n" +
+	"\x11IdentifierRequest\x12#\n" +
+	"\aaccount\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\aaccount\x12%\n" +
+	"\bpassword\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01(HR\bpassword\"v\n" +
 	"\x0fIdentifierReply\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
 	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1b\n" +
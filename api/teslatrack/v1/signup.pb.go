@@ -7,6 +7,7 @@
 package v1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -29,7 +30,7 @@ type CreateSignupRequest struct {
 	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	// The user's password.
 	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	// The invitation code.
+	// The invitation code, the account of the inviting user. Optional.
 	AskedCode     string `protobuf:"bytes,3,opt,name=asked_code,json=askedCode,proto3" json:"asked_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -219,15 +220,15 @@ var File_teslatrack_v1_signup_proto protoreflect.FileDescriptor
 
 const file_teslatrack_v1_signup_proto_rawDesc = "" +
 	"\n" +
-	"\x1ateslatrack/v1/signup.proto\x12\x11api.teslatrack.v1\x1a\x1cgoogle/api/annotations.proto\"j\n" +
-	"\x13CreateSignupRequest\x12\x18\n" +
-	"\aaccount\x18\x01 \x01(\tR\aaccount\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1d\n" +
+	"\x1ateslatrack/v1/signup.proto\x12\x11api.teslatrack.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\x93\x01\n" +
+	"\x13CreateSignupRequest\x12-\n" +
+	"\aaccount\x18\x01 \x01(\tB\x13\xfaB\x10r\x0e\x10\x03\x18@2\b^[^\\s]+$R\aaccount\x12%\n" +
+	"\bpassword\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\b(HR\bpassword\x12&\n" +
 	"\n" +
-	"asked_code\x18\x03 \x01(\tR\taskedCode\"\x13\n" +
-	"\x11CreateSignupReply\"/\n" +
-	"\x13VerifySignupRequest\x12\x18\n" +
-	"\aaccount\x18\x01 \x01(\tR\aaccount\"4\n" +
+	"asked_code\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x18@R\taskedCode\"\x13\n" +
+	"\x11CreateSignupReply\":\n" +
+	"\x13VerifySignupRequest\x12#\n" +
+	"\aaccount\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\aaccount\"4\n" +
 	"\x11VerifySignupReply\x12\x1f\n" +
 	"\vis_repeated\x18\x01 \x01(\bR\n" +
 	"isRepeated2\x85\x02\n" +
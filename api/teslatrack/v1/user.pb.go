@@ -0,0 +1,292 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        v5.29.3
+// source: teslatrack/v1/user.proto
+
+package v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UserInfo is the profile of a user.
+type UserInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the user.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The account name.
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// The IANA time zone, e.g., Asia/Shanghai.
+	TimeZone      string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserInfo) Reset() {
+	*x = UserInfo{}
+	mi := &file_teslatrack_v1_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfo) ProtoMessage() {}
+
+func (x *UserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_teslatrack_v1_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
+func (*UserInfo) Descriptor() ([]byte, []int) {
+	return file_teslatrack_v1_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserInfo) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *UserInfo) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// The request message for the signed in user.
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_teslatrack_v1_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_teslatrack_v1_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_teslatrack_v1_user_proto_rawDescGZIP(), []int{1}
+}
+
+// The request message for changing the time zone.
+type UpdateTimeZoneRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The IANA time zone, e.g., Asia/Shanghai.
+	TimeZone      string `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTimeZoneRequest) Reset() {
+	*x = UpdateTimeZoneRequest{}
+	mi := &file_teslatrack_v1_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTimeZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTimeZoneRequest) ProtoMessage() {}
+
+func (x *UpdateTimeZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_teslatrack_v1_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTimeZoneRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTimeZoneRequest) Descriptor() ([]byte, []int) {
+	return file_teslatrack_v1_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateTimeZoneRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// The reply message carrying a user.
+type UserReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user.
+	User          *UserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserReply) Reset() {
+	*x = UserReply{}
+	mi := &file_teslatrack_v1_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserReply) ProtoMessage() {}
+
+func (x *UserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_teslatrack_v1_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserReply.ProtoReflect.Descriptor instead.
+func (*UserReply) Descriptor() ([]byte, []int) {
+	return file_teslatrack_v1_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UserReply) GetUser() *UserInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+var File_teslatrack_v1_user_proto protoreflect.FileDescriptor
+
+const file_teslatrack_v1_user_proto_rawDesc = "" +
+	"\n" +
+	"\x18teslatrack/v1/user.proto\x12\x11api.teslatrack.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"Q\n" +
+	"\bUserInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\aaccount\x18\x02 \x01(\tR\aaccount\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\"\x10\n" +
+	"\x0eGetUserRequest\"?\n" +
+	"\x15UpdateTimeZoneRequest\x12&\n" +
+	"\ttime_zone\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\btimeZone\"<\n" +
+	"\tUserReply\x12/\n" +
+	"\x04user\x18\x01 \x01(\v2\x1b.api.teslatrack.v1.UserInfoR\x04user2\xe5\x01\n" +
+	"\x04User\x12`\n" +
+	"\aGetUser\x12!.api.teslatrack.v1.GetUserRequest\x1a\x1c.api.teslatrack.v1.UserReply\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/api/v1/user\x12{\n" +
+	"\x0eUpdateTimeZone\x12(.api.teslatrack.v1.UpdateTimeZoneRequest\x1a\x1c.api.teslatrack.v1.UserReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/api/v1/user/time_zoneB6\n" +
+	"\x11api.teslatrack.v1P\x01Z\x1fteslatrack/api/teslatrack/v1;v1b\x06proto3"
+
+var (
+	file_teslatrack_v1_user_proto_rawDescOnce sync.Once
+	file_teslatrack_v1_user_proto_rawDescData []byte
+)
+
+func file_teslatrack_v1_user_proto_rawDescGZIP() []byte {
+	file_teslatrack_v1_user_proto_rawDescOnce.Do(func() {
+		file_teslatrack_v1_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_teslatrack_v1_user_proto_rawDesc), len(file_teslatrack_v1_user_proto_rawDesc)))
+	})
+	return file_teslatrack_v1_user_proto_rawDescData
+}
+
+var file_teslatrack_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_teslatrack_v1_user_proto_goTypes = []any{
+	(*UserInfo)(nil),              // 0: api.teslatrack.v1.UserInfo
+	(*GetUserRequest)(nil),        // 1: api.teslatrack.v1.GetUserRequest
+	(*UpdateTimeZoneRequest)(nil), // 2: api.teslatrack.v1.UpdateTimeZoneRequest
+	(*UserReply)(nil),             // 3: api.teslatrack.v1.UserReply
+}
+var file_teslatrack_v1_user_proto_depIdxs = []int32{
+	0, // 0: api.teslatrack.v1.UserReply.user:type_name -> api.teslatrack.v1.UserInfo
+	1, // 1: api.teslatrack.v1.User.GetUser:input_type -> api.teslatrack.v1.GetUserRequest
+	2, // 2: api.teslatrack.v1.User.UpdateTimeZone:input_type -> api.teslatrack.v1.UpdateTimeZoneRequest
+	3, // 3: api.teslatrack.v1.User.GetUser:output_type -> api.teslatrack.v1.UserReply
+	3, // 4: api.teslatrack.v1.User.UpdateTimeZone:output_type -> api.teslatrack.v1.UserReply
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_teslatrack_v1_user_proto_init() }
+func file_teslatrack_v1_user_proto_init() {
+	if File_teslatrack_v1_user_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_teslatrack_v1_user_proto_rawDesc), len(file_teslatrack_v1_user_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_teslatrack_v1_user_proto_goTypes,
+		DependencyIndexes: file_teslatrack_v1_user_proto_depIdxs,
+		MessageInfos:      file_teslatrack_v1_user_proto_msgTypes,
+	}.Build()
+	File_teslatrack_v1_user_proto = out.File
+	file_teslatrack_v1_user_proto_goTypes = nil
+	file_teslatrack_v1_user_proto_depIdxs = nil
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"teslatrack/internal/conf"
+	"teslatrack/internal/server"
 	"teslatrack/pkg/zap"
 
 	"github.com/go-kratos/kratos/v2"
@@ -16,6 +17,7 @@ import (
 	"github.com/joho/godotenv"
 
 	_ "go.uber.org/automaxprocs"
+	_ "time/tzdata"
 )
 
 // go build -ldflags "-X main.Version=x.y.z"
@@ -35,7 +37,7 @@ func init() {
 	_ = godotenv.Load()
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, poller *server.Poller, rollup *server.RollupJob, recorder *server.EventRecorder, reader *server.EventReader, mqtt *server.MQTTBridge, metrics *server.VehicleMetrics, health *server.Health) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -43,24 +45,23 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Metadata(map[string]string{}),
 		kratos.Logger(logger),
 		kratos.Server(
-			// gs,
+			gs,
 			hs,
+			poller,
+			rollup,
+			recorder,
+			reader,
+			mqtt,
+			metrics,
+			health,
 		),
 	)
 }
 
 func main() {
 	flag.Parse()
-	// logger := log.With(log.NewStdLogger(os.Stdout),
-	// 	"ts", log.DefaultTimestamp,
-	// 	"caller", log.DefaultCaller,
-	// 	"service.id", id,
-	// 	"service.name", Name,
-	// 	"service.version", Version,
-	// 	"trace.id", tracing.TraceID(),
-	// 	"span.id", tracing.SpanID(),
-	// )
-	logger := zap.MustZapLogger()
+	// Log lines within a request or job span carry its trace.id and span.id.
+	logger := zap.MustZapLogger().WithTracing()
 	c := config.New(
 		config.WithSource(
 			file.NewSource(flagconf),
@@ -77,6 +78,12 @@ func main() {
 		panic(err)
 	}
 
+	if flag.Arg(0) == "migrate" {
+		code := runMigrate(bc.Data, logger, flag.Args()[1:])
+		_ = c.Close()
+		os.Exit(code)
+	}
+
 	app, cleanup, err := wireApp(bc.Server, bc.Data, logger)
 	if err != nil {
 		panic(err)
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"teslatrack/internal/conf"
+	"teslatrack/internal/data"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const migrateUsage = `usage: teslatrack [-conf path] migrate <command>
+
+commands:
+  status           print the schema version and the pending migrations
+  up               apply the pending migrations
+  down [N]         revert the last N applied migrations, 1 by default
+  force VERSION    record VERSION as the clean schema version without running migrations,
+                   to baseline an existing database or after repairing a failed migration;
+                   0 records none`
+
+// runMigrate runs the migrate command on the configured database and returns the exit code.
+func runMigrate(c *conf.Data, logger log.Logger, args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+	m, cleanup, err := data.NewMigrator(c.GetDatabase(), logger)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer cleanup()
+
+	ctx := context.Background()
+	switch {
+	case args[0] == "status" && len(args) == 1:
+		err = printMigrationStatus(ctx, m)
+	case args[0] == "up" && len(args) == 1:
+		var applied []data.Migration
+		applied, err = m.Up(ctx)
+		printMigrations("applied", applied)
+	case args[0] == "down" && len(args) <= 2:
+		steps := 1
+		if len(args) == 2 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				fmt.Fprintf(os.Stderr, "invalid number of migrations %q\n", args[1])
+				return 2
+			}
+		}
+		var reverted []data.Migration
+		reverted, err = m.Down(ctx, steps)
+		printMigrations("reverted", reverted)
+	case args[0] == "force" && len(args) == 2:
+		version, perr := strconv.ParseInt(args[1], 10, 64)
+		if perr != nil {
+			fmt.Fprintf(os.Stderr, "invalid version %q\n", args[1])
+			return 2
+		}
+		if err = m.Force(ctx, version); err == nil {
+			fmt.Printf("schema version set to %d\n", version)
+		}
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+
+// printMigrationStatus prints the schema version and the migrations of the binary.
+func printMigrationStatus(ctx context.Context, m *data.Migrator) error {
+	s, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	state := "up to date"
+	switch {
+	case s.Dirty:
+		state = "dirty, repair the schema then run migrate force"
+	case s.Version > s.Latest:
+		state = "ahead of the binary"
+	case len(s.Pending) > 0:
+		state = fmt.Sprintf("%d pending", len(s.Pending))
+	}
+	fmt.Printf("version %d, latest %d: %s\n", s.Version, s.Latest, state)
+	for _, mig := range s.Applied {
+		fmt.Printf("  applied  %d_%s\n", mig.Version, mig.Name)
+	}
+	for _, mig := range s.Pending {
+		fmt.Printf("  pending  %d_%s\n", mig.Version, mig.Name)
+	}
+	return nil
+}
+
+func printMigrations(verb string, ms []data.Migration) {
+	if len(ms) == 0 {
+		fmt.Printf("no migration %s\n", verb)
+	}
+	for _, mig := range ms {
+		fmt.Printf("%s %d_%s\n", verb, mig.Version, mig.Name)
+	}
+}
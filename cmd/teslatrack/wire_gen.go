@@ -18,31 +18,113 @@ import (
 
 import (
 	_ "go.uber.org/automaxprocs"
+	_ "time/tzdata"
 )
 
 // Injectors from wire.go:
 
 // wireApp init kratos application.
 func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
-	dataData, cleanup, err := data.NewData(confData, logger)
+	meterProvider, cleanup, err := server.NewMeterProvider()
 	if err != nil {
 		return nil, nil, err
 	}
-	greeterRepo := data.NewGreeterRepo(dataData, logger)
-	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
-	greeterService := service.NewGreeterService(greeterUsecase)
-	grpcServer := server.NewGRPCServer(confServer, greeterService, logger)
+	tracerProvider, cleanup2, err := server.NewTracerProvider(confServer)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	dataData, cleanup3, err := data.NewData(confData, meterProvider, tracerProvider, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	authorizeRepo := data.NewAuthorizeRepo(dataData)
+	authorizeStateRepo := data.NewAuthorizeStateRepo(dataData)
+	authorizeUsecase := biz.NewAuthorizeUsecase(authorizeRepo, authorizeStateRepo, confServer, logger)
+	authorizeService := service.NewAuthorizeService(authorizeUsecase, logger)
+	userRepo := data.NewUserRepo(dataData)
+	accountUsecase := biz.NewAccountUsecase(userRepo, confServer, logger)
+	signinService := service.NewSigninService(accountUsecase, logger)
+	signupService := service.NewSignupService(accountUsecase, logger)
+	geofenceRepo := data.NewGeofenceRepo(dataData)
+	eventStream := data.NewEventStream(dataData)
+	eventBus := biz.NewEventBus(eventStream, logger)
+	geofenceUsecase := biz.NewGeofenceUsecase(geofenceRepo, eventBus, logger)
+	geofenceService := service.NewGeofenceService(geofenceUsecase, logger)
+	tariffRepo := data.NewTariffRepo(dataData)
+	vehicleRepo := data.NewVehicleRepo(dataData)
+	vehicleStatePeriodRepo := data.NewVehicleStatePeriodRepo(dataData)
+	vehicleSnapshotRepo := data.NewVehicleSnapshotRepo(dataData)
+	tariffUsecase := biz.NewTariffUsecase(tariffRepo, geofenceRepo, vehicleRepo, vehicleStatePeriodRepo, vehicleSnapshotRepo, logger)
+	tariffService := service.NewTariffService(tariffUsecase, logger)
+	analyticsUsecase := biz.NewAnalyticsUsecase(vehicleRepo, vehicleStatePeriodRepo, vehicleSnapshotRepo, logger)
+	analyticsService := service.NewAnalyticsService(analyticsUsecase, logger)
+	rollupRepo := data.NewRollupRepo(dataData)
+	rollupUsecase := biz.NewRollupUsecase(rollupRepo, vehicleStatePeriodRepo, vehicleRepo, userRepo, logger)
+	statisticsService := service.NewStatisticsService(rollupUsecase, logger)
+	userUsecase := biz.NewUserUsecase(userRepo, logger)
+	userService := service.NewUserService(userUsecase, logger)
+	softwareUpdateRepo := data.NewSoftwareUpdateRepo(dataData)
+	softwareUsecase := biz.NewSoftwareUsecase(softwareUpdateRepo, vehicleRepo, logger)
+	softwareService := service.NewSoftwareService(softwareUsecase, logger)
+	tirePressureRepo := data.NewTirePressureRepo(dataData)
+	tireUsecase := biz.NewTireUsecase(tirePressureRepo, vehicleRepo, eventBus, confServer, logger)
+	tireService := service.NewTireService(tireUsecase, logger)
+	vehicleEventRepo := data.NewVehicleEventRepo(dataData)
+	timelineUsecase := biz.NewTimelineUsecase(vehicleEventRepo, vehicleRepo, vehicleStatePeriodRepo, softwareUpdateRepo, logger)
+	timelineService := service.NewTimelineService(timelineUsecase, logger)
 	authorizeTokenRepo := data.NewAuthorizeTokenRepo(dataData)
+	geocoder, err := data.NewGeocoder(confData, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	addressRepo := data.NewAddressRepo(dataData)
+	geocodeUsecase := biz.NewGeocodeUsecase(geocoder, addressRepo, logger)
+	vehicleStateUsecase := biz.NewVehicleStateUsecase(vehicleStatePeriodRepo, geocodeUsecase, geofenceUsecase, tariffUsecase, eventBus, logger)
+	locker := data.NewLocker(dataData)
+	collectorUsecase := biz.NewCollectorUsecase(authorizeTokenRepo, vehicleRepo, vehicleSnapshotRepo, vehicleStateUsecase, softwareUsecase, tireUsecase, eventBus, locker, confServer, logger)
+	rateLimiter := data.NewRateLimiter(dataData)
+	vehicleUsecase := biz.NewVehicleUsecase(vehicleRepo, vehicleSnapshotRepo, collectorUsecase, rateLimiter, logger)
+	vehicleService := service.NewVehicleService(vehicleUsecase, logger)
+	transaction := data.NewTransaction(dataData)
+	historyUsecase := biz.NewHistoryUsecase(vehicleRepo, vehicleStatePeriodRepo, vehicleSnapshotRepo, transaction, geofenceUsecase, geocodeUsecase, tariffUsecase, logger)
+	driveService := service.NewDriveService(historyUsecase, logger)
+	chargingService := service.NewChargingService(historyUsecase, logger)
+	liveUsecase := biz.NewLiveUsecase(eventBus, vehicleRepo, logger)
+	liveService := service.NewLiveService(liveUsecase, logger)
+	grpcServer := server.NewGRPCServer(confServer, logger, meterProvider, tracerProvider, authorizeService, signinService, signupService, geofenceService, tariffService, analyticsService, statisticsService, userService, softwareService, tireService, timelineService, vehicleService, driveService, chargingService, liveService)
 	authorizeTokenUsecase := biz.NewAuthorizeTokenUsecase(authorizeTokenRepo, confServer, logger)
-	redirector := server.NewRedirector(confServer, authorizeTokenUsecase)
+	redirector := server.NewRedirector(confServer, authorizeUsecase, authorizeTokenUsecase)
+	healthRepo := data.NewHealthRepo(dataData)
 	partnerRepo := data.NewPartnerRepo(dataData)
+	healthUsecase := biz.NewHealthUsecase(healthRepo, partnerRepo, authorizeTokenRepo, collectorUsecase, confServer, logger)
+	poller, err := server.NewPoller(confServer, collectorUsecase, locker, meterProvider, tracerProvider, logger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	health := server.NewHealth(confServer, healthUsecase, poller, logger)
 	partnerUsecase := biz.NewPartnerUsecase(partnerRepo, confServer, logger)
-	authorizeRepo := data.NewAuthorizeRepo(dataData)
-	authorizeUsecase := biz.NewAuthorizeUsecase(authorizeRepo, confServer, logger)
-	authorizeService := service.NewAuthorizeService(authorizeUsecase, logger)
-	httpServer := server.NewHTTPServer(confServer, logger, redirector, partnerUsecase, authorizeService)
-	app := newApp(logger, grpcServer, httpServer)
+	routeUsecase := biz.NewRouteUsecase(vehicleRepo, vehicleStatePeriodRepo, vehicleSnapshotRepo, logger)
+	routeService := service.NewRouteService(routeUsecase, logger)
+	httpServer := server.NewHTTPServer(confServer, logger, meterProvider, tracerProvider, redirector, health, partnerUsecase, authorizeService, signinService, signupService, geofenceService, routeService, tariffService, analyticsService, statisticsService, userService, softwareService, tireService, timelineService, vehicleService, driveService, chargingService, liveService)
+	rollupJob := server.NewRollupJob(confServer, rollupUsecase, locker, logger)
+	eventRecorder := server.NewEventRecorder(eventBus, timelineUsecase, logger)
+	eventReader := server.NewEventReader(eventBus, logger)
+	vehicleCommandUsecase := biz.NewVehicleCommandUsecase(authorizeTokenRepo, vehicleRepo, collectorUsecase, logger)
+	mqttBridge := server.NewMQTTBridge(confServer, eventBus, vehicleUsecase, tireUsecase, vehicleCommandUsecase, logger)
+	vehicleMetrics := server.NewVehicleMetrics(confServer, eventBus, meterProvider, logger)
+	app := newApp(logger, grpcServer, httpServer, poller, rollupJob, eventRecorder, eventReader, mqttBridge, vehicleMetrics, health)
 	return app, func() {
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
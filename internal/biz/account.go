@@ -0,0 +1,130 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/internal/conf"
+	"teslatrack/pkg/jwt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// minPasswordLength is the minimum length of a password.
+	minPasswordLength = 8
+	// maxPasswordBytes is the maximum length of a password in bytes, as bcrypt hashes no more.
+	maxPasswordBytes = 72
+	// defaultTokenExpire is the lifetime of access tokens unless configured.
+	defaultTokenExpire = 7 * 24 * time.Hour
+)
+
+var (
+	// ErrSigninFailed is returned for an unknown account or a wrong password.
+	ErrSigninFailed = v1.ErrorSigninFailed("wrong account or password")
+	// ErrSigninUnavailable is returned when no secret to sign access tokens is configured.
+	ErrSigninUnavailable = v1.ErrorSigninUnavailable("signing in is not configured")
+	// ErrAccountInvalid is returned for an empty account or a password that is too short or too long.
+	ErrAccountInvalid = v1.ErrorAccountInvalid("account is required and the password needs 8 characters to 72 bytes")
+	// ErrAccountExists is returned when signing up with an account already registered.
+	ErrAccountExists = v1.ErrorAccountExists("the account is already registered")
+	// ErrInvitationInvalid is returned for an invitation code matching no user.
+	ErrInvitationInvalid = v1.ErrorInvitationInvalid("unknown invitation code")
+)
+
+// AccessToken is an access token issued to a user.
+type AccessToken struct {
+	// Token is the signed token.
+	Token string
+	// ExpireAt is the time the token expires.
+	ExpireAt time.Time
+}
+
+// AccountUsecase signs users up and in.
+type AccountUsecase struct {
+	userRepo UserRepo
+	secret   string
+	expire   time.Duration
+	log      *log.Helper
+}
+
+// NewAccountUsecase creates an Account usecase.
+func NewAccountUsecase(userRepo UserRepo, c *conf.Server, logger log.Logger) *AccountUsecase {
+	uc := &AccountUsecase{
+		userRepo: userRepo,
+		secret:   c.GetAuth().GetJwtSecret(),
+		expire:   defaultTokenExpire,
+		log:      log.NewHelper(logger),
+	}
+	if expire := c.GetAuth().GetExpire(); expire != nil && expire.AsDuration() > 0 {
+		uc.expire = expire.AsDuration()
+	}
+	return uc
+}
+
+// Signin verifies the password of an account and issues an access token.
+func (uc *AccountUsecase) Signin(ctx context.Context, account, password string) (*AccessToken, error) {
+	if uc.secret == "" {
+		return nil, ErrSigninUnavailable
+	}
+	u, err := uc.userRepo.FindByAccount(ctx, strings.TrimSpace(account))
+	if err != nil {
+		return nil, err
+	}
+	if u == nil || bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password)) != nil {
+		return nil, ErrSigninFailed
+	}
+	token, expireAt, err := jwt.Sign(uc.secret, &jwt.LoginUser{ID: int64(u.ID), LoginTime: time.Now()}, uc.expire)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infow("msg", "user signed in", "userID", u.ID)
+	return &AccessToken{Token: token, ExpireAt: expireAt}, nil
+}
+
+// Signup registers an account. A non-empty invitation code is the account of the inviting user.
+func (uc *AccountUsecase) Signup(ctx context.Context, account, password, invitation string) (*User, error) {
+	account = strings.TrimSpace(account)
+	if account == "" || len(password) < minPasswordLength || len(password) > maxPasswordBytes {
+		return nil, ErrAccountInvalid
+	}
+	exists, err := uc.Exists(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrAccountExists
+	}
+	u := &User{Account: account, TimeZone: DefaultTimeZone}
+	if invitation = strings.TrimSpace(invitation); invitation != "" {
+		inviter, err := uc.userRepo.FindByAccount(ctx, invitation)
+		if err != nil {
+			return nil, err
+		}
+		if inviter == nil {
+			return nil, ErrInvitationInvalid
+		}
+		u.AskedUserID = inviter.ID
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	u.Password = string(hash)
+	if err := uc.userRepo.Create(ctx, u); err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infow("msg", "user signed up", "userID", u.ID)
+	return u, nil
+}
+
+// Exists reports whether an account is registered.
+func (uc *AccountUsecase) Exists(ctx context.Context, account string) (bool, error) {
+	u, err := uc.userRepo.FindByAccount(ctx, strings.TrimSpace(account))
+	if err != nil {
+		return false, err
+	}
+	return u != nil, nil
+}
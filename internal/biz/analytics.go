@@ -0,0 +1,335 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"teslatrack/pkg/stats"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Sources of the energy used by a drive.
+const (
+	// EnergySourcePower is the energy integrated from DriveState.Power.
+	EnergySourcePower = "power"
+	// EnergySourceSoC is the state of charge delta times the estimated pack capacity.
+	EnergySourceSoC = "soc"
+)
+
+const (
+	// minEfficiencyDistance is the shortest drive in km taken into the efficiency analysis.
+	// Shorter drives are dominated by the rounding of the odometer and the state of charge.
+	minEfficiencyDistance = 1.0
+	// minPowerCoverage is the share of a drive the power integration must cover to be used.
+	minPowerCoverage = 0.9
+	// minCapacitySoC is the least state of charge delta in percent used to estimate the pack capacity.
+	minCapacitySoC = 20
+	// capacityLookback is how far before the analysed range sessions are used to estimate the pack capacity.
+	capacityLookback = 90 * 24 * time.Hour
+	// climateOnRatio is the share of a drive with climate control on from which it counts as climate on.
+	climateOnRatio = 0.5
+)
+
+// Default bucket widths of the efficiency analysis.
+const (
+	DefaultTemperatureStep = 5.0
+	DefaultSpeedStep       = 20.0
+	DefaultHistogramStep   = 20.0
+)
+
+// Narrowest bucket widths of the efficiency analysis, the API rejects narrower ones.
+const (
+	MinTemperatureStep = 1.0
+	MinSpeedStep       = 5.0
+	MinHistogramStep   = 1.0
+)
+
+// DriveEfficiency is the energy efficiency of a single drive.
+type DriveEfficiency struct {
+	// DriveID is the ID of the drive.
+	DriveID int
+	// StartAt is the start of the drive.
+	StartAt time.Time
+	// Distance is the distance driven in km.
+	Distance float64
+	// Duration is the length of the drive.
+	Duration time.Duration
+	// EnergyUsed is the energy used in kWh.
+	EnergyUsed float64
+	// Source is how EnergyUsed was determined, see the EnergySource constants.
+	Source string
+	// OutsideTemp is the mean outside temperature in Celsius, nil when unknown.
+	OutsideTemp *float64
+	// ClimateRatio is the share of the drive with climate control on, from 0 to 1.
+	ClimateRatio float64
+}
+
+// Efficiency returns the consumption in Wh/km.
+func (d *DriveEfficiency) Efficiency() float64 {
+	return d.EnergyUsed * 1000 / d.Distance
+}
+
+// AverageSpeed returns the average speed in km/h.
+func (d *DriveEfficiency) AverageSpeed() float64 {
+	if d.Duration <= 0 {
+		return 0
+	}
+	return d.Distance / d.Duration.Hours()
+}
+
+// EfficiencyBucket aggregates the drives falling into one bucket.
+type EfficiencyBucket struct {
+	// Label names the bucket, e.g., "10" for [10, 15) or "on" for climate on.
+	Label string
+	// Lower is the inclusive lower edge of numeric buckets.
+	Lower float64
+	// Upper is the exclusive upper edge of numeric buckets.
+	Upper float64
+	// Drives is the number of drives.
+	Drives int
+	// Distance is the distance driven in km.
+	Distance float64
+	// EnergyUsed is the energy used in kWh.
+	EnergyUsed float64
+}
+
+// Efficiency returns the consumption of the bucket in Wh/km, weighted by distance.
+func (b *EfficiencyBucket) Efficiency() float64 {
+	if b.Distance <= 0 {
+		return 0
+	}
+	return b.EnergyUsed * 1000 / b.Distance
+}
+
+// add adds a drive to the bucket.
+func (b *EfficiencyBucket) add(d *DriveEfficiency) {
+	b.Drives++
+	b.Distance += d.Distance
+	b.EnergyUsed += d.EnergyUsed
+}
+
+// EfficiencyOptions are the bucket widths of the efficiency analysis.
+// Zero widths select the defaults, narrower widths than the minimums are widened to them.
+type EfficiencyOptions struct {
+	// TemperatureStep is the width of the temperature buckets in Celsius.
+	TemperatureStep float64
+	// SpeedStep is the width of the average speed buckets in km/h.
+	SpeedStep float64
+	// HistogramStep is the width of the efficiency histogram bins in Wh/km.
+	HistogramStep float64
+}
+
+// withDefaults returns the options with zero widths replaced by the defaults
+// and narrow widths raised to the minimums.
+func (o EfficiencyOptions) withDefaults() EfficiencyOptions {
+	if o.TemperatureStep <= 0 {
+		o.TemperatureStep = DefaultTemperatureStep
+	}
+	if o.SpeedStep <= 0 {
+		o.SpeedStep = DefaultSpeedStep
+	}
+	if o.HistogramStep <= 0 {
+		o.HistogramStep = DefaultHistogramStep
+	}
+	o.TemperatureStep = max(o.TemperatureStep, MinTemperatureStep)
+	o.SpeedStep = max(o.SpeedStep, MinSpeedStep)
+	o.HistogramStep = max(o.HistogramStep, MinHistogramStep)
+	return o
+}
+
+// EfficiencyReport is the efficiency of the drives of a vehicle in a time range.
+type EfficiencyReport struct {
+	// PackCapacity is the estimated usable pack capacity in kWh, 0 when unknown.
+	PackCapacity float64
+	// Total aggregates all analysed drives.
+	Total EfficiencyBucket
+	// Drives are the analysed drives, oldest first.
+	Drives []*DriveEfficiency
+	// Skipped is the number of drives without a usable energy reading or too short to analyse.
+	Skipped int
+	// ByTemperature buckets the drives by mean outside temperature.
+	ByTemperature []*EfficiencyBucket
+	// BySpeed buckets the drives by average speed.
+	BySpeed []*EfficiencyBucket
+	// ByClimate splits the drives into climate control off and on.
+	ByClimate []*EfficiencyBucket
+	// Distribution is the histogram of the per-drive efficiency in Wh/km.
+	Distribution []stats.Bin
+}
+
+// AnalyticsUsecase derives insights from the vehicle state timeline.
+type AnalyticsUsecase struct {
+	vehicleRepo  VehicleRepo
+	periodRepo   VehicleStatePeriodRepo
+	snapshotRepo VehicleSnapshotRepo
+	log          *log.Helper
+}
+
+// NewAnalyticsUsecase creates an Analytics usecase.
+func NewAnalyticsUsecase(
+	vehicleRepo VehicleRepo,
+	periodRepo VehicleStatePeriodRepo,
+	snapshotRepo VehicleSnapshotRepo,
+	logger log.Logger,
+) *AnalyticsUsecase {
+	return &AnalyticsUsecase{
+		vehicleRepo:  vehicleRepo,
+		periodRepo:   periodRepo,
+		snapshotRepo: snapshotRepo,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// Efficiency analyses the drives of a vehicle of the user started in [from, to).
+func (uc *AnalyticsUsecase) Efficiency(ctx context.Context, userID, vehicleID int, from, to time.Time, opts EfficiencyOptions) (*EfficiencyReport, error) {
+	if _, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, vehicleID); err != nil {
+		return nil, err
+	}
+	periods, err := uc.periodRepo.ListByVehicle(ctx, vehicleID, from.Add(-capacityLookback), to)
+	if err != nil {
+		return nil, err
+	}
+	var drives []*VehicleStatePeriod
+	for _, p := range periods {
+		if p.State == VehicleStateDriving && p.EndAt != nil && !p.StartAt.Before(from) {
+			drives = append(drives, p)
+		}
+	}
+	return AnalyseEfficiency(drives, PackCapacity(periods), opts), nil
+}
+
+// IdleDrains computes the battery drain of every parked stretch of a vehicle of the user in [from, to).
+func (uc *AnalyticsUsecase) IdleDrains(ctx context.Context, userID, vehicleID int, from, to time.Time) ([]*IdleDrain, error) {
+	if _, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, vehicleID); err != nil {
+		return nil, err
+	}
+	periods, err := uc.periodRepo.ListByVehicle(ctx, vehicleID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return IdleDrains(periods, time.Now()), nil
+}
+
+// PackCapacity estimates the usable pack capacity in kWh from the periods of a vehicle.
+// Charging sessions relate the energy added to the state of charge gained, and drives
+// with a power integration relate the energy used to the state of charge lost.
+// The median of all estimates is returned, 0 when no period is large enough.
+func PackCapacity(periods []*VehicleStatePeriod) float64 {
+	var estimates []float64
+	for _, p := range periods {
+		switch p.State {
+		case VehicleStateCharging:
+			gained := p.EndBatteryLevel - p.StartBatteryLevel
+			if gained >= minCapacitySoC && p.EnergyAdded > 0 {
+				estimates = append(estimates, p.EnergyAdded*100/float64(gained))
+			}
+		case VehicleStateDriving:
+			lost := p.StartBatteryLevel - p.EndBatteryLevel
+			if lost >= minCapacitySoC && powerCovered(p) {
+				estimates = append(estimates, p.EnergyUsed*100/float64(lost))
+			}
+		}
+	}
+	return stats.Median(estimates)
+}
+
+// powerCovered reports whether the power integration covers enough of the drive to be used.
+func powerCovered(p *VehicleStatePeriod) bool {
+	duration := p.Duration()
+	return duration > 0 && p.EnergyUsed > 0 && float64(p.PowerDuration) >= float64(duration)*minPowerCoverage
+}
+
+// NewDriveEfficiency computes the efficiency of a finished drive.
+// The integrated power is preferred; the state of charge delta is the fallback when a
+// pack capacity is known. Nil is returned when neither gives a usable reading.
+func NewDriveEfficiency(p *VehicleStatePeriod, packCapacity float64) *DriveEfficiency {
+	distance := p.EndOdometer - p.StartOdometer
+	if distance < minEfficiencyDistance {
+		return nil
+	}
+	d := &DriveEfficiency{
+		DriveID:      p.ID,
+		StartAt:      p.StartAt,
+		Distance:     distance,
+		Duration:     p.Duration(),
+		OutsideTemp:  p.OutsideTemp,
+		ClimateRatio: p.ClimateRatio,
+	}
+	lost := p.StartBatteryLevel - p.EndBatteryLevel
+	switch {
+	case powerCovered(p):
+		d.EnergyUsed = p.EnergyUsed
+		d.Source = EnergySourcePower
+	case packCapacity > 0 && lost > 0:
+		d.EnergyUsed = float64(lost) * packCapacity / 100
+		d.Source = EnergySourceSoC
+	default:
+		return nil
+	}
+	return d
+}
+
+// AnalyseEfficiency computes the efficiency of the drives and buckets it.
+func AnalyseEfficiency(drives []*VehicleStatePeriod, packCapacity float64, opts EfficiencyOptions) *EfficiencyReport {
+	opts = opts.withDefaults()
+	report := &EfficiencyReport{PackCapacity: packCapacity}
+	var (
+		temperature = map[float64]*EfficiencyBucket{}
+		speed       = map[float64]*EfficiencyBucket{}
+		climateOff  = &EfficiencyBucket{Label: "off", Upper: climateOnRatio}
+		climateOn   = &EfficiencyBucket{Label: "on", Lower: climateOnRatio, Upper: 1}
+		values      []float64
+	)
+	for _, p := range drives {
+		d := NewDriveEfficiency(p, packCapacity)
+		if d == nil {
+			report.Skipped++
+			continue
+		}
+		report.Drives = append(report.Drives, d)
+		report.Total.add(d)
+		values = append(values, d.Efficiency())
+		if d.OutsideTemp != nil {
+			bucketOf(temperature, *d.OutsideTemp, opts.TemperatureStep).add(d)
+		}
+		bucketOf(speed, d.AverageSpeed(), opts.SpeedStep).add(d)
+		if d.ClimateRatio >= climateOnRatio {
+			climateOn.add(d)
+		} else {
+			climateOff.add(d)
+		}
+	}
+	report.Total.Label = "total"
+	report.ByTemperature = sortedBuckets(temperature)
+	report.BySpeed = sortedBuckets(speed)
+	report.ByClimate = []*EfficiencyBucket{climateOff, climateOn}
+	report.Distribution = stats.Histogram(values, opts.HistogramStep)
+	return report
+}
+
+// bucketOf returns the fixed-width bucket containing the value, creating it when missing.
+func bucketOf(buckets map[float64]*EfficiencyBucket, value, step float64) *EfficiencyBucket {
+	lower := stats.Floor(value, step)
+	b, ok := buckets[lower]
+	if !ok {
+		b = &EfficiencyBucket{
+			Label: strconv.FormatFloat(lower, 'f', -1, 64),
+			Lower: lower,
+			Upper: lower + step,
+		}
+		buckets[lower] = b
+	}
+	return b
+}
+
+// sortedBuckets returns the buckets ordered by their lower edge.
+func sortedBuckets(buckets map[float64]*EfficiencyBucket) []*EfficiencyBucket {
+	sorted := make([]*EfficiencyBucket, 0, len(buckets))
+	for _, b := range buckets {
+		sorted = append(sorted, b)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Lower < sorted[j].Lower })
+	return sorted
+}
@@ -0,0 +1,53 @@
+package biz
+
+import (
+	"testing"
+	"time"
+)
+
+// drive returns a finished drive of distance km at speed km/h using energy kWh.
+func drive(distance, speed, energy, outsideTemp float64) *VehicleStatePeriod {
+	start := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	duration := time.Duration(distance / speed * float64(time.Hour))
+	end := start.Add(duration)
+	return &VehicleStatePeriod{
+		State:         VehicleStateDriving,
+		StartAt:       start,
+		EndAt:         &end,
+		EndOdometer:   distance,
+		EnergyUsed:    energy,
+		PowerDuration: duration,
+		OutsideTemp:   &outsideTemp,
+	}
+}
+
+func TestAnalyseEfficiencyNarrowSteps(t *testing.T) {
+	drives := []*VehicleStatePeriod{
+		drive(10, 40, 1.5, 3.2),
+		drive(30, 90, 5.1, 4.9),
+		drive(50, 110, 10, 21.7),
+	}
+	report := AnalyseEfficiency(drives, 0, EfficiencyOptions{TemperatureStep: 1e-9, SpeedStep: 1e-300, HistogramStep: 5e-324})
+	if len(report.Drives) != 3 {
+		t.Fatalf("AnalyseEfficiency analysed %d drives, want 3", len(report.Drives))
+	}
+	checks := []struct {
+		name    string
+		buckets []*EfficiencyBucket
+		step    float64
+	}{
+		{"temperature", report.ByTemperature, MinTemperatureStep},
+		{"speed", report.BySpeed, MinSpeedStep},
+	}
+	for _, c := range checks {
+		for _, b := range c.buckets {
+			if b.Upper-b.Lower != c.step {
+				t.Errorf("%s bucket %s is %v wide, want the minimum %v", c.name, b.Label, b.Upper-b.Lower, c.step)
+			}
+		}
+	}
+	// 150 to 200 Wh/km in 1 Wh/km bins.
+	if n := len(report.Distribution); n != 51 {
+		t.Errorf("distribution has %d bins, want 51", n)
+	}
+}
@@ -2,6 +2,7 @@ package biz
 
 import (
 	"context"
+	v1 "teslatrack/api/teslatrack/v1"
 	"teslatrack/internal/conf"
 	"time"
 
@@ -21,9 +22,23 @@ import (
 // - energy_cmds: Allows sending commands to energy products.
 const ALL_SCOPES = "openid offline_access user_data vehicle_device_data vehicle_location vehicle_cmds vehicle_charging_cmds energy_device_data energy_cmds"
 
+// authorizeStateTTL is how long a user may take to authorize on Tesla after the redirect.
+const authorizeStateTTL = 10 * time.Minute
+
 // TESLA_EXCHANGE_CODE_URL
 const TESLA_EXCHANGE_CODE_URL = "https://auth.tesla.cn/oauth2/v3/token"
 
+var (
+	// ErrClientNotFound is returned for a client ID matching no registered client.
+	ErrClientNotFound = v1.ErrorClientNotFound("client not found")
+	// ErrClientExists is returned when registering a client ID twice.
+	ErrClientExists = v1.ErrorClientExists("the client is already registered")
+	// ErrAuthorizeStateInvalid is returned for a callback whose state was not issued, expired or was used.
+	ErrAuthorizeStateInvalid = v1.ErrorAuthorizeStateInvalid("the authorization expired or was already completed, authorize again")
+	// ErrAuthorizeCodeInvalid is returned when Tesla does not exchange the code of a callback for tokens.
+	ErrAuthorizeCodeInvalid = v1.ErrorAuthorizeCodeInvalid("Tesla rejected the authorization, authorize again")
+)
+
 // Authorize is the data model for OAuth 2.0 client authorization.
 // It holds the necessary information for a client to obtain an access token.
 type Authorize struct {
@@ -43,27 +58,51 @@ type AuthorizeRepo interface {
 	Create(ctx context.Context, authorize *Authorize) error
 	// Update modifies an existing Authorize record in the storage.
 	Update(ctx context.Context, authorize *Authorize) error
-	// FindByClientID retrieves an Authorize record from the storage by its client ID.
+	// FindByClientID retrieves an Authorize record from the storage by its client ID, returns nil if none exists.
 	FindByClientID(ctx context.Context, clientID string) (*Authorize, error)
 }
 
+// AuthorizeState is an authorization redirect in progress, checked on its callback.
+type AuthorizeState struct {
+	ClientID string // The client identifier the redirect was issued for.
+	Nonce    string // The nonce sent with the redirect.
+	UserID   int    // The ID of the signed-in user the Tesla account is authorized for.
+}
+
+// AuthorizeStateRepo stores the states of the authorization redirects until their callback,
+// shared by the instances of the service as the callback may reach another one.
+type AuthorizeStateRepo interface {
+	// Save stores the redirect of a state for ttl.
+	Save(ctx context.Context, state string, redirect *AuthorizeState, ttl time.Duration) error
+	// Take returns and deletes the redirect of a state, returns nil if none exists or it expired.
+	Take(ctx context.Context, state string) (*AuthorizeState, error)
+}
+
 // AuthorizeUsecase provides the business logic for authorization operations.
 // It orchestrates the interaction between the transport layer (e.g., HTTP server) and the data layer (repository).
 type AuthorizeUsecase struct {
-	repo AuthorizeRepo
-	conf *conf.Server
-	log  *log.Helper
+	repo      AuthorizeRepo
+	stateRepo AuthorizeStateRepo
+	conf      *conf.Server
+	log       *log.Helper
 }
 
 // NewAuthorizeUsecase creates a new instance of AuthorizeUsecase.
-// It requires an AuthorizeRepo for data access and a logger for logging.
-func NewAuthorizeUsecase(repo AuthorizeRepo, config *conf.Server, logger log.Logger) *AuthorizeUsecase {
-	return &AuthorizeUsecase{repo: repo, conf: config, log: log.NewHelper(logger)}
+// It requires an AuthorizeRepo for data access, an AuthorizeStateRepo for the redirects in progress and a logger for logging.
+func NewAuthorizeUsecase(repo AuthorizeRepo, stateRepo AuthorizeStateRepo, config *conf.Server, logger log.Logger) *AuthorizeUsecase {
+	return &AuthorizeUsecase{repo: repo, stateRepo: stateRepo, conf: config, log: log.NewHelper(logger)}
 }
 
 // Create is the use case for creating a new authorization.
-// It delegates the creation operation to the underlying repository.
+// It returns ErrClientExists if the client ID is already registered.
 func (uc *AuthorizeUsecase) Create(ctx context.Context, authorize *Authorize) error {
+	existing, err := uc.repo.FindByClientID(ctx, authorize.ClientID)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return ErrClientExists
+	}
 	return uc.repo.Create(ctx, authorize)
 }
 
@@ -74,9 +113,16 @@ func (uc *AuthorizeUsecase) Update(ctx context.Context, authorize *Authorize) er
 }
 
 // FindByClientID is the use case for finding an authorization by its client ID.
-// It delegates the find operation to the underlying repository.
+// It returns ErrClientNotFound if no client is registered with the ID.
 func (uc *AuthorizeUsecase) FindByClientID(ctx context.Context, clientID string) (*Authorize, error) {
-	return uc.repo.FindByClientID(ctx, clientID)
+	authorize, err := uc.repo.FindByClientID(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if authorize == nil {
+		return nil, ErrClientNotFound
+	}
+	return authorize, nil
 }
 
 // Callback handles the authorization code received from the OAuth provider.
@@ -86,6 +132,22 @@ func (uc *AuthorizeUsecase) Callback(ctx context.Context, code string) error {
 	return nil
 }
 
+// VerifyState checks the state of a callback against the redirects in progress.
+// A state is valid once, so a callback cannot be replayed; it returns ErrAuthorizeStateInvalid otherwise.
+func (uc *AuthorizeUsecase) VerifyState(ctx context.Context, state string) (*AuthorizeState, error) {
+	if state == "" {
+		return nil, ErrAuthorizeStateInvalid
+	}
+	redirect, err := uc.stateRepo.Take(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	if redirect == nil {
+		return nil, ErrAuthorizeStateInvalid
+	}
+	return redirect, nil
+}
+
 // AuthorizeEncodeRedirect holds the parameters needed to construct the redirect URL
 // for the Tesla OAuth 2.0 authorization flow.
 type AuthorizeEncodeRedirect struct {
@@ -98,11 +160,11 @@ type AuthorizeEncodeRedirect struct {
 	RequireRequestedScopes bool   `json:"requireRequestedScopes"`
 }
 
-// Redirect prepares the necessary parameters for the authorization redirect.
-// It fetches client details, generates a secure state and nonce, and returns them.
-func (uc *AuthorizeUsecase) Redirect(ctx context.Context, clientID string) (*AuthorizeEncodeRedirect, error) {
+// Redirect prepares the necessary parameters for the authorization redirect of a user.
+// It fetches client details, generates a secure state and nonce, stores them with the user until the callback, and returns them.
+func (uc *AuthorizeUsecase) Redirect(ctx context.Context, userID int, clientID string) (*AuthorizeEncodeRedirect, error) {
 	// Fetch the client's authorization configuration.
-	authorize, err := uc.repo.FindByClientID(ctx, clientID)
+	authorize, err := uc.FindByClientID(ctx, clientID)
 	if err != nil {
 		return nil, err
 	}
@@ -110,6 +172,9 @@ func (uc *AuthorizeUsecase) Redirect(ctx context.Context, clientID string) (*Aut
 	// Generate a unique and non-guessable value for state and nonce to prevent CSRF and replay attacks.
 	state, _ := uuid.NewV7()
 	nonce, _ := uuid.NewV7()
+	if err := uc.stateRepo.Save(ctx, state.String(), &AuthorizeState{ClientID: authorize.ClientID, Nonce: nonce.String(), UserID: userID}, authorizeStateTTL); err != nil {
+		return nil, err
+	}
 
 	// Construct the redirect parameters.
 	return &AuthorizeEncodeRedirect{
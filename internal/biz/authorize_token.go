@@ -2,7 +2,7 @@ package biz
 
 import (
 	"context"
-	"io"
+	"encoding/json"
 	"net/http"
 	"net/url"
 	"os"
@@ -22,6 +22,7 @@ type AuthorizeToken struct {
 	AccessToken  string    // The token used to access the Tesla API.
 	RefreshToken string    // The token used to refresh the access token.
 	Scope        string    // The scope of permissions granted.
+	UserID       int       // The ID of the user who granted the token.
 	CreatedAt    time.Time // The timestamp when the token was created.
 	UpdatedAt    time.Time // The timestamp when the token was last updated.
 	Deleted      bool      // A flag for soft deletion.
@@ -33,12 +34,16 @@ type AuthorizeTokenRepo interface {
 	Create(ctx context.Context, token *AuthorizeToken) (*AuthorizeToken, error)
 	// Update modifies an existing AuthorizeToken record.
 	Update(ctx context.Context, token *AuthorizeToken) error
-	// FindByClientID retrieves an AuthorizeToken by the client ID.
+	// FindByClientID retrieves an AuthorizeToken by the client ID, returns nil if none exists.
 	FindByClientID(ctx context.Context, clientID string) (*AuthorizeToken, error)
-	// FindByAccessToken retrieves an AuthorizeToken by the access token.
+	// FindByAccessToken retrieves an AuthorizeToken by the access token, returns nil if none exists.
 	FindByAccessToken(ctx context.Context, accessToken string) (*AuthorizeToken, error)
 	// Delete soft-deletes an AuthorizeToken record by its ID.
 	Delete(ctx context.Context, id int64) error
+	// ListActive retrieves all AuthorizeTokens that have not been deleted.
+	ListActive(ctx context.Context) ([]*AuthorizeToken, error)
+	// FindActiveByUserID retrieves the most recent active AuthorizeToken of a user, returns nil if none exists.
+	FindActiveByUserID(ctx context.Context, userID int) (*AuthorizeToken, error)
 }
 
 // AuthorizeTokenUsecase provides the business logic for authorization token operations.
@@ -64,13 +69,29 @@ func (uc *AuthorizeTokenUsecase) Update(ctx context.Context, token *AuthorizeTok
 }
 
 // FindByClientID is the use case for finding a token by client ID.
+// It returns ErrTeslaNotAuthorized if there is none.
 func (uc *AuthorizeTokenUsecase) FindByClientID(ctx context.Context, clientID string) (*AuthorizeToken, error) {
-	return uc.repo.FindByClientID(ctx, clientID)
+	token, err := uc.repo.FindByClientID(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, ErrTeslaNotAuthorized
+	}
+	return token, nil
 }
 
 // FindByAccessToken is the use case for finding a token by access token.
+// It returns ErrTeslaNotAuthorized if there is none.
 func (uc *AuthorizeTokenUsecase) FindByAccessToken(ctx context.Context, accessToken string) (*AuthorizeToken, error) {
-	return uc.repo.FindByAccessToken(ctx, accessToken)
+	token, err := uc.repo.FindByAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, ErrTeslaNotAuthorized
+	}
+	return token, nil
 }
 
 // Delete is the use case for deleting (soft delete) an authorization token.
@@ -78,23 +99,66 @@ func (uc *AuthorizeTokenUsecase) Delete(ctx context.Context, id int64) error {
 	return uc.repo.Delete(ctx, id)
 }
 
-// ExchangeCode
-func (uc *AuthorizeTokenUsecase) ExchangeCode(ctx context.Context, code string) {
+// exchangedToken is the reply of Tesla to the exchange of an authorization code.
+type exchangedToken struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	Scope        string `json:"scope"`
+}
+
+// ExchangeCode exchanges the authorization code of a callback for the tokens of the Tesla account
+// and saves them for the user who started the authorization, replacing the active token of the user.
+// It returns ErrAuthorizeCodeInvalid if Tesla rejects the code.
+func (uc *AuthorizeTokenUsecase) ExchangeCode(ctx context.Context, userID int, code string) error {
+	clientID, clientSecret := os.Getenv("TESLA_CLIENT_ID"), os.Getenv("TESLA_CLIENT_SECRET")
 	// Build formValue
 	values := url.Values{
 		"grant_type":    []string{"authorization_code"},
 		"audience":      []string{"https://fleet-api.prd.cn.vn.cloud.tesla.cn"},
-		"client_id":     []string{os.Getenv("TESLA_CLIENT_ID")},
-		"client_secret": []string{os.Getenv("TESLA_CLIENT_SECRET")},
+		"client_id":     []string{clientID},
+		"client_secret": []string{clientSecret},
 		"code":          []string{code},
 		"redirect_uri":  []string{uc.conf.Http.Hostname + uc.conf.Tesla.Callback},
 	}
 	response, err := http.PostForm(TESLA_EXCHANGE_CODE_URL, values)
 	if err != nil {
-		panic(err)
+		return ErrTeslaUnavailable.WithCause(err)
 	}
+	defer response.Body.Close()
 
 	uc.log.Infow("msg", "exchange code response", "statusCode", response.StatusCode, "status", response.Status)
-	body, _ := io.ReadAll(response.Body)
-	uc.log.Infof("response body %s", string(body))
+	if response.StatusCode >= http.StatusInternalServerError {
+		return ErrTeslaUnavailable
+	}
+	if response.StatusCode != http.StatusOK {
+		return ErrAuthorizeCodeInvalid
+	}
+	var exchanged exchangedToken
+	if err := json.NewDecoder(response.Body).Decode(&exchanged); err != nil {
+		return ErrTeslaUnavailable.WithCause(err)
+	}
+	if exchanged.AccessToken == "" {
+		return ErrAuthorizeCodeInvalid
+	}
+
+	existing, err := uc.repo.FindActiveByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		existing.AccessToken = exchanged.AccessToken
+		existing.RefreshToken = exchanged.RefreshToken
+		existing.Scope = exchanged.Scope
+		return uc.repo.Update(ctx, existing)
+	}
+	_, err = uc.repo.Create(ctx, &AuthorizeToken{
+		TeslaCode:    code,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		AccessToken:  exchanged.AccessToken,
+		RefreshToken: exchanged.RefreshToken,
+		Scope:        exchanged.Scope,
+		UserID:       userID,
+	})
+	return err
 }
@@ -4,10 +4,26 @@ import "github.com/google/wire"
 
 // ProviderSet is biz providers.
 var ProviderSet = wire.NewSet(
-	NewGreeterUsecase,
 	NewAuthorizeUsecase,
 	NewAuthorizeTokenUsecase,
 	NewPartnerUsecase,
 	NewUserUsecase,
+	NewAccountUsecase,
 	NewVehicleUsecase,
+	NewVehicleCommandUsecase,
+	NewEventBus,
+	NewGeocodeUsecase,
+	NewGeofenceUsecase,
+	NewTariffUsecase,
+	NewAnalyticsUsecase,
+	NewVehicleStateUsecase,
+	NewCollectorUsecase,
+	NewRouteUsecase,
+	NewRollupUsecase,
+	NewSoftwareUsecase,
+	NewTimelineUsecase,
+	NewHistoryUsecase,
+	NewTireUsecase,
+	NewLiveUsecase,
+	NewHealthUsecase,
 )
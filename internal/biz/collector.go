@@ -0,0 +1,316 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"teslatrack/internal/conf"
+	"teslatrack/pkg/geo"
+	"teslatrack/pkg/tesla"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// CollectorUsecase collects vehicle snapshots from the Fleet API for every authorized account.
+type CollectorUsecase struct {
+	tokenRepo    AuthorizeTokenRepo
+	vehicleRepo  VehicleRepo
+	snapshotRepo VehicleSnapshotRepo
+	state        *VehicleStateUsecase
+	software     *SoftwareUsecase
+	tire         *TireUsecase
+	bus          *EventBus
+	locker       Locker
+	coordType    geo.CoordType
+	log          *log.Helper
+
+	mu       sync.Mutex
+	accounts map[int64]AccountStatus
+	idle     map[int]*vehicleIdle
+}
+
+const (
+	// suspendAfterIdle is how long an online vehicle is polled while parked and idle
+	// before its vehicle data is no longer requested.
+	suspendAfterIdle = 15 * time.Minute
+	// suspendDuration is how long polling leaves the vehicle data of an idle vehicle alone,
+	// as every request keeps the vehicle awake. Only the vehicle list is requested meanwhile,
+	// so the vehicle is seen falling asleep.
+	suspendDuration = 21 * time.Minute
+
+	// vehicleLockTTL bounds how long a vehicle stays locked by a collection that never finishes.
+	vehicleLockTTL = time.Minute
+	// vehicleLockWait is how long a collection waits for the collection of the same vehicle in progress.
+	vehicleLockWait = 30 * time.Second
+	// vehicleLockRetry is the interval of the attempts to lock a vehicle.
+	vehicleLockRetry = 100 * time.Millisecond
+)
+
+// vehicleIdle tracks how long an online vehicle has been parked and idle, kept in memory.
+type vehicleIdle struct {
+	// Since is the first idle sample, zero while the vehicle is in use.
+	Since time.Time
+	// SuspendedUntil is the end of the suspended polling, zero if not suspended.
+	SuspendedUntil time.Time
+}
+
+// AccountStatus is the status of the vehicle list requests of an account, kept in memory.
+type AccountStatus struct {
+	// LastSuccess is the time the vehicles of the account were last listed, zero if never.
+	LastSuccess time.Time
+	// LastFailure is the time listing the vehicles last failed, zero if never.
+	LastFailure time.Time
+	// LastReason is the error reason of the last failure, e.g., TESLA_TOKEN_EXPIRED.
+	LastReason string
+}
+
+// NewCollectorUsecase creates a Collector usecase.
+func NewCollectorUsecase(
+	tokenRepo AuthorizeTokenRepo,
+	vehicleRepo VehicleRepo,
+	snapshotRepo VehicleSnapshotRepo,
+	state *VehicleStateUsecase,
+	software *SoftwareUsecase,
+	tire *TireUsecase,
+	bus *EventBus,
+	locker Locker,
+	c *conf.Server,
+	logger log.Logger,
+) *CollectorUsecase {
+	coordType := TeslaCoordType
+	if ct, ok := geo.ParseCoordType(c.GetTesla().GetCoordType()); ok {
+		coordType = ct
+	}
+	return &CollectorUsecase{
+		tokenRepo:    tokenRepo,
+		vehicleRepo:  vehicleRepo,
+		snapshotRepo: snapshotRepo,
+		state:        state,
+		software:     software,
+		tire:         tire,
+		bus:          bus,
+		locker:       locker,
+		coordType:    coordType,
+		log:          log.NewHelper(logger),
+		accounts:     make(map[int64]AccountStatus),
+		idle:         make(map[int]*vehicleIdle),
+	}
+}
+
+// AccountStatus returns the status of the vehicle list requests of an account since startup.
+func (uc *CollectorUsecase) AccountStatus(tokenID int64) AccountStatus {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	return uc.accounts[tokenID]
+}
+
+// listVehicles lists the vehicles of an account, recording the outcome in its status.
+func (uc *CollectorUsecase) listVehicles(ctx context.Context, token *AuthorizeToken) ([]tesla.Vehicle, error) {
+	vehicles, err := tesla.GetVehices(ctx, token.AccessToken)
+	if err != nil {
+		err = teslaError(err)
+	}
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	status := uc.accounts[token.ID]
+	if err != nil {
+		status.LastFailure, status.LastReason = time.Now(), errors.Reason(err)
+	} else {
+		status.LastSuccess = time.Now()
+	}
+	uc.accounts[token.ID] = status
+	return vehicles, err
+}
+
+// Collect polls every vehicle of every active token once.
+// The vehicle data of vehicles parked and idle for suspendAfterIdle is not requested for
+// suspendDuration so that they can fall asleep.
+// Failures of a single account or vehicle are logged and do not stop the others.
+func (uc *CollectorUsecase) Collect(ctx context.Context) error {
+	tokens, err := uc.tokenRepo.ListActive(ctx)
+	if err != nil {
+		return err
+	}
+	for _, token := range tokens {
+		vehicles, err := uc.listVehicles(ctx, token)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorw("msg", "list vehicles failed", "tokenID", token.ID, "reason", errors.Reason(err), "err", err)
+			continue
+		}
+		for i := range vehicles {
+			if err := uc.collectVehicle(ctx, token, &vehicles[i], true); err != nil {
+				uc.log.WithContext(ctx).Errorw("msg", "collect vehicle failed", "vin", vehicles[i].VIN, "err", err)
+			}
+		}
+	}
+	return nil
+}
+
+// Refresh collects a single vehicle right away with the most recent token of its owner,
+// even while its polling is suspended. Like polling, it never wakes a sleeping vehicle.
+func (uc *CollectorUsecase) Refresh(ctx context.Context, veh *Vehicle) error {
+	token, err := uc.tokenRepo.FindActiveByUserID(ctx, veh.UserID)
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return ErrTeslaNotAuthorized
+	}
+	vehicles, err := uc.listVehicles(ctx, token)
+	if err != nil {
+		return err
+	}
+	for i := range vehicles {
+		if vehicles[i].VIN == veh.VIN {
+			if err := uc.collectVehicle(ctx, token, &vehicles[i], false); err != nil {
+				if errors.Reason(err) != "" {
+					return err
+				}
+				return ErrVehicleRefreshFailed.WithCause(err)
+			}
+			return nil
+		}
+	}
+	return ErrVehicleNotFound
+}
+
+// teslaError maps a failure of the Fleet API to the error reason clients can react on.
+func teslaError(err error) error {
+	var e *tesla.ResponseError
+	if !errors.As(err, &e) {
+		return ErrTeslaUnavailable.WithCause(err)
+	}
+	switch {
+	case e.StatusCode == http.StatusUnauthorized:
+		return ErrTeslaTokenExpired.WithCause(err)
+	case e.StatusCode == http.StatusTooManyRequests:
+		return ErrTeslaRateLimited.WithCause(err)
+	case e.StatusCode == http.StatusRequestTimeout, strings.Contains(e.Code, "vehicle unavailable"):
+		return ErrVehicleAsleep.WithCause(err)
+	case e.StatusCode == http.StatusNotFound:
+		return ErrVehicleNotFound.WithCause(err)
+	}
+	return ErrTeslaUnavailable.WithCause(err)
+}
+
+// collectVehicle stores the vehicle and records a snapshot of it.
+// Vehicle data is only requested for online vehicles, as requesting it wakes a sleeping vehicle,
+// and not while polling of an idle vehicle is suspended, as it keeps the vehicle awake.
+// Polling and refreshes of the same vehicle on any instance are serialized, so that its
+// snapshots are tracked one at a time and in order.
+func (uc *CollectorUsecase) collectVehicle(ctx context.Context, token *AuthorizeToken, v *tesla.Vehicle, poll bool) error {
+	raw, _ := json.Marshal(v)
+	veh, err := uc.vehicleRepo.SaveByVIN(ctx, &Vehicle{
+		VIN:         v.VIN,
+		UserID:      token.UserID,
+		DisplayName: v.DisplayName,
+		AccessType:  v.AccessType,
+		State:       v.State,
+		RawData:     string(raw),
+	})
+	if err != nil {
+		return err
+	}
+	lock, err := uc.lockVehicle(ctx, veh.ID)
+	if err != nil {
+		return err
+	}
+	defer lock.Unlock()
+
+	now := time.Now()
+	snapshot := &VehicleSnapshot{VehicleID: veh.ID, State: v.State, CreatedAt: now}
+	if v.State == TeslaStateOnline {
+		if poll && uc.suspended(veh.ID, now) {
+			return nil
+		}
+		data, err := tesla.GetVehiceData(ctx, token.AccessToken, v.VIN)
+		if err != nil {
+			return teslaError(err)
+		}
+		snapshot = NewVehicleSnapshot(veh.ID, data, uc.coordType)
+		uc.trackIdle(veh.ID, snapshot)
+		if err := uc.tire.Track(ctx, veh.UserID, NewTirePressure(veh.ID, data)); err != nil {
+			uc.log.WithContext(ctx).Errorw("msg", "track tire pressure failed", "vehicleID", veh.ID, "err", err)
+		}
+	}
+	if !snapshot.HasData() {
+		uc.trackIdle(veh.ID, snapshot)
+	}
+	return uc.Record(ctx, veh, snapshot)
+}
+
+// lockVehicle waits up to vehicleLockWait for the lock of a vehicle.
+// The lock is shared by the instances, so a refresh on one instance waits for polling on another.
+func (uc *CollectorUsecase) lockVehicle(ctx context.Context, vehicleID int) (Lock, error) {
+	ctx, cancel := context.WithTimeout(ctx, vehicleLockWait)
+	defer cancel()
+	key := "vehicle:" + strconv.Itoa(vehicleID)
+	for {
+		lock, ok, err := uc.locker.TryLock(ctx, key, vehicleLockTTL)
+		if err != nil || ok {
+			return lock, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(vehicleLockRetry):
+		}
+	}
+}
+
+// suspended reports whether polling the vehicle data of a vehicle is suspended at now.
+func (uc *CollectorUsecase) suspended(vehicleID int, now time.Time) bool {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	idle := uc.idle[vehicleID]
+	return idle != nil && now.Before(idle.SuspendedUntil)
+}
+
+// trackIdle updates the idle time of a vehicle from a snapshot and suspends its polling
+// once it has been parked and idle for suspendAfterIdle. A vehicle still idle after a suspension
+// is suspended again at its next sample. Any use of the vehicle, or the vehicle falling asleep, resets it.
+func (uc *CollectorUsecase) trackIdle(vehicleID int, s *VehicleSnapshot) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	if !s.Idle() {
+		delete(uc.idle, vehicleID)
+		return
+	}
+	idle := uc.idle[vehicleID]
+	if idle == nil {
+		uc.idle[vehicleID] = &vehicleIdle{Since: s.CreatedAt}
+		return
+	}
+	if s.CreatedAt.Sub(idle.Since) >= suspendAfterIdle {
+		idle.SuspendedUntil = s.CreatedAt.Add(suspendDuration)
+		uc.log.Infow("msg", "vehicle idle, polling suspended", "vehicleID", vehicleID, "until", idle.SuspendedUntil)
+	}
+}
+
+// Record stores and publishes a snapshot and applies it to the vehicle state timeline
+// and the software update history.
+func (uc *CollectorUsecase) Record(ctx context.Context, veh *Vehicle, snapshot *VehicleSnapshot) error {
+	if err := uc.snapshotRepo.Create(ctx, snapshot); err != nil {
+		return err
+	}
+	latest := &VehicleLatestState{Vehicle: veh, LastSeenAt: &snapshot.CreatedAt}
+	if snapshot.HasData() {
+		latest.Snapshot = snapshot
+	}
+	uc.bus.Publish(&Event{
+		Type:      EventVehicleSnapshot,
+		UserID:    veh.UserID,
+		VehicleID: veh.ID,
+		Time:      snapshot.CreatedAt,
+		Payload:   latest,
+	})
+	if err := uc.state.Track(ctx, veh.UserID, snapshot); err != nil {
+		return err
+	}
+	return uc.software.Track(ctx, snapshot)
+}
@@ -0,0 +1,96 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestCollectorSuspendsIdlePolling(t *testing.T) {
+	uc := &CollectorUsecase{idle: make(map[int]*vehicleIdle), log: log.NewHelper(log.DefaultLogger)}
+	start := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	sample := func(minute int) *VehicleSnapshot {
+		return &VehicleSnapshot{VehicleID: 1, State: TeslaStateOnline, ShiftState: "P", CreatedAt: start.Add(time.Duration(minute) * time.Minute)}
+	}
+	at := func(minute int) time.Time {
+		return start.Add(time.Duration(minute) * time.Minute)
+	}
+
+	for minute := 0; minute < 15; minute++ {
+		uc.trackIdle(1, sample(minute))
+		if uc.suspended(1, at(minute+1)) {
+			t.Fatalf("polling suspended after %d idle minutes, want %v", minute, suspendAfterIdle)
+		}
+	}
+	uc.trackIdle(1, sample(15))
+	if !uc.suspended(1, at(16)) || !uc.suspended(1, at(35)) {
+		t.Errorf("polling not suspended after %v idle", suspendAfterIdle)
+	}
+	if uc.suspended(1, at(36)) {
+		t.Errorf("polling still suspended after %v", suspendDuration)
+	}
+
+	// Still idle after the suspension, polling is suspended again at once.
+	uc.trackIdle(1, sample(36))
+	if !uc.suspended(1, at(37)) {
+		t.Error("polling not suspended again for a vehicle still idle")
+	}
+
+	// Sentry mode keeps the vehicle awake, so it is polled.
+	sentry := sample(60)
+	sentry.SentryMode = true
+	uc.trackIdle(1, sentry)
+	if uc.suspended(1, at(61)) {
+		t.Error("polling suspended for a vehicle with sentry mode on")
+	}
+	for minute := 61; minute <= 76; minute++ {
+		uc.trackIdle(1, sample(minute))
+	}
+	// Falling asleep resets the idle time.
+	uc.trackIdle(1, &VehicleSnapshot{VehicleID: 1, State: TeslaStateAsleep, CreatedAt: at(80)})
+	if uc.suspended(1, at(81)) {
+		t.Error("polling suspended for a vehicle that fell asleep")
+	}
+}
+
+func TestCollectorLockVehicle(t *testing.T) {
+	uc := &CollectorUsecase{locker: &memoryLocker{}, log: log.NewHelper(log.DefaultLogger)}
+	ctx := context.Background()
+	held, err := uc.lockVehicle(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := uc.lockVehicle(ctx, 2)
+	if err != nil {
+		t.Fatalf("lock of another vehicle: %v", err)
+	}
+	other.Unlock()
+
+	locked := make(chan error, 1)
+	go func() {
+		lock, err := uc.lockVehicle(ctx, 1)
+		if err == nil {
+			lock.Unlock()
+		}
+		locked <- err
+	}()
+	select {
+	case err := <-locked:
+		t.Fatalf("vehicle locked twice, error %v", err)
+	case <-time.After(3 * vehicleLockRetry):
+	}
+	held.Unlock()
+	if err := <-locked; err != nil {
+		t.Errorf("lock after the collection in progress: %v", err)
+	}
+
+	held, _ = uc.lockVehicle(ctx, 1)
+	defer held.Unlock()
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := uc.lockVehicle(ctx, 1); err != context.Canceled {
+		t.Errorf("lock of a cancelled collection error = %v, want %v", err, context.Canceled)
+	}
+}
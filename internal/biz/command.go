@@ -0,0 +1,126 @@
+package biz
+
+import (
+	"context"
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/pkg/tesla"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Vehicle commands accepted by the VehicleCommand usecase.
+const (
+	CommandWakeUp         = "wake_up"
+	CommandLock           = "lock"
+	CommandUnlock         = "unlock"
+	CommandClimateOn      = "climate_on"
+	CommandClimateOff     = "climate_off"
+	CommandChargeStart    = "charge_start"
+	CommandChargeStop     = "charge_stop"
+	CommandSetChargeLimit = "set_charge_limit"
+)
+
+const (
+	// MinChargeLimit is the lowest charge limit in percent accepted by the vehicles.
+	MinChargeLimit = 50
+	// MaxChargeLimit is the highest charge limit in percent.
+	MaxChargeLimit = 100
+)
+
+var (
+	// ErrVehicleCommandInvalid is returned for unknown commands and charge limits out of range.
+	ErrVehicleCommandInvalid = v1.ErrorVehicleCommandInvalid("unknown vehicle command or a charge limit out of 50-100%%")
+	// ErrVehicleCommandFailed is returned when the vehicle rejects a command, the reason is in the metadata.
+	ErrVehicleCommandFailed = v1.ErrorVehicleCommandFailed("the vehicle rejected the command")
+)
+
+// teslaCommands maps the commands to the Fleet API commands. Wake up has an endpoint of its own.
+var teslaCommands = map[string]string{
+	CommandLock:           tesla.COMMAND_DOOR_LOCK,
+	CommandUnlock:         tesla.COMMAND_DOOR_UNLOCK,
+	CommandClimateOn:      tesla.COMMAND_AUTO_CONDITIONING_START,
+	CommandClimateOff:     tesla.COMMAND_AUTO_CONDITIONING_STOP,
+	CommandChargeStart:    tesla.COMMAND_CHARGE_START,
+	CommandChargeStop:     tesla.COMMAND_CHARGE_STOP,
+	CommandSetChargeLimit: tesla.COMMAND_SET_CHARGE_LIMIT,
+}
+
+// VehicleCommand is a command sent to a vehicle.
+type VehicleCommand struct {
+	// Name is the command, e.g., CommandLock.
+	Name string
+	// ChargeLimit is the charge limit in percent for CommandSetChargeLimit.
+	ChargeLimit int
+}
+
+// VehicleCommandUsecase sends commands to vehicles through the Fleet API.
+type VehicleCommandUsecase struct {
+	tokenRepo   AuthorizeTokenRepo
+	vehicleRepo VehicleRepo
+	collector   *CollectorUsecase
+	log         *log.Helper
+}
+
+// NewVehicleCommandUsecase creates a VehicleCommand usecase.
+func NewVehicleCommandUsecase(tokenRepo AuthorizeTokenRepo, vehicleRepo VehicleRepo, collector *CollectorUsecase, logger log.Logger) *VehicleCommandUsecase {
+	return &VehicleCommandUsecase{
+		tokenRepo:   tokenRepo,
+		vehicleRepo: vehicleRepo,
+		collector:   collector,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// SendByVIN sends a command to the vehicle of the user with the VIN using the most recent token of the user.
+// Vehicles are addressed by VIN for integrations such as the MQTT bridge; it returns ErrVehicleNotFound
+// for the vehicles of another user.
+// After the command the vehicle is refreshed so that its new state is published.
+func (uc *VehicleCommandUsecase) SendByVIN(ctx context.Context, userID int, vin string, cmd *VehicleCommand) error {
+	command, ok := teslaCommands[cmd.Name]
+	if !ok && cmd.Name != CommandWakeUp {
+		return ErrVehicleCommandInvalid
+	}
+	var params any
+	if cmd.Name == CommandSetChargeLimit {
+		if cmd.ChargeLimit < MinChargeLimit || cmd.ChargeLimit > MaxChargeLimit {
+			return ErrVehicleCommandInvalid
+		}
+		params = map[string]int{"percent": cmd.ChargeLimit}
+	}
+	veh, err := uc.vehicleRepo.FindByVIN(ctx, vin)
+	if err != nil {
+		return err
+	}
+	if veh == nil || veh.UserID != userID {
+		return ErrVehicleNotFound
+	}
+	token, err := uc.tokenRepo.FindActiveByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return ErrTeslaNotAuthorized
+	}
+
+	if cmd.Name == CommandWakeUp {
+		_, err = tesla.WakeUp(ctx, token.AccessToken, veh.VIN)
+	} else {
+		err = tesla.SendCommand(ctx, token.AccessToken, veh.VIN, command, params)
+	}
+	if err != nil {
+		var rejected *tesla.CommandError
+		if errors.As(err, &rejected) {
+			return ErrVehicleCommandFailed.WithCause(err).WithMetadata(map[string]string{"reason": rejected.Reason})
+		}
+		return teslaError(err)
+	}
+	uc.log.WithContext(ctx).Infow("msg", "vehicle command sent", "vehicleID", veh.ID, "command", cmd.Name)
+
+	if cmd.Name != CommandWakeUp {
+		if err := uc.collector.Refresh(ctx, veh); err != nil {
+			uc.log.WithContext(ctx).Warnw("msg", "refresh after command failed", "vehicleID", veh.ID, "err", err)
+		}
+	}
+	return nil
+}
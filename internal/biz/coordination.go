@@ -0,0 +1,36 @@
+package biz
+
+import (
+	"context"
+	"time"
+)
+
+// RateLimiter limits events shared by the instances of the service, e.g., the forced
+// refreshes of a vehicle. It is backed by Redis when configured and by memory otherwise.
+type RateLimiter interface {
+	// Allow counts an event of key and reports whether it is allowed: at most limit events
+	// in a window starting at the first event counted.
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error)
+}
+
+// Locker acquires locks shared by the instances of the service, e.g., so that a single
+// instance runs a background job. It is backed by Redis when configured and by memory otherwise.
+type Locker interface {
+	// TryLock acquires the lock of key for at most ttl, reporting false if it is held.
+	TryLock(ctx context.Context, key string, ttl time.Duration) (lock Lock, ok bool, err error)
+}
+
+// Lock is a lock acquired from a Locker, held until it expires or is released.
+type Lock interface {
+	// Extend makes the lock expire ttl from now, reporting false if it expired meanwhile.
+	Extend(ctx context.Context, ttl time.Duration) (bool, error)
+	// Unlock releases the lock unless it expired and was acquired again since.
+	Unlock()
+}
+
+// Transaction runs changes of several repositories atomically.
+type Transaction interface {
+	// InTx runs fn in a database transaction, committed when fn returns nil and rolled back otherwise.
+	// The repositories called with the context passed to fn take part in the transaction.
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
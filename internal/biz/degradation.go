@@ -0,0 +1,218 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"teslatrack/pkg/stats"
+	"time"
+)
+
+const (
+	// minDegradationSoC is the least state of charge in percent a charging session must reach
+	// to be sampled. Projecting from a low state of charge magnifies its 1% rounding.
+	minDegradationSoC = 50
+	// minDegradationTemp is the lowest outside temperature in Celsius a sample is taken at.
+	// A cold pack holds back energy, which lowers the reported range without any degradation.
+	minDegradationTemp = 5.0
+	// degradationWindow is how far before the end of a charging session samples are searched.
+	degradationWindow = 15 * time.Minute
+	// referencePoints is the number of early months the reference range is taken from.
+	referencePoints = 3
+	// degradationDistance is the distance in km the degradation rate is expressed for.
+	degradationDistance = 10000.0
+)
+
+// RangeSample is the full range projected from a single snapshot taken at the end of a charging session.
+type RangeSample struct {
+	// Time is the time the snapshot was taken.
+	Time time.Time
+	// Odometer is the odometer in km.
+	Odometer float64
+	// BatteryLevel is the state of charge the range was projected from in percent.
+	BatteryLevel int
+	// RatedRange is the rated range projected to 100% in km.
+	RatedRange float64
+	// IdealRange is the ideal range projected to 100% in km.
+	IdealRange float64
+	// EstRange is the estimated range projected to 100% in km.
+	EstRange float64
+	// Capacity is the usable capacity estimated from the charging session in kWh, 0 when unknown.
+	Capacity float64
+}
+
+// NewRangeSample projects the ranges of a snapshot to 100%.
+// Nil is returned for snapshots distorted by a cold pack or a running battery heater.
+func NewRangeSample(s *VehicleSnapshot) *RangeSample {
+	if !s.HasData() || s.BatteryLevel < minDegradationSoC || s.BatteryRange <= 0 {
+		return nil
+	}
+	if s.BatteryHeaterOn || s.OutsideTemp < minDegradationTemp || s.UsableBatteryLevel < s.BatteryLevel-1 {
+		return nil
+	}
+	scale := 100 / float64(s.BatteryLevel)
+	return &RangeSample{
+		Time:         s.CreatedAt,
+		Odometer:     s.Odometer,
+		BatteryLevel: s.BatteryLevel,
+		RatedRange:   s.BatteryRange * scale,
+		IdealRange:   s.IdealBatteryRange * scale,
+		EstRange:     s.EstBatteryRange * scale,
+	}
+}
+
+// DegradationPoint is the median projected range of a vehicle in one month.
+type DegradationPoint struct {
+	// Month is the start of the month.
+	Month time.Time
+	// Samples is the number of samples in the month.
+	Samples int
+	// Odometer is the highest odometer of the samples in km.
+	Odometer float64
+	// RatedRange is the median rated range projected to 100% in km.
+	RatedRange float64
+	// IdealRange is the median ideal range projected to 100% in km.
+	IdealRange float64
+	// EstRange is the median estimated range projected to 100% in km.
+	EstRange float64
+	// Capacity is the median usable capacity in kWh, 0 when unknown.
+	Capacity float64
+	// Degradation is the loss of rated range against the reference in percent.
+	Degradation float64
+}
+
+// DegradationReport is the battery degradation curve of a vehicle.
+type DegradationReport struct {
+	// Samples are the filtered samples, oldest first.
+	Samples []*RangeSample
+	// Points are the monthly medians, oldest first.
+	Points []*DegradationPoint
+	// ReferenceRange is the rated range the degradation is measured against in km,
+	// the highest monthly median of the first months.
+	ReferenceRange float64
+	// CurrentRange is the rated range of the latest month in km.
+	CurrentRange float64
+	// Degradation is the loss of the latest month against the reference in percent.
+	Degradation float64
+	// DegradationPer10000Km is the fitted loss of rated range per 10,000 km in percent,
+	// nil when the samples span too little mileage.
+	DegradationPer10000Km *float64
+}
+
+// Degradation estimates the battery degradation of a vehicle of the user from the
+// charging sessions finished in [from, to). Months start in the given location.
+func (uc *AnalyticsUsecase) Degradation(ctx context.Context, userID, vehicleID int, from, to time.Time, loc *time.Location) (*DegradationReport, error) {
+	if _, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, vehicleID); err != nil {
+		return nil, err
+	}
+	periods, err := uc.periodRepo.ListByVehicle(ctx, vehicleID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	var samples []*RangeSample
+	for _, p := range periods {
+		if p.State != VehicleStateCharging || p.EndAt == nil || p.EndBatteryLevel < minDegradationSoC {
+			continue
+		}
+		sample, err := uc.rangeSample(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		if sample != nil {
+			samples = append(samples, sample)
+		}
+	}
+	return AnalyseDegradation(samples, loc), nil
+}
+
+// rangeSample samples the last usable snapshot of a charging session, nil when there is none.
+func (uc *AnalyticsUsecase) rangeSample(ctx context.Context, p *VehicleStatePeriod) (*RangeSample, error) {
+	snapshots, err := uc.snapshotRepo.ListByVehicle(ctx, p.VehicleID, p.EndAt.Add(-degradationWindow), p.EndAt.Add(time.Second))
+	if err != nil {
+		return nil, err
+	}
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		sample := NewRangeSample(snapshots[i])
+		if sample == nil {
+			continue
+		}
+		if gained := p.EndBatteryLevel - p.StartBatteryLevel; gained >= minCapacitySoC && p.EnergyAdded > 0 {
+			sample.Capacity = p.EnergyAdded * 100 / float64(gained)
+		}
+		return sample, nil
+	}
+	return nil, nil
+}
+
+// AnalyseDegradation groups the samples by month and measures the loss of range
+// against the early months and against the odometer.
+func AnalyseDegradation(samples []*RangeSample, loc *time.Location) *DegradationReport {
+	sort.Slice(samples, func(i, j int) bool { return samples[i].Time.Before(samples[j].Time) })
+	report := &DegradationReport{Samples: samples}
+	var (
+		group      []*RangeSample
+		odometers  []float64
+		ratedRange []float64
+	)
+	flush := func() {
+		if len(group) > 0 {
+			report.Points = append(report.Points, newDegradationPoint(group, loc))
+			group = nil
+		}
+	}
+	for _, s := range samples {
+		if len(group) > 0 && !sameMonth(group[0].Time, s.Time, loc) {
+			flush()
+		}
+		group = append(group, s)
+		odometers = append(odometers, s.Odometer)
+		ratedRange = append(ratedRange, s.RatedRange)
+	}
+	flush()
+	if len(report.Points) == 0 {
+		return report
+	}
+	for _, p := range report.Points[:min(referencePoints, len(report.Points))] {
+		report.ReferenceRange = max(report.ReferenceRange, p.RatedRange)
+	}
+	for _, p := range report.Points {
+		p.Degradation = (1 - p.RatedRange/report.ReferenceRange) * 100
+	}
+	latest := report.Points[len(report.Points)-1]
+	report.CurrentRange = latest.RatedRange
+	report.Degradation = latest.Degradation
+	if odometers[len(odometers)-1]-odometers[0] >= degradationDistance/10 {
+		if slope, _, ok := stats.LinearFit(odometers, ratedRange); ok {
+			rate := -slope * degradationDistance / report.ReferenceRange * 100
+			report.DegradationPer10000Km = &rate
+		}
+	}
+	return report
+}
+
+// newDegradationPoint aggregates the samples of one month.
+func newDegradationPoint(samples []*RangeSample, loc *time.Location) *DegradationPoint {
+	var rated, ideal, est, capacity []float64
+	p := &DegradationPoint{Samples: len(samples)}
+	for _, s := range samples {
+		rated = append(rated, s.RatedRange)
+		ideal = append(ideal, s.IdealRange)
+		est = append(est, s.EstRange)
+		if s.Capacity > 0 {
+			capacity = append(capacity, s.Capacity)
+		}
+		p.Odometer = max(p.Odometer, s.Odometer)
+	}
+	t := samples[0].Time.In(loc)
+	p.Month = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+	p.RatedRange = stats.Median(rated)
+	p.IdealRange = stats.Median(ideal)
+	p.EstRange = stats.Median(est)
+	p.Capacity = stats.Median(capacity)
+	return p
+}
+
+// sameMonth reports whether two times fall into the same month in the location.
+func sameMonth(a, b time.Time, loc *time.Location) bool {
+	a, b = a.In(loc), b.In(loc)
+	return a.Year() == b.Year() && a.Month() == b.Month()
+}
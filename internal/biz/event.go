@@ -0,0 +1,296 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+const (
+	// eventHistorySize is the number of recent events an EventBus keeps for resuming subscribers.
+	eventHistorySize = 1024
+	// eventAppendTimeout bounds appending an event to the shared stream.
+	eventAppendTimeout = 5 * time.Second
+)
+
+// Internal event types published on the EventBus.
+const (
+	EventGeofenceEnter   = "geofence.enter"
+	EventGeofenceExit    = "geofence.exit"
+	EventTirePressureLow = "tire.pressure_low"
+	EventTireSlowLeak    = "tire.slow_leak"
+	EventVehicleSnapshot = "vehicle.snapshot"
+	EventVehicleState    = "vehicle.state"
+	EventDriveStart      = "drive.start"
+	EventDriveEnd        = "drive.end"
+	EventChargeStart     = "charge.start"
+	EventChargeEnd       = "charge.end"
+)
+
+// Event is an internal notification about a vehicle.
+type Event struct {
+	// ID increases monotonically for the lifetime of the bus, or of the shared stream
+	// when the events are shared by the instances of the service.
+	ID uint64
+	// Type is the event type, see the Event constants.
+	Type string
+	// UserID is the owner of the vehicle.
+	UserID int
+	// VehicleID is the vehicle the event is about.
+	VehicleID int
+	// Time is the time the event happened.
+	Time time.Time
+	// Payload carries the type specific data, e.g., *GeofenceEvent or *TireEvent.
+	Payload any
+	// Relayed indicates that another instance of the service published the event.
+	Relayed bool
+}
+
+// eventPayload returns a new payload of the type published with events of typ, nil if they have none.
+func eventPayload(typ string) any {
+	switch typ {
+	case EventVehicleSnapshot:
+		return &VehicleLatestState{}
+	case EventVehicleState:
+		return &VehicleStateChange{}
+	case EventDriveStart, EventDriveEnd, EventChargeStart, EventChargeEnd:
+		return &VehicleStatePeriod{}
+	case EventGeofenceEnter, EventGeofenceExit:
+		return &GeofenceEvent{}
+	case EventTirePressureLow, EventTireSlowLeak:
+		return &TireEvent{}
+	}
+	return nil
+}
+
+// sharedEvent is an event encoded in the shared stream.
+type sharedEvent struct {
+	Instance  string          `json:"instance"`
+	Type      string          `json:"type"`
+	UserID    int             `json:"user_id"`
+	VehicleID int             `json:"vehicle_id"`
+	Time      time.Time       `json:"time"`
+	Payload   json.RawMessage `json:"payload,omitempty"`
+}
+
+// EventStream shares the events published by the instances of the service in a single order.
+// It is backed by a Redis stream when configured; without Redis there is a single instance
+// and the events are not shared.
+type EventStream interface {
+	// Shared reports whether the events are shared.
+	Shared() bool
+	// Append appends an encoded event, assigning it the next ID of the stream.
+	Append(ctx context.Context, event []byte) error
+	// Read calls deliver with every event appended from now on, in order, until ctx is done.
+	Read(ctx context.Context, deliver func(id uint64, event []byte)) error
+}
+
+// VehicleStateChange is the payload of vehicle state events.
+type VehicleStateChange struct {
+	// From is the state left, empty for the first period of a vehicle.
+	From string
+	// To is the state entered.
+	To string
+}
+
+// GeofenceEvent is the payload of geofence enter and exit events.
+type GeofenceEvent struct {
+	// Geofence is the geofence entered or left.
+	Geofence *Geofence
+	// Latitude is the WGS-84 latitude of the vehicle.
+	Latitude float64
+	// Longitude is the WGS-84 longitude of the vehicle.
+	Longitude float64
+}
+
+// Subscription receives the events of an EventBus.
+type Subscription struct {
+	// C delivers the events, it is closed when the subscription is closed.
+	C <-chan *Event
+
+	bus     *EventBus
+	ch      chan *Event
+	filter  func(*Event) bool
+	dropped atomic.Uint64
+}
+
+// Dropped returns the number of events dropped because the subscriber fell behind.
+func (s *Subscription) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Close unsubscribes and closes C.
+func (s *Subscription) Close() {
+	s.bus.unsubscribe(s)
+}
+
+// EventBus is a publish/subscribe bus for events. When the stream shares the events, they are
+// delivered once read back from the stream, so every instance delivers the events of all
+// instances with the same IDs; otherwise they are delivered in process.
+// Delivering never blocks: events for a subscriber whose buffer is full are dropped.
+// The most recent events are kept so that subscribers can resume after a disconnect.
+type EventBus struct {
+	stream   EventStream
+	instance string
+	log      *log.Helper
+
+	mu      sync.Mutex
+	subs    map[*Subscription]struct{}
+	lastID  uint64
+	history []*Event
+}
+
+// NewEventBus creates an EventBus.
+func NewEventBus(stream EventStream, logger log.Logger) *EventBus {
+	return &EventBus{
+		stream:   stream,
+		instance: uuid.NewString(),
+		log:      log.NewHelper(logger),
+		subs:     make(map[*Subscription]struct{}),
+	}
+}
+
+// Shared reports whether the events are shared by the instances of the service.
+func (b *EventBus) Shared() bool {
+	return b.stream.Shared()
+}
+
+// Subscribe receives the events accepted by filter, or all events when filter is nil.
+func (b *EventBus) Subscribe(buffer int, filter func(*Event) bool) *Subscription {
+	ch := make(chan *Event, buffer)
+	s := &Subscription{C: ch, bus: b, ch: ch, filter: filter}
+	b.mu.Lock()
+	b.subs[s] = struct{}{}
+	b.mu.Unlock()
+	return s
+}
+
+// SubscribeFrom is Subscribe resuming after the event lastID.
+// It returns the retained events after lastID accepted by filter, which precede the
+// events delivered on the subscription, and whether they are complete. They are not
+// when events after lastID were already discarded or lastID is unknown to the bus,
+// e.g., because it was issued before a restart.
+func (b *EventBus) SubscribeFrom(lastID uint64, buffer int, filter func(*Event) bool) (*Subscription, []*Event, bool) {
+	ch := make(chan *Event, buffer)
+	s := &Subscription{C: ch, bus: b, ch: ch, filter: filter}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subs[s] = struct{}{}
+	complete := lastID == b.lastID ||
+		lastID < b.lastID && len(b.history) > 0 && b.history[0].ID <= lastID+1
+	var replay []*Event
+	for _, e := range b.history {
+		if e.ID > lastID && (filter == nil || filter(e)) {
+			replay = append(replay, e)
+		}
+	}
+	return s, replay, complete
+}
+
+// Publish delivers the event to the subscribers with the next ID, through the stream
+// when the events are shared. An event that cannot be appended to the stream is dropped.
+func (b *EventBus) Publish(e *Event) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	if b.stream.Shared() {
+		if err := b.append(e); err != nil {
+			b.log.Errorw("msg", "share event failed", "type", e.Type, "vehicleID", e.VehicleID, "err", err)
+		}
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e.ID = b.lastID + 1
+	b.deliver(e)
+}
+
+// append encodes the event and appends it to the stream.
+func (b *EventBus) append(e *Event) error {
+	shared := sharedEvent{Instance: b.instance, Type: e.Type, UserID: e.UserID, VehicleID: e.VehicleID, Time: e.Time}
+	if e.Payload != nil {
+		payload, err := json.Marshal(e.Payload)
+		if err != nil {
+			return err
+		}
+		shared.Payload = payload
+	}
+	event, err := json.Marshal(shared)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), eventAppendTimeout)
+	defer cancel()
+	return b.stream.Append(ctx, event)
+}
+
+// Run delivers the events read from the stream until ctx is done. It returns at once
+// when the events are not shared.
+func (b *EventBus) Run(ctx context.Context) error {
+	if !b.stream.Shared() {
+		return nil
+	}
+	return b.stream.Read(ctx, func(id uint64, event []byte) {
+		var shared sharedEvent
+		if err := json.Unmarshal(event, &shared); err != nil {
+			b.log.Errorw("msg", "decode shared event failed", "id", id, "err", err)
+			return
+		}
+		e := &Event{
+			ID:        id,
+			Type:      shared.Type,
+			UserID:    shared.UserID,
+			VehicleID: shared.VehicleID,
+			Time:      shared.Time,
+			Relayed:   shared.Instance != b.instance,
+		}
+		if payload := eventPayload(shared.Type); payload != nil && shared.Payload != nil {
+			if err := json.Unmarshal(shared.Payload, payload); err != nil {
+				b.log.Errorw("msg", "decode shared event failed", "id", id, "type", shared.Type, "err", err)
+				return
+			}
+			e.Payload = payload
+		}
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.deliver(e)
+	})
+}
+
+// deliver keeps the event in the history and delivers it to the subscribers. The caller holds mu.
+func (b *EventBus) deliver(e *Event) {
+	if e.ID != b.lastID+1 {
+		// Events were missed, e.g., trimmed from the stream before they were read,
+		// so resuming from before them cannot be complete.
+		b.history = b.history[:0]
+	}
+	b.lastID = e.ID
+	if len(b.history) == eventHistorySize {
+		b.history = append(b.history[:0], b.history[1:]...)
+	}
+	b.history = append(b.history, e)
+	for s := range b.subs {
+		if s.filter != nil && !s.filter(e) {
+			continue
+		}
+		select {
+		case s.ch <- e:
+		default:
+			s.dropped.Add(1)
+		}
+	}
+}
+
+func (b *EventBus) unsubscribe(s *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subs[s]; ok {
+		delete(b.subs, s)
+		close(s.ch)
+	}
+}
@@ -0,0 +1,140 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// memoryStream is an EventStream shared by the buses of several instances in memory.
+type memoryStream struct {
+	events [][]byte
+}
+
+func (s *memoryStream) Shared() bool { return true }
+
+func (s *memoryStream) Append(_ context.Context, event []byte) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+// Read delivers the events appended so far and returns.
+func (s *memoryStream) Read(_ context.Context, deliver func(uint64, []byte)) error {
+	for i, event := range s.events {
+		deliver(uint64(i+1), event)
+	}
+	return nil
+}
+
+func TestEventBusShared(t *testing.T) {
+	stream := &memoryStream{}
+	publisher := NewEventBus(stream, log.DefaultLogger)
+	other := NewEventBus(stream, log.DefaultLogger)
+	at := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	local := publisher.Subscribe(4, nil)
+	relayed := other.Subscribe(4, nil)
+	publisher.Publish(&Event{Type: EventVehicleState, UserID: 1, VehicleID: 2, Time: at, Payload: &VehicleStateChange{From: VehicleStateAsleep, To: VehicleStateOnline}})
+	publisher.Publish(&Event{Type: EventTireSlowLeak, UserID: 1, VehicleID: 2, Time: at, Payload: &TireEvent{Pressure: 2.1, LeakRate: 0.05}})
+	if len(local.C) != 0 {
+		t.Fatal("a shared event was delivered before it was read from the stream")
+	}
+	for _, bus := range []*EventBus{publisher, other} {
+		if err := bus.Run(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cases := []struct {
+		name    string
+		sub     *Subscription
+		relayed bool
+	}{
+		{"publisher", local, false},
+		{"other instance", relayed, true},
+	}
+	for _, c := range cases {
+		if len(c.sub.C) != 2 {
+			t.Fatalf("%s: %d events delivered, want 2", c.name, len(c.sub.C))
+		}
+		e := <-c.sub.C
+		change, ok := e.Payload.(*VehicleStateChange)
+		if e.ID != 1 || e.Relayed != c.relayed || !e.Time.Equal(at) || !ok || change.To != VehicleStateOnline {
+			t.Errorf("%s: first event = %+v, want ID 1 online", c.name, e)
+		}
+		e = <-c.sub.C
+		if tire, ok := e.Payload.(*TireEvent); e.ID != 2 || !ok || tire.LeakRate != 0.05 {
+			t.Errorf("%s: second event = %+v, want ID 2 with the slow leak", c.name, e)
+		}
+	}
+}
+
+func TestEventBusSubscribeFrom(t *testing.T) {
+	bus := NewEventBus(localStream{}, log.DefaultLogger)
+	publish := func(n int) {
+		for i := 0; i < n; i++ {
+			bus.Publish(&Event{Type: EventVehicleState, VehicleID: 1 + i%2})
+		}
+	}
+	ids := func(events []*Event) []uint64 {
+		var ids []uint64
+		for _, e := range events {
+			ids = append(ids, e.ID)
+		}
+		return ids
+	}
+	publish(5)
+
+	cases := []struct {
+		name     string
+		lastID   uint64
+		filter   func(*Event) bool
+		replay   []uint64
+		complete bool
+	}{
+		{"resume", 3, nil, []uint64{4, 5}, true},
+		{"up to date", 5, nil, nil, true},
+		{"filtered", 2, func(e *Event) bool { return e.VehicleID == 1 }, []uint64{3, 5}, true},
+		{"unknown ID", 9, nil, nil, false},
+	}
+	for _, c := range cases {
+		sub, replay, complete := bus.SubscribeFrom(c.lastID, 1, c.filter)
+		if !slices.Equal(ids(replay), c.replay) || complete != c.complete {
+			t.Errorf("%s: replay %v complete %v, want %v complete %v", c.name, ids(replay), complete, c.replay, c.complete)
+		}
+		sub.Close()
+	}
+
+	// Live events follow the replay.
+	sub, _, _ := bus.SubscribeFrom(5, 1, nil)
+	publish(1)
+	if e := <-sub.C; e.ID != 6 {
+		t.Errorf("live event ID %d, want 6", e.ID)
+	}
+	sub.Close()
+
+	// Evicted events make resuming from before them incomplete.
+	publish(eventHistorySize)
+	first := uint64(7) // the history holds the last eventHistorySize of 6+eventHistorySize events
+	if _, replay, complete := bus.SubscribeFrom(first-2, 1, nil); complete || len(replay) != eventHistorySize {
+		t.Errorf("resume before the history: %d events complete %v, want %d incomplete", len(replay), complete, eventHistorySize)
+	}
+	if _, replay, complete := bus.SubscribeFrom(first-1, 1, nil); !complete || replay[0].ID != first {
+		t.Errorf("resume at the start of the history: complete %v, want complete from %d", complete, first)
+	}
+
+	// Events missing from the stream make resuming from before them incomplete.
+	bus.mu.Lock()
+	bus.deliver(&Event{ID: bus.lastID + 3, Type: EventVehicleState})
+	lastID := bus.lastID
+	bus.mu.Unlock()
+	if _, replay, complete := bus.SubscribeFrom(lastID-3, 1, nil); complete || len(replay) != 1 {
+		t.Errorf("resume across missed events: %d events complete %v, want 1 incomplete", len(replay), complete)
+	}
+	if _, _, complete := bus.SubscribeFrom(lastID-1, 1, nil); !complete {
+		t.Error("resume after the missed events is incomplete")
+	}
+}
@@ -0,0 +1,116 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// AddressPrecision is the number of decimals positions are rounded to when caching addresses.
+// Four decimals is a grid of about 11m, so repeated parking spots share a cache entry.
+const AddressPrecision = 4
+
+// Address is a reverse geocoded position.
+type Address struct {
+	// ID is the unique identifier of the cached address.
+	ID int
+	// GridLatitude is the WGS-84 latitude rounded to AddressPrecision decimals, scaled to an integer.
+	GridLatitude int
+	// GridLongitude is the WGS-84 longitude rounded to AddressPrecision decimals, scaled to an integer.
+	GridLongitude int
+	// Name is the name of the place, e.g., a point of interest or neighbourhood.
+	Name string
+	// Road is the road including the house number.
+	Road string
+	// District is the district or suburb.
+	District string
+	// City is the city, town or village.
+	City string
+	// Province is the province or state.
+	Province string
+	// Country is the country.
+	Country string
+	// Formatted is the full address.
+	Formatted string
+	// Provider is the geocoder the address was resolved by.
+	Provider string
+	// CreatedAt is the time the address was resolved.
+	CreatedAt time.Time
+}
+
+// gridKey rounds a position to the address cache grid.
+func gridKey(lat, lon float64) (int, int) {
+	scale := math.Pow10(AddressPrecision)
+	return int(math.Round(lat * scale)), int(math.Round(lon * scale))
+}
+
+// Geocoder resolves WGS-84 positions to addresses.
+// A nil address with a nil error means no place is known near the position.
+type Geocoder interface {
+	// Name returns the name of the provider, recorded with cached addresses.
+	Name() string
+	// Reverse looks up the address of a position.
+	Reverse(ctx context.Context, lat, lon float64) (*Address, error)
+}
+
+// AddressRepo defines the data access layer for the address cache.
+type AddressRepo interface {
+	// Get finds the cached address of a grid cell, returns nil if none exists.
+	Get(ctx context.Context, gridLat, gridLon int) (*Address, error)
+	// Create saves a resolved address.
+	Create(ctx context.Context, address *Address) error
+}
+
+// GeocodeUsecase resolves addresses through the cache and the configured geocoder.
+type GeocodeUsecase struct {
+	geocoder Geocoder
+	repo     AddressRepo
+	log      *log.Helper
+}
+
+// NewGeocodeUsecase creates a Geocode usecase.
+// The geocoder may be nil, in which case only cached addresses are returned.
+func NewGeocodeUsecase(geocoder Geocoder, repo AddressRepo, logger log.Logger) *GeocodeUsecase {
+	return &GeocodeUsecase{geocoder: geocoder, repo: repo, log: log.NewHelper(logger)}
+}
+
+// Reverse returns the address of a WGS-84 position, returns nil if it cannot be resolved.
+// Resolved addresses are cached by their rounded position.
+func (uc *GeocodeUsecase) Reverse(ctx context.Context, lat, lon float64) (*Address, error) {
+	if lat == 0 && lon == 0 {
+		return nil, nil
+	}
+	gridLat, gridLon := gridKey(lat, lon)
+	address, err := uc.repo.Get(ctx, gridLat, gridLon)
+	if err != nil || address != nil || uc.geocoder == nil {
+		return address, err
+	}
+
+	address, err = uc.geocoder.Reverse(ctx, lat, lon)
+	if err != nil || address == nil {
+		return nil, err
+	}
+	address.GridLatitude, address.GridLongitude = gridLat, gridLon
+	address.Provider = uc.geocoder.Name()
+	if err := uc.repo.Create(ctx, address); err != nil {
+		// A concurrent lookup may have cached the same cell, the address is still usable.
+		uc.log.WithContext(ctx).Warnw("msg", "cache address failed", "lat", gridLat, "lon", gridLon, "err", err)
+	}
+	return address, nil
+}
+
+// Lookup returns the formatted address of a position for display.
+// Failures are logged and yield an empty string, as an address is never essential.
+func (uc *GeocodeUsecase) Lookup(ctx context.Context, lat, lon float64) string {
+	address, err := uc.Reverse(ctx, lat, lon)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorw("msg", "reverse geocode failed", "lat", lat, "lon", lon, "err", err)
+		return ""
+	}
+	if address == nil {
+		return ""
+	}
+	return address.Formatted
+}
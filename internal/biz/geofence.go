@@ -0,0 +1,290 @@
+package biz
+
+import (
+	"context"
+	"math"
+	"sort"
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/pkg/geo"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Geofence shapes.
+const (
+	GeofenceShapeCircle  = "circle"
+	GeofenceShapePolygon = "polygon"
+)
+
+// Geofence categories, used to classify charging sessions.
+const (
+	GeofenceCategoryHome    = "home"
+	GeofenceCategoryWork    = "work"
+	GeofenceCategoryCharger = "charger"
+	GeofenceCategoryOther   = "other"
+)
+
+// Charging locations derived from the geofence a charging session took place in.
+const (
+	ChargeLocationHome   = "home"
+	ChargeLocationWork   = "work"
+	ChargeLocationPublic = "public"
+)
+
+var (
+	// ErrGeofenceNotFound is returned for unknown geofences and geofences of other users.
+	ErrGeofenceNotFound = v1.ErrorGeofenceNotFound("geofence not found")
+	// ErrGeofenceInvalid is returned for geofences without a usable shape.
+	ErrGeofenceInvalid = v1.ErrorGeofenceInvalid("a circle needs a center and a positive radius, a polygon at least 3 points, all within latitude ±90 and longitude ±180")
+)
+
+// Geofence is a named area defined by a user. Positions are WGS-84.
+type Geofence struct {
+	// ID is the unique identifier of the geofence.
+	ID int
+	// UserID is the owner of the geofence.
+	UserID int
+	// Name is the display name, e.g., Home.
+	Name string
+	// Category is the kind of place, see the GeofenceCategory constants.
+	Category string
+	// Shape is either circle or polygon.
+	Shape string
+	// Latitude is the latitude of the circle center.
+	Latitude float64
+	// Longitude is the longitude of the circle center.
+	Longitude float64
+	// Radius is the circle radius in metres.
+	Radius float64
+	// Polygon is the list of polygon vertices.
+	Polygon []geo.Point
+	// CreatedAt is the creation time.
+	CreatedAt time.Time
+	// UpdatedAt is the last update time.
+	UpdatedAt time.Time
+}
+
+// Valid reports whether the geofence has a usable shape.
+func (g *Geofence) Valid() bool {
+	switch g.Shape {
+	case GeofenceShapeCircle:
+		return g.Radius > 0 && validPosition(g.Latitude, g.Longitude)
+	case GeofenceShapePolygon:
+		if len(g.Polygon) < 3 {
+			return false
+		}
+		for _, p := range g.Polygon {
+			if !validPosition(p.Lat, p.Lon) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// validPosition reports whether a position is set and within the coordinate ranges.
+// 0,0 is what a missing position decodes to, no vehicle parks in the Gulf of Guinea.
+func validPosition(lat, lon float64) bool {
+	return (lat != 0 || lon != 0) && lat >= -90 && lat <= 90 && lon >= -180 && lon <= 180
+}
+
+// Contains reports whether a WGS-84 position lies within the geofence.
+func (g *Geofence) Contains(lat, lon float64) bool {
+	switch g.Shape {
+	case GeofenceShapeCircle:
+		return geo.Distance(g.Latitude, g.Longitude, lat, lon) <= g.Radius
+	case GeofenceShapePolygon:
+		return geo.InPolygon(lat, lon, g.Polygon)
+	}
+	return false
+}
+
+// Area returns the area of the geofence in square metres.
+func (g *Geofence) Area() float64 {
+	if g.Shape == GeofenceShapeCircle {
+		return math.Pi * g.Radius * g.Radius
+	}
+	return geo.PolygonArea(g.Polygon)
+}
+
+// ChargeLocation classifies a charging session in the geofence.
+// A nil geofence or any category other than home and work counts as public.
+func ChargeLocation(g *Geofence) string {
+	if g != nil {
+		switch g.Category {
+		case GeofenceCategoryHome:
+			return ChargeLocationHome
+		case GeofenceCategoryWork:
+			return ChargeLocationWork
+		}
+	}
+	return ChargeLocationPublic
+}
+
+// Geofences is the set of geofences a position lies in.
+type Geofences []*Geofence
+
+// Best returns the most specific geofence, i.e. the smallest one, or nil if empty.
+func (gs Geofences) Best() *Geofence {
+	var best *Geofence
+	for _, g := range gs {
+		if best == nil || g.Area() < best.Area() {
+			best = g
+		}
+	}
+	return best
+}
+
+// BestID returns the ID of the most specific geofence, or nil if empty.
+func (gs Geofences) BestID() *int {
+	if best := gs.Best(); best != nil {
+		return &best.ID
+	}
+	return nil
+}
+
+// has reports whether the set contains the geofence.
+func (gs Geofences) has(id int) bool {
+	for _, g := range gs {
+		if g.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// GeofenceRepo defines the data access layer for Geofence.
+type GeofenceRepo interface {
+	// Create saves a new geofence.
+	Create(ctx context.Context, g *Geofence) error
+	// Update saves an existing geofence.
+	Update(ctx context.Context, g *Geofence) error
+	// Delete removes a geofence.
+	Delete(ctx context.Context, id int) error
+	// FindOne finds a geofence by its ID, returns nil if none exists.
+	FindOne(ctx context.Context, id int) (*Geofence, error)
+	// ListByUser lists the geofences of a user ordered by ID.
+	ListByUser(ctx context.Context, userID int) ([]*Geofence, error)
+}
+
+// GeofenceUsecase manages geofences and evaluates positions against them.
+type GeofenceUsecase struct {
+	repo GeofenceRepo
+	bus  *EventBus
+	log  *log.Helper
+}
+
+// NewGeofenceUsecase creates a Geofence usecase.
+func NewGeofenceUsecase(repo GeofenceRepo, bus *EventBus, logger log.Logger) *GeofenceUsecase {
+	return &GeofenceUsecase{repo: repo, bus: bus, log: log.NewHelper(logger)}
+}
+
+// Create adds a geofence for its user.
+func (uc *GeofenceUsecase) Create(ctx context.Context, g *Geofence) error {
+	if !g.Valid() {
+		return ErrGeofenceInvalid
+	}
+	if g.Category == "" {
+		g.Category = GeofenceCategoryOther
+	}
+	return uc.repo.Create(ctx, g)
+}
+
+// Update changes a geofence of the user.
+func (uc *GeofenceUsecase) Update(ctx context.Context, userID int, g *Geofence) error {
+	if _, err := uc.Get(ctx, userID, g.ID); err != nil {
+		return err
+	}
+	if !g.Valid() {
+		return ErrGeofenceInvalid
+	}
+	g.UserID = userID
+	if g.Category == "" {
+		g.Category = GeofenceCategoryOther
+	}
+	return uc.repo.Update(ctx, g)
+}
+
+// Delete removes a geofence of the user.
+func (uc *GeofenceUsecase) Delete(ctx context.Context, userID, id int) error {
+	if _, err := uc.Get(ctx, userID, id); err != nil {
+		return err
+	}
+	return uc.repo.Delete(ctx, id)
+}
+
+// Get finds a geofence of the user.
+func (uc *GeofenceUsecase) Get(ctx context.Context, userID, id int) (*Geofence, error) {
+	g, err := uc.repo.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil || g.UserID != userID {
+		return nil, ErrGeofenceNotFound
+	}
+	return g, nil
+}
+
+// List lists the geofences of the user.
+func (uc *GeofenceUsecase) List(ctx context.Context, userID int) ([]*Geofence, error) {
+	return uc.repo.ListByUser(ctx, userID)
+}
+
+// Match returns the geofences of the user containing a position.
+func (uc *GeofenceUsecase) Match(ctx context.Context, userID int, lat, lon float64) (Geofences, error) {
+	all, err := uc.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return matchGeofences(all, lat, lon), nil
+}
+
+// Transition evaluates a vehicle moving between two positions, publishes an event for every
+// geofence entered or left and returns the geofences containing the new position.
+func (uc *GeofenceUsecase) Transition(ctx context.Context, userID, vehicleID int, from, to geo.Point, at time.Time) (Geofences, error) {
+	all, err := uc.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	before := matchGeofences(all, from.Lat, from.Lon)
+	after := matchGeofences(all, to.Lat, to.Lon)
+	for _, g := range after {
+		if !before.has(g.ID) {
+			uc.publish(EventGeofenceEnter, userID, vehicleID, g, to, at)
+		}
+	}
+	for _, g := range before {
+		if !after.has(g.ID) {
+			uc.publish(EventGeofenceExit, userID, vehicleID, g, to, at)
+		}
+	}
+	return after, nil
+}
+
+func (uc *GeofenceUsecase) publish(typ string, userID, vehicleID int, g *Geofence, at geo.Point, t time.Time) {
+	uc.log.Infow("msg", typ, "vehicleID", vehicleID, "geofenceID", g.ID, "name", g.Name)
+	uc.bus.Publish(&Event{
+		Type:      typ,
+		UserID:    userID,
+		VehicleID: vehicleID,
+		Time:      t,
+		Payload:   &GeofenceEvent{Geofence: g, Latitude: at.Lat, Longitude: at.Lon},
+	})
+}
+
+// matchGeofences returns the geofences containing a position, smallest first.
+func matchGeofences(all []*Geofence, lat, lon float64) Geofences {
+	if lat == 0 && lon == 0 {
+		return nil
+	}
+	var matched Geofences
+	for _, g := range all {
+		if g.Contains(lat, lon) {
+			matched = append(matched, g)
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool { return matched[i].Area() < matched[j].Area() })
+	return matched
+}
@@ -0,0 +1,32 @@
+package biz
+
+import (
+	"math"
+	"teslatrack/pkg/geo"
+	"testing"
+)
+
+func TestGeofenceValid(t *testing.T) {
+	square := []geo.Point{{Lat: 31.2, Lon: 121.4}, {Lat: 31.2, Lon: 121.5}, {Lat: 31.3, Lon: 121.5}, {Lat: 31.3, Lon: 121.4}}
+	cases := []struct {
+		name string
+		g    Geofence
+		want bool
+	}{
+		{"circle", Geofence{Shape: GeofenceShapeCircle, Latitude: 31.23, Longitude: 121.47, Radius: 100}, true},
+		{"circle without radius", Geofence{Shape: GeofenceShapeCircle, Latitude: 31.23, Longitude: 121.47}, false},
+		{"circle without center", Geofence{Shape: GeofenceShapeCircle, Radius: 100}, false},
+		{"circle latitude out of range", Geofence{Shape: GeofenceShapeCircle, Latitude: 91, Longitude: 121.47, Radius: 100}, false},
+		{"circle longitude out of range", Geofence{Shape: GeofenceShapeCircle, Latitude: 31.23, Longitude: -181, Radius: 100}, false},
+		{"circle NaN center", Geofence{Shape: GeofenceShapeCircle, Latitude: math.NaN(), Longitude: 121.47, Radius: 100}, false},
+		{"polygon", Geofence{Shape: GeofenceShapePolygon, Polygon: square}, true},
+		{"polygon of two points", Geofence{Shape: GeofenceShapePolygon, Polygon: square[:2]}, false},
+		{"polygon vertex out of range", Geofence{Shape: GeofenceShapePolygon, Polygon: append(square[:3:3], geo.Point{Lat: 31.3, Lon: 200})}, false},
+		{"unknown shape", Geofence{Shape: "square", Latitude: 31.23, Longitude: 121.47, Radius: 100}, false},
+	}
+	for _, c := range cases {
+		if got := c.g.Valid(); got != c.want {
+			t.Errorf("%s: Valid() = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package biz
+
+import (
+	"context"
+	"teslatrack/internal/conf"
+	"teslatrack/pkg/tesla"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// defaultTeslaFailing is how long the Fleet API may fail without a success unless configured.
+const defaultTeslaFailing = 30 * time.Minute
+
+// Health check statuses.
+const (
+	// HealthOK is a passing check.
+	HealthOK = "ok"
+	// HealthWarn is a degraded dependency the service still works without.
+	HealthWarn = "warn"
+	// HealthFail is a failing check; the service is not ready.
+	HealthFail = "fail"
+	// HealthSkip is a check of a dependency that is not configured or not used yet.
+	HealthSkip = "skip"
+)
+
+// HealthCheck is the result of a health check of a dependency.
+type HealthCheck struct {
+	// Name is the dependency checked, e.g., database.
+	Name string
+	// Status is one of the Health statuses.
+	Status string
+	// Message explains the status; it may carry internal details such as addresses.
+	Message string
+}
+
+// AccountHealth is the status of the Tesla token of an account.
+type AccountHealth struct {
+	// TokenID is the ID of the authorize token.
+	TokenID int64
+	// UserID is the owner of the account.
+	UserID int
+	// Scope is the scope granted to the token.
+	Scope string
+	// CreatedAt is the time the account was authorized.
+	CreatedAt time.Time
+	// UpdatedAt is the time the token was last updated.
+	UpdatedAt time.Time
+	// ExpiresAt is the expiry of the access token, zero if unknown.
+	ExpiresAt time.Time
+	// Expired reports whether the access token has expired.
+	Expired bool
+	// HasRefreshToken reports whether the token can be refreshed.
+	HasRefreshToken bool
+	// Status is the status of the vehicle list requests of the account since startup.
+	Status AccountStatus
+}
+
+// HealthRepo checks the connectivity of the data stores.
+type HealthRepo interface {
+	// PingDatabase checks the database connection.
+	PingDatabase(ctx context.Context) error
+	// PingRedis checks the Redis connection, reporting false if Redis is not configured.
+	PingRedis(ctx context.Context) (bool, error)
+}
+
+// HealthUsecase checks the dependencies of the service.
+type HealthUsecase struct {
+	repo         HealthRepo
+	partnerRepo  PartnerRepo
+	tokenRepo    AuthorizeTokenRepo
+	collector    *CollectorUsecase
+	conf         *conf.Server
+	teslaFailing time.Duration
+	log          *log.Helper
+}
+
+// NewHealthUsecase creates a Health usecase.
+func NewHealthUsecase(
+	repo HealthRepo,
+	partnerRepo PartnerRepo,
+	tokenRepo AuthorizeTokenRepo,
+	collector *CollectorUsecase,
+	c *conf.Server,
+	logger log.Logger,
+) *HealthUsecase {
+	uc := &HealthUsecase{
+		repo:         repo,
+		partnerRepo:  partnerRepo,
+		tokenRepo:    tokenRepo,
+		collector:    collector,
+		conf:         c,
+		teslaFailing: defaultTeslaFailing,
+		log:          log.NewHelper(logger),
+	}
+	if d := c.GetHealth().GetTeslaFailing(); d != nil {
+		uc.teslaFailing = d.AsDuration()
+	}
+	return uc
+}
+
+// Check checks the database, Redis, the partner token and the Fleet API.
+func (uc *HealthUsecase) Check(ctx context.Context) []*HealthCheck {
+	return []*HealthCheck{
+		uc.checkDatabase(ctx),
+		uc.checkRedis(ctx),
+		uc.checkPartner(ctx),
+		uc.checkTesla(),
+	}
+}
+
+func (uc *HealthUsecase) checkDatabase(ctx context.Context) *HealthCheck {
+	if err := uc.repo.PingDatabase(ctx); err != nil {
+		return &HealthCheck{Name: "database", Status: HealthFail, Message: err.Error()}
+	}
+	return &HealthCheck{Name: "database", Status: HealthOK}
+}
+
+func (uc *HealthUsecase) checkRedis(ctx context.Context) *HealthCheck {
+	configured, err := uc.repo.PingRedis(ctx)
+	switch {
+	case !configured:
+		return &HealthCheck{Name: "redis", Status: HealthSkip, Message: "not configured"}
+	case err != nil:
+		return &HealthCheck{Name: "redis", Status: HealthFail, Message: err.Error()}
+	}
+	return &HealthCheck{Name: "redis", Status: HealthOK}
+}
+
+// checkPartner checks the partner token. The token is only used to register the partner
+// at startup, so an expired one degrades the service rather than failing it.
+func (uc *HealthUsecase) checkPartner(ctx context.Context) *HealthCheck {
+	partner, err := uc.partnerRepo.MustGet(ctx, uc.conf.GetTesla().GetClientId())
+	switch {
+	case err != nil:
+		return &HealthCheck{Name: "partner", Status: HealthFail, Message: err.Error()}
+	case partner == nil:
+		return &HealthCheck{Name: "partner", Status: HealthFail, Message: "partner not registered"}
+	}
+	expiresAt := partner.ExpiresAt()
+	if expiresAt.Before(time.Now()) {
+		return &HealthCheck{Name: "partner", Status: HealthWarn, Message: "token expired at " + expiresAt.Format(time.RFC3339)}
+	}
+	return &HealthCheck{Name: "partner", Status: HealthOK, Message: "token expires at " + expiresAt.Format(time.RFC3339)}
+}
+
+// checkTesla fails when the Fleet API has been failing without a success for too long.
+// An idle service, whose last request succeeded, passes however long ago that was.
+func (uc *HealthUsecase) checkTesla() *HealthCheck {
+	success, failing := tesla.LastSuccess(), tesla.FailingSince()
+	switch {
+	case success.IsZero() && failing.IsZero():
+		return &HealthCheck{Name: "tesla", Status: HealthSkip, Message: "no request yet"}
+	case failing.IsZero():
+		return &HealthCheck{Name: "tesla", Status: HealthOK, Message: "last success at " + success.Format(time.RFC3339)}
+	case time.Since(failing) > uc.teslaFailing:
+		return &HealthCheck{Name: "tesla", Status: HealthFail, Message: "failing since " + failing.Format(time.RFC3339)}
+	}
+	return &HealthCheck{Name: "tesla", Status: HealthWarn, Message: "failing since " + failing.Format(time.RFC3339)}
+}
+
+// Accounts returns the token status of every active account.
+func (uc *HealthUsecase) Accounts(ctx context.Context) ([]*AccountHealth, error) {
+	tokens, err := uc.tokenRepo.ListActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]*AccountHealth, 0, len(tokens))
+	for _, token := range tokens {
+		account := &AccountHealth{
+			TokenID:         token.ID,
+			UserID:          token.UserID,
+			Scope:           token.Scope,
+			CreatedAt:       token.CreatedAt,
+			UpdatedAt:       token.UpdatedAt,
+			HasRefreshToken: token.RefreshToken != "",
+			Status:          uc.collector.AccountStatus(token.ID),
+		}
+		if expiresAt, err := tesla.TokenExpiry(token.AccessToken); err == nil {
+			account.ExpiresAt = expiresAt
+			account.Expired = expiresAt.Before(time.Now())
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
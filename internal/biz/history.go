@@ -0,0 +1,594 @@
+package biz
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/pkg/downsample"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Sort orders of the drive and charging session lists.
+const (
+	// SortNewest lists the most recent records first.
+	SortNewest = "newest"
+	// SortOldest lists the earliest records first.
+	SortOldest = "oldest"
+	// SortDistance lists the longest records first.
+	SortDistance = "distance"
+	// SortEnergy lists the records that used or added the most energy first.
+	SortEnergy = "energy"
+)
+
+// Limits of the history lists and details.
+const (
+	defaultHistoryLimit = 50
+	maxHistoryLimit     = 200
+	defaultMaxPoints    = 500
+	maxMaxPoints        = 5000
+	maxTagLength        = 32
+	maxTags             = 20
+)
+
+var (
+	// ErrHistorySortInvalid is returned for an unknown sort order.
+	ErrHistorySortInvalid = v1.ErrorHistorySortInvalid("sort must be newest, oldest, distance or energy")
+	// ErrHistoryCursorInvalid is returned for a cursor not issued for the same sort order.
+	ErrHistoryCursorInvalid = v1.ErrorHistoryCursorInvalid("the cursor is invalid")
+	// ErrTagInvalid is returned for tags that are too long or too many.
+	ErrTagInvalid = v1.ErrorTagInvalid("at most 20 tags of at most 32 characters are allowed")
+	// ErrMergeInvalid is returned for records that cannot be merged.
+	ErrMergeInvalid = v1.ErrorMergeInvalid("at least two finished records of the same vehicle, separated only by parked time, are needed")
+	// ErrSplitInvalid is returned for a split time without samples on both sides.
+	ErrSplitInvalid = v1.ErrorSplitInvalid("the split time must fall between two samples of a finished record")
+)
+
+// PeriodCursor is the position of the last period of a page in the order of the list.
+type PeriodCursor struct {
+	// StartAt is the start of the period, the key of the newest and oldest orders.
+	StartAt time.Time
+	// Value is the distance or energy of the period, the key of the other orders.
+	Value float64
+	// ID breaks ties between periods with the same key.
+	ID int
+}
+
+// PeriodFilter selects the periods of a history list.
+type PeriodFilter struct {
+	// VehicleIDs are the vehicles listed.
+	VehicleIDs []int
+	// State is the state of the periods, e.g., driving or charging.
+	State string
+	// From is the earliest start time listed, zero for no bound.
+	From time.Time
+	// To is the start time before which periods are listed, zero for no bound.
+	To time.Time
+	// GeofenceIDs lists the periods starting or ending in one of the geofences, nil for any.
+	GeofenceIDs []int
+	// Tag lists the periods carrying the tag, empty for any.
+	Tag string
+	// MinDistance is the least distance in km.
+	MinDistance float64
+	// MinEnergy is the least energy used while driving or added while charging in kWh.
+	MinEnergy float64
+	// Sort is the sort order, see the Sort constants.
+	Sort string
+	// After lists the periods following the cursor, nil for the first page.
+	After *PeriodCursor
+	// Limit is the maximum number of periods listed.
+	Limit int
+}
+
+// HistoryQuery is a request for a page of drives or charging sessions.
+type HistoryQuery struct {
+	// VehicleID is the vehicle listed, 0 for all vehicles of the user.
+	VehicleID int
+	// From is the earliest start time listed, zero for no bound.
+	From time.Time
+	// To is the start time before which records are listed, zero for no bound.
+	To time.Time
+	// GeofenceID lists the records starting or ending in the geofence, 0 for any.
+	GeofenceID int
+	// GeofenceCategory lists the records starting or ending in a geofence of the category, empty for any.
+	GeofenceCategory string
+	// Tag lists the records carrying the tag, empty for any.
+	Tag string
+	// MinDistance is the least distance in km.
+	MinDistance float64
+	// MinEnergy is the least energy used or added in kWh.
+	MinEnergy float64
+	// Sort is the sort order, defaults to newest.
+	Sort string
+	// Cursor is the NextCursor of the previous page, empty for the first page.
+	Cursor string
+	// Limit is the page size, defaults to 50 and is capped at 200.
+	Limit int
+}
+
+// PeriodList is one page of drives or charging sessions.
+type PeriodList struct {
+	// Periods are the records in the requested order.
+	Periods []*VehicleStatePeriod
+	// NextCursor continues the list, empty on the last page.
+	NextCursor string
+}
+
+// Distance returns the distance covered during the period in km.
+func (p *VehicleStatePeriod) Distance() float64 {
+	return max(p.EndOdometer-p.StartOdometer, 0)
+}
+
+// Energy returns the energy used while driving or added while charging in kWh.
+func (p *VehicleStatePeriod) Energy() float64 {
+	if p.State == VehicleStateCharging {
+		return p.EnergyAdded
+	}
+	return p.EnergyUsed
+}
+
+// encodePeriodCursor encodes the position of a period in a sort order as an opaque token.
+func encodePeriodCursor(sort string, p *VehicleStatePeriod) string {
+	value := 0.0
+	switch sort {
+	case SortDistance:
+		value = p.EndOdometer - p.StartOdometer
+	case SortEnergy:
+		value = p.Energy()
+	}
+	raw := fmt.Sprintf("%s|%d|%s|%d", sort, p.StartAt.UnixNano(), strconv.FormatFloat(value, 'g', -1, 64), p.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// parsePeriodCursor decodes a token issued by encodePeriodCursor for the same sort order.
+func parsePeriodCursor(sort, token string) (*PeriodCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrHistoryCursorInvalid
+	}
+	parts := strings.Split(string(raw), "|")
+	if len(parts) != 4 || parts[0] != sort {
+		return nil, ErrHistoryCursorInvalid
+	}
+	nanos, err1 := strconv.ParseInt(parts[1], 10, 64)
+	value, err2 := strconv.ParseFloat(parts[2], 64)
+	id, err3 := strconv.Atoi(parts[3])
+	if err1 != nil || err2 != nil || err3 != nil {
+		return nil, ErrHistoryCursorInvalid
+	}
+	return &PeriodCursor{StartAt: time.Unix(0, nanos), Value: value, ID: id}, nil
+}
+
+// normalizeTags trims the tags and drops empty and duplicate ones.
+func normalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || slices.Contains(normalized, tag) {
+			continue
+		}
+		if len([]rune(tag)) > maxTagLength {
+			return nil, ErrTagInvalid
+		}
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) > maxTags {
+		return nil, ErrTagInvalid
+	}
+	return normalized, nil
+}
+
+// HistoryUsecase serves and edits the drives and charging sessions of the user.
+type HistoryUsecase struct {
+	vehicleRepo  VehicleRepo
+	periodRepo   VehicleStatePeriodRepo
+	snapshotRepo VehicleSnapshotRepo
+	tx           Transaction
+	geofence     *GeofenceUsecase
+	geocode      *GeocodeUsecase
+	tariff       *TariffUsecase
+	log          *log.Helper
+}
+
+// NewHistoryUsecase creates a History usecase.
+func NewHistoryUsecase(
+	vehicleRepo VehicleRepo,
+	periodRepo VehicleStatePeriodRepo,
+	snapshotRepo VehicleSnapshotRepo,
+	tx Transaction,
+	geofence *GeofenceUsecase,
+	geocode *GeocodeUsecase,
+	tariff *TariffUsecase,
+	logger log.Logger,
+) *HistoryUsecase {
+	return &HistoryUsecase{
+		vehicleRepo:  vehicleRepo,
+		periodRepo:   periodRepo,
+		snapshotRepo: snapshotRepo,
+		tx:           tx,
+		geofence:     geofence,
+		geocode:      geocode,
+		tariff:       tariff,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// notFound returns the not found error of the records in the state.
+func notFound(state string) error {
+	if state == VehicleStateCharging {
+		return ErrChargeNotFound
+	}
+	return ErrDriveNotFound
+}
+
+// List returns one page of the drives or charging sessions of the user.
+func (uc *HistoryUsecase) List(ctx context.Context, userID int, state string, q *HistoryQuery) (*PeriodList, error) {
+	filter := &PeriodFilter{
+		State:       state,
+		From:        q.From,
+		To:          q.To,
+		Tag:         strings.TrimSpace(q.Tag),
+		MinDistance: q.MinDistance,
+		MinEnergy:   q.MinEnergy,
+		Sort:        q.Sort,
+		Limit:       q.Limit,
+	}
+	switch filter.Sort {
+	case "":
+		filter.Sort = SortNewest
+	case SortNewest, SortOldest, SortDistance, SortEnergy:
+	default:
+		return nil, ErrHistorySortInvalid
+	}
+	if q.Cursor != "" {
+		after, err := parsePeriodCursor(filter.Sort, q.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.After = after
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultHistoryLimit
+	}
+	filter.Limit = min(filter.Limit, maxHistoryLimit)
+
+	if q.VehicleID != 0 {
+		if _, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, q.VehicleID); err != nil {
+			return nil, err
+		}
+		filter.VehicleIDs = []int{q.VehicleID}
+	} else {
+		vehicles, err := uc.vehicleRepo.FindByUserID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		for _, veh := range vehicles {
+			filter.VehicleIDs = append(filter.VehicleIDs, veh.ID)
+		}
+	}
+	if q.GeofenceID != 0 || q.GeofenceCategory != "" {
+		geofences, err := uc.geofence.List(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		filter.GeofenceIDs = []int{}
+		for _, g := range geofences {
+			if (q.GeofenceID == 0 || g.ID == q.GeofenceID) && (q.GeofenceCategory == "" || g.Category == q.GeofenceCategory) {
+				filter.GeofenceIDs = append(filter.GeofenceIDs, g.ID)
+			}
+		}
+	}
+	if len(filter.VehicleIDs) == 0 || (filter.GeofenceIDs != nil && len(filter.GeofenceIDs) == 0) {
+		return &PeriodList{}, nil
+	}
+
+	// One period more than the page holds tells whether another page follows.
+	limit := filter.Limit
+	filter.Limit++
+	periods, err := uc.periodRepo.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	list := &PeriodList{Periods: periods}
+	if len(periods) > limit {
+		list.Periods = periods[:limit]
+		list.NextCursor = encodePeriodCursor(filter.Sort, list.Periods[limit-1])
+	}
+	return list, nil
+}
+
+// Get finds a drive or charging session of a vehicle owned by the user.
+func (uc *HistoryUsecase) Get(ctx context.Context, userID int, state string, id int) (*VehicleStatePeriod, error) {
+	p, err := uc.periodRepo.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil || p.State != state {
+		return nil, notFound(state)
+	}
+	if _, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, p.VehicleID); err != nil {
+		if errors.Is(err, ErrVehicleNotFound) {
+			return nil, notFound(state)
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+// Samples loads the snapshots of a period downsampled to at most maxPoints.
+// Drives keep the shape of their track, charging sessions the shape of their power curve.
+func (uc *HistoryUsecase) Samples(ctx context.Context, p *VehicleStatePeriod, maxPoints int) ([]*VehicleSnapshot, error) {
+	if maxPoints <= 0 {
+		maxPoints = defaultMaxPoints
+	}
+	maxPoints = min(maxPoints, maxMaxPoints)
+	snapshots, err := uc.snapshots(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	if p.State == VehicleStateDriving {
+		snapshots = slices.DeleteFunc(snapshots, func(s *VehicleSnapshot) bool {
+			return s.Latitude == 0 && s.Longitude == 0
+		})
+	}
+	indices := downsample.LTTB(len(snapshots), maxPoints, func(i int) (float64, float64) {
+		s := snapshots[i]
+		if p.State == VehicleStateDriving {
+			return s.Longitude, s.Latitude
+		}
+		return float64(s.CreatedAt.Unix()), float64(s.ChargerPower)
+	})
+	samples := make([]*VehicleSnapshot, 0, len(indices))
+	for _, i := range indices {
+		samples = append(samples, snapshots[i])
+	}
+	return samples, nil
+}
+
+// snapshots loads the snapshots carrying vehicle data taken during a period, the end inclusive.
+func (uc *HistoryUsecase) snapshots(ctx context.Context, p *VehicleStatePeriod) ([]*VehicleSnapshot, error) {
+	end := time.Now()
+	if p.EndAt != nil {
+		end = *p.EndAt
+	}
+	snapshots, err := uc.snapshotRepo.ListByVehicle(ctx, p.VehicleID, p.StartAt, end.Add(time.Second))
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(snapshots, func(s *VehicleSnapshot) bool { return !s.HasData() }), nil
+}
+
+// Rename sets the name of a drive or charging session, an empty name clears it.
+func (uc *HistoryUsecase) Rename(ctx context.Context, userID int, state string, id int, name string) (*VehicleStatePeriod, error) {
+	p, err := uc.Get(ctx, userID, state, id)
+	if err != nil {
+		return nil, err
+	}
+	p.Name = strings.TrimSpace(name)
+	if err := uc.periodRepo.Save(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// SetTags replaces the tags of a drive or charging session.
+func (uc *HistoryUsecase) SetTags(ctx context.Context, userID int, state string, id int, tags []string) (*VehicleStatePeriod, error) {
+	normalized, err := normalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	p, err := uc.Get(ctx, userID, state, id)
+	if err != nil {
+		return nil, err
+	}
+	p.Tags = normalized
+	if err := uc.periodRepo.Save(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Merge joins finished drives or charging sessions of one vehicle into the earliest of them,
+// e.g., a drive interrupted by a short stop. The parked periods between them are absorbed.
+// The energy added by merged charging sessions is recounted from their samples and priced again.
+func (uc *HistoryUsecase) Merge(ctx context.Context, userID int, state string, ids []int) (*VehicleStatePeriod, error) {
+	if len(ids) < 2 {
+		return nil, ErrMergeInvalid
+	}
+	var periods []*VehicleStatePeriod
+	for _, id := range ids {
+		p, err := uc.Get(ctx, userID, state, id)
+		if err != nil {
+			return nil, err
+		}
+		if p.EndAt == nil || (len(periods) > 0 && p.VehicleID != periods[0].VehicleID) {
+			return nil, ErrMergeInvalid
+		}
+		if !slices.ContainsFunc(periods, func(o *VehicleStatePeriod) bool { return o.ID == p.ID }) {
+			periods = append(periods, p)
+		}
+	}
+	if len(periods) < 2 {
+		return nil, ErrMergeInvalid
+	}
+	slices.SortFunc(periods, func(a, b *VehicleStatePeriod) int { return a.StartAt.Compare(b.StartAt) })
+	first, last := periods[0], periods[len(periods)-1]
+
+	between, err := uc.periodRepo.ListByVehicle(ctx, first.VehicleID, first.StartAt, *last.EndAt)
+	if err != nil {
+		return nil, err
+	}
+	var absorbed []int
+	for _, p := range between {
+		if p.StartAt.Before(first.StartAt) || !p.StartAt.Before(*last.EndAt) {
+			continue
+		}
+		if slices.ContainsFunc(periods, func(o *VehicleStatePeriod) bool { return o.ID == p.ID }) {
+			continue
+		}
+		if !IsParkedState(p.State) {
+			return nil, ErrMergeInvalid
+		}
+		absorbed = append(absorbed, p.ID)
+	}
+
+	merged := first
+	for _, p := range periods[1:] {
+		merged.absorb(p)
+		absorbed = append(absorbed, p.ID)
+	}
+	if state == VehicleStateCharging {
+		if err := uc.recount(ctx, userID, merged); err != nil {
+			return nil, err
+		}
+	}
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.periodRepo.Save(ctx, merged); err != nil {
+			return err
+		}
+		return uc.periodRepo.Delete(ctx, absorbed...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infow("msg", "periods merged", "state", state, "id", merged.ID, "absorbed", absorbed)
+	return merged, nil
+}
+
+// recount counts the energy added by a merged charging session from its samples and prices it.
+// The sessions of one plug-in share the energy counter of the vehicle, so their energy cannot be summed.
+func (uc *HistoryUsecase) recount(ctx context.Context, userID int, p *VehicleStatePeriod) error {
+	snapshots, err := uc.snapshots(ctx, p)
+	if err != nil {
+		return err
+	}
+	if len(snapshots) > 0 {
+		counter := chargeCounter{last: p.StartEnergyAdded}
+		for _, s := range snapshots {
+			counter.add(s.ChargeEnergyAdded)
+		}
+		p.EnergyAdded = counter.total
+	}
+	return uc.tariff.Price(ctx, userID, p)
+}
+
+// absorb extends the period by a later period of the same state.
+// Means are weighted by the duration of the periods, energy and cost are summed.
+func (p *VehicleStatePeriod) absorb(o *VehicleStatePeriod) {
+	dp, do := p.Duration().Seconds(), o.Duration().Seconds()
+	if total := dp + do; total > 0 {
+		p.ClimateRatio = (p.ClimateRatio*dp + o.ClimateRatio*do) / total
+		if p.OutsideTemp != nil && o.OutsideTemp != nil {
+			temp := (*p.OutsideTemp*dp + *o.OutsideTemp*do) / total
+			p.OutsideTemp = &temp
+		}
+	}
+	if p.OutsideTemp == nil {
+		p.OutsideTemp = o.OutsideTemp
+	}
+	p.SentryMode = p.SentryMode || o.SentryMode
+	p.ClimateOn = p.ClimateOn || o.ClimateOn
+	p.EnergyUsed += o.EnergyUsed
+	p.PowerDuration += o.PowerDuration
+	p.MaxSpeed = max(p.MaxSpeed, o.MaxSpeed)
+	p.EnergyAdded += o.EnergyAdded
+	p.FastCharger = p.FastCharger || o.FastCharger
+	if p.Cost != nil && o.Cost != nil {
+		cost := *p.Cost + *o.Cost
+		p.Cost = &cost
+	} else {
+		p.Cost = nil
+	}
+	if p.TariffID == nil || o.TariffID == nil || *p.TariffID != *o.TariffID {
+		p.TariffID = nil
+	}
+	p.CostManual = p.CostManual || o.CostManual
+	if p.Name == "" {
+		p.Name = o.Name
+	}
+	for _, tag := range o.Tags {
+		if !slices.Contains(p.Tags, tag) {
+			p.Tags = append(p.Tags, tag)
+		}
+	}
+	p.EndAt = o.EndAt
+	p.EndBatteryLevel = o.EndBatteryLevel
+	p.EndRange = o.EndRange
+	p.EndOdometer = o.EndOdometer
+	p.EndLatitude = o.EndLatitude
+	p.EndLongitude = o.EndLongitude
+	p.EndAddress = o.EndAddress
+	p.EndGeofenceID = o.EndGeofenceID
+}
+
+// Split cuts a finished drive or charging session in two at the first sample taken at or after the time,
+// e.g., two drives recorded as one because the vehicle never left drive.
+// Both parts are rebuilt from their samples, a cost is shared by the energy added.
+func (uc *HistoryUsecase) Split(ctx context.Context, userID int, state string, id int, at time.Time) (*VehicleStatePeriod, *VehicleStatePeriod, error) {
+	p, err := uc.Get(ctx, userID, state, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if p.EndAt == nil || !at.After(p.StartAt) || !at.Before(*p.EndAt) {
+		return nil, nil, ErrSplitInvalid
+	}
+	snapshots, err := uc.snapshots(ctx, p)
+	if err != nil {
+		return nil, nil, err
+	}
+	k := slices.IndexFunc(snapshots, func(s *VehicleSnapshot) bool { return !s.CreatedAt.Before(at) })
+	if k < 1 || k > len(snapshots)-2 {
+		return nil, nil, ErrSplitInvalid
+	}
+
+	first, second := p.rebuild(snapshots[:k+1]), p.rebuild(snapshots[k:])
+	first.ID, first.StartAt, first.StartAddress, first.StartGeofenceID = p.ID, p.StartAt, p.StartAddress, p.StartGeofenceID
+	second.EndAt, second.EndAddress, second.EndGeofenceID = p.EndAt, p.EndAddress, p.EndGeofenceID
+	boundary := snapshots[k]
+	address := uc.geocode.Lookup(ctx, boundary.Latitude, boundary.Longitude)
+	geofences, err := uc.geofence.Match(ctx, userID, boundary.Latitude, boundary.Longitude)
+	if err != nil {
+		return nil, nil, err
+	}
+	first.EndAddress, second.StartAddress = address, address
+	first.EndGeofenceID, second.StartGeofenceID = geofences.BestID(), geofences.BestID()
+	if state == VehicleStateCharging && p.Cost != nil {
+		share := first.Duration().Seconds() / p.Duration().Seconds()
+		if total := first.EnergyAdded + second.EnergyAdded; total > 0 {
+			share = first.EnergyAdded / total
+		}
+		firstCost, secondCost := *p.Cost*share, *p.Cost*(1-share)
+		first.Cost, second.Cost = &firstCost, &secondCost
+	}
+
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.periodRepo.Save(ctx, first); err != nil {
+			return err
+		}
+		return uc.periodRepo.Create(ctx, second)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	uc.log.WithContext(ctx).Infow("msg", "period split", "state", state, "id", first.ID, "newID", second.ID, "at", boundary.CreatedAt)
+	return first, second, nil
+}
+
+// rebuild replays snapshots into a new period carrying the owner's labels and the pricing of the period.
+func (p *VehicleStatePeriod) rebuild(snapshots []*VehicleSnapshot) *VehicleStatePeriod {
+	np := newVehicleStatePeriod(p.State, snapshots[0], nil)
+	for _, s := range snapshots[1:] {
+		np.extend(s)
+	}
+	np.SentryMode, np.ClimateOn = p.SentryMode, p.ClimateOn
+	np.ChargeLocation = p.ChargeLocation
+	np.CostManual, np.TariffID = p.CostManual, p.TariffID
+	np.Name, np.Tags = p.Name, slices.Clone(p.Tags)
+	return np
+}
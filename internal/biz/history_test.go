@@ -0,0 +1,132 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var historyStart = time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)
+
+// chargingHistory returns a history usecase over the snapshots of the charge counter values
+// taken every ten minutes from historyStart and the periods, priced at 0.25 per kWh.
+func chargingHistory(counters []float64, periods ...*VehicleStatePeriod) (*HistoryUsecase, *memoryPeriods) {
+	snapshots := &memorySnapshots{}
+	for i, added := range counters {
+		snapshots.snapshots = append(snapshots.snapshots, &VehicleSnapshot{
+			VehicleID:         1,
+			State:             TeslaStateOnline,
+			ChargeEnergyAdded: added,
+			CreatedAt:         historyStart.Add(time.Duration(i) * 10 * time.Minute),
+		})
+	}
+	for i, p := range periods {
+		p.ID, p.VehicleID = i+1, 1
+	}
+	periodRepo := &memoryPeriods{periods: periods}
+	vehicleRepo := &memoryVehicles{vehicles: []*Vehicle{{ID: 1, UserID: 1}}}
+	tariffs := &memoryTariffs{tariffs: []*Tariff{{ID: 1, UserID: 1, Kind: TariffKindFlat, Price: 0.25, TimeZone: "UTC"}}}
+	logger := log.DefaultLogger
+	uc := NewHistoryUsecase(vehicleRepo, periodRepo, snapshots, periodRepo,
+		NewGeofenceUsecase(memoryGeofences{}, nil, logger),
+		NewGeocodeUsecase(nil, nil, logger),
+		NewTariffUsecase(tariffs, nil, vehicleRepo, periodRepo, snapshots, logger),
+		logger)
+	return uc, periodRepo
+}
+
+// historyPeriod returns a closed period of the state from minute start to minute end of historyStart.
+func historyPeriod(state string, start, end int, energyAdded, cost float64) *VehicleStatePeriod {
+	endAt := historyStart.Add(time.Duration(end) * time.Minute)
+	return &VehicleStatePeriod{
+		State:       state,
+		StartAt:     historyStart.Add(time.Duration(start) * time.Minute),
+		EndAt:       &endAt,
+		EnergyAdded: energyAdded,
+		Cost:        &cost,
+	}
+}
+
+func TestHistoryMergeCharges(t *testing.T) {
+	cases := []struct {
+		name     string
+		counters []float64
+		second   *VehicleStatePeriod
+		energy   float64
+	}{
+		{
+			// Sessions recorded before the start counter was kept carry the counter as their energy.
+			name:     "one plug-in",
+			counters: []float64{0, 2, 4, 5, 5, 7, 9, 10},
+			second:   historyPeriod(VehicleStateCharging, 40, 70, 10, 2.5),
+			energy:   10,
+		},
+		{
+			name:     "plugged in again",
+			counters: []float64{0, 2, 4, 5, 5, 1, 2, 3},
+			second:   historyPeriod(VehicleStateCharging, 40, 70, 3, 0.75),
+			energy:   8,
+		},
+	}
+	for _, c := range cases {
+		uc, repo := chargingHistory(c.counters,
+			historyPeriod(VehicleStateCharging, 0, 30, 5, 1.25),
+			historyPeriod(VehicleStateOnline, 30, 40, 0, 0),
+			c.second,
+		)
+		merged, err := uc.Merge(context.Background(), 1, VehicleStateCharging, []int{1, 3})
+		if err != nil {
+			t.Fatalf("%s: Merge: %v", c.name, err)
+		}
+		if merged.EnergyAdded != c.energy || merged.Cost == nil || *merged.Cost != c.energy*0.25 {
+			t.Errorf("%s: merged session added %v kWh costing %v, want %v kWh costing %v", c.name, merged.EnergyAdded, merged.Cost, c.energy, c.energy*0.25)
+		}
+		if len(repo.periods) != 1 || *repo.periods[0].EndAt != *c.second.EndAt {
+			t.Errorf("%s: %d periods left after the merge, want the merged session", c.name, len(repo.periods))
+		}
+	}
+}
+
+func TestHistoryMergeRollsBack(t *testing.T) {
+	uc, repo := chargingHistory([]float64{0, 2, 4, 5, 5, 7, 9, 10},
+		historyPeriod(VehicleStateCharging, 0, 30, 5, 1.25),
+		historyPeriod(VehicleStateCharging, 40, 70, 10, 2.5),
+	)
+	repo.err = errors.New("database unavailable")
+	if _, err := uc.Merge(context.Background(), 1, VehicleStateCharging, []int{1, 2}); !errors.Is(err, repo.err) {
+		t.Fatalf("Merge error = %v, want %v", err, repo.err)
+	}
+	if len(repo.periods) != 2 || repo.periods[0].EndAt.After(historyStart.Add(30*time.Minute)) {
+		t.Errorf("failed merge left %d periods, the first ending at %v, want both unchanged", len(repo.periods), repo.periods[0].EndAt)
+	}
+}
+
+func TestHistorySplitCharge(t *testing.T) {
+	uc, repo := chargingHistory([]float64{0, 2, 4, 5}, historyPeriod(VehicleStateCharging, 0, 30, 5, 2.5))
+	first, second, err := uc.Split(context.Background(), 1, VehicleStateCharging, 1, historyStart.Add(15*time.Minute))
+	if err != nil {
+		t.Fatalf("Split: %v", err)
+	}
+	if first.EnergyAdded != 4 || second.EnergyAdded != 1 {
+		t.Errorf("split added %v and %v kWh, want 4 and 1", first.EnergyAdded, second.EnergyAdded)
+	}
+	if math.Abs(*first.Cost-2) > 1e-9 || math.Abs(*second.Cost-0.5) > 1e-9 {
+		t.Errorf("split cost %v and %v, want 2 and 0.5", *first.Cost, *second.Cost)
+	}
+	if len(repo.periods) != 2 {
+		t.Errorf("%d periods after the split, want 2", len(repo.periods))
+	}
+
+	uc, repo = chargingHistory([]float64{0, 2, 4, 5}, historyPeriod(VehicleStateCharging, 0, 30, 5, 2.5))
+	repo.err = errors.New("database unavailable")
+	if _, _, err := uc.Split(context.Background(), 1, VehicleStateCharging, 1, historyStart.Add(15*time.Minute)); !errors.Is(err, repo.err) {
+		t.Fatalf("Split error = %v, want %v", err, repo.err)
+	}
+	if len(repo.periods) != 1 || !repo.periods[0].EndAt.Equal(historyStart.Add(30*time.Minute)) {
+		t.Errorf("failed split left %d periods, want the session unchanged", len(repo.periods))
+	}
+}
@@ -0,0 +1,89 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	v1 "teslatrack/api/teslatrack/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// liveBuffer is the number of events buffered for a live stream.
+// A stream whose client falls further behind is ended and has to resume.
+const liveBuffer = 64
+
+// LiveEventTypes are the event types delivered to live streams.
+var LiveEventTypes = []string{
+	EventVehicleSnapshot,
+	EventVehicleState,
+	EventDriveStart,
+	EventDriveEnd,
+	EventChargeStart,
+	EventChargeEnd,
+	EventGeofenceEnter,
+	EventGeofenceExit,
+	EventTirePressureLow,
+	EventTireSlowLeak,
+}
+
+var (
+	// ErrLiveEventTypeInvalid is returned for an unknown live event type.
+	ErrLiveEventTypeInvalid = v1.ErrorLiveEventTypeInvalid("unknown live event type")
+	// ErrLiveStreamOverflow is returned when a client falls too far behind the live events.
+	ErrLiveStreamOverflow = v1.ErrorLiveStreamOverflow("the client fell behind, resume from the last event id")
+)
+
+// LiveStream is a subscription to the live events of a user.
+type LiveStream struct {
+	*Subscription
+	// Replay are the events after the last event id to deliver before the subscription.
+	Replay []*Event
+	// Complete reports whether Replay holds every event missed since the last event id.
+	// Clients should reload their state when it does not.
+	Complete bool
+}
+
+// LiveUsecase streams the events of the vehicles of a user.
+type LiveUsecase struct {
+	bus         *EventBus
+	vehicleRepo VehicleRepo
+	log         *log.Helper
+}
+
+// NewLiveUsecase creates a Live usecase.
+func NewLiveUsecase(bus *EventBus, vehicleRepo VehicleRepo, logger log.Logger) *LiveUsecase {
+	return &LiveUsecase{bus: bus, vehicleRepo: vehicleRepo, log: log.NewHelper(logger)}
+}
+
+// Subscribe subscribes to the events of a vehicle of the user, or of all vehicles
+// of the user when vehicleID is 0, limited to types unless empty.
+// A non-zero lastEventID resumes after that event. The stream must be closed.
+func (uc *LiveUsecase) Subscribe(ctx context.Context, userID, vehicleID int, types []string, lastEventID uint64) (*LiveStream, error) {
+	for _, typ := range types {
+		if !slices.Contains(LiveEventTypes, typ) {
+			return nil, ErrLiveEventTypeInvalid
+		}
+	}
+	if vehicleID != 0 {
+		if _, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, vehicleID); err != nil {
+			return nil, err
+		}
+	}
+	filter := func(e *Event) bool {
+		if e.UserID != userID || vehicleID != 0 && e.VehicleID != vehicleID {
+			return false
+		}
+		if len(types) > 0 {
+			return slices.Contains(types, e.Type)
+		}
+		return slices.Contains(LiveEventTypes, e.Type)
+	}
+	if lastEventID == 0 {
+		return &LiveStream{Subscription: uc.bus.Subscribe(liveBuffer, filter), Complete: true}, nil
+	}
+	sub, replay, complete := uc.bus.SubscribeFrom(lastEventID, liveBuffer, filter)
+	if !complete {
+		uc.log.WithContext(ctx).Infow("msg", "live stream resumed with a gap", "userID", userID, "lastEventID", lastEventID)
+	}
+	return &LiveStream{Subscription: sub, Replay: replay, Complete: complete}, nil
+}
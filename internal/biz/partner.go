@@ -27,6 +27,16 @@ type Partner struct {
 	UpdatedAt time.Time
 }
 
+// ExpiresAt returns the expiry of the partner token, issued when the partner was created
+// or last updated with a refreshed token.
+func (p *Partner) ExpiresAt() time.Time {
+	issuedAt := p.CreatedAt
+	if p.UpdatedAt.After(issuedAt) {
+		issuedAt = p.UpdatedAt
+	}
+	return issuedAt.Add(time.Duration(p.ExpiresIn) * time.Second)
+}
+
 // PartnerRepo is a Partner repo.
 type PartnerRepo interface {
 	// Get gets a Partner by clientID.
@@ -88,7 +98,7 @@ func (uc *PartnerUsecase) Initialize() error {
 	}
 
 	// Check if the partner token has expired.
-	if partner.CreatedAt.Add(time.Duration(partner.ExpiresIn) * time.Second).Before(time.Now()) {
+	if partner.ExpiresAt().Before(time.Now()) {
 		uc.log.Info("Partner token expired, refreshing...")
 		// Fetch a new token from Tesla API.
 		teslaPartner, err := tesla.GetPartner(clientID, clientSecret)
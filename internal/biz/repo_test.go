@@ -0,0 +1,335 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// memoryVehicles is a VehicleRepo of the vehicles of a slice.
+type memoryVehicles struct {
+	VehicleRepo
+	vehicles []*Vehicle
+}
+
+func (r *memoryVehicles) FindOne(_ context.Context, id int) (*Vehicle, error) {
+	for _, v := range r.vehicles {
+		if v.ID == id {
+			return v, nil
+		}
+	}
+	return nil, nil
+}
+
+// memoryPeriods is a VehicleStatePeriodRepo of the periods of a slice, returning copies.
+// It is also the Transaction of the usecases under test, restoring the periods when fn fails.
+type memoryPeriods struct {
+	VehicleStatePeriodRepo
+	periods []*VehicleStatePeriod
+	// err is returned by Create and Delete when set.
+	err error
+}
+
+func (r *memoryPeriods) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	saved := r.clone()
+	if err := fn(ctx); err != nil {
+		r.periods = saved
+		return err
+	}
+	return nil
+}
+
+func (r *memoryPeriods) clone() []*VehicleStatePeriod {
+	periods := make([]*VehicleStatePeriod, len(r.periods))
+	for i, p := range r.periods {
+		c := *p
+		periods[i] = &c
+	}
+	return periods
+}
+
+func (r *memoryPeriods) Create(_ context.Context, p *VehicleStatePeriod) error {
+	if r.err != nil {
+		return r.err
+	}
+	p.ID = len(r.periods) + 1
+	for _, o := range r.periods {
+		p.ID = max(p.ID, o.ID+1)
+	}
+	c := *p
+	r.periods = append(r.periods, &c)
+	return nil
+}
+
+func (r *memoryPeriods) Save(_ context.Context, p *VehicleStatePeriod) error {
+	for i, o := range r.periods {
+		if o.ID == p.ID {
+			c := *p
+			r.periods[i] = &c
+		}
+	}
+	return nil
+}
+
+func (r *memoryPeriods) Delete(_ context.Context, ids ...int) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.periods = slices.DeleteFunc(r.periods, func(p *VehicleStatePeriod) bool { return slices.Contains(ids, p.ID) })
+	return nil
+}
+
+func (r *memoryPeriods) FindOne(_ context.Context, id int) (*VehicleStatePeriod, error) {
+	for _, p := range r.clone() {
+		if p.ID == id {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryPeriods) ListByVehicle(_ context.Context, vehicleID int, from, to time.Time) ([]*VehicleStatePeriod, error) {
+	return slices.DeleteFunc(r.clone(), func(p *VehicleStatePeriod) bool {
+		return p.VehicleID != vehicleID || !p.StartAt.Before(to) || (p.EndAt != nil && !p.EndAt.After(from))
+	}), nil
+}
+
+// memorySnapshots is a VehicleSnapshotRepo of the snapshots of a slice, oldest first.
+type memorySnapshots struct {
+	VehicleSnapshotRepo
+	snapshots []*VehicleSnapshot
+}
+
+func (r *memorySnapshots) ListByVehicle(_ context.Context, vehicleID int, from, to time.Time) ([]*VehicleSnapshot, error) {
+	var snapshots []*VehicleSnapshot
+	for _, s := range r.snapshots {
+		if s.VehicleID == vehicleID && !s.CreatedAt.Before(from) && s.CreatedAt.Before(to) {
+			snapshots = append(snapshots, s)
+		}
+	}
+	return snapshots, nil
+}
+
+// memoryTariffs is a TariffRepo of the tariffs of a slice.
+type memoryTariffs struct {
+	TariffRepo
+	tariffs []*Tariff
+}
+
+func (r *memoryTariffs) ListByUser(_ context.Context, userID int) ([]*Tariff, error) {
+	var tariffs []*Tariff
+	for _, t := range r.tariffs {
+		if t.UserID == userID {
+			tariffs = append(tariffs, t)
+		}
+	}
+	return tariffs, nil
+}
+
+// memoryGeofences is a GeofenceRepo without geofences.
+type memoryGeofences struct {
+	GeofenceRepo
+}
+
+func (memoryGeofences) ListByUser(context.Context, int) ([]*Geofence, error) {
+	return nil, nil
+}
+
+// memoryLocker is a Locker of the locks of a map.
+type memoryLocker struct {
+	mu    sync.Mutex
+	locks map[string]bool
+}
+
+func (l *memoryLocker) TryLock(_ context.Context, key string, _ time.Duration) (Lock, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.locks[key] {
+		return nil, false, nil
+	}
+	if l.locks == nil {
+		l.locks = make(map[string]bool)
+	}
+	l.locks[key] = true
+	return &memoryLock{locker: l, key: key}, true, nil
+}
+
+// memoryLock is a lock of a memoryLocker.
+type memoryLock struct {
+	locker *memoryLocker
+	key    string
+}
+
+func (l *memoryLock) Extend(context.Context, time.Duration) (bool, error) {
+	return true, nil
+}
+
+func (l *memoryLock) Unlock() {
+	l.locker.mu.Lock()
+	defer l.locker.mu.Unlock()
+	delete(l.locker.locks, l.key)
+}
+
+func (r *memoryPeriods) Update(ctx context.Context, p *VehicleStatePeriod) error {
+	return r.Save(ctx, p)
+}
+
+func (r *memoryPeriods) Current(_ context.Context, vehicleID int) (*VehicleStatePeriod, error) {
+	var current *VehicleStatePeriod
+	for _, p := range r.clone() {
+		if p.VehicleID == vehicleID && (current == nil || p.StartAt.After(current.StartAt)) {
+			current = p
+		}
+	}
+	return current, nil
+}
+
+// localStream is an EventStream of a single instance.
+type localStream struct{}
+
+func (localStream) Shared() bool { return false }
+
+func (localStream) Append(context.Context, []byte) error { return nil }
+
+func (localStream) Read(context.Context, func(uint64, []byte)) error { return nil }
+
+func (r *memoryPeriods) ListUpdatedSince(_ context.Context, vehicleID int, since time.Time) ([]*VehicleStatePeriod, error) {
+	return slices.DeleteFunc(r.clone(), func(p *VehicleStatePeriod) bool {
+		return p.VehicleID != vehicleID || p.UpdatedAt.Before(since)
+	}), nil
+}
+
+// memoryUsers is a UserRepo of the users of a slice.
+type memoryUsers struct {
+	UserRepo
+	users []*User
+}
+
+func (r *memoryUsers) FindOne(_ context.Context, id int) (*User, error) {
+	for _, u := range r.users {
+		if u.ID == id {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+// memoryRollups is a RollupRepo of the rollups of a map, counting the saves.
+type memoryRollups struct {
+	RollupRepo
+	rollups map[string]*Rollup
+	states  map[int]*RollupState
+	saves   int
+}
+
+func (r *memoryRollups) Save(_ context.Context, rollup *Rollup) error {
+	if r.rollups == nil {
+		r.rollups = make(map[string]*Rollup)
+	}
+	r.rollups[rollup.Granularity+" "+rollup.StartAt.Format(time.RFC3339)] = rollup
+	r.saves++
+	return nil
+}
+
+func (r *memoryRollups) State(_ context.Context, vehicleID int) (*RollupState, error) {
+	return r.states[vehicleID], nil
+}
+
+func (r *memoryRollups) SaveState(_ context.Context, state *RollupState) error {
+	if r.states == nil {
+		r.states = make(map[int]*RollupState)
+	}
+	r.states[state.VehicleID] = state
+	return nil
+}
+
+// listPage returns the page of the items, newest first by the time and then the ID of at.
+func listPage[T any](items []T, page *TimelinePage, at func(T) (time.Time, int)) []T {
+	var listed []T
+	for _, item := range items {
+		t, id := at(item)
+		if (!page.From.IsZero() && t.Before(page.From)) || (!page.To.IsZero() && !t.Before(page.To)) {
+			continue
+		}
+		if !page.Before.IsZero() && !t.Before(page.Before) && !(t.Equal(page.Before) && id < page.BeforeID) {
+			continue
+		}
+		listed = append(listed, item)
+	}
+	slices.SortFunc(listed, func(a, b T) int {
+		ta, ida := at(a)
+		tb, idb := at(b)
+		if c := tb.Compare(ta); c != 0 {
+			return c
+		}
+		return idb - ida
+	})
+	return listed[:min(len(listed), page.Limit)]
+}
+
+func (r *memoryPeriods) ListPage(_ context.Context, vehicleID int, states []string, page *TimelinePage) ([]*VehicleStatePeriod, error) {
+	periods := slices.DeleteFunc(r.clone(), func(p *VehicleStatePeriod) bool {
+		return p.VehicleID != vehicleID || !slices.Contains(states, p.State)
+	})
+	return listPage(periods, page, func(p *VehicleStatePeriod) (time.Time, int) { return p.StartAt, p.ID }), nil
+}
+
+// memorySoftware is a SoftwareUpdateRepo of the updates of a slice.
+type memorySoftware struct {
+	SoftwareUpdateRepo
+	updates []*SoftwareUpdate
+}
+
+func (r *memorySoftware) ListInstalledPage(_ context.Context, vehicleID int, page *TimelinePage) ([]*SoftwareUpdate, error) {
+	var installed []*SoftwareUpdate
+	for _, u := range r.updates {
+		if u.VehicleID == vehicleID && u.CompletedAt != nil && u.FromVersion != "" {
+			installed = append(installed, u)
+		}
+	}
+	return listPage(installed, page, func(u *SoftwareUpdate) (time.Time, int) { return *u.CompletedAt, u.ID }), nil
+}
+
+func (r *memorySoftware) Create(_ context.Context, u *SoftwareUpdate) error {
+	u.ID = len(r.updates) + 1
+	c := *u
+	r.updates = append(r.updates, &c)
+	return nil
+}
+
+func (r *memorySoftware) Update(_ context.Context, u *SoftwareUpdate) error {
+	c := *u
+	r.updates[u.ID-1] = &c
+	return nil
+}
+
+func (r *memorySoftware) LatestInstalled(_ context.Context, vehicleID int) (*SoftwareUpdate, error) {
+	return r.latest(vehicleID, func(u *SoftwareUpdate) bool { return u.CompletedAt != nil }), nil
+}
+
+func (r *memorySoftware) LatestPending(_ context.Context, vehicleID int) (*SoftwareUpdate, error) {
+	return r.latest(vehicleID, (*SoftwareUpdate).Pending), nil
+}
+
+// latest returns a copy of the last update of a vehicle that matches, nil if none does.
+func (r *memorySoftware) latest(vehicleID int, match func(*SoftwareUpdate) bool) *SoftwareUpdate {
+	for i := len(r.updates) - 1; i >= 0; i-- {
+		if u := r.updates[i]; u.VehicleID == vehicleID && match(u) {
+			c := *u
+			return &c
+		}
+	}
+	return nil
+}
+
+// memoryEvents is a VehicleEventRepo of the events of a slice.
+type memoryEvents struct {
+	VehicleEventRepo
+	events []*VehicleEvent
+}
+
+func (r *memoryEvents) ListPage(_ context.Context, vehicleID int, page *TimelinePage) ([]*VehicleEvent, error) {
+	events := slices.DeleteFunc(slices.Clone(r.events), func(e *VehicleEvent) bool { return e.VehicleID != vehicleID })
+	return listPage(events, page, func(e *VehicleEvent) (time.Time, int) { return e.Time, e.ID }), nil
+}
@@ -0,0 +1,338 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	v1 "teslatrack/api/teslatrack/v1"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Granularities of the statistics rollups.
+const (
+	GranularityDay   = "day"
+	GranularityWeek  = "week"
+	GranularityMonth = "month"
+)
+
+// Granularities lists the granularities materialized by the rollup job.
+var Granularities = []string{GranularityDay, GranularityWeek, GranularityMonth}
+
+var (
+	// ErrGranularityInvalid is returned for granularities other than day, week and month.
+	ErrGranularityInvalid = v1.ErrorGranularityInvalid("granularity must be day, week or month")
+)
+
+// Rollup is the statistics of a vehicle over one day, ISO week or month.
+// Drives and charging sessions count towards the bucket they started in;
+// parked and asleep time is split at the bucket boundaries.
+type Rollup struct {
+	// ID is the unique identifier of the rollup.
+	ID int
+	// VehicleID is the ID of the vehicle.
+	VehicleID int
+	// Granularity is the bucket size, see the Granularity constants.
+	Granularity string
+	// TimeZone is the IANA time zone of the bucket boundaries.
+	TimeZone string
+	// StartAt is the start of the bucket.
+	StartAt time.Time
+	// EndAt is the end of the bucket, exclusive.
+	EndAt time.Time
+	// Drives is the number of drives.
+	Drives int
+	// Distance is the distance driven in km.
+	Distance float64
+	// DriveDuration is the time spent driving.
+	DriveDuration time.Duration
+	// EnergyUsed is the energy used by the drives with a usable energy reading in kWh.
+	EnergyUsed float64
+	// Efficiency is the consumption of the drives with a usable energy reading in Wh/km, 0 when unknown.
+	Efficiency float64
+	// MaxSpeed is the highest speed in km/h.
+	MaxSpeed float64
+	// Charges is the number of charging sessions.
+	Charges int
+	// EnergyAdded is the energy added while charging in kWh.
+	EnergyAdded float64
+	// ChargeCost is the cost of the priced charging sessions.
+	ChargeCost float64
+	// ParkedDuration is the time spent parked, including asleep.
+	ParkedDuration time.Duration
+	// AsleepDuration is the time spent asleep or offline.
+	AsleepDuration time.Duration
+}
+
+// RollupState records up to which period update the rollups of a vehicle are computed.
+type RollupState struct {
+	// VehicleID is the ID of the vehicle.
+	VehicleID int
+	// TimeZone is the IANA time zone the rollups were computed in.
+	TimeZone string
+	// Watermark is the latest update time of the periods rolled up. Every update at or
+	// before it is rolled up, so it never passes a second that may still be written.
+	Watermark time.Time
+}
+
+// watermarkSettle is how long after a second the update times of the periods stored in it
+// are final. Databases may keep update times to the second only, rounding them up.
+const watermarkSettle = 2 * time.Second
+
+// RollupRepo defines the data access layer for Rollup.
+type RollupRepo interface {
+	// Save creates the rollup or replaces the one of the same vehicle, granularity, time zone and start.
+	Save(ctx context.Context, r *Rollup) error
+	// List lists the rollups of a vehicle starting in [from, to), oldest first.
+	List(ctx context.Context, vehicleID int, granularity, timeZone string, from, to time.Time) ([]*Rollup, error)
+	// DeleteOtherTimeZones deletes the rollups of a vehicle computed in any other time zone.
+	DeleteOtherTimeZones(ctx context.Context, vehicleID int, timeZone string) error
+	// State finds the rollup state of a vehicle, returns nil if none exists.
+	State(ctx context.Context, vehicleID int) (*RollupState, error)
+	// SaveState creates or replaces the rollup state of a vehicle.
+	SaveState(ctx context.Context, state *RollupState) error
+}
+
+// RollupStart returns the start of the bucket containing t in the location.
+// Weeks are ISO weeks starting on Monday.
+func RollupStart(t time.Time, granularity string, loc *time.Location) time.Time {
+	t = t.In(loc)
+	switch granularity {
+	case GranularityWeek:
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, loc)
+	case GranularityMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// RollupEnd returns the end of the bucket starting at start.
+// Calendar arithmetic keeps the boundaries at local midnight across DST changes.
+func RollupEnd(start time.Time, granularity string) time.Time {
+	switch granularity {
+	case GranularityWeek:
+		return start.AddDate(0, 0, 7)
+	case GranularityMonth:
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// ValidGranularity reports whether the granularity is materialized.
+func ValidGranularity(granularity string) bool {
+	for _, g := range Granularities {
+		if g == granularity {
+			return true
+		}
+	}
+	return false
+}
+
+// NewRollup computes the statistics of a vehicle in [start, end) from its periods.
+// Open periods are measured up to now.
+func NewRollup(periods []*VehicleStatePeriod, start, end time.Time, packCapacity float64, now time.Time) *Rollup {
+	r := &Rollup{StartAt: start, EndAt: end}
+	var efficiencyDistance float64
+	for _, p := range periods {
+		pEnd := now
+		if p.EndAt != nil {
+			pEnd = *p.EndAt
+		}
+		if overlap := minTime(pEnd, end).Sub(maxTime(p.StartAt, start)); overlap > 0 && IsParkedState(p.State) {
+			r.ParkedDuration += overlap
+			if p.State == VehicleStateAsleep || p.State == VehicleStateOffline {
+				r.AsleepDuration += overlap
+			}
+		}
+		if p.StartAt.Before(start) || !p.StartAt.Before(end) {
+			continue
+		}
+		switch p.State {
+		case VehicleStateDriving:
+			r.Drives++
+			r.Distance += max(0, p.EndOdometer-p.StartOdometer)
+			r.DriveDuration += pEnd.Sub(p.StartAt)
+			r.MaxSpeed = max(r.MaxSpeed, p.MaxSpeed)
+			if p.EndAt == nil {
+				continue
+			}
+			if d := NewDriveEfficiency(p, packCapacity); d != nil {
+				r.EnergyUsed += d.EnergyUsed
+				efficiencyDistance += d.Distance
+			}
+		case VehicleStateCharging:
+			r.Charges++
+			r.EnergyAdded += p.EnergyAdded
+			if p.Cost != nil {
+				r.ChargeCost += *p.Cost
+			}
+		}
+	}
+	if efficiencyDistance > 0 {
+		r.Efficiency = r.EnergyUsed * 1000 / efficiencyDistance
+	}
+	return r
+}
+
+// RollupUsecase materializes the statistics rollups of the vehicles.
+type RollupUsecase struct {
+	repo        RollupRepo
+	periodRepo  VehicleStatePeriodRepo
+	vehicleRepo VehicleRepo
+	userRepo    UserRepo
+	log         *log.Helper
+}
+
+// NewRollupUsecase creates a Rollup usecase.
+func NewRollupUsecase(
+	repo RollupRepo,
+	periodRepo VehicleStatePeriodRepo,
+	vehicleRepo VehicleRepo,
+	userRepo UserRepo,
+	logger log.Logger,
+) *RollupUsecase {
+	return &RollupUsecase{
+		repo:        repo,
+		periodRepo:  periodRepo,
+		vehicleRepo: vehicleRepo,
+		userRepo:    userRepo,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// Run refreshes the rollups of every vehicle.
+// Failures of a single vehicle are logged and do not stop the others.
+func (uc *RollupUsecase) Run(ctx context.Context) error {
+	vehicles, err := uc.vehicleRepo.ListAll(ctx)
+	if err != nil {
+		return err
+	}
+	for _, veh := range vehicles {
+		if err := uc.Refresh(ctx, veh); err != nil {
+			uc.log.WithContext(ctx).Errorw("msg", "refresh rollups failed", "vehicleID", veh.ID, "err", err)
+		}
+	}
+	return nil
+}
+
+// Refresh recomputes the buckets of a vehicle touched by periods saved since the last run,
+// so late data and recalculated costs land in the right day, week and month.
+// A change of the owner's time zone recomputes the whole history.
+func (uc *RollupUsecase) Refresh(ctx context.Context, veh *Vehicle) error {
+	loc, err := uc.location(ctx, veh.UserID)
+	if err != nil {
+		return err
+	}
+	state, err := uc.repo.State(ctx, veh.ID)
+	if err != nil {
+		return err
+	}
+	var since time.Time
+	switch {
+	case state == nil:
+	case state.TimeZone == loc.String():
+		since = state.Watermark
+	default:
+		if err := uc.repo.DeleteOtherTimeZones(ctx, veh.ID, loc.String()); err != nil {
+			return err
+		}
+	}
+	changed, err := uc.periodRepo.ListUpdatedSince(ctx, veh.ID, since)
+	if err != nil {
+		return err
+	}
+	// Periods saved at the watermark were rolled up by the last run.
+	changed = slices.DeleteFunc(changed, func(p *VehicleStatePeriod) bool { return !p.UpdatedAt.After(since) })
+	if len(changed) == 0 {
+		return nil
+	}
+	now := time.Now()
+	settled := now.Add(-watermarkSettle).Truncate(time.Second)
+	from, to, watermark := changed[0].StartAt, changed[0].StartAt, since
+	for _, p := range changed {
+		from = minTime(from, p.StartAt)
+		if p.EndAt != nil {
+			to = maxTime(to, *p.EndAt)
+		} else {
+			to = maxTime(to, now)
+		}
+		// Periods saved in a second not settled yet are rolled up again by the next run,
+		// with the periods saved later in that second.
+		watermark = maxTime(watermark, minTime(p.UpdatedAt, settled))
+	}
+	if err := uc.recompute(ctx, veh.ID, loc, from, to, now); err != nil {
+		return err
+	}
+	return uc.repo.SaveState(ctx, &RollupState{VehicleID: veh.ID, TimeZone: loc.String(), Watermark: watermark})
+}
+
+// recompute saves every bucket of every granularity overlapping [from, to].
+func (uc *RollupUsecase) recompute(ctx context.Context, vehicleID int, loc *time.Location, from, to, now time.Time) error {
+	lo := minTime(RollupStart(from, GranularityWeek, loc), RollupStart(from, GranularityMonth, loc))
+	hi := maxTime(
+		RollupEnd(RollupStart(to, GranularityWeek, loc), GranularityWeek),
+		RollupEnd(RollupStart(to, GranularityMonth, loc), GranularityMonth),
+	)
+	periods, err := uc.periodRepo.ListByVehicle(ctx, vehicleID, lo.Add(-capacityLookback), hi)
+	if err != nil {
+		return err
+	}
+	capacity := PackCapacity(periods)
+	for _, granularity := range Granularities {
+		for start := RollupStart(from, granularity, loc); !start.After(to); start = RollupEnd(start, granularity) {
+			r := NewRollup(periods, start, RollupEnd(start, granularity), capacity, now)
+			r.VehicleID = vehicleID
+			r.Granularity = granularity
+			r.TimeZone = loc.String()
+			if err := uc.repo.Save(ctx, r); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// List lists the rollups of a vehicle of the user starting in [from, to),
+// in the time zone of the user.
+func (uc *RollupUsecase) List(ctx context.Context, userID, vehicleID int, granularity string, from, to time.Time) ([]*Rollup, error) {
+	if !ValidGranularity(granularity) {
+		return nil, ErrGranularityInvalid
+	}
+	if _, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, vehicleID); err != nil {
+		return nil, err
+	}
+	loc, err := uc.location(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.repo.List(ctx, vehicleID, granularity, loc.String(), from, to)
+}
+
+// location returns the time zone of the user.
+func (uc *RollupUsecase) location(ctx context.Context, userID int) (*time.Location, error) {
+	u, err := uc.userRepo.FindOne(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		return LoadLocation("")
+	}
+	return u.Location(), nil
+}
+
+// minTime returns the earlier of two times.
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+// maxTime returns the later of two times.
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
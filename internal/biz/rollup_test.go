@@ -0,0 +1,111 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestRollupBuckets(t *testing.T) {
+	berlin, err := LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(year int, month time.Month, day, hour, min int) time.Time {
+		return time.Date(year, month, day, hour, min, 0, 0, berlin)
+	}
+	cases := []struct {
+		name        string
+		t           time.Time
+		granularity string
+		start       time.Time
+		length      time.Duration
+	}{
+		{"DST starts", at(2026, 3, 29, 12, 0), GranularityDay, at(2026, 3, 29, 0, 0), 23 * time.Hour},
+		{"DST ends", at(2026, 10, 25, 12, 0), GranularityDay, at(2026, 10, 25, 0, 0), 25 * time.Hour},
+		{"new year in UTC is local", time.Date(2026, 12, 31, 23, 30, 0, 0, time.UTC), GranularityDay, at(2027, 1, 1, 0, 0), 24 * time.Hour},
+		{"ISO week across the year", at(2027, 1, 3, 10, 0), GranularityWeek, at(2026, 12, 28, 0, 0), 7 * 24 * time.Hour},
+		{"ISO week starts on Monday", at(2026, 12, 28, 0, 0), GranularityWeek, at(2026, 12, 28, 0, 0), 7 * 24 * time.Hour},
+		{"week with DST", at(2026, 3, 29, 23, 0), GranularityWeek, at(2026, 3, 23, 0, 0), 7*24*time.Hour - time.Hour},
+		{"December", at(2026, 12, 31, 23, 30), GranularityMonth, at(2026, 12, 1, 0, 0), 31 * 24 * time.Hour},
+	}
+	for _, c := range cases {
+		start := RollupStart(c.t, c.granularity, berlin)
+		if !start.Equal(c.start) {
+			t.Errorf("%s: RollupStart = %v, want %v", c.name, start, c.start)
+			continue
+		}
+		if length := RollupEnd(start, c.granularity).Sub(start); length != c.length {
+			t.Errorf("%s: bucket lasts %v, want %v", c.name, length, c.length)
+		}
+	}
+
+	// Parked time is split at local midnight, a DST day holds 23 hours.
+	asleep := at(2026, 3, 28, 22, 0)
+	end := at(2026, 3, 30, 2, 0)
+	day := at(2026, 3, 29, 0, 0)
+	periods := []*VehicleStatePeriod{{State: VehicleStateAsleep, StartAt: asleep, EndAt: &end}}
+	if r := NewRollup(periods, day, RollupEnd(day, GranularityDay), 0, end); r.AsleepDuration != 23*time.Hour {
+		t.Errorf("asleep %v on the DST day, want 23h", r.AsleepDuration)
+	}
+}
+
+func TestRollupRefreshIncremental(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	end := now.Add(-2 * time.Hour)
+	session := &VehicleStatePeriod{
+		ID:          1,
+		VehicleID:   1,
+		State:       VehicleStateCharging,
+		StartAt:     now.Add(-3 * time.Hour),
+		EndAt:       &end,
+		EnergyAdded: 10,
+		UpdatedAt:   now.Add(-time.Hour),
+	}
+	periods := &memoryPeriods{periods: []*VehicleStatePeriod{session}}
+	rollups := &memoryRollups{}
+	veh := &Vehicle{ID: 1, UserID: 1}
+	uc := NewRollupUsecase(rollups, periods, &memoryVehicles{vehicles: []*Vehicle{veh}},
+		&memoryUsers{users: []*User{{ID: 1, TimeZone: "Europe/Berlin"}}}, log.DefaultLogger)
+	loc, _ := LoadLocation("Europe/Berlin")
+	energy := func() float64 {
+		day := RollupStart(session.StartAt, GranularityDay, loc)
+		r := rollups.rollups[GranularityDay+" "+day.Format(time.RFC3339)]
+		if r == nil {
+			return 0
+		}
+		return r.EnergyAdded
+	}
+
+	if err := uc.Refresh(ctx, veh); err != nil {
+		t.Fatal(err)
+	}
+	if energy() != 10 || !rollups.states[1].Watermark.Equal(session.UpdatedAt) {
+		t.Fatalf("first run rolled up %v kWh up to %v, want 10 kWh up to %v", energy(), rollups.states[1].Watermark, session.UpdatedAt)
+	}
+	saves := rollups.saves
+	if err := uc.Refresh(ctx, veh); err != nil {
+		t.Fatal(err)
+	}
+	if rollups.saves != saves {
+		t.Errorf("a run without changes saved %d rollups", rollups.saves-saves)
+	}
+
+	// Saved twice within the current second, the update times are equal.
+	update := func(energyAdded float64) {
+		periods.periods[0].EnergyAdded = energyAdded
+		periods.periods[0].UpdatedAt = now.Truncate(time.Second)
+	}
+	for _, want := range []float64{12, 15} {
+		update(want)
+		if err := uc.Refresh(ctx, veh); err != nil {
+			t.Fatal(err)
+		}
+		if energy() != want {
+			t.Errorf("rolled up %v kWh, want %v", energy(), want)
+		}
+	}
+}
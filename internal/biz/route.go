@@ -0,0 +1,145 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/pkg/geo"
+	"teslatrack/pkg/route"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var (
+	// ErrDriveNotFound is returned for unknown drives and drives of other users' vehicles.
+	ErrDriveNotFound = v1.ErrorDriveNotFound("drive not found")
+)
+
+// RouteUsecase turns recorded drives into position tracks for export.
+type RouteUsecase struct {
+	vehicleRepo  VehicleRepo
+	periodRepo   VehicleStatePeriodRepo
+	snapshotRepo VehicleSnapshotRepo
+	log          *log.Helper
+}
+
+// NewRouteUsecase creates a Route usecase.
+func NewRouteUsecase(
+	vehicleRepo VehicleRepo,
+	periodRepo VehicleStatePeriodRepo,
+	snapshotRepo VehicleSnapshotRepo,
+	logger log.Logger,
+) *RouteUsecase {
+	return &RouteUsecase{
+		vehicleRepo:  vehicleRepo,
+		periodRepo:   periodRepo,
+		snapshotRepo: snapshotRepo,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// Drive finds a drive of a vehicle owned by the user.
+func (uc *RouteUsecase) Drive(ctx context.Context, userID, driveID int) (*VehicleStatePeriod, error) {
+	drive, err := uc.periodRepo.FindOne(ctx, driveID)
+	if err != nil {
+		return nil, err
+	}
+	if drive == nil || drive.State != VehicleStateDriving {
+		return nil, ErrDriveNotFound
+	}
+	if _, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, drive.VehicleID); err != nil {
+		if errors.Is(err, ErrVehicleNotFound) {
+			return nil, ErrDriveNotFound
+		}
+		return nil, err
+	}
+	return drive, nil
+}
+
+// Drives lists the drives of a vehicle owned by the user that started in [from, to).
+func (uc *RouteUsecase) Drives(ctx context.Context, userID, vehicleID int, from, to time.Time) ([]*VehicleStatePeriod, error) {
+	if _, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, vehicleID); err != nil {
+		return nil, err
+	}
+	periods, err := uc.periodRepo.ListByVehicle(ctx, vehicleID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	var drives []*VehicleStatePeriod
+	for _, p := range periods {
+		if p.State == VehicleStateDriving && !p.StartAt.Before(from) {
+			drives = append(drives, p)
+		}
+	}
+	return drives, nil
+}
+
+// Track loads the positions recorded during a drive in the requested datum.
+func (uc *RouteUsecase) Track(ctx context.Context, drive *VehicleStatePeriod, coordType geo.CoordType) (*route.Track, error) {
+	end := time.Now()
+	if drive.EndAt != nil {
+		end = *drive.EndAt
+	}
+	// The end is inclusive, the closing snapshot carries the final position.
+	snapshots, err := uc.snapshotRepo.ListByVehicle(ctx, drive.VehicleID, drive.StartAt, end.Add(time.Second))
+	if err != nil {
+		return nil, err
+	}
+	track := &route.Track{
+		Name:   fmt.Sprintf("Drive %s", drive.StartAt.Format("2006-01-02 15:04")),
+		Points: make([]route.Point, 0, len(snapshots)),
+	}
+	if drive.StartAddress != "" || drive.EndAddress != "" {
+		track.Description = drive.StartAddress + " → " + drive.EndAddress
+	}
+	for _, s := range snapshots {
+		if !s.HasData() || (s.Latitude == 0 && s.Longitude == 0) {
+			continue
+		}
+		lat, lon := geo.Convert(s.Latitude, s.Longitude, geo.WGS84, coordType)
+		track.Points = append(track.Points, route.Point{
+			Time:         s.CreatedAt,
+			Latitude:     lat,
+			Longitude:    lon,
+			Heading:      s.Heading,
+			Speed:        s.Speed,
+			Power:        s.Power,
+			BatteryLevel: s.BatteryLevel,
+		})
+	}
+	return track, nil
+}
+
+// ExportDrive writes a single drive of the user.
+func (uc *RouteUsecase) ExportDrive(ctx context.Context, userID, driveID int, coordType geo.CoordType, enc route.Encoder) error {
+	drive, err := uc.Drive(ctx, userID, driveID)
+	if err != nil {
+		return err
+	}
+	track, err := uc.Track(ctx, drive, coordType)
+	if err != nil {
+		return err
+	}
+	return enc.Encode(track)
+}
+
+// ExportRange writes every drive of a vehicle of the user started in [from, to), one track per drive.
+// It returns the number of drives written.
+func (uc *RouteUsecase) ExportRange(ctx context.Context, userID, vehicleID int, from, to time.Time, coordType geo.CoordType, enc route.Encoder) (int, error) {
+	drives, err := uc.Drives(ctx, userID, vehicleID, from, to)
+	if err != nil {
+		return 0, err
+	}
+	for i, drive := range drives {
+		track, err := uc.Track(ctx, drive, coordType)
+		if err != nil {
+			return i, err
+		}
+		if err := enc.Encode(track); err != nil {
+			return i, err
+		}
+	}
+	return len(drives), nil
+}
@@ -0,0 +1,299 @@
+package biz
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Statuses of a software update. The other statuses are passed through from the Fleet API,
+// e.g., available, scheduled, downloading, downloading_wifi_wait.
+const (
+	SoftwareUpdateInstalling = "installing"
+	SoftwareUpdateInstalled  = "installed"
+	// SoftwareUpdateSuperseded marks a pending update the vehicle replaced by another target version
+	// before installing it.
+	SoftwareUpdateSuperseded = "superseded"
+)
+
+// recentInstallWindow is how far back installs count as recent in the fleet statistics.
+const recentInstallWindow = 7 * 24 * time.Hour
+
+// SoftwareUpdate is one entry of the software update history of a vehicle.
+type SoftwareUpdate struct {
+	// ID is the unique identifier of the update.
+	ID int
+	// VehicleID is the ID of the vehicle.
+	VehicleID int
+	// FromVersion is the version installed before the update, empty for the first version seen.
+	FromVersion string
+	// Version is the target version.
+	Version string
+	// Status is the update status, see the SoftwareUpdate constants.
+	Status string
+	// DownloadPerc is the download progress in percent.
+	DownloadPerc int
+	// InstallPerc is the install progress in percent.
+	InstallPerc int
+	// ExpectedDuration is the expected install duration.
+	ExpectedDuration time.Duration
+	// AvailableAt is the time the update was first seen.
+	AvailableAt time.Time
+	// InstallStartedAt is the time the installation started, nil when not observed.
+	InstallStartedAt *time.Time
+	// CompletedAt is the time the vehicle was first seen running the version, nil while pending
+	// and for a superseded update.
+	CompletedAt *time.Time
+}
+
+// Pending reports whether the update is not installed yet and was not superseded.
+func (u *SoftwareUpdate) Pending() bool {
+	return u.CompletedAt == nil && u.Status != SoftwareUpdateSuperseded
+}
+
+// SoftwareUpdateRepo defines the data access layer for SoftwareUpdate.
+type SoftwareUpdateRepo interface {
+	// Create saves a new update.
+	Create(ctx context.Context, update *SoftwareUpdate) error
+	// Update saves the progress of an update.
+	Update(ctx context.Context, update *SoftwareUpdate) error
+	// LatestInstalled finds the most recently installed update of a vehicle, returns nil if none exists.
+	LatestInstalled(ctx context.Context, vehicleID int) (*SoftwareUpdate, error)
+	// LatestPending finds the most recent pending update of a vehicle that was not superseded,
+	// returns nil if none exists.
+	LatestPending(ctx context.Context, vehicleID int) (*SoftwareUpdate, error)
+	// ListByVehicle lists the updates of a vehicle, newest first.
+	ListByVehicle(ctx context.Context, vehicleID int) ([]*SoftwareUpdate, error)
+	// ListInstalled lists the installed updates of all vehicles.
+	// Only the vehicle, version and completion time are loaded.
+	ListInstalled(ctx context.Context) ([]*SoftwareUpdate, error)
+	// ListInstalledPage lists one page of the installed updates of a vehicle by completion time, newest first.
+	// The first version seen is not an update and is left out.
+	ListInstalledPage(ctx context.Context, vehicleID int, page *TimelinePage) ([]*SoftwareUpdate, error)
+}
+
+// VersionCount is the adoption of one software version across the fleet.
+type VersionCount struct {
+	// Version is the software version.
+	Version string
+	// Vehicles is the number of vehicles currently running the version.
+	Vehicles int
+	// Share is the share of the fleet running the version, from 0 to 1.
+	Share float64
+	// Installs is the number of vehicles that ever installed the version.
+	Installs int
+	// RecentInstalls is the number of installs in the last seven days.
+	RecentInstalls int
+	// FirstInstalledAt is the first time any vehicle was seen running the version.
+	FirstInstalledAt time.Time
+}
+
+// FleetVersions is the distribution of software versions across the fleet.
+type FleetVersions struct {
+	// Vehicles is the number of vehicles with a known version.
+	Vehicles int
+	// Versions are ordered by the number of vehicles running them, then by version, newest first.
+	Versions []*VersionCount
+}
+
+// NormalizeVersion strips the build hash from a version, e.g., "2024.14.3 c1a2b3d4e5" becomes "2024.14.3".
+func NormalizeVersion(version string) string {
+	if fields := strings.Fields(version); len(fields) > 0 {
+		return fields[0]
+	}
+	return ""
+}
+
+// CountVersions computes the fleet statistics from the installed updates of all vehicles.
+func CountVersions(installed []*SoftwareUpdate, now time.Time) *FleetVersions {
+	current := map[int]*SoftwareUpdate{}
+	counts := map[string]*VersionCount{}
+	for _, u := range installed {
+		if u.CompletedAt == nil {
+			continue
+		}
+		if c, ok := current[u.VehicleID]; !ok || u.CompletedAt.After(*c.CompletedAt) {
+			current[u.VehicleID] = u
+		}
+		c, ok := counts[u.Version]
+		if !ok {
+			c = &VersionCount{Version: u.Version, FirstInstalledAt: *u.CompletedAt}
+			counts[u.Version] = c
+		}
+		c.Installs++
+		if u.CompletedAt.Before(c.FirstInstalledAt) {
+			c.FirstInstalledAt = *u.CompletedAt
+		}
+		// The first version seen of a vehicle was not installed while tracked.
+		if u.FromVersion != "" && now.Sub(*u.CompletedAt) < recentInstallWindow {
+			c.RecentInstalls++
+		}
+	}
+	fleet := &FleetVersions{Vehicles: len(current)}
+	for _, u := range current {
+		counts[u.Version].Vehicles++
+	}
+	for _, c := range counts {
+		c.Share = float64(c.Vehicles) / float64(fleet.Vehicles)
+		fleet.Versions = append(fleet.Versions, c)
+	}
+	sort.Slice(fleet.Versions, func(i, j int) bool {
+		a, b := fleet.Versions[i], fleet.Versions[j]
+		if a.Vehicles != b.Vehicles {
+			return a.Vehicles > b.Vehicles
+		}
+		return CompareVersions(a.Version, b.Version) > 0
+	})
+	return fleet
+}
+
+// CompareVersions compares two versions such as 2024.8.9 and 2024.14.3 numerically
+// component by component. It returns -1, 0 or 1.
+func CompareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < max(len(as), len(bs)); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// SoftwareUsecase maintains the software update history of the vehicles.
+type SoftwareUsecase struct {
+	repo        SoftwareUpdateRepo
+	vehicleRepo VehicleRepo
+	log         *log.Helper
+}
+
+// NewSoftwareUsecase creates a Software usecase.
+func NewSoftwareUsecase(repo SoftwareUpdateRepo, vehicleRepo VehicleRepo, logger log.Logger) *SoftwareUsecase {
+	return &SoftwareUsecase{repo: repo, vehicleRepo: vehicleRepo, log: log.NewHelper(logger)}
+}
+
+// Track applies a snapshot to the update history of its vehicle.
+// A change of the running version completes the matching pending update, or is recorded
+// on its own when the update was installed unobserved. A pending update reported by the
+// vehicle is created or has its progress updated. A pending update replaced by another
+// target version, or overtaken by the running version, is marked superseded.
+func (uc *SoftwareUsecase) Track(ctx context.Context, s *VehicleSnapshot) error {
+	running := NormalizeVersion(s.CarVersion)
+	if !s.HasData() || running == "" {
+		return nil
+	}
+	installed, err := uc.repo.LatestInstalled(ctx, s.VehicleID)
+	if err != nil {
+		return err
+	}
+	pending, err := uc.repo.LatestPending(ctx, s.VehicleID)
+	if err != nil {
+		return err
+	}
+	if installed == nil || installed.Version != running {
+		if err := uc.complete(ctx, s, running, installed, pending); err != nil {
+			return err
+		}
+		if pending != nil && pending.Version == running {
+			pending = nil
+		}
+		if pending != nil && CompareVersions(running, pending.Version) > 0 {
+			if err := uc.supersede(ctx, pending); err != nil {
+				return err
+			}
+			pending = nil
+		}
+	}
+	target := NormalizeVersion(s.SoftwareUpdateVersion)
+	if s.SoftwareUpdateStatus == "" || target == "" || target == running {
+		return nil
+	}
+	if pending != nil && pending.Version != target {
+		if err := uc.supersede(ctx, pending); err != nil {
+			return err
+		}
+		pending = nil
+	}
+	if pending == nil {
+		pending = &SoftwareUpdate{VehicleID: s.VehicleID, FromVersion: running, Version: target, AvailableAt: s.CreatedAt}
+		applyUpdateProgress(pending, s)
+		uc.log.WithContext(ctx).Infow("msg", "software update available", "vehicleID", s.VehicleID, "version", target)
+		return uc.repo.Create(ctx, pending)
+	}
+	applyUpdateProgress(pending, s)
+	return uc.repo.Update(ctx, pending)
+}
+
+// complete records that the vehicle runs a new version.
+func (uc *SoftwareUsecase) complete(ctx context.Context, s *VehicleSnapshot, running string, installed, pending *SoftwareUpdate) error {
+	at := s.CreatedAt
+	if pending != nil && pending.Version == running {
+		pending.Status = SoftwareUpdateInstalled
+		pending.InstallPerc = 100
+		pending.CompletedAt = &at
+		uc.log.WithContext(ctx).Infow("msg", "software update installed", "vehicleID", s.VehicleID, "version", running)
+		return uc.repo.Update(ctx, pending)
+	}
+	update := &SoftwareUpdate{
+		VehicleID:   s.VehicleID,
+		Version:     running,
+		Status:      SoftwareUpdateInstalled,
+		AvailableAt: at,
+		CompletedAt: &at,
+	}
+	if installed != nil {
+		update.FromVersion = installed.Version
+	}
+	return uc.repo.Create(ctx, update)
+}
+
+// supersede marks a pending update the vehicle will not install.
+func (uc *SoftwareUsecase) supersede(ctx context.Context, pending *SoftwareUpdate) error {
+	pending.Status = SoftwareUpdateSuperseded
+	uc.log.WithContext(ctx).Infow("msg", "software update superseded", "vehicleID", pending.VehicleID, "version", pending.Version)
+	return uc.repo.Update(ctx, pending)
+}
+
+// applyUpdateProgress copies the update progress of a snapshot.
+func applyUpdateProgress(u *SoftwareUpdate, s *VehicleSnapshot) {
+	u.Status = s.SoftwareUpdateStatus
+	u.DownloadPerc = s.SoftwareUpdateDownloadPerc
+	u.InstallPerc = s.SoftwareUpdateInstallPerc
+	if s.SoftwareUpdateExpectedDuration > 0 {
+		u.ExpectedDuration = s.SoftwareUpdateExpectedDuration
+	}
+	if s.SoftwareUpdateStatus == SoftwareUpdateInstalling && u.InstallStartedAt == nil {
+		at := s.CreatedAt
+		u.InstallStartedAt = &at
+	}
+}
+
+// History lists the software updates of a vehicle of the user, newest first.
+func (uc *SoftwareUsecase) History(ctx context.Context, userID, vehicleID int) ([]*SoftwareUpdate, error) {
+	if _, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, vehicleID); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListByVehicle(ctx, vehicleID)
+}
+
+// FleetVersions computes which software versions the tracked vehicles run.
+func (uc *SoftwareUsecase) FleetVersions(ctx context.Context) (*FleetVersions, error) {
+	installed, err := uc.repo.ListInstalled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return CountVersions(installed, time.Now()), nil
+}
@@ -0,0 +1,56 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestSoftwareTrackSupersedes(t *testing.T) {
+	repo := &memorySoftware{}
+	uc := NewSoftwareUsecase(repo, nil, log.DefaultLogger)
+	start := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	snapshot := func(hour int, running, target string) *VehicleSnapshot {
+		s := &VehicleSnapshot{
+			VehicleID:  1,
+			State:      TeslaStateOnline,
+			CarVersion: running + " c1a2b3d4e5",
+			CreatedAt:  start.Add(time.Duration(hour) * time.Hour),
+		}
+		if target != "" {
+			s.SoftwareUpdateStatus, s.SoftwareUpdateVersion = "available", target
+		}
+		return s
+	}
+
+	cases := []struct {
+		name     string
+		snapshot *VehicleSnapshot
+		statuses []string
+	}{
+		{"first version", snapshot(0, "2026.8.1", ""), []string{"installed"}},
+		{"update available", snapshot(1, "2026.8.1", "2026.8.3"), []string{"installed", "available"}},
+		{"target replaced", snapshot(2, "2026.8.1", "2026.8.6"), []string{"installed", "superseded", "available"}},
+		{"same target", snapshot(3, "2026.8.1", "2026.8.6"), []string{"installed", "superseded", "available"}},
+		{"overtaken by an unobserved install", snapshot(4, "2026.14.2", ""), []string{"installed", "superseded", "superseded", "installed"}},
+	}
+	for _, c := range cases {
+		if err := uc.Track(context.Background(), c.snapshot); err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if len(repo.updates) != len(c.statuses) {
+			t.Errorf("%s: %d updates, want %d", c.name, len(repo.updates), len(c.statuses))
+			continue
+		}
+		for i, u := range repo.updates {
+			if u.Status != c.statuses[i] {
+				t.Errorf("%s: update %d of %s is %s, want %s", c.name, i, u.Version, u.Status, c.statuses[i])
+			}
+		}
+	}
+	if pending, _ := repo.LatestPending(context.Background(), 1); pending != nil {
+		t.Errorf("LatestPending = %s, want none", pending.Version)
+	}
+}
@@ -0,0 +1,400 @@
+package biz
+
+import (
+	"context"
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/pkg/pricing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Tariff kinds.
+const (
+	TariffKindFlat = "flat"
+	TariffKindTOU  = "tou"
+)
+
+var (
+	// ErrTariffNotFound is returned for unknown tariffs and tariffs of other users.
+	ErrTariffNotFound = v1.ErrorTariffNotFound("tariff not found")
+	// ErrTariffInvalid is returned for tariffs with an unknown kind, time zone or malformed windows.
+	ErrTariffInvalid = v1.ErrorTariffInvalid("invalid tariff")
+	// ErrChargeNotFound is returned for unknown charging sessions and sessions of other users' vehicles.
+	ErrChargeNotFound = v1.ErrorChargeNotFound("charging session not found")
+)
+
+// Tariff is the electricity price a user pays.
+// The default tariff has no geofence; a geofence tariff overrides it for sessions in that geofence.
+type Tariff struct {
+	// ID is the unique identifier of the tariff.
+	ID int
+	// UserID is the owner of the tariff.
+	UserID int
+	// Name is the display name, e.g., Home peak/valley.
+	Name string
+	// Kind is flat or tou.
+	Kind string
+	// GeofenceID is the geofence the tariff applies to, nil for the default tariff.
+	GeofenceID *int
+	// Currency is the ISO-4217 currency code.
+	Currency string
+	// Price is the price per kWh, outside all windows for time-of-use tariffs.
+	Price float64
+	// Windows are the time-of-use windows.
+	Windows []pricing.Window
+	// TimeZone is the IANA time zone the windows are defined in.
+	TimeZone string
+	// CreatedAt is the creation time.
+	CreatedAt time.Time
+	// UpdatedAt is the last update time.
+	UpdatedAt time.Time
+}
+
+// Schedule returns the pricing schedule of the tariff.
+func (t *Tariff) Schedule() (*pricing.Schedule, error) {
+	loc, err := LoadLocation(t.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	s := &pricing.Schedule{Price: t.Price, Location: loc}
+	if t.Kind == TariffKindTOU {
+		s.Windows = t.Windows
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// TariffRepo defines the data access layer for Tariff.
+type TariffRepo interface {
+	// Create saves a new tariff.
+	Create(ctx context.Context, t *Tariff) error
+	// Update saves an existing tariff.
+	Update(ctx context.Context, t *Tariff) error
+	// Delete removes a tariff.
+	Delete(ctx context.Context, id int) error
+	// FindOne finds a tariff by its ID, returns nil if none exists.
+	FindOne(ctx context.Context, id int) (*Tariff, error)
+	// ListByUser lists the tariffs of a user ordered by ID.
+	ListByUser(ctx context.Context, userID int) ([]*Tariff, error)
+}
+
+// MonthlyCost is the charging cost of a vehicle in a calendar month.
+type MonthlyCost struct {
+	// Month is the first instant of the month.
+	Month time.Time
+	// Sessions is the number of charging sessions.
+	Sessions int
+	// EnergyAdded is the energy added in kWh.
+	EnergyAdded float64
+	// Cost is the total cost of the sessions with a known cost.
+	Cost float64
+	// UnpricedSessions is the number of sessions without a cost, e.g., Supercharger sessions awaiting manual entry.
+	UnpricedSessions int
+	// Distance is the distance driven in km.
+	Distance float64
+}
+
+// CostPer100Km returns the charging cost per 100 km driven, zero without driving.
+func (m *MonthlyCost) CostPer100Km() float64 {
+	if m.Distance <= 0 {
+		return 0
+	}
+	return m.Cost / m.Distance * 100
+}
+
+// TariffUsecase manages tariffs and prices charging sessions.
+type TariffUsecase struct {
+	repo         TariffRepo
+	geofenceRepo GeofenceRepo
+	vehicleRepo  VehicleRepo
+	periodRepo   VehicleStatePeriodRepo
+	snapshotRepo VehicleSnapshotRepo
+	log          *log.Helper
+}
+
+// NewTariffUsecase creates a Tariff usecase.
+func NewTariffUsecase(
+	repo TariffRepo,
+	geofenceRepo GeofenceRepo,
+	vehicleRepo VehicleRepo,
+	periodRepo VehicleStatePeriodRepo,
+	snapshotRepo VehicleSnapshotRepo,
+	logger log.Logger,
+) *TariffUsecase {
+	return &TariffUsecase{
+		repo:         repo,
+		geofenceRepo: geofenceRepo,
+		vehicleRepo:  vehicleRepo,
+		periodRepo:   periodRepo,
+		snapshotRepo: snapshotRepo,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// validate checks a tariff of the user before it is saved.
+func (uc *TariffUsecase) validate(ctx context.Context, t *Tariff) error {
+	if t.Kind != TariffKindFlat && t.Kind != TariffKindTOU {
+		return ErrTariffInvalid
+	}
+	if t.TimeZone == "" {
+		t.TimeZone = DefaultTimeZone
+	}
+	if _, err := LoadLocation(t.TimeZone); err != nil {
+		return err
+	}
+	if t.Currency == "" {
+		t.Currency = "CNY"
+	}
+	if _, err := t.Schedule(); err != nil {
+		return ErrTariffInvalid.WithCause(err)
+	}
+	if t.GeofenceID != nil {
+		g, err := uc.geofenceRepo.FindOne(ctx, *t.GeofenceID)
+		if err != nil {
+			return err
+		}
+		if g == nil || g.UserID != t.UserID {
+			return ErrGeofenceNotFound
+		}
+	}
+	return nil
+}
+
+// Create adds a tariff for its user.
+func (uc *TariffUsecase) Create(ctx context.Context, t *Tariff) error {
+	if err := uc.validate(ctx, t); err != nil {
+		return err
+	}
+	return uc.repo.Create(ctx, t)
+}
+
+// Update changes a tariff of the user.
+// Existing sessions keep their cost until they are recalculated.
+func (uc *TariffUsecase) Update(ctx context.Context, userID int, t *Tariff) error {
+	if _, err := uc.Get(ctx, userID, t.ID); err != nil {
+		return err
+	}
+	t.UserID = userID
+	if err := uc.validate(ctx, t); err != nil {
+		return err
+	}
+	return uc.repo.Update(ctx, t)
+}
+
+// Delete removes a tariff of the user.
+func (uc *TariffUsecase) Delete(ctx context.Context, userID, id int) error {
+	if _, err := uc.Get(ctx, userID, id); err != nil {
+		return err
+	}
+	return uc.repo.Delete(ctx, id)
+}
+
+// Get finds a tariff of the user.
+func (uc *TariffUsecase) Get(ctx context.Context, userID, id int) (*Tariff, error) {
+	t, err := uc.repo.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil || t.UserID != userID {
+		return nil, ErrTariffNotFound
+	}
+	return t, nil
+}
+
+// List lists the tariffs of the user.
+func (uc *TariffUsecase) List(ctx context.Context, userID int) ([]*Tariff, error) {
+	return uc.repo.ListByUser(ctx, userID)
+}
+
+// selectTariff picks the tariff of a charging session: the tariff of its geofence,
+// otherwise the default tariff. DC fast charging outside a priced geofence is left
+// unpriced, public chargers bill per session and their cost is entered manually.
+func selectTariff(tariffs []*Tariff, session *VehicleStatePeriod) *Tariff {
+	if session.StartGeofenceID != nil {
+		for _, t := range tariffs {
+			if t.GeofenceID != nil && *t.GeofenceID == *session.StartGeofenceID {
+				return t
+			}
+		}
+	}
+	if session.FastCharger {
+		return nil
+	}
+	for _, t := range tariffs {
+		if t.GeofenceID == nil {
+			return t
+		}
+	}
+	return nil
+}
+
+// Price calculates the cost of a charging session of the user in place.
+// Manually priced sessions are left untouched.
+func (uc *TariffUsecase) Price(ctx context.Context, userID int, session *VehicleStatePeriod) error {
+	if session.State != VehicleStateCharging || session.CostManual {
+		return nil
+	}
+	tariffs, err := uc.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	session.Cost, session.TariffID = nil, nil
+	t := selectTariff(tariffs, session)
+	if t == nil {
+		return nil
+	}
+	schedule, err := t.Schedule()
+	if err != nil {
+		return err
+	}
+	samples, err := uc.chargeCurve(ctx, session)
+	if err != nil {
+		return err
+	}
+	cost, _ := schedule.Cost(samples)
+	session.Cost, session.TariffID = &cost, &t.ID
+	return nil
+}
+
+// chargeCurve returns the cumulative energy added during a session, counted from its start.
+// Sessions without samples are assumed to have charged evenly.
+func (uc *TariffUsecase) chargeCurve(ctx context.Context, session *VehicleStatePeriod) ([]pricing.Sample, error) {
+	end := time.Now()
+	if session.EndAt != nil {
+		end = *session.EndAt
+	}
+	snapshots, err := uc.snapshotRepo.ListByVehicle(ctx, session.VehicleID, session.StartAt, end.Add(time.Second))
+	if err != nil {
+		return nil, err
+	}
+	samples := []pricing.Sample{{Time: session.StartAt}}
+	counter := chargeCounter{last: session.StartEnergyAdded}
+	for _, s := range snapshots {
+		if !s.HasData() {
+			continue
+		}
+		if energy := counter.add(s.ChargeEnergyAdded); energy > 0 {
+			samples = append(samples, pricing.Sample{Time: s.CreatedAt, Energy: energy})
+		}
+	}
+	if len(samples) == 1 {
+		samples = append(samples, pricing.Sample{Time: end, Energy: session.EnergyAdded})
+	}
+	return samples, nil
+}
+
+// session finds a charging session of a vehicle owned by the user.
+func (uc *TariffUsecase) session(ctx context.Context, userID, id int) (*VehicleStatePeriod, error) {
+	session, err := uc.periodRepo.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil || session.State != VehicleStateCharging {
+		return nil, ErrChargeNotFound
+	}
+	if _, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, session.VehicleID); err != nil {
+		if errors.Is(err, ErrVehicleNotFound) {
+			return nil, ErrChargeNotFound
+		}
+		return nil, err
+	}
+	return session, nil
+}
+
+// SetManualCost records the cost of a session, e.g., from a Supercharger invoice.
+// A nil cost reverts the session to the tariff price.
+func (uc *TariffUsecase) SetManualCost(ctx context.Context, userID, sessionID int, cost *float64) (*VehicleStatePeriod, error) {
+	session, err := uc.session(ctx, userID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if cost != nil {
+		session.Cost, session.TariffID, session.CostManual = cost, nil, true
+	} else {
+		session.CostManual = false
+		if err := uc.Price(ctx, userID, session); err != nil {
+			return nil, err
+		}
+	}
+	if err := uc.periodRepo.UpdateCost(ctx, session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// Recalculate prices again every finished, not manually priced charging session of the
+// user's vehicles started in [from, to), e.g., after a tariff changed. A vehicleID of zero
+// selects all vehicles of the user. It returns the number of sessions updated.
+func (uc *TariffUsecase) Recalculate(ctx context.Context, userID, vehicleID int, from, to time.Time) (int, error) {
+	vehicles, err := uc.vehicles(ctx, userID, vehicleID)
+	if err != nil {
+		return 0, err
+	}
+	updated := 0
+	for _, veh := range vehicles {
+		periods, err := uc.periodRepo.ListByVehicle(ctx, veh.ID, from, to)
+		if err != nil {
+			return updated, err
+		}
+		for _, p := range periods {
+			if p.State != VehicleStateCharging || p.CostManual || p.EndAt == nil || p.StartAt.Before(from) {
+				continue
+			}
+			if err := uc.Price(ctx, userID, p); err != nil {
+				return updated, err
+			}
+			if err := uc.periodRepo.UpdateCost(ctx, p); err != nil {
+				return updated, err
+			}
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+// Monthly rolls up the charging cost of a vehicle of the user in the month starting at month.
+func (uc *TariffUsecase) Monthly(ctx context.Context, userID, vehicleID int, month time.Time) (*MonthlyCost, error) {
+	if _, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, vehicleID); err != nil {
+		return nil, err
+	}
+	end := month.AddDate(0, 1, 0)
+	periods, err := uc.periodRepo.ListByVehicle(ctx, vehicleID, month, end)
+	if err != nil {
+		return nil, err
+	}
+	m := &MonthlyCost{Month: month}
+	for _, p := range periods {
+		if p.StartAt.Before(month) {
+			continue
+		}
+		switch p.State {
+		case VehicleStateCharging:
+			m.Sessions++
+			m.EnergyAdded += p.EnergyAdded
+			if p.Cost != nil {
+				m.Cost += *p.Cost
+			} else {
+				m.UnpricedSessions++
+			}
+		case VehicleStateDriving:
+			m.Distance += p.EndOdometer - p.StartOdometer
+		}
+	}
+	return m, nil
+}
+
+// vehicles returns the given vehicle of the user, or all of them for a zero vehicleID.
+func (uc *TariffUsecase) vehicles(ctx context.Context, userID, vehicleID int) ([]*Vehicle, error) {
+	if vehicleID == 0 {
+		return uc.vehicleRepo.FindByUserID(ctx, userID)
+	}
+	veh, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	return []*Vehicle{veh}, nil
+}
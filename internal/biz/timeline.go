@@ -0,0 +1,364 @@
+package biz
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	v1 "teslatrack/api/teslatrack/v1"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Types of timeline items.
+const (
+	TimelineDrive  = "drive"
+	TimelineCharge = "charge"
+	TimelinePark   = "park"
+	TimelineSleep  = "sleep"
+	TimelineUpdate = "update"
+	TimelineEvent  = "event"
+)
+
+// Page sizes of the timeline.
+const (
+	defaultTimelineLimit = 50
+	maxTimelineLimit     = 200
+)
+
+var (
+	// ErrTimelineCursorInvalid is returned for a cursor not issued by the timeline.
+	ErrTimelineCursorInvalid = v1.ErrorTimelineCursorInvalid("the timeline cursor is invalid")
+	// ErrTimelineTypeInvalid is returned for an unknown timeline item type.
+	ErrTimelineTypeInvalid = v1.ErrorTimelineTypeInvalid("type must be drive, charge, park, sleep, update or event")
+)
+
+// timelinePeriodStates maps the period based item types to the period states they cover.
+// Updating periods are left out as the software update history covers them.
+var timelinePeriodStates = map[string][]string{
+	TimelineDrive:  {VehicleStateDriving},
+	TimelineCharge: {VehicleStateCharging},
+	TimelinePark:   {VehicleStateOnline, VehicleStateOffline},
+	TimelineSleep:  {VehicleStateAsleep},
+}
+
+// VehicleEvent is a notable event of a vehicle recorded from the EventBus.
+type VehicleEvent struct {
+	// ID is the unique identifier of the event.
+	ID int
+	// VehicleID is the ID of the vehicle.
+	VehicleID int
+	// UserID is the owner of the vehicle.
+	UserID int
+	// Type is the event type, see the Event constants.
+	Type string
+	// Summary is a human readable summary, e.g., Entered Home.
+	Summary string
+	// Data holds the type specific attributes, e.g., geofence_id or wheel.
+	Data map[string]string
+	// Time is the time the event happened.
+	Time time.Time
+}
+
+// NewVehicleEvent builds the record of a bus event, returns nil for events that are not recorded.
+func NewVehicleEvent(e *Event) *VehicleEvent {
+	ve := &VehicleEvent{VehicleID: e.VehicleID, UserID: e.UserID, Type: e.Type, Time: e.Time}
+	switch p := e.Payload.(type) {
+	case *GeofenceEvent:
+		if e.Type == EventGeofenceExit {
+			ve.Summary = "Left " + p.Geofence.Name
+		} else {
+			ve.Summary = "Entered " + p.Geofence.Name
+		}
+		ve.Data = map[string]string{
+			"geofence_id": strconv.Itoa(p.Geofence.ID),
+			"geofence":    p.Geofence.Name,
+			"latitude":    strconv.FormatFloat(p.Latitude, 'f', -1, 64),
+			"longitude":   strconv.FormatFloat(p.Longitude, 'f', -1, 64),
+		}
+	case *TireEvent:
+		if e.Type == EventTireSlowLeak {
+			ve.Summary = fmt.Sprintf("Slow leak on tire %s", p.Wheel)
+		} else {
+			ve.Summary = fmt.Sprintf("Low pressure on tire %s", p.Wheel)
+		}
+		ve.Data = map[string]string{
+			"wheel":       p.Wheel.String(),
+			"pressure":    strconv.FormatFloat(p.Pressure, 'f', -1, 64),
+			"recommended": strconv.FormatFloat(p.Recommended, 'f', -1, 64),
+		}
+		if p.LeakRate > 0 {
+			ve.Data["leak_rate"] = strconv.FormatFloat(p.LeakRate, 'f', -1, 64)
+		}
+	default:
+		return nil
+	}
+	return ve
+}
+
+// VehicleEventRepo defines the data access layer for VehicleEvent.
+type VehicleEventRepo interface {
+	// Create saves a new event.
+	Create(ctx context.Context, event *VehicleEvent) error
+	// ListPage lists one page of the events of a vehicle, newest first.
+	ListPage(ctx context.Context, vehicleID int, page *TimelinePage) ([]*VehicleEvent, error)
+}
+
+// TimelinePage selects one page of a timeline source, newest first by time and then ID.
+type TimelinePage struct {
+	// From is the earliest time listed, zero for no bound.
+	From time.Time
+	// To is the time before which items are listed, zero for no bound.
+	To time.Time
+	// Before lists the items older than this time, zero for the first page.
+	Before time.Time
+	// BeforeID also lists the items at Before with an ID below it.
+	BeforeID int
+	// Limit is the maximum number of items listed.
+	Limit int
+}
+
+// TimelineItem is one entry of the timeline, exactly one of Period, Update and Event is set.
+type TimelineItem struct {
+	// Type is the item type, see the Timeline constants.
+	Type string
+	// StartAt is the time the item starts, the timeline is ordered by it.
+	// Software updates are placed at their completion.
+	StartAt time.Time
+	// EndAt is the time the item ends, nil for open periods and instantaneous items.
+	EndAt *time.Time
+	// Period is the drive, charging session or parked period.
+	Period *VehicleStatePeriod
+	// Update is the installed software update.
+	Update *SoftwareUpdate
+	// Event is the notable event.
+	Event *VehicleEvent
+
+	source timelineSource
+	id     int
+}
+
+// timelineSource orders the items of the sources that start at the same time.
+type timelineSource int
+
+const (
+	timelineSourcePeriod timelineSource = iota
+	timelineSourceUpdate
+	timelineSourceEvent
+)
+
+// timelineCursor is the position of the last item of a page.
+type timelineCursor struct {
+	time   time.Time
+	source timelineSource
+	id     int
+}
+
+// String encodes the cursor as an opaque token.
+func (c *timelineCursor) String() string {
+	raw := fmt.Sprintf("%d.%d.%d", c.time.UnixNano(), c.source, c.id)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// parseTimelineCursor decodes a token returned by timelineCursor.String.
+func parseTimelineCursor(token string) (*timelineCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrTimelineCursorInvalid
+	}
+	var nanos int64
+	var source, id int
+	if _, err := fmt.Sscanf(string(raw), "%d.%d.%d", &nanos, &source, &id); err != nil {
+		return nil, ErrTimelineCursorInvalid
+	}
+	if source < int(timelineSourcePeriod) || source > int(timelineSourceEvent) {
+		return nil, ErrTimelineCursorInvalid
+	}
+	return &timelineCursor{time: time.Unix(0, nanos), source: timelineSource(source), id: id}, nil
+}
+
+// page returns the page of a source following the cursor.
+func (c *timelineCursor) page(source timelineSource, page TimelinePage) *TimelinePage {
+	if c == nil {
+		return &page
+	}
+	page.Before = c.time
+	switch {
+	case source < c.source:
+		page.BeforeID = 0
+	case source == c.source:
+		page.BeforeID = c.id
+	default:
+		page.BeforeID = math.MaxInt
+	}
+	return &page
+}
+
+// TimelineFilter selects the items of a timeline.
+type TimelineFilter struct {
+	// Types are the item types listed, all types when empty.
+	Types []string
+	// From is the earliest start time listed, zero for no bound.
+	From time.Time
+	// To is the start time before which items are listed, zero for no bound.
+	To time.Time
+	// Cursor is the NextCursor of the previous page, empty for the first page.
+	Cursor string
+	// Limit is the page size, defaults to 50 and is capped at 200.
+	Limit int
+}
+
+// Timeline is one page of the timeline of a vehicle.
+type Timeline struct {
+	// Items are the items, newest first.
+	Items []*TimelineItem
+	// NextCursor continues the timeline, empty on the last page.
+	NextCursor string
+}
+
+// TimelineUsecase merges the periods, software updates and notable events of a vehicle into one timeline.
+type TimelineUsecase struct {
+	repo         VehicleEventRepo
+	vehicleRepo  VehicleRepo
+	periodRepo   VehicleStatePeriodRepo
+	softwareRepo SoftwareUpdateRepo
+	log          *log.Helper
+}
+
+// NewTimelineUsecase creates a Timeline usecase.
+func NewTimelineUsecase(repo VehicleEventRepo, vehicleRepo VehicleRepo, periodRepo VehicleStatePeriodRepo, softwareRepo SoftwareUpdateRepo, logger log.Logger) *TimelineUsecase {
+	return &TimelineUsecase{
+		repo:         repo,
+		vehicleRepo:  vehicleRepo,
+		periodRepo:   periodRepo,
+		softwareRepo: softwareRepo,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// Record saves a bus event when it is a notable event.
+func (uc *TimelineUsecase) Record(ctx context.Context, e *Event) error {
+	ve := NewVehicleEvent(e)
+	if ve == nil {
+		return nil
+	}
+	return uc.repo.Create(ctx, ve)
+}
+
+// List returns one page of the timeline of a vehicle of the user.
+// Every source is asked for one item more than the page holds, so the merged
+// page tells whether another page follows.
+func (uc *TimelineUsecase) List(ctx context.Context, userID, vehicleID int, f *TimelineFilter) (*Timeline, error) {
+	if _, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, vehicleID); err != nil {
+		return nil, err
+	}
+	types, err := timelineTypes(f.Types)
+	if err != nil {
+		return nil, err
+	}
+	var cursor *timelineCursor
+	if f.Cursor != "" {
+		if cursor, err = parseTimelineCursor(f.Cursor); err != nil {
+			return nil, err
+		}
+	}
+	limit := f.Limit
+	if limit <= 0 {
+		limit = defaultTimelineLimit
+	}
+	limit = min(limit, maxTimelineLimit)
+	page := TimelinePage{From: f.From, To: f.To, Limit: limit + 1}
+
+	var items []*TimelineItem
+	var states []string
+	for _, t := range []string{TimelineDrive, TimelineCharge, TimelinePark, TimelineSleep} {
+		if types[t] {
+			states = append(states, timelinePeriodStates[t]...)
+		}
+	}
+	if len(states) > 0 {
+		periods, err := uc.periodRepo.ListPage(ctx, vehicleID, states, cursor.page(timelineSourcePeriod, page))
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range periods {
+			items = append(items, &TimelineItem{
+				Type:    periodTimelineType(p.State),
+				StartAt: p.StartAt,
+				EndAt:   p.EndAt,
+				Period:  p,
+				source:  timelineSourcePeriod,
+				id:      p.ID,
+			})
+		}
+	}
+	if types[TimelineUpdate] {
+		updates, err := uc.softwareRepo.ListInstalledPage(ctx, vehicleID, cursor.page(timelineSourceUpdate, page))
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range updates {
+			items = append(items, &TimelineItem{Type: TimelineUpdate, StartAt: *u.CompletedAt, Update: u, source: timelineSourceUpdate, id: u.ID})
+		}
+	}
+	if types[TimelineEvent] {
+		events, err := uc.repo.ListPage(ctx, vehicleID, cursor.page(timelineSourceEvent, page))
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range events {
+			items = append(items, &TimelineItem{Type: TimelineEvent, StartAt: e.Time, Event: e, source: timelineSourceEvent, id: e.ID})
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if !a.StartAt.Equal(b.StartAt) {
+			return a.StartAt.After(b.StartAt)
+		}
+		if a.source != b.source {
+			return a.source < b.source
+		}
+		return a.id > b.id
+	})
+	timeline := &Timeline{Items: items}
+	if len(items) > limit {
+		timeline.Items = items[:limit]
+		last := timeline.Items[limit-1]
+		timeline.NextCursor = (&timelineCursor{time: last.StartAt, source: last.source, id: last.id}).String()
+	}
+	return timeline, nil
+}
+
+// timelineTypes validates the requested item types, an empty list selects all types.
+func timelineTypes(requested []string) (map[string]bool, error) {
+	all := []string{TimelineDrive, TimelineCharge, TimelinePark, TimelineSleep, TimelineUpdate, TimelineEvent}
+	types := make(map[string]bool, len(all))
+	if len(requested) == 0 {
+		requested = all
+	}
+	for _, t := range requested {
+		if _, ok := timelinePeriodStates[t]; !ok && t != TimelineUpdate && t != TimelineEvent {
+			return nil, ErrTimelineTypeInvalid
+		}
+		types[t] = true
+	}
+	return types, nil
+}
+
+// periodTimelineType returns the item type of a period state.
+func periodTimelineType(state string) string {
+	switch state {
+	case VehicleStateDriving:
+		return TimelineDrive
+	case VehicleStateCharging:
+		return TimelineCharge
+	case VehicleStateAsleep:
+		return TimelineSleep
+	default:
+		return TimelinePark
+	}
+}
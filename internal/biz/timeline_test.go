@@ -0,0 +1,66 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestTimelineCursor(t *testing.T) {
+	base := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	at := func(hours int) time.Time { return base.Add(time.Duration(hours) * time.Hour) }
+	completed := func(hours int) *time.Time {
+		t := at(hours)
+		return &t
+	}
+	periods := &memoryPeriods{periods: []*VehicleStatePeriod{
+		{ID: 1, VehicleID: 1, State: VehicleStateDriving, StartAt: at(2)},
+		{ID: 2, VehicleID: 1, State: VehicleStateOnline, StartAt: at(0)},
+		{ID: 3, VehicleID: 1, State: VehicleStateCharging, StartAt: at(0)},
+		{ID: 4, VehicleID: 1, State: VehicleStateAsleep, StartAt: at(-1)},
+		{ID: 5, VehicleID: 2, State: VehicleStateDriving, StartAt: at(0)},
+	}}
+	software := &memorySoftware{updates: []*SoftwareUpdate{
+		{ID: 1, VehicleID: 1, FromVersion: "2026.2.1", Version: "2026.2.3", CompletedAt: completed(0)},
+		{ID: 2, VehicleID: 1, FromVersion: "2026.2.3", Version: "2026.2.6", CompletedAt: completed(1)},
+	}}
+	events := &memoryEvents{events: []*VehicleEvent{
+		{ID: 1, VehicleID: 1, Type: EventGeofenceEnter, Time: at(0)},
+		{ID: 2, VehicleID: 1, Type: EventGeofenceExit, Time: at(0)},
+		{ID: 3, VehicleID: 1, Type: EventTirePressureLow, Time: at(2)},
+	}}
+	uc := NewTimelineUsecase(events, &memoryVehicles{vehicles: []*Vehicle{{ID: 1, UserID: 1}}}, periods, software, log.DefaultLogger)
+
+	// Ties are ordered by source, periods first, then by ID, highest first.
+	want := []string{"drive 1", "event 3", "update 2", "charge 3", "park 2", "update 1", "event 2", "event 1", "sleep 4"}
+	label := func(item *TimelineItem) string {
+		return fmt.Sprintf("%s %d", item.Type, item.id)
+	}
+	for limit := 1; limit <= len(want)+1; limit++ {
+		var got []string
+		cursor := ""
+		for pages := 0; pages <= len(want); pages++ {
+			timeline, err := uc.List(context.Background(), 1, 1, &TimelineFilter{Cursor: cursor, Limit: limit})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, item := range timeline.Items {
+				got = append(got, label(item))
+			}
+			if cursor = timeline.NextCursor; cursor == "" {
+				break
+			}
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("pages of %d: %v, want %v", limit, got, want)
+		}
+	}
+
+	if _, err := uc.List(context.Background(), 1, 1, &TimelineFilter{Cursor: "not-a-cursor"}); err != ErrTimelineCursorInvalid {
+		t.Errorf("List with a malformed cursor error = %v, want %v", err, ErrTimelineCursorInvalid)
+	}
+}
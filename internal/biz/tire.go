@@ -0,0 +1,267 @@
+package biz
+
+import (
+	"context"
+	"teslatrack/internal/conf"
+	"teslatrack/pkg/tesla"
+	"teslatrack/pkg/tpms"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Defaults of the TPMS alerts.
+const (
+	defaultLowPressureRatio = 0.85
+	defaultLeakRate         = 0.02
+	defaultLeakWindow       = 14 * 24 * time.Hour
+	// minLeakSpan is the least history needed to tell a slow leak from noise.
+	minLeakSpan = 3 * 24 * time.Hour
+)
+
+// TirePressure is a TPMS reading of all four wheels, indexed by tpms.Wheel.
+// Pressures are stored in bar as reported by the Fleet API, zero when unknown.
+type TirePressure struct {
+	// ID is the unique identifier of the reading.
+	ID int
+	// VehicleID is the ID of the vehicle.
+	VehicleID int
+	// Pressure is the pressure of each wheel in bar.
+	Pressure [4]float64
+	// SeenAt is the time each sensor last reported, zero when unknown.
+	SeenAt [4]time.Time
+	// RecommendedFront is the recommended cold pressure of the front tires in bar.
+	RecommendedFront float64
+	// RecommendedRear is the recommended cold pressure of the rear tires in bar.
+	RecommendedRear float64
+	// SoftWarning is the soft TPMS warning of each wheel.
+	SoftWarning [4]bool
+	// HardWarning is the hard TPMS warning of each wheel.
+	HardWarning [4]bool
+	// Unit is the pressure unit shown in the vehicle, e.g., Psi.
+	Unit string
+	// CreatedAt is the time the reading was taken.
+	CreatedAt time.Time
+}
+
+// NewTirePressure builds a reading from the vehicle data returned by the Fleet API.
+func NewTirePressure(vehicleID int, data *tesla.VehicleData) *TirePressure {
+	vs := &data.VehicleState
+	p := &TirePressure{
+		VehicleID:        vehicleID,
+		Pressure:         [4]float64{vs.TpmsPressureFl, vs.TpmsPressureFr, vs.TpmsPressureRl, vs.TpmsPressureRr},
+		RecommendedFront: vs.TpmsRcpFrontValue,
+		RecommendedRear:  vs.TpmsRcpRearValue,
+		SoftWarning:      [4]bool{vs.TpmsSoftWarningFl, vs.TpmsSoftWarningFr, vs.TpmsSoftWarningRl, vs.TpmsSoftWarningRr},
+		HardWarning:      [4]bool{vs.TpmsHardWarningFl, vs.TpmsHardWarningFr, vs.TpmsHardWarningRl, vs.TpmsHardWarningRr},
+		Unit:             data.GuiSettings.GuiTirepressureUnits,
+		CreatedAt:        time.Now(),
+	}
+	for i, seen := range [4]int64{vs.TpmsLastSeenPressureTimeFl, vs.TpmsLastSeenPressureTimeFr, vs.TpmsLastSeenPressureTimeRl, vs.TpmsLastSeenPressureTimeRr} {
+		if seen > 0 {
+			p.SeenAt[i] = time.Unix(seen, 0)
+		}
+	}
+	return p
+}
+
+// Known reports whether any wheel has a pressure.
+func (p *TirePressure) Known() bool {
+	return p.Pressure != [4]float64{}
+}
+
+// Recommended returns the recommended cold pressure of a wheel in bar.
+func (p *TirePressure) Recommended(w tpms.Wheel) float64 {
+	if w.Front() {
+		return p.RecommendedFront
+	}
+	return p.RecommendedRear
+}
+
+// Low reports whether a wheel is below the given share of its recommended cold pressure.
+func (p *TirePressure) Low(w tpms.Wheel, ratio float64) bool {
+	recommended := p.Recommended(w)
+	return p.Pressure[w] > 0 && recommended > 0 && p.Pressure[w] < recommended*ratio
+}
+
+// sameReading reports whether two readings carry the same sensor data.
+func (p *TirePressure) sameReading(o *TirePressure) bool {
+	return p.Pressure == o.Pressure && p.SoftWarning == o.SoftWarning && p.HardWarning == o.HardWarning &&
+		p.RecommendedFront == o.RecommendedFront && p.RecommendedRear == o.RecommendedRear
+}
+
+// sample returns the reading as a leak detection sample.
+func (p *TirePressure) sample() tpms.Sample {
+	return tpms.Sample{Time: p.CreatedAt, Pressure: p.Pressure}
+}
+
+// TirePressureRepo defines the data access layer for TirePressure.
+type TirePressureRepo interface {
+	// Create saves a new reading.
+	Create(ctx context.Context, p *TirePressure) error
+	// Latest finds the most recent reading of a vehicle, returns nil if none exists.
+	Latest(ctx context.Context, vehicleID int) (*TirePressure, error)
+	// ListByVehicle lists the readings of a vehicle taken in [from, to), oldest first.
+	ListByVehicle(ctx context.Context, vehicleID int, from, to time.Time) ([]*TirePressure, error)
+}
+
+// TireEvent is the payload of tire pressure events.
+type TireEvent struct {
+	// Wheel is the affected wheel.
+	Wheel tpms.Wheel
+	// Pressure is the current pressure in bar.
+	Pressure float64
+	// Recommended is the recommended cold pressure in bar.
+	Recommended float64
+	// LeakRate is the pressure lost per day relative to the other wheels in bar, for slow leak events.
+	LeakRate float64
+}
+
+// TireStatus is the current state of the tires of a vehicle.
+type TireStatus struct {
+	// Latest is the latest reading, nil when none was taken.
+	Latest *TirePressure
+	// Low reports the wheels below the configured share of the recommended cold pressure.
+	Low [4]bool
+	// Leaks are the wheels with a slow leak.
+	Leaks []tpms.Leak
+}
+
+// TireUsecase keeps the tire pressure history and raises TPMS alerts.
+type TireUsecase struct {
+	repo        TirePressureRepo
+	vehicleRepo VehicleRepo
+	bus         *EventBus
+	lowRatio    float64
+	leakRate    float64
+	leakWindow  time.Duration
+	log         *log.Helper
+}
+
+// NewTireUsecase creates a Tire usecase.
+func NewTireUsecase(repo TirePressureRepo, vehicleRepo VehicleRepo, bus *EventBus, c *conf.Server, logger log.Logger) *TireUsecase {
+	uc := &TireUsecase{
+		repo:        repo,
+		vehicleRepo: vehicleRepo,
+		bus:         bus,
+		lowRatio:    defaultLowPressureRatio,
+		leakRate:    defaultLeakRate,
+		leakWindow:  defaultLeakWindow,
+		log:         log.NewHelper(logger),
+	}
+	if c.Tpms != nil {
+		if c.Tpms.LowPressureRatio > 0 {
+			uc.lowRatio = c.Tpms.LowPressureRatio
+		}
+		if c.Tpms.LeakRate > 0 {
+			uc.leakRate = c.Tpms.LeakRate
+		}
+		if c.Tpms.LeakWindow != nil {
+			uc.leakWindow = c.Tpms.LeakWindow.AsDuration()
+		}
+	}
+	return uc
+}
+
+// Track stores a reading of a vehicle owned by the user when it differs from the latest one,
+// and publishes an event for every wheel that dropped below the low pressure ratio or
+// started to show a slow leak.
+func (uc *TireUsecase) Track(ctx context.Context, userID int, p *TirePressure) error {
+	if !p.Known() {
+		return nil
+	}
+	latest, err := uc.repo.Latest(ctx, p.VehicleID)
+	if err != nil {
+		return err
+	}
+	if latest != nil && latest.sameReading(p) {
+		return nil
+	}
+	history, err := uc.repo.ListByVehicle(ctx, p.VehicleID, p.CreatedAt.Add(-uc.leakWindow), p.CreatedAt)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.Create(ctx, p); err != nil {
+		return err
+	}
+	for _, w := range tpms.Wheels {
+		if p.Low(w, uc.lowRatio) && (latest == nil || !latest.Low(w, uc.lowRatio)) {
+			uc.publish(userID, p, EventTirePressureLow, &TireEvent{Wheel: w, Pressure: p.Pressure[w], Recommended: p.Recommended(w)})
+		}
+	}
+	before := uc.leaks(history)
+	for _, leak := range uc.leaks(append(history, p)) {
+		if !hasLeak(before, leak.Wheel) {
+			uc.publish(userID, p, EventTireSlowLeak, &TireEvent{
+				Wheel:       leak.Wheel,
+				Pressure:    p.Pressure[leak.Wheel],
+				Recommended: p.Recommended(leak.Wheel),
+				LeakRate:    leak.Rate,
+			})
+		}
+	}
+	return nil
+}
+
+// publish publishes a tire event.
+func (uc *TireUsecase) publish(userID int, p *TirePressure, eventType string, payload *TireEvent) {
+	uc.log.Infow("msg", "tire alert", "type", eventType, "vehicleID", p.VehicleID, "wheel", payload.Wheel.String(), "pressure", payload.Pressure)
+	uc.bus.Publish(&Event{
+		Type:      eventType,
+		UserID:    userID,
+		VehicleID: p.VehicleID,
+		Time:      p.CreatedAt,
+		Payload:   payload,
+	})
+}
+
+// leaks detects slow leaks in the readings.
+func (uc *TireUsecase) leaks(readings []*TirePressure) []tpms.Leak {
+	samples := make([]tpms.Sample, 0, len(readings))
+	for _, r := range readings {
+		samples = append(samples, r.sample())
+	}
+	return tpms.DetectLeaks(samples, uc.leakRate, minLeakSpan)
+}
+
+// hasLeak reports whether the wheel is among the leaks.
+func hasLeak(leaks []tpms.Leak, w tpms.Wheel) bool {
+	for _, leak := range leaks {
+		if leak.Wheel == w {
+			return true
+		}
+	}
+	return false
+}
+
+// History lists the readings of a vehicle of the user taken in [from, to).
+func (uc *TireUsecase) History(ctx context.Context, userID, vehicleID int, from, to time.Time) ([]*TirePressure, error) {
+	if _, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, vehicleID); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListByVehicle(ctx, vehicleID, from, to)
+}
+
+// Status returns the current state of the tires of a vehicle of the user.
+func (uc *TireUsecase) Status(ctx context.Context, userID, vehicleID int) (*TireStatus, error) {
+	if _, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, vehicleID); err != nil {
+		return nil, err
+	}
+	latest, err := uc.repo.Latest(ctx, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+	status := &TireStatus{Latest: latest}
+	if latest == nil {
+		return status, nil
+	}
+	for _, w := range tpms.Wheels {
+		status.Low[w] = latest.Low(w, uc.lowRatio)
+	}
+	history, err := uc.repo.ListByVehicle(ctx, vehicleID, latest.CreatedAt.Add(-uc.leakWindow), latest.CreatedAt.Add(time.Second))
+	if err != nil {
+		return nil, err
+	}
+	status.Leaks = uc.leaks(history)
+	return status, nil
+}
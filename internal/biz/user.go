@@ -1,24 +1,76 @@
 package biz
 
 import (
+	"context"
+	v1 "teslatrack/api/teslatrack/v1"
+	"time"
+
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+// DefaultTimeZone is the time zone of users who did not choose one.
+const DefaultTimeZone = "Asia/Shanghai"
+
+var (
+	// ErrUserNotFound is user not found.
+	ErrUserNotFound = v1.ErrorUserNotFound("user not found")
+	// ErrTimeZoneInvalid is returned for time zones missing from the IANA database.
+	ErrTimeZoneInvalid = v1.ErrorTimeZoneInvalid("unknown time zone")
+)
+
 // User is a User model.
 type User struct {
 	// ID is the unique identifier of the user.
-	ID       int
+	ID int
 	// Account is the user's account name.
-	Account  string
+	Account string
 	// Password is the user's password (hashed).
 	Password string
+	// TimeZone is the IANA time zone statistics are rolled up in.
+	TimeZone string
+	// AskedUserID is the ID of the user who invited this user, 0 if none.
+	AskedUserID int
 	// Vehicles is the list of vehicles associated with the user.
 	Vehicles []*Vehicle
 }
 
+// Location returns the time zone of the user, Asia/Shanghai when unset or unknown.
+func (u *User) Location() *time.Location {
+	loc, err := LoadLocation(u.TimeZone)
+	if err != nil {
+		loc, _ = LoadLocation("")
+	}
+	return loc
+}
+
+// LoadLocation loads an IANA time zone, Asia/Shanghai when empty.
+// It returns ErrTimeZoneInvalid for time zones missing from the IANA database.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		loc, err := time.LoadLocation(DefaultTimeZone)
+		if err != nil {
+			return time.FixedZone(DefaultTimeZone, 8*60*60), nil
+		}
+		return loc, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrTimeZoneInvalid
+	}
+	return loc, nil
+}
+
 // UserRepo defines the data access layer for User.
-// It is currently a placeholder and should be expanded with methods.
-type UserRepo interface{}
+type UserRepo interface {
+	// FindOne finds a user by its ID, returns nil if none exists.
+	FindOne(ctx context.Context, id int) (*User, error)
+	// FindByAccount finds a user by its account, returns nil if none exists.
+	FindByAccount(ctx context.Context, account string) (*User, error)
+	// Create saves a new user and sets its ID, failing with ErrAccountExists for an account already registered.
+	Create(ctx context.Context, u *User) error
+	// UpdateTimeZone saves the time zone of a user.
+	UpdateTimeZone(ctx context.Context, id int, timeZone string) error
+}
 
 // UserUsecase is a User usecase.
 type UserUsecase struct {
@@ -30,3 +82,35 @@ type UserUsecase struct {
 func NewUserUsecase(userRepo UserRepo, logger log.Logger) *UserUsecase {
 	return &UserUsecase{userRepo: userRepo, log: log.NewHelper(logger)}
 }
+
+// Get finds a user by its ID.
+func (uc *UserUsecase) Get(ctx context.Context, id int) (*User, error) {
+	u, err := uc.userRepo.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		return nil, ErrUserNotFound
+	}
+	return u, nil
+}
+
+// SetTimeZone changes the time zone of a user.
+// The rollups of the user's vehicles are recomputed in the new time zone by the next rollup run.
+func (uc *UserUsecase) SetTimeZone(ctx context.Context, id int, timeZone string) (*User, error) {
+	if timeZone == "" {
+		return nil, ErrTimeZoneInvalid
+	}
+	if _, err := LoadLocation(timeZone); err != nil {
+		return nil, err
+	}
+	u, err := uc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.userRepo.UpdateTimeZone(ctx, id, timeZone); err != nil {
+		return nil, err
+	}
+	u.TimeZone = timeZone
+	return u, nil
+}
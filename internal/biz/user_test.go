@@ -0,0 +1,32 @@
+package biz
+
+import (
+	"testing"
+	_ "time/tzdata"
+)
+
+func TestLoadLocation(t *testing.T) {
+	cases := []struct {
+		name string
+		want string
+		err  error
+	}{
+		{"", DefaultTimeZone, nil},
+		{"Europe/Berlin", "Europe/Berlin", nil},
+		{"UTC", "UTC", nil},
+		{"Mars/Olympus_Mons", "", ErrTimeZoneInvalid},
+	}
+	for _, c := range cases {
+		loc, err := LoadLocation(c.name)
+		if err != c.err {
+			t.Errorf("LoadLocation(%q) error = %v, want %v", c.name, err, c.err)
+			continue
+		}
+		if err == nil && loc.String() != c.want {
+			t.Errorf("LoadLocation(%q) = %v, want %v", c.name, loc, c.want)
+		}
+	}
+	if loc := (&User{TimeZone: "Mars/Olympus_Mons"}).Location(); loc.String() != DefaultTimeZone {
+		t.Errorf("Location() of an unknown time zone = %v, want %v", loc, DefaultTimeZone)
+	}
+}
@@ -2,37 +2,197 @@ package biz
 
 import (
 	"context"
+	"strconv"
+	v1 "teslatrack/api/teslatrack/v1"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
 
+// minForceRefreshInterval is the least time between two forced refreshes of a vehicle,
+// keeping the Fleet API calls within Tesla's rate limits.
+const minForceRefreshInterval = time.Minute
+
+var (
+	// ErrVehicleNotFound is returned for unknown vehicles and vehicles of other users.
+	ErrVehicleNotFound = v1.ErrorVehicleNotFound("vehicle not found")
+	// ErrVehicleRefreshRateLimited is returned when a vehicle is refreshed again too soon.
+	ErrVehicleRefreshRateLimited = v1.ErrorVehicleRefreshRateLimited("the vehicle was refreshed less than a minute ago")
+	// ErrVehicleRefreshFailed is returned when a refreshed vehicle could not be stored.
+	ErrVehicleRefreshFailed = v1.ErrorVehicleRefreshFailed("the vehicle could not be refreshed from Tesla")
+	// ErrVehicleAsleep is returned when Tesla reports the vehicle asleep or offline.
+	ErrVehicleAsleep = v1.ErrorVehicleAsleep("the vehicle is asleep")
+	// ErrTeslaNotAuthorized is returned when the owner has no authorized Tesla account.
+	ErrTeslaNotAuthorized = v1.ErrorTeslaNotAuthorized("no Tesla account is authorized")
+	// ErrTeslaTokenExpired is returned when Tesla rejects the access token of the owner.
+	ErrTeslaTokenExpired = v1.ErrorTeslaTokenExpired("the Tesla authorization expired, authorize the Tesla account again")
+	// ErrTeslaRateLimited is returned when Tesla rate limits the requests of the owner.
+	ErrTeslaRateLimited = v1.ErrorTeslaRateLimited("Tesla rate limited the requests, retry later")
+	// ErrTeslaUnavailable is returned when the Fleet API fails or cannot be reached.
+	ErrTeslaUnavailable = v1.ErrorTeslaUnavailable("the Tesla API is unavailable")
+)
+
 // Vehicle is a Vehicle model.
 type Vehicle struct {
 	// ID is the unique identifier of the vehicle.
-	ID     int
+	ID int
 	// VIN is the Vehicle Identification Number.
-	VIN    string
+	VIN string
 	// UserID is the ID of the user who owns the vehicle.
 	UserID int
+	// DisplayName is the name the owner gave the vehicle.
+	DisplayName string
+	// AccessType is the owner's access type, e.g., OWNER.
+	AccessType string
+	// State is the connectivity state reported by Tesla, e.g., online, asleep, offline.
+	State string
+	// RawData is the raw vehicle payload from the Fleet API.
+	RawData string
 }
 
 // VehicleRepo defines the data access layer for Vehicle.
 type VehicleRepo interface {
 	// CreateVehicle creates a new vehicle.
 	CreateVehicle(ctx context.Context, veh *Vehicle) error
-	// FindOne finds a single vehicle by its ID.
+	// FindOne finds a single vehicle by its ID, returns nil if none exists.
 	FindOne(ctx context.Context, id int) (*Vehicle, error)
 	// FindByUserID finds all vehicles for a given user ID.
 	FindByUserID(ctx context.Context, userID int) ([]*Vehicle, error)
+	// FindByVIN finds a single vehicle by its VIN, returns nil if none exists.
+	FindByVIN(ctx context.Context, vin string) (*Vehicle, error)
+	// ListAll lists all vehicles.
+	ListAll(ctx context.Context) ([]*Vehicle, error)
+	// SaveByVIN creates the vehicle or updates the existing one with the same VIN,
+	// keeping the owner of an existing vehicle when the user ID is zero.
+	SaveByVIN(ctx context.Context, veh *Vehicle) (*Vehicle, error)
+}
+
+// findOwnedVehicle finds a vehicle of the user.
+// Vehicles of other users are reported as not found so their existence is not revealed.
+func findOwnedVehicle(ctx context.Context, repo VehicleRepo, userID, id int) (*Vehicle, error) {
+	veh, err := repo.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if veh == nil || veh.UserID != userID {
+		return nil, ErrVehicleNotFound
+	}
+	return veh, nil
+}
+
+// VehicleOverview is a vehicle with the time it was last seen.
+type VehicleOverview struct {
+	*Vehicle
+	// LastSeenAt is the time of the latest snapshot, nil when none was taken.
+	LastSeenAt *time.Time
+}
+
+// VehicleLatestState is the latest known state of a vehicle.
+type VehicleLatestState struct {
+	// Vehicle is the vehicle.
+	Vehicle *Vehicle
+	// LastSeenAt is the time of the latest snapshot, nil when none was taken.
+	LastSeenAt *time.Time
+	// Snapshot is the latest snapshot carrying vehicle data, nil when none was taken.
+	// It is older than LastSeenAt while the vehicle sleeps.
+	Snapshot *VehicleSnapshot
 }
 
 // VehicleUsecase is a Vehicle usecase.
 type VehicleUsecase struct {
-	vehicleRepo VehicleRepo
-	log         *log.Helper
+	vehicleRepo  VehicleRepo
+	snapshotRepo VehicleSnapshotRepo
+	collector    *CollectorUsecase
+	limiter      RateLimiter
+	log          *log.Helper
 }
 
 // NewVehicleUsecase creates a Vehicle usecase.
-func NewVehicleUsecase(vehicleRepo VehicleRepo, logger log.Logger) *VehicleUsecase {
-	return &VehicleUsecase{vehicleRepo: vehicleRepo, log: log.NewHelper(logger)}
+func NewVehicleUsecase(vehicleRepo VehicleRepo, snapshotRepo VehicleSnapshotRepo, collector *CollectorUsecase, limiter RateLimiter, logger log.Logger) *VehicleUsecase {
+	return &VehicleUsecase{
+		vehicleRepo:  vehicleRepo,
+		snapshotRepo: snapshotRepo,
+		collector:    collector,
+		limiter:      limiter,
+		log:          log.NewHelper(logger),
+	}
+}
+
+// List lists the vehicles of the user.
+func (uc *VehicleUsecase) List(ctx context.Context, userID int) ([]*VehicleOverview, error) {
+	vehicles, err := uc.vehicleRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	overviews := make([]*VehicleOverview, 0, len(vehicles))
+	for _, veh := range vehicles {
+		lastSeenAt, err := uc.lastSeenAt(ctx, veh.ID)
+		if err != nil {
+			return nil, err
+		}
+		overviews = append(overviews, &VehicleOverview{Vehicle: veh, LastSeenAt: lastSeenAt})
+	}
+	return overviews, nil
+}
+
+// Get finds a vehicle of the user.
+func (uc *VehicleUsecase) Get(ctx context.Context, userID, id int) (*VehicleOverview, error) {
+	veh, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	lastSeenAt, err := uc.lastSeenAt(ctx, veh.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleOverview{Vehicle: veh, LastSeenAt: lastSeenAt}, nil
+}
+
+// LatestState returns the latest stored state of a vehicle of the user.
+// With forceRefresh the vehicle is collected from the Fleet API first, at most once a minute.
+func (uc *VehicleUsecase) LatestState(ctx context.Context, userID, id int, forceRefresh bool) (*VehicleLatestState, error) {
+	veh, err := findOwnedVehicle(ctx, uc.vehicleRepo, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if forceRefresh {
+		if !uc.allowRefresh(ctx, veh.ID) {
+			return nil, ErrVehicleRefreshRateLimited
+		}
+		if err := uc.collector.Refresh(ctx, veh); err != nil {
+			return nil, err
+		}
+		if veh, err = findOwnedVehicle(ctx, uc.vehicleRepo, userID, id); err != nil {
+			return nil, err
+		}
+	}
+	lastSeenAt, err := uc.lastSeenAt(ctx, veh.ID)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := uc.snapshotRepo.LatestWithData(ctx, veh.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &VehicleLatestState{Vehicle: veh, LastSeenAt: lastSeenAt, Snapshot: snapshot}, nil
+}
+
+// allowRefresh reports whether a vehicle may be refreshed now and if so records the refresh.
+// The limit is shared by the instances of the service; it is not enforced while the limiter fails.
+func (uc *VehicleUsecase) allowRefresh(ctx context.Context, vehicleID int) bool {
+	ok, err := uc.limiter.Allow(ctx, "vehicle:refresh:"+strconv.Itoa(vehicleID), 1, minForceRefreshInterval)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorw("msg", "rate limit refresh failed", "vehicleID", vehicleID, "err", err)
+		return true
+	}
+	return ok
+}
+
+// lastSeenAt returns the time of the latest snapshot of a vehicle.
+func (uc *VehicleUsecase) lastSeenAt(ctx context.Context, vehicleID int) (*time.Time, error) {
+	latest, err := uc.snapshotRepo.Latest(ctx, vehicleID)
+	if err != nil || latest == nil {
+		return nil, err
+	}
+	return &latest.CreatedAt, nil
 }
@@ -0,0 +1,194 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"teslatrack/pkg/geo"
+	"teslatrack/pkg/tesla"
+	"time"
+)
+
+// MilesToKm converts the miles reported by the Fleet API to kilometres.
+const MilesToKm = 1.609344
+
+// TeslaCoordType is the default datum of DriveState.Latitude/Longitude, as returned by the China Fleet API.
+// Other regions are configured with tesla.coord_type.
+const TeslaCoordType = geo.GCJ02
+
+// VehicleSnapshot is a single sample of vehicle data collected from the Fleet API.
+// Distances, ranges and speeds are stored in kilometres and positions in WGS-84.
+type VehicleSnapshot struct {
+	// ID is the unique identifier of the snapshot.
+	ID int
+	// VehicleID is the ID of the vehicle the snapshot belongs to.
+	VehicleID int
+	// State is the vehicle state reported by Tesla, e.g., online, asleep, offline.
+	State string
+	// ShiftState is the gear shift state, e.g., P, D, R. Empty when unknown.
+	ShiftState string
+	// Speed is the speed in km/h.
+	Speed float64
+	// Power is the power draw (positive) or regeneration (negative) in kW.
+	Power int
+	// Latitude is the WGS-84 latitude of the vehicle.
+	Latitude float64
+	// Longitude is the WGS-84 longitude of the vehicle.
+	Longitude float64
+	// CoordType is the datum the vehicle reported its position in before conversion.
+	CoordType geo.CoordType
+	// Heading is the heading of the vehicle in degrees.
+	Heading int
+	// BatteryLevel is the state of charge in percent.
+	BatteryLevel int
+	// UsableBatteryLevel is the usable state of charge in percent.
+	UsableBatteryLevel int
+	// BatteryRange is the rated range in km.
+	BatteryRange float64
+	// IdealBatteryRange is the ideal range in km.
+	IdealBatteryRange float64
+	// EstBatteryRange is the estimated range in km.
+	EstBatteryRange float64
+	// ChargingState is the charging state, e.g., Charging, Complete, Disconnected.
+	ChargingState string
+	// ChargerPower is the charger power in kW.
+	ChargerPower int
+	// ChargeEnergyAdded is the energy added since the vehicle was plugged in, in kWh.
+	ChargeEnergyAdded float64
+	// ChargerVoltage is the charger voltage in V.
+	ChargerVoltage int
+	// ChargerActualCurrent is the charger current in A.
+	ChargerActualCurrent int
+	// FastChargerPresent indicates if a DC fast charger is connected.
+	FastChargerPresent bool
+	// FastChargerType is the DC fast charger type, e.g., Supercharger.
+	FastChargerType string
+	// BatteryHeaterOn indicates if the battery heater is on.
+	BatteryHeaterOn bool
+	// OutsideTemp is the outside temperature in Celsius.
+	OutsideTemp float64
+	// InsideTemp is the inside temperature in Celsius.
+	InsideTemp float64
+	// ClimateOn indicates if climate control is on.
+	ClimateOn bool
+	// ClimateKeeperMode is the climate keeper mode, e.g., off, dog, camp.
+	ClimateKeeperMode string
+	// CabinOverheatCooling indicates if cabin overheat protection is actively cooling.
+	CabinOverheatCooling bool
+	// SentryMode indicates if sentry mode is on.
+	SentryMode bool
+	// Locked indicates if the vehicle is locked.
+	Locked bool
+	// Odometer is the odometer reading in km.
+	Odometer float64
+	// CarVersion is the software version of the vehicle.
+	CarVersion string
+	// SoftwareUpdateStatus is the status of a pending software update.
+	SoftwareUpdateStatus string
+	// SoftwareUpdateVersion is the target version of a pending software update.
+	SoftwareUpdateVersion string
+	// SoftwareUpdateDownloadPerc is the download progress of a pending software update in percent.
+	SoftwareUpdateDownloadPerc int
+	// SoftwareUpdateInstallPerc is the install progress of a pending software update in percent.
+	SoftwareUpdateInstallPerc int
+	// SoftwareUpdateExpectedDuration is the expected install duration of a pending software update.
+	SoftwareUpdateExpectedDuration time.Duration
+	// CreatedAt is the time the sample was taken.
+	CreatedAt time.Time
+}
+
+// HasData reports whether the snapshot carries vehicle data.
+// Snapshots of sleeping or offline vehicles only record the state.
+func (s *VehicleSnapshot) HasData() bool {
+	return s.State == TeslaStateOnline
+}
+
+// Idle reports whether the vehicle is online, parked and could fall asleep:
+// not charging or updating and without sentry mode or climate control keeping it awake.
+func (s *VehicleSnapshot) Idle() bool {
+	return s.HasData() && ClassifyVehicleState(s) == VehicleStateOnline && !s.SentryMode && !s.ClimateOn && !s.CabinOverheatCooling
+}
+
+// ClimateActive reports whether climate keeper or cabin overheat protection is running.
+func (s *VehicleSnapshot) ClimateActive() bool {
+	return s.CabinOverheatCooling || (s.ClimateOn && s.ClimateKeeperMode != "" && s.ClimateKeeperMode != "off")
+}
+
+// NewVehicleSnapshot builds a snapshot from the vehicle data returned by the Fleet API,
+// whose position is reported in coordType unless the vehicle gives its native datum.
+func NewVehicleSnapshot(vehicleID int, data *tesla.VehicleData, coordType geo.CoordType) *VehicleSnapshot {
+	snapshot := &VehicleSnapshot{
+		VehicleID:            vehicleID,
+		State:                data.State,
+		Power:                data.DriveState.Power,
+		Heading:              data.DriveState.Heading,
+		BatteryLevel:         data.ChargeState.BatteryLevel,
+		UsableBatteryLevel:   data.ChargeState.UsableBatteryLevel,
+		BatteryRange:         data.ChargeState.BatteryRange * MilesToKm,
+		IdealBatteryRange:    data.ChargeState.IdealBatteryRange * MilesToKm,
+		EstBatteryRange:      data.ChargeState.EstBatteryRange * MilesToKm,
+		ChargingState:        data.ChargeState.ChargingState,
+		ChargerPower:         data.ChargeState.ChargerPower,
+		ChargeEnergyAdded:    data.ChargeState.ChargeEnergyAdded,
+		ChargerVoltage:       data.ChargeState.ChargerVoltage,
+		ChargerActualCurrent: data.ChargeState.ChargerActualCurrent,
+		FastChargerPresent:   data.ChargeState.FastChargerPresent,
+		FastChargerType:      data.ChargeState.FastChargerType,
+		BatteryHeaterOn:      data.ChargeState.BatteryHeaterOn,
+		OutsideTemp:          data.ClimateState.OutsideTemp,
+		InsideTemp:           data.ClimateState.InsideTemp,
+		ClimateOn:            data.ClimateState.IsClimateOn,
+		ClimateKeeperMode:    data.ClimateState.ClimateKeeperMode,
+		CabinOverheatCooling: data.ClimateState.CabinOverheatProtectionActivelyCooling,
+		SentryMode:           data.VehicleState.SentryMode,
+		Locked:               data.VehicleState.Locked,
+		Odometer:             data.VehicleState.Odometer * MilesToKm,
+		CarVersion:           data.VehicleState.CarVersion,
+		SoftwareUpdateStatus: data.VehicleState.SoftwareUpdate.Status,
+		CreatedAt:            time.Now(),
+
+		SoftwareUpdateVersion:          strings.TrimSpace(data.VehicleState.SoftwareUpdate.Version),
+		SoftwareUpdateDownloadPerc:     data.VehicleState.SoftwareUpdate.DownloadPerc,
+		SoftwareUpdateInstallPerc:      data.VehicleState.SoftwareUpdate.InstallPerc,
+		SoftwareUpdateExpectedDuration: time.Duration(data.VehicleState.SoftwareUpdate.ExpectedDurationSec) * time.Second,
+	}
+	snapshot.Latitude, snapshot.Longitude, snapshot.CoordType = wgs84Position(&data.DriveState, coordType)
+	if data.DriveState.ShiftState != nil {
+		snapshot.ShiftState = *data.DriveState.ShiftState
+	}
+	if data.DriveState.Speed != nil {
+		snapshot.Speed = *data.DriveState.Speed * MilesToKm
+	}
+	if data.DriveState.Timestamp > 0 {
+		snapshot.CreatedAt = time.UnixMilli(data.DriveState.Timestamp)
+	}
+	return snapshot
+}
+
+// wgs84Position returns the WGS-84 position of a drive state and the datum it was reported in.
+// The native position is preferred when the vehicle supports it, as it needs no conversion
+// in the common case of a "wgs" native type.
+func wgs84Position(d *tesla.DriveState, coordType geo.CoordType) (float64, float64, geo.CoordType) {
+	if d.NativeLocationSupported == 1 && (d.NativeLatitude != 0 || d.NativeLongitude != 0) {
+		if native, ok := geo.ParseCoordType(d.NativeType); ok {
+			lat, lon := geo.Convert(d.NativeLatitude, d.NativeLongitude, native, geo.WGS84)
+			return lat, lon, native
+		}
+	}
+	if d.Latitude == 0 && d.Longitude == 0 {
+		return 0, 0, ""
+	}
+	lat, lon := geo.Convert(d.Latitude, d.Longitude, coordType, geo.WGS84)
+	return lat, lon, coordType
+}
+
+// VehicleSnapshotRepo defines the data access layer for VehicleSnapshot.
+type VehicleSnapshotRepo interface {
+	// Create saves a new snapshot.
+	Create(ctx context.Context, snapshot *VehicleSnapshot) error
+	// Latest finds the most recent snapshot of a vehicle, returns nil if none exists.
+	Latest(ctx context.Context, vehicleID int) (*VehicleSnapshot, error)
+	// LatestWithData finds the most recent snapshot of a vehicle that carries vehicle data, returns nil if none exists.
+	LatestWithData(ctx context.Context, vehicleID int) (*VehicleSnapshot, error)
+	// ListByVehicle lists the snapshots of a vehicle taken in [from, to), oldest first.
+	ListByVehicle(ctx context.Context, vehicleID int, from, to time.Time) ([]*VehicleSnapshot, error)
+}
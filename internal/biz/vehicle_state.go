@@ -0,0 +1,553 @@
+package biz
+
+import (
+	"context"
+	"teslatrack/pkg/geo"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Connectivity states reported by the Fleet API in Vehicle.State.
+const (
+	TeslaStateOnline  = "online"
+	TeslaStateAsleep  = "asleep"
+	TeslaStateOffline = "offline"
+)
+
+// States tracked in the vehicle state timeline.
+const (
+	VehicleStateOnline   = "online"
+	VehicleStateAsleep   = "asleep"
+	VehicleStateOffline  = "offline"
+	VehicleStateDriving  = "driving"
+	VehicleStateCharging = "charging"
+	VehicleStateUpdating = "updating"
+)
+
+// ClassifyVehicleState derives the timeline state of a vehicle from a snapshot.
+func ClassifyVehicleState(s *VehicleSnapshot) string {
+	switch s.State {
+	case TeslaStateAsleep:
+		return VehicleStateAsleep
+	case TeslaStateOffline:
+		return VehicleStateOffline
+	}
+	switch {
+	case s.SoftwareUpdateStatus == "installing":
+		return VehicleStateUpdating
+	case s.ShiftState == "D" || s.ShiftState == "R" || s.ShiftState == "N":
+		return VehicleStateDriving
+	case s.ChargingState == "Charging" || s.ChargingState == "Starting":
+		return VehicleStateCharging
+	}
+	return VehicleStateOnline
+}
+
+// IsParkedState reports whether the vehicle is parked in the given timeline state.
+func IsParkedState(state string) bool {
+	switch state {
+	case VehicleStateOnline, VehicleStateAsleep, VehicleStateOffline, VehicleStateUpdating:
+		return true
+	}
+	return false
+}
+
+// VehicleStatePeriod is a continuous span of time a vehicle spent in a single state.
+// Online periods are further split whenever sentry mode or climate keeping toggles,
+// so every period can be attributed to exactly one drain category.
+type VehicleStatePeriod struct {
+	// ID is the unique identifier of the period.
+	ID int
+	// VehicleID is the ID of the vehicle.
+	VehicleID int
+	// State is the timeline state, see the VehicleState constants.
+	State string
+	// SentryMode indicates that sentry mode was on during the period.
+	SentryMode bool
+	// ClimateOn indicates that climate keeper or cabin overheat protection was running.
+	ClimateOn bool
+	// StartAt is the start of the period.
+	StartAt time.Time
+	// EndAt is the end of the period, nil while the period is still open.
+	EndAt *time.Time
+	// StartBatteryLevel is the state of charge at the start in percent.
+	StartBatteryLevel int
+	// EndBatteryLevel is the state of charge at the end in percent.
+	EndBatteryLevel int
+	// StartRange is the rated range at the start in km.
+	StartRange float64
+	// EndRange is the rated range at the end in km.
+	EndRange float64
+	// StartOdometer is the odometer at the start in km.
+	StartOdometer float64
+	// EndOdometer is the odometer at the end in km.
+	EndOdometer float64
+	// StartLatitude is the latitude at the start.
+	StartLatitude float64
+	// StartLongitude is the longitude at the start.
+	StartLongitude float64
+	// EndLatitude is the latitude at the end.
+	EndLatitude float64
+	// EndLongitude is the longitude at the end.
+	EndLongitude float64
+	// StartAddress is the address at the start, resolved for drives and charging sessions.
+	StartAddress string
+	// EndAddress is the address at the end, resolved for drives and charging sessions.
+	EndAddress string
+	// StartGeofenceID is the most specific geofence at the start, nil outside all geofences.
+	StartGeofenceID *int
+	// EndGeofenceID is the most specific geofence at the end, nil outside all geofences.
+	EndGeofenceID *int
+	// ChargeLocation classifies charging sessions, see the ChargeLocation constants.
+	ChargeLocation string
+	// FastCharger indicates that a DC fast charger was used while charging.
+	FastCharger bool
+	// EnergyAdded is the energy added while charging in kWh.
+	EnergyAdded float64
+	// StartEnergyAdded is the ChargeEnergyAdded reported at the start of a charging session in kWh.
+	// The vehicle counts the energy added since it was plugged in, across the sessions of a plug-in,
+	// so the energy added by a session is counted from it.
+	StartEnergyAdded float64
+	// EnergyUsed is the energy used while driving in kWh, integrated from the power samples.
+	EnergyUsed float64
+	// PowerDuration is the part of a drive covered by EnergyUsed.
+	// Gaps between samples longer than maxPowerGap are left out of the integration.
+	PowerDuration time.Duration
+	// OutsideTemp is the time-weighted mean outside temperature while driving, nil when unknown.
+	OutsideTemp *float64
+	// MaxSpeed is the highest speed while driving in km/h.
+	MaxSpeed float64
+	// ClimateRatio is the share of a drive with climate control on, from 0 to 1.
+	ClimateRatio float64
+	// Cost is the cost of a charging session, nil when unknown.
+	Cost *float64
+	// CostManual indicates that the cost was entered manually.
+	CostManual bool
+	// TariffID is the tariff the cost was calculated with.
+	TariffID *int
+	// Name is the name the owner gave a drive or charging session.
+	Name string
+	// Tags are the tags the owner attached to a drive or charging session.
+	Tags []string
+	// UpdatedAt is the time the period was last saved.
+	UpdatedAt time.Time
+}
+
+// maxPowerGap is the longest gap between two samples that is still integrated.
+// Longer gaps mean lost samples, and the power of a single sample does not represent them.
+const maxPowerGap = 5 * time.Minute
+
+// HasAddress reports whether the addresses of the period are resolved.
+// Parked periods are frequent and only their position is kept.
+func (p *VehicleStatePeriod) HasAddress() bool {
+	return p.State == VehicleStateDriving || p.State == VehicleStateCharging
+}
+
+// Duration returns the length of the period, measured up to now for open periods.
+func (p *VehicleStatePeriod) Duration() time.Duration {
+	if p.EndAt == nil {
+		return time.Since(p.StartAt)
+	}
+	return p.EndAt.Sub(p.StartAt)
+}
+
+// matches reports whether a snapshot in the given state continues the period.
+func (p *VehicleStatePeriod) matches(state string, s *VehicleSnapshot) bool {
+	if p.State != state {
+		return false
+	}
+	if !s.HasData() {
+		return true
+	}
+	return p.SentryMode == s.SentryMode && p.ClimateOn == s.ClimateActive()
+}
+
+// lastSampleAt returns the time of the latest snapshot applied to the period.
+func (p *VehicleStatePeriod) lastSampleAt() time.Time {
+	if p.EndAt == nil {
+		return p.StartAt
+	}
+	return *p.EndAt
+}
+
+// extend moves the end of the period to the snapshot.
+// Snapshots without data only move the end time and keep the last known readings.
+func (p *VehicleStatePeriod) extend(s *VehicleSnapshot) {
+	since := p.StartAt
+	if p.EndAt != nil {
+		since = *p.EndAt
+	}
+	end := s.CreatedAt
+	p.EndAt = &end
+	if !s.HasData() {
+		return
+	}
+	if p.State == VehicleStateDriving {
+		p.accumulate(s, since)
+	}
+	if p.State == VehicleStateCharging {
+		p.FastCharger = p.FastCharger || s.FastChargerPresent
+		p.EnergyAdded = max(p.EnergyAdded, s.ChargeEnergyAdded-p.StartEnergyAdded)
+	}
+	p.EndBatteryLevel = s.BatteryLevel
+	p.EndRange = s.BatteryRange
+	p.EndOdometer = s.Odometer
+	p.EndLatitude = s.Latitude
+	p.EndLongitude = s.Longitude
+}
+
+// accumulate adds the interval from since up to the snapshot to the driving statistics.
+// The readings of the snapshot are taken to hold for the whole interval.
+func (p *VehicleStatePeriod) accumulate(s *VehicleSnapshot, since time.Time) {
+	p.MaxSpeed = max(p.MaxSpeed, s.Speed)
+	dt := s.CreatedAt.Sub(since)
+	if dt <= 0 {
+		return
+	}
+	if dt <= maxPowerGap {
+		p.EnergyUsed += float64(s.Power) * dt.Hours()
+		p.PowerDuration += dt
+	}
+	weight := dt.Seconds() / s.CreatedAt.Sub(p.StartAt).Seconds()
+	temp := s.OutsideTemp
+	if p.OutsideTemp != nil {
+		temp = *p.OutsideTemp + (temp-*p.OutsideTemp)*weight
+	}
+	p.OutsideTemp = &temp
+	climate := 0.0
+	if s.ClimateOn {
+		climate = 1
+	}
+	p.ClimateRatio += (climate - p.ClimateRatio) * weight
+}
+
+// chargeCounter totals the energy added from the ChargeEnergyAdded counter of a vehicle,
+// which counts since the vehicle was plugged in and restarts at zero on the next plug-in.
+type chargeCounter struct {
+	// last is the latest value of the counter.
+	last float64
+	// total is the energy added since the first value in kWh.
+	total float64
+}
+
+// add counts a value of the counter and returns the energy added so far.
+func (c *chargeCounter) add(added float64) float64 {
+	if added < c.last {
+		c.last = 0
+	}
+	c.total += added - c.last
+	c.last = added
+	return c.total
+}
+
+// newVehicleStatePeriod opens a period at the snapshot.
+// The readings of the previous period are carried over when the snapshot has no data.
+func newVehicleStatePeriod(state string, s *VehicleSnapshot, prev *VehicleStatePeriod) *VehicleStatePeriod {
+	p := &VehicleStatePeriod{
+		VehicleID:   s.VehicleID,
+		State:       state,
+		SentryMode:  s.HasData() && s.SentryMode,
+		ClimateOn:   s.HasData() && s.ClimateActive(),
+		StartAt:     s.CreatedAt,
+		FastCharger: state == VehicleStateCharging && s.FastChargerPresent,
+	}
+	if s.HasData() {
+		if state == VehicleStateDriving {
+			temp := s.OutsideTemp
+			p.OutsideTemp = &temp
+			p.MaxSpeed = s.Speed
+		}
+		if state == VehicleStateCharging {
+			p.StartEnergyAdded = s.ChargeEnergyAdded
+		}
+		p.StartBatteryLevel = s.BatteryLevel
+		p.StartRange = s.BatteryRange
+		p.StartOdometer = s.Odometer
+		p.StartLatitude = s.Latitude
+		p.StartLongitude = s.Longitude
+	} else if prev != nil {
+		p.StartBatteryLevel = prev.EndBatteryLevel
+		p.StartRange = prev.EndRange
+		p.StartOdometer = prev.EndOdometer
+		p.StartLatitude = prev.EndLatitude
+		p.StartLongitude = prev.EndLongitude
+	}
+	p.EndBatteryLevel = p.StartBatteryLevel
+	p.EndRange = p.StartRange
+	p.EndOdometer = p.StartOdometer
+	p.EndLatitude = p.StartLatitude
+	p.EndLongitude = p.StartLongitude
+	return p
+}
+
+// VehicleStatePeriodRepo defines the data access layer for VehicleStatePeriod.
+type VehicleStatePeriodRepo interface {
+	// Create saves a new period.
+	Create(ctx context.Context, period *VehicleStatePeriod) error
+	// Update saves the end of an existing period, including its cost.
+	Update(ctx context.Context, period *VehicleStatePeriod) error
+	// FindOne finds a period by its ID, returns nil if none exists.
+	FindOne(ctx context.Context, id int) (*VehicleStatePeriod, error)
+	// Current finds the most recent period of a vehicle, returns nil if none exists.
+	Current(ctx context.Context, vehicleID int) (*VehicleStatePeriod, error)
+	// UpdateCost saves the cost of a charging session.
+	UpdateCost(ctx context.Context, period *VehicleStatePeriod) error
+	// ListByVehicle lists the periods of a vehicle overlapping [from, to), oldest first.
+	ListByVehicle(ctx context.Context, vehicleID int, from, to time.Time) ([]*VehicleStatePeriod, error)
+	// ListUpdatedSince lists the periods of a vehicle saved at or after the given time.
+	ListUpdatedSince(ctx context.Context, vehicleID int, since time.Time) ([]*VehicleStatePeriod, error)
+	// ListPage lists one page of the periods of a vehicle in the given states by start time, newest first.
+	ListPage(ctx context.Context, vehicleID int, states []string, page *TimelinePage) ([]*VehicleStatePeriod, error)
+	// List lists the closed and open periods selected by the filter in the order of its sort.
+	List(ctx context.Context, filter *PeriodFilter) ([]*VehicleStatePeriod, error)
+	// Save saves every field of an existing period.
+	Save(ctx context.Context, period *VehicleStatePeriod) error
+	// Delete deletes periods by their IDs.
+	Delete(ctx context.Context, ids ...int) error
+}
+
+// maxSampleGap is the longest time between two snapshots of one period. It exceeds suspendDuration
+// plus a polling interval, as idle vehicles are not sampled while their polling is suspended.
+const maxSampleGap = suspendDuration + 15*time.Minute
+
+// maxParkedGap is the longest gap between two periods still treated as one parked stretch.
+const maxParkedGap = 5 * time.Minute
+
+// IdleDrain is the battery drain of a vehicle over one parked stretch,
+// i.e. the time between two drives or charging sessions.
+type IdleDrain struct {
+	// StartAt is the time the vehicle was parked.
+	StartAt time.Time
+	// EndAt is the time the vehicle left or started charging.
+	EndAt time.Time
+	// Latitude is the latitude of the parking spot.
+	Latitude float64
+	// Longitude is the longitude of the parking spot.
+	Longitude float64
+	// Address is the address of the parking spot, taken from the end of the preceding drive or charge.
+	Address string
+	// StartBatteryLevel is the state of charge when parked in percent.
+	StartBatteryLevel int
+	// EndBatteryLevel is the state of charge at the end in percent.
+	EndBatteryLevel int
+	// RangeLoss is the rated range lost while parked in km.
+	RangeLoss float64
+	// AsleepDuration is the time spent asleep.
+	AsleepDuration time.Duration
+	// SentryDuration is the time spent with sentry mode on.
+	SentryDuration time.Duration
+	// ClimateDuration is the time spent keeping the cabin climate.
+	ClimateDuration time.Duration
+	// IdleDuration is the time spent awake without sentry mode or climate keeping.
+	IdleDuration time.Duration
+	// AsleepRangeLoss is the range lost while asleep in km.
+	AsleepRangeLoss float64
+	// SentryRangeLoss is the range lost while sentry mode was on in km.
+	SentryRangeLoss float64
+	// ClimateRangeLoss is the range lost while climate keeping in km.
+	ClimateRangeLoss float64
+	// IdleRangeLoss is the range lost while awake otherwise in km.
+	IdleRangeLoss float64
+}
+
+// BatteryLoss returns the state of charge lost while parked in percent.
+func (d *IdleDrain) BatteryLoss() int {
+	return d.StartBatteryLevel - d.EndBatteryLevel
+}
+
+// Duration returns the length of the parked stretch.
+func (d *IdleDrain) Duration() time.Duration {
+	return d.EndAt.Sub(d.StartAt)
+}
+
+// add attributes a parked period to the drain.
+// Climate keeping takes precedence over sentry mode as it draws far more power.
+func (d *IdleDrain) add(p *VehicleStatePeriod, end time.Time) {
+	duration := end.Sub(p.StartAt)
+	loss := p.StartRange - p.EndRange
+	switch {
+	case p.State == VehicleStateAsleep || p.State == VehicleStateOffline:
+		d.AsleepDuration += duration
+		d.AsleepRangeLoss += loss
+	case p.ClimateOn:
+		d.ClimateDuration += duration
+		d.ClimateRangeLoss += loss
+	case p.SentryMode:
+		d.SentryDuration += duration
+		d.SentryRangeLoss += loss
+	default:
+		d.IdleDuration += duration
+		d.IdleRangeLoss += loss
+	}
+	d.EndAt = end
+	d.EndBatteryLevel = p.EndBatteryLevel
+	d.RangeLoss += loss
+}
+
+// VehicleStateUsecase maintains the vehicle state timeline.
+type VehicleStateUsecase struct {
+	repo     VehicleStatePeriodRepo
+	geocode  *GeocodeUsecase
+	geofence *GeofenceUsecase
+	tariff   *TariffUsecase
+	bus      *EventBus
+	log      *log.Helper
+}
+
+// NewVehicleStateUsecase creates a VehicleState usecase.
+func NewVehicleStateUsecase(
+	repo VehicleStatePeriodRepo,
+	geocode *GeocodeUsecase,
+	geofence *GeofenceUsecase,
+	tariff *TariffUsecase,
+	bus *EventBus,
+	logger log.Logger,
+) *VehicleStateUsecase {
+	return &VehicleStateUsecase{
+		repo:     repo,
+		geocode:  geocode,
+		geofence: geofence,
+		tariff:   tariff,
+		bus:      bus,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Track applies a snapshot of a vehicle owned by the user to the timeline of the vehicle.
+// The open period is extended when the state is unchanged, otherwise it is closed
+// and a new period starts at the snapshot. A period not sampled for maxSampleGap is closed
+// at its last sample instead. Both ends are tagged with the geofences of the user.
+// State changes and the start and end of drives and charging sessions are published on the bus.
+func (uc *VehicleStateUsecase) Track(ctx context.Context, userID int, s *VehicleSnapshot) error {
+	state := ClassifyVehicleState(s)
+	current, err := uc.repo.Current(ctx, s.VehicleID)
+	if err != nil {
+		return err
+	}
+	geofences, err := uc.geofences(ctx, userID, current, s)
+	if err != nil {
+		return err
+	}
+	gap := current != nil && s.CreatedAt.Sub(current.lastSampleAt()) > maxSampleGap
+	if current != nil && !gap && current.matches(state, s) {
+		current.extend(s)
+		return uc.repo.Update(ctx, current)
+	}
+	if current != nil {
+		if gap {
+			// The vehicle was not seen for a while, e.g., while the service was down,
+			// so the period ends at its last sample rather than spanning the gap.
+			end := current.lastSampleAt()
+			current.EndAt = &end
+			ends, err := uc.geofence.Match(ctx, userID, current.EndLatitude, current.EndLongitude)
+			if err != nil {
+				return err
+			}
+			current.EndGeofenceID = ends.BestID()
+		} else {
+			current.extend(s)
+			current.EndGeofenceID = geofences.BestID()
+		}
+		if current.HasAddress() {
+			current.EndAddress = uc.geocode.Lookup(ctx, current.EndLatitude, current.EndLongitude)
+		}
+		if err := uc.tariff.Price(ctx, userID, current); err != nil {
+			uc.log.WithContext(ctx).Errorw("msg", "price charging session failed", "periodID", current.ID, "err", err)
+		}
+		if err := uc.repo.Update(ctx, current); err != nil {
+			return err
+		}
+		uc.log.WithContext(ctx).Infow("msg", "vehicle state changed", "vehicleID", s.VehicleID, "from", current.State, "to", state)
+		uc.publishPeriod(userID, current, EventDriveEnd, EventChargeEnd, *current.EndAt)
+	}
+	next := newVehicleStatePeriod(state, s, current)
+	next.StartGeofenceID = geofences.BestID()
+	next.EndGeofenceID = next.StartGeofenceID
+	if state == VehicleStateCharging {
+		next.ChargeLocation = ChargeLocation(geofences.Best())
+	}
+	if next.HasAddress() {
+		next.StartAddress = uc.geocode.Lookup(ctx, next.StartLatitude, next.StartLongitude)
+	}
+	if err := uc.repo.Create(ctx, next); err != nil {
+		return err
+	}
+	change := &VehicleStateChange{To: state}
+	if current != nil {
+		change.From = current.State
+	}
+	uc.bus.Publish(&Event{Type: EventVehicleState, UserID: userID, VehicleID: s.VehicleID, Time: next.StartAt, Payload: change})
+	uc.publishPeriod(userID, next, EventDriveStart, EventChargeStart, next.StartAt)
+	return nil
+}
+
+// publishPeriod publishes the drive or charging event of a period, other states are ignored.
+func (uc *VehicleStateUsecase) publishPeriod(userID int, p *VehicleStatePeriod, drive, charge string, at time.Time) {
+	var typ string
+	switch p.State {
+	case VehicleStateDriving:
+		typ = drive
+	case VehicleStateCharging:
+		typ = charge
+	default:
+		return
+	}
+	uc.bus.Publish(&Event{Type: typ, UserID: userID, VehicleID: p.VehicleID, Time: at, Payload: p})
+}
+
+// geofences evaluates the move from the last known position to the snapshot
+// and returns the geofences of the user containing the vehicle.
+func (uc *VehicleStateUsecase) geofences(ctx context.Context, userID int, current *VehicleStatePeriod, s *VehicleSnapshot) (Geofences, error) {
+	var from, to geo.Point
+	if current != nil {
+		from = geo.Point{Lat: current.EndLatitude, Lon: current.EndLongitude}
+	}
+	to = from
+	if s.HasData() {
+		to = geo.Point{Lat: s.Latitude, Lon: s.Longitude}
+	}
+	return uc.geofence.Transition(ctx, userID, s.VehicleID, from, to, s.CreatedAt)
+}
+
+// IdleDrains groups consecutive online, asleep, offline and updating periods into parked
+// stretches and attributes their drain. Open periods are measured up to now.
+// A gap longer than maxParkedGap before a period means lost samples: the vehicle may have
+// moved unseen, so the stretch ends, the range lost over the gap is left out and the next
+// stretch starts without an address.
+func IdleDrains(periods []*VehicleStatePeriod, now time.Time) []*IdleDrain {
+	var (
+		drains  []*IdleDrain
+		current *IdleDrain
+		address string
+		prevEnd time.Time
+	)
+	for _, p := range periods {
+		if !prevEnd.IsZero() && p.StartAt.Sub(prevEnd) > maxParkedGap {
+			current = nil
+			address = ""
+		}
+		end := now
+		if p.EndAt != nil {
+			end = *p.EndAt
+		}
+		prevEnd = end
+		if !IsParkedState(p.State) {
+			current = nil
+			address = p.EndAddress
+			continue
+		}
+		if current == nil {
+			current = &IdleDrain{
+				StartAt:           p.StartAt,
+				Latitude:          p.StartLatitude,
+				Longitude:         p.StartLongitude,
+				Address:           address,
+				StartBatteryLevel: p.StartBatteryLevel,
+			}
+			drains = append(drains, current)
+		}
+		current.add(p, end)
+	}
+	return drains
+}
@@ -0,0 +1,215 @@
+package biz
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var drainStart = time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+
+// period returns a closed period of the state from minute start to minute end of drainStart,
+// losing the range from startRange to endRange.
+func period(state string, start, end int, startRange, endRange float64) *VehicleStatePeriod {
+	endAt := drainStart.Add(time.Duration(end) * time.Minute)
+	return &VehicleStatePeriod{
+		State:      state,
+		StartAt:    drainStart.Add(time.Duration(start) * time.Minute),
+		EndAt:      &endAt,
+		StartRange: startRange,
+		EndRange:   endRange,
+	}
+}
+
+func TestIdleDrainsAttribution(t *testing.T) {
+	sentry := period(VehicleStateOnline, 60, 120, 300, 296)
+	sentry.SentryMode = true
+	climate := period(VehicleStateOnline, 120, 150, 296, 290)
+	climate.ClimateOn, climate.SentryMode = true, true
+	offline := period(VehicleStateOffline, 150, 180, 290, 289)
+
+	cases := []struct {
+		name    string
+		period  *VehicleStatePeriod
+		asleep  time.Duration
+		sentry  time.Duration
+		climate time.Duration
+		idle    time.Duration
+		loss    float64
+	}{
+		{"asleep", period(VehicleStateAsleep, 0, 60, 300, 299), time.Hour, 0, 0, 0, 1},
+		{"offline counts as asleep", offline, 30 * time.Minute, 0, 0, 0, 1},
+		{"sentry", sentry, 0, time.Hour, 0, 0, 4},
+		{"climate over sentry", climate, 0, 0, 30 * time.Minute, 0, 6},
+		{"idle", period(VehicleStateOnline, 0, 10, 300, 299.5), 0, 0, 0, 10 * time.Minute, 0.5},
+		{"updating is idle", period(VehicleStateUpdating, 0, 20, 300, 299), 0, 0, 0, 20 * time.Minute, 1},
+	}
+	for _, c := range cases {
+		drains := IdleDrains([]*VehicleStatePeriod{c.period}, drainStart)
+		if len(drains) != 1 {
+			t.Fatalf("%s: IdleDrains = %d stretches, want 1", c.name, len(drains))
+		}
+		d := drains[0]
+		if d.AsleepDuration != c.asleep || d.SentryDuration != c.sentry || d.ClimateDuration != c.climate || d.IdleDuration != c.idle {
+			t.Errorf("%s: durations asleep %v, sentry %v, climate %v, idle %v", c.name, d.AsleepDuration, d.SentryDuration, d.ClimateDuration, d.IdleDuration)
+		}
+		if got := d.AsleepRangeLoss + d.SentryRangeLoss + d.ClimateRangeLoss + d.IdleRangeLoss; got != c.loss || d.RangeLoss != c.loss {
+			t.Errorf("%s: range loss %v, attributed %v, want %v", c.name, d.RangeLoss, got, c.loss)
+		}
+	}
+}
+
+func TestIdleDrainsStretches(t *testing.T) {
+	drive := period(VehicleStateDriving, 0, 30, 320, 300)
+	drive.EndAddress = "Home"
+	open := period(VehicleStateAsleep, 400, 0, 280, 280)
+	open.EndAt = nil
+
+	cases := []struct {
+		name      string
+		periods   []*VehicleStatePeriod
+		stretches []time.Duration
+		addresses []string
+	}{
+		{
+			name: "consecutive parked periods join",
+			periods: []*VehicleStatePeriod{
+				drive,
+				period(VehicleStateOnline, 30, 40, 300, 299),
+				period(VehicleStateAsleep, 40, 100, 299, 298),
+				period(VehicleStateOnline, 100, 110, 298, 297),
+			},
+			stretches: []time.Duration{80 * time.Minute},
+			addresses: []string{"Home"},
+		},
+		{
+			name: "a drive or charge ends the stretch",
+			periods: []*VehicleStatePeriod{
+				period(VehicleStateAsleep, 0, 60, 300, 299),
+				period(VehicleStateCharging, 60, 90, 299, 320),
+				period(VehicleStateOnline, 90, 100, 320, 319),
+			},
+			stretches: []time.Duration{time.Hour, 10 * time.Minute},
+			addresses: []string{"", ""},
+		},
+		{
+			name: "a short gap is bridged",
+			periods: []*VehicleStatePeriod{
+				drive,
+				period(VehicleStateAsleep, 30, 60, 300, 299),
+				period(VehicleStateOnline, 63, 70, 299, 298),
+			},
+			stretches: []time.Duration{40 * time.Minute},
+			addresses: []string{"Home"},
+		},
+		{
+			name: "a long gap splits the stretch",
+			periods: []*VehicleStatePeriod{
+				drive,
+				period(VehicleStateAsleep, 30, 60, 300, 299),
+				period(VehicleStateAsleep, 120, 180, 250, 249),
+			},
+			stretches: []time.Duration{30 * time.Minute, time.Hour},
+			addresses: []string{"Home", ""},
+		},
+		{
+			name: "a long gap after a drive drops its address",
+			periods: []*VehicleStatePeriod{
+				drive,
+				period(VehicleStateAsleep, 90, 120, 300, 299),
+			},
+			stretches: []time.Duration{30 * time.Minute},
+			addresses: []string{""},
+		},
+		{
+			name:      "an open period is measured up to now",
+			periods:   []*VehicleStatePeriod{open},
+			stretches: []time.Duration{100 * time.Minute},
+			addresses: []string{""},
+		},
+		{
+			name:    "no parked period",
+			periods: []*VehicleStatePeriod{drive},
+		},
+	}
+	now := drainStart.Add(500 * time.Minute)
+	for _, c := range cases {
+		drains := IdleDrains(c.periods, now)
+		if len(drains) != len(c.stretches) {
+			t.Errorf("%s: IdleDrains = %d stretches, want %d", c.name, len(drains), len(c.stretches))
+			continue
+		}
+		for i, d := range drains {
+			if d.Duration() != c.stretches[i] || d.Address != c.addresses[i] {
+				t.Errorf("%s: stretch %d lasts %v at %q, want %v at %q", c.name, i, d.Duration(), d.Address, c.stretches[i], c.addresses[i])
+			}
+		}
+	}
+}
+
+func TestIdleDrainsRangeLossExcludesGap(t *testing.T) {
+	periods := []*VehicleStatePeriod{
+		period(VehicleStateAsleep, 0, 60, 300, 299),
+		period(VehicleStateAsleep, 63, 120, 298, 297),
+	}
+	periods[1].EndBatteryLevel = 80
+	drains := IdleDrains(periods, drainStart)
+	if len(drains) != 1 {
+		t.Fatalf("IdleDrains = %d stretches, want 1", len(drains))
+	}
+	if d := drains[0]; d.RangeLoss != 2 || d.AsleepDuration != 117*time.Minute || d.EndBatteryLevel != 80 {
+		t.Errorf("drain = %+v, want 2 km lost over 117 minutes asleep ending at 80%%", d)
+	}
+}
+
+func TestChargingEnergyFromSessionStart(t *testing.T) {
+	// The vehicle keeps counting across sessions of the same plug-in.
+	charging := func(minute int, added float64) *VehicleSnapshot {
+		return &VehicleSnapshot{
+			State:             TeslaStateOnline,
+			ChargingState:     "Charging",
+			ChargeEnergyAdded: added,
+			CreatedAt:         drainStart.Add(time.Duration(minute) * time.Minute),
+		}
+	}
+	p := newVehicleStatePeriod(VehicleStateCharging, charging(0, 10), nil)
+	p.extend(charging(10, 12))
+	p.extend(charging(20, 15))
+	if p.StartEnergyAdded != 10 || p.EnergyAdded != 5 {
+		t.Errorf("session from 10 to 15 kWh added %v kWh from %v, want 5 kWh from 10", p.EnergyAdded, p.StartEnergyAdded)
+	}
+}
+
+func TestTrackClosesPeriodAtGap(t *testing.T) {
+	repo := &memoryPeriods{}
+	logger := log.DefaultLogger
+	bus := NewEventBus(localStream{}, logger)
+	uc := NewVehicleStateUsecase(repo, NewGeocodeUsecase(nil, nil, logger), NewGeofenceUsecase(memoryGeofences{}, bus, logger),
+		NewTariffUsecase(&memoryTariffs{}, nil, nil, repo, &memorySnapshots{}, logger), bus, logger)
+	online := func(minute int) *VehicleSnapshot {
+		return &VehicleSnapshot{VehicleID: 1, State: TeslaStateOnline, ShiftState: "P", CreatedAt: drainStart.Add(time.Duration(minute) * time.Minute)}
+	}
+
+	// Polling suspended for an idle vehicle leaves a gap shorter than maxSampleGap.
+	for _, minute := range []int{0, 1, 2, 2 + int((suspendDuration+time.Minute)/time.Minute), 50, 61, 200} {
+		if err := uc.Track(context.Background(), 1, online(minute)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := [][2]int{{0, 61}, {200, 200}}
+	if len(repo.periods) != len(want) {
+		t.Fatalf("Track recorded %d periods, want %d", len(repo.periods), len(want))
+	}
+	for i, p := range repo.periods {
+		start, end := drainStart.Add(time.Duration(want[i][0])*time.Minute), drainStart.Add(time.Duration(want[i][1])*time.Minute)
+		if !p.StartAt.Equal(start) || (i == 0 && !p.EndAt.Equal(end)) {
+			t.Errorf("period %d from %v to %v, want from %v to %v", i, p.StartAt, p.EndAt, start, end)
+		}
+	}
+	// The gap also splits the parked stretch.
+	if drains := IdleDrains(repo.periods, drainStart.Add(210*time.Minute)); len(drains) != 2 {
+		t.Errorf("IdleDrains = %d stretches, want 2", len(drains))
+	}
+}
@@ -80,6 +80,14 @@ type Server struct {
 	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
 	Mux           *Server_Mux            `protobuf:"bytes,3,opt,name=mux,proto3" json:"mux,omitempty"`
 	Tesla         *Server_Tesla          `protobuf:"bytes,4,opt,name=tesla,proto3" json:"tesla,omitempty"`
+	Poller        *Server_Poller         `protobuf:"bytes,5,opt,name=poller,proto3" json:"poller,omitempty"`
+	Auth          *Server_Auth           `protobuf:"bytes,6,opt,name=auth,proto3" json:"auth,omitempty"`
+	Rollup        *Server_Rollup         `protobuf:"bytes,7,opt,name=rollup,proto3" json:"rollup,omitempty"`
+	Tpms          *Server_Tpms           `protobuf:"bytes,8,opt,name=tpms,proto3" json:"tpms,omitempty"`
+	Mqtt          *Server_Mqtt           `protobuf:"bytes,9,opt,name=mqtt,proto3" json:"mqtt,omitempty"`
+	Metrics       *Server_Metrics        `protobuf:"bytes,10,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	Tracing       *Server_Tracing        `protobuf:"bytes,11,opt,name=tracing,proto3" json:"tracing,omitempty"`
+	Health        *Server_Health         `protobuf:"bytes,12,opt,name=health,proto3" json:"health,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -142,10 +150,69 @@ func (x *Server) GetTesla() *Server_Tesla {
 	return nil
 }
 
+func (x *Server) GetPoller() *Server_Poller {
+	if x != nil {
+		return x.Poller
+	}
+	return nil
+}
+
+func (x *Server) GetAuth() *Server_Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *Server) GetRollup() *Server_Rollup {
+	if x != nil {
+		return x.Rollup
+	}
+	return nil
+}
+
+func (x *Server) GetTpms() *Server_Tpms {
+	if x != nil {
+		return x.Tpms
+	}
+	return nil
+}
+
+func (x *Server) GetMqtt() *Server_Mqtt {
+	if x != nil {
+		return x.Mqtt
+	}
+	return nil
+}
+
+func (x *Server) GetMetrics() *Server_Metrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *Server) GetTracing() *Server_Tracing {
+	if x != nil {
+		return x.Tracing
+	}
+	return nil
+}
+
+func (x *Server) GetHealth() *Server_Health {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
 type Data struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Database *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	// redis shares the caches, rate limits, locks and OAuth states between the instances.
+	// Without an addr they are kept in memory, which suits a single instance.
+	Redis         *Data_Redis    `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	Geocoder      *Data_Geocoder `protobuf:"bytes,3,opt,name=geocoder,proto3" json:"geocoder,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -194,6 +261,13 @@ func (x *Data) GetRedis() *Data_Redis {
 	return nil
 }
 
+func (x *Data) GetGeocoder() *Data_Geocoder {
+	if x != nil {
+		return x.Geocoder
+	}
+	return nil
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Hostname      string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
@@ -383,11 +457,14 @@ func (x *Server_Mux) GetTimeout() *durationpb.Duration {
 }
 
 type Server_Tesla struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Callback      string                 `protobuf:"bytes,1,opt,name=callback,proto3" json:"callback,omitempty"`
-	RedirectUrl   string                 `protobuf:"bytes,2,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
-	ClientId      string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ClientSecret  string                 `protobuf:"bytes,4,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Callback     string                 `protobuf:"bytes,1,opt,name=callback,proto3" json:"callback,omitempty"`
+	RedirectUrl  string                 `protobuf:"bytes,2,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	ClientId     string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret string                 `protobuf:"bytes,4,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	// coord_type is the datum of the positions returned by the Fleet API region in use,
+	// e.g., wgs84 outside China. gcj02 by default, as returned by the China Fleet API.
+	CoordType     string `protobuf:"bytes,5,opt,name=coord_type,json=coordType,proto3" json:"coord_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -450,17 +527,559 @@ func (x *Server_Tesla) GetClientSecret() string {
 	return ""
 }
 
-type Data_Database struct {
+func (x *Server_Tesla) GetCoordType() string {
+	if x != nil {
+		return x.CoordType
+	}
+	return ""
+}
+
+type Server_Poller struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Driver        string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
-	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Interval      *durationpb.Duration   `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Poller) Reset() {
+	*x = Server_Poller{}
+	mi := &file_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Poller) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Poller) ProtoMessage() {}
+
+func (x *Server_Poller) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Poller.ProtoReflect.Descriptor instead.
+func (*Server_Poller) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 4}
+}
+
+func (x *Server_Poller) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Server_Poller) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+type Server_Auth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// jwt_secret signs the access tokens issued to users.
+	JwtSecret     string               `protobuf:"bytes,1,opt,name=jwt_secret,json=jwtSecret,proto3" json:"jwt_secret,omitempty"`
+	Expire        *durationpb.Duration `protobuf:"bytes,2,opt,name=expire,proto3" json:"expire,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Auth) Reset() {
+	*x = Server_Auth{}
+	mi := &file_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Auth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Auth) ProtoMessage() {}
+
+func (x *Server_Auth) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Auth.ProtoReflect.Descriptor instead.
+func (*Server_Auth) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 5}
+}
+
+func (x *Server_Auth) GetJwtSecret() string {
+	if x != nil {
+		return x.JwtSecret
+	}
+	return ""
+}
+
+func (x *Server_Auth) GetExpire() *durationpb.Duration {
+	if x != nil {
+		return x.Expire
+	}
+	return nil
+}
+
+type Server_Tpms struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// low_pressure_ratio raises an alert below this share of the recommended cold pressure, 0.85 by default.
+	LowPressureRatio float64 `protobuf:"fixed64,1,opt,name=low_pressure_ratio,json=lowPressureRatio,proto3" json:"low_pressure_ratio,omitempty"`
+	// leak_rate is the loss in bar per day relative to the other wheels that counts as a slow leak, 0.02 by default.
+	LeakRate float64 `protobuf:"fixed64,2,opt,name=leak_rate,json=leakRate,proto3" json:"leak_rate,omitempty"`
+	// leak_window is the history searched for slow leaks, 14 days by default.
+	LeakWindow    *durationpb.Duration `protobuf:"bytes,3,opt,name=leak_window,json=leakWindow,proto3" json:"leak_window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Tpms) Reset() {
+	*x = Server_Tpms{}
+	mi := &file_conf_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Tpms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Tpms) ProtoMessage() {}
+
+func (x *Server_Tpms) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Tpms.ProtoReflect.Descriptor instead.
+func (*Server_Tpms) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 6}
+}
+
+func (x *Server_Tpms) GetLowPressureRatio() float64 {
+	if x != nil {
+		return x.LowPressureRatio
+	}
+	return 0
+}
+
+func (x *Server_Tpms) GetLeakRate() float64 {
+	if x != nil {
+		return x.LeakRate
+	}
+	return 0
+}
+
+func (x *Server_Tpms) GetLeakWindow() *durationpb.Duration {
+	if x != nil {
+		return x.LeakWindow
+	}
+	return nil
+}
+
+type Server_Rollup struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// interval between two refreshes of the statistics rollups.
+	Interval      *durationpb.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Rollup) Reset() {
+	*x = Server_Rollup{}
+	mi := &file_conf_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Rollup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Rollup) ProtoMessage() {}
+
+func (x *Server_Rollup) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Rollup.ProtoReflect.Descriptor instead.
+func (*Server_Rollup) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 7}
+}
+
+func (x *Server_Rollup) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Server_Rollup) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+type Server_Mqtt struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Enabled bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// broker is the URL of the MQTT broker, e.g., tcp://localhost:1883 or ssl://broker:8883.
+	Broker string `protobuf:"bytes,2,opt,name=broker,proto3" json:"broker,omitempty"`
+	// client_id defaults to teslatrack.
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	// topic_prefix prefixes the state, availability and command topics, teslatrack by default.
+	TopicPrefix string `protobuf:"bytes,6,opt,name=topic_prefix,json=topicPrefix,proto3" json:"topic_prefix,omitempty"`
+	// discovery_prefix is the Home Assistant discovery prefix, homeassistant by default.
+	DiscoveryPrefix string `protobuf:"bytes,7,opt,name=discovery_prefix,json=discoveryPrefix,proto3" json:"discovery_prefix,omitempty"`
+	// commands exposes locks, climate, charging and wake up as controllable entities
+	// and forwards their command topics to the vehicles. Off by default.
+	Commands bool `protobuf:"varint,8,opt,name=commands,proto3" json:"commands,omitempty"`
+	// user_id is the user whose vehicles are published and controlled, required when enabled.
+	// A broker shared by several users needs a bridge per user.
+	UserId        int64 `protobuf:"varint,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Mqtt) Reset() {
+	*x = Server_Mqtt{}
+	mi := &file_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Mqtt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Mqtt) ProtoMessage() {}
+
+func (x *Server_Mqtt) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Mqtt.ProtoReflect.Descriptor instead.
+func (*Server_Mqtt) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 8}
+}
+
+func (x *Server_Mqtt) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Server_Mqtt) GetBroker() string {
+	if x != nil {
+		return x.Broker
+	}
+	return ""
+}
+
+func (x *Server_Mqtt) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *Server_Mqtt) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Server_Mqtt) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Server_Mqtt) GetTopicPrefix() string {
+	if x != nil {
+		return x.TopicPrefix
+	}
+	return ""
+}
+
+func (x *Server_Mqtt) GetDiscoveryPrefix() string {
+	if x != nil {
+		return x.DiscoveryPrefix
+	}
+	return ""
+}
+
+func (x *Server_Mqtt) GetCommands() bool {
+	if x != nil {
+		return x.Commands
+	}
+	return false
+}
+
+func (x *Server_Mqtt) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type Server_Metrics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// vehicles exports per-vehicle gauges, e.g., battery level, range and temperatures,
+	// labelled with the vehicle ID and name. Off by default.
+	Vehicles      bool `protobuf:"varint,1,opt,name=vehicles,proto3" json:"vehicles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Metrics) Reset() {
+	*x = Server_Metrics{}
+	mi := &file_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Metrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Metrics) ProtoMessage() {}
+
+func (x *Server_Metrics) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Metrics.ProtoReflect.Descriptor instead.
+func (*Server_Metrics) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 9}
+}
+
+func (x *Server_Metrics) GetVehicles() bool {
+	if x != nil {
+		return x.Vehicles
+	}
+	return false
+}
+
+type Server_Tracing struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// exporter is one of otlp or stdout; empty disables tracing.
+	Exporter string `protobuf:"bytes,1,opt,name=exporter,proto3" json:"exporter,omitempty"`
+	// endpoint is the host:port of the OTLP gRPC collector, localhost:4317 by default.
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// insecure connects to the collector without TLS.
+	Insecure bool `protobuf:"varint,3,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	// sample_ratio is the share of new traces sampled, 1 by default. Traces continued
+	// from a caller follow the sampling decision of the caller.
+	SampleRatio   float64 `protobuf:"fixed64,4,opt,name=sample_ratio,json=sampleRatio,proto3" json:"sample_ratio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Tracing) Reset() {
+	*x = Server_Tracing{}
+	mi := &file_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Tracing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Tracing) ProtoMessage() {}
+
+func (x *Server_Tracing) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Tracing.ProtoReflect.Descriptor instead.
+func (*Server_Tracing) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 10}
+}
+
+func (x *Server_Tracing) GetExporter() string {
+	if x != nil {
+		return x.Exporter
+	}
+	return ""
+}
+
+func (x *Server_Tracing) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Server_Tracing) GetInsecure() bool {
+	if x != nil {
+		return x.Insecure
+	}
+	return false
+}
+
+func (x *Server_Tracing) GetSampleRatio() float64 {
+	if x != nil {
+		return x.SampleRatio
+	}
+	return 0
+}
+
+type Server_Health struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// admin_token is the bearer token of the diagnostics endpoint; empty disables it.
+	AdminToken string `protobuf:"bytes,1,opt,name=admin_token,json=adminToken,proto3" json:"admin_token,omitempty"`
+	// poller_stale is the age of the last collection past which the poller counts as stuck,
+	// three poller intervals by default.
+	PollerStale *durationpb.Duration `protobuf:"bytes,2,opt,name=poller_stale,json=pollerStale,proto3" json:"poller_stale,omitempty"`
+	// tesla_failing is how long the Fleet API may fail without a success before
+	// the service counts as not ready, 30 minutes by default.
+	TeslaFailing  *durationpb.Duration `protobuf:"bytes,3,opt,name=tesla_failing,json=teslaFailing,proto3" json:"tesla_failing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_Health) Reset() {
+	*x = Server_Health{}
+	mi := &file_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_Health) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Health) ProtoMessage() {}
+
+func (x *Server_Health) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Health.ProtoReflect.Descriptor instead.
+func (*Server_Health) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{1, 11}
+}
+
+func (x *Server_Health) GetAdminToken() string {
+	if x != nil {
+		return x.AdminToken
+	}
+	return ""
+}
+
+func (x *Server_Health) GetPollerStale() *durationpb.Duration {
+	if x != nil {
+		return x.PollerStale
+	}
+	return nil
+}
+
+func (x *Server_Health) GetTeslaFailing() *durationpb.Duration {
+	if x != nil {
+		return x.TeslaFailing
+	}
+	return nil
+}
+
+type Data_Database struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// driver is one of mysql, postgres or sqlite3.
+	Driver string `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	// source is the DSN of the driver, e.g., file:/data/teslatrack.db?_fk=1 for sqlite3.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// max_open_conns limits the open connections, 0 for unlimited; sqlite3 defaults to 1.
+	MaxOpenConns int32 `protobuf:"varint,3,opt,name=max_open_conns,json=maxOpenConns,proto3" json:"max_open_conns,omitempty"`
+	// max_idle_conns limits the idle connections, 0 keeps the default of 2.
+	MaxIdleConns int32 `protobuf:"varint,4,opt,name=max_idle_conns,json=maxIdleConns,proto3" json:"max_idle_conns,omitempty"`
+	// conn_max_lifetime closes connections older than it, unlimited if unset.
+	ConnMaxLifetime *durationpb.Duration `protobuf:"bytes,5,opt,name=conn_max_lifetime,json=connMaxLifetime,proto3" json:"conn_max_lifetime,omitempty"`
+	// conn_max_idle_time closes connections idle for longer than it, unlimited if unset.
+	ConnMaxIdleTime *durationpb.Duration `protobuf:"bytes,6,opt,name=conn_max_idle_time,json=connMaxIdleTime,proto3" json:"conn_max_idle_time,omitempty"`
+	// migrate is the check of the schema version at startup: check (default) warns when the
+	// schema is behind the binary, require refuses to start then, up applies the pending
+	// migrations and off skips the check, e.g., when the schema is managed outside.
+	Migrate       string `protobuf:"bytes,7,opt,name=migrate,proto3" json:"migrate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -472,7 +1091,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -502,6 +1121,41 @@ func (x *Data_Database) GetSource() string {
 	return ""
 }
 
+func (x *Data_Database) GetMaxOpenConns() int32 {
+	if x != nil {
+		return x.MaxOpenConns
+	}
+	return 0
+}
+
+func (x *Data_Database) GetMaxIdleConns() int32 {
+	if x != nil {
+		return x.MaxIdleConns
+	}
+	return 0
+}
+
+func (x *Data_Database) GetConnMaxLifetime() *durationpb.Duration {
+	if x != nil {
+		return x.ConnMaxLifetime
+	}
+	return nil
+}
+
+func (x *Data_Database) GetConnMaxIdleTime() *durationpb.Duration {
+	if x != nil {
+		return x.ConnMaxIdleTime
+	}
+	return nil
+}
+
+func (x *Data_Database) GetMigrate() string {
+	if x != nil {
+		return x.Migrate
+	}
+	return ""
+}
+
 type Data_Redis struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -514,7 +1168,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -526,7 +1180,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -570,6 +1224,93 @@ func (x *Data_Redis) GetWriteTimeout() *durationpb.Duration {
 	return nil
 }
 
+type Data_Geocoder struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// provider is one of offline, nominatim or amap; empty disables geocoding.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// dataset is the GeoNames extract used by the offline provider.
+	Dataset string `protobuf:"bytes,2,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	// endpoint overrides the URL of an HTTP provider, e.g., a local Nominatim.
+	Endpoint      string               `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Key           string               `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Language      string               `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	Timeout       *durationpb.Duration `protobuf:"bytes,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data_Geocoder) Reset() {
+	*x = Data_Geocoder{}
+	mi := &file_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data_Geocoder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data_Geocoder) ProtoMessage() {}
+
+func (x *Data_Geocoder) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data_Geocoder.ProtoReflect.Descriptor instead.
+func (*Data_Geocoder) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Data_Geocoder) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Data_Geocoder) GetDataset() string {
+	if x != nil {
+		return x.Dataset
+	}
+	return ""
+}
+
+func (x *Data_Geocoder) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Data_Geocoder) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Data_Geocoder) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Data_Geocoder) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
 var File_conf_conf_proto protoreflect.FileDescriptor
 
 const file_conf_conf_proto_rawDesc = "" +
@@ -578,12 +1319,21 @@ const file_conf_conf_proto_rawDesc = "" +
 	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"]\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
-	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\"\xa4\x05\n" +
+	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\"\xd6\x10\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
 	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x12(\n" +
 	"\x03mux\x18\x03 \x01(\v2\x16.kratos.api.Server.MuxR\x03mux\x12.\n" +
-	"\x05tesla\x18\x04 \x01(\v2\x18.kratos.api.Server.TeslaR\x05tesla\x1a\x85\x01\n" +
+	"\x05tesla\x18\x04 \x01(\v2\x18.kratos.api.Server.TeslaR\x05tesla\x121\n" +
+	"\x06poller\x18\x05 \x01(\v2\x19.kratos.api.Server.PollerR\x06poller\x12+\n" +
+	"\x04auth\x18\x06 \x01(\v2\x17.kratos.api.Server.AuthR\x04auth\x121\n" +
+	"\x06rollup\x18\a \x01(\v2\x19.kratos.api.Server.RollupR\x06rollup\x12+\n" +
+	"\x04tpms\x18\b \x01(\v2\x17.kratos.api.Server.TpmsR\x04tpms\x12+\n" +
+	"\x04mqtt\x18\t \x01(\v2\x17.kratos.api.Server.MqttR\x04mqtt\x124\n" +
+	"\ametrics\x18\n" +
+	" \x01(\v2\x1a.kratos.api.Server.MetricsR\ametrics\x124\n" +
+	"\atracing\x18\v \x01(\v2\x1a.kratos.api.Server.TracingR\atracing\x121\n" +
+	"\x06health\x18\f \x01(\v2\x19.kratos.api.Server.HealthR\x06health\x1a\x85\x01\n" +
 	"\x04HTTP\x12\x1a\n" +
 	"\bhostname\x18\x01 \x01(\tR\bhostname\x12\x18\n" +
 	"\anetwork\x18\x02 \x01(\tR\anetwork\x12\x12\n" +
@@ -596,23 +1346,75 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\x03Mux\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
-	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a\x88\x01\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x1a\xa7\x01\n" +
 	"\x05Tesla\x12\x1a\n" +
 	"\bcallback\x18\x01 \x01(\tR\bcallback\x12!\n" +
 	"\fredirect_url\x18\x02 \x01(\tR\vredirectUrl\x12\x1b\n" +
 	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12#\n" +
-	"\rclient_secret\x18\x04 \x01(\tR\fclientSecret\"\xdd\x02\n" +
+	"\rclient_secret\x18\x04 \x01(\tR\fclientSecret\x12\x1d\n" +
+	"\n" +
+	"coord_type\x18\x05 \x01(\tR\tcoordType\x1aY\n" +
+	"\x06Poller\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\x1aX\n" +
+	"\x04Auth\x12\x1d\n" +
+	"\n" +
+	"jwt_secret\x18\x01 \x01(\tR\tjwtSecret\x121\n" +
+	"\x06expire\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x06expire\x1a\x8d\x01\n" +
+	"\x04Tpms\x12,\n" +
+	"\x12low_pressure_ratio\x18\x01 \x01(\x01R\x10lowPressureRatio\x12\x1b\n" +
+	"\tleak_rate\x18\x02 \x01(\x01R\bleakRate\x12:\n" +
+	"\vleak_window\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"leakWindow\x1aY\n" +
+	"\x06Rollup\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x125\n" +
+	"\binterval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\binterval\x1a\x90\x02\n" +
+	"\x04Mqtt\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x16\n" +
+	"\x06broker\x18\x02 \x01(\tR\x06broker\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x05 \x01(\tR\bpassword\x12!\n" +
+	"\ftopic_prefix\x18\x06 \x01(\tR\vtopicPrefix\x12)\n" +
+	"\x10discovery_prefix\x18\a \x01(\tR\x0fdiscoveryPrefix\x12\x1a\n" +
+	"\bcommands\x18\b \x01(\bR\bcommands\x12\x17\n" +
+	"\auser_id\x18\t \x01(\x03R\x06userId\x1a%\n" +
+	"\aMetrics\x12\x1a\n" +
+	"\bvehicles\x18\x01 \x01(\bR\bvehicles\x1a\x80\x01\n" +
+	"\aTracing\x12\x1a\n" +
+	"\bexporter\x18\x01 \x01(\tR\bexporter\x12\x1a\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x1a\n" +
+	"\binsecure\x18\x03 \x01(\bR\binsecure\x12!\n" +
+	"\fsample_ratio\x18\x04 \x01(\x01R\vsampleRatio\x1a\xa7\x01\n" +
+	"\x06Health\x12\x1f\n" +
+	"\vadmin_token\x18\x01 \x01(\tR\n" +
+	"adminToken\x12<\n" +
+	"\fpoller_stale\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\vpollerStale\x12>\n" +
+	"\rtesla_failing\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\fteslaFailing\"\xcc\x06\n" +
 	"\x04Data\x125\n" +
 	"\bdatabase\x18\x01 \x01(\v2\x19.kratos.api.Data.DatabaseR\bdatabase\x12,\n" +
-	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x1a:\n" +
+	"\x05redis\x18\x02 \x01(\v2\x16.kratos.api.Data.RedisR\x05redis\x125\n" +
+	"\bgeocoder\x18\x03 \x01(\v2\x19.kratos.api.Data.GeocoderR\bgeocoder\x1a\xaf\x02\n" +
 	"\bDatabase\x12\x16\n" +
 	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x16\n" +
-	"\x06source\x18\x02 \x01(\tR\x06source\x1a\xb3\x01\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12$\n" +
+	"\x0emax_open_conns\x18\x03 \x01(\x05R\fmaxOpenConns\x12$\n" +
+	"\x0emax_idle_conns\x18\x04 \x01(\x05R\fmaxIdleConns\x12E\n" +
+	"\x11conn_max_lifetime\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0fconnMaxLifetime\x12F\n" +
+	"\x12conn_max_idle_time\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x0fconnMaxIdleTime\x12\x18\n" +
+	"\amigrate\x18\a \x01(\tR\amigrate\x1a\xb3\x01\n" +
 	"\x05Redis\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x12<\n" +
 	"\fread_timeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vreadTimeout\x12>\n" +
-	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeoutB\x1fZ\x1dteslatrack/internal/conf;confb\x06proto3"
+	"\rwrite_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\fwriteTimeout\x1a\xbf\x01\n" +
+	"\bGeocoder\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x18\n" +
+	"\adataset\x18\x02 \x01(\tR\adataset\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\tR\x03key\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\x123\n" +
+	"\atimeout\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\atimeoutB\x1fZ\x1dteslatrack/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -626,7 +1428,7 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
 	(*Server)(nil),              // 1: kratos.api.Server
@@ -635,9 +1437,18 @@ var file_conf_conf_proto_goTypes = []any{
 	(*Server_GRPC)(nil),         // 4: kratos.api.Server.GRPC
 	(*Server_Mux)(nil),          // 5: kratos.api.Server.Mux
 	(*Server_Tesla)(nil),        // 6: kratos.api.Server.Tesla
-	(*Data_Database)(nil),       // 7: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 8: kratos.api.Data.Redis
-	(*durationpb.Duration)(nil), // 9: google.protobuf.Duration
+	(*Server_Poller)(nil),       // 7: kratos.api.Server.Poller
+	(*Server_Auth)(nil),         // 8: kratos.api.Server.Auth
+	(*Server_Tpms)(nil),         // 9: kratos.api.Server.Tpms
+	(*Server_Rollup)(nil),       // 10: kratos.api.Server.Rollup
+	(*Server_Mqtt)(nil),         // 11: kratos.api.Server.Mqtt
+	(*Server_Metrics)(nil),      // 12: kratos.api.Server.Metrics
+	(*Server_Tracing)(nil),      // 13: kratos.api.Server.Tracing
+	(*Server_Health)(nil),       // 14: kratos.api.Server.Health
+	(*Data_Database)(nil),       // 15: kratos.api.Data.Database
+	(*Data_Redis)(nil),          // 16: kratos.api.Data.Redis
+	(*Data_Geocoder)(nil),       // 17: kratos.api.Data.Geocoder
+	(*durationpb.Duration)(nil), // 18: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	1,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
@@ -646,18 +1457,36 @@ var file_conf_conf_proto_depIdxs = []int32{
 	4,  // 3: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
 	5,  // 4: kratos.api.Server.mux:type_name -> kratos.api.Server.Mux
 	6,  // 5: kratos.api.Server.tesla:type_name -> kratos.api.Server.Tesla
-	7,  // 6: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	8,  // 7: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	9,  // 8: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	9,  // 9: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	9,  // 10: kratos.api.Server.Mux.timeout:type_name -> google.protobuf.Duration
-	9,  // 11: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	9,  // 12: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	7,  // 6: kratos.api.Server.poller:type_name -> kratos.api.Server.Poller
+	8,  // 7: kratos.api.Server.auth:type_name -> kratos.api.Server.Auth
+	10, // 8: kratos.api.Server.rollup:type_name -> kratos.api.Server.Rollup
+	9,  // 9: kratos.api.Server.tpms:type_name -> kratos.api.Server.Tpms
+	11, // 10: kratos.api.Server.mqtt:type_name -> kratos.api.Server.Mqtt
+	12, // 11: kratos.api.Server.metrics:type_name -> kratos.api.Server.Metrics
+	13, // 12: kratos.api.Server.tracing:type_name -> kratos.api.Server.Tracing
+	14, // 13: kratos.api.Server.health:type_name -> kratos.api.Server.Health
+	15, // 14: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	16, // 15: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	17, // 16: kratos.api.Data.geocoder:type_name -> kratos.api.Data.Geocoder
+	18, // 17: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	18, // 18: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	18, // 19: kratos.api.Server.Mux.timeout:type_name -> google.protobuf.Duration
+	18, // 20: kratos.api.Server.Poller.interval:type_name -> google.protobuf.Duration
+	18, // 21: kratos.api.Server.Auth.expire:type_name -> google.protobuf.Duration
+	18, // 22: kratos.api.Server.Tpms.leak_window:type_name -> google.protobuf.Duration
+	18, // 23: kratos.api.Server.Rollup.interval:type_name -> google.protobuf.Duration
+	18, // 24: kratos.api.Server.Health.poller_stale:type_name -> google.protobuf.Duration
+	18, // 25: kratos.api.Server.Health.tesla_failing:type_name -> google.protobuf.Duration
+	18, // 26: kratos.api.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
+	18, // 27: kratos.api.Data.Database.conn_max_idle_time:type_name -> google.protobuf.Duration
+	18, // 28: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	18, // 29: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	18, // 30: kratos.api.Data.Geocoder.timeout:type_name -> google.protobuf.Duration
+	31, // [31:31] is the sub-list for method output_type
+	31, // [31:31] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -671,7 +1500,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,74 @@
+package data
+
+import (
+	"context"
+	"teslatrack/internal/biz"
+	"teslatrack/internal/data/ent"
+	"teslatrack/internal/data/ent/address"
+)
+
+var _ biz.AddressRepo = (*addressRepo)(nil)
+
+// addressRepo is the data layer implementation of AddressRepo.
+type addressRepo struct {
+	data *Data
+}
+
+// NewAddressRepo creates a new addressRepo.
+func NewAddressRepo(data *Data) biz.AddressRepo {
+	return &addressRepo{data: data}
+}
+
+// toBizAddress converts an ent.Address model to a biz.Address model.
+func toBizAddress(model *ent.Address) *biz.Address {
+	return &biz.Address{
+		ID:            model.ID,
+		GridLatitude:  model.GridLatitude,
+		GridLongitude: model.GridLongitude,
+		Name:          model.Name,
+		Road:          model.Road,
+		District:      model.District,
+		City:          model.City,
+		Province:      model.Province,
+		Country:       model.Country,
+		Formatted:     model.Formatted,
+		Provider:      model.Provider,
+		CreatedAt:     model.CreatedAt,
+	}
+}
+
+// Get implements biz.AddressRepo.
+func (r *addressRepo) Get(ctx context.Context, gridLat, gridLon int) (*biz.Address, error) {
+	model, err := r.data.db.Address.Query().
+		Where(address.GridLatitude(gridLat), address.GridLongitude(gridLon)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toBizAddress(model), nil
+}
+
+// Create implements biz.AddressRepo.
+func (r *addressRepo) Create(ctx context.Context, a *biz.Address) error {
+	model, err := r.data.db.Address.Create().
+		SetGridLatitude(a.GridLatitude).
+		SetGridLongitude(a.GridLongitude).
+		SetName(a.Name).
+		SetRoad(a.Road).
+		SetDistrict(a.District).
+		SetCity(a.City).
+		SetProvince(a.Province).
+		SetCountry(a.Country).
+		SetFormatted(a.Formatted).
+		SetProvider(a.Provider).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	a.ID = model.ID
+	a.CreatedAt = model.CreatedAt
+	return nil
+}
@@ -3,6 +3,7 @@ package data
 import (
 	"context"
 	"teslatrack/internal/biz"
+	"teslatrack/internal/data/ent"
 	"teslatrack/internal/data/ent/authorize"
 )
 
@@ -35,12 +36,16 @@ func (repo *authorizeRepo) Create(ctx context.Context, auth *biz.Authorize) erro
 
 // FindByClientID retrieves an authorization record from the database by its client ID.
 // It queries the database and maps the resulting ent.Authorize model to a biz.Authorize model.
+// It returns nil if no record is found.
 func (repo *authorizeRepo) FindByClientID(ctx context.Context, clientID string) (*biz.Authorize, error) {
 	model, err := repo.data.db.Authorize.Query().
 		Where(authorize.ClientID(clientID)).
 		First(ctx)
 	if err != nil {
-		return nil, err // Return error if the query fails or no record is found.
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err // Return error if the query fails.
 	}
 	// Map the ent model to the biz model.
 	return &biz.Authorize{
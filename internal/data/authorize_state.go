@@ -0,0 +1,38 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"teslatrack/internal/biz"
+	"time"
+)
+
+var _ biz.AuthorizeStateRepo = (*authorizeStateRepo)(nil)
+
+// authorizeStateRepo stores the states of the authorization redirects in the store.
+type authorizeStateRepo struct {
+	data *Data
+}
+
+// NewAuthorizeStateRepo creates a new authorizeStateRepo.
+func NewAuthorizeStateRepo(data *Data) biz.AuthorizeStateRepo {
+	return &authorizeStateRepo{data: data}
+}
+
+// Save implements biz.AuthorizeStateRepo.
+func (r *authorizeStateRepo) Save(ctx context.Context, state string, redirect *biz.AuthorizeState, ttl time.Duration) error {
+	return setJSON(ctx, r.data.kv, "oauth:state:"+state, redirect, ttl)
+}
+
+// Take implements biz.AuthorizeStateRepo.
+func (r *authorizeStateRepo) Take(ctx context.Context, state string) (*biz.AuthorizeState, error) {
+	value, ok, err := r.data.kv.take(ctx, "oauth:state:"+state)
+	if err != nil || !ok {
+		return nil, err
+	}
+	var redirect biz.AuthorizeState
+	if err := json.Unmarshal(value, &redirect); err != nil {
+		return nil, err
+	}
+	return &redirect, nil
+}
@@ -35,6 +35,7 @@ func toBizToken(model *ent.AuthorizeToken) *biz.AuthorizeToken {
 		AccessToken:  model.AccessToken,
 		RefreshToken: model.RefreshToken,
 		Scope:        model.Scope,
+		UserID:       model.UserID,
 		CreatedAt:    model.CreatedAt,
 		UpdatedAt:    model.UpdatedAt,
 		Deleted:      model.Deleted,
@@ -50,6 +51,7 @@ func (r *authorizeTokenRepo) Create(ctx context.Context, token *biz.AuthorizeTok
 		SetAccessToken(token.AccessToken).
 		SetRefreshToken(token.RefreshToken).
 		SetScope(token.Scope).
+		SetUserID(token.UserID).
 		Save(ctx)
 	if err != nil {
 		return nil, err
@@ -68,23 +70,29 @@ func (r *authorizeTokenRepo) Update(ctx context.Context, token *biz.AuthorizeTok
 	return err
 }
 
-// FindByClientID retrieves a token by its client ID.
+// FindByClientID retrieves a token by its client ID, or nil if there is none.
 func (r *authorizeTokenRepo) FindByClientID(ctx context.Context, clientID string) (*biz.AuthorizeToken, error) {
 	model, err := r.data.db.AuthorizeToken.Query().
 		Where(authorizetoken.ClientID(clientID), authorizetoken.Deleted(false)).
 		First(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return toBizToken(model), nil
 }
 
-// FindByAccessToken retrieves a token by its access token.
+// FindByAccessToken retrieves a token by its access token, or nil if there is none.
 func (r *authorizeTokenRepo) FindByAccessToken(ctx context.Context, accessToken string) (*biz.AuthorizeToken, error) {
 	model, err := r.data.db.AuthorizeToken.Query().
 		Where(authorizetoken.AccessToken(accessToken), authorizetoken.Deleted(false)).
 		First(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
 		return nil, err
 	}
 	return toBizToken(model), nil
@@ -97,3 +105,33 @@ func (r *authorizeTokenRepo) Delete(ctx context.Context, id int64) error {
 		Save(ctx)
 	return err
 }
+
+// FindActiveByUserID retrieves the most recent token of a user that has not been soft-deleted.
+func (r *authorizeTokenRepo) FindActiveByUserID(ctx context.Context, userID int) (*biz.AuthorizeToken, error) {
+	model, err := r.data.db.AuthorizeToken.Query().
+		Where(authorizetoken.UserID(userID), authorizetoken.Deleted(false)).
+		Order(ent.Desc(authorizetoken.FieldUpdatedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toBizToken(model), nil
+}
+
+// ListActive retrieves all tokens that have not been soft-deleted.
+func (r *authorizeTokenRepo) ListActive(ctx context.Context) ([]*biz.AuthorizeToken, error) {
+	models, err := r.data.db.AuthorizeToken.Query().
+		Where(authorizetoken.Deleted(false)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tokens := make([]*biz.AuthorizeToken, 0, len(models))
+	for _, model := range models {
+		tokens = append(tokens, toBizToken(model))
+	}
+	return tokens, nil
+}
@@ -0,0 +1,76 @@
+package data
+
+import (
+	"context"
+	"teslatrack/internal/biz"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	_ biz.RateLimiter = (*rateLimiter)(nil)
+	_ biz.Locker      = (*locker)(nil)
+	_ biz.Lock        = (*lock)(nil)
+)
+
+// rateLimiter counts the events of a key in fixed windows of the store.
+type rateLimiter struct {
+	data *Data
+}
+
+// NewRateLimiter creates a new rateLimiter.
+func NewRateLimiter(data *Data) biz.RateLimiter {
+	return &rateLimiter{data: data}
+}
+
+// Allow implements biz.RateLimiter.
+func (r *rateLimiter) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	n, err := r.data.kv.incr(ctx, "ratelimit:"+key, window)
+	if err != nil {
+		return false, err
+	}
+	return n <= int64(limit), nil
+}
+
+// locker holds a lock as a key of the store set to a token of the holder, so that
+// a holder whose lock expired does not release the lock of the next one.
+type locker struct {
+	data *Data
+}
+
+// NewLocker creates a new locker.
+func NewLocker(data *Data) biz.Locker {
+	return &locker{data: data}
+}
+
+// TryLock implements biz.Locker.
+func (l *locker) TryLock(ctx context.Context, key string, ttl time.Duration) (biz.Lock, bool, error) {
+	key = "lock:" + key
+	token := []byte(uuid.NewString())
+	ok, err := l.data.kv.setNX(ctx, key, token, ttl)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	return &lock{data: l.data, key: key, token: token}, true, nil
+}
+
+// lock is a lock held with a token, checked before it is extended or released.
+type lock struct {
+	data  *Data
+	key   string
+	token []byte
+}
+
+// Extend implements biz.Lock.
+func (l *lock) Extend(ctx context.Context, ttl time.Duration) (bool, error) {
+	return l.data.kv.expireIfEqual(ctx, l.key, l.token, ttl)
+}
+
+// Unlock implements biz.Lock.
+func (l *lock) Unlock() {
+	// The lock is released even if the context of the holder is done.
+	if _, err := l.data.kv.delIfEqual(context.Background(), l.key, l.token); err != nil {
+		l.data.log.Errorw("msg", "release lock failed", "key", l.key, "err", err)
+	}
+}
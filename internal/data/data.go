@@ -1,55 +1,235 @@
 package data
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
 	"teslatrack/internal/conf"
 	"teslatrack/internal/data/ent"
+	"teslatrack/internal/data/migrations"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/XSAM/otelsql"
 	"github.com/go-kratos/kratos/v2/log"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/google/wire"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // ProviderSet is data providers.
 var ProviderSet = wire.NewSet(
 	NewData,
-	NewGreeterRepo,
 	NewAuthorizeRepo,
 	NewAuthorizeTokenRepo,
 	NewPartnerRepo,
 	NewUserRepo,
 	NewVehicleRepo,
+	NewVehicleSnapshotRepo,
+	NewVehicleStatePeriodRepo,
+	NewAddressRepo,
+	NewGeocoder,
+	NewGeofenceRepo,
+	NewTariffRepo,
+	NewRollupRepo,
+	NewSoftwareUpdateRepo,
+	NewTirePressureRepo,
+	NewVehicleEventRepo,
+	NewHealthRepo,
+	NewRateLimiter,
+	NewLocker,
+	NewEventStream,
+	NewTransaction,
+	NewAuthorizeStateRepo,
 )
 
 // Data .
 type Data struct {
 	// db *ent.Edge
 	db *ent.Client
+	// sql is the connection pool of db.
+	sql *sql.DB
+	// rdb is the Redis client, nil unless Redis is configured.
+	rdb *redis.Client
+	// kv is the store of the caches, the rate limits, the locks and the OAuth states,
+	// in Redis when configured and in memory otherwise.
+	kv  store
+	log *log.Helper
 }
 
 // NewData .
-func NewData(c *conf.Data, logger log.Logger) (*Data, func(), error) {
-	db := mustNewMysqlSqlClient(c, logger)
+func NewData(c *conf.Data, mp metric.MeterProvider, tp trace.TracerProvider, logger log.Logger) (*Data, func(), error) {
+	drv, err := newSQLDriver(c.GetDatabase(), tp, mp, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	db := ent.NewClient(ent.Driver(drv))
+	reg, err := registerDBStats(mp.Meter("teslatrack/internal/data"), drv.DB())
+	if err != nil {
+		_ = db.Close()
+		return nil, nil, err
+	}
+
+	helper := log.NewHelper(logger)
+	m, err := newMigrator(drv.DB(), c.Database.Driver, migrations.FS, logger)
+	if err == nil {
+		err = checkSchema(context.Background(), m, c.Database.GetMigrate(), helper)
+	}
+	if err != nil {
+		_ = reg.Unregister()
+		_ = db.Close()
+		return nil, nil, err
+	}
+
+	rdb := newRedisClient(c)
+	if rdb == nil {
+		helper.Info("redis not configured, caching and rate limiting in memory")
+	}
 
 	cleanup := func() {
-		log.NewHelper(logger).Info("closing the data resources")
+		helper.Info("closing the data resources")
+		_ = reg.Unregister()
 		_ = db.Close()
+		if rdb != nil {
+			_ = rdb.Close()
+		}
 	}
 
-	return &Data{db: db}, cleanup, nil
+	return &Data{db: db, sql: drv.DB(), rdb: rdb, kv: newStore(rdb), log: helper}, cleanup, nil
 }
 
-func mustNewMysqlSqlClient(c *conf.Data, logger log.Logger) *ent.Client {
-	helper := log.NewHelper(logger)
+// newRedisClient creates the Redis client, nil when no Redis address is configured.
+// The client connects lazily, so an unreachable Redis does not stop the service.
+func newRedisClient(c *conf.Data) *redis.Client {
+	if c.GetRedis().GetAddr() == "" {
+		return nil
+	}
+	opts := &redis.Options{Network: c.Redis.Network, Addr: c.Redis.Addr}
+	if c.Redis.ReadTimeout != nil {
+		opts.ReadTimeout = c.Redis.ReadTimeout.AsDuration()
+	}
+	if c.Redis.WriteTimeout != nil {
+		opts.WriteTimeout = c.Redis.WriteTimeout.AsDuration()
+	}
+	return redis.NewClient(opts)
+}
+
+// registerDBStats exports the connection pool statistics of the database.
+func registerDBStats(meter metric.Meter, db *sql.DB) (metric.Registration, error) {
+	maxOpen, err := meter.Int64ObservableGauge("db.connections.max_open",
+		metric.WithDescription("Maximum number of open connections to the database, 0 for unlimited."))
+	if err != nil {
+		return nil, err
+	}
+	open, err := meter.Int64ObservableGauge("db.connections.open",
+		metric.WithDescription("Open connections to the database, in use and idle."))
+	if err != nil {
+		return nil, err
+	}
+	inUse, err := meter.Int64ObservableGauge("db.connections.in_use",
+		metric.WithDescription("Connections to the database currently in use."))
+	if err != nil {
+		return nil, err
+	}
+	idle, err := meter.Int64ObservableGauge("db.connections.idle",
+		metric.WithDescription("Idle connections to the database."))
+	if err != nil {
+		return nil, err
+	}
+	waits, err := meter.Int64ObservableCounter("db.connections.waits",
+		metric.WithDescription("Times a query waited for a free connection."))
+	if err != nil {
+		return nil, err
+	}
+	waitDuration, err := meter.Float64ObservableCounter("db.connections.wait.duration",
+		metric.WithDescription("Total time queries waited for a free connection."),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+	return meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		stats := db.Stats()
+		o.ObserveInt64(maxOpen, int64(stats.MaxOpenConnections))
+		o.ObserveInt64(open, int64(stats.OpenConnections))
+		o.ObserveInt64(inUse, int64(stats.InUse))
+		o.ObserveInt64(idle, int64(stats.Idle))
+		o.ObserveInt64(waits, stats.WaitCount)
+		o.ObserveFloat64(waitDuration, stats.WaitDuration.Seconds())
+		return nil
+	}, maxOpen, open, inUse, idle, waits, waitDuration)
+}
 
-	var databaseSource = c.Database.Source
-	var databaseDriver = c.Database.Driver
+// sqlDrivers maps the configured drivers, named after their ent dialect, to the database/sql drivers.
+var sqlDrivers = map[string]string{
+	dialect.MySQL:    "mysql",
+	dialect.Postgres: "pgx",
+	dialect.SQLite:   "sqlite3",
+}
 
-	helper.Debugw("msg", "mysql connecting", "databaseDriver", databaseDriver, "databaseSource", databaseSource)
-	clt, err := ent.Open(databaseDriver, databaseSource)
+// newSQLDriver opens the database of the configured driver with a span per query, a child
+// of the span of the query context. Queries outside a span, e.g., of background jobs, are
+// not traced. The connections are opened lazily, so an unreachable database is reported
+// by the readiness probe rather than stopping the service.
+func newSQLDriver(c *conf.Data_Database, tp trace.TracerProvider, mp metric.MeterProvider, logger log.Logger) (*entsql.Driver, error) {
+	name, ok := sqlDrivers[c.GetDriver()]
+	if !ok {
+		return nil, fmt.Errorf("unsupported database driver %q, want mysql, postgres or sqlite3", c.GetDriver())
+	}
+	db, err := otelsql.Open(name, c.GetSource(),
+		otelsql.WithTracerProvider(tp),
+		otelsql.WithMeterProvider(mp),
+		otelsql.WithAttributes(dbSystem(c.Driver)),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			DisableErrSkip:       true,
+			OmitConnResetSession: true,
+			OmitRows:             true,
+			SpanFilter: func(ctx context.Context, _ otelsql.Method, _ string, _ []driver.NamedValue) bool {
+				return trace.SpanContextFromContext(ctx).IsValid()
+			},
+		}),
+	)
 	if err != nil {
-		panic("must be new mysql client")
+		return nil, fmt.Errorf("open %s database: %w", c.Driver, err)
+	}
+	setPool(db, c)
+	log.NewHelper(logger).Infow("msg", "database opened", "driver", c.Driver)
+	return entsql.OpenDB(c.Driver, db), nil
+}
+
+// setPool applies the pool settings. SQLite serializes the writes to a file, so unless
+// configured it uses a single connection rather than failing writes with SQLITE_BUSY.
+func setPool(db *sql.DB, c *conf.Data_Database) {
+	maxOpen := int(c.GetMaxOpenConns())
+	if maxOpen == 0 && c.GetDriver() == dialect.SQLite {
+		maxOpen = 1
 	}
+	db.SetMaxOpenConns(maxOpen)
+	if c.GetMaxIdleConns() > 0 {
+		db.SetMaxIdleConns(int(c.GetMaxIdleConns()))
+	}
+	if d := c.GetConnMaxLifetime(); d != nil {
+		db.SetConnMaxLifetime(d.AsDuration())
+	}
+	if d := c.GetConnMaxIdleTime(); d != nil {
+		db.SetConnMaxIdleTime(d.AsDuration())
+	}
+}
 
-	helper.Info("mysql connection success")
-	return clt
+// dbSystem returns the semantic convention of the database of a driver.
+func dbSystem(driver string) attribute.KeyValue {
+	switch driver {
+	case dialect.Postgres:
+		return semconv.DBSystemPostgreSQL
+	case dialect.SQLite:
+		return semconv.DBSystemSqlite
+	default:
+		return semconv.DBSystemMySQL
+	}
 }
@@ -0,0 +1,67 @@
+package data
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"teslatrack/internal/conf"
+	"teslatrack/internal/data/ent"
+	"teslatrack/internal/data/ent/enttest"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var testDatabases atomic.Int64
+
+// newTestData opens a Data on a private in-memory SQLite database with the schema
+// created, and the in-memory store.
+func newTestData(t *testing.T) *Data {
+	t.Helper()
+	c := &conf.Data_Database{
+		Driver: "sqlite3",
+		Source: fmt.Sprintf("file:test%d?mode=memory&cache=shared&_fk=1", testDatabases.Add(1)),
+	}
+	drv, err := newSQLDriver(c, tracenoop.NewTracerProvider(), metricnoop.NewMeterProvider(), log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := enttest.NewClient(t, enttest.WithOptions(ent.Driver(drv)))
+	t.Cleanup(func() { _ = db.Close() })
+	return &Data{db: db, sql: drv.DB(), kv: newMemoryStore(), log: log.NewHelper(log.DefaultLogger)}
+}
+
+func TestNewSQLDriverUnsupported(t *testing.T) {
+	_, err := newSQLDriver(&conf.Data_Database{Driver: "oracle"}, tracenoop.NewTracerProvider(), metricnoop.NewMeterProvider(), log.DefaultLogger)
+	if err == nil || !strings.Contains(err.Error(), "oracle") {
+		t.Errorf("newSQLDriver(oracle) error = %v", err)
+	}
+}
+
+func TestNewSQLDriverPool(t *testing.T) {
+	tests := []struct {
+		c    *conf.Data_Database
+		want int
+	}{
+		{&conf.Data_Database{Driver: "sqlite3", Source: "file::memory:"}, 1},
+		{&conf.Data_Database{Driver: "sqlite3", Source: "file::memory:", MaxOpenConns: 4}, 4},
+		{&conf.Data_Database{Driver: "postgres", Source: "postgres://localhost/teslatrack"}, 0},
+		{&conf.Data_Database{Driver: "mysql", Source: "root@tcp(localhost)/teslatrack", MaxOpenConns: 20, ConnMaxLifetime: durationpb.New(0)}, 20},
+	}
+	for _, tt := range tests {
+		drv, err := newSQLDriver(tt.c, tracenoop.NewTracerProvider(), metricnoop.NewMeterProvider(), log.DefaultLogger)
+		if err != nil {
+			t.Fatalf("newSQLDriver(%s) error = %v", tt.c.Driver, err)
+		}
+		if got := drv.DB().Stats().MaxOpenConnections; got != tt.want {
+			t.Errorf("newSQLDriver(%s) max open connections = %d, want %d", tt.c.Driver, got, tt.want)
+		}
+		if drv.Dialect() != tt.c.Driver {
+			t.Errorf("newSQLDriver(%s) dialect = %s", tt.c.Driver, drv.Dialect())
+		}
+		_ = drv.Close()
+	}
+}
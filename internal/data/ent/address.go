@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"teslatrack/internal/data/ent/address"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Reverse geocoded address cache table
+type Address struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// WGS-84 latitude rounded to 4 decimals, times 10^4
+	GridLatitude int `json:"grid_latitude,omitempty"`
+	// WGS-84 longitude rounded to 4 decimals, times 10^4
+	GridLongitude int `json:"grid_longitude,omitempty"`
+	// Place name
+	Name string `json:"name,omitempty"`
+	// Road and house number
+	Road string `json:"road,omitempty"`
+	// District or suburb
+	District string `json:"district,omitempty"`
+	// City, town or village
+	City string `json:"city,omitempty"`
+	// Province or state
+	Province string `json:"province,omitempty"`
+	// Country
+	Country string `json:"country,omitempty"`
+	// Full formatted address
+	Formatted string `json:"formatted,omitempty"`
+	// Geocoder that resolved the address, e.g., offline, nominatim, amap
+	Provider string `json:"provider,omitempty"`
+	// Creation time
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Address) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case address.FieldID, address.FieldGridLatitude, address.FieldGridLongitude:
+			values[i] = new(sql.NullInt64)
+		case address.FieldName, address.FieldRoad, address.FieldDistrict, address.FieldCity, address.FieldProvince, address.FieldCountry, address.FieldFormatted, address.FieldProvider:
+			values[i] = new(sql.NullString)
+		case address.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Address fields.
+func (_m *Address) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case address.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case address.FieldGridLatitude:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field grid_latitude", values[i])
+			} else if value.Valid {
+				_m.GridLatitude = int(value.Int64)
+			}
+		case address.FieldGridLongitude:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field grid_longitude", values[i])
+			} else if value.Valid {
+				_m.GridLongitude = int(value.Int64)
+			}
+		case address.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case address.FieldRoad:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field road", values[i])
+			} else if value.Valid {
+				_m.Road = value.String
+			}
+		case address.FieldDistrict:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field district", values[i])
+			} else if value.Valid {
+				_m.District = value.String
+			}
+		case address.FieldCity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field city", values[i])
+			} else if value.Valid {
+				_m.City = value.String
+			}
+		case address.FieldProvince:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field province", values[i])
+			} else if value.Valid {
+				_m.Province = value.String
+			}
+		case address.FieldCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country", values[i])
+			} else if value.Valid {
+				_m.Country = value.String
+			}
+		case address.FieldFormatted:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field formatted", values[i])
+			} else if value.Valid {
+				_m.Formatted = value.String
+			}
+		case address.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case address.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Address.
+// This includes values selected through modifiers, order, etc.
+func (_m *Address) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Address.
+// Note that you need to call Address.Unwrap() before calling this method if this Address
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Address) Update() *AddressUpdateOne {
+	return NewAddressClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Address entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Address) Unwrap() *Address {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Address is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Address) String() string {
+	var builder strings.Builder
+	builder.WriteString("Address(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("grid_latitude=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GridLatitude))
+	builder.WriteString(", ")
+	builder.WriteString("grid_longitude=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GridLongitude))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("road=")
+	builder.WriteString(_m.Road)
+	builder.WriteString(", ")
+	builder.WriteString("district=")
+	builder.WriteString(_m.District)
+	builder.WriteString(", ")
+	builder.WriteString("city=")
+	builder.WriteString(_m.City)
+	builder.WriteString(", ")
+	builder.WriteString("province=")
+	builder.WriteString(_m.Province)
+	builder.WriteString(", ")
+	builder.WriteString("country=")
+	builder.WriteString(_m.Country)
+	builder.WriteString(", ")
+	builder.WriteString("formatted=")
+	builder.WriteString(_m.Formatted)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Addresses is a parsable slice of Address.
+type Addresses []*Address
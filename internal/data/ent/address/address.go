@@ -0,0 +1,134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package address
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the address type in the database.
+	Label = "address"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGridLatitude holds the string denoting the grid_latitude field in the database.
+	FieldGridLatitude = "grid_latitude"
+	// FieldGridLongitude holds the string denoting the grid_longitude field in the database.
+	FieldGridLongitude = "grid_longitude"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldRoad holds the string denoting the road field in the database.
+	FieldRoad = "road"
+	// FieldDistrict holds the string denoting the district field in the database.
+	FieldDistrict = "district"
+	// FieldCity holds the string denoting the city field in the database.
+	FieldCity = "city"
+	// FieldProvince holds the string denoting the province field in the database.
+	FieldProvince = "province"
+	// FieldCountry holds the string denoting the country field in the database.
+	FieldCountry = "country"
+	// FieldFormatted holds the string denoting the formatted field in the database.
+	FieldFormatted = "formatted"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the address in the database.
+	Table = "address"
+)
+
+// Columns holds all SQL columns for address fields.
+var Columns = []string{
+	FieldID,
+	FieldGridLatitude,
+	FieldGridLongitude,
+	FieldName,
+	FieldRoad,
+	FieldDistrict,
+	FieldCity,
+	FieldProvince,
+	FieldCountry,
+	FieldFormatted,
+	FieldProvider,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Address queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGridLatitude orders the results by the grid_latitude field.
+func ByGridLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGridLatitude, opts...).ToFunc()
+}
+
+// ByGridLongitude orders the results by the grid_longitude field.
+func ByGridLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGridLongitude, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByRoad orders the results by the road field.
+func ByRoad(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoad, opts...).ToFunc()
+}
+
+// ByDistrict orders the results by the district field.
+func ByDistrict(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDistrict, opts...).ToFunc()
+}
+
+// ByCity orders the results by the city field.
+func ByCity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCity, opts...).ToFunc()
+}
+
+// ByProvince orders the results by the province field.
+func ByProvince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvince, opts...).ToFunc()
+}
+
+// ByCountry orders the results by the country field.
+func ByCountry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCountry, opts...).ToFunc()
+}
+
+// ByFormatted orders the results by the formatted field.
+func ByFormatted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormatted, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
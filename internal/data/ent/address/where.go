@@ -0,0 +1,845 @@
+// Code generated by ent, DO NOT EDIT.
+
+package address
+
+import (
+	"teslatrack/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Address {
+	return predicate.Address(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Address {
+	return predicate.Address(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Address {
+	return predicate.Address(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Address {
+	return predicate.Address(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Address {
+	return predicate.Address(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Address {
+	return predicate.Address(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Address {
+	return predicate.Address(sql.FieldLTE(FieldID, id))
+}
+
+// GridLatitude applies equality check predicate on the "grid_latitude" field. It's identical to GridLatitudeEQ.
+func GridLatitude(v int) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldGridLatitude, v))
+}
+
+// GridLongitude applies equality check predicate on the "grid_longitude" field. It's identical to GridLongitudeEQ.
+func GridLongitude(v int) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldGridLongitude, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldName, v))
+}
+
+// Road applies equality check predicate on the "road" field. It's identical to RoadEQ.
+func Road(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldRoad, v))
+}
+
+// District applies equality check predicate on the "district" field. It's identical to DistrictEQ.
+func District(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldDistrict, v))
+}
+
+// City applies equality check predicate on the "city" field. It's identical to CityEQ.
+func City(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldCity, v))
+}
+
+// Province applies equality check predicate on the "province" field. It's identical to ProvinceEQ.
+func Province(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldProvince, v))
+}
+
+// Country applies equality check predicate on the "country" field. It's identical to CountryEQ.
+func Country(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldCountry, v))
+}
+
+// Formatted applies equality check predicate on the "formatted" field. It's identical to FormattedEQ.
+func Formatted(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldFormatted, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldProvider, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// GridLatitudeEQ applies the EQ predicate on the "grid_latitude" field.
+func GridLatitudeEQ(v int) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldGridLatitude, v))
+}
+
+// GridLatitudeNEQ applies the NEQ predicate on the "grid_latitude" field.
+func GridLatitudeNEQ(v int) predicate.Address {
+	return predicate.Address(sql.FieldNEQ(FieldGridLatitude, v))
+}
+
+// GridLatitudeIn applies the In predicate on the "grid_latitude" field.
+func GridLatitudeIn(vs ...int) predicate.Address {
+	return predicate.Address(sql.FieldIn(FieldGridLatitude, vs...))
+}
+
+// GridLatitudeNotIn applies the NotIn predicate on the "grid_latitude" field.
+func GridLatitudeNotIn(vs ...int) predicate.Address {
+	return predicate.Address(sql.FieldNotIn(FieldGridLatitude, vs...))
+}
+
+// GridLatitudeGT applies the GT predicate on the "grid_latitude" field.
+func GridLatitudeGT(v int) predicate.Address {
+	return predicate.Address(sql.FieldGT(FieldGridLatitude, v))
+}
+
+// GridLatitudeGTE applies the GTE predicate on the "grid_latitude" field.
+func GridLatitudeGTE(v int) predicate.Address {
+	return predicate.Address(sql.FieldGTE(FieldGridLatitude, v))
+}
+
+// GridLatitudeLT applies the LT predicate on the "grid_latitude" field.
+func GridLatitudeLT(v int) predicate.Address {
+	return predicate.Address(sql.FieldLT(FieldGridLatitude, v))
+}
+
+// GridLatitudeLTE applies the LTE predicate on the "grid_latitude" field.
+func GridLatitudeLTE(v int) predicate.Address {
+	return predicate.Address(sql.FieldLTE(FieldGridLatitude, v))
+}
+
+// GridLongitudeEQ applies the EQ predicate on the "grid_longitude" field.
+func GridLongitudeEQ(v int) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldGridLongitude, v))
+}
+
+// GridLongitudeNEQ applies the NEQ predicate on the "grid_longitude" field.
+func GridLongitudeNEQ(v int) predicate.Address {
+	return predicate.Address(sql.FieldNEQ(FieldGridLongitude, v))
+}
+
+// GridLongitudeIn applies the In predicate on the "grid_longitude" field.
+func GridLongitudeIn(vs ...int) predicate.Address {
+	return predicate.Address(sql.FieldIn(FieldGridLongitude, vs...))
+}
+
+// GridLongitudeNotIn applies the NotIn predicate on the "grid_longitude" field.
+func GridLongitudeNotIn(vs ...int) predicate.Address {
+	return predicate.Address(sql.FieldNotIn(FieldGridLongitude, vs...))
+}
+
+// GridLongitudeGT applies the GT predicate on the "grid_longitude" field.
+func GridLongitudeGT(v int) predicate.Address {
+	return predicate.Address(sql.FieldGT(FieldGridLongitude, v))
+}
+
+// GridLongitudeGTE applies the GTE predicate on the "grid_longitude" field.
+func GridLongitudeGTE(v int) predicate.Address {
+	return predicate.Address(sql.FieldGTE(FieldGridLongitude, v))
+}
+
+// GridLongitudeLT applies the LT predicate on the "grid_longitude" field.
+func GridLongitudeLT(v int) predicate.Address {
+	return predicate.Address(sql.FieldLT(FieldGridLongitude, v))
+}
+
+// GridLongitudeLTE applies the LTE predicate on the "grid_longitude" field.
+func GridLongitudeLTE(v int) predicate.Address {
+	return predicate.Address(sql.FieldLTE(FieldGridLongitude, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Address {
+	return predicate.Address(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Address {
+	return predicate.Address(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Address {
+	return predicate.Address(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.Address {
+	return predicate.Address(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.Address {
+	return predicate.Address(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldContainsFold(FieldName, v))
+}
+
+// RoadEQ applies the EQ predicate on the "road" field.
+func RoadEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldRoad, v))
+}
+
+// RoadNEQ applies the NEQ predicate on the "road" field.
+func RoadNEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldNEQ(FieldRoad, v))
+}
+
+// RoadIn applies the In predicate on the "road" field.
+func RoadIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldIn(FieldRoad, vs...))
+}
+
+// RoadNotIn applies the NotIn predicate on the "road" field.
+func RoadNotIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldNotIn(FieldRoad, vs...))
+}
+
+// RoadGT applies the GT predicate on the "road" field.
+func RoadGT(v string) predicate.Address {
+	return predicate.Address(sql.FieldGT(FieldRoad, v))
+}
+
+// RoadGTE applies the GTE predicate on the "road" field.
+func RoadGTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldGTE(FieldRoad, v))
+}
+
+// RoadLT applies the LT predicate on the "road" field.
+func RoadLT(v string) predicate.Address {
+	return predicate.Address(sql.FieldLT(FieldRoad, v))
+}
+
+// RoadLTE applies the LTE predicate on the "road" field.
+func RoadLTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldLTE(FieldRoad, v))
+}
+
+// RoadContains applies the Contains predicate on the "road" field.
+func RoadContains(v string) predicate.Address {
+	return predicate.Address(sql.FieldContains(FieldRoad, v))
+}
+
+// RoadHasPrefix applies the HasPrefix predicate on the "road" field.
+func RoadHasPrefix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasPrefix(FieldRoad, v))
+}
+
+// RoadHasSuffix applies the HasSuffix predicate on the "road" field.
+func RoadHasSuffix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasSuffix(FieldRoad, v))
+}
+
+// RoadIsNil applies the IsNil predicate on the "road" field.
+func RoadIsNil() predicate.Address {
+	return predicate.Address(sql.FieldIsNull(FieldRoad))
+}
+
+// RoadNotNil applies the NotNil predicate on the "road" field.
+func RoadNotNil() predicate.Address {
+	return predicate.Address(sql.FieldNotNull(FieldRoad))
+}
+
+// RoadEqualFold applies the EqualFold predicate on the "road" field.
+func RoadEqualFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldEqualFold(FieldRoad, v))
+}
+
+// RoadContainsFold applies the ContainsFold predicate on the "road" field.
+func RoadContainsFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldContainsFold(FieldRoad, v))
+}
+
+// DistrictEQ applies the EQ predicate on the "district" field.
+func DistrictEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldDistrict, v))
+}
+
+// DistrictNEQ applies the NEQ predicate on the "district" field.
+func DistrictNEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldNEQ(FieldDistrict, v))
+}
+
+// DistrictIn applies the In predicate on the "district" field.
+func DistrictIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldIn(FieldDistrict, vs...))
+}
+
+// DistrictNotIn applies the NotIn predicate on the "district" field.
+func DistrictNotIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldNotIn(FieldDistrict, vs...))
+}
+
+// DistrictGT applies the GT predicate on the "district" field.
+func DistrictGT(v string) predicate.Address {
+	return predicate.Address(sql.FieldGT(FieldDistrict, v))
+}
+
+// DistrictGTE applies the GTE predicate on the "district" field.
+func DistrictGTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldGTE(FieldDistrict, v))
+}
+
+// DistrictLT applies the LT predicate on the "district" field.
+func DistrictLT(v string) predicate.Address {
+	return predicate.Address(sql.FieldLT(FieldDistrict, v))
+}
+
+// DistrictLTE applies the LTE predicate on the "district" field.
+func DistrictLTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldLTE(FieldDistrict, v))
+}
+
+// DistrictContains applies the Contains predicate on the "district" field.
+func DistrictContains(v string) predicate.Address {
+	return predicate.Address(sql.FieldContains(FieldDistrict, v))
+}
+
+// DistrictHasPrefix applies the HasPrefix predicate on the "district" field.
+func DistrictHasPrefix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasPrefix(FieldDistrict, v))
+}
+
+// DistrictHasSuffix applies the HasSuffix predicate on the "district" field.
+func DistrictHasSuffix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasSuffix(FieldDistrict, v))
+}
+
+// DistrictIsNil applies the IsNil predicate on the "district" field.
+func DistrictIsNil() predicate.Address {
+	return predicate.Address(sql.FieldIsNull(FieldDistrict))
+}
+
+// DistrictNotNil applies the NotNil predicate on the "district" field.
+func DistrictNotNil() predicate.Address {
+	return predicate.Address(sql.FieldNotNull(FieldDistrict))
+}
+
+// DistrictEqualFold applies the EqualFold predicate on the "district" field.
+func DistrictEqualFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldEqualFold(FieldDistrict, v))
+}
+
+// DistrictContainsFold applies the ContainsFold predicate on the "district" field.
+func DistrictContainsFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldContainsFold(FieldDistrict, v))
+}
+
+// CityEQ applies the EQ predicate on the "city" field.
+func CityEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldCity, v))
+}
+
+// CityNEQ applies the NEQ predicate on the "city" field.
+func CityNEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldNEQ(FieldCity, v))
+}
+
+// CityIn applies the In predicate on the "city" field.
+func CityIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldIn(FieldCity, vs...))
+}
+
+// CityNotIn applies the NotIn predicate on the "city" field.
+func CityNotIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldNotIn(FieldCity, vs...))
+}
+
+// CityGT applies the GT predicate on the "city" field.
+func CityGT(v string) predicate.Address {
+	return predicate.Address(sql.FieldGT(FieldCity, v))
+}
+
+// CityGTE applies the GTE predicate on the "city" field.
+func CityGTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldGTE(FieldCity, v))
+}
+
+// CityLT applies the LT predicate on the "city" field.
+func CityLT(v string) predicate.Address {
+	return predicate.Address(sql.FieldLT(FieldCity, v))
+}
+
+// CityLTE applies the LTE predicate on the "city" field.
+func CityLTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldLTE(FieldCity, v))
+}
+
+// CityContains applies the Contains predicate on the "city" field.
+func CityContains(v string) predicate.Address {
+	return predicate.Address(sql.FieldContains(FieldCity, v))
+}
+
+// CityHasPrefix applies the HasPrefix predicate on the "city" field.
+func CityHasPrefix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasPrefix(FieldCity, v))
+}
+
+// CityHasSuffix applies the HasSuffix predicate on the "city" field.
+func CityHasSuffix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasSuffix(FieldCity, v))
+}
+
+// CityIsNil applies the IsNil predicate on the "city" field.
+func CityIsNil() predicate.Address {
+	return predicate.Address(sql.FieldIsNull(FieldCity))
+}
+
+// CityNotNil applies the NotNil predicate on the "city" field.
+func CityNotNil() predicate.Address {
+	return predicate.Address(sql.FieldNotNull(FieldCity))
+}
+
+// CityEqualFold applies the EqualFold predicate on the "city" field.
+func CityEqualFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldEqualFold(FieldCity, v))
+}
+
+// CityContainsFold applies the ContainsFold predicate on the "city" field.
+func CityContainsFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldContainsFold(FieldCity, v))
+}
+
+// ProvinceEQ applies the EQ predicate on the "province" field.
+func ProvinceEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldProvince, v))
+}
+
+// ProvinceNEQ applies the NEQ predicate on the "province" field.
+func ProvinceNEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldNEQ(FieldProvince, v))
+}
+
+// ProvinceIn applies the In predicate on the "province" field.
+func ProvinceIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldIn(FieldProvince, vs...))
+}
+
+// ProvinceNotIn applies the NotIn predicate on the "province" field.
+func ProvinceNotIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldNotIn(FieldProvince, vs...))
+}
+
+// ProvinceGT applies the GT predicate on the "province" field.
+func ProvinceGT(v string) predicate.Address {
+	return predicate.Address(sql.FieldGT(FieldProvince, v))
+}
+
+// ProvinceGTE applies the GTE predicate on the "province" field.
+func ProvinceGTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldGTE(FieldProvince, v))
+}
+
+// ProvinceLT applies the LT predicate on the "province" field.
+func ProvinceLT(v string) predicate.Address {
+	return predicate.Address(sql.FieldLT(FieldProvince, v))
+}
+
+// ProvinceLTE applies the LTE predicate on the "province" field.
+func ProvinceLTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldLTE(FieldProvince, v))
+}
+
+// ProvinceContains applies the Contains predicate on the "province" field.
+func ProvinceContains(v string) predicate.Address {
+	return predicate.Address(sql.FieldContains(FieldProvince, v))
+}
+
+// ProvinceHasPrefix applies the HasPrefix predicate on the "province" field.
+func ProvinceHasPrefix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasPrefix(FieldProvince, v))
+}
+
+// ProvinceHasSuffix applies the HasSuffix predicate on the "province" field.
+func ProvinceHasSuffix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasSuffix(FieldProvince, v))
+}
+
+// ProvinceIsNil applies the IsNil predicate on the "province" field.
+func ProvinceIsNil() predicate.Address {
+	return predicate.Address(sql.FieldIsNull(FieldProvince))
+}
+
+// ProvinceNotNil applies the NotNil predicate on the "province" field.
+func ProvinceNotNil() predicate.Address {
+	return predicate.Address(sql.FieldNotNull(FieldProvince))
+}
+
+// ProvinceEqualFold applies the EqualFold predicate on the "province" field.
+func ProvinceEqualFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldEqualFold(FieldProvince, v))
+}
+
+// ProvinceContainsFold applies the ContainsFold predicate on the "province" field.
+func ProvinceContainsFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldContainsFold(FieldProvince, v))
+}
+
+// CountryEQ applies the EQ predicate on the "country" field.
+func CountryEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldCountry, v))
+}
+
+// CountryNEQ applies the NEQ predicate on the "country" field.
+func CountryNEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldNEQ(FieldCountry, v))
+}
+
+// CountryIn applies the In predicate on the "country" field.
+func CountryIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldIn(FieldCountry, vs...))
+}
+
+// CountryNotIn applies the NotIn predicate on the "country" field.
+func CountryNotIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldNotIn(FieldCountry, vs...))
+}
+
+// CountryGT applies the GT predicate on the "country" field.
+func CountryGT(v string) predicate.Address {
+	return predicate.Address(sql.FieldGT(FieldCountry, v))
+}
+
+// CountryGTE applies the GTE predicate on the "country" field.
+func CountryGTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldGTE(FieldCountry, v))
+}
+
+// CountryLT applies the LT predicate on the "country" field.
+func CountryLT(v string) predicate.Address {
+	return predicate.Address(sql.FieldLT(FieldCountry, v))
+}
+
+// CountryLTE applies the LTE predicate on the "country" field.
+func CountryLTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldLTE(FieldCountry, v))
+}
+
+// CountryContains applies the Contains predicate on the "country" field.
+func CountryContains(v string) predicate.Address {
+	return predicate.Address(sql.FieldContains(FieldCountry, v))
+}
+
+// CountryHasPrefix applies the HasPrefix predicate on the "country" field.
+func CountryHasPrefix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasPrefix(FieldCountry, v))
+}
+
+// CountryHasSuffix applies the HasSuffix predicate on the "country" field.
+func CountryHasSuffix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasSuffix(FieldCountry, v))
+}
+
+// CountryIsNil applies the IsNil predicate on the "country" field.
+func CountryIsNil() predicate.Address {
+	return predicate.Address(sql.FieldIsNull(FieldCountry))
+}
+
+// CountryNotNil applies the NotNil predicate on the "country" field.
+func CountryNotNil() predicate.Address {
+	return predicate.Address(sql.FieldNotNull(FieldCountry))
+}
+
+// CountryEqualFold applies the EqualFold predicate on the "country" field.
+func CountryEqualFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldEqualFold(FieldCountry, v))
+}
+
+// CountryContainsFold applies the ContainsFold predicate on the "country" field.
+func CountryContainsFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldContainsFold(FieldCountry, v))
+}
+
+// FormattedEQ applies the EQ predicate on the "formatted" field.
+func FormattedEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldFormatted, v))
+}
+
+// FormattedNEQ applies the NEQ predicate on the "formatted" field.
+func FormattedNEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldNEQ(FieldFormatted, v))
+}
+
+// FormattedIn applies the In predicate on the "formatted" field.
+func FormattedIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldIn(FieldFormatted, vs...))
+}
+
+// FormattedNotIn applies the NotIn predicate on the "formatted" field.
+func FormattedNotIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldNotIn(FieldFormatted, vs...))
+}
+
+// FormattedGT applies the GT predicate on the "formatted" field.
+func FormattedGT(v string) predicate.Address {
+	return predicate.Address(sql.FieldGT(FieldFormatted, v))
+}
+
+// FormattedGTE applies the GTE predicate on the "formatted" field.
+func FormattedGTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldGTE(FieldFormatted, v))
+}
+
+// FormattedLT applies the LT predicate on the "formatted" field.
+func FormattedLT(v string) predicate.Address {
+	return predicate.Address(sql.FieldLT(FieldFormatted, v))
+}
+
+// FormattedLTE applies the LTE predicate on the "formatted" field.
+func FormattedLTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldLTE(FieldFormatted, v))
+}
+
+// FormattedContains applies the Contains predicate on the "formatted" field.
+func FormattedContains(v string) predicate.Address {
+	return predicate.Address(sql.FieldContains(FieldFormatted, v))
+}
+
+// FormattedHasPrefix applies the HasPrefix predicate on the "formatted" field.
+func FormattedHasPrefix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasPrefix(FieldFormatted, v))
+}
+
+// FormattedHasSuffix applies the HasSuffix predicate on the "formatted" field.
+func FormattedHasSuffix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasSuffix(FieldFormatted, v))
+}
+
+// FormattedIsNil applies the IsNil predicate on the "formatted" field.
+func FormattedIsNil() predicate.Address {
+	return predicate.Address(sql.FieldIsNull(FieldFormatted))
+}
+
+// FormattedNotNil applies the NotNil predicate on the "formatted" field.
+func FormattedNotNil() predicate.Address {
+	return predicate.Address(sql.FieldNotNull(FieldFormatted))
+}
+
+// FormattedEqualFold applies the EqualFold predicate on the "formatted" field.
+func FormattedEqualFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldEqualFold(FieldFormatted, v))
+}
+
+// FormattedContainsFold applies the ContainsFold predicate on the "formatted" field.
+func FormattedContainsFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldContainsFold(FieldFormatted, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.Address {
+	return predicate.Address(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.Address {
+	return predicate.Address(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.Address {
+	return predicate.Address(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.Address {
+	return predicate.Address(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.Address {
+	return predicate.Address(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.Address {
+	return predicate.Address(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.Address {
+	return predicate.Address(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderIsNil applies the IsNil predicate on the "provider" field.
+func ProviderIsNil() predicate.Address {
+	return predicate.Address(sql.FieldIsNull(FieldProvider))
+}
+
+// ProviderNotNil applies the NotNil predicate on the "provider" field.
+func ProviderNotNil() predicate.Address {
+	return predicate.Address(sql.FieldNotNull(FieldProvider))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.Address {
+	return predicate.Address(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Address {
+	return predicate.Address(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Address {
+	return predicate.Address(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Address {
+	return predicate.Address(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Address {
+	return predicate.Address(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Address {
+	return predicate.Address(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Address {
+	return predicate.Address(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Address {
+	return predicate.Address(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Address {
+	return predicate.Address(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Address) predicate.Address {
+	return predicate.Address(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Address) predicate.Address {
+	return predicate.Address(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Address) predicate.Address {
+	return predicate.Address(sql.NotPredicates(p))
+}
@@ -0,0 +1,372 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/address"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AddressCreate is the builder for creating a Address entity.
+type AddressCreate struct {
+	config
+	mutation *AddressMutation
+	hooks    []Hook
+}
+
+// SetGridLatitude sets the "grid_latitude" field.
+func (_c *AddressCreate) SetGridLatitude(v int) *AddressCreate {
+	_c.mutation.SetGridLatitude(v)
+	return _c
+}
+
+// SetGridLongitude sets the "grid_longitude" field.
+func (_c *AddressCreate) SetGridLongitude(v int) *AddressCreate {
+	_c.mutation.SetGridLongitude(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *AddressCreate) SetName(v string) *AddressCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *AddressCreate) SetNillableName(v *string) *AddressCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetRoad sets the "road" field.
+func (_c *AddressCreate) SetRoad(v string) *AddressCreate {
+	_c.mutation.SetRoad(v)
+	return _c
+}
+
+// SetNillableRoad sets the "road" field if the given value is not nil.
+func (_c *AddressCreate) SetNillableRoad(v *string) *AddressCreate {
+	if v != nil {
+		_c.SetRoad(*v)
+	}
+	return _c
+}
+
+// SetDistrict sets the "district" field.
+func (_c *AddressCreate) SetDistrict(v string) *AddressCreate {
+	_c.mutation.SetDistrict(v)
+	return _c
+}
+
+// SetNillableDistrict sets the "district" field if the given value is not nil.
+func (_c *AddressCreate) SetNillableDistrict(v *string) *AddressCreate {
+	if v != nil {
+		_c.SetDistrict(*v)
+	}
+	return _c
+}
+
+// SetCity sets the "city" field.
+func (_c *AddressCreate) SetCity(v string) *AddressCreate {
+	_c.mutation.SetCity(v)
+	return _c
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (_c *AddressCreate) SetNillableCity(v *string) *AddressCreate {
+	if v != nil {
+		_c.SetCity(*v)
+	}
+	return _c
+}
+
+// SetProvince sets the "province" field.
+func (_c *AddressCreate) SetProvince(v string) *AddressCreate {
+	_c.mutation.SetProvince(v)
+	return _c
+}
+
+// SetNillableProvince sets the "province" field if the given value is not nil.
+func (_c *AddressCreate) SetNillableProvince(v *string) *AddressCreate {
+	if v != nil {
+		_c.SetProvince(*v)
+	}
+	return _c
+}
+
+// SetCountry sets the "country" field.
+func (_c *AddressCreate) SetCountry(v string) *AddressCreate {
+	_c.mutation.SetCountry(v)
+	return _c
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_c *AddressCreate) SetNillableCountry(v *string) *AddressCreate {
+	if v != nil {
+		_c.SetCountry(*v)
+	}
+	return _c
+}
+
+// SetFormatted sets the "formatted" field.
+func (_c *AddressCreate) SetFormatted(v string) *AddressCreate {
+	_c.mutation.SetFormatted(v)
+	return _c
+}
+
+// SetNillableFormatted sets the "formatted" field if the given value is not nil.
+func (_c *AddressCreate) SetNillableFormatted(v *string) *AddressCreate {
+	if v != nil {
+		_c.SetFormatted(*v)
+	}
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *AddressCreate) SetProvider(v string) *AddressCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_c *AddressCreate) SetNillableProvider(v *string) *AddressCreate {
+	if v != nil {
+		_c.SetProvider(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AddressCreate) SetCreatedAt(v time.Time) *AddressCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AddressCreate) SetNillableCreatedAt(v *time.Time) *AddressCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the AddressMutation object of the builder.
+func (_c *AddressCreate) Mutation() *AddressMutation {
+	return _c.mutation
+}
+
+// Save creates the Address in the database.
+func (_c *AddressCreate) Save(ctx context.Context) (*Address, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AddressCreate) SaveX(ctx context.Context) *Address {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AddressCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AddressCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AddressCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := address.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AddressCreate) check() error {
+	if _, ok := _c.mutation.GridLatitude(); !ok {
+		return &ValidationError{Name: "grid_latitude", err: errors.New(`ent: missing required field "Address.grid_latitude"`)}
+	}
+	if _, ok := _c.mutation.GridLongitude(); !ok {
+		return &ValidationError{Name: "grid_longitude", err: errors.New(`ent: missing required field "Address.grid_longitude"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Address.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *AddressCreate) sqlSave(ctx context.Context) (*Address, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AddressCreate) createSpec() (*Address, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Address{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(address.Table, sqlgraph.NewFieldSpec(address.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.GridLatitude(); ok {
+		_spec.SetField(address.FieldGridLatitude, field.TypeInt, value)
+		_node.GridLatitude = value
+	}
+	if value, ok := _c.mutation.GridLongitude(); ok {
+		_spec.SetField(address.FieldGridLongitude, field.TypeInt, value)
+		_node.GridLongitude = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(address.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Road(); ok {
+		_spec.SetField(address.FieldRoad, field.TypeString, value)
+		_node.Road = value
+	}
+	if value, ok := _c.mutation.District(); ok {
+		_spec.SetField(address.FieldDistrict, field.TypeString, value)
+		_node.District = value
+	}
+	if value, ok := _c.mutation.City(); ok {
+		_spec.SetField(address.FieldCity, field.TypeString, value)
+		_node.City = value
+	}
+	if value, ok := _c.mutation.Province(); ok {
+		_spec.SetField(address.FieldProvince, field.TypeString, value)
+		_node.Province = value
+	}
+	if value, ok := _c.mutation.Country(); ok {
+		_spec.SetField(address.FieldCountry, field.TypeString, value)
+		_node.Country = value
+	}
+	if value, ok := _c.mutation.Formatted(); ok {
+		_spec.SetField(address.FieldFormatted, field.TypeString, value)
+		_node.Formatted = value
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(address.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(address.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AddressCreateBulk is the builder for creating many Address entities in bulk.
+type AddressCreateBulk struct {
+	config
+	err      error
+	builders []*AddressCreate
+}
+
+// Save creates the Address entities in the database.
+func (_c *AddressCreateBulk) Save(ctx context.Context) ([]*Address, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Address, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AddressMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AddressCreateBulk) SaveX(ctx context.Context) []*Address {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AddressCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AddressCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,699 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/address"
+	"teslatrack/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AddressUpdate is the builder for updating Address entities.
+type AddressUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AddressMutation
+}
+
+// Where appends a list predicates to the AddressUpdate builder.
+func (_u *AddressUpdate) Where(ps ...predicate.Address) *AddressUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetGridLatitude sets the "grid_latitude" field.
+func (_u *AddressUpdate) SetGridLatitude(v int) *AddressUpdate {
+	_u.mutation.ResetGridLatitude()
+	_u.mutation.SetGridLatitude(v)
+	return _u
+}
+
+// SetNillableGridLatitude sets the "grid_latitude" field if the given value is not nil.
+func (_u *AddressUpdate) SetNillableGridLatitude(v *int) *AddressUpdate {
+	if v != nil {
+		_u.SetGridLatitude(*v)
+	}
+	return _u
+}
+
+// AddGridLatitude adds value to the "grid_latitude" field.
+func (_u *AddressUpdate) AddGridLatitude(v int) *AddressUpdate {
+	_u.mutation.AddGridLatitude(v)
+	return _u
+}
+
+// SetGridLongitude sets the "grid_longitude" field.
+func (_u *AddressUpdate) SetGridLongitude(v int) *AddressUpdate {
+	_u.mutation.ResetGridLongitude()
+	_u.mutation.SetGridLongitude(v)
+	return _u
+}
+
+// SetNillableGridLongitude sets the "grid_longitude" field if the given value is not nil.
+func (_u *AddressUpdate) SetNillableGridLongitude(v *int) *AddressUpdate {
+	if v != nil {
+		_u.SetGridLongitude(*v)
+	}
+	return _u
+}
+
+// AddGridLongitude adds value to the "grid_longitude" field.
+func (_u *AddressUpdate) AddGridLongitude(v int) *AddressUpdate {
+	_u.mutation.AddGridLongitude(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AddressUpdate) SetName(v string) *AddressUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AddressUpdate) SetNillableName(v *string) *AddressUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *AddressUpdate) ClearName() *AddressUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetRoad sets the "road" field.
+func (_u *AddressUpdate) SetRoad(v string) *AddressUpdate {
+	_u.mutation.SetRoad(v)
+	return _u
+}
+
+// SetNillableRoad sets the "road" field if the given value is not nil.
+func (_u *AddressUpdate) SetNillableRoad(v *string) *AddressUpdate {
+	if v != nil {
+		_u.SetRoad(*v)
+	}
+	return _u
+}
+
+// ClearRoad clears the value of the "road" field.
+func (_u *AddressUpdate) ClearRoad() *AddressUpdate {
+	_u.mutation.ClearRoad()
+	return _u
+}
+
+// SetDistrict sets the "district" field.
+func (_u *AddressUpdate) SetDistrict(v string) *AddressUpdate {
+	_u.mutation.SetDistrict(v)
+	return _u
+}
+
+// SetNillableDistrict sets the "district" field if the given value is not nil.
+func (_u *AddressUpdate) SetNillableDistrict(v *string) *AddressUpdate {
+	if v != nil {
+		_u.SetDistrict(*v)
+	}
+	return _u
+}
+
+// ClearDistrict clears the value of the "district" field.
+func (_u *AddressUpdate) ClearDistrict() *AddressUpdate {
+	_u.mutation.ClearDistrict()
+	return _u
+}
+
+// SetCity sets the "city" field.
+func (_u *AddressUpdate) SetCity(v string) *AddressUpdate {
+	_u.mutation.SetCity(v)
+	return _u
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (_u *AddressUpdate) SetNillableCity(v *string) *AddressUpdate {
+	if v != nil {
+		_u.SetCity(*v)
+	}
+	return _u
+}
+
+// ClearCity clears the value of the "city" field.
+func (_u *AddressUpdate) ClearCity() *AddressUpdate {
+	_u.mutation.ClearCity()
+	return _u
+}
+
+// SetProvince sets the "province" field.
+func (_u *AddressUpdate) SetProvince(v string) *AddressUpdate {
+	_u.mutation.SetProvince(v)
+	return _u
+}
+
+// SetNillableProvince sets the "province" field if the given value is not nil.
+func (_u *AddressUpdate) SetNillableProvince(v *string) *AddressUpdate {
+	if v != nil {
+		_u.SetProvince(*v)
+	}
+	return _u
+}
+
+// ClearProvince clears the value of the "province" field.
+func (_u *AddressUpdate) ClearProvince() *AddressUpdate {
+	_u.mutation.ClearProvince()
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *AddressUpdate) SetCountry(v string) *AddressUpdate {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *AddressUpdate) SetNillableCountry(v *string) *AddressUpdate {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// ClearCountry clears the value of the "country" field.
+func (_u *AddressUpdate) ClearCountry() *AddressUpdate {
+	_u.mutation.ClearCountry()
+	return _u
+}
+
+// SetFormatted sets the "formatted" field.
+func (_u *AddressUpdate) SetFormatted(v string) *AddressUpdate {
+	_u.mutation.SetFormatted(v)
+	return _u
+}
+
+// SetNillableFormatted sets the "formatted" field if the given value is not nil.
+func (_u *AddressUpdate) SetNillableFormatted(v *string) *AddressUpdate {
+	if v != nil {
+		_u.SetFormatted(*v)
+	}
+	return _u
+}
+
+// ClearFormatted clears the value of the "formatted" field.
+func (_u *AddressUpdate) ClearFormatted() *AddressUpdate {
+	_u.mutation.ClearFormatted()
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *AddressUpdate) SetProvider(v string) *AddressUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *AddressUpdate) SetNillableProvider(v *string) *AddressUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (_u *AddressUpdate) ClearProvider() *AddressUpdate {
+	_u.mutation.ClearProvider()
+	return _u
+}
+
+// Mutation returns the AddressMutation object of the builder.
+func (_u *AddressUpdate) Mutation() *AddressMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AddressUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AddressUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AddressUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AddressUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AddressUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(address.Table, address.Columns, sqlgraph.NewFieldSpec(address.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GridLatitude(); ok {
+		_spec.SetField(address.FieldGridLatitude, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGridLatitude(); ok {
+		_spec.AddField(address.FieldGridLatitude, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GridLongitude(); ok {
+		_spec.SetField(address.FieldGridLongitude, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGridLongitude(); ok {
+		_spec.AddField(address.FieldGridLongitude, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(address.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(address.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Road(); ok {
+		_spec.SetField(address.FieldRoad, field.TypeString, value)
+	}
+	if _u.mutation.RoadCleared() {
+		_spec.ClearField(address.FieldRoad, field.TypeString)
+	}
+	if value, ok := _u.mutation.District(); ok {
+		_spec.SetField(address.FieldDistrict, field.TypeString, value)
+	}
+	if _u.mutation.DistrictCleared() {
+		_spec.ClearField(address.FieldDistrict, field.TypeString)
+	}
+	if value, ok := _u.mutation.City(); ok {
+		_spec.SetField(address.FieldCity, field.TypeString, value)
+	}
+	if _u.mutation.CityCleared() {
+		_spec.ClearField(address.FieldCity, field.TypeString)
+	}
+	if value, ok := _u.mutation.Province(); ok {
+		_spec.SetField(address.FieldProvince, field.TypeString, value)
+	}
+	if _u.mutation.ProvinceCleared() {
+		_spec.ClearField(address.FieldProvince, field.TypeString)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(address.FieldCountry, field.TypeString, value)
+	}
+	if _u.mutation.CountryCleared() {
+		_spec.ClearField(address.FieldCountry, field.TypeString)
+	}
+	if value, ok := _u.mutation.Formatted(); ok {
+		_spec.SetField(address.FieldFormatted, field.TypeString, value)
+	}
+	if _u.mutation.FormattedCleared() {
+		_spec.ClearField(address.FieldFormatted, field.TypeString)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(address.FieldProvider, field.TypeString, value)
+	}
+	if _u.mutation.ProviderCleared() {
+		_spec.ClearField(address.FieldProvider, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{address.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AddressUpdateOne is the builder for updating a single Address entity.
+type AddressUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AddressMutation
+}
+
+// SetGridLatitude sets the "grid_latitude" field.
+func (_u *AddressUpdateOne) SetGridLatitude(v int) *AddressUpdateOne {
+	_u.mutation.ResetGridLatitude()
+	_u.mutation.SetGridLatitude(v)
+	return _u
+}
+
+// SetNillableGridLatitude sets the "grid_latitude" field if the given value is not nil.
+func (_u *AddressUpdateOne) SetNillableGridLatitude(v *int) *AddressUpdateOne {
+	if v != nil {
+		_u.SetGridLatitude(*v)
+	}
+	return _u
+}
+
+// AddGridLatitude adds value to the "grid_latitude" field.
+func (_u *AddressUpdateOne) AddGridLatitude(v int) *AddressUpdateOne {
+	_u.mutation.AddGridLatitude(v)
+	return _u
+}
+
+// SetGridLongitude sets the "grid_longitude" field.
+func (_u *AddressUpdateOne) SetGridLongitude(v int) *AddressUpdateOne {
+	_u.mutation.ResetGridLongitude()
+	_u.mutation.SetGridLongitude(v)
+	return _u
+}
+
+// SetNillableGridLongitude sets the "grid_longitude" field if the given value is not nil.
+func (_u *AddressUpdateOne) SetNillableGridLongitude(v *int) *AddressUpdateOne {
+	if v != nil {
+		_u.SetGridLongitude(*v)
+	}
+	return _u
+}
+
+// AddGridLongitude adds value to the "grid_longitude" field.
+func (_u *AddressUpdateOne) AddGridLongitude(v int) *AddressUpdateOne {
+	_u.mutation.AddGridLongitude(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AddressUpdateOne) SetName(v string) *AddressUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AddressUpdateOne) SetNillableName(v *string) *AddressUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *AddressUpdateOne) ClearName() *AddressUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetRoad sets the "road" field.
+func (_u *AddressUpdateOne) SetRoad(v string) *AddressUpdateOne {
+	_u.mutation.SetRoad(v)
+	return _u
+}
+
+// SetNillableRoad sets the "road" field if the given value is not nil.
+func (_u *AddressUpdateOne) SetNillableRoad(v *string) *AddressUpdateOne {
+	if v != nil {
+		_u.SetRoad(*v)
+	}
+	return _u
+}
+
+// ClearRoad clears the value of the "road" field.
+func (_u *AddressUpdateOne) ClearRoad() *AddressUpdateOne {
+	_u.mutation.ClearRoad()
+	return _u
+}
+
+// SetDistrict sets the "district" field.
+func (_u *AddressUpdateOne) SetDistrict(v string) *AddressUpdateOne {
+	_u.mutation.SetDistrict(v)
+	return _u
+}
+
+// SetNillableDistrict sets the "district" field if the given value is not nil.
+func (_u *AddressUpdateOne) SetNillableDistrict(v *string) *AddressUpdateOne {
+	if v != nil {
+		_u.SetDistrict(*v)
+	}
+	return _u
+}
+
+// ClearDistrict clears the value of the "district" field.
+func (_u *AddressUpdateOne) ClearDistrict() *AddressUpdateOne {
+	_u.mutation.ClearDistrict()
+	return _u
+}
+
+// SetCity sets the "city" field.
+func (_u *AddressUpdateOne) SetCity(v string) *AddressUpdateOne {
+	_u.mutation.SetCity(v)
+	return _u
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (_u *AddressUpdateOne) SetNillableCity(v *string) *AddressUpdateOne {
+	if v != nil {
+		_u.SetCity(*v)
+	}
+	return _u
+}
+
+// ClearCity clears the value of the "city" field.
+func (_u *AddressUpdateOne) ClearCity() *AddressUpdateOne {
+	_u.mutation.ClearCity()
+	return _u
+}
+
+// SetProvince sets the "province" field.
+func (_u *AddressUpdateOne) SetProvince(v string) *AddressUpdateOne {
+	_u.mutation.SetProvince(v)
+	return _u
+}
+
+// SetNillableProvince sets the "province" field if the given value is not nil.
+func (_u *AddressUpdateOne) SetNillableProvince(v *string) *AddressUpdateOne {
+	if v != nil {
+		_u.SetProvince(*v)
+	}
+	return _u
+}
+
+// ClearProvince clears the value of the "province" field.
+func (_u *AddressUpdateOne) ClearProvince() *AddressUpdateOne {
+	_u.mutation.ClearProvince()
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *AddressUpdateOne) SetCountry(v string) *AddressUpdateOne {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *AddressUpdateOne) SetNillableCountry(v *string) *AddressUpdateOne {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// ClearCountry clears the value of the "country" field.
+func (_u *AddressUpdateOne) ClearCountry() *AddressUpdateOne {
+	_u.mutation.ClearCountry()
+	return _u
+}
+
+// SetFormatted sets the "formatted" field.
+func (_u *AddressUpdateOne) SetFormatted(v string) *AddressUpdateOne {
+	_u.mutation.SetFormatted(v)
+	return _u
+}
+
+// SetNillableFormatted sets the "formatted" field if the given value is not nil.
+func (_u *AddressUpdateOne) SetNillableFormatted(v *string) *AddressUpdateOne {
+	if v != nil {
+		_u.SetFormatted(*v)
+	}
+	return _u
+}
+
+// ClearFormatted clears the value of the "formatted" field.
+func (_u *AddressUpdateOne) ClearFormatted() *AddressUpdateOne {
+	_u.mutation.ClearFormatted()
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *AddressUpdateOne) SetProvider(v string) *AddressUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *AddressUpdateOne) SetNillableProvider(v *string) *AddressUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (_u *AddressUpdateOne) ClearProvider() *AddressUpdateOne {
+	_u.mutation.ClearProvider()
+	return _u
+}
+
+// Mutation returns the AddressMutation object of the builder.
+func (_u *AddressUpdateOne) Mutation() *AddressMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the AddressUpdate builder.
+func (_u *AddressUpdateOne) Where(ps ...predicate.Address) *AddressUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AddressUpdateOne) Select(field string, fields ...string) *AddressUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Address entity.
+func (_u *AddressUpdateOne) Save(ctx context.Context) (*Address, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AddressUpdateOne) SaveX(ctx context.Context) *Address {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AddressUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AddressUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *AddressUpdateOne) sqlSave(ctx context.Context) (_node *Address, err error) {
+	_spec := sqlgraph.NewUpdateSpec(address.Table, address.Columns, sqlgraph.NewFieldSpec(address.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Address.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, address.FieldID)
+		for _, f := range fields {
+			if !address.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != address.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GridLatitude(); ok {
+		_spec.SetField(address.FieldGridLatitude, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGridLatitude(); ok {
+		_spec.AddField(address.FieldGridLatitude, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GridLongitude(); ok {
+		_spec.SetField(address.FieldGridLongitude, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGridLongitude(); ok {
+		_spec.AddField(address.FieldGridLongitude, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(address.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(address.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Road(); ok {
+		_spec.SetField(address.FieldRoad, field.TypeString, value)
+	}
+	if _u.mutation.RoadCleared() {
+		_spec.ClearField(address.FieldRoad, field.TypeString)
+	}
+	if value, ok := _u.mutation.District(); ok {
+		_spec.SetField(address.FieldDistrict, field.TypeString, value)
+	}
+	if _u.mutation.DistrictCleared() {
+		_spec.ClearField(address.FieldDistrict, field.TypeString)
+	}
+	if value, ok := _u.mutation.City(); ok {
+		_spec.SetField(address.FieldCity, field.TypeString, value)
+	}
+	if _u.mutation.CityCleared() {
+		_spec.ClearField(address.FieldCity, field.TypeString)
+	}
+	if value, ok := _u.mutation.Province(); ok {
+		_spec.SetField(address.FieldProvince, field.TypeString, value)
+	}
+	if _u.mutation.ProvinceCleared() {
+		_spec.ClearField(address.FieldProvince, field.TypeString)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(address.FieldCountry, field.TypeString, value)
+	}
+	if _u.mutation.CountryCleared() {
+		_spec.ClearField(address.FieldCountry, field.TypeString)
+	}
+	if value, ok := _u.mutation.Formatted(); ok {
+		_spec.SetField(address.FieldFormatted, field.TypeString, value)
+	}
+	if _u.mutation.FormattedCleared() {
+		_spec.ClearField(address.FieldFormatted, field.TypeString)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(address.FieldProvider, field.TypeString, value)
+	}
+	if _u.mutation.ProviderCleared() {
+		_spec.ClearField(address.FieldProvider, field.TypeString)
+	}
+	_node = &Address{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{address.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
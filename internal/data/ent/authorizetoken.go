@@ -29,6 +29,8 @@ type AuthorizeToken struct {
 	RefreshToken string `json:"refresh_token,omitempty"`
 	// Scope holds the value of the "scope" field.
 	Scope string `json:"scope,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -45,7 +47,7 @@ func (*AuthorizeToken) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case authorizetoken.FieldDeleted:
 			values[i] = new(sql.NullBool)
-		case authorizetoken.FieldID:
+		case authorizetoken.FieldID, authorizetoken.FieldUserID:
 			values[i] = new(sql.NullInt64)
 		case authorizetoken.FieldTeslaCode, authorizetoken.FieldClientID, authorizetoken.FieldClientSecret, authorizetoken.FieldAccessToken, authorizetoken.FieldRefreshToken, authorizetoken.FieldScope:
 			values[i] = new(sql.NullString)
@@ -108,6 +110,12 @@ func (_m *AuthorizeToken) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Scope = value.String
 			}
+		case authorizetoken.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
 		case authorizetoken.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -180,6 +188,9 @@ func (_m *AuthorizeToken) String() string {
 	builder.WriteString("scope=")
 	builder.WriteString(_m.Scope)
 	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
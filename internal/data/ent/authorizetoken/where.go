@@ -84,6 +84,11 @@ func Scope(v string) predicate.AuthorizeToken {
 	return predicate.AuthorizeToken(sql.FieldEQ(FieldScope, v))
 }
 
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.AuthorizeToken {
+	return predicate.AuthorizeToken(sql.FieldEQ(FieldUserID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.AuthorizeToken {
 	return predicate.AuthorizeToken(sql.FieldEQ(FieldCreatedAt, v))
@@ -489,6 +494,56 @@ func ScopeContainsFold(v string) predicate.AuthorizeToken {
 	return predicate.AuthorizeToken(sql.FieldContainsFold(FieldScope, v))
 }
 
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.AuthorizeToken {
+	return predicate.AuthorizeToken(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.AuthorizeToken {
+	return predicate.AuthorizeToken(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.AuthorizeToken {
+	return predicate.AuthorizeToken(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.AuthorizeToken {
+	return predicate.AuthorizeToken(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.AuthorizeToken {
+	return predicate.AuthorizeToken(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.AuthorizeToken {
+	return predicate.AuthorizeToken(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.AuthorizeToken {
+	return predicate.AuthorizeToken(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.AuthorizeToken {
+	return predicate.AuthorizeToken(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.AuthorizeToken {
+	return predicate.AuthorizeToken(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.AuthorizeToken {
+	return predicate.AuthorizeToken(sql.FieldNotNull(FieldUserID))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.AuthorizeToken {
 	return predicate.AuthorizeToken(sql.FieldEQ(FieldCreatedAt, v))
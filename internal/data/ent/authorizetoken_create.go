@@ -56,6 +56,20 @@ func (_c *AuthorizeTokenCreate) SetScope(v string) *AuthorizeTokenCreate {
 	return _c
 }
 
+// SetUserID sets the "user_id" field.
+func (_c *AuthorizeTokenCreate) SetUserID(v int) *AuthorizeTokenCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *AuthorizeTokenCreate) SetNillableUserID(v *int) *AuthorizeTokenCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *AuthorizeTokenCreate) SetCreatedAt(v time.Time) *AuthorizeTokenCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -226,6 +240,10 @@ func (_c *AuthorizeTokenCreate) createSpec() (*AuthorizeToken, *sqlgraph.CreateS
 		_spec.SetField(authorizetoken.FieldScope, field.TypeString, value)
 		_node.Scope = value
 	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(authorizetoken.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(authorizetoken.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -112,6 +112,33 @@ func (_u *AuthorizeTokenUpdate) SetNillableScope(v *string) *AuthorizeTokenUpdat
 	return _u
 }
 
+// SetUserID sets the "user_id" field.
+func (_u *AuthorizeTokenUpdate) SetUserID(v int) *AuthorizeTokenUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AuthorizeTokenUpdate) SetNillableUserID(v *int) *AuthorizeTokenUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *AuthorizeTokenUpdate) AddUserID(v int) *AuthorizeTokenUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *AuthorizeTokenUpdate) ClearUserID() *AuthorizeTokenUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *AuthorizeTokenUpdate) SetCreatedAt(v time.Time) *AuthorizeTokenUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -213,6 +240,15 @@ func (_u *AuthorizeTokenUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.Scope(); ok {
 		_spec.SetField(authorizetoken.FieldScope, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(authorizetoken.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(authorizetoken.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(authorizetoken.FieldUserID, field.TypeInt)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(authorizetoken.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -326,6 +362,33 @@ func (_u *AuthorizeTokenUpdateOne) SetNillableScope(v *string) *AuthorizeTokenUp
 	return _u
 }
 
+// SetUserID sets the "user_id" field.
+func (_u *AuthorizeTokenUpdateOne) SetUserID(v int) *AuthorizeTokenUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *AuthorizeTokenUpdateOne) SetNillableUserID(v *int) *AuthorizeTokenUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *AuthorizeTokenUpdateOne) AddUserID(v int) *AuthorizeTokenUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *AuthorizeTokenUpdateOne) ClearUserID() *AuthorizeTokenUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *AuthorizeTokenUpdateOne) SetCreatedAt(v time.Time) *AuthorizeTokenUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -457,6 +520,15 @@ func (_u *AuthorizeTokenUpdateOne) sqlSave(ctx context.Context) (_node *Authoriz
 	if value, ok := _u.mutation.Scope(); ok {
 		_spec.SetField(authorizetoken.FieldScope, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(authorizetoken.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(authorizetoken.FieldUserID, field.TypeInt, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(authorizetoken.FieldUserID, field.TypeInt)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(authorizetoken.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -11,11 +11,21 @@ import (
 
 	"teslatrack/internal/data/ent/migrate"
 
+	"teslatrack/internal/data/ent/address"
 	"teslatrack/internal/data/ent/authorize"
 	"teslatrack/internal/data/ent/authorizetoken"
+	"teslatrack/internal/data/ent/geofence"
 	"teslatrack/internal/data/ent/partner"
+	"teslatrack/internal/data/ent/rollupstate"
+	"teslatrack/internal/data/ent/softwareupdate"
+	"teslatrack/internal/data/ent/tariff"
+	"teslatrack/internal/data/ent/tirepressure"
 	"teslatrack/internal/data/ent/user"
 	"teslatrack/internal/data/ent/vehicle"
+	"teslatrack/internal/data/ent/vehicleevent"
+	"teslatrack/internal/data/ent/vehiclerollup"
+	"teslatrack/internal/data/ent/vehiclesnapshot"
+	"teslatrack/internal/data/ent/vehiclestateperiod"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -27,16 +37,36 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Address is the client for interacting with the Address builders.
+	Address *AddressClient
 	// Authorize is the client for interacting with the Authorize builders.
 	Authorize *AuthorizeClient
 	// AuthorizeToken is the client for interacting with the AuthorizeToken builders.
 	AuthorizeToken *AuthorizeTokenClient
+	// Geofence is the client for interacting with the Geofence builders.
+	Geofence *GeofenceClient
 	// Partner is the client for interacting with the Partner builders.
 	Partner *PartnerClient
+	// RollupState is the client for interacting with the RollupState builders.
+	RollupState *RollupStateClient
+	// SoftwareUpdate is the client for interacting with the SoftwareUpdate builders.
+	SoftwareUpdate *SoftwareUpdateClient
+	// Tariff is the client for interacting with the Tariff builders.
+	Tariff *TariffClient
+	// TirePressure is the client for interacting with the TirePressure builders.
+	TirePressure *TirePressureClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// Vehicle is the client for interacting with the Vehicle builders.
 	Vehicle *VehicleClient
+	// VehicleEvent is the client for interacting with the VehicleEvent builders.
+	VehicleEvent *VehicleEventClient
+	// VehicleRollup is the client for interacting with the VehicleRollup builders.
+	VehicleRollup *VehicleRollupClient
+	// VehicleSnapshot is the client for interacting with the VehicleSnapshot builders.
+	VehicleSnapshot *VehicleSnapshotClient
+	// VehicleStatePeriod is the client for interacting with the VehicleStatePeriod builders.
+	VehicleStatePeriod *VehicleStatePeriodClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -48,11 +78,21 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Address = NewAddressClient(c.config)
 	c.Authorize = NewAuthorizeClient(c.config)
 	c.AuthorizeToken = NewAuthorizeTokenClient(c.config)
+	c.Geofence = NewGeofenceClient(c.config)
 	c.Partner = NewPartnerClient(c.config)
+	c.RollupState = NewRollupStateClient(c.config)
+	c.SoftwareUpdate = NewSoftwareUpdateClient(c.config)
+	c.Tariff = NewTariffClient(c.config)
+	c.TirePressure = NewTirePressureClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.Vehicle = NewVehicleClient(c.config)
+	c.VehicleEvent = NewVehicleEventClient(c.config)
+	c.VehicleRollup = NewVehicleRollupClient(c.config)
+	c.VehicleSnapshot = NewVehicleSnapshotClient(c.config)
+	c.VehicleStatePeriod = NewVehicleStatePeriodClient(c.config)
 }
 
 type (
@@ -143,13 +183,23 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		Authorize:      NewAuthorizeClient(cfg),
-		AuthorizeToken: NewAuthorizeTokenClient(cfg),
-		Partner:        NewPartnerClient(cfg),
-		User:           NewUserClient(cfg),
-		Vehicle:        NewVehicleClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Address:            NewAddressClient(cfg),
+		Authorize:          NewAuthorizeClient(cfg),
+		AuthorizeToken:     NewAuthorizeTokenClient(cfg),
+		Geofence:           NewGeofenceClient(cfg),
+		Partner:            NewPartnerClient(cfg),
+		RollupState:        NewRollupStateClient(cfg),
+		SoftwareUpdate:     NewSoftwareUpdateClient(cfg),
+		Tariff:             NewTariffClient(cfg),
+		TirePressure:       NewTirePressureClient(cfg),
+		User:               NewUserClient(cfg),
+		Vehicle:            NewVehicleClient(cfg),
+		VehicleEvent:       NewVehicleEventClient(cfg),
+		VehicleRollup:      NewVehicleRollupClient(cfg),
+		VehicleSnapshot:    NewVehicleSnapshotClient(cfg),
+		VehicleStatePeriod: NewVehicleStatePeriodClient(cfg),
 	}, nil
 }
 
@@ -167,20 +217,30 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		Authorize:      NewAuthorizeClient(cfg),
-		AuthorizeToken: NewAuthorizeTokenClient(cfg),
-		Partner:        NewPartnerClient(cfg),
-		User:           NewUserClient(cfg),
-		Vehicle:        NewVehicleClient(cfg),
+		ctx:                ctx,
+		config:             cfg,
+		Address:            NewAddressClient(cfg),
+		Authorize:          NewAuthorizeClient(cfg),
+		AuthorizeToken:     NewAuthorizeTokenClient(cfg),
+		Geofence:           NewGeofenceClient(cfg),
+		Partner:            NewPartnerClient(cfg),
+		RollupState:        NewRollupStateClient(cfg),
+		SoftwareUpdate:     NewSoftwareUpdateClient(cfg),
+		Tariff:             NewTariffClient(cfg),
+		TirePressure:       NewTirePressureClient(cfg),
+		User:               NewUserClient(cfg),
+		Vehicle:            NewVehicleClient(cfg),
+		VehicleEvent:       NewVehicleEventClient(cfg),
+		VehicleRollup:      NewVehicleRollupClient(cfg),
+		VehicleSnapshot:    NewVehicleSnapshotClient(cfg),
+		VehicleStatePeriod: NewVehicleStatePeriodClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Authorize.
+//		Address.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -202,41 +262,198 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Authorize.Use(hooks...)
-	c.AuthorizeToken.Use(hooks...)
-	c.Partner.Use(hooks...)
-	c.User.Use(hooks...)
-	c.Vehicle.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Address, c.Authorize, c.AuthorizeToken, c.Geofence, c.Partner, c.RollupState,
+		c.SoftwareUpdate, c.Tariff, c.TirePressure, c.User, c.Vehicle, c.VehicleEvent,
+		c.VehicleRollup, c.VehicleSnapshot, c.VehicleStatePeriod,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Authorize.Intercept(interceptors...)
-	c.AuthorizeToken.Intercept(interceptors...)
-	c.Partner.Intercept(interceptors...)
-	c.User.Intercept(interceptors...)
-	c.Vehicle.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Address, c.Authorize, c.AuthorizeToken, c.Geofence, c.Partner, c.RollupState,
+		c.SoftwareUpdate, c.Tariff, c.TirePressure, c.User, c.Vehicle, c.VehicleEvent,
+		c.VehicleRollup, c.VehicleSnapshot, c.VehicleStatePeriod,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AddressMutation:
+		return c.Address.mutate(ctx, m)
 	case *AuthorizeMutation:
 		return c.Authorize.mutate(ctx, m)
 	case *AuthorizeTokenMutation:
 		return c.AuthorizeToken.mutate(ctx, m)
+	case *GeofenceMutation:
+		return c.Geofence.mutate(ctx, m)
 	case *PartnerMutation:
 		return c.Partner.mutate(ctx, m)
+	case *RollupStateMutation:
+		return c.RollupState.mutate(ctx, m)
+	case *SoftwareUpdateMutation:
+		return c.SoftwareUpdate.mutate(ctx, m)
+	case *TariffMutation:
+		return c.Tariff.mutate(ctx, m)
+	case *TirePressureMutation:
+		return c.TirePressure.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *VehicleMutation:
 		return c.Vehicle.mutate(ctx, m)
+	case *VehicleEventMutation:
+		return c.VehicleEvent.mutate(ctx, m)
+	case *VehicleRollupMutation:
+		return c.VehicleRollup.mutate(ctx, m)
+	case *VehicleSnapshotMutation:
+		return c.VehicleSnapshot.mutate(ctx, m)
+	case *VehicleStatePeriodMutation:
+		return c.VehicleStatePeriod.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// AddressClient is a client for the Address schema.
+type AddressClient struct {
+	config
+}
+
+// NewAddressClient returns a client for the Address from the given config.
+func NewAddressClient(c config) *AddressClient {
+	return &AddressClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `address.Hooks(f(g(h())))`.
+func (c *AddressClient) Use(hooks ...Hook) {
+	c.hooks.Address = append(c.hooks.Address, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `address.Intercept(f(g(h())))`.
+func (c *AddressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Address = append(c.inters.Address, interceptors...)
+}
+
+// Create returns a builder for creating a Address entity.
+func (c *AddressClient) Create() *AddressCreate {
+	mutation := newAddressMutation(c.config, OpCreate)
+	return &AddressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Address entities.
+func (c *AddressClient) CreateBulk(builders ...*AddressCreate) *AddressCreateBulk {
+	return &AddressCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AddressClient) MapCreateBulk(slice any, setFunc func(*AddressCreate, int)) *AddressCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AddressCreateBulk{err: fmt.Errorf("calling to AddressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AddressCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AddressCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Address.
+func (c *AddressClient) Update() *AddressUpdate {
+	mutation := newAddressMutation(c.config, OpUpdate)
+	return &AddressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AddressClient) UpdateOne(_m *Address) *AddressUpdateOne {
+	mutation := newAddressMutation(c.config, OpUpdateOne, withAddress(_m))
+	return &AddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AddressClient) UpdateOneID(id int) *AddressUpdateOne {
+	mutation := newAddressMutation(c.config, OpUpdateOne, withAddressID(id))
+	return &AddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Address.
+func (c *AddressClient) Delete() *AddressDelete {
+	mutation := newAddressMutation(c.config, OpDelete)
+	return &AddressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AddressClient) DeleteOne(_m *Address) *AddressDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AddressClient) DeleteOneID(id int) *AddressDeleteOne {
+	builder := c.Delete().Where(address.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AddressDeleteOne{builder}
+}
+
+// Query returns a query builder for Address.
+func (c *AddressClient) Query() *AddressQuery {
+	return &AddressQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAddress},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Address entity by its id.
+func (c *AddressClient) Get(ctx context.Context, id int) (*Address, error) {
+	return c.Query().Where(address.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AddressClient) GetX(ctx context.Context, id int) *Address {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AddressClient) Hooks() []Hook {
+	return c.hooks.Address
+}
+
+// Interceptors returns the client interceptors.
+func (c *AddressClient) Interceptors() []Interceptor {
+	return c.inters.Address
+}
+
+func (c *AddressClient) mutate(ctx context.Context, m *AddressMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AddressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AddressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AddressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AddressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Address mutation op: %q", m.Op())
+	}
+}
+
 // AuthorizeClient is a client for the Authorize schema.
 type AuthorizeClient struct {
 	config
@@ -503,6 +720,139 @@ func (c *AuthorizeTokenClient) mutate(ctx context.Context, m *AuthorizeTokenMuta
 	}
 }
 
+// GeofenceClient is a client for the Geofence schema.
+type GeofenceClient struct {
+	config
+}
+
+// NewGeofenceClient returns a client for the Geofence from the given config.
+func NewGeofenceClient(c config) *GeofenceClient {
+	return &GeofenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `geofence.Hooks(f(g(h())))`.
+func (c *GeofenceClient) Use(hooks ...Hook) {
+	c.hooks.Geofence = append(c.hooks.Geofence, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `geofence.Intercept(f(g(h())))`.
+func (c *GeofenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Geofence = append(c.inters.Geofence, interceptors...)
+}
+
+// Create returns a builder for creating a Geofence entity.
+func (c *GeofenceClient) Create() *GeofenceCreate {
+	mutation := newGeofenceMutation(c.config, OpCreate)
+	return &GeofenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Geofence entities.
+func (c *GeofenceClient) CreateBulk(builders ...*GeofenceCreate) *GeofenceCreateBulk {
+	return &GeofenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GeofenceClient) MapCreateBulk(slice any, setFunc func(*GeofenceCreate, int)) *GeofenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GeofenceCreateBulk{err: fmt.Errorf("calling to GeofenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GeofenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GeofenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Geofence.
+func (c *GeofenceClient) Update() *GeofenceUpdate {
+	mutation := newGeofenceMutation(c.config, OpUpdate)
+	return &GeofenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GeofenceClient) UpdateOne(_m *Geofence) *GeofenceUpdateOne {
+	mutation := newGeofenceMutation(c.config, OpUpdateOne, withGeofence(_m))
+	return &GeofenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GeofenceClient) UpdateOneID(id int) *GeofenceUpdateOne {
+	mutation := newGeofenceMutation(c.config, OpUpdateOne, withGeofenceID(id))
+	return &GeofenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Geofence.
+func (c *GeofenceClient) Delete() *GeofenceDelete {
+	mutation := newGeofenceMutation(c.config, OpDelete)
+	return &GeofenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GeofenceClient) DeleteOne(_m *Geofence) *GeofenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GeofenceClient) DeleteOneID(id int) *GeofenceDeleteOne {
+	builder := c.Delete().Where(geofence.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GeofenceDeleteOne{builder}
+}
+
+// Query returns a query builder for Geofence.
+func (c *GeofenceClient) Query() *GeofenceQuery {
+	return &GeofenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGeofence},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Geofence entity by its id.
+func (c *GeofenceClient) Get(ctx context.Context, id int) (*Geofence, error) {
+	return c.Query().Where(geofence.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GeofenceClient) GetX(ctx context.Context, id int) *Geofence {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GeofenceClient) Hooks() []Hook {
+	return c.hooks.Geofence
+}
+
+// Interceptors returns the client interceptors.
+func (c *GeofenceClient) Interceptors() []Interceptor {
+	return c.inters.Geofence
+}
+
+func (c *GeofenceClient) mutate(ctx context.Context, m *GeofenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GeofenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GeofenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GeofenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GeofenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Geofence mutation op: %q", m.Op())
+	}
+}
+
 // PartnerClient is a client for the Partner schema.
 type PartnerClient struct {
 	config
@@ -636,107 +986,107 @@ func (c *PartnerClient) mutate(ctx context.Context, m *PartnerMutation) (Value,
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// RollupStateClient is a client for the RollupState schema.
+type RollupStateClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewRollupStateClient returns a client for the RollupState from the given config.
+func NewRollupStateClient(c config) *RollupStateClient {
+	return &RollupStateClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `rollupstate.Hooks(f(g(h())))`.
+func (c *RollupStateClient) Use(hooks ...Hook) {
+	c.hooks.RollupState = append(c.hooks.RollupState, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `rollupstate.Intercept(f(g(h())))`.
+func (c *RollupStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RollupState = append(c.inters.RollupState, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a RollupState entity.
+func (c *RollupStateClient) Create() *RollupStateCreate {
+	mutation := newRollupStateMutation(c.config, OpCreate)
+	return &RollupStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
-func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
-	return &UserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of RollupState entities.
+func (c *RollupStateClient) CreateBulk(builders ...*RollupStateCreate) *RollupStateCreateBulk {
+	return &RollupStateCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+func (c *RollupStateClient) MapCreateBulk(slice any, setFunc func(*RollupStateCreate, int)) *RollupStateCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RollupStateCreateBulk{err: fmt.Errorf("calling to RollupStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserCreate, rv.Len())
+	builders := make([]*RollupStateCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserCreateBulk{config: c.config, builders: builders}
+	return &RollupStateCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for User.
-func (c *UserClient) Update() *UserUpdate {
-	mutation := newUserMutation(c.config, OpUpdate)
-	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for RollupState.
+func (c *RollupStateClient) Update() *RollupStateUpdate {
+	mutation := newRollupStateMutation(c.config, OpUpdate)
+	return &RollupStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RollupStateClient) UpdateOne(_m *RollupState) *RollupStateUpdateOne {
+	mutation := newRollupStateMutation(c.config, OpUpdateOne, withRollupState(_m))
+	return &RollupStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RollupStateClient) UpdateOneID(id int) *RollupStateUpdateOne {
+	mutation := newRollupStateMutation(c.config, OpUpdateOne, withRollupStateID(id))
+	return &RollupStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for User.
-func (c *UserClient) Delete() *UserDelete {
-	mutation := newUserMutation(c.config, OpDelete)
-	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for RollupState.
+func (c *RollupStateClient) Delete() *RollupStateDelete {
+	mutation := newRollupStateMutation(c.config, OpDelete)
+	return &RollupStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+func (c *RollupStateClient) DeleteOne(_m *RollupState) *RollupStateDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
-	builder := c.Delete().Where(user.ID(id))
+func (c *RollupStateClient) DeleteOneID(id int) *RollupStateDeleteOne {
+	builder := c.Delete().Where(rollupstate.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserDeleteOne{builder}
+	return &RollupStateDeleteOne{builder}
 }
 
-// Query returns a query builder for User.
-func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{
+// Query returns a query builder for RollupState.
+func (c *RollupStateClient) Query() *RollupStateQuery {
+	return &RollupStateQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUser},
+		ctx:    &QueryContext{Type: TypeRollupState},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+// Get returns a RollupState entity by its id.
+func (c *RollupStateClient) Get(ctx context.Context, id int) (*RollupState, error) {
+	return c.Query().Where(rollupstate.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id int) *User {
+func (c *RollupStateClient) GetX(ctx context.Context, id int) *RollupState {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -745,131 +1095,796 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 }
 
 // Hooks returns the client hooks.
-func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+func (c *RollupStateClient) Hooks() []Hook {
+	return c.hooks.RollupState
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserClient) Interceptors() []Interceptor {
-	return c.inters.User
+func (c *RollupStateClient) Interceptors() []Interceptor {
+	return c.inters.RollupState
 }
 
-func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+func (c *RollupStateClient) mutate(ctx context.Context, m *RollupStateMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RollupStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RollupStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RollupStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RollupStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RollupState mutation op: %q", m.Op())
 	}
 }
 
-// VehicleClient is a client for the Vehicle schema.
-type VehicleClient struct {
+// SoftwareUpdateClient is a client for the SoftwareUpdate schema.
+type SoftwareUpdateClient struct {
 	config
 }
 
-// NewVehicleClient returns a client for the Vehicle from the given config.
-func NewVehicleClient(c config) *VehicleClient {
-	return &VehicleClient{config: c}
+// NewSoftwareUpdateClient returns a client for the SoftwareUpdate from the given config.
+func NewSoftwareUpdateClient(c config) *SoftwareUpdateClient {
+	return &SoftwareUpdateClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `vehicle.Hooks(f(g(h())))`.
-func (c *VehicleClient) Use(hooks ...Hook) {
-	c.hooks.Vehicle = append(c.hooks.Vehicle, hooks...)
+// A call to `Use(f, g, h)` equals to `softwareupdate.Hooks(f(g(h())))`.
+func (c *SoftwareUpdateClient) Use(hooks ...Hook) {
+	c.hooks.SoftwareUpdate = append(c.hooks.SoftwareUpdate, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `vehicle.Intercept(f(g(h())))`.
-func (c *VehicleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Vehicle = append(c.inters.Vehicle, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `softwareupdate.Intercept(f(g(h())))`.
+func (c *SoftwareUpdateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SoftwareUpdate = append(c.inters.SoftwareUpdate, interceptors...)
 }
 
-// Create returns a builder for creating a Vehicle entity.
-func (c *VehicleClient) Create() *VehicleCreate {
-	mutation := newVehicleMutation(c.config, OpCreate)
-	return &VehicleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SoftwareUpdate entity.
+func (c *SoftwareUpdateClient) Create() *SoftwareUpdateCreate {
+	mutation := newSoftwareUpdateMutation(c.config, OpCreate)
+	return &SoftwareUpdateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Vehicle entities.
-func (c *VehicleClient) CreateBulk(builders ...*VehicleCreate) *VehicleCreateBulk {
-	return &VehicleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SoftwareUpdate entities.
+func (c *SoftwareUpdateClient) CreateBulk(builders ...*SoftwareUpdateCreate) *SoftwareUpdateCreateBulk {
+	return &SoftwareUpdateCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *VehicleClient) MapCreateBulk(slice any, setFunc func(*VehicleCreate, int)) *VehicleCreateBulk {
+func (c *SoftwareUpdateClient) MapCreateBulk(slice any, setFunc func(*SoftwareUpdateCreate, int)) *SoftwareUpdateCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &VehicleCreateBulk{err: fmt.Errorf("calling to VehicleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SoftwareUpdateCreateBulk{err: fmt.Errorf("calling to SoftwareUpdateClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*VehicleCreate, rv.Len())
+	builders := make([]*SoftwareUpdateCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &VehicleCreateBulk{config: c.config, builders: builders}
+	return &SoftwareUpdateCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Vehicle.
-func (c *VehicleClient) Update() *VehicleUpdate {
-	mutation := newVehicleMutation(c.config, OpUpdate)
-	return &VehicleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SoftwareUpdate.
+func (c *SoftwareUpdateClient) Update() *SoftwareUpdateUpdate {
+	mutation := newSoftwareUpdateMutation(c.config, OpUpdate)
+	return &SoftwareUpdateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *VehicleClient) UpdateOne(_m *Vehicle) *VehicleUpdateOne {
-	mutation := newVehicleMutation(c.config, OpUpdateOne, withVehicle(_m))
-	return &VehicleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SoftwareUpdateClient) UpdateOne(_m *SoftwareUpdate) *SoftwareUpdateUpdateOne {
+	mutation := newSoftwareUpdateMutation(c.config, OpUpdateOne, withSoftwareUpdate(_m))
+	return &SoftwareUpdateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *VehicleClient) UpdateOneID(id int) *VehicleUpdateOne {
-	mutation := newVehicleMutation(c.config, OpUpdateOne, withVehicleID(id))
-	return &VehicleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SoftwareUpdateClient) UpdateOneID(id int) *SoftwareUpdateUpdateOne {
+	mutation := newSoftwareUpdateMutation(c.config, OpUpdateOne, withSoftwareUpdateID(id))
+	return &SoftwareUpdateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Vehicle.
-func (c *VehicleClient) Delete() *VehicleDelete {
-	mutation := newVehicleMutation(c.config, OpDelete)
-	return &VehicleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SoftwareUpdate.
+func (c *SoftwareUpdateClient) Delete() *SoftwareUpdateDelete {
+	mutation := newSoftwareUpdateMutation(c.config, OpDelete)
+	return &SoftwareUpdateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *VehicleClient) DeleteOne(_m *Vehicle) *VehicleDeleteOne {
+func (c *SoftwareUpdateClient) DeleteOne(_m *SoftwareUpdate) *SoftwareUpdateDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *VehicleClient) DeleteOneID(id int) *VehicleDeleteOne {
-	builder := c.Delete().Where(vehicle.ID(id))
+func (c *SoftwareUpdateClient) DeleteOneID(id int) *SoftwareUpdateDeleteOne {
+	builder := c.Delete().Where(softwareupdate.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &VehicleDeleteOne{builder}
+	return &SoftwareUpdateDeleteOne{builder}
 }
 
-// Query returns a query builder for Vehicle.
-func (c *VehicleClient) Query() *VehicleQuery {
-	return &VehicleQuery{
+// Query returns a query builder for SoftwareUpdate.
+func (c *SoftwareUpdateClient) Query() *SoftwareUpdateQuery {
+	return &SoftwareUpdateQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeVehicle},
+		ctx:    &QueryContext{Type: TypeSoftwareUpdate},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Vehicle entity by its id.
-func (c *VehicleClient) Get(ctx context.Context, id int) (*Vehicle, error) {
+// Get returns a SoftwareUpdate entity by its id.
+func (c *SoftwareUpdateClient) Get(ctx context.Context, id int) (*SoftwareUpdate, error) {
+	return c.Query().Where(softwareupdate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SoftwareUpdateClient) GetX(ctx context.Context, id int) *SoftwareUpdate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SoftwareUpdateClient) Hooks() []Hook {
+	return c.hooks.SoftwareUpdate
+}
+
+// Interceptors returns the client interceptors.
+func (c *SoftwareUpdateClient) Interceptors() []Interceptor {
+	return c.inters.SoftwareUpdate
+}
+
+func (c *SoftwareUpdateClient) mutate(ctx context.Context, m *SoftwareUpdateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SoftwareUpdateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SoftwareUpdateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SoftwareUpdateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SoftwareUpdateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SoftwareUpdate mutation op: %q", m.Op())
+	}
+}
+
+// TariffClient is a client for the Tariff schema.
+type TariffClient struct {
+	config
+}
+
+// NewTariffClient returns a client for the Tariff from the given config.
+func NewTariffClient(c config) *TariffClient {
+	return &TariffClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tariff.Hooks(f(g(h())))`.
+func (c *TariffClient) Use(hooks ...Hook) {
+	c.hooks.Tariff = append(c.hooks.Tariff, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tariff.Intercept(f(g(h())))`.
+func (c *TariffClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tariff = append(c.inters.Tariff, interceptors...)
+}
+
+// Create returns a builder for creating a Tariff entity.
+func (c *TariffClient) Create() *TariffCreate {
+	mutation := newTariffMutation(c.config, OpCreate)
+	return &TariffCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Tariff entities.
+func (c *TariffClient) CreateBulk(builders ...*TariffCreate) *TariffCreateBulk {
+	return &TariffCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TariffClient) MapCreateBulk(slice any, setFunc func(*TariffCreate, int)) *TariffCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TariffCreateBulk{err: fmt.Errorf("calling to TariffClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TariffCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TariffCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Tariff.
+func (c *TariffClient) Update() *TariffUpdate {
+	mutation := newTariffMutation(c.config, OpUpdate)
+	return &TariffUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TariffClient) UpdateOne(_m *Tariff) *TariffUpdateOne {
+	mutation := newTariffMutation(c.config, OpUpdateOne, withTariff(_m))
+	return &TariffUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TariffClient) UpdateOneID(id int) *TariffUpdateOne {
+	mutation := newTariffMutation(c.config, OpUpdateOne, withTariffID(id))
+	return &TariffUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Tariff.
+func (c *TariffClient) Delete() *TariffDelete {
+	mutation := newTariffMutation(c.config, OpDelete)
+	return &TariffDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TariffClient) DeleteOne(_m *Tariff) *TariffDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TariffClient) DeleteOneID(id int) *TariffDeleteOne {
+	builder := c.Delete().Where(tariff.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TariffDeleteOne{builder}
+}
+
+// Query returns a query builder for Tariff.
+func (c *TariffClient) Query() *TariffQuery {
+	return &TariffQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTariff},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Tariff entity by its id.
+func (c *TariffClient) Get(ctx context.Context, id int) (*Tariff, error) {
+	return c.Query().Where(tariff.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TariffClient) GetX(ctx context.Context, id int) *Tariff {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TariffClient) Hooks() []Hook {
+	return c.hooks.Tariff
+}
+
+// Interceptors returns the client interceptors.
+func (c *TariffClient) Interceptors() []Interceptor {
+	return c.inters.Tariff
+}
+
+func (c *TariffClient) mutate(ctx context.Context, m *TariffMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TariffCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TariffUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TariffUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TariffDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Tariff mutation op: %q", m.Op())
+	}
+}
+
+// TirePressureClient is a client for the TirePressure schema.
+type TirePressureClient struct {
+	config
+}
+
+// NewTirePressureClient returns a client for the TirePressure from the given config.
+func NewTirePressureClient(c config) *TirePressureClient {
+	return &TirePressureClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tirepressure.Hooks(f(g(h())))`.
+func (c *TirePressureClient) Use(hooks ...Hook) {
+	c.hooks.TirePressure = append(c.hooks.TirePressure, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tirepressure.Intercept(f(g(h())))`.
+func (c *TirePressureClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TirePressure = append(c.inters.TirePressure, interceptors...)
+}
+
+// Create returns a builder for creating a TirePressure entity.
+func (c *TirePressureClient) Create() *TirePressureCreate {
+	mutation := newTirePressureMutation(c.config, OpCreate)
+	return &TirePressureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TirePressure entities.
+func (c *TirePressureClient) CreateBulk(builders ...*TirePressureCreate) *TirePressureCreateBulk {
+	return &TirePressureCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TirePressureClient) MapCreateBulk(slice any, setFunc func(*TirePressureCreate, int)) *TirePressureCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TirePressureCreateBulk{err: fmt.Errorf("calling to TirePressureClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TirePressureCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TirePressureCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TirePressure.
+func (c *TirePressureClient) Update() *TirePressureUpdate {
+	mutation := newTirePressureMutation(c.config, OpUpdate)
+	return &TirePressureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TirePressureClient) UpdateOne(_m *TirePressure) *TirePressureUpdateOne {
+	mutation := newTirePressureMutation(c.config, OpUpdateOne, withTirePressure(_m))
+	return &TirePressureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TirePressureClient) UpdateOneID(id int) *TirePressureUpdateOne {
+	mutation := newTirePressureMutation(c.config, OpUpdateOne, withTirePressureID(id))
+	return &TirePressureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TirePressure.
+func (c *TirePressureClient) Delete() *TirePressureDelete {
+	mutation := newTirePressureMutation(c.config, OpDelete)
+	return &TirePressureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TirePressureClient) DeleteOne(_m *TirePressure) *TirePressureDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TirePressureClient) DeleteOneID(id int) *TirePressureDeleteOne {
+	builder := c.Delete().Where(tirepressure.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TirePressureDeleteOne{builder}
+}
+
+// Query returns a query builder for TirePressure.
+func (c *TirePressureClient) Query() *TirePressureQuery {
+	return &TirePressureQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTirePressure},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TirePressure entity by its id.
+func (c *TirePressureClient) Get(ctx context.Context, id int) (*TirePressure, error) {
+	return c.Query().Where(tirepressure.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TirePressureClient) GetX(ctx context.Context, id int) *TirePressure {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TirePressureClient) Hooks() []Hook {
+	return c.hooks.TirePressure
+}
+
+// Interceptors returns the client interceptors.
+func (c *TirePressureClient) Interceptors() []Interceptor {
+	return c.inters.TirePressure
+}
+
+func (c *TirePressureClient) mutate(ctx context.Context, m *TirePressureMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TirePressureCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TirePressureUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TirePressureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TirePressureDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TirePressure mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id int) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+	}
+}
+
+// VehicleClient is a client for the Vehicle schema.
+type VehicleClient struct {
+	config
+}
+
+// NewVehicleClient returns a client for the Vehicle from the given config.
+func NewVehicleClient(c config) *VehicleClient {
+	return &VehicleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vehicle.Hooks(f(g(h())))`.
+func (c *VehicleClient) Use(hooks ...Hook) {
+	c.hooks.Vehicle = append(c.hooks.Vehicle, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vehicle.Intercept(f(g(h())))`.
+func (c *VehicleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Vehicle = append(c.inters.Vehicle, interceptors...)
+}
+
+// Create returns a builder for creating a Vehicle entity.
+func (c *VehicleClient) Create() *VehicleCreate {
+	mutation := newVehicleMutation(c.config, OpCreate)
+	return &VehicleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Vehicle entities.
+func (c *VehicleClient) CreateBulk(builders ...*VehicleCreate) *VehicleCreateBulk {
+	return &VehicleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VehicleClient) MapCreateBulk(slice any, setFunc func(*VehicleCreate, int)) *VehicleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VehicleCreateBulk{err: fmt.Errorf("calling to VehicleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VehicleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VehicleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Vehicle.
+func (c *VehicleClient) Update() *VehicleUpdate {
+	mutation := newVehicleMutation(c.config, OpUpdate)
+	return &VehicleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VehicleClient) UpdateOne(_m *Vehicle) *VehicleUpdateOne {
+	mutation := newVehicleMutation(c.config, OpUpdateOne, withVehicle(_m))
+	return &VehicleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VehicleClient) UpdateOneID(id int) *VehicleUpdateOne {
+	mutation := newVehicleMutation(c.config, OpUpdateOne, withVehicleID(id))
+	return &VehicleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Vehicle.
+func (c *VehicleClient) Delete() *VehicleDelete {
+	mutation := newVehicleMutation(c.config, OpDelete)
+	return &VehicleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VehicleClient) DeleteOne(_m *Vehicle) *VehicleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VehicleClient) DeleteOneID(id int) *VehicleDeleteOne {
+	builder := c.Delete().Where(vehicle.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VehicleDeleteOne{builder}
+}
+
+// Query returns a query builder for Vehicle.
+func (c *VehicleClient) Query() *VehicleQuery {
+	return &VehicleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVehicle},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Vehicle entity by its id.
+func (c *VehicleClient) Get(ctx context.Context, id int) (*Vehicle, error) {
 	return c.Query().Where(vehicle.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *VehicleClient) GetX(ctx context.Context, id int) *Vehicle {
+func (c *VehicleClient) GetX(ctx context.Context, id int) *Vehicle {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *VehicleClient) Hooks() []Hook {
+	return c.hooks.Vehicle
+}
+
+// Interceptors returns the client interceptors.
+func (c *VehicleClient) Interceptors() []Interceptor {
+	return c.inters.Vehicle
+}
+
+func (c *VehicleClient) mutate(ctx context.Context, m *VehicleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VehicleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VehicleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VehicleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VehicleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Vehicle mutation op: %q", m.Op())
+	}
+}
+
+// VehicleEventClient is a client for the VehicleEvent schema.
+type VehicleEventClient struct {
+	config
+}
+
+// NewVehicleEventClient returns a client for the VehicleEvent from the given config.
+func NewVehicleEventClient(c config) *VehicleEventClient {
+	return &VehicleEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vehicleevent.Hooks(f(g(h())))`.
+func (c *VehicleEventClient) Use(hooks ...Hook) {
+	c.hooks.VehicleEvent = append(c.hooks.VehicleEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vehicleevent.Intercept(f(g(h())))`.
+func (c *VehicleEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VehicleEvent = append(c.inters.VehicleEvent, interceptors...)
+}
+
+// Create returns a builder for creating a VehicleEvent entity.
+func (c *VehicleEventClient) Create() *VehicleEventCreate {
+	mutation := newVehicleEventMutation(c.config, OpCreate)
+	return &VehicleEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VehicleEvent entities.
+func (c *VehicleEventClient) CreateBulk(builders ...*VehicleEventCreate) *VehicleEventCreateBulk {
+	return &VehicleEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VehicleEventClient) MapCreateBulk(slice any, setFunc func(*VehicleEventCreate, int)) *VehicleEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VehicleEventCreateBulk{err: fmt.Errorf("calling to VehicleEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VehicleEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VehicleEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VehicleEvent.
+func (c *VehicleEventClient) Update() *VehicleEventUpdate {
+	mutation := newVehicleEventMutation(c.config, OpUpdate)
+	return &VehicleEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VehicleEventClient) UpdateOne(_m *VehicleEvent) *VehicleEventUpdateOne {
+	mutation := newVehicleEventMutation(c.config, OpUpdateOne, withVehicleEvent(_m))
+	return &VehicleEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VehicleEventClient) UpdateOneID(id int) *VehicleEventUpdateOne {
+	mutation := newVehicleEventMutation(c.config, OpUpdateOne, withVehicleEventID(id))
+	return &VehicleEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VehicleEvent.
+func (c *VehicleEventClient) Delete() *VehicleEventDelete {
+	mutation := newVehicleEventMutation(c.config, OpDelete)
+	return &VehicleEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VehicleEventClient) DeleteOne(_m *VehicleEvent) *VehicleEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VehicleEventClient) DeleteOneID(id int) *VehicleEventDeleteOne {
+	builder := c.Delete().Where(vehicleevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VehicleEventDeleteOne{builder}
+}
+
+// Query returns a query builder for VehicleEvent.
+func (c *VehicleEventClient) Query() *VehicleEventQuery {
+	return &VehicleEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVehicleEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VehicleEvent entity by its id.
+func (c *VehicleEventClient) Get(ctx context.Context, id int) (*VehicleEvent, error) {
+	return c.Query().Where(vehicleevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VehicleEventClient) GetX(ctx context.Context, id int) *VehicleEvent {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -878,36 +1893,439 @@ func (c *VehicleClient) GetX(ctx context.Context, id int) *Vehicle {
 }
 
 // Hooks returns the client hooks.
-func (c *VehicleClient) Hooks() []Hook {
-	return c.hooks.Vehicle
+func (c *VehicleEventClient) Hooks() []Hook {
+	return c.hooks.VehicleEvent
 }
 
 // Interceptors returns the client interceptors.
-func (c *VehicleClient) Interceptors() []Interceptor {
-	return c.inters.Vehicle
+func (c *VehicleEventClient) Interceptors() []Interceptor {
+	return c.inters.VehicleEvent
 }
 
-func (c *VehicleClient) mutate(ctx context.Context, m *VehicleMutation) (Value, error) {
+func (c *VehicleEventClient) mutate(ctx context.Context, m *VehicleEventMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&VehicleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VehicleEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&VehicleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VehicleEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&VehicleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VehicleEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&VehicleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&VehicleEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Vehicle mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown VehicleEvent mutation op: %q", m.Op())
+	}
+}
+
+// VehicleRollupClient is a client for the VehicleRollup schema.
+type VehicleRollupClient struct {
+	config
+}
+
+// NewVehicleRollupClient returns a client for the VehicleRollup from the given config.
+func NewVehicleRollupClient(c config) *VehicleRollupClient {
+	return &VehicleRollupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vehiclerollup.Hooks(f(g(h())))`.
+func (c *VehicleRollupClient) Use(hooks ...Hook) {
+	c.hooks.VehicleRollup = append(c.hooks.VehicleRollup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vehiclerollup.Intercept(f(g(h())))`.
+func (c *VehicleRollupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VehicleRollup = append(c.inters.VehicleRollup, interceptors...)
+}
+
+// Create returns a builder for creating a VehicleRollup entity.
+func (c *VehicleRollupClient) Create() *VehicleRollupCreate {
+	mutation := newVehicleRollupMutation(c.config, OpCreate)
+	return &VehicleRollupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VehicleRollup entities.
+func (c *VehicleRollupClient) CreateBulk(builders ...*VehicleRollupCreate) *VehicleRollupCreateBulk {
+	return &VehicleRollupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VehicleRollupClient) MapCreateBulk(slice any, setFunc func(*VehicleRollupCreate, int)) *VehicleRollupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VehicleRollupCreateBulk{err: fmt.Errorf("calling to VehicleRollupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VehicleRollupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VehicleRollupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VehicleRollup.
+func (c *VehicleRollupClient) Update() *VehicleRollupUpdate {
+	mutation := newVehicleRollupMutation(c.config, OpUpdate)
+	return &VehicleRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VehicleRollupClient) UpdateOne(_m *VehicleRollup) *VehicleRollupUpdateOne {
+	mutation := newVehicleRollupMutation(c.config, OpUpdateOne, withVehicleRollup(_m))
+	return &VehicleRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VehicleRollupClient) UpdateOneID(id int) *VehicleRollupUpdateOne {
+	mutation := newVehicleRollupMutation(c.config, OpUpdateOne, withVehicleRollupID(id))
+	return &VehicleRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VehicleRollup.
+func (c *VehicleRollupClient) Delete() *VehicleRollupDelete {
+	mutation := newVehicleRollupMutation(c.config, OpDelete)
+	return &VehicleRollupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VehicleRollupClient) DeleteOne(_m *VehicleRollup) *VehicleRollupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VehicleRollupClient) DeleteOneID(id int) *VehicleRollupDeleteOne {
+	builder := c.Delete().Where(vehiclerollup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VehicleRollupDeleteOne{builder}
+}
+
+// Query returns a query builder for VehicleRollup.
+func (c *VehicleRollupClient) Query() *VehicleRollupQuery {
+	return &VehicleRollupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVehicleRollup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VehicleRollup entity by its id.
+func (c *VehicleRollupClient) Get(ctx context.Context, id int) (*VehicleRollup, error) {
+	return c.Query().Where(vehiclerollup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VehicleRollupClient) GetX(ctx context.Context, id int) *VehicleRollup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *VehicleRollupClient) Hooks() []Hook {
+	return c.hooks.VehicleRollup
+}
+
+// Interceptors returns the client interceptors.
+func (c *VehicleRollupClient) Interceptors() []Interceptor {
+	return c.inters.VehicleRollup
+}
+
+func (c *VehicleRollupClient) mutate(ctx context.Context, m *VehicleRollupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VehicleRollupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VehicleRollupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VehicleRollupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VehicleRollupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VehicleRollup mutation op: %q", m.Op())
+	}
+}
+
+// VehicleSnapshotClient is a client for the VehicleSnapshot schema.
+type VehicleSnapshotClient struct {
+	config
+}
+
+// NewVehicleSnapshotClient returns a client for the VehicleSnapshot from the given config.
+func NewVehicleSnapshotClient(c config) *VehicleSnapshotClient {
+	return &VehicleSnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vehiclesnapshot.Hooks(f(g(h())))`.
+func (c *VehicleSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.VehicleSnapshot = append(c.hooks.VehicleSnapshot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vehiclesnapshot.Intercept(f(g(h())))`.
+func (c *VehicleSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VehicleSnapshot = append(c.inters.VehicleSnapshot, interceptors...)
+}
+
+// Create returns a builder for creating a VehicleSnapshot entity.
+func (c *VehicleSnapshotClient) Create() *VehicleSnapshotCreate {
+	mutation := newVehicleSnapshotMutation(c.config, OpCreate)
+	return &VehicleSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VehicleSnapshot entities.
+func (c *VehicleSnapshotClient) CreateBulk(builders ...*VehicleSnapshotCreate) *VehicleSnapshotCreateBulk {
+	return &VehicleSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VehicleSnapshotClient) MapCreateBulk(slice any, setFunc func(*VehicleSnapshotCreate, int)) *VehicleSnapshotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VehicleSnapshotCreateBulk{err: fmt.Errorf("calling to VehicleSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VehicleSnapshotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VehicleSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VehicleSnapshot.
+func (c *VehicleSnapshotClient) Update() *VehicleSnapshotUpdate {
+	mutation := newVehicleSnapshotMutation(c.config, OpUpdate)
+	return &VehicleSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VehicleSnapshotClient) UpdateOne(_m *VehicleSnapshot) *VehicleSnapshotUpdateOne {
+	mutation := newVehicleSnapshotMutation(c.config, OpUpdateOne, withVehicleSnapshot(_m))
+	return &VehicleSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VehicleSnapshotClient) UpdateOneID(id int) *VehicleSnapshotUpdateOne {
+	mutation := newVehicleSnapshotMutation(c.config, OpUpdateOne, withVehicleSnapshotID(id))
+	return &VehicleSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VehicleSnapshot.
+func (c *VehicleSnapshotClient) Delete() *VehicleSnapshotDelete {
+	mutation := newVehicleSnapshotMutation(c.config, OpDelete)
+	return &VehicleSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VehicleSnapshotClient) DeleteOne(_m *VehicleSnapshot) *VehicleSnapshotDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VehicleSnapshotClient) DeleteOneID(id int) *VehicleSnapshotDeleteOne {
+	builder := c.Delete().Where(vehiclesnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VehicleSnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for VehicleSnapshot.
+func (c *VehicleSnapshotClient) Query() *VehicleSnapshotQuery {
+	return &VehicleSnapshotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVehicleSnapshot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VehicleSnapshot entity by its id.
+func (c *VehicleSnapshotClient) Get(ctx context.Context, id int) (*VehicleSnapshot, error) {
+	return c.Query().Where(vehiclesnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VehicleSnapshotClient) GetX(ctx context.Context, id int) *VehicleSnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *VehicleSnapshotClient) Hooks() []Hook {
+	return c.hooks.VehicleSnapshot
+}
+
+// Interceptors returns the client interceptors.
+func (c *VehicleSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.VehicleSnapshot
+}
+
+func (c *VehicleSnapshotClient) mutate(ctx context.Context, m *VehicleSnapshotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VehicleSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VehicleSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VehicleSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VehicleSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VehicleSnapshot mutation op: %q", m.Op())
+	}
+}
+
+// VehicleStatePeriodClient is a client for the VehicleStatePeriod schema.
+type VehicleStatePeriodClient struct {
+	config
+}
+
+// NewVehicleStatePeriodClient returns a client for the VehicleStatePeriod from the given config.
+func NewVehicleStatePeriodClient(c config) *VehicleStatePeriodClient {
+	return &VehicleStatePeriodClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vehiclestateperiod.Hooks(f(g(h())))`.
+func (c *VehicleStatePeriodClient) Use(hooks ...Hook) {
+	c.hooks.VehicleStatePeriod = append(c.hooks.VehicleStatePeriod, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vehiclestateperiod.Intercept(f(g(h())))`.
+func (c *VehicleStatePeriodClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VehicleStatePeriod = append(c.inters.VehicleStatePeriod, interceptors...)
+}
+
+// Create returns a builder for creating a VehicleStatePeriod entity.
+func (c *VehicleStatePeriodClient) Create() *VehicleStatePeriodCreate {
+	mutation := newVehicleStatePeriodMutation(c.config, OpCreate)
+	return &VehicleStatePeriodCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VehicleStatePeriod entities.
+func (c *VehicleStatePeriodClient) CreateBulk(builders ...*VehicleStatePeriodCreate) *VehicleStatePeriodCreateBulk {
+	return &VehicleStatePeriodCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VehicleStatePeriodClient) MapCreateBulk(slice any, setFunc func(*VehicleStatePeriodCreate, int)) *VehicleStatePeriodCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VehicleStatePeriodCreateBulk{err: fmt.Errorf("calling to VehicleStatePeriodClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VehicleStatePeriodCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VehicleStatePeriodCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VehicleStatePeriod.
+func (c *VehicleStatePeriodClient) Update() *VehicleStatePeriodUpdate {
+	mutation := newVehicleStatePeriodMutation(c.config, OpUpdate)
+	return &VehicleStatePeriodUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VehicleStatePeriodClient) UpdateOne(_m *VehicleStatePeriod) *VehicleStatePeriodUpdateOne {
+	mutation := newVehicleStatePeriodMutation(c.config, OpUpdateOne, withVehicleStatePeriod(_m))
+	return &VehicleStatePeriodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VehicleStatePeriodClient) UpdateOneID(id int) *VehicleStatePeriodUpdateOne {
+	mutation := newVehicleStatePeriodMutation(c.config, OpUpdateOne, withVehicleStatePeriodID(id))
+	return &VehicleStatePeriodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VehicleStatePeriod.
+func (c *VehicleStatePeriodClient) Delete() *VehicleStatePeriodDelete {
+	mutation := newVehicleStatePeriodMutation(c.config, OpDelete)
+	return &VehicleStatePeriodDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VehicleStatePeriodClient) DeleteOne(_m *VehicleStatePeriod) *VehicleStatePeriodDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VehicleStatePeriodClient) DeleteOneID(id int) *VehicleStatePeriodDeleteOne {
+	builder := c.Delete().Where(vehiclestateperiod.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VehicleStatePeriodDeleteOne{builder}
+}
+
+// Query returns a query builder for VehicleStatePeriod.
+func (c *VehicleStatePeriodClient) Query() *VehicleStatePeriodQuery {
+	return &VehicleStatePeriodQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVehicleStatePeriod},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VehicleStatePeriod entity by its id.
+func (c *VehicleStatePeriodClient) Get(ctx context.Context, id int) (*VehicleStatePeriod, error) {
+	return c.Query().Where(vehiclestateperiod.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VehicleStatePeriodClient) GetX(ctx context.Context, id int) *VehicleStatePeriod {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *VehicleStatePeriodClient) Hooks() []Hook {
+	return c.hooks.VehicleStatePeriod
+}
+
+// Interceptors returns the client interceptors.
+func (c *VehicleStatePeriodClient) Interceptors() []Interceptor {
+	return c.inters.VehicleStatePeriod
+}
+
+func (c *VehicleStatePeriodClient) mutate(ctx context.Context, m *VehicleStatePeriodMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VehicleStatePeriodCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VehicleStatePeriodUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VehicleStatePeriodUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VehicleStatePeriodDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VehicleStatePeriod mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Authorize, AuthorizeToken, Partner, User, Vehicle []ent.Hook
+		Address, Authorize, AuthorizeToken, Geofence, Partner, RollupState,
+		SoftwareUpdate, Tariff, TirePressure, User, Vehicle, VehicleEvent,
+		VehicleRollup, VehicleSnapshot, VehicleStatePeriod []ent.Hook
 	}
 	inters struct {
-		Authorize, AuthorizeToken, Partner, User, Vehicle []ent.Interceptor
+		Address, Authorize, AuthorizeToken, Geofence, Partner, RollupState,
+		SoftwareUpdate, Tariff, TirePressure, User, Vehicle, VehicleEvent,
+		VehicleRollup, VehicleSnapshot, VehicleStatePeriod []ent.Interceptor
 	}
 )
@@ -8,11 +8,21 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"teslatrack/internal/data/ent/address"
 	"teslatrack/internal/data/ent/authorize"
 	"teslatrack/internal/data/ent/authorizetoken"
+	"teslatrack/internal/data/ent/geofence"
 	"teslatrack/internal/data/ent/partner"
+	"teslatrack/internal/data/ent/rollupstate"
+	"teslatrack/internal/data/ent/softwareupdate"
+	"teslatrack/internal/data/ent/tariff"
+	"teslatrack/internal/data/ent/tirepressure"
 	"teslatrack/internal/data/ent/user"
 	"teslatrack/internal/data/ent/vehicle"
+	"teslatrack/internal/data/ent/vehicleevent"
+	"teslatrack/internal/data/ent/vehiclerollup"
+	"teslatrack/internal/data/ent/vehiclesnapshot"
+	"teslatrack/internal/data/ent/vehiclestateperiod"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -77,11 +87,21 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			authorize.Table:      authorize.ValidColumn,
-			authorizetoken.Table: authorizetoken.ValidColumn,
-			partner.Table:        partner.ValidColumn,
-			user.Table:           user.ValidColumn,
-			vehicle.Table:        vehicle.ValidColumn,
+			address.Table:            address.ValidColumn,
+			authorize.Table:          authorize.ValidColumn,
+			authorizetoken.Table:     authorizetoken.ValidColumn,
+			geofence.Table:           geofence.ValidColumn,
+			partner.Table:            partner.ValidColumn,
+			rollupstate.Table:        rollupstate.ValidColumn,
+			softwareupdate.Table:     softwareupdate.ValidColumn,
+			tariff.Table:             tariff.ValidColumn,
+			tirepressure.Table:       tirepressure.ValidColumn,
+			user.Table:               user.ValidColumn,
+			vehicle.Table:            vehicle.ValidColumn,
+			vehicleevent.Table:       vehicleevent.ValidColumn,
+			vehiclerollup.Table:      vehiclerollup.ValidColumn,
+			vehiclesnapshot.Table:    vehiclesnapshot.ValidColumn,
+			vehiclestateperiod.Table: vehiclestateperiod.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"teslatrack/internal/data/ent/geofence"
+	"teslatrack/pkg/geo"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// User defined geofence table
+type Geofence struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Owner user ID
+	UserID int `json:"user_id,omitempty"`
+	// Display name
+	Name string `json:"name,omitempty"`
+	// Category, e.g., home, work, charger, other
+	Category string `json:"category,omitempty"`
+	// Shape, circle or polygon
+	Shape string `json:"shape,omitempty"`
+	// WGS-84 latitude of the circle center
+	Latitude float64 `json:"latitude,omitempty"`
+	// WGS-84 longitude of the circle center
+	Longitude float64 `json:"longitude,omitempty"`
+	// Circle radius in metres
+	Radius float64 `json:"radius,omitempty"`
+	// WGS-84 polygon vertices
+	Polygon []geo.Point `json:"polygon,omitempty"`
+	// Creation time
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update time
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Geofence) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case geofence.FieldPolygon:
+			values[i] = new([]byte)
+		case geofence.FieldLatitude, geofence.FieldLongitude, geofence.FieldRadius:
+			values[i] = new(sql.NullFloat64)
+		case geofence.FieldID, geofence.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case geofence.FieldName, geofence.FieldCategory, geofence.FieldShape:
+			values[i] = new(sql.NullString)
+		case geofence.FieldCreatedAt, geofence.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Geofence fields.
+func (_m *Geofence) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case geofence.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case geofence.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case geofence.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case geofence.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = value.String
+			}
+		case geofence.FieldShape:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shape", values[i])
+			} else if value.Valid {
+				_m.Shape = value.String
+			}
+		case geofence.FieldLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field latitude", values[i])
+			} else if value.Valid {
+				_m.Latitude = value.Float64
+			}
+		case geofence.FieldLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field longitude", values[i])
+			} else if value.Valid {
+				_m.Longitude = value.Float64
+			}
+		case geofence.FieldRadius:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field radius", values[i])
+			} else if value.Valid {
+				_m.Radius = value.Float64
+			}
+		case geofence.FieldPolygon:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field polygon", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Polygon); err != nil {
+					return fmt.Errorf("unmarshal field polygon: %w", err)
+				}
+			}
+		case geofence.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case geofence.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Geofence.
+// This includes values selected through modifiers, order, etc.
+func (_m *Geofence) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Geofence.
+// Note that you need to call Geofence.Unwrap() before calling this method if this Geofence
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Geofence) Update() *GeofenceUpdateOne {
+	return NewGeofenceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Geofence entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Geofence) Unwrap() *Geofence {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Geofence is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Geofence) String() string {
+	var builder strings.Builder
+	builder.WriteString("Geofence(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(_m.Category)
+	builder.WriteString(", ")
+	builder.WriteString("shape=")
+	builder.WriteString(_m.Shape)
+	builder.WriteString(", ")
+	builder.WriteString("latitude=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Latitude))
+	builder.WriteString(", ")
+	builder.WriteString("longitude=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Longitude))
+	builder.WriteString(", ")
+	builder.WriteString("radius=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Radius))
+	builder.WriteString(", ")
+	builder.WriteString("polygon=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Polygon))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Geofences is a parsable slice of Geofence.
+type Geofences []*Geofence
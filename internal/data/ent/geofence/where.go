@@ -0,0 +1,590 @@
+// Code generated by ent, DO NOT EDIT.
+
+package geofence
+
+import (
+	"teslatrack/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldName, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldCategory, v))
+}
+
+// Shape applies equality check predicate on the "shape" field. It's identical to ShapeEQ.
+func Shape(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldShape, v))
+}
+
+// Latitude applies equality check predicate on the "latitude" field. It's identical to LatitudeEQ.
+func Latitude(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldLatitude, v))
+}
+
+// Longitude applies equality check predicate on the "longitude" field. It's identical to LongitudeEQ.
+func Longitude(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldLongitude, v))
+}
+
+// Radius applies equality check predicate on the "radius" field. It's identical to RadiusEQ.
+func Radius(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldRadius, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLTE(FieldUserID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldContainsFold(FieldName, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// ShapeEQ applies the EQ predicate on the "shape" field.
+func ShapeEQ(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldShape, v))
+}
+
+// ShapeNEQ applies the NEQ predicate on the "shape" field.
+func ShapeNEQ(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNEQ(FieldShape, v))
+}
+
+// ShapeIn applies the In predicate on the "shape" field.
+func ShapeIn(vs ...string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldIn(FieldShape, vs...))
+}
+
+// ShapeNotIn applies the NotIn predicate on the "shape" field.
+func ShapeNotIn(vs ...string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNotIn(FieldShape, vs...))
+}
+
+// ShapeGT applies the GT predicate on the "shape" field.
+func ShapeGT(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGT(FieldShape, v))
+}
+
+// ShapeGTE applies the GTE predicate on the "shape" field.
+func ShapeGTE(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGTE(FieldShape, v))
+}
+
+// ShapeLT applies the LT predicate on the "shape" field.
+func ShapeLT(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLT(FieldShape, v))
+}
+
+// ShapeLTE applies the LTE predicate on the "shape" field.
+func ShapeLTE(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLTE(FieldShape, v))
+}
+
+// ShapeContains applies the Contains predicate on the "shape" field.
+func ShapeContains(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldContains(FieldShape, v))
+}
+
+// ShapeHasPrefix applies the HasPrefix predicate on the "shape" field.
+func ShapeHasPrefix(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldHasPrefix(FieldShape, v))
+}
+
+// ShapeHasSuffix applies the HasSuffix predicate on the "shape" field.
+func ShapeHasSuffix(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldHasSuffix(FieldShape, v))
+}
+
+// ShapeEqualFold applies the EqualFold predicate on the "shape" field.
+func ShapeEqualFold(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEqualFold(FieldShape, v))
+}
+
+// ShapeContainsFold applies the ContainsFold predicate on the "shape" field.
+func ShapeContainsFold(v string) predicate.Geofence {
+	return predicate.Geofence(sql.FieldContainsFold(FieldShape, v))
+}
+
+// LatitudeEQ applies the EQ predicate on the "latitude" field.
+func LatitudeEQ(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldLatitude, v))
+}
+
+// LatitudeNEQ applies the NEQ predicate on the "latitude" field.
+func LatitudeNEQ(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNEQ(FieldLatitude, v))
+}
+
+// LatitudeIn applies the In predicate on the "latitude" field.
+func LatitudeIn(vs ...float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldIn(FieldLatitude, vs...))
+}
+
+// LatitudeNotIn applies the NotIn predicate on the "latitude" field.
+func LatitudeNotIn(vs ...float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNotIn(FieldLatitude, vs...))
+}
+
+// LatitudeGT applies the GT predicate on the "latitude" field.
+func LatitudeGT(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGT(FieldLatitude, v))
+}
+
+// LatitudeGTE applies the GTE predicate on the "latitude" field.
+func LatitudeGTE(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGTE(FieldLatitude, v))
+}
+
+// LatitudeLT applies the LT predicate on the "latitude" field.
+func LatitudeLT(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLT(FieldLatitude, v))
+}
+
+// LatitudeLTE applies the LTE predicate on the "latitude" field.
+func LatitudeLTE(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLTE(FieldLatitude, v))
+}
+
+// LatitudeIsNil applies the IsNil predicate on the "latitude" field.
+func LatitudeIsNil() predicate.Geofence {
+	return predicate.Geofence(sql.FieldIsNull(FieldLatitude))
+}
+
+// LatitudeNotNil applies the NotNil predicate on the "latitude" field.
+func LatitudeNotNil() predicate.Geofence {
+	return predicate.Geofence(sql.FieldNotNull(FieldLatitude))
+}
+
+// LongitudeEQ applies the EQ predicate on the "longitude" field.
+func LongitudeEQ(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldLongitude, v))
+}
+
+// LongitudeNEQ applies the NEQ predicate on the "longitude" field.
+func LongitudeNEQ(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNEQ(FieldLongitude, v))
+}
+
+// LongitudeIn applies the In predicate on the "longitude" field.
+func LongitudeIn(vs ...float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldIn(FieldLongitude, vs...))
+}
+
+// LongitudeNotIn applies the NotIn predicate on the "longitude" field.
+func LongitudeNotIn(vs ...float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNotIn(FieldLongitude, vs...))
+}
+
+// LongitudeGT applies the GT predicate on the "longitude" field.
+func LongitudeGT(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGT(FieldLongitude, v))
+}
+
+// LongitudeGTE applies the GTE predicate on the "longitude" field.
+func LongitudeGTE(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGTE(FieldLongitude, v))
+}
+
+// LongitudeLT applies the LT predicate on the "longitude" field.
+func LongitudeLT(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLT(FieldLongitude, v))
+}
+
+// LongitudeLTE applies the LTE predicate on the "longitude" field.
+func LongitudeLTE(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLTE(FieldLongitude, v))
+}
+
+// LongitudeIsNil applies the IsNil predicate on the "longitude" field.
+func LongitudeIsNil() predicate.Geofence {
+	return predicate.Geofence(sql.FieldIsNull(FieldLongitude))
+}
+
+// LongitudeNotNil applies the NotNil predicate on the "longitude" field.
+func LongitudeNotNil() predicate.Geofence {
+	return predicate.Geofence(sql.FieldNotNull(FieldLongitude))
+}
+
+// RadiusEQ applies the EQ predicate on the "radius" field.
+func RadiusEQ(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldRadius, v))
+}
+
+// RadiusNEQ applies the NEQ predicate on the "radius" field.
+func RadiusNEQ(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNEQ(FieldRadius, v))
+}
+
+// RadiusIn applies the In predicate on the "radius" field.
+func RadiusIn(vs ...float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldIn(FieldRadius, vs...))
+}
+
+// RadiusNotIn applies the NotIn predicate on the "radius" field.
+func RadiusNotIn(vs ...float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNotIn(FieldRadius, vs...))
+}
+
+// RadiusGT applies the GT predicate on the "radius" field.
+func RadiusGT(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGT(FieldRadius, v))
+}
+
+// RadiusGTE applies the GTE predicate on the "radius" field.
+func RadiusGTE(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGTE(FieldRadius, v))
+}
+
+// RadiusLT applies the LT predicate on the "radius" field.
+func RadiusLT(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLT(FieldRadius, v))
+}
+
+// RadiusLTE applies the LTE predicate on the "radius" field.
+func RadiusLTE(v float64) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLTE(FieldRadius, v))
+}
+
+// RadiusIsNil applies the IsNil predicate on the "radius" field.
+func RadiusIsNil() predicate.Geofence {
+	return predicate.Geofence(sql.FieldIsNull(FieldRadius))
+}
+
+// RadiusNotNil applies the NotNil predicate on the "radius" field.
+func RadiusNotNil() predicate.Geofence {
+	return predicate.Geofence(sql.FieldNotNull(FieldRadius))
+}
+
+// PolygonIsNil applies the IsNil predicate on the "polygon" field.
+func PolygonIsNil() predicate.Geofence {
+	return predicate.Geofence(sql.FieldIsNull(FieldPolygon))
+}
+
+// PolygonNotNil applies the NotNil predicate on the "polygon" field.
+func PolygonNotNil() predicate.Geofence {
+	return predicate.Geofence(sql.FieldNotNull(FieldPolygon))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Geofence {
+	return predicate.Geofence(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Geofence) predicate.Geofence {
+	return predicate.Geofence(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Geofence) predicate.Geofence {
+	return predicate.Geofence(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Geofence) predicate.Geofence {
+	return predicate.Geofence(sql.NotPredicates(p))
+}
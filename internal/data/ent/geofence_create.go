@@ -0,0 +1,356 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/geofence"
+	"teslatrack/pkg/geo"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// GeofenceCreate is the builder for creating a Geofence entity.
+type GeofenceCreate struct {
+	config
+	mutation *GeofenceMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *GeofenceCreate) SetUserID(v int) *GeofenceCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *GeofenceCreate) SetName(v string) *GeofenceCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *GeofenceCreate) SetCategory(v string) *GeofenceCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_c *GeofenceCreate) SetNillableCategory(v *string) *GeofenceCreate {
+	if v != nil {
+		_c.SetCategory(*v)
+	}
+	return _c
+}
+
+// SetShape sets the "shape" field.
+func (_c *GeofenceCreate) SetShape(v string) *GeofenceCreate {
+	_c.mutation.SetShape(v)
+	return _c
+}
+
+// SetLatitude sets the "latitude" field.
+func (_c *GeofenceCreate) SetLatitude(v float64) *GeofenceCreate {
+	_c.mutation.SetLatitude(v)
+	return _c
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_c *GeofenceCreate) SetNillableLatitude(v *float64) *GeofenceCreate {
+	if v != nil {
+		_c.SetLatitude(*v)
+	}
+	return _c
+}
+
+// SetLongitude sets the "longitude" field.
+func (_c *GeofenceCreate) SetLongitude(v float64) *GeofenceCreate {
+	_c.mutation.SetLongitude(v)
+	return _c
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_c *GeofenceCreate) SetNillableLongitude(v *float64) *GeofenceCreate {
+	if v != nil {
+		_c.SetLongitude(*v)
+	}
+	return _c
+}
+
+// SetRadius sets the "radius" field.
+func (_c *GeofenceCreate) SetRadius(v float64) *GeofenceCreate {
+	_c.mutation.SetRadius(v)
+	return _c
+}
+
+// SetNillableRadius sets the "radius" field if the given value is not nil.
+func (_c *GeofenceCreate) SetNillableRadius(v *float64) *GeofenceCreate {
+	if v != nil {
+		_c.SetRadius(*v)
+	}
+	return _c
+}
+
+// SetPolygon sets the "polygon" field.
+func (_c *GeofenceCreate) SetPolygon(v []geo.Point) *GeofenceCreate {
+	_c.mutation.SetPolygon(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GeofenceCreate) SetCreatedAt(v time.Time) *GeofenceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GeofenceCreate) SetNillableCreatedAt(v *time.Time) *GeofenceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *GeofenceCreate) SetUpdatedAt(v time.Time) *GeofenceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *GeofenceCreate) SetNillableUpdatedAt(v *time.Time) *GeofenceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the GeofenceMutation object of the builder.
+func (_c *GeofenceCreate) Mutation() *GeofenceMutation {
+	return _c.mutation
+}
+
+// Save creates the Geofence in the database.
+func (_c *GeofenceCreate) Save(ctx context.Context) (*Geofence, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GeofenceCreate) SaveX(ctx context.Context) *Geofence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GeofenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GeofenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GeofenceCreate) defaults() {
+	if _, ok := _c.mutation.Category(); !ok {
+		v := geofence.DefaultCategory
+		_c.mutation.SetCategory(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := geofence.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := geofence.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GeofenceCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Geofence.user_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Geofence.name"`)}
+	}
+	if _, ok := _c.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "Geofence.category"`)}
+	}
+	if _, ok := _c.mutation.Shape(); !ok {
+		return &ValidationError{Name: "shape", err: errors.New(`ent: missing required field "Geofence.shape"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Geofence.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Geofence.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *GeofenceCreate) sqlSave(ctx context.Context) (*Geofence, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GeofenceCreate) createSpec() (*Geofence, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Geofence{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(geofence.Table, sqlgraph.NewFieldSpec(geofence.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(geofence.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(geofence.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(geofence.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.Shape(); ok {
+		_spec.SetField(geofence.FieldShape, field.TypeString, value)
+		_node.Shape = value
+	}
+	if value, ok := _c.mutation.Latitude(); ok {
+		_spec.SetField(geofence.FieldLatitude, field.TypeFloat64, value)
+		_node.Latitude = value
+	}
+	if value, ok := _c.mutation.Longitude(); ok {
+		_spec.SetField(geofence.FieldLongitude, field.TypeFloat64, value)
+		_node.Longitude = value
+	}
+	if value, ok := _c.mutation.Radius(); ok {
+		_spec.SetField(geofence.FieldRadius, field.TypeFloat64, value)
+		_node.Radius = value
+	}
+	if value, ok := _c.mutation.Polygon(); ok {
+		_spec.SetField(geofence.FieldPolygon, field.TypeJSON, value)
+		_node.Polygon = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(geofence.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(geofence.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// GeofenceCreateBulk is the builder for creating many Geofence entities in bulk.
+type GeofenceCreateBulk struct {
+	config
+	err      error
+	builders []*GeofenceCreate
+}
+
+// Save creates the Geofence entities in the database.
+func (_c *GeofenceCreateBulk) Save(ctx context.Context) ([]*Geofence, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Geofence, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GeofenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GeofenceCreateBulk) SaveX(ctx context.Context) []*Geofence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GeofenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GeofenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/geofence"
+	"teslatrack/internal/data/ent/predicate"
+	"teslatrack/pkg/geo"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// GeofenceUpdate is the builder for updating Geofence entities.
+type GeofenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GeofenceMutation
+}
+
+// Where appends a list predicates to the GeofenceUpdate builder.
+func (_u *GeofenceUpdate) Where(ps ...predicate.Geofence) *GeofenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *GeofenceUpdate) SetUserID(v int) *GeofenceUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *GeofenceUpdate) SetNillableUserID(v *int) *GeofenceUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *GeofenceUpdate) AddUserID(v int) *GeofenceUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *GeofenceUpdate) SetName(v string) *GeofenceUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *GeofenceUpdate) SetNillableName(v *string) *GeofenceUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *GeofenceUpdate) SetCategory(v string) *GeofenceUpdate {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *GeofenceUpdate) SetNillableCategory(v *string) *GeofenceUpdate {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetShape sets the "shape" field.
+func (_u *GeofenceUpdate) SetShape(v string) *GeofenceUpdate {
+	_u.mutation.SetShape(v)
+	return _u
+}
+
+// SetNillableShape sets the "shape" field if the given value is not nil.
+func (_u *GeofenceUpdate) SetNillableShape(v *string) *GeofenceUpdate {
+	if v != nil {
+		_u.SetShape(*v)
+	}
+	return _u
+}
+
+// SetLatitude sets the "latitude" field.
+func (_u *GeofenceUpdate) SetLatitude(v float64) *GeofenceUpdate {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *GeofenceUpdate) SetNillableLatitude(v *float64) *GeofenceUpdate {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *GeofenceUpdate) AddLatitude(v float64) *GeofenceUpdate {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (_u *GeofenceUpdate) ClearLatitude() *GeofenceUpdate {
+	_u.mutation.ClearLatitude()
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *GeofenceUpdate) SetLongitude(v float64) *GeofenceUpdate {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *GeofenceUpdate) SetNillableLongitude(v *float64) *GeofenceUpdate {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *GeofenceUpdate) AddLongitude(v float64) *GeofenceUpdate {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (_u *GeofenceUpdate) ClearLongitude() *GeofenceUpdate {
+	_u.mutation.ClearLongitude()
+	return _u
+}
+
+// SetRadius sets the "radius" field.
+func (_u *GeofenceUpdate) SetRadius(v float64) *GeofenceUpdate {
+	_u.mutation.ResetRadius()
+	_u.mutation.SetRadius(v)
+	return _u
+}
+
+// SetNillableRadius sets the "radius" field if the given value is not nil.
+func (_u *GeofenceUpdate) SetNillableRadius(v *float64) *GeofenceUpdate {
+	if v != nil {
+		_u.SetRadius(*v)
+	}
+	return _u
+}
+
+// AddRadius adds value to the "radius" field.
+func (_u *GeofenceUpdate) AddRadius(v float64) *GeofenceUpdate {
+	_u.mutation.AddRadius(v)
+	return _u
+}
+
+// ClearRadius clears the value of the "radius" field.
+func (_u *GeofenceUpdate) ClearRadius() *GeofenceUpdate {
+	_u.mutation.ClearRadius()
+	return _u
+}
+
+// SetPolygon sets the "polygon" field.
+func (_u *GeofenceUpdate) SetPolygon(v []geo.Point) *GeofenceUpdate {
+	_u.mutation.SetPolygon(v)
+	return _u
+}
+
+// AppendPolygon appends value to the "polygon" field.
+func (_u *GeofenceUpdate) AppendPolygon(v []geo.Point) *GeofenceUpdate {
+	_u.mutation.AppendPolygon(v)
+	return _u
+}
+
+// ClearPolygon clears the value of the "polygon" field.
+func (_u *GeofenceUpdate) ClearPolygon() *GeofenceUpdate {
+	_u.mutation.ClearPolygon()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GeofenceUpdate) SetUpdatedAt(v time.Time) *GeofenceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the GeofenceMutation object of the builder.
+func (_u *GeofenceUpdate) Mutation() *GeofenceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GeofenceUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GeofenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GeofenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GeofenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GeofenceUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := geofence.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *GeofenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(geofence.Table, geofence.Columns, sqlgraph.NewFieldSpec(geofence.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(geofence.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(geofence.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(geofence.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(geofence.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Shape(); ok {
+		_spec.SetField(geofence.FieldShape, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(geofence.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(geofence.FieldLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LatitudeCleared() {
+		_spec.ClearField(geofence.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(geofence.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(geofence.FieldLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongitudeCleared() {
+		_spec.ClearField(geofence.FieldLongitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Radius(); ok {
+		_spec.SetField(geofence.FieldRadius, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRadius(); ok {
+		_spec.AddField(geofence.FieldRadius, field.TypeFloat64, value)
+	}
+	if _u.mutation.RadiusCleared() {
+		_spec.ClearField(geofence.FieldRadius, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Polygon(); ok {
+		_spec.SetField(geofence.FieldPolygon, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolygon(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, geofence.FieldPolygon, value)
+		})
+	}
+	if _u.mutation.PolygonCleared() {
+		_spec.ClearField(geofence.FieldPolygon, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(geofence.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{geofence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GeofenceUpdateOne is the builder for updating a single Geofence entity.
+type GeofenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GeofenceMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *GeofenceUpdateOne) SetUserID(v int) *GeofenceUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *GeofenceUpdateOne) SetNillableUserID(v *int) *GeofenceUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *GeofenceUpdateOne) AddUserID(v int) *GeofenceUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *GeofenceUpdateOne) SetName(v string) *GeofenceUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *GeofenceUpdateOne) SetNillableName(v *string) *GeofenceUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *GeofenceUpdateOne) SetCategory(v string) *GeofenceUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *GeofenceUpdateOne) SetNillableCategory(v *string) *GeofenceUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetShape sets the "shape" field.
+func (_u *GeofenceUpdateOne) SetShape(v string) *GeofenceUpdateOne {
+	_u.mutation.SetShape(v)
+	return _u
+}
+
+// SetNillableShape sets the "shape" field if the given value is not nil.
+func (_u *GeofenceUpdateOne) SetNillableShape(v *string) *GeofenceUpdateOne {
+	if v != nil {
+		_u.SetShape(*v)
+	}
+	return _u
+}
+
+// SetLatitude sets the "latitude" field.
+func (_u *GeofenceUpdateOne) SetLatitude(v float64) *GeofenceUpdateOne {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *GeofenceUpdateOne) SetNillableLatitude(v *float64) *GeofenceUpdateOne {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *GeofenceUpdateOne) AddLatitude(v float64) *GeofenceUpdateOne {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (_u *GeofenceUpdateOne) ClearLatitude() *GeofenceUpdateOne {
+	_u.mutation.ClearLatitude()
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *GeofenceUpdateOne) SetLongitude(v float64) *GeofenceUpdateOne {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *GeofenceUpdateOne) SetNillableLongitude(v *float64) *GeofenceUpdateOne {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *GeofenceUpdateOne) AddLongitude(v float64) *GeofenceUpdateOne {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (_u *GeofenceUpdateOne) ClearLongitude() *GeofenceUpdateOne {
+	_u.mutation.ClearLongitude()
+	return _u
+}
+
+// SetRadius sets the "radius" field.
+func (_u *GeofenceUpdateOne) SetRadius(v float64) *GeofenceUpdateOne {
+	_u.mutation.ResetRadius()
+	_u.mutation.SetRadius(v)
+	return _u
+}
+
+// SetNillableRadius sets the "radius" field if the given value is not nil.
+func (_u *GeofenceUpdateOne) SetNillableRadius(v *float64) *GeofenceUpdateOne {
+	if v != nil {
+		_u.SetRadius(*v)
+	}
+	return _u
+}
+
+// AddRadius adds value to the "radius" field.
+func (_u *GeofenceUpdateOne) AddRadius(v float64) *GeofenceUpdateOne {
+	_u.mutation.AddRadius(v)
+	return _u
+}
+
+// ClearRadius clears the value of the "radius" field.
+func (_u *GeofenceUpdateOne) ClearRadius() *GeofenceUpdateOne {
+	_u.mutation.ClearRadius()
+	return _u
+}
+
+// SetPolygon sets the "polygon" field.
+func (_u *GeofenceUpdateOne) SetPolygon(v []geo.Point) *GeofenceUpdateOne {
+	_u.mutation.SetPolygon(v)
+	return _u
+}
+
+// AppendPolygon appends value to the "polygon" field.
+func (_u *GeofenceUpdateOne) AppendPolygon(v []geo.Point) *GeofenceUpdateOne {
+	_u.mutation.AppendPolygon(v)
+	return _u
+}
+
+// ClearPolygon clears the value of the "polygon" field.
+func (_u *GeofenceUpdateOne) ClearPolygon() *GeofenceUpdateOne {
+	_u.mutation.ClearPolygon()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GeofenceUpdateOne) SetUpdatedAt(v time.Time) *GeofenceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the GeofenceMutation object of the builder.
+func (_u *GeofenceUpdateOne) Mutation() *GeofenceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the GeofenceUpdate builder.
+func (_u *GeofenceUpdateOne) Where(ps ...predicate.Geofence) *GeofenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GeofenceUpdateOne) Select(field string, fields ...string) *GeofenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Geofence entity.
+func (_u *GeofenceUpdateOne) Save(ctx context.Context) (*Geofence, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GeofenceUpdateOne) SaveX(ctx context.Context) *Geofence {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GeofenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GeofenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GeofenceUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := geofence.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *GeofenceUpdateOne) sqlSave(ctx context.Context) (_node *Geofence, err error) {
+	_spec := sqlgraph.NewUpdateSpec(geofence.Table, geofence.Columns, sqlgraph.NewFieldSpec(geofence.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Geofence.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, geofence.FieldID)
+		for _, f := range fields {
+			if !geofence.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != geofence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(geofence.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(geofence.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(geofence.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(geofence.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Shape(); ok {
+		_spec.SetField(geofence.FieldShape, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(geofence.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(geofence.FieldLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LatitudeCleared() {
+		_spec.ClearField(geofence.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(geofence.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(geofence.FieldLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongitudeCleared() {
+		_spec.ClearField(geofence.FieldLongitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Radius(); ok {
+		_spec.SetField(geofence.FieldRadius, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRadius(); ok {
+		_spec.AddField(geofence.FieldRadius, field.TypeFloat64, value)
+	}
+	if _u.mutation.RadiusCleared() {
+		_spec.ClearField(geofence.FieldRadius, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Polygon(); ok {
+		_spec.SetField(geofence.FieldPolygon, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPolygon(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, geofence.FieldPolygon, value)
+		})
+	}
+	if _u.mutation.PolygonCleared() {
+		_spec.ClearField(geofence.FieldPolygon, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(geofence.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Geofence{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{geofence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
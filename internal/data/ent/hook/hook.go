@@ -8,6 +8,18 @@ import (
 	"teslatrack/internal/data/ent"
 )
 
+// The AddressFunc type is an adapter to allow the use of ordinary
+// function as Address mutator.
+type AddressFunc func(context.Context, *ent.AddressMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AddressFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AddressMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AddressMutation", m)
+}
+
 // The AuthorizeFunc type is an adapter to allow the use of ordinary
 // function as Authorize mutator.
 type AuthorizeFunc func(context.Context, *ent.AuthorizeMutation) (ent.Value, error)
@@ -32,6 +44,18 @@ func (f AuthorizeTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuthorizeTokenMutation", m)
 }
 
+// The GeofenceFunc type is an adapter to allow the use of ordinary
+// function as Geofence mutator.
+type GeofenceFunc func(context.Context, *ent.GeofenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GeofenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GeofenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GeofenceMutation", m)
+}
+
 // The PartnerFunc type is an adapter to allow the use of ordinary
 // function as Partner mutator.
 type PartnerFunc func(context.Context, *ent.PartnerMutation) (ent.Value, error)
@@ -44,6 +68,54 @@ func (f PartnerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PartnerMutation", m)
 }
 
+// The RollupStateFunc type is an adapter to allow the use of ordinary
+// function as RollupState mutator.
+type RollupStateFunc func(context.Context, *ent.RollupStateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RollupStateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RollupStateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RollupStateMutation", m)
+}
+
+// The SoftwareUpdateFunc type is an adapter to allow the use of ordinary
+// function as SoftwareUpdate mutator.
+type SoftwareUpdateFunc func(context.Context, *ent.SoftwareUpdateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SoftwareUpdateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SoftwareUpdateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SoftwareUpdateMutation", m)
+}
+
+// The TariffFunc type is an adapter to allow the use of ordinary
+// function as Tariff mutator.
+type TariffFunc func(context.Context, *ent.TariffMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TariffFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TariffMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TariffMutation", m)
+}
+
+// The TirePressureFunc type is an adapter to allow the use of ordinary
+// function as TirePressure mutator.
+type TirePressureFunc func(context.Context, *ent.TirePressureMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TirePressureFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TirePressureMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TirePressureMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -68,6 +140,54 @@ func (f VehicleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VehicleMutation", m)
 }
 
+// The VehicleEventFunc type is an adapter to allow the use of ordinary
+// function as VehicleEvent mutator.
+type VehicleEventFunc func(context.Context, *ent.VehicleEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VehicleEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VehicleEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VehicleEventMutation", m)
+}
+
+// The VehicleRollupFunc type is an adapter to allow the use of ordinary
+// function as VehicleRollup mutator.
+type VehicleRollupFunc func(context.Context, *ent.VehicleRollupMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VehicleRollupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VehicleRollupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VehicleRollupMutation", m)
+}
+
+// The VehicleSnapshotFunc type is an adapter to allow the use of ordinary
+// function as VehicleSnapshot mutator.
+type VehicleSnapshotFunc func(context.Context, *ent.VehicleSnapshotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VehicleSnapshotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VehicleSnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VehicleSnapshotMutation", m)
+}
+
+// The VehicleStatePeriodFunc type is an adapter to allow the use of ordinary
+// function as VehicleStatePeriod mutator.
+type VehicleStatePeriodFunc func(context.Context, *ent.VehicleStatePeriodMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VehicleStatePeriodFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VehicleStatePeriodMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VehicleStatePeriodMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -9,6 +9,34 @@ import (
 )
 
 var (
+	// AddressColumns holds the columns for the "address" table.
+	AddressColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "grid_latitude", Type: field.TypeInt},
+		{Name: "grid_longitude", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "road", Type: field.TypeString, Nullable: true},
+		{Name: "district", Type: field.TypeString, Nullable: true},
+		{Name: "city", Type: field.TypeString, Nullable: true},
+		{Name: "province", Type: field.TypeString, Nullable: true},
+		{Name: "country", Type: field.TypeString, Nullable: true},
+		{Name: "formatted", Type: field.TypeString, Nullable: true},
+		{Name: "provider", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AddressTable holds the schema information for the "address" table.
+	AddressTable = &schema.Table{
+		Name:       "address",
+		Columns:    AddressColumns,
+		PrimaryKey: []*schema.Column{AddressColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "address_grid_latitude_grid_longitude",
+				Unique:  true,
+				Columns: []*schema.Column{AddressColumns[1], AddressColumns[2]},
+			},
+		},
+	}
 	// AuthorizeColumns holds the columns for the "authorize" table.
 	AuthorizeColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -29,12 +57,13 @@ var (
 	// AuthorizeTokenColumns holds the columns for the "authorize_token" table.
 	AuthorizeTokenColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "tesla_code", Type: field.TypeString},
+		{Name: "tesla_code", Type: field.TypeString, Size: 2147483647},
 		{Name: "client_id", Type: field.TypeString},
 		{Name: "client_secret", Type: field.TypeString},
-		{Name: "access_token", Type: field.TypeString},
-		{Name: "refresh_token", Type: field.TypeString},
+		{Name: "access_token", Type: field.TypeString, Size: 2147483647},
+		{Name: "refresh_token", Type: field.TypeString, Size: 2147483647},
 		{Name: "scope", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted", Type: field.TypeBool, Default: false},
@@ -45,11 +74,38 @@ var (
 		Columns:    AuthorizeTokenColumns,
 		PrimaryKey: []*schema.Column{AuthorizeTokenColumns[0]},
 	}
+	// GeofenceColumns holds the columns for the "geofence" table.
+	GeofenceColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString},
+		{Name: "category", Type: field.TypeString, Default: "other"},
+		{Name: "shape", Type: field.TypeString},
+		{Name: "latitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "longitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "radius", Type: field.TypeFloat64, Nullable: true},
+		{Name: "polygon", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// GeofenceTable holds the schema information for the "geofence" table.
+	GeofenceTable = &schema.Table{
+		Name:       "geofence",
+		Columns:    GeofenceColumns,
+		PrimaryKey: []*schema.Column{GeofenceColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "geofence_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{GeofenceColumns[1]},
+			},
+		},
+	}
 	// PartnerColumns holds the columns for the "partner" table.
 	PartnerColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "client_id", Type: field.TypeString},
-		{Name: "access_token", Type: field.TypeString, Nullable: true},
+		{Name: "access_token", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "expires_in", Type: field.TypeInt, Nullable: true},
 		{Name: "token_type", Type: field.TypeString, Nullable: true, Size: 125},
 		{Name: "created_at", Type: field.TypeTime},
@@ -62,6 +118,118 @@ var (
 		Columns:    PartnerColumns,
 		PrimaryKey: []*schema.Column{PartnerColumns[0]},
 	}
+	// RollupStateColumns holds the columns for the "rollup_state" table.
+	RollupStateColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "vehicle_id", Type: field.TypeInt, Unique: true},
+		{Name: "time_zone", Type: field.TypeString},
+		{Name: "watermark", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RollupStateTable holds the schema information for the "rollup_state" table.
+	RollupStateTable = &schema.Table{
+		Name:       "rollup_state",
+		Columns:    RollupStateColumns,
+		PrimaryKey: []*schema.Column{RollupStateColumns[0]},
+	}
+	// SoftwareUpdateColumns holds the columns for the "software_update" table.
+	SoftwareUpdateColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "vehicle_id", Type: field.TypeInt},
+		{Name: "from_version", Type: field.TypeString, Nullable: true},
+		{Name: "version", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString},
+		{Name: "download_perc", Type: field.TypeInt, Default: 0},
+		{Name: "install_perc", Type: field.TypeInt, Default: 0},
+		{Name: "expected_duration", Type: field.TypeInt, Default: 0},
+		{Name: "available_at", Type: field.TypeTime},
+		{Name: "install_started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SoftwareUpdateTable holds the schema information for the "software_update" table.
+	SoftwareUpdateTable = &schema.Table{
+		Name:       "software_update",
+		Columns:    SoftwareUpdateColumns,
+		PrimaryKey: []*schema.Column{SoftwareUpdateColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "softwareupdate_vehicle_id_available_at",
+				Unique:  false,
+				Columns: []*schema.Column{SoftwareUpdateColumns[1], SoftwareUpdateColumns[8]},
+			},
+			{
+				Name:    "softwareupdate_vehicle_id_completed_at",
+				Unique:  false,
+				Columns: []*schema.Column{SoftwareUpdateColumns[1], SoftwareUpdateColumns[10]},
+			},
+			{
+				Name:    "softwareupdate_version",
+				Unique:  false,
+				Columns: []*schema.Column{SoftwareUpdateColumns[3]},
+			},
+		},
+	}
+	// TariffColumns holds the columns for the "tariff" table.
+	TariffColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "name", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "geofence_id", Type: field.TypeInt, Nullable: true},
+		{Name: "currency", Type: field.TypeString, Default: "CNY"},
+		{Name: "price", Type: field.TypeFloat64, Default: 0},
+		{Name: "windows", Type: field.TypeJSON, Nullable: true},
+		{Name: "time_zone", Type: field.TypeString, Default: "Asia/Shanghai"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TariffTable holds the schema information for the "tariff" table.
+	TariffTable = &schema.Table{
+		Name:       "tariff",
+		Columns:    TariffColumns,
+		PrimaryKey: []*schema.Column{TariffColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tariff_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{TariffColumns[1]},
+			},
+		},
+	}
+	// TirePressureColumns holds the columns for the "tire_pressure" table.
+	TirePressureColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "vehicle_id", Type: field.TypeInt},
+		{Name: "pressure_fl", Type: field.TypeFloat64, Nullable: true},
+		{Name: "pressure_fr", Type: field.TypeFloat64, Nullable: true},
+		{Name: "pressure_rl", Type: field.TypeFloat64, Nullable: true},
+		{Name: "pressure_rr", Type: field.TypeFloat64, Nullable: true},
+		{Name: "seen_at_fl", Type: field.TypeTime, Nullable: true},
+		{Name: "seen_at_fr", Type: field.TypeTime, Nullable: true},
+		{Name: "seen_at_rl", Type: field.TypeTime, Nullable: true},
+		{Name: "seen_at_rr", Type: field.TypeTime, Nullable: true},
+		{Name: "recommended_front", Type: field.TypeFloat64, Nullable: true},
+		{Name: "recommended_rear", Type: field.TypeFloat64, Nullable: true},
+		{Name: "soft_warnings", Type: field.TypeInt, Default: 0},
+		{Name: "hard_warnings", Type: field.TypeInt, Default: 0},
+		{Name: "unit", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TirePressureTable holds the schema information for the "tire_pressure" table.
+	TirePressureTable = &schema.Table{
+		Name:       "tire_pressure",
+		Columns:    TirePressureColumns,
+		PrimaryKey: []*schema.Column{TirePressureColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tirepressure_vehicle_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{TirePressureColumns[1], TirePressureColumns[15]},
+			},
+		},
+	}
 	// UserColumns holds the columns for the "user" table.
 	UserColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -75,6 +243,7 @@ var (
 		{Name: "gender", Type: field.TypeInt8, Default: 0},
 		{Name: "asked_user_id", Type: field.TypeInt, Nullable: true},
 		{Name: "area_code", Type: field.TypeString, Nullable: true},
+		{Name: "time_zone", Type: field.TypeString, Default: "Asia/Shanghai"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted", Type: field.TypeBool, Default: false},
@@ -84,6 +253,13 @@ var (
 		Name:       "user",
 		Columns:    UserColumns,
 		PrimaryKey: []*schema.Column{UserColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_account",
+				Unique:  true,
+				Columns: []*schema.Column{UserColumns[1]},
+			},
+		},
 	}
 	// VehicleColumns holds the columns for the "vehicle" table.
 	VehicleColumns = []*schema.Column{
@@ -97,7 +273,7 @@ var (
 		{Name: "calendar_enabled", Type: field.TypeInt8, Default: 0},
 		{Name: "car_type", Type: field.TypeString, Nullable: true},
 		{Name: "api_version", Type: field.TypeString, Nullable: true},
-		{Name: "raw_data", Type: field.TypeString},
+		{Name: "raw_data", Type: field.TypeString, Size: 2147483647},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted", Type: field.TypeBool, Default: false},
@@ -108,30 +284,240 @@ var (
 		Columns:    VehicleColumns,
 		PrimaryKey: []*schema.Column{VehicleColumns[0]},
 	}
+	// VehicleEventColumns holds the columns for the "vehicle_event" table.
+	VehicleEventColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "vehicle_id", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt},
+		{Name: "type", Type: field.TypeString},
+		{Name: "summary", Type: field.TypeString},
+		{Name: "data", Type: field.TypeJSON, Nullable: true},
+		{Name: "occurred_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// VehicleEventTable holds the schema information for the "vehicle_event" table.
+	VehicleEventTable = &schema.Table{
+		Name:       "vehicle_event",
+		Columns:    VehicleEventColumns,
+		PrimaryKey: []*schema.Column{VehicleEventColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vehicleevent_vehicle_id_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{VehicleEventColumns[1], VehicleEventColumns[6]},
+			},
+		},
+	}
+	// VehicleRollupColumns holds the columns for the "vehicle_rollup" table.
+	VehicleRollupColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "vehicle_id", Type: field.TypeInt},
+		{Name: "granularity", Type: field.TypeString},
+		{Name: "time_zone", Type: field.TypeString},
+		{Name: "start_at", Type: field.TypeTime},
+		{Name: "end_at", Type: field.TypeTime},
+		{Name: "drives", Type: field.TypeInt, Default: 0},
+		{Name: "distance", Type: field.TypeFloat64, Default: 0},
+		{Name: "drive_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "energy_used", Type: field.TypeFloat64, Default: 0},
+		{Name: "efficiency", Type: field.TypeFloat64, Default: 0},
+		{Name: "max_speed", Type: field.TypeFloat64, Default: 0},
+		{Name: "charges", Type: field.TypeInt, Default: 0},
+		{Name: "energy_added", Type: field.TypeFloat64, Default: 0},
+		{Name: "charge_cost", Type: field.TypeFloat64, Default: 0},
+		{Name: "parked_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "asleep_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// VehicleRollupTable holds the schema information for the "vehicle_rollup" table.
+	VehicleRollupTable = &schema.Table{
+		Name:       "vehicle_rollup",
+		Columns:    VehicleRollupColumns,
+		PrimaryKey: []*schema.Column{VehicleRollupColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vehiclerollup_vehicle_id_granularity_time_zone_start_at",
+				Unique:  true,
+				Columns: []*schema.Column{VehicleRollupColumns[1], VehicleRollupColumns[2], VehicleRollupColumns[3], VehicleRollupColumns[4]},
+			},
+		},
+	}
+	// VehicleSnapshotColumns holds the columns for the "vehicle_snapshot" table.
+	VehicleSnapshotColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "vehicle_id", Type: field.TypeInt},
+		{Name: "state", Type: field.TypeString},
+		{Name: "shift_state", Type: field.TypeString, Nullable: true},
+		{Name: "speed", Type: field.TypeFloat64, Nullable: true},
+		{Name: "power", Type: field.TypeInt, Nullable: true},
+		{Name: "latitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "longitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "coord_type", Type: field.TypeString, Nullable: true},
+		{Name: "heading", Type: field.TypeInt, Nullable: true},
+		{Name: "battery_level", Type: field.TypeInt, Nullable: true},
+		{Name: "usable_battery_level", Type: field.TypeInt, Nullable: true},
+		{Name: "battery_range", Type: field.TypeFloat64, Nullable: true},
+		{Name: "ideal_battery_range", Type: field.TypeFloat64, Nullable: true},
+		{Name: "est_battery_range", Type: field.TypeFloat64, Nullable: true},
+		{Name: "charging_state", Type: field.TypeString, Nullable: true},
+		{Name: "charger_power", Type: field.TypeInt, Nullable: true},
+		{Name: "charge_energy_added", Type: field.TypeFloat64, Nullable: true},
+		{Name: "charger_voltage", Type: field.TypeInt, Nullable: true},
+		{Name: "charger_actual_current", Type: field.TypeInt, Nullable: true},
+		{Name: "fast_charger_present", Type: field.TypeBool, Default: false},
+		{Name: "fast_charger_type", Type: field.TypeString, Nullable: true},
+		{Name: "battery_heater_on", Type: field.TypeBool, Default: false},
+		{Name: "outside_temp", Type: field.TypeFloat64, Nullable: true},
+		{Name: "inside_temp", Type: field.TypeFloat64, Nullable: true},
+		{Name: "climate_on", Type: field.TypeBool, Default: false},
+		{Name: "climate_keeper_mode", Type: field.TypeString, Nullable: true},
+		{Name: "cabin_overheat_cooling", Type: field.TypeBool, Default: false},
+		{Name: "sentry_mode", Type: field.TypeBool, Default: false},
+		{Name: "locked", Type: field.TypeBool, Default: false},
+		{Name: "odometer", Type: field.TypeFloat64, Nullable: true},
+		{Name: "car_version", Type: field.TypeString, Nullable: true},
+		{Name: "software_update_status", Type: field.TypeString, Nullable: true},
+		{Name: "software_update_version", Type: field.TypeString, Nullable: true},
+		{Name: "software_update_download_perc", Type: field.TypeInt, Nullable: true},
+		{Name: "software_update_install_perc", Type: field.TypeInt, Nullable: true},
+		{Name: "software_update_expected_duration", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// VehicleSnapshotTable holds the schema information for the "vehicle_snapshot" table.
+	VehicleSnapshotTable = &schema.Table{
+		Name:       "vehicle_snapshot",
+		Columns:    VehicleSnapshotColumns,
+		PrimaryKey: []*schema.Column{VehicleSnapshotColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vehiclesnapshot_vehicle_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{VehicleSnapshotColumns[1], VehicleSnapshotColumns[37]},
+			},
+		},
+	}
+	// VehicleStatePeriodColumns holds the columns for the "vehicle_state_period" table.
+	VehicleStatePeriodColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "vehicle_id", Type: field.TypeInt},
+		{Name: "state", Type: field.TypeString},
+		{Name: "sentry_mode", Type: field.TypeBool, Default: false},
+		{Name: "climate_on", Type: field.TypeBool, Default: false},
+		{Name: "start_at", Type: field.TypeTime},
+		{Name: "end_at", Type: field.TypeTime, Nullable: true},
+		{Name: "start_battery_level", Type: field.TypeInt, Nullable: true},
+		{Name: "end_battery_level", Type: field.TypeInt, Nullable: true},
+		{Name: "start_range", Type: field.TypeFloat64, Nullable: true},
+		{Name: "end_range", Type: field.TypeFloat64, Nullable: true},
+		{Name: "start_odometer", Type: field.TypeFloat64, Nullable: true},
+		{Name: "end_odometer", Type: field.TypeFloat64, Nullable: true},
+		{Name: "start_latitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "start_longitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "end_latitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "end_longitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "start_address", Type: field.TypeString, Nullable: true},
+		{Name: "end_address", Type: field.TypeString, Nullable: true},
+		{Name: "start_geofence_id", Type: field.TypeInt, Nullable: true},
+		{Name: "end_geofence_id", Type: field.TypeInt, Nullable: true},
+		{Name: "charge_location", Type: field.TypeString, Nullable: true},
+		{Name: "fast_charger", Type: field.TypeBool, Default: false},
+		{Name: "energy_added", Type: field.TypeFloat64, Nullable: true},
+		{Name: "start_energy_added", Type: field.TypeFloat64, Nullable: true},
+		{Name: "energy_used", Type: field.TypeFloat64, Nullable: true},
+		{Name: "power_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "outside_temp", Type: field.TypeFloat64, Nullable: true},
+		{Name: "max_speed", Type: field.TypeFloat64, Nullable: true},
+		{Name: "climate_ratio", Type: field.TypeFloat64, Nullable: true},
+		{Name: "cost", Type: field.TypeFloat64, Nullable: true},
+		{Name: "cost_manual", Type: field.TypeBool, Default: false},
+		{Name: "tariff_id", Type: field.TypeInt, Nullable: true},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// VehicleStatePeriodTable holds the schema information for the "vehicle_state_period" table.
+	VehicleStatePeriodTable = &schema.Table{
+		Name:       "vehicle_state_period",
+		Columns:    VehicleStatePeriodColumns,
+		PrimaryKey: []*schema.Column{VehicleStatePeriodColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vehiclestateperiod_vehicle_id_start_at",
+				Unique:  false,
+				Columns: []*schema.Column{VehicleStatePeriodColumns[1], VehicleStatePeriodColumns[5]},
+			},
+			{
+				Name:    "vehiclestateperiod_vehicle_id_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{VehicleStatePeriodColumns[1], VehicleStatePeriodColumns[36]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AddressTable,
 		AuthorizeTable,
 		AuthorizeTokenTable,
+		GeofenceTable,
 		PartnerTable,
+		RollupStateTable,
+		SoftwareUpdateTable,
+		TariffTable,
+		TirePressureTable,
 		UserTable,
 		VehicleTable,
+		VehicleEventTable,
+		VehicleRollupTable,
+		VehicleSnapshotTable,
+		VehicleStatePeriodTable,
 	}
 )
 
 func init() {
+	AddressTable.Annotation = &entsql.Annotation{
+		Table: "address",
+	}
 	AuthorizeTable.Annotation = &entsql.Annotation{
 		Table: "authorize",
 	}
 	AuthorizeTokenTable.Annotation = &entsql.Annotation{
 		Table: "authorize_token",
 	}
+	GeofenceTable.Annotation = &entsql.Annotation{
+		Table: "geofence",
+	}
 	PartnerTable.Annotation = &entsql.Annotation{
 		Table: "partner",
 	}
+	RollupStateTable.Annotation = &entsql.Annotation{
+		Table: "rollup_state",
+	}
+	SoftwareUpdateTable.Annotation = &entsql.Annotation{
+		Table: "software_update",
+	}
+	TariffTable.Annotation = &entsql.Annotation{
+		Table: "tariff",
+	}
+	TirePressureTable.Annotation = &entsql.Annotation{
+		Table: "tire_pressure",
+	}
 	UserTable.Annotation = &entsql.Annotation{
 		Table: "user",
 	}
 	VehicleTable.Annotation = &entsql.Annotation{
 		Table: "vehicle",
 	}
+	VehicleEventTable.Annotation = &entsql.Annotation{
+		Table: "vehicle_event",
+	}
+	VehicleRollupTable.Annotation = &entsql.Annotation{
+		Table: "vehicle_rollup",
+	}
+	VehicleSnapshotTable.Annotation = &entsql.Annotation{
+		Table: "vehicle_snapshot",
+	}
+	VehicleStatePeriodTable.Annotation = &entsql.Annotation{
+		Table: "vehicle_state_period",
+	}
 }
@@ -7,12 +7,24 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"teslatrack/internal/data/ent/address"
 	"teslatrack/internal/data/ent/authorize"
 	"teslatrack/internal/data/ent/authorizetoken"
+	"teslatrack/internal/data/ent/geofence"
 	"teslatrack/internal/data/ent/partner"
 	"teslatrack/internal/data/ent/predicate"
+	"teslatrack/internal/data/ent/rollupstate"
+	"teslatrack/internal/data/ent/softwareupdate"
+	"teslatrack/internal/data/ent/tariff"
+	"teslatrack/internal/data/ent/tirepressure"
 	"teslatrack/internal/data/ent/user"
 	"teslatrack/internal/data/ent/vehicle"
+	"teslatrack/internal/data/ent/vehicleevent"
+	"teslatrack/internal/data/ent/vehiclerollup"
+	"teslatrack/internal/data/ent/vehiclesnapshot"
+	"teslatrack/internal/data/ent/vehiclestateperiod"
+	"teslatrack/pkg/geo"
+	"teslatrack/pkg/pricing"
 	"time"
 
 	"entgo.io/ent"
@@ -28,43 +40,59 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAuthorize      = "Authorize"
-	TypeAuthorizeToken = "AuthorizeToken"
-	TypePartner        = "Partner"
-	TypeUser           = "User"
-	TypeVehicle        = "Vehicle"
+	TypeAddress            = "Address"
+	TypeAuthorize          = "Authorize"
+	TypeAuthorizeToken     = "AuthorizeToken"
+	TypeGeofence           = "Geofence"
+	TypePartner            = "Partner"
+	TypeRollupState        = "RollupState"
+	TypeSoftwareUpdate     = "SoftwareUpdate"
+	TypeTariff             = "Tariff"
+	TypeTirePressure       = "TirePressure"
+	TypeUser               = "User"
+	TypeVehicle            = "Vehicle"
+	TypeVehicleEvent       = "VehicleEvent"
+	TypeVehicleRollup      = "VehicleRollup"
+	TypeVehicleSnapshot    = "VehicleSnapshot"
+	TypeVehicleStatePeriod = "VehicleStatePeriod"
 )
 
-// AuthorizeMutation represents an operation that mutates the Authorize nodes in the graph.
-type AuthorizeMutation struct {
+// AddressMutation represents an operation that mutates the Address nodes in the graph.
+type AddressMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	client_id     *string
-	client_secret *string
-	grant_type    *string
-	redirect_uri  *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	deleted       *bool
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Authorize, error)
-	predicates    []predicate.Authorize
-}
-
-var _ ent.Mutation = (*AuthorizeMutation)(nil)
-
-// authorizeOption allows management of the mutation configuration using functional options.
-type authorizeOption func(*AuthorizeMutation)
-
-// newAuthorizeMutation creates new mutation for the Authorize entity.
-func newAuthorizeMutation(c config, op Op, opts ...authorizeOption) *AuthorizeMutation {
-	m := &AuthorizeMutation{
+	op                Op
+	typ               string
+	id                *int
+	grid_latitude     *int
+	addgrid_latitude  *int
+	grid_longitude    *int
+	addgrid_longitude *int
+	name              *string
+	road              *string
+	district          *string
+	city              *string
+	province          *string
+	country           *string
+	formatted         *string
+	provider          *string
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*Address, error)
+	predicates        []predicate.Address
+}
+
+var _ ent.Mutation = (*AddressMutation)(nil)
+
+// addressOption allows management of the mutation configuration using functional options.
+type addressOption func(*AddressMutation)
+
+// newAddressMutation creates new mutation for the Address entity.
+func newAddressMutation(c config, op Op, opts ...addressOption) *AddressMutation {
+	m := &AddressMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAuthorize,
+		typ:           TypeAddress,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -73,20 +101,20 @@ func newAuthorizeMutation(c config, op Op, opts ...authorizeOption) *AuthorizeMu
 	return m
 }
 
-// withAuthorizeID sets the ID field of the mutation.
-func withAuthorizeID(id int) authorizeOption {
-	return func(m *AuthorizeMutation) {
+// withAddressID sets the ID field of the mutation.
+func withAddressID(id int) addressOption {
+	return func(m *AddressMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Authorize
+			value *Address
 		)
-		m.oldValue = func(ctx context.Context) (*Authorize, error) {
+		m.oldValue = func(ctx context.Context) (*Address, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Authorize.Get(ctx, id)
+					value, err = m.Client().Address.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -95,10 +123,10 @@ func withAuthorizeID(id int) authorizeOption {
 	}
 }
 
-// withAuthorize sets the old Authorize of the mutation.
-func withAuthorize(node *Authorize) authorizeOption {
-	return func(m *AuthorizeMutation) {
-		m.oldValue = func(context.Context) (*Authorize, error) {
+// withAddress sets the old Address of the mutation.
+func withAddress(node *Address) addressOption {
+	return func(m *AddressMutation) {
+		m.oldValue = func(context.Context) (*Address, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -107,7 +135,7 @@ func withAuthorize(node *Authorize) authorizeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AuthorizeMutation) Client() *Client {
+func (m AddressMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -115,7 +143,7 @@ func (m AuthorizeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AuthorizeMutation) Tx() (*Tx, error) {
+func (m AddressMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -126,7 +154,7 @@ func (m AuthorizeMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AuthorizeMutation) ID() (id int, exists bool) {
+func (m *AddressMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -137,7 +165,7 @@ func (m *AuthorizeMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AuthorizeMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AddressMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -146,319 +174,619 @@ func (m *AuthorizeMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Authorize.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Address.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetClientID sets the "client_id" field.
-func (m *AuthorizeMutation) SetClientID(s string) {
-	m.client_id = &s
+// SetGridLatitude sets the "grid_latitude" field.
+func (m *AddressMutation) SetGridLatitude(i int) {
+	m.grid_latitude = &i
+	m.addgrid_latitude = nil
 }
 
-// ClientID returns the value of the "client_id" field in the mutation.
-func (m *AuthorizeMutation) ClientID() (r string, exists bool) {
-	v := m.client_id
+// GridLatitude returns the value of the "grid_latitude" field in the mutation.
+func (m *AddressMutation) GridLatitude() (r int, exists bool) {
+	v := m.grid_latitude
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientID returns the old "client_id" field's value of the Authorize entity.
-// If the Authorize object wasn't provided to the builder, the object is fetched from the database.
+// OldGridLatitude returns the old "grid_latitude" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorizeMutation) OldClientID(ctx context.Context) (v string, err error) {
+func (m *AddressMutation) OldGridLatitude(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+		return v, errors.New("OldGridLatitude is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientID requires an ID field in the mutation")
+		return v, errors.New("OldGridLatitude requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+		return v, fmt.Errorf("querying old value for OldGridLatitude: %w", err)
 	}
-	return oldValue.ClientID, nil
+	return oldValue.GridLatitude, nil
 }
 
-// ResetClientID resets all changes to the "client_id" field.
-func (m *AuthorizeMutation) ResetClientID() {
-	m.client_id = nil
+// AddGridLatitude adds i to the "grid_latitude" field.
+func (m *AddressMutation) AddGridLatitude(i int) {
+	if m.addgrid_latitude != nil {
+		*m.addgrid_latitude += i
+	} else {
+		m.addgrid_latitude = &i
+	}
 }
 
-// SetClientSecret sets the "client_secret" field.
-func (m *AuthorizeMutation) SetClientSecret(s string) {
-	m.client_secret = &s
+// AddedGridLatitude returns the value that was added to the "grid_latitude" field in this mutation.
+func (m *AddressMutation) AddedGridLatitude() (r int, exists bool) {
+	v := m.addgrid_latitude
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClientSecret returns the value of the "client_secret" field in the mutation.
-func (m *AuthorizeMutation) ClientSecret() (r string, exists bool) {
-	v := m.client_secret
+// ResetGridLatitude resets all changes to the "grid_latitude" field.
+func (m *AddressMutation) ResetGridLatitude() {
+	m.grid_latitude = nil
+	m.addgrid_latitude = nil
+}
+
+// SetGridLongitude sets the "grid_longitude" field.
+func (m *AddressMutation) SetGridLongitude(i int) {
+	m.grid_longitude = &i
+	m.addgrid_longitude = nil
+}
+
+// GridLongitude returns the value of the "grid_longitude" field in the mutation.
+func (m *AddressMutation) GridLongitude() (r int, exists bool) {
+	v := m.grid_longitude
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientSecret returns the old "client_secret" field's value of the Authorize entity.
-// If the Authorize object wasn't provided to the builder, the object is fetched from the database.
+// OldGridLongitude returns the old "grid_longitude" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorizeMutation) OldClientSecret(ctx context.Context) (v string, err error) {
+func (m *AddressMutation) OldGridLongitude(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldGridLongitude is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientSecret requires an ID field in the mutation")
+		return v, errors.New("OldGridLongitude requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientSecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldGridLongitude: %w", err)
 	}
-	return oldValue.ClientSecret, nil
+	return oldValue.GridLongitude, nil
 }
 
-// ResetClientSecret resets all changes to the "client_secret" field.
-func (m *AuthorizeMutation) ResetClientSecret() {
-	m.client_secret = nil
+// AddGridLongitude adds i to the "grid_longitude" field.
+func (m *AddressMutation) AddGridLongitude(i int) {
+	if m.addgrid_longitude != nil {
+		*m.addgrid_longitude += i
+	} else {
+		m.addgrid_longitude = &i
+	}
 }
 
-// SetGrantType sets the "grant_type" field.
-func (m *AuthorizeMutation) SetGrantType(s string) {
-	m.grant_type = &s
+// AddedGridLongitude returns the value that was added to the "grid_longitude" field in this mutation.
+func (m *AddressMutation) AddedGridLongitude() (r int, exists bool) {
+	v := m.addgrid_longitude
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// GrantType returns the value of the "grant_type" field in the mutation.
-func (m *AuthorizeMutation) GrantType() (r string, exists bool) {
-	v := m.grant_type
+// ResetGridLongitude resets all changes to the "grid_longitude" field.
+func (m *AddressMutation) ResetGridLongitude() {
+	m.grid_longitude = nil
+	m.addgrid_longitude = nil
+}
+
+// SetName sets the "name" field.
+func (m *AddressMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *AddressMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGrantType returns the old "grant_type" field's value of the Authorize entity.
-// If the Authorize object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorizeMutation) OldGrantType(ctx context.Context) (v string, err error) {
+func (m *AddressMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGrantType is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGrantType requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGrantType: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.GrantType, nil
+	return oldValue.Name, nil
 }
 
-// ResetGrantType resets all changes to the "grant_type" field.
-func (m *AuthorizeMutation) ResetGrantType() {
-	m.grant_type = nil
+// ClearName clears the value of the "name" field.
+func (m *AddressMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[address.FieldName] = struct{}{}
 }
 
-// SetRedirectURI sets the "redirect_uri" field.
-func (m *AuthorizeMutation) SetRedirectURI(s string) {
-	m.redirect_uri = &s
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *AddressMutation) NameCleared() bool {
+	_, ok := m.clearedFields[address.FieldName]
+	return ok
 }
 
-// RedirectURI returns the value of the "redirect_uri" field in the mutation.
-func (m *AuthorizeMutation) RedirectURI() (r string, exists bool) {
-	v := m.redirect_uri
+// ResetName resets all changes to the "name" field.
+func (m *AddressMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, address.FieldName)
+}
+
+// SetRoad sets the "road" field.
+func (m *AddressMutation) SetRoad(s string) {
+	m.road = &s
+}
+
+// Road returns the value of the "road" field in the mutation.
+func (m *AddressMutation) Road() (r string, exists bool) {
+	v := m.road
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRedirectURI returns the old "redirect_uri" field's value of the Authorize entity.
-// If the Authorize object wasn't provided to the builder, the object is fetched from the database.
+// OldRoad returns the old "road" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorizeMutation) OldRedirectURI(ctx context.Context) (v string, err error) {
+func (m *AddressMutation) OldRoad(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRedirectURI is only allowed on UpdateOne operations")
+		return v, errors.New("OldRoad is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRedirectURI requires an ID field in the mutation")
+		return v, errors.New("OldRoad requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRedirectURI: %w", err)
+		return v, fmt.Errorf("querying old value for OldRoad: %w", err)
 	}
-	return oldValue.RedirectURI, nil
+	return oldValue.Road, nil
 }
 
-// ResetRedirectURI resets all changes to the "redirect_uri" field.
-func (m *AuthorizeMutation) ResetRedirectURI() {
-	m.redirect_uri = nil
+// ClearRoad clears the value of the "road" field.
+func (m *AddressMutation) ClearRoad() {
+	m.road = nil
+	m.clearedFields[address.FieldRoad] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *AuthorizeMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// RoadCleared returns if the "road" field was cleared in this mutation.
+func (m *AddressMutation) RoadCleared() bool {
+	_, ok := m.clearedFields[address.FieldRoad]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *AuthorizeMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetRoad resets all changes to the "road" field.
+func (m *AddressMutation) ResetRoad() {
+	m.road = nil
+	delete(m.clearedFields, address.FieldRoad)
+}
+
+// SetDistrict sets the "district" field.
+func (m *AddressMutation) SetDistrict(s string) {
+	m.district = &s
+}
+
+// District returns the value of the "district" field in the mutation.
+func (m *AddressMutation) District() (r string, exists bool) {
+	v := m.district
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Authorize entity.
-// If the Authorize object wasn't provided to the builder, the object is fetched from the database.
+// OldDistrict returns the old "district" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorizeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AddressMutation) OldDistrict(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDistrict is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDistrict requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDistrict: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.District, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *AuthorizeMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearDistrict clears the value of the "district" field.
+func (m *AddressMutation) ClearDistrict() {
+	m.district = nil
+	m.clearedFields[address.FieldDistrict] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *AuthorizeMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// DistrictCleared returns if the "district" field was cleared in this mutation.
+func (m *AddressMutation) DistrictCleared() bool {
+	_, ok := m.clearedFields[address.FieldDistrict]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *AuthorizeMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetDistrict resets all changes to the "district" field.
+func (m *AddressMutation) ResetDistrict() {
+	m.district = nil
+	delete(m.clearedFields, address.FieldDistrict)
+}
+
+// SetCity sets the "city" field.
+func (m *AddressMutation) SetCity(s string) {
+	m.city = &s
+}
+
+// City returns the value of the "city" field in the mutation.
+func (m *AddressMutation) City() (r string, exists bool) {
+	v := m.city
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Authorize entity.
-// If the Authorize object wasn't provided to the builder, the object is fetched from the database.
+// OldCity returns the old "city" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorizeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AddressMutation) OldCity(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCity: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.City, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *AuthorizeMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearCity clears the value of the "city" field.
+func (m *AddressMutation) ClearCity() {
+	m.city = nil
+	m.clearedFields[address.FieldCity] = struct{}{}
 }
 
-// SetDeleted sets the "deleted" field.
-func (m *AuthorizeMutation) SetDeleted(b bool) {
-	m.deleted = &b
+// CityCleared returns if the "city" field was cleared in this mutation.
+func (m *AddressMutation) CityCleared() bool {
+	_, ok := m.clearedFields[address.FieldCity]
+	return ok
 }
 
-// Deleted returns the value of the "deleted" field in the mutation.
-func (m *AuthorizeMutation) Deleted() (r bool, exists bool) {
-	v := m.deleted
+// ResetCity resets all changes to the "city" field.
+func (m *AddressMutation) ResetCity() {
+	m.city = nil
+	delete(m.clearedFields, address.FieldCity)
+}
+
+// SetProvince sets the "province" field.
+func (m *AddressMutation) SetProvince(s string) {
+	m.province = &s
+}
+
+// Province returns the value of the "province" field in the mutation.
+func (m *AddressMutation) Province() (r string, exists bool) {
+	v := m.province
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeleted returns the old "deleted" field's value of the Authorize entity.
-// If the Authorize object wasn't provided to the builder, the object is fetched from the database.
+// OldProvince returns the old "province" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorizeMutation) OldDeleted(ctx context.Context) (v bool, err error) {
+func (m *AddressMutation) OldProvince(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeleted is only allowed on UpdateOne operations")
+		return v, errors.New("OldProvince is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeleted requires an ID field in the mutation")
+		return v, errors.New("OldProvince requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeleted: %w", err)
+		return v, fmt.Errorf("querying old value for OldProvince: %w", err)
 	}
-	return oldValue.Deleted, nil
+	return oldValue.Province, nil
 }
 
-// ResetDeleted resets all changes to the "deleted" field.
-func (m *AuthorizeMutation) ResetDeleted() {
-	m.deleted = nil
+// ClearProvince clears the value of the "province" field.
+func (m *AddressMutation) ClearProvince() {
+	m.province = nil
+	m.clearedFields[address.FieldProvince] = struct{}{}
 }
 
-// Where appends a list predicates to the AuthorizeMutation builder.
-func (m *AuthorizeMutation) Where(ps ...predicate.Authorize) {
-	m.predicates = append(m.predicates, ps...)
+// ProvinceCleared returns if the "province" field was cleared in this mutation.
+func (m *AddressMutation) ProvinceCleared() bool {
+	_, ok := m.clearedFields[address.FieldProvince]
+	return ok
 }
 
-// WhereP appends storage-level predicates to the AuthorizeMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuthorizeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Authorize, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ResetProvince resets all changes to the "province" field.
+func (m *AddressMutation) ResetProvince() {
+	m.province = nil
+	delete(m.clearedFields, address.FieldProvince)
+}
+
+// SetCountry sets the "country" field.
+func (m *AddressMutation) SetCountry(s string) {
+	m.country = &s
+}
+
+// Country returns the value of the "country" field in the mutation.
+func (m *AddressMutation) Country() (r string, exists bool) {
+	v := m.country
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *AuthorizeMutation) Op() Op {
-	return m.op
+// OldCountry returns the old "country" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldCountry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
+	}
+	return oldValue.Country, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *AuthorizeMutation) SetOp(op Op) {
-	m.op = op
+// ClearCountry clears the value of the "country" field.
+func (m *AddressMutation) ClearCountry() {
+	m.country = nil
+	m.clearedFields[address.FieldCountry] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Authorize).
-func (m *AuthorizeMutation) Type() string {
-	return m.typ
+// CountryCleared returns if the "country" field was cleared in this mutation.
+func (m *AddressMutation) CountryCleared() bool {
+	_, ok := m.clearedFields[address.FieldCountry]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *AuthorizeMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.client_id != nil {
-		fields = append(fields, authorize.FieldClientID)
-	}
-	if m.client_secret != nil {
-		fields = append(fields, authorize.FieldClientSecret)
+// ResetCountry resets all changes to the "country" field.
+func (m *AddressMutation) ResetCountry() {
+	m.country = nil
+	delete(m.clearedFields, address.FieldCountry)
+}
+
+// SetFormatted sets the "formatted" field.
+func (m *AddressMutation) SetFormatted(s string) {
+	m.formatted = &s
+}
+
+// Formatted returns the value of the "formatted" field in the mutation.
+func (m *AddressMutation) Formatted() (r string, exists bool) {
+	v := m.formatted
+	if v == nil {
+		return
 	}
-	if m.grant_type != nil {
-		fields = append(fields, authorize.FieldGrantType)
+	return *v, true
+}
+
+// OldFormatted returns the old "formatted" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldFormatted(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormatted is only allowed on UpdateOne operations")
 	}
-	if m.redirect_uri != nil {
-		fields = append(fields, authorize.FieldRedirectURI)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormatted requires an ID field in the mutation")
 	}
-	if m.created_at != nil {
-		fields = append(fields, authorize.FieldCreatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormatted: %w", err)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, authorize.FieldUpdatedAt)
+	return oldValue.Formatted, nil
+}
+
+// ClearFormatted clears the value of the "formatted" field.
+func (m *AddressMutation) ClearFormatted() {
+	m.formatted = nil
+	m.clearedFields[address.FieldFormatted] = struct{}{}
+}
+
+// FormattedCleared returns if the "formatted" field was cleared in this mutation.
+func (m *AddressMutation) FormattedCleared() bool {
+	_, ok := m.clearedFields[address.FieldFormatted]
+	return ok
+}
+
+// ResetFormatted resets all changes to the "formatted" field.
+func (m *AddressMutation) ResetFormatted() {
+	m.formatted = nil
+	delete(m.clearedFields, address.FieldFormatted)
+}
+
+// SetProvider sets the "provider" field.
+func (m *AddressMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *AddressMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
 	}
-	if m.deleted != nil {
-		fields = append(fields, authorize.FieldDeleted)
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ClearProvider clears the value of the "provider" field.
+func (m *AddressMutation) ClearProvider() {
+	m.provider = nil
+	m.clearedFields[address.FieldProvider] = struct{}{}
+}
+
+// ProviderCleared returns if the "provider" field was cleared in this mutation.
+func (m *AddressMutation) ProviderCleared() bool {
+	_, ok := m.clearedFields[address.FieldProvider]
+	return ok
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *AddressMutation) ResetProvider() {
+	m.provider = nil
+	delete(m.clearedFields, address.FieldProvider)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AddressMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AddressMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AddressMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the AddressMutation builder.
+func (m *AddressMutation) Where(ps ...predicate.Address) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AddressMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AddressMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Address, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AddressMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AddressMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Address).
+func (m *AddressMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AddressMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.grid_latitude != nil {
+		fields = append(fields, address.FieldGridLatitude)
+	}
+	if m.grid_longitude != nil {
+		fields = append(fields, address.FieldGridLongitude)
+	}
+	if m.name != nil {
+		fields = append(fields, address.FieldName)
+	}
+	if m.road != nil {
+		fields = append(fields, address.FieldRoad)
+	}
+	if m.district != nil {
+		fields = append(fields, address.FieldDistrict)
+	}
+	if m.city != nil {
+		fields = append(fields, address.FieldCity)
+	}
+	if m.province != nil {
+		fields = append(fields, address.FieldProvince)
+	}
+	if m.country != nil {
+		fields = append(fields, address.FieldCountry)
+	}
+	if m.formatted != nil {
+		fields = append(fields, address.FieldFormatted)
+	}
+	if m.provider != nil {
+		fields = append(fields, address.FieldProvider)
+	}
+	if m.created_at != nil {
+		fields = append(fields, address.FieldCreatedAt)
 	}
 	return fields
 }
@@ -466,22 +794,30 @@ func (m *AuthorizeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AuthorizeMutation) Field(name string) (ent.Value, bool) {
+func (m *AddressMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case authorize.FieldClientID:
-		return m.ClientID()
-	case authorize.FieldClientSecret:
-		return m.ClientSecret()
-	case authorize.FieldGrantType:
-		return m.GrantType()
-	case authorize.FieldRedirectURI:
-		return m.RedirectURI()
-	case authorize.FieldCreatedAt:
+	case address.FieldGridLatitude:
+		return m.GridLatitude()
+	case address.FieldGridLongitude:
+		return m.GridLongitude()
+	case address.FieldName:
+		return m.Name()
+	case address.FieldRoad:
+		return m.Road()
+	case address.FieldDistrict:
+		return m.District()
+	case address.FieldCity:
+		return m.City()
+	case address.FieldProvince:
+		return m.Province()
+	case address.FieldCountry:
+		return m.Country()
+	case address.FieldFormatted:
+		return m.Formatted()
+	case address.FieldProvider:
+		return m.Provider()
+	case address.FieldCreatedAt:
 		return m.CreatedAt()
-	case authorize.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case authorize.FieldDeleted:
-		return m.Deleted()
 	}
 	return nil, false
 }
@@ -489,234 +825,358 @@ func (m *AuthorizeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AuthorizeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AddressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case authorize.FieldClientID:
-		return m.OldClientID(ctx)
-	case authorize.FieldClientSecret:
-		return m.OldClientSecret(ctx)
-	case authorize.FieldGrantType:
-		return m.OldGrantType(ctx)
-	case authorize.FieldRedirectURI:
-		return m.OldRedirectURI(ctx)
-	case authorize.FieldCreatedAt:
+	case address.FieldGridLatitude:
+		return m.OldGridLatitude(ctx)
+	case address.FieldGridLongitude:
+		return m.OldGridLongitude(ctx)
+	case address.FieldName:
+		return m.OldName(ctx)
+	case address.FieldRoad:
+		return m.OldRoad(ctx)
+	case address.FieldDistrict:
+		return m.OldDistrict(ctx)
+	case address.FieldCity:
+		return m.OldCity(ctx)
+	case address.FieldProvince:
+		return m.OldProvince(ctx)
+	case address.FieldCountry:
+		return m.OldCountry(ctx)
+	case address.FieldFormatted:
+		return m.OldFormatted(ctx)
+	case address.FieldProvider:
+		return m.OldProvider(ctx)
+	case address.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case authorize.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case authorize.FieldDeleted:
-		return m.OldDeleted(ctx)
 	}
-	return nil, fmt.Errorf("unknown Authorize field %s", name)
+	return nil, fmt.Errorf("unknown Address field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthorizeMutation) SetField(name string, value ent.Value) error {
+func (m *AddressMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case authorize.FieldClientID:
+	case address.FieldGridLatitude:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGridLatitude(v)
+		return nil
+	case address.FieldGridLongitude:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGridLongitude(v)
+		return nil
+	case address.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientID(v)
+		m.SetName(v)
 		return nil
-	case authorize.FieldClientSecret:
+	case address.FieldRoad:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientSecret(v)
+		m.SetRoad(v)
 		return nil
-	case authorize.FieldGrantType:
+	case address.FieldDistrict:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGrantType(v)
+		m.SetDistrict(v)
 		return nil
-	case authorize.FieldRedirectURI:
+	case address.FieldCity:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRedirectURI(v)
+		m.SetCity(v)
 		return nil
-	case authorize.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case address.FieldProvince:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetProvince(v)
 		return nil
-	case authorize.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case address.FieldCountry:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCountry(v)
 		return nil
-	case authorize.FieldDeleted:
-		v, ok := value.(bool)
+	case address.FieldFormatted:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeleted(v)
+		m.SetFormatted(v)
+		return nil
+	case address.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case address.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Authorize field %s", name)
+	return fmt.Errorf("unknown Address field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AuthorizeMutation) AddedFields() []string {
-	return nil
+func (m *AddressMutation) AddedFields() []string {
+	var fields []string
+	if m.addgrid_latitude != nil {
+		fields = append(fields, address.FieldGridLatitude)
+	}
+	if m.addgrid_longitude != nil {
+		fields = append(fields, address.FieldGridLongitude)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AuthorizeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AddressMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case address.FieldGridLatitude:
+		return m.AddedGridLatitude()
+	case address.FieldGridLongitude:
+		return m.AddedGridLongitude()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthorizeMutation) AddField(name string, value ent.Value) error {
+func (m *AddressMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case address.FieldGridLatitude:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGridLatitude(v)
+		return nil
+	case address.FieldGridLongitude:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGridLongitude(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Authorize numeric field %s", name)
+	return fmt.Errorf("unknown Address numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AuthorizeMutation) ClearedFields() []string {
-	return nil
+func (m *AddressMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(address.FieldName) {
+		fields = append(fields, address.FieldName)
+	}
+	if m.FieldCleared(address.FieldRoad) {
+		fields = append(fields, address.FieldRoad)
+	}
+	if m.FieldCleared(address.FieldDistrict) {
+		fields = append(fields, address.FieldDistrict)
+	}
+	if m.FieldCleared(address.FieldCity) {
+		fields = append(fields, address.FieldCity)
+	}
+	if m.FieldCleared(address.FieldProvince) {
+		fields = append(fields, address.FieldProvince)
+	}
+	if m.FieldCleared(address.FieldCountry) {
+		fields = append(fields, address.FieldCountry)
+	}
+	if m.FieldCleared(address.FieldFormatted) {
+		fields = append(fields, address.FieldFormatted)
+	}
+	if m.FieldCleared(address.FieldProvider) {
+		fields = append(fields, address.FieldProvider)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AuthorizeMutation) FieldCleared(name string) bool {
+func (m *AddressMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AuthorizeMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Authorize nullable field %s", name)
+func (m *AddressMutation) ClearField(name string) error {
+	switch name {
+	case address.FieldName:
+		m.ClearName()
+		return nil
+	case address.FieldRoad:
+		m.ClearRoad()
+		return nil
+	case address.FieldDistrict:
+		m.ClearDistrict()
+		return nil
+	case address.FieldCity:
+		m.ClearCity()
+		return nil
+	case address.FieldProvince:
+		m.ClearProvince()
+		return nil
+	case address.FieldCountry:
+		m.ClearCountry()
+		return nil
+	case address.FieldFormatted:
+		m.ClearFormatted()
+		return nil
+	case address.FieldProvider:
+		m.ClearProvider()
+		return nil
+	}
+	return fmt.Errorf("unknown Address nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AuthorizeMutation) ResetField(name string) error {
+func (m *AddressMutation) ResetField(name string) error {
 	switch name {
-	case authorize.FieldClientID:
-		m.ResetClientID()
+	case address.FieldGridLatitude:
+		m.ResetGridLatitude()
 		return nil
-	case authorize.FieldClientSecret:
-		m.ResetClientSecret()
+	case address.FieldGridLongitude:
+		m.ResetGridLongitude()
 		return nil
-	case authorize.FieldGrantType:
-		m.ResetGrantType()
+	case address.FieldName:
+		m.ResetName()
 		return nil
-	case authorize.FieldRedirectURI:
-		m.ResetRedirectURI()
+	case address.FieldRoad:
+		m.ResetRoad()
 		return nil
-	case authorize.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case address.FieldDistrict:
+		m.ResetDistrict()
 		return nil
-	case authorize.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case address.FieldCity:
+		m.ResetCity()
 		return nil
-	case authorize.FieldDeleted:
-		m.ResetDeleted()
+	case address.FieldProvince:
+		m.ResetProvince()
+		return nil
+	case address.FieldCountry:
+		m.ResetCountry()
+		return nil
+	case address.FieldFormatted:
+		m.ResetFormatted()
+		return nil
+	case address.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case address.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Authorize field %s", name)
+	return fmt.Errorf("unknown Address field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuthorizeMutation) AddedEdges() []string {
+func (m *AddressMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AuthorizeMutation) AddedIDs(name string) []ent.Value {
+func (m *AddressMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuthorizeMutation) RemovedEdges() []string {
+func (m *AddressMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AuthorizeMutation) RemovedIDs(name string) []ent.Value {
+func (m *AddressMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuthorizeMutation) ClearedEdges() []string {
+func (m *AddressMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AuthorizeMutation) EdgeCleared(name string) bool {
+func (m *AddressMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AuthorizeMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Authorize unique edge %s", name)
+func (m *AddressMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Address unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AuthorizeMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Authorize edge %s", name)
+func (m *AddressMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Address edge %s", name)
 }
 
-// AuthorizeTokenMutation represents an operation that mutates the AuthorizeToken nodes in the graph.
-type AuthorizeTokenMutation struct {
+// AuthorizeMutation represents an operation that mutates the Authorize nodes in the graph.
+type AuthorizeMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	tesla_code    *string
 	client_id     *string
 	client_secret *string
-	access_token  *string
-	refresh_token *string
-	scope         *string
+	grant_type    *string
+	redirect_uri  *string
 	created_at    *time.Time
 	updated_at    *time.Time
 	deleted       *bool
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*AuthorizeToken, error)
-	predicates    []predicate.AuthorizeToken
+	oldValue      func(context.Context) (*Authorize, error)
+	predicates    []predicate.Authorize
 }
 
-var _ ent.Mutation = (*AuthorizeTokenMutation)(nil)
+var _ ent.Mutation = (*AuthorizeMutation)(nil)
 
-// authorizetokenOption allows management of the mutation configuration using functional options.
-type authorizetokenOption func(*AuthorizeTokenMutation)
+// authorizeOption allows management of the mutation configuration using functional options.
+type authorizeOption func(*AuthorizeMutation)
 
-// newAuthorizeTokenMutation creates new mutation for the AuthorizeToken entity.
-func newAuthorizeTokenMutation(c config, op Op, opts ...authorizetokenOption) *AuthorizeTokenMutation {
-	m := &AuthorizeTokenMutation{
+// newAuthorizeMutation creates new mutation for the Authorize entity.
+func newAuthorizeMutation(c config, op Op, opts ...authorizeOption) *AuthorizeMutation {
+	m := &AuthorizeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAuthorizeToken,
+		typ:           TypeAuthorize,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -725,20 +1185,20 @@ func newAuthorizeTokenMutation(c config, op Op, opts ...authorizetokenOption) *A
 	return m
 }
 
-// withAuthorizeTokenID sets the ID field of the mutation.
-func withAuthorizeTokenID(id int) authorizetokenOption {
-	return func(m *AuthorizeTokenMutation) {
+// withAuthorizeID sets the ID field of the mutation.
+func withAuthorizeID(id int) authorizeOption {
+	return func(m *AuthorizeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *AuthorizeToken
+			value *Authorize
 		)
-		m.oldValue = func(ctx context.Context) (*AuthorizeToken, error) {
+		m.oldValue = func(ctx context.Context) (*Authorize, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().AuthorizeToken.Get(ctx, id)
+					value, err = m.Client().Authorize.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -747,10 +1207,10 @@ func withAuthorizeTokenID(id int) authorizetokenOption {
 	}
 }
 
-// withAuthorizeToken sets the old AuthorizeToken of the mutation.
-func withAuthorizeToken(node *AuthorizeToken) authorizetokenOption {
-	return func(m *AuthorizeTokenMutation) {
-		m.oldValue = func(context.Context) (*AuthorizeToken, error) {
+// withAuthorize sets the old Authorize of the mutation.
+func withAuthorize(node *Authorize) authorizeOption {
+	return func(m *AuthorizeMutation) {
+		m.oldValue = func(context.Context) (*Authorize, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -759,7 +1219,7 @@ func withAuthorizeToken(node *AuthorizeToken) authorizetokenOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AuthorizeTokenMutation) Client() *Client {
+func (m AuthorizeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -767,7 +1227,7 @@ func (m AuthorizeTokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AuthorizeTokenMutation) Tx() (*Tx, error) {
+func (m AuthorizeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -778,7 +1238,7 @@ func (m AuthorizeTokenMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AuthorizeTokenMutation) ID() (id int, exists bool) {
+func (m *AuthorizeMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -789,7 +1249,7 @@ func (m *AuthorizeTokenMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AuthorizeTokenMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AuthorizeMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -798,55 +1258,19 @@ func (m *AuthorizeTokenMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().AuthorizeToken.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Authorize.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTeslaCode sets the "tesla_code" field.
-func (m *AuthorizeTokenMutation) SetTeslaCode(s string) {
-	m.tesla_code = &s
-}
-
-// TeslaCode returns the value of the "tesla_code" field in the mutation.
-func (m *AuthorizeTokenMutation) TeslaCode() (r string, exists bool) {
-	v := m.tesla_code
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTeslaCode returns the old "tesla_code" field's value of the AuthorizeToken entity.
-// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorizeTokenMutation) OldTeslaCode(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTeslaCode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTeslaCode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTeslaCode: %w", err)
-	}
-	return oldValue.TeslaCode, nil
-}
-
-// ResetTeslaCode resets all changes to the "tesla_code" field.
-func (m *AuthorizeTokenMutation) ResetTeslaCode() {
-	m.tesla_code = nil
-}
-
 // SetClientID sets the "client_id" field.
-func (m *AuthorizeTokenMutation) SetClientID(s string) {
+func (m *AuthorizeMutation) SetClientID(s string) {
 	m.client_id = &s
 }
 
 // ClientID returns the value of the "client_id" field in the mutation.
-func (m *AuthorizeTokenMutation) ClientID() (r string, exists bool) {
+func (m *AuthorizeMutation) ClientID() (r string, exists bool) {
 	v := m.client_id
 	if v == nil {
 		return
@@ -854,10 +1278,10 @@ func (m *AuthorizeTokenMutation) ClientID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldClientID returns the old "client_id" field's value of the AuthorizeToken entity.
-// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
+// OldClientID returns the old "client_id" field's value of the Authorize entity.
+// If the Authorize object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorizeTokenMutation) OldClientID(ctx context.Context) (v string, err error) {
+func (m *AuthorizeMutation) OldClientID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
 	}
@@ -872,17 +1296,17 @@ func (m *AuthorizeTokenMutation) OldClientID(ctx context.Context) (v string, err
 }
 
 // ResetClientID resets all changes to the "client_id" field.
-func (m *AuthorizeTokenMutation) ResetClientID() {
+func (m *AuthorizeMutation) ResetClientID() {
 	m.client_id = nil
 }
 
 // SetClientSecret sets the "client_secret" field.
-func (m *AuthorizeTokenMutation) SetClientSecret(s string) {
+func (m *AuthorizeMutation) SetClientSecret(s string) {
 	m.client_secret = &s
 }
 
 // ClientSecret returns the value of the "client_secret" field in the mutation.
-func (m *AuthorizeTokenMutation) ClientSecret() (r string, exists bool) {
+func (m *AuthorizeMutation) ClientSecret() (r string, exists bool) {
 	v := m.client_secret
 	if v == nil {
 		return
@@ -890,10 +1314,10 @@ func (m *AuthorizeTokenMutation) ClientSecret() (r string, exists bool) {
 	return *v, true
 }
 
-// OldClientSecret returns the old "client_secret" field's value of the AuthorizeToken entity.
-// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
+// OldClientSecret returns the old "client_secret" field's value of the Authorize entity.
+// If the Authorize object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorizeTokenMutation) OldClientSecret(ctx context.Context) (v string, err error) {
+func (m *AuthorizeMutation) OldClientSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldClientSecret is only allowed on UpdateOne operations")
 	}
@@ -908,125 +1332,89 @@ func (m *AuthorizeTokenMutation) OldClientSecret(ctx context.Context) (v string,
 }
 
 // ResetClientSecret resets all changes to the "client_secret" field.
-func (m *AuthorizeTokenMutation) ResetClientSecret() {
+func (m *AuthorizeMutation) ResetClientSecret() {
 	m.client_secret = nil
 }
 
-// SetAccessToken sets the "access_token" field.
-func (m *AuthorizeTokenMutation) SetAccessToken(s string) {
-	m.access_token = &s
-}
-
-// AccessToken returns the value of the "access_token" field in the mutation.
-func (m *AuthorizeTokenMutation) AccessToken() (r string, exists bool) {
-	v := m.access_token
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAccessToken returns the old "access_token" field's value of the AuthorizeToken entity.
-// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorizeTokenMutation) OldAccessToken(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccessToken is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccessToken requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccessToken: %w", err)
-	}
-	return oldValue.AccessToken, nil
-}
-
-// ResetAccessToken resets all changes to the "access_token" field.
-func (m *AuthorizeTokenMutation) ResetAccessToken() {
-	m.access_token = nil
-}
-
-// SetRefreshToken sets the "refresh_token" field.
-func (m *AuthorizeTokenMutation) SetRefreshToken(s string) {
-	m.refresh_token = &s
+// SetGrantType sets the "grant_type" field.
+func (m *AuthorizeMutation) SetGrantType(s string) {
+	m.grant_type = &s
 }
 
-// RefreshToken returns the value of the "refresh_token" field in the mutation.
-func (m *AuthorizeTokenMutation) RefreshToken() (r string, exists bool) {
-	v := m.refresh_token
+// GrantType returns the value of the "grant_type" field in the mutation.
+func (m *AuthorizeMutation) GrantType() (r string, exists bool) {
+	v := m.grant_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRefreshToken returns the old "refresh_token" field's value of the AuthorizeToken entity.
-// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
+// OldGrantType returns the old "grant_type" field's value of the Authorize entity.
+// If the Authorize object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorizeTokenMutation) OldRefreshToken(ctx context.Context) (v string, err error) {
+func (m *AuthorizeMutation) OldGrantType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRefreshToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldGrantType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRefreshToken requires an ID field in the mutation")
+		return v, errors.New("OldGrantType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRefreshToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldGrantType: %w", err)
 	}
-	return oldValue.RefreshToken, nil
+	return oldValue.GrantType, nil
 }
 
-// ResetRefreshToken resets all changes to the "refresh_token" field.
-func (m *AuthorizeTokenMutation) ResetRefreshToken() {
-	m.refresh_token = nil
+// ResetGrantType resets all changes to the "grant_type" field.
+func (m *AuthorizeMutation) ResetGrantType() {
+	m.grant_type = nil
 }
 
-// SetScope sets the "scope" field.
-func (m *AuthorizeTokenMutation) SetScope(s string) {
-	m.scope = &s
+// SetRedirectURI sets the "redirect_uri" field.
+func (m *AuthorizeMutation) SetRedirectURI(s string) {
+	m.redirect_uri = &s
 }
 
-// Scope returns the value of the "scope" field in the mutation.
-func (m *AuthorizeTokenMutation) Scope() (r string, exists bool) {
-	v := m.scope
+// RedirectURI returns the value of the "redirect_uri" field in the mutation.
+func (m *AuthorizeMutation) RedirectURI() (r string, exists bool) {
+	v := m.redirect_uri
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScope returns the old "scope" field's value of the AuthorizeToken entity.
-// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
+// OldRedirectURI returns the old "redirect_uri" field's value of the Authorize entity.
+// If the Authorize object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorizeTokenMutation) OldScope(ctx context.Context) (v string, err error) {
+func (m *AuthorizeMutation) OldRedirectURI(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+		return v, errors.New("OldRedirectURI is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScope requires an ID field in the mutation")
+		return v, errors.New("OldRedirectURI requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+		return v, fmt.Errorf("querying old value for OldRedirectURI: %w", err)
 	}
-	return oldValue.Scope, nil
+	return oldValue.RedirectURI, nil
 }
 
-// ResetScope resets all changes to the "scope" field.
-func (m *AuthorizeTokenMutation) ResetScope() {
-	m.scope = nil
+// ResetRedirectURI resets all changes to the "redirect_uri" field.
+func (m *AuthorizeMutation) ResetRedirectURI() {
+	m.redirect_uri = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *AuthorizeTokenMutation) SetCreatedAt(t time.Time) {
+func (m *AuthorizeMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *AuthorizeTokenMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AuthorizeMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1034,10 +1422,10 @@ func (m *AuthorizeTokenMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the AuthorizeToken entity.
-// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Authorize entity.
+// If the Authorize object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorizeTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuthorizeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1052,17 +1440,17 @@ func (m *AuthorizeTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *AuthorizeTokenMutation) ResetCreatedAt() {
+func (m *AuthorizeMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *AuthorizeTokenMutation) SetUpdatedAt(t time.Time) {
+func (m *AuthorizeMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *AuthorizeTokenMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *AuthorizeMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1070,10 +1458,10 @@ func (m *AuthorizeTokenMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the AuthorizeToken entity.
-// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Authorize entity.
+// If the Authorize object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorizeTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuthorizeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1088,17 +1476,17 @@ func (m *AuthorizeTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *AuthorizeTokenMutation) ResetUpdatedAt() {
+func (m *AuthorizeMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeleted sets the "deleted" field.
-func (m *AuthorizeTokenMutation) SetDeleted(b bool) {
+func (m *AuthorizeMutation) SetDeleted(b bool) {
 	m.deleted = &b
 }
 
 // Deleted returns the value of the "deleted" field in the mutation.
-func (m *AuthorizeTokenMutation) Deleted() (r bool, exists bool) {
+func (m *AuthorizeMutation) Deleted() (r bool, exists bool) {
 	v := m.deleted
 	if v == nil {
 		return
@@ -1106,10 +1494,10 @@ func (m *AuthorizeTokenMutation) Deleted() (r bool, exists bool) {
 	return *v, true
 }
 
-// OldDeleted returns the old "deleted" field's value of the AuthorizeToken entity.
-// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
+// OldDeleted returns the old "deleted" field's value of the Authorize entity.
+// If the Authorize object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthorizeTokenMutation) OldDeleted(ctx context.Context) (v bool, err error) {
+func (m *AuthorizeMutation) OldDeleted(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeleted is only allowed on UpdateOne operations")
 	}
@@ -1124,19 +1512,19 @@ func (m *AuthorizeTokenMutation) OldDeleted(ctx context.Context) (v bool, err er
 }
 
 // ResetDeleted resets all changes to the "deleted" field.
-func (m *AuthorizeTokenMutation) ResetDeleted() {
+func (m *AuthorizeMutation) ResetDeleted() {
 	m.deleted = nil
 }
 
-// Where appends a list predicates to the AuthorizeTokenMutation builder.
-func (m *AuthorizeTokenMutation) Where(ps ...predicate.AuthorizeToken) {
-	m.predicates = append(m.predicates, ps...)
+// Where appends a list predicates to the AuthorizeMutation builder.
+func (m *AuthorizeMutation) Where(ps ...predicate.Authorize) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the AuthorizeTokenMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuthorizeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuthorizeTokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AuthorizeToken, len(ps))
+func (m *AuthorizeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Authorize, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1144,51 +1532,45 @@ func (m *AuthorizeTokenMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *AuthorizeTokenMutation) Op() Op {
+func (m *AuthorizeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AuthorizeTokenMutation) SetOp(op Op) {
+func (m *AuthorizeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (AuthorizeToken).
-func (m *AuthorizeTokenMutation) Type() string {
+// Type returns the node type of this mutation (Authorize).
+func (m *AuthorizeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *AuthorizeTokenMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.tesla_code != nil {
-		fields = append(fields, authorizetoken.FieldTeslaCode)
-	}
+func (m *AuthorizeMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.client_id != nil {
-		fields = append(fields, authorizetoken.FieldClientID)
+		fields = append(fields, authorize.FieldClientID)
 	}
 	if m.client_secret != nil {
-		fields = append(fields, authorizetoken.FieldClientSecret)
-	}
-	if m.access_token != nil {
-		fields = append(fields, authorizetoken.FieldAccessToken)
+		fields = append(fields, authorize.FieldClientSecret)
 	}
-	if m.refresh_token != nil {
-		fields = append(fields, authorizetoken.FieldRefreshToken)
+	if m.grant_type != nil {
+		fields = append(fields, authorize.FieldGrantType)
 	}
-	if m.scope != nil {
-		fields = append(fields, authorizetoken.FieldScope)
+	if m.redirect_uri != nil {
+		fields = append(fields, authorize.FieldRedirectURI)
 	}
 	if m.created_at != nil {
-		fields = append(fields, authorizetoken.FieldCreatedAt)
+		fields = append(fields, authorize.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, authorizetoken.FieldUpdatedAt)
+		fields = append(fields, authorize.FieldUpdatedAt)
 	}
 	if m.deleted != nil {
-		fields = append(fields, authorizetoken.FieldDeleted)
+		fields = append(fields, authorize.FieldDeleted)
 	}
 	return fields
 }
@@ -1196,25 +1578,21 @@ func (m *AuthorizeTokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AuthorizeTokenMutation) Field(name string) (ent.Value, bool) {
+func (m *AuthorizeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case authorizetoken.FieldTeslaCode:
-		return m.TeslaCode()
-	case authorizetoken.FieldClientID:
+	case authorize.FieldClientID:
 		return m.ClientID()
-	case authorizetoken.FieldClientSecret:
+	case authorize.FieldClientSecret:
 		return m.ClientSecret()
-	case authorizetoken.FieldAccessToken:
-		return m.AccessToken()
-	case authorizetoken.FieldRefreshToken:
-		return m.RefreshToken()
-	case authorizetoken.FieldScope:
-		return m.Scope()
-	case authorizetoken.FieldCreatedAt:
+	case authorize.FieldGrantType:
+		return m.GrantType()
+	case authorize.FieldRedirectURI:
+		return m.RedirectURI()
+	case authorize.FieldCreatedAt:
 		return m.CreatedAt()
-	case authorizetoken.FieldUpdatedAt:
+	case authorize.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case authorizetoken.FieldDeleted:
+	case authorize.FieldDeleted:
 		return m.Deleted()
 	}
 	return nil, false
@@ -1223,92 +1601,74 @@ func (m *AuthorizeTokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AuthorizeTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuthorizeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case authorizetoken.FieldTeslaCode:
-		return m.OldTeslaCode(ctx)
-	case authorizetoken.FieldClientID:
+	case authorize.FieldClientID:
 		return m.OldClientID(ctx)
-	case authorizetoken.FieldClientSecret:
+	case authorize.FieldClientSecret:
 		return m.OldClientSecret(ctx)
-	case authorizetoken.FieldAccessToken:
-		return m.OldAccessToken(ctx)
-	case authorizetoken.FieldRefreshToken:
-		return m.OldRefreshToken(ctx)
-	case authorizetoken.FieldScope:
-		return m.OldScope(ctx)
-	case authorizetoken.FieldCreatedAt:
+	case authorize.FieldGrantType:
+		return m.OldGrantType(ctx)
+	case authorize.FieldRedirectURI:
+		return m.OldRedirectURI(ctx)
+	case authorize.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case authorizetoken.FieldUpdatedAt:
+	case authorize.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case authorizetoken.FieldDeleted:
+	case authorize.FieldDeleted:
 		return m.OldDeleted(ctx)
 	}
-	return nil, fmt.Errorf("unknown AuthorizeToken field %s", name)
+	return nil, fmt.Errorf("unknown Authorize field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthorizeTokenMutation) SetField(name string, value ent.Value) error {
+func (m *AuthorizeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case authorizetoken.FieldTeslaCode:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTeslaCode(v)
-		return nil
-	case authorizetoken.FieldClientID:
+	case authorize.FieldClientID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClientID(v)
 		return nil
-	case authorizetoken.FieldClientSecret:
+	case authorize.FieldClientSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClientSecret(v)
 		return nil
-	case authorizetoken.FieldAccessToken:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAccessToken(v)
-		return nil
-	case authorizetoken.FieldRefreshToken:
+	case authorize.FieldGrantType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRefreshToken(v)
+		m.SetGrantType(v)
 		return nil
-	case authorizetoken.FieldScope:
+	case authorize.FieldRedirectURI:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScope(v)
+		m.SetRedirectURI(v)
 		return nil
-	case authorizetoken.FieldCreatedAt:
+	case authorize.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case authorizetoken.FieldUpdatedAt:
+	case authorize.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case authorizetoken.FieldDeleted:
+	case authorize.FieldDeleted:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1316,164 +1676,161 @@ func (m *AuthorizeTokenMutation) SetField(name string, value ent.Value) error {
 		m.SetDeleted(v)
 		return nil
 	}
-	return fmt.Errorf("unknown AuthorizeToken field %s", name)
+	return fmt.Errorf("unknown Authorize field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AuthorizeTokenMutation) AddedFields() []string {
+func (m *AuthorizeMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AuthorizeTokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuthorizeMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthorizeTokenMutation) AddField(name string, value ent.Value) error {
+func (m *AuthorizeMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown AuthorizeToken numeric field %s", name)
+	return fmt.Errorf("unknown Authorize numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AuthorizeTokenMutation) ClearedFields() []string {
+func (m *AuthorizeMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AuthorizeTokenMutation) FieldCleared(name string) bool {
+func (m *AuthorizeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AuthorizeTokenMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown AuthorizeToken nullable field %s", name)
+func (m *AuthorizeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Authorize nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AuthorizeTokenMutation) ResetField(name string) error {
+func (m *AuthorizeMutation) ResetField(name string) error {
 	switch name {
-	case authorizetoken.FieldTeslaCode:
-		m.ResetTeslaCode()
-		return nil
-	case authorizetoken.FieldClientID:
+	case authorize.FieldClientID:
 		m.ResetClientID()
 		return nil
-	case authorizetoken.FieldClientSecret:
+	case authorize.FieldClientSecret:
 		m.ResetClientSecret()
 		return nil
-	case authorizetoken.FieldAccessToken:
-		m.ResetAccessToken()
-		return nil
-	case authorizetoken.FieldRefreshToken:
-		m.ResetRefreshToken()
+	case authorize.FieldGrantType:
+		m.ResetGrantType()
 		return nil
-	case authorizetoken.FieldScope:
-		m.ResetScope()
+	case authorize.FieldRedirectURI:
+		m.ResetRedirectURI()
 		return nil
-	case authorizetoken.FieldCreatedAt:
+	case authorize.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case authorizetoken.FieldUpdatedAt:
+	case authorize.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case authorizetoken.FieldDeleted:
+	case authorize.FieldDeleted:
 		m.ResetDeleted()
 		return nil
 	}
-	return fmt.Errorf("unknown AuthorizeToken field %s", name)
+	return fmt.Errorf("unknown Authorize field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuthorizeTokenMutation) AddedEdges() []string {
+func (m *AuthorizeMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AuthorizeTokenMutation) AddedIDs(name string) []ent.Value {
+func (m *AuthorizeMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuthorizeTokenMutation) RemovedEdges() []string {
+func (m *AuthorizeMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AuthorizeTokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuthorizeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuthorizeTokenMutation) ClearedEdges() []string {
+func (m *AuthorizeMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AuthorizeTokenMutation) EdgeCleared(name string) bool {
+func (m *AuthorizeMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AuthorizeTokenMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown AuthorizeToken unique edge %s", name)
+func (m *AuthorizeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Authorize unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AuthorizeTokenMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown AuthorizeToken edge %s", name)
+func (m *AuthorizeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Authorize edge %s", name)
 }
 
-// PartnerMutation represents an operation that mutates the Partner nodes in the graph.
-type PartnerMutation struct {
+// AuthorizeTokenMutation represents an operation that mutates the AuthorizeToken nodes in the graph.
+type AuthorizeTokenMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
+	tesla_code    *string
 	client_id     *string
+	client_secret *string
 	access_token  *string
-	expires_in    *int
-	addexpires_in *int
-	token_type    *string
+	refresh_token *string
+	scope         *string
+	user_id       *int
+	adduser_id    *int
 	created_at    *time.Time
 	updated_at    *time.Time
 	deleted       *bool
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Partner, error)
-	predicates    []predicate.Partner
+	oldValue      func(context.Context) (*AuthorizeToken, error)
+	predicates    []predicate.AuthorizeToken
 }
 
-var _ ent.Mutation = (*PartnerMutation)(nil)
+var _ ent.Mutation = (*AuthorizeTokenMutation)(nil)
 
-// partnerOption allows management of the mutation configuration using functional options.
-type partnerOption func(*PartnerMutation)
+// authorizetokenOption allows management of the mutation configuration using functional options.
+type authorizetokenOption func(*AuthorizeTokenMutation)
 
-// newPartnerMutation creates new mutation for the Partner entity.
-func newPartnerMutation(c config, op Op, opts ...partnerOption) *PartnerMutation {
-	m := &PartnerMutation{
+// newAuthorizeTokenMutation creates new mutation for the AuthorizeToken entity.
+func newAuthorizeTokenMutation(c config, op Op, opts ...authorizetokenOption) *AuthorizeTokenMutation {
+	m := &AuthorizeTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePartner,
+		typ:           TypeAuthorizeToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1482,20 +1839,20 @@ func newPartnerMutation(c config, op Op, opts ...partnerOption) *PartnerMutation
 	return m
 }
 
-// withPartnerID sets the ID field of the mutation.
-func withPartnerID(id int) partnerOption {
-	return func(m *PartnerMutation) {
+// withAuthorizeTokenID sets the ID field of the mutation.
+func withAuthorizeTokenID(id int) authorizetokenOption {
+	return func(m *AuthorizeTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Partner
+			value *AuthorizeToken
 		)
-		m.oldValue = func(ctx context.Context) (*Partner, error) {
+		m.oldValue = func(ctx context.Context) (*AuthorizeToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Partner.Get(ctx, id)
+					value, err = m.Client().AuthorizeToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1504,10 +1861,10 @@ func withPartnerID(id int) partnerOption {
 	}
 }
 
-// withPartner sets the old Partner of the mutation.
-func withPartner(node *Partner) partnerOption {
-	return func(m *PartnerMutation) {
-		m.oldValue = func(context.Context) (*Partner, error) {
+// withAuthorizeToken sets the old AuthorizeToken of the mutation.
+func withAuthorizeToken(node *AuthorizeToken) authorizetokenOption {
+	return func(m *AuthorizeTokenMutation) {
+		m.oldValue = func(context.Context) (*AuthorizeToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1516,7 +1873,7 @@ func withPartner(node *Partner) partnerOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PartnerMutation) Client() *Client {
+func (m AuthorizeTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1524,7 +1881,7 @@ func (m PartnerMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PartnerMutation) Tx() (*Tx, error) {
+func (m AuthorizeTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1535,7 +1892,7 @@ func (m PartnerMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PartnerMutation) ID() (id int, exists bool) {
+func (m *AuthorizeTokenMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1546,7 +1903,7 @@ func (m *PartnerMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PartnerMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AuthorizeTokenMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1555,295 +1912,377 @@ func (m *PartnerMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Partner.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuthorizeToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetClientID sets the "client_id" field.
-func (m *PartnerMutation) SetClientID(s string) {
-	m.client_id = &s
+// SetTeslaCode sets the "tesla_code" field.
+func (m *AuthorizeTokenMutation) SetTeslaCode(s string) {
+	m.tesla_code = &s
 }
 
-// ClientID returns the value of the "client_id" field in the mutation.
-func (m *PartnerMutation) ClientID() (r string, exists bool) {
-	v := m.client_id
+// TeslaCode returns the value of the "tesla_code" field in the mutation.
+func (m *AuthorizeTokenMutation) TeslaCode() (r string, exists bool) {
+	v := m.tesla_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientID returns the old "client_id" field's value of the Partner entity.
-// If the Partner object wasn't provided to the builder, the object is fetched from the database.
+// OldTeslaCode returns the old "tesla_code" field's value of the AuthorizeToken entity.
+// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PartnerMutation) OldClientID(ctx context.Context) (v string, err error) {
+func (m *AuthorizeTokenMutation) OldTeslaCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTeslaCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientID requires an ID field in the mutation")
+		return v, errors.New("OldTeslaCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTeslaCode: %w", err)
 	}
-	return oldValue.ClientID, nil
+	return oldValue.TeslaCode, nil
 }
 
-// ResetClientID resets all changes to the "client_id" field.
-func (m *PartnerMutation) ResetClientID() {
-	m.client_id = nil
+// ResetTeslaCode resets all changes to the "tesla_code" field.
+func (m *AuthorizeTokenMutation) ResetTeslaCode() {
+	m.tesla_code = nil
 }
 
-// SetAccessToken sets the "access_token" field.
-func (m *PartnerMutation) SetAccessToken(s string) {
-	m.access_token = &s
+// SetClientID sets the "client_id" field.
+func (m *AuthorizeTokenMutation) SetClientID(s string) {
+	m.client_id = &s
 }
 
-// AccessToken returns the value of the "access_token" field in the mutation.
-func (m *PartnerMutation) AccessToken() (r string, exists bool) {
-	v := m.access_token
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *AuthorizeTokenMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAccessToken returns the old "access_token" field's value of the Partner entity.
-// If the Partner object wasn't provided to the builder, the object is fetched from the database.
+// OldClientID returns the old "client_id" field's value of the AuthorizeToken entity.
+// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PartnerMutation) OldAccessToken(ctx context.Context) (v string, err error) {
+func (m *AuthorizeTokenMutation) OldClientID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccessToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccessToken requires an ID field in the mutation")
+		return v, errors.New("OldClientID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccessToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
 	}
-	return oldValue.AccessToken, nil
-}
-
-// ClearAccessToken clears the value of the "access_token" field.
-func (m *PartnerMutation) ClearAccessToken() {
-	m.access_token = nil
-	m.clearedFields[partner.FieldAccessToken] = struct{}{}
-}
-
-// AccessTokenCleared returns if the "access_token" field was cleared in this mutation.
-func (m *PartnerMutation) AccessTokenCleared() bool {
-	_, ok := m.clearedFields[partner.FieldAccessToken]
-	return ok
+	return oldValue.ClientID, nil
 }
 
-// ResetAccessToken resets all changes to the "access_token" field.
-func (m *PartnerMutation) ResetAccessToken() {
-	m.access_token = nil
-	delete(m.clearedFields, partner.FieldAccessToken)
+// ResetClientID resets all changes to the "client_id" field.
+func (m *AuthorizeTokenMutation) ResetClientID() {
+	m.client_id = nil
 }
 
-// SetExpiresIn sets the "expires_in" field.
-func (m *PartnerMutation) SetExpiresIn(i int) {
-	m.expires_in = &i
-	m.addexpires_in = nil
+// SetClientSecret sets the "client_secret" field.
+func (m *AuthorizeTokenMutation) SetClientSecret(s string) {
+	m.client_secret = &s
 }
 
-// ExpiresIn returns the value of the "expires_in" field in the mutation.
-func (m *PartnerMutation) ExpiresIn() (r int, exists bool) {
-	v := m.expires_in
+// ClientSecret returns the value of the "client_secret" field in the mutation.
+func (m *AuthorizeTokenMutation) ClientSecret() (r string, exists bool) {
+	v := m.client_secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresIn returns the old "expires_in" field's value of the Partner entity.
-// If the Partner object wasn't provided to the builder, the object is fetched from the database.
+// OldClientSecret returns the old "client_secret" field's value of the AuthorizeToken entity.
+// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PartnerMutation) OldExpiresIn(ctx context.Context) (v int, err error) {
+func (m *AuthorizeTokenMutation) OldClientSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresIn is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresIn requires an ID field in the mutation")
+		return v, errors.New("OldClientSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresIn: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientSecret: %w", err)
 	}
-	return oldValue.ExpiresIn, nil
+	return oldValue.ClientSecret, nil
 }
 
-// AddExpiresIn adds i to the "expires_in" field.
-func (m *PartnerMutation) AddExpiresIn(i int) {
-	if m.addexpires_in != nil {
-		*m.addexpires_in += i
-	} else {
-		m.addexpires_in = &i
-	}
+// ResetClientSecret resets all changes to the "client_secret" field.
+func (m *AuthorizeTokenMutation) ResetClientSecret() {
+	m.client_secret = nil
 }
 
-// AddedExpiresIn returns the value that was added to the "expires_in" field in this mutation.
-func (m *PartnerMutation) AddedExpiresIn() (r int, exists bool) {
-	v := m.addexpires_in
+// SetAccessToken sets the "access_token" field.
+func (m *AuthorizeTokenMutation) SetAccessToken(s string) {
+	m.access_token = &s
+}
+
+// AccessToken returns the value of the "access_token" field in the mutation.
+func (m *AuthorizeTokenMutation) AccessToken() (r string, exists bool) {
+	v := m.access_token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearExpiresIn clears the value of the "expires_in" field.
-func (m *PartnerMutation) ClearExpiresIn() {
-	m.expires_in = nil
-	m.addexpires_in = nil
-	m.clearedFields[partner.FieldExpiresIn] = struct{}{}
-}
-
-// ExpiresInCleared returns if the "expires_in" field was cleared in this mutation.
-func (m *PartnerMutation) ExpiresInCleared() bool {
-	_, ok := m.clearedFields[partner.FieldExpiresIn]
-	return ok
+// OldAccessToken returns the old "access_token" field's value of the AuthorizeToken entity.
+// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthorizeTokenMutation) OldAccessToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessToken: %w", err)
+	}
+	return oldValue.AccessToken, nil
 }
 
-// ResetExpiresIn resets all changes to the "expires_in" field.
-func (m *PartnerMutation) ResetExpiresIn() {
-	m.expires_in = nil
-	m.addexpires_in = nil
-	delete(m.clearedFields, partner.FieldExpiresIn)
+// ResetAccessToken resets all changes to the "access_token" field.
+func (m *AuthorizeTokenMutation) ResetAccessToken() {
+	m.access_token = nil
 }
 
-// SetTokenType sets the "token_type" field.
-func (m *PartnerMutation) SetTokenType(s string) {
-	m.token_type = &s
+// SetRefreshToken sets the "refresh_token" field.
+func (m *AuthorizeTokenMutation) SetRefreshToken(s string) {
+	m.refresh_token = &s
 }
 
-// TokenType returns the value of the "token_type" field in the mutation.
-func (m *PartnerMutation) TokenType() (r string, exists bool) {
-	v := m.token_type
+// RefreshToken returns the value of the "refresh_token" field in the mutation.
+func (m *AuthorizeTokenMutation) RefreshToken() (r string, exists bool) {
+	v := m.refresh_token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokenType returns the old "token_type" field's value of the Partner entity.
-// If the Partner object wasn't provided to the builder, the object is fetched from the database.
+// OldRefreshToken returns the old "refresh_token" field's value of the AuthorizeToken entity.
+// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PartnerMutation) OldTokenType(ctx context.Context) (v string, err error) {
+func (m *AuthorizeTokenMutation) OldRefreshToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenType is only allowed on UpdateOne operations")
+		return v, errors.New("OldRefreshToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenType requires an ID field in the mutation")
+		return v, errors.New("OldRefreshToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenType: %w", err)
+		return v, fmt.Errorf("querying old value for OldRefreshToken: %w", err)
 	}
-	return oldValue.TokenType, nil
-}
-
-// ClearTokenType clears the value of the "token_type" field.
-func (m *PartnerMutation) ClearTokenType() {
-	m.token_type = nil
-	m.clearedFields[partner.FieldTokenType] = struct{}{}
-}
-
-// TokenTypeCleared returns if the "token_type" field was cleared in this mutation.
-func (m *PartnerMutation) TokenTypeCleared() bool {
-	_, ok := m.clearedFields[partner.FieldTokenType]
-	return ok
+	return oldValue.RefreshToken, nil
 }
 
-// ResetTokenType resets all changes to the "token_type" field.
-func (m *PartnerMutation) ResetTokenType() {
-	m.token_type = nil
-	delete(m.clearedFields, partner.FieldTokenType)
+// ResetRefreshToken resets all changes to the "refresh_token" field.
+func (m *AuthorizeTokenMutation) ResetRefreshToken() {
+	m.refresh_token = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PartnerMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetScope sets the "scope" field.
+func (m *AuthorizeTokenMutation) SetScope(s string) {
+	m.scope = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PartnerMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Scope returns the value of the "scope" field in the mutation.
+func (m *AuthorizeTokenMutation) Scope() (r string, exists bool) {
+	v := m.scope
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Partner entity.
-// If the Partner object wasn't provided to the builder, the object is fetched from the database.
+// OldScope returns the old "scope" field's value of the AuthorizeToken entity.
+// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PartnerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuthorizeTokenMutation) OldScope(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldScope requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Scope, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PartnerMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetScope resets all changes to the "scope" field.
+func (m *AuthorizeTokenMutation) ResetScope() {
+	m.scope = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PartnerMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetUserID sets the "user_id" field.
+func (m *AuthorizeTokenMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PartnerMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AuthorizeTokenMutation) UserID() (r int, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Partner entity.
-// If the Partner object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the AuthorizeToken entity.
+// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PartnerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuthorizeTokenMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *AuthorizeTokenMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *AuthorizeTokenMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *AuthorizeTokenMutation) ClearUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	m.clearedFields[authorizetoken.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *AuthorizeTokenMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[authorizetoken.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AuthorizeTokenMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	delete(m.clearedFields, authorizetoken.FieldUserID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AuthorizeTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuthorizeTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AuthorizeToken entity.
+// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthorizeTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuthorizeTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *AuthorizeTokenMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *AuthorizeTokenMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the AuthorizeToken entity.
+// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthorizeTokenMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PartnerMutation) ResetUpdatedAt() {
+func (m *AuthorizeTokenMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetDeleted sets the "deleted" field.
-func (m *PartnerMutation) SetDeleted(b bool) {
+func (m *AuthorizeTokenMutation) SetDeleted(b bool) {
 	m.deleted = &b
 }
 
 // Deleted returns the value of the "deleted" field in the mutation.
-func (m *PartnerMutation) Deleted() (r bool, exists bool) {
+func (m *AuthorizeTokenMutation) Deleted() (r bool, exists bool) {
 	v := m.deleted
 	if v == nil {
 		return
@@ -1851,10 +2290,10 @@ func (m *PartnerMutation) Deleted() (r bool, exists bool) {
 	return *v, true
 }
 
-// OldDeleted returns the old "deleted" field's value of the Partner entity.
-// If the Partner object wasn't provided to the builder, the object is fetched from the database.
+// OldDeleted returns the old "deleted" field's value of the AuthorizeToken entity.
+// If the AuthorizeToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PartnerMutation) OldDeleted(ctx context.Context) (v bool, err error) {
+func (m *AuthorizeTokenMutation) OldDeleted(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeleted is only allowed on UpdateOne operations")
 	}
@@ -1869,19 +2308,19 @@ func (m *PartnerMutation) OldDeleted(ctx context.Context) (v bool, err error) {
 }
 
 // ResetDeleted resets all changes to the "deleted" field.
-func (m *PartnerMutation) ResetDeleted() {
+func (m *AuthorizeTokenMutation) ResetDeleted() {
 	m.deleted = nil
 }
 
-// Where appends a list predicates to the PartnerMutation builder.
-func (m *PartnerMutation) Where(ps ...predicate.Partner) {
+// Where appends a list predicates to the AuthorizeTokenMutation builder.
+func (m *AuthorizeTokenMutation) Where(ps ...predicate.AuthorizeToken) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PartnerMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuthorizeTokenMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PartnerMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Partner, len(ps))
+func (m *AuthorizeTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuthorizeToken, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1889,45 +2328,54 @@ func (m *PartnerMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PartnerMutation) Op() Op {
+func (m *AuthorizeTokenMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PartnerMutation) SetOp(op Op) {
+func (m *AuthorizeTokenMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Partner).
-func (m *PartnerMutation) Type() string {
+// Type returns the node type of this mutation (AuthorizeToken).
+func (m *AuthorizeTokenMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PartnerMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *AuthorizeTokenMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.tesla_code != nil {
+		fields = append(fields, authorizetoken.FieldTeslaCode)
+	}
 	if m.client_id != nil {
-		fields = append(fields, partner.FieldClientID)
+		fields = append(fields, authorizetoken.FieldClientID)
+	}
+	if m.client_secret != nil {
+		fields = append(fields, authorizetoken.FieldClientSecret)
 	}
 	if m.access_token != nil {
-		fields = append(fields, partner.FieldAccessToken)
+		fields = append(fields, authorizetoken.FieldAccessToken)
 	}
-	if m.expires_in != nil {
-		fields = append(fields, partner.FieldExpiresIn)
+	if m.refresh_token != nil {
+		fields = append(fields, authorizetoken.FieldRefreshToken)
 	}
-	if m.token_type != nil {
-		fields = append(fields, partner.FieldTokenType)
+	if m.scope != nil {
+		fields = append(fields, authorizetoken.FieldScope)
+	}
+	if m.user_id != nil {
+		fields = append(fields, authorizetoken.FieldUserID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, partner.FieldCreatedAt)
+		fields = append(fields, authorizetoken.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, partner.FieldUpdatedAt)
+		fields = append(fields, authorizetoken.FieldUpdatedAt)
 	}
 	if m.deleted != nil {
-		fields = append(fields, partner.FieldDeleted)
+		fields = append(fields, authorizetoken.FieldDeleted)
 	}
 	return fields
 }
@@ -1935,21 +2383,27 @@ func (m *PartnerMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PartnerMutation) Field(name string) (ent.Value, bool) {
+func (m *AuthorizeTokenMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case partner.FieldClientID:
+	case authorizetoken.FieldTeslaCode:
+		return m.TeslaCode()
+	case authorizetoken.FieldClientID:
 		return m.ClientID()
-	case partner.FieldAccessToken:
+	case authorizetoken.FieldClientSecret:
+		return m.ClientSecret()
+	case authorizetoken.FieldAccessToken:
 		return m.AccessToken()
-	case partner.FieldExpiresIn:
-		return m.ExpiresIn()
-	case partner.FieldTokenType:
-		return m.TokenType()
-	case partner.FieldCreatedAt:
+	case authorizetoken.FieldRefreshToken:
+		return m.RefreshToken()
+	case authorizetoken.FieldScope:
+		return m.Scope()
+	case authorizetoken.FieldUserID:
+		return m.UserID()
+	case authorizetoken.FieldCreatedAt:
 		return m.CreatedAt()
-	case partner.FieldUpdatedAt:
+	case authorizetoken.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case partner.FieldDeleted:
+	case authorizetoken.FieldDeleted:
 		return m.Deleted()
 	}
 	return nil, false
@@ -1958,74 +2412,101 @@ func (m *PartnerMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PartnerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuthorizeTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case partner.FieldClientID:
+	case authorizetoken.FieldTeslaCode:
+		return m.OldTeslaCode(ctx)
+	case authorizetoken.FieldClientID:
 		return m.OldClientID(ctx)
-	case partner.FieldAccessToken:
+	case authorizetoken.FieldClientSecret:
+		return m.OldClientSecret(ctx)
+	case authorizetoken.FieldAccessToken:
 		return m.OldAccessToken(ctx)
-	case partner.FieldExpiresIn:
-		return m.OldExpiresIn(ctx)
-	case partner.FieldTokenType:
-		return m.OldTokenType(ctx)
-	case partner.FieldCreatedAt:
+	case authorizetoken.FieldRefreshToken:
+		return m.OldRefreshToken(ctx)
+	case authorizetoken.FieldScope:
+		return m.OldScope(ctx)
+	case authorizetoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case authorizetoken.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case partner.FieldUpdatedAt:
+	case authorizetoken.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case partner.FieldDeleted:
+	case authorizetoken.FieldDeleted:
 		return m.OldDeleted(ctx)
 	}
-	return nil, fmt.Errorf("unknown Partner field %s", name)
+	return nil, fmt.Errorf("unknown AuthorizeToken field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PartnerMutation) SetField(name string, value ent.Value) error {
+func (m *AuthorizeTokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case partner.FieldClientID:
+	case authorizetoken.FieldTeslaCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeslaCode(v)
+		return nil
+	case authorizetoken.FieldClientID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetClientID(v)
 		return nil
-	case partner.FieldAccessToken:
+	case authorizetoken.FieldClientSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientSecret(v)
+		return nil
+	case authorizetoken.FieldAccessToken:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetAccessToken(v)
 		return nil
-	case partner.FieldExpiresIn:
-		v, ok := value.(int)
+	case authorizetoken.FieldRefreshToken:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiresIn(v)
+		m.SetRefreshToken(v)
 		return nil
-	case partner.FieldTokenType:
+	case authorizetoken.FieldScope:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTokenType(v)
+		m.SetScope(v)
 		return nil
-	case partner.FieldCreatedAt:
+	case authorizetoken.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case authorizetoken.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case partner.FieldUpdatedAt:
+	case authorizetoken.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case partner.FieldDeleted:
+	case authorizetoken.FieldDeleted:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2033,15 +2514,15 @@ func (m *PartnerMutation) SetField(name string, value ent.Value) error {
 		m.SetDeleted(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Partner field %s", name)
+	return fmt.Errorf("unknown AuthorizeToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PartnerMutation) AddedFields() []string {
+func (m *AuthorizeTokenMutation) AddedFields() []string {
 	var fields []string
-	if m.addexpires_in != nil {
-		fields = append(fields, partner.FieldExpiresIn)
+	if m.adduser_id != nil {
+		fields = append(fields, authorizetoken.FieldUserID)
 	}
 	return fields
 }
@@ -2049,10 +2530,10 @@ func (m *PartnerMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PartnerMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuthorizeTokenMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case partner.FieldExpiresIn:
-		return m.AddedExpiresIn()
+	case authorizetoken.FieldUserID:
+		return m.AddedUserID()
 	}
 	return nil, false
 }
@@ -2060,174 +2541,171 @@ func (m *PartnerMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PartnerMutation) AddField(name string, value ent.Value) error {
+func (m *AuthorizeTokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case partner.FieldExpiresIn:
+	case authorizetoken.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddExpiresIn(v)
+		m.AddUserID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Partner numeric field %s", name)
+	return fmt.Errorf("unknown AuthorizeToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PartnerMutation) ClearedFields() []string {
+func (m *AuthorizeTokenMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(partner.FieldAccessToken) {
-		fields = append(fields, partner.FieldAccessToken)
-	}
-	if m.FieldCleared(partner.FieldExpiresIn) {
-		fields = append(fields, partner.FieldExpiresIn)
-	}
-	if m.FieldCleared(partner.FieldTokenType) {
-		fields = append(fields, partner.FieldTokenType)
+	if m.FieldCleared(authorizetoken.FieldUserID) {
+		fields = append(fields, authorizetoken.FieldUserID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PartnerMutation) FieldCleared(name string) bool {
+func (m *AuthorizeTokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PartnerMutation) ClearField(name string) error {
+func (m *AuthorizeTokenMutation) ClearField(name string) error {
 	switch name {
-	case partner.FieldAccessToken:
-		m.ClearAccessToken()
-		return nil
-	case partner.FieldExpiresIn:
-		m.ClearExpiresIn()
-		return nil
-	case partner.FieldTokenType:
-		m.ClearTokenType()
+	case authorizetoken.FieldUserID:
+		m.ClearUserID()
 		return nil
 	}
-	return fmt.Errorf("unknown Partner nullable field %s", name)
+	return fmt.Errorf("unknown AuthorizeToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PartnerMutation) ResetField(name string) error {
+func (m *AuthorizeTokenMutation) ResetField(name string) error {
 	switch name {
-	case partner.FieldClientID:
+	case authorizetoken.FieldTeslaCode:
+		m.ResetTeslaCode()
+		return nil
+	case authorizetoken.FieldClientID:
 		m.ResetClientID()
 		return nil
-	case partner.FieldAccessToken:
+	case authorizetoken.FieldClientSecret:
+		m.ResetClientSecret()
+		return nil
+	case authorizetoken.FieldAccessToken:
 		m.ResetAccessToken()
 		return nil
-	case partner.FieldExpiresIn:
-		m.ResetExpiresIn()
+	case authorizetoken.FieldRefreshToken:
+		m.ResetRefreshToken()
 		return nil
-	case partner.FieldTokenType:
-		m.ResetTokenType()
+	case authorizetoken.FieldScope:
+		m.ResetScope()
 		return nil
-	case partner.FieldCreatedAt:
+	case authorizetoken.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case authorizetoken.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case partner.FieldUpdatedAt:
+	case authorizetoken.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case partner.FieldDeleted:
+	case authorizetoken.FieldDeleted:
 		m.ResetDeleted()
 		return nil
 	}
-	return fmt.Errorf("unknown Partner field %s", name)
+	return fmt.Errorf("unknown AuthorizeToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PartnerMutation) AddedEdges() []string {
+func (m *AuthorizeTokenMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PartnerMutation) AddedIDs(name string) []ent.Value {
+func (m *AuthorizeTokenMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PartnerMutation) RemovedEdges() []string {
+func (m *AuthorizeTokenMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PartnerMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuthorizeTokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PartnerMutation) ClearedEdges() []string {
+func (m *AuthorizeTokenMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PartnerMutation) EdgeCleared(name string) bool {
+func (m *AuthorizeTokenMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PartnerMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Partner unique edge %s", name)
+func (m *AuthorizeTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuthorizeToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PartnerMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Partner edge %s", name)
+func (m *AuthorizeTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuthorizeToken edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// GeofenceMutation represents an operation that mutates the Geofence nodes in the graph.
+type GeofenceMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	account          *string
-	password         *string
-	mobile           *string
-	open_id          *string
-	avatar           *string
-	nick_name        *string
-	introduction     *string
-	gender           *int8
-	addgender        *int8
-	asked_user_id    *int
-	addasked_user_id *int
-	area_code        *string
-	created_at       *time.Time
-	updated_at       *time.Time
-	deleted          *bool
-	clearedFields    map[string]struct{}
-	done             bool
-	oldValue         func(context.Context) (*User, error)
-	predicates       []predicate.User
+	op            Op
+	typ           string
+	id            *int
+	user_id       *int
+	adduser_id    *int
+	name          *string
+	category      *string
+	shape         *string
+	latitude      *float64
+	addlatitude   *float64
+	longitude     *float64
+	addlongitude  *float64
+	radius        *float64
+	addradius     *float64
+	polygon       *[]geo.Point
+	appendpolygon []geo.Point
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Geofence, error)
+	predicates    []predicate.Geofence
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*GeofenceMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// geofenceOption allows management of the mutation configuration using functional options.
+type geofenceOption func(*GeofenceMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newGeofenceMutation creates new mutation for the Geofence entity.
+func newGeofenceMutation(c config, op Op, opts ...geofenceOption) *GeofenceMutation {
+	m := &GeofenceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeGeofence,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2236,20 +2714,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
+// withGeofenceID sets the ID field of the mutation.
+func withGeofenceID(id int) geofenceOption {
+	return func(m *GeofenceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *Geofence
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*Geofence, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().Geofence.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2258,10 +2736,10 @@ func withUserID(id int) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withGeofence sets the old Geofence of the mutation.
+func withGeofence(node *Geofence) geofenceOption {
+	return func(m *GeofenceMutation) {
+		m.oldValue = func(context.Context) (*Geofence, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2270,7 +2748,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m GeofenceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2278,7 +2756,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m GeofenceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2289,7 +2767,7 @@ func (m UserMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
+func (m *GeofenceMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2300,7 +2778,7 @@ func (m *UserMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *GeofenceMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2309,511 +2787,458 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Geofence.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAccount sets the "account" field.
-func (m *UserMutation) SetAccount(s string) {
-	m.account = &s
+// SetUserID sets the "user_id" field.
+func (m *GeofenceMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
 }
 
-// Account returns the value of the "account" field in the mutation.
-func (m *UserMutation) Account() (r string, exists bool) {
-	v := m.account
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *GeofenceMutation) UserID() (r int, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAccount returns the old "account" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAccount(ctx context.Context) (v string, err error) {
+func (m *GeofenceMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccount requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Account, nil
+	return oldValue.UserID, nil
 }
 
-// ResetAccount resets all changes to the "account" field.
-func (m *UserMutation) ResetAccount() {
-	m.account = nil
+// AddUserID adds i to the "user_id" field.
+func (m *GeofenceMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *GeofenceMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
+// ResetUserID resets all changes to the "user_id" field.
+func (m *GeofenceMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *GeofenceMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *GeofenceMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *GeofenceMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.Name, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// ResetName resets all changes to the "name" field.
+func (m *GeofenceMutation) ResetName() {
+	m.name = nil
 }
 
-// SetMobile sets the "mobile" field.
-func (m *UserMutation) SetMobile(s string) {
-	m.mobile = &s
+// SetCategory sets the "category" field.
+func (m *GeofenceMutation) SetCategory(s string) {
+	m.category = &s
 }
 
-// Mobile returns the value of the "mobile" field in the mutation.
-func (m *UserMutation) Mobile() (r string, exists bool) {
-	v := m.mobile
+// Category returns the value of the "category" field in the mutation.
+func (m *GeofenceMutation) Category() (r string, exists bool) {
+	v := m.category
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMobile returns the old "mobile" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCategory returns the old "category" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldMobile(ctx context.Context) (v string, err error) {
+func (m *GeofenceMutation) OldCategory(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMobile is only allowed on UpdateOne operations")
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMobile requires an ID field in the mutation")
+		return v, errors.New("OldCategory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMobile: %w", err)
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
 	}
-	return oldValue.Mobile, nil
-}
-
-// ClearMobile clears the value of the "mobile" field.
-func (m *UserMutation) ClearMobile() {
-	m.mobile = nil
-	m.clearedFields[user.FieldMobile] = struct{}{}
-}
-
-// MobileCleared returns if the "mobile" field was cleared in this mutation.
-func (m *UserMutation) MobileCleared() bool {
-	_, ok := m.clearedFields[user.FieldMobile]
-	return ok
+	return oldValue.Category, nil
 }
 
-// ResetMobile resets all changes to the "mobile" field.
-func (m *UserMutation) ResetMobile() {
-	m.mobile = nil
-	delete(m.clearedFields, user.FieldMobile)
+// ResetCategory resets all changes to the "category" field.
+func (m *GeofenceMutation) ResetCategory() {
+	m.category = nil
 }
 
-// SetOpenID sets the "open_id" field.
-func (m *UserMutation) SetOpenID(s string) {
-	m.open_id = &s
+// SetShape sets the "shape" field.
+func (m *GeofenceMutation) SetShape(s string) {
+	m.shape = &s
 }
 
-// OpenID returns the value of the "open_id" field in the mutation.
-func (m *UserMutation) OpenID() (r string, exists bool) {
-	v := m.open_id
+// Shape returns the value of the "shape" field in the mutation.
+func (m *GeofenceMutation) Shape() (r string, exists bool) {
+	v := m.shape
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOpenID returns the old "open_id" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldShape returns the old "shape" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldOpenID(ctx context.Context) (v string, err error) {
+func (m *GeofenceMutation) OldShape(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOpenID is only allowed on UpdateOne operations")
+		return v, errors.New("OldShape is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOpenID requires an ID field in the mutation")
+		return v, errors.New("OldShape requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOpenID: %w", err)
+		return v, fmt.Errorf("querying old value for OldShape: %w", err)
 	}
-	return oldValue.OpenID, nil
-}
-
-// ClearOpenID clears the value of the "open_id" field.
-func (m *UserMutation) ClearOpenID() {
-	m.open_id = nil
-	m.clearedFields[user.FieldOpenID] = struct{}{}
+	return oldValue.Shape, nil
 }
 
-// OpenIDCleared returns if the "open_id" field was cleared in this mutation.
-func (m *UserMutation) OpenIDCleared() bool {
-	_, ok := m.clearedFields[user.FieldOpenID]
-	return ok
+// ResetShape resets all changes to the "shape" field.
+func (m *GeofenceMutation) ResetShape() {
+	m.shape = nil
 }
 
-// ResetOpenID resets all changes to the "open_id" field.
-func (m *UserMutation) ResetOpenID() {
-	m.open_id = nil
-	delete(m.clearedFields, user.FieldOpenID)
+// SetLatitude sets the "latitude" field.
+func (m *GeofenceMutation) SetLatitude(f float64) {
+	m.latitude = &f
+	m.addlatitude = nil
 }
 
-// SetAvatar sets the "avatar" field.
-func (m *UserMutation) SetAvatar(s string) {
-	m.avatar = &s
-}
-
-// Avatar returns the value of the "avatar" field in the mutation.
-func (m *UserMutation) Avatar() (r string, exists bool) {
-	v := m.avatar
+// Latitude returns the value of the "latitude" field in the mutation.
+func (m *GeofenceMutation) Latitude() (r float64, exists bool) {
+	v := m.latitude
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvatar returns the old "avatar" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldLatitude returns the old "latitude" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+func (m *GeofenceMutation) OldLatitude(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+		return v, errors.New("OldLatitude is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatar requires an ID field in the mutation")
+		return v, errors.New("OldLatitude requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+		return v, fmt.Errorf("querying old value for OldLatitude: %w", err)
 	}
-	return oldValue.Avatar, nil
-}
-
-// ClearAvatar clears the value of the "avatar" field.
-func (m *UserMutation) ClearAvatar() {
-	m.avatar = nil
-	m.clearedFields[user.FieldAvatar] = struct{}{}
-}
-
-// AvatarCleared returns if the "avatar" field was cleared in this mutation.
-func (m *UserMutation) AvatarCleared() bool {
-	_, ok := m.clearedFields[user.FieldAvatar]
-	return ok
-}
-
-// ResetAvatar resets all changes to the "avatar" field.
-func (m *UserMutation) ResetAvatar() {
-	m.avatar = nil
-	delete(m.clearedFields, user.FieldAvatar)
+	return oldValue.Latitude, nil
 }
 
-// SetNickName sets the "nick_name" field.
-func (m *UserMutation) SetNickName(s string) {
-	m.nick_name = &s
+// AddLatitude adds f to the "latitude" field.
+func (m *GeofenceMutation) AddLatitude(f float64) {
+	if m.addlatitude != nil {
+		*m.addlatitude += f
+	} else {
+		m.addlatitude = &f
+	}
 }
 
-// NickName returns the value of the "nick_name" field in the mutation.
-func (m *UserMutation) NickName() (r string, exists bool) {
-	v := m.nick_name
+// AddedLatitude returns the value that was added to the "latitude" field in this mutation.
+func (m *GeofenceMutation) AddedLatitude() (r float64, exists bool) {
+	v := m.addlatitude
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNickName returns the old "nick_name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldNickName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNickName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNickName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNickName: %w", err)
-	}
-	return oldValue.NickName, nil
-}
-
-// ClearNickName clears the value of the "nick_name" field.
-func (m *UserMutation) ClearNickName() {
-	m.nick_name = nil
-	m.clearedFields[user.FieldNickName] = struct{}{}
+// ClearLatitude clears the value of the "latitude" field.
+func (m *GeofenceMutation) ClearLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+	m.clearedFields[geofence.FieldLatitude] = struct{}{}
 }
 
-// NickNameCleared returns if the "nick_name" field was cleared in this mutation.
-func (m *UserMutation) NickNameCleared() bool {
-	_, ok := m.clearedFields[user.FieldNickName]
+// LatitudeCleared returns if the "latitude" field was cleared in this mutation.
+func (m *GeofenceMutation) LatitudeCleared() bool {
+	_, ok := m.clearedFields[geofence.FieldLatitude]
 	return ok
 }
 
-// ResetNickName resets all changes to the "nick_name" field.
-func (m *UserMutation) ResetNickName() {
-	m.nick_name = nil
-	delete(m.clearedFields, user.FieldNickName)
+// ResetLatitude resets all changes to the "latitude" field.
+func (m *GeofenceMutation) ResetLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+	delete(m.clearedFields, geofence.FieldLatitude)
 }
 
-// SetIntroduction sets the "introduction" field.
-func (m *UserMutation) SetIntroduction(s string) {
-	m.introduction = &s
+// SetLongitude sets the "longitude" field.
+func (m *GeofenceMutation) SetLongitude(f float64) {
+	m.longitude = &f
+	m.addlongitude = nil
 }
 
-// Introduction returns the value of the "introduction" field in the mutation.
-func (m *UserMutation) Introduction() (r string, exists bool) {
-	v := m.introduction
+// Longitude returns the value of the "longitude" field in the mutation.
+func (m *GeofenceMutation) Longitude() (r float64, exists bool) {
+	v := m.longitude
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIntroduction returns the old "introduction" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldLongitude returns the old "longitude" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIntroduction(ctx context.Context) (v string, err error) {
+func (m *GeofenceMutation) OldLongitude(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIntroduction is only allowed on UpdateOne operations")
+		return v, errors.New("OldLongitude is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIntroduction requires an ID field in the mutation")
+		return v, errors.New("OldLongitude requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIntroduction: %w", err)
+		return v, fmt.Errorf("querying old value for OldLongitude: %w", err)
 	}
-	return oldValue.Introduction, nil
-}
-
-// ClearIntroduction clears the value of the "introduction" field.
-func (m *UserMutation) ClearIntroduction() {
-	m.introduction = nil
-	m.clearedFields[user.FieldIntroduction] = struct{}{}
-}
-
-// IntroductionCleared returns if the "introduction" field was cleared in this mutation.
-func (m *UserMutation) IntroductionCleared() bool {
-	_, ok := m.clearedFields[user.FieldIntroduction]
-	return ok
-}
-
-// ResetIntroduction resets all changes to the "introduction" field.
-func (m *UserMutation) ResetIntroduction() {
-	m.introduction = nil
-	delete(m.clearedFields, user.FieldIntroduction)
+	return oldValue.Longitude, nil
 }
 
-// SetGender sets the "gender" field.
-func (m *UserMutation) SetGender(i int8) {
-	m.gender = &i
-	m.addgender = nil
+// AddLongitude adds f to the "longitude" field.
+func (m *GeofenceMutation) AddLongitude(f float64) {
+	if m.addlongitude != nil {
+		*m.addlongitude += f
+	} else {
+		m.addlongitude = &f
+	}
 }
 
-// Gender returns the value of the "gender" field in the mutation.
-func (m *UserMutation) Gender() (r int8, exists bool) {
-	v := m.gender
+// AddedLongitude returns the value that was added to the "longitude" field in this mutation.
+func (m *GeofenceMutation) AddedLongitude() (r float64, exists bool) {
+	v := m.addlongitude
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGender returns the old "gender" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldGender(ctx context.Context) (v *int8, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGender is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGender requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGender: %w", err)
-	}
-	return oldValue.Gender, nil
-}
-
-// AddGender adds i to the "gender" field.
-func (m *UserMutation) AddGender(i int8) {
-	if m.addgender != nil {
-		*m.addgender += i
-	} else {
-		m.addgender = &i
-	}
+// ClearLongitude clears the value of the "longitude" field.
+func (m *GeofenceMutation) ClearLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+	m.clearedFields[geofence.FieldLongitude] = struct{}{}
 }
 
-// AddedGender returns the value that was added to the "gender" field in this mutation.
-func (m *UserMutation) AddedGender() (r int8, exists bool) {
-	v := m.addgender
-	if v == nil {
-		return
-	}
-	return *v, true
+// LongitudeCleared returns if the "longitude" field was cleared in this mutation.
+func (m *GeofenceMutation) LongitudeCleared() bool {
+	_, ok := m.clearedFields[geofence.FieldLongitude]
+	return ok
 }
 
-// ResetGender resets all changes to the "gender" field.
-func (m *UserMutation) ResetGender() {
-	m.gender = nil
-	m.addgender = nil
+// ResetLongitude resets all changes to the "longitude" field.
+func (m *GeofenceMutation) ResetLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+	delete(m.clearedFields, geofence.FieldLongitude)
 }
 
-// SetAskedUserID sets the "asked_user_id" field.
-func (m *UserMutation) SetAskedUserID(i int) {
-	m.asked_user_id = &i
-	m.addasked_user_id = nil
+// SetRadius sets the "radius" field.
+func (m *GeofenceMutation) SetRadius(f float64) {
+	m.radius = &f
+	m.addradius = nil
 }
 
-// AskedUserID returns the value of the "asked_user_id" field in the mutation.
-func (m *UserMutation) AskedUserID() (r int, exists bool) {
-	v := m.asked_user_id
+// Radius returns the value of the "radius" field in the mutation.
+func (m *GeofenceMutation) Radius() (r float64, exists bool) {
+	v := m.radius
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAskedUserID returns the old "asked_user_id" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldRadius returns the old "radius" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAskedUserID(ctx context.Context) (v int, err error) {
+func (m *GeofenceMutation) OldRadius(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAskedUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRadius is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAskedUserID requires an ID field in the mutation")
+		return v, errors.New("OldRadius requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAskedUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRadius: %w", err)
 	}
-	return oldValue.AskedUserID, nil
+	return oldValue.Radius, nil
 }
 
-// AddAskedUserID adds i to the "asked_user_id" field.
-func (m *UserMutation) AddAskedUserID(i int) {
-	if m.addasked_user_id != nil {
-		*m.addasked_user_id += i
+// AddRadius adds f to the "radius" field.
+func (m *GeofenceMutation) AddRadius(f float64) {
+	if m.addradius != nil {
+		*m.addradius += f
 	} else {
-		m.addasked_user_id = &i
+		m.addradius = &f
 	}
 }
 
-// AddedAskedUserID returns the value that was added to the "asked_user_id" field in this mutation.
-func (m *UserMutation) AddedAskedUserID() (r int, exists bool) {
-	v := m.addasked_user_id
+// AddedRadius returns the value that was added to the "radius" field in this mutation.
+func (m *GeofenceMutation) AddedRadius() (r float64, exists bool) {
+	v := m.addradius
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearAskedUserID clears the value of the "asked_user_id" field.
-func (m *UserMutation) ClearAskedUserID() {
-	m.asked_user_id = nil
-	m.addasked_user_id = nil
-	m.clearedFields[user.FieldAskedUserID] = struct{}{}
+// ClearRadius clears the value of the "radius" field.
+func (m *GeofenceMutation) ClearRadius() {
+	m.radius = nil
+	m.addradius = nil
+	m.clearedFields[geofence.FieldRadius] = struct{}{}
 }
 
-// AskedUserIDCleared returns if the "asked_user_id" field was cleared in this mutation.
-func (m *UserMutation) AskedUserIDCleared() bool {
-	_, ok := m.clearedFields[user.FieldAskedUserID]
+// RadiusCleared returns if the "radius" field was cleared in this mutation.
+func (m *GeofenceMutation) RadiusCleared() bool {
+	_, ok := m.clearedFields[geofence.FieldRadius]
 	return ok
 }
 
-// ResetAskedUserID resets all changes to the "asked_user_id" field.
-func (m *UserMutation) ResetAskedUserID() {
-	m.asked_user_id = nil
-	m.addasked_user_id = nil
-	delete(m.clearedFields, user.FieldAskedUserID)
+// ResetRadius resets all changes to the "radius" field.
+func (m *GeofenceMutation) ResetRadius() {
+	m.radius = nil
+	m.addradius = nil
+	delete(m.clearedFields, geofence.FieldRadius)
 }
 
-// SetAreaCode sets the "area_code" field.
-func (m *UserMutation) SetAreaCode(s string) {
-	m.area_code = &s
+// SetPolygon sets the "polygon" field.
+func (m *GeofenceMutation) SetPolygon(ge []geo.Point) {
+	m.polygon = &ge
+	m.appendpolygon = nil
 }
 
-// AreaCode returns the value of the "area_code" field in the mutation.
-func (m *UserMutation) AreaCode() (r string, exists bool) {
-	v := m.area_code
+// Polygon returns the value of the "polygon" field in the mutation.
+func (m *GeofenceMutation) Polygon() (r []geo.Point, exists bool) {
+	v := m.polygon
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAreaCode returns the old "area_code" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldPolygon returns the old "polygon" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAreaCode(ctx context.Context) (v string, err error) {
+func (m *GeofenceMutation) OldPolygon(ctx context.Context) (v []geo.Point, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAreaCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldPolygon is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAreaCode requires an ID field in the mutation")
+		return v, errors.New("OldPolygon requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAreaCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldPolygon: %w", err)
 	}
-	return oldValue.AreaCode, nil
+	return oldValue.Polygon, nil
 }
 
-// ClearAreaCode clears the value of the "area_code" field.
-func (m *UserMutation) ClearAreaCode() {
-	m.area_code = nil
-	m.clearedFields[user.FieldAreaCode] = struct{}{}
+// AppendPolygon adds ge to the "polygon" field.
+func (m *GeofenceMutation) AppendPolygon(ge []geo.Point) {
+	m.appendpolygon = append(m.appendpolygon, ge...)
 }
 
-// AreaCodeCleared returns if the "area_code" field was cleared in this mutation.
-func (m *UserMutation) AreaCodeCleared() bool {
-	_, ok := m.clearedFields[user.FieldAreaCode]
+// AppendedPolygon returns the list of values that were appended to the "polygon" field in this mutation.
+func (m *GeofenceMutation) AppendedPolygon() ([]geo.Point, bool) {
+	if len(m.appendpolygon) == 0 {
+		return nil, false
+	}
+	return m.appendpolygon, true
+}
+
+// ClearPolygon clears the value of the "polygon" field.
+func (m *GeofenceMutation) ClearPolygon() {
+	m.polygon = nil
+	m.appendpolygon = nil
+	m.clearedFields[geofence.FieldPolygon] = struct{}{}
+}
+
+// PolygonCleared returns if the "polygon" field was cleared in this mutation.
+func (m *GeofenceMutation) PolygonCleared() bool {
+	_, ok := m.clearedFields[geofence.FieldPolygon]
 	return ok
 }
 
-// ResetAreaCode resets all changes to the "area_code" field.
-func (m *UserMutation) ResetAreaCode() {
-	m.area_code = nil
-	delete(m.clearedFields, user.FieldAreaCode)
+// ResetPolygon resets all changes to the "polygon" field.
+func (m *GeofenceMutation) ResetPolygon() {
+	m.polygon = nil
+	m.appendpolygon = nil
+	delete(m.clearedFields, geofence.FieldPolygon)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
+func (m *GeofenceMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *GeofenceMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2821,10 +3246,10 @@ func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GeofenceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2839,17 +3264,17 @@ func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
+func (m *GeofenceMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
+func (m *GeofenceMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *GeofenceMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2857,10 +3282,10 @@ func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Geofence entity.
+// If the Geofence object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *GeofenceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2875,119 +3300,74 @@ func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
+func (m *GeofenceMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetDeleted sets the "deleted" field.
-func (m *UserMutation) SetDeleted(b bool) {
-	m.deleted = &b
+// Where appends a list predicates to the GeofenceMutation builder.
+func (m *GeofenceMutation) Where(ps ...predicate.Geofence) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Deleted returns the value of the "deleted" field in the mutation.
-func (m *UserMutation) Deleted() (r bool, exists bool) {
-	v := m.deleted
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the GeofenceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GeofenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Geofence, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldDeleted returns the old "deleted" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldDeleted(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeleted is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeleted requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeleted: %w", err)
-	}
-	return oldValue.Deleted, nil
-}
-
-// ResetDeleted resets all changes to the "deleted" field.
-func (m *UserMutation) ResetDeleted() {
-	m.deleted = nil
-}
-
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *UserMutation) Op() Op {
-	return m.op
+// Op returns the operation name.
+func (m *GeofenceMutation) Op() Op {
+	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *GeofenceMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (Geofence).
+func (m *GeofenceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 13)
-	if m.account != nil {
-		fields = append(fields, user.FieldAccount)
-	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
-	}
-	if m.mobile != nil {
-		fields = append(fields, user.FieldMobile)
+func (m *GeofenceMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.user_id != nil {
+		fields = append(fields, geofence.FieldUserID)
 	}
-	if m.open_id != nil {
-		fields = append(fields, user.FieldOpenID)
+	if m.name != nil {
+		fields = append(fields, geofence.FieldName)
 	}
-	if m.avatar != nil {
-		fields = append(fields, user.FieldAvatar)
+	if m.category != nil {
+		fields = append(fields, geofence.FieldCategory)
 	}
-	if m.nick_name != nil {
-		fields = append(fields, user.FieldNickName)
+	if m.shape != nil {
+		fields = append(fields, geofence.FieldShape)
 	}
-	if m.introduction != nil {
-		fields = append(fields, user.FieldIntroduction)
+	if m.latitude != nil {
+		fields = append(fields, geofence.FieldLatitude)
 	}
-	if m.gender != nil {
-		fields = append(fields, user.FieldGender)
+	if m.longitude != nil {
+		fields = append(fields, geofence.FieldLongitude)
 	}
-	if m.asked_user_id != nil {
-		fields = append(fields, user.FieldAskedUserID)
+	if m.radius != nil {
+		fields = append(fields, geofence.FieldRadius)
 	}
-	if m.area_code != nil {
-		fields = append(fields, user.FieldAreaCode)
+	if m.polygon != nil {
+		fields = append(fields, geofence.FieldPolygon)
 	}
 	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+		fields = append(fields, geofence.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
-	}
-	if m.deleted != nil {
-		fields = append(fields, user.FieldDeleted)
+		fields = append(fields, geofence.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2995,34 +3375,28 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *GeofenceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldAccount:
-		return m.Account()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldMobile:
-		return m.Mobile()
-	case user.FieldOpenID:
-		return m.OpenID()
-	case user.FieldAvatar:
-		return m.Avatar()
-	case user.FieldNickName:
-		return m.NickName()
-	case user.FieldIntroduction:
-		return m.Introduction()
-	case user.FieldGender:
-		return m.Gender()
-	case user.FieldAskedUserID:
-		return m.AskedUserID()
-	case user.FieldAreaCode:
-		return m.AreaCode()
-	case user.FieldCreatedAt:
+	case geofence.FieldUserID:
+		return m.UserID()
+	case geofence.FieldName:
+		return m.Name()
+	case geofence.FieldCategory:
+		return m.Category()
+	case geofence.FieldShape:
+		return m.Shape()
+	case geofence.FieldLatitude:
+		return m.Latitude()
+	case geofence.FieldLongitude:
+		return m.Longitude()
+	case geofence.FieldRadius:
+		return m.Radius()
+	case geofence.FieldPolygon:
+		return m.Polygon()
+	case geofence.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
+	case geofence.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case user.FieldDeleted:
-		return m.Deleted()
 	}
 	return nil, false
 }
@@ -3030,147 +3404,126 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *GeofenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldAccount:
-		return m.OldAccount(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldMobile:
-		return m.OldMobile(ctx)
-	case user.FieldOpenID:
-		return m.OldOpenID(ctx)
-	case user.FieldAvatar:
-		return m.OldAvatar(ctx)
-	case user.FieldNickName:
-		return m.OldNickName(ctx)
-	case user.FieldIntroduction:
-		return m.OldIntroduction(ctx)
-	case user.FieldGender:
-		return m.OldGender(ctx)
-	case user.FieldAskedUserID:
-		return m.OldAskedUserID(ctx)
-	case user.FieldAreaCode:
-		return m.OldAreaCode(ctx)
-	case user.FieldCreatedAt:
+	case geofence.FieldUserID:
+		return m.OldUserID(ctx)
+	case geofence.FieldName:
+		return m.OldName(ctx)
+	case geofence.FieldCategory:
+		return m.OldCategory(ctx)
+	case geofence.FieldShape:
+		return m.OldShape(ctx)
+	case geofence.FieldLatitude:
+		return m.OldLatitude(ctx)
+	case geofence.FieldLongitude:
+		return m.OldLongitude(ctx)
+	case geofence.FieldRadius:
+		return m.OldRadius(ctx)
+	case geofence.FieldPolygon:
+		return m.OldPolygon(ctx)
+	case geofence.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
+	case geofence.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case user.FieldDeleted:
-		return m.OldDeleted(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown Geofence field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *GeofenceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldAccount:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAccount(v)
-		return nil
-	case user.FieldPassword:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPassword(v)
-		return nil
-	case user.FieldMobile:
-		v, ok := value.(string)
+	case geofence.FieldUserID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMobile(v)
+		m.SetUserID(v)
 		return nil
-	case user.FieldOpenID:
+	case geofence.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOpenID(v)
+		m.SetName(v)
 		return nil
-	case user.FieldAvatar:
+	case geofence.FieldCategory:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAvatar(v)
+		m.SetCategory(v)
 		return nil
-	case user.FieldNickName:
+	case geofence.FieldShape:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNickName(v)
+		m.SetShape(v)
 		return nil
-	case user.FieldIntroduction:
-		v, ok := value.(string)
+	case geofence.FieldLatitude:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIntroduction(v)
+		m.SetLatitude(v)
 		return nil
-	case user.FieldGender:
-		v, ok := value.(int8)
+	case geofence.FieldLongitude:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGender(v)
+		m.SetLongitude(v)
 		return nil
-	case user.FieldAskedUserID:
-		v, ok := value.(int)
+	case geofence.FieldRadius:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAskedUserID(v)
+		m.SetRadius(v)
 		return nil
-	case user.FieldAreaCode:
-		v, ok := value.(string)
+	case geofence.FieldPolygon:
+		v, ok := value.([]geo.Point)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAreaCode(v)
+		m.SetPolygon(v)
 		return nil
-	case user.FieldCreatedAt:
+	case geofence.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case geofence.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case user.FieldDeleted:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeleted(v)
-		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown Geofence field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *GeofenceMutation) AddedFields() []string {
 	var fields []string
-	if m.addgender != nil {
-		fields = append(fields, user.FieldGender)
+	if m.adduser_id != nil {
+		fields = append(fields, geofence.FieldUserID)
 	}
-	if m.addasked_user_id != nil {
-		fields = append(fields, user.FieldAskedUserID)
+	if m.addlatitude != nil {
+		fields = append(fields, geofence.FieldLatitude)
+	}
+	if m.addlongitude != nil {
+		fields = append(fields, geofence.FieldLongitude)
+	}
+	if m.addradius != nil {
+		fields = append(fields, geofence.FieldRadius)
 	}
 	return fields
 }
@@ -3178,12 +3531,16 @@ func (m *UserMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *GeofenceMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldGender:
-		return m.AddedGender()
-	case user.FieldAskedUserID:
-		return m.AddedAskedUserID()
+	case geofence.FieldUserID:
+		return m.AddedUserID()
+	case geofence.FieldLatitude:
+		return m.AddedLatitude()
+	case geofence.FieldLongitude:
+		return m.AddedLongitude()
+	case geofence.FieldRadius:
+		return m.AddedRadius()
 	}
 	return nil, false
 }
@@ -3191,225 +3548,203 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *GeofenceMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldGender:
-		v, ok := value.(int8)
+	case geofence.FieldUserID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddGender(v)
+		m.AddUserID(v)
 		return nil
-	case user.FieldAskedUserID:
-		v, ok := value.(int)
+	case geofence.FieldLatitude:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAskedUserID(v)
+		m.AddLatitude(v)
+		return nil
+	case geofence.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLongitude(v)
+		return nil
+	case geofence.FieldRadius:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRadius(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown Geofence numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *GeofenceMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(user.FieldMobile) {
-		fields = append(fields, user.FieldMobile)
-	}
-	if m.FieldCleared(user.FieldOpenID) {
-		fields = append(fields, user.FieldOpenID)
-	}
-	if m.FieldCleared(user.FieldAvatar) {
-		fields = append(fields, user.FieldAvatar)
+	if m.FieldCleared(geofence.FieldLatitude) {
+		fields = append(fields, geofence.FieldLatitude)
 	}
-	if m.FieldCleared(user.FieldNickName) {
-		fields = append(fields, user.FieldNickName)
-	}
-	if m.FieldCleared(user.FieldIntroduction) {
-		fields = append(fields, user.FieldIntroduction)
+	if m.FieldCleared(geofence.FieldLongitude) {
+		fields = append(fields, geofence.FieldLongitude)
 	}
-	if m.FieldCleared(user.FieldAskedUserID) {
-		fields = append(fields, user.FieldAskedUserID)
+	if m.FieldCleared(geofence.FieldRadius) {
+		fields = append(fields, geofence.FieldRadius)
 	}
-	if m.FieldCleared(user.FieldAreaCode) {
-		fields = append(fields, user.FieldAreaCode)
+	if m.FieldCleared(geofence.FieldPolygon) {
+		fields = append(fields, geofence.FieldPolygon)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *GeofenceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
+func (m *GeofenceMutation) ClearField(name string) error {
 	switch name {
-	case user.FieldMobile:
-		m.ClearMobile()
-		return nil
-	case user.FieldOpenID:
-		m.ClearOpenID()
-		return nil
-	case user.FieldAvatar:
-		m.ClearAvatar()
-		return nil
-	case user.FieldNickName:
-		m.ClearNickName()
+	case geofence.FieldLatitude:
+		m.ClearLatitude()
 		return nil
-	case user.FieldIntroduction:
-		m.ClearIntroduction()
+	case geofence.FieldLongitude:
+		m.ClearLongitude()
 		return nil
-	case user.FieldAskedUserID:
-		m.ClearAskedUserID()
+	case geofence.FieldRadius:
+		m.ClearRadius()
 		return nil
-	case user.FieldAreaCode:
-		m.ClearAreaCode()
+	case geofence.FieldPolygon:
+		m.ClearPolygon()
 		return nil
 	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+	return fmt.Errorf("unknown Geofence nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *GeofenceMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldAccount:
-		m.ResetAccount()
-		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
-		return nil
-	case user.FieldMobile:
-		m.ResetMobile()
+	case geofence.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case user.FieldOpenID:
-		m.ResetOpenID()
+	case geofence.FieldName:
+		m.ResetName()
 		return nil
-	case user.FieldAvatar:
-		m.ResetAvatar()
+	case geofence.FieldCategory:
+		m.ResetCategory()
 		return nil
-	case user.FieldNickName:
-		m.ResetNickName()
+	case geofence.FieldShape:
+		m.ResetShape()
 		return nil
-	case user.FieldIntroduction:
-		m.ResetIntroduction()
+	case geofence.FieldLatitude:
+		m.ResetLatitude()
 		return nil
-	case user.FieldGender:
-		m.ResetGender()
+	case geofence.FieldLongitude:
+		m.ResetLongitude()
 		return nil
-	case user.FieldAskedUserID:
-		m.ResetAskedUserID()
+	case geofence.FieldRadius:
+		m.ResetRadius()
 		return nil
-	case user.FieldAreaCode:
-		m.ResetAreaCode()
+	case geofence.FieldPolygon:
+		m.ResetPolygon()
 		return nil
-	case user.FieldCreatedAt:
+	case geofence.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldUpdatedAt:
+	case geofence.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case user.FieldDeleted:
-		m.ResetDeleted()
-		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown Geofence field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
+func (m *GeofenceMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *GeofenceMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
+func (m *GeofenceMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+func (m *GeofenceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
+func (m *GeofenceMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *GeofenceMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown User unique edge %s", name)
+func (m *GeofenceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Geofence unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown User edge %s", name)
+func (m *GeofenceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Geofence edge %s", name)
 }
 
-// VehicleMutation represents an operation that mutates the Vehicle nodes in the graph.
-type VehicleMutation struct {
+// PartnerMutation represents an operation that mutates the Partner nodes in the graph.
+type PartnerMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	vin                 *string
-	user_id             *int
-	adduser_id          *int
-	display_name        *string
-	access_type         *string
-	state               *int8
-	addstate            *int8
-	in_service          *int8
-	addin_service       *int8
-	calendar_enabled    *int8
-	addcalendar_enabled *int8
-	car_type            *string
-	api_version         *string
-	raw_data            *string
-	created_at          *time.Time
-	updated_at          *time.Time
-	deleted             *bool
-	clearedFields       map[string]struct{}
-	done                bool
-	oldValue            func(context.Context) (*Vehicle, error)
-	predicates          []predicate.Vehicle
+	op            Op
+	typ           string
+	id            *int
+	client_id     *string
+	access_token  *string
+	expires_in    *int
+	addexpires_in *int
+	token_type    *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	deleted       *bool
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Partner, error)
+	predicates    []predicate.Partner
 }
 
-var _ ent.Mutation = (*VehicleMutation)(nil)
+var _ ent.Mutation = (*PartnerMutation)(nil)
 
-// vehicleOption allows management of the mutation configuration using functional options.
-type vehicleOption func(*VehicleMutation)
+// partnerOption allows management of the mutation configuration using functional options.
+type partnerOption func(*PartnerMutation)
 
-// newVehicleMutation creates new mutation for the Vehicle entity.
-func newVehicleMutation(c config, op Op, opts ...vehicleOption) *VehicleMutation {
-	m := &VehicleMutation{
+// newPartnerMutation creates new mutation for the Partner entity.
+func newPartnerMutation(c config, op Op, opts ...partnerOption) *PartnerMutation {
+	m := &PartnerMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeVehicle,
+		typ:           TypePartner,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3418,20 +3753,20 @@ func newVehicleMutation(c config, op Op, opts ...vehicleOption) *VehicleMutation
 	return m
 }
 
-// withVehicleID sets the ID field of the mutation.
-func withVehicleID(id int) vehicleOption {
-	return func(m *VehicleMutation) {
+// withPartnerID sets the ID field of the mutation.
+func withPartnerID(id int) partnerOption {
+	return func(m *PartnerMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Vehicle
+			value *Partner
 		)
-		m.oldValue = func(ctx context.Context) (*Vehicle, error) {
+		m.oldValue = func(ctx context.Context) (*Partner, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Vehicle.Get(ctx, id)
+					value, err = m.Client().Partner.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3440,10 +3775,10 @@ func withVehicleID(id int) vehicleOption {
 	}
 }
 
-// withVehicle sets the old Vehicle of the mutation.
-func withVehicle(node *Vehicle) vehicleOption {
-	return func(m *VehicleMutation) {
-		m.oldValue = func(context.Context) (*Vehicle, error) {
+// withPartner sets the old Partner of the mutation.
+func withPartner(node *Partner) partnerOption {
+	return func(m *PartnerMutation) {
+		m.oldValue = func(context.Context) (*Partner, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3452,7 +3787,7 @@ func withVehicle(node *Vehicle) vehicleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m VehicleMutation) Client() *Client {
+func (m PartnerMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3460,7 +3795,7 @@ func (m VehicleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m VehicleMutation) Tx() (*Tx, error) {
+func (m PartnerMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3471,7 +3806,7 @@ func (m VehicleMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *VehicleMutation) ID() (id int, exists bool) {
+func (m *PartnerMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3482,7 +3817,7 @@ func (m *VehicleMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *VehicleMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *PartnerMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3491,659 +3826,15523 @@ func (m *VehicleMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Vehicle.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Partner.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetVin sets the "vin" field.
-func (m *VehicleMutation) SetVin(s string) {
-	m.vin = &s
+// SetClientID sets the "client_id" field.
+func (m *PartnerMutation) SetClientID(s string) {
+	m.client_id = &s
 }
 
-// Vin returns the value of the "vin" field in the mutation.
-func (m *VehicleMutation) Vin() (r string, exists bool) {
-	v := m.vin
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *PartnerMutation) ClientID() (r string, exists bool) {
+	v := m.client_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVin returns the old "vin" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// OldClientID returns the old "client_id" field's value of the Partner entity.
+// If the Partner object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldVin(ctx context.Context) (v string, err error) {
+func (m *PartnerMutation) OldClientID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVin is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVin requires an ID field in the mutation")
+		return v, errors.New("OldClientID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVin: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
 	}
-	return oldValue.Vin, nil
+	return oldValue.ClientID, nil
 }
 
-// ResetVin resets all changes to the "vin" field.
-func (m *VehicleMutation) ResetVin() {
-	m.vin = nil
+// ResetClientID resets all changes to the "client_id" field.
+func (m *PartnerMutation) ResetClientID() {
+	m.client_id = nil
 }
 
-// SetUserID sets the "user_id" field.
-func (m *VehicleMutation) SetUserID(i int) {
-	m.user_id = &i
-	m.adduser_id = nil
+// SetAccessToken sets the "access_token" field.
+func (m *PartnerMutation) SetAccessToken(s string) {
+	m.access_token = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *VehicleMutation) UserID() (r int, exists bool) {
-	v := m.user_id
+// AccessToken returns the value of the "access_token" field in the mutation.
+func (m *PartnerMutation) AccessToken() (r string, exists bool) {
+	v := m.access_token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// OldAccessToken returns the old "access_token" field's value of the Partner entity.
+// If the Partner object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *PartnerMutation) OldAccessToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccessToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldAccessToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccessToken: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.AccessToken, nil
 }
 
-// AddUserID adds i to the "user_id" field.
-func (m *VehicleMutation) AddUserID(i int) {
-	if m.adduser_id != nil {
-		*m.adduser_id += i
-	} else {
-		m.adduser_id = &i
-	}
+// ClearAccessToken clears the value of the "access_token" field.
+func (m *PartnerMutation) ClearAccessToken() {
+	m.access_token = nil
+	m.clearedFields[partner.FieldAccessToken] = struct{}{}
 }
 
-// AddedUserID returns the value that was added to the "user_id" field in this mutation.
-func (m *VehicleMutation) AddedUserID() (r int, exists bool) {
-	v := m.adduser_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// AccessTokenCleared returns if the "access_token" field was cleared in this mutation.
+func (m *PartnerMutation) AccessTokenCleared() bool {
+	_, ok := m.clearedFields[partner.FieldAccessToken]
+	return ok
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *VehicleMutation) ResetUserID() {
-	m.user_id = nil
-	m.adduser_id = nil
+// ResetAccessToken resets all changes to the "access_token" field.
+func (m *PartnerMutation) ResetAccessToken() {
+	m.access_token = nil
+	delete(m.clearedFields, partner.FieldAccessToken)
 }
 
-// SetDisplayName sets the "display_name" field.
-func (m *VehicleMutation) SetDisplayName(s string) {
-	m.display_name = &s
+// SetExpiresIn sets the "expires_in" field.
+func (m *PartnerMutation) SetExpiresIn(i int) {
+	m.expires_in = &i
+	m.addexpires_in = nil
 }
 
-// DisplayName returns the value of the "display_name" field in the mutation.
-func (m *VehicleMutation) DisplayName() (r string, exists bool) {
-	v := m.display_name
+// ExpiresIn returns the value of the "expires_in" field in the mutation.
+func (m *PartnerMutation) ExpiresIn() (r int, exists bool) {
+	v := m.expires_in
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDisplayName returns the old "display_name" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresIn returns the old "expires_in" field's value of the Partner entity.
+// If the Partner object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+func (m *PartnerMutation) OldExpiresIn(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresIn is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+		return v, errors.New("OldExpiresIn requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresIn: %w", err)
 	}
-	return oldValue.DisplayName, nil
-}
-
-// ResetDisplayName resets all changes to the "display_name" field.
-func (m *VehicleMutation) ResetDisplayName() {
-	m.display_name = nil
+	return oldValue.ExpiresIn, nil
 }
 
-// SetAccessType sets the "access_type" field.
-func (m *VehicleMutation) SetAccessType(s string) {
-	m.access_type = &s
+// AddExpiresIn adds i to the "expires_in" field.
+func (m *PartnerMutation) AddExpiresIn(i int) {
+	if m.addexpires_in != nil {
+		*m.addexpires_in += i
+	} else {
+		m.addexpires_in = &i
+	}
 }
 
-// AccessType returns the value of the "access_type" field in the mutation.
-func (m *VehicleMutation) AccessType() (r string, exists bool) {
-	v := m.access_type
+// AddedExpiresIn returns the value that was added to the "expires_in" field in this mutation.
+func (m *PartnerMutation) AddedExpiresIn() (r int, exists bool) {
+	v := m.addexpires_in
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAccessType returns the old "access_type" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldAccessType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccessType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccessType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccessType: %w", err)
-	}
-	return oldValue.AccessType, nil
+// ClearExpiresIn clears the value of the "expires_in" field.
+func (m *PartnerMutation) ClearExpiresIn() {
+	m.expires_in = nil
+	m.addexpires_in = nil
+	m.clearedFields[partner.FieldExpiresIn] = struct{}{}
 }
 
-// ResetAccessType resets all changes to the "access_type" field.
-func (m *VehicleMutation) ResetAccessType() {
-	m.access_type = nil
+// ExpiresInCleared returns if the "expires_in" field was cleared in this mutation.
+func (m *PartnerMutation) ExpiresInCleared() bool {
+	_, ok := m.clearedFields[partner.FieldExpiresIn]
+	return ok
 }
 
-// SetState sets the "state" field.
-func (m *VehicleMutation) SetState(i int8) {
-	m.state = &i
-	m.addstate = nil
+// ResetExpiresIn resets all changes to the "expires_in" field.
+func (m *PartnerMutation) ResetExpiresIn() {
+	m.expires_in = nil
+	m.addexpires_in = nil
+	delete(m.clearedFields, partner.FieldExpiresIn)
 }
 
-// State returns the value of the "state" field in the mutation.
-func (m *VehicleMutation) State() (r int8, exists bool) {
-	v := m.state
+// SetTokenType sets the "token_type" field.
+func (m *PartnerMutation) SetTokenType(s string) {
+	m.token_type = &s
+}
+
+// TokenType returns the value of the "token_type" field in the mutation.
+func (m *PartnerMutation) TokenType() (r string, exists bool) {
+	v := m.token_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldState returns the old "state" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenType returns the old "token_type" field's value of the Partner entity.
+// If the Partner object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldState(ctx context.Context) (v int8, err error) {
+func (m *PartnerMutation) OldTokenType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldState is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldState requires an ID field in the mutation")
+		return v, errors.New("OldTokenType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldState: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenType: %w", err)
 	}
-	return oldValue.State, nil
+	return oldValue.TokenType, nil
 }
 
-// AddState adds i to the "state" field.
-func (m *VehicleMutation) AddState(i int8) {
-	if m.addstate != nil {
-		*m.addstate += i
-	} else {
-		m.addstate = &i
-	}
+// ClearTokenType clears the value of the "token_type" field.
+func (m *PartnerMutation) ClearTokenType() {
+	m.token_type = nil
+	m.clearedFields[partner.FieldTokenType] = struct{}{}
 }
 
-// AddedState returns the value that was added to the "state" field in this mutation.
-func (m *VehicleMutation) AddedState() (r int8, exists bool) {
-	v := m.addstate
-	if v == nil {
-		return
-	}
-	return *v, true
+// TokenTypeCleared returns if the "token_type" field was cleared in this mutation.
+func (m *PartnerMutation) TokenTypeCleared() bool {
+	_, ok := m.clearedFields[partner.FieldTokenType]
+	return ok
 }
 
-// ResetState resets all changes to the "state" field.
-func (m *VehicleMutation) ResetState() {
-	m.state = nil
-	m.addstate = nil
+// ResetTokenType resets all changes to the "token_type" field.
+func (m *PartnerMutation) ResetTokenType() {
+	m.token_type = nil
+	delete(m.clearedFields, partner.FieldTokenType)
 }
 
-// SetInService sets the "in_service" field.
-func (m *VehicleMutation) SetInService(i int8) {
-	m.in_service = &i
-	m.addin_service = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *PartnerMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// InService returns the value of the "in_service" field in the mutation.
-func (m *VehicleMutation) InService() (r int8, exists bool) {
-	v := m.in_service
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PartnerMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInService returns the old "in_service" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Partner entity.
+// If the Partner object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldInService(ctx context.Context) (v *int8, err error) {
+func (m *PartnerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInService is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInService requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInService: %w", err)
-	}
-	return oldValue.InService, nil
-}
-
-// AddInService adds i to the "in_service" field.
-func (m *VehicleMutation) AddInService(i int8) {
-	if m.addin_service != nil {
-		*m.addin_service += i
-	} else {
-		m.addin_service = &i
-	}
-}
-
-// AddedInService returns the value that was added to the "in_service" field in this mutation.
-func (m *VehicleMutation) AddedInService() (r int8, exists bool) {
-	v := m.addin_service
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.CreatedAt, nil
 }
 
-// ResetInService resets all changes to the "in_service" field.
-func (m *VehicleMutation) ResetInService() {
-	m.in_service = nil
-	m.addin_service = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PartnerMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetCalendarEnabled sets the "calendar_enabled" field.
-func (m *VehicleMutation) SetCalendarEnabled(i int8) {
-	m.calendar_enabled = &i
-	m.addcalendar_enabled = nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PartnerMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// CalendarEnabled returns the value of the "calendar_enabled" field in the mutation.
-func (m *VehicleMutation) CalendarEnabled() (r int8, exists bool) {
-	v := m.calendar_enabled
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PartnerMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCalendarEnabled returns the old "calendar_enabled" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Partner entity.
+// If the Partner object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldCalendarEnabled(ctx context.Context) (v *int8, err error) {
+func (m *PartnerMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCalendarEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCalendarEnabled requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCalendarEnabled: %w", err)
-	}
-	return oldValue.CalendarEnabled, nil
-}
-
-// AddCalendarEnabled adds i to the "calendar_enabled" field.
-func (m *VehicleMutation) AddCalendarEnabled(i int8) {
-	if m.addcalendar_enabled != nil {
-		*m.addcalendar_enabled += i
-	} else {
-		m.addcalendar_enabled = &i
-	}
-}
-
-// AddedCalendarEnabled returns the value that was added to the "calendar_enabled" field in this mutation.
-func (m *VehicleMutation) AddedCalendarEnabled() (r int8, exists bool) {
-	v := m.addcalendar_enabled
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetCalendarEnabled resets all changes to the "calendar_enabled" field.
-func (m *VehicleMutation) ResetCalendarEnabled() {
-	m.calendar_enabled = nil
-	m.addcalendar_enabled = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PartnerMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetCarType sets the "car_type" field.
-func (m *VehicleMutation) SetCarType(s string) {
-	m.car_type = &s
+// SetDeleted sets the "deleted" field.
+func (m *PartnerMutation) SetDeleted(b bool) {
+	m.deleted = &b
 }
 
-// CarType returns the value of the "car_type" field in the mutation.
-func (m *VehicleMutation) CarType() (r string, exists bool) {
-	v := m.car_type
+// Deleted returns the value of the "deleted" field in the mutation.
+func (m *PartnerMutation) Deleted() (r bool, exists bool) {
+	v := m.deleted
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCarType returns the old "car_type" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// OldDeleted returns the old "deleted" field's value of the Partner entity.
+// If the Partner object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldCarType(ctx context.Context) (v string, err error) {
+func (m *PartnerMutation) OldDeleted(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCarType is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeleted is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCarType requires an ID field in the mutation")
+		return v, errors.New("OldDeleted requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCarType: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeleted: %w", err)
 	}
-	return oldValue.CarType, nil
+	return oldValue.Deleted, nil
 }
 
-// ClearCarType clears the value of the "car_type" field.
-func (m *VehicleMutation) ClearCarType() {
-	m.car_type = nil
-	m.clearedFields[vehicle.FieldCarType] = struct{}{}
+// ResetDeleted resets all changes to the "deleted" field.
+func (m *PartnerMutation) ResetDeleted() {
+	m.deleted = nil
 }
 
-// CarTypeCleared returns if the "car_type" field was cleared in this mutation.
-func (m *VehicleMutation) CarTypeCleared() bool {
-	_, ok := m.clearedFields[vehicle.FieldCarType]
-	return ok
+// Where appends a list predicates to the PartnerMutation builder.
+func (m *PartnerMutation) Where(ps ...predicate.Partner) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetCarType resets all changes to the "car_type" field.
-func (m *VehicleMutation) ResetCarType() {
-	m.car_type = nil
-	delete(m.clearedFields, vehicle.FieldCarType)
+// WhereP appends storage-level predicates to the PartnerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PartnerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Partner, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetAPIVersion sets the "api_version" field.
-func (m *VehicleMutation) SetAPIVersion(s string) {
-	m.api_version = &s
+// Op returns the operation name.
+func (m *PartnerMutation) Op() Op {
+	return m.op
 }
 
-// APIVersion returns the value of the "api_version" field in the mutation.
-func (m *VehicleMutation) APIVersion() (r string, exists bool) {
-	v := m.api_version
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *PartnerMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OldAPIVersion returns the old "api_version" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldAPIVersion(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAPIVersion is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAPIVersion requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAPIVersion: %w", err)
-	}
-	return oldValue.APIVersion, nil
-}
-
-// ClearAPIVersion clears the value of the "api_version" field.
-func (m *VehicleMutation) ClearAPIVersion() {
-	m.api_version = nil
-	m.clearedFields[vehicle.FieldAPIVersion] = struct{}{}
-}
-
-// APIVersionCleared returns if the "api_version" field was cleared in this mutation.
-func (m *VehicleMutation) APIVersionCleared() bool {
-	_, ok := m.clearedFields[vehicle.FieldAPIVersion]
-	return ok
+// Type returns the node type of this mutation (Partner).
+func (m *PartnerMutation) Type() string {
+	return m.typ
 }
 
-// ResetAPIVersion resets all changes to the "api_version" field.
-func (m *VehicleMutation) ResetAPIVersion() {
-	m.api_version = nil
-	delete(m.clearedFields, vehicle.FieldAPIVersion)
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PartnerMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.client_id != nil {
+		fields = append(fields, partner.FieldClientID)
+	}
+	if m.access_token != nil {
+		fields = append(fields, partner.FieldAccessToken)
+	}
+	if m.expires_in != nil {
+		fields = append(fields, partner.FieldExpiresIn)
+	}
+	if m.token_type != nil {
+		fields = append(fields, partner.FieldTokenType)
+	}
+	if m.created_at != nil {
+		fields = append(fields, partner.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, partner.FieldUpdatedAt)
+	}
+	if m.deleted != nil {
+		fields = append(fields, partner.FieldDeleted)
+	}
+	return fields
 }
 
-// SetRawData sets the "raw_data" field.
-func (m *VehicleMutation) SetRawData(s string) {
-	m.raw_data = &s
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PartnerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case partner.FieldClientID:
+		return m.ClientID()
+	case partner.FieldAccessToken:
+		return m.AccessToken()
+	case partner.FieldExpiresIn:
+		return m.ExpiresIn()
+	case partner.FieldTokenType:
+		return m.TokenType()
+	case partner.FieldCreatedAt:
+		return m.CreatedAt()
+	case partner.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case partner.FieldDeleted:
+		return m.Deleted()
+	}
+	return nil, false
 }
 
-// RawData returns the value of the "raw_data" field in the mutation.
-func (m *VehicleMutation) RawData() (r string, exists bool) {
-	v := m.raw_data
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PartnerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case partner.FieldClientID:
+		return m.OldClientID(ctx)
+	case partner.FieldAccessToken:
+		return m.OldAccessToken(ctx)
+	case partner.FieldExpiresIn:
+		return m.OldExpiresIn(ctx)
+	case partner.FieldTokenType:
+		return m.OldTokenType(ctx)
+	case partner.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case partner.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case partner.FieldDeleted:
+		return m.OldDeleted(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown Partner field %s", name)
 }
 
-// OldRawData returns the old "raw_data" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldRawData(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRawData is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRawData requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRawData: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PartnerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case partner.FieldClientID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case partner.FieldAccessToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessToken(v)
+		return nil
+	case partner.FieldExpiresIn:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresIn(v)
+		return nil
+	case partner.FieldTokenType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenType(v)
+		return nil
+	case partner.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case partner.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case partner.FieldDeleted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleted(v)
+		return nil
 	}
-	return oldValue.RawData, nil
+	return fmt.Errorf("unknown Partner field %s", name)
 }
 
-// ResetRawData resets all changes to the "raw_data" field.
-func (m *VehicleMutation) ResetRawData() {
-	m.raw_data = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PartnerMutation) AddedFields() []string {
+	var fields []string
+	if m.addexpires_in != nil {
+		fields = append(fields, partner.FieldExpiresIn)
+	}
+	return fields
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *VehicleMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PartnerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case partner.FieldExpiresIn:
+		return m.AddedExpiresIn()
+	}
+	return nil, false
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *VehicleMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PartnerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case partner.FieldExpiresIn:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpiresIn(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Partner numeric field %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PartnerMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(partner.FieldAccessToken) {
+		fields = append(fields, partner.FieldAccessToken)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	if m.FieldCleared(partner.FieldExpiresIn) {
+		fields = append(fields, partner.FieldExpiresIn)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	if m.FieldCleared(partner.FieldTokenType) {
+		fields = append(fields, partner.FieldTokenType)
 	}
-	return oldValue.CreatedAt, nil
+	return fields
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *VehicleMutation) ResetCreatedAt() {
-	m.created_at = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PartnerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *VehicleMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PartnerMutation) ClearField(name string) error {
+	switch name {
+	case partner.FieldAccessToken:
+		m.ClearAccessToken()
+		return nil
+	case partner.FieldExpiresIn:
+		m.ClearExpiresIn()
+		return nil
+	case partner.FieldTokenType:
+		m.ClearTokenType()
+		return nil
+	}
+	return fmt.Errorf("unknown Partner nullable field %s", name)
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *VehicleMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PartnerMutation) ResetField(name string) error {
+	switch name {
+	case partner.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case partner.FieldAccessToken:
+		m.ResetAccessToken()
+		return nil
+	case partner.FieldExpiresIn:
+		m.ResetExpiresIn()
+		return nil
+	case partner.FieldTokenType:
+		m.ResetTokenType()
+		return nil
+	case partner.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case partner.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case partner.FieldDeleted:
+		m.ResetDeleted()
+		return nil
+	}
+	return fmt.Errorf("unknown Partner field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PartnerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PartnerMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PartnerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PartnerMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PartnerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PartnerMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PartnerMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Partner unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PartnerMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Partner edge %s", name)
+}
+
+// RollupStateMutation represents an operation that mutates the RollupState nodes in the graph.
+type RollupStateMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	vehicle_id    *int
+	addvehicle_id *int
+	time_zone     *string
+	watermark     *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*RollupState, error)
+	predicates    []predicate.RollupState
+}
+
+var _ ent.Mutation = (*RollupStateMutation)(nil)
+
+// rollupstateOption allows management of the mutation configuration using functional options.
+type rollupstateOption func(*RollupStateMutation)
+
+// newRollupStateMutation creates new mutation for the RollupState entity.
+func newRollupStateMutation(c config, op Op, opts ...rollupstateOption) *RollupStateMutation {
+	m := &RollupStateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRollupState,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRollupStateID sets the ID field of the mutation.
+func withRollupStateID(id int) rollupstateOption {
+	return func(m *RollupStateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RollupState
+		)
+		m.oldValue = func(ctx context.Context) (*RollupState, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RollupState.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRollupState sets the old RollupState of the mutation.
+func withRollupState(node *RollupState) rollupstateOption {
+	return func(m *RollupStateMutation) {
+		m.oldValue = func(context.Context) (*RollupState, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RollupStateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RollupStateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RollupStateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RollupStateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RollupState.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (m *RollupStateMutation) SetVehicleID(i int) {
+	m.vehicle_id = &i
+	m.addvehicle_id = nil
+}
+
+// VehicleID returns the value of the "vehicle_id" field in the mutation.
+func (m *RollupStateMutation) VehicleID() (r int, exists bool) {
+	v := m.vehicle_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// OldVehicleID returns the old "vehicle_id" field's value of the RollupState entity.
+// If the RollupState object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RollupStateMutation) OldVehicleID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldVehicleID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldVehicleID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldVehicleID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.VehicleID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *VehicleMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddVehicleID adds i to the "vehicle_id" field.
+func (m *RollupStateMutation) AddVehicleID(i int) {
+	if m.addvehicle_id != nil {
+		*m.addvehicle_id += i
+	} else {
+		m.addvehicle_id = &i
+	}
 }
 
-// SetDeleted sets the "deleted" field.
-func (m *VehicleMutation) SetDeleted(b bool) {
-	m.deleted = &b
+// AddedVehicleID returns the value that was added to the "vehicle_id" field in this mutation.
+func (m *RollupStateMutation) AddedVehicleID() (r int, exists bool) {
+	v := m.addvehicle_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Deleted returns the value of the "deleted" field in the mutation.
-func (m *VehicleMutation) Deleted() (r bool, exists bool) {
-	v := m.deleted
+// ResetVehicleID resets all changes to the "vehicle_id" field.
+func (m *RollupStateMutation) ResetVehicleID() {
+	m.vehicle_id = nil
+	m.addvehicle_id = nil
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (m *RollupStateMutation) SetTimeZone(s string) {
+	m.time_zone = &s
+}
+
+// TimeZone returns the value of the "time_zone" field in the mutation.
+func (m *RollupStateMutation) TimeZone() (r string, exists bool) {
+	v := m.time_zone
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeleted returns the old "deleted" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// OldTimeZone returns the old "time_zone" field's value of the RollupState entity.
+// If the RollupState object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldDeleted(ctx context.Context) (v bool, err error) {
+func (m *RollupStateMutation) OldTimeZone(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeleted is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimeZone is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeleted requires an ID field in the mutation")
+		return v, errors.New("OldTimeZone requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeleted: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimeZone: %w", err)
 	}
-	return oldValue.Deleted, nil
+	return oldValue.TimeZone, nil
 }
 
-// ResetDeleted resets all changes to the "deleted" field.
-func (m *VehicleMutation) ResetDeleted() {
-	m.deleted = nil
+// ResetTimeZone resets all changes to the "time_zone" field.
+func (m *RollupStateMutation) ResetTimeZone() {
+	m.time_zone = nil
 }
 
-// Where appends a list predicates to the VehicleMutation builder.
-func (m *VehicleMutation) Where(ps ...predicate.Vehicle) {
-	m.predicates = append(m.predicates, ps...)
+// SetWatermark sets the "watermark" field.
+func (m *RollupStateMutation) SetWatermark(t time.Time) {
+	m.watermark = &t
 }
 
-// WhereP appends storage-level predicates to the VehicleMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *VehicleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Vehicle, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Watermark returns the value of the "watermark" field in the mutation.
+func (m *RollupStateMutation) Watermark() (r time.Time, exists bool) {
+	v := m.watermark
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *VehicleMutation) Op() Op {
-	return m.op
+// OldWatermark returns the old "watermark" field's value of the RollupState entity.
+// If the RollupState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RollupStateMutation) OldWatermark(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWatermark is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWatermark requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWatermark: %w", err)
+	}
+	return oldValue.Watermark, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *VehicleMutation) SetOp(op Op) {
-	m.op = op
+// ResetWatermark resets all changes to the "watermark" field.
+func (m *RollupStateMutation) ResetWatermark() {
+	m.watermark = nil
 }
 
-// Type returns the node type of this mutation (Vehicle).
-func (m *VehicleMutation) Type() string {
-	return m.typ
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RollupStateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *VehicleMutation) Fields() []string {
-	fields := make([]string, 0, 13)
-	if m.vin != nil {
-		fields = append(fields, vehicle.FieldVin)
-	}
-	if m.user_id != nil {
-		fields = append(fields, vehicle.FieldUserID)
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RollupStateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	if m.display_name != nil {
-		fields = append(fields, vehicle.FieldDisplayName)
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RollupState entity.
+// If the RollupState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RollupStateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RollupStateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the RollupStateMutation builder.
+func (m *RollupStateMutation) Where(ps ...predicate.RollupState) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RollupStateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RollupStateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RollupState, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RollupStateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RollupStateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RollupState).
+func (m *RollupStateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RollupStateMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.vehicle_id != nil {
+		fields = append(fields, rollupstate.FieldVehicleID)
+	}
+	if m.time_zone != nil {
+		fields = append(fields, rollupstate.FieldTimeZone)
+	}
+	if m.watermark != nil {
+		fields = append(fields, rollupstate.FieldWatermark)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, rollupstate.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RollupStateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rollupstate.FieldVehicleID:
+		return m.VehicleID()
+	case rollupstate.FieldTimeZone:
+		return m.TimeZone()
+	case rollupstate.FieldWatermark:
+		return m.Watermark()
+	case rollupstate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RollupStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rollupstate.FieldVehicleID:
+		return m.OldVehicleID(ctx)
+	case rollupstate.FieldTimeZone:
+		return m.OldTimeZone(ctx)
+	case rollupstate.FieldWatermark:
+		return m.OldWatermark(ctx)
+	case rollupstate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RollupState field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RollupStateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rollupstate.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVehicleID(v)
+		return nil
+	case rollupstate.FieldTimeZone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeZone(v)
+		return nil
+	case rollupstate.FieldWatermark:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWatermark(v)
+		return nil
+	case rollupstate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RollupState field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RollupStateMutation) AddedFields() []string {
+	var fields []string
+	if m.addvehicle_id != nil {
+		fields = append(fields, rollupstate.FieldVehicleID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RollupStateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case rollupstate.FieldVehicleID:
+		return m.AddedVehicleID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RollupStateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case rollupstate.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVehicleID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RollupState numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RollupStateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RollupStateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RollupStateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RollupState nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RollupStateMutation) ResetField(name string) error {
+	switch name {
+	case rollupstate.FieldVehicleID:
+		m.ResetVehicleID()
+		return nil
+	case rollupstate.FieldTimeZone:
+		m.ResetTimeZone()
+		return nil
+	case rollupstate.FieldWatermark:
+		m.ResetWatermark()
+		return nil
+	case rollupstate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RollupState field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RollupStateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RollupStateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RollupStateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RollupStateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RollupStateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RollupStateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RollupStateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RollupState unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RollupStateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RollupState edge %s", name)
+}
+
+// SoftwareUpdateMutation represents an operation that mutates the SoftwareUpdate nodes in the graph.
+type SoftwareUpdateMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	vehicle_id           *int
+	addvehicle_id        *int
+	from_version         *string
+	version              *string
+	status               *string
+	download_perc        *int
+	adddownload_perc     *int
+	install_perc         *int
+	addinstall_perc      *int
+	expected_duration    *int
+	addexpected_duration *int
+	available_at         *time.Time
+	install_started_at   *time.Time
+	completed_at         *time.Time
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*SoftwareUpdate, error)
+	predicates           []predicate.SoftwareUpdate
+}
+
+var _ ent.Mutation = (*SoftwareUpdateMutation)(nil)
+
+// softwareupdateOption allows management of the mutation configuration using functional options.
+type softwareupdateOption func(*SoftwareUpdateMutation)
+
+// newSoftwareUpdateMutation creates new mutation for the SoftwareUpdate entity.
+func newSoftwareUpdateMutation(c config, op Op, opts ...softwareupdateOption) *SoftwareUpdateMutation {
+	m := &SoftwareUpdateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSoftwareUpdate,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSoftwareUpdateID sets the ID field of the mutation.
+func withSoftwareUpdateID(id int) softwareupdateOption {
+	return func(m *SoftwareUpdateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SoftwareUpdate
+		)
+		m.oldValue = func(ctx context.Context) (*SoftwareUpdate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SoftwareUpdate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSoftwareUpdate sets the old SoftwareUpdate of the mutation.
+func withSoftwareUpdate(node *SoftwareUpdate) softwareupdateOption {
+	return func(m *SoftwareUpdateMutation) {
+		m.oldValue = func(context.Context) (*SoftwareUpdate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SoftwareUpdateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SoftwareUpdateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SoftwareUpdateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SoftwareUpdateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SoftwareUpdate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (m *SoftwareUpdateMutation) SetVehicleID(i int) {
+	m.vehicle_id = &i
+	m.addvehicle_id = nil
+}
+
+// VehicleID returns the value of the "vehicle_id" field in the mutation.
+func (m *SoftwareUpdateMutation) VehicleID() (r int, exists bool) {
+	v := m.vehicle_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVehicleID returns the old "vehicle_id" field's value of the SoftwareUpdate entity.
+// If the SoftwareUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SoftwareUpdateMutation) OldVehicleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVehicleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVehicleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVehicleID: %w", err)
+	}
+	return oldValue.VehicleID, nil
+}
+
+// AddVehicleID adds i to the "vehicle_id" field.
+func (m *SoftwareUpdateMutation) AddVehicleID(i int) {
+	if m.addvehicle_id != nil {
+		*m.addvehicle_id += i
+	} else {
+		m.addvehicle_id = &i
+	}
+}
+
+// AddedVehicleID returns the value that was added to the "vehicle_id" field in this mutation.
+func (m *SoftwareUpdateMutation) AddedVehicleID() (r int, exists bool) {
+	v := m.addvehicle_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVehicleID resets all changes to the "vehicle_id" field.
+func (m *SoftwareUpdateMutation) ResetVehicleID() {
+	m.vehicle_id = nil
+	m.addvehicle_id = nil
+}
+
+// SetFromVersion sets the "from_version" field.
+func (m *SoftwareUpdateMutation) SetFromVersion(s string) {
+	m.from_version = &s
+}
+
+// FromVersion returns the value of the "from_version" field in the mutation.
+func (m *SoftwareUpdateMutation) FromVersion() (r string, exists bool) {
+	v := m.from_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromVersion returns the old "from_version" field's value of the SoftwareUpdate entity.
+// If the SoftwareUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SoftwareUpdateMutation) OldFromVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromVersion: %w", err)
+	}
+	return oldValue.FromVersion, nil
+}
+
+// ClearFromVersion clears the value of the "from_version" field.
+func (m *SoftwareUpdateMutation) ClearFromVersion() {
+	m.from_version = nil
+	m.clearedFields[softwareupdate.FieldFromVersion] = struct{}{}
+}
+
+// FromVersionCleared returns if the "from_version" field was cleared in this mutation.
+func (m *SoftwareUpdateMutation) FromVersionCleared() bool {
+	_, ok := m.clearedFields[softwareupdate.FieldFromVersion]
+	return ok
+}
+
+// ResetFromVersion resets all changes to the "from_version" field.
+func (m *SoftwareUpdateMutation) ResetFromVersion() {
+	m.from_version = nil
+	delete(m.clearedFields, softwareupdate.FieldFromVersion)
+}
+
+// SetVersion sets the "version" field.
+func (m *SoftwareUpdateMutation) SetVersion(s string) {
+	m.version = &s
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *SoftwareUpdateMutation) Version() (r string, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the SoftwareUpdate entity.
+// If the SoftwareUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SoftwareUpdateMutation) OldVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *SoftwareUpdateMutation) ResetVersion() {
+	m.version = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SoftwareUpdateMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SoftwareUpdateMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SoftwareUpdate entity.
+// If the SoftwareUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SoftwareUpdateMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SoftwareUpdateMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetDownloadPerc sets the "download_perc" field.
+func (m *SoftwareUpdateMutation) SetDownloadPerc(i int) {
+	m.download_perc = &i
+	m.adddownload_perc = nil
+}
+
+// DownloadPerc returns the value of the "download_perc" field in the mutation.
+func (m *SoftwareUpdateMutation) DownloadPerc() (r int, exists bool) {
+	v := m.download_perc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDownloadPerc returns the old "download_perc" field's value of the SoftwareUpdate entity.
+// If the SoftwareUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SoftwareUpdateMutation) OldDownloadPerc(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDownloadPerc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDownloadPerc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDownloadPerc: %w", err)
+	}
+	return oldValue.DownloadPerc, nil
+}
+
+// AddDownloadPerc adds i to the "download_perc" field.
+func (m *SoftwareUpdateMutation) AddDownloadPerc(i int) {
+	if m.adddownload_perc != nil {
+		*m.adddownload_perc += i
+	} else {
+		m.adddownload_perc = &i
+	}
+}
+
+// AddedDownloadPerc returns the value that was added to the "download_perc" field in this mutation.
+func (m *SoftwareUpdateMutation) AddedDownloadPerc() (r int, exists bool) {
+	v := m.adddownload_perc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDownloadPerc resets all changes to the "download_perc" field.
+func (m *SoftwareUpdateMutation) ResetDownloadPerc() {
+	m.download_perc = nil
+	m.adddownload_perc = nil
+}
+
+// SetInstallPerc sets the "install_perc" field.
+func (m *SoftwareUpdateMutation) SetInstallPerc(i int) {
+	m.install_perc = &i
+	m.addinstall_perc = nil
+}
+
+// InstallPerc returns the value of the "install_perc" field in the mutation.
+func (m *SoftwareUpdateMutation) InstallPerc() (r int, exists bool) {
+	v := m.install_perc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstallPerc returns the old "install_perc" field's value of the SoftwareUpdate entity.
+// If the SoftwareUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SoftwareUpdateMutation) OldInstallPerc(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstallPerc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstallPerc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstallPerc: %w", err)
+	}
+	return oldValue.InstallPerc, nil
+}
+
+// AddInstallPerc adds i to the "install_perc" field.
+func (m *SoftwareUpdateMutation) AddInstallPerc(i int) {
+	if m.addinstall_perc != nil {
+		*m.addinstall_perc += i
+	} else {
+		m.addinstall_perc = &i
+	}
+}
+
+// AddedInstallPerc returns the value that was added to the "install_perc" field in this mutation.
+func (m *SoftwareUpdateMutation) AddedInstallPerc() (r int, exists bool) {
+	v := m.addinstall_perc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInstallPerc resets all changes to the "install_perc" field.
+func (m *SoftwareUpdateMutation) ResetInstallPerc() {
+	m.install_perc = nil
+	m.addinstall_perc = nil
+}
+
+// SetExpectedDuration sets the "expected_duration" field.
+func (m *SoftwareUpdateMutation) SetExpectedDuration(i int) {
+	m.expected_duration = &i
+	m.addexpected_duration = nil
+}
+
+// ExpectedDuration returns the value of the "expected_duration" field in the mutation.
+func (m *SoftwareUpdateMutation) ExpectedDuration() (r int, exists bool) {
+	v := m.expected_duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpectedDuration returns the old "expected_duration" field's value of the SoftwareUpdate entity.
+// If the SoftwareUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SoftwareUpdateMutation) OldExpectedDuration(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpectedDuration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpectedDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpectedDuration: %w", err)
+	}
+	return oldValue.ExpectedDuration, nil
+}
+
+// AddExpectedDuration adds i to the "expected_duration" field.
+func (m *SoftwareUpdateMutation) AddExpectedDuration(i int) {
+	if m.addexpected_duration != nil {
+		*m.addexpected_duration += i
+	} else {
+		m.addexpected_duration = &i
+	}
+}
+
+// AddedExpectedDuration returns the value that was added to the "expected_duration" field in this mutation.
+func (m *SoftwareUpdateMutation) AddedExpectedDuration() (r int, exists bool) {
+	v := m.addexpected_duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExpectedDuration resets all changes to the "expected_duration" field.
+func (m *SoftwareUpdateMutation) ResetExpectedDuration() {
+	m.expected_duration = nil
+	m.addexpected_duration = nil
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (m *SoftwareUpdateMutation) SetAvailableAt(t time.Time) {
+	m.available_at = &t
+}
+
+// AvailableAt returns the value of the "available_at" field in the mutation.
+func (m *SoftwareUpdateMutation) AvailableAt() (r time.Time, exists bool) {
+	v := m.available_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvailableAt returns the old "available_at" field's value of the SoftwareUpdate entity.
+// If the SoftwareUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SoftwareUpdateMutation) OldAvailableAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvailableAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvailableAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvailableAt: %w", err)
+	}
+	return oldValue.AvailableAt, nil
+}
+
+// ResetAvailableAt resets all changes to the "available_at" field.
+func (m *SoftwareUpdateMutation) ResetAvailableAt() {
+	m.available_at = nil
+}
+
+// SetInstallStartedAt sets the "install_started_at" field.
+func (m *SoftwareUpdateMutation) SetInstallStartedAt(t time.Time) {
+	m.install_started_at = &t
+}
+
+// InstallStartedAt returns the value of the "install_started_at" field in the mutation.
+func (m *SoftwareUpdateMutation) InstallStartedAt() (r time.Time, exists bool) {
+	v := m.install_started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstallStartedAt returns the old "install_started_at" field's value of the SoftwareUpdate entity.
+// If the SoftwareUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SoftwareUpdateMutation) OldInstallStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstallStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstallStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstallStartedAt: %w", err)
+	}
+	return oldValue.InstallStartedAt, nil
+}
+
+// ClearInstallStartedAt clears the value of the "install_started_at" field.
+func (m *SoftwareUpdateMutation) ClearInstallStartedAt() {
+	m.install_started_at = nil
+	m.clearedFields[softwareupdate.FieldInstallStartedAt] = struct{}{}
+}
+
+// InstallStartedAtCleared returns if the "install_started_at" field was cleared in this mutation.
+func (m *SoftwareUpdateMutation) InstallStartedAtCleared() bool {
+	_, ok := m.clearedFields[softwareupdate.FieldInstallStartedAt]
+	return ok
+}
+
+// ResetInstallStartedAt resets all changes to the "install_started_at" field.
+func (m *SoftwareUpdateMutation) ResetInstallStartedAt() {
+	m.install_started_at = nil
+	delete(m.clearedFields, softwareupdate.FieldInstallStartedAt)
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *SoftwareUpdateMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *SoftwareUpdateMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the SoftwareUpdate entity.
+// If the SoftwareUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SoftwareUpdateMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *SoftwareUpdateMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[softwareupdate.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *SoftwareUpdateMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[softwareupdate.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *SoftwareUpdateMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, softwareupdate.FieldCompletedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SoftwareUpdateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SoftwareUpdateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SoftwareUpdate entity.
+// If the SoftwareUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SoftwareUpdateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SoftwareUpdateMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SoftwareUpdateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SoftwareUpdateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SoftwareUpdate entity.
+// If the SoftwareUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SoftwareUpdateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SoftwareUpdateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the SoftwareUpdateMutation builder.
+func (m *SoftwareUpdateMutation) Where(ps ...predicate.SoftwareUpdate) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SoftwareUpdateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SoftwareUpdateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SoftwareUpdate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SoftwareUpdateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SoftwareUpdateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SoftwareUpdate).
+func (m *SoftwareUpdateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SoftwareUpdateMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.vehicle_id != nil {
+		fields = append(fields, softwareupdate.FieldVehicleID)
+	}
+	if m.from_version != nil {
+		fields = append(fields, softwareupdate.FieldFromVersion)
+	}
+	if m.version != nil {
+		fields = append(fields, softwareupdate.FieldVersion)
+	}
+	if m.status != nil {
+		fields = append(fields, softwareupdate.FieldStatus)
+	}
+	if m.download_perc != nil {
+		fields = append(fields, softwareupdate.FieldDownloadPerc)
+	}
+	if m.install_perc != nil {
+		fields = append(fields, softwareupdate.FieldInstallPerc)
+	}
+	if m.expected_duration != nil {
+		fields = append(fields, softwareupdate.FieldExpectedDuration)
+	}
+	if m.available_at != nil {
+		fields = append(fields, softwareupdate.FieldAvailableAt)
+	}
+	if m.install_started_at != nil {
+		fields = append(fields, softwareupdate.FieldInstallStartedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, softwareupdate.FieldCompletedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, softwareupdate.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, softwareupdate.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SoftwareUpdateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case softwareupdate.FieldVehicleID:
+		return m.VehicleID()
+	case softwareupdate.FieldFromVersion:
+		return m.FromVersion()
+	case softwareupdate.FieldVersion:
+		return m.Version()
+	case softwareupdate.FieldStatus:
+		return m.Status()
+	case softwareupdate.FieldDownloadPerc:
+		return m.DownloadPerc()
+	case softwareupdate.FieldInstallPerc:
+		return m.InstallPerc()
+	case softwareupdate.FieldExpectedDuration:
+		return m.ExpectedDuration()
+	case softwareupdate.FieldAvailableAt:
+		return m.AvailableAt()
+	case softwareupdate.FieldInstallStartedAt:
+		return m.InstallStartedAt()
+	case softwareupdate.FieldCompletedAt:
+		return m.CompletedAt()
+	case softwareupdate.FieldCreatedAt:
+		return m.CreatedAt()
+	case softwareupdate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SoftwareUpdateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case softwareupdate.FieldVehicleID:
+		return m.OldVehicleID(ctx)
+	case softwareupdate.FieldFromVersion:
+		return m.OldFromVersion(ctx)
+	case softwareupdate.FieldVersion:
+		return m.OldVersion(ctx)
+	case softwareupdate.FieldStatus:
+		return m.OldStatus(ctx)
+	case softwareupdate.FieldDownloadPerc:
+		return m.OldDownloadPerc(ctx)
+	case softwareupdate.FieldInstallPerc:
+		return m.OldInstallPerc(ctx)
+	case softwareupdate.FieldExpectedDuration:
+		return m.OldExpectedDuration(ctx)
+	case softwareupdate.FieldAvailableAt:
+		return m.OldAvailableAt(ctx)
+	case softwareupdate.FieldInstallStartedAt:
+		return m.OldInstallStartedAt(ctx)
+	case softwareupdate.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case softwareupdate.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case softwareupdate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SoftwareUpdate field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SoftwareUpdateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case softwareupdate.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVehicleID(v)
+		return nil
+	case softwareupdate.FieldFromVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromVersion(v)
+		return nil
+	case softwareupdate.FieldVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case softwareupdate.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case softwareupdate.FieldDownloadPerc:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDownloadPerc(v)
+		return nil
+	case softwareupdate.FieldInstallPerc:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstallPerc(v)
+		return nil
+	case softwareupdate.FieldExpectedDuration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpectedDuration(v)
+		return nil
+	case softwareupdate.FieldAvailableAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvailableAt(v)
+		return nil
+	case softwareupdate.FieldInstallStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstallStartedAt(v)
+		return nil
+	case softwareupdate.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case softwareupdate.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case softwareupdate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SoftwareUpdate field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SoftwareUpdateMutation) AddedFields() []string {
+	var fields []string
+	if m.addvehicle_id != nil {
+		fields = append(fields, softwareupdate.FieldVehicleID)
+	}
+	if m.adddownload_perc != nil {
+		fields = append(fields, softwareupdate.FieldDownloadPerc)
+	}
+	if m.addinstall_perc != nil {
+		fields = append(fields, softwareupdate.FieldInstallPerc)
+	}
+	if m.addexpected_duration != nil {
+		fields = append(fields, softwareupdate.FieldExpectedDuration)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SoftwareUpdateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case softwareupdate.FieldVehicleID:
+		return m.AddedVehicleID()
+	case softwareupdate.FieldDownloadPerc:
+		return m.AddedDownloadPerc()
+	case softwareupdate.FieldInstallPerc:
+		return m.AddedInstallPerc()
+	case softwareupdate.FieldExpectedDuration:
+		return m.AddedExpectedDuration()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SoftwareUpdateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case softwareupdate.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVehicleID(v)
+		return nil
+	case softwareupdate.FieldDownloadPerc:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDownloadPerc(v)
+		return nil
+	case softwareupdate.FieldInstallPerc:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInstallPerc(v)
+		return nil
+	case softwareupdate.FieldExpectedDuration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpectedDuration(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SoftwareUpdate numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SoftwareUpdateMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(softwareupdate.FieldFromVersion) {
+		fields = append(fields, softwareupdate.FieldFromVersion)
+	}
+	if m.FieldCleared(softwareupdate.FieldInstallStartedAt) {
+		fields = append(fields, softwareupdate.FieldInstallStartedAt)
+	}
+	if m.FieldCleared(softwareupdate.FieldCompletedAt) {
+		fields = append(fields, softwareupdate.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SoftwareUpdateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SoftwareUpdateMutation) ClearField(name string) error {
+	switch name {
+	case softwareupdate.FieldFromVersion:
+		m.ClearFromVersion()
+		return nil
+	case softwareupdate.FieldInstallStartedAt:
+		m.ClearInstallStartedAt()
+		return nil
+	case softwareupdate.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SoftwareUpdate nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SoftwareUpdateMutation) ResetField(name string) error {
+	switch name {
+	case softwareupdate.FieldVehicleID:
+		m.ResetVehicleID()
+		return nil
+	case softwareupdate.FieldFromVersion:
+		m.ResetFromVersion()
+		return nil
+	case softwareupdate.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case softwareupdate.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case softwareupdate.FieldDownloadPerc:
+		m.ResetDownloadPerc()
+		return nil
+	case softwareupdate.FieldInstallPerc:
+		m.ResetInstallPerc()
+		return nil
+	case softwareupdate.FieldExpectedDuration:
+		m.ResetExpectedDuration()
+		return nil
+	case softwareupdate.FieldAvailableAt:
+		m.ResetAvailableAt()
+		return nil
+	case softwareupdate.FieldInstallStartedAt:
+		m.ResetInstallStartedAt()
+		return nil
+	case softwareupdate.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case softwareupdate.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case softwareupdate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SoftwareUpdate field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SoftwareUpdateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SoftwareUpdateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SoftwareUpdateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SoftwareUpdateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SoftwareUpdateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SoftwareUpdateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SoftwareUpdateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SoftwareUpdate unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SoftwareUpdateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SoftwareUpdate edge %s", name)
+}
+
+// TariffMutation represents an operation that mutates the Tariff nodes in the graph.
+type TariffMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	user_id        *int
+	adduser_id     *int
+	name           *string
+	kind           *string
+	geofence_id    *int
+	addgeofence_id *int
+	currency       *string
+	price          *float64
+	addprice       *float64
+	windows        *[]pricing.Window
+	appendwindows  []pricing.Window
+	time_zone      *string
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*Tariff, error)
+	predicates     []predicate.Tariff
+}
+
+var _ ent.Mutation = (*TariffMutation)(nil)
+
+// tariffOption allows management of the mutation configuration using functional options.
+type tariffOption func(*TariffMutation)
+
+// newTariffMutation creates new mutation for the Tariff entity.
+func newTariffMutation(c config, op Op, opts ...tariffOption) *TariffMutation {
+	m := &TariffMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTariff,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTariffID sets the ID field of the mutation.
+func withTariffID(id int) tariffOption {
+	return func(m *TariffMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tariff
+		)
+		m.oldValue = func(ctx context.Context) (*Tariff, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tariff.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTariff sets the old Tariff of the mutation.
+func withTariff(node *Tariff) tariffOption {
+	return func(m *TariffMutation) {
+		m.oldValue = func(context.Context) (*Tariff, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TariffMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TariffMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TariffMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TariffMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tariff.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *TariffMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TariffMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Tariff entity.
+// If the Tariff object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TariffMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *TariffMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *TariffMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TariffMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *TariffMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TariffMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tariff entity.
+// If the Tariff object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TariffMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TariffMutation) ResetName() {
+	m.name = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *TariffMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *TariffMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Tariff entity.
+// If the Tariff object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TariffMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *TariffMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetGeofenceID sets the "geofence_id" field.
+func (m *TariffMutation) SetGeofenceID(i int) {
+	m.geofence_id = &i
+	m.addgeofence_id = nil
+}
+
+// GeofenceID returns the value of the "geofence_id" field in the mutation.
+func (m *TariffMutation) GeofenceID() (r int, exists bool) {
+	v := m.geofence_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGeofenceID returns the old "geofence_id" field's value of the Tariff entity.
+// If the Tariff object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TariffMutation) OldGeofenceID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGeofenceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGeofenceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGeofenceID: %w", err)
+	}
+	return oldValue.GeofenceID, nil
+}
+
+// AddGeofenceID adds i to the "geofence_id" field.
+func (m *TariffMutation) AddGeofenceID(i int) {
+	if m.addgeofence_id != nil {
+		*m.addgeofence_id += i
+	} else {
+		m.addgeofence_id = &i
+	}
+}
+
+// AddedGeofenceID returns the value that was added to the "geofence_id" field in this mutation.
+func (m *TariffMutation) AddedGeofenceID() (r int, exists bool) {
+	v := m.addgeofence_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearGeofenceID clears the value of the "geofence_id" field.
+func (m *TariffMutation) ClearGeofenceID() {
+	m.geofence_id = nil
+	m.addgeofence_id = nil
+	m.clearedFields[tariff.FieldGeofenceID] = struct{}{}
+}
+
+// GeofenceIDCleared returns if the "geofence_id" field was cleared in this mutation.
+func (m *TariffMutation) GeofenceIDCleared() bool {
+	_, ok := m.clearedFields[tariff.FieldGeofenceID]
+	return ok
+}
+
+// ResetGeofenceID resets all changes to the "geofence_id" field.
+func (m *TariffMutation) ResetGeofenceID() {
+	m.geofence_id = nil
+	m.addgeofence_id = nil
+	delete(m.clearedFields, tariff.FieldGeofenceID)
+}
+
+// SetCurrency sets the "currency" field.
+func (m *TariffMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *TariffMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Tariff entity.
+// If the Tariff object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TariffMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *TariffMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetPrice sets the "price" field.
+func (m *TariffMutation) SetPrice(f float64) {
+	m.price = &f
+	m.addprice = nil
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *TariffMutation) Price() (r float64, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the Tariff entity.
+// If the Tariff object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TariffMutation) OldPrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// AddPrice adds f to the "price" field.
+func (m *TariffMutation) AddPrice(f float64) {
+	if m.addprice != nil {
+		*m.addprice += f
+	} else {
+		m.addprice = &f
+	}
+}
+
+// AddedPrice returns the value that was added to the "price" field in this mutation.
+func (m *TariffMutation) AddedPrice() (r float64, exists bool) {
+	v := m.addprice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *TariffMutation) ResetPrice() {
+	m.price = nil
+	m.addprice = nil
+}
+
+// SetWindows sets the "windows" field.
+func (m *TariffMutation) SetWindows(pr []pricing.Window) {
+	m.windows = &pr
+	m.appendwindows = nil
+}
+
+// Windows returns the value of the "windows" field in the mutation.
+func (m *TariffMutation) Windows() (r []pricing.Window, exists bool) {
+	v := m.windows
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWindows returns the old "windows" field's value of the Tariff entity.
+// If the Tariff object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TariffMutation) OldWindows(ctx context.Context) (v []pricing.Window, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWindows is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWindows requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWindows: %w", err)
+	}
+	return oldValue.Windows, nil
+}
+
+// AppendWindows adds pr to the "windows" field.
+func (m *TariffMutation) AppendWindows(pr []pricing.Window) {
+	m.appendwindows = append(m.appendwindows, pr...)
+}
+
+// AppendedWindows returns the list of values that were appended to the "windows" field in this mutation.
+func (m *TariffMutation) AppendedWindows() ([]pricing.Window, bool) {
+	if len(m.appendwindows) == 0 {
+		return nil, false
+	}
+	return m.appendwindows, true
+}
+
+// ClearWindows clears the value of the "windows" field.
+func (m *TariffMutation) ClearWindows() {
+	m.windows = nil
+	m.appendwindows = nil
+	m.clearedFields[tariff.FieldWindows] = struct{}{}
+}
+
+// WindowsCleared returns if the "windows" field was cleared in this mutation.
+func (m *TariffMutation) WindowsCleared() bool {
+	_, ok := m.clearedFields[tariff.FieldWindows]
+	return ok
+}
+
+// ResetWindows resets all changes to the "windows" field.
+func (m *TariffMutation) ResetWindows() {
+	m.windows = nil
+	m.appendwindows = nil
+	delete(m.clearedFields, tariff.FieldWindows)
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (m *TariffMutation) SetTimeZone(s string) {
+	m.time_zone = &s
+}
+
+// TimeZone returns the value of the "time_zone" field in the mutation.
+func (m *TariffMutation) TimeZone() (r string, exists bool) {
+	v := m.time_zone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeZone returns the old "time_zone" field's value of the Tariff entity.
+// If the Tariff object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TariffMutation) OldTimeZone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeZone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeZone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeZone: %w", err)
+	}
+	return oldValue.TimeZone, nil
+}
+
+// ResetTimeZone resets all changes to the "time_zone" field.
+func (m *TariffMutation) ResetTimeZone() {
+	m.time_zone = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TariffMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TariffMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Tariff entity.
+// If the Tariff object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TariffMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TariffMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TariffMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TariffMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Tariff entity.
+// If the Tariff object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TariffMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TariffMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the TariffMutation builder.
+func (m *TariffMutation) Where(ps ...predicate.Tariff) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TariffMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TariffMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tariff, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TariffMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TariffMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tariff).
+func (m *TariffMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TariffMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.user_id != nil {
+		fields = append(fields, tariff.FieldUserID)
+	}
+	if m.name != nil {
+		fields = append(fields, tariff.FieldName)
+	}
+	if m.kind != nil {
+		fields = append(fields, tariff.FieldKind)
+	}
+	if m.geofence_id != nil {
+		fields = append(fields, tariff.FieldGeofenceID)
+	}
+	if m.currency != nil {
+		fields = append(fields, tariff.FieldCurrency)
+	}
+	if m.price != nil {
+		fields = append(fields, tariff.FieldPrice)
+	}
+	if m.windows != nil {
+		fields = append(fields, tariff.FieldWindows)
+	}
+	if m.time_zone != nil {
+		fields = append(fields, tariff.FieldTimeZone)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tariff.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, tariff.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TariffMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tariff.FieldUserID:
+		return m.UserID()
+	case tariff.FieldName:
+		return m.Name()
+	case tariff.FieldKind:
+		return m.Kind()
+	case tariff.FieldGeofenceID:
+		return m.GeofenceID()
+	case tariff.FieldCurrency:
+		return m.Currency()
+	case tariff.FieldPrice:
+		return m.Price()
+	case tariff.FieldWindows:
+		return m.Windows()
+	case tariff.FieldTimeZone:
+		return m.TimeZone()
+	case tariff.FieldCreatedAt:
+		return m.CreatedAt()
+	case tariff.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TariffMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tariff.FieldUserID:
+		return m.OldUserID(ctx)
+	case tariff.FieldName:
+		return m.OldName(ctx)
+	case tariff.FieldKind:
+		return m.OldKind(ctx)
+	case tariff.FieldGeofenceID:
+		return m.OldGeofenceID(ctx)
+	case tariff.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case tariff.FieldPrice:
+		return m.OldPrice(ctx)
+	case tariff.FieldWindows:
+		return m.OldWindows(ctx)
+	case tariff.FieldTimeZone:
+		return m.OldTimeZone(ctx)
+	case tariff.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case tariff.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tariff field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TariffMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tariff.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case tariff.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tariff.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case tariff.FieldGeofenceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGeofenceID(v)
+		return nil
+	case tariff.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case tariff.FieldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case tariff.FieldWindows:
+		v, ok := value.([]pricing.Window)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWindows(v)
+		return nil
+	case tariff.FieldTimeZone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeZone(v)
+		return nil
+	case tariff.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case tariff.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tariff field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TariffMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, tariff.FieldUserID)
+	}
+	if m.addgeofence_id != nil {
+		fields = append(fields, tariff.FieldGeofenceID)
+	}
+	if m.addprice != nil {
+		fields = append(fields, tariff.FieldPrice)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TariffMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tariff.FieldUserID:
+		return m.AddedUserID()
+	case tariff.FieldGeofenceID:
+		return m.AddedGeofenceID()
+	case tariff.FieldPrice:
+		return m.AddedPrice()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TariffMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tariff.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case tariff.FieldGeofenceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGeofenceID(v)
+		return nil
+	case tariff.FieldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrice(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tariff numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TariffMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tariff.FieldGeofenceID) {
+		fields = append(fields, tariff.FieldGeofenceID)
+	}
+	if m.FieldCleared(tariff.FieldWindows) {
+		fields = append(fields, tariff.FieldWindows)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TariffMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TariffMutation) ClearField(name string) error {
+	switch name {
+	case tariff.FieldGeofenceID:
+		m.ClearGeofenceID()
+		return nil
+	case tariff.FieldWindows:
+		m.ClearWindows()
+		return nil
+	}
+	return fmt.Errorf("unknown Tariff nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TariffMutation) ResetField(name string) error {
+	switch name {
+	case tariff.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case tariff.FieldName:
+		m.ResetName()
+		return nil
+	case tariff.FieldKind:
+		m.ResetKind()
+		return nil
+	case tariff.FieldGeofenceID:
+		m.ResetGeofenceID()
+		return nil
+	case tariff.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case tariff.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case tariff.FieldWindows:
+		m.ResetWindows()
+		return nil
+	case tariff.FieldTimeZone:
+		m.ResetTimeZone()
+		return nil
+	case tariff.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case tariff.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Tariff field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TariffMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TariffMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TariffMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TariffMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TariffMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TariffMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TariffMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Tariff unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TariffMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Tariff edge %s", name)
+}
+
+// TirePressureMutation represents an operation that mutates the TirePressure nodes in the graph.
+type TirePressureMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	vehicle_id           *int
+	addvehicle_id        *int
+	pressure_fl          *float64
+	addpressure_fl       *float64
+	pressure_fr          *float64
+	addpressure_fr       *float64
+	pressure_rl          *float64
+	addpressure_rl       *float64
+	pressure_rr          *float64
+	addpressure_rr       *float64
+	seen_at_fl           *time.Time
+	seen_at_fr           *time.Time
+	seen_at_rl           *time.Time
+	seen_at_rr           *time.Time
+	recommended_front    *float64
+	addrecommended_front *float64
+	recommended_rear     *float64
+	addrecommended_rear  *float64
+	soft_warnings        *int
+	addsoft_warnings     *int
+	hard_warnings        *int
+	addhard_warnings     *int
+	unit                 *string
+	created_at           *time.Time
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*TirePressure, error)
+	predicates           []predicate.TirePressure
+}
+
+var _ ent.Mutation = (*TirePressureMutation)(nil)
+
+// tirepressureOption allows management of the mutation configuration using functional options.
+type tirepressureOption func(*TirePressureMutation)
+
+// newTirePressureMutation creates new mutation for the TirePressure entity.
+func newTirePressureMutation(c config, op Op, opts ...tirepressureOption) *TirePressureMutation {
+	m := &TirePressureMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTirePressure,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTirePressureID sets the ID field of the mutation.
+func withTirePressureID(id int) tirepressureOption {
+	return func(m *TirePressureMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TirePressure
+		)
+		m.oldValue = func(ctx context.Context) (*TirePressure, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TirePressure.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTirePressure sets the old TirePressure of the mutation.
+func withTirePressure(node *TirePressure) tirepressureOption {
+	return func(m *TirePressureMutation) {
+		m.oldValue = func(context.Context) (*TirePressure, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TirePressureMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TirePressureMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TirePressureMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TirePressureMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TirePressure.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (m *TirePressureMutation) SetVehicleID(i int) {
+	m.vehicle_id = &i
+	m.addvehicle_id = nil
+}
+
+// VehicleID returns the value of the "vehicle_id" field in the mutation.
+func (m *TirePressureMutation) VehicleID() (r int, exists bool) {
+	v := m.vehicle_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVehicleID returns the old "vehicle_id" field's value of the TirePressure entity.
+// If the TirePressure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TirePressureMutation) OldVehicleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVehicleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVehicleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVehicleID: %w", err)
+	}
+	return oldValue.VehicleID, nil
+}
+
+// AddVehicleID adds i to the "vehicle_id" field.
+func (m *TirePressureMutation) AddVehicleID(i int) {
+	if m.addvehicle_id != nil {
+		*m.addvehicle_id += i
+	} else {
+		m.addvehicle_id = &i
+	}
+}
+
+// AddedVehicleID returns the value that was added to the "vehicle_id" field in this mutation.
+func (m *TirePressureMutation) AddedVehicleID() (r int, exists bool) {
+	v := m.addvehicle_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVehicleID resets all changes to the "vehicle_id" field.
+func (m *TirePressureMutation) ResetVehicleID() {
+	m.vehicle_id = nil
+	m.addvehicle_id = nil
+}
+
+// SetPressureFl sets the "pressure_fl" field.
+func (m *TirePressureMutation) SetPressureFl(f float64) {
+	m.pressure_fl = &f
+	m.addpressure_fl = nil
+}
+
+// PressureFl returns the value of the "pressure_fl" field in the mutation.
+func (m *TirePressureMutation) PressureFl() (r float64, exists bool) {
+	v := m.pressure_fl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPressureFl returns the old "pressure_fl" field's value of the TirePressure entity.
+// If the TirePressure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TirePressureMutation) OldPressureFl(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPressureFl is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPressureFl requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPressureFl: %w", err)
+	}
+	return oldValue.PressureFl, nil
+}
+
+// AddPressureFl adds f to the "pressure_fl" field.
+func (m *TirePressureMutation) AddPressureFl(f float64) {
+	if m.addpressure_fl != nil {
+		*m.addpressure_fl += f
+	} else {
+		m.addpressure_fl = &f
+	}
+}
+
+// AddedPressureFl returns the value that was added to the "pressure_fl" field in this mutation.
+func (m *TirePressureMutation) AddedPressureFl() (r float64, exists bool) {
+	v := m.addpressure_fl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPressureFl clears the value of the "pressure_fl" field.
+func (m *TirePressureMutation) ClearPressureFl() {
+	m.pressure_fl = nil
+	m.addpressure_fl = nil
+	m.clearedFields[tirepressure.FieldPressureFl] = struct{}{}
+}
+
+// PressureFlCleared returns if the "pressure_fl" field was cleared in this mutation.
+func (m *TirePressureMutation) PressureFlCleared() bool {
+	_, ok := m.clearedFields[tirepressure.FieldPressureFl]
+	return ok
+}
+
+// ResetPressureFl resets all changes to the "pressure_fl" field.
+func (m *TirePressureMutation) ResetPressureFl() {
+	m.pressure_fl = nil
+	m.addpressure_fl = nil
+	delete(m.clearedFields, tirepressure.FieldPressureFl)
+}
+
+// SetPressureFr sets the "pressure_fr" field.
+func (m *TirePressureMutation) SetPressureFr(f float64) {
+	m.pressure_fr = &f
+	m.addpressure_fr = nil
+}
+
+// PressureFr returns the value of the "pressure_fr" field in the mutation.
+func (m *TirePressureMutation) PressureFr() (r float64, exists bool) {
+	v := m.pressure_fr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPressureFr returns the old "pressure_fr" field's value of the TirePressure entity.
+// If the TirePressure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TirePressureMutation) OldPressureFr(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPressureFr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPressureFr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPressureFr: %w", err)
+	}
+	return oldValue.PressureFr, nil
+}
+
+// AddPressureFr adds f to the "pressure_fr" field.
+func (m *TirePressureMutation) AddPressureFr(f float64) {
+	if m.addpressure_fr != nil {
+		*m.addpressure_fr += f
+	} else {
+		m.addpressure_fr = &f
+	}
+}
+
+// AddedPressureFr returns the value that was added to the "pressure_fr" field in this mutation.
+func (m *TirePressureMutation) AddedPressureFr() (r float64, exists bool) {
+	v := m.addpressure_fr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPressureFr clears the value of the "pressure_fr" field.
+func (m *TirePressureMutation) ClearPressureFr() {
+	m.pressure_fr = nil
+	m.addpressure_fr = nil
+	m.clearedFields[tirepressure.FieldPressureFr] = struct{}{}
+}
+
+// PressureFrCleared returns if the "pressure_fr" field was cleared in this mutation.
+func (m *TirePressureMutation) PressureFrCleared() bool {
+	_, ok := m.clearedFields[tirepressure.FieldPressureFr]
+	return ok
+}
+
+// ResetPressureFr resets all changes to the "pressure_fr" field.
+func (m *TirePressureMutation) ResetPressureFr() {
+	m.pressure_fr = nil
+	m.addpressure_fr = nil
+	delete(m.clearedFields, tirepressure.FieldPressureFr)
+}
+
+// SetPressureRl sets the "pressure_rl" field.
+func (m *TirePressureMutation) SetPressureRl(f float64) {
+	m.pressure_rl = &f
+	m.addpressure_rl = nil
+}
+
+// PressureRl returns the value of the "pressure_rl" field in the mutation.
+func (m *TirePressureMutation) PressureRl() (r float64, exists bool) {
+	v := m.pressure_rl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPressureRl returns the old "pressure_rl" field's value of the TirePressure entity.
+// If the TirePressure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TirePressureMutation) OldPressureRl(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPressureRl is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPressureRl requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPressureRl: %w", err)
+	}
+	return oldValue.PressureRl, nil
+}
+
+// AddPressureRl adds f to the "pressure_rl" field.
+func (m *TirePressureMutation) AddPressureRl(f float64) {
+	if m.addpressure_rl != nil {
+		*m.addpressure_rl += f
+	} else {
+		m.addpressure_rl = &f
+	}
+}
+
+// AddedPressureRl returns the value that was added to the "pressure_rl" field in this mutation.
+func (m *TirePressureMutation) AddedPressureRl() (r float64, exists bool) {
+	v := m.addpressure_rl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPressureRl clears the value of the "pressure_rl" field.
+func (m *TirePressureMutation) ClearPressureRl() {
+	m.pressure_rl = nil
+	m.addpressure_rl = nil
+	m.clearedFields[tirepressure.FieldPressureRl] = struct{}{}
+}
+
+// PressureRlCleared returns if the "pressure_rl" field was cleared in this mutation.
+func (m *TirePressureMutation) PressureRlCleared() bool {
+	_, ok := m.clearedFields[tirepressure.FieldPressureRl]
+	return ok
+}
+
+// ResetPressureRl resets all changes to the "pressure_rl" field.
+func (m *TirePressureMutation) ResetPressureRl() {
+	m.pressure_rl = nil
+	m.addpressure_rl = nil
+	delete(m.clearedFields, tirepressure.FieldPressureRl)
+}
+
+// SetPressureRr sets the "pressure_rr" field.
+func (m *TirePressureMutation) SetPressureRr(f float64) {
+	m.pressure_rr = &f
+	m.addpressure_rr = nil
+}
+
+// PressureRr returns the value of the "pressure_rr" field in the mutation.
+func (m *TirePressureMutation) PressureRr() (r float64, exists bool) {
+	v := m.pressure_rr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPressureRr returns the old "pressure_rr" field's value of the TirePressure entity.
+// If the TirePressure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TirePressureMutation) OldPressureRr(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPressureRr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPressureRr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPressureRr: %w", err)
+	}
+	return oldValue.PressureRr, nil
+}
+
+// AddPressureRr adds f to the "pressure_rr" field.
+func (m *TirePressureMutation) AddPressureRr(f float64) {
+	if m.addpressure_rr != nil {
+		*m.addpressure_rr += f
+	} else {
+		m.addpressure_rr = &f
+	}
+}
+
+// AddedPressureRr returns the value that was added to the "pressure_rr" field in this mutation.
+func (m *TirePressureMutation) AddedPressureRr() (r float64, exists bool) {
+	v := m.addpressure_rr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPressureRr clears the value of the "pressure_rr" field.
+func (m *TirePressureMutation) ClearPressureRr() {
+	m.pressure_rr = nil
+	m.addpressure_rr = nil
+	m.clearedFields[tirepressure.FieldPressureRr] = struct{}{}
+}
+
+// PressureRrCleared returns if the "pressure_rr" field was cleared in this mutation.
+func (m *TirePressureMutation) PressureRrCleared() bool {
+	_, ok := m.clearedFields[tirepressure.FieldPressureRr]
+	return ok
+}
+
+// ResetPressureRr resets all changes to the "pressure_rr" field.
+func (m *TirePressureMutation) ResetPressureRr() {
+	m.pressure_rr = nil
+	m.addpressure_rr = nil
+	delete(m.clearedFields, tirepressure.FieldPressureRr)
+}
+
+// SetSeenAtFl sets the "seen_at_fl" field.
+func (m *TirePressureMutation) SetSeenAtFl(t time.Time) {
+	m.seen_at_fl = &t
+}
+
+// SeenAtFl returns the value of the "seen_at_fl" field in the mutation.
+func (m *TirePressureMutation) SeenAtFl() (r time.Time, exists bool) {
+	v := m.seen_at_fl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeenAtFl returns the old "seen_at_fl" field's value of the TirePressure entity.
+// If the TirePressure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TirePressureMutation) OldSeenAtFl(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeenAtFl is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeenAtFl requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeenAtFl: %w", err)
+	}
+	return oldValue.SeenAtFl, nil
+}
+
+// ClearSeenAtFl clears the value of the "seen_at_fl" field.
+func (m *TirePressureMutation) ClearSeenAtFl() {
+	m.seen_at_fl = nil
+	m.clearedFields[tirepressure.FieldSeenAtFl] = struct{}{}
+}
+
+// SeenAtFlCleared returns if the "seen_at_fl" field was cleared in this mutation.
+func (m *TirePressureMutation) SeenAtFlCleared() bool {
+	_, ok := m.clearedFields[tirepressure.FieldSeenAtFl]
+	return ok
+}
+
+// ResetSeenAtFl resets all changes to the "seen_at_fl" field.
+func (m *TirePressureMutation) ResetSeenAtFl() {
+	m.seen_at_fl = nil
+	delete(m.clearedFields, tirepressure.FieldSeenAtFl)
+}
+
+// SetSeenAtFr sets the "seen_at_fr" field.
+func (m *TirePressureMutation) SetSeenAtFr(t time.Time) {
+	m.seen_at_fr = &t
+}
+
+// SeenAtFr returns the value of the "seen_at_fr" field in the mutation.
+func (m *TirePressureMutation) SeenAtFr() (r time.Time, exists bool) {
+	v := m.seen_at_fr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeenAtFr returns the old "seen_at_fr" field's value of the TirePressure entity.
+// If the TirePressure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TirePressureMutation) OldSeenAtFr(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeenAtFr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeenAtFr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeenAtFr: %w", err)
+	}
+	return oldValue.SeenAtFr, nil
+}
+
+// ClearSeenAtFr clears the value of the "seen_at_fr" field.
+func (m *TirePressureMutation) ClearSeenAtFr() {
+	m.seen_at_fr = nil
+	m.clearedFields[tirepressure.FieldSeenAtFr] = struct{}{}
+}
+
+// SeenAtFrCleared returns if the "seen_at_fr" field was cleared in this mutation.
+func (m *TirePressureMutation) SeenAtFrCleared() bool {
+	_, ok := m.clearedFields[tirepressure.FieldSeenAtFr]
+	return ok
+}
+
+// ResetSeenAtFr resets all changes to the "seen_at_fr" field.
+func (m *TirePressureMutation) ResetSeenAtFr() {
+	m.seen_at_fr = nil
+	delete(m.clearedFields, tirepressure.FieldSeenAtFr)
+}
+
+// SetSeenAtRl sets the "seen_at_rl" field.
+func (m *TirePressureMutation) SetSeenAtRl(t time.Time) {
+	m.seen_at_rl = &t
+}
+
+// SeenAtRl returns the value of the "seen_at_rl" field in the mutation.
+func (m *TirePressureMutation) SeenAtRl() (r time.Time, exists bool) {
+	v := m.seen_at_rl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeenAtRl returns the old "seen_at_rl" field's value of the TirePressure entity.
+// If the TirePressure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TirePressureMutation) OldSeenAtRl(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeenAtRl is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeenAtRl requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeenAtRl: %w", err)
+	}
+	return oldValue.SeenAtRl, nil
+}
+
+// ClearSeenAtRl clears the value of the "seen_at_rl" field.
+func (m *TirePressureMutation) ClearSeenAtRl() {
+	m.seen_at_rl = nil
+	m.clearedFields[tirepressure.FieldSeenAtRl] = struct{}{}
+}
+
+// SeenAtRlCleared returns if the "seen_at_rl" field was cleared in this mutation.
+func (m *TirePressureMutation) SeenAtRlCleared() bool {
+	_, ok := m.clearedFields[tirepressure.FieldSeenAtRl]
+	return ok
+}
+
+// ResetSeenAtRl resets all changes to the "seen_at_rl" field.
+func (m *TirePressureMutation) ResetSeenAtRl() {
+	m.seen_at_rl = nil
+	delete(m.clearedFields, tirepressure.FieldSeenAtRl)
+}
+
+// SetSeenAtRr sets the "seen_at_rr" field.
+func (m *TirePressureMutation) SetSeenAtRr(t time.Time) {
+	m.seen_at_rr = &t
+}
+
+// SeenAtRr returns the value of the "seen_at_rr" field in the mutation.
+func (m *TirePressureMutation) SeenAtRr() (r time.Time, exists bool) {
+	v := m.seen_at_rr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeenAtRr returns the old "seen_at_rr" field's value of the TirePressure entity.
+// If the TirePressure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TirePressureMutation) OldSeenAtRr(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeenAtRr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeenAtRr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeenAtRr: %w", err)
+	}
+	return oldValue.SeenAtRr, nil
+}
+
+// ClearSeenAtRr clears the value of the "seen_at_rr" field.
+func (m *TirePressureMutation) ClearSeenAtRr() {
+	m.seen_at_rr = nil
+	m.clearedFields[tirepressure.FieldSeenAtRr] = struct{}{}
+}
+
+// SeenAtRrCleared returns if the "seen_at_rr" field was cleared in this mutation.
+func (m *TirePressureMutation) SeenAtRrCleared() bool {
+	_, ok := m.clearedFields[tirepressure.FieldSeenAtRr]
+	return ok
+}
+
+// ResetSeenAtRr resets all changes to the "seen_at_rr" field.
+func (m *TirePressureMutation) ResetSeenAtRr() {
+	m.seen_at_rr = nil
+	delete(m.clearedFields, tirepressure.FieldSeenAtRr)
+}
+
+// SetRecommendedFront sets the "recommended_front" field.
+func (m *TirePressureMutation) SetRecommendedFront(f float64) {
+	m.recommended_front = &f
+	m.addrecommended_front = nil
+}
+
+// RecommendedFront returns the value of the "recommended_front" field in the mutation.
+func (m *TirePressureMutation) RecommendedFront() (r float64, exists bool) {
+	v := m.recommended_front
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecommendedFront returns the old "recommended_front" field's value of the TirePressure entity.
+// If the TirePressure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TirePressureMutation) OldRecommendedFront(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecommendedFront is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecommendedFront requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecommendedFront: %w", err)
+	}
+	return oldValue.RecommendedFront, nil
+}
+
+// AddRecommendedFront adds f to the "recommended_front" field.
+func (m *TirePressureMutation) AddRecommendedFront(f float64) {
+	if m.addrecommended_front != nil {
+		*m.addrecommended_front += f
+	} else {
+		m.addrecommended_front = &f
+	}
+}
+
+// AddedRecommendedFront returns the value that was added to the "recommended_front" field in this mutation.
+func (m *TirePressureMutation) AddedRecommendedFront() (r float64, exists bool) {
+	v := m.addrecommended_front
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRecommendedFront clears the value of the "recommended_front" field.
+func (m *TirePressureMutation) ClearRecommendedFront() {
+	m.recommended_front = nil
+	m.addrecommended_front = nil
+	m.clearedFields[tirepressure.FieldRecommendedFront] = struct{}{}
+}
+
+// RecommendedFrontCleared returns if the "recommended_front" field was cleared in this mutation.
+func (m *TirePressureMutation) RecommendedFrontCleared() bool {
+	_, ok := m.clearedFields[tirepressure.FieldRecommendedFront]
+	return ok
+}
+
+// ResetRecommendedFront resets all changes to the "recommended_front" field.
+func (m *TirePressureMutation) ResetRecommendedFront() {
+	m.recommended_front = nil
+	m.addrecommended_front = nil
+	delete(m.clearedFields, tirepressure.FieldRecommendedFront)
+}
+
+// SetRecommendedRear sets the "recommended_rear" field.
+func (m *TirePressureMutation) SetRecommendedRear(f float64) {
+	m.recommended_rear = &f
+	m.addrecommended_rear = nil
+}
+
+// RecommendedRear returns the value of the "recommended_rear" field in the mutation.
+func (m *TirePressureMutation) RecommendedRear() (r float64, exists bool) {
+	v := m.recommended_rear
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecommendedRear returns the old "recommended_rear" field's value of the TirePressure entity.
+// If the TirePressure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TirePressureMutation) OldRecommendedRear(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecommendedRear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecommendedRear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecommendedRear: %w", err)
+	}
+	return oldValue.RecommendedRear, nil
+}
+
+// AddRecommendedRear adds f to the "recommended_rear" field.
+func (m *TirePressureMutation) AddRecommendedRear(f float64) {
+	if m.addrecommended_rear != nil {
+		*m.addrecommended_rear += f
+	} else {
+		m.addrecommended_rear = &f
+	}
+}
+
+// AddedRecommendedRear returns the value that was added to the "recommended_rear" field in this mutation.
+func (m *TirePressureMutation) AddedRecommendedRear() (r float64, exists bool) {
+	v := m.addrecommended_rear
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRecommendedRear clears the value of the "recommended_rear" field.
+func (m *TirePressureMutation) ClearRecommendedRear() {
+	m.recommended_rear = nil
+	m.addrecommended_rear = nil
+	m.clearedFields[tirepressure.FieldRecommendedRear] = struct{}{}
+}
+
+// RecommendedRearCleared returns if the "recommended_rear" field was cleared in this mutation.
+func (m *TirePressureMutation) RecommendedRearCleared() bool {
+	_, ok := m.clearedFields[tirepressure.FieldRecommendedRear]
+	return ok
+}
+
+// ResetRecommendedRear resets all changes to the "recommended_rear" field.
+func (m *TirePressureMutation) ResetRecommendedRear() {
+	m.recommended_rear = nil
+	m.addrecommended_rear = nil
+	delete(m.clearedFields, tirepressure.FieldRecommendedRear)
+}
+
+// SetSoftWarnings sets the "soft_warnings" field.
+func (m *TirePressureMutation) SetSoftWarnings(i int) {
+	m.soft_warnings = &i
+	m.addsoft_warnings = nil
+}
+
+// SoftWarnings returns the value of the "soft_warnings" field in the mutation.
+func (m *TirePressureMutation) SoftWarnings() (r int, exists bool) {
+	v := m.soft_warnings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoftWarnings returns the old "soft_warnings" field's value of the TirePressure entity.
+// If the TirePressure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TirePressureMutation) OldSoftWarnings(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoftWarnings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoftWarnings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoftWarnings: %w", err)
+	}
+	return oldValue.SoftWarnings, nil
+}
+
+// AddSoftWarnings adds i to the "soft_warnings" field.
+func (m *TirePressureMutation) AddSoftWarnings(i int) {
+	if m.addsoft_warnings != nil {
+		*m.addsoft_warnings += i
+	} else {
+		m.addsoft_warnings = &i
+	}
+}
+
+// AddedSoftWarnings returns the value that was added to the "soft_warnings" field in this mutation.
+func (m *TirePressureMutation) AddedSoftWarnings() (r int, exists bool) {
+	v := m.addsoft_warnings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSoftWarnings resets all changes to the "soft_warnings" field.
+func (m *TirePressureMutation) ResetSoftWarnings() {
+	m.soft_warnings = nil
+	m.addsoft_warnings = nil
+}
+
+// SetHardWarnings sets the "hard_warnings" field.
+func (m *TirePressureMutation) SetHardWarnings(i int) {
+	m.hard_warnings = &i
+	m.addhard_warnings = nil
+}
+
+// HardWarnings returns the value of the "hard_warnings" field in the mutation.
+func (m *TirePressureMutation) HardWarnings() (r int, exists bool) {
+	v := m.hard_warnings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHardWarnings returns the old "hard_warnings" field's value of the TirePressure entity.
+// If the TirePressure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TirePressureMutation) OldHardWarnings(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHardWarnings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHardWarnings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHardWarnings: %w", err)
+	}
+	return oldValue.HardWarnings, nil
+}
+
+// AddHardWarnings adds i to the "hard_warnings" field.
+func (m *TirePressureMutation) AddHardWarnings(i int) {
+	if m.addhard_warnings != nil {
+		*m.addhard_warnings += i
+	} else {
+		m.addhard_warnings = &i
+	}
+}
+
+// AddedHardWarnings returns the value that was added to the "hard_warnings" field in this mutation.
+func (m *TirePressureMutation) AddedHardWarnings() (r int, exists bool) {
+	v := m.addhard_warnings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHardWarnings resets all changes to the "hard_warnings" field.
+func (m *TirePressureMutation) ResetHardWarnings() {
+	m.hard_warnings = nil
+	m.addhard_warnings = nil
+}
+
+// SetUnit sets the "unit" field.
+func (m *TirePressureMutation) SetUnit(s string) {
+	m.unit = &s
+}
+
+// Unit returns the value of the "unit" field in the mutation.
+func (m *TirePressureMutation) Unit() (r string, exists bool) {
+	v := m.unit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnit returns the old "unit" field's value of the TirePressure entity.
+// If the TirePressure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TirePressureMutation) OldUnit(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnit: %w", err)
+	}
+	return oldValue.Unit, nil
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (m *TirePressureMutation) ClearUnit() {
+	m.unit = nil
+	m.clearedFields[tirepressure.FieldUnit] = struct{}{}
+}
+
+// UnitCleared returns if the "unit" field was cleared in this mutation.
+func (m *TirePressureMutation) UnitCleared() bool {
+	_, ok := m.clearedFields[tirepressure.FieldUnit]
+	return ok
+}
+
+// ResetUnit resets all changes to the "unit" field.
+func (m *TirePressureMutation) ResetUnit() {
+	m.unit = nil
+	delete(m.clearedFields, tirepressure.FieldUnit)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TirePressureMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TirePressureMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TirePressure entity.
+// If the TirePressure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TirePressureMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TirePressureMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the TirePressureMutation builder.
+func (m *TirePressureMutation) Where(ps ...predicate.TirePressure) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TirePressureMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TirePressureMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TirePressure, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TirePressureMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TirePressureMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TirePressure).
+func (m *TirePressureMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TirePressureMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.vehicle_id != nil {
+		fields = append(fields, tirepressure.FieldVehicleID)
+	}
+	if m.pressure_fl != nil {
+		fields = append(fields, tirepressure.FieldPressureFl)
+	}
+	if m.pressure_fr != nil {
+		fields = append(fields, tirepressure.FieldPressureFr)
+	}
+	if m.pressure_rl != nil {
+		fields = append(fields, tirepressure.FieldPressureRl)
+	}
+	if m.pressure_rr != nil {
+		fields = append(fields, tirepressure.FieldPressureRr)
+	}
+	if m.seen_at_fl != nil {
+		fields = append(fields, tirepressure.FieldSeenAtFl)
+	}
+	if m.seen_at_fr != nil {
+		fields = append(fields, tirepressure.FieldSeenAtFr)
+	}
+	if m.seen_at_rl != nil {
+		fields = append(fields, tirepressure.FieldSeenAtRl)
+	}
+	if m.seen_at_rr != nil {
+		fields = append(fields, tirepressure.FieldSeenAtRr)
+	}
+	if m.recommended_front != nil {
+		fields = append(fields, tirepressure.FieldRecommendedFront)
+	}
+	if m.recommended_rear != nil {
+		fields = append(fields, tirepressure.FieldRecommendedRear)
+	}
+	if m.soft_warnings != nil {
+		fields = append(fields, tirepressure.FieldSoftWarnings)
+	}
+	if m.hard_warnings != nil {
+		fields = append(fields, tirepressure.FieldHardWarnings)
+	}
+	if m.unit != nil {
+		fields = append(fields, tirepressure.FieldUnit)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tirepressure.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TirePressureMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tirepressure.FieldVehicleID:
+		return m.VehicleID()
+	case tirepressure.FieldPressureFl:
+		return m.PressureFl()
+	case tirepressure.FieldPressureFr:
+		return m.PressureFr()
+	case tirepressure.FieldPressureRl:
+		return m.PressureRl()
+	case tirepressure.FieldPressureRr:
+		return m.PressureRr()
+	case tirepressure.FieldSeenAtFl:
+		return m.SeenAtFl()
+	case tirepressure.FieldSeenAtFr:
+		return m.SeenAtFr()
+	case tirepressure.FieldSeenAtRl:
+		return m.SeenAtRl()
+	case tirepressure.FieldSeenAtRr:
+		return m.SeenAtRr()
+	case tirepressure.FieldRecommendedFront:
+		return m.RecommendedFront()
+	case tirepressure.FieldRecommendedRear:
+		return m.RecommendedRear()
+	case tirepressure.FieldSoftWarnings:
+		return m.SoftWarnings()
+	case tirepressure.FieldHardWarnings:
+		return m.HardWarnings()
+	case tirepressure.FieldUnit:
+		return m.Unit()
+	case tirepressure.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TirePressureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tirepressure.FieldVehicleID:
+		return m.OldVehicleID(ctx)
+	case tirepressure.FieldPressureFl:
+		return m.OldPressureFl(ctx)
+	case tirepressure.FieldPressureFr:
+		return m.OldPressureFr(ctx)
+	case tirepressure.FieldPressureRl:
+		return m.OldPressureRl(ctx)
+	case tirepressure.FieldPressureRr:
+		return m.OldPressureRr(ctx)
+	case tirepressure.FieldSeenAtFl:
+		return m.OldSeenAtFl(ctx)
+	case tirepressure.FieldSeenAtFr:
+		return m.OldSeenAtFr(ctx)
+	case tirepressure.FieldSeenAtRl:
+		return m.OldSeenAtRl(ctx)
+	case tirepressure.FieldSeenAtRr:
+		return m.OldSeenAtRr(ctx)
+	case tirepressure.FieldRecommendedFront:
+		return m.OldRecommendedFront(ctx)
+	case tirepressure.FieldRecommendedRear:
+		return m.OldRecommendedRear(ctx)
+	case tirepressure.FieldSoftWarnings:
+		return m.OldSoftWarnings(ctx)
+	case tirepressure.FieldHardWarnings:
+		return m.OldHardWarnings(ctx)
+	case tirepressure.FieldUnit:
+		return m.OldUnit(ctx)
+	case tirepressure.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TirePressure field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TirePressureMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tirepressure.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVehicleID(v)
+		return nil
+	case tirepressure.FieldPressureFl:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPressureFl(v)
+		return nil
+	case tirepressure.FieldPressureFr:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPressureFr(v)
+		return nil
+	case tirepressure.FieldPressureRl:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPressureRl(v)
+		return nil
+	case tirepressure.FieldPressureRr:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPressureRr(v)
+		return nil
+	case tirepressure.FieldSeenAtFl:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeenAtFl(v)
+		return nil
+	case tirepressure.FieldSeenAtFr:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeenAtFr(v)
+		return nil
+	case tirepressure.FieldSeenAtRl:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeenAtRl(v)
+		return nil
+	case tirepressure.FieldSeenAtRr:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeenAtRr(v)
+		return nil
+	case tirepressure.FieldRecommendedFront:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecommendedFront(v)
+		return nil
+	case tirepressure.FieldRecommendedRear:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecommendedRear(v)
+		return nil
+	case tirepressure.FieldSoftWarnings:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoftWarnings(v)
+		return nil
+	case tirepressure.FieldHardWarnings:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHardWarnings(v)
+		return nil
+	case tirepressure.FieldUnit:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnit(v)
+		return nil
+	case tirepressure.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TirePressure field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TirePressureMutation) AddedFields() []string {
+	var fields []string
+	if m.addvehicle_id != nil {
+		fields = append(fields, tirepressure.FieldVehicleID)
+	}
+	if m.addpressure_fl != nil {
+		fields = append(fields, tirepressure.FieldPressureFl)
+	}
+	if m.addpressure_fr != nil {
+		fields = append(fields, tirepressure.FieldPressureFr)
+	}
+	if m.addpressure_rl != nil {
+		fields = append(fields, tirepressure.FieldPressureRl)
+	}
+	if m.addpressure_rr != nil {
+		fields = append(fields, tirepressure.FieldPressureRr)
+	}
+	if m.addrecommended_front != nil {
+		fields = append(fields, tirepressure.FieldRecommendedFront)
+	}
+	if m.addrecommended_rear != nil {
+		fields = append(fields, tirepressure.FieldRecommendedRear)
+	}
+	if m.addsoft_warnings != nil {
+		fields = append(fields, tirepressure.FieldSoftWarnings)
+	}
+	if m.addhard_warnings != nil {
+		fields = append(fields, tirepressure.FieldHardWarnings)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TirePressureMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tirepressure.FieldVehicleID:
+		return m.AddedVehicleID()
+	case tirepressure.FieldPressureFl:
+		return m.AddedPressureFl()
+	case tirepressure.FieldPressureFr:
+		return m.AddedPressureFr()
+	case tirepressure.FieldPressureRl:
+		return m.AddedPressureRl()
+	case tirepressure.FieldPressureRr:
+		return m.AddedPressureRr()
+	case tirepressure.FieldRecommendedFront:
+		return m.AddedRecommendedFront()
+	case tirepressure.FieldRecommendedRear:
+		return m.AddedRecommendedRear()
+	case tirepressure.FieldSoftWarnings:
+		return m.AddedSoftWarnings()
+	case tirepressure.FieldHardWarnings:
+		return m.AddedHardWarnings()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TirePressureMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tirepressure.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVehicleID(v)
+		return nil
+	case tirepressure.FieldPressureFl:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPressureFl(v)
+		return nil
+	case tirepressure.FieldPressureFr:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPressureFr(v)
+		return nil
+	case tirepressure.FieldPressureRl:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPressureRl(v)
+		return nil
+	case tirepressure.FieldPressureRr:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPressureRr(v)
+		return nil
+	case tirepressure.FieldRecommendedFront:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRecommendedFront(v)
+		return nil
+	case tirepressure.FieldRecommendedRear:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRecommendedRear(v)
+		return nil
+	case tirepressure.FieldSoftWarnings:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSoftWarnings(v)
+		return nil
+	case tirepressure.FieldHardWarnings:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHardWarnings(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TirePressure numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TirePressureMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tirepressure.FieldPressureFl) {
+		fields = append(fields, tirepressure.FieldPressureFl)
+	}
+	if m.FieldCleared(tirepressure.FieldPressureFr) {
+		fields = append(fields, tirepressure.FieldPressureFr)
+	}
+	if m.FieldCleared(tirepressure.FieldPressureRl) {
+		fields = append(fields, tirepressure.FieldPressureRl)
+	}
+	if m.FieldCleared(tirepressure.FieldPressureRr) {
+		fields = append(fields, tirepressure.FieldPressureRr)
+	}
+	if m.FieldCleared(tirepressure.FieldSeenAtFl) {
+		fields = append(fields, tirepressure.FieldSeenAtFl)
+	}
+	if m.FieldCleared(tirepressure.FieldSeenAtFr) {
+		fields = append(fields, tirepressure.FieldSeenAtFr)
+	}
+	if m.FieldCleared(tirepressure.FieldSeenAtRl) {
+		fields = append(fields, tirepressure.FieldSeenAtRl)
+	}
+	if m.FieldCleared(tirepressure.FieldSeenAtRr) {
+		fields = append(fields, tirepressure.FieldSeenAtRr)
+	}
+	if m.FieldCleared(tirepressure.FieldRecommendedFront) {
+		fields = append(fields, tirepressure.FieldRecommendedFront)
+	}
+	if m.FieldCleared(tirepressure.FieldRecommendedRear) {
+		fields = append(fields, tirepressure.FieldRecommendedRear)
+	}
+	if m.FieldCleared(tirepressure.FieldUnit) {
+		fields = append(fields, tirepressure.FieldUnit)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TirePressureMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TirePressureMutation) ClearField(name string) error {
+	switch name {
+	case tirepressure.FieldPressureFl:
+		m.ClearPressureFl()
+		return nil
+	case tirepressure.FieldPressureFr:
+		m.ClearPressureFr()
+		return nil
+	case tirepressure.FieldPressureRl:
+		m.ClearPressureRl()
+		return nil
+	case tirepressure.FieldPressureRr:
+		m.ClearPressureRr()
+		return nil
+	case tirepressure.FieldSeenAtFl:
+		m.ClearSeenAtFl()
+		return nil
+	case tirepressure.FieldSeenAtFr:
+		m.ClearSeenAtFr()
+		return nil
+	case tirepressure.FieldSeenAtRl:
+		m.ClearSeenAtRl()
+		return nil
+	case tirepressure.FieldSeenAtRr:
+		m.ClearSeenAtRr()
+		return nil
+	case tirepressure.FieldRecommendedFront:
+		m.ClearRecommendedFront()
+		return nil
+	case tirepressure.FieldRecommendedRear:
+		m.ClearRecommendedRear()
+		return nil
+	case tirepressure.FieldUnit:
+		m.ClearUnit()
+		return nil
+	}
+	return fmt.Errorf("unknown TirePressure nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TirePressureMutation) ResetField(name string) error {
+	switch name {
+	case tirepressure.FieldVehicleID:
+		m.ResetVehicleID()
+		return nil
+	case tirepressure.FieldPressureFl:
+		m.ResetPressureFl()
+		return nil
+	case tirepressure.FieldPressureFr:
+		m.ResetPressureFr()
+		return nil
+	case tirepressure.FieldPressureRl:
+		m.ResetPressureRl()
+		return nil
+	case tirepressure.FieldPressureRr:
+		m.ResetPressureRr()
+		return nil
+	case tirepressure.FieldSeenAtFl:
+		m.ResetSeenAtFl()
+		return nil
+	case tirepressure.FieldSeenAtFr:
+		m.ResetSeenAtFr()
+		return nil
+	case tirepressure.FieldSeenAtRl:
+		m.ResetSeenAtRl()
+		return nil
+	case tirepressure.FieldSeenAtRr:
+		m.ResetSeenAtRr()
+		return nil
+	case tirepressure.FieldRecommendedFront:
+		m.ResetRecommendedFront()
+		return nil
+	case tirepressure.FieldRecommendedRear:
+		m.ResetRecommendedRear()
+		return nil
+	case tirepressure.FieldSoftWarnings:
+		m.ResetSoftWarnings()
+		return nil
+	case tirepressure.FieldHardWarnings:
+		m.ResetHardWarnings()
+		return nil
+	case tirepressure.FieldUnit:
+		m.ResetUnit()
+		return nil
+	case tirepressure.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TirePressure field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TirePressureMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TirePressureMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TirePressureMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TirePressureMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TirePressureMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TirePressureMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TirePressureMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TirePressure unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TirePressureMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TirePressure edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	account          *string
+	password         *string
+	mobile           *string
+	open_id          *string
+	avatar           *string
+	nick_name        *string
+	introduction     *string
+	gender           *int8
+	addgender        *int8
+	asked_user_id    *int
+	addasked_user_id *int
+	area_code        *string
+	time_zone        *string
+	created_at       *time.Time
+	updated_at       *time.Time
+	deleted          *bool
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*User, error)
+	predicates       []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAccount sets the "account" field.
+func (m *UserMutation) SetAccount(s string) {
+	m.account = &s
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *UserMutation) Account() (r string, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccount returns the old "account" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
+}
+
+// ResetAccount resets all changes to the "account" field.
+func (m *UserMutation) ResetAccount() {
+	m.account = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetMobile sets the "mobile" field.
+func (m *UserMutation) SetMobile(s string) {
+	m.mobile = &s
+}
+
+// Mobile returns the value of the "mobile" field in the mutation.
+func (m *UserMutation) Mobile() (r string, exists bool) {
+	v := m.mobile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMobile returns the old "mobile" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldMobile(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMobile is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMobile requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMobile: %w", err)
+	}
+	return oldValue.Mobile, nil
+}
+
+// ClearMobile clears the value of the "mobile" field.
+func (m *UserMutation) ClearMobile() {
+	m.mobile = nil
+	m.clearedFields[user.FieldMobile] = struct{}{}
+}
+
+// MobileCleared returns if the "mobile" field was cleared in this mutation.
+func (m *UserMutation) MobileCleared() bool {
+	_, ok := m.clearedFields[user.FieldMobile]
+	return ok
+}
+
+// ResetMobile resets all changes to the "mobile" field.
+func (m *UserMutation) ResetMobile() {
+	m.mobile = nil
+	delete(m.clearedFields, user.FieldMobile)
+}
+
+// SetOpenID sets the "open_id" field.
+func (m *UserMutation) SetOpenID(s string) {
+	m.open_id = &s
+}
+
+// OpenID returns the value of the "open_id" field in the mutation.
+func (m *UserMutation) OpenID() (r string, exists bool) {
+	v := m.open_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpenID returns the old "open_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldOpenID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpenID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpenID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpenID: %w", err)
+	}
+	return oldValue.OpenID, nil
+}
+
+// ClearOpenID clears the value of the "open_id" field.
+func (m *UserMutation) ClearOpenID() {
+	m.open_id = nil
+	m.clearedFields[user.FieldOpenID] = struct{}{}
+}
+
+// OpenIDCleared returns if the "open_id" field was cleared in this mutation.
+func (m *UserMutation) OpenIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldOpenID]
+	return ok
+}
+
+// ResetOpenID resets all changes to the "open_id" field.
+func (m *UserMutation) ResetOpenID() {
+	m.open_id = nil
+	delete(m.clearedFields, user.FieldOpenID)
+}
+
+// SetAvatar sets the "avatar" field.
+func (m *UserMutation) SetAvatar(s string) {
+	m.avatar = &s
+}
+
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *UserMutation) Avatar() (r string, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatar returns the old "avatar" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (m *UserMutation) ClearAvatar() {
+	m.avatar = nil
+	m.clearedFields[user.FieldAvatar] = struct{}{}
+}
+
+// AvatarCleared returns if the "avatar" field was cleared in this mutation.
+func (m *UserMutation) AvatarCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatar]
+	return ok
+}
+
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *UserMutation) ResetAvatar() {
+	m.avatar = nil
+	delete(m.clearedFields, user.FieldAvatar)
+}
+
+// SetNickName sets the "nick_name" field.
+func (m *UserMutation) SetNickName(s string) {
+	m.nick_name = &s
+}
+
+// NickName returns the value of the "nick_name" field in the mutation.
+func (m *UserMutation) NickName() (r string, exists bool) {
+	v := m.nick_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNickName returns the old "nick_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldNickName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNickName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNickName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNickName: %w", err)
+	}
+	return oldValue.NickName, nil
+}
+
+// ClearNickName clears the value of the "nick_name" field.
+func (m *UserMutation) ClearNickName() {
+	m.nick_name = nil
+	m.clearedFields[user.FieldNickName] = struct{}{}
+}
+
+// NickNameCleared returns if the "nick_name" field was cleared in this mutation.
+func (m *UserMutation) NickNameCleared() bool {
+	_, ok := m.clearedFields[user.FieldNickName]
+	return ok
+}
+
+// ResetNickName resets all changes to the "nick_name" field.
+func (m *UserMutation) ResetNickName() {
+	m.nick_name = nil
+	delete(m.clearedFields, user.FieldNickName)
+}
+
+// SetIntroduction sets the "introduction" field.
+func (m *UserMutation) SetIntroduction(s string) {
+	m.introduction = &s
+}
+
+// Introduction returns the value of the "introduction" field in the mutation.
+func (m *UserMutation) Introduction() (r string, exists bool) {
+	v := m.introduction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntroduction returns the old "introduction" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIntroduction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntroduction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntroduction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntroduction: %w", err)
+	}
+	return oldValue.Introduction, nil
+}
+
+// ClearIntroduction clears the value of the "introduction" field.
+func (m *UserMutation) ClearIntroduction() {
+	m.introduction = nil
+	m.clearedFields[user.FieldIntroduction] = struct{}{}
+}
+
+// IntroductionCleared returns if the "introduction" field was cleared in this mutation.
+func (m *UserMutation) IntroductionCleared() bool {
+	_, ok := m.clearedFields[user.FieldIntroduction]
+	return ok
+}
+
+// ResetIntroduction resets all changes to the "introduction" field.
+func (m *UserMutation) ResetIntroduction() {
+	m.introduction = nil
+	delete(m.clearedFields, user.FieldIntroduction)
+}
+
+// SetGender sets the "gender" field.
+func (m *UserMutation) SetGender(i int8) {
+	m.gender = &i
+	m.addgender = nil
+}
+
+// Gender returns the value of the "gender" field in the mutation.
+func (m *UserMutation) Gender() (r int8, exists bool) {
+	v := m.gender
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGender returns the old "gender" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldGender(ctx context.Context) (v *int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGender is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGender requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGender: %w", err)
+	}
+	return oldValue.Gender, nil
+}
+
+// AddGender adds i to the "gender" field.
+func (m *UserMutation) AddGender(i int8) {
+	if m.addgender != nil {
+		*m.addgender += i
+	} else {
+		m.addgender = &i
+	}
+}
+
+// AddedGender returns the value that was added to the "gender" field in this mutation.
+func (m *UserMutation) AddedGender() (r int8, exists bool) {
+	v := m.addgender
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGender resets all changes to the "gender" field.
+func (m *UserMutation) ResetGender() {
+	m.gender = nil
+	m.addgender = nil
+}
+
+// SetAskedUserID sets the "asked_user_id" field.
+func (m *UserMutation) SetAskedUserID(i int) {
+	m.asked_user_id = &i
+	m.addasked_user_id = nil
+}
+
+// AskedUserID returns the value of the "asked_user_id" field in the mutation.
+func (m *UserMutation) AskedUserID() (r int, exists bool) {
+	v := m.asked_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAskedUserID returns the old "asked_user_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAskedUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAskedUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAskedUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAskedUserID: %w", err)
+	}
+	return oldValue.AskedUserID, nil
+}
+
+// AddAskedUserID adds i to the "asked_user_id" field.
+func (m *UserMutation) AddAskedUserID(i int) {
+	if m.addasked_user_id != nil {
+		*m.addasked_user_id += i
+	} else {
+		m.addasked_user_id = &i
+	}
+}
+
+// AddedAskedUserID returns the value that was added to the "asked_user_id" field in this mutation.
+func (m *UserMutation) AddedAskedUserID() (r int, exists bool) {
+	v := m.addasked_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAskedUserID clears the value of the "asked_user_id" field.
+func (m *UserMutation) ClearAskedUserID() {
+	m.asked_user_id = nil
+	m.addasked_user_id = nil
+	m.clearedFields[user.FieldAskedUserID] = struct{}{}
+}
+
+// AskedUserIDCleared returns if the "asked_user_id" field was cleared in this mutation.
+func (m *UserMutation) AskedUserIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldAskedUserID]
+	return ok
+}
+
+// ResetAskedUserID resets all changes to the "asked_user_id" field.
+func (m *UserMutation) ResetAskedUserID() {
+	m.asked_user_id = nil
+	m.addasked_user_id = nil
+	delete(m.clearedFields, user.FieldAskedUserID)
+}
+
+// SetAreaCode sets the "area_code" field.
+func (m *UserMutation) SetAreaCode(s string) {
+	m.area_code = &s
+}
+
+// AreaCode returns the value of the "area_code" field in the mutation.
+func (m *UserMutation) AreaCode() (r string, exists bool) {
+	v := m.area_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAreaCode returns the old "area_code" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAreaCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAreaCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAreaCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAreaCode: %w", err)
+	}
+	return oldValue.AreaCode, nil
+}
+
+// ClearAreaCode clears the value of the "area_code" field.
+func (m *UserMutation) ClearAreaCode() {
+	m.area_code = nil
+	m.clearedFields[user.FieldAreaCode] = struct{}{}
+}
+
+// AreaCodeCleared returns if the "area_code" field was cleared in this mutation.
+func (m *UserMutation) AreaCodeCleared() bool {
+	_, ok := m.clearedFields[user.FieldAreaCode]
+	return ok
+}
+
+// ResetAreaCode resets all changes to the "area_code" field.
+func (m *UserMutation) ResetAreaCode() {
+	m.area_code = nil
+	delete(m.clearedFields, user.FieldAreaCode)
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (m *UserMutation) SetTimeZone(s string) {
+	m.time_zone = &s
+}
+
+// TimeZone returns the value of the "time_zone" field in the mutation.
+func (m *UserMutation) TimeZone() (r string, exists bool) {
+	v := m.time_zone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeZone returns the old "time_zone" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTimeZone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeZone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeZone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeZone: %w", err)
+	}
+	return oldValue.TimeZone, nil
+}
+
+// ResetTimeZone resets all changes to the "time_zone" field.
+func (m *UserMutation) ResetTimeZone() {
+	m.time_zone = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeleted sets the "deleted" field.
+func (m *UserMutation) SetDeleted(b bool) {
+	m.deleted = &b
+}
+
+// Deleted returns the value of the "deleted" field in the mutation.
+func (m *UserMutation) Deleted() (r bool, exists bool) {
+	v := m.deleted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleted returns the old "deleted" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDeleted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleted: %w", err)
+	}
+	return oldValue.Deleted, nil
+}
+
+// ResetDeleted resets all changes to the "deleted" field.
+func (m *UserMutation) ResetDeleted() {
+	m.deleted = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.account != nil {
+		fields = append(fields, user.FieldAccount)
+	}
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.mobile != nil {
+		fields = append(fields, user.FieldMobile)
+	}
+	if m.open_id != nil {
+		fields = append(fields, user.FieldOpenID)
+	}
+	if m.avatar != nil {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.nick_name != nil {
+		fields = append(fields, user.FieldNickName)
+	}
+	if m.introduction != nil {
+		fields = append(fields, user.FieldIntroduction)
+	}
+	if m.gender != nil {
+		fields = append(fields, user.FieldGender)
+	}
+	if m.asked_user_id != nil {
+		fields = append(fields, user.FieldAskedUserID)
+	}
+	if m.area_code != nil {
+		fields = append(fields, user.FieldAreaCode)
+	}
+	if m.time_zone != nil {
+		fields = append(fields, user.FieldTimeZone)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	if m.deleted != nil {
+		fields = append(fields, user.FieldDeleted)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldAccount:
+		return m.Account()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldMobile:
+		return m.Mobile()
+	case user.FieldOpenID:
+		return m.OpenID()
+	case user.FieldAvatar:
+		return m.Avatar()
+	case user.FieldNickName:
+		return m.NickName()
+	case user.FieldIntroduction:
+		return m.Introduction()
+	case user.FieldGender:
+		return m.Gender()
+	case user.FieldAskedUserID:
+		return m.AskedUserID()
+	case user.FieldAreaCode:
+		return m.AreaCode()
+	case user.FieldTimeZone:
+		return m.TimeZone()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case user.FieldDeleted:
+		return m.Deleted()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldAccount:
+		return m.OldAccount(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldMobile:
+		return m.OldMobile(ctx)
+	case user.FieldOpenID:
+		return m.OldOpenID(ctx)
+	case user.FieldAvatar:
+		return m.OldAvatar(ctx)
+	case user.FieldNickName:
+		return m.OldNickName(ctx)
+	case user.FieldIntroduction:
+		return m.OldIntroduction(ctx)
+	case user.FieldGender:
+		return m.OldGender(ctx)
+	case user.FieldAskedUserID:
+		return m.OldAskedUserID(ctx)
+	case user.FieldAreaCode:
+		return m.OldAreaCode(ctx)
+	case user.FieldTimeZone:
+		return m.OldTimeZone(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case user.FieldDeleted:
+		return m.OldDeleted(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case user.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case user.FieldMobile:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMobile(v)
+		return nil
+	case user.FieldOpenID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenID(v)
+		return nil
+	case user.FieldAvatar:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatar(v)
+		return nil
+	case user.FieldNickName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNickName(v)
+		return nil
+	case user.FieldIntroduction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntroduction(v)
+		return nil
+	case user.FieldGender:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGender(v)
+		return nil
+	case user.FieldAskedUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAskedUserID(v)
+		return nil
+	case user.FieldAreaCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAreaCode(v)
+		return nil
+	case user.FieldTimeZone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeZone(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case user.FieldDeleted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleted(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.addgender != nil {
+		fields = append(fields, user.FieldGender)
+	}
+	if m.addasked_user_id != nil {
+		fields = append(fields, user.FieldAskedUserID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldGender:
+		return m.AddedGender()
+	case user.FieldAskedUserID:
+		return m.AddedAskedUserID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldGender:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGender(v)
+		return nil
+	case user.FieldAskedUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAskedUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldMobile) {
+		fields = append(fields, user.FieldMobile)
+	}
+	if m.FieldCleared(user.FieldOpenID) {
+		fields = append(fields, user.FieldOpenID)
+	}
+	if m.FieldCleared(user.FieldAvatar) {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.FieldCleared(user.FieldNickName) {
+		fields = append(fields, user.FieldNickName)
+	}
+	if m.FieldCleared(user.FieldIntroduction) {
+		fields = append(fields, user.FieldIntroduction)
+	}
+	if m.FieldCleared(user.FieldAskedUserID) {
+		fields = append(fields, user.FieldAskedUserID)
+	}
+	if m.FieldCleared(user.FieldAreaCode) {
+		fields = append(fields, user.FieldAreaCode)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldMobile:
+		m.ClearMobile()
+		return nil
+	case user.FieldOpenID:
+		m.ClearOpenID()
+		return nil
+	case user.FieldAvatar:
+		m.ClearAvatar()
+		return nil
+	case user.FieldNickName:
+		m.ClearNickName()
+		return nil
+	case user.FieldIntroduction:
+		m.ClearIntroduction()
+		return nil
+	case user.FieldAskedUserID:
+		m.ClearAskedUserID()
+		return nil
+	case user.FieldAreaCode:
+		m.ClearAreaCode()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case user.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case user.FieldMobile:
+		m.ResetMobile()
+		return nil
+	case user.FieldOpenID:
+		m.ResetOpenID()
+		return nil
+	case user.FieldAvatar:
+		m.ResetAvatar()
+		return nil
+	case user.FieldNickName:
+		m.ResetNickName()
+		return nil
+	case user.FieldIntroduction:
+		m.ResetIntroduction()
+		return nil
+	case user.FieldGender:
+		m.ResetGender()
+		return nil
+	case user.FieldAskedUserID:
+		m.ResetAskedUserID()
+		return nil
+	case user.FieldAreaCode:
+		m.ResetAreaCode()
+		return nil
+	case user.FieldTimeZone:
+		m.ResetTimeZone()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case user.FieldDeleted:
+		m.ResetDeleted()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown User edge %s", name)
+}
+
+// VehicleMutation represents an operation that mutates the Vehicle nodes in the graph.
+type VehicleMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	vin                 *string
+	user_id             *int
+	adduser_id          *int
+	display_name        *string
+	access_type         *string
+	state               *int8
+	addstate            *int8
+	in_service          *int8
+	addin_service       *int8
+	calendar_enabled    *int8
+	addcalendar_enabled *int8
+	car_type            *string
+	api_version         *string
+	raw_data            *string
+	created_at          *time.Time
+	updated_at          *time.Time
+	deleted             *bool
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*Vehicle, error)
+	predicates          []predicate.Vehicle
+}
+
+var _ ent.Mutation = (*VehicleMutation)(nil)
+
+// vehicleOption allows management of the mutation configuration using functional options.
+type vehicleOption func(*VehicleMutation)
+
+// newVehicleMutation creates new mutation for the Vehicle entity.
+func newVehicleMutation(c config, op Op, opts ...vehicleOption) *VehicleMutation {
+	m := &VehicleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVehicle,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVehicleID sets the ID field of the mutation.
+func withVehicleID(id int) vehicleOption {
+	return func(m *VehicleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Vehicle
+		)
+		m.oldValue = func(ctx context.Context) (*Vehicle, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Vehicle.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVehicle sets the old Vehicle of the mutation.
+func withVehicle(node *Vehicle) vehicleOption {
+	return func(m *VehicleMutation) {
+		m.oldValue = func(context.Context) (*Vehicle, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VehicleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VehicleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VehicleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VehicleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Vehicle.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVin sets the "vin" field.
+func (m *VehicleMutation) SetVin(s string) {
+	m.vin = &s
+}
+
+// Vin returns the value of the "vin" field in the mutation.
+func (m *VehicleMutation) Vin() (r string, exists bool) {
+	v := m.vin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVin returns the old "vin" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldVin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVin: %w", err)
+	}
+	return oldValue.Vin, nil
+}
+
+// ResetVin resets all changes to the "vin" field.
+func (m *VehicleMutation) ResetVin() {
+	m.vin = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *VehicleMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *VehicleMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *VehicleMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *VehicleMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *VehicleMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetDisplayName sets the "display_name" field.
+func (m *VehicleMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *VehicleMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *VehicleMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
+// SetAccessType sets the "access_type" field.
+func (m *VehicleMutation) SetAccessType(s string) {
+	m.access_type = &s
+}
+
+// AccessType returns the value of the "access_type" field in the mutation.
+func (m *VehicleMutation) AccessType() (r string, exists bool) {
+	v := m.access_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessType returns the old "access_type" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldAccessType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessType: %w", err)
+	}
+	return oldValue.AccessType, nil
+}
+
+// ResetAccessType resets all changes to the "access_type" field.
+func (m *VehicleMutation) ResetAccessType() {
+	m.access_type = nil
+}
+
+// SetState sets the "state" field.
+func (m *VehicleMutation) SetState(i int8) {
+	m.state = &i
+	m.addstate = nil
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *VehicleMutation) State() (r int8, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldState(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// AddState adds i to the "state" field.
+func (m *VehicleMutation) AddState(i int8) {
+	if m.addstate != nil {
+		*m.addstate += i
+	} else {
+		m.addstate = &i
+	}
+}
+
+// AddedState returns the value that was added to the "state" field in this mutation.
+func (m *VehicleMutation) AddedState() (r int8, exists bool) {
+	v := m.addstate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *VehicleMutation) ResetState() {
+	m.state = nil
+	m.addstate = nil
+}
+
+// SetInService sets the "in_service" field.
+func (m *VehicleMutation) SetInService(i int8) {
+	m.in_service = &i
+	m.addin_service = nil
+}
+
+// InService returns the value of the "in_service" field in the mutation.
+func (m *VehicleMutation) InService() (r int8, exists bool) {
+	v := m.in_service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInService returns the old "in_service" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldInService(ctx context.Context) (v *int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInService is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInService requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInService: %w", err)
+	}
+	return oldValue.InService, nil
+}
+
+// AddInService adds i to the "in_service" field.
+func (m *VehicleMutation) AddInService(i int8) {
+	if m.addin_service != nil {
+		*m.addin_service += i
+	} else {
+		m.addin_service = &i
+	}
+}
+
+// AddedInService returns the value that was added to the "in_service" field in this mutation.
+func (m *VehicleMutation) AddedInService() (r int8, exists bool) {
+	v := m.addin_service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInService resets all changes to the "in_service" field.
+func (m *VehicleMutation) ResetInService() {
+	m.in_service = nil
+	m.addin_service = nil
+}
+
+// SetCalendarEnabled sets the "calendar_enabled" field.
+func (m *VehicleMutation) SetCalendarEnabled(i int8) {
+	m.calendar_enabled = &i
+	m.addcalendar_enabled = nil
+}
+
+// CalendarEnabled returns the value of the "calendar_enabled" field in the mutation.
+func (m *VehicleMutation) CalendarEnabled() (r int8, exists bool) {
+	v := m.calendar_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCalendarEnabled returns the old "calendar_enabled" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldCalendarEnabled(ctx context.Context) (v *int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCalendarEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCalendarEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCalendarEnabled: %w", err)
+	}
+	return oldValue.CalendarEnabled, nil
+}
+
+// AddCalendarEnabled adds i to the "calendar_enabled" field.
+func (m *VehicleMutation) AddCalendarEnabled(i int8) {
+	if m.addcalendar_enabled != nil {
+		*m.addcalendar_enabled += i
+	} else {
+		m.addcalendar_enabled = &i
+	}
+}
+
+// AddedCalendarEnabled returns the value that was added to the "calendar_enabled" field in this mutation.
+func (m *VehicleMutation) AddedCalendarEnabled() (r int8, exists bool) {
+	v := m.addcalendar_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCalendarEnabled resets all changes to the "calendar_enabled" field.
+func (m *VehicleMutation) ResetCalendarEnabled() {
+	m.calendar_enabled = nil
+	m.addcalendar_enabled = nil
+}
+
+// SetCarType sets the "car_type" field.
+func (m *VehicleMutation) SetCarType(s string) {
+	m.car_type = &s
+}
+
+// CarType returns the value of the "car_type" field in the mutation.
+func (m *VehicleMutation) CarType() (r string, exists bool) {
+	v := m.car_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarType returns the old "car_type" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldCarType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarType: %w", err)
+	}
+	return oldValue.CarType, nil
+}
+
+// ClearCarType clears the value of the "car_type" field.
+func (m *VehicleMutation) ClearCarType() {
+	m.car_type = nil
+	m.clearedFields[vehicle.FieldCarType] = struct{}{}
+}
+
+// CarTypeCleared returns if the "car_type" field was cleared in this mutation.
+func (m *VehicleMutation) CarTypeCleared() bool {
+	_, ok := m.clearedFields[vehicle.FieldCarType]
+	return ok
+}
+
+// ResetCarType resets all changes to the "car_type" field.
+func (m *VehicleMutation) ResetCarType() {
+	m.car_type = nil
+	delete(m.clearedFields, vehicle.FieldCarType)
+}
+
+// SetAPIVersion sets the "api_version" field.
+func (m *VehicleMutation) SetAPIVersion(s string) {
+	m.api_version = &s
+}
+
+// APIVersion returns the value of the "api_version" field in the mutation.
+func (m *VehicleMutation) APIVersion() (r string, exists bool) {
+	v := m.api_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPIVersion returns the old "api_version" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldAPIVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPIVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPIVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPIVersion: %w", err)
+	}
+	return oldValue.APIVersion, nil
+}
+
+// ClearAPIVersion clears the value of the "api_version" field.
+func (m *VehicleMutation) ClearAPIVersion() {
+	m.api_version = nil
+	m.clearedFields[vehicle.FieldAPIVersion] = struct{}{}
+}
+
+// APIVersionCleared returns if the "api_version" field was cleared in this mutation.
+func (m *VehicleMutation) APIVersionCleared() bool {
+	_, ok := m.clearedFields[vehicle.FieldAPIVersion]
+	return ok
+}
+
+// ResetAPIVersion resets all changes to the "api_version" field.
+func (m *VehicleMutation) ResetAPIVersion() {
+	m.api_version = nil
+	delete(m.clearedFields, vehicle.FieldAPIVersion)
+}
+
+// SetRawData sets the "raw_data" field.
+func (m *VehicleMutation) SetRawData(s string) {
+	m.raw_data = &s
+}
+
+// RawData returns the value of the "raw_data" field in the mutation.
+func (m *VehicleMutation) RawData() (r string, exists bool) {
+	v := m.raw_data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRawData returns the old "raw_data" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldRawData(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRawData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRawData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawData: %w", err)
+	}
+	return oldValue.RawData, nil
+}
+
+// ResetRawData resets all changes to the "raw_data" field.
+func (m *VehicleMutation) ResetRawData() {
+	m.raw_data = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *VehicleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *VehicleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *VehicleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *VehicleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *VehicleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *VehicleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeleted sets the "deleted" field.
+func (m *VehicleMutation) SetDeleted(b bool) {
+	m.deleted = &b
+}
+
+// Deleted returns the value of the "deleted" field in the mutation.
+func (m *VehicleMutation) Deleted() (r bool, exists bool) {
+	v := m.deleted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleted returns the old "deleted" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldDeleted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleted: %w", err)
+	}
+	return oldValue.Deleted, nil
+}
+
+// ResetDeleted resets all changes to the "deleted" field.
+func (m *VehicleMutation) ResetDeleted() {
+	m.deleted = nil
+}
+
+// Where appends a list predicates to the VehicleMutation builder.
+func (m *VehicleMutation) Where(ps ...predicate.Vehicle) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the VehicleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *VehicleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Vehicle, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *VehicleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *VehicleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Vehicle).
+func (m *VehicleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *VehicleMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.vin != nil {
+		fields = append(fields, vehicle.FieldVin)
+	}
+	if m.user_id != nil {
+		fields = append(fields, vehicle.FieldUserID)
+	}
+	if m.display_name != nil {
+		fields = append(fields, vehicle.FieldDisplayName)
 	}
 	if m.access_type != nil {
 		fields = append(fields, vehicle.FieldAccessType)
 	}
 	if m.state != nil {
-		fields = append(fields, vehicle.FieldState)
+		fields = append(fields, vehicle.FieldState)
+	}
+	if m.in_service != nil {
+		fields = append(fields, vehicle.FieldInService)
+	}
+	if m.calendar_enabled != nil {
+		fields = append(fields, vehicle.FieldCalendarEnabled)
+	}
+	if m.car_type != nil {
+		fields = append(fields, vehicle.FieldCarType)
+	}
+	if m.api_version != nil {
+		fields = append(fields, vehicle.FieldAPIVersion)
+	}
+	if m.raw_data != nil {
+		fields = append(fields, vehicle.FieldRawData)
+	}
+	if m.created_at != nil {
+		fields = append(fields, vehicle.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, vehicle.FieldUpdatedAt)
+	}
+	if m.deleted != nil {
+		fields = append(fields, vehicle.FieldDeleted)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *VehicleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case vehicle.FieldVin:
+		return m.Vin()
+	case vehicle.FieldUserID:
+		return m.UserID()
+	case vehicle.FieldDisplayName:
+		return m.DisplayName()
+	case vehicle.FieldAccessType:
+		return m.AccessType()
+	case vehicle.FieldState:
+		return m.State()
+	case vehicle.FieldInService:
+		return m.InService()
+	case vehicle.FieldCalendarEnabled:
+		return m.CalendarEnabled()
+	case vehicle.FieldCarType:
+		return m.CarType()
+	case vehicle.FieldAPIVersion:
+		return m.APIVersion()
+	case vehicle.FieldRawData:
+		return m.RawData()
+	case vehicle.FieldCreatedAt:
+		return m.CreatedAt()
+	case vehicle.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case vehicle.FieldDeleted:
+		return m.Deleted()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *VehicleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case vehicle.FieldVin:
+		return m.OldVin(ctx)
+	case vehicle.FieldUserID:
+		return m.OldUserID(ctx)
+	case vehicle.FieldDisplayName:
+		return m.OldDisplayName(ctx)
+	case vehicle.FieldAccessType:
+		return m.OldAccessType(ctx)
+	case vehicle.FieldState:
+		return m.OldState(ctx)
+	case vehicle.FieldInService:
+		return m.OldInService(ctx)
+	case vehicle.FieldCalendarEnabled:
+		return m.OldCalendarEnabled(ctx)
+	case vehicle.FieldCarType:
+		return m.OldCarType(ctx)
+	case vehicle.FieldAPIVersion:
+		return m.OldAPIVersion(ctx)
+	case vehicle.FieldRawData:
+		return m.OldRawData(ctx)
+	case vehicle.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case vehicle.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case vehicle.FieldDeleted:
+		return m.OldDeleted(ctx)
+	}
+	return nil, fmt.Errorf("unknown Vehicle field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VehicleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case vehicle.FieldVin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVin(v)
+		return nil
+	case vehicle.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case vehicle.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
+	case vehicle.FieldAccessType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessType(v)
+		return nil
+	case vehicle.FieldState:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case vehicle.FieldInService:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInService(v)
+		return nil
+	case vehicle.FieldCalendarEnabled:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCalendarEnabled(v)
+		return nil
+	case vehicle.FieldCarType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarType(v)
+		return nil
+	case vehicle.FieldAPIVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIVersion(v)
+		return nil
+	case vehicle.FieldRawData:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRawData(v)
+		return nil
+	case vehicle.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case vehicle.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case vehicle.FieldDeleted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleted(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Vehicle field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *VehicleMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, vehicle.FieldUserID)
+	}
+	if m.addstate != nil {
+		fields = append(fields, vehicle.FieldState)
+	}
+	if m.addin_service != nil {
+		fields = append(fields, vehicle.FieldInService)
+	}
+	if m.addcalendar_enabled != nil {
+		fields = append(fields, vehicle.FieldCalendarEnabled)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *VehicleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case vehicle.FieldUserID:
+		return m.AddedUserID()
+	case vehicle.FieldState:
+		return m.AddedState()
+	case vehicle.FieldInService:
+		return m.AddedInService()
+	case vehicle.FieldCalendarEnabled:
+		return m.AddedCalendarEnabled()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VehicleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case vehicle.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case vehicle.FieldState:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddState(v)
+		return nil
+	case vehicle.FieldInService:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInService(v)
+		return nil
+	case vehicle.FieldCalendarEnabled:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCalendarEnabled(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Vehicle numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *VehicleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(vehicle.FieldCarType) {
+		fields = append(fields, vehicle.FieldCarType)
+	}
+	if m.FieldCleared(vehicle.FieldAPIVersion) {
+		fields = append(fields, vehicle.FieldAPIVersion)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *VehicleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *VehicleMutation) ClearField(name string) error {
+	switch name {
+	case vehicle.FieldCarType:
+		m.ClearCarType()
+		return nil
+	case vehicle.FieldAPIVersion:
+		m.ClearAPIVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown Vehicle nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *VehicleMutation) ResetField(name string) error {
+	switch name {
+	case vehicle.FieldVin:
+		m.ResetVin()
+		return nil
+	case vehicle.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case vehicle.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
+	case vehicle.FieldAccessType:
+		m.ResetAccessType()
+		return nil
+	case vehicle.FieldState:
+		m.ResetState()
+		return nil
+	case vehicle.FieldInService:
+		m.ResetInService()
+		return nil
+	case vehicle.FieldCalendarEnabled:
+		m.ResetCalendarEnabled()
+		return nil
+	case vehicle.FieldCarType:
+		m.ResetCarType()
+		return nil
+	case vehicle.FieldAPIVersion:
+		m.ResetAPIVersion()
+		return nil
+	case vehicle.FieldRawData:
+		m.ResetRawData()
+		return nil
+	case vehicle.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case vehicle.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case vehicle.FieldDeleted:
+		m.ResetDeleted()
+		return nil
+	}
+	return fmt.Errorf("unknown Vehicle field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *VehicleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *VehicleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *VehicleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *VehicleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *VehicleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *VehicleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *VehicleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Vehicle unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *VehicleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Vehicle edge %s", name)
+}
+
+// VehicleEventMutation represents an operation that mutates the VehicleEvent nodes in the graph.
+type VehicleEventMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	vehicle_id    *int
+	addvehicle_id *int
+	user_id       *int
+	adduser_id    *int
+	_type         *string
+	summary       *string
+	data          *map[string]string
+	occurred_at   *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*VehicleEvent, error)
+	predicates    []predicate.VehicleEvent
+}
+
+var _ ent.Mutation = (*VehicleEventMutation)(nil)
+
+// vehicleeventOption allows management of the mutation configuration using functional options.
+type vehicleeventOption func(*VehicleEventMutation)
+
+// newVehicleEventMutation creates new mutation for the VehicleEvent entity.
+func newVehicleEventMutation(c config, op Op, opts ...vehicleeventOption) *VehicleEventMutation {
+	m := &VehicleEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVehicleEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVehicleEventID sets the ID field of the mutation.
+func withVehicleEventID(id int) vehicleeventOption {
+	return func(m *VehicleEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *VehicleEvent
+		)
+		m.oldValue = func(ctx context.Context) (*VehicleEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().VehicleEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVehicleEvent sets the old VehicleEvent of the mutation.
+func withVehicleEvent(node *VehicleEvent) vehicleeventOption {
+	return func(m *VehicleEventMutation) {
+		m.oldValue = func(context.Context) (*VehicleEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VehicleEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VehicleEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VehicleEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VehicleEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().VehicleEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (m *VehicleEventMutation) SetVehicleID(i int) {
+	m.vehicle_id = &i
+	m.addvehicle_id = nil
+}
+
+// VehicleID returns the value of the "vehicle_id" field in the mutation.
+func (m *VehicleEventMutation) VehicleID() (r int, exists bool) {
+	v := m.vehicle_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVehicleID returns the old "vehicle_id" field's value of the VehicleEvent entity.
+// If the VehicleEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleEventMutation) OldVehicleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVehicleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVehicleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVehicleID: %w", err)
+	}
+	return oldValue.VehicleID, nil
+}
+
+// AddVehicleID adds i to the "vehicle_id" field.
+func (m *VehicleEventMutation) AddVehicleID(i int) {
+	if m.addvehicle_id != nil {
+		*m.addvehicle_id += i
+	} else {
+		m.addvehicle_id = &i
+	}
+}
+
+// AddedVehicleID returns the value that was added to the "vehicle_id" field in this mutation.
+func (m *VehicleEventMutation) AddedVehicleID() (r int, exists bool) {
+	v := m.addvehicle_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVehicleID resets all changes to the "vehicle_id" field.
+func (m *VehicleEventMutation) ResetVehicleID() {
+	m.vehicle_id = nil
+	m.addvehicle_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *VehicleEventMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *VehicleEventMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the VehicleEvent entity.
+// If the VehicleEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleEventMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *VehicleEventMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *VehicleEventMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *VehicleEventMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetType sets the "type" field.
+func (m *VehicleEventMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *VehicleEventMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the VehicleEvent entity.
+// If the VehicleEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleEventMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *VehicleEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetSummary sets the "summary" field.
+func (m *VehicleEventMutation) SetSummary(s string) {
+	m.summary = &s
+}
+
+// Summary returns the value of the "summary" field in the mutation.
+func (m *VehicleEventMutation) Summary() (r string, exists bool) {
+	v := m.summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummary returns the old "summary" field's value of the VehicleEvent entity.
+// If the VehicleEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleEventMutation) OldSummary(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+	}
+	return oldValue.Summary, nil
+}
+
+// ResetSummary resets all changes to the "summary" field.
+func (m *VehicleEventMutation) ResetSummary() {
+	m.summary = nil
+}
+
+// SetData sets the "data" field.
+func (m *VehicleEventMutation) SetData(value map[string]string) {
+	m.data = &value
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *VehicleEventMutation) Data() (r map[string]string, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the VehicleEvent entity.
+// If the VehicleEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleEventMutation) OldData(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ClearData clears the value of the "data" field.
+func (m *VehicleEventMutation) ClearData() {
+	m.data = nil
+	m.clearedFields[vehicleevent.FieldData] = struct{}{}
+}
+
+// DataCleared returns if the "data" field was cleared in this mutation.
+func (m *VehicleEventMutation) DataCleared() bool {
+	_, ok := m.clearedFields[vehicleevent.FieldData]
+	return ok
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *VehicleEventMutation) ResetData() {
+	m.data = nil
+	delete(m.clearedFields, vehicleevent.FieldData)
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (m *VehicleEventMutation) SetOccurredAt(t time.Time) {
+	m.occurred_at = &t
+}
+
+// OccurredAt returns the value of the "occurred_at" field in the mutation.
+func (m *VehicleEventMutation) OccurredAt() (r time.Time, exists bool) {
+	v := m.occurred_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOccurredAt returns the old "occurred_at" field's value of the VehicleEvent entity.
+// If the VehicleEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleEventMutation) OldOccurredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOccurredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOccurredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOccurredAt: %w", err)
+	}
+	return oldValue.OccurredAt, nil
+}
+
+// ResetOccurredAt resets all changes to the "occurred_at" field.
+func (m *VehicleEventMutation) ResetOccurredAt() {
+	m.occurred_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *VehicleEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *VehicleEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the VehicleEvent entity.
+// If the VehicleEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *VehicleEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the VehicleEventMutation builder.
+func (m *VehicleEventMutation) Where(ps ...predicate.VehicleEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the VehicleEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *VehicleEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VehicleEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *VehicleEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *VehicleEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (VehicleEvent).
+func (m *VehicleEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *VehicleEventMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.vehicle_id != nil {
+		fields = append(fields, vehicleevent.FieldVehicleID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, vehicleevent.FieldUserID)
+	}
+	if m._type != nil {
+		fields = append(fields, vehicleevent.FieldType)
+	}
+	if m.summary != nil {
+		fields = append(fields, vehicleevent.FieldSummary)
+	}
+	if m.data != nil {
+		fields = append(fields, vehicleevent.FieldData)
+	}
+	if m.occurred_at != nil {
+		fields = append(fields, vehicleevent.FieldOccurredAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, vehicleevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *VehicleEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case vehicleevent.FieldVehicleID:
+		return m.VehicleID()
+	case vehicleevent.FieldUserID:
+		return m.UserID()
+	case vehicleevent.FieldType:
+		return m.GetType()
+	case vehicleevent.FieldSummary:
+		return m.Summary()
+	case vehicleevent.FieldData:
+		return m.Data()
+	case vehicleevent.FieldOccurredAt:
+		return m.OccurredAt()
+	case vehicleevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *VehicleEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case vehicleevent.FieldVehicleID:
+		return m.OldVehicleID(ctx)
+	case vehicleevent.FieldUserID:
+		return m.OldUserID(ctx)
+	case vehicleevent.FieldType:
+		return m.OldType(ctx)
+	case vehicleevent.FieldSummary:
+		return m.OldSummary(ctx)
+	case vehicleevent.FieldData:
+		return m.OldData(ctx)
+	case vehicleevent.FieldOccurredAt:
+		return m.OldOccurredAt(ctx)
+	case vehicleevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown VehicleEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VehicleEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case vehicleevent.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVehicleID(v)
+		return nil
+	case vehicleevent.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case vehicleevent.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case vehicleevent.FieldSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummary(v)
+		return nil
+	case vehicleevent.FieldData:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case vehicleevent.FieldOccurredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOccurredAt(v)
+		return nil
+	case vehicleevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *VehicleEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addvehicle_id != nil {
+		fields = append(fields, vehicleevent.FieldVehicleID)
+	}
+	if m.adduser_id != nil {
+		fields = append(fields, vehicleevent.FieldUserID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *VehicleEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case vehicleevent.FieldVehicleID:
+		return m.AddedVehicleID()
+	case vehicleevent.FieldUserID:
+		return m.AddedUserID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VehicleEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case vehicleevent.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVehicleID(v)
+		return nil
+	case vehicleevent.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *VehicleEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(vehicleevent.FieldData) {
+		fields = append(fields, vehicleevent.FieldData)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *VehicleEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *VehicleEventMutation) ClearField(name string) error {
+	switch name {
+	case vehicleevent.FieldData:
+		m.ClearData()
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *VehicleEventMutation) ResetField(name string) error {
+	switch name {
+	case vehicleevent.FieldVehicleID:
+		m.ResetVehicleID()
+		return nil
+	case vehicleevent.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case vehicleevent.FieldType:
+		m.ResetType()
+		return nil
+	case vehicleevent.FieldSummary:
+		m.ResetSummary()
+		return nil
+	case vehicleevent.FieldData:
+		m.ResetData()
+		return nil
+	case vehicleevent.FieldOccurredAt:
+		m.ResetOccurredAt()
+		return nil
+	case vehicleevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *VehicleEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *VehicleEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *VehicleEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *VehicleEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *VehicleEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *VehicleEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *VehicleEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown VehicleEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *VehicleEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown VehicleEvent edge %s", name)
+}
+
+// VehicleRollupMutation represents an operation that mutates the VehicleRollup nodes in the graph.
+type VehicleRollupMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	vehicle_id        *int
+	addvehicle_id     *int
+	granularity       *string
+	time_zone         *string
+	start_at          *time.Time
+	end_at            *time.Time
+	drives            *int
+	adddrives         *int
+	distance          *float64
+	adddistance       *float64
+	drive_seconds     *int
+	adddrive_seconds  *int
+	energy_used       *float64
+	addenergy_used    *float64
+	efficiency        *float64
+	addefficiency     *float64
+	max_speed         *float64
+	addmax_speed      *float64
+	charges           *int
+	addcharges        *int
+	energy_added      *float64
+	addenergy_added   *float64
+	charge_cost       *float64
+	addcharge_cost    *float64
+	parked_seconds    *int
+	addparked_seconds *int
+	asleep_seconds    *int
+	addasleep_seconds *int
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*VehicleRollup, error)
+	predicates        []predicate.VehicleRollup
+}
+
+var _ ent.Mutation = (*VehicleRollupMutation)(nil)
+
+// vehiclerollupOption allows management of the mutation configuration using functional options.
+type vehiclerollupOption func(*VehicleRollupMutation)
+
+// newVehicleRollupMutation creates new mutation for the VehicleRollup entity.
+func newVehicleRollupMutation(c config, op Op, opts ...vehiclerollupOption) *VehicleRollupMutation {
+	m := &VehicleRollupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVehicleRollup,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVehicleRollupID sets the ID field of the mutation.
+func withVehicleRollupID(id int) vehiclerollupOption {
+	return func(m *VehicleRollupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *VehicleRollup
+		)
+		m.oldValue = func(ctx context.Context) (*VehicleRollup, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().VehicleRollup.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVehicleRollup sets the old VehicleRollup of the mutation.
+func withVehicleRollup(node *VehicleRollup) vehiclerollupOption {
+	return func(m *VehicleRollupMutation) {
+		m.oldValue = func(context.Context) (*VehicleRollup, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VehicleRollupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VehicleRollupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VehicleRollupMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VehicleRollupMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().VehicleRollup.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (m *VehicleRollupMutation) SetVehicleID(i int) {
+	m.vehicle_id = &i
+	m.addvehicle_id = nil
+}
+
+// VehicleID returns the value of the "vehicle_id" field in the mutation.
+func (m *VehicleRollupMutation) VehicleID() (r int, exists bool) {
+	v := m.vehicle_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVehicleID returns the old "vehicle_id" field's value of the VehicleRollup entity.
+// If the VehicleRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleRollupMutation) OldVehicleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVehicleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVehicleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVehicleID: %w", err)
+	}
+	return oldValue.VehicleID, nil
+}
+
+// AddVehicleID adds i to the "vehicle_id" field.
+func (m *VehicleRollupMutation) AddVehicleID(i int) {
+	if m.addvehicle_id != nil {
+		*m.addvehicle_id += i
+	} else {
+		m.addvehicle_id = &i
+	}
+}
+
+// AddedVehicleID returns the value that was added to the "vehicle_id" field in this mutation.
+func (m *VehicleRollupMutation) AddedVehicleID() (r int, exists bool) {
+	v := m.addvehicle_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVehicleID resets all changes to the "vehicle_id" field.
+func (m *VehicleRollupMutation) ResetVehicleID() {
+	m.vehicle_id = nil
+	m.addvehicle_id = nil
+}
+
+// SetGranularity sets the "granularity" field.
+func (m *VehicleRollupMutation) SetGranularity(s string) {
+	m.granularity = &s
+}
+
+// Granularity returns the value of the "granularity" field in the mutation.
+func (m *VehicleRollupMutation) Granularity() (r string, exists bool) {
+	v := m.granularity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGranularity returns the old "granularity" field's value of the VehicleRollup entity.
+// If the VehicleRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleRollupMutation) OldGranularity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGranularity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGranularity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGranularity: %w", err)
+	}
+	return oldValue.Granularity, nil
+}
+
+// ResetGranularity resets all changes to the "granularity" field.
+func (m *VehicleRollupMutation) ResetGranularity() {
+	m.granularity = nil
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (m *VehicleRollupMutation) SetTimeZone(s string) {
+	m.time_zone = &s
+}
+
+// TimeZone returns the value of the "time_zone" field in the mutation.
+func (m *VehicleRollupMutation) TimeZone() (r string, exists bool) {
+	v := m.time_zone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeZone returns the old "time_zone" field's value of the VehicleRollup entity.
+// If the VehicleRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleRollupMutation) OldTimeZone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeZone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeZone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeZone: %w", err)
+	}
+	return oldValue.TimeZone, nil
+}
+
+// ResetTimeZone resets all changes to the "time_zone" field.
+func (m *VehicleRollupMutation) ResetTimeZone() {
+	m.time_zone = nil
+}
+
+// SetStartAt sets the "start_at" field.
+func (m *VehicleRollupMutation) SetStartAt(t time.Time) {
+	m.start_at = &t
+}
+
+// StartAt returns the value of the "start_at" field in the mutation.
+func (m *VehicleRollupMutation) StartAt() (r time.Time, exists bool) {
+	v := m.start_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartAt returns the old "start_at" field's value of the VehicleRollup entity.
+// If the VehicleRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleRollupMutation) OldStartAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartAt: %w", err)
+	}
+	return oldValue.StartAt, nil
+}
+
+// ResetStartAt resets all changes to the "start_at" field.
+func (m *VehicleRollupMutation) ResetStartAt() {
+	m.start_at = nil
+}
+
+// SetEndAt sets the "end_at" field.
+func (m *VehicleRollupMutation) SetEndAt(t time.Time) {
+	m.end_at = &t
+}
+
+// EndAt returns the value of the "end_at" field in the mutation.
+func (m *VehicleRollupMutation) EndAt() (r time.Time, exists bool) {
+	v := m.end_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndAt returns the old "end_at" field's value of the VehicleRollup entity.
+// If the VehicleRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleRollupMutation) OldEndAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndAt: %w", err)
+	}
+	return oldValue.EndAt, nil
+}
+
+// ResetEndAt resets all changes to the "end_at" field.
+func (m *VehicleRollupMutation) ResetEndAt() {
+	m.end_at = nil
+}
+
+// SetDrives sets the "drives" field.
+func (m *VehicleRollupMutation) SetDrives(i int) {
+	m.drives = &i
+	m.adddrives = nil
+}
+
+// Drives returns the value of the "drives" field in the mutation.
+func (m *VehicleRollupMutation) Drives() (r int, exists bool) {
+	v := m.drives
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDrives returns the old "drives" field's value of the VehicleRollup entity.
+// If the VehicleRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleRollupMutation) OldDrives(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDrives is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDrives requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDrives: %w", err)
+	}
+	return oldValue.Drives, nil
+}
+
+// AddDrives adds i to the "drives" field.
+func (m *VehicleRollupMutation) AddDrives(i int) {
+	if m.adddrives != nil {
+		*m.adddrives += i
+	} else {
+		m.adddrives = &i
+	}
+}
+
+// AddedDrives returns the value that was added to the "drives" field in this mutation.
+func (m *VehicleRollupMutation) AddedDrives() (r int, exists bool) {
+	v := m.adddrives
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDrives resets all changes to the "drives" field.
+func (m *VehicleRollupMutation) ResetDrives() {
+	m.drives = nil
+	m.adddrives = nil
+}
+
+// SetDistance sets the "distance" field.
+func (m *VehicleRollupMutation) SetDistance(f float64) {
+	m.distance = &f
+	m.adddistance = nil
+}
+
+// Distance returns the value of the "distance" field in the mutation.
+func (m *VehicleRollupMutation) Distance() (r float64, exists bool) {
+	v := m.distance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDistance returns the old "distance" field's value of the VehicleRollup entity.
+// If the VehicleRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleRollupMutation) OldDistance(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDistance is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDistance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDistance: %w", err)
+	}
+	return oldValue.Distance, nil
+}
+
+// AddDistance adds f to the "distance" field.
+func (m *VehicleRollupMutation) AddDistance(f float64) {
+	if m.adddistance != nil {
+		*m.adddistance += f
+	} else {
+		m.adddistance = &f
+	}
+}
+
+// AddedDistance returns the value that was added to the "distance" field in this mutation.
+func (m *VehicleRollupMutation) AddedDistance() (r float64, exists bool) {
+	v := m.adddistance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDistance resets all changes to the "distance" field.
+func (m *VehicleRollupMutation) ResetDistance() {
+	m.distance = nil
+	m.adddistance = nil
+}
+
+// SetDriveSeconds sets the "drive_seconds" field.
+func (m *VehicleRollupMutation) SetDriveSeconds(i int) {
+	m.drive_seconds = &i
+	m.adddrive_seconds = nil
+}
+
+// DriveSeconds returns the value of the "drive_seconds" field in the mutation.
+func (m *VehicleRollupMutation) DriveSeconds() (r int, exists bool) {
+	v := m.drive_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDriveSeconds returns the old "drive_seconds" field's value of the VehicleRollup entity.
+// If the VehicleRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleRollupMutation) OldDriveSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDriveSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDriveSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDriveSeconds: %w", err)
+	}
+	return oldValue.DriveSeconds, nil
+}
+
+// AddDriveSeconds adds i to the "drive_seconds" field.
+func (m *VehicleRollupMutation) AddDriveSeconds(i int) {
+	if m.adddrive_seconds != nil {
+		*m.adddrive_seconds += i
+	} else {
+		m.adddrive_seconds = &i
+	}
+}
+
+// AddedDriveSeconds returns the value that was added to the "drive_seconds" field in this mutation.
+func (m *VehicleRollupMutation) AddedDriveSeconds() (r int, exists bool) {
+	v := m.adddrive_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDriveSeconds resets all changes to the "drive_seconds" field.
+func (m *VehicleRollupMutation) ResetDriveSeconds() {
+	m.drive_seconds = nil
+	m.adddrive_seconds = nil
+}
+
+// SetEnergyUsed sets the "energy_used" field.
+func (m *VehicleRollupMutation) SetEnergyUsed(f float64) {
+	m.energy_used = &f
+	m.addenergy_used = nil
+}
+
+// EnergyUsed returns the value of the "energy_used" field in the mutation.
+func (m *VehicleRollupMutation) EnergyUsed() (r float64, exists bool) {
+	v := m.energy_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnergyUsed returns the old "energy_used" field's value of the VehicleRollup entity.
+// If the VehicleRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleRollupMutation) OldEnergyUsed(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnergyUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnergyUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnergyUsed: %w", err)
+	}
+	return oldValue.EnergyUsed, nil
+}
+
+// AddEnergyUsed adds f to the "energy_used" field.
+func (m *VehicleRollupMutation) AddEnergyUsed(f float64) {
+	if m.addenergy_used != nil {
+		*m.addenergy_used += f
+	} else {
+		m.addenergy_used = &f
+	}
+}
+
+// AddedEnergyUsed returns the value that was added to the "energy_used" field in this mutation.
+func (m *VehicleRollupMutation) AddedEnergyUsed() (r float64, exists bool) {
+	v := m.addenergy_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEnergyUsed resets all changes to the "energy_used" field.
+func (m *VehicleRollupMutation) ResetEnergyUsed() {
+	m.energy_used = nil
+	m.addenergy_used = nil
+}
+
+// SetEfficiency sets the "efficiency" field.
+func (m *VehicleRollupMutation) SetEfficiency(f float64) {
+	m.efficiency = &f
+	m.addefficiency = nil
+}
+
+// Efficiency returns the value of the "efficiency" field in the mutation.
+func (m *VehicleRollupMutation) Efficiency() (r float64, exists bool) {
+	v := m.efficiency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEfficiency returns the old "efficiency" field's value of the VehicleRollup entity.
+// If the VehicleRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleRollupMutation) OldEfficiency(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEfficiency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEfficiency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEfficiency: %w", err)
+	}
+	return oldValue.Efficiency, nil
+}
+
+// AddEfficiency adds f to the "efficiency" field.
+func (m *VehicleRollupMutation) AddEfficiency(f float64) {
+	if m.addefficiency != nil {
+		*m.addefficiency += f
+	} else {
+		m.addefficiency = &f
+	}
+}
+
+// AddedEfficiency returns the value that was added to the "efficiency" field in this mutation.
+func (m *VehicleRollupMutation) AddedEfficiency() (r float64, exists bool) {
+	v := m.addefficiency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEfficiency resets all changes to the "efficiency" field.
+func (m *VehicleRollupMutation) ResetEfficiency() {
+	m.efficiency = nil
+	m.addefficiency = nil
+}
+
+// SetMaxSpeed sets the "max_speed" field.
+func (m *VehicleRollupMutation) SetMaxSpeed(f float64) {
+	m.max_speed = &f
+	m.addmax_speed = nil
+}
+
+// MaxSpeed returns the value of the "max_speed" field in the mutation.
+func (m *VehicleRollupMutation) MaxSpeed() (r float64, exists bool) {
+	v := m.max_speed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxSpeed returns the old "max_speed" field's value of the VehicleRollup entity.
+// If the VehicleRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleRollupMutation) OldMaxSpeed(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxSpeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxSpeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxSpeed: %w", err)
+	}
+	return oldValue.MaxSpeed, nil
+}
+
+// AddMaxSpeed adds f to the "max_speed" field.
+func (m *VehicleRollupMutation) AddMaxSpeed(f float64) {
+	if m.addmax_speed != nil {
+		*m.addmax_speed += f
+	} else {
+		m.addmax_speed = &f
+	}
+}
+
+// AddedMaxSpeed returns the value that was added to the "max_speed" field in this mutation.
+func (m *VehicleRollupMutation) AddedMaxSpeed() (r float64, exists bool) {
+	v := m.addmax_speed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxSpeed resets all changes to the "max_speed" field.
+func (m *VehicleRollupMutation) ResetMaxSpeed() {
+	m.max_speed = nil
+	m.addmax_speed = nil
+}
+
+// SetCharges sets the "charges" field.
+func (m *VehicleRollupMutation) SetCharges(i int) {
+	m.charges = &i
+	m.addcharges = nil
+}
+
+// Charges returns the value of the "charges" field in the mutation.
+func (m *VehicleRollupMutation) Charges() (r int, exists bool) {
+	v := m.charges
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCharges returns the old "charges" field's value of the VehicleRollup entity.
+// If the VehicleRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleRollupMutation) OldCharges(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCharges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCharges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCharges: %w", err)
+	}
+	return oldValue.Charges, nil
+}
+
+// AddCharges adds i to the "charges" field.
+func (m *VehicleRollupMutation) AddCharges(i int) {
+	if m.addcharges != nil {
+		*m.addcharges += i
+	} else {
+		m.addcharges = &i
+	}
+}
+
+// AddedCharges returns the value that was added to the "charges" field in this mutation.
+func (m *VehicleRollupMutation) AddedCharges() (r int, exists bool) {
+	v := m.addcharges
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCharges resets all changes to the "charges" field.
+func (m *VehicleRollupMutation) ResetCharges() {
+	m.charges = nil
+	m.addcharges = nil
+}
+
+// SetEnergyAdded sets the "energy_added" field.
+func (m *VehicleRollupMutation) SetEnergyAdded(f float64) {
+	m.energy_added = &f
+	m.addenergy_added = nil
+}
+
+// EnergyAdded returns the value of the "energy_added" field in the mutation.
+func (m *VehicleRollupMutation) EnergyAdded() (r float64, exists bool) {
+	v := m.energy_added
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnergyAdded returns the old "energy_added" field's value of the VehicleRollup entity.
+// If the VehicleRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleRollupMutation) OldEnergyAdded(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnergyAdded is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnergyAdded requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnergyAdded: %w", err)
+	}
+	return oldValue.EnergyAdded, nil
+}
+
+// AddEnergyAdded adds f to the "energy_added" field.
+func (m *VehicleRollupMutation) AddEnergyAdded(f float64) {
+	if m.addenergy_added != nil {
+		*m.addenergy_added += f
+	} else {
+		m.addenergy_added = &f
+	}
+}
+
+// AddedEnergyAdded returns the value that was added to the "energy_added" field in this mutation.
+func (m *VehicleRollupMutation) AddedEnergyAdded() (r float64, exists bool) {
+	v := m.addenergy_added
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEnergyAdded resets all changes to the "energy_added" field.
+func (m *VehicleRollupMutation) ResetEnergyAdded() {
+	m.energy_added = nil
+	m.addenergy_added = nil
+}
+
+// SetChargeCost sets the "charge_cost" field.
+func (m *VehicleRollupMutation) SetChargeCost(f float64) {
+	m.charge_cost = &f
+	m.addcharge_cost = nil
+}
+
+// ChargeCost returns the value of the "charge_cost" field in the mutation.
+func (m *VehicleRollupMutation) ChargeCost() (r float64, exists bool) {
+	v := m.charge_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChargeCost returns the old "charge_cost" field's value of the VehicleRollup entity.
+// If the VehicleRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleRollupMutation) OldChargeCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChargeCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChargeCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChargeCost: %w", err)
+	}
+	return oldValue.ChargeCost, nil
+}
+
+// AddChargeCost adds f to the "charge_cost" field.
+func (m *VehicleRollupMutation) AddChargeCost(f float64) {
+	if m.addcharge_cost != nil {
+		*m.addcharge_cost += f
+	} else {
+		m.addcharge_cost = &f
+	}
+}
+
+// AddedChargeCost returns the value that was added to the "charge_cost" field in this mutation.
+func (m *VehicleRollupMutation) AddedChargeCost() (r float64, exists bool) {
+	v := m.addcharge_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChargeCost resets all changes to the "charge_cost" field.
+func (m *VehicleRollupMutation) ResetChargeCost() {
+	m.charge_cost = nil
+	m.addcharge_cost = nil
+}
+
+// SetParkedSeconds sets the "parked_seconds" field.
+func (m *VehicleRollupMutation) SetParkedSeconds(i int) {
+	m.parked_seconds = &i
+	m.addparked_seconds = nil
+}
+
+// ParkedSeconds returns the value of the "parked_seconds" field in the mutation.
+func (m *VehicleRollupMutation) ParkedSeconds() (r int, exists bool) {
+	v := m.parked_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParkedSeconds returns the old "parked_seconds" field's value of the VehicleRollup entity.
+// If the VehicleRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleRollupMutation) OldParkedSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParkedSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParkedSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParkedSeconds: %w", err)
+	}
+	return oldValue.ParkedSeconds, nil
+}
+
+// AddParkedSeconds adds i to the "parked_seconds" field.
+func (m *VehicleRollupMutation) AddParkedSeconds(i int) {
+	if m.addparked_seconds != nil {
+		*m.addparked_seconds += i
+	} else {
+		m.addparked_seconds = &i
+	}
+}
+
+// AddedParkedSeconds returns the value that was added to the "parked_seconds" field in this mutation.
+func (m *VehicleRollupMutation) AddedParkedSeconds() (r int, exists bool) {
+	v := m.addparked_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetParkedSeconds resets all changes to the "parked_seconds" field.
+func (m *VehicleRollupMutation) ResetParkedSeconds() {
+	m.parked_seconds = nil
+	m.addparked_seconds = nil
+}
+
+// SetAsleepSeconds sets the "asleep_seconds" field.
+func (m *VehicleRollupMutation) SetAsleepSeconds(i int) {
+	m.asleep_seconds = &i
+	m.addasleep_seconds = nil
+}
+
+// AsleepSeconds returns the value of the "asleep_seconds" field in the mutation.
+func (m *VehicleRollupMutation) AsleepSeconds() (r int, exists bool) {
+	v := m.asleep_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAsleepSeconds returns the old "asleep_seconds" field's value of the VehicleRollup entity.
+// If the VehicleRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleRollupMutation) OldAsleepSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAsleepSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAsleepSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAsleepSeconds: %w", err)
+	}
+	return oldValue.AsleepSeconds, nil
+}
+
+// AddAsleepSeconds adds i to the "asleep_seconds" field.
+func (m *VehicleRollupMutation) AddAsleepSeconds(i int) {
+	if m.addasleep_seconds != nil {
+		*m.addasleep_seconds += i
+	} else {
+		m.addasleep_seconds = &i
+	}
+}
+
+// AddedAsleepSeconds returns the value that was added to the "asleep_seconds" field in this mutation.
+func (m *VehicleRollupMutation) AddedAsleepSeconds() (r int, exists bool) {
+	v := m.addasleep_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAsleepSeconds resets all changes to the "asleep_seconds" field.
+func (m *VehicleRollupMutation) ResetAsleepSeconds() {
+	m.asleep_seconds = nil
+	m.addasleep_seconds = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *VehicleRollupMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *VehicleRollupMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the VehicleRollup entity.
+// If the VehicleRollup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleRollupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *VehicleRollupMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the VehicleRollupMutation builder.
+func (m *VehicleRollupMutation) Where(ps ...predicate.VehicleRollup) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the VehicleRollupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *VehicleRollupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VehicleRollup, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *VehicleRollupMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *VehicleRollupMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (VehicleRollup).
+func (m *VehicleRollupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *VehicleRollupMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.vehicle_id != nil {
+		fields = append(fields, vehiclerollup.FieldVehicleID)
+	}
+	if m.granularity != nil {
+		fields = append(fields, vehiclerollup.FieldGranularity)
+	}
+	if m.time_zone != nil {
+		fields = append(fields, vehiclerollup.FieldTimeZone)
+	}
+	if m.start_at != nil {
+		fields = append(fields, vehiclerollup.FieldStartAt)
+	}
+	if m.end_at != nil {
+		fields = append(fields, vehiclerollup.FieldEndAt)
+	}
+	if m.drives != nil {
+		fields = append(fields, vehiclerollup.FieldDrives)
+	}
+	if m.distance != nil {
+		fields = append(fields, vehiclerollup.FieldDistance)
+	}
+	if m.drive_seconds != nil {
+		fields = append(fields, vehiclerollup.FieldDriveSeconds)
+	}
+	if m.energy_used != nil {
+		fields = append(fields, vehiclerollup.FieldEnergyUsed)
+	}
+	if m.efficiency != nil {
+		fields = append(fields, vehiclerollup.FieldEfficiency)
+	}
+	if m.max_speed != nil {
+		fields = append(fields, vehiclerollup.FieldMaxSpeed)
+	}
+	if m.charges != nil {
+		fields = append(fields, vehiclerollup.FieldCharges)
+	}
+	if m.energy_added != nil {
+		fields = append(fields, vehiclerollup.FieldEnergyAdded)
+	}
+	if m.charge_cost != nil {
+		fields = append(fields, vehiclerollup.FieldChargeCost)
+	}
+	if m.parked_seconds != nil {
+		fields = append(fields, vehiclerollup.FieldParkedSeconds)
+	}
+	if m.asleep_seconds != nil {
+		fields = append(fields, vehiclerollup.FieldAsleepSeconds)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, vehiclerollup.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *VehicleRollupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case vehiclerollup.FieldVehicleID:
+		return m.VehicleID()
+	case vehiclerollup.FieldGranularity:
+		return m.Granularity()
+	case vehiclerollup.FieldTimeZone:
+		return m.TimeZone()
+	case vehiclerollup.FieldStartAt:
+		return m.StartAt()
+	case vehiclerollup.FieldEndAt:
+		return m.EndAt()
+	case vehiclerollup.FieldDrives:
+		return m.Drives()
+	case vehiclerollup.FieldDistance:
+		return m.Distance()
+	case vehiclerollup.FieldDriveSeconds:
+		return m.DriveSeconds()
+	case vehiclerollup.FieldEnergyUsed:
+		return m.EnergyUsed()
+	case vehiclerollup.FieldEfficiency:
+		return m.Efficiency()
+	case vehiclerollup.FieldMaxSpeed:
+		return m.MaxSpeed()
+	case vehiclerollup.FieldCharges:
+		return m.Charges()
+	case vehiclerollup.FieldEnergyAdded:
+		return m.EnergyAdded()
+	case vehiclerollup.FieldChargeCost:
+		return m.ChargeCost()
+	case vehiclerollup.FieldParkedSeconds:
+		return m.ParkedSeconds()
+	case vehiclerollup.FieldAsleepSeconds:
+		return m.AsleepSeconds()
+	case vehiclerollup.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *VehicleRollupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case vehiclerollup.FieldVehicleID:
+		return m.OldVehicleID(ctx)
+	case vehiclerollup.FieldGranularity:
+		return m.OldGranularity(ctx)
+	case vehiclerollup.FieldTimeZone:
+		return m.OldTimeZone(ctx)
+	case vehiclerollup.FieldStartAt:
+		return m.OldStartAt(ctx)
+	case vehiclerollup.FieldEndAt:
+		return m.OldEndAt(ctx)
+	case vehiclerollup.FieldDrives:
+		return m.OldDrives(ctx)
+	case vehiclerollup.FieldDistance:
+		return m.OldDistance(ctx)
+	case vehiclerollup.FieldDriveSeconds:
+		return m.OldDriveSeconds(ctx)
+	case vehiclerollup.FieldEnergyUsed:
+		return m.OldEnergyUsed(ctx)
+	case vehiclerollup.FieldEfficiency:
+		return m.OldEfficiency(ctx)
+	case vehiclerollup.FieldMaxSpeed:
+		return m.OldMaxSpeed(ctx)
+	case vehiclerollup.FieldCharges:
+		return m.OldCharges(ctx)
+	case vehiclerollup.FieldEnergyAdded:
+		return m.OldEnergyAdded(ctx)
+	case vehiclerollup.FieldChargeCost:
+		return m.OldChargeCost(ctx)
+	case vehiclerollup.FieldParkedSeconds:
+		return m.OldParkedSeconds(ctx)
+	case vehiclerollup.FieldAsleepSeconds:
+		return m.OldAsleepSeconds(ctx)
+	case vehiclerollup.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown VehicleRollup field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VehicleRollupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case vehiclerollup.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVehicleID(v)
+		return nil
+	case vehiclerollup.FieldGranularity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGranularity(v)
+		return nil
+	case vehiclerollup.FieldTimeZone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeZone(v)
+		return nil
+	case vehiclerollup.FieldStartAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartAt(v)
+		return nil
+	case vehiclerollup.FieldEndAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndAt(v)
+		return nil
+	case vehiclerollup.FieldDrives:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDrives(v)
+		return nil
+	case vehiclerollup.FieldDistance:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDistance(v)
+		return nil
+	case vehiclerollup.FieldDriveSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDriveSeconds(v)
+		return nil
+	case vehiclerollup.FieldEnergyUsed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnergyUsed(v)
+		return nil
+	case vehiclerollup.FieldEfficiency:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEfficiency(v)
+		return nil
+	case vehiclerollup.FieldMaxSpeed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxSpeed(v)
+		return nil
+	case vehiclerollup.FieldCharges:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCharges(v)
+		return nil
+	case vehiclerollup.FieldEnergyAdded:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnergyAdded(v)
+		return nil
+	case vehiclerollup.FieldChargeCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChargeCost(v)
+		return nil
+	case vehiclerollup.FieldParkedSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParkedSeconds(v)
+		return nil
+	case vehiclerollup.FieldAsleepSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAsleepSeconds(v)
+		return nil
+	case vehiclerollup.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleRollup field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *VehicleRollupMutation) AddedFields() []string {
+	var fields []string
+	if m.addvehicle_id != nil {
+		fields = append(fields, vehiclerollup.FieldVehicleID)
+	}
+	if m.adddrives != nil {
+		fields = append(fields, vehiclerollup.FieldDrives)
+	}
+	if m.adddistance != nil {
+		fields = append(fields, vehiclerollup.FieldDistance)
+	}
+	if m.adddrive_seconds != nil {
+		fields = append(fields, vehiclerollup.FieldDriveSeconds)
+	}
+	if m.addenergy_used != nil {
+		fields = append(fields, vehiclerollup.FieldEnergyUsed)
+	}
+	if m.addefficiency != nil {
+		fields = append(fields, vehiclerollup.FieldEfficiency)
+	}
+	if m.addmax_speed != nil {
+		fields = append(fields, vehiclerollup.FieldMaxSpeed)
+	}
+	if m.addcharges != nil {
+		fields = append(fields, vehiclerollup.FieldCharges)
+	}
+	if m.addenergy_added != nil {
+		fields = append(fields, vehiclerollup.FieldEnergyAdded)
+	}
+	if m.addcharge_cost != nil {
+		fields = append(fields, vehiclerollup.FieldChargeCost)
+	}
+	if m.addparked_seconds != nil {
+		fields = append(fields, vehiclerollup.FieldParkedSeconds)
+	}
+	if m.addasleep_seconds != nil {
+		fields = append(fields, vehiclerollup.FieldAsleepSeconds)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *VehicleRollupMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case vehiclerollup.FieldVehicleID:
+		return m.AddedVehicleID()
+	case vehiclerollup.FieldDrives:
+		return m.AddedDrives()
+	case vehiclerollup.FieldDistance:
+		return m.AddedDistance()
+	case vehiclerollup.FieldDriveSeconds:
+		return m.AddedDriveSeconds()
+	case vehiclerollup.FieldEnergyUsed:
+		return m.AddedEnergyUsed()
+	case vehiclerollup.FieldEfficiency:
+		return m.AddedEfficiency()
+	case vehiclerollup.FieldMaxSpeed:
+		return m.AddedMaxSpeed()
+	case vehiclerollup.FieldCharges:
+		return m.AddedCharges()
+	case vehiclerollup.FieldEnergyAdded:
+		return m.AddedEnergyAdded()
+	case vehiclerollup.FieldChargeCost:
+		return m.AddedChargeCost()
+	case vehiclerollup.FieldParkedSeconds:
+		return m.AddedParkedSeconds()
+	case vehiclerollup.FieldAsleepSeconds:
+		return m.AddedAsleepSeconds()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VehicleRollupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case vehiclerollup.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVehicleID(v)
+		return nil
+	case vehiclerollup.FieldDrives:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDrives(v)
+		return nil
+	case vehiclerollup.FieldDistance:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDistance(v)
+		return nil
+	case vehiclerollup.FieldDriveSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDriveSeconds(v)
+		return nil
+	case vehiclerollup.FieldEnergyUsed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEnergyUsed(v)
+		return nil
+	case vehiclerollup.FieldEfficiency:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEfficiency(v)
+		return nil
+	case vehiclerollup.FieldMaxSpeed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxSpeed(v)
+		return nil
+	case vehiclerollup.FieldCharges:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCharges(v)
+		return nil
+	case vehiclerollup.FieldEnergyAdded:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEnergyAdded(v)
+		return nil
+	case vehiclerollup.FieldChargeCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChargeCost(v)
+		return nil
+	case vehiclerollup.FieldParkedSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddParkedSeconds(v)
+		return nil
+	case vehiclerollup.FieldAsleepSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAsleepSeconds(v)
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleRollup numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *VehicleRollupMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *VehicleRollupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *VehicleRollupMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown VehicleRollup nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *VehicleRollupMutation) ResetField(name string) error {
+	switch name {
+	case vehiclerollup.FieldVehicleID:
+		m.ResetVehicleID()
+		return nil
+	case vehiclerollup.FieldGranularity:
+		m.ResetGranularity()
+		return nil
+	case vehiclerollup.FieldTimeZone:
+		m.ResetTimeZone()
+		return nil
+	case vehiclerollup.FieldStartAt:
+		m.ResetStartAt()
+		return nil
+	case vehiclerollup.FieldEndAt:
+		m.ResetEndAt()
+		return nil
+	case vehiclerollup.FieldDrives:
+		m.ResetDrives()
+		return nil
+	case vehiclerollup.FieldDistance:
+		m.ResetDistance()
+		return nil
+	case vehiclerollup.FieldDriveSeconds:
+		m.ResetDriveSeconds()
+		return nil
+	case vehiclerollup.FieldEnergyUsed:
+		m.ResetEnergyUsed()
+		return nil
+	case vehiclerollup.FieldEfficiency:
+		m.ResetEfficiency()
+		return nil
+	case vehiclerollup.FieldMaxSpeed:
+		m.ResetMaxSpeed()
+		return nil
+	case vehiclerollup.FieldCharges:
+		m.ResetCharges()
+		return nil
+	case vehiclerollup.FieldEnergyAdded:
+		m.ResetEnergyAdded()
+		return nil
+	case vehiclerollup.FieldChargeCost:
+		m.ResetChargeCost()
+		return nil
+	case vehiclerollup.FieldParkedSeconds:
+		m.ResetParkedSeconds()
+		return nil
+	case vehiclerollup.FieldAsleepSeconds:
+		m.ResetAsleepSeconds()
+		return nil
+	case vehiclerollup.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleRollup field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *VehicleRollupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *VehicleRollupMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *VehicleRollupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *VehicleRollupMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *VehicleRollupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *VehicleRollupMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *VehicleRollupMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown VehicleRollup unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *VehicleRollupMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown VehicleRollup edge %s", name)
+}
+
+// VehicleSnapshotMutation represents an operation that mutates the VehicleSnapshot nodes in the graph.
+type VehicleSnapshotMutation struct {
+	config
+	op                                   Op
+	typ                                  string
+	id                                   *int
+	vehicle_id                           *int
+	addvehicle_id                        *int
+	state                                *string
+	shift_state                          *string
+	speed                                *float64
+	addspeed                             *float64
+	power                                *int
+	addpower                             *int
+	latitude                             *float64
+	addlatitude                          *float64
+	longitude                            *float64
+	addlongitude                         *float64
+	coord_type                           *string
+	heading                              *int
+	addheading                           *int
+	battery_level                        *int
+	addbattery_level                     *int
+	usable_battery_level                 *int
+	addusable_battery_level              *int
+	battery_range                        *float64
+	addbattery_range                     *float64
+	ideal_battery_range                  *float64
+	addideal_battery_range               *float64
+	est_battery_range                    *float64
+	addest_battery_range                 *float64
+	charging_state                       *string
+	charger_power                        *int
+	addcharger_power                     *int
+	charge_energy_added                  *float64
+	addcharge_energy_added               *float64
+	charger_voltage                      *int
+	addcharger_voltage                   *int
+	charger_actual_current               *int
+	addcharger_actual_current            *int
+	fast_charger_present                 *bool
+	fast_charger_type                    *string
+	battery_heater_on                    *bool
+	outside_temp                         *float64
+	addoutside_temp                      *float64
+	inside_temp                          *float64
+	addinside_temp                       *float64
+	climate_on                           *bool
+	climate_keeper_mode                  *string
+	cabin_overheat_cooling               *bool
+	sentry_mode                          *bool
+	locked                               *bool
+	odometer                             *float64
+	addodometer                          *float64
+	car_version                          *string
+	software_update_status               *string
+	software_update_version              *string
+	software_update_download_perc        *int
+	addsoftware_update_download_perc     *int
+	software_update_install_perc         *int
+	addsoftware_update_install_perc      *int
+	software_update_expected_duration    *int
+	addsoftware_update_expected_duration *int
+	created_at                           *time.Time
+	clearedFields                        map[string]struct{}
+	done                                 bool
+	oldValue                             func(context.Context) (*VehicleSnapshot, error)
+	predicates                           []predicate.VehicleSnapshot
+}
+
+var _ ent.Mutation = (*VehicleSnapshotMutation)(nil)
+
+// vehiclesnapshotOption allows management of the mutation configuration using functional options.
+type vehiclesnapshotOption func(*VehicleSnapshotMutation)
+
+// newVehicleSnapshotMutation creates new mutation for the VehicleSnapshot entity.
+func newVehicleSnapshotMutation(c config, op Op, opts ...vehiclesnapshotOption) *VehicleSnapshotMutation {
+	m := &VehicleSnapshotMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVehicleSnapshot,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVehicleSnapshotID sets the ID field of the mutation.
+func withVehicleSnapshotID(id int) vehiclesnapshotOption {
+	return func(m *VehicleSnapshotMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *VehicleSnapshot
+		)
+		m.oldValue = func(ctx context.Context) (*VehicleSnapshot, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().VehicleSnapshot.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVehicleSnapshot sets the old VehicleSnapshot of the mutation.
+func withVehicleSnapshot(node *VehicleSnapshot) vehiclesnapshotOption {
+	return func(m *VehicleSnapshotMutation) {
+		m.oldValue = func(context.Context) (*VehicleSnapshot, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VehicleSnapshotMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VehicleSnapshotMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VehicleSnapshotMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VehicleSnapshotMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().VehicleSnapshot.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (m *VehicleSnapshotMutation) SetVehicleID(i int) {
+	m.vehicle_id = &i
+	m.addvehicle_id = nil
+}
+
+// VehicleID returns the value of the "vehicle_id" field in the mutation.
+func (m *VehicleSnapshotMutation) VehicleID() (r int, exists bool) {
+	v := m.vehicle_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVehicleID returns the old "vehicle_id" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldVehicleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVehicleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVehicleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVehicleID: %w", err)
+	}
+	return oldValue.VehicleID, nil
+}
+
+// AddVehicleID adds i to the "vehicle_id" field.
+func (m *VehicleSnapshotMutation) AddVehicleID(i int) {
+	if m.addvehicle_id != nil {
+		*m.addvehicle_id += i
+	} else {
+		m.addvehicle_id = &i
+	}
+}
+
+// AddedVehicleID returns the value that was added to the "vehicle_id" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedVehicleID() (r int, exists bool) {
+	v := m.addvehicle_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVehicleID resets all changes to the "vehicle_id" field.
+func (m *VehicleSnapshotMutation) ResetVehicleID() {
+	m.vehicle_id = nil
+	m.addvehicle_id = nil
+}
+
+// SetState sets the "state" field.
+func (m *VehicleSnapshotMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *VehicleSnapshotMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *VehicleSnapshotMutation) ResetState() {
+	m.state = nil
+}
+
+// SetShiftState sets the "shift_state" field.
+func (m *VehicleSnapshotMutation) SetShiftState(s string) {
+	m.shift_state = &s
+}
+
+// ShiftState returns the value of the "shift_state" field in the mutation.
+func (m *VehicleSnapshotMutation) ShiftState() (r string, exists bool) {
+	v := m.shift_state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShiftState returns the old "shift_state" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldShiftState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShiftState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShiftState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShiftState: %w", err)
+	}
+	return oldValue.ShiftState, nil
+}
+
+// ClearShiftState clears the value of the "shift_state" field.
+func (m *VehicleSnapshotMutation) ClearShiftState() {
+	m.shift_state = nil
+	m.clearedFields[vehiclesnapshot.FieldShiftState] = struct{}{}
+}
+
+// ShiftStateCleared returns if the "shift_state" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) ShiftStateCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldShiftState]
+	return ok
+}
+
+// ResetShiftState resets all changes to the "shift_state" field.
+func (m *VehicleSnapshotMutation) ResetShiftState() {
+	m.shift_state = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldShiftState)
+}
+
+// SetSpeed sets the "speed" field.
+func (m *VehicleSnapshotMutation) SetSpeed(f float64) {
+	m.speed = &f
+	m.addspeed = nil
+}
+
+// Speed returns the value of the "speed" field in the mutation.
+func (m *VehicleSnapshotMutation) Speed() (r float64, exists bool) {
+	v := m.speed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpeed returns the old "speed" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldSpeed(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpeed: %w", err)
+	}
+	return oldValue.Speed, nil
+}
+
+// AddSpeed adds f to the "speed" field.
+func (m *VehicleSnapshotMutation) AddSpeed(f float64) {
+	if m.addspeed != nil {
+		*m.addspeed += f
+	} else {
+		m.addspeed = &f
+	}
+}
+
+// AddedSpeed returns the value that was added to the "speed" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedSpeed() (r float64, exists bool) {
+	v := m.addspeed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSpeed clears the value of the "speed" field.
+func (m *VehicleSnapshotMutation) ClearSpeed() {
+	m.speed = nil
+	m.addspeed = nil
+	m.clearedFields[vehiclesnapshot.FieldSpeed] = struct{}{}
+}
+
+// SpeedCleared returns if the "speed" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) SpeedCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldSpeed]
+	return ok
+}
+
+// ResetSpeed resets all changes to the "speed" field.
+func (m *VehicleSnapshotMutation) ResetSpeed() {
+	m.speed = nil
+	m.addspeed = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldSpeed)
+}
+
+// SetPower sets the "power" field.
+func (m *VehicleSnapshotMutation) SetPower(i int) {
+	m.power = &i
+	m.addpower = nil
+}
+
+// Power returns the value of the "power" field in the mutation.
+func (m *VehicleSnapshotMutation) Power() (r int, exists bool) {
+	v := m.power
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPower returns the old "power" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldPower(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPower is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPower requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPower: %w", err)
+	}
+	return oldValue.Power, nil
+}
+
+// AddPower adds i to the "power" field.
+func (m *VehicleSnapshotMutation) AddPower(i int) {
+	if m.addpower != nil {
+		*m.addpower += i
+	} else {
+		m.addpower = &i
+	}
+}
+
+// AddedPower returns the value that was added to the "power" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedPower() (r int, exists bool) {
+	v := m.addpower
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPower clears the value of the "power" field.
+func (m *VehicleSnapshotMutation) ClearPower() {
+	m.power = nil
+	m.addpower = nil
+	m.clearedFields[vehiclesnapshot.FieldPower] = struct{}{}
+}
+
+// PowerCleared returns if the "power" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) PowerCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldPower]
+	return ok
+}
+
+// ResetPower resets all changes to the "power" field.
+func (m *VehicleSnapshotMutation) ResetPower() {
+	m.power = nil
+	m.addpower = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldPower)
+}
+
+// SetLatitude sets the "latitude" field.
+func (m *VehicleSnapshotMutation) SetLatitude(f float64) {
+	m.latitude = &f
+	m.addlatitude = nil
+}
+
+// Latitude returns the value of the "latitude" field in the mutation.
+func (m *VehicleSnapshotMutation) Latitude() (r float64, exists bool) {
+	v := m.latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatitude returns the old "latitude" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldLatitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatitude: %w", err)
+	}
+	return oldValue.Latitude, nil
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (m *VehicleSnapshotMutation) AddLatitude(f float64) {
+	if m.addlatitude != nil {
+		*m.addlatitude += f
+	} else {
+		m.addlatitude = &f
+	}
+}
+
+// AddedLatitude returns the value that was added to the "latitude" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedLatitude() (r float64, exists bool) {
+	v := m.addlatitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (m *VehicleSnapshotMutation) ClearLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+	m.clearedFields[vehiclesnapshot.FieldLatitude] = struct{}{}
+}
+
+// LatitudeCleared returns if the "latitude" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) LatitudeCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldLatitude]
+	return ok
+}
+
+// ResetLatitude resets all changes to the "latitude" field.
+func (m *VehicleSnapshotMutation) ResetLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldLatitude)
+}
+
+// SetLongitude sets the "longitude" field.
+func (m *VehicleSnapshotMutation) SetLongitude(f float64) {
+	m.longitude = &f
+	m.addlongitude = nil
+}
+
+// Longitude returns the value of the "longitude" field in the mutation.
+func (m *VehicleSnapshotMutation) Longitude() (r float64, exists bool) {
+	v := m.longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLongitude returns the old "longitude" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldLongitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLongitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLongitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLongitude: %w", err)
+	}
+	return oldValue.Longitude, nil
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (m *VehicleSnapshotMutation) AddLongitude(f float64) {
+	if m.addlongitude != nil {
+		*m.addlongitude += f
+	} else {
+		m.addlongitude = &f
+	}
+}
+
+// AddedLongitude returns the value that was added to the "longitude" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedLongitude() (r float64, exists bool) {
+	v := m.addlongitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (m *VehicleSnapshotMutation) ClearLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+	m.clearedFields[vehiclesnapshot.FieldLongitude] = struct{}{}
+}
+
+// LongitudeCleared returns if the "longitude" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) LongitudeCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldLongitude]
+	return ok
+}
+
+// ResetLongitude resets all changes to the "longitude" field.
+func (m *VehicleSnapshotMutation) ResetLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldLongitude)
+}
+
+// SetCoordType sets the "coord_type" field.
+func (m *VehicleSnapshotMutation) SetCoordType(s string) {
+	m.coord_type = &s
+}
+
+// CoordType returns the value of the "coord_type" field in the mutation.
+func (m *VehicleSnapshotMutation) CoordType() (r string, exists bool) {
+	v := m.coord_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCoordType returns the old "coord_type" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldCoordType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCoordType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCoordType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCoordType: %w", err)
+	}
+	return oldValue.CoordType, nil
+}
+
+// ClearCoordType clears the value of the "coord_type" field.
+func (m *VehicleSnapshotMutation) ClearCoordType() {
+	m.coord_type = nil
+	m.clearedFields[vehiclesnapshot.FieldCoordType] = struct{}{}
+}
+
+// CoordTypeCleared returns if the "coord_type" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) CoordTypeCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldCoordType]
+	return ok
+}
+
+// ResetCoordType resets all changes to the "coord_type" field.
+func (m *VehicleSnapshotMutation) ResetCoordType() {
+	m.coord_type = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldCoordType)
+}
+
+// SetHeading sets the "heading" field.
+func (m *VehicleSnapshotMutation) SetHeading(i int) {
+	m.heading = &i
+	m.addheading = nil
+}
+
+// Heading returns the value of the "heading" field in the mutation.
+func (m *VehicleSnapshotMutation) Heading() (r int, exists bool) {
+	v := m.heading
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeading returns the old "heading" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldHeading(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeading is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeading requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeading: %w", err)
+	}
+	return oldValue.Heading, nil
+}
+
+// AddHeading adds i to the "heading" field.
+func (m *VehicleSnapshotMutation) AddHeading(i int) {
+	if m.addheading != nil {
+		*m.addheading += i
+	} else {
+		m.addheading = &i
+	}
+}
+
+// AddedHeading returns the value that was added to the "heading" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedHeading() (r int, exists bool) {
+	v := m.addheading
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHeading clears the value of the "heading" field.
+func (m *VehicleSnapshotMutation) ClearHeading() {
+	m.heading = nil
+	m.addheading = nil
+	m.clearedFields[vehiclesnapshot.FieldHeading] = struct{}{}
+}
+
+// HeadingCleared returns if the "heading" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) HeadingCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldHeading]
+	return ok
+}
+
+// ResetHeading resets all changes to the "heading" field.
+func (m *VehicleSnapshotMutation) ResetHeading() {
+	m.heading = nil
+	m.addheading = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldHeading)
+}
+
+// SetBatteryLevel sets the "battery_level" field.
+func (m *VehicleSnapshotMutation) SetBatteryLevel(i int) {
+	m.battery_level = &i
+	m.addbattery_level = nil
+}
+
+// BatteryLevel returns the value of the "battery_level" field in the mutation.
+func (m *VehicleSnapshotMutation) BatteryLevel() (r int, exists bool) {
+	v := m.battery_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBatteryLevel returns the old "battery_level" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldBatteryLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBatteryLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBatteryLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBatteryLevel: %w", err)
+	}
+	return oldValue.BatteryLevel, nil
+}
+
+// AddBatteryLevel adds i to the "battery_level" field.
+func (m *VehicleSnapshotMutation) AddBatteryLevel(i int) {
+	if m.addbattery_level != nil {
+		*m.addbattery_level += i
+	} else {
+		m.addbattery_level = &i
+	}
+}
+
+// AddedBatteryLevel returns the value that was added to the "battery_level" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedBatteryLevel() (r int, exists bool) {
+	v := m.addbattery_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBatteryLevel clears the value of the "battery_level" field.
+func (m *VehicleSnapshotMutation) ClearBatteryLevel() {
+	m.battery_level = nil
+	m.addbattery_level = nil
+	m.clearedFields[vehiclesnapshot.FieldBatteryLevel] = struct{}{}
+}
+
+// BatteryLevelCleared returns if the "battery_level" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) BatteryLevelCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldBatteryLevel]
+	return ok
+}
+
+// ResetBatteryLevel resets all changes to the "battery_level" field.
+func (m *VehicleSnapshotMutation) ResetBatteryLevel() {
+	m.battery_level = nil
+	m.addbattery_level = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldBatteryLevel)
+}
+
+// SetUsableBatteryLevel sets the "usable_battery_level" field.
+func (m *VehicleSnapshotMutation) SetUsableBatteryLevel(i int) {
+	m.usable_battery_level = &i
+	m.addusable_battery_level = nil
+}
+
+// UsableBatteryLevel returns the value of the "usable_battery_level" field in the mutation.
+func (m *VehicleSnapshotMutation) UsableBatteryLevel() (r int, exists bool) {
+	v := m.usable_battery_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsableBatteryLevel returns the old "usable_battery_level" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldUsableBatteryLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsableBatteryLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsableBatteryLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsableBatteryLevel: %w", err)
+	}
+	return oldValue.UsableBatteryLevel, nil
+}
+
+// AddUsableBatteryLevel adds i to the "usable_battery_level" field.
+func (m *VehicleSnapshotMutation) AddUsableBatteryLevel(i int) {
+	if m.addusable_battery_level != nil {
+		*m.addusable_battery_level += i
+	} else {
+		m.addusable_battery_level = &i
+	}
+}
+
+// AddedUsableBatteryLevel returns the value that was added to the "usable_battery_level" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedUsableBatteryLevel() (r int, exists bool) {
+	v := m.addusable_battery_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUsableBatteryLevel clears the value of the "usable_battery_level" field.
+func (m *VehicleSnapshotMutation) ClearUsableBatteryLevel() {
+	m.usable_battery_level = nil
+	m.addusable_battery_level = nil
+	m.clearedFields[vehiclesnapshot.FieldUsableBatteryLevel] = struct{}{}
+}
+
+// UsableBatteryLevelCleared returns if the "usable_battery_level" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) UsableBatteryLevelCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldUsableBatteryLevel]
+	return ok
+}
+
+// ResetUsableBatteryLevel resets all changes to the "usable_battery_level" field.
+func (m *VehicleSnapshotMutation) ResetUsableBatteryLevel() {
+	m.usable_battery_level = nil
+	m.addusable_battery_level = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldUsableBatteryLevel)
+}
+
+// SetBatteryRange sets the "battery_range" field.
+func (m *VehicleSnapshotMutation) SetBatteryRange(f float64) {
+	m.battery_range = &f
+	m.addbattery_range = nil
+}
+
+// BatteryRange returns the value of the "battery_range" field in the mutation.
+func (m *VehicleSnapshotMutation) BatteryRange() (r float64, exists bool) {
+	v := m.battery_range
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBatteryRange returns the old "battery_range" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldBatteryRange(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBatteryRange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBatteryRange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBatteryRange: %w", err)
+	}
+	return oldValue.BatteryRange, nil
+}
+
+// AddBatteryRange adds f to the "battery_range" field.
+func (m *VehicleSnapshotMutation) AddBatteryRange(f float64) {
+	if m.addbattery_range != nil {
+		*m.addbattery_range += f
+	} else {
+		m.addbattery_range = &f
+	}
+}
+
+// AddedBatteryRange returns the value that was added to the "battery_range" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedBatteryRange() (r float64, exists bool) {
+	v := m.addbattery_range
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBatteryRange clears the value of the "battery_range" field.
+func (m *VehicleSnapshotMutation) ClearBatteryRange() {
+	m.battery_range = nil
+	m.addbattery_range = nil
+	m.clearedFields[vehiclesnapshot.FieldBatteryRange] = struct{}{}
+}
+
+// BatteryRangeCleared returns if the "battery_range" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) BatteryRangeCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldBatteryRange]
+	return ok
+}
+
+// ResetBatteryRange resets all changes to the "battery_range" field.
+func (m *VehicleSnapshotMutation) ResetBatteryRange() {
+	m.battery_range = nil
+	m.addbattery_range = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldBatteryRange)
+}
+
+// SetIdealBatteryRange sets the "ideal_battery_range" field.
+func (m *VehicleSnapshotMutation) SetIdealBatteryRange(f float64) {
+	m.ideal_battery_range = &f
+	m.addideal_battery_range = nil
+}
+
+// IdealBatteryRange returns the value of the "ideal_battery_range" field in the mutation.
+func (m *VehicleSnapshotMutation) IdealBatteryRange() (r float64, exists bool) {
+	v := m.ideal_battery_range
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdealBatteryRange returns the old "ideal_battery_range" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldIdealBatteryRange(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdealBatteryRange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdealBatteryRange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdealBatteryRange: %w", err)
+	}
+	return oldValue.IdealBatteryRange, nil
+}
+
+// AddIdealBatteryRange adds f to the "ideal_battery_range" field.
+func (m *VehicleSnapshotMutation) AddIdealBatteryRange(f float64) {
+	if m.addideal_battery_range != nil {
+		*m.addideal_battery_range += f
+	} else {
+		m.addideal_battery_range = &f
+	}
+}
+
+// AddedIdealBatteryRange returns the value that was added to the "ideal_battery_range" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedIdealBatteryRange() (r float64, exists bool) {
+	v := m.addideal_battery_range
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearIdealBatteryRange clears the value of the "ideal_battery_range" field.
+func (m *VehicleSnapshotMutation) ClearIdealBatteryRange() {
+	m.ideal_battery_range = nil
+	m.addideal_battery_range = nil
+	m.clearedFields[vehiclesnapshot.FieldIdealBatteryRange] = struct{}{}
+}
+
+// IdealBatteryRangeCleared returns if the "ideal_battery_range" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) IdealBatteryRangeCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldIdealBatteryRange]
+	return ok
+}
+
+// ResetIdealBatteryRange resets all changes to the "ideal_battery_range" field.
+func (m *VehicleSnapshotMutation) ResetIdealBatteryRange() {
+	m.ideal_battery_range = nil
+	m.addideal_battery_range = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldIdealBatteryRange)
+}
+
+// SetEstBatteryRange sets the "est_battery_range" field.
+func (m *VehicleSnapshotMutation) SetEstBatteryRange(f float64) {
+	m.est_battery_range = &f
+	m.addest_battery_range = nil
+}
+
+// EstBatteryRange returns the value of the "est_battery_range" field in the mutation.
+func (m *VehicleSnapshotMutation) EstBatteryRange() (r float64, exists bool) {
+	v := m.est_battery_range
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEstBatteryRange returns the old "est_battery_range" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldEstBatteryRange(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEstBatteryRange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEstBatteryRange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEstBatteryRange: %w", err)
+	}
+	return oldValue.EstBatteryRange, nil
+}
+
+// AddEstBatteryRange adds f to the "est_battery_range" field.
+func (m *VehicleSnapshotMutation) AddEstBatteryRange(f float64) {
+	if m.addest_battery_range != nil {
+		*m.addest_battery_range += f
+	} else {
+		m.addest_battery_range = &f
+	}
+}
+
+// AddedEstBatteryRange returns the value that was added to the "est_battery_range" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedEstBatteryRange() (r float64, exists bool) {
+	v := m.addest_battery_range
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEstBatteryRange clears the value of the "est_battery_range" field.
+func (m *VehicleSnapshotMutation) ClearEstBatteryRange() {
+	m.est_battery_range = nil
+	m.addest_battery_range = nil
+	m.clearedFields[vehiclesnapshot.FieldEstBatteryRange] = struct{}{}
+}
+
+// EstBatteryRangeCleared returns if the "est_battery_range" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) EstBatteryRangeCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldEstBatteryRange]
+	return ok
+}
+
+// ResetEstBatteryRange resets all changes to the "est_battery_range" field.
+func (m *VehicleSnapshotMutation) ResetEstBatteryRange() {
+	m.est_battery_range = nil
+	m.addest_battery_range = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldEstBatteryRange)
+}
+
+// SetChargingState sets the "charging_state" field.
+func (m *VehicleSnapshotMutation) SetChargingState(s string) {
+	m.charging_state = &s
+}
+
+// ChargingState returns the value of the "charging_state" field in the mutation.
+func (m *VehicleSnapshotMutation) ChargingState() (r string, exists bool) {
+	v := m.charging_state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChargingState returns the old "charging_state" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldChargingState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChargingState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChargingState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChargingState: %w", err)
+	}
+	return oldValue.ChargingState, nil
+}
+
+// ClearChargingState clears the value of the "charging_state" field.
+func (m *VehicleSnapshotMutation) ClearChargingState() {
+	m.charging_state = nil
+	m.clearedFields[vehiclesnapshot.FieldChargingState] = struct{}{}
+}
+
+// ChargingStateCleared returns if the "charging_state" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) ChargingStateCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldChargingState]
+	return ok
+}
+
+// ResetChargingState resets all changes to the "charging_state" field.
+func (m *VehicleSnapshotMutation) ResetChargingState() {
+	m.charging_state = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldChargingState)
+}
+
+// SetChargerPower sets the "charger_power" field.
+func (m *VehicleSnapshotMutation) SetChargerPower(i int) {
+	m.charger_power = &i
+	m.addcharger_power = nil
+}
+
+// ChargerPower returns the value of the "charger_power" field in the mutation.
+func (m *VehicleSnapshotMutation) ChargerPower() (r int, exists bool) {
+	v := m.charger_power
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChargerPower returns the old "charger_power" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldChargerPower(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChargerPower is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChargerPower requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChargerPower: %w", err)
+	}
+	return oldValue.ChargerPower, nil
+}
+
+// AddChargerPower adds i to the "charger_power" field.
+func (m *VehicleSnapshotMutation) AddChargerPower(i int) {
+	if m.addcharger_power != nil {
+		*m.addcharger_power += i
+	} else {
+		m.addcharger_power = &i
+	}
+}
+
+// AddedChargerPower returns the value that was added to the "charger_power" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedChargerPower() (r int, exists bool) {
+	v := m.addcharger_power
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearChargerPower clears the value of the "charger_power" field.
+func (m *VehicleSnapshotMutation) ClearChargerPower() {
+	m.charger_power = nil
+	m.addcharger_power = nil
+	m.clearedFields[vehiclesnapshot.FieldChargerPower] = struct{}{}
+}
+
+// ChargerPowerCleared returns if the "charger_power" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) ChargerPowerCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldChargerPower]
+	return ok
+}
+
+// ResetChargerPower resets all changes to the "charger_power" field.
+func (m *VehicleSnapshotMutation) ResetChargerPower() {
+	m.charger_power = nil
+	m.addcharger_power = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldChargerPower)
+}
+
+// SetChargeEnergyAdded sets the "charge_energy_added" field.
+func (m *VehicleSnapshotMutation) SetChargeEnergyAdded(f float64) {
+	m.charge_energy_added = &f
+	m.addcharge_energy_added = nil
+}
+
+// ChargeEnergyAdded returns the value of the "charge_energy_added" field in the mutation.
+func (m *VehicleSnapshotMutation) ChargeEnergyAdded() (r float64, exists bool) {
+	v := m.charge_energy_added
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChargeEnergyAdded returns the old "charge_energy_added" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldChargeEnergyAdded(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChargeEnergyAdded is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChargeEnergyAdded requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChargeEnergyAdded: %w", err)
+	}
+	return oldValue.ChargeEnergyAdded, nil
+}
+
+// AddChargeEnergyAdded adds f to the "charge_energy_added" field.
+func (m *VehicleSnapshotMutation) AddChargeEnergyAdded(f float64) {
+	if m.addcharge_energy_added != nil {
+		*m.addcharge_energy_added += f
+	} else {
+		m.addcharge_energy_added = &f
+	}
+}
+
+// AddedChargeEnergyAdded returns the value that was added to the "charge_energy_added" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedChargeEnergyAdded() (r float64, exists bool) {
+	v := m.addcharge_energy_added
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearChargeEnergyAdded clears the value of the "charge_energy_added" field.
+func (m *VehicleSnapshotMutation) ClearChargeEnergyAdded() {
+	m.charge_energy_added = nil
+	m.addcharge_energy_added = nil
+	m.clearedFields[vehiclesnapshot.FieldChargeEnergyAdded] = struct{}{}
+}
+
+// ChargeEnergyAddedCleared returns if the "charge_energy_added" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) ChargeEnergyAddedCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldChargeEnergyAdded]
+	return ok
+}
+
+// ResetChargeEnergyAdded resets all changes to the "charge_energy_added" field.
+func (m *VehicleSnapshotMutation) ResetChargeEnergyAdded() {
+	m.charge_energy_added = nil
+	m.addcharge_energy_added = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldChargeEnergyAdded)
+}
+
+// SetChargerVoltage sets the "charger_voltage" field.
+func (m *VehicleSnapshotMutation) SetChargerVoltage(i int) {
+	m.charger_voltage = &i
+	m.addcharger_voltage = nil
+}
+
+// ChargerVoltage returns the value of the "charger_voltage" field in the mutation.
+func (m *VehicleSnapshotMutation) ChargerVoltage() (r int, exists bool) {
+	v := m.charger_voltage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChargerVoltage returns the old "charger_voltage" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldChargerVoltage(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChargerVoltage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChargerVoltage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChargerVoltage: %w", err)
+	}
+	return oldValue.ChargerVoltage, nil
+}
+
+// AddChargerVoltage adds i to the "charger_voltage" field.
+func (m *VehicleSnapshotMutation) AddChargerVoltage(i int) {
+	if m.addcharger_voltage != nil {
+		*m.addcharger_voltage += i
+	} else {
+		m.addcharger_voltage = &i
+	}
+}
+
+// AddedChargerVoltage returns the value that was added to the "charger_voltage" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedChargerVoltage() (r int, exists bool) {
+	v := m.addcharger_voltage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearChargerVoltage clears the value of the "charger_voltage" field.
+func (m *VehicleSnapshotMutation) ClearChargerVoltage() {
+	m.charger_voltage = nil
+	m.addcharger_voltage = nil
+	m.clearedFields[vehiclesnapshot.FieldChargerVoltage] = struct{}{}
+}
+
+// ChargerVoltageCleared returns if the "charger_voltage" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) ChargerVoltageCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldChargerVoltage]
+	return ok
+}
+
+// ResetChargerVoltage resets all changes to the "charger_voltage" field.
+func (m *VehicleSnapshotMutation) ResetChargerVoltage() {
+	m.charger_voltage = nil
+	m.addcharger_voltage = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldChargerVoltage)
+}
+
+// SetChargerActualCurrent sets the "charger_actual_current" field.
+func (m *VehicleSnapshotMutation) SetChargerActualCurrent(i int) {
+	m.charger_actual_current = &i
+	m.addcharger_actual_current = nil
+}
+
+// ChargerActualCurrent returns the value of the "charger_actual_current" field in the mutation.
+func (m *VehicleSnapshotMutation) ChargerActualCurrent() (r int, exists bool) {
+	v := m.charger_actual_current
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChargerActualCurrent returns the old "charger_actual_current" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldChargerActualCurrent(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChargerActualCurrent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChargerActualCurrent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChargerActualCurrent: %w", err)
+	}
+	return oldValue.ChargerActualCurrent, nil
+}
+
+// AddChargerActualCurrent adds i to the "charger_actual_current" field.
+func (m *VehicleSnapshotMutation) AddChargerActualCurrent(i int) {
+	if m.addcharger_actual_current != nil {
+		*m.addcharger_actual_current += i
+	} else {
+		m.addcharger_actual_current = &i
+	}
+}
+
+// AddedChargerActualCurrent returns the value that was added to the "charger_actual_current" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedChargerActualCurrent() (r int, exists bool) {
+	v := m.addcharger_actual_current
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearChargerActualCurrent clears the value of the "charger_actual_current" field.
+func (m *VehicleSnapshotMutation) ClearChargerActualCurrent() {
+	m.charger_actual_current = nil
+	m.addcharger_actual_current = nil
+	m.clearedFields[vehiclesnapshot.FieldChargerActualCurrent] = struct{}{}
+}
+
+// ChargerActualCurrentCleared returns if the "charger_actual_current" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) ChargerActualCurrentCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldChargerActualCurrent]
+	return ok
+}
+
+// ResetChargerActualCurrent resets all changes to the "charger_actual_current" field.
+func (m *VehicleSnapshotMutation) ResetChargerActualCurrent() {
+	m.charger_actual_current = nil
+	m.addcharger_actual_current = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldChargerActualCurrent)
+}
+
+// SetFastChargerPresent sets the "fast_charger_present" field.
+func (m *VehicleSnapshotMutation) SetFastChargerPresent(b bool) {
+	m.fast_charger_present = &b
+}
+
+// FastChargerPresent returns the value of the "fast_charger_present" field in the mutation.
+func (m *VehicleSnapshotMutation) FastChargerPresent() (r bool, exists bool) {
+	v := m.fast_charger_present
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFastChargerPresent returns the old "fast_charger_present" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldFastChargerPresent(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFastChargerPresent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFastChargerPresent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFastChargerPresent: %w", err)
+	}
+	return oldValue.FastChargerPresent, nil
+}
+
+// ResetFastChargerPresent resets all changes to the "fast_charger_present" field.
+func (m *VehicleSnapshotMutation) ResetFastChargerPresent() {
+	m.fast_charger_present = nil
+}
+
+// SetFastChargerType sets the "fast_charger_type" field.
+func (m *VehicleSnapshotMutation) SetFastChargerType(s string) {
+	m.fast_charger_type = &s
+}
+
+// FastChargerType returns the value of the "fast_charger_type" field in the mutation.
+func (m *VehicleSnapshotMutation) FastChargerType() (r string, exists bool) {
+	v := m.fast_charger_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFastChargerType returns the old "fast_charger_type" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldFastChargerType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFastChargerType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFastChargerType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFastChargerType: %w", err)
+	}
+	return oldValue.FastChargerType, nil
+}
+
+// ClearFastChargerType clears the value of the "fast_charger_type" field.
+func (m *VehicleSnapshotMutation) ClearFastChargerType() {
+	m.fast_charger_type = nil
+	m.clearedFields[vehiclesnapshot.FieldFastChargerType] = struct{}{}
+}
+
+// FastChargerTypeCleared returns if the "fast_charger_type" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) FastChargerTypeCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldFastChargerType]
+	return ok
+}
+
+// ResetFastChargerType resets all changes to the "fast_charger_type" field.
+func (m *VehicleSnapshotMutation) ResetFastChargerType() {
+	m.fast_charger_type = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldFastChargerType)
+}
+
+// SetBatteryHeaterOn sets the "battery_heater_on" field.
+func (m *VehicleSnapshotMutation) SetBatteryHeaterOn(b bool) {
+	m.battery_heater_on = &b
+}
+
+// BatteryHeaterOn returns the value of the "battery_heater_on" field in the mutation.
+func (m *VehicleSnapshotMutation) BatteryHeaterOn() (r bool, exists bool) {
+	v := m.battery_heater_on
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBatteryHeaterOn returns the old "battery_heater_on" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldBatteryHeaterOn(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBatteryHeaterOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBatteryHeaterOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBatteryHeaterOn: %w", err)
+	}
+	return oldValue.BatteryHeaterOn, nil
+}
+
+// ResetBatteryHeaterOn resets all changes to the "battery_heater_on" field.
+func (m *VehicleSnapshotMutation) ResetBatteryHeaterOn() {
+	m.battery_heater_on = nil
+}
+
+// SetOutsideTemp sets the "outside_temp" field.
+func (m *VehicleSnapshotMutation) SetOutsideTemp(f float64) {
+	m.outside_temp = &f
+	m.addoutside_temp = nil
+}
+
+// OutsideTemp returns the value of the "outside_temp" field in the mutation.
+func (m *VehicleSnapshotMutation) OutsideTemp() (r float64, exists bool) {
+	v := m.outside_temp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutsideTemp returns the old "outside_temp" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldOutsideTemp(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutsideTemp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutsideTemp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutsideTemp: %w", err)
+	}
+	return oldValue.OutsideTemp, nil
+}
+
+// AddOutsideTemp adds f to the "outside_temp" field.
+func (m *VehicleSnapshotMutation) AddOutsideTemp(f float64) {
+	if m.addoutside_temp != nil {
+		*m.addoutside_temp += f
+	} else {
+		m.addoutside_temp = &f
+	}
+}
+
+// AddedOutsideTemp returns the value that was added to the "outside_temp" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedOutsideTemp() (r float64, exists bool) {
+	v := m.addoutside_temp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOutsideTemp clears the value of the "outside_temp" field.
+func (m *VehicleSnapshotMutation) ClearOutsideTemp() {
+	m.outside_temp = nil
+	m.addoutside_temp = nil
+	m.clearedFields[vehiclesnapshot.FieldOutsideTemp] = struct{}{}
+}
+
+// OutsideTempCleared returns if the "outside_temp" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) OutsideTempCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldOutsideTemp]
+	return ok
+}
+
+// ResetOutsideTemp resets all changes to the "outside_temp" field.
+func (m *VehicleSnapshotMutation) ResetOutsideTemp() {
+	m.outside_temp = nil
+	m.addoutside_temp = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldOutsideTemp)
+}
+
+// SetInsideTemp sets the "inside_temp" field.
+func (m *VehicleSnapshotMutation) SetInsideTemp(f float64) {
+	m.inside_temp = &f
+	m.addinside_temp = nil
+}
+
+// InsideTemp returns the value of the "inside_temp" field in the mutation.
+func (m *VehicleSnapshotMutation) InsideTemp() (r float64, exists bool) {
+	v := m.inside_temp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInsideTemp returns the old "inside_temp" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldInsideTemp(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInsideTemp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInsideTemp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInsideTemp: %w", err)
+	}
+	return oldValue.InsideTemp, nil
+}
+
+// AddInsideTemp adds f to the "inside_temp" field.
+func (m *VehicleSnapshotMutation) AddInsideTemp(f float64) {
+	if m.addinside_temp != nil {
+		*m.addinside_temp += f
+	} else {
+		m.addinside_temp = &f
+	}
+}
+
+// AddedInsideTemp returns the value that was added to the "inside_temp" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedInsideTemp() (r float64, exists bool) {
+	v := m.addinside_temp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearInsideTemp clears the value of the "inside_temp" field.
+func (m *VehicleSnapshotMutation) ClearInsideTemp() {
+	m.inside_temp = nil
+	m.addinside_temp = nil
+	m.clearedFields[vehiclesnapshot.FieldInsideTemp] = struct{}{}
+}
+
+// InsideTempCleared returns if the "inside_temp" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) InsideTempCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldInsideTemp]
+	return ok
+}
+
+// ResetInsideTemp resets all changes to the "inside_temp" field.
+func (m *VehicleSnapshotMutation) ResetInsideTemp() {
+	m.inside_temp = nil
+	m.addinside_temp = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldInsideTemp)
+}
+
+// SetClimateOn sets the "climate_on" field.
+func (m *VehicleSnapshotMutation) SetClimateOn(b bool) {
+	m.climate_on = &b
+}
+
+// ClimateOn returns the value of the "climate_on" field in the mutation.
+func (m *VehicleSnapshotMutation) ClimateOn() (r bool, exists bool) {
+	v := m.climate_on
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClimateOn returns the old "climate_on" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldClimateOn(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClimateOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClimateOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClimateOn: %w", err)
+	}
+	return oldValue.ClimateOn, nil
+}
+
+// ResetClimateOn resets all changes to the "climate_on" field.
+func (m *VehicleSnapshotMutation) ResetClimateOn() {
+	m.climate_on = nil
+}
+
+// SetClimateKeeperMode sets the "climate_keeper_mode" field.
+func (m *VehicleSnapshotMutation) SetClimateKeeperMode(s string) {
+	m.climate_keeper_mode = &s
+}
+
+// ClimateKeeperMode returns the value of the "climate_keeper_mode" field in the mutation.
+func (m *VehicleSnapshotMutation) ClimateKeeperMode() (r string, exists bool) {
+	v := m.climate_keeper_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClimateKeeperMode returns the old "climate_keeper_mode" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldClimateKeeperMode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClimateKeeperMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClimateKeeperMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClimateKeeperMode: %w", err)
+	}
+	return oldValue.ClimateKeeperMode, nil
+}
+
+// ClearClimateKeeperMode clears the value of the "climate_keeper_mode" field.
+func (m *VehicleSnapshotMutation) ClearClimateKeeperMode() {
+	m.climate_keeper_mode = nil
+	m.clearedFields[vehiclesnapshot.FieldClimateKeeperMode] = struct{}{}
+}
+
+// ClimateKeeperModeCleared returns if the "climate_keeper_mode" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) ClimateKeeperModeCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldClimateKeeperMode]
+	return ok
+}
+
+// ResetClimateKeeperMode resets all changes to the "climate_keeper_mode" field.
+func (m *VehicleSnapshotMutation) ResetClimateKeeperMode() {
+	m.climate_keeper_mode = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldClimateKeeperMode)
+}
+
+// SetCabinOverheatCooling sets the "cabin_overheat_cooling" field.
+func (m *VehicleSnapshotMutation) SetCabinOverheatCooling(b bool) {
+	m.cabin_overheat_cooling = &b
+}
+
+// CabinOverheatCooling returns the value of the "cabin_overheat_cooling" field in the mutation.
+func (m *VehicleSnapshotMutation) CabinOverheatCooling() (r bool, exists bool) {
+	v := m.cabin_overheat_cooling
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCabinOverheatCooling returns the old "cabin_overheat_cooling" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldCabinOverheatCooling(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCabinOverheatCooling is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCabinOverheatCooling requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCabinOverheatCooling: %w", err)
+	}
+	return oldValue.CabinOverheatCooling, nil
+}
+
+// ResetCabinOverheatCooling resets all changes to the "cabin_overheat_cooling" field.
+func (m *VehicleSnapshotMutation) ResetCabinOverheatCooling() {
+	m.cabin_overheat_cooling = nil
+}
+
+// SetSentryMode sets the "sentry_mode" field.
+func (m *VehicleSnapshotMutation) SetSentryMode(b bool) {
+	m.sentry_mode = &b
+}
+
+// SentryMode returns the value of the "sentry_mode" field in the mutation.
+func (m *VehicleSnapshotMutation) SentryMode() (r bool, exists bool) {
+	v := m.sentry_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentryMode returns the old "sentry_mode" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldSentryMode(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentryMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentryMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentryMode: %w", err)
+	}
+	return oldValue.SentryMode, nil
+}
+
+// ResetSentryMode resets all changes to the "sentry_mode" field.
+func (m *VehicleSnapshotMutation) ResetSentryMode() {
+	m.sentry_mode = nil
+}
+
+// SetLocked sets the "locked" field.
+func (m *VehicleSnapshotMutation) SetLocked(b bool) {
+	m.locked = &b
+}
+
+// Locked returns the value of the "locked" field in the mutation.
+func (m *VehicleSnapshotMutation) Locked() (r bool, exists bool) {
+	v := m.locked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocked returns the old "locked" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldLocked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocked: %w", err)
+	}
+	return oldValue.Locked, nil
+}
+
+// ResetLocked resets all changes to the "locked" field.
+func (m *VehicleSnapshotMutation) ResetLocked() {
+	m.locked = nil
+}
+
+// SetOdometer sets the "odometer" field.
+func (m *VehicleSnapshotMutation) SetOdometer(f float64) {
+	m.odometer = &f
+	m.addodometer = nil
+}
+
+// Odometer returns the value of the "odometer" field in the mutation.
+func (m *VehicleSnapshotMutation) Odometer() (r float64, exists bool) {
+	v := m.odometer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOdometer returns the old "odometer" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldOdometer(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOdometer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOdometer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOdometer: %w", err)
+	}
+	return oldValue.Odometer, nil
+}
+
+// AddOdometer adds f to the "odometer" field.
+func (m *VehicleSnapshotMutation) AddOdometer(f float64) {
+	if m.addodometer != nil {
+		*m.addodometer += f
+	} else {
+		m.addodometer = &f
+	}
+}
+
+// AddedOdometer returns the value that was added to the "odometer" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedOdometer() (r float64, exists bool) {
+	v := m.addodometer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOdometer clears the value of the "odometer" field.
+func (m *VehicleSnapshotMutation) ClearOdometer() {
+	m.odometer = nil
+	m.addodometer = nil
+	m.clearedFields[vehiclesnapshot.FieldOdometer] = struct{}{}
+}
+
+// OdometerCleared returns if the "odometer" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) OdometerCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldOdometer]
+	return ok
+}
+
+// ResetOdometer resets all changes to the "odometer" field.
+func (m *VehicleSnapshotMutation) ResetOdometer() {
+	m.odometer = nil
+	m.addodometer = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldOdometer)
+}
+
+// SetCarVersion sets the "car_version" field.
+func (m *VehicleSnapshotMutation) SetCarVersion(s string) {
+	m.car_version = &s
+}
+
+// CarVersion returns the value of the "car_version" field in the mutation.
+func (m *VehicleSnapshotMutation) CarVersion() (r string, exists bool) {
+	v := m.car_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarVersion returns the old "car_version" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldCarVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarVersion: %w", err)
+	}
+	return oldValue.CarVersion, nil
+}
+
+// ClearCarVersion clears the value of the "car_version" field.
+func (m *VehicleSnapshotMutation) ClearCarVersion() {
+	m.car_version = nil
+	m.clearedFields[vehiclesnapshot.FieldCarVersion] = struct{}{}
+}
+
+// CarVersionCleared returns if the "car_version" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) CarVersionCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldCarVersion]
+	return ok
+}
+
+// ResetCarVersion resets all changes to the "car_version" field.
+func (m *VehicleSnapshotMutation) ResetCarVersion() {
+	m.car_version = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldCarVersion)
+}
+
+// SetSoftwareUpdateStatus sets the "software_update_status" field.
+func (m *VehicleSnapshotMutation) SetSoftwareUpdateStatus(s string) {
+	m.software_update_status = &s
+}
+
+// SoftwareUpdateStatus returns the value of the "software_update_status" field in the mutation.
+func (m *VehicleSnapshotMutation) SoftwareUpdateStatus() (r string, exists bool) {
+	v := m.software_update_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoftwareUpdateStatus returns the old "software_update_status" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldSoftwareUpdateStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoftwareUpdateStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoftwareUpdateStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoftwareUpdateStatus: %w", err)
+	}
+	return oldValue.SoftwareUpdateStatus, nil
+}
+
+// ClearSoftwareUpdateStatus clears the value of the "software_update_status" field.
+func (m *VehicleSnapshotMutation) ClearSoftwareUpdateStatus() {
+	m.software_update_status = nil
+	m.clearedFields[vehiclesnapshot.FieldSoftwareUpdateStatus] = struct{}{}
+}
+
+// SoftwareUpdateStatusCleared returns if the "software_update_status" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) SoftwareUpdateStatusCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldSoftwareUpdateStatus]
+	return ok
+}
+
+// ResetSoftwareUpdateStatus resets all changes to the "software_update_status" field.
+func (m *VehicleSnapshotMutation) ResetSoftwareUpdateStatus() {
+	m.software_update_status = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldSoftwareUpdateStatus)
+}
+
+// SetSoftwareUpdateVersion sets the "software_update_version" field.
+func (m *VehicleSnapshotMutation) SetSoftwareUpdateVersion(s string) {
+	m.software_update_version = &s
+}
+
+// SoftwareUpdateVersion returns the value of the "software_update_version" field in the mutation.
+func (m *VehicleSnapshotMutation) SoftwareUpdateVersion() (r string, exists bool) {
+	v := m.software_update_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoftwareUpdateVersion returns the old "software_update_version" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldSoftwareUpdateVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoftwareUpdateVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoftwareUpdateVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoftwareUpdateVersion: %w", err)
+	}
+	return oldValue.SoftwareUpdateVersion, nil
+}
+
+// ClearSoftwareUpdateVersion clears the value of the "software_update_version" field.
+func (m *VehicleSnapshotMutation) ClearSoftwareUpdateVersion() {
+	m.software_update_version = nil
+	m.clearedFields[vehiclesnapshot.FieldSoftwareUpdateVersion] = struct{}{}
+}
+
+// SoftwareUpdateVersionCleared returns if the "software_update_version" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) SoftwareUpdateVersionCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldSoftwareUpdateVersion]
+	return ok
+}
+
+// ResetSoftwareUpdateVersion resets all changes to the "software_update_version" field.
+func (m *VehicleSnapshotMutation) ResetSoftwareUpdateVersion() {
+	m.software_update_version = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldSoftwareUpdateVersion)
+}
+
+// SetSoftwareUpdateDownloadPerc sets the "software_update_download_perc" field.
+func (m *VehicleSnapshotMutation) SetSoftwareUpdateDownloadPerc(i int) {
+	m.software_update_download_perc = &i
+	m.addsoftware_update_download_perc = nil
+}
+
+// SoftwareUpdateDownloadPerc returns the value of the "software_update_download_perc" field in the mutation.
+func (m *VehicleSnapshotMutation) SoftwareUpdateDownloadPerc() (r int, exists bool) {
+	v := m.software_update_download_perc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoftwareUpdateDownloadPerc returns the old "software_update_download_perc" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldSoftwareUpdateDownloadPerc(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoftwareUpdateDownloadPerc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoftwareUpdateDownloadPerc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoftwareUpdateDownloadPerc: %w", err)
+	}
+	return oldValue.SoftwareUpdateDownloadPerc, nil
+}
+
+// AddSoftwareUpdateDownloadPerc adds i to the "software_update_download_perc" field.
+func (m *VehicleSnapshotMutation) AddSoftwareUpdateDownloadPerc(i int) {
+	if m.addsoftware_update_download_perc != nil {
+		*m.addsoftware_update_download_perc += i
+	} else {
+		m.addsoftware_update_download_perc = &i
+	}
+}
+
+// AddedSoftwareUpdateDownloadPerc returns the value that was added to the "software_update_download_perc" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedSoftwareUpdateDownloadPerc() (r int, exists bool) {
+	v := m.addsoftware_update_download_perc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSoftwareUpdateDownloadPerc clears the value of the "software_update_download_perc" field.
+func (m *VehicleSnapshotMutation) ClearSoftwareUpdateDownloadPerc() {
+	m.software_update_download_perc = nil
+	m.addsoftware_update_download_perc = nil
+	m.clearedFields[vehiclesnapshot.FieldSoftwareUpdateDownloadPerc] = struct{}{}
+}
+
+// SoftwareUpdateDownloadPercCleared returns if the "software_update_download_perc" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) SoftwareUpdateDownloadPercCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldSoftwareUpdateDownloadPerc]
+	return ok
+}
+
+// ResetSoftwareUpdateDownloadPerc resets all changes to the "software_update_download_perc" field.
+func (m *VehicleSnapshotMutation) ResetSoftwareUpdateDownloadPerc() {
+	m.software_update_download_perc = nil
+	m.addsoftware_update_download_perc = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldSoftwareUpdateDownloadPerc)
+}
+
+// SetSoftwareUpdateInstallPerc sets the "software_update_install_perc" field.
+func (m *VehicleSnapshotMutation) SetSoftwareUpdateInstallPerc(i int) {
+	m.software_update_install_perc = &i
+	m.addsoftware_update_install_perc = nil
+}
+
+// SoftwareUpdateInstallPerc returns the value of the "software_update_install_perc" field in the mutation.
+func (m *VehicleSnapshotMutation) SoftwareUpdateInstallPerc() (r int, exists bool) {
+	v := m.software_update_install_perc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoftwareUpdateInstallPerc returns the old "software_update_install_perc" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldSoftwareUpdateInstallPerc(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoftwareUpdateInstallPerc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoftwareUpdateInstallPerc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoftwareUpdateInstallPerc: %w", err)
+	}
+	return oldValue.SoftwareUpdateInstallPerc, nil
+}
+
+// AddSoftwareUpdateInstallPerc adds i to the "software_update_install_perc" field.
+func (m *VehicleSnapshotMutation) AddSoftwareUpdateInstallPerc(i int) {
+	if m.addsoftware_update_install_perc != nil {
+		*m.addsoftware_update_install_perc += i
+	} else {
+		m.addsoftware_update_install_perc = &i
+	}
+}
+
+// AddedSoftwareUpdateInstallPerc returns the value that was added to the "software_update_install_perc" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedSoftwareUpdateInstallPerc() (r int, exists bool) {
+	v := m.addsoftware_update_install_perc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSoftwareUpdateInstallPerc clears the value of the "software_update_install_perc" field.
+func (m *VehicleSnapshotMutation) ClearSoftwareUpdateInstallPerc() {
+	m.software_update_install_perc = nil
+	m.addsoftware_update_install_perc = nil
+	m.clearedFields[vehiclesnapshot.FieldSoftwareUpdateInstallPerc] = struct{}{}
+}
+
+// SoftwareUpdateInstallPercCleared returns if the "software_update_install_perc" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) SoftwareUpdateInstallPercCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldSoftwareUpdateInstallPerc]
+	return ok
+}
+
+// ResetSoftwareUpdateInstallPerc resets all changes to the "software_update_install_perc" field.
+func (m *VehicleSnapshotMutation) ResetSoftwareUpdateInstallPerc() {
+	m.software_update_install_perc = nil
+	m.addsoftware_update_install_perc = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldSoftwareUpdateInstallPerc)
+}
+
+// SetSoftwareUpdateExpectedDuration sets the "software_update_expected_duration" field.
+func (m *VehicleSnapshotMutation) SetSoftwareUpdateExpectedDuration(i int) {
+	m.software_update_expected_duration = &i
+	m.addsoftware_update_expected_duration = nil
+}
+
+// SoftwareUpdateExpectedDuration returns the value of the "software_update_expected_duration" field in the mutation.
+func (m *VehicleSnapshotMutation) SoftwareUpdateExpectedDuration() (r int, exists bool) {
+	v := m.software_update_expected_duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSoftwareUpdateExpectedDuration returns the old "software_update_expected_duration" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldSoftwareUpdateExpectedDuration(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSoftwareUpdateExpectedDuration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSoftwareUpdateExpectedDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSoftwareUpdateExpectedDuration: %w", err)
+	}
+	return oldValue.SoftwareUpdateExpectedDuration, nil
+}
+
+// AddSoftwareUpdateExpectedDuration adds i to the "software_update_expected_duration" field.
+func (m *VehicleSnapshotMutation) AddSoftwareUpdateExpectedDuration(i int) {
+	if m.addsoftware_update_expected_duration != nil {
+		*m.addsoftware_update_expected_duration += i
+	} else {
+		m.addsoftware_update_expected_duration = &i
+	}
+}
+
+// AddedSoftwareUpdateExpectedDuration returns the value that was added to the "software_update_expected_duration" field in this mutation.
+func (m *VehicleSnapshotMutation) AddedSoftwareUpdateExpectedDuration() (r int, exists bool) {
+	v := m.addsoftware_update_expected_duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSoftwareUpdateExpectedDuration clears the value of the "software_update_expected_duration" field.
+func (m *VehicleSnapshotMutation) ClearSoftwareUpdateExpectedDuration() {
+	m.software_update_expected_duration = nil
+	m.addsoftware_update_expected_duration = nil
+	m.clearedFields[vehiclesnapshot.FieldSoftwareUpdateExpectedDuration] = struct{}{}
+}
+
+// SoftwareUpdateExpectedDurationCleared returns if the "software_update_expected_duration" field was cleared in this mutation.
+func (m *VehicleSnapshotMutation) SoftwareUpdateExpectedDurationCleared() bool {
+	_, ok := m.clearedFields[vehiclesnapshot.FieldSoftwareUpdateExpectedDuration]
+	return ok
+}
+
+// ResetSoftwareUpdateExpectedDuration resets all changes to the "software_update_expected_duration" field.
+func (m *VehicleSnapshotMutation) ResetSoftwareUpdateExpectedDuration() {
+	m.software_update_expected_duration = nil
+	m.addsoftware_update_expected_duration = nil
+	delete(m.clearedFields, vehiclesnapshot.FieldSoftwareUpdateExpectedDuration)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *VehicleSnapshotMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *VehicleSnapshotMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the VehicleSnapshot entity.
+// If the VehicleSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleSnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *VehicleSnapshotMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the VehicleSnapshotMutation builder.
+func (m *VehicleSnapshotMutation) Where(ps ...predicate.VehicleSnapshot) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the VehicleSnapshotMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *VehicleSnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VehicleSnapshot, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *VehicleSnapshotMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *VehicleSnapshotMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (VehicleSnapshot).
+func (m *VehicleSnapshotMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *VehicleSnapshotMutation) Fields() []string {
+	fields := make([]string, 0, 37)
+	if m.vehicle_id != nil {
+		fields = append(fields, vehiclesnapshot.FieldVehicleID)
+	}
+	if m.state != nil {
+		fields = append(fields, vehiclesnapshot.FieldState)
+	}
+	if m.shift_state != nil {
+		fields = append(fields, vehiclesnapshot.FieldShiftState)
+	}
+	if m.speed != nil {
+		fields = append(fields, vehiclesnapshot.FieldSpeed)
+	}
+	if m.power != nil {
+		fields = append(fields, vehiclesnapshot.FieldPower)
+	}
+	if m.latitude != nil {
+		fields = append(fields, vehiclesnapshot.FieldLatitude)
+	}
+	if m.longitude != nil {
+		fields = append(fields, vehiclesnapshot.FieldLongitude)
+	}
+	if m.coord_type != nil {
+		fields = append(fields, vehiclesnapshot.FieldCoordType)
+	}
+	if m.heading != nil {
+		fields = append(fields, vehiclesnapshot.FieldHeading)
+	}
+	if m.battery_level != nil {
+		fields = append(fields, vehiclesnapshot.FieldBatteryLevel)
+	}
+	if m.usable_battery_level != nil {
+		fields = append(fields, vehiclesnapshot.FieldUsableBatteryLevel)
+	}
+	if m.battery_range != nil {
+		fields = append(fields, vehiclesnapshot.FieldBatteryRange)
+	}
+	if m.ideal_battery_range != nil {
+		fields = append(fields, vehiclesnapshot.FieldIdealBatteryRange)
+	}
+	if m.est_battery_range != nil {
+		fields = append(fields, vehiclesnapshot.FieldEstBatteryRange)
+	}
+	if m.charging_state != nil {
+		fields = append(fields, vehiclesnapshot.FieldChargingState)
+	}
+	if m.charger_power != nil {
+		fields = append(fields, vehiclesnapshot.FieldChargerPower)
+	}
+	if m.charge_energy_added != nil {
+		fields = append(fields, vehiclesnapshot.FieldChargeEnergyAdded)
+	}
+	if m.charger_voltage != nil {
+		fields = append(fields, vehiclesnapshot.FieldChargerVoltage)
+	}
+	if m.charger_actual_current != nil {
+		fields = append(fields, vehiclesnapshot.FieldChargerActualCurrent)
+	}
+	if m.fast_charger_present != nil {
+		fields = append(fields, vehiclesnapshot.FieldFastChargerPresent)
+	}
+	if m.fast_charger_type != nil {
+		fields = append(fields, vehiclesnapshot.FieldFastChargerType)
+	}
+	if m.battery_heater_on != nil {
+		fields = append(fields, vehiclesnapshot.FieldBatteryHeaterOn)
+	}
+	if m.outside_temp != nil {
+		fields = append(fields, vehiclesnapshot.FieldOutsideTemp)
+	}
+	if m.inside_temp != nil {
+		fields = append(fields, vehiclesnapshot.FieldInsideTemp)
+	}
+	if m.climate_on != nil {
+		fields = append(fields, vehiclesnapshot.FieldClimateOn)
+	}
+	if m.climate_keeper_mode != nil {
+		fields = append(fields, vehiclesnapshot.FieldClimateKeeperMode)
+	}
+	if m.cabin_overheat_cooling != nil {
+		fields = append(fields, vehiclesnapshot.FieldCabinOverheatCooling)
+	}
+	if m.sentry_mode != nil {
+		fields = append(fields, vehiclesnapshot.FieldSentryMode)
+	}
+	if m.locked != nil {
+		fields = append(fields, vehiclesnapshot.FieldLocked)
+	}
+	if m.odometer != nil {
+		fields = append(fields, vehiclesnapshot.FieldOdometer)
+	}
+	if m.car_version != nil {
+		fields = append(fields, vehiclesnapshot.FieldCarVersion)
+	}
+	if m.software_update_status != nil {
+		fields = append(fields, vehiclesnapshot.FieldSoftwareUpdateStatus)
+	}
+	if m.software_update_version != nil {
+		fields = append(fields, vehiclesnapshot.FieldSoftwareUpdateVersion)
+	}
+	if m.software_update_download_perc != nil {
+		fields = append(fields, vehiclesnapshot.FieldSoftwareUpdateDownloadPerc)
+	}
+	if m.software_update_install_perc != nil {
+		fields = append(fields, vehiclesnapshot.FieldSoftwareUpdateInstallPerc)
+	}
+	if m.software_update_expected_duration != nil {
+		fields = append(fields, vehiclesnapshot.FieldSoftwareUpdateExpectedDuration)
+	}
+	if m.created_at != nil {
+		fields = append(fields, vehiclesnapshot.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *VehicleSnapshotMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case vehiclesnapshot.FieldVehicleID:
+		return m.VehicleID()
+	case vehiclesnapshot.FieldState:
+		return m.State()
+	case vehiclesnapshot.FieldShiftState:
+		return m.ShiftState()
+	case vehiclesnapshot.FieldSpeed:
+		return m.Speed()
+	case vehiclesnapshot.FieldPower:
+		return m.Power()
+	case vehiclesnapshot.FieldLatitude:
+		return m.Latitude()
+	case vehiclesnapshot.FieldLongitude:
+		return m.Longitude()
+	case vehiclesnapshot.FieldCoordType:
+		return m.CoordType()
+	case vehiclesnapshot.FieldHeading:
+		return m.Heading()
+	case vehiclesnapshot.FieldBatteryLevel:
+		return m.BatteryLevel()
+	case vehiclesnapshot.FieldUsableBatteryLevel:
+		return m.UsableBatteryLevel()
+	case vehiclesnapshot.FieldBatteryRange:
+		return m.BatteryRange()
+	case vehiclesnapshot.FieldIdealBatteryRange:
+		return m.IdealBatteryRange()
+	case vehiclesnapshot.FieldEstBatteryRange:
+		return m.EstBatteryRange()
+	case vehiclesnapshot.FieldChargingState:
+		return m.ChargingState()
+	case vehiclesnapshot.FieldChargerPower:
+		return m.ChargerPower()
+	case vehiclesnapshot.FieldChargeEnergyAdded:
+		return m.ChargeEnergyAdded()
+	case vehiclesnapshot.FieldChargerVoltage:
+		return m.ChargerVoltage()
+	case vehiclesnapshot.FieldChargerActualCurrent:
+		return m.ChargerActualCurrent()
+	case vehiclesnapshot.FieldFastChargerPresent:
+		return m.FastChargerPresent()
+	case vehiclesnapshot.FieldFastChargerType:
+		return m.FastChargerType()
+	case vehiclesnapshot.FieldBatteryHeaterOn:
+		return m.BatteryHeaterOn()
+	case vehiclesnapshot.FieldOutsideTemp:
+		return m.OutsideTemp()
+	case vehiclesnapshot.FieldInsideTemp:
+		return m.InsideTemp()
+	case vehiclesnapshot.FieldClimateOn:
+		return m.ClimateOn()
+	case vehiclesnapshot.FieldClimateKeeperMode:
+		return m.ClimateKeeperMode()
+	case vehiclesnapshot.FieldCabinOverheatCooling:
+		return m.CabinOverheatCooling()
+	case vehiclesnapshot.FieldSentryMode:
+		return m.SentryMode()
+	case vehiclesnapshot.FieldLocked:
+		return m.Locked()
+	case vehiclesnapshot.FieldOdometer:
+		return m.Odometer()
+	case vehiclesnapshot.FieldCarVersion:
+		return m.CarVersion()
+	case vehiclesnapshot.FieldSoftwareUpdateStatus:
+		return m.SoftwareUpdateStatus()
+	case vehiclesnapshot.FieldSoftwareUpdateVersion:
+		return m.SoftwareUpdateVersion()
+	case vehiclesnapshot.FieldSoftwareUpdateDownloadPerc:
+		return m.SoftwareUpdateDownloadPerc()
+	case vehiclesnapshot.FieldSoftwareUpdateInstallPerc:
+		return m.SoftwareUpdateInstallPerc()
+	case vehiclesnapshot.FieldSoftwareUpdateExpectedDuration:
+		return m.SoftwareUpdateExpectedDuration()
+	case vehiclesnapshot.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *VehicleSnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case vehiclesnapshot.FieldVehicleID:
+		return m.OldVehicleID(ctx)
+	case vehiclesnapshot.FieldState:
+		return m.OldState(ctx)
+	case vehiclesnapshot.FieldShiftState:
+		return m.OldShiftState(ctx)
+	case vehiclesnapshot.FieldSpeed:
+		return m.OldSpeed(ctx)
+	case vehiclesnapshot.FieldPower:
+		return m.OldPower(ctx)
+	case vehiclesnapshot.FieldLatitude:
+		return m.OldLatitude(ctx)
+	case vehiclesnapshot.FieldLongitude:
+		return m.OldLongitude(ctx)
+	case vehiclesnapshot.FieldCoordType:
+		return m.OldCoordType(ctx)
+	case vehiclesnapshot.FieldHeading:
+		return m.OldHeading(ctx)
+	case vehiclesnapshot.FieldBatteryLevel:
+		return m.OldBatteryLevel(ctx)
+	case vehiclesnapshot.FieldUsableBatteryLevel:
+		return m.OldUsableBatteryLevel(ctx)
+	case vehiclesnapshot.FieldBatteryRange:
+		return m.OldBatteryRange(ctx)
+	case vehiclesnapshot.FieldIdealBatteryRange:
+		return m.OldIdealBatteryRange(ctx)
+	case vehiclesnapshot.FieldEstBatteryRange:
+		return m.OldEstBatteryRange(ctx)
+	case vehiclesnapshot.FieldChargingState:
+		return m.OldChargingState(ctx)
+	case vehiclesnapshot.FieldChargerPower:
+		return m.OldChargerPower(ctx)
+	case vehiclesnapshot.FieldChargeEnergyAdded:
+		return m.OldChargeEnergyAdded(ctx)
+	case vehiclesnapshot.FieldChargerVoltage:
+		return m.OldChargerVoltage(ctx)
+	case vehiclesnapshot.FieldChargerActualCurrent:
+		return m.OldChargerActualCurrent(ctx)
+	case vehiclesnapshot.FieldFastChargerPresent:
+		return m.OldFastChargerPresent(ctx)
+	case vehiclesnapshot.FieldFastChargerType:
+		return m.OldFastChargerType(ctx)
+	case vehiclesnapshot.FieldBatteryHeaterOn:
+		return m.OldBatteryHeaterOn(ctx)
+	case vehiclesnapshot.FieldOutsideTemp:
+		return m.OldOutsideTemp(ctx)
+	case vehiclesnapshot.FieldInsideTemp:
+		return m.OldInsideTemp(ctx)
+	case vehiclesnapshot.FieldClimateOn:
+		return m.OldClimateOn(ctx)
+	case vehiclesnapshot.FieldClimateKeeperMode:
+		return m.OldClimateKeeperMode(ctx)
+	case vehiclesnapshot.FieldCabinOverheatCooling:
+		return m.OldCabinOverheatCooling(ctx)
+	case vehiclesnapshot.FieldSentryMode:
+		return m.OldSentryMode(ctx)
+	case vehiclesnapshot.FieldLocked:
+		return m.OldLocked(ctx)
+	case vehiclesnapshot.FieldOdometer:
+		return m.OldOdometer(ctx)
+	case vehiclesnapshot.FieldCarVersion:
+		return m.OldCarVersion(ctx)
+	case vehiclesnapshot.FieldSoftwareUpdateStatus:
+		return m.OldSoftwareUpdateStatus(ctx)
+	case vehiclesnapshot.FieldSoftwareUpdateVersion:
+		return m.OldSoftwareUpdateVersion(ctx)
+	case vehiclesnapshot.FieldSoftwareUpdateDownloadPerc:
+		return m.OldSoftwareUpdateDownloadPerc(ctx)
+	case vehiclesnapshot.FieldSoftwareUpdateInstallPerc:
+		return m.OldSoftwareUpdateInstallPerc(ctx)
+	case vehiclesnapshot.FieldSoftwareUpdateExpectedDuration:
+		return m.OldSoftwareUpdateExpectedDuration(ctx)
+	case vehiclesnapshot.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown VehicleSnapshot field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VehicleSnapshotMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case vehiclesnapshot.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVehicleID(v)
+		return nil
+	case vehiclesnapshot.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case vehiclesnapshot.FieldShiftState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShiftState(v)
+		return nil
+	case vehiclesnapshot.FieldSpeed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpeed(v)
+		return nil
+	case vehiclesnapshot.FieldPower:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPower(v)
+		return nil
+	case vehiclesnapshot.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatitude(v)
+		return nil
+	case vehiclesnapshot.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLongitude(v)
+		return nil
+	case vehiclesnapshot.FieldCoordType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCoordType(v)
+		return nil
+	case vehiclesnapshot.FieldHeading:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeading(v)
+		return nil
+	case vehiclesnapshot.FieldBatteryLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBatteryLevel(v)
+		return nil
+	case vehiclesnapshot.FieldUsableBatteryLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsableBatteryLevel(v)
+		return nil
+	case vehiclesnapshot.FieldBatteryRange:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBatteryRange(v)
+		return nil
+	case vehiclesnapshot.FieldIdealBatteryRange:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdealBatteryRange(v)
+		return nil
+	case vehiclesnapshot.FieldEstBatteryRange:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEstBatteryRange(v)
+		return nil
+	case vehiclesnapshot.FieldChargingState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChargingState(v)
+		return nil
+	case vehiclesnapshot.FieldChargerPower:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChargerPower(v)
+		return nil
+	case vehiclesnapshot.FieldChargeEnergyAdded:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChargeEnergyAdded(v)
+		return nil
+	case vehiclesnapshot.FieldChargerVoltage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChargerVoltage(v)
+		return nil
+	case vehiclesnapshot.FieldChargerActualCurrent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChargerActualCurrent(v)
+		return nil
+	case vehiclesnapshot.FieldFastChargerPresent:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFastChargerPresent(v)
+		return nil
+	case vehiclesnapshot.FieldFastChargerType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFastChargerType(v)
+		return nil
+	case vehiclesnapshot.FieldBatteryHeaterOn:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBatteryHeaterOn(v)
+		return nil
+	case vehiclesnapshot.FieldOutsideTemp:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutsideTemp(v)
+		return nil
+	case vehiclesnapshot.FieldInsideTemp:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInsideTemp(v)
+		return nil
+	case vehiclesnapshot.FieldClimateOn:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClimateOn(v)
+		return nil
+	case vehiclesnapshot.FieldClimateKeeperMode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClimateKeeperMode(v)
+		return nil
+	case vehiclesnapshot.FieldCabinOverheatCooling:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCabinOverheatCooling(v)
+		return nil
+	case vehiclesnapshot.FieldSentryMode:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentryMode(v)
+		return nil
+	case vehiclesnapshot.FieldLocked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocked(v)
+		return nil
+	case vehiclesnapshot.FieldOdometer:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOdometer(v)
+		return nil
+	case vehiclesnapshot.FieldCarVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarVersion(v)
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoftwareUpdateStatus(v)
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoftwareUpdateVersion(v)
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateDownloadPerc:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoftwareUpdateDownloadPerc(v)
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateInstallPerc:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoftwareUpdateInstallPerc(v)
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateExpectedDuration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSoftwareUpdateExpectedDuration(v)
+		return nil
+	case vehiclesnapshot.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleSnapshot field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *VehicleSnapshotMutation) AddedFields() []string {
+	var fields []string
+	if m.addvehicle_id != nil {
+		fields = append(fields, vehiclesnapshot.FieldVehicleID)
+	}
+	if m.addspeed != nil {
+		fields = append(fields, vehiclesnapshot.FieldSpeed)
+	}
+	if m.addpower != nil {
+		fields = append(fields, vehiclesnapshot.FieldPower)
+	}
+	if m.addlatitude != nil {
+		fields = append(fields, vehiclesnapshot.FieldLatitude)
+	}
+	if m.addlongitude != nil {
+		fields = append(fields, vehiclesnapshot.FieldLongitude)
+	}
+	if m.addheading != nil {
+		fields = append(fields, vehiclesnapshot.FieldHeading)
+	}
+	if m.addbattery_level != nil {
+		fields = append(fields, vehiclesnapshot.FieldBatteryLevel)
+	}
+	if m.addusable_battery_level != nil {
+		fields = append(fields, vehiclesnapshot.FieldUsableBatteryLevel)
+	}
+	if m.addbattery_range != nil {
+		fields = append(fields, vehiclesnapshot.FieldBatteryRange)
+	}
+	if m.addideal_battery_range != nil {
+		fields = append(fields, vehiclesnapshot.FieldIdealBatteryRange)
+	}
+	if m.addest_battery_range != nil {
+		fields = append(fields, vehiclesnapshot.FieldEstBatteryRange)
+	}
+	if m.addcharger_power != nil {
+		fields = append(fields, vehiclesnapshot.FieldChargerPower)
+	}
+	if m.addcharge_energy_added != nil {
+		fields = append(fields, vehiclesnapshot.FieldChargeEnergyAdded)
+	}
+	if m.addcharger_voltage != nil {
+		fields = append(fields, vehiclesnapshot.FieldChargerVoltage)
+	}
+	if m.addcharger_actual_current != nil {
+		fields = append(fields, vehiclesnapshot.FieldChargerActualCurrent)
+	}
+	if m.addoutside_temp != nil {
+		fields = append(fields, vehiclesnapshot.FieldOutsideTemp)
+	}
+	if m.addinside_temp != nil {
+		fields = append(fields, vehiclesnapshot.FieldInsideTemp)
+	}
+	if m.addodometer != nil {
+		fields = append(fields, vehiclesnapshot.FieldOdometer)
+	}
+	if m.addsoftware_update_download_perc != nil {
+		fields = append(fields, vehiclesnapshot.FieldSoftwareUpdateDownloadPerc)
+	}
+	if m.addsoftware_update_install_perc != nil {
+		fields = append(fields, vehiclesnapshot.FieldSoftwareUpdateInstallPerc)
+	}
+	if m.addsoftware_update_expected_duration != nil {
+		fields = append(fields, vehiclesnapshot.FieldSoftwareUpdateExpectedDuration)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *VehicleSnapshotMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case vehiclesnapshot.FieldVehicleID:
+		return m.AddedVehicleID()
+	case vehiclesnapshot.FieldSpeed:
+		return m.AddedSpeed()
+	case vehiclesnapshot.FieldPower:
+		return m.AddedPower()
+	case vehiclesnapshot.FieldLatitude:
+		return m.AddedLatitude()
+	case vehiclesnapshot.FieldLongitude:
+		return m.AddedLongitude()
+	case vehiclesnapshot.FieldHeading:
+		return m.AddedHeading()
+	case vehiclesnapshot.FieldBatteryLevel:
+		return m.AddedBatteryLevel()
+	case vehiclesnapshot.FieldUsableBatteryLevel:
+		return m.AddedUsableBatteryLevel()
+	case vehiclesnapshot.FieldBatteryRange:
+		return m.AddedBatteryRange()
+	case vehiclesnapshot.FieldIdealBatteryRange:
+		return m.AddedIdealBatteryRange()
+	case vehiclesnapshot.FieldEstBatteryRange:
+		return m.AddedEstBatteryRange()
+	case vehiclesnapshot.FieldChargerPower:
+		return m.AddedChargerPower()
+	case vehiclesnapshot.FieldChargeEnergyAdded:
+		return m.AddedChargeEnergyAdded()
+	case vehiclesnapshot.FieldChargerVoltage:
+		return m.AddedChargerVoltage()
+	case vehiclesnapshot.FieldChargerActualCurrent:
+		return m.AddedChargerActualCurrent()
+	case vehiclesnapshot.FieldOutsideTemp:
+		return m.AddedOutsideTemp()
+	case vehiclesnapshot.FieldInsideTemp:
+		return m.AddedInsideTemp()
+	case vehiclesnapshot.FieldOdometer:
+		return m.AddedOdometer()
+	case vehiclesnapshot.FieldSoftwareUpdateDownloadPerc:
+		return m.AddedSoftwareUpdateDownloadPerc()
+	case vehiclesnapshot.FieldSoftwareUpdateInstallPerc:
+		return m.AddedSoftwareUpdateInstallPerc()
+	case vehiclesnapshot.FieldSoftwareUpdateExpectedDuration:
+		return m.AddedSoftwareUpdateExpectedDuration()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VehicleSnapshotMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case vehiclesnapshot.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVehicleID(v)
+		return nil
+	case vehiclesnapshot.FieldSpeed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpeed(v)
+		return nil
+	case vehiclesnapshot.FieldPower:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPower(v)
+		return nil
+	case vehiclesnapshot.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatitude(v)
+		return nil
+	case vehiclesnapshot.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLongitude(v)
+		return nil
+	case vehiclesnapshot.FieldHeading:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeading(v)
+		return nil
+	case vehiclesnapshot.FieldBatteryLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBatteryLevel(v)
+		return nil
+	case vehiclesnapshot.FieldUsableBatteryLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUsableBatteryLevel(v)
+		return nil
+	case vehiclesnapshot.FieldBatteryRange:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBatteryRange(v)
+		return nil
+	case vehiclesnapshot.FieldIdealBatteryRange:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIdealBatteryRange(v)
+		return nil
+	case vehiclesnapshot.FieldEstBatteryRange:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEstBatteryRange(v)
+		return nil
+	case vehiclesnapshot.FieldChargerPower:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChargerPower(v)
+		return nil
+	case vehiclesnapshot.FieldChargeEnergyAdded:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChargeEnergyAdded(v)
+		return nil
+	case vehiclesnapshot.FieldChargerVoltage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChargerVoltage(v)
+		return nil
+	case vehiclesnapshot.FieldChargerActualCurrent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChargerActualCurrent(v)
+		return nil
+	case vehiclesnapshot.FieldOutsideTemp:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOutsideTemp(v)
+		return nil
+	case vehiclesnapshot.FieldInsideTemp:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInsideTemp(v)
+		return nil
+	case vehiclesnapshot.FieldOdometer:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOdometer(v)
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateDownloadPerc:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSoftwareUpdateDownloadPerc(v)
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateInstallPerc:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSoftwareUpdateInstallPerc(v)
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateExpectedDuration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSoftwareUpdateExpectedDuration(v)
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleSnapshot numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *VehicleSnapshotMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(vehiclesnapshot.FieldShiftState) {
+		fields = append(fields, vehiclesnapshot.FieldShiftState)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldSpeed) {
+		fields = append(fields, vehiclesnapshot.FieldSpeed)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldPower) {
+		fields = append(fields, vehiclesnapshot.FieldPower)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldLatitude) {
+		fields = append(fields, vehiclesnapshot.FieldLatitude)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldLongitude) {
+		fields = append(fields, vehiclesnapshot.FieldLongitude)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldCoordType) {
+		fields = append(fields, vehiclesnapshot.FieldCoordType)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldHeading) {
+		fields = append(fields, vehiclesnapshot.FieldHeading)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldBatteryLevel) {
+		fields = append(fields, vehiclesnapshot.FieldBatteryLevel)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldUsableBatteryLevel) {
+		fields = append(fields, vehiclesnapshot.FieldUsableBatteryLevel)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldBatteryRange) {
+		fields = append(fields, vehiclesnapshot.FieldBatteryRange)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldIdealBatteryRange) {
+		fields = append(fields, vehiclesnapshot.FieldIdealBatteryRange)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldEstBatteryRange) {
+		fields = append(fields, vehiclesnapshot.FieldEstBatteryRange)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldChargingState) {
+		fields = append(fields, vehiclesnapshot.FieldChargingState)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldChargerPower) {
+		fields = append(fields, vehiclesnapshot.FieldChargerPower)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldChargeEnergyAdded) {
+		fields = append(fields, vehiclesnapshot.FieldChargeEnergyAdded)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldChargerVoltage) {
+		fields = append(fields, vehiclesnapshot.FieldChargerVoltage)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldChargerActualCurrent) {
+		fields = append(fields, vehiclesnapshot.FieldChargerActualCurrent)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldFastChargerType) {
+		fields = append(fields, vehiclesnapshot.FieldFastChargerType)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldOutsideTemp) {
+		fields = append(fields, vehiclesnapshot.FieldOutsideTemp)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldInsideTemp) {
+		fields = append(fields, vehiclesnapshot.FieldInsideTemp)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldClimateKeeperMode) {
+		fields = append(fields, vehiclesnapshot.FieldClimateKeeperMode)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldOdometer) {
+		fields = append(fields, vehiclesnapshot.FieldOdometer)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldCarVersion) {
+		fields = append(fields, vehiclesnapshot.FieldCarVersion)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldSoftwareUpdateStatus) {
+		fields = append(fields, vehiclesnapshot.FieldSoftwareUpdateStatus)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldSoftwareUpdateVersion) {
+		fields = append(fields, vehiclesnapshot.FieldSoftwareUpdateVersion)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldSoftwareUpdateDownloadPerc) {
+		fields = append(fields, vehiclesnapshot.FieldSoftwareUpdateDownloadPerc)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldSoftwareUpdateInstallPerc) {
+		fields = append(fields, vehiclesnapshot.FieldSoftwareUpdateInstallPerc)
+	}
+	if m.FieldCleared(vehiclesnapshot.FieldSoftwareUpdateExpectedDuration) {
+		fields = append(fields, vehiclesnapshot.FieldSoftwareUpdateExpectedDuration)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *VehicleSnapshotMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *VehicleSnapshotMutation) ClearField(name string) error {
+	switch name {
+	case vehiclesnapshot.FieldShiftState:
+		m.ClearShiftState()
+		return nil
+	case vehiclesnapshot.FieldSpeed:
+		m.ClearSpeed()
+		return nil
+	case vehiclesnapshot.FieldPower:
+		m.ClearPower()
+		return nil
+	case vehiclesnapshot.FieldLatitude:
+		m.ClearLatitude()
+		return nil
+	case vehiclesnapshot.FieldLongitude:
+		m.ClearLongitude()
+		return nil
+	case vehiclesnapshot.FieldCoordType:
+		m.ClearCoordType()
+		return nil
+	case vehiclesnapshot.FieldHeading:
+		m.ClearHeading()
+		return nil
+	case vehiclesnapshot.FieldBatteryLevel:
+		m.ClearBatteryLevel()
+		return nil
+	case vehiclesnapshot.FieldUsableBatteryLevel:
+		m.ClearUsableBatteryLevel()
+		return nil
+	case vehiclesnapshot.FieldBatteryRange:
+		m.ClearBatteryRange()
+		return nil
+	case vehiclesnapshot.FieldIdealBatteryRange:
+		m.ClearIdealBatteryRange()
+		return nil
+	case vehiclesnapshot.FieldEstBatteryRange:
+		m.ClearEstBatteryRange()
+		return nil
+	case vehiclesnapshot.FieldChargingState:
+		m.ClearChargingState()
+		return nil
+	case vehiclesnapshot.FieldChargerPower:
+		m.ClearChargerPower()
+		return nil
+	case vehiclesnapshot.FieldChargeEnergyAdded:
+		m.ClearChargeEnergyAdded()
+		return nil
+	case vehiclesnapshot.FieldChargerVoltage:
+		m.ClearChargerVoltage()
+		return nil
+	case vehiclesnapshot.FieldChargerActualCurrent:
+		m.ClearChargerActualCurrent()
+		return nil
+	case vehiclesnapshot.FieldFastChargerType:
+		m.ClearFastChargerType()
+		return nil
+	case vehiclesnapshot.FieldOutsideTemp:
+		m.ClearOutsideTemp()
+		return nil
+	case vehiclesnapshot.FieldInsideTemp:
+		m.ClearInsideTemp()
+		return nil
+	case vehiclesnapshot.FieldClimateKeeperMode:
+		m.ClearClimateKeeperMode()
+		return nil
+	case vehiclesnapshot.FieldOdometer:
+		m.ClearOdometer()
+		return nil
+	case vehiclesnapshot.FieldCarVersion:
+		m.ClearCarVersion()
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateStatus:
+		m.ClearSoftwareUpdateStatus()
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateVersion:
+		m.ClearSoftwareUpdateVersion()
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateDownloadPerc:
+		m.ClearSoftwareUpdateDownloadPerc()
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateInstallPerc:
+		m.ClearSoftwareUpdateInstallPerc()
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateExpectedDuration:
+		m.ClearSoftwareUpdateExpectedDuration()
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleSnapshot nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *VehicleSnapshotMutation) ResetField(name string) error {
+	switch name {
+	case vehiclesnapshot.FieldVehicleID:
+		m.ResetVehicleID()
+		return nil
+	case vehiclesnapshot.FieldState:
+		m.ResetState()
+		return nil
+	case vehiclesnapshot.FieldShiftState:
+		m.ResetShiftState()
+		return nil
+	case vehiclesnapshot.FieldSpeed:
+		m.ResetSpeed()
+		return nil
+	case vehiclesnapshot.FieldPower:
+		m.ResetPower()
+		return nil
+	case vehiclesnapshot.FieldLatitude:
+		m.ResetLatitude()
+		return nil
+	case vehiclesnapshot.FieldLongitude:
+		m.ResetLongitude()
+		return nil
+	case vehiclesnapshot.FieldCoordType:
+		m.ResetCoordType()
+		return nil
+	case vehiclesnapshot.FieldHeading:
+		m.ResetHeading()
+		return nil
+	case vehiclesnapshot.FieldBatteryLevel:
+		m.ResetBatteryLevel()
+		return nil
+	case vehiclesnapshot.FieldUsableBatteryLevel:
+		m.ResetUsableBatteryLevel()
+		return nil
+	case vehiclesnapshot.FieldBatteryRange:
+		m.ResetBatteryRange()
+		return nil
+	case vehiclesnapshot.FieldIdealBatteryRange:
+		m.ResetIdealBatteryRange()
+		return nil
+	case vehiclesnapshot.FieldEstBatteryRange:
+		m.ResetEstBatteryRange()
+		return nil
+	case vehiclesnapshot.FieldChargingState:
+		m.ResetChargingState()
+		return nil
+	case vehiclesnapshot.FieldChargerPower:
+		m.ResetChargerPower()
+		return nil
+	case vehiclesnapshot.FieldChargeEnergyAdded:
+		m.ResetChargeEnergyAdded()
+		return nil
+	case vehiclesnapshot.FieldChargerVoltage:
+		m.ResetChargerVoltage()
+		return nil
+	case vehiclesnapshot.FieldChargerActualCurrent:
+		m.ResetChargerActualCurrent()
+		return nil
+	case vehiclesnapshot.FieldFastChargerPresent:
+		m.ResetFastChargerPresent()
+		return nil
+	case vehiclesnapshot.FieldFastChargerType:
+		m.ResetFastChargerType()
+		return nil
+	case vehiclesnapshot.FieldBatteryHeaterOn:
+		m.ResetBatteryHeaterOn()
+		return nil
+	case vehiclesnapshot.FieldOutsideTemp:
+		m.ResetOutsideTemp()
+		return nil
+	case vehiclesnapshot.FieldInsideTemp:
+		m.ResetInsideTemp()
+		return nil
+	case vehiclesnapshot.FieldClimateOn:
+		m.ResetClimateOn()
+		return nil
+	case vehiclesnapshot.FieldClimateKeeperMode:
+		m.ResetClimateKeeperMode()
+		return nil
+	case vehiclesnapshot.FieldCabinOverheatCooling:
+		m.ResetCabinOverheatCooling()
+		return nil
+	case vehiclesnapshot.FieldSentryMode:
+		m.ResetSentryMode()
+		return nil
+	case vehiclesnapshot.FieldLocked:
+		m.ResetLocked()
+		return nil
+	case vehiclesnapshot.FieldOdometer:
+		m.ResetOdometer()
+		return nil
+	case vehiclesnapshot.FieldCarVersion:
+		m.ResetCarVersion()
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateStatus:
+		m.ResetSoftwareUpdateStatus()
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateVersion:
+		m.ResetSoftwareUpdateVersion()
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateDownloadPerc:
+		m.ResetSoftwareUpdateDownloadPerc()
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateInstallPerc:
+		m.ResetSoftwareUpdateInstallPerc()
+		return nil
+	case vehiclesnapshot.FieldSoftwareUpdateExpectedDuration:
+		m.ResetSoftwareUpdateExpectedDuration()
+		return nil
+	case vehiclesnapshot.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleSnapshot field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *VehicleSnapshotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *VehicleSnapshotMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *VehicleSnapshotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *VehicleSnapshotMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *VehicleSnapshotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *VehicleSnapshotMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *VehicleSnapshotMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown VehicleSnapshot unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *VehicleSnapshotMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown VehicleSnapshot edge %s", name)
+}
+
+// VehicleStatePeriodMutation represents an operation that mutates the VehicleStatePeriod nodes in the graph.
+type VehicleStatePeriodMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	vehicle_id             *int
+	addvehicle_id          *int
+	state                  *string
+	sentry_mode            *bool
+	climate_on             *bool
+	start_at               *time.Time
+	end_at                 *time.Time
+	start_battery_level    *int
+	addstart_battery_level *int
+	end_battery_level      *int
+	addend_battery_level   *int
+	start_range            *float64
+	addstart_range         *float64
+	end_range              *float64
+	addend_range           *float64
+	start_odometer         *float64
+	addstart_odometer      *float64
+	end_odometer           *float64
+	addend_odometer        *float64
+	start_latitude         *float64
+	addstart_latitude      *float64
+	start_longitude        *float64
+	addstart_longitude     *float64
+	end_latitude           *float64
+	addend_latitude        *float64
+	end_longitude          *float64
+	addend_longitude       *float64
+	start_address          *string
+	end_address            *string
+	start_geofence_id      *int
+	addstart_geofence_id   *int
+	end_geofence_id        *int
+	addend_geofence_id     *int
+	charge_location        *string
+	fast_charger           *bool
+	energy_added           *float64
+	addenergy_added        *float64
+	start_energy_added     *float64
+	addstart_energy_added  *float64
+	energy_used            *float64
+	addenergy_used         *float64
+	power_seconds          *int
+	addpower_seconds       *int
+	outside_temp           *float64
+	addoutside_temp        *float64
+	max_speed              *float64
+	addmax_speed           *float64
+	climate_ratio          *float64
+	addclimate_ratio       *float64
+	cost                   *float64
+	addcost                *float64
+	cost_manual            *bool
+	tariff_id              *int
+	addtariff_id           *int
+	name                   *string
+	tags                   *[]string
+	appendtags             []string
+	created_at             *time.Time
+	updated_at             *time.Time
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*VehicleStatePeriod, error)
+	predicates             []predicate.VehicleStatePeriod
+}
+
+var _ ent.Mutation = (*VehicleStatePeriodMutation)(nil)
+
+// vehiclestateperiodOption allows management of the mutation configuration using functional options.
+type vehiclestateperiodOption func(*VehicleStatePeriodMutation)
+
+// newVehicleStatePeriodMutation creates new mutation for the VehicleStatePeriod entity.
+func newVehicleStatePeriodMutation(c config, op Op, opts ...vehiclestateperiodOption) *VehicleStatePeriodMutation {
+	m := &VehicleStatePeriodMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVehicleStatePeriod,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVehicleStatePeriodID sets the ID field of the mutation.
+func withVehicleStatePeriodID(id int) vehiclestateperiodOption {
+	return func(m *VehicleStatePeriodMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *VehicleStatePeriod
+		)
+		m.oldValue = func(ctx context.Context) (*VehicleStatePeriod, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().VehicleStatePeriod.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVehicleStatePeriod sets the old VehicleStatePeriod of the mutation.
+func withVehicleStatePeriod(node *VehicleStatePeriod) vehiclestateperiodOption {
+	return func(m *VehicleStatePeriodMutation) {
+		m.oldValue = func(context.Context) (*VehicleStatePeriod, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VehicleStatePeriodMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VehicleStatePeriodMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VehicleStatePeriodMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VehicleStatePeriodMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().VehicleStatePeriod.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (m *VehicleStatePeriodMutation) SetVehicleID(i int) {
+	m.vehicle_id = &i
+	m.addvehicle_id = nil
+}
+
+// VehicleID returns the value of the "vehicle_id" field in the mutation.
+func (m *VehicleStatePeriodMutation) VehicleID() (r int, exists bool) {
+	v := m.vehicle_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVehicleID returns the old "vehicle_id" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldVehicleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVehicleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVehicleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVehicleID: %w", err)
+	}
+	return oldValue.VehicleID, nil
+}
+
+// AddVehicleID adds i to the "vehicle_id" field.
+func (m *VehicleStatePeriodMutation) AddVehicleID(i int) {
+	if m.addvehicle_id != nil {
+		*m.addvehicle_id += i
+	} else {
+		m.addvehicle_id = &i
+	}
+}
+
+// AddedVehicleID returns the value that was added to the "vehicle_id" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedVehicleID() (r int, exists bool) {
+	v := m.addvehicle_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVehicleID resets all changes to the "vehicle_id" field.
+func (m *VehicleStatePeriodMutation) ResetVehicleID() {
+	m.vehicle_id = nil
+	m.addvehicle_id = nil
+}
+
+// SetState sets the "state" field.
+func (m *VehicleStatePeriodMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *VehicleStatePeriodMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *VehicleStatePeriodMutation) ResetState() {
+	m.state = nil
+}
+
+// SetSentryMode sets the "sentry_mode" field.
+func (m *VehicleStatePeriodMutation) SetSentryMode(b bool) {
+	m.sentry_mode = &b
+}
+
+// SentryMode returns the value of the "sentry_mode" field in the mutation.
+func (m *VehicleStatePeriodMutation) SentryMode() (r bool, exists bool) {
+	v := m.sentry_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentryMode returns the old "sentry_mode" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldSentryMode(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentryMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentryMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentryMode: %w", err)
+	}
+	return oldValue.SentryMode, nil
+}
+
+// ResetSentryMode resets all changes to the "sentry_mode" field.
+func (m *VehicleStatePeriodMutation) ResetSentryMode() {
+	m.sentry_mode = nil
+}
+
+// SetClimateOn sets the "climate_on" field.
+func (m *VehicleStatePeriodMutation) SetClimateOn(b bool) {
+	m.climate_on = &b
+}
+
+// ClimateOn returns the value of the "climate_on" field in the mutation.
+func (m *VehicleStatePeriodMutation) ClimateOn() (r bool, exists bool) {
+	v := m.climate_on
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClimateOn returns the old "climate_on" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldClimateOn(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClimateOn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClimateOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClimateOn: %w", err)
+	}
+	return oldValue.ClimateOn, nil
+}
+
+// ResetClimateOn resets all changes to the "climate_on" field.
+func (m *VehicleStatePeriodMutation) ResetClimateOn() {
+	m.climate_on = nil
+}
+
+// SetStartAt sets the "start_at" field.
+func (m *VehicleStatePeriodMutation) SetStartAt(t time.Time) {
+	m.start_at = &t
+}
+
+// StartAt returns the value of the "start_at" field in the mutation.
+func (m *VehicleStatePeriodMutation) StartAt() (r time.Time, exists bool) {
+	v := m.start_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartAt returns the old "start_at" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldStartAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartAt: %w", err)
+	}
+	return oldValue.StartAt, nil
+}
+
+// ResetStartAt resets all changes to the "start_at" field.
+func (m *VehicleStatePeriodMutation) ResetStartAt() {
+	m.start_at = nil
+}
+
+// SetEndAt sets the "end_at" field.
+func (m *VehicleStatePeriodMutation) SetEndAt(t time.Time) {
+	m.end_at = &t
+}
+
+// EndAt returns the value of the "end_at" field in the mutation.
+func (m *VehicleStatePeriodMutation) EndAt() (r time.Time, exists bool) {
+	v := m.end_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndAt returns the old "end_at" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldEndAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndAt: %w", err)
+	}
+	return oldValue.EndAt, nil
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (m *VehicleStatePeriodMutation) ClearEndAt() {
+	m.end_at = nil
+	m.clearedFields[vehiclestateperiod.FieldEndAt] = struct{}{}
+}
+
+// EndAtCleared returns if the "end_at" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) EndAtCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldEndAt]
+	return ok
+}
+
+// ResetEndAt resets all changes to the "end_at" field.
+func (m *VehicleStatePeriodMutation) ResetEndAt() {
+	m.end_at = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldEndAt)
+}
+
+// SetStartBatteryLevel sets the "start_battery_level" field.
+func (m *VehicleStatePeriodMutation) SetStartBatteryLevel(i int) {
+	m.start_battery_level = &i
+	m.addstart_battery_level = nil
+}
+
+// StartBatteryLevel returns the value of the "start_battery_level" field in the mutation.
+func (m *VehicleStatePeriodMutation) StartBatteryLevel() (r int, exists bool) {
+	v := m.start_battery_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartBatteryLevel returns the old "start_battery_level" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldStartBatteryLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartBatteryLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartBatteryLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartBatteryLevel: %w", err)
+	}
+	return oldValue.StartBatteryLevel, nil
+}
+
+// AddStartBatteryLevel adds i to the "start_battery_level" field.
+func (m *VehicleStatePeriodMutation) AddStartBatteryLevel(i int) {
+	if m.addstart_battery_level != nil {
+		*m.addstart_battery_level += i
+	} else {
+		m.addstart_battery_level = &i
+	}
+}
+
+// AddedStartBatteryLevel returns the value that was added to the "start_battery_level" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedStartBatteryLevel() (r int, exists bool) {
+	v := m.addstart_battery_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStartBatteryLevel clears the value of the "start_battery_level" field.
+func (m *VehicleStatePeriodMutation) ClearStartBatteryLevel() {
+	m.start_battery_level = nil
+	m.addstart_battery_level = nil
+	m.clearedFields[vehiclestateperiod.FieldStartBatteryLevel] = struct{}{}
+}
+
+// StartBatteryLevelCleared returns if the "start_battery_level" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) StartBatteryLevelCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldStartBatteryLevel]
+	return ok
+}
+
+// ResetStartBatteryLevel resets all changes to the "start_battery_level" field.
+func (m *VehicleStatePeriodMutation) ResetStartBatteryLevel() {
+	m.start_battery_level = nil
+	m.addstart_battery_level = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldStartBatteryLevel)
+}
+
+// SetEndBatteryLevel sets the "end_battery_level" field.
+func (m *VehicleStatePeriodMutation) SetEndBatteryLevel(i int) {
+	m.end_battery_level = &i
+	m.addend_battery_level = nil
+}
+
+// EndBatteryLevel returns the value of the "end_battery_level" field in the mutation.
+func (m *VehicleStatePeriodMutation) EndBatteryLevel() (r int, exists bool) {
+	v := m.end_battery_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndBatteryLevel returns the old "end_battery_level" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldEndBatteryLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndBatteryLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndBatteryLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndBatteryLevel: %w", err)
+	}
+	return oldValue.EndBatteryLevel, nil
+}
+
+// AddEndBatteryLevel adds i to the "end_battery_level" field.
+func (m *VehicleStatePeriodMutation) AddEndBatteryLevel(i int) {
+	if m.addend_battery_level != nil {
+		*m.addend_battery_level += i
+	} else {
+		m.addend_battery_level = &i
+	}
+}
+
+// AddedEndBatteryLevel returns the value that was added to the "end_battery_level" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedEndBatteryLevel() (r int, exists bool) {
+	v := m.addend_battery_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEndBatteryLevel clears the value of the "end_battery_level" field.
+func (m *VehicleStatePeriodMutation) ClearEndBatteryLevel() {
+	m.end_battery_level = nil
+	m.addend_battery_level = nil
+	m.clearedFields[vehiclestateperiod.FieldEndBatteryLevel] = struct{}{}
+}
+
+// EndBatteryLevelCleared returns if the "end_battery_level" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) EndBatteryLevelCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldEndBatteryLevel]
+	return ok
+}
+
+// ResetEndBatteryLevel resets all changes to the "end_battery_level" field.
+func (m *VehicleStatePeriodMutation) ResetEndBatteryLevel() {
+	m.end_battery_level = nil
+	m.addend_battery_level = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldEndBatteryLevel)
+}
+
+// SetStartRange sets the "start_range" field.
+func (m *VehicleStatePeriodMutation) SetStartRange(f float64) {
+	m.start_range = &f
+	m.addstart_range = nil
+}
+
+// StartRange returns the value of the "start_range" field in the mutation.
+func (m *VehicleStatePeriodMutation) StartRange() (r float64, exists bool) {
+	v := m.start_range
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartRange returns the old "start_range" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldStartRange(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartRange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartRange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartRange: %w", err)
+	}
+	return oldValue.StartRange, nil
+}
+
+// AddStartRange adds f to the "start_range" field.
+func (m *VehicleStatePeriodMutation) AddStartRange(f float64) {
+	if m.addstart_range != nil {
+		*m.addstart_range += f
+	} else {
+		m.addstart_range = &f
+	}
+}
+
+// AddedStartRange returns the value that was added to the "start_range" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedStartRange() (r float64, exists bool) {
+	v := m.addstart_range
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStartRange clears the value of the "start_range" field.
+func (m *VehicleStatePeriodMutation) ClearStartRange() {
+	m.start_range = nil
+	m.addstart_range = nil
+	m.clearedFields[vehiclestateperiod.FieldStartRange] = struct{}{}
+}
+
+// StartRangeCleared returns if the "start_range" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) StartRangeCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldStartRange]
+	return ok
+}
+
+// ResetStartRange resets all changes to the "start_range" field.
+func (m *VehicleStatePeriodMutation) ResetStartRange() {
+	m.start_range = nil
+	m.addstart_range = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldStartRange)
+}
+
+// SetEndRange sets the "end_range" field.
+func (m *VehicleStatePeriodMutation) SetEndRange(f float64) {
+	m.end_range = &f
+	m.addend_range = nil
+}
+
+// EndRange returns the value of the "end_range" field in the mutation.
+func (m *VehicleStatePeriodMutation) EndRange() (r float64, exists bool) {
+	v := m.end_range
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndRange returns the old "end_range" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldEndRange(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndRange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndRange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndRange: %w", err)
+	}
+	return oldValue.EndRange, nil
+}
+
+// AddEndRange adds f to the "end_range" field.
+func (m *VehicleStatePeriodMutation) AddEndRange(f float64) {
+	if m.addend_range != nil {
+		*m.addend_range += f
+	} else {
+		m.addend_range = &f
+	}
+}
+
+// AddedEndRange returns the value that was added to the "end_range" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedEndRange() (r float64, exists bool) {
+	v := m.addend_range
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEndRange clears the value of the "end_range" field.
+func (m *VehicleStatePeriodMutation) ClearEndRange() {
+	m.end_range = nil
+	m.addend_range = nil
+	m.clearedFields[vehiclestateperiod.FieldEndRange] = struct{}{}
+}
+
+// EndRangeCleared returns if the "end_range" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) EndRangeCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldEndRange]
+	return ok
+}
+
+// ResetEndRange resets all changes to the "end_range" field.
+func (m *VehicleStatePeriodMutation) ResetEndRange() {
+	m.end_range = nil
+	m.addend_range = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldEndRange)
+}
+
+// SetStartOdometer sets the "start_odometer" field.
+func (m *VehicleStatePeriodMutation) SetStartOdometer(f float64) {
+	m.start_odometer = &f
+	m.addstart_odometer = nil
+}
+
+// StartOdometer returns the value of the "start_odometer" field in the mutation.
+func (m *VehicleStatePeriodMutation) StartOdometer() (r float64, exists bool) {
+	v := m.start_odometer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartOdometer returns the old "start_odometer" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldStartOdometer(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartOdometer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartOdometer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartOdometer: %w", err)
+	}
+	return oldValue.StartOdometer, nil
+}
+
+// AddStartOdometer adds f to the "start_odometer" field.
+func (m *VehicleStatePeriodMutation) AddStartOdometer(f float64) {
+	if m.addstart_odometer != nil {
+		*m.addstart_odometer += f
+	} else {
+		m.addstart_odometer = &f
+	}
+}
+
+// AddedStartOdometer returns the value that was added to the "start_odometer" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedStartOdometer() (r float64, exists bool) {
+	v := m.addstart_odometer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStartOdometer clears the value of the "start_odometer" field.
+func (m *VehicleStatePeriodMutation) ClearStartOdometer() {
+	m.start_odometer = nil
+	m.addstart_odometer = nil
+	m.clearedFields[vehiclestateperiod.FieldStartOdometer] = struct{}{}
+}
+
+// StartOdometerCleared returns if the "start_odometer" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) StartOdometerCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldStartOdometer]
+	return ok
+}
+
+// ResetStartOdometer resets all changes to the "start_odometer" field.
+func (m *VehicleStatePeriodMutation) ResetStartOdometer() {
+	m.start_odometer = nil
+	m.addstart_odometer = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldStartOdometer)
+}
+
+// SetEndOdometer sets the "end_odometer" field.
+func (m *VehicleStatePeriodMutation) SetEndOdometer(f float64) {
+	m.end_odometer = &f
+	m.addend_odometer = nil
+}
+
+// EndOdometer returns the value of the "end_odometer" field in the mutation.
+func (m *VehicleStatePeriodMutation) EndOdometer() (r float64, exists bool) {
+	v := m.end_odometer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndOdometer returns the old "end_odometer" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldEndOdometer(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndOdometer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndOdometer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndOdometer: %w", err)
+	}
+	return oldValue.EndOdometer, nil
+}
+
+// AddEndOdometer adds f to the "end_odometer" field.
+func (m *VehicleStatePeriodMutation) AddEndOdometer(f float64) {
+	if m.addend_odometer != nil {
+		*m.addend_odometer += f
+	} else {
+		m.addend_odometer = &f
+	}
+}
+
+// AddedEndOdometer returns the value that was added to the "end_odometer" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedEndOdometer() (r float64, exists bool) {
+	v := m.addend_odometer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEndOdometer clears the value of the "end_odometer" field.
+func (m *VehicleStatePeriodMutation) ClearEndOdometer() {
+	m.end_odometer = nil
+	m.addend_odometer = nil
+	m.clearedFields[vehiclestateperiod.FieldEndOdometer] = struct{}{}
+}
+
+// EndOdometerCleared returns if the "end_odometer" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) EndOdometerCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldEndOdometer]
+	return ok
+}
+
+// ResetEndOdometer resets all changes to the "end_odometer" field.
+func (m *VehicleStatePeriodMutation) ResetEndOdometer() {
+	m.end_odometer = nil
+	m.addend_odometer = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldEndOdometer)
+}
+
+// SetStartLatitude sets the "start_latitude" field.
+func (m *VehicleStatePeriodMutation) SetStartLatitude(f float64) {
+	m.start_latitude = &f
+	m.addstart_latitude = nil
+}
+
+// StartLatitude returns the value of the "start_latitude" field in the mutation.
+func (m *VehicleStatePeriodMutation) StartLatitude() (r float64, exists bool) {
+	v := m.start_latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartLatitude returns the old "start_latitude" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldStartLatitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartLatitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartLatitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartLatitude: %w", err)
+	}
+	return oldValue.StartLatitude, nil
+}
+
+// AddStartLatitude adds f to the "start_latitude" field.
+func (m *VehicleStatePeriodMutation) AddStartLatitude(f float64) {
+	if m.addstart_latitude != nil {
+		*m.addstart_latitude += f
+	} else {
+		m.addstart_latitude = &f
+	}
+}
+
+// AddedStartLatitude returns the value that was added to the "start_latitude" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedStartLatitude() (r float64, exists bool) {
+	v := m.addstart_latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStartLatitude clears the value of the "start_latitude" field.
+func (m *VehicleStatePeriodMutation) ClearStartLatitude() {
+	m.start_latitude = nil
+	m.addstart_latitude = nil
+	m.clearedFields[vehiclestateperiod.FieldStartLatitude] = struct{}{}
+}
+
+// StartLatitudeCleared returns if the "start_latitude" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) StartLatitudeCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldStartLatitude]
+	return ok
+}
+
+// ResetStartLatitude resets all changes to the "start_latitude" field.
+func (m *VehicleStatePeriodMutation) ResetStartLatitude() {
+	m.start_latitude = nil
+	m.addstart_latitude = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldStartLatitude)
+}
+
+// SetStartLongitude sets the "start_longitude" field.
+func (m *VehicleStatePeriodMutation) SetStartLongitude(f float64) {
+	m.start_longitude = &f
+	m.addstart_longitude = nil
+}
+
+// StartLongitude returns the value of the "start_longitude" field in the mutation.
+func (m *VehicleStatePeriodMutation) StartLongitude() (r float64, exists bool) {
+	v := m.start_longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartLongitude returns the old "start_longitude" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldStartLongitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartLongitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartLongitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartLongitude: %w", err)
+	}
+	return oldValue.StartLongitude, nil
+}
+
+// AddStartLongitude adds f to the "start_longitude" field.
+func (m *VehicleStatePeriodMutation) AddStartLongitude(f float64) {
+	if m.addstart_longitude != nil {
+		*m.addstart_longitude += f
+	} else {
+		m.addstart_longitude = &f
+	}
+}
+
+// AddedStartLongitude returns the value that was added to the "start_longitude" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedStartLongitude() (r float64, exists bool) {
+	v := m.addstart_longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStartLongitude clears the value of the "start_longitude" field.
+func (m *VehicleStatePeriodMutation) ClearStartLongitude() {
+	m.start_longitude = nil
+	m.addstart_longitude = nil
+	m.clearedFields[vehiclestateperiod.FieldStartLongitude] = struct{}{}
+}
+
+// StartLongitudeCleared returns if the "start_longitude" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) StartLongitudeCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldStartLongitude]
+	return ok
+}
+
+// ResetStartLongitude resets all changes to the "start_longitude" field.
+func (m *VehicleStatePeriodMutation) ResetStartLongitude() {
+	m.start_longitude = nil
+	m.addstart_longitude = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldStartLongitude)
+}
+
+// SetEndLatitude sets the "end_latitude" field.
+func (m *VehicleStatePeriodMutation) SetEndLatitude(f float64) {
+	m.end_latitude = &f
+	m.addend_latitude = nil
+}
+
+// EndLatitude returns the value of the "end_latitude" field in the mutation.
+func (m *VehicleStatePeriodMutation) EndLatitude() (r float64, exists bool) {
+	v := m.end_latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndLatitude returns the old "end_latitude" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldEndLatitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndLatitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndLatitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndLatitude: %w", err)
+	}
+	return oldValue.EndLatitude, nil
+}
+
+// AddEndLatitude adds f to the "end_latitude" field.
+func (m *VehicleStatePeriodMutation) AddEndLatitude(f float64) {
+	if m.addend_latitude != nil {
+		*m.addend_latitude += f
+	} else {
+		m.addend_latitude = &f
+	}
+}
+
+// AddedEndLatitude returns the value that was added to the "end_latitude" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedEndLatitude() (r float64, exists bool) {
+	v := m.addend_latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEndLatitude clears the value of the "end_latitude" field.
+func (m *VehicleStatePeriodMutation) ClearEndLatitude() {
+	m.end_latitude = nil
+	m.addend_latitude = nil
+	m.clearedFields[vehiclestateperiod.FieldEndLatitude] = struct{}{}
+}
+
+// EndLatitudeCleared returns if the "end_latitude" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) EndLatitudeCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldEndLatitude]
+	return ok
+}
+
+// ResetEndLatitude resets all changes to the "end_latitude" field.
+func (m *VehicleStatePeriodMutation) ResetEndLatitude() {
+	m.end_latitude = nil
+	m.addend_latitude = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldEndLatitude)
+}
+
+// SetEndLongitude sets the "end_longitude" field.
+func (m *VehicleStatePeriodMutation) SetEndLongitude(f float64) {
+	m.end_longitude = &f
+	m.addend_longitude = nil
+}
+
+// EndLongitude returns the value of the "end_longitude" field in the mutation.
+func (m *VehicleStatePeriodMutation) EndLongitude() (r float64, exists bool) {
+	v := m.end_longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndLongitude returns the old "end_longitude" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldEndLongitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndLongitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndLongitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndLongitude: %w", err)
+	}
+	return oldValue.EndLongitude, nil
+}
+
+// AddEndLongitude adds f to the "end_longitude" field.
+func (m *VehicleStatePeriodMutation) AddEndLongitude(f float64) {
+	if m.addend_longitude != nil {
+		*m.addend_longitude += f
+	} else {
+		m.addend_longitude = &f
+	}
+}
+
+// AddedEndLongitude returns the value that was added to the "end_longitude" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedEndLongitude() (r float64, exists bool) {
+	v := m.addend_longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEndLongitude clears the value of the "end_longitude" field.
+func (m *VehicleStatePeriodMutation) ClearEndLongitude() {
+	m.end_longitude = nil
+	m.addend_longitude = nil
+	m.clearedFields[vehiclestateperiod.FieldEndLongitude] = struct{}{}
+}
+
+// EndLongitudeCleared returns if the "end_longitude" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) EndLongitudeCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldEndLongitude]
+	return ok
+}
+
+// ResetEndLongitude resets all changes to the "end_longitude" field.
+func (m *VehicleStatePeriodMutation) ResetEndLongitude() {
+	m.end_longitude = nil
+	m.addend_longitude = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldEndLongitude)
+}
+
+// SetStartAddress sets the "start_address" field.
+func (m *VehicleStatePeriodMutation) SetStartAddress(s string) {
+	m.start_address = &s
+}
+
+// StartAddress returns the value of the "start_address" field in the mutation.
+func (m *VehicleStatePeriodMutation) StartAddress() (r string, exists bool) {
+	v := m.start_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartAddress returns the old "start_address" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldStartAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartAddress: %w", err)
+	}
+	return oldValue.StartAddress, nil
+}
+
+// ClearStartAddress clears the value of the "start_address" field.
+func (m *VehicleStatePeriodMutation) ClearStartAddress() {
+	m.start_address = nil
+	m.clearedFields[vehiclestateperiod.FieldStartAddress] = struct{}{}
+}
+
+// StartAddressCleared returns if the "start_address" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) StartAddressCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldStartAddress]
+	return ok
+}
+
+// ResetStartAddress resets all changes to the "start_address" field.
+func (m *VehicleStatePeriodMutation) ResetStartAddress() {
+	m.start_address = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldStartAddress)
+}
+
+// SetEndAddress sets the "end_address" field.
+func (m *VehicleStatePeriodMutation) SetEndAddress(s string) {
+	m.end_address = &s
+}
+
+// EndAddress returns the value of the "end_address" field in the mutation.
+func (m *VehicleStatePeriodMutation) EndAddress() (r string, exists bool) {
+	v := m.end_address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndAddress returns the old "end_address" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldEndAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndAddress: %w", err)
+	}
+	return oldValue.EndAddress, nil
+}
+
+// ClearEndAddress clears the value of the "end_address" field.
+func (m *VehicleStatePeriodMutation) ClearEndAddress() {
+	m.end_address = nil
+	m.clearedFields[vehiclestateperiod.FieldEndAddress] = struct{}{}
+}
+
+// EndAddressCleared returns if the "end_address" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) EndAddressCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldEndAddress]
+	return ok
+}
+
+// ResetEndAddress resets all changes to the "end_address" field.
+func (m *VehicleStatePeriodMutation) ResetEndAddress() {
+	m.end_address = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldEndAddress)
+}
+
+// SetStartGeofenceID sets the "start_geofence_id" field.
+func (m *VehicleStatePeriodMutation) SetStartGeofenceID(i int) {
+	m.start_geofence_id = &i
+	m.addstart_geofence_id = nil
+}
+
+// StartGeofenceID returns the value of the "start_geofence_id" field in the mutation.
+func (m *VehicleStatePeriodMutation) StartGeofenceID() (r int, exists bool) {
+	v := m.start_geofence_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartGeofenceID returns the old "start_geofence_id" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldStartGeofenceID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartGeofenceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartGeofenceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartGeofenceID: %w", err)
+	}
+	return oldValue.StartGeofenceID, nil
+}
+
+// AddStartGeofenceID adds i to the "start_geofence_id" field.
+func (m *VehicleStatePeriodMutation) AddStartGeofenceID(i int) {
+	if m.addstart_geofence_id != nil {
+		*m.addstart_geofence_id += i
+	} else {
+		m.addstart_geofence_id = &i
+	}
+}
+
+// AddedStartGeofenceID returns the value that was added to the "start_geofence_id" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedStartGeofenceID() (r int, exists bool) {
+	v := m.addstart_geofence_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStartGeofenceID clears the value of the "start_geofence_id" field.
+func (m *VehicleStatePeriodMutation) ClearStartGeofenceID() {
+	m.start_geofence_id = nil
+	m.addstart_geofence_id = nil
+	m.clearedFields[vehiclestateperiod.FieldStartGeofenceID] = struct{}{}
+}
+
+// StartGeofenceIDCleared returns if the "start_geofence_id" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) StartGeofenceIDCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldStartGeofenceID]
+	return ok
+}
+
+// ResetStartGeofenceID resets all changes to the "start_geofence_id" field.
+func (m *VehicleStatePeriodMutation) ResetStartGeofenceID() {
+	m.start_geofence_id = nil
+	m.addstart_geofence_id = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldStartGeofenceID)
+}
+
+// SetEndGeofenceID sets the "end_geofence_id" field.
+func (m *VehicleStatePeriodMutation) SetEndGeofenceID(i int) {
+	m.end_geofence_id = &i
+	m.addend_geofence_id = nil
+}
+
+// EndGeofenceID returns the value of the "end_geofence_id" field in the mutation.
+func (m *VehicleStatePeriodMutation) EndGeofenceID() (r int, exists bool) {
+	v := m.end_geofence_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndGeofenceID returns the old "end_geofence_id" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldEndGeofenceID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndGeofenceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndGeofenceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndGeofenceID: %w", err)
+	}
+	return oldValue.EndGeofenceID, nil
+}
+
+// AddEndGeofenceID adds i to the "end_geofence_id" field.
+func (m *VehicleStatePeriodMutation) AddEndGeofenceID(i int) {
+	if m.addend_geofence_id != nil {
+		*m.addend_geofence_id += i
+	} else {
+		m.addend_geofence_id = &i
+	}
+}
+
+// AddedEndGeofenceID returns the value that was added to the "end_geofence_id" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedEndGeofenceID() (r int, exists bool) {
+	v := m.addend_geofence_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEndGeofenceID clears the value of the "end_geofence_id" field.
+func (m *VehicleStatePeriodMutation) ClearEndGeofenceID() {
+	m.end_geofence_id = nil
+	m.addend_geofence_id = nil
+	m.clearedFields[vehiclestateperiod.FieldEndGeofenceID] = struct{}{}
+}
+
+// EndGeofenceIDCleared returns if the "end_geofence_id" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) EndGeofenceIDCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldEndGeofenceID]
+	return ok
+}
+
+// ResetEndGeofenceID resets all changes to the "end_geofence_id" field.
+func (m *VehicleStatePeriodMutation) ResetEndGeofenceID() {
+	m.end_geofence_id = nil
+	m.addend_geofence_id = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldEndGeofenceID)
+}
+
+// SetChargeLocation sets the "charge_location" field.
+func (m *VehicleStatePeriodMutation) SetChargeLocation(s string) {
+	m.charge_location = &s
+}
+
+// ChargeLocation returns the value of the "charge_location" field in the mutation.
+func (m *VehicleStatePeriodMutation) ChargeLocation() (r string, exists bool) {
+	v := m.charge_location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChargeLocation returns the old "charge_location" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldChargeLocation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChargeLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChargeLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChargeLocation: %w", err)
+	}
+	return oldValue.ChargeLocation, nil
+}
+
+// ClearChargeLocation clears the value of the "charge_location" field.
+func (m *VehicleStatePeriodMutation) ClearChargeLocation() {
+	m.charge_location = nil
+	m.clearedFields[vehiclestateperiod.FieldChargeLocation] = struct{}{}
+}
+
+// ChargeLocationCleared returns if the "charge_location" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) ChargeLocationCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldChargeLocation]
+	return ok
+}
+
+// ResetChargeLocation resets all changes to the "charge_location" field.
+func (m *VehicleStatePeriodMutation) ResetChargeLocation() {
+	m.charge_location = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldChargeLocation)
+}
+
+// SetFastCharger sets the "fast_charger" field.
+func (m *VehicleStatePeriodMutation) SetFastCharger(b bool) {
+	m.fast_charger = &b
+}
+
+// FastCharger returns the value of the "fast_charger" field in the mutation.
+func (m *VehicleStatePeriodMutation) FastCharger() (r bool, exists bool) {
+	v := m.fast_charger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFastCharger returns the old "fast_charger" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldFastCharger(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFastCharger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFastCharger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFastCharger: %w", err)
+	}
+	return oldValue.FastCharger, nil
+}
+
+// ResetFastCharger resets all changes to the "fast_charger" field.
+func (m *VehicleStatePeriodMutation) ResetFastCharger() {
+	m.fast_charger = nil
+}
+
+// SetEnergyAdded sets the "energy_added" field.
+func (m *VehicleStatePeriodMutation) SetEnergyAdded(f float64) {
+	m.energy_added = &f
+	m.addenergy_added = nil
+}
+
+// EnergyAdded returns the value of the "energy_added" field in the mutation.
+func (m *VehicleStatePeriodMutation) EnergyAdded() (r float64, exists bool) {
+	v := m.energy_added
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnergyAdded returns the old "energy_added" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldEnergyAdded(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnergyAdded is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnergyAdded requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnergyAdded: %w", err)
+	}
+	return oldValue.EnergyAdded, nil
+}
+
+// AddEnergyAdded adds f to the "energy_added" field.
+func (m *VehicleStatePeriodMutation) AddEnergyAdded(f float64) {
+	if m.addenergy_added != nil {
+		*m.addenergy_added += f
+	} else {
+		m.addenergy_added = &f
+	}
+}
+
+// AddedEnergyAdded returns the value that was added to the "energy_added" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedEnergyAdded() (r float64, exists bool) {
+	v := m.addenergy_added
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEnergyAdded clears the value of the "energy_added" field.
+func (m *VehicleStatePeriodMutation) ClearEnergyAdded() {
+	m.energy_added = nil
+	m.addenergy_added = nil
+	m.clearedFields[vehiclestateperiod.FieldEnergyAdded] = struct{}{}
+}
+
+// EnergyAddedCleared returns if the "energy_added" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) EnergyAddedCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldEnergyAdded]
+	return ok
+}
+
+// ResetEnergyAdded resets all changes to the "energy_added" field.
+func (m *VehicleStatePeriodMutation) ResetEnergyAdded() {
+	m.energy_added = nil
+	m.addenergy_added = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldEnergyAdded)
+}
+
+// SetStartEnergyAdded sets the "start_energy_added" field.
+func (m *VehicleStatePeriodMutation) SetStartEnergyAdded(f float64) {
+	m.start_energy_added = &f
+	m.addstart_energy_added = nil
+}
+
+// StartEnergyAdded returns the value of the "start_energy_added" field in the mutation.
+func (m *VehicleStatePeriodMutation) StartEnergyAdded() (r float64, exists bool) {
+	v := m.start_energy_added
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartEnergyAdded returns the old "start_energy_added" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldStartEnergyAdded(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartEnergyAdded is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartEnergyAdded requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartEnergyAdded: %w", err)
+	}
+	return oldValue.StartEnergyAdded, nil
+}
+
+// AddStartEnergyAdded adds f to the "start_energy_added" field.
+func (m *VehicleStatePeriodMutation) AddStartEnergyAdded(f float64) {
+	if m.addstart_energy_added != nil {
+		*m.addstart_energy_added += f
+	} else {
+		m.addstart_energy_added = &f
+	}
+}
+
+// AddedStartEnergyAdded returns the value that was added to the "start_energy_added" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedStartEnergyAdded() (r float64, exists bool) {
+	v := m.addstart_energy_added
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStartEnergyAdded clears the value of the "start_energy_added" field.
+func (m *VehicleStatePeriodMutation) ClearStartEnergyAdded() {
+	m.start_energy_added = nil
+	m.addstart_energy_added = nil
+	m.clearedFields[vehiclestateperiod.FieldStartEnergyAdded] = struct{}{}
+}
+
+// StartEnergyAddedCleared returns if the "start_energy_added" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) StartEnergyAddedCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldStartEnergyAdded]
+	return ok
+}
+
+// ResetStartEnergyAdded resets all changes to the "start_energy_added" field.
+func (m *VehicleStatePeriodMutation) ResetStartEnergyAdded() {
+	m.start_energy_added = nil
+	m.addstart_energy_added = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldStartEnergyAdded)
+}
+
+// SetEnergyUsed sets the "energy_used" field.
+func (m *VehicleStatePeriodMutation) SetEnergyUsed(f float64) {
+	m.energy_used = &f
+	m.addenergy_used = nil
+}
+
+// EnergyUsed returns the value of the "energy_used" field in the mutation.
+func (m *VehicleStatePeriodMutation) EnergyUsed() (r float64, exists bool) {
+	v := m.energy_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnergyUsed returns the old "energy_used" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldEnergyUsed(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnergyUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnergyUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnergyUsed: %w", err)
+	}
+	return oldValue.EnergyUsed, nil
+}
+
+// AddEnergyUsed adds f to the "energy_used" field.
+func (m *VehicleStatePeriodMutation) AddEnergyUsed(f float64) {
+	if m.addenergy_used != nil {
+		*m.addenergy_used += f
+	} else {
+		m.addenergy_used = &f
+	}
+}
+
+// AddedEnergyUsed returns the value that was added to the "energy_used" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedEnergyUsed() (r float64, exists bool) {
+	v := m.addenergy_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEnergyUsed clears the value of the "energy_used" field.
+func (m *VehicleStatePeriodMutation) ClearEnergyUsed() {
+	m.energy_used = nil
+	m.addenergy_used = nil
+	m.clearedFields[vehiclestateperiod.FieldEnergyUsed] = struct{}{}
+}
+
+// EnergyUsedCleared returns if the "energy_used" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) EnergyUsedCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldEnergyUsed]
+	return ok
+}
+
+// ResetEnergyUsed resets all changes to the "energy_used" field.
+func (m *VehicleStatePeriodMutation) ResetEnergyUsed() {
+	m.energy_used = nil
+	m.addenergy_used = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldEnergyUsed)
+}
+
+// SetPowerSeconds sets the "power_seconds" field.
+func (m *VehicleStatePeriodMutation) SetPowerSeconds(i int) {
+	m.power_seconds = &i
+	m.addpower_seconds = nil
+}
+
+// PowerSeconds returns the value of the "power_seconds" field in the mutation.
+func (m *VehicleStatePeriodMutation) PowerSeconds() (r int, exists bool) {
+	v := m.power_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPowerSeconds returns the old "power_seconds" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldPowerSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPowerSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPowerSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPowerSeconds: %w", err)
+	}
+	return oldValue.PowerSeconds, nil
+}
+
+// AddPowerSeconds adds i to the "power_seconds" field.
+func (m *VehicleStatePeriodMutation) AddPowerSeconds(i int) {
+	if m.addpower_seconds != nil {
+		*m.addpower_seconds += i
+	} else {
+		m.addpower_seconds = &i
+	}
+}
+
+// AddedPowerSeconds returns the value that was added to the "power_seconds" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedPowerSeconds() (r int, exists bool) {
+	v := m.addpower_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPowerSeconds clears the value of the "power_seconds" field.
+func (m *VehicleStatePeriodMutation) ClearPowerSeconds() {
+	m.power_seconds = nil
+	m.addpower_seconds = nil
+	m.clearedFields[vehiclestateperiod.FieldPowerSeconds] = struct{}{}
+}
+
+// PowerSecondsCleared returns if the "power_seconds" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) PowerSecondsCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldPowerSeconds]
+	return ok
+}
+
+// ResetPowerSeconds resets all changes to the "power_seconds" field.
+func (m *VehicleStatePeriodMutation) ResetPowerSeconds() {
+	m.power_seconds = nil
+	m.addpower_seconds = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldPowerSeconds)
+}
+
+// SetOutsideTemp sets the "outside_temp" field.
+func (m *VehicleStatePeriodMutation) SetOutsideTemp(f float64) {
+	m.outside_temp = &f
+	m.addoutside_temp = nil
+}
+
+// OutsideTemp returns the value of the "outside_temp" field in the mutation.
+func (m *VehicleStatePeriodMutation) OutsideTemp() (r float64, exists bool) {
+	v := m.outside_temp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutsideTemp returns the old "outside_temp" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldOutsideTemp(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutsideTemp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutsideTemp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutsideTemp: %w", err)
+	}
+	return oldValue.OutsideTemp, nil
+}
+
+// AddOutsideTemp adds f to the "outside_temp" field.
+func (m *VehicleStatePeriodMutation) AddOutsideTemp(f float64) {
+	if m.addoutside_temp != nil {
+		*m.addoutside_temp += f
+	} else {
+		m.addoutside_temp = &f
+	}
+}
+
+// AddedOutsideTemp returns the value that was added to the "outside_temp" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedOutsideTemp() (r float64, exists bool) {
+	v := m.addoutside_temp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOutsideTemp clears the value of the "outside_temp" field.
+func (m *VehicleStatePeriodMutation) ClearOutsideTemp() {
+	m.outside_temp = nil
+	m.addoutside_temp = nil
+	m.clearedFields[vehiclestateperiod.FieldOutsideTemp] = struct{}{}
+}
+
+// OutsideTempCleared returns if the "outside_temp" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) OutsideTempCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldOutsideTemp]
+	return ok
+}
+
+// ResetOutsideTemp resets all changes to the "outside_temp" field.
+func (m *VehicleStatePeriodMutation) ResetOutsideTemp() {
+	m.outside_temp = nil
+	m.addoutside_temp = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldOutsideTemp)
+}
+
+// SetMaxSpeed sets the "max_speed" field.
+func (m *VehicleStatePeriodMutation) SetMaxSpeed(f float64) {
+	m.max_speed = &f
+	m.addmax_speed = nil
+}
+
+// MaxSpeed returns the value of the "max_speed" field in the mutation.
+func (m *VehicleStatePeriodMutation) MaxSpeed() (r float64, exists bool) {
+	v := m.max_speed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxSpeed returns the old "max_speed" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldMaxSpeed(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxSpeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxSpeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxSpeed: %w", err)
+	}
+	return oldValue.MaxSpeed, nil
+}
+
+// AddMaxSpeed adds f to the "max_speed" field.
+func (m *VehicleStatePeriodMutation) AddMaxSpeed(f float64) {
+	if m.addmax_speed != nil {
+		*m.addmax_speed += f
+	} else {
+		m.addmax_speed = &f
+	}
+}
+
+// AddedMaxSpeed returns the value that was added to the "max_speed" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedMaxSpeed() (r float64, exists bool) {
+	v := m.addmax_speed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxSpeed clears the value of the "max_speed" field.
+func (m *VehicleStatePeriodMutation) ClearMaxSpeed() {
+	m.max_speed = nil
+	m.addmax_speed = nil
+	m.clearedFields[vehiclestateperiod.FieldMaxSpeed] = struct{}{}
+}
+
+// MaxSpeedCleared returns if the "max_speed" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) MaxSpeedCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldMaxSpeed]
+	return ok
+}
+
+// ResetMaxSpeed resets all changes to the "max_speed" field.
+func (m *VehicleStatePeriodMutation) ResetMaxSpeed() {
+	m.max_speed = nil
+	m.addmax_speed = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldMaxSpeed)
+}
+
+// SetClimateRatio sets the "climate_ratio" field.
+func (m *VehicleStatePeriodMutation) SetClimateRatio(f float64) {
+	m.climate_ratio = &f
+	m.addclimate_ratio = nil
+}
+
+// ClimateRatio returns the value of the "climate_ratio" field in the mutation.
+func (m *VehicleStatePeriodMutation) ClimateRatio() (r float64, exists bool) {
+	v := m.climate_ratio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClimateRatio returns the old "climate_ratio" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldClimateRatio(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClimateRatio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClimateRatio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClimateRatio: %w", err)
+	}
+	return oldValue.ClimateRatio, nil
+}
+
+// AddClimateRatio adds f to the "climate_ratio" field.
+func (m *VehicleStatePeriodMutation) AddClimateRatio(f float64) {
+	if m.addclimate_ratio != nil {
+		*m.addclimate_ratio += f
+	} else {
+		m.addclimate_ratio = &f
+	}
+}
+
+// AddedClimateRatio returns the value that was added to the "climate_ratio" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedClimateRatio() (r float64, exists bool) {
+	v := m.addclimate_ratio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearClimateRatio clears the value of the "climate_ratio" field.
+func (m *VehicleStatePeriodMutation) ClearClimateRatio() {
+	m.climate_ratio = nil
+	m.addclimate_ratio = nil
+	m.clearedFields[vehiclestateperiod.FieldClimateRatio] = struct{}{}
+}
+
+// ClimateRatioCleared returns if the "climate_ratio" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) ClimateRatioCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldClimateRatio]
+	return ok
+}
+
+// ResetClimateRatio resets all changes to the "climate_ratio" field.
+func (m *VehicleStatePeriodMutation) ResetClimateRatio() {
+	m.climate_ratio = nil
+	m.addclimate_ratio = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldClimateRatio)
+}
+
+// SetCost sets the "cost" field.
+func (m *VehicleStatePeriodMutation) SetCost(f float64) {
+	m.cost = &f
+	m.addcost = nil
+}
+
+// Cost returns the value of the "cost" field in the mutation.
+func (m *VehicleStatePeriodMutation) Cost() (r float64, exists bool) {
+	v := m.cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCost returns the old "cost" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldCost(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCost: %w", err)
+	}
+	return oldValue.Cost, nil
+}
+
+// AddCost adds f to the "cost" field.
+func (m *VehicleStatePeriodMutation) AddCost(f float64) {
+	if m.addcost != nil {
+		*m.addcost += f
+	} else {
+		m.addcost = &f
+	}
+}
+
+// AddedCost returns the value that was added to the "cost" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedCost() (r float64, exists bool) {
+	v := m.addcost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCost clears the value of the "cost" field.
+func (m *VehicleStatePeriodMutation) ClearCost() {
+	m.cost = nil
+	m.addcost = nil
+	m.clearedFields[vehiclestateperiod.FieldCost] = struct{}{}
+}
+
+// CostCleared returns if the "cost" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) CostCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldCost]
+	return ok
+}
+
+// ResetCost resets all changes to the "cost" field.
+func (m *VehicleStatePeriodMutation) ResetCost() {
+	m.cost = nil
+	m.addcost = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldCost)
+}
+
+// SetCostManual sets the "cost_manual" field.
+func (m *VehicleStatePeriodMutation) SetCostManual(b bool) {
+	m.cost_manual = &b
+}
+
+// CostManual returns the value of the "cost_manual" field in the mutation.
+func (m *VehicleStatePeriodMutation) CostManual() (r bool, exists bool) {
+	v := m.cost_manual
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCostManual returns the old "cost_manual" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldCostManual(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCostManual is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCostManual requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCostManual: %w", err)
+	}
+	return oldValue.CostManual, nil
+}
+
+// ResetCostManual resets all changes to the "cost_manual" field.
+func (m *VehicleStatePeriodMutation) ResetCostManual() {
+	m.cost_manual = nil
+}
+
+// SetTariffID sets the "tariff_id" field.
+func (m *VehicleStatePeriodMutation) SetTariffID(i int) {
+	m.tariff_id = &i
+	m.addtariff_id = nil
+}
+
+// TariffID returns the value of the "tariff_id" field in the mutation.
+func (m *VehicleStatePeriodMutation) TariffID() (r int, exists bool) {
+	v := m.tariff_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTariffID returns the old "tariff_id" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldTariffID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTariffID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTariffID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTariffID: %w", err)
+	}
+	return oldValue.TariffID, nil
+}
+
+// AddTariffID adds i to the "tariff_id" field.
+func (m *VehicleStatePeriodMutation) AddTariffID(i int) {
+	if m.addtariff_id != nil {
+		*m.addtariff_id += i
+	} else {
+		m.addtariff_id = &i
+	}
+}
+
+// AddedTariffID returns the value that was added to the "tariff_id" field in this mutation.
+func (m *VehicleStatePeriodMutation) AddedTariffID() (r int, exists bool) {
+	v := m.addtariff_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTariffID clears the value of the "tariff_id" field.
+func (m *VehicleStatePeriodMutation) ClearTariffID() {
+	m.tariff_id = nil
+	m.addtariff_id = nil
+	m.clearedFields[vehiclestateperiod.FieldTariffID] = struct{}{}
+}
+
+// TariffIDCleared returns if the "tariff_id" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) TariffIDCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldTariffID]
+	return ok
+}
+
+// ResetTariffID resets all changes to the "tariff_id" field.
+func (m *VehicleStatePeriodMutation) ResetTariffID() {
+	m.tariff_id = nil
+	m.addtariff_id = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldTariffID)
+}
+
+// SetName sets the "name" field.
+func (m *VehicleStatePeriodMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *VehicleStatePeriodMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *VehicleStatePeriodMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[vehiclestateperiod.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) NameCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *VehicleStatePeriodMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldName)
+}
+
+// SetTags sets the "tags" field.
+func (m *VehicleStatePeriodMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *VehicleStatePeriodMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *VehicleStatePeriodMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *VehicleStatePeriodMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *VehicleStatePeriodMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[vehiclestateperiod.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *VehicleStatePeriodMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[vehiclestateperiod.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *VehicleStatePeriodMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, vehiclestateperiod.FieldTags)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *VehicleStatePeriodMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *VehicleStatePeriodMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *VehicleStatePeriodMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *VehicleStatePeriodMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *VehicleStatePeriodMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the VehicleStatePeriod entity.
+// If the VehicleStatePeriod object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleStatePeriodMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *VehicleStatePeriodMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the VehicleStatePeriodMutation builder.
+func (m *VehicleStatePeriodMutation) Where(ps ...predicate.VehicleStatePeriod) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the VehicleStatePeriodMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *VehicleStatePeriodMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VehicleStatePeriod, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *VehicleStatePeriodMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *VehicleStatePeriodMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (VehicleStatePeriod).
+func (m *VehicleStatePeriodMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *VehicleStatePeriodMutation) Fields() []string {
+	fields := make([]string, 0, 36)
+	if m.vehicle_id != nil {
+		fields = append(fields, vehiclestateperiod.FieldVehicleID)
+	}
+	if m.state != nil {
+		fields = append(fields, vehiclestateperiod.FieldState)
 	}
-	if m.in_service != nil {
-		fields = append(fields, vehicle.FieldInService)
+	if m.sentry_mode != nil {
+		fields = append(fields, vehiclestateperiod.FieldSentryMode)
 	}
-	if m.calendar_enabled != nil {
-		fields = append(fields, vehicle.FieldCalendarEnabled)
+	if m.climate_on != nil {
+		fields = append(fields, vehiclestateperiod.FieldClimateOn)
 	}
-	if m.car_type != nil {
-		fields = append(fields, vehicle.FieldCarType)
+	if m.start_at != nil {
+		fields = append(fields, vehiclestateperiod.FieldStartAt)
 	}
-	if m.api_version != nil {
-		fields = append(fields, vehicle.FieldAPIVersion)
+	if m.end_at != nil {
+		fields = append(fields, vehiclestateperiod.FieldEndAt)
 	}
-	if m.raw_data != nil {
-		fields = append(fields, vehicle.FieldRawData)
+	if m.start_battery_level != nil {
+		fields = append(fields, vehiclestateperiod.FieldStartBatteryLevel)
+	}
+	if m.end_battery_level != nil {
+		fields = append(fields, vehiclestateperiod.FieldEndBatteryLevel)
+	}
+	if m.start_range != nil {
+		fields = append(fields, vehiclestateperiod.FieldStartRange)
+	}
+	if m.end_range != nil {
+		fields = append(fields, vehiclestateperiod.FieldEndRange)
+	}
+	if m.start_odometer != nil {
+		fields = append(fields, vehiclestateperiod.FieldStartOdometer)
+	}
+	if m.end_odometer != nil {
+		fields = append(fields, vehiclestateperiod.FieldEndOdometer)
+	}
+	if m.start_latitude != nil {
+		fields = append(fields, vehiclestateperiod.FieldStartLatitude)
+	}
+	if m.start_longitude != nil {
+		fields = append(fields, vehiclestateperiod.FieldStartLongitude)
+	}
+	if m.end_latitude != nil {
+		fields = append(fields, vehiclestateperiod.FieldEndLatitude)
+	}
+	if m.end_longitude != nil {
+		fields = append(fields, vehiclestateperiod.FieldEndLongitude)
+	}
+	if m.start_address != nil {
+		fields = append(fields, vehiclestateperiod.FieldStartAddress)
+	}
+	if m.end_address != nil {
+		fields = append(fields, vehiclestateperiod.FieldEndAddress)
+	}
+	if m.start_geofence_id != nil {
+		fields = append(fields, vehiclestateperiod.FieldStartGeofenceID)
+	}
+	if m.end_geofence_id != nil {
+		fields = append(fields, vehiclestateperiod.FieldEndGeofenceID)
+	}
+	if m.charge_location != nil {
+		fields = append(fields, vehiclestateperiod.FieldChargeLocation)
+	}
+	if m.fast_charger != nil {
+		fields = append(fields, vehiclestateperiod.FieldFastCharger)
+	}
+	if m.energy_added != nil {
+		fields = append(fields, vehiclestateperiod.FieldEnergyAdded)
+	}
+	if m.start_energy_added != nil {
+		fields = append(fields, vehiclestateperiod.FieldStartEnergyAdded)
+	}
+	if m.energy_used != nil {
+		fields = append(fields, vehiclestateperiod.FieldEnergyUsed)
+	}
+	if m.power_seconds != nil {
+		fields = append(fields, vehiclestateperiod.FieldPowerSeconds)
+	}
+	if m.outside_temp != nil {
+		fields = append(fields, vehiclestateperiod.FieldOutsideTemp)
+	}
+	if m.max_speed != nil {
+		fields = append(fields, vehiclestateperiod.FieldMaxSpeed)
+	}
+	if m.climate_ratio != nil {
+		fields = append(fields, vehiclestateperiod.FieldClimateRatio)
+	}
+	if m.cost != nil {
+		fields = append(fields, vehiclestateperiod.FieldCost)
+	}
+	if m.cost_manual != nil {
+		fields = append(fields, vehiclestateperiod.FieldCostManual)
+	}
+	if m.tariff_id != nil {
+		fields = append(fields, vehiclestateperiod.FieldTariffID)
+	}
+	if m.name != nil {
+		fields = append(fields, vehiclestateperiod.FieldName)
+	}
+	if m.tags != nil {
+		fields = append(fields, vehiclestateperiod.FieldTags)
 	}
 	if m.created_at != nil {
-		fields = append(fields, vehicle.FieldCreatedAt)
+		fields = append(fields, vehiclestateperiod.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, vehicle.FieldUpdatedAt)
-	}
-	if m.deleted != nil {
-		fields = append(fields, vehicle.FieldDeleted)
+		fields = append(fields, vehiclestateperiod.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -4151,34 +19350,80 @@ func (m *VehicleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *VehicleMutation) Field(name string) (ent.Value, bool) {
+func (m *VehicleStatePeriodMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case vehicle.FieldVin:
-		return m.Vin()
-	case vehicle.FieldUserID:
-		return m.UserID()
-	case vehicle.FieldDisplayName:
-		return m.DisplayName()
-	case vehicle.FieldAccessType:
-		return m.AccessType()
-	case vehicle.FieldState:
+	case vehiclestateperiod.FieldVehicleID:
+		return m.VehicleID()
+	case vehiclestateperiod.FieldState:
 		return m.State()
-	case vehicle.FieldInService:
-		return m.InService()
-	case vehicle.FieldCalendarEnabled:
-		return m.CalendarEnabled()
-	case vehicle.FieldCarType:
-		return m.CarType()
-	case vehicle.FieldAPIVersion:
-		return m.APIVersion()
-	case vehicle.FieldRawData:
-		return m.RawData()
-	case vehicle.FieldCreatedAt:
+	case vehiclestateperiod.FieldSentryMode:
+		return m.SentryMode()
+	case vehiclestateperiod.FieldClimateOn:
+		return m.ClimateOn()
+	case vehiclestateperiod.FieldStartAt:
+		return m.StartAt()
+	case vehiclestateperiod.FieldEndAt:
+		return m.EndAt()
+	case vehiclestateperiod.FieldStartBatteryLevel:
+		return m.StartBatteryLevel()
+	case vehiclestateperiod.FieldEndBatteryLevel:
+		return m.EndBatteryLevel()
+	case vehiclestateperiod.FieldStartRange:
+		return m.StartRange()
+	case vehiclestateperiod.FieldEndRange:
+		return m.EndRange()
+	case vehiclestateperiod.FieldStartOdometer:
+		return m.StartOdometer()
+	case vehiclestateperiod.FieldEndOdometer:
+		return m.EndOdometer()
+	case vehiclestateperiod.FieldStartLatitude:
+		return m.StartLatitude()
+	case vehiclestateperiod.FieldStartLongitude:
+		return m.StartLongitude()
+	case vehiclestateperiod.FieldEndLatitude:
+		return m.EndLatitude()
+	case vehiclestateperiod.FieldEndLongitude:
+		return m.EndLongitude()
+	case vehiclestateperiod.FieldStartAddress:
+		return m.StartAddress()
+	case vehiclestateperiod.FieldEndAddress:
+		return m.EndAddress()
+	case vehiclestateperiod.FieldStartGeofenceID:
+		return m.StartGeofenceID()
+	case vehiclestateperiod.FieldEndGeofenceID:
+		return m.EndGeofenceID()
+	case vehiclestateperiod.FieldChargeLocation:
+		return m.ChargeLocation()
+	case vehiclestateperiod.FieldFastCharger:
+		return m.FastCharger()
+	case vehiclestateperiod.FieldEnergyAdded:
+		return m.EnergyAdded()
+	case vehiclestateperiod.FieldStartEnergyAdded:
+		return m.StartEnergyAdded()
+	case vehiclestateperiod.FieldEnergyUsed:
+		return m.EnergyUsed()
+	case vehiclestateperiod.FieldPowerSeconds:
+		return m.PowerSeconds()
+	case vehiclestateperiod.FieldOutsideTemp:
+		return m.OutsideTemp()
+	case vehiclestateperiod.FieldMaxSpeed:
+		return m.MaxSpeed()
+	case vehiclestateperiod.FieldClimateRatio:
+		return m.ClimateRatio()
+	case vehiclestateperiod.FieldCost:
+		return m.Cost()
+	case vehiclestateperiod.FieldCostManual:
+		return m.CostManual()
+	case vehiclestateperiod.FieldTariffID:
+		return m.TariffID()
+	case vehiclestateperiod.FieldName:
+		return m.Name()
+	case vehiclestateperiod.FieldTags:
+		return m.Tags()
+	case vehiclestateperiod.FieldCreatedAt:
 		return m.CreatedAt()
-	case vehicle.FieldUpdatedAt:
+	case vehiclestateperiod.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case vehicle.FieldDeleted:
-		return m.Deleted()
 	}
 	return nil, false
 }
@@ -4186,336 +19431,978 @@ func (m *VehicleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *VehicleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VehicleStatePeriodMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case vehicle.FieldVin:
-		return m.OldVin(ctx)
-	case vehicle.FieldUserID:
-		return m.OldUserID(ctx)
-	case vehicle.FieldDisplayName:
-		return m.OldDisplayName(ctx)
-	case vehicle.FieldAccessType:
-		return m.OldAccessType(ctx)
-	case vehicle.FieldState:
+	case vehiclestateperiod.FieldVehicleID:
+		return m.OldVehicleID(ctx)
+	case vehiclestateperiod.FieldState:
 		return m.OldState(ctx)
-	case vehicle.FieldInService:
-		return m.OldInService(ctx)
-	case vehicle.FieldCalendarEnabled:
-		return m.OldCalendarEnabled(ctx)
-	case vehicle.FieldCarType:
-		return m.OldCarType(ctx)
-	case vehicle.FieldAPIVersion:
-		return m.OldAPIVersion(ctx)
-	case vehicle.FieldRawData:
-		return m.OldRawData(ctx)
-	case vehicle.FieldCreatedAt:
+	case vehiclestateperiod.FieldSentryMode:
+		return m.OldSentryMode(ctx)
+	case vehiclestateperiod.FieldClimateOn:
+		return m.OldClimateOn(ctx)
+	case vehiclestateperiod.FieldStartAt:
+		return m.OldStartAt(ctx)
+	case vehiclestateperiod.FieldEndAt:
+		return m.OldEndAt(ctx)
+	case vehiclestateperiod.FieldStartBatteryLevel:
+		return m.OldStartBatteryLevel(ctx)
+	case vehiclestateperiod.FieldEndBatteryLevel:
+		return m.OldEndBatteryLevel(ctx)
+	case vehiclestateperiod.FieldStartRange:
+		return m.OldStartRange(ctx)
+	case vehiclestateperiod.FieldEndRange:
+		return m.OldEndRange(ctx)
+	case vehiclestateperiod.FieldStartOdometer:
+		return m.OldStartOdometer(ctx)
+	case vehiclestateperiod.FieldEndOdometer:
+		return m.OldEndOdometer(ctx)
+	case vehiclestateperiod.FieldStartLatitude:
+		return m.OldStartLatitude(ctx)
+	case vehiclestateperiod.FieldStartLongitude:
+		return m.OldStartLongitude(ctx)
+	case vehiclestateperiod.FieldEndLatitude:
+		return m.OldEndLatitude(ctx)
+	case vehiclestateperiod.FieldEndLongitude:
+		return m.OldEndLongitude(ctx)
+	case vehiclestateperiod.FieldStartAddress:
+		return m.OldStartAddress(ctx)
+	case vehiclestateperiod.FieldEndAddress:
+		return m.OldEndAddress(ctx)
+	case vehiclestateperiod.FieldStartGeofenceID:
+		return m.OldStartGeofenceID(ctx)
+	case vehiclestateperiod.FieldEndGeofenceID:
+		return m.OldEndGeofenceID(ctx)
+	case vehiclestateperiod.FieldChargeLocation:
+		return m.OldChargeLocation(ctx)
+	case vehiclestateperiod.FieldFastCharger:
+		return m.OldFastCharger(ctx)
+	case vehiclestateperiod.FieldEnergyAdded:
+		return m.OldEnergyAdded(ctx)
+	case vehiclestateperiod.FieldStartEnergyAdded:
+		return m.OldStartEnergyAdded(ctx)
+	case vehiclestateperiod.FieldEnergyUsed:
+		return m.OldEnergyUsed(ctx)
+	case vehiclestateperiod.FieldPowerSeconds:
+		return m.OldPowerSeconds(ctx)
+	case vehiclestateperiod.FieldOutsideTemp:
+		return m.OldOutsideTemp(ctx)
+	case vehiclestateperiod.FieldMaxSpeed:
+		return m.OldMaxSpeed(ctx)
+	case vehiclestateperiod.FieldClimateRatio:
+		return m.OldClimateRatio(ctx)
+	case vehiclestateperiod.FieldCost:
+		return m.OldCost(ctx)
+	case vehiclestateperiod.FieldCostManual:
+		return m.OldCostManual(ctx)
+	case vehiclestateperiod.FieldTariffID:
+		return m.OldTariffID(ctx)
+	case vehiclestateperiod.FieldName:
+		return m.OldName(ctx)
+	case vehiclestateperiod.FieldTags:
+		return m.OldTags(ctx)
+	case vehiclestateperiod.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case vehicle.FieldUpdatedAt:
+	case vehiclestateperiod.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case vehicle.FieldDeleted:
-		return m.OldDeleted(ctx)
 	}
-	return nil, fmt.Errorf("unknown Vehicle field %s", name)
+	return nil, fmt.Errorf("unknown VehicleStatePeriod field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VehicleStatePeriodMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case vehiclestateperiod.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVehicleID(v)
+		return nil
+	case vehiclestateperiod.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	case vehiclestateperiod.FieldSentryMode:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentryMode(v)
+		return nil
+	case vehiclestateperiod.FieldClimateOn:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClimateOn(v)
+		return nil
+	case vehiclestateperiod.FieldStartAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartAt(v)
+		return nil
+	case vehiclestateperiod.FieldEndAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndAt(v)
+		return nil
+	case vehiclestateperiod.FieldStartBatteryLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartBatteryLevel(v)
+		return nil
+	case vehiclestateperiod.FieldEndBatteryLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndBatteryLevel(v)
+		return nil
+	case vehiclestateperiod.FieldStartRange:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartRange(v)
+		return nil
+	case vehiclestateperiod.FieldEndRange:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndRange(v)
+		return nil
+	case vehiclestateperiod.FieldStartOdometer:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartOdometer(v)
+		return nil
+	case vehiclestateperiod.FieldEndOdometer:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndOdometer(v)
+		return nil
+	case vehiclestateperiod.FieldStartLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartLatitude(v)
+		return nil
+	case vehiclestateperiod.FieldStartLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartLongitude(v)
+		return nil
+	case vehiclestateperiod.FieldEndLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndLatitude(v)
+		return nil
+	case vehiclestateperiod.FieldEndLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndLongitude(v)
+		return nil
+	case vehiclestateperiod.FieldStartAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartAddress(v)
+		return nil
+	case vehiclestateperiod.FieldEndAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndAddress(v)
+		return nil
+	case vehiclestateperiod.FieldStartGeofenceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartGeofenceID(v)
+		return nil
+	case vehiclestateperiod.FieldEndGeofenceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndGeofenceID(v)
+		return nil
+	case vehiclestateperiod.FieldChargeLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChargeLocation(v)
+		return nil
+	case vehiclestateperiod.FieldFastCharger:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFastCharger(v)
+		return nil
+	case vehiclestateperiod.FieldEnergyAdded:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnergyAdded(v)
+		return nil
+	case vehiclestateperiod.FieldStartEnergyAdded:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartEnergyAdded(v)
+		return nil
+	case vehiclestateperiod.FieldEnergyUsed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnergyUsed(v)
+		return nil
+	case vehiclestateperiod.FieldPowerSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPowerSeconds(v)
+		return nil
+	case vehiclestateperiod.FieldOutsideTemp:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutsideTemp(v)
+		return nil
+	case vehiclestateperiod.FieldMaxSpeed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxSpeed(v)
+		return nil
+	case vehiclestateperiod.FieldClimateRatio:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClimateRatio(v)
+		return nil
+	case vehiclestateperiod.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCost(v)
+		return nil
+	case vehiclestateperiod.FieldCostManual:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCostManual(v)
+		return nil
+	case vehiclestateperiod.FieldTariffID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTariffID(v)
+		return nil
+	case vehiclestateperiod.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case vehiclestateperiod.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case vehiclestateperiod.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case vehiclestateperiod.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleStatePeriod field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *VehicleStatePeriodMutation) AddedFields() []string {
+	var fields []string
+	if m.addvehicle_id != nil {
+		fields = append(fields, vehiclestateperiod.FieldVehicleID)
+	}
+	if m.addstart_battery_level != nil {
+		fields = append(fields, vehiclestateperiod.FieldStartBatteryLevel)
+	}
+	if m.addend_battery_level != nil {
+		fields = append(fields, vehiclestateperiod.FieldEndBatteryLevel)
+	}
+	if m.addstart_range != nil {
+		fields = append(fields, vehiclestateperiod.FieldStartRange)
+	}
+	if m.addend_range != nil {
+		fields = append(fields, vehiclestateperiod.FieldEndRange)
+	}
+	if m.addstart_odometer != nil {
+		fields = append(fields, vehiclestateperiod.FieldStartOdometer)
+	}
+	if m.addend_odometer != nil {
+		fields = append(fields, vehiclestateperiod.FieldEndOdometer)
+	}
+	if m.addstart_latitude != nil {
+		fields = append(fields, vehiclestateperiod.FieldStartLatitude)
+	}
+	if m.addstart_longitude != nil {
+		fields = append(fields, vehiclestateperiod.FieldStartLongitude)
+	}
+	if m.addend_latitude != nil {
+		fields = append(fields, vehiclestateperiod.FieldEndLatitude)
+	}
+	if m.addend_longitude != nil {
+		fields = append(fields, vehiclestateperiod.FieldEndLongitude)
+	}
+	if m.addstart_geofence_id != nil {
+		fields = append(fields, vehiclestateperiod.FieldStartGeofenceID)
+	}
+	if m.addend_geofence_id != nil {
+		fields = append(fields, vehiclestateperiod.FieldEndGeofenceID)
+	}
+	if m.addenergy_added != nil {
+		fields = append(fields, vehiclestateperiod.FieldEnergyAdded)
+	}
+	if m.addstart_energy_added != nil {
+		fields = append(fields, vehiclestateperiod.FieldStartEnergyAdded)
+	}
+	if m.addenergy_used != nil {
+		fields = append(fields, vehiclestateperiod.FieldEnergyUsed)
+	}
+	if m.addpower_seconds != nil {
+		fields = append(fields, vehiclestateperiod.FieldPowerSeconds)
+	}
+	if m.addoutside_temp != nil {
+		fields = append(fields, vehiclestateperiod.FieldOutsideTemp)
+	}
+	if m.addmax_speed != nil {
+		fields = append(fields, vehiclestateperiod.FieldMaxSpeed)
+	}
+	if m.addclimate_ratio != nil {
+		fields = append(fields, vehiclestateperiod.FieldClimateRatio)
+	}
+	if m.addcost != nil {
+		fields = append(fields, vehiclestateperiod.FieldCost)
+	}
+	if m.addtariff_id != nil {
+		fields = append(fields, vehiclestateperiod.FieldTariffID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *VehicleStatePeriodMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case vehiclestateperiod.FieldVehicleID:
+		return m.AddedVehicleID()
+	case vehiclestateperiod.FieldStartBatteryLevel:
+		return m.AddedStartBatteryLevel()
+	case vehiclestateperiod.FieldEndBatteryLevel:
+		return m.AddedEndBatteryLevel()
+	case vehiclestateperiod.FieldStartRange:
+		return m.AddedStartRange()
+	case vehiclestateperiod.FieldEndRange:
+		return m.AddedEndRange()
+	case vehiclestateperiod.FieldStartOdometer:
+		return m.AddedStartOdometer()
+	case vehiclestateperiod.FieldEndOdometer:
+		return m.AddedEndOdometer()
+	case vehiclestateperiod.FieldStartLatitude:
+		return m.AddedStartLatitude()
+	case vehiclestateperiod.FieldStartLongitude:
+		return m.AddedStartLongitude()
+	case vehiclestateperiod.FieldEndLatitude:
+		return m.AddedEndLatitude()
+	case vehiclestateperiod.FieldEndLongitude:
+		return m.AddedEndLongitude()
+	case vehiclestateperiod.FieldStartGeofenceID:
+		return m.AddedStartGeofenceID()
+	case vehiclestateperiod.FieldEndGeofenceID:
+		return m.AddedEndGeofenceID()
+	case vehiclestateperiod.FieldEnergyAdded:
+		return m.AddedEnergyAdded()
+	case vehiclestateperiod.FieldStartEnergyAdded:
+		return m.AddedStartEnergyAdded()
+	case vehiclestateperiod.FieldEnergyUsed:
+		return m.AddedEnergyUsed()
+	case vehiclestateperiod.FieldPowerSeconds:
+		return m.AddedPowerSeconds()
+	case vehiclestateperiod.FieldOutsideTemp:
+		return m.AddedOutsideTemp()
+	case vehiclestateperiod.FieldMaxSpeed:
+		return m.AddedMaxSpeed()
+	case vehiclestateperiod.FieldClimateRatio:
+		return m.AddedClimateRatio()
+	case vehiclestateperiod.FieldCost:
+		return m.AddedCost()
+	case vehiclestateperiod.FieldTariffID:
+		return m.AddedTariffID()
+	}
+	return nil, false
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
+// AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *VehicleMutation) SetField(name string, value ent.Value) error {
+func (m *VehicleStatePeriodMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case vehicle.FieldVin:
-		v, ok := value.(string)
+	case vehiclestateperiod.FieldVehicleID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVin(v)
+		m.AddVehicleID(v)
 		return nil
-	case vehicle.FieldUserID:
+	case vehiclestateperiod.FieldStartBatteryLevel:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.AddStartBatteryLevel(v)
 		return nil
-	case vehicle.FieldDisplayName:
-		v, ok := value.(string)
+	case vehiclestateperiod.FieldEndBatteryLevel:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDisplayName(v)
+		m.AddEndBatteryLevel(v)
 		return nil
-	case vehicle.FieldAccessType:
-		v, ok := value.(string)
+	case vehiclestateperiod.FieldStartRange:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAccessType(v)
+		m.AddStartRange(v)
 		return nil
-	case vehicle.FieldState:
-		v, ok := value.(int8)
+	case vehiclestateperiod.FieldEndRange:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetState(v)
+		m.AddEndRange(v)
 		return nil
-	case vehicle.FieldInService:
-		v, ok := value.(int8)
+	case vehiclestateperiod.FieldStartOdometer:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInService(v)
+		m.AddStartOdometer(v)
 		return nil
-	case vehicle.FieldCalendarEnabled:
-		v, ok := value.(int8)
+	case vehiclestateperiod.FieldEndOdometer:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCalendarEnabled(v)
+		m.AddEndOdometer(v)
 		return nil
-	case vehicle.FieldCarType:
-		v, ok := value.(string)
+	case vehiclestateperiod.FieldStartLatitude:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCarType(v)
+		m.AddStartLatitude(v)
 		return nil
-	case vehicle.FieldAPIVersion:
-		v, ok := value.(string)
+	case vehiclestateperiod.FieldStartLongitude:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAPIVersion(v)
+		m.AddStartLongitude(v)
 		return nil
-	case vehicle.FieldRawData:
-		v, ok := value.(string)
+	case vehiclestateperiod.FieldEndLatitude:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRawData(v)
+		m.AddEndLatitude(v)
 		return nil
-	case vehicle.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case vehiclestateperiod.FieldEndLongitude:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.AddEndLongitude(v)
 		return nil
-	case vehicle.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case vehiclestateperiod.FieldStartGeofenceID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.AddStartGeofenceID(v)
 		return nil
-	case vehicle.FieldDeleted:
-		v, ok := value.(bool)
+	case vehiclestateperiod.FieldEndGeofenceID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeleted(v)
+		m.AddEndGeofenceID(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Vehicle field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *VehicleMutation) AddedFields() []string {
-	var fields []string
-	if m.adduser_id != nil {
-		fields = append(fields, vehicle.FieldUserID)
-	}
-	if m.addstate != nil {
-		fields = append(fields, vehicle.FieldState)
-	}
-	if m.addin_service != nil {
-		fields = append(fields, vehicle.FieldInService)
-	}
-	if m.addcalendar_enabled != nil {
-		fields = append(fields, vehicle.FieldCalendarEnabled)
-	}
-	return fields
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *VehicleMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case vehicle.FieldUserID:
-		return m.AddedUserID()
-	case vehicle.FieldState:
-		return m.AddedState()
-	case vehicle.FieldInService:
-		return m.AddedInService()
-	case vehicle.FieldCalendarEnabled:
-		return m.AddedCalendarEnabled()
-	}
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *VehicleMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case vehicle.FieldUserID:
+	case vehiclestateperiod.FieldEnergyAdded:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEnergyAdded(v)
+		return nil
+	case vehiclestateperiod.FieldStartEnergyAdded:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartEnergyAdded(v)
+		return nil
+	case vehiclestateperiod.FieldEnergyUsed:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEnergyUsed(v)
+		return nil
+	case vehiclestateperiod.FieldPowerSeconds:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddUserID(v)
+		m.AddPowerSeconds(v)
 		return nil
-	case vehicle.FieldState:
-		v, ok := value.(int8)
+	case vehiclestateperiod.FieldOutsideTemp:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddState(v)
+		m.AddOutsideTemp(v)
 		return nil
-	case vehicle.FieldInService:
-		v, ok := value.(int8)
+	case vehiclestateperiod.FieldMaxSpeed:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddInService(v)
+		m.AddMaxSpeed(v)
 		return nil
-	case vehicle.FieldCalendarEnabled:
-		v, ok := value.(int8)
+	case vehiclestateperiod.FieldClimateRatio:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCalendarEnabled(v)
+		m.AddClimateRatio(v)
+		return nil
+	case vehiclestateperiod.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCost(v)
+		return nil
+	case vehiclestateperiod.FieldTariffID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTariffID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Vehicle numeric field %s", name)
+	return fmt.Errorf("unknown VehicleStatePeriod numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *VehicleMutation) ClearedFields() []string {
+func (m *VehicleStatePeriodMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(vehicle.FieldCarType) {
-		fields = append(fields, vehicle.FieldCarType)
+	if m.FieldCleared(vehiclestateperiod.FieldEndAt) {
+		fields = append(fields, vehiclestateperiod.FieldEndAt)
 	}
-	if m.FieldCleared(vehicle.FieldAPIVersion) {
-		fields = append(fields, vehicle.FieldAPIVersion)
+	if m.FieldCleared(vehiclestateperiod.FieldStartBatteryLevel) {
+		fields = append(fields, vehiclestateperiod.FieldStartBatteryLevel)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldEndBatteryLevel) {
+		fields = append(fields, vehiclestateperiod.FieldEndBatteryLevel)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldStartRange) {
+		fields = append(fields, vehiclestateperiod.FieldStartRange)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldEndRange) {
+		fields = append(fields, vehiclestateperiod.FieldEndRange)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldStartOdometer) {
+		fields = append(fields, vehiclestateperiod.FieldStartOdometer)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldEndOdometer) {
+		fields = append(fields, vehiclestateperiod.FieldEndOdometer)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldStartLatitude) {
+		fields = append(fields, vehiclestateperiod.FieldStartLatitude)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldStartLongitude) {
+		fields = append(fields, vehiclestateperiod.FieldStartLongitude)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldEndLatitude) {
+		fields = append(fields, vehiclestateperiod.FieldEndLatitude)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldEndLongitude) {
+		fields = append(fields, vehiclestateperiod.FieldEndLongitude)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldStartAddress) {
+		fields = append(fields, vehiclestateperiod.FieldStartAddress)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldEndAddress) {
+		fields = append(fields, vehiclestateperiod.FieldEndAddress)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldStartGeofenceID) {
+		fields = append(fields, vehiclestateperiod.FieldStartGeofenceID)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldEndGeofenceID) {
+		fields = append(fields, vehiclestateperiod.FieldEndGeofenceID)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldChargeLocation) {
+		fields = append(fields, vehiclestateperiod.FieldChargeLocation)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldEnergyAdded) {
+		fields = append(fields, vehiclestateperiod.FieldEnergyAdded)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldStartEnergyAdded) {
+		fields = append(fields, vehiclestateperiod.FieldStartEnergyAdded)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldEnergyUsed) {
+		fields = append(fields, vehiclestateperiod.FieldEnergyUsed)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldPowerSeconds) {
+		fields = append(fields, vehiclestateperiod.FieldPowerSeconds)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldOutsideTemp) {
+		fields = append(fields, vehiclestateperiod.FieldOutsideTemp)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldMaxSpeed) {
+		fields = append(fields, vehiclestateperiod.FieldMaxSpeed)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldClimateRatio) {
+		fields = append(fields, vehiclestateperiod.FieldClimateRatio)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldCost) {
+		fields = append(fields, vehiclestateperiod.FieldCost)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldTariffID) {
+		fields = append(fields, vehiclestateperiod.FieldTariffID)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldName) {
+		fields = append(fields, vehiclestateperiod.FieldName)
+	}
+	if m.FieldCleared(vehiclestateperiod.FieldTags) {
+		fields = append(fields, vehiclestateperiod.FieldTags)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *VehicleMutation) FieldCleared(name string) bool {
+func (m *VehicleStatePeriodMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *VehicleMutation) ClearField(name string) error {
+func (m *VehicleStatePeriodMutation) ClearField(name string) error {
 	switch name {
-	case vehicle.FieldCarType:
-		m.ClearCarType()
+	case vehiclestateperiod.FieldEndAt:
+		m.ClearEndAt()
 		return nil
-	case vehicle.FieldAPIVersion:
-		m.ClearAPIVersion()
+	case vehiclestateperiod.FieldStartBatteryLevel:
+		m.ClearStartBatteryLevel()
+		return nil
+	case vehiclestateperiod.FieldEndBatteryLevel:
+		m.ClearEndBatteryLevel()
+		return nil
+	case vehiclestateperiod.FieldStartRange:
+		m.ClearStartRange()
+		return nil
+	case vehiclestateperiod.FieldEndRange:
+		m.ClearEndRange()
+		return nil
+	case vehiclestateperiod.FieldStartOdometer:
+		m.ClearStartOdometer()
+		return nil
+	case vehiclestateperiod.FieldEndOdometer:
+		m.ClearEndOdometer()
+		return nil
+	case vehiclestateperiod.FieldStartLatitude:
+		m.ClearStartLatitude()
+		return nil
+	case vehiclestateperiod.FieldStartLongitude:
+		m.ClearStartLongitude()
+		return nil
+	case vehiclestateperiod.FieldEndLatitude:
+		m.ClearEndLatitude()
+		return nil
+	case vehiclestateperiod.FieldEndLongitude:
+		m.ClearEndLongitude()
+		return nil
+	case vehiclestateperiod.FieldStartAddress:
+		m.ClearStartAddress()
+		return nil
+	case vehiclestateperiod.FieldEndAddress:
+		m.ClearEndAddress()
+		return nil
+	case vehiclestateperiod.FieldStartGeofenceID:
+		m.ClearStartGeofenceID()
+		return nil
+	case vehiclestateperiod.FieldEndGeofenceID:
+		m.ClearEndGeofenceID()
+		return nil
+	case vehiclestateperiod.FieldChargeLocation:
+		m.ClearChargeLocation()
+		return nil
+	case vehiclestateperiod.FieldEnergyAdded:
+		m.ClearEnergyAdded()
+		return nil
+	case vehiclestateperiod.FieldStartEnergyAdded:
+		m.ClearStartEnergyAdded()
+		return nil
+	case vehiclestateperiod.FieldEnergyUsed:
+		m.ClearEnergyUsed()
+		return nil
+	case vehiclestateperiod.FieldPowerSeconds:
+		m.ClearPowerSeconds()
+		return nil
+	case vehiclestateperiod.FieldOutsideTemp:
+		m.ClearOutsideTemp()
+		return nil
+	case vehiclestateperiod.FieldMaxSpeed:
+		m.ClearMaxSpeed()
+		return nil
+	case vehiclestateperiod.FieldClimateRatio:
+		m.ClearClimateRatio()
+		return nil
+	case vehiclestateperiod.FieldCost:
+		m.ClearCost()
+		return nil
+	case vehiclestateperiod.FieldTariffID:
+		m.ClearTariffID()
+		return nil
+	case vehiclestateperiod.FieldName:
+		m.ClearName()
+		return nil
+	case vehiclestateperiod.FieldTags:
+		m.ClearTags()
 		return nil
 	}
-	return fmt.Errorf("unknown Vehicle nullable field %s", name)
+	return fmt.Errorf("unknown VehicleStatePeriod nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *VehicleMutation) ResetField(name string) error {
+func (m *VehicleStatePeriodMutation) ResetField(name string) error {
 	switch name {
-	case vehicle.FieldVin:
-		m.ResetVin()
+	case vehiclestateperiod.FieldVehicleID:
+		m.ResetVehicleID()
 		return nil
-	case vehicle.FieldUserID:
-		m.ResetUserID()
+	case vehiclestateperiod.FieldState:
+		m.ResetState()
 		return nil
-	case vehicle.FieldDisplayName:
-		m.ResetDisplayName()
+	case vehiclestateperiod.FieldSentryMode:
+		m.ResetSentryMode()
 		return nil
-	case vehicle.FieldAccessType:
-		m.ResetAccessType()
+	case vehiclestateperiod.FieldClimateOn:
+		m.ResetClimateOn()
 		return nil
-	case vehicle.FieldState:
-		m.ResetState()
+	case vehiclestateperiod.FieldStartAt:
+		m.ResetStartAt()
 		return nil
-	case vehicle.FieldInService:
-		m.ResetInService()
+	case vehiclestateperiod.FieldEndAt:
+		m.ResetEndAt()
 		return nil
-	case vehicle.FieldCalendarEnabled:
-		m.ResetCalendarEnabled()
+	case vehiclestateperiod.FieldStartBatteryLevel:
+		m.ResetStartBatteryLevel()
 		return nil
-	case vehicle.FieldCarType:
-		m.ResetCarType()
+	case vehiclestateperiod.FieldEndBatteryLevel:
+		m.ResetEndBatteryLevel()
 		return nil
-	case vehicle.FieldAPIVersion:
-		m.ResetAPIVersion()
+	case vehiclestateperiod.FieldStartRange:
+		m.ResetStartRange()
 		return nil
-	case vehicle.FieldRawData:
-		m.ResetRawData()
+	case vehiclestateperiod.FieldEndRange:
+		m.ResetEndRange()
 		return nil
-	case vehicle.FieldCreatedAt:
+	case vehiclestateperiod.FieldStartOdometer:
+		m.ResetStartOdometer()
+		return nil
+	case vehiclestateperiod.FieldEndOdometer:
+		m.ResetEndOdometer()
+		return nil
+	case vehiclestateperiod.FieldStartLatitude:
+		m.ResetStartLatitude()
+		return nil
+	case vehiclestateperiod.FieldStartLongitude:
+		m.ResetStartLongitude()
+		return nil
+	case vehiclestateperiod.FieldEndLatitude:
+		m.ResetEndLatitude()
+		return nil
+	case vehiclestateperiod.FieldEndLongitude:
+		m.ResetEndLongitude()
+		return nil
+	case vehiclestateperiod.FieldStartAddress:
+		m.ResetStartAddress()
+		return nil
+	case vehiclestateperiod.FieldEndAddress:
+		m.ResetEndAddress()
+		return nil
+	case vehiclestateperiod.FieldStartGeofenceID:
+		m.ResetStartGeofenceID()
+		return nil
+	case vehiclestateperiod.FieldEndGeofenceID:
+		m.ResetEndGeofenceID()
+		return nil
+	case vehiclestateperiod.FieldChargeLocation:
+		m.ResetChargeLocation()
+		return nil
+	case vehiclestateperiod.FieldFastCharger:
+		m.ResetFastCharger()
+		return nil
+	case vehiclestateperiod.FieldEnergyAdded:
+		m.ResetEnergyAdded()
+		return nil
+	case vehiclestateperiod.FieldStartEnergyAdded:
+		m.ResetStartEnergyAdded()
+		return nil
+	case vehiclestateperiod.FieldEnergyUsed:
+		m.ResetEnergyUsed()
+		return nil
+	case vehiclestateperiod.FieldPowerSeconds:
+		m.ResetPowerSeconds()
+		return nil
+	case vehiclestateperiod.FieldOutsideTemp:
+		m.ResetOutsideTemp()
+		return nil
+	case vehiclestateperiod.FieldMaxSpeed:
+		m.ResetMaxSpeed()
+		return nil
+	case vehiclestateperiod.FieldClimateRatio:
+		m.ResetClimateRatio()
+		return nil
+	case vehiclestateperiod.FieldCost:
+		m.ResetCost()
+		return nil
+	case vehiclestateperiod.FieldCostManual:
+		m.ResetCostManual()
+		return nil
+	case vehiclestateperiod.FieldTariffID:
+		m.ResetTariffID()
+		return nil
+	case vehiclestateperiod.FieldName:
+		m.ResetName()
+		return nil
+	case vehiclestateperiod.FieldTags:
+		m.ResetTags()
+		return nil
+	case vehiclestateperiod.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case vehicle.FieldUpdatedAt:
+	case vehiclestateperiod.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case vehicle.FieldDeleted:
-		m.ResetDeleted()
-		return nil
 	}
-	return fmt.Errorf("unknown Vehicle field %s", name)
+	return fmt.Errorf("unknown VehicleStatePeriod field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *VehicleMutation) AddedEdges() []string {
+func (m *VehicleStatePeriodMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *VehicleMutation) AddedIDs(name string) []ent.Value {
+func (m *VehicleStatePeriodMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *VehicleMutation) RemovedEdges() []string {
+func (m *VehicleStatePeriodMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *VehicleMutation) RemovedIDs(name string) []ent.Value {
+func (m *VehicleStatePeriodMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *VehicleMutation) ClearedEdges() []string {
+func (m *VehicleStatePeriodMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *VehicleMutation) EdgeCleared(name string) bool {
+func (m *VehicleStatePeriodMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *VehicleMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Vehicle unique edge %s", name)
+func (m *VehicleStatePeriodMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown VehicleStatePeriod unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *VehicleMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Vehicle edge %s", name)
+func (m *VehicleStatePeriodMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown VehicleStatePeriod edge %s", name)
 }
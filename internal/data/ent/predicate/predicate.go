@@ -6,17 +6,47 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Address is the predicate function for address builders.
+type Address func(*sql.Selector)
+
 // Authorize is the predicate function for authorize builders.
 type Authorize func(*sql.Selector)
 
 // AuthorizeToken is the predicate function for authorizetoken builders.
 type AuthorizeToken func(*sql.Selector)
 
+// Geofence is the predicate function for geofence builders.
+type Geofence func(*sql.Selector)
+
 // Partner is the predicate function for partner builders.
 type Partner func(*sql.Selector)
 
+// RollupState is the predicate function for rollupstate builders.
+type RollupState func(*sql.Selector)
+
+// SoftwareUpdate is the predicate function for softwareupdate builders.
+type SoftwareUpdate func(*sql.Selector)
+
+// Tariff is the predicate function for tariff builders.
+type Tariff func(*sql.Selector)
+
+// TirePressure is the predicate function for tirepressure builders.
+type TirePressure func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
 // Vehicle is the predicate function for vehicle builders.
 type Vehicle func(*sql.Selector)
+
+// VehicleEvent is the predicate function for vehicleevent builders.
+type VehicleEvent func(*sql.Selector)
+
+// VehicleRollup is the predicate function for vehiclerollup builders.
+type VehicleRollup func(*sql.Selector)
+
+// VehicleSnapshot is the predicate function for vehiclesnapshot builders.
+type VehicleSnapshot func(*sql.Selector)
+
+// VehicleStatePeriod is the predicate function for vehiclestateperiod builders.
+type VehicleStatePeriod func(*sql.Selector)
@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"teslatrack/internal/data/ent/rollupstate"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Rollup progress table
+type RollupState struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Associated vehicle ID
+	VehicleID int `json:"vehicle_id,omitempty"`
+	// IANA time zone the rollups were computed in
+	TimeZone string `json:"time_zone,omitempty"`
+	// Latest update time of the state periods rolled up
+	Watermark time.Time `json:"watermark,omitempty"`
+	// Update time
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RollupState) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rollupstate.FieldID, rollupstate.FieldVehicleID:
+			values[i] = new(sql.NullInt64)
+		case rollupstate.FieldTimeZone:
+			values[i] = new(sql.NullString)
+		case rollupstate.FieldWatermark, rollupstate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RollupState fields.
+func (_m *RollupState) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rollupstate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case rollupstate.FieldVehicleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vehicle_id", values[i])
+			} else if value.Valid {
+				_m.VehicleID = int(value.Int64)
+			}
+		case rollupstate.FieldTimeZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field time_zone", values[i])
+			} else if value.Valid {
+				_m.TimeZone = value.String
+			}
+		case rollupstate.FieldWatermark:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field watermark", values[i])
+			} else if value.Valid {
+				_m.Watermark = value.Time
+			}
+		case rollupstate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RollupState.
+// This includes values selected through modifiers, order, etc.
+func (_m *RollupState) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RollupState.
+// Note that you need to call RollupState.Unwrap() before calling this method if this RollupState
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RollupState) Update() *RollupStateUpdateOne {
+	return NewRollupStateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RollupState entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RollupState) Unwrap() *RollupState {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RollupState is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RollupState) String() string {
+	var builder strings.Builder
+	builder.WriteString("RollupState(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("vehicle_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VehicleID))
+	builder.WriteString(", ")
+	builder.WriteString("time_zone=")
+	builder.WriteString(_m.TimeZone)
+	builder.WriteString(", ")
+	builder.WriteString("watermark=")
+	builder.WriteString(_m.Watermark.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RollupStates is a parsable slice of RollupState.
+type RollupStates []*RollupState
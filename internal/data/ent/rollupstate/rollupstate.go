@@ -0,0 +1,80 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rollupstate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the rollupstate type in the database.
+	Label = "rollup_state"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVehicleID holds the string denoting the vehicle_id field in the database.
+	FieldVehicleID = "vehicle_id"
+	// FieldTimeZone holds the string denoting the time_zone field in the database.
+	FieldTimeZone = "time_zone"
+	// FieldWatermark holds the string denoting the watermark field in the database.
+	FieldWatermark = "watermark"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the rollupstate in the database.
+	Table = "rollup_state"
+)
+
+// Columns holds all SQL columns for rollupstate fields.
+var Columns = []string{
+	FieldID,
+	FieldVehicleID,
+	FieldTimeZone,
+	FieldWatermark,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the RollupState queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVehicleID orders the results by the vehicle_id field.
+func ByVehicleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVehicleID, opts...).ToFunc()
+}
+
+// ByTimeZone orders the results by the time_zone field.
+func ByTimeZone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeZone, opts...).ToFunc()
+}
+
+// ByWatermark orders the results by the watermark field.
+func ByWatermark(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWatermark, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
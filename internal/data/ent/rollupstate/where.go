@@ -0,0 +1,275 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rollupstate
+
+import (
+	"teslatrack/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldLTE(FieldID, id))
+}
+
+// VehicleID applies equality check predicate on the "vehicle_id" field. It's identical to VehicleIDEQ.
+func VehicleID(v int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// TimeZone applies equality check predicate on the "time_zone" field. It's identical to TimeZoneEQ.
+func TimeZone(v string) predicate.RollupState {
+	return predicate.RollupState(sql.FieldEQ(FieldTimeZone, v))
+}
+
+// Watermark applies equality check predicate on the "watermark" field. It's identical to WatermarkEQ.
+func Watermark(v time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldEQ(FieldWatermark, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// VehicleIDEQ applies the EQ predicate on the "vehicle_id" field.
+func VehicleIDEQ(v int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// VehicleIDNEQ applies the NEQ predicate on the "vehicle_id" field.
+func VehicleIDNEQ(v int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldNEQ(FieldVehicleID, v))
+}
+
+// VehicleIDIn applies the In predicate on the "vehicle_id" field.
+func VehicleIDIn(vs ...int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldIn(FieldVehicleID, vs...))
+}
+
+// VehicleIDNotIn applies the NotIn predicate on the "vehicle_id" field.
+func VehicleIDNotIn(vs ...int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldNotIn(FieldVehicleID, vs...))
+}
+
+// VehicleIDGT applies the GT predicate on the "vehicle_id" field.
+func VehicleIDGT(v int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldGT(FieldVehicleID, v))
+}
+
+// VehicleIDGTE applies the GTE predicate on the "vehicle_id" field.
+func VehicleIDGTE(v int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldGTE(FieldVehicleID, v))
+}
+
+// VehicleIDLT applies the LT predicate on the "vehicle_id" field.
+func VehicleIDLT(v int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldLT(FieldVehicleID, v))
+}
+
+// VehicleIDLTE applies the LTE predicate on the "vehicle_id" field.
+func VehicleIDLTE(v int) predicate.RollupState {
+	return predicate.RollupState(sql.FieldLTE(FieldVehicleID, v))
+}
+
+// TimeZoneEQ applies the EQ predicate on the "time_zone" field.
+func TimeZoneEQ(v string) predicate.RollupState {
+	return predicate.RollupState(sql.FieldEQ(FieldTimeZone, v))
+}
+
+// TimeZoneNEQ applies the NEQ predicate on the "time_zone" field.
+func TimeZoneNEQ(v string) predicate.RollupState {
+	return predicate.RollupState(sql.FieldNEQ(FieldTimeZone, v))
+}
+
+// TimeZoneIn applies the In predicate on the "time_zone" field.
+func TimeZoneIn(vs ...string) predicate.RollupState {
+	return predicate.RollupState(sql.FieldIn(FieldTimeZone, vs...))
+}
+
+// TimeZoneNotIn applies the NotIn predicate on the "time_zone" field.
+func TimeZoneNotIn(vs ...string) predicate.RollupState {
+	return predicate.RollupState(sql.FieldNotIn(FieldTimeZone, vs...))
+}
+
+// TimeZoneGT applies the GT predicate on the "time_zone" field.
+func TimeZoneGT(v string) predicate.RollupState {
+	return predicate.RollupState(sql.FieldGT(FieldTimeZone, v))
+}
+
+// TimeZoneGTE applies the GTE predicate on the "time_zone" field.
+func TimeZoneGTE(v string) predicate.RollupState {
+	return predicate.RollupState(sql.FieldGTE(FieldTimeZone, v))
+}
+
+// TimeZoneLT applies the LT predicate on the "time_zone" field.
+func TimeZoneLT(v string) predicate.RollupState {
+	return predicate.RollupState(sql.FieldLT(FieldTimeZone, v))
+}
+
+// TimeZoneLTE applies the LTE predicate on the "time_zone" field.
+func TimeZoneLTE(v string) predicate.RollupState {
+	return predicate.RollupState(sql.FieldLTE(FieldTimeZone, v))
+}
+
+// TimeZoneContains applies the Contains predicate on the "time_zone" field.
+func TimeZoneContains(v string) predicate.RollupState {
+	return predicate.RollupState(sql.FieldContains(FieldTimeZone, v))
+}
+
+// TimeZoneHasPrefix applies the HasPrefix predicate on the "time_zone" field.
+func TimeZoneHasPrefix(v string) predicate.RollupState {
+	return predicate.RollupState(sql.FieldHasPrefix(FieldTimeZone, v))
+}
+
+// TimeZoneHasSuffix applies the HasSuffix predicate on the "time_zone" field.
+func TimeZoneHasSuffix(v string) predicate.RollupState {
+	return predicate.RollupState(sql.FieldHasSuffix(FieldTimeZone, v))
+}
+
+// TimeZoneEqualFold applies the EqualFold predicate on the "time_zone" field.
+func TimeZoneEqualFold(v string) predicate.RollupState {
+	return predicate.RollupState(sql.FieldEqualFold(FieldTimeZone, v))
+}
+
+// TimeZoneContainsFold applies the ContainsFold predicate on the "time_zone" field.
+func TimeZoneContainsFold(v string) predicate.RollupState {
+	return predicate.RollupState(sql.FieldContainsFold(FieldTimeZone, v))
+}
+
+// WatermarkEQ applies the EQ predicate on the "watermark" field.
+func WatermarkEQ(v time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldEQ(FieldWatermark, v))
+}
+
+// WatermarkNEQ applies the NEQ predicate on the "watermark" field.
+func WatermarkNEQ(v time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldNEQ(FieldWatermark, v))
+}
+
+// WatermarkIn applies the In predicate on the "watermark" field.
+func WatermarkIn(vs ...time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldIn(FieldWatermark, vs...))
+}
+
+// WatermarkNotIn applies the NotIn predicate on the "watermark" field.
+func WatermarkNotIn(vs ...time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldNotIn(FieldWatermark, vs...))
+}
+
+// WatermarkGT applies the GT predicate on the "watermark" field.
+func WatermarkGT(v time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldGT(FieldWatermark, v))
+}
+
+// WatermarkGTE applies the GTE predicate on the "watermark" field.
+func WatermarkGTE(v time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldGTE(FieldWatermark, v))
+}
+
+// WatermarkLT applies the LT predicate on the "watermark" field.
+func WatermarkLT(v time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldLT(FieldWatermark, v))
+}
+
+// WatermarkLTE applies the LTE predicate on the "watermark" field.
+func WatermarkLTE(v time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldLTE(FieldWatermark, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RollupState {
+	return predicate.RollupState(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RollupState) predicate.RollupState {
+	return predicate.RollupState(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RollupState) predicate.RollupState {
+	return predicate.RollupState(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RollupState) predicate.RollupState {
+	return predicate.RollupState(sql.NotPredicates(p))
+}
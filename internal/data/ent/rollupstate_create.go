@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/rollupstate"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RollupStateCreate is the builder for creating a RollupState entity.
+type RollupStateCreate struct {
+	config
+	mutation *RollupStateMutation
+	hooks    []Hook
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_c *RollupStateCreate) SetVehicleID(v int) *RollupStateCreate {
+	_c.mutation.SetVehicleID(v)
+	return _c
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_c *RollupStateCreate) SetTimeZone(v string) *RollupStateCreate {
+	_c.mutation.SetTimeZone(v)
+	return _c
+}
+
+// SetWatermark sets the "watermark" field.
+func (_c *RollupStateCreate) SetWatermark(v time.Time) *RollupStateCreate {
+	_c.mutation.SetWatermark(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RollupStateCreate) SetUpdatedAt(v time.Time) *RollupStateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RollupStateCreate) SetNillableUpdatedAt(v *time.Time) *RollupStateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RollupStateMutation object of the builder.
+func (_c *RollupStateCreate) Mutation() *RollupStateMutation {
+	return _c.mutation
+}
+
+// Save creates the RollupState in the database.
+func (_c *RollupStateCreate) Save(ctx context.Context) (*RollupState, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RollupStateCreate) SaveX(ctx context.Context) *RollupState {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RollupStateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RollupStateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RollupStateCreate) defaults() {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := rollupstate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RollupStateCreate) check() error {
+	if _, ok := _c.mutation.VehicleID(); !ok {
+		return &ValidationError{Name: "vehicle_id", err: errors.New(`ent: missing required field "RollupState.vehicle_id"`)}
+	}
+	if _, ok := _c.mutation.TimeZone(); !ok {
+		return &ValidationError{Name: "time_zone", err: errors.New(`ent: missing required field "RollupState.time_zone"`)}
+	}
+	if _, ok := _c.mutation.Watermark(); !ok {
+		return &ValidationError{Name: "watermark", err: errors.New(`ent: missing required field "RollupState.watermark"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RollupState.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *RollupStateCreate) sqlSave(ctx context.Context) (*RollupState, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RollupStateCreate) createSpec() (*RollupState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RollupState{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(rollupstate.Table, sqlgraph.NewFieldSpec(rollupstate.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.VehicleID(); ok {
+		_spec.SetField(rollupstate.FieldVehicleID, field.TypeInt, value)
+		_node.VehicleID = value
+	}
+	if value, ok := _c.mutation.TimeZone(); ok {
+		_spec.SetField(rollupstate.FieldTimeZone, field.TypeString, value)
+		_node.TimeZone = value
+	}
+	if value, ok := _c.mutation.Watermark(); ok {
+		_spec.SetField(rollupstate.FieldWatermark, field.TypeTime, value)
+		_node.Watermark = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(rollupstate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// RollupStateCreateBulk is the builder for creating many RollupState entities in bulk.
+type RollupStateCreateBulk struct {
+	config
+	err      error
+	builders []*RollupStateCreate
+}
+
+// Save creates the RollupState entities in the database.
+func (_c *RollupStateCreateBulk) Save(ctx context.Context) ([]*RollupState, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RollupState, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RollupStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RollupStateCreateBulk) SaveX(ctx context.Context) []*RollupState {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RollupStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RollupStateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,334 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/predicate"
+	"teslatrack/internal/data/ent/rollupstate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RollupStateUpdate is the builder for updating RollupState entities.
+type RollupStateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RollupStateMutation
+}
+
+// Where appends a list predicates to the RollupStateUpdate builder.
+func (_u *RollupStateUpdate) Where(ps ...predicate.RollupState) *RollupStateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_u *RollupStateUpdate) SetVehicleID(v int) *RollupStateUpdate {
+	_u.mutation.ResetVehicleID()
+	_u.mutation.SetVehicleID(v)
+	return _u
+}
+
+// SetNillableVehicleID sets the "vehicle_id" field if the given value is not nil.
+func (_u *RollupStateUpdate) SetNillableVehicleID(v *int) *RollupStateUpdate {
+	if v != nil {
+		_u.SetVehicleID(*v)
+	}
+	return _u
+}
+
+// AddVehicleID adds value to the "vehicle_id" field.
+func (_u *RollupStateUpdate) AddVehicleID(v int) *RollupStateUpdate {
+	_u.mutation.AddVehicleID(v)
+	return _u
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_u *RollupStateUpdate) SetTimeZone(v string) *RollupStateUpdate {
+	_u.mutation.SetTimeZone(v)
+	return _u
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_u *RollupStateUpdate) SetNillableTimeZone(v *string) *RollupStateUpdate {
+	if v != nil {
+		_u.SetTimeZone(*v)
+	}
+	return _u
+}
+
+// SetWatermark sets the "watermark" field.
+func (_u *RollupStateUpdate) SetWatermark(v time.Time) *RollupStateUpdate {
+	_u.mutation.SetWatermark(v)
+	return _u
+}
+
+// SetNillableWatermark sets the "watermark" field if the given value is not nil.
+func (_u *RollupStateUpdate) SetNillableWatermark(v *time.Time) *RollupStateUpdate {
+	if v != nil {
+		_u.SetWatermark(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RollupStateUpdate) SetUpdatedAt(v time.Time) *RollupStateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the RollupStateMutation object of the builder.
+func (_u *RollupStateUpdate) Mutation() *RollupStateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RollupStateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RollupStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RollupStateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RollupStateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RollupStateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := rollupstate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *RollupStateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(rollupstate.Table, rollupstate.Columns, sqlgraph.NewFieldSpec(rollupstate.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VehicleID(); ok {
+		_spec.SetField(rollupstate.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVehicleID(); ok {
+		_spec.AddField(rollupstate.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TimeZone(); ok {
+		_spec.SetField(rollupstate.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Watermark(); ok {
+		_spec.SetField(rollupstate.FieldWatermark, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(rollupstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rollupstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RollupStateUpdateOne is the builder for updating a single RollupState entity.
+type RollupStateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RollupStateMutation
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_u *RollupStateUpdateOne) SetVehicleID(v int) *RollupStateUpdateOne {
+	_u.mutation.ResetVehicleID()
+	_u.mutation.SetVehicleID(v)
+	return _u
+}
+
+// SetNillableVehicleID sets the "vehicle_id" field if the given value is not nil.
+func (_u *RollupStateUpdateOne) SetNillableVehicleID(v *int) *RollupStateUpdateOne {
+	if v != nil {
+		_u.SetVehicleID(*v)
+	}
+	return _u
+}
+
+// AddVehicleID adds value to the "vehicle_id" field.
+func (_u *RollupStateUpdateOne) AddVehicleID(v int) *RollupStateUpdateOne {
+	_u.mutation.AddVehicleID(v)
+	return _u
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_u *RollupStateUpdateOne) SetTimeZone(v string) *RollupStateUpdateOne {
+	_u.mutation.SetTimeZone(v)
+	return _u
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_u *RollupStateUpdateOne) SetNillableTimeZone(v *string) *RollupStateUpdateOne {
+	if v != nil {
+		_u.SetTimeZone(*v)
+	}
+	return _u
+}
+
+// SetWatermark sets the "watermark" field.
+func (_u *RollupStateUpdateOne) SetWatermark(v time.Time) *RollupStateUpdateOne {
+	_u.mutation.SetWatermark(v)
+	return _u
+}
+
+// SetNillableWatermark sets the "watermark" field if the given value is not nil.
+func (_u *RollupStateUpdateOne) SetNillableWatermark(v *time.Time) *RollupStateUpdateOne {
+	if v != nil {
+		_u.SetWatermark(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RollupStateUpdateOne) SetUpdatedAt(v time.Time) *RollupStateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the RollupStateMutation object of the builder.
+func (_u *RollupStateUpdateOne) Mutation() *RollupStateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RollupStateUpdate builder.
+func (_u *RollupStateUpdateOne) Where(ps ...predicate.RollupState) *RollupStateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RollupStateUpdateOne) Select(field string, fields ...string) *RollupStateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RollupState entity.
+func (_u *RollupStateUpdateOne) Save(ctx context.Context) (*RollupState, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RollupStateUpdateOne) SaveX(ctx context.Context) *RollupState {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RollupStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RollupStateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RollupStateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := rollupstate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *RollupStateUpdateOne) sqlSave(ctx context.Context) (_node *RollupState, err error) {
+	_spec := sqlgraph.NewUpdateSpec(rollupstate.Table, rollupstate.Columns, sqlgraph.NewFieldSpec(rollupstate.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RollupState.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rollupstate.FieldID)
+		for _, f := range fields {
+			if !rollupstate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rollupstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VehicleID(); ok {
+		_spec.SetField(rollupstate.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVehicleID(); ok {
+		_spec.AddField(rollupstate.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TimeZone(); ok {
+		_spec.SetField(rollupstate.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Watermark(); ok {
+		_spec.SetField(rollupstate.FieldWatermark, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(rollupstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &RollupState{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rollupstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,12 +3,22 @@
 package ent
 
 import (
+	"teslatrack/internal/data/ent/address"
 	"teslatrack/internal/data/ent/authorize"
 	"teslatrack/internal/data/ent/authorizetoken"
+	"teslatrack/internal/data/ent/geofence"
 	"teslatrack/internal/data/ent/partner"
+	"teslatrack/internal/data/ent/rollupstate"
 	"teslatrack/internal/data/ent/schema"
+	"teslatrack/internal/data/ent/softwareupdate"
+	"teslatrack/internal/data/ent/tariff"
+	"teslatrack/internal/data/ent/tirepressure"
 	"teslatrack/internal/data/ent/user"
 	"teslatrack/internal/data/ent/vehicle"
+	"teslatrack/internal/data/ent/vehicleevent"
+	"teslatrack/internal/data/ent/vehiclerollup"
+	"teslatrack/internal/data/ent/vehiclesnapshot"
+	"teslatrack/internal/data/ent/vehiclestateperiod"
 	"time"
 )
 
@@ -16,6 +26,12 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	addressFields := schema.Address{}.Fields()
+	_ = addressFields
+	// addressDescCreatedAt is the schema descriptor for created_at field.
+	addressDescCreatedAt := addressFields[10].Descriptor()
+	// address.DefaultCreatedAt holds the default value on creation for the created_at field.
+	address.DefaultCreatedAt = addressDescCreatedAt.Default.(func() time.Time)
 	authorizeFields := schema.Authorize{}.Fields()
 	_ = authorizeFields
 	// authorizeDescCreatedAt is the schema descriptor for created_at field.
@@ -35,17 +51,33 @@ func init() {
 	authorizetokenFields := schema.AuthorizeToken{}.Fields()
 	_ = authorizetokenFields
 	// authorizetokenDescCreatedAt is the schema descriptor for created_at field.
-	authorizetokenDescCreatedAt := authorizetokenFields[6].Descriptor()
+	authorizetokenDescCreatedAt := authorizetokenFields[7].Descriptor()
 	// authorizetoken.DefaultCreatedAt holds the default value on creation for the created_at field.
 	authorizetoken.DefaultCreatedAt = authorizetokenDescCreatedAt.Default.(func() time.Time)
 	// authorizetokenDescUpdatedAt is the schema descriptor for updated_at field.
-	authorizetokenDescUpdatedAt := authorizetokenFields[7].Descriptor()
+	authorizetokenDescUpdatedAt := authorizetokenFields[8].Descriptor()
 	// authorizetoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	authorizetoken.DefaultUpdatedAt = authorizetokenDescUpdatedAt.Default.(func() time.Time)
 	// authorizetokenDescDeleted is the schema descriptor for deleted field.
-	authorizetokenDescDeleted := authorizetokenFields[8].Descriptor()
+	authorizetokenDescDeleted := authorizetokenFields[9].Descriptor()
 	// authorizetoken.DefaultDeleted holds the default value on creation for the deleted field.
 	authorizetoken.DefaultDeleted = authorizetokenDescDeleted.Default.(bool)
+	geofenceFields := schema.Geofence{}.Fields()
+	_ = geofenceFields
+	// geofenceDescCategory is the schema descriptor for category field.
+	geofenceDescCategory := geofenceFields[2].Descriptor()
+	// geofence.DefaultCategory holds the default value on creation for the category field.
+	geofence.DefaultCategory = geofenceDescCategory.Default.(string)
+	// geofenceDescCreatedAt is the schema descriptor for created_at field.
+	geofenceDescCreatedAt := geofenceFields[8].Descriptor()
+	// geofence.DefaultCreatedAt holds the default value on creation for the created_at field.
+	geofence.DefaultCreatedAt = geofenceDescCreatedAt.Default.(func() time.Time)
+	// geofenceDescUpdatedAt is the schema descriptor for updated_at field.
+	geofenceDescUpdatedAt := geofenceFields[9].Descriptor()
+	// geofence.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	geofence.DefaultUpdatedAt = geofenceDescUpdatedAt.Default.(func() time.Time)
+	// geofence.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	geofence.UpdateDefaultUpdatedAt = geofenceDescUpdatedAt.UpdateDefault.(func() time.Time)
 	partnerFields := schema.Partner{}.Fields()
 	_ = partnerFields
 	// partnerDescTokenType is the schema descriptor for token_type field.
@@ -66,24 +98,98 @@ func init() {
 	partnerDescDeleted := partnerFields[6].Descriptor()
 	// partner.DefaultDeleted holds the default value on creation for the deleted field.
 	partner.DefaultDeleted = partnerDescDeleted.Default.(bool)
+	rollupstateFields := schema.RollupState{}.Fields()
+	_ = rollupstateFields
+	// rollupstateDescUpdatedAt is the schema descriptor for updated_at field.
+	rollupstateDescUpdatedAt := rollupstateFields[3].Descriptor()
+	// rollupstate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rollupstate.DefaultUpdatedAt = rollupstateDescUpdatedAt.Default.(func() time.Time)
+	// rollupstate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rollupstate.UpdateDefaultUpdatedAt = rollupstateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	softwareupdateFields := schema.SoftwareUpdate{}.Fields()
+	_ = softwareupdateFields
+	// softwareupdateDescDownloadPerc is the schema descriptor for download_perc field.
+	softwareupdateDescDownloadPerc := softwareupdateFields[4].Descriptor()
+	// softwareupdate.DefaultDownloadPerc holds the default value on creation for the download_perc field.
+	softwareupdate.DefaultDownloadPerc = softwareupdateDescDownloadPerc.Default.(int)
+	// softwareupdateDescInstallPerc is the schema descriptor for install_perc field.
+	softwareupdateDescInstallPerc := softwareupdateFields[5].Descriptor()
+	// softwareupdate.DefaultInstallPerc holds the default value on creation for the install_perc field.
+	softwareupdate.DefaultInstallPerc = softwareupdateDescInstallPerc.Default.(int)
+	// softwareupdateDescExpectedDuration is the schema descriptor for expected_duration field.
+	softwareupdateDescExpectedDuration := softwareupdateFields[6].Descriptor()
+	// softwareupdate.DefaultExpectedDuration holds the default value on creation for the expected_duration field.
+	softwareupdate.DefaultExpectedDuration = softwareupdateDescExpectedDuration.Default.(int)
+	// softwareupdateDescCreatedAt is the schema descriptor for created_at field.
+	softwareupdateDescCreatedAt := softwareupdateFields[10].Descriptor()
+	// softwareupdate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	softwareupdate.DefaultCreatedAt = softwareupdateDescCreatedAt.Default.(func() time.Time)
+	// softwareupdateDescUpdatedAt is the schema descriptor for updated_at field.
+	softwareupdateDescUpdatedAt := softwareupdateFields[11].Descriptor()
+	// softwareupdate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	softwareupdate.DefaultUpdatedAt = softwareupdateDescUpdatedAt.Default.(func() time.Time)
+	// softwareupdate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	softwareupdate.UpdateDefaultUpdatedAt = softwareupdateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	tariffFields := schema.Tariff{}.Fields()
+	_ = tariffFields
+	// tariffDescCurrency is the schema descriptor for currency field.
+	tariffDescCurrency := tariffFields[4].Descriptor()
+	// tariff.DefaultCurrency holds the default value on creation for the currency field.
+	tariff.DefaultCurrency = tariffDescCurrency.Default.(string)
+	// tariffDescPrice is the schema descriptor for price field.
+	tariffDescPrice := tariffFields[5].Descriptor()
+	// tariff.DefaultPrice holds the default value on creation for the price field.
+	tariff.DefaultPrice = tariffDescPrice.Default.(float64)
+	// tariffDescTimeZone is the schema descriptor for time_zone field.
+	tariffDescTimeZone := tariffFields[7].Descriptor()
+	// tariff.DefaultTimeZone holds the default value on creation for the time_zone field.
+	tariff.DefaultTimeZone = tariffDescTimeZone.Default.(string)
+	// tariffDescCreatedAt is the schema descriptor for created_at field.
+	tariffDescCreatedAt := tariffFields[8].Descriptor()
+	// tariff.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tariff.DefaultCreatedAt = tariffDescCreatedAt.Default.(func() time.Time)
+	// tariffDescUpdatedAt is the schema descriptor for updated_at field.
+	tariffDescUpdatedAt := tariffFields[9].Descriptor()
+	// tariff.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tariff.DefaultUpdatedAt = tariffDescUpdatedAt.Default.(func() time.Time)
+	// tariff.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tariff.UpdateDefaultUpdatedAt = tariffDescUpdatedAt.UpdateDefault.(func() time.Time)
+	tirepressureFields := schema.TirePressure{}.Fields()
+	_ = tirepressureFields
+	// tirepressureDescSoftWarnings is the schema descriptor for soft_warnings field.
+	tirepressureDescSoftWarnings := tirepressureFields[11].Descriptor()
+	// tirepressure.DefaultSoftWarnings holds the default value on creation for the soft_warnings field.
+	tirepressure.DefaultSoftWarnings = tirepressureDescSoftWarnings.Default.(int)
+	// tirepressureDescHardWarnings is the schema descriptor for hard_warnings field.
+	tirepressureDescHardWarnings := tirepressureFields[12].Descriptor()
+	// tirepressure.DefaultHardWarnings holds the default value on creation for the hard_warnings field.
+	tirepressure.DefaultHardWarnings = tirepressureDescHardWarnings.Default.(int)
+	// tirepressureDescCreatedAt is the schema descriptor for created_at field.
+	tirepressureDescCreatedAt := tirepressureFields[14].Descriptor()
+	// tirepressure.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tirepressure.DefaultCreatedAt = tirepressureDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescGender is the schema descriptor for gender field.
 	userDescGender := userFields[7].Descriptor()
 	// user.DefaultGender holds the default value on creation for the gender field.
 	user.DefaultGender = userDescGender.Default.(int8)
+	// userDescTimeZone is the schema descriptor for time_zone field.
+	userDescTimeZone := userFields[10].Descriptor()
+	// user.DefaultTimeZone holds the default value on creation for the time_zone field.
+	user.DefaultTimeZone = userDescTimeZone.Default.(string)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[10].Descriptor()
+	userDescCreatedAt := userFields[11].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[11].Descriptor()
+	userDescUpdatedAt := userFields[12].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// userDescDeleted is the schema descriptor for deleted field.
-	userDescDeleted := userFields[12].Descriptor()
+	userDescDeleted := userFields[13].Descriptor()
 	// user.DefaultDeleted holds the default value on creation for the deleted field.
 	user.DefaultDeleted = userDescDeleted.Default.(bool)
 	vehicleFields := schema.Vehicle{}.Fields()
@@ -114,4 +220,120 @@ func init() {
 	vehicleDescDeleted := vehicleFields[12].Descriptor()
 	// vehicle.DefaultDeleted holds the default value on creation for the deleted field.
 	vehicle.DefaultDeleted = vehicleDescDeleted.Default.(bool)
+	vehicleeventFields := schema.VehicleEvent{}.Fields()
+	_ = vehicleeventFields
+	// vehicleeventDescCreatedAt is the schema descriptor for created_at field.
+	vehicleeventDescCreatedAt := vehicleeventFields[6].Descriptor()
+	// vehicleevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	vehicleevent.DefaultCreatedAt = vehicleeventDescCreatedAt.Default.(func() time.Time)
+	vehiclerollupFields := schema.VehicleRollup{}.Fields()
+	_ = vehiclerollupFields
+	// vehiclerollupDescDrives is the schema descriptor for drives field.
+	vehiclerollupDescDrives := vehiclerollupFields[5].Descriptor()
+	// vehiclerollup.DefaultDrives holds the default value on creation for the drives field.
+	vehiclerollup.DefaultDrives = vehiclerollupDescDrives.Default.(int)
+	// vehiclerollupDescDistance is the schema descriptor for distance field.
+	vehiclerollupDescDistance := vehiclerollupFields[6].Descriptor()
+	// vehiclerollup.DefaultDistance holds the default value on creation for the distance field.
+	vehiclerollup.DefaultDistance = vehiclerollupDescDistance.Default.(float64)
+	// vehiclerollupDescDriveSeconds is the schema descriptor for drive_seconds field.
+	vehiclerollupDescDriveSeconds := vehiclerollupFields[7].Descriptor()
+	// vehiclerollup.DefaultDriveSeconds holds the default value on creation for the drive_seconds field.
+	vehiclerollup.DefaultDriveSeconds = vehiclerollupDescDriveSeconds.Default.(int)
+	// vehiclerollupDescEnergyUsed is the schema descriptor for energy_used field.
+	vehiclerollupDescEnergyUsed := vehiclerollupFields[8].Descriptor()
+	// vehiclerollup.DefaultEnergyUsed holds the default value on creation for the energy_used field.
+	vehiclerollup.DefaultEnergyUsed = vehiclerollupDescEnergyUsed.Default.(float64)
+	// vehiclerollupDescEfficiency is the schema descriptor for efficiency field.
+	vehiclerollupDescEfficiency := vehiclerollupFields[9].Descriptor()
+	// vehiclerollup.DefaultEfficiency holds the default value on creation for the efficiency field.
+	vehiclerollup.DefaultEfficiency = vehiclerollupDescEfficiency.Default.(float64)
+	// vehiclerollupDescMaxSpeed is the schema descriptor for max_speed field.
+	vehiclerollupDescMaxSpeed := vehiclerollupFields[10].Descriptor()
+	// vehiclerollup.DefaultMaxSpeed holds the default value on creation for the max_speed field.
+	vehiclerollup.DefaultMaxSpeed = vehiclerollupDescMaxSpeed.Default.(float64)
+	// vehiclerollupDescCharges is the schema descriptor for charges field.
+	vehiclerollupDescCharges := vehiclerollupFields[11].Descriptor()
+	// vehiclerollup.DefaultCharges holds the default value on creation for the charges field.
+	vehiclerollup.DefaultCharges = vehiclerollupDescCharges.Default.(int)
+	// vehiclerollupDescEnergyAdded is the schema descriptor for energy_added field.
+	vehiclerollupDescEnergyAdded := vehiclerollupFields[12].Descriptor()
+	// vehiclerollup.DefaultEnergyAdded holds the default value on creation for the energy_added field.
+	vehiclerollup.DefaultEnergyAdded = vehiclerollupDescEnergyAdded.Default.(float64)
+	// vehiclerollupDescChargeCost is the schema descriptor for charge_cost field.
+	vehiclerollupDescChargeCost := vehiclerollupFields[13].Descriptor()
+	// vehiclerollup.DefaultChargeCost holds the default value on creation for the charge_cost field.
+	vehiclerollup.DefaultChargeCost = vehiclerollupDescChargeCost.Default.(float64)
+	// vehiclerollupDescParkedSeconds is the schema descriptor for parked_seconds field.
+	vehiclerollupDescParkedSeconds := vehiclerollupFields[14].Descriptor()
+	// vehiclerollup.DefaultParkedSeconds holds the default value on creation for the parked_seconds field.
+	vehiclerollup.DefaultParkedSeconds = vehiclerollupDescParkedSeconds.Default.(int)
+	// vehiclerollupDescAsleepSeconds is the schema descriptor for asleep_seconds field.
+	vehiclerollupDescAsleepSeconds := vehiclerollupFields[15].Descriptor()
+	// vehiclerollup.DefaultAsleepSeconds holds the default value on creation for the asleep_seconds field.
+	vehiclerollup.DefaultAsleepSeconds = vehiclerollupDescAsleepSeconds.Default.(int)
+	// vehiclerollupDescUpdatedAt is the schema descriptor for updated_at field.
+	vehiclerollupDescUpdatedAt := vehiclerollupFields[16].Descriptor()
+	// vehiclerollup.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	vehiclerollup.DefaultUpdatedAt = vehiclerollupDescUpdatedAt.Default.(func() time.Time)
+	// vehiclerollup.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	vehiclerollup.UpdateDefaultUpdatedAt = vehiclerollupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	vehiclesnapshotFields := schema.VehicleSnapshot{}.Fields()
+	_ = vehiclesnapshotFields
+	// vehiclesnapshotDescFastChargerPresent is the schema descriptor for fast_charger_present field.
+	vehiclesnapshotDescFastChargerPresent := vehiclesnapshotFields[19].Descriptor()
+	// vehiclesnapshot.DefaultFastChargerPresent holds the default value on creation for the fast_charger_present field.
+	vehiclesnapshot.DefaultFastChargerPresent = vehiclesnapshotDescFastChargerPresent.Default.(bool)
+	// vehiclesnapshotDescBatteryHeaterOn is the schema descriptor for battery_heater_on field.
+	vehiclesnapshotDescBatteryHeaterOn := vehiclesnapshotFields[21].Descriptor()
+	// vehiclesnapshot.DefaultBatteryHeaterOn holds the default value on creation for the battery_heater_on field.
+	vehiclesnapshot.DefaultBatteryHeaterOn = vehiclesnapshotDescBatteryHeaterOn.Default.(bool)
+	// vehiclesnapshotDescClimateOn is the schema descriptor for climate_on field.
+	vehiclesnapshotDescClimateOn := vehiclesnapshotFields[24].Descriptor()
+	// vehiclesnapshot.DefaultClimateOn holds the default value on creation for the climate_on field.
+	vehiclesnapshot.DefaultClimateOn = vehiclesnapshotDescClimateOn.Default.(bool)
+	// vehiclesnapshotDescCabinOverheatCooling is the schema descriptor for cabin_overheat_cooling field.
+	vehiclesnapshotDescCabinOverheatCooling := vehiclesnapshotFields[26].Descriptor()
+	// vehiclesnapshot.DefaultCabinOverheatCooling holds the default value on creation for the cabin_overheat_cooling field.
+	vehiclesnapshot.DefaultCabinOverheatCooling = vehiclesnapshotDescCabinOverheatCooling.Default.(bool)
+	// vehiclesnapshotDescSentryMode is the schema descriptor for sentry_mode field.
+	vehiclesnapshotDescSentryMode := vehiclesnapshotFields[27].Descriptor()
+	// vehiclesnapshot.DefaultSentryMode holds the default value on creation for the sentry_mode field.
+	vehiclesnapshot.DefaultSentryMode = vehiclesnapshotDescSentryMode.Default.(bool)
+	// vehiclesnapshotDescLocked is the schema descriptor for locked field.
+	vehiclesnapshotDescLocked := vehiclesnapshotFields[28].Descriptor()
+	// vehiclesnapshot.DefaultLocked holds the default value on creation for the locked field.
+	vehiclesnapshot.DefaultLocked = vehiclesnapshotDescLocked.Default.(bool)
+	// vehiclesnapshotDescCreatedAt is the schema descriptor for created_at field.
+	vehiclesnapshotDescCreatedAt := vehiclesnapshotFields[36].Descriptor()
+	// vehiclesnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	vehiclesnapshot.DefaultCreatedAt = vehiclesnapshotDescCreatedAt.Default.(func() time.Time)
+	vehiclestateperiodFields := schema.VehicleStatePeriod{}.Fields()
+	_ = vehiclestateperiodFields
+	// vehiclestateperiodDescSentryMode is the schema descriptor for sentry_mode field.
+	vehiclestateperiodDescSentryMode := vehiclestateperiodFields[2].Descriptor()
+	// vehiclestateperiod.DefaultSentryMode holds the default value on creation for the sentry_mode field.
+	vehiclestateperiod.DefaultSentryMode = vehiclestateperiodDescSentryMode.Default.(bool)
+	// vehiclestateperiodDescClimateOn is the schema descriptor for climate_on field.
+	vehiclestateperiodDescClimateOn := vehiclestateperiodFields[3].Descriptor()
+	// vehiclestateperiod.DefaultClimateOn holds the default value on creation for the climate_on field.
+	vehiclestateperiod.DefaultClimateOn = vehiclestateperiodDescClimateOn.Default.(bool)
+	// vehiclestateperiodDescFastCharger is the schema descriptor for fast_charger field.
+	vehiclestateperiodDescFastCharger := vehiclestateperiodFields[21].Descriptor()
+	// vehiclestateperiod.DefaultFastCharger holds the default value on creation for the fast_charger field.
+	vehiclestateperiod.DefaultFastCharger = vehiclestateperiodDescFastCharger.Default.(bool)
+	// vehiclestateperiodDescCostManual is the schema descriptor for cost_manual field.
+	vehiclestateperiodDescCostManual := vehiclestateperiodFields[30].Descriptor()
+	// vehiclestateperiod.DefaultCostManual holds the default value on creation for the cost_manual field.
+	vehiclestateperiod.DefaultCostManual = vehiclestateperiodDescCostManual.Default.(bool)
+	// vehiclestateperiodDescCreatedAt is the schema descriptor for created_at field.
+	vehiclestateperiodDescCreatedAt := vehiclestateperiodFields[34].Descriptor()
+	// vehiclestateperiod.DefaultCreatedAt holds the default value on creation for the created_at field.
+	vehiclestateperiod.DefaultCreatedAt = vehiclestateperiodDescCreatedAt.Default.(func() time.Time)
+	// vehiclestateperiodDescUpdatedAt is the schema descriptor for updated_at field.
+	vehiclestateperiodDescUpdatedAt := vehiclestateperiodFields[35].Descriptor()
+	// vehiclestateperiod.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	vehiclestateperiod.DefaultUpdatedAt = vehiclestateperiodDescUpdatedAt.Default.(func() time.Time)
+	// vehiclestateperiod.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	vehiclestateperiod.UpdateDefaultUpdatedAt = vehiclestateperiodDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Address holds the schema definition for the Address entity.
+// Addresses cache reverse geocoding results keyed by rounded coordinates.
+type Address struct {
+	ent.Schema
+}
+
+// Fields of the Address.
+func (Address) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("grid_latitude").Comment("WGS-84 latitude rounded to 4 decimals, times 10^4"),
+		field.Int("grid_longitude").Comment("WGS-84 longitude rounded to 4 decimals, times 10^4"),
+		field.String("name").Optional().Comment("Place name"),
+		field.String("road").Optional().Comment("Road and house number"),
+		field.String("district").Optional().Comment("District or suburb"),
+		field.String("city").Optional().Comment("City, town or village"),
+		field.String("province").Optional().Comment("Province or state"),
+		field.String("country").Optional().Comment("Country"),
+		field.String("formatted").Optional().Comment("Full formatted address"),
+		field.String("provider").Optional().Comment("Geocoder that resolved the address, e.g., offline, nominatim, amap"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time"),
+	}
+}
+
+// Edges of the Address.
+func (Address) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Address.
+func (Address) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("grid_latitude", "grid_longitude").Unique(),
+	}
+}
+
+// Annotations of the Address.
+func (Address) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "address"},
+		schema.Comment("Reverse geocoded address cache table"),
+	}
+}
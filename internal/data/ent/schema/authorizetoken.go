@@ -19,17 +19,19 @@ type AuthorizeToken struct {
 func (AuthorizeToken) Fields() []ent.Field {
 	return []ent.Field{
 		// The authorization code received from Tesla's OAuth 2.0 flow.
-		field.String("tesla_code"),
+		field.Text("tesla_code"),
 		// The client ID for your application registered with Tesla.
 		field.String("client_id"),
 		// The client secret for your application registered with Tesla.
 		field.String("client_secret"),
 		// The access token used to make authenticated requests to the Tesla API.
-		field.String("access_token"),
+		field.Text("access_token"),
 		// The refresh token used to obtain a new access token when the current one expires.
-		field.String("refresh_token"),
+		field.Text("refresh_token"),
 		// The scope of permissions granted by the access token (e.g., "vehicle_data").
 		field.String("scope"),
+		// The ID of the user who granted the token, used to assign collected vehicles.
+		field.Int("user_id").Optional(),
 		// The time the token record was created.
 		field.Time("created_at").Default(time.Now),
 		// The time the token record was last updated.
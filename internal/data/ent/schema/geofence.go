@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"teslatrack/pkg/geo"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Geofence holds the schema definition for the Geofence entity.
+type Geofence struct {
+	ent.Schema
+}
+
+// Fields of the Geofence.
+func (Geofence) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").Comment("Owner user ID"),
+		field.String("name").Comment("Display name"),
+		field.String("category").Default("other").Comment("Category, e.g., home, work, charger, other"),
+		field.String("shape").Comment("Shape, circle or polygon"),
+		field.Float("latitude").Optional().Comment("WGS-84 latitude of the circle center"),
+		field.Float("longitude").Optional().Comment("WGS-84 longitude of the circle center"),
+		field.Float("radius").Optional().Comment("Circle radius in metres"),
+		field.JSON("polygon", []geo.Point{}).Optional().Comment("WGS-84 polygon vertices"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("Update time"),
+	}
+}
+
+// Edges of the Geofence.
+func (Geofence) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Geofence.
+func (Geofence) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+// Annotations of the Geofence.
+func (Geofence) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "geofence"},
+		schema.Comment("User defined geofence table"),
+	}
+}
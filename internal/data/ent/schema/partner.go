@@ -18,7 +18,7 @@ type Partner struct {
 func (Partner) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("client_id"),
-		field.String("access_token").
+		field.Text("access_token").
 			Optional(),
 		field.Int("expires_in").
 			Optional(),
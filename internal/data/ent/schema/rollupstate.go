@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// RollupState holds the schema definition for the RollupState entity.
+// It records how far the rollups of a vehicle are up to date.
+type RollupState struct {
+	ent.Schema
+}
+
+// Fields of the RollupState.
+func (RollupState) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("vehicle_id").Unique().Comment("Associated vehicle ID"),
+		field.String("time_zone").Comment("IANA time zone the rollups were computed in"),
+		field.Time("watermark").Comment("Latest update time of the state periods rolled up"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("Update time"),
+	}
+}
+
+// Edges of the RollupState.
+func (RollupState) Edges() []ent.Edge {
+	return nil
+}
+
+// Annotations of the RollupState.
+func (RollupState) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "rollup_state"},
+		schema.Comment("Rollup progress table"),
+	}
+}
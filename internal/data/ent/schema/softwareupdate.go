@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SoftwareUpdate holds the schema definition for the SoftwareUpdate entity.
+// Every software version a vehicle ran or was offered is one row of its update history.
+type SoftwareUpdate struct {
+	ent.Schema
+}
+
+// Fields of the SoftwareUpdate.
+func (SoftwareUpdate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("vehicle_id").Comment("Associated vehicle ID"),
+		field.String("from_version").Optional().Comment("Version installed before the update, empty for the first version seen"),
+		field.String("version").Comment("Target version of the update"),
+		field.String("status").Comment("Status, e.g., available, scheduled, downloading, installing, installed"),
+		field.Int("download_perc").Default(0).Comment("Download progress in percent"),
+		field.Int("install_perc").Default(0).Comment("Install progress in percent"),
+		field.Int("expected_duration").Default(0).Comment("Expected install duration in seconds"),
+		field.Time("available_at").Comment("Time the update was first seen"),
+		field.Time("install_started_at").Optional().Nillable().Comment("Time the installation started"),
+		field.Time("completed_at").Optional().Nillable().Comment("Time the vehicle was first seen running the version"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("Update time"),
+	}
+}
+
+// Edges of the SoftwareUpdate.
+func (SoftwareUpdate) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SoftwareUpdate.
+func (SoftwareUpdate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vehicle_id", "available_at"),
+		index.Fields("vehicle_id", "completed_at"),
+		index.Fields("version"),
+	}
+}
+
+// Annotations of the SoftwareUpdate.
+func (SoftwareUpdate) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "software_update"},
+		schema.Comment("Vehicle software update history table"),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"teslatrack/pkg/pricing"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Tariff holds the schema definition for the Tariff entity.
+type Tariff struct {
+	ent.Schema
+}
+
+// Fields of the Tariff.
+func (Tariff) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").Comment("Owner user ID"),
+		field.String("name").Comment("Display name"),
+		field.String("kind").Comment("Kind, flat or tou (time-of-use)"),
+		field.Int("geofence_id").Optional().Nillable().Comment("Geofence the tariff applies to, null for the default tariff"),
+		field.String("currency").Default("CNY").Comment("ISO-4217 currency code"),
+		field.Float("price").Default(0).Comment("Price per kWh, outside all windows for time-of-use tariffs"),
+		field.JSON("windows", []pricing.Window{}).Optional().Comment("Time-of-use windows"),
+		field.String("time_zone").Default("Asia/Shanghai").Comment("IANA time zone of the windows"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("Update time"),
+	}
+}
+
+// Edges of the Tariff.
+func (Tariff) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Tariff.
+func (Tariff) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
+
+// Annotations of the Tariff.
+func (Tariff) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "tariff"},
+		schema.Comment("Electricity tariff table"),
+	}
+}
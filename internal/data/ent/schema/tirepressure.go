@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TirePressure holds the schema definition for the TirePressure entity.
+// A row is stored whenever the TPMS readings of a vehicle change.
+type TirePressure struct {
+	ent.Schema
+}
+
+// Fields of the TirePressure.
+func (TirePressure) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("vehicle_id").Comment("Associated vehicle ID"),
+		field.Float("pressure_fl").Optional().Comment("Front left pressure in bar, 0 when unknown"),
+		field.Float("pressure_fr").Optional().Comment("Front right pressure in bar, 0 when unknown"),
+		field.Float("pressure_rl").Optional().Comment("Rear left pressure in bar, 0 when unknown"),
+		field.Float("pressure_rr").Optional().Comment("Rear right pressure in bar, 0 when unknown"),
+		field.Time("seen_at_fl").Optional().Nillable().Comment("Time the front left sensor last reported"),
+		field.Time("seen_at_fr").Optional().Nillable().Comment("Time the front right sensor last reported"),
+		field.Time("seen_at_rl").Optional().Nillable().Comment("Time the rear left sensor last reported"),
+		field.Time("seen_at_rr").Optional().Nillable().Comment("Time the rear right sensor last reported"),
+		field.Float("recommended_front").Optional().Comment("Recommended cold pressure of the front tires in bar"),
+		field.Float("recommended_rear").Optional().Comment("Recommended cold pressure of the rear tires in bar"),
+		field.Int("soft_warnings").Default(0).Comment("Soft TPMS warnings, one bit per wheel in the order fl, fr, rl, rr"),
+		field.Int("hard_warnings").Default(0).Comment("Hard TPMS warnings, one bit per wheel in the order fl, fr, rl, rr"),
+		field.String("unit").Optional().Comment("Pressure unit shown in the vehicle, e.g., Psi, kPa, Bar"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time"),
+	}
+}
+
+// Edges of the TirePressure.
+func (TirePressure) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the TirePressure.
+func (TirePressure) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vehicle_id", "created_at"),
+	}
+}
+
+// Annotations of the TirePressure.
+func (TirePressure) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "tire_pressure"},
+		schema.Comment("Tire pressure history table"),
+	}
+}
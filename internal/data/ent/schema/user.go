@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // User holds the schema definition for the User entity.
@@ -27,6 +28,7 @@ func (User) Fields() []ent.Field {
 		field.Int8("gender").Nillable().Default(0).Comment("Gender, 0:unknown, 1:male, 2:female"),
 		field.Int("asked_user_id").Optional().Comment("ID of the user who invited this user"),
 		field.String("area_code").Optional().Comment("Area code for mobile number"),
+		field.String("time_zone").Default("Asia/Shanghai").Comment("IANA time zone statistics are rolled up in"),
 		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time"),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("Update time"),
 		field.Bool("deleted").Default(false).Comment("Is deleted"),
@@ -38,6 +40,14 @@ func (User) Edges() []ent.Edge {
 	return nil
 }
 
+// Indexes of the User.
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		// Accounts are unique, also among deleted users, so that concurrent signups of an account fail.
+		index.Fields("account").Unique(),
+	}
+}
+
 // Annotations of the User.
 func (User) Annotations() []schema.Annotation {
 	return []schema.Annotation{
@@ -26,7 +26,7 @@ func (Vehicle) Fields() []ent.Field {
 		field.Int8("calendar_enabled").Nillable().Default(0).Comment("Is calendar enabled"),
 		field.String("car_type").Optional().Comment("Car model type"),
 		field.String("api_version").Optional().Comment("API version used by vehicle"),
-		field.String("raw_data").Comment("Raw vehicle data from API"),
+		field.Text("raw_data").Comment("Raw vehicle data from API"),
 		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time"),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("Update time"),
 		field.Bool("deleted").Default(false).Comment("Is deleted"),
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VehicleEvent holds the schema definition for the VehicleEvent entity.
+// Notable events published on the event bus are recorded for the vehicle timeline.
+type VehicleEvent struct {
+	ent.Schema
+}
+
+// Fields of the VehicleEvent.
+func (VehicleEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("vehicle_id").Comment("Associated vehicle ID"),
+		field.Int("user_id").Comment("Owner of the vehicle"),
+		field.String("type").Comment("Event type, e.g., geofence.enter, tire.pressure_low"),
+		field.String("summary").Comment("Human readable summary, e.g., Entered Home"),
+		field.JSON("data", map[string]string{}).Optional().Comment("Type specific attributes"),
+		field.Time("occurred_at").Comment("Time the event happened"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time"),
+	}
+}
+
+// Edges of the VehicleEvent.
+func (VehicleEvent) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the VehicleEvent.
+func (VehicleEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vehicle_id", "occurred_at"),
+	}
+}
+
+// Annotations of the VehicleEvent.
+func (VehicleEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "vehicle_event"},
+		schema.Comment("Vehicle notable event table"),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VehicleRollup holds the schema definition for the VehicleRollup entity.
+// A rollup materializes the statistics of a vehicle over one day, ISO week or month.
+type VehicleRollup struct {
+	ent.Schema
+}
+
+// Fields of the VehicleRollup.
+func (VehicleRollup) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("vehicle_id").Comment("Associated vehicle ID"),
+		field.String("granularity").Comment("Granularity, e.g., day, week, month"),
+		field.String("time_zone").Comment("IANA time zone of the bucket boundaries"),
+		field.Time("start_at").Comment("Bucket start time"),
+		field.Time("end_at").Comment("Bucket end time, exclusive"),
+		field.Int("drives").Default(0).Comment("Number of drives started in the bucket"),
+		field.Float("distance").Default(0).Comment("Distance driven in km"),
+		field.Int("drive_seconds").Default(0).Comment("Time spent driving in seconds"),
+		field.Float("energy_used").Default(0).Comment("Energy used while driving in kWh"),
+		field.Float("efficiency").Default(0).Comment("Consumption in Wh/km, 0 when unknown"),
+		field.Float("max_speed").Default(0).Comment("Highest speed in km/h"),
+		field.Int("charges").Default(0).Comment("Number of charging sessions started in the bucket"),
+		field.Float("energy_added").Default(0).Comment("Energy added while charging in kWh"),
+		field.Float("charge_cost").Default(0).Comment("Cost of the priced charging sessions"),
+		field.Int("parked_seconds").Default(0).Comment("Time spent parked, including asleep, in seconds"),
+		field.Int("asleep_seconds").Default(0).Comment("Time spent asleep or offline in seconds"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("Update time"),
+	}
+}
+
+// Edges of the VehicleRollup.
+func (VehicleRollup) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the VehicleRollup.
+func (VehicleRollup) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vehicle_id", "granularity", "time_zone", "start_at").Unique(),
+	}
+}
+
+// Annotations of the VehicleRollup.
+func (VehicleRollup) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "vehicle_rollup"},
+		schema.Comment("Vehicle statistics rollup table"),
+	}
+}
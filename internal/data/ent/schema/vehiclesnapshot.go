@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VehicleSnapshot holds the schema definition for the VehicleSnapshot entity.
+// A snapshot is a single sample of vehicle data collected by the poller.
+type VehicleSnapshot struct {
+	ent.Schema
+}
+
+// Fields of the VehicleSnapshot.
+func (VehicleSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("vehicle_id").Comment("Associated vehicle ID"),
+		field.String("state").Comment("Vehicle state reported by Tesla, e.g., online, asleep, offline"),
+		field.String("shift_state").Optional().Comment("Gear shift state, e.g., P, D, R"),
+		field.Float("speed").Optional().Comment("Speed in km/h"),
+		field.Int("power").Optional().Comment("Power draw or regeneration in kW"),
+		field.Float("latitude").Optional().Comment("WGS-84 latitude"),
+		field.Float("longitude").Optional().Comment("WGS-84 longitude"),
+		field.String("coord_type").Optional().Comment("Datum the position was reported in, e.g., wgs84, gcj02"),
+		field.Int("heading").Optional().Comment("Heading in degrees"),
+		field.Int("battery_level").Optional().Comment("State of charge in percent"),
+		field.Int("usable_battery_level").Optional().Comment("Usable state of charge in percent"),
+		field.Float("battery_range").Optional().Comment("Rated range in km"),
+		field.Float("ideal_battery_range").Optional().Comment("Ideal range in km"),
+		field.Float("est_battery_range").Optional().Comment("Estimated range in km"),
+		field.String("charging_state").Optional().Comment("Charging state, e.g., Charging, Disconnected"),
+		field.Int("charger_power").Optional().Comment("Charger power in kW"),
+		field.Float("charge_energy_added").Optional().Comment("Energy added in the current charging session in kWh"),
+		field.Int("charger_voltage").Optional().Comment("Charger voltage in V"),
+		field.Int("charger_actual_current").Optional().Comment("Charger current in A"),
+		field.Bool("fast_charger_present").Default(false).Comment("Is a DC fast charger connected"),
+		field.String("fast_charger_type").Optional().Comment("DC fast charger type, e.g., Supercharger"),
+		field.Bool("battery_heater_on").Default(false).Comment("Is the battery heater on"),
+		field.Float("outside_temp").Optional().Comment("Outside temperature in Celsius"),
+		field.Float("inside_temp").Optional().Comment("Inside temperature in Celsius"),
+		field.Bool("climate_on").Default(false).Comment("Is climate control on"),
+		field.String("climate_keeper_mode").Optional().Comment("Climate keeper mode, e.g., off, dog, camp"),
+		field.Bool("cabin_overheat_cooling").Default(false).Comment("Is cabin overheat protection actively cooling"),
+		field.Bool("sentry_mode").Default(false).Comment("Is sentry mode on"),
+		field.Bool("locked").Default(false).Comment("Is the vehicle locked"),
+		field.Float("odometer").Optional().Comment("Odometer in km"),
+		field.String("car_version").Optional().Comment("Vehicle software version"),
+		field.String("software_update_status").Optional().Comment("Software update status"),
+		field.String("software_update_version").Optional().Comment("Target version of the pending software update"),
+		field.Int("software_update_download_perc").Optional().Comment("Software update download progress in percent"),
+		field.Int("software_update_install_perc").Optional().Comment("Software update install progress in percent"),
+		field.Int("software_update_expected_duration").Optional().Comment("Expected software update install duration in seconds"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Sample time"),
+	}
+}
+
+// Edges of the VehicleSnapshot.
+func (VehicleSnapshot) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the VehicleSnapshot.
+func (VehicleSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vehicle_id", "created_at"),
+	}
+}
+
+// Annotations of the VehicleSnapshot.
+func (VehicleSnapshot) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "vehicle_snapshot"},
+		schema.Comment("Vehicle snapshot table"),
+	}
+}
@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VehicleStatePeriod holds the schema definition for the VehicleStatePeriod entity.
+// A period is a continuous span of time the vehicle spent in a single state.
+type VehicleStatePeriod struct {
+	ent.Schema
+}
+
+// Fields of the VehicleStatePeriod.
+func (VehicleStatePeriod) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("vehicle_id").Comment("Associated vehicle ID"),
+		field.String("state").Comment("State, e.g., online, asleep, offline, driving, charging, updating"),
+		field.Bool("sentry_mode").Default(false).Comment("Was sentry mode on during the period"),
+		field.Bool("climate_on").Default(false).Comment("Was climate keeper or cabin overheat protection running during the period"),
+		field.Time("start_at").Comment("Period start time"),
+		field.Time("end_at").Optional().Nillable().Comment("Period end time, null while the period is open"),
+		field.Int("start_battery_level").Optional().Comment("State of charge at the start in percent"),
+		field.Int("end_battery_level").Optional().Comment("State of charge at the end in percent"),
+		field.Float("start_range").Optional().Comment("Rated range at the start in km"),
+		field.Float("end_range").Optional().Comment("Rated range at the end in km"),
+		field.Float("start_odometer").Optional().Comment("Odometer at the start in km"),
+		field.Float("end_odometer").Optional().Comment("Odometer at the end in km"),
+		field.Float("start_latitude").Optional().Comment("WGS-84 latitude at the start"),
+		field.Float("start_longitude").Optional().Comment("WGS-84 longitude at the start"),
+		field.Float("end_latitude").Optional().Comment("WGS-84 latitude at the end"),
+		field.Float("end_longitude").Optional().Comment("WGS-84 longitude at the end"),
+		field.String("start_address").Optional().Comment("Address at the start, resolved for drives and charging sessions"),
+		field.String("end_address").Optional().Comment("Address at the end, resolved for drives and charging sessions"),
+		field.Int("start_geofence_id").Optional().Nillable().Comment("Geofence at the start"),
+		field.Int("end_geofence_id").Optional().Nillable().Comment("Geofence at the end"),
+		field.String("charge_location").Optional().Comment("Charging location, e.g., home, work, public"),
+		field.Bool("fast_charger").Default(false).Comment("Was a DC fast charger used"),
+		field.Float("energy_added").Optional().Comment("Energy added while charging in kWh"),
+		field.Float("start_energy_added").Optional().Comment("Energy added since plugged in as reported by the vehicle at the start of a charging session in kWh"),
+		field.Float("energy_used").Optional().Comment("Energy used while driving in kWh, integrated from the power samples"),
+		field.Int("power_seconds").Optional().Comment("Seconds of the drive covered by the power integration"),
+		field.Float("outside_temp").Optional().Nillable().Comment("Time-weighted mean outside temperature while driving in Celsius"),
+		field.Float("max_speed").Optional().Comment("Highest speed while driving in km/h"),
+		field.Float("climate_ratio").Optional().Comment("Share of the drive with climate control on, from 0 to 1"),
+		field.Float("cost").Optional().Nillable().Comment("Charging cost, null when unknown"),
+		field.Bool("cost_manual").Default(false).Comment("Was the cost entered manually, e.g., for a Supercharger session"),
+		field.Int("tariff_id").Optional().Nillable().Comment("Tariff the cost was calculated with"),
+		field.String("name").Optional().Comment("Name the owner gave a drive or charging session"),
+		field.JSON("tags", []string{}).Optional().Comment("Tags the owner attached to a drive or charging session"),
+		field.Time("created_at").Default(time.Now).Immutable().Comment("Creation time"),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now).Comment("Update time"),
+	}
+}
+
+// Edges of the VehicleStatePeriod.
+func (VehicleStatePeriod) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the VehicleStatePeriod.
+func (VehicleStatePeriod) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vehicle_id", "start_at"),
+		index.Fields("vehicle_id", "updated_at"),
+	}
+}
+
+// Annotations of the VehicleStatePeriod.
+func (VehicleStatePeriod) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "vehicle_state_period"},
+		schema.Comment("Vehicle state period table"),
+	}
+}
@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"teslatrack/internal/data/ent/softwareupdate"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Vehicle software update history table
+type SoftwareUpdate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Associated vehicle ID
+	VehicleID int `json:"vehicle_id,omitempty"`
+	// Version installed before the update, empty for the first version seen
+	FromVersion string `json:"from_version,omitempty"`
+	// Target version of the update
+	Version string `json:"version,omitempty"`
+	// Status, e.g., available, scheduled, downloading, installing, installed
+	Status string `json:"status,omitempty"`
+	// Download progress in percent
+	DownloadPerc int `json:"download_perc,omitempty"`
+	// Install progress in percent
+	InstallPerc int `json:"install_perc,omitempty"`
+	// Expected install duration in seconds
+	ExpectedDuration int `json:"expected_duration,omitempty"`
+	// Time the update was first seen
+	AvailableAt time.Time `json:"available_at,omitempty"`
+	// Time the installation started
+	InstallStartedAt *time.Time `json:"install_started_at,omitempty"`
+	// Time the vehicle was first seen running the version
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// Creation time
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update time
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SoftwareUpdate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case softwareupdate.FieldID, softwareupdate.FieldVehicleID, softwareupdate.FieldDownloadPerc, softwareupdate.FieldInstallPerc, softwareupdate.FieldExpectedDuration:
+			values[i] = new(sql.NullInt64)
+		case softwareupdate.FieldFromVersion, softwareupdate.FieldVersion, softwareupdate.FieldStatus:
+			values[i] = new(sql.NullString)
+		case softwareupdate.FieldAvailableAt, softwareupdate.FieldInstallStartedAt, softwareupdate.FieldCompletedAt, softwareupdate.FieldCreatedAt, softwareupdate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SoftwareUpdate fields.
+func (_m *SoftwareUpdate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case softwareupdate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case softwareupdate.FieldVehicleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vehicle_id", values[i])
+			} else if value.Valid {
+				_m.VehicleID = int(value.Int64)
+			}
+		case softwareupdate.FieldFromVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_version", values[i])
+			} else if value.Valid {
+				_m.FromVersion = value.String
+			}
+		case softwareupdate.FieldVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = value.String
+			}
+		case softwareupdate.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case softwareupdate.FieldDownloadPerc:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field download_perc", values[i])
+			} else if value.Valid {
+				_m.DownloadPerc = int(value.Int64)
+			}
+		case softwareupdate.FieldInstallPerc:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field install_perc", values[i])
+			} else if value.Valid {
+				_m.InstallPerc = int(value.Int64)
+			}
+		case softwareupdate.FieldExpectedDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_duration", values[i])
+			} else if value.Valid {
+				_m.ExpectedDuration = int(value.Int64)
+			}
+		case softwareupdate.FieldAvailableAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field available_at", values[i])
+			} else if value.Valid {
+				_m.AvailableAt = value.Time
+			}
+		case softwareupdate.FieldInstallStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field install_started_at", values[i])
+			} else if value.Valid {
+				_m.InstallStartedAt = new(time.Time)
+				*_m.InstallStartedAt = value.Time
+			}
+		case softwareupdate.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		case softwareupdate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case softwareupdate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SoftwareUpdate.
+// This includes values selected through modifiers, order, etc.
+func (_m *SoftwareUpdate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SoftwareUpdate.
+// Note that you need to call SoftwareUpdate.Unwrap() before calling this method if this SoftwareUpdate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SoftwareUpdate) Update() *SoftwareUpdateUpdateOne {
+	return NewSoftwareUpdateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SoftwareUpdate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SoftwareUpdate) Unwrap() *SoftwareUpdate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SoftwareUpdate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SoftwareUpdate) String() string {
+	var builder strings.Builder
+	builder.WriteString("SoftwareUpdate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("vehicle_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VehicleID))
+	builder.WriteString(", ")
+	builder.WriteString("from_version=")
+	builder.WriteString(_m.FromVersion)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(_m.Version)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("download_perc=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DownloadPerc))
+	builder.WriteString(", ")
+	builder.WriteString("install_perc=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InstallPerc))
+	builder.WriteString(", ")
+	builder.WriteString("expected_duration=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExpectedDuration))
+	builder.WriteString(", ")
+	builder.WriteString("available_at=")
+	builder.WriteString(_m.AvailableAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.InstallStartedAt; v != nil {
+		builder.WriteString("install_started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SoftwareUpdates is a parsable slice of SoftwareUpdate.
+type SoftwareUpdates []*SoftwareUpdate
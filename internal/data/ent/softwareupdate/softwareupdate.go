@@ -0,0 +1,152 @@
+// Code generated by ent, DO NOT EDIT.
+
+package softwareupdate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the softwareupdate type in the database.
+	Label = "software_update"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVehicleID holds the string denoting the vehicle_id field in the database.
+	FieldVehicleID = "vehicle_id"
+	// FieldFromVersion holds the string denoting the from_version field in the database.
+	FieldFromVersion = "from_version"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldDownloadPerc holds the string denoting the download_perc field in the database.
+	FieldDownloadPerc = "download_perc"
+	// FieldInstallPerc holds the string denoting the install_perc field in the database.
+	FieldInstallPerc = "install_perc"
+	// FieldExpectedDuration holds the string denoting the expected_duration field in the database.
+	FieldExpectedDuration = "expected_duration"
+	// FieldAvailableAt holds the string denoting the available_at field in the database.
+	FieldAvailableAt = "available_at"
+	// FieldInstallStartedAt holds the string denoting the install_started_at field in the database.
+	FieldInstallStartedAt = "install_started_at"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the softwareupdate in the database.
+	Table = "software_update"
+)
+
+// Columns holds all SQL columns for softwareupdate fields.
+var Columns = []string{
+	FieldID,
+	FieldVehicleID,
+	FieldFromVersion,
+	FieldVersion,
+	FieldStatus,
+	FieldDownloadPerc,
+	FieldInstallPerc,
+	FieldExpectedDuration,
+	FieldAvailableAt,
+	FieldInstallStartedAt,
+	FieldCompletedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDownloadPerc holds the default value on creation for the "download_perc" field.
+	DefaultDownloadPerc int
+	// DefaultInstallPerc holds the default value on creation for the "install_perc" field.
+	DefaultInstallPerc int
+	// DefaultExpectedDuration holds the default value on creation for the "expected_duration" field.
+	DefaultExpectedDuration int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the SoftwareUpdate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVehicleID orders the results by the vehicle_id field.
+func ByVehicleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVehicleID, opts...).ToFunc()
+}
+
+// ByFromVersion orders the results by the from_version field.
+func ByFromVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromVersion, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByDownloadPerc orders the results by the download_perc field.
+func ByDownloadPerc(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDownloadPerc, opts...).ToFunc()
+}
+
+// ByInstallPerc orders the results by the install_perc field.
+func ByInstallPerc(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstallPerc, opts...).ToFunc()
+}
+
+// ByExpectedDuration orders the results by the expected_duration field.
+func ByExpectedDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpectedDuration, opts...).ToFunc()
+}
+
+// ByAvailableAt orders the results by the available_at field.
+func ByAvailableAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvailableAt, opts...).ToFunc()
+}
+
+// ByInstallStartedAt orders the results by the install_started_at field.
+func ByInstallStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstallStartedAt, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,715 @@
+// Code generated by ent, DO NOT EDIT.
+
+package softwareupdate
+
+import (
+	"teslatrack/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLTE(FieldID, id))
+}
+
+// VehicleID applies equality check predicate on the "vehicle_id" field. It's identical to VehicleIDEQ.
+func VehicleID(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// FromVersion applies equality check predicate on the "from_version" field. It's identical to FromVersionEQ.
+func FromVersion(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldFromVersion, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldVersion, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldStatus, v))
+}
+
+// DownloadPerc applies equality check predicate on the "download_perc" field. It's identical to DownloadPercEQ.
+func DownloadPerc(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldDownloadPerc, v))
+}
+
+// InstallPerc applies equality check predicate on the "install_perc" field. It's identical to InstallPercEQ.
+func InstallPerc(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldInstallPerc, v))
+}
+
+// ExpectedDuration applies equality check predicate on the "expected_duration" field. It's identical to ExpectedDurationEQ.
+func ExpectedDuration(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldExpectedDuration, v))
+}
+
+// AvailableAt applies equality check predicate on the "available_at" field. It's identical to AvailableAtEQ.
+func AvailableAt(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldAvailableAt, v))
+}
+
+// InstallStartedAt applies equality check predicate on the "install_started_at" field. It's identical to InstallStartedAtEQ.
+func InstallStartedAt(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldInstallStartedAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// VehicleIDEQ applies the EQ predicate on the "vehicle_id" field.
+func VehicleIDEQ(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// VehicleIDNEQ applies the NEQ predicate on the "vehicle_id" field.
+func VehicleIDNEQ(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNEQ(FieldVehicleID, v))
+}
+
+// VehicleIDIn applies the In predicate on the "vehicle_id" field.
+func VehicleIDIn(vs ...int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldIn(FieldVehicleID, vs...))
+}
+
+// VehicleIDNotIn applies the NotIn predicate on the "vehicle_id" field.
+func VehicleIDNotIn(vs ...int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNotIn(FieldVehicleID, vs...))
+}
+
+// VehicleIDGT applies the GT predicate on the "vehicle_id" field.
+func VehicleIDGT(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGT(FieldVehicleID, v))
+}
+
+// VehicleIDGTE applies the GTE predicate on the "vehicle_id" field.
+func VehicleIDGTE(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGTE(FieldVehicleID, v))
+}
+
+// VehicleIDLT applies the LT predicate on the "vehicle_id" field.
+func VehicleIDLT(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLT(FieldVehicleID, v))
+}
+
+// VehicleIDLTE applies the LTE predicate on the "vehicle_id" field.
+func VehicleIDLTE(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLTE(FieldVehicleID, v))
+}
+
+// FromVersionEQ applies the EQ predicate on the "from_version" field.
+func FromVersionEQ(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldFromVersion, v))
+}
+
+// FromVersionNEQ applies the NEQ predicate on the "from_version" field.
+func FromVersionNEQ(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNEQ(FieldFromVersion, v))
+}
+
+// FromVersionIn applies the In predicate on the "from_version" field.
+func FromVersionIn(vs ...string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldIn(FieldFromVersion, vs...))
+}
+
+// FromVersionNotIn applies the NotIn predicate on the "from_version" field.
+func FromVersionNotIn(vs ...string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNotIn(FieldFromVersion, vs...))
+}
+
+// FromVersionGT applies the GT predicate on the "from_version" field.
+func FromVersionGT(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGT(FieldFromVersion, v))
+}
+
+// FromVersionGTE applies the GTE predicate on the "from_version" field.
+func FromVersionGTE(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGTE(FieldFromVersion, v))
+}
+
+// FromVersionLT applies the LT predicate on the "from_version" field.
+func FromVersionLT(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLT(FieldFromVersion, v))
+}
+
+// FromVersionLTE applies the LTE predicate on the "from_version" field.
+func FromVersionLTE(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLTE(FieldFromVersion, v))
+}
+
+// FromVersionContains applies the Contains predicate on the "from_version" field.
+func FromVersionContains(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldContains(FieldFromVersion, v))
+}
+
+// FromVersionHasPrefix applies the HasPrefix predicate on the "from_version" field.
+func FromVersionHasPrefix(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldHasPrefix(FieldFromVersion, v))
+}
+
+// FromVersionHasSuffix applies the HasSuffix predicate on the "from_version" field.
+func FromVersionHasSuffix(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldHasSuffix(FieldFromVersion, v))
+}
+
+// FromVersionIsNil applies the IsNil predicate on the "from_version" field.
+func FromVersionIsNil() predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldIsNull(FieldFromVersion))
+}
+
+// FromVersionNotNil applies the NotNil predicate on the "from_version" field.
+func FromVersionNotNil() predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNotNull(FieldFromVersion))
+}
+
+// FromVersionEqualFold applies the EqualFold predicate on the "from_version" field.
+func FromVersionEqualFold(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEqualFold(FieldFromVersion, v))
+}
+
+// FromVersionContainsFold applies the ContainsFold predicate on the "from_version" field.
+func FromVersionContainsFold(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldContainsFold(FieldFromVersion, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLTE(FieldVersion, v))
+}
+
+// VersionContains applies the Contains predicate on the "version" field.
+func VersionContains(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldContains(FieldVersion, v))
+}
+
+// VersionHasPrefix applies the HasPrefix predicate on the "version" field.
+func VersionHasPrefix(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldHasPrefix(FieldVersion, v))
+}
+
+// VersionHasSuffix applies the HasSuffix predicate on the "version" field.
+func VersionHasSuffix(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldHasSuffix(FieldVersion, v))
+}
+
+// VersionEqualFold applies the EqualFold predicate on the "version" field.
+func VersionEqualFold(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEqualFold(FieldVersion, v))
+}
+
+// VersionContainsFold applies the ContainsFold predicate on the "version" field.
+func VersionContainsFold(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldContainsFold(FieldVersion, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// DownloadPercEQ applies the EQ predicate on the "download_perc" field.
+func DownloadPercEQ(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldDownloadPerc, v))
+}
+
+// DownloadPercNEQ applies the NEQ predicate on the "download_perc" field.
+func DownloadPercNEQ(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNEQ(FieldDownloadPerc, v))
+}
+
+// DownloadPercIn applies the In predicate on the "download_perc" field.
+func DownloadPercIn(vs ...int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldIn(FieldDownloadPerc, vs...))
+}
+
+// DownloadPercNotIn applies the NotIn predicate on the "download_perc" field.
+func DownloadPercNotIn(vs ...int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNotIn(FieldDownloadPerc, vs...))
+}
+
+// DownloadPercGT applies the GT predicate on the "download_perc" field.
+func DownloadPercGT(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGT(FieldDownloadPerc, v))
+}
+
+// DownloadPercGTE applies the GTE predicate on the "download_perc" field.
+func DownloadPercGTE(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGTE(FieldDownloadPerc, v))
+}
+
+// DownloadPercLT applies the LT predicate on the "download_perc" field.
+func DownloadPercLT(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLT(FieldDownloadPerc, v))
+}
+
+// DownloadPercLTE applies the LTE predicate on the "download_perc" field.
+func DownloadPercLTE(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLTE(FieldDownloadPerc, v))
+}
+
+// InstallPercEQ applies the EQ predicate on the "install_perc" field.
+func InstallPercEQ(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldInstallPerc, v))
+}
+
+// InstallPercNEQ applies the NEQ predicate on the "install_perc" field.
+func InstallPercNEQ(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNEQ(FieldInstallPerc, v))
+}
+
+// InstallPercIn applies the In predicate on the "install_perc" field.
+func InstallPercIn(vs ...int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldIn(FieldInstallPerc, vs...))
+}
+
+// InstallPercNotIn applies the NotIn predicate on the "install_perc" field.
+func InstallPercNotIn(vs ...int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNotIn(FieldInstallPerc, vs...))
+}
+
+// InstallPercGT applies the GT predicate on the "install_perc" field.
+func InstallPercGT(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGT(FieldInstallPerc, v))
+}
+
+// InstallPercGTE applies the GTE predicate on the "install_perc" field.
+func InstallPercGTE(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGTE(FieldInstallPerc, v))
+}
+
+// InstallPercLT applies the LT predicate on the "install_perc" field.
+func InstallPercLT(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLT(FieldInstallPerc, v))
+}
+
+// InstallPercLTE applies the LTE predicate on the "install_perc" field.
+func InstallPercLTE(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLTE(FieldInstallPerc, v))
+}
+
+// ExpectedDurationEQ applies the EQ predicate on the "expected_duration" field.
+func ExpectedDurationEQ(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldExpectedDuration, v))
+}
+
+// ExpectedDurationNEQ applies the NEQ predicate on the "expected_duration" field.
+func ExpectedDurationNEQ(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNEQ(FieldExpectedDuration, v))
+}
+
+// ExpectedDurationIn applies the In predicate on the "expected_duration" field.
+func ExpectedDurationIn(vs ...int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldIn(FieldExpectedDuration, vs...))
+}
+
+// ExpectedDurationNotIn applies the NotIn predicate on the "expected_duration" field.
+func ExpectedDurationNotIn(vs ...int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNotIn(FieldExpectedDuration, vs...))
+}
+
+// ExpectedDurationGT applies the GT predicate on the "expected_duration" field.
+func ExpectedDurationGT(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGT(FieldExpectedDuration, v))
+}
+
+// ExpectedDurationGTE applies the GTE predicate on the "expected_duration" field.
+func ExpectedDurationGTE(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGTE(FieldExpectedDuration, v))
+}
+
+// ExpectedDurationLT applies the LT predicate on the "expected_duration" field.
+func ExpectedDurationLT(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLT(FieldExpectedDuration, v))
+}
+
+// ExpectedDurationLTE applies the LTE predicate on the "expected_duration" field.
+func ExpectedDurationLTE(v int) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLTE(FieldExpectedDuration, v))
+}
+
+// AvailableAtEQ applies the EQ predicate on the "available_at" field.
+func AvailableAtEQ(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldAvailableAt, v))
+}
+
+// AvailableAtNEQ applies the NEQ predicate on the "available_at" field.
+func AvailableAtNEQ(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNEQ(FieldAvailableAt, v))
+}
+
+// AvailableAtIn applies the In predicate on the "available_at" field.
+func AvailableAtIn(vs ...time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldIn(FieldAvailableAt, vs...))
+}
+
+// AvailableAtNotIn applies the NotIn predicate on the "available_at" field.
+func AvailableAtNotIn(vs ...time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNotIn(FieldAvailableAt, vs...))
+}
+
+// AvailableAtGT applies the GT predicate on the "available_at" field.
+func AvailableAtGT(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGT(FieldAvailableAt, v))
+}
+
+// AvailableAtGTE applies the GTE predicate on the "available_at" field.
+func AvailableAtGTE(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGTE(FieldAvailableAt, v))
+}
+
+// AvailableAtLT applies the LT predicate on the "available_at" field.
+func AvailableAtLT(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLT(FieldAvailableAt, v))
+}
+
+// AvailableAtLTE applies the LTE predicate on the "available_at" field.
+func AvailableAtLTE(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLTE(FieldAvailableAt, v))
+}
+
+// InstallStartedAtEQ applies the EQ predicate on the "install_started_at" field.
+func InstallStartedAtEQ(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldInstallStartedAt, v))
+}
+
+// InstallStartedAtNEQ applies the NEQ predicate on the "install_started_at" field.
+func InstallStartedAtNEQ(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNEQ(FieldInstallStartedAt, v))
+}
+
+// InstallStartedAtIn applies the In predicate on the "install_started_at" field.
+func InstallStartedAtIn(vs ...time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldIn(FieldInstallStartedAt, vs...))
+}
+
+// InstallStartedAtNotIn applies the NotIn predicate on the "install_started_at" field.
+func InstallStartedAtNotIn(vs ...time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNotIn(FieldInstallStartedAt, vs...))
+}
+
+// InstallStartedAtGT applies the GT predicate on the "install_started_at" field.
+func InstallStartedAtGT(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGT(FieldInstallStartedAt, v))
+}
+
+// InstallStartedAtGTE applies the GTE predicate on the "install_started_at" field.
+func InstallStartedAtGTE(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGTE(FieldInstallStartedAt, v))
+}
+
+// InstallStartedAtLT applies the LT predicate on the "install_started_at" field.
+func InstallStartedAtLT(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLT(FieldInstallStartedAt, v))
+}
+
+// InstallStartedAtLTE applies the LTE predicate on the "install_started_at" field.
+func InstallStartedAtLTE(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLTE(FieldInstallStartedAt, v))
+}
+
+// InstallStartedAtIsNil applies the IsNil predicate on the "install_started_at" field.
+func InstallStartedAtIsNil() predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldIsNull(FieldInstallStartedAt))
+}
+
+// InstallStartedAtNotNil applies the NotNil predicate on the "install_started_at" field.
+func InstallStartedAtNotNil() predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNotNull(FieldInstallStartedAt))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SoftwareUpdate) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SoftwareUpdate) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SoftwareUpdate) predicate.SoftwareUpdate {
+	return predicate.SoftwareUpdate(sql.NotPredicates(p))
+}
@@ -0,0 +1,408 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/softwareupdate"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SoftwareUpdateCreate is the builder for creating a SoftwareUpdate entity.
+type SoftwareUpdateCreate struct {
+	config
+	mutation *SoftwareUpdateMutation
+	hooks    []Hook
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_c *SoftwareUpdateCreate) SetVehicleID(v int) *SoftwareUpdateCreate {
+	_c.mutation.SetVehicleID(v)
+	return _c
+}
+
+// SetFromVersion sets the "from_version" field.
+func (_c *SoftwareUpdateCreate) SetFromVersion(v string) *SoftwareUpdateCreate {
+	_c.mutation.SetFromVersion(v)
+	return _c
+}
+
+// SetNillableFromVersion sets the "from_version" field if the given value is not nil.
+func (_c *SoftwareUpdateCreate) SetNillableFromVersion(v *string) *SoftwareUpdateCreate {
+	if v != nil {
+		_c.SetFromVersion(*v)
+	}
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *SoftwareUpdateCreate) SetVersion(v string) *SoftwareUpdateCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SoftwareUpdateCreate) SetStatus(v string) *SoftwareUpdateCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetDownloadPerc sets the "download_perc" field.
+func (_c *SoftwareUpdateCreate) SetDownloadPerc(v int) *SoftwareUpdateCreate {
+	_c.mutation.SetDownloadPerc(v)
+	return _c
+}
+
+// SetNillableDownloadPerc sets the "download_perc" field if the given value is not nil.
+func (_c *SoftwareUpdateCreate) SetNillableDownloadPerc(v *int) *SoftwareUpdateCreate {
+	if v != nil {
+		_c.SetDownloadPerc(*v)
+	}
+	return _c
+}
+
+// SetInstallPerc sets the "install_perc" field.
+func (_c *SoftwareUpdateCreate) SetInstallPerc(v int) *SoftwareUpdateCreate {
+	_c.mutation.SetInstallPerc(v)
+	return _c
+}
+
+// SetNillableInstallPerc sets the "install_perc" field if the given value is not nil.
+func (_c *SoftwareUpdateCreate) SetNillableInstallPerc(v *int) *SoftwareUpdateCreate {
+	if v != nil {
+		_c.SetInstallPerc(*v)
+	}
+	return _c
+}
+
+// SetExpectedDuration sets the "expected_duration" field.
+func (_c *SoftwareUpdateCreate) SetExpectedDuration(v int) *SoftwareUpdateCreate {
+	_c.mutation.SetExpectedDuration(v)
+	return _c
+}
+
+// SetNillableExpectedDuration sets the "expected_duration" field if the given value is not nil.
+func (_c *SoftwareUpdateCreate) SetNillableExpectedDuration(v *int) *SoftwareUpdateCreate {
+	if v != nil {
+		_c.SetExpectedDuration(*v)
+	}
+	return _c
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (_c *SoftwareUpdateCreate) SetAvailableAt(v time.Time) *SoftwareUpdateCreate {
+	_c.mutation.SetAvailableAt(v)
+	return _c
+}
+
+// SetInstallStartedAt sets the "install_started_at" field.
+func (_c *SoftwareUpdateCreate) SetInstallStartedAt(v time.Time) *SoftwareUpdateCreate {
+	_c.mutation.SetInstallStartedAt(v)
+	return _c
+}
+
+// SetNillableInstallStartedAt sets the "install_started_at" field if the given value is not nil.
+func (_c *SoftwareUpdateCreate) SetNillableInstallStartedAt(v *time.Time) *SoftwareUpdateCreate {
+	if v != nil {
+		_c.SetInstallStartedAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *SoftwareUpdateCreate) SetCompletedAt(v time.Time) *SoftwareUpdateCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *SoftwareUpdateCreate) SetNillableCompletedAt(v *time.Time) *SoftwareUpdateCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SoftwareUpdateCreate) SetCreatedAt(v time.Time) *SoftwareUpdateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SoftwareUpdateCreate) SetNillableCreatedAt(v *time.Time) *SoftwareUpdateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SoftwareUpdateCreate) SetUpdatedAt(v time.Time) *SoftwareUpdateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SoftwareUpdateCreate) SetNillableUpdatedAt(v *time.Time) *SoftwareUpdateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SoftwareUpdateMutation object of the builder.
+func (_c *SoftwareUpdateCreate) Mutation() *SoftwareUpdateMutation {
+	return _c.mutation
+}
+
+// Save creates the SoftwareUpdate in the database.
+func (_c *SoftwareUpdateCreate) Save(ctx context.Context) (*SoftwareUpdate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SoftwareUpdateCreate) SaveX(ctx context.Context) *SoftwareUpdate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SoftwareUpdateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SoftwareUpdateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SoftwareUpdateCreate) defaults() {
+	if _, ok := _c.mutation.DownloadPerc(); !ok {
+		v := softwareupdate.DefaultDownloadPerc
+		_c.mutation.SetDownloadPerc(v)
+	}
+	if _, ok := _c.mutation.InstallPerc(); !ok {
+		v := softwareupdate.DefaultInstallPerc
+		_c.mutation.SetInstallPerc(v)
+	}
+	if _, ok := _c.mutation.ExpectedDuration(); !ok {
+		v := softwareupdate.DefaultExpectedDuration
+		_c.mutation.SetExpectedDuration(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := softwareupdate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := softwareupdate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SoftwareUpdateCreate) check() error {
+	if _, ok := _c.mutation.VehicleID(); !ok {
+		return &ValidationError{Name: "vehicle_id", err: errors.New(`ent: missing required field "SoftwareUpdate.vehicle_id"`)}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "SoftwareUpdate.version"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SoftwareUpdate.status"`)}
+	}
+	if _, ok := _c.mutation.DownloadPerc(); !ok {
+		return &ValidationError{Name: "download_perc", err: errors.New(`ent: missing required field "SoftwareUpdate.download_perc"`)}
+	}
+	if _, ok := _c.mutation.InstallPerc(); !ok {
+		return &ValidationError{Name: "install_perc", err: errors.New(`ent: missing required field "SoftwareUpdate.install_perc"`)}
+	}
+	if _, ok := _c.mutation.ExpectedDuration(); !ok {
+		return &ValidationError{Name: "expected_duration", err: errors.New(`ent: missing required field "SoftwareUpdate.expected_duration"`)}
+	}
+	if _, ok := _c.mutation.AvailableAt(); !ok {
+		return &ValidationError{Name: "available_at", err: errors.New(`ent: missing required field "SoftwareUpdate.available_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SoftwareUpdate.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SoftwareUpdate.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *SoftwareUpdateCreate) sqlSave(ctx context.Context) (*SoftwareUpdate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SoftwareUpdateCreate) createSpec() (*SoftwareUpdate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SoftwareUpdate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(softwareupdate.Table, sqlgraph.NewFieldSpec(softwareupdate.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.VehicleID(); ok {
+		_spec.SetField(softwareupdate.FieldVehicleID, field.TypeInt, value)
+		_node.VehicleID = value
+	}
+	if value, ok := _c.mutation.FromVersion(); ok {
+		_spec.SetField(softwareupdate.FieldFromVersion, field.TypeString, value)
+		_node.FromVersion = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(softwareupdate.FieldVersion, field.TypeString, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(softwareupdate.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.DownloadPerc(); ok {
+		_spec.SetField(softwareupdate.FieldDownloadPerc, field.TypeInt, value)
+		_node.DownloadPerc = value
+	}
+	if value, ok := _c.mutation.InstallPerc(); ok {
+		_spec.SetField(softwareupdate.FieldInstallPerc, field.TypeInt, value)
+		_node.InstallPerc = value
+	}
+	if value, ok := _c.mutation.ExpectedDuration(); ok {
+		_spec.SetField(softwareupdate.FieldExpectedDuration, field.TypeInt, value)
+		_node.ExpectedDuration = value
+	}
+	if value, ok := _c.mutation.AvailableAt(); ok {
+		_spec.SetField(softwareupdate.FieldAvailableAt, field.TypeTime, value)
+		_node.AvailableAt = value
+	}
+	if value, ok := _c.mutation.InstallStartedAt(); ok {
+		_spec.SetField(softwareupdate.FieldInstallStartedAt, field.TypeTime, value)
+		_node.InstallStartedAt = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(softwareupdate.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(softwareupdate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(softwareupdate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// SoftwareUpdateCreateBulk is the builder for creating many SoftwareUpdate entities in bulk.
+type SoftwareUpdateCreateBulk struct {
+	config
+	err      error
+	builders []*SoftwareUpdateCreate
+}
+
+// Save creates the SoftwareUpdate entities in the database.
+func (_c *SoftwareUpdateCreateBulk) Save(ctx context.Context) ([]*SoftwareUpdate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SoftwareUpdate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SoftwareUpdateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SoftwareUpdateCreateBulk) SaveX(ctx context.Context) []*SoftwareUpdate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SoftwareUpdateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SoftwareUpdateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
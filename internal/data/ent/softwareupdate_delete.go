@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"teslatrack/internal/data/ent/predicate"
+	"teslatrack/internal/data/ent/softwareupdate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SoftwareUpdateDelete is the builder for deleting a SoftwareUpdate entity.
+type SoftwareUpdateDelete struct {
+	config
+	hooks    []Hook
+	mutation *SoftwareUpdateMutation
+}
+
+// Where appends a list predicates to the SoftwareUpdateDelete builder.
+func (_d *SoftwareUpdateDelete) Where(ps ...predicate.SoftwareUpdate) *SoftwareUpdateDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *SoftwareUpdateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SoftwareUpdateDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *SoftwareUpdateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(softwareupdate.Table, sqlgraph.NewFieldSpec(softwareupdate.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// SoftwareUpdateDeleteOne is the builder for deleting a single SoftwareUpdate entity.
+type SoftwareUpdateDeleteOne struct {
+	_d *SoftwareUpdateDelete
+}
+
+// Where appends a list predicates to the SoftwareUpdateDelete builder.
+func (_d *SoftwareUpdateDeleteOne) Where(ps ...predicate.SoftwareUpdate) *SoftwareUpdateDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *SoftwareUpdateDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{softwareupdate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *SoftwareUpdateDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
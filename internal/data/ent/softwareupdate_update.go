@@ -0,0 +1,686 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/predicate"
+	"teslatrack/internal/data/ent/softwareupdate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SoftwareUpdateUpdate is the builder for updating SoftwareUpdate entities.
+type SoftwareUpdateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SoftwareUpdateMutation
+}
+
+// Where appends a list predicates to the SoftwareUpdateUpdate builder.
+func (_u *SoftwareUpdateUpdate) Where(ps ...predicate.SoftwareUpdate) *SoftwareUpdateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_u *SoftwareUpdateUpdate) SetVehicleID(v int) *SoftwareUpdateUpdate {
+	_u.mutation.ResetVehicleID()
+	_u.mutation.SetVehicleID(v)
+	return _u
+}
+
+// SetNillableVehicleID sets the "vehicle_id" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdate) SetNillableVehicleID(v *int) *SoftwareUpdateUpdate {
+	if v != nil {
+		_u.SetVehicleID(*v)
+	}
+	return _u
+}
+
+// AddVehicleID adds value to the "vehicle_id" field.
+func (_u *SoftwareUpdateUpdate) AddVehicleID(v int) *SoftwareUpdateUpdate {
+	_u.mutation.AddVehicleID(v)
+	return _u
+}
+
+// SetFromVersion sets the "from_version" field.
+func (_u *SoftwareUpdateUpdate) SetFromVersion(v string) *SoftwareUpdateUpdate {
+	_u.mutation.SetFromVersion(v)
+	return _u
+}
+
+// SetNillableFromVersion sets the "from_version" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdate) SetNillableFromVersion(v *string) *SoftwareUpdateUpdate {
+	if v != nil {
+		_u.SetFromVersion(*v)
+	}
+	return _u
+}
+
+// ClearFromVersion clears the value of the "from_version" field.
+func (_u *SoftwareUpdateUpdate) ClearFromVersion() *SoftwareUpdateUpdate {
+	_u.mutation.ClearFromVersion()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *SoftwareUpdateUpdate) SetVersion(v string) *SoftwareUpdateUpdate {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdate) SetNillableVersion(v *string) *SoftwareUpdateUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SoftwareUpdateUpdate) SetStatus(v string) *SoftwareUpdateUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdate) SetNillableStatus(v *string) *SoftwareUpdateUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDownloadPerc sets the "download_perc" field.
+func (_u *SoftwareUpdateUpdate) SetDownloadPerc(v int) *SoftwareUpdateUpdate {
+	_u.mutation.ResetDownloadPerc()
+	_u.mutation.SetDownloadPerc(v)
+	return _u
+}
+
+// SetNillableDownloadPerc sets the "download_perc" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdate) SetNillableDownloadPerc(v *int) *SoftwareUpdateUpdate {
+	if v != nil {
+		_u.SetDownloadPerc(*v)
+	}
+	return _u
+}
+
+// AddDownloadPerc adds value to the "download_perc" field.
+func (_u *SoftwareUpdateUpdate) AddDownloadPerc(v int) *SoftwareUpdateUpdate {
+	_u.mutation.AddDownloadPerc(v)
+	return _u
+}
+
+// SetInstallPerc sets the "install_perc" field.
+func (_u *SoftwareUpdateUpdate) SetInstallPerc(v int) *SoftwareUpdateUpdate {
+	_u.mutation.ResetInstallPerc()
+	_u.mutation.SetInstallPerc(v)
+	return _u
+}
+
+// SetNillableInstallPerc sets the "install_perc" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdate) SetNillableInstallPerc(v *int) *SoftwareUpdateUpdate {
+	if v != nil {
+		_u.SetInstallPerc(*v)
+	}
+	return _u
+}
+
+// AddInstallPerc adds value to the "install_perc" field.
+func (_u *SoftwareUpdateUpdate) AddInstallPerc(v int) *SoftwareUpdateUpdate {
+	_u.mutation.AddInstallPerc(v)
+	return _u
+}
+
+// SetExpectedDuration sets the "expected_duration" field.
+func (_u *SoftwareUpdateUpdate) SetExpectedDuration(v int) *SoftwareUpdateUpdate {
+	_u.mutation.ResetExpectedDuration()
+	_u.mutation.SetExpectedDuration(v)
+	return _u
+}
+
+// SetNillableExpectedDuration sets the "expected_duration" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdate) SetNillableExpectedDuration(v *int) *SoftwareUpdateUpdate {
+	if v != nil {
+		_u.SetExpectedDuration(*v)
+	}
+	return _u
+}
+
+// AddExpectedDuration adds value to the "expected_duration" field.
+func (_u *SoftwareUpdateUpdate) AddExpectedDuration(v int) *SoftwareUpdateUpdate {
+	_u.mutation.AddExpectedDuration(v)
+	return _u
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (_u *SoftwareUpdateUpdate) SetAvailableAt(v time.Time) *SoftwareUpdateUpdate {
+	_u.mutation.SetAvailableAt(v)
+	return _u
+}
+
+// SetNillableAvailableAt sets the "available_at" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdate) SetNillableAvailableAt(v *time.Time) *SoftwareUpdateUpdate {
+	if v != nil {
+		_u.SetAvailableAt(*v)
+	}
+	return _u
+}
+
+// SetInstallStartedAt sets the "install_started_at" field.
+func (_u *SoftwareUpdateUpdate) SetInstallStartedAt(v time.Time) *SoftwareUpdateUpdate {
+	_u.mutation.SetInstallStartedAt(v)
+	return _u
+}
+
+// SetNillableInstallStartedAt sets the "install_started_at" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdate) SetNillableInstallStartedAt(v *time.Time) *SoftwareUpdateUpdate {
+	if v != nil {
+		_u.SetInstallStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearInstallStartedAt clears the value of the "install_started_at" field.
+func (_u *SoftwareUpdateUpdate) ClearInstallStartedAt() *SoftwareUpdateUpdate {
+	_u.mutation.ClearInstallStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *SoftwareUpdateUpdate) SetCompletedAt(v time.Time) *SoftwareUpdateUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdate) SetNillableCompletedAt(v *time.Time) *SoftwareUpdateUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *SoftwareUpdateUpdate) ClearCompletedAt() *SoftwareUpdateUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SoftwareUpdateUpdate) SetUpdatedAt(v time.Time) *SoftwareUpdateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SoftwareUpdateMutation object of the builder.
+func (_u *SoftwareUpdateUpdate) Mutation() *SoftwareUpdateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SoftwareUpdateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SoftwareUpdateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SoftwareUpdateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SoftwareUpdateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SoftwareUpdateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := softwareupdate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *SoftwareUpdateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(softwareupdate.Table, softwareupdate.Columns, sqlgraph.NewFieldSpec(softwareupdate.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VehicleID(); ok {
+		_spec.SetField(softwareupdate.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVehicleID(); ok {
+		_spec.AddField(softwareupdate.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FromVersion(); ok {
+		_spec.SetField(softwareupdate.FieldFromVersion, field.TypeString, value)
+	}
+	if _u.mutation.FromVersionCleared() {
+		_spec.ClearField(softwareupdate.FieldFromVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(softwareupdate.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(softwareupdate.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DownloadPerc(); ok {
+		_spec.SetField(softwareupdate.FieldDownloadPerc, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadPerc(); ok {
+		_spec.AddField(softwareupdate.FieldDownloadPerc, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InstallPerc(); ok {
+		_spec.SetField(softwareupdate.FieldInstallPerc, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInstallPerc(); ok {
+		_spec.AddField(softwareupdate.FieldInstallPerc, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpectedDuration(); ok {
+		_spec.SetField(softwareupdate.FieldExpectedDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExpectedDuration(); ok {
+		_spec.AddField(softwareupdate.FieldExpectedDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AvailableAt(); ok {
+		_spec.SetField(softwareupdate.FieldAvailableAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.InstallStartedAt(); ok {
+		_spec.SetField(softwareupdate.FieldInstallStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.InstallStartedAtCleared() {
+		_spec.ClearField(softwareupdate.FieldInstallStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(softwareupdate.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(softwareupdate.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(softwareupdate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{softwareupdate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SoftwareUpdateUpdateOne is the builder for updating a single SoftwareUpdate entity.
+type SoftwareUpdateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SoftwareUpdateMutation
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_u *SoftwareUpdateUpdateOne) SetVehicleID(v int) *SoftwareUpdateUpdateOne {
+	_u.mutation.ResetVehicleID()
+	_u.mutation.SetVehicleID(v)
+	return _u
+}
+
+// SetNillableVehicleID sets the "vehicle_id" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdateOne) SetNillableVehicleID(v *int) *SoftwareUpdateUpdateOne {
+	if v != nil {
+		_u.SetVehicleID(*v)
+	}
+	return _u
+}
+
+// AddVehicleID adds value to the "vehicle_id" field.
+func (_u *SoftwareUpdateUpdateOne) AddVehicleID(v int) *SoftwareUpdateUpdateOne {
+	_u.mutation.AddVehicleID(v)
+	return _u
+}
+
+// SetFromVersion sets the "from_version" field.
+func (_u *SoftwareUpdateUpdateOne) SetFromVersion(v string) *SoftwareUpdateUpdateOne {
+	_u.mutation.SetFromVersion(v)
+	return _u
+}
+
+// SetNillableFromVersion sets the "from_version" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdateOne) SetNillableFromVersion(v *string) *SoftwareUpdateUpdateOne {
+	if v != nil {
+		_u.SetFromVersion(*v)
+	}
+	return _u
+}
+
+// ClearFromVersion clears the value of the "from_version" field.
+func (_u *SoftwareUpdateUpdateOne) ClearFromVersion() *SoftwareUpdateUpdateOne {
+	_u.mutation.ClearFromVersion()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *SoftwareUpdateUpdateOne) SetVersion(v string) *SoftwareUpdateUpdateOne {
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdateOne) SetNillableVersion(v *string) *SoftwareUpdateUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SoftwareUpdateUpdateOne) SetStatus(v string) *SoftwareUpdateUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdateOne) SetNillableStatus(v *string) *SoftwareUpdateUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDownloadPerc sets the "download_perc" field.
+func (_u *SoftwareUpdateUpdateOne) SetDownloadPerc(v int) *SoftwareUpdateUpdateOne {
+	_u.mutation.ResetDownloadPerc()
+	_u.mutation.SetDownloadPerc(v)
+	return _u
+}
+
+// SetNillableDownloadPerc sets the "download_perc" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdateOne) SetNillableDownloadPerc(v *int) *SoftwareUpdateUpdateOne {
+	if v != nil {
+		_u.SetDownloadPerc(*v)
+	}
+	return _u
+}
+
+// AddDownloadPerc adds value to the "download_perc" field.
+func (_u *SoftwareUpdateUpdateOne) AddDownloadPerc(v int) *SoftwareUpdateUpdateOne {
+	_u.mutation.AddDownloadPerc(v)
+	return _u
+}
+
+// SetInstallPerc sets the "install_perc" field.
+func (_u *SoftwareUpdateUpdateOne) SetInstallPerc(v int) *SoftwareUpdateUpdateOne {
+	_u.mutation.ResetInstallPerc()
+	_u.mutation.SetInstallPerc(v)
+	return _u
+}
+
+// SetNillableInstallPerc sets the "install_perc" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdateOne) SetNillableInstallPerc(v *int) *SoftwareUpdateUpdateOne {
+	if v != nil {
+		_u.SetInstallPerc(*v)
+	}
+	return _u
+}
+
+// AddInstallPerc adds value to the "install_perc" field.
+func (_u *SoftwareUpdateUpdateOne) AddInstallPerc(v int) *SoftwareUpdateUpdateOne {
+	_u.mutation.AddInstallPerc(v)
+	return _u
+}
+
+// SetExpectedDuration sets the "expected_duration" field.
+func (_u *SoftwareUpdateUpdateOne) SetExpectedDuration(v int) *SoftwareUpdateUpdateOne {
+	_u.mutation.ResetExpectedDuration()
+	_u.mutation.SetExpectedDuration(v)
+	return _u
+}
+
+// SetNillableExpectedDuration sets the "expected_duration" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdateOne) SetNillableExpectedDuration(v *int) *SoftwareUpdateUpdateOne {
+	if v != nil {
+		_u.SetExpectedDuration(*v)
+	}
+	return _u
+}
+
+// AddExpectedDuration adds value to the "expected_duration" field.
+func (_u *SoftwareUpdateUpdateOne) AddExpectedDuration(v int) *SoftwareUpdateUpdateOne {
+	_u.mutation.AddExpectedDuration(v)
+	return _u
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (_u *SoftwareUpdateUpdateOne) SetAvailableAt(v time.Time) *SoftwareUpdateUpdateOne {
+	_u.mutation.SetAvailableAt(v)
+	return _u
+}
+
+// SetNillableAvailableAt sets the "available_at" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdateOne) SetNillableAvailableAt(v *time.Time) *SoftwareUpdateUpdateOne {
+	if v != nil {
+		_u.SetAvailableAt(*v)
+	}
+	return _u
+}
+
+// SetInstallStartedAt sets the "install_started_at" field.
+func (_u *SoftwareUpdateUpdateOne) SetInstallStartedAt(v time.Time) *SoftwareUpdateUpdateOne {
+	_u.mutation.SetInstallStartedAt(v)
+	return _u
+}
+
+// SetNillableInstallStartedAt sets the "install_started_at" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdateOne) SetNillableInstallStartedAt(v *time.Time) *SoftwareUpdateUpdateOne {
+	if v != nil {
+		_u.SetInstallStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearInstallStartedAt clears the value of the "install_started_at" field.
+func (_u *SoftwareUpdateUpdateOne) ClearInstallStartedAt() *SoftwareUpdateUpdateOne {
+	_u.mutation.ClearInstallStartedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *SoftwareUpdateUpdateOne) SetCompletedAt(v time.Time) *SoftwareUpdateUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *SoftwareUpdateUpdateOne) SetNillableCompletedAt(v *time.Time) *SoftwareUpdateUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *SoftwareUpdateUpdateOne) ClearCompletedAt() *SoftwareUpdateUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SoftwareUpdateUpdateOne) SetUpdatedAt(v time.Time) *SoftwareUpdateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SoftwareUpdateMutation object of the builder.
+func (_u *SoftwareUpdateUpdateOne) Mutation() *SoftwareUpdateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SoftwareUpdateUpdate builder.
+func (_u *SoftwareUpdateUpdateOne) Where(ps ...predicate.SoftwareUpdate) *SoftwareUpdateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SoftwareUpdateUpdateOne) Select(field string, fields ...string) *SoftwareUpdateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SoftwareUpdate entity.
+func (_u *SoftwareUpdateUpdateOne) Save(ctx context.Context) (*SoftwareUpdate, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SoftwareUpdateUpdateOne) SaveX(ctx context.Context) *SoftwareUpdate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SoftwareUpdateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SoftwareUpdateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SoftwareUpdateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := softwareupdate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *SoftwareUpdateUpdateOne) sqlSave(ctx context.Context) (_node *SoftwareUpdate, err error) {
+	_spec := sqlgraph.NewUpdateSpec(softwareupdate.Table, softwareupdate.Columns, sqlgraph.NewFieldSpec(softwareupdate.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SoftwareUpdate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, softwareupdate.FieldID)
+		for _, f := range fields {
+			if !softwareupdate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != softwareupdate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VehicleID(); ok {
+		_spec.SetField(softwareupdate.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVehicleID(); ok {
+		_spec.AddField(softwareupdate.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FromVersion(); ok {
+		_spec.SetField(softwareupdate.FieldFromVersion, field.TypeString, value)
+	}
+	if _u.mutation.FromVersionCleared() {
+		_spec.ClearField(softwareupdate.FieldFromVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(softwareupdate.FieldVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(softwareupdate.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DownloadPerc(); ok {
+		_spec.SetField(softwareupdate.FieldDownloadPerc, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadPerc(); ok {
+		_spec.AddField(softwareupdate.FieldDownloadPerc, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.InstallPerc(); ok {
+		_spec.SetField(softwareupdate.FieldInstallPerc, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInstallPerc(); ok {
+		_spec.AddField(softwareupdate.FieldInstallPerc, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpectedDuration(); ok {
+		_spec.SetField(softwareupdate.FieldExpectedDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExpectedDuration(); ok {
+		_spec.AddField(softwareupdate.FieldExpectedDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AvailableAt(); ok {
+		_spec.SetField(softwareupdate.FieldAvailableAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.InstallStartedAt(); ok {
+		_spec.SetField(softwareupdate.FieldInstallStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.InstallStartedAtCleared() {
+		_spec.ClearField(softwareupdate.FieldInstallStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(softwareupdate.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(softwareupdate.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(softwareupdate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &SoftwareUpdate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{softwareupdate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
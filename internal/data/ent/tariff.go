@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"teslatrack/internal/data/ent/tariff"
+	"teslatrack/pkg/pricing"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Electricity tariff table
+type Tariff struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Owner user ID
+	UserID int `json:"user_id,omitempty"`
+	// Display name
+	Name string `json:"name,omitempty"`
+	// Kind, flat or tou (time-of-use)
+	Kind string `json:"kind,omitempty"`
+	// Geofence the tariff applies to, null for the default tariff
+	GeofenceID *int `json:"geofence_id,omitempty"`
+	// ISO-4217 currency code
+	Currency string `json:"currency,omitempty"`
+	// Price per kWh, outside all windows for time-of-use tariffs
+	Price float64 `json:"price,omitempty"`
+	// Time-of-use windows
+	Windows []pricing.Window `json:"windows,omitempty"`
+	// IANA time zone of the windows
+	TimeZone string `json:"time_zone,omitempty"`
+	// Creation time
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update time
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Tariff) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tariff.FieldWindows:
+			values[i] = new([]byte)
+		case tariff.FieldPrice:
+			values[i] = new(sql.NullFloat64)
+		case tariff.FieldID, tariff.FieldUserID, tariff.FieldGeofenceID:
+			values[i] = new(sql.NullInt64)
+		case tariff.FieldName, tariff.FieldKind, tariff.FieldCurrency, tariff.FieldTimeZone:
+			values[i] = new(sql.NullString)
+		case tariff.FieldCreatedAt, tariff.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Tariff fields.
+func (_m *Tariff) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tariff.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case tariff.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = int(value.Int64)
+			}
+		case tariff.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case tariff.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case tariff.FieldGeofenceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field geofence_id", values[i])
+			} else if value.Valid {
+				_m.GeofenceID = new(int)
+				*_m.GeofenceID = int(value.Int64)
+			}
+		case tariff.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case tariff.FieldPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				_m.Price = value.Float64
+			}
+		case tariff.FieldWindows:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field windows", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Windows); err != nil {
+					return fmt.Errorf("unmarshal field windows: %w", err)
+				}
+			}
+		case tariff.FieldTimeZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field time_zone", values[i])
+			} else if value.Valid {
+				_m.TimeZone = value.String
+			}
+		case tariff.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case tariff.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Tariff.
+// This includes values selected through modifiers, order, etc.
+func (_m *Tariff) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Tariff.
+// Note that you need to call Tariff.Unwrap() before calling this method if this Tariff
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Tariff) Update() *TariffUpdateOne {
+	return NewTariffClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Tariff entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Tariff) Unwrap() *Tariff {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Tariff is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Tariff) String() string {
+	var builder strings.Builder
+	builder.WriteString("Tariff(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	if v := _m.GeofenceID; v != nil {
+		builder.WriteString("geofence_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Price))
+	builder.WriteString(", ")
+	builder.WriteString("windows=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Windows))
+	builder.WriteString(", ")
+	builder.WriteString("time_zone=")
+	builder.WriteString(_m.TimeZone)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Tariffs is a parsable slice of Tariff.
+type Tariffs []*Tariff
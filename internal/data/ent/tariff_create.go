@@ -0,0 +1,370 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/tariff"
+	"teslatrack/pkg/pricing"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TariffCreate is the builder for creating a Tariff entity.
+type TariffCreate struct {
+	config
+	mutation *TariffMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *TariffCreate) SetUserID(v int) *TariffCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *TariffCreate) SetName(v string) *TariffCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *TariffCreate) SetKind(v string) *TariffCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetGeofenceID sets the "geofence_id" field.
+func (_c *TariffCreate) SetGeofenceID(v int) *TariffCreate {
+	_c.mutation.SetGeofenceID(v)
+	return _c
+}
+
+// SetNillableGeofenceID sets the "geofence_id" field if the given value is not nil.
+func (_c *TariffCreate) SetNillableGeofenceID(v *int) *TariffCreate {
+	if v != nil {
+		_c.SetGeofenceID(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *TariffCreate) SetCurrency(v string) *TariffCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *TariffCreate) SetNillableCurrency(v *string) *TariffCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetPrice sets the "price" field.
+func (_c *TariffCreate) SetPrice(v float64) *TariffCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_c *TariffCreate) SetNillablePrice(v *float64) *TariffCreate {
+	if v != nil {
+		_c.SetPrice(*v)
+	}
+	return _c
+}
+
+// SetWindows sets the "windows" field.
+func (_c *TariffCreate) SetWindows(v []pricing.Window) *TariffCreate {
+	_c.mutation.SetWindows(v)
+	return _c
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_c *TariffCreate) SetTimeZone(v string) *TariffCreate {
+	_c.mutation.SetTimeZone(v)
+	return _c
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_c *TariffCreate) SetNillableTimeZone(v *string) *TariffCreate {
+	if v != nil {
+		_c.SetTimeZone(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TariffCreate) SetCreatedAt(v time.Time) *TariffCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TariffCreate) SetNillableCreatedAt(v *time.Time) *TariffCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TariffCreate) SetUpdatedAt(v time.Time) *TariffCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TariffCreate) SetNillableUpdatedAt(v *time.Time) *TariffCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TariffMutation object of the builder.
+func (_c *TariffCreate) Mutation() *TariffMutation {
+	return _c.mutation
+}
+
+// Save creates the Tariff in the database.
+func (_c *TariffCreate) Save(ctx context.Context) (*Tariff, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TariffCreate) SaveX(ctx context.Context) *Tariff {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TariffCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TariffCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TariffCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := tariff.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.Price(); !ok {
+		v := tariff.DefaultPrice
+		_c.mutation.SetPrice(v)
+	}
+	if _, ok := _c.mutation.TimeZone(); !ok {
+		v := tariff.DefaultTimeZone
+		_c.mutation.SetTimeZone(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := tariff.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := tariff.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TariffCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Tariff.user_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Tariff.name"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Tariff.kind"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Tariff.currency"`)}
+	}
+	if _, ok := _c.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "Tariff.price"`)}
+	}
+	if _, ok := _c.mutation.TimeZone(); !ok {
+		return &ValidationError{Name: "time_zone", err: errors.New(`ent: missing required field "Tariff.time_zone"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Tariff.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Tariff.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *TariffCreate) sqlSave(ctx context.Context) (*Tariff, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TariffCreate) createSpec() (*Tariff, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Tariff{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tariff.Table, sqlgraph.NewFieldSpec(tariff.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(tariff.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(tariff.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(tariff.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.GeofenceID(); ok {
+		_spec.SetField(tariff.FieldGeofenceID, field.TypeInt, value)
+		_node.GeofenceID = &value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(tariff.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(tariff.FieldPrice, field.TypeFloat64, value)
+		_node.Price = value
+	}
+	if value, ok := _c.mutation.Windows(); ok {
+		_spec.SetField(tariff.FieldWindows, field.TypeJSON, value)
+		_node.Windows = value
+	}
+	if value, ok := _c.mutation.TimeZone(); ok {
+		_spec.SetField(tariff.FieldTimeZone, field.TypeString, value)
+		_node.TimeZone = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tariff.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(tariff.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// TariffCreateBulk is the builder for creating many Tariff entities in bulk.
+type TariffCreateBulk struct {
+	config
+	err      error
+	builders []*TariffCreate
+}
+
+// Save creates the Tariff entities in the database.
+func (_c *TariffCreateBulk) Save(ctx context.Context) ([]*Tariff, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Tariff, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TariffMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TariffCreateBulk) SaveX(ctx context.Context) []*Tariff {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TariffCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TariffCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
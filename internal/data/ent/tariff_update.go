@@ -0,0 +1,588 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/predicate"
+	"teslatrack/internal/data/ent/tariff"
+	"teslatrack/pkg/pricing"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// TariffUpdate is the builder for updating Tariff entities.
+type TariffUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TariffMutation
+}
+
+// Where appends a list predicates to the TariffUpdate builder.
+func (_u *TariffUpdate) Where(ps ...predicate.Tariff) *TariffUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TariffUpdate) SetUserID(v int) *TariffUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TariffUpdate) SetNillableUserID(v *int) *TariffUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *TariffUpdate) AddUserID(v int) *TariffUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TariffUpdate) SetName(v string) *TariffUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TariffUpdate) SetNillableName(v *string) *TariffUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *TariffUpdate) SetKind(v string) *TariffUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *TariffUpdate) SetNillableKind(v *string) *TariffUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetGeofenceID sets the "geofence_id" field.
+func (_u *TariffUpdate) SetGeofenceID(v int) *TariffUpdate {
+	_u.mutation.ResetGeofenceID()
+	_u.mutation.SetGeofenceID(v)
+	return _u
+}
+
+// SetNillableGeofenceID sets the "geofence_id" field if the given value is not nil.
+func (_u *TariffUpdate) SetNillableGeofenceID(v *int) *TariffUpdate {
+	if v != nil {
+		_u.SetGeofenceID(*v)
+	}
+	return _u
+}
+
+// AddGeofenceID adds value to the "geofence_id" field.
+func (_u *TariffUpdate) AddGeofenceID(v int) *TariffUpdate {
+	_u.mutation.AddGeofenceID(v)
+	return _u
+}
+
+// ClearGeofenceID clears the value of the "geofence_id" field.
+func (_u *TariffUpdate) ClearGeofenceID() *TariffUpdate {
+	_u.mutation.ClearGeofenceID()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *TariffUpdate) SetCurrency(v string) *TariffUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *TariffUpdate) SetNillableCurrency(v *string) *TariffUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *TariffUpdate) SetPrice(v float64) *TariffUpdate {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *TariffUpdate) SetNillablePrice(v *float64) *TariffUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *TariffUpdate) AddPrice(v float64) *TariffUpdate {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetWindows sets the "windows" field.
+func (_u *TariffUpdate) SetWindows(v []pricing.Window) *TariffUpdate {
+	_u.mutation.SetWindows(v)
+	return _u
+}
+
+// AppendWindows appends value to the "windows" field.
+func (_u *TariffUpdate) AppendWindows(v []pricing.Window) *TariffUpdate {
+	_u.mutation.AppendWindows(v)
+	return _u
+}
+
+// ClearWindows clears the value of the "windows" field.
+func (_u *TariffUpdate) ClearWindows() *TariffUpdate {
+	_u.mutation.ClearWindows()
+	return _u
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_u *TariffUpdate) SetTimeZone(v string) *TariffUpdate {
+	_u.mutation.SetTimeZone(v)
+	return _u
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_u *TariffUpdate) SetNillableTimeZone(v *string) *TariffUpdate {
+	if v != nil {
+		_u.SetTimeZone(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TariffUpdate) SetUpdatedAt(v time.Time) *TariffUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TariffMutation object of the builder.
+func (_u *TariffUpdate) Mutation() *TariffMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TariffUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TariffUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TariffUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TariffUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TariffUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := tariff.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *TariffUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tariff.Table, tariff.Columns, sqlgraph.NewFieldSpec(tariff.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(tariff.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(tariff.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tariff.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(tariff.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GeofenceID(); ok {
+		_spec.SetField(tariff.FieldGeofenceID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGeofenceID(); ok {
+		_spec.AddField(tariff.FieldGeofenceID, field.TypeInt, value)
+	}
+	if _u.mutation.GeofenceIDCleared() {
+		_spec.ClearField(tariff.FieldGeofenceID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(tariff.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(tariff.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(tariff.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Windows(); ok {
+		_spec.SetField(tariff.FieldWindows, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWindows(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tariff.FieldWindows, value)
+		})
+	}
+	if _u.mutation.WindowsCleared() {
+		_spec.ClearField(tariff.FieldWindows, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TimeZone(); ok {
+		_spec.SetField(tariff.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tariff.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tariff.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TariffUpdateOne is the builder for updating a single Tariff entity.
+type TariffUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TariffMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TariffUpdateOne) SetUserID(v int) *TariffUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TariffUpdateOne) SetNillableUserID(v *int) *TariffUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *TariffUpdateOne) AddUserID(v int) *TariffUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TariffUpdateOne) SetName(v string) *TariffUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TariffUpdateOne) SetNillableName(v *string) *TariffUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *TariffUpdateOne) SetKind(v string) *TariffUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *TariffUpdateOne) SetNillableKind(v *string) *TariffUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetGeofenceID sets the "geofence_id" field.
+func (_u *TariffUpdateOne) SetGeofenceID(v int) *TariffUpdateOne {
+	_u.mutation.ResetGeofenceID()
+	_u.mutation.SetGeofenceID(v)
+	return _u
+}
+
+// SetNillableGeofenceID sets the "geofence_id" field if the given value is not nil.
+func (_u *TariffUpdateOne) SetNillableGeofenceID(v *int) *TariffUpdateOne {
+	if v != nil {
+		_u.SetGeofenceID(*v)
+	}
+	return _u
+}
+
+// AddGeofenceID adds value to the "geofence_id" field.
+func (_u *TariffUpdateOne) AddGeofenceID(v int) *TariffUpdateOne {
+	_u.mutation.AddGeofenceID(v)
+	return _u
+}
+
+// ClearGeofenceID clears the value of the "geofence_id" field.
+func (_u *TariffUpdateOne) ClearGeofenceID() *TariffUpdateOne {
+	_u.mutation.ClearGeofenceID()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *TariffUpdateOne) SetCurrency(v string) *TariffUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *TariffUpdateOne) SetNillableCurrency(v *string) *TariffUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *TariffUpdateOne) SetPrice(v float64) *TariffUpdateOne {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *TariffUpdateOne) SetNillablePrice(v *float64) *TariffUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *TariffUpdateOne) AddPrice(v float64) *TariffUpdateOne {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetWindows sets the "windows" field.
+func (_u *TariffUpdateOne) SetWindows(v []pricing.Window) *TariffUpdateOne {
+	_u.mutation.SetWindows(v)
+	return _u
+}
+
+// AppendWindows appends value to the "windows" field.
+func (_u *TariffUpdateOne) AppendWindows(v []pricing.Window) *TariffUpdateOne {
+	_u.mutation.AppendWindows(v)
+	return _u
+}
+
+// ClearWindows clears the value of the "windows" field.
+func (_u *TariffUpdateOne) ClearWindows() *TariffUpdateOne {
+	_u.mutation.ClearWindows()
+	return _u
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_u *TariffUpdateOne) SetTimeZone(v string) *TariffUpdateOne {
+	_u.mutation.SetTimeZone(v)
+	return _u
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_u *TariffUpdateOne) SetNillableTimeZone(v *string) *TariffUpdateOne {
+	if v != nil {
+		_u.SetTimeZone(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TariffUpdateOne) SetUpdatedAt(v time.Time) *TariffUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TariffMutation object of the builder.
+func (_u *TariffUpdateOne) Mutation() *TariffMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TariffUpdate builder.
+func (_u *TariffUpdateOne) Where(ps ...predicate.Tariff) *TariffUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TariffUpdateOne) Select(field string, fields ...string) *TariffUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Tariff entity.
+func (_u *TariffUpdateOne) Save(ctx context.Context) (*Tariff, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TariffUpdateOne) SaveX(ctx context.Context) *Tariff {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TariffUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TariffUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TariffUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := tariff.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *TariffUpdateOne) sqlSave(ctx context.Context) (_node *Tariff, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tariff.Table, tariff.Columns, sqlgraph.NewFieldSpec(tariff.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Tariff.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tariff.FieldID)
+		for _, f := range fields {
+			if !tariff.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tariff.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(tariff.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(tariff.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tariff.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(tariff.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GeofenceID(); ok {
+		_spec.SetField(tariff.FieldGeofenceID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGeofenceID(); ok {
+		_spec.AddField(tariff.FieldGeofenceID, field.TypeInt, value)
+	}
+	if _u.mutation.GeofenceIDCleared() {
+		_spec.ClearField(tariff.FieldGeofenceID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(tariff.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(tariff.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(tariff.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Windows(); ok {
+		_spec.SetField(tariff.FieldWindows, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedWindows(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tariff.FieldWindows, value)
+		})
+	}
+	if _u.mutation.WindowsCleared() {
+		_spec.ClearField(tariff.FieldWindows, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.TimeZone(); ok {
+		_spec.SetField(tariff.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tariff.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Tariff{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tariff.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"teslatrack/internal/data/ent/tirepressure"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Tire pressure history table
+type TirePressure struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Associated vehicle ID
+	VehicleID int `json:"vehicle_id,omitempty"`
+	// Front left pressure in bar, 0 when unknown
+	PressureFl float64 `json:"pressure_fl,omitempty"`
+	// Front right pressure in bar, 0 when unknown
+	PressureFr float64 `json:"pressure_fr,omitempty"`
+	// Rear left pressure in bar, 0 when unknown
+	PressureRl float64 `json:"pressure_rl,omitempty"`
+	// Rear right pressure in bar, 0 when unknown
+	PressureRr float64 `json:"pressure_rr,omitempty"`
+	// Time the front left sensor last reported
+	SeenAtFl *time.Time `json:"seen_at_fl,omitempty"`
+	// Time the front right sensor last reported
+	SeenAtFr *time.Time `json:"seen_at_fr,omitempty"`
+	// Time the rear left sensor last reported
+	SeenAtRl *time.Time `json:"seen_at_rl,omitempty"`
+	// Time the rear right sensor last reported
+	SeenAtRr *time.Time `json:"seen_at_rr,omitempty"`
+	// Recommended cold pressure of the front tires in bar
+	RecommendedFront float64 `json:"recommended_front,omitempty"`
+	// Recommended cold pressure of the rear tires in bar
+	RecommendedRear float64 `json:"recommended_rear,omitempty"`
+	// Soft TPMS warnings, one bit per wheel in the order fl, fr, rl, rr
+	SoftWarnings int `json:"soft_warnings,omitempty"`
+	// Hard TPMS warnings, one bit per wheel in the order fl, fr, rl, rr
+	HardWarnings int `json:"hard_warnings,omitempty"`
+	// Pressure unit shown in the vehicle, e.g., Psi, kPa, Bar
+	Unit string `json:"unit,omitempty"`
+	// Creation time
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TirePressure) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tirepressure.FieldPressureFl, tirepressure.FieldPressureFr, tirepressure.FieldPressureRl, tirepressure.FieldPressureRr, tirepressure.FieldRecommendedFront, tirepressure.FieldRecommendedRear:
+			values[i] = new(sql.NullFloat64)
+		case tirepressure.FieldID, tirepressure.FieldVehicleID, tirepressure.FieldSoftWarnings, tirepressure.FieldHardWarnings:
+			values[i] = new(sql.NullInt64)
+		case tirepressure.FieldUnit:
+			values[i] = new(sql.NullString)
+		case tirepressure.FieldSeenAtFl, tirepressure.FieldSeenAtFr, tirepressure.FieldSeenAtRl, tirepressure.FieldSeenAtRr, tirepressure.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TirePressure fields.
+func (_m *TirePressure) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tirepressure.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case tirepressure.FieldVehicleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vehicle_id", values[i])
+			} else if value.Valid {
+				_m.VehicleID = int(value.Int64)
+			}
+		case tirepressure.FieldPressureFl:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field pressure_fl", values[i])
+			} else if value.Valid {
+				_m.PressureFl = value.Float64
+			}
+		case tirepressure.FieldPressureFr:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field pressure_fr", values[i])
+			} else if value.Valid {
+				_m.PressureFr = value.Float64
+			}
+		case tirepressure.FieldPressureRl:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field pressure_rl", values[i])
+			} else if value.Valid {
+				_m.PressureRl = value.Float64
+			}
+		case tirepressure.FieldPressureRr:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field pressure_rr", values[i])
+			} else if value.Valid {
+				_m.PressureRr = value.Float64
+			}
+		case tirepressure.FieldSeenAtFl:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field seen_at_fl", values[i])
+			} else if value.Valid {
+				_m.SeenAtFl = new(time.Time)
+				*_m.SeenAtFl = value.Time
+			}
+		case tirepressure.FieldSeenAtFr:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field seen_at_fr", values[i])
+			} else if value.Valid {
+				_m.SeenAtFr = new(time.Time)
+				*_m.SeenAtFr = value.Time
+			}
+		case tirepressure.FieldSeenAtRl:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field seen_at_rl", values[i])
+			} else if value.Valid {
+				_m.SeenAtRl = new(time.Time)
+				*_m.SeenAtRl = value.Time
+			}
+		case tirepressure.FieldSeenAtRr:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field seen_at_rr", values[i])
+			} else if value.Valid {
+				_m.SeenAtRr = new(time.Time)
+				*_m.SeenAtRr = value.Time
+			}
+		case tirepressure.FieldRecommendedFront:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field recommended_front", values[i])
+			} else if value.Valid {
+				_m.RecommendedFront = value.Float64
+			}
+		case tirepressure.FieldRecommendedRear:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field recommended_rear", values[i])
+			} else if value.Valid {
+				_m.RecommendedRear = value.Float64
+			}
+		case tirepressure.FieldSoftWarnings:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field soft_warnings", values[i])
+			} else if value.Valid {
+				_m.SoftWarnings = int(value.Int64)
+			}
+		case tirepressure.FieldHardWarnings:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field hard_warnings", values[i])
+			} else if value.Valid {
+				_m.HardWarnings = int(value.Int64)
+			}
+		case tirepressure.FieldUnit:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field unit", values[i])
+			} else if value.Valid {
+				_m.Unit = value.String
+			}
+		case tirepressure.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TirePressure.
+// This includes values selected through modifiers, order, etc.
+func (_m *TirePressure) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TirePressure.
+// Note that you need to call TirePressure.Unwrap() before calling this method if this TirePressure
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TirePressure) Update() *TirePressureUpdateOne {
+	return NewTirePressureClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TirePressure entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TirePressure) Unwrap() *TirePressure {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TirePressure is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TirePressure) String() string {
+	var builder strings.Builder
+	builder.WriteString("TirePressure(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("vehicle_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VehicleID))
+	builder.WriteString(", ")
+	builder.WriteString("pressure_fl=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PressureFl))
+	builder.WriteString(", ")
+	builder.WriteString("pressure_fr=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PressureFr))
+	builder.WriteString(", ")
+	builder.WriteString("pressure_rl=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PressureRl))
+	builder.WriteString(", ")
+	builder.WriteString("pressure_rr=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PressureRr))
+	builder.WriteString(", ")
+	if v := _m.SeenAtFl; v != nil {
+		builder.WriteString("seen_at_fl=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.SeenAtFr; v != nil {
+		builder.WriteString("seen_at_fr=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.SeenAtRl; v != nil {
+		builder.WriteString("seen_at_rl=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.SeenAtRr; v != nil {
+		builder.WriteString("seen_at_rr=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("recommended_front=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecommendedFront))
+	builder.WriteString(", ")
+	builder.WriteString("recommended_rear=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecommendedRear))
+	builder.WriteString(", ")
+	builder.WriteString("soft_warnings=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SoftWarnings))
+	builder.WriteString(", ")
+	builder.WriteString("hard_warnings=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HardWarnings))
+	builder.WriteString(", ")
+	builder.WriteString("unit=")
+	builder.WriteString(_m.Unit)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TirePressures is a parsable slice of TirePressure.
+type TirePressures []*TirePressure
@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tirepressure
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the tirepressure type in the database.
+	Label = "tire_pressure"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVehicleID holds the string denoting the vehicle_id field in the database.
+	FieldVehicleID = "vehicle_id"
+	// FieldPressureFl holds the string denoting the pressure_fl field in the database.
+	FieldPressureFl = "pressure_fl"
+	// FieldPressureFr holds the string denoting the pressure_fr field in the database.
+	FieldPressureFr = "pressure_fr"
+	// FieldPressureRl holds the string denoting the pressure_rl field in the database.
+	FieldPressureRl = "pressure_rl"
+	// FieldPressureRr holds the string denoting the pressure_rr field in the database.
+	FieldPressureRr = "pressure_rr"
+	// FieldSeenAtFl holds the string denoting the seen_at_fl field in the database.
+	FieldSeenAtFl = "seen_at_fl"
+	// FieldSeenAtFr holds the string denoting the seen_at_fr field in the database.
+	FieldSeenAtFr = "seen_at_fr"
+	// FieldSeenAtRl holds the string denoting the seen_at_rl field in the database.
+	FieldSeenAtRl = "seen_at_rl"
+	// FieldSeenAtRr holds the string denoting the seen_at_rr field in the database.
+	FieldSeenAtRr = "seen_at_rr"
+	// FieldRecommendedFront holds the string denoting the recommended_front field in the database.
+	FieldRecommendedFront = "recommended_front"
+	// FieldRecommendedRear holds the string denoting the recommended_rear field in the database.
+	FieldRecommendedRear = "recommended_rear"
+	// FieldSoftWarnings holds the string denoting the soft_warnings field in the database.
+	FieldSoftWarnings = "soft_warnings"
+	// FieldHardWarnings holds the string denoting the hard_warnings field in the database.
+	FieldHardWarnings = "hard_warnings"
+	// FieldUnit holds the string denoting the unit field in the database.
+	FieldUnit = "unit"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the tirepressure in the database.
+	Table = "tire_pressure"
+)
+
+// Columns holds all SQL columns for tirepressure fields.
+var Columns = []string{
+	FieldID,
+	FieldVehicleID,
+	FieldPressureFl,
+	FieldPressureFr,
+	FieldPressureRl,
+	FieldPressureRr,
+	FieldSeenAtFl,
+	FieldSeenAtFr,
+	FieldSeenAtRl,
+	FieldSeenAtRr,
+	FieldRecommendedFront,
+	FieldRecommendedRear,
+	FieldSoftWarnings,
+	FieldHardWarnings,
+	FieldUnit,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultSoftWarnings holds the default value on creation for the "soft_warnings" field.
+	DefaultSoftWarnings int
+	// DefaultHardWarnings holds the default value on creation for the "hard_warnings" field.
+	DefaultHardWarnings int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the TirePressure queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVehicleID orders the results by the vehicle_id field.
+func ByVehicleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVehicleID, opts...).ToFunc()
+}
+
+// ByPressureFl orders the results by the pressure_fl field.
+func ByPressureFl(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPressureFl, opts...).ToFunc()
+}
+
+// ByPressureFr orders the results by the pressure_fr field.
+func ByPressureFr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPressureFr, opts...).ToFunc()
+}
+
+// ByPressureRl orders the results by the pressure_rl field.
+func ByPressureRl(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPressureRl, opts...).ToFunc()
+}
+
+// ByPressureRr orders the results by the pressure_rr field.
+func ByPressureRr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPressureRr, opts...).ToFunc()
+}
+
+// BySeenAtFl orders the results by the seen_at_fl field.
+func BySeenAtFl(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeenAtFl, opts...).ToFunc()
+}
+
+// BySeenAtFr orders the results by the seen_at_fr field.
+func BySeenAtFr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeenAtFr, opts...).ToFunc()
+}
+
+// BySeenAtRl orders the results by the seen_at_rl field.
+func BySeenAtRl(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeenAtRl, opts...).ToFunc()
+}
+
+// BySeenAtRr orders the results by the seen_at_rr field.
+func BySeenAtRr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeenAtRr, opts...).ToFunc()
+}
+
+// ByRecommendedFront orders the results by the recommended_front field.
+func ByRecommendedFront(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecommendedFront, opts...).ToFunc()
+}
+
+// ByRecommendedRear orders the results by the recommended_rear field.
+func ByRecommendedRear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecommendedRear, opts...).ToFunc()
+}
+
+// BySoftWarnings orders the results by the soft_warnings field.
+func BySoftWarnings(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSoftWarnings, opts...).ToFunc()
+}
+
+// ByHardWarnings orders the results by the hard_warnings field.
+func ByHardWarnings(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHardWarnings, opts...).ToFunc()
+}
+
+// ByUnit orders the results by the unit field.
+func ByUnit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnit, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,880 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tirepressure
+
+import (
+	"teslatrack/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLTE(FieldID, id))
+}
+
+// VehicleID applies equality check predicate on the "vehicle_id" field. It's identical to VehicleIDEQ.
+func VehicleID(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// PressureFl applies equality check predicate on the "pressure_fl" field. It's identical to PressureFlEQ.
+func PressureFl(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldPressureFl, v))
+}
+
+// PressureFr applies equality check predicate on the "pressure_fr" field. It's identical to PressureFrEQ.
+func PressureFr(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldPressureFr, v))
+}
+
+// PressureRl applies equality check predicate on the "pressure_rl" field. It's identical to PressureRlEQ.
+func PressureRl(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldPressureRl, v))
+}
+
+// PressureRr applies equality check predicate on the "pressure_rr" field. It's identical to PressureRrEQ.
+func PressureRr(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldPressureRr, v))
+}
+
+// SeenAtFl applies equality check predicate on the "seen_at_fl" field. It's identical to SeenAtFlEQ.
+func SeenAtFl(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldSeenAtFl, v))
+}
+
+// SeenAtFr applies equality check predicate on the "seen_at_fr" field. It's identical to SeenAtFrEQ.
+func SeenAtFr(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldSeenAtFr, v))
+}
+
+// SeenAtRl applies equality check predicate on the "seen_at_rl" field. It's identical to SeenAtRlEQ.
+func SeenAtRl(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldSeenAtRl, v))
+}
+
+// SeenAtRr applies equality check predicate on the "seen_at_rr" field. It's identical to SeenAtRrEQ.
+func SeenAtRr(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldSeenAtRr, v))
+}
+
+// RecommendedFront applies equality check predicate on the "recommended_front" field. It's identical to RecommendedFrontEQ.
+func RecommendedFront(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldRecommendedFront, v))
+}
+
+// RecommendedRear applies equality check predicate on the "recommended_rear" field. It's identical to RecommendedRearEQ.
+func RecommendedRear(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldRecommendedRear, v))
+}
+
+// SoftWarnings applies equality check predicate on the "soft_warnings" field. It's identical to SoftWarningsEQ.
+func SoftWarnings(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldSoftWarnings, v))
+}
+
+// HardWarnings applies equality check predicate on the "hard_warnings" field. It's identical to HardWarningsEQ.
+func HardWarnings(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldHardWarnings, v))
+}
+
+// Unit applies equality check predicate on the "unit" field. It's identical to UnitEQ.
+func Unit(v string) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldUnit, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// VehicleIDEQ applies the EQ predicate on the "vehicle_id" field.
+func VehicleIDEQ(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// VehicleIDNEQ applies the NEQ predicate on the "vehicle_id" field.
+func VehicleIDNEQ(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNEQ(FieldVehicleID, v))
+}
+
+// VehicleIDIn applies the In predicate on the "vehicle_id" field.
+func VehicleIDIn(vs ...int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIn(FieldVehicleID, vs...))
+}
+
+// VehicleIDNotIn applies the NotIn predicate on the "vehicle_id" field.
+func VehicleIDNotIn(vs ...int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotIn(FieldVehicleID, vs...))
+}
+
+// VehicleIDGT applies the GT predicate on the "vehicle_id" field.
+func VehicleIDGT(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGT(FieldVehicleID, v))
+}
+
+// VehicleIDGTE applies the GTE predicate on the "vehicle_id" field.
+func VehicleIDGTE(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGTE(FieldVehicleID, v))
+}
+
+// VehicleIDLT applies the LT predicate on the "vehicle_id" field.
+func VehicleIDLT(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLT(FieldVehicleID, v))
+}
+
+// VehicleIDLTE applies the LTE predicate on the "vehicle_id" field.
+func VehicleIDLTE(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLTE(FieldVehicleID, v))
+}
+
+// PressureFlEQ applies the EQ predicate on the "pressure_fl" field.
+func PressureFlEQ(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldPressureFl, v))
+}
+
+// PressureFlNEQ applies the NEQ predicate on the "pressure_fl" field.
+func PressureFlNEQ(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNEQ(FieldPressureFl, v))
+}
+
+// PressureFlIn applies the In predicate on the "pressure_fl" field.
+func PressureFlIn(vs ...float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIn(FieldPressureFl, vs...))
+}
+
+// PressureFlNotIn applies the NotIn predicate on the "pressure_fl" field.
+func PressureFlNotIn(vs ...float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotIn(FieldPressureFl, vs...))
+}
+
+// PressureFlGT applies the GT predicate on the "pressure_fl" field.
+func PressureFlGT(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGT(FieldPressureFl, v))
+}
+
+// PressureFlGTE applies the GTE predicate on the "pressure_fl" field.
+func PressureFlGTE(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGTE(FieldPressureFl, v))
+}
+
+// PressureFlLT applies the LT predicate on the "pressure_fl" field.
+func PressureFlLT(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLT(FieldPressureFl, v))
+}
+
+// PressureFlLTE applies the LTE predicate on the "pressure_fl" field.
+func PressureFlLTE(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLTE(FieldPressureFl, v))
+}
+
+// PressureFlIsNil applies the IsNil predicate on the "pressure_fl" field.
+func PressureFlIsNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIsNull(FieldPressureFl))
+}
+
+// PressureFlNotNil applies the NotNil predicate on the "pressure_fl" field.
+func PressureFlNotNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotNull(FieldPressureFl))
+}
+
+// PressureFrEQ applies the EQ predicate on the "pressure_fr" field.
+func PressureFrEQ(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldPressureFr, v))
+}
+
+// PressureFrNEQ applies the NEQ predicate on the "pressure_fr" field.
+func PressureFrNEQ(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNEQ(FieldPressureFr, v))
+}
+
+// PressureFrIn applies the In predicate on the "pressure_fr" field.
+func PressureFrIn(vs ...float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIn(FieldPressureFr, vs...))
+}
+
+// PressureFrNotIn applies the NotIn predicate on the "pressure_fr" field.
+func PressureFrNotIn(vs ...float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotIn(FieldPressureFr, vs...))
+}
+
+// PressureFrGT applies the GT predicate on the "pressure_fr" field.
+func PressureFrGT(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGT(FieldPressureFr, v))
+}
+
+// PressureFrGTE applies the GTE predicate on the "pressure_fr" field.
+func PressureFrGTE(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGTE(FieldPressureFr, v))
+}
+
+// PressureFrLT applies the LT predicate on the "pressure_fr" field.
+func PressureFrLT(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLT(FieldPressureFr, v))
+}
+
+// PressureFrLTE applies the LTE predicate on the "pressure_fr" field.
+func PressureFrLTE(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLTE(FieldPressureFr, v))
+}
+
+// PressureFrIsNil applies the IsNil predicate on the "pressure_fr" field.
+func PressureFrIsNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIsNull(FieldPressureFr))
+}
+
+// PressureFrNotNil applies the NotNil predicate on the "pressure_fr" field.
+func PressureFrNotNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotNull(FieldPressureFr))
+}
+
+// PressureRlEQ applies the EQ predicate on the "pressure_rl" field.
+func PressureRlEQ(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldPressureRl, v))
+}
+
+// PressureRlNEQ applies the NEQ predicate on the "pressure_rl" field.
+func PressureRlNEQ(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNEQ(FieldPressureRl, v))
+}
+
+// PressureRlIn applies the In predicate on the "pressure_rl" field.
+func PressureRlIn(vs ...float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIn(FieldPressureRl, vs...))
+}
+
+// PressureRlNotIn applies the NotIn predicate on the "pressure_rl" field.
+func PressureRlNotIn(vs ...float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotIn(FieldPressureRl, vs...))
+}
+
+// PressureRlGT applies the GT predicate on the "pressure_rl" field.
+func PressureRlGT(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGT(FieldPressureRl, v))
+}
+
+// PressureRlGTE applies the GTE predicate on the "pressure_rl" field.
+func PressureRlGTE(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGTE(FieldPressureRl, v))
+}
+
+// PressureRlLT applies the LT predicate on the "pressure_rl" field.
+func PressureRlLT(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLT(FieldPressureRl, v))
+}
+
+// PressureRlLTE applies the LTE predicate on the "pressure_rl" field.
+func PressureRlLTE(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLTE(FieldPressureRl, v))
+}
+
+// PressureRlIsNil applies the IsNil predicate on the "pressure_rl" field.
+func PressureRlIsNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIsNull(FieldPressureRl))
+}
+
+// PressureRlNotNil applies the NotNil predicate on the "pressure_rl" field.
+func PressureRlNotNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotNull(FieldPressureRl))
+}
+
+// PressureRrEQ applies the EQ predicate on the "pressure_rr" field.
+func PressureRrEQ(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldPressureRr, v))
+}
+
+// PressureRrNEQ applies the NEQ predicate on the "pressure_rr" field.
+func PressureRrNEQ(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNEQ(FieldPressureRr, v))
+}
+
+// PressureRrIn applies the In predicate on the "pressure_rr" field.
+func PressureRrIn(vs ...float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIn(FieldPressureRr, vs...))
+}
+
+// PressureRrNotIn applies the NotIn predicate on the "pressure_rr" field.
+func PressureRrNotIn(vs ...float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotIn(FieldPressureRr, vs...))
+}
+
+// PressureRrGT applies the GT predicate on the "pressure_rr" field.
+func PressureRrGT(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGT(FieldPressureRr, v))
+}
+
+// PressureRrGTE applies the GTE predicate on the "pressure_rr" field.
+func PressureRrGTE(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGTE(FieldPressureRr, v))
+}
+
+// PressureRrLT applies the LT predicate on the "pressure_rr" field.
+func PressureRrLT(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLT(FieldPressureRr, v))
+}
+
+// PressureRrLTE applies the LTE predicate on the "pressure_rr" field.
+func PressureRrLTE(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLTE(FieldPressureRr, v))
+}
+
+// PressureRrIsNil applies the IsNil predicate on the "pressure_rr" field.
+func PressureRrIsNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIsNull(FieldPressureRr))
+}
+
+// PressureRrNotNil applies the NotNil predicate on the "pressure_rr" field.
+func PressureRrNotNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotNull(FieldPressureRr))
+}
+
+// SeenAtFlEQ applies the EQ predicate on the "seen_at_fl" field.
+func SeenAtFlEQ(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldSeenAtFl, v))
+}
+
+// SeenAtFlNEQ applies the NEQ predicate on the "seen_at_fl" field.
+func SeenAtFlNEQ(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNEQ(FieldSeenAtFl, v))
+}
+
+// SeenAtFlIn applies the In predicate on the "seen_at_fl" field.
+func SeenAtFlIn(vs ...time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIn(FieldSeenAtFl, vs...))
+}
+
+// SeenAtFlNotIn applies the NotIn predicate on the "seen_at_fl" field.
+func SeenAtFlNotIn(vs ...time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotIn(FieldSeenAtFl, vs...))
+}
+
+// SeenAtFlGT applies the GT predicate on the "seen_at_fl" field.
+func SeenAtFlGT(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGT(FieldSeenAtFl, v))
+}
+
+// SeenAtFlGTE applies the GTE predicate on the "seen_at_fl" field.
+func SeenAtFlGTE(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGTE(FieldSeenAtFl, v))
+}
+
+// SeenAtFlLT applies the LT predicate on the "seen_at_fl" field.
+func SeenAtFlLT(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLT(FieldSeenAtFl, v))
+}
+
+// SeenAtFlLTE applies the LTE predicate on the "seen_at_fl" field.
+func SeenAtFlLTE(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLTE(FieldSeenAtFl, v))
+}
+
+// SeenAtFlIsNil applies the IsNil predicate on the "seen_at_fl" field.
+func SeenAtFlIsNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIsNull(FieldSeenAtFl))
+}
+
+// SeenAtFlNotNil applies the NotNil predicate on the "seen_at_fl" field.
+func SeenAtFlNotNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotNull(FieldSeenAtFl))
+}
+
+// SeenAtFrEQ applies the EQ predicate on the "seen_at_fr" field.
+func SeenAtFrEQ(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldSeenAtFr, v))
+}
+
+// SeenAtFrNEQ applies the NEQ predicate on the "seen_at_fr" field.
+func SeenAtFrNEQ(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNEQ(FieldSeenAtFr, v))
+}
+
+// SeenAtFrIn applies the In predicate on the "seen_at_fr" field.
+func SeenAtFrIn(vs ...time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIn(FieldSeenAtFr, vs...))
+}
+
+// SeenAtFrNotIn applies the NotIn predicate on the "seen_at_fr" field.
+func SeenAtFrNotIn(vs ...time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotIn(FieldSeenAtFr, vs...))
+}
+
+// SeenAtFrGT applies the GT predicate on the "seen_at_fr" field.
+func SeenAtFrGT(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGT(FieldSeenAtFr, v))
+}
+
+// SeenAtFrGTE applies the GTE predicate on the "seen_at_fr" field.
+func SeenAtFrGTE(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGTE(FieldSeenAtFr, v))
+}
+
+// SeenAtFrLT applies the LT predicate on the "seen_at_fr" field.
+func SeenAtFrLT(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLT(FieldSeenAtFr, v))
+}
+
+// SeenAtFrLTE applies the LTE predicate on the "seen_at_fr" field.
+func SeenAtFrLTE(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLTE(FieldSeenAtFr, v))
+}
+
+// SeenAtFrIsNil applies the IsNil predicate on the "seen_at_fr" field.
+func SeenAtFrIsNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIsNull(FieldSeenAtFr))
+}
+
+// SeenAtFrNotNil applies the NotNil predicate on the "seen_at_fr" field.
+func SeenAtFrNotNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotNull(FieldSeenAtFr))
+}
+
+// SeenAtRlEQ applies the EQ predicate on the "seen_at_rl" field.
+func SeenAtRlEQ(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldSeenAtRl, v))
+}
+
+// SeenAtRlNEQ applies the NEQ predicate on the "seen_at_rl" field.
+func SeenAtRlNEQ(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNEQ(FieldSeenAtRl, v))
+}
+
+// SeenAtRlIn applies the In predicate on the "seen_at_rl" field.
+func SeenAtRlIn(vs ...time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIn(FieldSeenAtRl, vs...))
+}
+
+// SeenAtRlNotIn applies the NotIn predicate on the "seen_at_rl" field.
+func SeenAtRlNotIn(vs ...time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotIn(FieldSeenAtRl, vs...))
+}
+
+// SeenAtRlGT applies the GT predicate on the "seen_at_rl" field.
+func SeenAtRlGT(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGT(FieldSeenAtRl, v))
+}
+
+// SeenAtRlGTE applies the GTE predicate on the "seen_at_rl" field.
+func SeenAtRlGTE(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGTE(FieldSeenAtRl, v))
+}
+
+// SeenAtRlLT applies the LT predicate on the "seen_at_rl" field.
+func SeenAtRlLT(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLT(FieldSeenAtRl, v))
+}
+
+// SeenAtRlLTE applies the LTE predicate on the "seen_at_rl" field.
+func SeenAtRlLTE(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLTE(FieldSeenAtRl, v))
+}
+
+// SeenAtRlIsNil applies the IsNil predicate on the "seen_at_rl" field.
+func SeenAtRlIsNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIsNull(FieldSeenAtRl))
+}
+
+// SeenAtRlNotNil applies the NotNil predicate on the "seen_at_rl" field.
+func SeenAtRlNotNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotNull(FieldSeenAtRl))
+}
+
+// SeenAtRrEQ applies the EQ predicate on the "seen_at_rr" field.
+func SeenAtRrEQ(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldSeenAtRr, v))
+}
+
+// SeenAtRrNEQ applies the NEQ predicate on the "seen_at_rr" field.
+func SeenAtRrNEQ(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNEQ(FieldSeenAtRr, v))
+}
+
+// SeenAtRrIn applies the In predicate on the "seen_at_rr" field.
+func SeenAtRrIn(vs ...time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIn(FieldSeenAtRr, vs...))
+}
+
+// SeenAtRrNotIn applies the NotIn predicate on the "seen_at_rr" field.
+func SeenAtRrNotIn(vs ...time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotIn(FieldSeenAtRr, vs...))
+}
+
+// SeenAtRrGT applies the GT predicate on the "seen_at_rr" field.
+func SeenAtRrGT(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGT(FieldSeenAtRr, v))
+}
+
+// SeenAtRrGTE applies the GTE predicate on the "seen_at_rr" field.
+func SeenAtRrGTE(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGTE(FieldSeenAtRr, v))
+}
+
+// SeenAtRrLT applies the LT predicate on the "seen_at_rr" field.
+func SeenAtRrLT(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLT(FieldSeenAtRr, v))
+}
+
+// SeenAtRrLTE applies the LTE predicate on the "seen_at_rr" field.
+func SeenAtRrLTE(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLTE(FieldSeenAtRr, v))
+}
+
+// SeenAtRrIsNil applies the IsNil predicate on the "seen_at_rr" field.
+func SeenAtRrIsNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIsNull(FieldSeenAtRr))
+}
+
+// SeenAtRrNotNil applies the NotNil predicate on the "seen_at_rr" field.
+func SeenAtRrNotNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotNull(FieldSeenAtRr))
+}
+
+// RecommendedFrontEQ applies the EQ predicate on the "recommended_front" field.
+func RecommendedFrontEQ(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldRecommendedFront, v))
+}
+
+// RecommendedFrontNEQ applies the NEQ predicate on the "recommended_front" field.
+func RecommendedFrontNEQ(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNEQ(FieldRecommendedFront, v))
+}
+
+// RecommendedFrontIn applies the In predicate on the "recommended_front" field.
+func RecommendedFrontIn(vs ...float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIn(FieldRecommendedFront, vs...))
+}
+
+// RecommendedFrontNotIn applies the NotIn predicate on the "recommended_front" field.
+func RecommendedFrontNotIn(vs ...float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotIn(FieldRecommendedFront, vs...))
+}
+
+// RecommendedFrontGT applies the GT predicate on the "recommended_front" field.
+func RecommendedFrontGT(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGT(FieldRecommendedFront, v))
+}
+
+// RecommendedFrontGTE applies the GTE predicate on the "recommended_front" field.
+func RecommendedFrontGTE(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGTE(FieldRecommendedFront, v))
+}
+
+// RecommendedFrontLT applies the LT predicate on the "recommended_front" field.
+func RecommendedFrontLT(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLT(FieldRecommendedFront, v))
+}
+
+// RecommendedFrontLTE applies the LTE predicate on the "recommended_front" field.
+func RecommendedFrontLTE(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLTE(FieldRecommendedFront, v))
+}
+
+// RecommendedFrontIsNil applies the IsNil predicate on the "recommended_front" field.
+func RecommendedFrontIsNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIsNull(FieldRecommendedFront))
+}
+
+// RecommendedFrontNotNil applies the NotNil predicate on the "recommended_front" field.
+func RecommendedFrontNotNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotNull(FieldRecommendedFront))
+}
+
+// RecommendedRearEQ applies the EQ predicate on the "recommended_rear" field.
+func RecommendedRearEQ(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldRecommendedRear, v))
+}
+
+// RecommendedRearNEQ applies the NEQ predicate on the "recommended_rear" field.
+func RecommendedRearNEQ(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNEQ(FieldRecommendedRear, v))
+}
+
+// RecommendedRearIn applies the In predicate on the "recommended_rear" field.
+func RecommendedRearIn(vs ...float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIn(FieldRecommendedRear, vs...))
+}
+
+// RecommendedRearNotIn applies the NotIn predicate on the "recommended_rear" field.
+func RecommendedRearNotIn(vs ...float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotIn(FieldRecommendedRear, vs...))
+}
+
+// RecommendedRearGT applies the GT predicate on the "recommended_rear" field.
+func RecommendedRearGT(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGT(FieldRecommendedRear, v))
+}
+
+// RecommendedRearGTE applies the GTE predicate on the "recommended_rear" field.
+func RecommendedRearGTE(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGTE(FieldRecommendedRear, v))
+}
+
+// RecommendedRearLT applies the LT predicate on the "recommended_rear" field.
+func RecommendedRearLT(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLT(FieldRecommendedRear, v))
+}
+
+// RecommendedRearLTE applies the LTE predicate on the "recommended_rear" field.
+func RecommendedRearLTE(v float64) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLTE(FieldRecommendedRear, v))
+}
+
+// RecommendedRearIsNil applies the IsNil predicate on the "recommended_rear" field.
+func RecommendedRearIsNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIsNull(FieldRecommendedRear))
+}
+
+// RecommendedRearNotNil applies the NotNil predicate on the "recommended_rear" field.
+func RecommendedRearNotNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotNull(FieldRecommendedRear))
+}
+
+// SoftWarningsEQ applies the EQ predicate on the "soft_warnings" field.
+func SoftWarningsEQ(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldSoftWarnings, v))
+}
+
+// SoftWarningsNEQ applies the NEQ predicate on the "soft_warnings" field.
+func SoftWarningsNEQ(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNEQ(FieldSoftWarnings, v))
+}
+
+// SoftWarningsIn applies the In predicate on the "soft_warnings" field.
+func SoftWarningsIn(vs ...int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIn(FieldSoftWarnings, vs...))
+}
+
+// SoftWarningsNotIn applies the NotIn predicate on the "soft_warnings" field.
+func SoftWarningsNotIn(vs ...int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotIn(FieldSoftWarnings, vs...))
+}
+
+// SoftWarningsGT applies the GT predicate on the "soft_warnings" field.
+func SoftWarningsGT(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGT(FieldSoftWarnings, v))
+}
+
+// SoftWarningsGTE applies the GTE predicate on the "soft_warnings" field.
+func SoftWarningsGTE(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGTE(FieldSoftWarnings, v))
+}
+
+// SoftWarningsLT applies the LT predicate on the "soft_warnings" field.
+func SoftWarningsLT(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLT(FieldSoftWarnings, v))
+}
+
+// SoftWarningsLTE applies the LTE predicate on the "soft_warnings" field.
+func SoftWarningsLTE(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLTE(FieldSoftWarnings, v))
+}
+
+// HardWarningsEQ applies the EQ predicate on the "hard_warnings" field.
+func HardWarningsEQ(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldHardWarnings, v))
+}
+
+// HardWarningsNEQ applies the NEQ predicate on the "hard_warnings" field.
+func HardWarningsNEQ(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNEQ(FieldHardWarnings, v))
+}
+
+// HardWarningsIn applies the In predicate on the "hard_warnings" field.
+func HardWarningsIn(vs ...int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIn(FieldHardWarnings, vs...))
+}
+
+// HardWarningsNotIn applies the NotIn predicate on the "hard_warnings" field.
+func HardWarningsNotIn(vs ...int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotIn(FieldHardWarnings, vs...))
+}
+
+// HardWarningsGT applies the GT predicate on the "hard_warnings" field.
+func HardWarningsGT(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGT(FieldHardWarnings, v))
+}
+
+// HardWarningsGTE applies the GTE predicate on the "hard_warnings" field.
+func HardWarningsGTE(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGTE(FieldHardWarnings, v))
+}
+
+// HardWarningsLT applies the LT predicate on the "hard_warnings" field.
+func HardWarningsLT(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLT(FieldHardWarnings, v))
+}
+
+// HardWarningsLTE applies the LTE predicate on the "hard_warnings" field.
+func HardWarningsLTE(v int) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLTE(FieldHardWarnings, v))
+}
+
+// UnitEQ applies the EQ predicate on the "unit" field.
+func UnitEQ(v string) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldUnit, v))
+}
+
+// UnitNEQ applies the NEQ predicate on the "unit" field.
+func UnitNEQ(v string) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNEQ(FieldUnit, v))
+}
+
+// UnitIn applies the In predicate on the "unit" field.
+func UnitIn(vs ...string) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIn(FieldUnit, vs...))
+}
+
+// UnitNotIn applies the NotIn predicate on the "unit" field.
+func UnitNotIn(vs ...string) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotIn(FieldUnit, vs...))
+}
+
+// UnitGT applies the GT predicate on the "unit" field.
+func UnitGT(v string) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGT(FieldUnit, v))
+}
+
+// UnitGTE applies the GTE predicate on the "unit" field.
+func UnitGTE(v string) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGTE(FieldUnit, v))
+}
+
+// UnitLT applies the LT predicate on the "unit" field.
+func UnitLT(v string) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLT(FieldUnit, v))
+}
+
+// UnitLTE applies the LTE predicate on the "unit" field.
+func UnitLTE(v string) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLTE(FieldUnit, v))
+}
+
+// UnitContains applies the Contains predicate on the "unit" field.
+func UnitContains(v string) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldContains(FieldUnit, v))
+}
+
+// UnitHasPrefix applies the HasPrefix predicate on the "unit" field.
+func UnitHasPrefix(v string) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldHasPrefix(FieldUnit, v))
+}
+
+// UnitHasSuffix applies the HasSuffix predicate on the "unit" field.
+func UnitHasSuffix(v string) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldHasSuffix(FieldUnit, v))
+}
+
+// UnitIsNil applies the IsNil predicate on the "unit" field.
+func UnitIsNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIsNull(FieldUnit))
+}
+
+// UnitNotNil applies the NotNil predicate on the "unit" field.
+func UnitNotNil() predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotNull(FieldUnit))
+}
+
+// UnitEqualFold applies the EqualFold predicate on the "unit" field.
+func UnitEqualFold(v string) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEqualFold(FieldUnit, v))
+}
+
+// UnitContainsFold applies the ContainsFold predicate on the "unit" field.
+func UnitContainsFold(v string) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldContainsFold(FieldUnit, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TirePressure {
+	return predicate.TirePressure(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TirePressure) predicate.TirePressure {
+	return predicate.TirePressure(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TirePressure) predicate.TirePressure {
+	return predicate.TirePressure(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TirePressure) predicate.TirePressure {
+	return predicate.TirePressure(sql.NotPredicates(p))
+}
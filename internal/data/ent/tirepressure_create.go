@@ -0,0 +1,463 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/tirepressure"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TirePressureCreate is the builder for creating a TirePressure entity.
+type TirePressureCreate struct {
+	config
+	mutation *TirePressureMutation
+	hooks    []Hook
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_c *TirePressureCreate) SetVehicleID(v int) *TirePressureCreate {
+	_c.mutation.SetVehicleID(v)
+	return _c
+}
+
+// SetPressureFl sets the "pressure_fl" field.
+func (_c *TirePressureCreate) SetPressureFl(v float64) *TirePressureCreate {
+	_c.mutation.SetPressureFl(v)
+	return _c
+}
+
+// SetNillablePressureFl sets the "pressure_fl" field if the given value is not nil.
+func (_c *TirePressureCreate) SetNillablePressureFl(v *float64) *TirePressureCreate {
+	if v != nil {
+		_c.SetPressureFl(*v)
+	}
+	return _c
+}
+
+// SetPressureFr sets the "pressure_fr" field.
+func (_c *TirePressureCreate) SetPressureFr(v float64) *TirePressureCreate {
+	_c.mutation.SetPressureFr(v)
+	return _c
+}
+
+// SetNillablePressureFr sets the "pressure_fr" field if the given value is not nil.
+func (_c *TirePressureCreate) SetNillablePressureFr(v *float64) *TirePressureCreate {
+	if v != nil {
+		_c.SetPressureFr(*v)
+	}
+	return _c
+}
+
+// SetPressureRl sets the "pressure_rl" field.
+func (_c *TirePressureCreate) SetPressureRl(v float64) *TirePressureCreate {
+	_c.mutation.SetPressureRl(v)
+	return _c
+}
+
+// SetNillablePressureRl sets the "pressure_rl" field if the given value is not nil.
+func (_c *TirePressureCreate) SetNillablePressureRl(v *float64) *TirePressureCreate {
+	if v != nil {
+		_c.SetPressureRl(*v)
+	}
+	return _c
+}
+
+// SetPressureRr sets the "pressure_rr" field.
+func (_c *TirePressureCreate) SetPressureRr(v float64) *TirePressureCreate {
+	_c.mutation.SetPressureRr(v)
+	return _c
+}
+
+// SetNillablePressureRr sets the "pressure_rr" field if the given value is not nil.
+func (_c *TirePressureCreate) SetNillablePressureRr(v *float64) *TirePressureCreate {
+	if v != nil {
+		_c.SetPressureRr(*v)
+	}
+	return _c
+}
+
+// SetSeenAtFl sets the "seen_at_fl" field.
+func (_c *TirePressureCreate) SetSeenAtFl(v time.Time) *TirePressureCreate {
+	_c.mutation.SetSeenAtFl(v)
+	return _c
+}
+
+// SetNillableSeenAtFl sets the "seen_at_fl" field if the given value is not nil.
+func (_c *TirePressureCreate) SetNillableSeenAtFl(v *time.Time) *TirePressureCreate {
+	if v != nil {
+		_c.SetSeenAtFl(*v)
+	}
+	return _c
+}
+
+// SetSeenAtFr sets the "seen_at_fr" field.
+func (_c *TirePressureCreate) SetSeenAtFr(v time.Time) *TirePressureCreate {
+	_c.mutation.SetSeenAtFr(v)
+	return _c
+}
+
+// SetNillableSeenAtFr sets the "seen_at_fr" field if the given value is not nil.
+func (_c *TirePressureCreate) SetNillableSeenAtFr(v *time.Time) *TirePressureCreate {
+	if v != nil {
+		_c.SetSeenAtFr(*v)
+	}
+	return _c
+}
+
+// SetSeenAtRl sets the "seen_at_rl" field.
+func (_c *TirePressureCreate) SetSeenAtRl(v time.Time) *TirePressureCreate {
+	_c.mutation.SetSeenAtRl(v)
+	return _c
+}
+
+// SetNillableSeenAtRl sets the "seen_at_rl" field if the given value is not nil.
+func (_c *TirePressureCreate) SetNillableSeenAtRl(v *time.Time) *TirePressureCreate {
+	if v != nil {
+		_c.SetSeenAtRl(*v)
+	}
+	return _c
+}
+
+// SetSeenAtRr sets the "seen_at_rr" field.
+func (_c *TirePressureCreate) SetSeenAtRr(v time.Time) *TirePressureCreate {
+	_c.mutation.SetSeenAtRr(v)
+	return _c
+}
+
+// SetNillableSeenAtRr sets the "seen_at_rr" field if the given value is not nil.
+func (_c *TirePressureCreate) SetNillableSeenAtRr(v *time.Time) *TirePressureCreate {
+	if v != nil {
+		_c.SetSeenAtRr(*v)
+	}
+	return _c
+}
+
+// SetRecommendedFront sets the "recommended_front" field.
+func (_c *TirePressureCreate) SetRecommendedFront(v float64) *TirePressureCreate {
+	_c.mutation.SetRecommendedFront(v)
+	return _c
+}
+
+// SetNillableRecommendedFront sets the "recommended_front" field if the given value is not nil.
+func (_c *TirePressureCreate) SetNillableRecommendedFront(v *float64) *TirePressureCreate {
+	if v != nil {
+		_c.SetRecommendedFront(*v)
+	}
+	return _c
+}
+
+// SetRecommendedRear sets the "recommended_rear" field.
+func (_c *TirePressureCreate) SetRecommendedRear(v float64) *TirePressureCreate {
+	_c.mutation.SetRecommendedRear(v)
+	return _c
+}
+
+// SetNillableRecommendedRear sets the "recommended_rear" field if the given value is not nil.
+func (_c *TirePressureCreate) SetNillableRecommendedRear(v *float64) *TirePressureCreate {
+	if v != nil {
+		_c.SetRecommendedRear(*v)
+	}
+	return _c
+}
+
+// SetSoftWarnings sets the "soft_warnings" field.
+func (_c *TirePressureCreate) SetSoftWarnings(v int) *TirePressureCreate {
+	_c.mutation.SetSoftWarnings(v)
+	return _c
+}
+
+// SetNillableSoftWarnings sets the "soft_warnings" field if the given value is not nil.
+func (_c *TirePressureCreate) SetNillableSoftWarnings(v *int) *TirePressureCreate {
+	if v != nil {
+		_c.SetSoftWarnings(*v)
+	}
+	return _c
+}
+
+// SetHardWarnings sets the "hard_warnings" field.
+func (_c *TirePressureCreate) SetHardWarnings(v int) *TirePressureCreate {
+	_c.mutation.SetHardWarnings(v)
+	return _c
+}
+
+// SetNillableHardWarnings sets the "hard_warnings" field if the given value is not nil.
+func (_c *TirePressureCreate) SetNillableHardWarnings(v *int) *TirePressureCreate {
+	if v != nil {
+		_c.SetHardWarnings(*v)
+	}
+	return _c
+}
+
+// SetUnit sets the "unit" field.
+func (_c *TirePressureCreate) SetUnit(v string) *TirePressureCreate {
+	_c.mutation.SetUnit(v)
+	return _c
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (_c *TirePressureCreate) SetNillableUnit(v *string) *TirePressureCreate {
+	if v != nil {
+		_c.SetUnit(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TirePressureCreate) SetCreatedAt(v time.Time) *TirePressureCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TirePressureCreate) SetNillableCreatedAt(v *time.Time) *TirePressureCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TirePressureMutation object of the builder.
+func (_c *TirePressureCreate) Mutation() *TirePressureMutation {
+	return _c.mutation
+}
+
+// Save creates the TirePressure in the database.
+func (_c *TirePressureCreate) Save(ctx context.Context) (*TirePressure, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TirePressureCreate) SaveX(ctx context.Context) *TirePressure {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TirePressureCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TirePressureCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TirePressureCreate) defaults() {
+	if _, ok := _c.mutation.SoftWarnings(); !ok {
+		v := tirepressure.DefaultSoftWarnings
+		_c.mutation.SetSoftWarnings(v)
+	}
+	if _, ok := _c.mutation.HardWarnings(); !ok {
+		v := tirepressure.DefaultHardWarnings
+		_c.mutation.SetHardWarnings(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := tirepressure.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TirePressureCreate) check() error {
+	if _, ok := _c.mutation.VehicleID(); !ok {
+		return &ValidationError{Name: "vehicle_id", err: errors.New(`ent: missing required field "TirePressure.vehicle_id"`)}
+	}
+	if _, ok := _c.mutation.SoftWarnings(); !ok {
+		return &ValidationError{Name: "soft_warnings", err: errors.New(`ent: missing required field "TirePressure.soft_warnings"`)}
+	}
+	if _, ok := _c.mutation.HardWarnings(); !ok {
+		return &ValidationError{Name: "hard_warnings", err: errors.New(`ent: missing required field "TirePressure.hard_warnings"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TirePressure.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *TirePressureCreate) sqlSave(ctx context.Context) (*TirePressure, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TirePressureCreate) createSpec() (*TirePressure, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TirePressure{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tirepressure.Table, sqlgraph.NewFieldSpec(tirepressure.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.VehicleID(); ok {
+		_spec.SetField(tirepressure.FieldVehicleID, field.TypeInt, value)
+		_node.VehicleID = value
+	}
+	if value, ok := _c.mutation.PressureFl(); ok {
+		_spec.SetField(tirepressure.FieldPressureFl, field.TypeFloat64, value)
+		_node.PressureFl = value
+	}
+	if value, ok := _c.mutation.PressureFr(); ok {
+		_spec.SetField(tirepressure.FieldPressureFr, field.TypeFloat64, value)
+		_node.PressureFr = value
+	}
+	if value, ok := _c.mutation.PressureRl(); ok {
+		_spec.SetField(tirepressure.FieldPressureRl, field.TypeFloat64, value)
+		_node.PressureRl = value
+	}
+	if value, ok := _c.mutation.PressureRr(); ok {
+		_spec.SetField(tirepressure.FieldPressureRr, field.TypeFloat64, value)
+		_node.PressureRr = value
+	}
+	if value, ok := _c.mutation.SeenAtFl(); ok {
+		_spec.SetField(tirepressure.FieldSeenAtFl, field.TypeTime, value)
+		_node.SeenAtFl = &value
+	}
+	if value, ok := _c.mutation.SeenAtFr(); ok {
+		_spec.SetField(tirepressure.FieldSeenAtFr, field.TypeTime, value)
+		_node.SeenAtFr = &value
+	}
+	if value, ok := _c.mutation.SeenAtRl(); ok {
+		_spec.SetField(tirepressure.FieldSeenAtRl, field.TypeTime, value)
+		_node.SeenAtRl = &value
+	}
+	if value, ok := _c.mutation.SeenAtRr(); ok {
+		_spec.SetField(tirepressure.FieldSeenAtRr, field.TypeTime, value)
+		_node.SeenAtRr = &value
+	}
+	if value, ok := _c.mutation.RecommendedFront(); ok {
+		_spec.SetField(tirepressure.FieldRecommendedFront, field.TypeFloat64, value)
+		_node.RecommendedFront = value
+	}
+	if value, ok := _c.mutation.RecommendedRear(); ok {
+		_spec.SetField(tirepressure.FieldRecommendedRear, field.TypeFloat64, value)
+		_node.RecommendedRear = value
+	}
+	if value, ok := _c.mutation.SoftWarnings(); ok {
+		_spec.SetField(tirepressure.FieldSoftWarnings, field.TypeInt, value)
+		_node.SoftWarnings = value
+	}
+	if value, ok := _c.mutation.HardWarnings(); ok {
+		_spec.SetField(tirepressure.FieldHardWarnings, field.TypeInt, value)
+		_node.HardWarnings = value
+	}
+	if value, ok := _c.mutation.Unit(); ok {
+		_spec.SetField(tirepressure.FieldUnit, field.TypeString, value)
+		_node.Unit = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tirepressure.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// TirePressureCreateBulk is the builder for creating many TirePressure entities in bulk.
+type TirePressureCreateBulk struct {
+	config
+	err      error
+	builders []*TirePressureCreate
+}
+
+// Save creates the TirePressure entities in the database.
+func (_c *TirePressureCreateBulk) Save(ctx context.Context) ([]*TirePressure, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TirePressure, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TirePressureMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TirePressureCreateBulk) SaveX(ctx context.Context) []*TirePressure {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TirePressureCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TirePressureCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
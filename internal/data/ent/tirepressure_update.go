@@ -0,0 +1,1030 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/predicate"
+	"teslatrack/internal/data/ent/tirepressure"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TirePressureUpdate is the builder for updating TirePressure entities.
+type TirePressureUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TirePressureMutation
+}
+
+// Where appends a list predicates to the TirePressureUpdate builder.
+func (_u *TirePressureUpdate) Where(ps ...predicate.TirePressure) *TirePressureUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_u *TirePressureUpdate) SetVehicleID(v int) *TirePressureUpdate {
+	_u.mutation.ResetVehicleID()
+	_u.mutation.SetVehicleID(v)
+	return _u
+}
+
+// SetNillableVehicleID sets the "vehicle_id" field if the given value is not nil.
+func (_u *TirePressureUpdate) SetNillableVehicleID(v *int) *TirePressureUpdate {
+	if v != nil {
+		_u.SetVehicleID(*v)
+	}
+	return _u
+}
+
+// AddVehicleID adds value to the "vehicle_id" field.
+func (_u *TirePressureUpdate) AddVehicleID(v int) *TirePressureUpdate {
+	_u.mutation.AddVehicleID(v)
+	return _u
+}
+
+// SetPressureFl sets the "pressure_fl" field.
+func (_u *TirePressureUpdate) SetPressureFl(v float64) *TirePressureUpdate {
+	_u.mutation.ResetPressureFl()
+	_u.mutation.SetPressureFl(v)
+	return _u
+}
+
+// SetNillablePressureFl sets the "pressure_fl" field if the given value is not nil.
+func (_u *TirePressureUpdate) SetNillablePressureFl(v *float64) *TirePressureUpdate {
+	if v != nil {
+		_u.SetPressureFl(*v)
+	}
+	return _u
+}
+
+// AddPressureFl adds value to the "pressure_fl" field.
+func (_u *TirePressureUpdate) AddPressureFl(v float64) *TirePressureUpdate {
+	_u.mutation.AddPressureFl(v)
+	return _u
+}
+
+// ClearPressureFl clears the value of the "pressure_fl" field.
+func (_u *TirePressureUpdate) ClearPressureFl() *TirePressureUpdate {
+	_u.mutation.ClearPressureFl()
+	return _u
+}
+
+// SetPressureFr sets the "pressure_fr" field.
+func (_u *TirePressureUpdate) SetPressureFr(v float64) *TirePressureUpdate {
+	_u.mutation.ResetPressureFr()
+	_u.mutation.SetPressureFr(v)
+	return _u
+}
+
+// SetNillablePressureFr sets the "pressure_fr" field if the given value is not nil.
+func (_u *TirePressureUpdate) SetNillablePressureFr(v *float64) *TirePressureUpdate {
+	if v != nil {
+		_u.SetPressureFr(*v)
+	}
+	return _u
+}
+
+// AddPressureFr adds value to the "pressure_fr" field.
+func (_u *TirePressureUpdate) AddPressureFr(v float64) *TirePressureUpdate {
+	_u.mutation.AddPressureFr(v)
+	return _u
+}
+
+// ClearPressureFr clears the value of the "pressure_fr" field.
+func (_u *TirePressureUpdate) ClearPressureFr() *TirePressureUpdate {
+	_u.mutation.ClearPressureFr()
+	return _u
+}
+
+// SetPressureRl sets the "pressure_rl" field.
+func (_u *TirePressureUpdate) SetPressureRl(v float64) *TirePressureUpdate {
+	_u.mutation.ResetPressureRl()
+	_u.mutation.SetPressureRl(v)
+	return _u
+}
+
+// SetNillablePressureRl sets the "pressure_rl" field if the given value is not nil.
+func (_u *TirePressureUpdate) SetNillablePressureRl(v *float64) *TirePressureUpdate {
+	if v != nil {
+		_u.SetPressureRl(*v)
+	}
+	return _u
+}
+
+// AddPressureRl adds value to the "pressure_rl" field.
+func (_u *TirePressureUpdate) AddPressureRl(v float64) *TirePressureUpdate {
+	_u.mutation.AddPressureRl(v)
+	return _u
+}
+
+// ClearPressureRl clears the value of the "pressure_rl" field.
+func (_u *TirePressureUpdate) ClearPressureRl() *TirePressureUpdate {
+	_u.mutation.ClearPressureRl()
+	return _u
+}
+
+// SetPressureRr sets the "pressure_rr" field.
+func (_u *TirePressureUpdate) SetPressureRr(v float64) *TirePressureUpdate {
+	_u.mutation.ResetPressureRr()
+	_u.mutation.SetPressureRr(v)
+	return _u
+}
+
+// SetNillablePressureRr sets the "pressure_rr" field if the given value is not nil.
+func (_u *TirePressureUpdate) SetNillablePressureRr(v *float64) *TirePressureUpdate {
+	if v != nil {
+		_u.SetPressureRr(*v)
+	}
+	return _u
+}
+
+// AddPressureRr adds value to the "pressure_rr" field.
+func (_u *TirePressureUpdate) AddPressureRr(v float64) *TirePressureUpdate {
+	_u.mutation.AddPressureRr(v)
+	return _u
+}
+
+// ClearPressureRr clears the value of the "pressure_rr" field.
+func (_u *TirePressureUpdate) ClearPressureRr() *TirePressureUpdate {
+	_u.mutation.ClearPressureRr()
+	return _u
+}
+
+// SetSeenAtFl sets the "seen_at_fl" field.
+func (_u *TirePressureUpdate) SetSeenAtFl(v time.Time) *TirePressureUpdate {
+	_u.mutation.SetSeenAtFl(v)
+	return _u
+}
+
+// SetNillableSeenAtFl sets the "seen_at_fl" field if the given value is not nil.
+func (_u *TirePressureUpdate) SetNillableSeenAtFl(v *time.Time) *TirePressureUpdate {
+	if v != nil {
+		_u.SetSeenAtFl(*v)
+	}
+	return _u
+}
+
+// ClearSeenAtFl clears the value of the "seen_at_fl" field.
+func (_u *TirePressureUpdate) ClearSeenAtFl() *TirePressureUpdate {
+	_u.mutation.ClearSeenAtFl()
+	return _u
+}
+
+// SetSeenAtFr sets the "seen_at_fr" field.
+func (_u *TirePressureUpdate) SetSeenAtFr(v time.Time) *TirePressureUpdate {
+	_u.mutation.SetSeenAtFr(v)
+	return _u
+}
+
+// SetNillableSeenAtFr sets the "seen_at_fr" field if the given value is not nil.
+func (_u *TirePressureUpdate) SetNillableSeenAtFr(v *time.Time) *TirePressureUpdate {
+	if v != nil {
+		_u.SetSeenAtFr(*v)
+	}
+	return _u
+}
+
+// ClearSeenAtFr clears the value of the "seen_at_fr" field.
+func (_u *TirePressureUpdate) ClearSeenAtFr() *TirePressureUpdate {
+	_u.mutation.ClearSeenAtFr()
+	return _u
+}
+
+// SetSeenAtRl sets the "seen_at_rl" field.
+func (_u *TirePressureUpdate) SetSeenAtRl(v time.Time) *TirePressureUpdate {
+	_u.mutation.SetSeenAtRl(v)
+	return _u
+}
+
+// SetNillableSeenAtRl sets the "seen_at_rl" field if the given value is not nil.
+func (_u *TirePressureUpdate) SetNillableSeenAtRl(v *time.Time) *TirePressureUpdate {
+	if v != nil {
+		_u.SetSeenAtRl(*v)
+	}
+	return _u
+}
+
+// ClearSeenAtRl clears the value of the "seen_at_rl" field.
+func (_u *TirePressureUpdate) ClearSeenAtRl() *TirePressureUpdate {
+	_u.mutation.ClearSeenAtRl()
+	return _u
+}
+
+// SetSeenAtRr sets the "seen_at_rr" field.
+func (_u *TirePressureUpdate) SetSeenAtRr(v time.Time) *TirePressureUpdate {
+	_u.mutation.SetSeenAtRr(v)
+	return _u
+}
+
+// SetNillableSeenAtRr sets the "seen_at_rr" field if the given value is not nil.
+func (_u *TirePressureUpdate) SetNillableSeenAtRr(v *time.Time) *TirePressureUpdate {
+	if v != nil {
+		_u.SetSeenAtRr(*v)
+	}
+	return _u
+}
+
+// ClearSeenAtRr clears the value of the "seen_at_rr" field.
+func (_u *TirePressureUpdate) ClearSeenAtRr() *TirePressureUpdate {
+	_u.mutation.ClearSeenAtRr()
+	return _u
+}
+
+// SetRecommendedFront sets the "recommended_front" field.
+func (_u *TirePressureUpdate) SetRecommendedFront(v float64) *TirePressureUpdate {
+	_u.mutation.ResetRecommendedFront()
+	_u.mutation.SetRecommendedFront(v)
+	return _u
+}
+
+// SetNillableRecommendedFront sets the "recommended_front" field if the given value is not nil.
+func (_u *TirePressureUpdate) SetNillableRecommendedFront(v *float64) *TirePressureUpdate {
+	if v != nil {
+		_u.SetRecommendedFront(*v)
+	}
+	return _u
+}
+
+// AddRecommendedFront adds value to the "recommended_front" field.
+func (_u *TirePressureUpdate) AddRecommendedFront(v float64) *TirePressureUpdate {
+	_u.mutation.AddRecommendedFront(v)
+	return _u
+}
+
+// ClearRecommendedFront clears the value of the "recommended_front" field.
+func (_u *TirePressureUpdate) ClearRecommendedFront() *TirePressureUpdate {
+	_u.mutation.ClearRecommendedFront()
+	return _u
+}
+
+// SetRecommendedRear sets the "recommended_rear" field.
+func (_u *TirePressureUpdate) SetRecommendedRear(v float64) *TirePressureUpdate {
+	_u.mutation.ResetRecommendedRear()
+	_u.mutation.SetRecommendedRear(v)
+	return _u
+}
+
+// SetNillableRecommendedRear sets the "recommended_rear" field if the given value is not nil.
+func (_u *TirePressureUpdate) SetNillableRecommendedRear(v *float64) *TirePressureUpdate {
+	if v != nil {
+		_u.SetRecommendedRear(*v)
+	}
+	return _u
+}
+
+// AddRecommendedRear adds value to the "recommended_rear" field.
+func (_u *TirePressureUpdate) AddRecommendedRear(v float64) *TirePressureUpdate {
+	_u.mutation.AddRecommendedRear(v)
+	return _u
+}
+
+// ClearRecommendedRear clears the value of the "recommended_rear" field.
+func (_u *TirePressureUpdate) ClearRecommendedRear() *TirePressureUpdate {
+	_u.mutation.ClearRecommendedRear()
+	return _u
+}
+
+// SetSoftWarnings sets the "soft_warnings" field.
+func (_u *TirePressureUpdate) SetSoftWarnings(v int) *TirePressureUpdate {
+	_u.mutation.ResetSoftWarnings()
+	_u.mutation.SetSoftWarnings(v)
+	return _u
+}
+
+// SetNillableSoftWarnings sets the "soft_warnings" field if the given value is not nil.
+func (_u *TirePressureUpdate) SetNillableSoftWarnings(v *int) *TirePressureUpdate {
+	if v != nil {
+		_u.SetSoftWarnings(*v)
+	}
+	return _u
+}
+
+// AddSoftWarnings adds value to the "soft_warnings" field.
+func (_u *TirePressureUpdate) AddSoftWarnings(v int) *TirePressureUpdate {
+	_u.mutation.AddSoftWarnings(v)
+	return _u
+}
+
+// SetHardWarnings sets the "hard_warnings" field.
+func (_u *TirePressureUpdate) SetHardWarnings(v int) *TirePressureUpdate {
+	_u.mutation.ResetHardWarnings()
+	_u.mutation.SetHardWarnings(v)
+	return _u
+}
+
+// SetNillableHardWarnings sets the "hard_warnings" field if the given value is not nil.
+func (_u *TirePressureUpdate) SetNillableHardWarnings(v *int) *TirePressureUpdate {
+	if v != nil {
+		_u.SetHardWarnings(*v)
+	}
+	return _u
+}
+
+// AddHardWarnings adds value to the "hard_warnings" field.
+func (_u *TirePressureUpdate) AddHardWarnings(v int) *TirePressureUpdate {
+	_u.mutation.AddHardWarnings(v)
+	return _u
+}
+
+// SetUnit sets the "unit" field.
+func (_u *TirePressureUpdate) SetUnit(v string) *TirePressureUpdate {
+	_u.mutation.SetUnit(v)
+	return _u
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (_u *TirePressureUpdate) SetNillableUnit(v *string) *TirePressureUpdate {
+	if v != nil {
+		_u.SetUnit(*v)
+	}
+	return _u
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (_u *TirePressureUpdate) ClearUnit() *TirePressureUpdate {
+	_u.mutation.ClearUnit()
+	return _u
+}
+
+// Mutation returns the TirePressureMutation object of the builder.
+func (_u *TirePressureUpdate) Mutation() *TirePressureMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TirePressureUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TirePressureUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TirePressureUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TirePressureUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TirePressureUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tirepressure.Table, tirepressure.Columns, sqlgraph.NewFieldSpec(tirepressure.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VehicleID(); ok {
+		_spec.SetField(tirepressure.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVehicleID(); ok {
+		_spec.AddField(tirepressure.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PressureFl(); ok {
+		_spec.SetField(tirepressure.FieldPressureFl, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPressureFl(); ok {
+		_spec.AddField(tirepressure.FieldPressureFl, field.TypeFloat64, value)
+	}
+	if _u.mutation.PressureFlCleared() {
+		_spec.ClearField(tirepressure.FieldPressureFl, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PressureFr(); ok {
+		_spec.SetField(tirepressure.FieldPressureFr, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPressureFr(); ok {
+		_spec.AddField(tirepressure.FieldPressureFr, field.TypeFloat64, value)
+	}
+	if _u.mutation.PressureFrCleared() {
+		_spec.ClearField(tirepressure.FieldPressureFr, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PressureRl(); ok {
+		_spec.SetField(tirepressure.FieldPressureRl, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPressureRl(); ok {
+		_spec.AddField(tirepressure.FieldPressureRl, field.TypeFloat64, value)
+	}
+	if _u.mutation.PressureRlCleared() {
+		_spec.ClearField(tirepressure.FieldPressureRl, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PressureRr(); ok {
+		_spec.SetField(tirepressure.FieldPressureRr, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPressureRr(); ok {
+		_spec.AddField(tirepressure.FieldPressureRr, field.TypeFloat64, value)
+	}
+	if _u.mutation.PressureRrCleared() {
+		_spec.ClearField(tirepressure.FieldPressureRr, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.SeenAtFl(); ok {
+		_spec.SetField(tirepressure.FieldSeenAtFl, field.TypeTime, value)
+	}
+	if _u.mutation.SeenAtFlCleared() {
+		_spec.ClearField(tirepressure.FieldSeenAtFl, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SeenAtFr(); ok {
+		_spec.SetField(tirepressure.FieldSeenAtFr, field.TypeTime, value)
+	}
+	if _u.mutation.SeenAtFrCleared() {
+		_spec.ClearField(tirepressure.FieldSeenAtFr, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SeenAtRl(); ok {
+		_spec.SetField(tirepressure.FieldSeenAtRl, field.TypeTime, value)
+	}
+	if _u.mutation.SeenAtRlCleared() {
+		_spec.ClearField(tirepressure.FieldSeenAtRl, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SeenAtRr(); ok {
+		_spec.SetField(tirepressure.FieldSeenAtRr, field.TypeTime, value)
+	}
+	if _u.mutation.SeenAtRrCleared() {
+		_spec.ClearField(tirepressure.FieldSeenAtRr, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RecommendedFront(); ok {
+		_spec.SetField(tirepressure.FieldRecommendedFront, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRecommendedFront(); ok {
+		_spec.AddField(tirepressure.FieldRecommendedFront, field.TypeFloat64, value)
+	}
+	if _u.mutation.RecommendedFrontCleared() {
+		_spec.ClearField(tirepressure.FieldRecommendedFront, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.RecommendedRear(); ok {
+		_spec.SetField(tirepressure.FieldRecommendedRear, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRecommendedRear(); ok {
+		_spec.AddField(tirepressure.FieldRecommendedRear, field.TypeFloat64, value)
+	}
+	if _u.mutation.RecommendedRearCleared() {
+		_spec.ClearField(tirepressure.FieldRecommendedRear, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.SoftWarnings(); ok {
+		_spec.SetField(tirepressure.FieldSoftWarnings, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSoftWarnings(); ok {
+		_spec.AddField(tirepressure.FieldSoftWarnings, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.HardWarnings(); ok {
+		_spec.SetField(tirepressure.FieldHardWarnings, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHardWarnings(); ok {
+		_spec.AddField(tirepressure.FieldHardWarnings, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Unit(); ok {
+		_spec.SetField(tirepressure.FieldUnit, field.TypeString, value)
+	}
+	if _u.mutation.UnitCleared() {
+		_spec.ClearField(tirepressure.FieldUnit, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tirepressure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TirePressureUpdateOne is the builder for updating a single TirePressure entity.
+type TirePressureUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TirePressureMutation
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_u *TirePressureUpdateOne) SetVehicleID(v int) *TirePressureUpdateOne {
+	_u.mutation.ResetVehicleID()
+	_u.mutation.SetVehicleID(v)
+	return _u
+}
+
+// SetNillableVehicleID sets the "vehicle_id" field if the given value is not nil.
+func (_u *TirePressureUpdateOne) SetNillableVehicleID(v *int) *TirePressureUpdateOne {
+	if v != nil {
+		_u.SetVehicleID(*v)
+	}
+	return _u
+}
+
+// AddVehicleID adds value to the "vehicle_id" field.
+func (_u *TirePressureUpdateOne) AddVehicleID(v int) *TirePressureUpdateOne {
+	_u.mutation.AddVehicleID(v)
+	return _u
+}
+
+// SetPressureFl sets the "pressure_fl" field.
+func (_u *TirePressureUpdateOne) SetPressureFl(v float64) *TirePressureUpdateOne {
+	_u.mutation.ResetPressureFl()
+	_u.mutation.SetPressureFl(v)
+	return _u
+}
+
+// SetNillablePressureFl sets the "pressure_fl" field if the given value is not nil.
+func (_u *TirePressureUpdateOne) SetNillablePressureFl(v *float64) *TirePressureUpdateOne {
+	if v != nil {
+		_u.SetPressureFl(*v)
+	}
+	return _u
+}
+
+// AddPressureFl adds value to the "pressure_fl" field.
+func (_u *TirePressureUpdateOne) AddPressureFl(v float64) *TirePressureUpdateOne {
+	_u.mutation.AddPressureFl(v)
+	return _u
+}
+
+// ClearPressureFl clears the value of the "pressure_fl" field.
+func (_u *TirePressureUpdateOne) ClearPressureFl() *TirePressureUpdateOne {
+	_u.mutation.ClearPressureFl()
+	return _u
+}
+
+// SetPressureFr sets the "pressure_fr" field.
+func (_u *TirePressureUpdateOne) SetPressureFr(v float64) *TirePressureUpdateOne {
+	_u.mutation.ResetPressureFr()
+	_u.mutation.SetPressureFr(v)
+	return _u
+}
+
+// SetNillablePressureFr sets the "pressure_fr" field if the given value is not nil.
+func (_u *TirePressureUpdateOne) SetNillablePressureFr(v *float64) *TirePressureUpdateOne {
+	if v != nil {
+		_u.SetPressureFr(*v)
+	}
+	return _u
+}
+
+// AddPressureFr adds value to the "pressure_fr" field.
+func (_u *TirePressureUpdateOne) AddPressureFr(v float64) *TirePressureUpdateOne {
+	_u.mutation.AddPressureFr(v)
+	return _u
+}
+
+// ClearPressureFr clears the value of the "pressure_fr" field.
+func (_u *TirePressureUpdateOne) ClearPressureFr() *TirePressureUpdateOne {
+	_u.mutation.ClearPressureFr()
+	return _u
+}
+
+// SetPressureRl sets the "pressure_rl" field.
+func (_u *TirePressureUpdateOne) SetPressureRl(v float64) *TirePressureUpdateOne {
+	_u.mutation.ResetPressureRl()
+	_u.mutation.SetPressureRl(v)
+	return _u
+}
+
+// SetNillablePressureRl sets the "pressure_rl" field if the given value is not nil.
+func (_u *TirePressureUpdateOne) SetNillablePressureRl(v *float64) *TirePressureUpdateOne {
+	if v != nil {
+		_u.SetPressureRl(*v)
+	}
+	return _u
+}
+
+// AddPressureRl adds value to the "pressure_rl" field.
+func (_u *TirePressureUpdateOne) AddPressureRl(v float64) *TirePressureUpdateOne {
+	_u.mutation.AddPressureRl(v)
+	return _u
+}
+
+// ClearPressureRl clears the value of the "pressure_rl" field.
+func (_u *TirePressureUpdateOne) ClearPressureRl() *TirePressureUpdateOne {
+	_u.mutation.ClearPressureRl()
+	return _u
+}
+
+// SetPressureRr sets the "pressure_rr" field.
+func (_u *TirePressureUpdateOne) SetPressureRr(v float64) *TirePressureUpdateOne {
+	_u.mutation.ResetPressureRr()
+	_u.mutation.SetPressureRr(v)
+	return _u
+}
+
+// SetNillablePressureRr sets the "pressure_rr" field if the given value is not nil.
+func (_u *TirePressureUpdateOne) SetNillablePressureRr(v *float64) *TirePressureUpdateOne {
+	if v != nil {
+		_u.SetPressureRr(*v)
+	}
+	return _u
+}
+
+// AddPressureRr adds value to the "pressure_rr" field.
+func (_u *TirePressureUpdateOne) AddPressureRr(v float64) *TirePressureUpdateOne {
+	_u.mutation.AddPressureRr(v)
+	return _u
+}
+
+// ClearPressureRr clears the value of the "pressure_rr" field.
+func (_u *TirePressureUpdateOne) ClearPressureRr() *TirePressureUpdateOne {
+	_u.mutation.ClearPressureRr()
+	return _u
+}
+
+// SetSeenAtFl sets the "seen_at_fl" field.
+func (_u *TirePressureUpdateOne) SetSeenAtFl(v time.Time) *TirePressureUpdateOne {
+	_u.mutation.SetSeenAtFl(v)
+	return _u
+}
+
+// SetNillableSeenAtFl sets the "seen_at_fl" field if the given value is not nil.
+func (_u *TirePressureUpdateOne) SetNillableSeenAtFl(v *time.Time) *TirePressureUpdateOne {
+	if v != nil {
+		_u.SetSeenAtFl(*v)
+	}
+	return _u
+}
+
+// ClearSeenAtFl clears the value of the "seen_at_fl" field.
+func (_u *TirePressureUpdateOne) ClearSeenAtFl() *TirePressureUpdateOne {
+	_u.mutation.ClearSeenAtFl()
+	return _u
+}
+
+// SetSeenAtFr sets the "seen_at_fr" field.
+func (_u *TirePressureUpdateOne) SetSeenAtFr(v time.Time) *TirePressureUpdateOne {
+	_u.mutation.SetSeenAtFr(v)
+	return _u
+}
+
+// SetNillableSeenAtFr sets the "seen_at_fr" field if the given value is not nil.
+func (_u *TirePressureUpdateOne) SetNillableSeenAtFr(v *time.Time) *TirePressureUpdateOne {
+	if v != nil {
+		_u.SetSeenAtFr(*v)
+	}
+	return _u
+}
+
+// ClearSeenAtFr clears the value of the "seen_at_fr" field.
+func (_u *TirePressureUpdateOne) ClearSeenAtFr() *TirePressureUpdateOne {
+	_u.mutation.ClearSeenAtFr()
+	return _u
+}
+
+// SetSeenAtRl sets the "seen_at_rl" field.
+func (_u *TirePressureUpdateOne) SetSeenAtRl(v time.Time) *TirePressureUpdateOne {
+	_u.mutation.SetSeenAtRl(v)
+	return _u
+}
+
+// SetNillableSeenAtRl sets the "seen_at_rl" field if the given value is not nil.
+func (_u *TirePressureUpdateOne) SetNillableSeenAtRl(v *time.Time) *TirePressureUpdateOne {
+	if v != nil {
+		_u.SetSeenAtRl(*v)
+	}
+	return _u
+}
+
+// ClearSeenAtRl clears the value of the "seen_at_rl" field.
+func (_u *TirePressureUpdateOne) ClearSeenAtRl() *TirePressureUpdateOne {
+	_u.mutation.ClearSeenAtRl()
+	return _u
+}
+
+// SetSeenAtRr sets the "seen_at_rr" field.
+func (_u *TirePressureUpdateOne) SetSeenAtRr(v time.Time) *TirePressureUpdateOne {
+	_u.mutation.SetSeenAtRr(v)
+	return _u
+}
+
+// SetNillableSeenAtRr sets the "seen_at_rr" field if the given value is not nil.
+func (_u *TirePressureUpdateOne) SetNillableSeenAtRr(v *time.Time) *TirePressureUpdateOne {
+	if v != nil {
+		_u.SetSeenAtRr(*v)
+	}
+	return _u
+}
+
+// ClearSeenAtRr clears the value of the "seen_at_rr" field.
+func (_u *TirePressureUpdateOne) ClearSeenAtRr() *TirePressureUpdateOne {
+	_u.mutation.ClearSeenAtRr()
+	return _u
+}
+
+// SetRecommendedFront sets the "recommended_front" field.
+func (_u *TirePressureUpdateOne) SetRecommendedFront(v float64) *TirePressureUpdateOne {
+	_u.mutation.ResetRecommendedFront()
+	_u.mutation.SetRecommendedFront(v)
+	return _u
+}
+
+// SetNillableRecommendedFront sets the "recommended_front" field if the given value is not nil.
+func (_u *TirePressureUpdateOne) SetNillableRecommendedFront(v *float64) *TirePressureUpdateOne {
+	if v != nil {
+		_u.SetRecommendedFront(*v)
+	}
+	return _u
+}
+
+// AddRecommendedFront adds value to the "recommended_front" field.
+func (_u *TirePressureUpdateOne) AddRecommendedFront(v float64) *TirePressureUpdateOne {
+	_u.mutation.AddRecommendedFront(v)
+	return _u
+}
+
+// ClearRecommendedFront clears the value of the "recommended_front" field.
+func (_u *TirePressureUpdateOne) ClearRecommendedFront() *TirePressureUpdateOne {
+	_u.mutation.ClearRecommendedFront()
+	return _u
+}
+
+// SetRecommendedRear sets the "recommended_rear" field.
+func (_u *TirePressureUpdateOne) SetRecommendedRear(v float64) *TirePressureUpdateOne {
+	_u.mutation.ResetRecommendedRear()
+	_u.mutation.SetRecommendedRear(v)
+	return _u
+}
+
+// SetNillableRecommendedRear sets the "recommended_rear" field if the given value is not nil.
+func (_u *TirePressureUpdateOne) SetNillableRecommendedRear(v *float64) *TirePressureUpdateOne {
+	if v != nil {
+		_u.SetRecommendedRear(*v)
+	}
+	return _u
+}
+
+// AddRecommendedRear adds value to the "recommended_rear" field.
+func (_u *TirePressureUpdateOne) AddRecommendedRear(v float64) *TirePressureUpdateOne {
+	_u.mutation.AddRecommendedRear(v)
+	return _u
+}
+
+// ClearRecommendedRear clears the value of the "recommended_rear" field.
+func (_u *TirePressureUpdateOne) ClearRecommendedRear() *TirePressureUpdateOne {
+	_u.mutation.ClearRecommendedRear()
+	return _u
+}
+
+// SetSoftWarnings sets the "soft_warnings" field.
+func (_u *TirePressureUpdateOne) SetSoftWarnings(v int) *TirePressureUpdateOne {
+	_u.mutation.ResetSoftWarnings()
+	_u.mutation.SetSoftWarnings(v)
+	return _u
+}
+
+// SetNillableSoftWarnings sets the "soft_warnings" field if the given value is not nil.
+func (_u *TirePressureUpdateOne) SetNillableSoftWarnings(v *int) *TirePressureUpdateOne {
+	if v != nil {
+		_u.SetSoftWarnings(*v)
+	}
+	return _u
+}
+
+// AddSoftWarnings adds value to the "soft_warnings" field.
+func (_u *TirePressureUpdateOne) AddSoftWarnings(v int) *TirePressureUpdateOne {
+	_u.mutation.AddSoftWarnings(v)
+	return _u
+}
+
+// SetHardWarnings sets the "hard_warnings" field.
+func (_u *TirePressureUpdateOne) SetHardWarnings(v int) *TirePressureUpdateOne {
+	_u.mutation.ResetHardWarnings()
+	_u.mutation.SetHardWarnings(v)
+	return _u
+}
+
+// SetNillableHardWarnings sets the "hard_warnings" field if the given value is not nil.
+func (_u *TirePressureUpdateOne) SetNillableHardWarnings(v *int) *TirePressureUpdateOne {
+	if v != nil {
+		_u.SetHardWarnings(*v)
+	}
+	return _u
+}
+
+// AddHardWarnings adds value to the "hard_warnings" field.
+func (_u *TirePressureUpdateOne) AddHardWarnings(v int) *TirePressureUpdateOne {
+	_u.mutation.AddHardWarnings(v)
+	return _u
+}
+
+// SetUnit sets the "unit" field.
+func (_u *TirePressureUpdateOne) SetUnit(v string) *TirePressureUpdateOne {
+	_u.mutation.SetUnit(v)
+	return _u
+}
+
+// SetNillableUnit sets the "unit" field if the given value is not nil.
+func (_u *TirePressureUpdateOne) SetNillableUnit(v *string) *TirePressureUpdateOne {
+	if v != nil {
+		_u.SetUnit(*v)
+	}
+	return _u
+}
+
+// ClearUnit clears the value of the "unit" field.
+func (_u *TirePressureUpdateOne) ClearUnit() *TirePressureUpdateOne {
+	_u.mutation.ClearUnit()
+	return _u
+}
+
+// Mutation returns the TirePressureMutation object of the builder.
+func (_u *TirePressureUpdateOne) Mutation() *TirePressureMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TirePressureUpdate builder.
+func (_u *TirePressureUpdateOne) Where(ps ...predicate.TirePressure) *TirePressureUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TirePressureUpdateOne) Select(field string, fields ...string) *TirePressureUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TirePressure entity.
+func (_u *TirePressureUpdateOne) Save(ctx context.Context) (*TirePressure, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TirePressureUpdateOne) SaveX(ctx context.Context) *TirePressure {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TirePressureUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TirePressureUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *TirePressureUpdateOne) sqlSave(ctx context.Context) (_node *TirePressure, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tirepressure.Table, tirepressure.Columns, sqlgraph.NewFieldSpec(tirepressure.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TirePressure.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tirepressure.FieldID)
+		for _, f := range fields {
+			if !tirepressure.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tirepressure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VehicleID(); ok {
+		_spec.SetField(tirepressure.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVehicleID(); ok {
+		_spec.AddField(tirepressure.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PressureFl(); ok {
+		_spec.SetField(tirepressure.FieldPressureFl, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPressureFl(); ok {
+		_spec.AddField(tirepressure.FieldPressureFl, field.TypeFloat64, value)
+	}
+	if _u.mutation.PressureFlCleared() {
+		_spec.ClearField(tirepressure.FieldPressureFl, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PressureFr(); ok {
+		_spec.SetField(tirepressure.FieldPressureFr, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPressureFr(); ok {
+		_spec.AddField(tirepressure.FieldPressureFr, field.TypeFloat64, value)
+	}
+	if _u.mutation.PressureFrCleared() {
+		_spec.ClearField(tirepressure.FieldPressureFr, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PressureRl(); ok {
+		_spec.SetField(tirepressure.FieldPressureRl, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPressureRl(); ok {
+		_spec.AddField(tirepressure.FieldPressureRl, field.TypeFloat64, value)
+	}
+	if _u.mutation.PressureRlCleared() {
+		_spec.ClearField(tirepressure.FieldPressureRl, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PressureRr(); ok {
+		_spec.SetField(tirepressure.FieldPressureRr, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPressureRr(); ok {
+		_spec.AddField(tirepressure.FieldPressureRr, field.TypeFloat64, value)
+	}
+	if _u.mutation.PressureRrCleared() {
+		_spec.ClearField(tirepressure.FieldPressureRr, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.SeenAtFl(); ok {
+		_spec.SetField(tirepressure.FieldSeenAtFl, field.TypeTime, value)
+	}
+	if _u.mutation.SeenAtFlCleared() {
+		_spec.ClearField(tirepressure.FieldSeenAtFl, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SeenAtFr(); ok {
+		_spec.SetField(tirepressure.FieldSeenAtFr, field.TypeTime, value)
+	}
+	if _u.mutation.SeenAtFrCleared() {
+		_spec.ClearField(tirepressure.FieldSeenAtFr, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SeenAtRl(); ok {
+		_spec.SetField(tirepressure.FieldSeenAtRl, field.TypeTime, value)
+	}
+	if _u.mutation.SeenAtRlCleared() {
+		_spec.ClearField(tirepressure.FieldSeenAtRl, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SeenAtRr(); ok {
+		_spec.SetField(tirepressure.FieldSeenAtRr, field.TypeTime, value)
+	}
+	if _u.mutation.SeenAtRrCleared() {
+		_spec.ClearField(tirepressure.FieldSeenAtRr, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RecommendedFront(); ok {
+		_spec.SetField(tirepressure.FieldRecommendedFront, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRecommendedFront(); ok {
+		_spec.AddField(tirepressure.FieldRecommendedFront, field.TypeFloat64, value)
+	}
+	if _u.mutation.RecommendedFrontCleared() {
+		_spec.ClearField(tirepressure.FieldRecommendedFront, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.RecommendedRear(); ok {
+		_spec.SetField(tirepressure.FieldRecommendedRear, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRecommendedRear(); ok {
+		_spec.AddField(tirepressure.FieldRecommendedRear, field.TypeFloat64, value)
+	}
+	if _u.mutation.RecommendedRearCleared() {
+		_spec.ClearField(tirepressure.FieldRecommendedRear, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.SoftWarnings(); ok {
+		_spec.SetField(tirepressure.FieldSoftWarnings, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSoftWarnings(); ok {
+		_spec.AddField(tirepressure.FieldSoftWarnings, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.HardWarnings(); ok {
+		_spec.SetField(tirepressure.FieldHardWarnings, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHardWarnings(); ok {
+		_spec.AddField(tirepressure.FieldHardWarnings, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Unit(); ok {
+		_spec.SetField(tirepressure.FieldUnit, field.TypeString, value)
+	}
+	if _u.mutation.UnitCleared() {
+		_spec.ClearField(tirepressure.FieldUnit, field.TypeString)
+	}
+	_node = &TirePressure{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tirepressure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
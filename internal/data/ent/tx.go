@@ -12,16 +12,36 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Address is the client for interacting with the Address builders.
+	Address *AddressClient
 	// Authorize is the client for interacting with the Authorize builders.
 	Authorize *AuthorizeClient
 	// AuthorizeToken is the client for interacting with the AuthorizeToken builders.
 	AuthorizeToken *AuthorizeTokenClient
+	// Geofence is the client for interacting with the Geofence builders.
+	Geofence *GeofenceClient
 	// Partner is the client for interacting with the Partner builders.
 	Partner *PartnerClient
+	// RollupState is the client for interacting with the RollupState builders.
+	RollupState *RollupStateClient
+	// SoftwareUpdate is the client for interacting with the SoftwareUpdate builders.
+	SoftwareUpdate *SoftwareUpdateClient
+	// Tariff is the client for interacting with the Tariff builders.
+	Tariff *TariffClient
+	// TirePressure is the client for interacting with the TirePressure builders.
+	TirePressure *TirePressureClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// Vehicle is the client for interacting with the Vehicle builders.
 	Vehicle *VehicleClient
+	// VehicleEvent is the client for interacting with the VehicleEvent builders.
+	VehicleEvent *VehicleEventClient
+	// VehicleRollup is the client for interacting with the VehicleRollup builders.
+	VehicleRollup *VehicleRollupClient
+	// VehicleSnapshot is the client for interacting with the VehicleSnapshot builders.
+	VehicleSnapshot *VehicleSnapshotClient
+	// VehicleStatePeriod is the client for interacting with the VehicleStatePeriod builders.
+	VehicleStatePeriod *VehicleStatePeriodClient
 
 	// lazily loaded.
 	client     *Client
@@ -153,11 +173,21 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Address = NewAddressClient(tx.config)
 	tx.Authorize = NewAuthorizeClient(tx.config)
 	tx.AuthorizeToken = NewAuthorizeTokenClient(tx.config)
+	tx.Geofence = NewGeofenceClient(tx.config)
 	tx.Partner = NewPartnerClient(tx.config)
+	tx.RollupState = NewRollupStateClient(tx.config)
+	tx.SoftwareUpdate = NewSoftwareUpdateClient(tx.config)
+	tx.Tariff = NewTariffClient(tx.config)
+	tx.TirePressure = NewTirePressureClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.Vehicle = NewVehicleClient(tx.config)
+	tx.VehicleEvent = NewVehicleEventClient(tx.config)
+	tx.VehicleRollup = NewVehicleRollupClient(tx.config)
+	tx.VehicleSnapshot = NewVehicleSnapshotClient(tx.config)
+	tx.VehicleStatePeriod = NewVehicleStatePeriodClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -167,7 +197,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Authorize.QueryXXX(), the query will be executed
+// applies a query, for example: Address.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
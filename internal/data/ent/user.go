@@ -12,36 +12,38 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
-// 用户表
+// User table
 type User struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
-	// 账号
+	// Account
 	Account string `json:"account,omitempty"`
-	// Password holds the value of the "password" field.
+	// Password
 	Password string `json:"password,omitempty"`
-	// Mobile holds the value of the "mobile" field.
+	// Mobile phone number
 	Mobile string `json:"mobile,omitempty"`
-	// OpenID holds the value of the "open_id" field.
+	// Wechat OpenID
 	OpenID string `json:"open_id,omitempty"`
-	// Avatar holds the value of the "avatar" field.
+	// User avatar URL
 	Avatar string `json:"avatar,omitempty"`
-	// NickName holds the value of the "nick_name" field.
+	// User nickname
 	NickName string `json:"nick_name,omitempty"`
-	// Introduction holds the value of the "introduction" field.
+	// User introduction
 	Introduction string `json:"introduction,omitempty"`
-	// Gender holds the value of the "gender" field.
+	// Gender, 0:unknown, 1:male, 2:female
 	Gender *int8 `json:"gender,omitempty"`
-	// AskedUserID holds the value of the "asked_user_id" field.
+	// ID of the user who invited this user
 	AskedUserID int `json:"asked_user_id,omitempty"`
-	// AreaCode holds the value of the "area_code" field.
+	// Area code for mobile number
 	AreaCode string `json:"area_code,omitempty"`
-	// CreatedAt holds the value of the "created_at" field.
+	// IANA time zone statistics are rolled up in
+	TimeZone string `json:"time_zone,omitempty"`
+	// Creation time
 	CreatedAt time.Time `json:"created_at,omitempty"`
-	// UpdatedAt holds the value of the "updated_at" field.
+	// Update time
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	// Deleted holds the value of the "deleted" field.
+	// Is deleted
 	Deleted      bool `json:"deleted,omitempty"`
 	selectValues sql.SelectValues
 }
@@ -55,7 +57,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case user.FieldID, user.FieldGender, user.FieldAskedUserID:
 			values[i] = new(sql.NullInt64)
-		case user.FieldAccount, user.FieldPassword, user.FieldMobile, user.FieldOpenID, user.FieldAvatar, user.FieldNickName, user.FieldIntroduction, user.FieldAreaCode:
+		case user.FieldAccount, user.FieldPassword, user.FieldMobile, user.FieldOpenID, user.FieldAvatar, user.FieldNickName, user.FieldIntroduction, user.FieldAreaCode, user.FieldTimeZone:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -141,6 +143,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.AreaCode = value.String
 			}
+		case user.FieldTimeZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field time_zone", values[i])
+			} else if value.Valid {
+				_m.TimeZone = value.String
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -227,6 +235,9 @@ func (_m *User) String() string {
 	builder.WriteString("area_code=")
 	builder.WriteString(_m.AreaCode)
 	builder.WriteString(", ")
+	builder.WriteString("time_zone=")
+	builder.WriteString(_m.TimeZone)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -33,6 +33,8 @@ const (
 	FieldAskedUserID = "asked_user_id"
 	// FieldAreaCode holds the string denoting the area_code field in the database.
 	FieldAreaCode = "area_code"
+	// FieldTimeZone holds the string denoting the time_zone field in the database.
+	FieldTimeZone = "time_zone"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -56,6 +58,7 @@ var Columns = []string{
 	FieldGender,
 	FieldAskedUserID,
 	FieldAreaCode,
+	FieldTimeZone,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldDeleted,
@@ -74,6 +77,8 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultGender holds the default value on creation for the "gender" field.
 	DefaultGender int8
+	// DefaultTimeZone holds the default value on creation for the "time_zone" field.
+	DefaultTimeZone string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -142,6 +147,11 @@ func ByAreaCode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAreaCode, opts...).ToFunc()
 }
 
+// ByTimeZone orders the results by the time_zone field.
+func ByTimeZone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeZone, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
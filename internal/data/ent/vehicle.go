@@ -12,36 +12,36 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
-// 车辆
+// Vehicle table
 type Vehicle struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
-	// 车辆 VIN 码
+	// Vehicle VIN code
 	Vin string `json:"vin,omitempty"`
-	// UserID holds the value of the "user_id" field.
+	// Associated user ID
 	UserID int `json:"user_id,omitempty"`
-	// DisplayName holds the value of the "display_name" field.
+	// Vehicle display name
 	DisplayName string `json:"display_name,omitempty"`
-	// AccessType holds the value of the "access_type" field.
+	// Access type, e.g., OWNER
 	AccessType string `json:"access_type,omitempty"`
-	// State holds the value of the "state" field.
+	// Vehicle state, e.g., online, offline
 	State int8 `json:"state,omitempty"`
-	// InService holds the value of the "in_service" field.
+	// Is vehicle in service
 	InService *int8 `json:"in_service,omitempty"`
-	// CalendarEnabled holds the value of the "calendar_enabled" field.
+	// Is calendar enabled
 	CalendarEnabled *int8 `json:"calendar_enabled,omitempty"`
-	// CarType holds the value of the "car_type" field.
+	// Car model type
 	CarType string `json:"car_type,omitempty"`
-	// APIVersion holds the value of the "api_version" field.
+	// API version used by vehicle
 	APIVersion string `json:"api_version,omitempty"`
-	// 原始数据
+	// Raw vehicle data from API
 	RawData string `json:"raw_data,omitempty"`
-	// CreatedAt holds the value of the "created_at" field.
+	// Creation time
 	CreatedAt time.Time `json:"created_at,omitempty"`
-	// UpdatedAt holds the value of the "updated_at" field.
+	// Update time
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	// Deleted holds the value of the "deleted" field.
+	// Is deleted
 	Deleted      bool `json:"deleted,omitempty"`
 	selectValues sql.SelectValues
 }
@@ -0,0 +1,277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/vehicleevent"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VehicleEventCreate is the builder for creating a VehicleEvent entity.
+type VehicleEventCreate struct {
+	config
+	mutation *VehicleEventMutation
+	hooks    []Hook
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_c *VehicleEventCreate) SetVehicleID(v int) *VehicleEventCreate {
+	_c.mutation.SetVehicleID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *VehicleEventCreate) SetUserID(v int) *VehicleEventCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *VehicleEventCreate) SetType(v string) *VehicleEventCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetSummary sets the "summary" field.
+func (_c *VehicleEventCreate) SetSummary(v string) *VehicleEventCreate {
+	_c.mutation.SetSummary(v)
+	return _c
+}
+
+// SetData sets the "data" field.
+func (_c *VehicleEventCreate) SetData(v map[string]string) *VehicleEventCreate {
+	_c.mutation.SetData(v)
+	return _c
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (_c *VehicleEventCreate) SetOccurredAt(v time.Time) *VehicleEventCreate {
+	_c.mutation.SetOccurredAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VehicleEventCreate) SetCreatedAt(v time.Time) *VehicleEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VehicleEventCreate) SetNillableCreatedAt(v *time.Time) *VehicleEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the VehicleEventMutation object of the builder.
+func (_c *VehicleEventCreate) Mutation() *VehicleEventMutation {
+	return _c.mutation
+}
+
+// Save creates the VehicleEvent in the database.
+func (_c *VehicleEventCreate) Save(ctx context.Context) (*VehicleEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VehicleEventCreate) SaveX(ctx context.Context) *VehicleEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VehicleEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VehicleEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VehicleEventCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := vehicleevent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VehicleEventCreate) check() error {
+	if _, ok := _c.mutation.VehicleID(); !ok {
+		return &ValidationError{Name: "vehicle_id", err: errors.New(`ent: missing required field "VehicleEvent.vehicle_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "VehicleEvent.user_id"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "VehicleEvent.type"`)}
+	}
+	if _, ok := _c.mutation.Summary(); !ok {
+		return &ValidationError{Name: "summary", err: errors.New(`ent: missing required field "VehicleEvent.summary"`)}
+	}
+	if _, ok := _c.mutation.OccurredAt(); !ok {
+		return &ValidationError{Name: "occurred_at", err: errors.New(`ent: missing required field "VehicleEvent.occurred_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VehicleEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *VehicleEventCreate) sqlSave(ctx context.Context) (*VehicleEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VehicleEventCreate) createSpec() (*VehicleEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VehicleEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(vehicleevent.Table, sqlgraph.NewFieldSpec(vehicleevent.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.VehicleID(); ok {
+		_spec.SetField(vehicleevent.FieldVehicleID, field.TypeInt, value)
+		_node.VehicleID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(vehicleevent.FieldUserID, field.TypeInt, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(vehicleevent.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Summary(); ok {
+		_spec.SetField(vehicleevent.FieldSummary, field.TypeString, value)
+		_node.Summary = value
+	}
+	if value, ok := _c.mutation.Data(); ok {
+		_spec.SetField(vehicleevent.FieldData, field.TypeJSON, value)
+		_node.Data = value
+	}
+	if value, ok := _c.mutation.OccurredAt(); ok {
+		_spec.SetField(vehicleevent.FieldOccurredAt, field.TypeTime, value)
+		_node.OccurredAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(vehicleevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// VehicleEventCreateBulk is the builder for creating many VehicleEvent entities in bulk.
+type VehicleEventCreateBulk struct {
+	config
+	err      error
+	builders []*VehicleEventCreate
+}
+
+// Save creates the VehicleEvent entities in the database.
+func (_c *VehicleEventCreateBulk) Save(ctx context.Context) ([]*VehicleEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*VehicleEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VehicleEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VehicleEventCreateBulk) SaveX(ctx context.Context) []*VehicleEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VehicleEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VehicleEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
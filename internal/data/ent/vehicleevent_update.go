@@ -0,0 +1,422 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/predicate"
+	"teslatrack/internal/data/ent/vehicleevent"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VehicleEventUpdate is the builder for updating VehicleEvent entities.
+type VehicleEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VehicleEventMutation
+}
+
+// Where appends a list predicates to the VehicleEventUpdate builder.
+func (_u *VehicleEventUpdate) Where(ps ...predicate.VehicleEvent) *VehicleEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_u *VehicleEventUpdate) SetVehicleID(v int) *VehicleEventUpdate {
+	_u.mutation.ResetVehicleID()
+	_u.mutation.SetVehicleID(v)
+	return _u
+}
+
+// SetNillableVehicleID sets the "vehicle_id" field if the given value is not nil.
+func (_u *VehicleEventUpdate) SetNillableVehicleID(v *int) *VehicleEventUpdate {
+	if v != nil {
+		_u.SetVehicleID(*v)
+	}
+	return _u
+}
+
+// AddVehicleID adds value to the "vehicle_id" field.
+func (_u *VehicleEventUpdate) AddVehicleID(v int) *VehicleEventUpdate {
+	_u.mutation.AddVehicleID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *VehicleEventUpdate) SetUserID(v int) *VehicleEventUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *VehicleEventUpdate) SetNillableUserID(v *int) *VehicleEventUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *VehicleEventUpdate) AddUserID(v int) *VehicleEventUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *VehicleEventUpdate) SetType(v string) *VehicleEventUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *VehicleEventUpdate) SetNillableType(v *string) *VehicleEventUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *VehicleEventUpdate) SetSummary(v string) *VehicleEventUpdate {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *VehicleEventUpdate) SetNillableSummary(v *string) *VehicleEventUpdate {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// SetData sets the "data" field.
+func (_u *VehicleEventUpdate) SetData(v map[string]string) *VehicleEventUpdate {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// ClearData clears the value of the "data" field.
+func (_u *VehicleEventUpdate) ClearData() *VehicleEventUpdate {
+	_u.mutation.ClearData()
+	return _u
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (_u *VehicleEventUpdate) SetOccurredAt(v time.Time) *VehicleEventUpdate {
+	_u.mutation.SetOccurredAt(v)
+	return _u
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (_u *VehicleEventUpdate) SetNillableOccurredAt(v *time.Time) *VehicleEventUpdate {
+	if v != nil {
+		_u.SetOccurredAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the VehicleEventMutation object of the builder.
+func (_u *VehicleEventUpdate) Mutation() *VehicleEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VehicleEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VehicleEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VehicleEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VehicleEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *VehicleEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vehicleevent.Table, vehicleevent.Columns, sqlgraph.NewFieldSpec(vehicleevent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VehicleID(); ok {
+		_spec.SetField(vehicleevent.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVehicleID(); ok {
+		_spec.AddField(vehicleevent.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(vehicleevent.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(vehicleevent.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(vehicleevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(vehicleevent.FieldSummary, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(vehicleevent.FieldData, field.TypeJSON, value)
+	}
+	if _u.mutation.DataCleared() {
+		_spec.ClearField(vehicleevent.FieldData, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OccurredAt(); ok {
+		_spec.SetField(vehicleevent.FieldOccurredAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vehicleevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VehicleEventUpdateOne is the builder for updating a single VehicleEvent entity.
+type VehicleEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VehicleEventMutation
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_u *VehicleEventUpdateOne) SetVehicleID(v int) *VehicleEventUpdateOne {
+	_u.mutation.ResetVehicleID()
+	_u.mutation.SetVehicleID(v)
+	return _u
+}
+
+// SetNillableVehicleID sets the "vehicle_id" field if the given value is not nil.
+func (_u *VehicleEventUpdateOne) SetNillableVehicleID(v *int) *VehicleEventUpdateOne {
+	if v != nil {
+		_u.SetVehicleID(*v)
+	}
+	return _u
+}
+
+// AddVehicleID adds value to the "vehicle_id" field.
+func (_u *VehicleEventUpdateOne) AddVehicleID(v int) *VehicleEventUpdateOne {
+	_u.mutation.AddVehicleID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *VehicleEventUpdateOne) SetUserID(v int) *VehicleEventUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *VehicleEventUpdateOne) SetNillableUserID(v *int) *VehicleEventUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *VehicleEventUpdateOne) AddUserID(v int) *VehicleEventUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *VehicleEventUpdateOne) SetType(v string) *VehicleEventUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *VehicleEventUpdateOne) SetNillableType(v *string) *VehicleEventUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetSummary sets the "summary" field.
+func (_u *VehicleEventUpdateOne) SetSummary(v string) *VehicleEventUpdateOne {
+	_u.mutation.SetSummary(v)
+	return _u
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (_u *VehicleEventUpdateOne) SetNillableSummary(v *string) *VehicleEventUpdateOne {
+	if v != nil {
+		_u.SetSummary(*v)
+	}
+	return _u
+}
+
+// SetData sets the "data" field.
+func (_u *VehicleEventUpdateOne) SetData(v map[string]string) *VehicleEventUpdateOne {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// ClearData clears the value of the "data" field.
+func (_u *VehicleEventUpdateOne) ClearData() *VehicleEventUpdateOne {
+	_u.mutation.ClearData()
+	return _u
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (_u *VehicleEventUpdateOne) SetOccurredAt(v time.Time) *VehicleEventUpdateOne {
+	_u.mutation.SetOccurredAt(v)
+	return _u
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (_u *VehicleEventUpdateOne) SetNillableOccurredAt(v *time.Time) *VehicleEventUpdateOne {
+	if v != nil {
+		_u.SetOccurredAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the VehicleEventMutation object of the builder.
+func (_u *VehicleEventUpdateOne) Mutation() *VehicleEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the VehicleEventUpdate builder.
+func (_u *VehicleEventUpdateOne) Where(ps ...predicate.VehicleEvent) *VehicleEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VehicleEventUpdateOne) Select(field string, fields ...string) *VehicleEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated VehicleEvent entity.
+func (_u *VehicleEventUpdateOne) Save(ctx context.Context) (*VehicleEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VehicleEventUpdateOne) SaveX(ctx context.Context) *VehicleEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VehicleEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VehicleEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *VehicleEventUpdateOne) sqlSave(ctx context.Context) (_node *VehicleEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vehicleevent.Table, vehicleevent.Columns, sqlgraph.NewFieldSpec(vehicleevent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VehicleEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vehicleevent.FieldID)
+		for _, f := range fields {
+			if !vehicleevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vehicleevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VehicleID(); ok {
+		_spec.SetField(vehicleevent.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVehicleID(); ok {
+		_spec.AddField(vehicleevent.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(vehicleevent.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(vehicleevent.FieldUserID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(vehicleevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Summary(); ok {
+		_spec.SetField(vehicleevent.FieldSummary, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(vehicleevent.FieldData, field.TypeJSON, value)
+	}
+	if _u.mutation.DataCleared() {
+		_spec.ClearField(vehicleevent.FieldData, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.OccurredAt(); ok {
+		_spec.SetField(vehicleevent.FieldOccurredAt, field.TypeTime, value)
+	}
+	_node = &VehicleEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vehicleevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
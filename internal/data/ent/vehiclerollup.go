@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"teslatrack/internal/data/ent/vehiclerollup"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Vehicle statistics rollup table
+type VehicleRollup struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Associated vehicle ID
+	VehicleID int `json:"vehicle_id,omitempty"`
+	// Granularity, e.g., day, week, month
+	Granularity string `json:"granularity,omitempty"`
+	// IANA time zone of the bucket boundaries
+	TimeZone string `json:"time_zone,omitempty"`
+	// Bucket start time
+	StartAt time.Time `json:"start_at,omitempty"`
+	// Bucket end time, exclusive
+	EndAt time.Time `json:"end_at,omitempty"`
+	// Number of drives started in the bucket
+	Drives int `json:"drives,omitempty"`
+	// Distance driven in km
+	Distance float64 `json:"distance,omitempty"`
+	// Time spent driving in seconds
+	DriveSeconds int `json:"drive_seconds,omitempty"`
+	// Energy used while driving in kWh
+	EnergyUsed float64 `json:"energy_used,omitempty"`
+	// Consumption in Wh/km, 0 when unknown
+	Efficiency float64 `json:"efficiency,omitempty"`
+	// Highest speed in km/h
+	MaxSpeed float64 `json:"max_speed,omitempty"`
+	// Number of charging sessions started in the bucket
+	Charges int `json:"charges,omitempty"`
+	// Energy added while charging in kWh
+	EnergyAdded float64 `json:"energy_added,omitempty"`
+	// Cost of the priced charging sessions
+	ChargeCost float64 `json:"charge_cost,omitempty"`
+	// Time spent parked, including asleep, in seconds
+	ParkedSeconds int `json:"parked_seconds,omitempty"`
+	// Time spent asleep or offline in seconds
+	AsleepSeconds int `json:"asleep_seconds,omitempty"`
+	// Update time
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VehicleRollup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vehiclerollup.FieldDistance, vehiclerollup.FieldEnergyUsed, vehiclerollup.FieldEfficiency, vehiclerollup.FieldMaxSpeed, vehiclerollup.FieldEnergyAdded, vehiclerollup.FieldChargeCost:
+			values[i] = new(sql.NullFloat64)
+		case vehiclerollup.FieldID, vehiclerollup.FieldVehicleID, vehiclerollup.FieldDrives, vehiclerollup.FieldDriveSeconds, vehiclerollup.FieldCharges, vehiclerollup.FieldParkedSeconds, vehiclerollup.FieldAsleepSeconds:
+			values[i] = new(sql.NullInt64)
+		case vehiclerollup.FieldGranularity, vehiclerollup.FieldTimeZone:
+			values[i] = new(sql.NullString)
+		case vehiclerollup.FieldStartAt, vehiclerollup.FieldEndAt, vehiclerollup.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VehicleRollup fields.
+func (_m *VehicleRollup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vehiclerollup.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case vehiclerollup.FieldVehicleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vehicle_id", values[i])
+			} else if value.Valid {
+				_m.VehicleID = int(value.Int64)
+			}
+		case vehiclerollup.FieldGranularity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field granularity", values[i])
+			} else if value.Valid {
+				_m.Granularity = value.String
+			}
+		case vehiclerollup.FieldTimeZone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field time_zone", values[i])
+			} else if value.Valid {
+				_m.TimeZone = value.String
+			}
+		case vehiclerollup.FieldStartAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_at", values[i])
+			} else if value.Valid {
+				_m.StartAt = value.Time
+			}
+		case vehiclerollup.FieldEndAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_at", values[i])
+			} else if value.Valid {
+				_m.EndAt = value.Time
+			}
+		case vehiclerollup.FieldDrives:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field drives", values[i])
+			} else if value.Valid {
+				_m.Drives = int(value.Int64)
+			}
+		case vehiclerollup.FieldDistance:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field distance", values[i])
+			} else if value.Valid {
+				_m.Distance = value.Float64
+			}
+		case vehiclerollup.FieldDriveSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field drive_seconds", values[i])
+			} else if value.Valid {
+				_m.DriveSeconds = int(value.Int64)
+			}
+		case vehiclerollup.FieldEnergyUsed:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field energy_used", values[i])
+			} else if value.Valid {
+				_m.EnergyUsed = value.Float64
+			}
+		case vehiclerollup.FieldEfficiency:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field efficiency", values[i])
+			} else if value.Valid {
+				_m.Efficiency = value.Float64
+			}
+		case vehiclerollup.FieldMaxSpeed:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_speed", values[i])
+			} else if value.Valid {
+				_m.MaxSpeed = value.Float64
+			}
+		case vehiclerollup.FieldCharges:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field charges", values[i])
+			} else if value.Valid {
+				_m.Charges = int(value.Int64)
+			}
+		case vehiclerollup.FieldEnergyAdded:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field energy_added", values[i])
+			} else if value.Valid {
+				_m.EnergyAdded = value.Float64
+			}
+		case vehiclerollup.FieldChargeCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field charge_cost", values[i])
+			} else if value.Valid {
+				_m.ChargeCost = value.Float64
+			}
+		case vehiclerollup.FieldParkedSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parked_seconds", values[i])
+			} else if value.Valid {
+				_m.ParkedSeconds = int(value.Int64)
+			}
+		case vehiclerollup.FieldAsleepSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field asleep_seconds", values[i])
+			} else if value.Valid {
+				_m.AsleepSeconds = int(value.Int64)
+			}
+		case vehiclerollup.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VehicleRollup.
+// This includes values selected through modifiers, order, etc.
+func (_m *VehicleRollup) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this VehicleRollup.
+// Note that you need to call VehicleRollup.Unwrap() before calling this method if this VehicleRollup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *VehicleRollup) Update() *VehicleRollupUpdateOne {
+	return NewVehicleRollupClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the VehicleRollup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *VehicleRollup) Unwrap() *VehicleRollup {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VehicleRollup is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *VehicleRollup) String() string {
+	var builder strings.Builder
+	builder.WriteString("VehicleRollup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("vehicle_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VehicleID))
+	builder.WriteString(", ")
+	builder.WriteString("granularity=")
+	builder.WriteString(_m.Granularity)
+	builder.WriteString(", ")
+	builder.WriteString("time_zone=")
+	builder.WriteString(_m.TimeZone)
+	builder.WriteString(", ")
+	builder.WriteString("start_at=")
+	builder.WriteString(_m.StartAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_at=")
+	builder.WriteString(_m.EndAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("drives=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Drives))
+	builder.WriteString(", ")
+	builder.WriteString("distance=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Distance))
+	builder.WriteString(", ")
+	builder.WriteString("drive_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DriveSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("energy_used=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnergyUsed))
+	builder.WriteString(", ")
+	builder.WriteString("efficiency=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Efficiency))
+	builder.WriteString(", ")
+	builder.WriteString("max_speed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxSpeed))
+	builder.WriteString(", ")
+	builder.WriteString("charges=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Charges))
+	builder.WriteString(", ")
+	builder.WriteString("energy_added=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnergyAdded))
+	builder.WriteString(", ")
+	builder.WriteString("charge_cost=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChargeCost))
+	builder.WriteString(", ")
+	builder.WriteString("parked_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ParkedSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("asleep_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AsleepSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VehicleRollups is a parsable slice of VehicleRollup.
+type VehicleRollups []*VehicleRollup
@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vehiclerollup
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the vehiclerollup type in the database.
+	Label = "vehicle_rollup"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVehicleID holds the string denoting the vehicle_id field in the database.
+	FieldVehicleID = "vehicle_id"
+	// FieldGranularity holds the string denoting the granularity field in the database.
+	FieldGranularity = "granularity"
+	// FieldTimeZone holds the string denoting the time_zone field in the database.
+	FieldTimeZone = "time_zone"
+	// FieldStartAt holds the string denoting the start_at field in the database.
+	FieldStartAt = "start_at"
+	// FieldEndAt holds the string denoting the end_at field in the database.
+	FieldEndAt = "end_at"
+	// FieldDrives holds the string denoting the drives field in the database.
+	FieldDrives = "drives"
+	// FieldDistance holds the string denoting the distance field in the database.
+	FieldDistance = "distance"
+	// FieldDriveSeconds holds the string denoting the drive_seconds field in the database.
+	FieldDriveSeconds = "drive_seconds"
+	// FieldEnergyUsed holds the string denoting the energy_used field in the database.
+	FieldEnergyUsed = "energy_used"
+	// FieldEfficiency holds the string denoting the efficiency field in the database.
+	FieldEfficiency = "efficiency"
+	// FieldMaxSpeed holds the string denoting the max_speed field in the database.
+	FieldMaxSpeed = "max_speed"
+	// FieldCharges holds the string denoting the charges field in the database.
+	FieldCharges = "charges"
+	// FieldEnergyAdded holds the string denoting the energy_added field in the database.
+	FieldEnergyAdded = "energy_added"
+	// FieldChargeCost holds the string denoting the charge_cost field in the database.
+	FieldChargeCost = "charge_cost"
+	// FieldParkedSeconds holds the string denoting the parked_seconds field in the database.
+	FieldParkedSeconds = "parked_seconds"
+	// FieldAsleepSeconds holds the string denoting the asleep_seconds field in the database.
+	FieldAsleepSeconds = "asleep_seconds"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the vehiclerollup in the database.
+	Table = "vehicle_rollup"
+)
+
+// Columns holds all SQL columns for vehiclerollup fields.
+var Columns = []string{
+	FieldID,
+	FieldVehicleID,
+	FieldGranularity,
+	FieldTimeZone,
+	FieldStartAt,
+	FieldEndAt,
+	FieldDrives,
+	FieldDistance,
+	FieldDriveSeconds,
+	FieldEnergyUsed,
+	FieldEfficiency,
+	FieldMaxSpeed,
+	FieldCharges,
+	FieldEnergyAdded,
+	FieldChargeCost,
+	FieldParkedSeconds,
+	FieldAsleepSeconds,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDrives holds the default value on creation for the "drives" field.
+	DefaultDrives int
+	// DefaultDistance holds the default value on creation for the "distance" field.
+	DefaultDistance float64
+	// DefaultDriveSeconds holds the default value on creation for the "drive_seconds" field.
+	DefaultDriveSeconds int
+	// DefaultEnergyUsed holds the default value on creation for the "energy_used" field.
+	DefaultEnergyUsed float64
+	// DefaultEfficiency holds the default value on creation for the "efficiency" field.
+	DefaultEfficiency float64
+	// DefaultMaxSpeed holds the default value on creation for the "max_speed" field.
+	DefaultMaxSpeed float64
+	// DefaultCharges holds the default value on creation for the "charges" field.
+	DefaultCharges int
+	// DefaultEnergyAdded holds the default value on creation for the "energy_added" field.
+	DefaultEnergyAdded float64
+	// DefaultChargeCost holds the default value on creation for the "charge_cost" field.
+	DefaultChargeCost float64
+	// DefaultParkedSeconds holds the default value on creation for the "parked_seconds" field.
+	DefaultParkedSeconds int
+	// DefaultAsleepSeconds holds the default value on creation for the "asleep_seconds" field.
+	DefaultAsleepSeconds int
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the VehicleRollup queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVehicleID orders the results by the vehicle_id field.
+func ByVehicleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVehicleID, opts...).ToFunc()
+}
+
+// ByGranularity orders the results by the granularity field.
+func ByGranularity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGranularity, opts...).ToFunc()
+}
+
+// ByTimeZone orders the results by the time_zone field.
+func ByTimeZone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeZone, opts...).ToFunc()
+}
+
+// ByStartAt orders the results by the start_at field.
+func ByStartAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartAt, opts...).ToFunc()
+}
+
+// ByEndAt orders the results by the end_at field.
+func ByEndAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndAt, opts...).ToFunc()
+}
+
+// ByDrives orders the results by the drives field.
+func ByDrives(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDrives, opts...).ToFunc()
+}
+
+// ByDistance orders the results by the distance field.
+func ByDistance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDistance, opts...).ToFunc()
+}
+
+// ByDriveSeconds orders the results by the drive_seconds field.
+func ByDriveSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDriveSeconds, opts...).ToFunc()
+}
+
+// ByEnergyUsed orders the results by the energy_used field.
+func ByEnergyUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnergyUsed, opts...).ToFunc()
+}
+
+// ByEfficiency orders the results by the efficiency field.
+func ByEfficiency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEfficiency, opts...).ToFunc()
+}
+
+// ByMaxSpeed orders the results by the max_speed field.
+func ByMaxSpeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxSpeed, opts...).ToFunc()
+}
+
+// ByCharges orders the results by the charges field.
+func ByCharges(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCharges, opts...).ToFunc()
+}
+
+// ByEnergyAdded orders the results by the energy_added field.
+func ByEnergyAdded(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnergyAdded, opts...).ToFunc()
+}
+
+// ByChargeCost orders the results by the charge_cost field.
+func ByChargeCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChargeCost, opts...).ToFunc()
+}
+
+// ByParkedSeconds orders the results by the parked_seconds field.
+func ByParkedSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParkedSeconds, opts...).ToFunc()
+}
+
+// ByAsleepSeconds orders the results by the asleep_seconds field.
+func ByAsleepSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAsleepSeconds, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,885 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vehiclerollup
+
+import (
+	"teslatrack/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldID, id))
+}
+
+// VehicleID applies equality check predicate on the "vehicle_id" field. It's identical to VehicleIDEQ.
+func VehicleID(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// Granularity applies equality check predicate on the "granularity" field. It's identical to GranularityEQ.
+func Granularity(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldGranularity, v))
+}
+
+// TimeZone applies equality check predicate on the "time_zone" field. It's identical to TimeZoneEQ.
+func TimeZone(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldTimeZone, v))
+}
+
+// StartAt applies equality check predicate on the "start_at" field. It's identical to StartAtEQ.
+func StartAt(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldStartAt, v))
+}
+
+// EndAt applies equality check predicate on the "end_at" field. It's identical to EndAtEQ.
+func EndAt(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldEndAt, v))
+}
+
+// Drives applies equality check predicate on the "drives" field. It's identical to DrivesEQ.
+func Drives(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldDrives, v))
+}
+
+// Distance applies equality check predicate on the "distance" field. It's identical to DistanceEQ.
+func Distance(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldDistance, v))
+}
+
+// DriveSeconds applies equality check predicate on the "drive_seconds" field. It's identical to DriveSecondsEQ.
+func DriveSeconds(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldDriveSeconds, v))
+}
+
+// EnergyUsed applies equality check predicate on the "energy_used" field. It's identical to EnergyUsedEQ.
+func EnergyUsed(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldEnergyUsed, v))
+}
+
+// Efficiency applies equality check predicate on the "efficiency" field. It's identical to EfficiencyEQ.
+func Efficiency(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldEfficiency, v))
+}
+
+// MaxSpeed applies equality check predicate on the "max_speed" field. It's identical to MaxSpeedEQ.
+func MaxSpeed(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldMaxSpeed, v))
+}
+
+// Charges applies equality check predicate on the "charges" field. It's identical to ChargesEQ.
+func Charges(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldCharges, v))
+}
+
+// EnergyAdded applies equality check predicate on the "energy_added" field. It's identical to EnergyAddedEQ.
+func EnergyAdded(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldEnergyAdded, v))
+}
+
+// ChargeCost applies equality check predicate on the "charge_cost" field. It's identical to ChargeCostEQ.
+func ChargeCost(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldChargeCost, v))
+}
+
+// ParkedSeconds applies equality check predicate on the "parked_seconds" field. It's identical to ParkedSecondsEQ.
+func ParkedSeconds(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldParkedSeconds, v))
+}
+
+// AsleepSeconds applies equality check predicate on the "asleep_seconds" field. It's identical to AsleepSecondsEQ.
+func AsleepSeconds(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldAsleepSeconds, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// VehicleIDEQ applies the EQ predicate on the "vehicle_id" field.
+func VehicleIDEQ(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// VehicleIDNEQ applies the NEQ predicate on the "vehicle_id" field.
+func VehicleIDNEQ(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldVehicleID, v))
+}
+
+// VehicleIDIn applies the In predicate on the "vehicle_id" field.
+func VehicleIDIn(vs ...int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldVehicleID, vs...))
+}
+
+// VehicleIDNotIn applies the NotIn predicate on the "vehicle_id" field.
+func VehicleIDNotIn(vs ...int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldVehicleID, vs...))
+}
+
+// VehicleIDGT applies the GT predicate on the "vehicle_id" field.
+func VehicleIDGT(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldVehicleID, v))
+}
+
+// VehicleIDGTE applies the GTE predicate on the "vehicle_id" field.
+func VehicleIDGTE(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldVehicleID, v))
+}
+
+// VehicleIDLT applies the LT predicate on the "vehicle_id" field.
+func VehicleIDLT(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldVehicleID, v))
+}
+
+// VehicleIDLTE applies the LTE predicate on the "vehicle_id" field.
+func VehicleIDLTE(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldVehicleID, v))
+}
+
+// GranularityEQ applies the EQ predicate on the "granularity" field.
+func GranularityEQ(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldGranularity, v))
+}
+
+// GranularityNEQ applies the NEQ predicate on the "granularity" field.
+func GranularityNEQ(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldGranularity, v))
+}
+
+// GranularityIn applies the In predicate on the "granularity" field.
+func GranularityIn(vs ...string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldGranularity, vs...))
+}
+
+// GranularityNotIn applies the NotIn predicate on the "granularity" field.
+func GranularityNotIn(vs ...string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldGranularity, vs...))
+}
+
+// GranularityGT applies the GT predicate on the "granularity" field.
+func GranularityGT(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldGranularity, v))
+}
+
+// GranularityGTE applies the GTE predicate on the "granularity" field.
+func GranularityGTE(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldGranularity, v))
+}
+
+// GranularityLT applies the LT predicate on the "granularity" field.
+func GranularityLT(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldGranularity, v))
+}
+
+// GranularityLTE applies the LTE predicate on the "granularity" field.
+func GranularityLTE(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldGranularity, v))
+}
+
+// GranularityContains applies the Contains predicate on the "granularity" field.
+func GranularityContains(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldContains(FieldGranularity, v))
+}
+
+// GranularityHasPrefix applies the HasPrefix predicate on the "granularity" field.
+func GranularityHasPrefix(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldHasPrefix(FieldGranularity, v))
+}
+
+// GranularityHasSuffix applies the HasSuffix predicate on the "granularity" field.
+func GranularityHasSuffix(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldHasSuffix(FieldGranularity, v))
+}
+
+// GranularityEqualFold applies the EqualFold predicate on the "granularity" field.
+func GranularityEqualFold(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEqualFold(FieldGranularity, v))
+}
+
+// GranularityContainsFold applies the ContainsFold predicate on the "granularity" field.
+func GranularityContainsFold(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldContainsFold(FieldGranularity, v))
+}
+
+// TimeZoneEQ applies the EQ predicate on the "time_zone" field.
+func TimeZoneEQ(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldTimeZone, v))
+}
+
+// TimeZoneNEQ applies the NEQ predicate on the "time_zone" field.
+func TimeZoneNEQ(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldTimeZone, v))
+}
+
+// TimeZoneIn applies the In predicate on the "time_zone" field.
+func TimeZoneIn(vs ...string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldTimeZone, vs...))
+}
+
+// TimeZoneNotIn applies the NotIn predicate on the "time_zone" field.
+func TimeZoneNotIn(vs ...string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldTimeZone, vs...))
+}
+
+// TimeZoneGT applies the GT predicate on the "time_zone" field.
+func TimeZoneGT(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldTimeZone, v))
+}
+
+// TimeZoneGTE applies the GTE predicate on the "time_zone" field.
+func TimeZoneGTE(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldTimeZone, v))
+}
+
+// TimeZoneLT applies the LT predicate on the "time_zone" field.
+func TimeZoneLT(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldTimeZone, v))
+}
+
+// TimeZoneLTE applies the LTE predicate on the "time_zone" field.
+func TimeZoneLTE(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldTimeZone, v))
+}
+
+// TimeZoneContains applies the Contains predicate on the "time_zone" field.
+func TimeZoneContains(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldContains(FieldTimeZone, v))
+}
+
+// TimeZoneHasPrefix applies the HasPrefix predicate on the "time_zone" field.
+func TimeZoneHasPrefix(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldHasPrefix(FieldTimeZone, v))
+}
+
+// TimeZoneHasSuffix applies the HasSuffix predicate on the "time_zone" field.
+func TimeZoneHasSuffix(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldHasSuffix(FieldTimeZone, v))
+}
+
+// TimeZoneEqualFold applies the EqualFold predicate on the "time_zone" field.
+func TimeZoneEqualFold(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEqualFold(FieldTimeZone, v))
+}
+
+// TimeZoneContainsFold applies the ContainsFold predicate on the "time_zone" field.
+func TimeZoneContainsFold(v string) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldContainsFold(FieldTimeZone, v))
+}
+
+// StartAtEQ applies the EQ predicate on the "start_at" field.
+func StartAtEQ(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldStartAt, v))
+}
+
+// StartAtNEQ applies the NEQ predicate on the "start_at" field.
+func StartAtNEQ(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldStartAt, v))
+}
+
+// StartAtIn applies the In predicate on the "start_at" field.
+func StartAtIn(vs ...time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldStartAt, vs...))
+}
+
+// StartAtNotIn applies the NotIn predicate on the "start_at" field.
+func StartAtNotIn(vs ...time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldStartAt, vs...))
+}
+
+// StartAtGT applies the GT predicate on the "start_at" field.
+func StartAtGT(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldStartAt, v))
+}
+
+// StartAtGTE applies the GTE predicate on the "start_at" field.
+func StartAtGTE(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldStartAt, v))
+}
+
+// StartAtLT applies the LT predicate on the "start_at" field.
+func StartAtLT(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldStartAt, v))
+}
+
+// StartAtLTE applies the LTE predicate on the "start_at" field.
+func StartAtLTE(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldStartAt, v))
+}
+
+// EndAtEQ applies the EQ predicate on the "end_at" field.
+func EndAtEQ(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldEndAt, v))
+}
+
+// EndAtNEQ applies the NEQ predicate on the "end_at" field.
+func EndAtNEQ(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldEndAt, v))
+}
+
+// EndAtIn applies the In predicate on the "end_at" field.
+func EndAtIn(vs ...time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldEndAt, vs...))
+}
+
+// EndAtNotIn applies the NotIn predicate on the "end_at" field.
+func EndAtNotIn(vs ...time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldEndAt, vs...))
+}
+
+// EndAtGT applies the GT predicate on the "end_at" field.
+func EndAtGT(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldEndAt, v))
+}
+
+// EndAtGTE applies the GTE predicate on the "end_at" field.
+func EndAtGTE(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldEndAt, v))
+}
+
+// EndAtLT applies the LT predicate on the "end_at" field.
+func EndAtLT(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldEndAt, v))
+}
+
+// EndAtLTE applies the LTE predicate on the "end_at" field.
+func EndAtLTE(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldEndAt, v))
+}
+
+// DrivesEQ applies the EQ predicate on the "drives" field.
+func DrivesEQ(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldDrives, v))
+}
+
+// DrivesNEQ applies the NEQ predicate on the "drives" field.
+func DrivesNEQ(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldDrives, v))
+}
+
+// DrivesIn applies the In predicate on the "drives" field.
+func DrivesIn(vs ...int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldDrives, vs...))
+}
+
+// DrivesNotIn applies the NotIn predicate on the "drives" field.
+func DrivesNotIn(vs ...int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldDrives, vs...))
+}
+
+// DrivesGT applies the GT predicate on the "drives" field.
+func DrivesGT(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldDrives, v))
+}
+
+// DrivesGTE applies the GTE predicate on the "drives" field.
+func DrivesGTE(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldDrives, v))
+}
+
+// DrivesLT applies the LT predicate on the "drives" field.
+func DrivesLT(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldDrives, v))
+}
+
+// DrivesLTE applies the LTE predicate on the "drives" field.
+func DrivesLTE(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldDrives, v))
+}
+
+// DistanceEQ applies the EQ predicate on the "distance" field.
+func DistanceEQ(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldDistance, v))
+}
+
+// DistanceNEQ applies the NEQ predicate on the "distance" field.
+func DistanceNEQ(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldDistance, v))
+}
+
+// DistanceIn applies the In predicate on the "distance" field.
+func DistanceIn(vs ...float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldDistance, vs...))
+}
+
+// DistanceNotIn applies the NotIn predicate on the "distance" field.
+func DistanceNotIn(vs ...float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldDistance, vs...))
+}
+
+// DistanceGT applies the GT predicate on the "distance" field.
+func DistanceGT(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldDistance, v))
+}
+
+// DistanceGTE applies the GTE predicate on the "distance" field.
+func DistanceGTE(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldDistance, v))
+}
+
+// DistanceLT applies the LT predicate on the "distance" field.
+func DistanceLT(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldDistance, v))
+}
+
+// DistanceLTE applies the LTE predicate on the "distance" field.
+func DistanceLTE(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldDistance, v))
+}
+
+// DriveSecondsEQ applies the EQ predicate on the "drive_seconds" field.
+func DriveSecondsEQ(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldDriveSeconds, v))
+}
+
+// DriveSecondsNEQ applies the NEQ predicate on the "drive_seconds" field.
+func DriveSecondsNEQ(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldDriveSeconds, v))
+}
+
+// DriveSecondsIn applies the In predicate on the "drive_seconds" field.
+func DriveSecondsIn(vs ...int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldDriveSeconds, vs...))
+}
+
+// DriveSecondsNotIn applies the NotIn predicate on the "drive_seconds" field.
+func DriveSecondsNotIn(vs ...int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldDriveSeconds, vs...))
+}
+
+// DriveSecondsGT applies the GT predicate on the "drive_seconds" field.
+func DriveSecondsGT(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldDriveSeconds, v))
+}
+
+// DriveSecondsGTE applies the GTE predicate on the "drive_seconds" field.
+func DriveSecondsGTE(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldDriveSeconds, v))
+}
+
+// DriveSecondsLT applies the LT predicate on the "drive_seconds" field.
+func DriveSecondsLT(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldDriveSeconds, v))
+}
+
+// DriveSecondsLTE applies the LTE predicate on the "drive_seconds" field.
+func DriveSecondsLTE(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldDriveSeconds, v))
+}
+
+// EnergyUsedEQ applies the EQ predicate on the "energy_used" field.
+func EnergyUsedEQ(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldEnergyUsed, v))
+}
+
+// EnergyUsedNEQ applies the NEQ predicate on the "energy_used" field.
+func EnergyUsedNEQ(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldEnergyUsed, v))
+}
+
+// EnergyUsedIn applies the In predicate on the "energy_used" field.
+func EnergyUsedIn(vs ...float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldEnergyUsed, vs...))
+}
+
+// EnergyUsedNotIn applies the NotIn predicate on the "energy_used" field.
+func EnergyUsedNotIn(vs ...float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldEnergyUsed, vs...))
+}
+
+// EnergyUsedGT applies the GT predicate on the "energy_used" field.
+func EnergyUsedGT(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldEnergyUsed, v))
+}
+
+// EnergyUsedGTE applies the GTE predicate on the "energy_used" field.
+func EnergyUsedGTE(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldEnergyUsed, v))
+}
+
+// EnergyUsedLT applies the LT predicate on the "energy_used" field.
+func EnergyUsedLT(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldEnergyUsed, v))
+}
+
+// EnergyUsedLTE applies the LTE predicate on the "energy_used" field.
+func EnergyUsedLTE(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldEnergyUsed, v))
+}
+
+// EfficiencyEQ applies the EQ predicate on the "efficiency" field.
+func EfficiencyEQ(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldEfficiency, v))
+}
+
+// EfficiencyNEQ applies the NEQ predicate on the "efficiency" field.
+func EfficiencyNEQ(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldEfficiency, v))
+}
+
+// EfficiencyIn applies the In predicate on the "efficiency" field.
+func EfficiencyIn(vs ...float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldEfficiency, vs...))
+}
+
+// EfficiencyNotIn applies the NotIn predicate on the "efficiency" field.
+func EfficiencyNotIn(vs ...float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldEfficiency, vs...))
+}
+
+// EfficiencyGT applies the GT predicate on the "efficiency" field.
+func EfficiencyGT(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldEfficiency, v))
+}
+
+// EfficiencyGTE applies the GTE predicate on the "efficiency" field.
+func EfficiencyGTE(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldEfficiency, v))
+}
+
+// EfficiencyLT applies the LT predicate on the "efficiency" field.
+func EfficiencyLT(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldEfficiency, v))
+}
+
+// EfficiencyLTE applies the LTE predicate on the "efficiency" field.
+func EfficiencyLTE(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldEfficiency, v))
+}
+
+// MaxSpeedEQ applies the EQ predicate on the "max_speed" field.
+func MaxSpeedEQ(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldMaxSpeed, v))
+}
+
+// MaxSpeedNEQ applies the NEQ predicate on the "max_speed" field.
+func MaxSpeedNEQ(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldMaxSpeed, v))
+}
+
+// MaxSpeedIn applies the In predicate on the "max_speed" field.
+func MaxSpeedIn(vs ...float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldMaxSpeed, vs...))
+}
+
+// MaxSpeedNotIn applies the NotIn predicate on the "max_speed" field.
+func MaxSpeedNotIn(vs ...float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldMaxSpeed, vs...))
+}
+
+// MaxSpeedGT applies the GT predicate on the "max_speed" field.
+func MaxSpeedGT(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldMaxSpeed, v))
+}
+
+// MaxSpeedGTE applies the GTE predicate on the "max_speed" field.
+func MaxSpeedGTE(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldMaxSpeed, v))
+}
+
+// MaxSpeedLT applies the LT predicate on the "max_speed" field.
+func MaxSpeedLT(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldMaxSpeed, v))
+}
+
+// MaxSpeedLTE applies the LTE predicate on the "max_speed" field.
+func MaxSpeedLTE(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldMaxSpeed, v))
+}
+
+// ChargesEQ applies the EQ predicate on the "charges" field.
+func ChargesEQ(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldCharges, v))
+}
+
+// ChargesNEQ applies the NEQ predicate on the "charges" field.
+func ChargesNEQ(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldCharges, v))
+}
+
+// ChargesIn applies the In predicate on the "charges" field.
+func ChargesIn(vs ...int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldCharges, vs...))
+}
+
+// ChargesNotIn applies the NotIn predicate on the "charges" field.
+func ChargesNotIn(vs ...int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldCharges, vs...))
+}
+
+// ChargesGT applies the GT predicate on the "charges" field.
+func ChargesGT(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldCharges, v))
+}
+
+// ChargesGTE applies the GTE predicate on the "charges" field.
+func ChargesGTE(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldCharges, v))
+}
+
+// ChargesLT applies the LT predicate on the "charges" field.
+func ChargesLT(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldCharges, v))
+}
+
+// ChargesLTE applies the LTE predicate on the "charges" field.
+func ChargesLTE(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldCharges, v))
+}
+
+// EnergyAddedEQ applies the EQ predicate on the "energy_added" field.
+func EnergyAddedEQ(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldEnergyAdded, v))
+}
+
+// EnergyAddedNEQ applies the NEQ predicate on the "energy_added" field.
+func EnergyAddedNEQ(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldEnergyAdded, v))
+}
+
+// EnergyAddedIn applies the In predicate on the "energy_added" field.
+func EnergyAddedIn(vs ...float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldEnergyAdded, vs...))
+}
+
+// EnergyAddedNotIn applies the NotIn predicate on the "energy_added" field.
+func EnergyAddedNotIn(vs ...float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldEnergyAdded, vs...))
+}
+
+// EnergyAddedGT applies the GT predicate on the "energy_added" field.
+func EnergyAddedGT(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldEnergyAdded, v))
+}
+
+// EnergyAddedGTE applies the GTE predicate on the "energy_added" field.
+func EnergyAddedGTE(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldEnergyAdded, v))
+}
+
+// EnergyAddedLT applies the LT predicate on the "energy_added" field.
+func EnergyAddedLT(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldEnergyAdded, v))
+}
+
+// EnergyAddedLTE applies the LTE predicate on the "energy_added" field.
+func EnergyAddedLTE(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldEnergyAdded, v))
+}
+
+// ChargeCostEQ applies the EQ predicate on the "charge_cost" field.
+func ChargeCostEQ(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldChargeCost, v))
+}
+
+// ChargeCostNEQ applies the NEQ predicate on the "charge_cost" field.
+func ChargeCostNEQ(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldChargeCost, v))
+}
+
+// ChargeCostIn applies the In predicate on the "charge_cost" field.
+func ChargeCostIn(vs ...float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldChargeCost, vs...))
+}
+
+// ChargeCostNotIn applies the NotIn predicate on the "charge_cost" field.
+func ChargeCostNotIn(vs ...float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldChargeCost, vs...))
+}
+
+// ChargeCostGT applies the GT predicate on the "charge_cost" field.
+func ChargeCostGT(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldChargeCost, v))
+}
+
+// ChargeCostGTE applies the GTE predicate on the "charge_cost" field.
+func ChargeCostGTE(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldChargeCost, v))
+}
+
+// ChargeCostLT applies the LT predicate on the "charge_cost" field.
+func ChargeCostLT(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldChargeCost, v))
+}
+
+// ChargeCostLTE applies the LTE predicate on the "charge_cost" field.
+func ChargeCostLTE(v float64) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldChargeCost, v))
+}
+
+// ParkedSecondsEQ applies the EQ predicate on the "parked_seconds" field.
+func ParkedSecondsEQ(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldParkedSeconds, v))
+}
+
+// ParkedSecondsNEQ applies the NEQ predicate on the "parked_seconds" field.
+func ParkedSecondsNEQ(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldParkedSeconds, v))
+}
+
+// ParkedSecondsIn applies the In predicate on the "parked_seconds" field.
+func ParkedSecondsIn(vs ...int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldParkedSeconds, vs...))
+}
+
+// ParkedSecondsNotIn applies the NotIn predicate on the "parked_seconds" field.
+func ParkedSecondsNotIn(vs ...int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldParkedSeconds, vs...))
+}
+
+// ParkedSecondsGT applies the GT predicate on the "parked_seconds" field.
+func ParkedSecondsGT(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldParkedSeconds, v))
+}
+
+// ParkedSecondsGTE applies the GTE predicate on the "parked_seconds" field.
+func ParkedSecondsGTE(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldParkedSeconds, v))
+}
+
+// ParkedSecondsLT applies the LT predicate on the "parked_seconds" field.
+func ParkedSecondsLT(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldParkedSeconds, v))
+}
+
+// ParkedSecondsLTE applies the LTE predicate on the "parked_seconds" field.
+func ParkedSecondsLTE(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldParkedSeconds, v))
+}
+
+// AsleepSecondsEQ applies the EQ predicate on the "asleep_seconds" field.
+func AsleepSecondsEQ(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldAsleepSeconds, v))
+}
+
+// AsleepSecondsNEQ applies the NEQ predicate on the "asleep_seconds" field.
+func AsleepSecondsNEQ(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldAsleepSeconds, v))
+}
+
+// AsleepSecondsIn applies the In predicate on the "asleep_seconds" field.
+func AsleepSecondsIn(vs ...int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldAsleepSeconds, vs...))
+}
+
+// AsleepSecondsNotIn applies the NotIn predicate on the "asleep_seconds" field.
+func AsleepSecondsNotIn(vs ...int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldAsleepSeconds, vs...))
+}
+
+// AsleepSecondsGT applies the GT predicate on the "asleep_seconds" field.
+func AsleepSecondsGT(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldAsleepSeconds, v))
+}
+
+// AsleepSecondsGTE applies the GTE predicate on the "asleep_seconds" field.
+func AsleepSecondsGTE(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldAsleepSeconds, v))
+}
+
+// AsleepSecondsLT applies the LT predicate on the "asleep_seconds" field.
+func AsleepSecondsLT(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldAsleepSeconds, v))
+}
+
+// AsleepSecondsLTE applies the LTE predicate on the "asleep_seconds" field.
+func AsleepSecondsLTE(v int) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldAsleepSeconds, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VehicleRollup) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VehicleRollup) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VehicleRollup) predicate.VehicleRollup {
+	return predicate.VehicleRollup(sql.NotPredicates(p))
+}
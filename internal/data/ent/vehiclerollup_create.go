@@ -0,0 +1,542 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/vehiclerollup"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VehicleRollupCreate is the builder for creating a VehicleRollup entity.
+type VehicleRollupCreate struct {
+	config
+	mutation *VehicleRollupMutation
+	hooks    []Hook
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_c *VehicleRollupCreate) SetVehicleID(v int) *VehicleRollupCreate {
+	_c.mutation.SetVehicleID(v)
+	return _c
+}
+
+// SetGranularity sets the "granularity" field.
+func (_c *VehicleRollupCreate) SetGranularity(v string) *VehicleRollupCreate {
+	_c.mutation.SetGranularity(v)
+	return _c
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_c *VehicleRollupCreate) SetTimeZone(v string) *VehicleRollupCreate {
+	_c.mutation.SetTimeZone(v)
+	return _c
+}
+
+// SetStartAt sets the "start_at" field.
+func (_c *VehicleRollupCreate) SetStartAt(v time.Time) *VehicleRollupCreate {
+	_c.mutation.SetStartAt(v)
+	return _c
+}
+
+// SetEndAt sets the "end_at" field.
+func (_c *VehicleRollupCreate) SetEndAt(v time.Time) *VehicleRollupCreate {
+	_c.mutation.SetEndAt(v)
+	return _c
+}
+
+// SetDrives sets the "drives" field.
+func (_c *VehicleRollupCreate) SetDrives(v int) *VehicleRollupCreate {
+	_c.mutation.SetDrives(v)
+	return _c
+}
+
+// SetNillableDrives sets the "drives" field if the given value is not nil.
+func (_c *VehicleRollupCreate) SetNillableDrives(v *int) *VehicleRollupCreate {
+	if v != nil {
+		_c.SetDrives(*v)
+	}
+	return _c
+}
+
+// SetDistance sets the "distance" field.
+func (_c *VehicleRollupCreate) SetDistance(v float64) *VehicleRollupCreate {
+	_c.mutation.SetDistance(v)
+	return _c
+}
+
+// SetNillableDistance sets the "distance" field if the given value is not nil.
+func (_c *VehicleRollupCreate) SetNillableDistance(v *float64) *VehicleRollupCreate {
+	if v != nil {
+		_c.SetDistance(*v)
+	}
+	return _c
+}
+
+// SetDriveSeconds sets the "drive_seconds" field.
+func (_c *VehicleRollupCreate) SetDriveSeconds(v int) *VehicleRollupCreate {
+	_c.mutation.SetDriveSeconds(v)
+	return _c
+}
+
+// SetNillableDriveSeconds sets the "drive_seconds" field if the given value is not nil.
+func (_c *VehicleRollupCreate) SetNillableDriveSeconds(v *int) *VehicleRollupCreate {
+	if v != nil {
+		_c.SetDriveSeconds(*v)
+	}
+	return _c
+}
+
+// SetEnergyUsed sets the "energy_used" field.
+func (_c *VehicleRollupCreate) SetEnergyUsed(v float64) *VehicleRollupCreate {
+	_c.mutation.SetEnergyUsed(v)
+	return _c
+}
+
+// SetNillableEnergyUsed sets the "energy_used" field if the given value is not nil.
+func (_c *VehicleRollupCreate) SetNillableEnergyUsed(v *float64) *VehicleRollupCreate {
+	if v != nil {
+		_c.SetEnergyUsed(*v)
+	}
+	return _c
+}
+
+// SetEfficiency sets the "efficiency" field.
+func (_c *VehicleRollupCreate) SetEfficiency(v float64) *VehicleRollupCreate {
+	_c.mutation.SetEfficiency(v)
+	return _c
+}
+
+// SetNillableEfficiency sets the "efficiency" field if the given value is not nil.
+func (_c *VehicleRollupCreate) SetNillableEfficiency(v *float64) *VehicleRollupCreate {
+	if v != nil {
+		_c.SetEfficiency(*v)
+	}
+	return _c
+}
+
+// SetMaxSpeed sets the "max_speed" field.
+func (_c *VehicleRollupCreate) SetMaxSpeed(v float64) *VehicleRollupCreate {
+	_c.mutation.SetMaxSpeed(v)
+	return _c
+}
+
+// SetNillableMaxSpeed sets the "max_speed" field if the given value is not nil.
+func (_c *VehicleRollupCreate) SetNillableMaxSpeed(v *float64) *VehicleRollupCreate {
+	if v != nil {
+		_c.SetMaxSpeed(*v)
+	}
+	return _c
+}
+
+// SetCharges sets the "charges" field.
+func (_c *VehicleRollupCreate) SetCharges(v int) *VehicleRollupCreate {
+	_c.mutation.SetCharges(v)
+	return _c
+}
+
+// SetNillableCharges sets the "charges" field if the given value is not nil.
+func (_c *VehicleRollupCreate) SetNillableCharges(v *int) *VehicleRollupCreate {
+	if v != nil {
+		_c.SetCharges(*v)
+	}
+	return _c
+}
+
+// SetEnergyAdded sets the "energy_added" field.
+func (_c *VehicleRollupCreate) SetEnergyAdded(v float64) *VehicleRollupCreate {
+	_c.mutation.SetEnergyAdded(v)
+	return _c
+}
+
+// SetNillableEnergyAdded sets the "energy_added" field if the given value is not nil.
+func (_c *VehicleRollupCreate) SetNillableEnergyAdded(v *float64) *VehicleRollupCreate {
+	if v != nil {
+		_c.SetEnergyAdded(*v)
+	}
+	return _c
+}
+
+// SetChargeCost sets the "charge_cost" field.
+func (_c *VehicleRollupCreate) SetChargeCost(v float64) *VehicleRollupCreate {
+	_c.mutation.SetChargeCost(v)
+	return _c
+}
+
+// SetNillableChargeCost sets the "charge_cost" field if the given value is not nil.
+func (_c *VehicleRollupCreate) SetNillableChargeCost(v *float64) *VehicleRollupCreate {
+	if v != nil {
+		_c.SetChargeCost(*v)
+	}
+	return _c
+}
+
+// SetParkedSeconds sets the "parked_seconds" field.
+func (_c *VehicleRollupCreate) SetParkedSeconds(v int) *VehicleRollupCreate {
+	_c.mutation.SetParkedSeconds(v)
+	return _c
+}
+
+// SetNillableParkedSeconds sets the "parked_seconds" field if the given value is not nil.
+func (_c *VehicleRollupCreate) SetNillableParkedSeconds(v *int) *VehicleRollupCreate {
+	if v != nil {
+		_c.SetParkedSeconds(*v)
+	}
+	return _c
+}
+
+// SetAsleepSeconds sets the "asleep_seconds" field.
+func (_c *VehicleRollupCreate) SetAsleepSeconds(v int) *VehicleRollupCreate {
+	_c.mutation.SetAsleepSeconds(v)
+	return _c
+}
+
+// SetNillableAsleepSeconds sets the "asleep_seconds" field if the given value is not nil.
+func (_c *VehicleRollupCreate) SetNillableAsleepSeconds(v *int) *VehicleRollupCreate {
+	if v != nil {
+		_c.SetAsleepSeconds(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *VehicleRollupCreate) SetUpdatedAt(v time.Time) *VehicleRollupCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *VehicleRollupCreate) SetNillableUpdatedAt(v *time.Time) *VehicleRollupCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the VehicleRollupMutation object of the builder.
+func (_c *VehicleRollupCreate) Mutation() *VehicleRollupMutation {
+	return _c.mutation
+}
+
+// Save creates the VehicleRollup in the database.
+func (_c *VehicleRollupCreate) Save(ctx context.Context) (*VehicleRollup, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VehicleRollupCreate) SaveX(ctx context.Context) *VehicleRollup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VehicleRollupCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VehicleRollupCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VehicleRollupCreate) defaults() {
+	if _, ok := _c.mutation.Drives(); !ok {
+		v := vehiclerollup.DefaultDrives
+		_c.mutation.SetDrives(v)
+	}
+	if _, ok := _c.mutation.Distance(); !ok {
+		v := vehiclerollup.DefaultDistance
+		_c.mutation.SetDistance(v)
+	}
+	if _, ok := _c.mutation.DriveSeconds(); !ok {
+		v := vehiclerollup.DefaultDriveSeconds
+		_c.mutation.SetDriveSeconds(v)
+	}
+	if _, ok := _c.mutation.EnergyUsed(); !ok {
+		v := vehiclerollup.DefaultEnergyUsed
+		_c.mutation.SetEnergyUsed(v)
+	}
+	if _, ok := _c.mutation.Efficiency(); !ok {
+		v := vehiclerollup.DefaultEfficiency
+		_c.mutation.SetEfficiency(v)
+	}
+	if _, ok := _c.mutation.MaxSpeed(); !ok {
+		v := vehiclerollup.DefaultMaxSpeed
+		_c.mutation.SetMaxSpeed(v)
+	}
+	if _, ok := _c.mutation.Charges(); !ok {
+		v := vehiclerollup.DefaultCharges
+		_c.mutation.SetCharges(v)
+	}
+	if _, ok := _c.mutation.EnergyAdded(); !ok {
+		v := vehiclerollup.DefaultEnergyAdded
+		_c.mutation.SetEnergyAdded(v)
+	}
+	if _, ok := _c.mutation.ChargeCost(); !ok {
+		v := vehiclerollup.DefaultChargeCost
+		_c.mutation.SetChargeCost(v)
+	}
+	if _, ok := _c.mutation.ParkedSeconds(); !ok {
+		v := vehiclerollup.DefaultParkedSeconds
+		_c.mutation.SetParkedSeconds(v)
+	}
+	if _, ok := _c.mutation.AsleepSeconds(); !ok {
+		v := vehiclerollup.DefaultAsleepSeconds
+		_c.mutation.SetAsleepSeconds(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := vehiclerollup.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VehicleRollupCreate) check() error {
+	if _, ok := _c.mutation.VehicleID(); !ok {
+		return &ValidationError{Name: "vehicle_id", err: errors.New(`ent: missing required field "VehicleRollup.vehicle_id"`)}
+	}
+	if _, ok := _c.mutation.Granularity(); !ok {
+		return &ValidationError{Name: "granularity", err: errors.New(`ent: missing required field "VehicleRollup.granularity"`)}
+	}
+	if _, ok := _c.mutation.TimeZone(); !ok {
+		return &ValidationError{Name: "time_zone", err: errors.New(`ent: missing required field "VehicleRollup.time_zone"`)}
+	}
+	if _, ok := _c.mutation.StartAt(); !ok {
+		return &ValidationError{Name: "start_at", err: errors.New(`ent: missing required field "VehicleRollup.start_at"`)}
+	}
+	if _, ok := _c.mutation.EndAt(); !ok {
+		return &ValidationError{Name: "end_at", err: errors.New(`ent: missing required field "VehicleRollup.end_at"`)}
+	}
+	if _, ok := _c.mutation.Drives(); !ok {
+		return &ValidationError{Name: "drives", err: errors.New(`ent: missing required field "VehicleRollup.drives"`)}
+	}
+	if _, ok := _c.mutation.Distance(); !ok {
+		return &ValidationError{Name: "distance", err: errors.New(`ent: missing required field "VehicleRollup.distance"`)}
+	}
+	if _, ok := _c.mutation.DriveSeconds(); !ok {
+		return &ValidationError{Name: "drive_seconds", err: errors.New(`ent: missing required field "VehicleRollup.drive_seconds"`)}
+	}
+	if _, ok := _c.mutation.EnergyUsed(); !ok {
+		return &ValidationError{Name: "energy_used", err: errors.New(`ent: missing required field "VehicleRollup.energy_used"`)}
+	}
+	if _, ok := _c.mutation.Efficiency(); !ok {
+		return &ValidationError{Name: "efficiency", err: errors.New(`ent: missing required field "VehicleRollup.efficiency"`)}
+	}
+	if _, ok := _c.mutation.MaxSpeed(); !ok {
+		return &ValidationError{Name: "max_speed", err: errors.New(`ent: missing required field "VehicleRollup.max_speed"`)}
+	}
+	if _, ok := _c.mutation.Charges(); !ok {
+		return &ValidationError{Name: "charges", err: errors.New(`ent: missing required field "VehicleRollup.charges"`)}
+	}
+	if _, ok := _c.mutation.EnergyAdded(); !ok {
+		return &ValidationError{Name: "energy_added", err: errors.New(`ent: missing required field "VehicleRollup.energy_added"`)}
+	}
+	if _, ok := _c.mutation.ChargeCost(); !ok {
+		return &ValidationError{Name: "charge_cost", err: errors.New(`ent: missing required field "VehicleRollup.charge_cost"`)}
+	}
+	if _, ok := _c.mutation.ParkedSeconds(); !ok {
+		return &ValidationError{Name: "parked_seconds", err: errors.New(`ent: missing required field "VehicleRollup.parked_seconds"`)}
+	}
+	if _, ok := _c.mutation.AsleepSeconds(); !ok {
+		return &ValidationError{Name: "asleep_seconds", err: errors.New(`ent: missing required field "VehicleRollup.asleep_seconds"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "VehicleRollup.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *VehicleRollupCreate) sqlSave(ctx context.Context) (*VehicleRollup, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VehicleRollupCreate) createSpec() (*VehicleRollup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VehicleRollup{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(vehiclerollup.Table, sqlgraph.NewFieldSpec(vehiclerollup.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.VehicleID(); ok {
+		_spec.SetField(vehiclerollup.FieldVehicleID, field.TypeInt, value)
+		_node.VehicleID = value
+	}
+	if value, ok := _c.mutation.Granularity(); ok {
+		_spec.SetField(vehiclerollup.FieldGranularity, field.TypeString, value)
+		_node.Granularity = value
+	}
+	if value, ok := _c.mutation.TimeZone(); ok {
+		_spec.SetField(vehiclerollup.FieldTimeZone, field.TypeString, value)
+		_node.TimeZone = value
+	}
+	if value, ok := _c.mutation.StartAt(); ok {
+		_spec.SetField(vehiclerollup.FieldStartAt, field.TypeTime, value)
+		_node.StartAt = value
+	}
+	if value, ok := _c.mutation.EndAt(); ok {
+		_spec.SetField(vehiclerollup.FieldEndAt, field.TypeTime, value)
+		_node.EndAt = value
+	}
+	if value, ok := _c.mutation.Drives(); ok {
+		_spec.SetField(vehiclerollup.FieldDrives, field.TypeInt, value)
+		_node.Drives = value
+	}
+	if value, ok := _c.mutation.Distance(); ok {
+		_spec.SetField(vehiclerollup.FieldDistance, field.TypeFloat64, value)
+		_node.Distance = value
+	}
+	if value, ok := _c.mutation.DriveSeconds(); ok {
+		_spec.SetField(vehiclerollup.FieldDriveSeconds, field.TypeInt, value)
+		_node.DriveSeconds = value
+	}
+	if value, ok := _c.mutation.EnergyUsed(); ok {
+		_spec.SetField(vehiclerollup.FieldEnergyUsed, field.TypeFloat64, value)
+		_node.EnergyUsed = value
+	}
+	if value, ok := _c.mutation.Efficiency(); ok {
+		_spec.SetField(vehiclerollup.FieldEfficiency, field.TypeFloat64, value)
+		_node.Efficiency = value
+	}
+	if value, ok := _c.mutation.MaxSpeed(); ok {
+		_spec.SetField(vehiclerollup.FieldMaxSpeed, field.TypeFloat64, value)
+		_node.MaxSpeed = value
+	}
+	if value, ok := _c.mutation.Charges(); ok {
+		_spec.SetField(vehiclerollup.FieldCharges, field.TypeInt, value)
+		_node.Charges = value
+	}
+	if value, ok := _c.mutation.EnergyAdded(); ok {
+		_spec.SetField(vehiclerollup.FieldEnergyAdded, field.TypeFloat64, value)
+		_node.EnergyAdded = value
+	}
+	if value, ok := _c.mutation.ChargeCost(); ok {
+		_spec.SetField(vehiclerollup.FieldChargeCost, field.TypeFloat64, value)
+		_node.ChargeCost = value
+	}
+	if value, ok := _c.mutation.ParkedSeconds(); ok {
+		_spec.SetField(vehiclerollup.FieldParkedSeconds, field.TypeInt, value)
+		_node.ParkedSeconds = value
+	}
+	if value, ok := _c.mutation.AsleepSeconds(); ok {
+		_spec.SetField(vehiclerollup.FieldAsleepSeconds, field.TypeInt, value)
+		_node.AsleepSeconds = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(vehiclerollup.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// VehicleRollupCreateBulk is the builder for creating many VehicleRollup entities in bulk.
+type VehicleRollupCreateBulk struct {
+	config
+	err      error
+	builders []*VehicleRollupCreate
+}
+
+// Save creates the VehicleRollup entities in the database.
+func (_c *VehicleRollupCreateBulk) Save(ctx context.Context) ([]*VehicleRollup, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*VehicleRollup, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VehicleRollupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VehicleRollupCreateBulk) SaveX(ctx context.Context) []*VehicleRollup {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VehicleRollupCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VehicleRollupCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,996 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/predicate"
+	"teslatrack/internal/data/ent/vehiclerollup"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VehicleRollupUpdate is the builder for updating VehicleRollup entities.
+type VehicleRollupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VehicleRollupMutation
+}
+
+// Where appends a list predicates to the VehicleRollupUpdate builder.
+func (_u *VehicleRollupUpdate) Where(ps ...predicate.VehicleRollup) *VehicleRollupUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_u *VehicleRollupUpdate) SetVehicleID(v int) *VehicleRollupUpdate {
+	_u.mutation.ResetVehicleID()
+	_u.mutation.SetVehicleID(v)
+	return _u
+}
+
+// SetNillableVehicleID sets the "vehicle_id" field if the given value is not nil.
+func (_u *VehicleRollupUpdate) SetNillableVehicleID(v *int) *VehicleRollupUpdate {
+	if v != nil {
+		_u.SetVehicleID(*v)
+	}
+	return _u
+}
+
+// AddVehicleID adds value to the "vehicle_id" field.
+func (_u *VehicleRollupUpdate) AddVehicleID(v int) *VehicleRollupUpdate {
+	_u.mutation.AddVehicleID(v)
+	return _u
+}
+
+// SetGranularity sets the "granularity" field.
+func (_u *VehicleRollupUpdate) SetGranularity(v string) *VehicleRollupUpdate {
+	_u.mutation.SetGranularity(v)
+	return _u
+}
+
+// SetNillableGranularity sets the "granularity" field if the given value is not nil.
+func (_u *VehicleRollupUpdate) SetNillableGranularity(v *string) *VehicleRollupUpdate {
+	if v != nil {
+		_u.SetGranularity(*v)
+	}
+	return _u
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_u *VehicleRollupUpdate) SetTimeZone(v string) *VehicleRollupUpdate {
+	_u.mutation.SetTimeZone(v)
+	return _u
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_u *VehicleRollupUpdate) SetNillableTimeZone(v *string) *VehicleRollupUpdate {
+	if v != nil {
+		_u.SetTimeZone(*v)
+	}
+	return _u
+}
+
+// SetStartAt sets the "start_at" field.
+func (_u *VehicleRollupUpdate) SetStartAt(v time.Time) *VehicleRollupUpdate {
+	_u.mutation.SetStartAt(v)
+	return _u
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (_u *VehicleRollupUpdate) SetNillableStartAt(v *time.Time) *VehicleRollupUpdate {
+	if v != nil {
+		_u.SetStartAt(*v)
+	}
+	return _u
+}
+
+// SetEndAt sets the "end_at" field.
+func (_u *VehicleRollupUpdate) SetEndAt(v time.Time) *VehicleRollupUpdate {
+	_u.mutation.SetEndAt(v)
+	return _u
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (_u *VehicleRollupUpdate) SetNillableEndAt(v *time.Time) *VehicleRollupUpdate {
+	if v != nil {
+		_u.SetEndAt(*v)
+	}
+	return _u
+}
+
+// SetDrives sets the "drives" field.
+func (_u *VehicleRollupUpdate) SetDrives(v int) *VehicleRollupUpdate {
+	_u.mutation.ResetDrives()
+	_u.mutation.SetDrives(v)
+	return _u
+}
+
+// SetNillableDrives sets the "drives" field if the given value is not nil.
+func (_u *VehicleRollupUpdate) SetNillableDrives(v *int) *VehicleRollupUpdate {
+	if v != nil {
+		_u.SetDrives(*v)
+	}
+	return _u
+}
+
+// AddDrives adds value to the "drives" field.
+func (_u *VehicleRollupUpdate) AddDrives(v int) *VehicleRollupUpdate {
+	_u.mutation.AddDrives(v)
+	return _u
+}
+
+// SetDistance sets the "distance" field.
+func (_u *VehicleRollupUpdate) SetDistance(v float64) *VehicleRollupUpdate {
+	_u.mutation.ResetDistance()
+	_u.mutation.SetDistance(v)
+	return _u
+}
+
+// SetNillableDistance sets the "distance" field if the given value is not nil.
+func (_u *VehicleRollupUpdate) SetNillableDistance(v *float64) *VehicleRollupUpdate {
+	if v != nil {
+		_u.SetDistance(*v)
+	}
+	return _u
+}
+
+// AddDistance adds value to the "distance" field.
+func (_u *VehicleRollupUpdate) AddDistance(v float64) *VehicleRollupUpdate {
+	_u.mutation.AddDistance(v)
+	return _u
+}
+
+// SetDriveSeconds sets the "drive_seconds" field.
+func (_u *VehicleRollupUpdate) SetDriveSeconds(v int) *VehicleRollupUpdate {
+	_u.mutation.ResetDriveSeconds()
+	_u.mutation.SetDriveSeconds(v)
+	return _u
+}
+
+// SetNillableDriveSeconds sets the "drive_seconds" field if the given value is not nil.
+func (_u *VehicleRollupUpdate) SetNillableDriveSeconds(v *int) *VehicleRollupUpdate {
+	if v != nil {
+		_u.SetDriveSeconds(*v)
+	}
+	return _u
+}
+
+// AddDriveSeconds adds value to the "drive_seconds" field.
+func (_u *VehicleRollupUpdate) AddDriveSeconds(v int) *VehicleRollupUpdate {
+	_u.mutation.AddDriveSeconds(v)
+	return _u
+}
+
+// SetEnergyUsed sets the "energy_used" field.
+func (_u *VehicleRollupUpdate) SetEnergyUsed(v float64) *VehicleRollupUpdate {
+	_u.mutation.ResetEnergyUsed()
+	_u.mutation.SetEnergyUsed(v)
+	return _u
+}
+
+// SetNillableEnergyUsed sets the "energy_used" field if the given value is not nil.
+func (_u *VehicleRollupUpdate) SetNillableEnergyUsed(v *float64) *VehicleRollupUpdate {
+	if v != nil {
+		_u.SetEnergyUsed(*v)
+	}
+	return _u
+}
+
+// AddEnergyUsed adds value to the "energy_used" field.
+func (_u *VehicleRollupUpdate) AddEnergyUsed(v float64) *VehicleRollupUpdate {
+	_u.mutation.AddEnergyUsed(v)
+	return _u
+}
+
+// SetEfficiency sets the "efficiency" field.
+func (_u *VehicleRollupUpdate) SetEfficiency(v float64) *VehicleRollupUpdate {
+	_u.mutation.ResetEfficiency()
+	_u.mutation.SetEfficiency(v)
+	return _u
+}
+
+// SetNillableEfficiency sets the "efficiency" field if the given value is not nil.
+func (_u *VehicleRollupUpdate) SetNillableEfficiency(v *float64) *VehicleRollupUpdate {
+	if v != nil {
+		_u.SetEfficiency(*v)
+	}
+	return _u
+}
+
+// AddEfficiency adds value to the "efficiency" field.
+func (_u *VehicleRollupUpdate) AddEfficiency(v float64) *VehicleRollupUpdate {
+	_u.mutation.AddEfficiency(v)
+	return _u
+}
+
+// SetMaxSpeed sets the "max_speed" field.
+func (_u *VehicleRollupUpdate) SetMaxSpeed(v float64) *VehicleRollupUpdate {
+	_u.mutation.ResetMaxSpeed()
+	_u.mutation.SetMaxSpeed(v)
+	return _u
+}
+
+// SetNillableMaxSpeed sets the "max_speed" field if the given value is not nil.
+func (_u *VehicleRollupUpdate) SetNillableMaxSpeed(v *float64) *VehicleRollupUpdate {
+	if v != nil {
+		_u.SetMaxSpeed(*v)
+	}
+	return _u
+}
+
+// AddMaxSpeed adds value to the "max_speed" field.
+func (_u *VehicleRollupUpdate) AddMaxSpeed(v float64) *VehicleRollupUpdate {
+	_u.mutation.AddMaxSpeed(v)
+	return _u
+}
+
+// SetCharges sets the "charges" field.
+func (_u *VehicleRollupUpdate) SetCharges(v int) *VehicleRollupUpdate {
+	_u.mutation.ResetCharges()
+	_u.mutation.SetCharges(v)
+	return _u
+}
+
+// SetNillableCharges sets the "charges" field if the given value is not nil.
+func (_u *VehicleRollupUpdate) SetNillableCharges(v *int) *VehicleRollupUpdate {
+	if v != nil {
+		_u.SetCharges(*v)
+	}
+	return _u
+}
+
+// AddCharges adds value to the "charges" field.
+func (_u *VehicleRollupUpdate) AddCharges(v int) *VehicleRollupUpdate {
+	_u.mutation.AddCharges(v)
+	return _u
+}
+
+// SetEnergyAdded sets the "energy_added" field.
+func (_u *VehicleRollupUpdate) SetEnergyAdded(v float64) *VehicleRollupUpdate {
+	_u.mutation.ResetEnergyAdded()
+	_u.mutation.SetEnergyAdded(v)
+	return _u
+}
+
+// SetNillableEnergyAdded sets the "energy_added" field if the given value is not nil.
+func (_u *VehicleRollupUpdate) SetNillableEnergyAdded(v *float64) *VehicleRollupUpdate {
+	if v != nil {
+		_u.SetEnergyAdded(*v)
+	}
+	return _u
+}
+
+// AddEnergyAdded adds value to the "energy_added" field.
+func (_u *VehicleRollupUpdate) AddEnergyAdded(v float64) *VehicleRollupUpdate {
+	_u.mutation.AddEnergyAdded(v)
+	return _u
+}
+
+// SetChargeCost sets the "charge_cost" field.
+func (_u *VehicleRollupUpdate) SetChargeCost(v float64) *VehicleRollupUpdate {
+	_u.mutation.ResetChargeCost()
+	_u.mutation.SetChargeCost(v)
+	return _u
+}
+
+// SetNillableChargeCost sets the "charge_cost" field if the given value is not nil.
+func (_u *VehicleRollupUpdate) SetNillableChargeCost(v *float64) *VehicleRollupUpdate {
+	if v != nil {
+		_u.SetChargeCost(*v)
+	}
+	return _u
+}
+
+// AddChargeCost adds value to the "charge_cost" field.
+func (_u *VehicleRollupUpdate) AddChargeCost(v float64) *VehicleRollupUpdate {
+	_u.mutation.AddChargeCost(v)
+	return _u
+}
+
+// SetParkedSeconds sets the "parked_seconds" field.
+func (_u *VehicleRollupUpdate) SetParkedSeconds(v int) *VehicleRollupUpdate {
+	_u.mutation.ResetParkedSeconds()
+	_u.mutation.SetParkedSeconds(v)
+	return _u
+}
+
+// SetNillableParkedSeconds sets the "parked_seconds" field if the given value is not nil.
+func (_u *VehicleRollupUpdate) SetNillableParkedSeconds(v *int) *VehicleRollupUpdate {
+	if v != nil {
+		_u.SetParkedSeconds(*v)
+	}
+	return _u
+}
+
+// AddParkedSeconds adds value to the "parked_seconds" field.
+func (_u *VehicleRollupUpdate) AddParkedSeconds(v int) *VehicleRollupUpdate {
+	_u.mutation.AddParkedSeconds(v)
+	return _u
+}
+
+// SetAsleepSeconds sets the "asleep_seconds" field.
+func (_u *VehicleRollupUpdate) SetAsleepSeconds(v int) *VehicleRollupUpdate {
+	_u.mutation.ResetAsleepSeconds()
+	_u.mutation.SetAsleepSeconds(v)
+	return _u
+}
+
+// SetNillableAsleepSeconds sets the "asleep_seconds" field if the given value is not nil.
+func (_u *VehicleRollupUpdate) SetNillableAsleepSeconds(v *int) *VehicleRollupUpdate {
+	if v != nil {
+		_u.SetAsleepSeconds(*v)
+	}
+	return _u
+}
+
+// AddAsleepSeconds adds value to the "asleep_seconds" field.
+func (_u *VehicleRollupUpdate) AddAsleepSeconds(v int) *VehicleRollupUpdate {
+	_u.mutation.AddAsleepSeconds(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VehicleRollupUpdate) SetUpdatedAt(v time.Time) *VehicleRollupUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the VehicleRollupMutation object of the builder.
+func (_u *VehicleRollupUpdate) Mutation() *VehicleRollupMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VehicleRollupUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VehicleRollupUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VehicleRollupUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VehicleRollupUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VehicleRollupUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := vehiclerollup.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *VehicleRollupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vehiclerollup.Table, vehiclerollup.Columns, sqlgraph.NewFieldSpec(vehiclerollup.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VehicleID(); ok {
+		_spec.SetField(vehiclerollup.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVehicleID(); ok {
+		_spec.AddField(vehiclerollup.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Granularity(); ok {
+		_spec.SetField(vehiclerollup.FieldGranularity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TimeZone(); ok {
+		_spec.SetField(vehiclerollup.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartAt(); ok {
+		_spec.SetField(vehiclerollup.FieldStartAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndAt(); ok {
+		_spec.SetField(vehiclerollup.FieldEndAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Drives(); ok {
+		_spec.SetField(vehiclerollup.FieldDrives, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDrives(); ok {
+		_spec.AddField(vehiclerollup.FieldDrives, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Distance(); ok {
+		_spec.SetField(vehiclerollup.FieldDistance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDistance(); ok {
+		_spec.AddField(vehiclerollup.FieldDistance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.DriveSeconds(); ok {
+		_spec.SetField(vehiclerollup.FieldDriveSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDriveSeconds(); ok {
+		_spec.AddField(vehiclerollup.FieldDriveSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EnergyUsed(); ok {
+		_spec.SetField(vehiclerollup.FieldEnergyUsed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEnergyUsed(); ok {
+		_spec.AddField(vehiclerollup.FieldEnergyUsed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Efficiency(); ok {
+		_spec.SetField(vehiclerollup.FieldEfficiency, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEfficiency(); ok {
+		_spec.AddField(vehiclerollup.FieldEfficiency, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxSpeed(); ok {
+		_spec.SetField(vehiclerollup.FieldMaxSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxSpeed(); ok {
+		_spec.AddField(vehiclerollup.FieldMaxSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Charges(); ok {
+		_spec.SetField(vehiclerollup.FieldCharges, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCharges(); ok {
+		_spec.AddField(vehiclerollup.FieldCharges, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EnergyAdded(); ok {
+		_spec.SetField(vehiclerollup.FieldEnergyAdded, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEnergyAdded(); ok {
+		_spec.AddField(vehiclerollup.FieldEnergyAdded, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ChargeCost(); ok {
+		_spec.SetField(vehiclerollup.FieldChargeCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedChargeCost(); ok {
+		_spec.AddField(vehiclerollup.FieldChargeCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ParkedSeconds(); ok {
+		_spec.SetField(vehiclerollup.FieldParkedSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedParkedSeconds(); ok {
+		_spec.AddField(vehiclerollup.FieldParkedSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AsleepSeconds(); ok {
+		_spec.SetField(vehiclerollup.FieldAsleepSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAsleepSeconds(); ok {
+		_spec.AddField(vehiclerollup.FieldAsleepSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(vehiclerollup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vehiclerollup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VehicleRollupUpdateOne is the builder for updating a single VehicleRollup entity.
+type VehicleRollupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VehicleRollupMutation
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_u *VehicleRollupUpdateOne) SetVehicleID(v int) *VehicleRollupUpdateOne {
+	_u.mutation.ResetVehicleID()
+	_u.mutation.SetVehicleID(v)
+	return _u
+}
+
+// SetNillableVehicleID sets the "vehicle_id" field if the given value is not nil.
+func (_u *VehicleRollupUpdateOne) SetNillableVehicleID(v *int) *VehicleRollupUpdateOne {
+	if v != nil {
+		_u.SetVehicleID(*v)
+	}
+	return _u
+}
+
+// AddVehicleID adds value to the "vehicle_id" field.
+func (_u *VehicleRollupUpdateOne) AddVehicleID(v int) *VehicleRollupUpdateOne {
+	_u.mutation.AddVehicleID(v)
+	return _u
+}
+
+// SetGranularity sets the "granularity" field.
+func (_u *VehicleRollupUpdateOne) SetGranularity(v string) *VehicleRollupUpdateOne {
+	_u.mutation.SetGranularity(v)
+	return _u
+}
+
+// SetNillableGranularity sets the "granularity" field if the given value is not nil.
+func (_u *VehicleRollupUpdateOne) SetNillableGranularity(v *string) *VehicleRollupUpdateOne {
+	if v != nil {
+		_u.SetGranularity(*v)
+	}
+	return _u
+}
+
+// SetTimeZone sets the "time_zone" field.
+func (_u *VehicleRollupUpdateOne) SetTimeZone(v string) *VehicleRollupUpdateOne {
+	_u.mutation.SetTimeZone(v)
+	return _u
+}
+
+// SetNillableTimeZone sets the "time_zone" field if the given value is not nil.
+func (_u *VehicleRollupUpdateOne) SetNillableTimeZone(v *string) *VehicleRollupUpdateOne {
+	if v != nil {
+		_u.SetTimeZone(*v)
+	}
+	return _u
+}
+
+// SetStartAt sets the "start_at" field.
+func (_u *VehicleRollupUpdateOne) SetStartAt(v time.Time) *VehicleRollupUpdateOne {
+	_u.mutation.SetStartAt(v)
+	return _u
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (_u *VehicleRollupUpdateOne) SetNillableStartAt(v *time.Time) *VehicleRollupUpdateOne {
+	if v != nil {
+		_u.SetStartAt(*v)
+	}
+	return _u
+}
+
+// SetEndAt sets the "end_at" field.
+func (_u *VehicleRollupUpdateOne) SetEndAt(v time.Time) *VehicleRollupUpdateOne {
+	_u.mutation.SetEndAt(v)
+	return _u
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (_u *VehicleRollupUpdateOne) SetNillableEndAt(v *time.Time) *VehicleRollupUpdateOne {
+	if v != nil {
+		_u.SetEndAt(*v)
+	}
+	return _u
+}
+
+// SetDrives sets the "drives" field.
+func (_u *VehicleRollupUpdateOne) SetDrives(v int) *VehicleRollupUpdateOne {
+	_u.mutation.ResetDrives()
+	_u.mutation.SetDrives(v)
+	return _u
+}
+
+// SetNillableDrives sets the "drives" field if the given value is not nil.
+func (_u *VehicleRollupUpdateOne) SetNillableDrives(v *int) *VehicleRollupUpdateOne {
+	if v != nil {
+		_u.SetDrives(*v)
+	}
+	return _u
+}
+
+// AddDrives adds value to the "drives" field.
+func (_u *VehicleRollupUpdateOne) AddDrives(v int) *VehicleRollupUpdateOne {
+	_u.mutation.AddDrives(v)
+	return _u
+}
+
+// SetDistance sets the "distance" field.
+func (_u *VehicleRollupUpdateOne) SetDistance(v float64) *VehicleRollupUpdateOne {
+	_u.mutation.ResetDistance()
+	_u.mutation.SetDistance(v)
+	return _u
+}
+
+// SetNillableDistance sets the "distance" field if the given value is not nil.
+func (_u *VehicleRollupUpdateOne) SetNillableDistance(v *float64) *VehicleRollupUpdateOne {
+	if v != nil {
+		_u.SetDistance(*v)
+	}
+	return _u
+}
+
+// AddDistance adds value to the "distance" field.
+func (_u *VehicleRollupUpdateOne) AddDistance(v float64) *VehicleRollupUpdateOne {
+	_u.mutation.AddDistance(v)
+	return _u
+}
+
+// SetDriveSeconds sets the "drive_seconds" field.
+func (_u *VehicleRollupUpdateOne) SetDriveSeconds(v int) *VehicleRollupUpdateOne {
+	_u.mutation.ResetDriveSeconds()
+	_u.mutation.SetDriveSeconds(v)
+	return _u
+}
+
+// SetNillableDriveSeconds sets the "drive_seconds" field if the given value is not nil.
+func (_u *VehicleRollupUpdateOne) SetNillableDriveSeconds(v *int) *VehicleRollupUpdateOne {
+	if v != nil {
+		_u.SetDriveSeconds(*v)
+	}
+	return _u
+}
+
+// AddDriveSeconds adds value to the "drive_seconds" field.
+func (_u *VehicleRollupUpdateOne) AddDriveSeconds(v int) *VehicleRollupUpdateOne {
+	_u.mutation.AddDriveSeconds(v)
+	return _u
+}
+
+// SetEnergyUsed sets the "energy_used" field.
+func (_u *VehicleRollupUpdateOne) SetEnergyUsed(v float64) *VehicleRollupUpdateOne {
+	_u.mutation.ResetEnergyUsed()
+	_u.mutation.SetEnergyUsed(v)
+	return _u
+}
+
+// SetNillableEnergyUsed sets the "energy_used" field if the given value is not nil.
+func (_u *VehicleRollupUpdateOne) SetNillableEnergyUsed(v *float64) *VehicleRollupUpdateOne {
+	if v != nil {
+		_u.SetEnergyUsed(*v)
+	}
+	return _u
+}
+
+// AddEnergyUsed adds value to the "energy_used" field.
+func (_u *VehicleRollupUpdateOne) AddEnergyUsed(v float64) *VehicleRollupUpdateOne {
+	_u.mutation.AddEnergyUsed(v)
+	return _u
+}
+
+// SetEfficiency sets the "efficiency" field.
+func (_u *VehicleRollupUpdateOne) SetEfficiency(v float64) *VehicleRollupUpdateOne {
+	_u.mutation.ResetEfficiency()
+	_u.mutation.SetEfficiency(v)
+	return _u
+}
+
+// SetNillableEfficiency sets the "efficiency" field if the given value is not nil.
+func (_u *VehicleRollupUpdateOne) SetNillableEfficiency(v *float64) *VehicleRollupUpdateOne {
+	if v != nil {
+		_u.SetEfficiency(*v)
+	}
+	return _u
+}
+
+// AddEfficiency adds value to the "efficiency" field.
+func (_u *VehicleRollupUpdateOne) AddEfficiency(v float64) *VehicleRollupUpdateOne {
+	_u.mutation.AddEfficiency(v)
+	return _u
+}
+
+// SetMaxSpeed sets the "max_speed" field.
+func (_u *VehicleRollupUpdateOne) SetMaxSpeed(v float64) *VehicleRollupUpdateOne {
+	_u.mutation.ResetMaxSpeed()
+	_u.mutation.SetMaxSpeed(v)
+	return _u
+}
+
+// SetNillableMaxSpeed sets the "max_speed" field if the given value is not nil.
+func (_u *VehicleRollupUpdateOne) SetNillableMaxSpeed(v *float64) *VehicleRollupUpdateOne {
+	if v != nil {
+		_u.SetMaxSpeed(*v)
+	}
+	return _u
+}
+
+// AddMaxSpeed adds value to the "max_speed" field.
+func (_u *VehicleRollupUpdateOne) AddMaxSpeed(v float64) *VehicleRollupUpdateOne {
+	_u.mutation.AddMaxSpeed(v)
+	return _u
+}
+
+// SetCharges sets the "charges" field.
+func (_u *VehicleRollupUpdateOne) SetCharges(v int) *VehicleRollupUpdateOne {
+	_u.mutation.ResetCharges()
+	_u.mutation.SetCharges(v)
+	return _u
+}
+
+// SetNillableCharges sets the "charges" field if the given value is not nil.
+func (_u *VehicleRollupUpdateOne) SetNillableCharges(v *int) *VehicleRollupUpdateOne {
+	if v != nil {
+		_u.SetCharges(*v)
+	}
+	return _u
+}
+
+// AddCharges adds value to the "charges" field.
+func (_u *VehicleRollupUpdateOne) AddCharges(v int) *VehicleRollupUpdateOne {
+	_u.mutation.AddCharges(v)
+	return _u
+}
+
+// SetEnergyAdded sets the "energy_added" field.
+func (_u *VehicleRollupUpdateOne) SetEnergyAdded(v float64) *VehicleRollupUpdateOne {
+	_u.mutation.ResetEnergyAdded()
+	_u.mutation.SetEnergyAdded(v)
+	return _u
+}
+
+// SetNillableEnergyAdded sets the "energy_added" field if the given value is not nil.
+func (_u *VehicleRollupUpdateOne) SetNillableEnergyAdded(v *float64) *VehicleRollupUpdateOne {
+	if v != nil {
+		_u.SetEnergyAdded(*v)
+	}
+	return _u
+}
+
+// AddEnergyAdded adds value to the "energy_added" field.
+func (_u *VehicleRollupUpdateOne) AddEnergyAdded(v float64) *VehicleRollupUpdateOne {
+	_u.mutation.AddEnergyAdded(v)
+	return _u
+}
+
+// SetChargeCost sets the "charge_cost" field.
+func (_u *VehicleRollupUpdateOne) SetChargeCost(v float64) *VehicleRollupUpdateOne {
+	_u.mutation.ResetChargeCost()
+	_u.mutation.SetChargeCost(v)
+	return _u
+}
+
+// SetNillableChargeCost sets the "charge_cost" field if the given value is not nil.
+func (_u *VehicleRollupUpdateOne) SetNillableChargeCost(v *float64) *VehicleRollupUpdateOne {
+	if v != nil {
+		_u.SetChargeCost(*v)
+	}
+	return _u
+}
+
+// AddChargeCost adds value to the "charge_cost" field.
+func (_u *VehicleRollupUpdateOne) AddChargeCost(v float64) *VehicleRollupUpdateOne {
+	_u.mutation.AddChargeCost(v)
+	return _u
+}
+
+// SetParkedSeconds sets the "parked_seconds" field.
+func (_u *VehicleRollupUpdateOne) SetParkedSeconds(v int) *VehicleRollupUpdateOne {
+	_u.mutation.ResetParkedSeconds()
+	_u.mutation.SetParkedSeconds(v)
+	return _u
+}
+
+// SetNillableParkedSeconds sets the "parked_seconds" field if the given value is not nil.
+func (_u *VehicleRollupUpdateOne) SetNillableParkedSeconds(v *int) *VehicleRollupUpdateOne {
+	if v != nil {
+		_u.SetParkedSeconds(*v)
+	}
+	return _u
+}
+
+// AddParkedSeconds adds value to the "parked_seconds" field.
+func (_u *VehicleRollupUpdateOne) AddParkedSeconds(v int) *VehicleRollupUpdateOne {
+	_u.mutation.AddParkedSeconds(v)
+	return _u
+}
+
+// SetAsleepSeconds sets the "asleep_seconds" field.
+func (_u *VehicleRollupUpdateOne) SetAsleepSeconds(v int) *VehicleRollupUpdateOne {
+	_u.mutation.ResetAsleepSeconds()
+	_u.mutation.SetAsleepSeconds(v)
+	return _u
+}
+
+// SetNillableAsleepSeconds sets the "asleep_seconds" field if the given value is not nil.
+func (_u *VehicleRollupUpdateOne) SetNillableAsleepSeconds(v *int) *VehicleRollupUpdateOne {
+	if v != nil {
+		_u.SetAsleepSeconds(*v)
+	}
+	return _u
+}
+
+// AddAsleepSeconds adds value to the "asleep_seconds" field.
+func (_u *VehicleRollupUpdateOne) AddAsleepSeconds(v int) *VehicleRollupUpdateOne {
+	_u.mutation.AddAsleepSeconds(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VehicleRollupUpdateOne) SetUpdatedAt(v time.Time) *VehicleRollupUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the VehicleRollupMutation object of the builder.
+func (_u *VehicleRollupUpdateOne) Mutation() *VehicleRollupMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the VehicleRollupUpdate builder.
+func (_u *VehicleRollupUpdateOne) Where(ps ...predicate.VehicleRollup) *VehicleRollupUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VehicleRollupUpdateOne) Select(field string, fields ...string) *VehicleRollupUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated VehicleRollup entity.
+func (_u *VehicleRollupUpdateOne) Save(ctx context.Context) (*VehicleRollup, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VehicleRollupUpdateOne) SaveX(ctx context.Context) *VehicleRollup {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VehicleRollupUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VehicleRollupUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VehicleRollupUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := vehiclerollup.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *VehicleRollupUpdateOne) sqlSave(ctx context.Context) (_node *VehicleRollup, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vehiclerollup.Table, vehiclerollup.Columns, sqlgraph.NewFieldSpec(vehiclerollup.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VehicleRollup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vehiclerollup.FieldID)
+		for _, f := range fields {
+			if !vehiclerollup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vehiclerollup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VehicleID(); ok {
+		_spec.SetField(vehiclerollup.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVehicleID(); ok {
+		_spec.AddField(vehiclerollup.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Granularity(); ok {
+		_spec.SetField(vehiclerollup.FieldGranularity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TimeZone(); ok {
+		_spec.SetField(vehiclerollup.FieldTimeZone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartAt(); ok {
+		_spec.SetField(vehiclerollup.FieldStartAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndAt(); ok {
+		_spec.SetField(vehiclerollup.FieldEndAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Drives(); ok {
+		_spec.SetField(vehiclerollup.FieldDrives, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDrives(); ok {
+		_spec.AddField(vehiclerollup.FieldDrives, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Distance(); ok {
+		_spec.SetField(vehiclerollup.FieldDistance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDistance(); ok {
+		_spec.AddField(vehiclerollup.FieldDistance, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.DriveSeconds(); ok {
+		_spec.SetField(vehiclerollup.FieldDriveSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDriveSeconds(); ok {
+		_spec.AddField(vehiclerollup.FieldDriveSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EnergyUsed(); ok {
+		_spec.SetField(vehiclerollup.FieldEnergyUsed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEnergyUsed(); ok {
+		_spec.AddField(vehiclerollup.FieldEnergyUsed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Efficiency(); ok {
+		_spec.SetField(vehiclerollup.FieldEfficiency, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEfficiency(); ok {
+		_spec.AddField(vehiclerollup.FieldEfficiency, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxSpeed(); ok {
+		_spec.SetField(vehiclerollup.FieldMaxSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxSpeed(); ok {
+		_spec.AddField(vehiclerollup.FieldMaxSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Charges(); ok {
+		_spec.SetField(vehiclerollup.FieldCharges, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCharges(); ok {
+		_spec.AddField(vehiclerollup.FieldCharges, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EnergyAdded(); ok {
+		_spec.SetField(vehiclerollup.FieldEnergyAdded, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEnergyAdded(); ok {
+		_spec.AddField(vehiclerollup.FieldEnergyAdded, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ChargeCost(); ok {
+		_spec.SetField(vehiclerollup.FieldChargeCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedChargeCost(); ok {
+		_spec.AddField(vehiclerollup.FieldChargeCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ParkedSeconds(); ok {
+		_spec.SetField(vehiclerollup.FieldParkedSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedParkedSeconds(); ok {
+		_spec.AddField(vehiclerollup.FieldParkedSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AsleepSeconds(); ok {
+		_spec.SetField(vehiclerollup.FieldAsleepSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAsleepSeconds(); ok {
+		_spec.AddField(vehiclerollup.FieldAsleepSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(vehiclerollup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &VehicleRollup{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vehiclerollup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,506 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"teslatrack/internal/data/ent/vehiclesnapshot"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Vehicle snapshot table
+type VehicleSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Associated vehicle ID
+	VehicleID int `json:"vehicle_id,omitempty"`
+	// Vehicle state reported by Tesla, e.g., online, asleep, offline
+	State string `json:"state,omitempty"`
+	// Gear shift state, e.g., P, D, R
+	ShiftState string `json:"shift_state,omitempty"`
+	// Speed in km/h
+	Speed float64 `json:"speed,omitempty"`
+	// Power draw or regeneration in kW
+	Power int `json:"power,omitempty"`
+	// WGS-84 latitude
+	Latitude float64 `json:"latitude,omitempty"`
+	// WGS-84 longitude
+	Longitude float64 `json:"longitude,omitempty"`
+	// Datum the position was reported in, e.g., wgs84, gcj02
+	CoordType string `json:"coord_type,omitempty"`
+	// Heading in degrees
+	Heading int `json:"heading,omitempty"`
+	// State of charge in percent
+	BatteryLevel int `json:"battery_level,omitempty"`
+	// Usable state of charge in percent
+	UsableBatteryLevel int `json:"usable_battery_level,omitempty"`
+	// Rated range in km
+	BatteryRange float64 `json:"battery_range,omitempty"`
+	// Ideal range in km
+	IdealBatteryRange float64 `json:"ideal_battery_range,omitempty"`
+	// Estimated range in km
+	EstBatteryRange float64 `json:"est_battery_range,omitempty"`
+	// Charging state, e.g., Charging, Disconnected
+	ChargingState string `json:"charging_state,omitempty"`
+	// Charger power in kW
+	ChargerPower int `json:"charger_power,omitempty"`
+	// Energy added in the current charging session in kWh
+	ChargeEnergyAdded float64 `json:"charge_energy_added,omitempty"`
+	// Charger voltage in V
+	ChargerVoltage int `json:"charger_voltage,omitempty"`
+	// Charger current in A
+	ChargerActualCurrent int `json:"charger_actual_current,omitempty"`
+	// Is a DC fast charger connected
+	FastChargerPresent bool `json:"fast_charger_present,omitempty"`
+	// DC fast charger type, e.g., Supercharger
+	FastChargerType string `json:"fast_charger_type,omitempty"`
+	// Is the battery heater on
+	BatteryHeaterOn bool `json:"battery_heater_on,omitempty"`
+	// Outside temperature in Celsius
+	OutsideTemp float64 `json:"outside_temp,omitempty"`
+	// Inside temperature in Celsius
+	InsideTemp float64 `json:"inside_temp,omitempty"`
+	// Is climate control on
+	ClimateOn bool `json:"climate_on,omitempty"`
+	// Climate keeper mode, e.g., off, dog, camp
+	ClimateKeeperMode string `json:"climate_keeper_mode,omitempty"`
+	// Is cabin overheat protection actively cooling
+	CabinOverheatCooling bool `json:"cabin_overheat_cooling,omitempty"`
+	// Is sentry mode on
+	SentryMode bool `json:"sentry_mode,omitempty"`
+	// Is the vehicle locked
+	Locked bool `json:"locked,omitempty"`
+	// Odometer in km
+	Odometer float64 `json:"odometer,omitempty"`
+	// Vehicle software version
+	CarVersion string `json:"car_version,omitempty"`
+	// Software update status
+	SoftwareUpdateStatus string `json:"software_update_status,omitempty"`
+	// Target version of the pending software update
+	SoftwareUpdateVersion string `json:"software_update_version,omitempty"`
+	// Software update download progress in percent
+	SoftwareUpdateDownloadPerc int `json:"software_update_download_perc,omitempty"`
+	// Software update install progress in percent
+	SoftwareUpdateInstallPerc int `json:"software_update_install_perc,omitempty"`
+	// Expected software update install duration in seconds
+	SoftwareUpdateExpectedDuration int `json:"software_update_expected_duration,omitempty"`
+	// Sample time
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VehicleSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vehiclesnapshot.FieldFastChargerPresent, vehiclesnapshot.FieldBatteryHeaterOn, vehiclesnapshot.FieldClimateOn, vehiclesnapshot.FieldCabinOverheatCooling, vehiclesnapshot.FieldSentryMode, vehiclesnapshot.FieldLocked:
+			values[i] = new(sql.NullBool)
+		case vehiclesnapshot.FieldSpeed, vehiclesnapshot.FieldLatitude, vehiclesnapshot.FieldLongitude, vehiclesnapshot.FieldBatteryRange, vehiclesnapshot.FieldIdealBatteryRange, vehiclesnapshot.FieldEstBatteryRange, vehiclesnapshot.FieldChargeEnergyAdded, vehiclesnapshot.FieldOutsideTemp, vehiclesnapshot.FieldInsideTemp, vehiclesnapshot.FieldOdometer:
+			values[i] = new(sql.NullFloat64)
+		case vehiclesnapshot.FieldID, vehiclesnapshot.FieldVehicleID, vehiclesnapshot.FieldPower, vehiclesnapshot.FieldHeading, vehiclesnapshot.FieldBatteryLevel, vehiclesnapshot.FieldUsableBatteryLevel, vehiclesnapshot.FieldChargerPower, vehiclesnapshot.FieldChargerVoltage, vehiclesnapshot.FieldChargerActualCurrent, vehiclesnapshot.FieldSoftwareUpdateDownloadPerc, vehiclesnapshot.FieldSoftwareUpdateInstallPerc, vehiclesnapshot.FieldSoftwareUpdateExpectedDuration:
+			values[i] = new(sql.NullInt64)
+		case vehiclesnapshot.FieldState, vehiclesnapshot.FieldShiftState, vehiclesnapshot.FieldCoordType, vehiclesnapshot.FieldChargingState, vehiclesnapshot.FieldFastChargerType, vehiclesnapshot.FieldClimateKeeperMode, vehiclesnapshot.FieldCarVersion, vehiclesnapshot.FieldSoftwareUpdateStatus, vehiclesnapshot.FieldSoftwareUpdateVersion:
+			values[i] = new(sql.NullString)
+		case vehiclesnapshot.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VehicleSnapshot fields.
+func (_m *VehicleSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vehiclesnapshot.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case vehiclesnapshot.FieldVehicleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vehicle_id", values[i])
+			} else if value.Valid {
+				_m.VehicleID = int(value.Int64)
+			}
+		case vehiclesnapshot.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = value.String
+			}
+		case vehiclesnapshot.FieldShiftState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shift_state", values[i])
+			} else if value.Valid {
+				_m.ShiftState = value.String
+			}
+		case vehiclesnapshot.FieldSpeed:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field speed", values[i])
+			} else if value.Valid {
+				_m.Speed = value.Float64
+			}
+		case vehiclesnapshot.FieldPower:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field power", values[i])
+			} else if value.Valid {
+				_m.Power = int(value.Int64)
+			}
+		case vehiclesnapshot.FieldLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field latitude", values[i])
+			} else if value.Valid {
+				_m.Latitude = value.Float64
+			}
+		case vehiclesnapshot.FieldLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field longitude", values[i])
+			} else if value.Valid {
+				_m.Longitude = value.Float64
+			}
+		case vehiclesnapshot.FieldCoordType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field coord_type", values[i])
+			} else if value.Valid {
+				_m.CoordType = value.String
+			}
+		case vehiclesnapshot.FieldHeading:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field heading", values[i])
+			} else if value.Valid {
+				_m.Heading = int(value.Int64)
+			}
+		case vehiclesnapshot.FieldBatteryLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field battery_level", values[i])
+			} else if value.Valid {
+				_m.BatteryLevel = int(value.Int64)
+			}
+		case vehiclesnapshot.FieldUsableBatteryLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field usable_battery_level", values[i])
+			} else if value.Valid {
+				_m.UsableBatteryLevel = int(value.Int64)
+			}
+		case vehiclesnapshot.FieldBatteryRange:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field battery_range", values[i])
+			} else if value.Valid {
+				_m.BatteryRange = value.Float64
+			}
+		case vehiclesnapshot.FieldIdealBatteryRange:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field ideal_battery_range", values[i])
+			} else if value.Valid {
+				_m.IdealBatteryRange = value.Float64
+			}
+		case vehiclesnapshot.FieldEstBatteryRange:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field est_battery_range", values[i])
+			} else if value.Valid {
+				_m.EstBatteryRange = value.Float64
+			}
+		case vehiclesnapshot.FieldChargingState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field charging_state", values[i])
+			} else if value.Valid {
+				_m.ChargingState = value.String
+			}
+		case vehiclesnapshot.FieldChargerPower:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field charger_power", values[i])
+			} else if value.Valid {
+				_m.ChargerPower = int(value.Int64)
+			}
+		case vehiclesnapshot.FieldChargeEnergyAdded:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field charge_energy_added", values[i])
+			} else if value.Valid {
+				_m.ChargeEnergyAdded = value.Float64
+			}
+		case vehiclesnapshot.FieldChargerVoltage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field charger_voltage", values[i])
+			} else if value.Valid {
+				_m.ChargerVoltage = int(value.Int64)
+			}
+		case vehiclesnapshot.FieldChargerActualCurrent:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field charger_actual_current", values[i])
+			} else if value.Valid {
+				_m.ChargerActualCurrent = int(value.Int64)
+			}
+		case vehiclesnapshot.FieldFastChargerPresent:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field fast_charger_present", values[i])
+			} else if value.Valid {
+				_m.FastChargerPresent = value.Bool
+			}
+		case vehiclesnapshot.FieldFastChargerType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fast_charger_type", values[i])
+			} else if value.Valid {
+				_m.FastChargerType = value.String
+			}
+		case vehiclesnapshot.FieldBatteryHeaterOn:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field battery_heater_on", values[i])
+			} else if value.Valid {
+				_m.BatteryHeaterOn = value.Bool
+			}
+		case vehiclesnapshot.FieldOutsideTemp:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field outside_temp", values[i])
+			} else if value.Valid {
+				_m.OutsideTemp = value.Float64
+			}
+		case vehiclesnapshot.FieldInsideTemp:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field inside_temp", values[i])
+			} else if value.Valid {
+				_m.InsideTemp = value.Float64
+			}
+		case vehiclesnapshot.FieldClimateOn:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field climate_on", values[i])
+			} else if value.Valid {
+				_m.ClimateOn = value.Bool
+			}
+		case vehiclesnapshot.FieldClimateKeeperMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field climate_keeper_mode", values[i])
+			} else if value.Valid {
+				_m.ClimateKeeperMode = value.String
+			}
+		case vehiclesnapshot.FieldCabinOverheatCooling:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cabin_overheat_cooling", values[i])
+			} else if value.Valid {
+				_m.CabinOverheatCooling = value.Bool
+			}
+		case vehiclesnapshot.FieldSentryMode:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sentry_mode", values[i])
+			} else if value.Valid {
+				_m.SentryMode = value.Bool
+			}
+		case vehiclesnapshot.FieldLocked:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field locked", values[i])
+			} else if value.Valid {
+				_m.Locked = value.Bool
+			}
+		case vehiclesnapshot.FieldOdometer:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field odometer", values[i])
+			} else if value.Valid {
+				_m.Odometer = value.Float64
+			}
+		case vehiclesnapshot.FieldCarVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field car_version", values[i])
+			} else if value.Valid {
+				_m.CarVersion = value.String
+			}
+		case vehiclesnapshot.FieldSoftwareUpdateStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field software_update_status", values[i])
+			} else if value.Valid {
+				_m.SoftwareUpdateStatus = value.String
+			}
+		case vehiclesnapshot.FieldSoftwareUpdateVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field software_update_version", values[i])
+			} else if value.Valid {
+				_m.SoftwareUpdateVersion = value.String
+			}
+		case vehiclesnapshot.FieldSoftwareUpdateDownloadPerc:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field software_update_download_perc", values[i])
+			} else if value.Valid {
+				_m.SoftwareUpdateDownloadPerc = int(value.Int64)
+			}
+		case vehiclesnapshot.FieldSoftwareUpdateInstallPerc:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field software_update_install_perc", values[i])
+			} else if value.Valid {
+				_m.SoftwareUpdateInstallPerc = int(value.Int64)
+			}
+		case vehiclesnapshot.FieldSoftwareUpdateExpectedDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field software_update_expected_duration", values[i])
+			} else if value.Valid {
+				_m.SoftwareUpdateExpectedDuration = int(value.Int64)
+			}
+		case vehiclesnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VehicleSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (_m *VehicleSnapshot) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this VehicleSnapshot.
+// Note that you need to call VehicleSnapshot.Unwrap() before calling this method if this VehicleSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *VehicleSnapshot) Update() *VehicleSnapshotUpdateOne {
+	return NewVehicleSnapshotClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the VehicleSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *VehicleSnapshot) Unwrap() *VehicleSnapshot {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VehicleSnapshot is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *VehicleSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("VehicleSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("vehicle_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VehicleID))
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(_m.State)
+	builder.WriteString(", ")
+	builder.WriteString("shift_state=")
+	builder.WriteString(_m.ShiftState)
+	builder.WriteString(", ")
+	builder.WriteString("speed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Speed))
+	builder.WriteString(", ")
+	builder.WriteString("power=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Power))
+	builder.WriteString(", ")
+	builder.WriteString("latitude=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Latitude))
+	builder.WriteString(", ")
+	builder.WriteString("longitude=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Longitude))
+	builder.WriteString(", ")
+	builder.WriteString("coord_type=")
+	builder.WriteString(_m.CoordType)
+	builder.WriteString(", ")
+	builder.WriteString("heading=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Heading))
+	builder.WriteString(", ")
+	builder.WriteString("battery_level=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BatteryLevel))
+	builder.WriteString(", ")
+	builder.WriteString("usable_battery_level=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UsableBatteryLevel))
+	builder.WriteString(", ")
+	builder.WriteString("battery_range=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BatteryRange))
+	builder.WriteString(", ")
+	builder.WriteString("ideal_battery_range=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IdealBatteryRange))
+	builder.WriteString(", ")
+	builder.WriteString("est_battery_range=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EstBatteryRange))
+	builder.WriteString(", ")
+	builder.WriteString("charging_state=")
+	builder.WriteString(_m.ChargingState)
+	builder.WriteString(", ")
+	builder.WriteString("charger_power=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChargerPower))
+	builder.WriteString(", ")
+	builder.WriteString("charge_energy_added=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChargeEnergyAdded))
+	builder.WriteString(", ")
+	builder.WriteString("charger_voltage=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChargerVoltage))
+	builder.WriteString(", ")
+	builder.WriteString("charger_actual_current=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChargerActualCurrent))
+	builder.WriteString(", ")
+	builder.WriteString("fast_charger_present=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FastChargerPresent))
+	builder.WriteString(", ")
+	builder.WriteString("fast_charger_type=")
+	builder.WriteString(_m.FastChargerType)
+	builder.WriteString(", ")
+	builder.WriteString("battery_heater_on=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BatteryHeaterOn))
+	builder.WriteString(", ")
+	builder.WriteString("outside_temp=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OutsideTemp))
+	builder.WriteString(", ")
+	builder.WriteString("inside_temp=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InsideTemp))
+	builder.WriteString(", ")
+	builder.WriteString("climate_on=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClimateOn))
+	builder.WriteString(", ")
+	builder.WriteString("climate_keeper_mode=")
+	builder.WriteString(_m.ClimateKeeperMode)
+	builder.WriteString(", ")
+	builder.WriteString("cabin_overheat_cooling=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CabinOverheatCooling))
+	builder.WriteString(", ")
+	builder.WriteString("sentry_mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SentryMode))
+	builder.WriteString(", ")
+	builder.WriteString("locked=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Locked))
+	builder.WriteString(", ")
+	builder.WriteString("odometer=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Odometer))
+	builder.WriteString(", ")
+	builder.WriteString("car_version=")
+	builder.WriteString(_m.CarVersion)
+	builder.WriteString(", ")
+	builder.WriteString("software_update_status=")
+	builder.WriteString(_m.SoftwareUpdateStatus)
+	builder.WriteString(", ")
+	builder.WriteString("software_update_version=")
+	builder.WriteString(_m.SoftwareUpdateVersion)
+	builder.WriteString(", ")
+	builder.WriteString("software_update_download_perc=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SoftwareUpdateDownloadPerc))
+	builder.WriteString(", ")
+	builder.WriteString("software_update_install_perc=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SoftwareUpdateInstallPerc))
+	builder.WriteString(", ")
+	builder.WriteString("software_update_expected_duration=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SoftwareUpdateExpectedDuration))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VehicleSnapshots is a parsable slice of VehicleSnapshot.
+type VehicleSnapshots []*VehicleSnapshot
@@ -0,0 +1,354 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vehiclesnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the vehiclesnapshot type in the database.
+	Label = "vehicle_snapshot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVehicleID holds the string denoting the vehicle_id field in the database.
+	FieldVehicleID = "vehicle_id"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldShiftState holds the string denoting the shift_state field in the database.
+	FieldShiftState = "shift_state"
+	// FieldSpeed holds the string denoting the speed field in the database.
+	FieldSpeed = "speed"
+	// FieldPower holds the string denoting the power field in the database.
+	FieldPower = "power"
+	// FieldLatitude holds the string denoting the latitude field in the database.
+	FieldLatitude = "latitude"
+	// FieldLongitude holds the string denoting the longitude field in the database.
+	FieldLongitude = "longitude"
+	// FieldCoordType holds the string denoting the coord_type field in the database.
+	FieldCoordType = "coord_type"
+	// FieldHeading holds the string denoting the heading field in the database.
+	FieldHeading = "heading"
+	// FieldBatteryLevel holds the string denoting the battery_level field in the database.
+	FieldBatteryLevel = "battery_level"
+	// FieldUsableBatteryLevel holds the string denoting the usable_battery_level field in the database.
+	FieldUsableBatteryLevel = "usable_battery_level"
+	// FieldBatteryRange holds the string denoting the battery_range field in the database.
+	FieldBatteryRange = "battery_range"
+	// FieldIdealBatteryRange holds the string denoting the ideal_battery_range field in the database.
+	FieldIdealBatteryRange = "ideal_battery_range"
+	// FieldEstBatteryRange holds the string denoting the est_battery_range field in the database.
+	FieldEstBatteryRange = "est_battery_range"
+	// FieldChargingState holds the string denoting the charging_state field in the database.
+	FieldChargingState = "charging_state"
+	// FieldChargerPower holds the string denoting the charger_power field in the database.
+	FieldChargerPower = "charger_power"
+	// FieldChargeEnergyAdded holds the string denoting the charge_energy_added field in the database.
+	FieldChargeEnergyAdded = "charge_energy_added"
+	// FieldChargerVoltage holds the string denoting the charger_voltage field in the database.
+	FieldChargerVoltage = "charger_voltage"
+	// FieldChargerActualCurrent holds the string denoting the charger_actual_current field in the database.
+	FieldChargerActualCurrent = "charger_actual_current"
+	// FieldFastChargerPresent holds the string denoting the fast_charger_present field in the database.
+	FieldFastChargerPresent = "fast_charger_present"
+	// FieldFastChargerType holds the string denoting the fast_charger_type field in the database.
+	FieldFastChargerType = "fast_charger_type"
+	// FieldBatteryHeaterOn holds the string denoting the battery_heater_on field in the database.
+	FieldBatteryHeaterOn = "battery_heater_on"
+	// FieldOutsideTemp holds the string denoting the outside_temp field in the database.
+	FieldOutsideTemp = "outside_temp"
+	// FieldInsideTemp holds the string denoting the inside_temp field in the database.
+	FieldInsideTemp = "inside_temp"
+	// FieldClimateOn holds the string denoting the climate_on field in the database.
+	FieldClimateOn = "climate_on"
+	// FieldClimateKeeperMode holds the string denoting the climate_keeper_mode field in the database.
+	FieldClimateKeeperMode = "climate_keeper_mode"
+	// FieldCabinOverheatCooling holds the string denoting the cabin_overheat_cooling field in the database.
+	FieldCabinOverheatCooling = "cabin_overheat_cooling"
+	// FieldSentryMode holds the string denoting the sentry_mode field in the database.
+	FieldSentryMode = "sentry_mode"
+	// FieldLocked holds the string denoting the locked field in the database.
+	FieldLocked = "locked"
+	// FieldOdometer holds the string denoting the odometer field in the database.
+	FieldOdometer = "odometer"
+	// FieldCarVersion holds the string denoting the car_version field in the database.
+	FieldCarVersion = "car_version"
+	// FieldSoftwareUpdateStatus holds the string denoting the software_update_status field in the database.
+	FieldSoftwareUpdateStatus = "software_update_status"
+	// FieldSoftwareUpdateVersion holds the string denoting the software_update_version field in the database.
+	FieldSoftwareUpdateVersion = "software_update_version"
+	// FieldSoftwareUpdateDownloadPerc holds the string denoting the software_update_download_perc field in the database.
+	FieldSoftwareUpdateDownloadPerc = "software_update_download_perc"
+	// FieldSoftwareUpdateInstallPerc holds the string denoting the software_update_install_perc field in the database.
+	FieldSoftwareUpdateInstallPerc = "software_update_install_perc"
+	// FieldSoftwareUpdateExpectedDuration holds the string denoting the software_update_expected_duration field in the database.
+	FieldSoftwareUpdateExpectedDuration = "software_update_expected_duration"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the vehiclesnapshot in the database.
+	Table = "vehicle_snapshot"
+)
+
+// Columns holds all SQL columns for vehiclesnapshot fields.
+var Columns = []string{
+	FieldID,
+	FieldVehicleID,
+	FieldState,
+	FieldShiftState,
+	FieldSpeed,
+	FieldPower,
+	FieldLatitude,
+	FieldLongitude,
+	FieldCoordType,
+	FieldHeading,
+	FieldBatteryLevel,
+	FieldUsableBatteryLevel,
+	FieldBatteryRange,
+	FieldIdealBatteryRange,
+	FieldEstBatteryRange,
+	FieldChargingState,
+	FieldChargerPower,
+	FieldChargeEnergyAdded,
+	FieldChargerVoltage,
+	FieldChargerActualCurrent,
+	FieldFastChargerPresent,
+	FieldFastChargerType,
+	FieldBatteryHeaterOn,
+	FieldOutsideTemp,
+	FieldInsideTemp,
+	FieldClimateOn,
+	FieldClimateKeeperMode,
+	FieldCabinOverheatCooling,
+	FieldSentryMode,
+	FieldLocked,
+	FieldOdometer,
+	FieldCarVersion,
+	FieldSoftwareUpdateStatus,
+	FieldSoftwareUpdateVersion,
+	FieldSoftwareUpdateDownloadPerc,
+	FieldSoftwareUpdateInstallPerc,
+	FieldSoftwareUpdateExpectedDuration,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultFastChargerPresent holds the default value on creation for the "fast_charger_present" field.
+	DefaultFastChargerPresent bool
+	// DefaultBatteryHeaterOn holds the default value on creation for the "battery_heater_on" field.
+	DefaultBatteryHeaterOn bool
+	// DefaultClimateOn holds the default value on creation for the "climate_on" field.
+	DefaultClimateOn bool
+	// DefaultCabinOverheatCooling holds the default value on creation for the "cabin_overheat_cooling" field.
+	DefaultCabinOverheatCooling bool
+	// DefaultSentryMode holds the default value on creation for the "sentry_mode" field.
+	DefaultSentryMode bool
+	// DefaultLocked holds the default value on creation for the "locked" field.
+	DefaultLocked bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the VehicleSnapshot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVehicleID orders the results by the vehicle_id field.
+func ByVehicleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVehicleID, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByShiftState orders the results by the shift_state field.
+func ByShiftState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShiftState, opts...).ToFunc()
+}
+
+// BySpeed orders the results by the speed field.
+func BySpeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpeed, opts...).ToFunc()
+}
+
+// ByPower orders the results by the power field.
+func ByPower(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPower, opts...).ToFunc()
+}
+
+// ByLatitude orders the results by the latitude field.
+func ByLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatitude, opts...).ToFunc()
+}
+
+// ByLongitude orders the results by the longitude field.
+func ByLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLongitude, opts...).ToFunc()
+}
+
+// ByCoordType orders the results by the coord_type field.
+func ByCoordType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCoordType, opts...).ToFunc()
+}
+
+// ByHeading orders the results by the heading field.
+func ByHeading(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeading, opts...).ToFunc()
+}
+
+// ByBatteryLevel orders the results by the battery_level field.
+func ByBatteryLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatteryLevel, opts...).ToFunc()
+}
+
+// ByUsableBatteryLevel orders the results by the usable_battery_level field.
+func ByUsableBatteryLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsableBatteryLevel, opts...).ToFunc()
+}
+
+// ByBatteryRange orders the results by the battery_range field.
+func ByBatteryRange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatteryRange, opts...).ToFunc()
+}
+
+// ByIdealBatteryRange orders the results by the ideal_battery_range field.
+func ByIdealBatteryRange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdealBatteryRange, opts...).ToFunc()
+}
+
+// ByEstBatteryRange orders the results by the est_battery_range field.
+func ByEstBatteryRange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEstBatteryRange, opts...).ToFunc()
+}
+
+// ByChargingState orders the results by the charging_state field.
+func ByChargingState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChargingState, opts...).ToFunc()
+}
+
+// ByChargerPower orders the results by the charger_power field.
+func ByChargerPower(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChargerPower, opts...).ToFunc()
+}
+
+// ByChargeEnergyAdded orders the results by the charge_energy_added field.
+func ByChargeEnergyAdded(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChargeEnergyAdded, opts...).ToFunc()
+}
+
+// ByChargerVoltage orders the results by the charger_voltage field.
+func ByChargerVoltage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChargerVoltage, opts...).ToFunc()
+}
+
+// ByChargerActualCurrent orders the results by the charger_actual_current field.
+func ByChargerActualCurrent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChargerActualCurrent, opts...).ToFunc()
+}
+
+// ByFastChargerPresent orders the results by the fast_charger_present field.
+func ByFastChargerPresent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFastChargerPresent, opts...).ToFunc()
+}
+
+// ByFastChargerType orders the results by the fast_charger_type field.
+func ByFastChargerType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFastChargerType, opts...).ToFunc()
+}
+
+// ByBatteryHeaterOn orders the results by the battery_heater_on field.
+func ByBatteryHeaterOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatteryHeaterOn, opts...).ToFunc()
+}
+
+// ByOutsideTemp orders the results by the outside_temp field.
+func ByOutsideTemp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutsideTemp, opts...).ToFunc()
+}
+
+// ByInsideTemp orders the results by the inside_temp field.
+func ByInsideTemp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInsideTemp, opts...).ToFunc()
+}
+
+// ByClimateOn orders the results by the climate_on field.
+func ByClimateOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClimateOn, opts...).ToFunc()
+}
+
+// ByClimateKeeperMode orders the results by the climate_keeper_mode field.
+func ByClimateKeeperMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClimateKeeperMode, opts...).ToFunc()
+}
+
+// ByCabinOverheatCooling orders the results by the cabin_overheat_cooling field.
+func ByCabinOverheatCooling(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCabinOverheatCooling, opts...).ToFunc()
+}
+
+// BySentryMode orders the results by the sentry_mode field.
+func BySentryMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSentryMode, opts...).ToFunc()
+}
+
+// ByLocked orders the results by the locked field.
+func ByLocked(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocked, opts...).ToFunc()
+}
+
+// ByOdometer orders the results by the odometer field.
+func ByOdometer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOdometer, opts...).ToFunc()
+}
+
+// ByCarVersion orders the results by the car_version field.
+func ByCarVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCarVersion, opts...).ToFunc()
+}
+
+// BySoftwareUpdateStatus orders the results by the software_update_status field.
+func BySoftwareUpdateStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSoftwareUpdateStatus, opts...).ToFunc()
+}
+
+// BySoftwareUpdateVersion orders the results by the software_update_version field.
+func BySoftwareUpdateVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSoftwareUpdateVersion, opts...).ToFunc()
+}
+
+// BySoftwareUpdateDownloadPerc orders the results by the software_update_download_perc field.
+func BySoftwareUpdateDownloadPerc(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSoftwareUpdateDownloadPerc, opts...).ToFunc()
+}
+
+// BySoftwareUpdateInstallPerc orders the results by the software_update_install_perc field.
+func BySoftwareUpdateInstallPerc(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSoftwareUpdateInstallPerc, opts...).ToFunc()
+}
+
+// BySoftwareUpdateExpectedDuration orders the results by the software_update_expected_duration field.
+func BySoftwareUpdateExpectedDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSoftwareUpdateExpectedDuration, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
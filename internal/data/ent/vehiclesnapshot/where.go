@@ -0,0 +1,2060 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vehiclesnapshot
+
+import (
+	"teslatrack/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// VehicleID applies equality check predicate on the "vehicle_id" field. It's identical to VehicleIDEQ.
+func VehicleID(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldState, v))
+}
+
+// ShiftState applies equality check predicate on the "shift_state" field. It's identical to ShiftStateEQ.
+func ShiftState(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldShiftState, v))
+}
+
+// Speed applies equality check predicate on the "speed" field. It's identical to SpeedEQ.
+func Speed(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldSpeed, v))
+}
+
+// Power applies equality check predicate on the "power" field. It's identical to PowerEQ.
+func Power(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldPower, v))
+}
+
+// Latitude applies equality check predicate on the "latitude" field. It's identical to LatitudeEQ.
+func Latitude(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldLatitude, v))
+}
+
+// Longitude applies equality check predicate on the "longitude" field. It's identical to LongitudeEQ.
+func Longitude(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldLongitude, v))
+}
+
+// CoordType applies equality check predicate on the "coord_type" field. It's identical to CoordTypeEQ.
+func CoordType(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldCoordType, v))
+}
+
+// Heading applies equality check predicate on the "heading" field. It's identical to HeadingEQ.
+func Heading(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldHeading, v))
+}
+
+// BatteryLevel applies equality check predicate on the "battery_level" field. It's identical to BatteryLevelEQ.
+func BatteryLevel(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldBatteryLevel, v))
+}
+
+// UsableBatteryLevel applies equality check predicate on the "usable_battery_level" field. It's identical to UsableBatteryLevelEQ.
+func UsableBatteryLevel(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldUsableBatteryLevel, v))
+}
+
+// BatteryRange applies equality check predicate on the "battery_range" field. It's identical to BatteryRangeEQ.
+func BatteryRange(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldBatteryRange, v))
+}
+
+// IdealBatteryRange applies equality check predicate on the "ideal_battery_range" field. It's identical to IdealBatteryRangeEQ.
+func IdealBatteryRange(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldIdealBatteryRange, v))
+}
+
+// EstBatteryRange applies equality check predicate on the "est_battery_range" field. It's identical to EstBatteryRangeEQ.
+func EstBatteryRange(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldEstBatteryRange, v))
+}
+
+// ChargingState applies equality check predicate on the "charging_state" field. It's identical to ChargingStateEQ.
+func ChargingState(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldChargingState, v))
+}
+
+// ChargerPower applies equality check predicate on the "charger_power" field. It's identical to ChargerPowerEQ.
+func ChargerPower(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldChargerPower, v))
+}
+
+// ChargeEnergyAdded applies equality check predicate on the "charge_energy_added" field. It's identical to ChargeEnergyAddedEQ.
+func ChargeEnergyAdded(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldChargeEnergyAdded, v))
+}
+
+// ChargerVoltage applies equality check predicate on the "charger_voltage" field. It's identical to ChargerVoltageEQ.
+func ChargerVoltage(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldChargerVoltage, v))
+}
+
+// ChargerActualCurrent applies equality check predicate on the "charger_actual_current" field. It's identical to ChargerActualCurrentEQ.
+func ChargerActualCurrent(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldChargerActualCurrent, v))
+}
+
+// FastChargerPresent applies equality check predicate on the "fast_charger_present" field. It's identical to FastChargerPresentEQ.
+func FastChargerPresent(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldFastChargerPresent, v))
+}
+
+// FastChargerType applies equality check predicate on the "fast_charger_type" field. It's identical to FastChargerTypeEQ.
+func FastChargerType(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldFastChargerType, v))
+}
+
+// BatteryHeaterOn applies equality check predicate on the "battery_heater_on" field. It's identical to BatteryHeaterOnEQ.
+func BatteryHeaterOn(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldBatteryHeaterOn, v))
+}
+
+// OutsideTemp applies equality check predicate on the "outside_temp" field. It's identical to OutsideTempEQ.
+func OutsideTemp(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldOutsideTemp, v))
+}
+
+// InsideTemp applies equality check predicate on the "inside_temp" field. It's identical to InsideTempEQ.
+func InsideTemp(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldInsideTemp, v))
+}
+
+// ClimateOn applies equality check predicate on the "climate_on" field. It's identical to ClimateOnEQ.
+func ClimateOn(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldClimateOn, v))
+}
+
+// ClimateKeeperMode applies equality check predicate on the "climate_keeper_mode" field. It's identical to ClimateKeeperModeEQ.
+func ClimateKeeperMode(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldClimateKeeperMode, v))
+}
+
+// CabinOverheatCooling applies equality check predicate on the "cabin_overheat_cooling" field. It's identical to CabinOverheatCoolingEQ.
+func CabinOverheatCooling(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldCabinOverheatCooling, v))
+}
+
+// SentryMode applies equality check predicate on the "sentry_mode" field. It's identical to SentryModeEQ.
+func SentryMode(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldSentryMode, v))
+}
+
+// Locked applies equality check predicate on the "locked" field. It's identical to LockedEQ.
+func Locked(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldLocked, v))
+}
+
+// Odometer applies equality check predicate on the "odometer" field. It's identical to OdometerEQ.
+func Odometer(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldOdometer, v))
+}
+
+// CarVersion applies equality check predicate on the "car_version" field. It's identical to CarVersionEQ.
+func CarVersion(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldCarVersion, v))
+}
+
+// SoftwareUpdateStatus applies equality check predicate on the "software_update_status" field. It's identical to SoftwareUpdateStatusEQ.
+func SoftwareUpdateStatus(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldSoftwareUpdateStatus, v))
+}
+
+// SoftwareUpdateVersion applies equality check predicate on the "software_update_version" field. It's identical to SoftwareUpdateVersionEQ.
+func SoftwareUpdateVersion(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldSoftwareUpdateVersion, v))
+}
+
+// SoftwareUpdateDownloadPerc applies equality check predicate on the "software_update_download_perc" field. It's identical to SoftwareUpdateDownloadPercEQ.
+func SoftwareUpdateDownloadPerc(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldSoftwareUpdateDownloadPerc, v))
+}
+
+// SoftwareUpdateInstallPerc applies equality check predicate on the "software_update_install_perc" field. It's identical to SoftwareUpdateInstallPercEQ.
+func SoftwareUpdateInstallPerc(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldSoftwareUpdateInstallPerc, v))
+}
+
+// SoftwareUpdateExpectedDuration applies equality check predicate on the "software_update_expected_duration" field. It's identical to SoftwareUpdateExpectedDurationEQ.
+func SoftwareUpdateExpectedDuration(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldSoftwareUpdateExpectedDuration, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// VehicleIDEQ applies the EQ predicate on the "vehicle_id" field.
+func VehicleIDEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// VehicleIDNEQ applies the NEQ predicate on the "vehicle_id" field.
+func VehicleIDNEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldVehicleID, v))
+}
+
+// VehicleIDIn applies the In predicate on the "vehicle_id" field.
+func VehicleIDIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldVehicleID, vs...))
+}
+
+// VehicleIDNotIn applies the NotIn predicate on the "vehicle_id" field.
+func VehicleIDNotIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldVehicleID, vs...))
+}
+
+// VehicleIDGT applies the GT predicate on the "vehicle_id" field.
+func VehicleIDGT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldVehicleID, v))
+}
+
+// VehicleIDGTE applies the GTE predicate on the "vehicle_id" field.
+func VehicleIDGTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldVehicleID, v))
+}
+
+// VehicleIDLT applies the LT predicate on the "vehicle_id" field.
+func VehicleIDLT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldVehicleID, v))
+}
+
+// VehicleIDLTE applies the LTE predicate on the "vehicle_id" field.
+func VehicleIDLTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldVehicleID, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldState, vs...))
+}
+
+// StateGT applies the GT predicate on the "state" field.
+func StateGT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldState, v))
+}
+
+// StateGTE applies the GTE predicate on the "state" field.
+func StateGTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldState, v))
+}
+
+// StateLT applies the LT predicate on the "state" field.
+func StateLT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldState, v))
+}
+
+// StateLTE applies the LTE predicate on the "state" field.
+func StateLTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldState, v))
+}
+
+// StateContains applies the Contains predicate on the "state" field.
+func StateContains(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContains(FieldState, v))
+}
+
+// StateHasPrefix applies the HasPrefix predicate on the "state" field.
+func StateHasPrefix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasPrefix(FieldState, v))
+}
+
+// StateHasSuffix applies the HasSuffix predicate on the "state" field.
+func StateHasSuffix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasSuffix(FieldState, v))
+}
+
+// StateEqualFold applies the EqualFold predicate on the "state" field.
+func StateEqualFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEqualFold(FieldState, v))
+}
+
+// StateContainsFold applies the ContainsFold predicate on the "state" field.
+func StateContainsFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContainsFold(FieldState, v))
+}
+
+// ShiftStateEQ applies the EQ predicate on the "shift_state" field.
+func ShiftStateEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldShiftState, v))
+}
+
+// ShiftStateNEQ applies the NEQ predicate on the "shift_state" field.
+func ShiftStateNEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldShiftState, v))
+}
+
+// ShiftStateIn applies the In predicate on the "shift_state" field.
+func ShiftStateIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldShiftState, vs...))
+}
+
+// ShiftStateNotIn applies the NotIn predicate on the "shift_state" field.
+func ShiftStateNotIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldShiftState, vs...))
+}
+
+// ShiftStateGT applies the GT predicate on the "shift_state" field.
+func ShiftStateGT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldShiftState, v))
+}
+
+// ShiftStateGTE applies the GTE predicate on the "shift_state" field.
+func ShiftStateGTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldShiftState, v))
+}
+
+// ShiftStateLT applies the LT predicate on the "shift_state" field.
+func ShiftStateLT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldShiftState, v))
+}
+
+// ShiftStateLTE applies the LTE predicate on the "shift_state" field.
+func ShiftStateLTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldShiftState, v))
+}
+
+// ShiftStateContains applies the Contains predicate on the "shift_state" field.
+func ShiftStateContains(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContains(FieldShiftState, v))
+}
+
+// ShiftStateHasPrefix applies the HasPrefix predicate on the "shift_state" field.
+func ShiftStateHasPrefix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasPrefix(FieldShiftState, v))
+}
+
+// ShiftStateHasSuffix applies the HasSuffix predicate on the "shift_state" field.
+func ShiftStateHasSuffix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasSuffix(FieldShiftState, v))
+}
+
+// ShiftStateIsNil applies the IsNil predicate on the "shift_state" field.
+func ShiftStateIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldShiftState))
+}
+
+// ShiftStateNotNil applies the NotNil predicate on the "shift_state" field.
+func ShiftStateNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldShiftState))
+}
+
+// ShiftStateEqualFold applies the EqualFold predicate on the "shift_state" field.
+func ShiftStateEqualFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEqualFold(FieldShiftState, v))
+}
+
+// ShiftStateContainsFold applies the ContainsFold predicate on the "shift_state" field.
+func ShiftStateContainsFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContainsFold(FieldShiftState, v))
+}
+
+// SpeedEQ applies the EQ predicate on the "speed" field.
+func SpeedEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldSpeed, v))
+}
+
+// SpeedNEQ applies the NEQ predicate on the "speed" field.
+func SpeedNEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldSpeed, v))
+}
+
+// SpeedIn applies the In predicate on the "speed" field.
+func SpeedIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldSpeed, vs...))
+}
+
+// SpeedNotIn applies the NotIn predicate on the "speed" field.
+func SpeedNotIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldSpeed, vs...))
+}
+
+// SpeedGT applies the GT predicate on the "speed" field.
+func SpeedGT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldSpeed, v))
+}
+
+// SpeedGTE applies the GTE predicate on the "speed" field.
+func SpeedGTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldSpeed, v))
+}
+
+// SpeedLT applies the LT predicate on the "speed" field.
+func SpeedLT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldSpeed, v))
+}
+
+// SpeedLTE applies the LTE predicate on the "speed" field.
+func SpeedLTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldSpeed, v))
+}
+
+// SpeedIsNil applies the IsNil predicate on the "speed" field.
+func SpeedIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldSpeed))
+}
+
+// SpeedNotNil applies the NotNil predicate on the "speed" field.
+func SpeedNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldSpeed))
+}
+
+// PowerEQ applies the EQ predicate on the "power" field.
+func PowerEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldPower, v))
+}
+
+// PowerNEQ applies the NEQ predicate on the "power" field.
+func PowerNEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldPower, v))
+}
+
+// PowerIn applies the In predicate on the "power" field.
+func PowerIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldPower, vs...))
+}
+
+// PowerNotIn applies the NotIn predicate on the "power" field.
+func PowerNotIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldPower, vs...))
+}
+
+// PowerGT applies the GT predicate on the "power" field.
+func PowerGT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldPower, v))
+}
+
+// PowerGTE applies the GTE predicate on the "power" field.
+func PowerGTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldPower, v))
+}
+
+// PowerLT applies the LT predicate on the "power" field.
+func PowerLT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldPower, v))
+}
+
+// PowerLTE applies the LTE predicate on the "power" field.
+func PowerLTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldPower, v))
+}
+
+// PowerIsNil applies the IsNil predicate on the "power" field.
+func PowerIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldPower))
+}
+
+// PowerNotNil applies the NotNil predicate on the "power" field.
+func PowerNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldPower))
+}
+
+// LatitudeEQ applies the EQ predicate on the "latitude" field.
+func LatitudeEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldLatitude, v))
+}
+
+// LatitudeNEQ applies the NEQ predicate on the "latitude" field.
+func LatitudeNEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldLatitude, v))
+}
+
+// LatitudeIn applies the In predicate on the "latitude" field.
+func LatitudeIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldLatitude, vs...))
+}
+
+// LatitudeNotIn applies the NotIn predicate on the "latitude" field.
+func LatitudeNotIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldLatitude, vs...))
+}
+
+// LatitudeGT applies the GT predicate on the "latitude" field.
+func LatitudeGT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldLatitude, v))
+}
+
+// LatitudeGTE applies the GTE predicate on the "latitude" field.
+func LatitudeGTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldLatitude, v))
+}
+
+// LatitudeLT applies the LT predicate on the "latitude" field.
+func LatitudeLT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldLatitude, v))
+}
+
+// LatitudeLTE applies the LTE predicate on the "latitude" field.
+func LatitudeLTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldLatitude, v))
+}
+
+// LatitudeIsNil applies the IsNil predicate on the "latitude" field.
+func LatitudeIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldLatitude))
+}
+
+// LatitudeNotNil applies the NotNil predicate on the "latitude" field.
+func LatitudeNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldLatitude))
+}
+
+// LongitudeEQ applies the EQ predicate on the "longitude" field.
+func LongitudeEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldLongitude, v))
+}
+
+// LongitudeNEQ applies the NEQ predicate on the "longitude" field.
+func LongitudeNEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldLongitude, v))
+}
+
+// LongitudeIn applies the In predicate on the "longitude" field.
+func LongitudeIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldLongitude, vs...))
+}
+
+// LongitudeNotIn applies the NotIn predicate on the "longitude" field.
+func LongitudeNotIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldLongitude, vs...))
+}
+
+// LongitudeGT applies the GT predicate on the "longitude" field.
+func LongitudeGT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldLongitude, v))
+}
+
+// LongitudeGTE applies the GTE predicate on the "longitude" field.
+func LongitudeGTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldLongitude, v))
+}
+
+// LongitudeLT applies the LT predicate on the "longitude" field.
+func LongitudeLT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldLongitude, v))
+}
+
+// LongitudeLTE applies the LTE predicate on the "longitude" field.
+func LongitudeLTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldLongitude, v))
+}
+
+// LongitudeIsNil applies the IsNil predicate on the "longitude" field.
+func LongitudeIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldLongitude))
+}
+
+// LongitudeNotNil applies the NotNil predicate on the "longitude" field.
+func LongitudeNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldLongitude))
+}
+
+// CoordTypeEQ applies the EQ predicate on the "coord_type" field.
+func CoordTypeEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldCoordType, v))
+}
+
+// CoordTypeNEQ applies the NEQ predicate on the "coord_type" field.
+func CoordTypeNEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldCoordType, v))
+}
+
+// CoordTypeIn applies the In predicate on the "coord_type" field.
+func CoordTypeIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldCoordType, vs...))
+}
+
+// CoordTypeNotIn applies the NotIn predicate on the "coord_type" field.
+func CoordTypeNotIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldCoordType, vs...))
+}
+
+// CoordTypeGT applies the GT predicate on the "coord_type" field.
+func CoordTypeGT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldCoordType, v))
+}
+
+// CoordTypeGTE applies the GTE predicate on the "coord_type" field.
+func CoordTypeGTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldCoordType, v))
+}
+
+// CoordTypeLT applies the LT predicate on the "coord_type" field.
+func CoordTypeLT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldCoordType, v))
+}
+
+// CoordTypeLTE applies the LTE predicate on the "coord_type" field.
+func CoordTypeLTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldCoordType, v))
+}
+
+// CoordTypeContains applies the Contains predicate on the "coord_type" field.
+func CoordTypeContains(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContains(FieldCoordType, v))
+}
+
+// CoordTypeHasPrefix applies the HasPrefix predicate on the "coord_type" field.
+func CoordTypeHasPrefix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasPrefix(FieldCoordType, v))
+}
+
+// CoordTypeHasSuffix applies the HasSuffix predicate on the "coord_type" field.
+func CoordTypeHasSuffix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasSuffix(FieldCoordType, v))
+}
+
+// CoordTypeIsNil applies the IsNil predicate on the "coord_type" field.
+func CoordTypeIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldCoordType))
+}
+
+// CoordTypeNotNil applies the NotNil predicate on the "coord_type" field.
+func CoordTypeNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldCoordType))
+}
+
+// CoordTypeEqualFold applies the EqualFold predicate on the "coord_type" field.
+func CoordTypeEqualFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEqualFold(FieldCoordType, v))
+}
+
+// CoordTypeContainsFold applies the ContainsFold predicate on the "coord_type" field.
+func CoordTypeContainsFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContainsFold(FieldCoordType, v))
+}
+
+// HeadingEQ applies the EQ predicate on the "heading" field.
+func HeadingEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldHeading, v))
+}
+
+// HeadingNEQ applies the NEQ predicate on the "heading" field.
+func HeadingNEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldHeading, v))
+}
+
+// HeadingIn applies the In predicate on the "heading" field.
+func HeadingIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldHeading, vs...))
+}
+
+// HeadingNotIn applies the NotIn predicate on the "heading" field.
+func HeadingNotIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldHeading, vs...))
+}
+
+// HeadingGT applies the GT predicate on the "heading" field.
+func HeadingGT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldHeading, v))
+}
+
+// HeadingGTE applies the GTE predicate on the "heading" field.
+func HeadingGTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldHeading, v))
+}
+
+// HeadingLT applies the LT predicate on the "heading" field.
+func HeadingLT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldHeading, v))
+}
+
+// HeadingLTE applies the LTE predicate on the "heading" field.
+func HeadingLTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldHeading, v))
+}
+
+// HeadingIsNil applies the IsNil predicate on the "heading" field.
+func HeadingIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldHeading))
+}
+
+// HeadingNotNil applies the NotNil predicate on the "heading" field.
+func HeadingNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldHeading))
+}
+
+// BatteryLevelEQ applies the EQ predicate on the "battery_level" field.
+func BatteryLevelEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldBatteryLevel, v))
+}
+
+// BatteryLevelNEQ applies the NEQ predicate on the "battery_level" field.
+func BatteryLevelNEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldBatteryLevel, v))
+}
+
+// BatteryLevelIn applies the In predicate on the "battery_level" field.
+func BatteryLevelIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldBatteryLevel, vs...))
+}
+
+// BatteryLevelNotIn applies the NotIn predicate on the "battery_level" field.
+func BatteryLevelNotIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldBatteryLevel, vs...))
+}
+
+// BatteryLevelGT applies the GT predicate on the "battery_level" field.
+func BatteryLevelGT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldBatteryLevel, v))
+}
+
+// BatteryLevelGTE applies the GTE predicate on the "battery_level" field.
+func BatteryLevelGTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldBatteryLevel, v))
+}
+
+// BatteryLevelLT applies the LT predicate on the "battery_level" field.
+func BatteryLevelLT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldBatteryLevel, v))
+}
+
+// BatteryLevelLTE applies the LTE predicate on the "battery_level" field.
+func BatteryLevelLTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldBatteryLevel, v))
+}
+
+// BatteryLevelIsNil applies the IsNil predicate on the "battery_level" field.
+func BatteryLevelIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldBatteryLevel))
+}
+
+// BatteryLevelNotNil applies the NotNil predicate on the "battery_level" field.
+func BatteryLevelNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldBatteryLevel))
+}
+
+// UsableBatteryLevelEQ applies the EQ predicate on the "usable_battery_level" field.
+func UsableBatteryLevelEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldUsableBatteryLevel, v))
+}
+
+// UsableBatteryLevelNEQ applies the NEQ predicate on the "usable_battery_level" field.
+func UsableBatteryLevelNEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldUsableBatteryLevel, v))
+}
+
+// UsableBatteryLevelIn applies the In predicate on the "usable_battery_level" field.
+func UsableBatteryLevelIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldUsableBatteryLevel, vs...))
+}
+
+// UsableBatteryLevelNotIn applies the NotIn predicate on the "usable_battery_level" field.
+func UsableBatteryLevelNotIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldUsableBatteryLevel, vs...))
+}
+
+// UsableBatteryLevelGT applies the GT predicate on the "usable_battery_level" field.
+func UsableBatteryLevelGT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldUsableBatteryLevel, v))
+}
+
+// UsableBatteryLevelGTE applies the GTE predicate on the "usable_battery_level" field.
+func UsableBatteryLevelGTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldUsableBatteryLevel, v))
+}
+
+// UsableBatteryLevelLT applies the LT predicate on the "usable_battery_level" field.
+func UsableBatteryLevelLT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldUsableBatteryLevel, v))
+}
+
+// UsableBatteryLevelLTE applies the LTE predicate on the "usable_battery_level" field.
+func UsableBatteryLevelLTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldUsableBatteryLevel, v))
+}
+
+// UsableBatteryLevelIsNil applies the IsNil predicate on the "usable_battery_level" field.
+func UsableBatteryLevelIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldUsableBatteryLevel))
+}
+
+// UsableBatteryLevelNotNil applies the NotNil predicate on the "usable_battery_level" field.
+func UsableBatteryLevelNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldUsableBatteryLevel))
+}
+
+// BatteryRangeEQ applies the EQ predicate on the "battery_range" field.
+func BatteryRangeEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldBatteryRange, v))
+}
+
+// BatteryRangeNEQ applies the NEQ predicate on the "battery_range" field.
+func BatteryRangeNEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldBatteryRange, v))
+}
+
+// BatteryRangeIn applies the In predicate on the "battery_range" field.
+func BatteryRangeIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldBatteryRange, vs...))
+}
+
+// BatteryRangeNotIn applies the NotIn predicate on the "battery_range" field.
+func BatteryRangeNotIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldBatteryRange, vs...))
+}
+
+// BatteryRangeGT applies the GT predicate on the "battery_range" field.
+func BatteryRangeGT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldBatteryRange, v))
+}
+
+// BatteryRangeGTE applies the GTE predicate on the "battery_range" field.
+func BatteryRangeGTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldBatteryRange, v))
+}
+
+// BatteryRangeLT applies the LT predicate on the "battery_range" field.
+func BatteryRangeLT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldBatteryRange, v))
+}
+
+// BatteryRangeLTE applies the LTE predicate on the "battery_range" field.
+func BatteryRangeLTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldBatteryRange, v))
+}
+
+// BatteryRangeIsNil applies the IsNil predicate on the "battery_range" field.
+func BatteryRangeIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldBatteryRange))
+}
+
+// BatteryRangeNotNil applies the NotNil predicate on the "battery_range" field.
+func BatteryRangeNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldBatteryRange))
+}
+
+// IdealBatteryRangeEQ applies the EQ predicate on the "ideal_battery_range" field.
+func IdealBatteryRangeEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldIdealBatteryRange, v))
+}
+
+// IdealBatteryRangeNEQ applies the NEQ predicate on the "ideal_battery_range" field.
+func IdealBatteryRangeNEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldIdealBatteryRange, v))
+}
+
+// IdealBatteryRangeIn applies the In predicate on the "ideal_battery_range" field.
+func IdealBatteryRangeIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldIdealBatteryRange, vs...))
+}
+
+// IdealBatteryRangeNotIn applies the NotIn predicate on the "ideal_battery_range" field.
+func IdealBatteryRangeNotIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldIdealBatteryRange, vs...))
+}
+
+// IdealBatteryRangeGT applies the GT predicate on the "ideal_battery_range" field.
+func IdealBatteryRangeGT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldIdealBatteryRange, v))
+}
+
+// IdealBatteryRangeGTE applies the GTE predicate on the "ideal_battery_range" field.
+func IdealBatteryRangeGTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldIdealBatteryRange, v))
+}
+
+// IdealBatteryRangeLT applies the LT predicate on the "ideal_battery_range" field.
+func IdealBatteryRangeLT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldIdealBatteryRange, v))
+}
+
+// IdealBatteryRangeLTE applies the LTE predicate on the "ideal_battery_range" field.
+func IdealBatteryRangeLTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldIdealBatteryRange, v))
+}
+
+// IdealBatteryRangeIsNil applies the IsNil predicate on the "ideal_battery_range" field.
+func IdealBatteryRangeIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldIdealBatteryRange))
+}
+
+// IdealBatteryRangeNotNil applies the NotNil predicate on the "ideal_battery_range" field.
+func IdealBatteryRangeNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldIdealBatteryRange))
+}
+
+// EstBatteryRangeEQ applies the EQ predicate on the "est_battery_range" field.
+func EstBatteryRangeEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldEstBatteryRange, v))
+}
+
+// EstBatteryRangeNEQ applies the NEQ predicate on the "est_battery_range" field.
+func EstBatteryRangeNEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldEstBatteryRange, v))
+}
+
+// EstBatteryRangeIn applies the In predicate on the "est_battery_range" field.
+func EstBatteryRangeIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldEstBatteryRange, vs...))
+}
+
+// EstBatteryRangeNotIn applies the NotIn predicate on the "est_battery_range" field.
+func EstBatteryRangeNotIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldEstBatteryRange, vs...))
+}
+
+// EstBatteryRangeGT applies the GT predicate on the "est_battery_range" field.
+func EstBatteryRangeGT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldEstBatteryRange, v))
+}
+
+// EstBatteryRangeGTE applies the GTE predicate on the "est_battery_range" field.
+func EstBatteryRangeGTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldEstBatteryRange, v))
+}
+
+// EstBatteryRangeLT applies the LT predicate on the "est_battery_range" field.
+func EstBatteryRangeLT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldEstBatteryRange, v))
+}
+
+// EstBatteryRangeLTE applies the LTE predicate on the "est_battery_range" field.
+func EstBatteryRangeLTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldEstBatteryRange, v))
+}
+
+// EstBatteryRangeIsNil applies the IsNil predicate on the "est_battery_range" field.
+func EstBatteryRangeIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldEstBatteryRange))
+}
+
+// EstBatteryRangeNotNil applies the NotNil predicate on the "est_battery_range" field.
+func EstBatteryRangeNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldEstBatteryRange))
+}
+
+// ChargingStateEQ applies the EQ predicate on the "charging_state" field.
+func ChargingStateEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldChargingState, v))
+}
+
+// ChargingStateNEQ applies the NEQ predicate on the "charging_state" field.
+func ChargingStateNEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldChargingState, v))
+}
+
+// ChargingStateIn applies the In predicate on the "charging_state" field.
+func ChargingStateIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldChargingState, vs...))
+}
+
+// ChargingStateNotIn applies the NotIn predicate on the "charging_state" field.
+func ChargingStateNotIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldChargingState, vs...))
+}
+
+// ChargingStateGT applies the GT predicate on the "charging_state" field.
+func ChargingStateGT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldChargingState, v))
+}
+
+// ChargingStateGTE applies the GTE predicate on the "charging_state" field.
+func ChargingStateGTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldChargingState, v))
+}
+
+// ChargingStateLT applies the LT predicate on the "charging_state" field.
+func ChargingStateLT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldChargingState, v))
+}
+
+// ChargingStateLTE applies the LTE predicate on the "charging_state" field.
+func ChargingStateLTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldChargingState, v))
+}
+
+// ChargingStateContains applies the Contains predicate on the "charging_state" field.
+func ChargingStateContains(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContains(FieldChargingState, v))
+}
+
+// ChargingStateHasPrefix applies the HasPrefix predicate on the "charging_state" field.
+func ChargingStateHasPrefix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasPrefix(FieldChargingState, v))
+}
+
+// ChargingStateHasSuffix applies the HasSuffix predicate on the "charging_state" field.
+func ChargingStateHasSuffix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasSuffix(FieldChargingState, v))
+}
+
+// ChargingStateIsNil applies the IsNil predicate on the "charging_state" field.
+func ChargingStateIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldChargingState))
+}
+
+// ChargingStateNotNil applies the NotNil predicate on the "charging_state" field.
+func ChargingStateNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldChargingState))
+}
+
+// ChargingStateEqualFold applies the EqualFold predicate on the "charging_state" field.
+func ChargingStateEqualFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEqualFold(FieldChargingState, v))
+}
+
+// ChargingStateContainsFold applies the ContainsFold predicate on the "charging_state" field.
+func ChargingStateContainsFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContainsFold(FieldChargingState, v))
+}
+
+// ChargerPowerEQ applies the EQ predicate on the "charger_power" field.
+func ChargerPowerEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldChargerPower, v))
+}
+
+// ChargerPowerNEQ applies the NEQ predicate on the "charger_power" field.
+func ChargerPowerNEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldChargerPower, v))
+}
+
+// ChargerPowerIn applies the In predicate on the "charger_power" field.
+func ChargerPowerIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldChargerPower, vs...))
+}
+
+// ChargerPowerNotIn applies the NotIn predicate on the "charger_power" field.
+func ChargerPowerNotIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldChargerPower, vs...))
+}
+
+// ChargerPowerGT applies the GT predicate on the "charger_power" field.
+func ChargerPowerGT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldChargerPower, v))
+}
+
+// ChargerPowerGTE applies the GTE predicate on the "charger_power" field.
+func ChargerPowerGTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldChargerPower, v))
+}
+
+// ChargerPowerLT applies the LT predicate on the "charger_power" field.
+func ChargerPowerLT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldChargerPower, v))
+}
+
+// ChargerPowerLTE applies the LTE predicate on the "charger_power" field.
+func ChargerPowerLTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldChargerPower, v))
+}
+
+// ChargerPowerIsNil applies the IsNil predicate on the "charger_power" field.
+func ChargerPowerIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldChargerPower))
+}
+
+// ChargerPowerNotNil applies the NotNil predicate on the "charger_power" field.
+func ChargerPowerNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldChargerPower))
+}
+
+// ChargeEnergyAddedEQ applies the EQ predicate on the "charge_energy_added" field.
+func ChargeEnergyAddedEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldChargeEnergyAdded, v))
+}
+
+// ChargeEnergyAddedNEQ applies the NEQ predicate on the "charge_energy_added" field.
+func ChargeEnergyAddedNEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldChargeEnergyAdded, v))
+}
+
+// ChargeEnergyAddedIn applies the In predicate on the "charge_energy_added" field.
+func ChargeEnergyAddedIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldChargeEnergyAdded, vs...))
+}
+
+// ChargeEnergyAddedNotIn applies the NotIn predicate on the "charge_energy_added" field.
+func ChargeEnergyAddedNotIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldChargeEnergyAdded, vs...))
+}
+
+// ChargeEnergyAddedGT applies the GT predicate on the "charge_energy_added" field.
+func ChargeEnergyAddedGT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldChargeEnergyAdded, v))
+}
+
+// ChargeEnergyAddedGTE applies the GTE predicate on the "charge_energy_added" field.
+func ChargeEnergyAddedGTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldChargeEnergyAdded, v))
+}
+
+// ChargeEnergyAddedLT applies the LT predicate on the "charge_energy_added" field.
+func ChargeEnergyAddedLT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldChargeEnergyAdded, v))
+}
+
+// ChargeEnergyAddedLTE applies the LTE predicate on the "charge_energy_added" field.
+func ChargeEnergyAddedLTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldChargeEnergyAdded, v))
+}
+
+// ChargeEnergyAddedIsNil applies the IsNil predicate on the "charge_energy_added" field.
+func ChargeEnergyAddedIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldChargeEnergyAdded))
+}
+
+// ChargeEnergyAddedNotNil applies the NotNil predicate on the "charge_energy_added" field.
+func ChargeEnergyAddedNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldChargeEnergyAdded))
+}
+
+// ChargerVoltageEQ applies the EQ predicate on the "charger_voltage" field.
+func ChargerVoltageEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldChargerVoltage, v))
+}
+
+// ChargerVoltageNEQ applies the NEQ predicate on the "charger_voltage" field.
+func ChargerVoltageNEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldChargerVoltage, v))
+}
+
+// ChargerVoltageIn applies the In predicate on the "charger_voltage" field.
+func ChargerVoltageIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldChargerVoltage, vs...))
+}
+
+// ChargerVoltageNotIn applies the NotIn predicate on the "charger_voltage" field.
+func ChargerVoltageNotIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldChargerVoltage, vs...))
+}
+
+// ChargerVoltageGT applies the GT predicate on the "charger_voltage" field.
+func ChargerVoltageGT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldChargerVoltage, v))
+}
+
+// ChargerVoltageGTE applies the GTE predicate on the "charger_voltage" field.
+func ChargerVoltageGTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldChargerVoltage, v))
+}
+
+// ChargerVoltageLT applies the LT predicate on the "charger_voltage" field.
+func ChargerVoltageLT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldChargerVoltage, v))
+}
+
+// ChargerVoltageLTE applies the LTE predicate on the "charger_voltage" field.
+func ChargerVoltageLTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldChargerVoltage, v))
+}
+
+// ChargerVoltageIsNil applies the IsNil predicate on the "charger_voltage" field.
+func ChargerVoltageIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldChargerVoltage))
+}
+
+// ChargerVoltageNotNil applies the NotNil predicate on the "charger_voltage" field.
+func ChargerVoltageNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldChargerVoltage))
+}
+
+// ChargerActualCurrentEQ applies the EQ predicate on the "charger_actual_current" field.
+func ChargerActualCurrentEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldChargerActualCurrent, v))
+}
+
+// ChargerActualCurrentNEQ applies the NEQ predicate on the "charger_actual_current" field.
+func ChargerActualCurrentNEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldChargerActualCurrent, v))
+}
+
+// ChargerActualCurrentIn applies the In predicate on the "charger_actual_current" field.
+func ChargerActualCurrentIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldChargerActualCurrent, vs...))
+}
+
+// ChargerActualCurrentNotIn applies the NotIn predicate on the "charger_actual_current" field.
+func ChargerActualCurrentNotIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldChargerActualCurrent, vs...))
+}
+
+// ChargerActualCurrentGT applies the GT predicate on the "charger_actual_current" field.
+func ChargerActualCurrentGT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldChargerActualCurrent, v))
+}
+
+// ChargerActualCurrentGTE applies the GTE predicate on the "charger_actual_current" field.
+func ChargerActualCurrentGTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldChargerActualCurrent, v))
+}
+
+// ChargerActualCurrentLT applies the LT predicate on the "charger_actual_current" field.
+func ChargerActualCurrentLT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldChargerActualCurrent, v))
+}
+
+// ChargerActualCurrentLTE applies the LTE predicate on the "charger_actual_current" field.
+func ChargerActualCurrentLTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldChargerActualCurrent, v))
+}
+
+// ChargerActualCurrentIsNil applies the IsNil predicate on the "charger_actual_current" field.
+func ChargerActualCurrentIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldChargerActualCurrent))
+}
+
+// ChargerActualCurrentNotNil applies the NotNil predicate on the "charger_actual_current" field.
+func ChargerActualCurrentNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldChargerActualCurrent))
+}
+
+// FastChargerPresentEQ applies the EQ predicate on the "fast_charger_present" field.
+func FastChargerPresentEQ(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldFastChargerPresent, v))
+}
+
+// FastChargerPresentNEQ applies the NEQ predicate on the "fast_charger_present" field.
+func FastChargerPresentNEQ(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldFastChargerPresent, v))
+}
+
+// FastChargerTypeEQ applies the EQ predicate on the "fast_charger_type" field.
+func FastChargerTypeEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldFastChargerType, v))
+}
+
+// FastChargerTypeNEQ applies the NEQ predicate on the "fast_charger_type" field.
+func FastChargerTypeNEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldFastChargerType, v))
+}
+
+// FastChargerTypeIn applies the In predicate on the "fast_charger_type" field.
+func FastChargerTypeIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldFastChargerType, vs...))
+}
+
+// FastChargerTypeNotIn applies the NotIn predicate on the "fast_charger_type" field.
+func FastChargerTypeNotIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldFastChargerType, vs...))
+}
+
+// FastChargerTypeGT applies the GT predicate on the "fast_charger_type" field.
+func FastChargerTypeGT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldFastChargerType, v))
+}
+
+// FastChargerTypeGTE applies the GTE predicate on the "fast_charger_type" field.
+func FastChargerTypeGTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldFastChargerType, v))
+}
+
+// FastChargerTypeLT applies the LT predicate on the "fast_charger_type" field.
+func FastChargerTypeLT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldFastChargerType, v))
+}
+
+// FastChargerTypeLTE applies the LTE predicate on the "fast_charger_type" field.
+func FastChargerTypeLTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldFastChargerType, v))
+}
+
+// FastChargerTypeContains applies the Contains predicate on the "fast_charger_type" field.
+func FastChargerTypeContains(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContains(FieldFastChargerType, v))
+}
+
+// FastChargerTypeHasPrefix applies the HasPrefix predicate on the "fast_charger_type" field.
+func FastChargerTypeHasPrefix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasPrefix(FieldFastChargerType, v))
+}
+
+// FastChargerTypeHasSuffix applies the HasSuffix predicate on the "fast_charger_type" field.
+func FastChargerTypeHasSuffix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasSuffix(FieldFastChargerType, v))
+}
+
+// FastChargerTypeIsNil applies the IsNil predicate on the "fast_charger_type" field.
+func FastChargerTypeIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldFastChargerType))
+}
+
+// FastChargerTypeNotNil applies the NotNil predicate on the "fast_charger_type" field.
+func FastChargerTypeNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldFastChargerType))
+}
+
+// FastChargerTypeEqualFold applies the EqualFold predicate on the "fast_charger_type" field.
+func FastChargerTypeEqualFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEqualFold(FieldFastChargerType, v))
+}
+
+// FastChargerTypeContainsFold applies the ContainsFold predicate on the "fast_charger_type" field.
+func FastChargerTypeContainsFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContainsFold(FieldFastChargerType, v))
+}
+
+// BatteryHeaterOnEQ applies the EQ predicate on the "battery_heater_on" field.
+func BatteryHeaterOnEQ(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldBatteryHeaterOn, v))
+}
+
+// BatteryHeaterOnNEQ applies the NEQ predicate on the "battery_heater_on" field.
+func BatteryHeaterOnNEQ(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldBatteryHeaterOn, v))
+}
+
+// OutsideTempEQ applies the EQ predicate on the "outside_temp" field.
+func OutsideTempEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldOutsideTemp, v))
+}
+
+// OutsideTempNEQ applies the NEQ predicate on the "outside_temp" field.
+func OutsideTempNEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldOutsideTemp, v))
+}
+
+// OutsideTempIn applies the In predicate on the "outside_temp" field.
+func OutsideTempIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldOutsideTemp, vs...))
+}
+
+// OutsideTempNotIn applies the NotIn predicate on the "outside_temp" field.
+func OutsideTempNotIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldOutsideTemp, vs...))
+}
+
+// OutsideTempGT applies the GT predicate on the "outside_temp" field.
+func OutsideTempGT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldOutsideTemp, v))
+}
+
+// OutsideTempGTE applies the GTE predicate on the "outside_temp" field.
+func OutsideTempGTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldOutsideTemp, v))
+}
+
+// OutsideTempLT applies the LT predicate on the "outside_temp" field.
+func OutsideTempLT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldOutsideTemp, v))
+}
+
+// OutsideTempLTE applies the LTE predicate on the "outside_temp" field.
+func OutsideTempLTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldOutsideTemp, v))
+}
+
+// OutsideTempIsNil applies the IsNil predicate on the "outside_temp" field.
+func OutsideTempIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldOutsideTemp))
+}
+
+// OutsideTempNotNil applies the NotNil predicate on the "outside_temp" field.
+func OutsideTempNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldOutsideTemp))
+}
+
+// InsideTempEQ applies the EQ predicate on the "inside_temp" field.
+func InsideTempEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldInsideTemp, v))
+}
+
+// InsideTempNEQ applies the NEQ predicate on the "inside_temp" field.
+func InsideTempNEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldInsideTemp, v))
+}
+
+// InsideTempIn applies the In predicate on the "inside_temp" field.
+func InsideTempIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldInsideTemp, vs...))
+}
+
+// InsideTempNotIn applies the NotIn predicate on the "inside_temp" field.
+func InsideTempNotIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldInsideTemp, vs...))
+}
+
+// InsideTempGT applies the GT predicate on the "inside_temp" field.
+func InsideTempGT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldInsideTemp, v))
+}
+
+// InsideTempGTE applies the GTE predicate on the "inside_temp" field.
+func InsideTempGTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldInsideTemp, v))
+}
+
+// InsideTempLT applies the LT predicate on the "inside_temp" field.
+func InsideTempLT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldInsideTemp, v))
+}
+
+// InsideTempLTE applies the LTE predicate on the "inside_temp" field.
+func InsideTempLTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldInsideTemp, v))
+}
+
+// InsideTempIsNil applies the IsNil predicate on the "inside_temp" field.
+func InsideTempIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldInsideTemp))
+}
+
+// InsideTempNotNil applies the NotNil predicate on the "inside_temp" field.
+func InsideTempNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldInsideTemp))
+}
+
+// ClimateOnEQ applies the EQ predicate on the "climate_on" field.
+func ClimateOnEQ(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldClimateOn, v))
+}
+
+// ClimateOnNEQ applies the NEQ predicate on the "climate_on" field.
+func ClimateOnNEQ(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldClimateOn, v))
+}
+
+// ClimateKeeperModeEQ applies the EQ predicate on the "climate_keeper_mode" field.
+func ClimateKeeperModeEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldClimateKeeperMode, v))
+}
+
+// ClimateKeeperModeNEQ applies the NEQ predicate on the "climate_keeper_mode" field.
+func ClimateKeeperModeNEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldClimateKeeperMode, v))
+}
+
+// ClimateKeeperModeIn applies the In predicate on the "climate_keeper_mode" field.
+func ClimateKeeperModeIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldClimateKeeperMode, vs...))
+}
+
+// ClimateKeeperModeNotIn applies the NotIn predicate on the "climate_keeper_mode" field.
+func ClimateKeeperModeNotIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldClimateKeeperMode, vs...))
+}
+
+// ClimateKeeperModeGT applies the GT predicate on the "climate_keeper_mode" field.
+func ClimateKeeperModeGT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldClimateKeeperMode, v))
+}
+
+// ClimateKeeperModeGTE applies the GTE predicate on the "climate_keeper_mode" field.
+func ClimateKeeperModeGTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldClimateKeeperMode, v))
+}
+
+// ClimateKeeperModeLT applies the LT predicate on the "climate_keeper_mode" field.
+func ClimateKeeperModeLT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldClimateKeeperMode, v))
+}
+
+// ClimateKeeperModeLTE applies the LTE predicate on the "climate_keeper_mode" field.
+func ClimateKeeperModeLTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldClimateKeeperMode, v))
+}
+
+// ClimateKeeperModeContains applies the Contains predicate on the "climate_keeper_mode" field.
+func ClimateKeeperModeContains(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContains(FieldClimateKeeperMode, v))
+}
+
+// ClimateKeeperModeHasPrefix applies the HasPrefix predicate on the "climate_keeper_mode" field.
+func ClimateKeeperModeHasPrefix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasPrefix(FieldClimateKeeperMode, v))
+}
+
+// ClimateKeeperModeHasSuffix applies the HasSuffix predicate on the "climate_keeper_mode" field.
+func ClimateKeeperModeHasSuffix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasSuffix(FieldClimateKeeperMode, v))
+}
+
+// ClimateKeeperModeIsNil applies the IsNil predicate on the "climate_keeper_mode" field.
+func ClimateKeeperModeIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldClimateKeeperMode))
+}
+
+// ClimateKeeperModeNotNil applies the NotNil predicate on the "climate_keeper_mode" field.
+func ClimateKeeperModeNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldClimateKeeperMode))
+}
+
+// ClimateKeeperModeEqualFold applies the EqualFold predicate on the "climate_keeper_mode" field.
+func ClimateKeeperModeEqualFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEqualFold(FieldClimateKeeperMode, v))
+}
+
+// ClimateKeeperModeContainsFold applies the ContainsFold predicate on the "climate_keeper_mode" field.
+func ClimateKeeperModeContainsFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContainsFold(FieldClimateKeeperMode, v))
+}
+
+// CabinOverheatCoolingEQ applies the EQ predicate on the "cabin_overheat_cooling" field.
+func CabinOverheatCoolingEQ(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldCabinOverheatCooling, v))
+}
+
+// CabinOverheatCoolingNEQ applies the NEQ predicate on the "cabin_overheat_cooling" field.
+func CabinOverheatCoolingNEQ(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldCabinOverheatCooling, v))
+}
+
+// SentryModeEQ applies the EQ predicate on the "sentry_mode" field.
+func SentryModeEQ(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldSentryMode, v))
+}
+
+// SentryModeNEQ applies the NEQ predicate on the "sentry_mode" field.
+func SentryModeNEQ(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldSentryMode, v))
+}
+
+// LockedEQ applies the EQ predicate on the "locked" field.
+func LockedEQ(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldLocked, v))
+}
+
+// LockedNEQ applies the NEQ predicate on the "locked" field.
+func LockedNEQ(v bool) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldLocked, v))
+}
+
+// OdometerEQ applies the EQ predicate on the "odometer" field.
+func OdometerEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldOdometer, v))
+}
+
+// OdometerNEQ applies the NEQ predicate on the "odometer" field.
+func OdometerNEQ(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldOdometer, v))
+}
+
+// OdometerIn applies the In predicate on the "odometer" field.
+func OdometerIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldOdometer, vs...))
+}
+
+// OdometerNotIn applies the NotIn predicate on the "odometer" field.
+func OdometerNotIn(vs ...float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldOdometer, vs...))
+}
+
+// OdometerGT applies the GT predicate on the "odometer" field.
+func OdometerGT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldOdometer, v))
+}
+
+// OdometerGTE applies the GTE predicate on the "odometer" field.
+func OdometerGTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldOdometer, v))
+}
+
+// OdometerLT applies the LT predicate on the "odometer" field.
+func OdometerLT(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldOdometer, v))
+}
+
+// OdometerLTE applies the LTE predicate on the "odometer" field.
+func OdometerLTE(v float64) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldOdometer, v))
+}
+
+// OdometerIsNil applies the IsNil predicate on the "odometer" field.
+func OdometerIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldOdometer))
+}
+
+// OdometerNotNil applies the NotNil predicate on the "odometer" field.
+func OdometerNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldOdometer))
+}
+
+// CarVersionEQ applies the EQ predicate on the "car_version" field.
+func CarVersionEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldCarVersion, v))
+}
+
+// CarVersionNEQ applies the NEQ predicate on the "car_version" field.
+func CarVersionNEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldCarVersion, v))
+}
+
+// CarVersionIn applies the In predicate on the "car_version" field.
+func CarVersionIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldCarVersion, vs...))
+}
+
+// CarVersionNotIn applies the NotIn predicate on the "car_version" field.
+func CarVersionNotIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldCarVersion, vs...))
+}
+
+// CarVersionGT applies the GT predicate on the "car_version" field.
+func CarVersionGT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldCarVersion, v))
+}
+
+// CarVersionGTE applies the GTE predicate on the "car_version" field.
+func CarVersionGTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldCarVersion, v))
+}
+
+// CarVersionLT applies the LT predicate on the "car_version" field.
+func CarVersionLT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldCarVersion, v))
+}
+
+// CarVersionLTE applies the LTE predicate on the "car_version" field.
+func CarVersionLTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldCarVersion, v))
+}
+
+// CarVersionContains applies the Contains predicate on the "car_version" field.
+func CarVersionContains(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContains(FieldCarVersion, v))
+}
+
+// CarVersionHasPrefix applies the HasPrefix predicate on the "car_version" field.
+func CarVersionHasPrefix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasPrefix(FieldCarVersion, v))
+}
+
+// CarVersionHasSuffix applies the HasSuffix predicate on the "car_version" field.
+func CarVersionHasSuffix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasSuffix(FieldCarVersion, v))
+}
+
+// CarVersionIsNil applies the IsNil predicate on the "car_version" field.
+func CarVersionIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldCarVersion))
+}
+
+// CarVersionNotNil applies the NotNil predicate on the "car_version" field.
+func CarVersionNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldCarVersion))
+}
+
+// CarVersionEqualFold applies the EqualFold predicate on the "car_version" field.
+func CarVersionEqualFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEqualFold(FieldCarVersion, v))
+}
+
+// CarVersionContainsFold applies the ContainsFold predicate on the "car_version" field.
+func CarVersionContainsFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContainsFold(FieldCarVersion, v))
+}
+
+// SoftwareUpdateStatusEQ applies the EQ predicate on the "software_update_status" field.
+func SoftwareUpdateStatusEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldSoftwareUpdateStatus, v))
+}
+
+// SoftwareUpdateStatusNEQ applies the NEQ predicate on the "software_update_status" field.
+func SoftwareUpdateStatusNEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldSoftwareUpdateStatus, v))
+}
+
+// SoftwareUpdateStatusIn applies the In predicate on the "software_update_status" field.
+func SoftwareUpdateStatusIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldSoftwareUpdateStatus, vs...))
+}
+
+// SoftwareUpdateStatusNotIn applies the NotIn predicate on the "software_update_status" field.
+func SoftwareUpdateStatusNotIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldSoftwareUpdateStatus, vs...))
+}
+
+// SoftwareUpdateStatusGT applies the GT predicate on the "software_update_status" field.
+func SoftwareUpdateStatusGT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldSoftwareUpdateStatus, v))
+}
+
+// SoftwareUpdateStatusGTE applies the GTE predicate on the "software_update_status" field.
+func SoftwareUpdateStatusGTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldSoftwareUpdateStatus, v))
+}
+
+// SoftwareUpdateStatusLT applies the LT predicate on the "software_update_status" field.
+func SoftwareUpdateStatusLT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldSoftwareUpdateStatus, v))
+}
+
+// SoftwareUpdateStatusLTE applies the LTE predicate on the "software_update_status" field.
+func SoftwareUpdateStatusLTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldSoftwareUpdateStatus, v))
+}
+
+// SoftwareUpdateStatusContains applies the Contains predicate on the "software_update_status" field.
+func SoftwareUpdateStatusContains(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContains(FieldSoftwareUpdateStatus, v))
+}
+
+// SoftwareUpdateStatusHasPrefix applies the HasPrefix predicate on the "software_update_status" field.
+func SoftwareUpdateStatusHasPrefix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasPrefix(FieldSoftwareUpdateStatus, v))
+}
+
+// SoftwareUpdateStatusHasSuffix applies the HasSuffix predicate on the "software_update_status" field.
+func SoftwareUpdateStatusHasSuffix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasSuffix(FieldSoftwareUpdateStatus, v))
+}
+
+// SoftwareUpdateStatusIsNil applies the IsNil predicate on the "software_update_status" field.
+func SoftwareUpdateStatusIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldSoftwareUpdateStatus))
+}
+
+// SoftwareUpdateStatusNotNil applies the NotNil predicate on the "software_update_status" field.
+func SoftwareUpdateStatusNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldSoftwareUpdateStatus))
+}
+
+// SoftwareUpdateStatusEqualFold applies the EqualFold predicate on the "software_update_status" field.
+func SoftwareUpdateStatusEqualFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEqualFold(FieldSoftwareUpdateStatus, v))
+}
+
+// SoftwareUpdateStatusContainsFold applies the ContainsFold predicate on the "software_update_status" field.
+func SoftwareUpdateStatusContainsFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContainsFold(FieldSoftwareUpdateStatus, v))
+}
+
+// SoftwareUpdateVersionEQ applies the EQ predicate on the "software_update_version" field.
+func SoftwareUpdateVersionEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldSoftwareUpdateVersion, v))
+}
+
+// SoftwareUpdateVersionNEQ applies the NEQ predicate on the "software_update_version" field.
+func SoftwareUpdateVersionNEQ(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldSoftwareUpdateVersion, v))
+}
+
+// SoftwareUpdateVersionIn applies the In predicate on the "software_update_version" field.
+func SoftwareUpdateVersionIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldSoftwareUpdateVersion, vs...))
+}
+
+// SoftwareUpdateVersionNotIn applies the NotIn predicate on the "software_update_version" field.
+func SoftwareUpdateVersionNotIn(vs ...string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldSoftwareUpdateVersion, vs...))
+}
+
+// SoftwareUpdateVersionGT applies the GT predicate on the "software_update_version" field.
+func SoftwareUpdateVersionGT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldSoftwareUpdateVersion, v))
+}
+
+// SoftwareUpdateVersionGTE applies the GTE predicate on the "software_update_version" field.
+func SoftwareUpdateVersionGTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldSoftwareUpdateVersion, v))
+}
+
+// SoftwareUpdateVersionLT applies the LT predicate on the "software_update_version" field.
+func SoftwareUpdateVersionLT(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldSoftwareUpdateVersion, v))
+}
+
+// SoftwareUpdateVersionLTE applies the LTE predicate on the "software_update_version" field.
+func SoftwareUpdateVersionLTE(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldSoftwareUpdateVersion, v))
+}
+
+// SoftwareUpdateVersionContains applies the Contains predicate on the "software_update_version" field.
+func SoftwareUpdateVersionContains(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContains(FieldSoftwareUpdateVersion, v))
+}
+
+// SoftwareUpdateVersionHasPrefix applies the HasPrefix predicate on the "software_update_version" field.
+func SoftwareUpdateVersionHasPrefix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasPrefix(FieldSoftwareUpdateVersion, v))
+}
+
+// SoftwareUpdateVersionHasSuffix applies the HasSuffix predicate on the "software_update_version" field.
+func SoftwareUpdateVersionHasSuffix(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldHasSuffix(FieldSoftwareUpdateVersion, v))
+}
+
+// SoftwareUpdateVersionIsNil applies the IsNil predicate on the "software_update_version" field.
+func SoftwareUpdateVersionIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldSoftwareUpdateVersion))
+}
+
+// SoftwareUpdateVersionNotNil applies the NotNil predicate on the "software_update_version" field.
+func SoftwareUpdateVersionNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldSoftwareUpdateVersion))
+}
+
+// SoftwareUpdateVersionEqualFold applies the EqualFold predicate on the "software_update_version" field.
+func SoftwareUpdateVersionEqualFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEqualFold(FieldSoftwareUpdateVersion, v))
+}
+
+// SoftwareUpdateVersionContainsFold applies the ContainsFold predicate on the "software_update_version" field.
+func SoftwareUpdateVersionContainsFold(v string) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldContainsFold(FieldSoftwareUpdateVersion, v))
+}
+
+// SoftwareUpdateDownloadPercEQ applies the EQ predicate on the "software_update_download_perc" field.
+func SoftwareUpdateDownloadPercEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldSoftwareUpdateDownloadPerc, v))
+}
+
+// SoftwareUpdateDownloadPercNEQ applies the NEQ predicate on the "software_update_download_perc" field.
+func SoftwareUpdateDownloadPercNEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldSoftwareUpdateDownloadPerc, v))
+}
+
+// SoftwareUpdateDownloadPercIn applies the In predicate on the "software_update_download_perc" field.
+func SoftwareUpdateDownloadPercIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldSoftwareUpdateDownloadPerc, vs...))
+}
+
+// SoftwareUpdateDownloadPercNotIn applies the NotIn predicate on the "software_update_download_perc" field.
+func SoftwareUpdateDownloadPercNotIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldSoftwareUpdateDownloadPerc, vs...))
+}
+
+// SoftwareUpdateDownloadPercGT applies the GT predicate on the "software_update_download_perc" field.
+func SoftwareUpdateDownloadPercGT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldSoftwareUpdateDownloadPerc, v))
+}
+
+// SoftwareUpdateDownloadPercGTE applies the GTE predicate on the "software_update_download_perc" field.
+func SoftwareUpdateDownloadPercGTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldSoftwareUpdateDownloadPerc, v))
+}
+
+// SoftwareUpdateDownloadPercLT applies the LT predicate on the "software_update_download_perc" field.
+func SoftwareUpdateDownloadPercLT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldSoftwareUpdateDownloadPerc, v))
+}
+
+// SoftwareUpdateDownloadPercLTE applies the LTE predicate on the "software_update_download_perc" field.
+func SoftwareUpdateDownloadPercLTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldSoftwareUpdateDownloadPerc, v))
+}
+
+// SoftwareUpdateDownloadPercIsNil applies the IsNil predicate on the "software_update_download_perc" field.
+func SoftwareUpdateDownloadPercIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldSoftwareUpdateDownloadPerc))
+}
+
+// SoftwareUpdateDownloadPercNotNil applies the NotNil predicate on the "software_update_download_perc" field.
+func SoftwareUpdateDownloadPercNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldSoftwareUpdateDownloadPerc))
+}
+
+// SoftwareUpdateInstallPercEQ applies the EQ predicate on the "software_update_install_perc" field.
+func SoftwareUpdateInstallPercEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldSoftwareUpdateInstallPerc, v))
+}
+
+// SoftwareUpdateInstallPercNEQ applies the NEQ predicate on the "software_update_install_perc" field.
+func SoftwareUpdateInstallPercNEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldSoftwareUpdateInstallPerc, v))
+}
+
+// SoftwareUpdateInstallPercIn applies the In predicate on the "software_update_install_perc" field.
+func SoftwareUpdateInstallPercIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldSoftwareUpdateInstallPerc, vs...))
+}
+
+// SoftwareUpdateInstallPercNotIn applies the NotIn predicate on the "software_update_install_perc" field.
+func SoftwareUpdateInstallPercNotIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldSoftwareUpdateInstallPerc, vs...))
+}
+
+// SoftwareUpdateInstallPercGT applies the GT predicate on the "software_update_install_perc" field.
+func SoftwareUpdateInstallPercGT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldSoftwareUpdateInstallPerc, v))
+}
+
+// SoftwareUpdateInstallPercGTE applies the GTE predicate on the "software_update_install_perc" field.
+func SoftwareUpdateInstallPercGTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldSoftwareUpdateInstallPerc, v))
+}
+
+// SoftwareUpdateInstallPercLT applies the LT predicate on the "software_update_install_perc" field.
+func SoftwareUpdateInstallPercLT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldSoftwareUpdateInstallPerc, v))
+}
+
+// SoftwareUpdateInstallPercLTE applies the LTE predicate on the "software_update_install_perc" field.
+func SoftwareUpdateInstallPercLTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldSoftwareUpdateInstallPerc, v))
+}
+
+// SoftwareUpdateInstallPercIsNil applies the IsNil predicate on the "software_update_install_perc" field.
+func SoftwareUpdateInstallPercIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldSoftwareUpdateInstallPerc))
+}
+
+// SoftwareUpdateInstallPercNotNil applies the NotNil predicate on the "software_update_install_perc" field.
+func SoftwareUpdateInstallPercNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldSoftwareUpdateInstallPerc))
+}
+
+// SoftwareUpdateExpectedDurationEQ applies the EQ predicate on the "software_update_expected_duration" field.
+func SoftwareUpdateExpectedDurationEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldSoftwareUpdateExpectedDuration, v))
+}
+
+// SoftwareUpdateExpectedDurationNEQ applies the NEQ predicate on the "software_update_expected_duration" field.
+func SoftwareUpdateExpectedDurationNEQ(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldSoftwareUpdateExpectedDuration, v))
+}
+
+// SoftwareUpdateExpectedDurationIn applies the In predicate on the "software_update_expected_duration" field.
+func SoftwareUpdateExpectedDurationIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldSoftwareUpdateExpectedDuration, vs...))
+}
+
+// SoftwareUpdateExpectedDurationNotIn applies the NotIn predicate on the "software_update_expected_duration" field.
+func SoftwareUpdateExpectedDurationNotIn(vs ...int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldSoftwareUpdateExpectedDuration, vs...))
+}
+
+// SoftwareUpdateExpectedDurationGT applies the GT predicate on the "software_update_expected_duration" field.
+func SoftwareUpdateExpectedDurationGT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldSoftwareUpdateExpectedDuration, v))
+}
+
+// SoftwareUpdateExpectedDurationGTE applies the GTE predicate on the "software_update_expected_duration" field.
+func SoftwareUpdateExpectedDurationGTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldSoftwareUpdateExpectedDuration, v))
+}
+
+// SoftwareUpdateExpectedDurationLT applies the LT predicate on the "software_update_expected_duration" field.
+func SoftwareUpdateExpectedDurationLT(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldSoftwareUpdateExpectedDuration, v))
+}
+
+// SoftwareUpdateExpectedDurationLTE applies the LTE predicate on the "software_update_expected_duration" field.
+func SoftwareUpdateExpectedDurationLTE(v int) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldSoftwareUpdateExpectedDuration, v))
+}
+
+// SoftwareUpdateExpectedDurationIsNil applies the IsNil predicate on the "software_update_expected_duration" field.
+func SoftwareUpdateExpectedDurationIsNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIsNull(FieldSoftwareUpdateExpectedDuration))
+}
+
+// SoftwareUpdateExpectedDurationNotNil applies the NotNil predicate on the "software_update_expected_duration" field.
+func SoftwareUpdateExpectedDurationNotNil() predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotNull(FieldSoftwareUpdateExpectedDuration))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VehicleSnapshot) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VehicleSnapshot) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VehicleSnapshot) predicate.VehicleSnapshot {
+	return predicate.VehicleSnapshot(sql.NotPredicates(p))
+}
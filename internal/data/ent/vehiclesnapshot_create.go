@@ -0,0 +1,882 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/vehiclesnapshot"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VehicleSnapshotCreate is the builder for creating a VehicleSnapshot entity.
+type VehicleSnapshotCreate struct {
+	config
+	mutation *VehicleSnapshotMutation
+	hooks    []Hook
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_c *VehicleSnapshotCreate) SetVehicleID(v int) *VehicleSnapshotCreate {
+	_c.mutation.SetVehicleID(v)
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *VehicleSnapshotCreate) SetState(v string) *VehicleSnapshotCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetShiftState sets the "shift_state" field.
+func (_c *VehicleSnapshotCreate) SetShiftState(v string) *VehicleSnapshotCreate {
+	_c.mutation.SetShiftState(v)
+	return _c
+}
+
+// SetNillableShiftState sets the "shift_state" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableShiftState(v *string) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetShiftState(*v)
+	}
+	return _c
+}
+
+// SetSpeed sets the "speed" field.
+func (_c *VehicleSnapshotCreate) SetSpeed(v float64) *VehicleSnapshotCreate {
+	_c.mutation.SetSpeed(v)
+	return _c
+}
+
+// SetNillableSpeed sets the "speed" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableSpeed(v *float64) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetSpeed(*v)
+	}
+	return _c
+}
+
+// SetPower sets the "power" field.
+func (_c *VehicleSnapshotCreate) SetPower(v int) *VehicleSnapshotCreate {
+	_c.mutation.SetPower(v)
+	return _c
+}
+
+// SetNillablePower sets the "power" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillablePower(v *int) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetPower(*v)
+	}
+	return _c
+}
+
+// SetLatitude sets the "latitude" field.
+func (_c *VehicleSnapshotCreate) SetLatitude(v float64) *VehicleSnapshotCreate {
+	_c.mutation.SetLatitude(v)
+	return _c
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableLatitude(v *float64) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetLatitude(*v)
+	}
+	return _c
+}
+
+// SetLongitude sets the "longitude" field.
+func (_c *VehicleSnapshotCreate) SetLongitude(v float64) *VehicleSnapshotCreate {
+	_c.mutation.SetLongitude(v)
+	return _c
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableLongitude(v *float64) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetLongitude(*v)
+	}
+	return _c
+}
+
+// SetCoordType sets the "coord_type" field.
+func (_c *VehicleSnapshotCreate) SetCoordType(v string) *VehicleSnapshotCreate {
+	_c.mutation.SetCoordType(v)
+	return _c
+}
+
+// SetNillableCoordType sets the "coord_type" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableCoordType(v *string) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetCoordType(*v)
+	}
+	return _c
+}
+
+// SetHeading sets the "heading" field.
+func (_c *VehicleSnapshotCreate) SetHeading(v int) *VehicleSnapshotCreate {
+	_c.mutation.SetHeading(v)
+	return _c
+}
+
+// SetNillableHeading sets the "heading" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableHeading(v *int) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetHeading(*v)
+	}
+	return _c
+}
+
+// SetBatteryLevel sets the "battery_level" field.
+func (_c *VehicleSnapshotCreate) SetBatteryLevel(v int) *VehicleSnapshotCreate {
+	_c.mutation.SetBatteryLevel(v)
+	return _c
+}
+
+// SetNillableBatteryLevel sets the "battery_level" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableBatteryLevel(v *int) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetBatteryLevel(*v)
+	}
+	return _c
+}
+
+// SetUsableBatteryLevel sets the "usable_battery_level" field.
+func (_c *VehicleSnapshotCreate) SetUsableBatteryLevel(v int) *VehicleSnapshotCreate {
+	_c.mutation.SetUsableBatteryLevel(v)
+	return _c
+}
+
+// SetNillableUsableBatteryLevel sets the "usable_battery_level" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableUsableBatteryLevel(v *int) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetUsableBatteryLevel(*v)
+	}
+	return _c
+}
+
+// SetBatteryRange sets the "battery_range" field.
+func (_c *VehicleSnapshotCreate) SetBatteryRange(v float64) *VehicleSnapshotCreate {
+	_c.mutation.SetBatteryRange(v)
+	return _c
+}
+
+// SetNillableBatteryRange sets the "battery_range" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableBatteryRange(v *float64) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetBatteryRange(*v)
+	}
+	return _c
+}
+
+// SetIdealBatteryRange sets the "ideal_battery_range" field.
+func (_c *VehicleSnapshotCreate) SetIdealBatteryRange(v float64) *VehicleSnapshotCreate {
+	_c.mutation.SetIdealBatteryRange(v)
+	return _c
+}
+
+// SetNillableIdealBatteryRange sets the "ideal_battery_range" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableIdealBatteryRange(v *float64) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetIdealBatteryRange(*v)
+	}
+	return _c
+}
+
+// SetEstBatteryRange sets the "est_battery_range" field.
+func (_c *VehicleSnapshotCreate) SetEstBatteryRange(v float64) *VehicleSnapshotCreate {
+	_c.mutation.SetEstBatteryRange(v)
+	return _c
+}
+
+// SetNillableEstBatteryRange sets the "est_battery_range" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableEstBatteryRange(v *float64) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetEstBatteryRange(*v)
+	}
+	return _c
+}
+
+// SetChargingState sets the "charging_state" field.
+func (_c *VehicleSnapshotCreate) SetChargingState(v string) *VehicleSnapshotCreate {
+	_c.mutation.SetChargingState(v)
+	return _c
+}
+
+// SetNillableChargingState sets the "charging_state" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableChargingState(v *string) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetChargingState(*v)
+	}
+	return _c
+}
+
+// SetChargerPower sets the "charger_power" field.
+func (_c *VehicleSnapshotCreate) SetChargerPower(v int) *VehicleSnapshotCreate {
+	_c.mutation.SetChargerPower(v)
+	return _c
+}
+
+// SetNillableChargerPower sets the "charger_power" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableChargerPower(v *int) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetChargerPower(*v)
+	}
+	return _c
+}
+
+// SetChargeEnergyAdded sets the "charge_energy_added" field.
+func (_c *VehicleSnapshotCreate) SetChargeEnergyAdded(v float64) *VehicleSnapshotCreate {
+	_c.mutation.SetChargeEnergyAdded(v)
+	return _c
+}
+
+// SetNillableChargeEnergyAdded sets the "charge_energy_added" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableChargeEnergyAdded(v *float64) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetChargeEnergyAdded(*v)
+	}
+	return _c
+}
+
+// SetChargerVoltage sets the "charger_voltage" field.
+func (_c *VehicleSnapshotCreate) SetChargerVoltage(v int) *VehicleSnapshotCreate {
+	_c.mutation.SetChargerVoltage(v)
+	return _c
+}
+
+// SetNillableChargerVoltage sets the "charger_voltage" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableChargerVoltage(v *int) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetChargerVoltage(*v)
+	}
+	return _c
+}
+
+// SetChargerActualCurrent sets the "charger_actual_current" field.
+func (_c *VehicleSnapshotCreate) SetChargerActualCurrent(v int) *VehicleSnapshotCreate {
+	_c.mutation.SetChargerActualCurrent(v)
+	return _c
+}
+
+// SetNillableChargerActualCurrent sets the "charger_actual_current" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableChargerActualCurrent(v *int) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetChargerActualCurrent(*v)
+	}
+	return _c
+}
+
+// SetFastChargerPresent sets the "fast_charger_present" field.
+func (_c *VehicleSnapshotCreate) SetFastChargerPresent(v bool) *VehicleSnapshotCreate {
+	_c.mutation.SetFastChargerPresent(v)
+	return _c
+}
+
+// SetNillableFastChargerPresent sets the "fast_charger_present" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableFastChargerPresent(v *bool) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetFastChargerPresent(*v)
+	}
+	return _c
+}
+
+// SetFastChargerType sets the "fast_charger_type" field.
+func (_c *VehicleSnapshotCreate) SetFastChargerType(v string) *VehicleSnapshotCreate {
+	_c.mutation.SetFastChargerType(v)
+	return _c
+}
+
+// SetNillableFastChargerType sets the "fast_charger_type" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableFastChargerType(v *string) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetFastChargerType(*v)
+	}
+	return _c
+}
+
+// SetBatteryHeaterOn sets the "battery_heater_on" field.
+func (_c *VehicleSnapshotCreate) SetBatteryHeaterOn(v bool) *VehicleSnapshotCreate {
+	_c.mutation.SetBatteryHeaterOn(v)
+	return _c
+}
+
+// SetNillableBatteryHeaterOn sets the "battery_heater_on" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableBatteryHeaterOn(v *bool) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetBatteryHeaterOn(*v)
+	}
+	return _c
+}
+
+// SetOutsideTemp sets the "outside_temp" field.
+func (_c *VehicleSnapshotCreate) SetOutsideTemp(v float64) *VehicleSnapshotCreate {
+	_c.mutation.SetOutsideTemp(v)
+	return _c
+}
+
+// SetNillableOutsideTemp sets the "outside_temp" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableOutsideTemp(v *float64) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetOutsideTemp(*v)
+	}
+	return _c
+}
+
+// SetInsideTemp sets the "inside_temp" field.
+func (_c *VehicleSnapshotCreate) SetInsideTemp(v float64) *VehicleSnapshotCreate {
+	_c.mutation.SetInsideTemp(v)
+	return _c
+}
+
+// SetNillableInsideTemp sets the "inside_temp" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableInsideTemp(v *float64) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetInsideTemp(*v)
+	}
+	return _c
+}
+
+// SetClimateOn sets the "climate_on" field.
+func (_c *VehicleSnapshotCreate) SetClimateOn(v bool) *VehicleSnapshotCreate {
+	_c.mutation.SetClimateOn(v)
+	return _c
+}
+
+// SetNillableClimateOn sets the "climate_on" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableClimateOn(v *bool) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetClimateOn(*v)
+	}
+	return _c
+}
+
+// SetClimateKeeperMode sets the "climate_keeper_mode" field.
+func (_c *VehicleSnapshotCreate) SetClimateKeeperMode(v string) *VehicleSnapshotCreate {
+	_c.mutation.SetClimateKeeperMode(v)
+	return _c
+}
+
+// SetNillableClimateKeeperMode sets the "climate_keeper_mode" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableClimateKeeperMode(v *string) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetClimateKeeperMode(*v)
+	}
+	return _c
+}
+
+// SetCabinOverheatCooling sets the "cabin_overheat_cooling" field.
+func (_c *VehicleSnapshotCreate) SetCabinOverheatCooling(v bool) *VehicleSnapshotCreate {
+	_c.mutation.SetCabinOverheatCooling(v)
+	return _c
+}
+
+// SetNillableCabinOverheatCooling sets the "cabin_overheat_cooling" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableCabinOverheatCooling(v *bool) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetCabinOverheatCooling(*v)
+	}
+	return _c
+}
+
+// SetSentryMode sets the "sentry_mode" field.
+func (_c *VehicleSnapshotCreate) SetSentryMode(v bool) *VehicleSnapshotCreate {
+	_c.mutation.SetSentryMode(v)
+	return _c
+}
+
+// SetNillableSentryMode sets the "sentry_mode" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableSentryMode(v *bool) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetSentryMode(*v)
+	}
+	return _c
+}
+
+// SetLocked sets the "locked" field.
+func (_c *VehicleSnapshotCreate) SetLocked(v bool) *VehicleSnapshotCreate {
+	_c.mutation.SetLocked(v)
+	return _c
+}
+
+// SetNillableLocked sets the "locked" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableLocked(v *bool) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetLocked(*v)
+	}
+	return _c
+}
+
+// SetOdometer sets the "odometer" field.
+func (_c *VehicleSnapshotCreate) SetOdometer(v float64) *VehicleSnapshotCreate {
+	_c.mutation.SetOdometer(v)
+	return _c
+}
+
+// SetNillableOdometer sets the "odometer" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableOdometer(v *float64) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetOdometer(*v)
+	}
+	return _c
+}
+
+// SetCarVersion sets the "car_version" field.
+func (_c *VehicleSnapshotCreate) SetCarVersion(v string) *VehicleSnapshotCreate {
+	_c.mutation.SetCarVersion(v)
+	return _c
+}
+
+// SetNillableCarVersion sets the "car_version" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableCarVersion(v *string) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetCarVersion(*v)
+	}
+	return _c
+}
+
+// SetSoftwareUpdateStatus sets the "software_update_status" field.
+func (_c *VehicleSnapshotCreate) SetSoftwareUpdateStatus(v string) *VehicleSnapshotCreate {
+	_c.mutation.SetSoftwareUpdateStatus(v)
+	return _c
+}
+
+// SetNillableSoftwareUpdateStatus sets the "software_update_status" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableSoftwareUpdateStatus(v *string) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetSoftwareUpdateStatus(*v)
+	}
+	return _c
+}
+
+// SetSoftwareUpdateVersion sets the "software_update_version" field.
+func (_c *VehicleSnapshotCreate) SetSoftwareUpdateVersion(v string) *VehicleSnapshotCreate {
+	_c.mutation.SetSoftwareUpdateVersion(v)
+	return _c
+}
+
+// SetNillableSoftwareUpdateVersion sets the "software_update_version" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableSoftwareUpdateVersion(v *string) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetSoftwareUpdateVersion(*v)
+	}
+	return _c
+}
+
+// SetSoftwareUpdateDownloadPerc sets the "software_update_download_perc" field.
+func (_c *VehicleSnapshotCreate) SetSoftwareUpdateDownloadPerc(v int) *VehicleSnapshotCreate {
+	_c.mutation.SetSoftwareUpdateDownloadPerc(v)
+	return _c
+}
+
+// SetNillableSoftwareUpdateDownloadPerc sets the "software_update_download_perc" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableSoftwareUpdateDownloadPerc(v *int) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetSoftwareUpdateDownloadPerc(*v)
+	}
+	return _c
+}
+
+// SetSoftwareUpdateInstallPerc sets the "software_update_install_perc" field.
+func (_c *VehicleSnapshotCreate) SetSoftwareUpdateInstallPerc(v int) *VehicleSnapshotCreate {
+	_c.mutation.SetSoftwareUpdateInstallPerc(v)
+	return _c
+}
+
+// SetNillableSoftwareUpdateInstallPerc sets the "software_update_install_perc" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableSoftwareUpdateInstallPerc(v *int) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetSoftwareUpdateInstallPerc(*v)
+	}
+	return _c
+}
+
+// SetSoftwareUpdateExpectedDuration sets the "software_update_expected_duration" field.
+func (_c *VehicleSnapshotCreate) SetSoftwareUpdateExpectedDuration(v int) *VehicleSnapshotCreate {
+	_c.mutation.SetSoftwareUpdateExpectedDuration(v)
+	return _c
+}
+
+// SetNillableSoftwareUpdateExpectedDuration sets the "software_update_expected_duration" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableSoftwareUpdateExpectedDuration(v *int) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetSoftwareUpdateExpectedDuration(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VehicleSnapshotCreate) SetCreatedAt(v time.Time) *VehicleSnapshotCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VehicleSnapshotCreate) SetNillableCreatedAt(v *time.Time) *VehicleSnapshotCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the VehicleSnapshotMutation object of the builder.
+func (_c *VehicleSnapshotCreate) Mutation() *VehicleSnapshotMutation {
+	return _c.mutation
+}
+
+// Save creates the VehicleSnapshot in the database.
+func (_c *VehicleSnapshotCreate) Save(ctx context.Context) (*VehicleSnapshot, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VehicleSnapshotCreate) SaveX(ctx context.Context) *VehicleSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VehicleSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VehicleSnapshotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VehicleSnapshotCreate) defaults() {
+	if _, ok := _c.mutation.FastChargerPresent(); !ok {
+		v := vehiclesnapshot.DefaultFastChargerPresent
+		_c.mutation.SetFastChargerPresent(v)
+	}
+	if _, ok := _c.mutation.BatteryHeaterOn(); !ok {
+		v := vehiclesnapshot.DefaultBatteryHeaterOn
+		_c.mutation.SetBatteryHeaterOn(v)
+	}
+	if _, ok := _c.mutation.ClimateOn(); !ok {
+		v := vehiclesnapshot.DefaultClimateOn
+		_c.mutation.SetClimateOn(v)
+	}
+	if _, ok := _c.mutation.CabinOverheatCooling(); !ok {
+		v := vehiclesnapshot.DefaultCabinOverheatCooling
+		_c.mutation.SetCabinOverheatCooling(v)
+	}
+	if _, ok := _c.mutation.SentryMode(); !ok {
+		v := vehiclesnapshot.DefaultSentryMode
+		_c.mutation.SetSentryMode(v)
+	}
+	if _, ok := _c.mutation.Locked(); !ok {
+		v := vehiclesnapshot.DefaultLocked
+		_c.mutation.SetLocked(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := vehiclesnapshot.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VehicleSnapshotCreate) check() error {
+	if _, ok := _c.mutation.VehicleID(); !ok {
+		return &ValidationError{Name: "vehicle_id", err: errors.New(`ent: missing required field "VehicleSnapshot.vehicle_id"`)}
+	}
+	if _, ok := _c.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "VehicleSnapshot.state"`)}
+	}
+	if _, ok := _c.mutation.FastChargerPresent(); !ok {
+		return &ValidationError{Name: "fast_charger_present", err: errors.New(`ent: missing required field "VehicleSnapshot.fast_charger_present"`)}
+	}
+	if _, ok := _c.mutation.BatteryHeaterOn(); !ok {
+		return &ValidationError{Name: "battery_heater_on", err: errors.New(`ent: missing required field "VehicleSnapshot.battery_heater_on"`)}
+	}
+	if _, ok := _c.mutation.ClimateOn(); !ok {
+		return &ValidationError{Name: "climate_on", err: errors.New(`ent: missing required field "VehicleSnapshot.climate_on"`)}
+	}
+	if _, ok := _c.mutation.CabinOverheatCooling(); !ok {
+		return &ValidationError{Name: "cabin_overheat_cooling", err: errors.New(`ent: missing required field "VehicleSnapshot.cabin_overheat_cooling"`)}
+	}
+	if _, ok := _c.mutation.SentryMode(); !ok {
+		return &ValidationError{Name: "sentry_mode", err: errors.New(`ent: missing required field "VehicleSnapshot.sentry_mode"`)}
+	}
+	if _, ok := _c.mutation.Locked(); !ok {
+		return &ValidationError{Name: "locked", err: errors.New(`ent: missing required field "VehicleSnapshot.locked"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VehicleSnapshot.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *VehicleSnapshotCreate) sqlSave(ctx context.Context) (*VehicleSnapshot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VehicleSnapshotCreate) createSpec() (*VehicleSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VehicleSnapshot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(vehiclesnapshot.Table, sqlgraph.NewFieldSpec(vehiclesnapshot.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.VehicleID(); ok {
+		_spec.SetField(vehiclesnapshot.FieldVehicleID, field.TypeInt, value)
+		_node.VehicleID = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(vehiclesnapshot.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.ShiftState(); ok {
+		_spec.SetField(vehiclesnapshot.FieldShiftState, field.TypeString, value)
+		_node.ShiftState = value
+	}
+	if value, ok := _c.mutation.Speed(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSpeed, field.TypeFloat64, value)
+		_node.Speed = value
+	}
+	if value, ok := _c.mutation.Power(); ok {
+		_spec.SetField(vehiclesnapshot.FieldPower, field.TypeInt, value)
+		_node.Power = value
+	}
+	if value, ok := _c.mutation.Latitude(); ok {
+		_spec.SetField(vehiclesnapshot.FieldLatitude, field.TypeFloat64, value)
+		_node.Latitude = value
+	}
+	if value, ok := _c.mutation.Longitude(); ok {
+		_spec.SetField(vehiclesnapshot.FieldLongitude, field.TypeFloat64, value)
+		_node.Longitude = value
+	}
+	if value, ok := _c.mutation.CoordType(); ok {
+		_spec.SetField(vehiclesnapshot.FieldCoordType, field.TypeString, value)
+		_node.CoordType = value
+	}
+	if value, ok := _c.mutation.Heading(); ok {
+		_spec.SetField(vehiclesnapshot.FieldHeading, field.TypeInt, value)
+		_node.Heading = value
+	}
+	if value, ok := _c.mutation.BatteryLevel(); ok {
+		_spec.SetField(vehiclesnapshot.FieldBatteryLevel, field.TypeInt, value)
+		_node.BatteryLevel = value
+	}
+	if value, ok := _c.mutation.UsableBatteryLevel(); ok {
+		_spec.SetField(vehiclesnapshot.FieldUsableBatteryLevel, field.TypeInt, value)
+		_node.UsableBatteryLevel = value
+	}
+	if value, ok := _c.mutation.BatteryRange(); ok {
+		_spec.SetField(vehiclesnapshot.FieldBatteryRange, field.TypeFloat64, value)
+		_node.BatteryRange = value
+	}
+	if value, ok := _c.mutation.IdealBatteryRange(); ok {
+		_spec.SetField(vehiclesnapshot.FieldIdealBatteryRange, field.TypeFloat64, value)
+		_node.IdealBatteryRange = value
+	}
+	if value, ok := _c.mutation.EstBatteryRange(); ok {
+		_spec.SetField(vehiclesnapshot.FieldEstBatteryRange, field.TypeFloat64, value)
+		_node.EstBatteryRange = value
+	}
+	if value, ok := _c.mutation.ChargingState(); ok {
+		_spec.SetField(vehiclesnapshot.FieldChargingState, field.TypeString, value)
+		_node.ChargingState = value
+	}
+	if value, ok := _c.mutation.ChargerPower(); ok {
+		_spec.SetField(vehiclesnapshot.FieldChargerPower, field.TypeInt, value)
+		_node.ChargerPower = value
+	}
+	if value, ok := _c.mutation.ChargeEnergyAdded(); ok {
+		_spec.SetField(vehiclesnapshot.FieldChargeEnergyAdded, field.TypeFloat64, value)
+		_node.ChargeEnergyAdded = value
+	}
+	if value, ok := _c.mutation.ChargerVoltage(); ok {
+		_spec.SetField(vehiclesnapshot.FieldChargerVoltage, field.TypeInt, value)
+		_node.ChargerVoltage = value
+	}
+	if value, ok := _c.mutation.ChargerActualCurrent(); ok {
+		_spec.SetField(vehiclesnapshot.FieldChargerActualCurrent, field.TypeInt, value)
+		_node.ChargerActualCurrent = value
+	}
+	if value, ok := _c.mutation.FastChargerPresent(); ok {
+		_spec.SetField(vehiclesnapshot.FieldFastChargerPresent, field.TypeBool, value)
+		_node.FastChargerPresent = value
+	}
+	if value, ok := _c.mutation.FastChargerType(); ok {
+		_spec.SetField(vehiclesnapshot.FieldFastChargerType, field.TypeString, value)
+		_node.FastChargerType = value
+	}
+	if value, ok := _c.mutation.BatteryHeaterOn(); ok {
+		_spec.SetField(vehiclesnapshot.FieldBatteryHeaterOn, field.TypeBool, value)
+		_node.BatteryHeaterOn = value
+	}
+	if value, ok := _c.mutation.OutsideTemp(); ok {
+		_spec.SetField(vehiclesnapshot.FieldOutsideTemp, field.TypeFloat64, value)
+		_node.OutsideTemp = value
+	}
+	if value, ok := _c.mutation.InsideTemp(); ok {
+		_spec.SetField(vehiclesnapshot.FieldInsideTemp, field.TypeFloat64, value)
+		_node.InsideTemp = value
+	}
+	if value, ok := _c.mutation.ClimateOn(); ok {
+		_spec.SetField(vehiclesnapshot.FieldClimateOn, field.TypeBool, value)
+		_node.ClimateOn = value
+	}
+	if value, ok := _c.mutation.ClimateKeeperMode(); ok {
+		_spec.SetField(vehiclesnapshot.FieldClimateKeeperMode, field.TypeString, value)
+		_node.ClimateKeeperMode = value
+	}
+	if value, ok := _c.mutation.CabinOverheatCooling(); ok {
+		_spec.SetField(vehiclesnapshot.FieldCabinOverheatCooling, field.TypeBool, value)
+		_node.CabinOverheatCooling = value
+	}
+	if value, ok := _c.mutation.SentryMode(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSentryMode, field.TypeBool, value)
+		_node.SentryMode = value
+	}
+	if value, ok := _c.mutation.Locked(); ok {
+		_spec.SetField(vehiclesnapshot.FieldLocked, field.TypeBool, value)
+		_node.Locked = value
+	}
+	if value, ok := _c.mutation.Odometer(); ok {
+		_spec.SetField(vehiclesnapshot.FieldOdometer, field.TypeFloat64, value)
+		_node.Odometer = value
+	}
+	if value, ok := _c.mutation.CarVersion(); ok {
+		_spec.SetField(vehiclesnapshot.FieldCarVersion, field.TypeString, value)
+		_node.CarVersion = value
+	}
+	if value, ok := _c.mutation.SoftwareUpdateStatus(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSoftwareUpdateStatus, field.TypeString, value)
+		_node.SoftwareUpdateStatus = value
+	}
+	if value, ok := _c.mutation.SoftwareUpdateVersion(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSoftwareUpdateVersion, field.TypeString, value)
+		_node.SoftwareUpdateVersion = value
+	}
+	if value, ok := _c.mutation.SoftwareUpdateDownloadPerc(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSoftwareUpdateDownloadPerc, field.TypeInt, value)
+		_node.SoftwareUpdateDownloadPerc = value
+	}
+	if value, ok := _c.mutation.SoftwareUpdateInstallPerc(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSoftwareUpdateInstallPerc, field.TypeInt, value)
+		_node.SoftwareUpdateInstallPerc = value
+	}
+	if value, ok := _c.mutation.SoftwareUpdateExpectedDuration(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSoftwareUpdateExpectedDuration, field.TypeInt, value)
+		_node.SoftwareUpdateExpectedDuration = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(vehiclesnapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// VehicleSnapshotCreateBulk is the builder for creating many VehicleSnapshot entities in bulk.
+type VehicleSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*VehicleSnapshotCreate
+}
+
+// Save creates the VehicleSnapshot entities in the database.
+func (_c *VehicleSnapshotCreateBulk) Save(ctx context.Context) ([]*VehicleSnapshot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*VehicleSnapshot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VehicleSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VehicleSnapshotCreateBulk) SaveX(ctx context.Context) []*VehicleSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VehicleSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VehicleSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"teslatrack/internal/data/ent/predicate"
+	"teslatrack/internal/data/ent/vehiclesnapshot"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VehicleSnapshotQuery is the builder for querying VehicleSnapshot entities.
+type VehicleSnapshotQuery struct {
+	config
+	ctx        *QueryContext
+	order      []vehiclesnapshot.OrderOption
+	inters     []Interceptor
+	predicates []predicate.VehicleSnapshot
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the VehicleSnapshotQuery builder.
+func (_q *VehicleSnapshotQuery) Where(ps ...predicate.VehicleSnapshot) *VehicleSnapshotQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *VehicleSnapshotQuery) Limit(limit int) *VehicleSnapshotQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *VehicleSnapshotQuery) Offset(offset int) *VehicleSnapshotQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *VehicleSnapshotQuery) Unique(unique bool) *VehicleSnapshotQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *VehicleSnapshotQuery) Order(o ...vehiclesnapshot.OrderOption) *VehicleSnapshotQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// First returns the first VehicleSnapshot entity from the query.
+// Returns a *NotFoundError when no VehicleSnapshot was found.
+func (_q *VehicleSnapshotQuery) First(ctx context.Context) (*VehicleSnapshot, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{vehiclesnapshot.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *VehicleSnapshotQuery) FirstX(ctx context.Context) *VehicleSnapshot {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first VehicleSnapshot ID from the query.
+// Returns a *NotFoundError when no VehicleSnapshot ID was found.
+func (_q *VehicleSnapshotQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{vehiclesnapshot.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *VehicleSnapshotQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single VehicleSnapshot entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one VehicleSnapshot entity is found.
+// Returns a *NotFoundError when no VehicleSnapshot entities are found.
+func (_q *VehicleSnapshotQuery) Only(ctx context.Context) (*VehicleSnapshot, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{vehiclesnapshot.Label}
+	default:
+		return nil, &NotSingularError{vehiclesnapshot.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *VehicleSnapshotQuery) OnlyX(ctx context.Context) *VehicleSnapshot {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only VehicleSnapshot ID in the query.
+// Returns a *NotSingularError when more than one VehicleSnapshot ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *VehicleSnapshotQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{vehiclesnapshot.Label}
+	default:
+		err = &NotSingularError{vehiclesnapshot.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *VehicleSnapshotQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of VehicleSnapshots.
+func (_q *VehicleSnapshotQuery) All(ctx context.Context) ([]*VehicleSnapshot, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*VehicleSnapshot, *VehicleSnapshotQuery]()
+	return withInterceptors[[]*VehicleSnapshot](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *VehicleSnapshotQuery) AllX(ctx context.Context) []*VehicleSnapshot {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of VehicleSnapshot IDs.
+func (_q *VehicleSnapshotQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(vehiclesnapshot.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *VehicleSnapshotQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *VehicleSnapshotQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*VehicleSnapshotQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *VehicleSnapshotQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *VehicleSnapshotQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *VehicleSnapshotQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the VehicleSnapshotQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *VehicleSnapshotQuery) Clone() *VehicleSnapshotQuery {
+	if _q == nil {
+		return nil
+	}
+	return &VehicleSnapshotQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]vehiclesnapshot.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.VehicleSnapshot{}, _q.predicates...),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		VehicleID int `json:"vehicle_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.VehicleSnapshot.Query().
+//		GroupBy(vehiclesnapshot.FieldVehicleID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *VehicleSnapshotQuery) GroupBy(field string, fields ...string) *VehicleSnapshotGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &VehicleSnapshotGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = vehiclesnapshot.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		VehicleID int `json:"vehicle_id,omitempty"`
+//	}
+//
+//	client.VehicleSnapshot.Query().
+//		Select(vehiclesnapshot.FieldVehicleID).
+//		Scan(ctx, &v)
+func (_q *VehicleSnapshotQuery) Select(fields ...string) *VehicleSnapshotSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &VehicleSnapshotSelect{VehicleSnapshotQuery: _q}
+	sbuild.label = vehiclesnapshot.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a VehicleSnapshotSelect configured with the given aggregations.
+func (_q *VehicleSnapshotQuery) Aggregate(fns ...AggregateFunc) *VehicleSnapshotSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *VehicleSnapshotQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !vehiclesnapshot.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *VehicleSnapshotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*VehicleSnapshot, error) {
+	var (
+		nodes = []*VehicleSnapshot{}
+		_spec = _q.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*VehicleSnapshot).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &VehicleSnapshot{config: _q.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (_q *VehicleSnapshotQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *VehicleSnapshotQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(vehiclesnapshot.Table, vehiclesnapshot.Columns, sqlgraph.NewFieldSpec(vehiclesnapshot.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vehiclesnapshot.FieldID)
+		for i := range fields {
+			if fields[i] != vehiclesnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *VehicleSnapshotQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(vehiclesnapshot.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = vehiclesnapshot.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// VehicleSnapshotGroupBy is the group-by builder for VehicleSnapshot entities.
+type VehicleSnapshotGroupBy struct {
+	selector
+	build *VehicleSnapshotQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *VehicleSnapshotGroupBy) Aggregate(fns ...AggregateFunc) *VehicleSnapshotGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *VehicleSnapshotGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VehicleSnapshotQuery, *VehicleSnapshotGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *VehicleSnapshotGroupBy) sqlScan(ctx context.Context, root *VehicleSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// VehicleSnapshotSelect is the builder for selecting fields of VehicleSnapshot entities.
+type VehicleSnapshotSelect struct {
+	*VehicleSnapshotQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *VehicleSnapshotSelect) Aggregate(fns ...AggregateFunc) *VehicleSnapshotSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *VehicleSnapshotSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*VehicleSnapshotQuery, *VehicleSnapshotSelect](ctx, _s.VehicleSnapshotQuery, _s, _s.inters, v)
+}
+
+func (_s *VehicleSnapshotSelect) sqlScan(ctx context.Context, root *VehicleSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
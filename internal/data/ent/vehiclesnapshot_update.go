@@ -0,0 +1,2323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/predicate"
+	"teslatrack/internal/data/ent/vehiclesnapshot"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VehicleSnapshotUpdate is the builder for updating VehicleSnapshot entities.
+type VehicleSnapshotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VehicleSnapshotMutation
+}
+
+// Where appends a list predicates to the VehicleSnapshotUpdate builder.
+func (_u *VehicleSnapshotUpdate) Where(ps ...predicate.VehicleSnapshot) *VehicleSnapshotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_u *VehicleSnapshotUpdate) SetVehicleID(v int) *VehicleSnapshotUpdate {
+	_u.mutation.ResetVehicleID()
+	_u.mutation.SetVehicleID(v)
+	return _u
+}
+
+// SetNillableVehicleID sets the "vehicle_id" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableVehicleID(v *int) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetVehicleID(*v)
+	}
+	return _u
+}
+
+// AddVehicleID adds value to the "vehicle_id" field.
+func (_u *VehicleSnapshotUpdate) AddVehicleID(v int) *VehicleSnapshotUpdate {
+	_u.mutation.AddVehicleID(v)
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *VehicleSnapshotUpdate) SetState(v string) *VehicleSnapshotUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableState(v *string) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetShiftState sets the "shift_state" field.
+func (_u *VehicleSnapshotUpdate) SetShiftState(v string) *VehicleSnapshotUpdate {
+	_u.mutation.SetShiftState(v)
+	return _u
+}
+
+// SetNillableShiftState sets the "shift_state" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableShiftState(v *string) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetShiftState(*v)
+	}
+	return _u
+}
+
+// ClearShiftState clears the value of the "shift_state" field.
+func (_u *VehicleSnapshotUpdate) ClearShiftState() *VehicleSnapshotUpdate {
+	_u.mutation.ClearShiftState()
+	return _u
+}
+
+// SetSpeed sets the "speed" field.
+func (_u *VehicleSnapshotUpdate) SetSpeed(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.ResetSpeed()
+	_u.mutation.SetSpeed(v)
+	return _u
+}
+
+// SetNillableSpeed sets the "speed" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableSpeed(v *float64) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetSpeed(*v)
+	}
+	return _u
+}
+
+// AddSpeed adds value to the "speed" field.
+func (_u *VehicleSnapshotUpdate) AddSpeed(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.AddSpeed(v)
+	return _u
+}
+
+// ClearSpeed clears the value of the "speed" field.
+func (_u *VehicleSnapshotUpdate) ClearSpeed() *VehicleSnapshotUpdate {
+	_u.mutation.ClearSpeed()
+	return _u
+}
+
+// SetPower sets the "power" field.
+func (_u *VehicleSnapshotUpdate) SetPower(v int) *VehicleSnapshotUpdate {
+	_u.mutation.ResetPower()
+	_u.mutation.SetPower(v)
+	return _u
+}
+
+// SetNillablePower sets the "power" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillablePower(v *int) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetPower(*v)
+	}
+	return _u
+}
+
+// AddPower adds value to the "power" field.
+func (_u *VehicleSnapshotUpdate) AddPower(v int) *VehicleSnapshotUpdate {
+	_u.mutation.AddPower(v)
+	return _u
+}
+
+// ClearPower clears the value of the "power" field.
+func (_u *VehicleSnapshotUpdate) ClearPower() *VehicleSnapshotUpdate {
+	_u.mutation.ClearPower()
+	return _u
+}
+
+// SetLatitude sets the "latitude" field.
+func (_u *VehicleSnapshotUpdate) SetLatitude(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableLatitude(v *float64) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *VehicleSnapshotUpdate) AddLatitude(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (_u *VehicleSnapshotUpdate) ClearLatitude() *VehicleSnapshotUpdate {
+	_u.mutation.ClearLatitude()
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *VehicleSnapshotUpdate) SetLongitude(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableLongitude(v *float64) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *VehicleSnapshotUpdate) AddLongitude(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (_u *VehicleSnapshotUpdate) ClearLongitude() *VehicleSnapshotUpdate {
+	_u.mutation.ClearLongitude()
+	return _u
+}
+
+// SetCoordType sets the "coord_type" field.
+func (_u *VehicleSnapshotUpdate) SetCoordType(v string) *VehicleSnapshotUpdate {
+	_u.mutation.SetCoordType(v)
+	return _u
+}
+
+// SetNillableCoordType sets the "coord_type" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableCoordType(v *string) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetCoordType(*v)
+	}
+	return _u
+}
+
+// ClearCoordType clears the value of the "coord_type" field.
+func (_u *VehicleSnapshotUpdate) ClearCoordType() *VehicleSnapshotUpdate {
+	_u.mutation.ClearCoordType()
+	return _u
+}
+
+// SetHeading sets the "heading" field.
+func (_u *VehicleSnapshotUpdate) SetHeading(v int) *VehicleSnapshotUpdate {
+	_u.mutation.ResetHeading()
+	_u.mutation.SetHeading(v)
+	return _u
+}
+
+// SetNillableHeading sets the "heading" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableHeading(v *int) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetHeading(*v)
+	}
+	return _u
+}
+
+// AddHeading adds value to the "heading" field.
+func (_u *VehicleSnapshotUpdate) AddHeading(v int) *VehicleSnapshotUpdate {
+	_u.mutation.AddHeading(v)
+	return _u
+}
+
+// ClearHeading clears the value of the "heading" field.
+func (_u *VehicleSnapshotUpdate) ClearHeading() *VehicleSnapshotUpdate {
+	_u.mutation.ClearHeading()
+	return _u
+}
+
+// SetBatteryLevel sets the "battery_level" field.
+func (_u *VehicleSnapshotUpdate) SetBatteryLevel(v int) *VehicleSnapshotUpdate {
+	_u.mutation.ResetBatteryLevel()
+	_u.mutation.SetBatteryLevel(v)
+	return _u
+}
+
+// SetNillableBatteryLevel sets the "battery_level" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableBatteryLevel(v *int) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetBatteryLevel(*v)
+	}
+	return _u
+}
+
+// AddBatteryLevel adds value to the "battery_level" field.
+func (_u *VehicleSnapshotUpdate) AddBatteryLevel(v int) *VehicleSnapshotUpdate {
+	_u.mutation.AddBatteryLevel(v)
+	return _u
+}
+
+// ClearBatteryLevel clears the value of the "battery_level" field.
+func (_u *VehicleSnapshotUpdate) ClearBatteryLevel() *VehicleSnapshotUpdate {
+	_u.mutation.ClearBatteryLevel()
+	return _u
+}
+
+// SetUsableBatteryLevel sets the "usable_battery_level" field.
+func (_u *VehicleSnapshotUpdate) SetUsableBatteryLevel(v int) *VehicleSnapshotUpdate {
+	_u.mutation.ResetUsableBatteryLevel()
+	_u.mutation.SetUsableBatteryLevel(v)
+	return _u
+}
+
+// SetNillableUsableBatteryLevel sets the "usable_battery_level" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableUsableBatteryLevel(v *int) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetUsableBatteryLevel(*v)
+	}
+	return _u
+}
+
+// AddUsableBatteryLevel adds value to the "usable_battery_level" field.
+func (_u *VehicleSnapshotUpdate) AddUsableBatteryLevel(v int) *VehicleSnapshotUpdate {
+	_u.mutation.AddUsableBatteryLevel(v)
+	return _u
+}
+
+// ClearUsableBatteryLevel clears the value of the "usable_battery_level" field.
+func (_u *VehicleSnapshotUpdate) ClearUsableBatteryLevel() *VehicleSnapshotUpdate {
+	_u.mutation.ClearUsableBatteryLevel()
+	return _u
+}
+
+// SetBatteryRange sets the "battery_range" field.
+func (_u *VehicleSnapshotUpdate) SetBatteryRange(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.ResetBatteryRange()
+	_u.mutation.SetBatteryRange(v)
+	return _u
+}
+
+// SetNillableBatteryRange sets the "battery_range" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableBatteryRange(v *float64) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetBatteryRange(*v)
+	}
+	return _u
+}
+
+// AddBatteryRange adds value to the "battery_range" field.
+func (_u *VehicleSnapshotUpdate) AddBatteryRange(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.AddBatteryRange(v)
+	return _u
+}
+
+// ClearBatteryRange clears the value of the "battery_range" field.
+func (_u *VehicleSnapshotUpdate) ClearBatteryRange() *VehicleSnapshotUpdate {
+	_u.mutation.ClearBatteryRange()
+	return _u
+}
+
+// SetIdealBatteryRange sets the "ideal_battery_range" field.
+func (_u *VehicleSnapshotUpdate) SetIdealBatteryRange(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.ResetIdealBatteryRange()
+	_u.mutation.SetIdealBatteryRange(v)
+	return _u
+}
+
+// SetNillableIdealBatteryRange sets the "ideal_battery_range" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableIdealBatteryRange(v *float64) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetIdealBatteryRange(*v)
+	}
+	return _u
+}
+
+// AddIdealBatteryRange adds value to the "ideal_battery_range" field.
+func (_u *VehicleSnapshotUpdate) AddIdealBatteryRange(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.AddIdealBatteryRange(v)
+	return _u
+}
+
+// ClearIdealBatteryRange clears the value of the "ideal_battery_range" field.
+func (_u *VehicleSnapshotUpdate) ClearIdealBatteryRange() *VehicleSnapshotUpdate {
+	_u.mutation.ClearIdealBatteryRange()
+	return _u
+}
+
+// SetEstBatteryRange sets the "est_battery_range" field.
+func (_u *VehicleSnapshotUpdate) SetEstBatteryRange(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.ResetEstBatteryRange()
+	_u.mutation.SetEstBatteryRange(v)
+	return _u
+}
+
+// SetNillableEstBatteryRange sets the "est_battery_range" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableEstBatteryRange(v *float64) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetEstBatteryRange(*v)
+	}
+	return _u
+}
+
+// AddEstBatteryRange adds value to the "est_battery_range" field.
+func (_u *VehicleSnapshotUpdate) AddEstBatteryRange(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.AddEstBatteryRange(v)
+	return _u
+}
+
+// ClearEstBatteryRange clears the value of the "est_battery_range" field.
+func (_u *VehicleSnapshotUpdate) ClearEstBatteryRange() *VehicleSnapshotUpdate {
+	_u.mutation.ClearEstBatteryRange()
+	return _u
+}
+
+// SetChargingState sets the "charging_state" field.
+func (_u *VehicleSnapshotUpdate) SetChargingState(v string) *VehicleSnapshotUpdate {
+	_u.mutation.SetChargingState(v)
+	return _u
+}
+
+// SetNillableChargingState sets the "charging_state" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableChargingState(v *string) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetChargingState(*v)
+	}
+	return _u
+}
+
+// ClearChargingState clears the value of the "charging_state" field.
+func (_u *VehicleSnapshotUpdate) ClearChargingState() *VehicleSnapshotUpdate {
+	_u.mutation.ClearChargingState()
+	return _u
+}
+
+// SetChargerPower sets the "charger_power" field.
+func (_u *VehicleSnapshotUpdate) SetChargerPower(v int) *VehicleSnapshotUpdate {
+	_u.mutation.ResetChargerPower()
+	_u.mutation.SetChargerPower(v)
+	return _u
+}
+
+// SetNillableChargerPower sets the "charger_power" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableChargerPower(v *int) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetChargerPower(*v)
+	}
+	return _u
+}
+
+// AddChargerPower adds value to the "charger_power" field.
+func (_u *VehicleSnapshotUpdate) AddChargerPower(v int) *VehicleSnapshotUpdate {
+	_u.mutation.AddChargerPower(v)
+	return _u
+}
+
+// ClearChargerPower clears the value of the "charger_power" field.
+func (_u *VehicleSnapshotUpdate) ClearChargerPower() *VehicleSnapshotUpdate {
+	_u.mutation.ClearChargerPower()
+	return _u
+}
+
+// SetChargeEnergyAdded sets the "charge_energy_added" field.
+func (_u *VehicleSnapshotUpdate) SetChargeEnergyAdded(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.ResetChargeEnergyAdded()
+	_u.mutation.SetChargeEnergyAdded(v)
+	return _u
+}
+
+// SetNillableChargeEnergyAdded sets the "charge_energy_added" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableChargeEnergyAdded(v *float64) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetChargeEnergyAdded(*v)
+	}
+	return _u
+}
+
+// AddChargeEnergyAdded adds value to the "charge_energy_added" field.
+func (_u *VehicleSnapshotUpdate) AddChargeEnergyAdded(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.AddChargeEnergyAdded(v)
+	return _u
+}
+
+// ClearChargeEnergyAdded clears the value of the "charge_energy_added" field.
+func (_u *VehicleSnapshotUpdate) ClearChargeEnergyAdded() *VehicleSnapshotUpdate {
+	_u.mutation.ClearChargeEnergyAdded()
+	return _u
+}
+
+// SetChargerVoltage sets the "charger_voltage" field.
+func (_u *VehicleSnapshotUpdate) SetChargerVoltage(v int) *VehicleSnapshotUpdate {
+	_u.mutation.ResetChargerVoltage()
+	_u.mutation.SetChargerVoltage(v)
+	return _u
+}
+
+// SetNillableChargerVoltage sets the "charger_voltage" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableChargerVoltage(v *int) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetChargerVoltage(*v)
+	}
+	return _u
+}
+
+// AddChargerVoltage adds value to the "charger_voltage" field.
+func (_u *VehicleSnapshotUpdate) AddChargerVoltage(v int) *VehicleSnapshotUpdate {
+	_u.mutation.AddChargerVoltage(v)
+	return _u
+}
+
+// ClearChargerVoltage clears the value of the "charger_voltage" field.
+func (_u *VehicleSnapshotUpdate) ClearChargerVoltage() *VehicleSnapshotUpdate {
+	_u.mutation.ClearChargerVoltage()
+	return _u
+}
+
+// SetChargerActualCurrent sets the "charger_actual_current" field.
+func (_u *VehicleSnapshotUpdate) SetChargerActualCurrent(v int) *VehicleSnapshotUpdate {
+	_u.mutation.ResetChargerActualCurrent()
+	_u.mutation.SetChargerActualCurrent(v)
+	return _u
+}
+
+// SetNillableChargerActualCurrent sets the "charger_actual_current" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableChargerActualCurrent(v *int) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetChargerActualCurrent(*v)
+	}
+	return _u
+}
+
+// AddChargerActualCurrent adds value to the "charger_actual_current" field.
+func (_u *VehicleSnapshotUpdate) AddChargerActualCurrent(v int) *VehicleSnapshotUpdate {
+	_u.mutation.AddChargerActualCurrent(v)
+	return _u
+}
+
+// ClearChargerActualCurrent clears the value of the "charger_actual_current" field.
+func (_u *VehicleSnapshotUpdate) ClearChargerActualCurrent() *VehicleSnapshotUpdate {
+	_u.mutation.ClearChargerActualCurrent()
+	return _u
+}
+
+// SetFastChargerPresent sets the "fast_charger_present" field.
+func (_u *VehicleSnapshotUpdate) SetFastChargerPresent(v bool) *VehicleSnapshotUpdate {
+	_u.mutation.SetFastChargerPresent(v)
+	return _u
+}
+
+// SetNillableFastChargerPresent sets the "fast_charger_present" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableFastChargerPresent(v *bool) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetFastChargerPresent(*v)
+	}
+	return _u
+}
+
+// SetFastChargerType sets the "fast_charger_type" field.
+func (_u *VehicleSnapshotUpdate) SetFastChargerType(v string) *VehicleSnapshotUpdate {
+	_u.mutation.SetFastChargerType(v)
+	return _u
+}
+
+// SetNillableFastChargerType sets the "fast_charger_type" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableFastChargerType(v *string) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetFastChargerType(*v)
+	}
+	return _u
+}
+
+// ClearFastChargerType clears the value of the "fast_charger_type" field.
+func (_u *VehicleSnapshotUpdate) ClearFastChargerType() *VehicleSnapshotUpdate {
+	_u.mutation.ClearFastChargerType()
+	return _u
+}
+
+// SetBatteryHeaterOn sets the "battery_heater_on" field.
+func (_u *VehicleSnapshotUpdate) SetBatteryHeaterOn(v bool) *VehicleSnapshotUpdate {
+	_u.mutation.SetBatteryHeaterOn(v)
+	return _u
+}
+
+// SetNillableBatteryHeaterOn sets the "battery_heater_on" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableBatteryHeaterOn(v *bool) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetBatteryHeaterOn(*v)
+	}
+	return _u
+}
+
+// SetOutsideTemp sets the "outside_temp" field.
+func (_u *VehicleSnapshotUpdate) SetOutsideTemp(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.ResetOutsideTemp()
+	_u.mutation.SetOutsideTemp(v)
+	return _u
+}
+
+// SetNillableOutsideTemp sets the "outside_temp" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableOutsideTemp(v *float64) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetOutsideTemp(*v)
+	}
+	return _u
+}
+
+// AddOutsideTemp adds value to the "outside_temp" field.
+func (_u *VehicleSnapshotUpdate) AddOutsideTemp(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.AddOutsideTemp(v)
+	return _u
+}
+
+// ClearOutsideTemp clears the value of the "outside_temp" field.
+func (_u *VehicleSnapshotUpdate) ClearOutsideTemp() *VehicleSnapshotUpdate {
+	_u.mutation.ClearOutsideTemp()
+	return _u
+}
+
+// SetInsideTemp sets the "inside_temp" field.
+func (_u *VehicleSnapshotUpdate) SetInsideTemp(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.ResetInsideTemp()
+	_u.mutation.SetInsideTemp(v)
+	return _u
+}
+
+// SetNillableInsideTemp sets the "inside_temp" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableInsideTemp(v *float64) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetInsideTemp(*v)
+	}
+	return _u
+}
+
+// AddInsideTemp adds value to the "inside_temp" field.
+func (_u *VehicleSnapshotUpdate) AddInsideTemp(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.AddInsideTemp(v)
+	return _u
+}
+
+// ClearInsideTemp clears the value of the "inside_temp" field.
+func (_u *VehicleSnapshotUpdate) ClearInsideTemp() *VehicleSnapshotUpdate {
+	_u.mutation.ClearInsideTemp()
+	return _u
+}
+
+// SetClimateOn sets the "climate_on" field.
+func (_u *VehicleSnapshotUpdate) SetClimateOn(v bool) *VehicleSnapshotUpdate {
+	_u.mutation.SetClimateOn(v)
+	return _u
+}
+
+// SetNillableClimateOn sets the "climate_on" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableClimateOn(v *bool) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetClimateOn(*v)
+	}
+	return _u
+}
+
+// SetClimateKeeperMode sets the "climate_keeper_mode" field.
+func (_u *VehicleSnapshotUpdate) SetClimateKeeperMode(v string) *VehicleSnapshotUpdate {
+	_u.mutation.SetClimateKeeperMode(v)
+	return _u
+}
+
+// SetNillableClimateKeeperMode sets the "climate_keeper_mode" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableClimateKeeperMode(v *string) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetClimateKeeperMode(*v)
+	}
+	return _u
+}
+
+// ClearClimateKeeperMode clears the value of the "climate_keeper_mode" field.
+func (_u *VehicleSnapshotUpdate) ClearClimateKeeperMode() *VehicleSnapshotUpdate {
+	_u.mutation.ClearClimateKeeperMode()
+	return _u
+}
+
+// SetCabinOverheatCooling sets the "cabin_overheat_cooling" field.
+func (_u *VehicleSnapshotUpdate) SetCabinOverheatCooling(v bool) *VehicleSnapshotUpdate {
+	_u.mutation.SetCabinOverheatCooling(v)
+	return _u
+}
+
+// SetNillableCabinOverheatCooling sets the "cabin_overheat_cooling" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableCabinOverheatCooling(v *bool) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetCabinOverheatCooling(*v)
+	}
+	return _u
+}
+
+// SetSentryMode sets the "sentry_mode" field.
+func (_u *VehicleSnapshotUpdate) SetSentryMode(v bool) *VehicleSnapshotUpdate {
+	_u.mutation.SetSentryMode(v)
+	return _u
+}
+
+// SetNillableSentryMode sets the "sentry_mode" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableSentryMode(v *bool) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetSentryMode(*v)
+	}
+	return _u
+}
+
+// SetLocked sets the "locked" field.
+func (_u *VehicleSnapshotUpdate) SetLocked(v bool) *VehicleSnapshotUpdate {
+	_u.mutation.SetLocked(v)
+	return _u
+}
+
+// SetNillableLocked sets the "locked" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableLocked(v *bool) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetLocked(*v)
+	}
+	return _u
+}
+
+// SetOdometer sets the "odometer" field.
+func (_u *VehicleSnapshotUpdate) SetOdometer(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.ResetOdometer()
+	_u.mutation.SetOdometer(v)
+	return _u
+}
+
+// SetNillableOdometer sets the "odometer" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableOdometer(v *float64) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetOdometer(*v)
+	}
+	return _u
+}
+
+// AddOdometer adds value to the "odometer" field.
+func (_u *VehicleSnapshotUpdate) AddOdometer(v float64) *VehicleSnapshotUpdate {
+	_u.mutation.AddOdometer(v)
+	return _u
+}
+
+// ClearOdometer clears the value of the "odometer" field.
+func (_u *VehicleSnapshotUpdate) ClearOdometer() *VehicleSnapshotUpdate {
+	_u.mutation.ClearOdometer()
+	return _u
+}
+
+// SetCarVersion sets the "car_version" field.
+func (_u *VehicleSnapshotUpdate) SetCarVersion(v string) *VehicleSnapshotUpdate {
+	_u.mutation.SetCarVersion(v)
+	return _u
+}
+
+// SetNillableCarVersion sets the "car_version" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableCarVersion(v *string) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetCarVersion(*v)
+	}
+	return _u
+}
+
+// ClearCarVersion clears the value of the "car_version" field.
+func (_u *VehicleSnapshotUpdate) ClearCarVersion() *VehicleSnapshotUpdate {
+	_u.mutation.ClearCarVersion()
+	return _u
+}
+
+// SetSoftwareUpdateStatus sets the "software_update_status" field.
+func (_u *VehicleSnapshotUpdate) SetSoftwareUpdateStatus(v string) *VehicleSnapshotUpdate {
+	_u.mutation.SetSoftwareUpdateStatus(v)
+	return _u
+}
+
+// SetNillableSoftwareUpdateStatus sets the "software_update_status" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableSoftwareUpdateStatus(v *string) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetSoftwareUpdateStatus(*v)
+	}
+	return _u
+}
+
+// ClearSoftwareUpdateStatus clears the value of the "software_update_status" field.
+func (_u *VehicleSnapshotUpdate) ClearSoftwareUpdateStatus() *VehicleSnapshotUpdate {
+	_u.mutation.ClearSoftwareUpdateStatus()
+	return _u
+}
+
+// SetSoftwareUpdateVersion sets the "software_update_version" field.
+func (_u *VehicleSnapshotUpdate) SetSoftwareUpdateVersion(v string) *VehicleSnapshotUpdate {
+	_u.mutation.SetSoftwareUpdateVersion(v)
+	return _u
+}
+
+// SetNillableSoftwareUpdateVersion sets the "software_update_version" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableSoftwareUpdateVersion(v *string) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetSoftwareUpdateVersion(*v)
+	}
+	return _u
+}
+
+// ClearSoftwareUpdateVersion clears the value of the "software_update_version" field.
+func (_u *VehicleSnapshotUpdate) ClearSoftwareUpdateVersion() *VehicleSnapshotUpdate {
+	_u.mutation.ClearSoftwareUpdateVersion()
+	return _u
+}
+
+// SetSoftwareUpdateDownloadPerc sets the "software_update_download_perc" field.
+func (_u *VehicleSnapshotUpdate) SetSoftwareUpdateDownloadPerc(v int) *VehicleSnapshotUpdate {
+	_u.mutation.ResetSoftwareUpdateDownloadPerc()
+	_u.mutation.SetSoftwareUpdateDownloadPerc(v)
+	return _u
+}
+
+// SetNillableSoftwareUpdateDownloadPerc sets the "software_update_download_perc" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableSoftwareUpdateDownloadPerc(v *int) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetSoftwareUpdateDownloadPerc(*v)
+	}
+	return _u
+}
+
+// AddSoftwareUpdateDownloadPerc adds value to the "software_update_download_perc" field.
+func (_u *VehicleSnapshotUpdate) AddSoftwareUpdateDownloadPerc(v int) *VehicleSnapshotUpdate {
+	_u.mutation.AddSoftwareUpdateDownloadPerc(v)
+	return _u
+}
+
+// ClearSoftwareUpdateDownloadPerc clears the value of the "software_update_download_perc" field.
+func (_u *VehicleSnapshotUpdate) ClearSoftwareUpdateDownloadPerc() *VehicleSnapshotUpdate {
+	_u.mutation.ClearSoftwareUpdateDownloadPerc()
+	return _u
+}
+
+// SetSoftwareUpdateInstallPerc sets the "software_update_install_perc" field.
+func (_u *VehicleSnapshotUpdate) SetSoftwareUpdateInstallPerc(v int) *VehicleSnapshotUpdate {
+	_u.mutation.ResetSoftwareUpdateInstallPerc()
+	_u.mutation.SetSoftwareUpdateInstallPerc(v)
+	return _u
+}
+
+// SetNillableSoftwareUpdateInstallPerc sets the "software_update_install_perc" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableSoftwareUpdateInstallPerc(v *int) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetSoftwareUpdateInstallPerc(*v)
+	}
+	return _u
+}
+
+// AddSoftwareUpdateInstallPerc adds value to the "software_update_install_perc" field.
+func (_u *VehicleSnapshotUpdate) AddSoftwareUpdateInstallPerc(v int) *VehicleSnapshotUpdate {
+	_u.mutation.AddSoftwareUpdateInstallPerc(v)
+	return _u
+}
+
+// ClearSoftwareUpdateInstallPerc clears the value of the "software_update_install_perc" field.
+func (_u *VehicleSnapshotUpdate) ClearSoftwareUpdateInstallPerc() *VehicleSnapshotUpdate {
+	_u.mutation.ClearSoftwareUpdateInstallPerc()
+	return _u
+}
+
+// SetSoftwareUpdateExpectedDuration sets the "software_update_expected_duration" field.
+func (_u *VehicleSnapshotUpdate) SetSoftwareUpdateExpectedDuration(v int) *VehicleSnapshotUpdate {
+	_u.mutation.ResetSoftwareUpdateExpectedDuration()
+	_u.mutation.SetSoftwareUpdateExpectedDuration(v)
+	return _u
+}
+
+// SetNillableSoftwareUpdateExpectedDuration sets the "software_update_expected_duration" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdate) SetNillableSoftwareUpdateExpectedDuration(v *int) *VehicleSnapshotUpdate {
+	if v != nil {
+		_u.SetSoftwareUpdateExpectedDuration(*v)
+	}
+	return _u
+}
+
+// AddSoftwareUpdateExpectedDuration adds value to the "software_update_expected_duration" field.
+func (_u *VehicleSnapshotUpdate) AddSoftwareUpdateExpectedDuration(v int) *VehicleSnapshotUpdate {
+	_u.mutation.AddSoftwareUpdateExpectedDuration(v)
+	return _u
+}
+
+// ClearSoftwareUpdateExpectedDuration clears the value of the "software_update_expected_duration" field.
+func (_u *VehicleSnapshotUpdate) ClearSoftwareUpdateExpectedDuration() *VehicleSnapshotUpdate {
+	_u.mutation.ClearSoftwareUpdateExpectedDuration()
+	return _u
+}
+
+// Mutation returns the VehicleSnapshotMutation object of the builder.
+func (_u *VehicleSnapshotUpdate) Mutation() *VehicleSnapshotMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VehicleSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VehicleSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VehicleSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VehicleSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *VehicleSnapshotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vehiclesnapshot.Table, vehiclesnapshot.Columns, sqlgraph.NewFieldSpec(vehiclesnapshot.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VehicleID(); ok {
+		_spec.SetField(vehiclesnapshot.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVehicleID(); ok {
+		_spec.AddField(vehiclesnapshot.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(vehiclesnapshot.FieldState, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ShiftState(); ok {
+		_spec.SetField(vehiclesnapshot.FieldShiftState, field.TypeString, value)
+	}
+	if _u.mutation.ShiftStateCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldShiftState, field.TypeString)
+	}
+	if value, ok := _u.mutation.Speed(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSpeed(); ok {
+		_spec.AddField(vehiclesnapshot.FieldSpeed, field.TypeFloat64, value)
+	}
+	if _u.mutation.SpeedCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldSpeed, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Power(); ok {
+		_spec.SetField(vehiclesnapshot.FieldPower, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPower(); ok {
+		_spec.AddField(vehiclesnapshot.FieldPower, field.TypeInt, value)
+	}
+	if _u.mutation.PowerCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldPower, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(vehiclesnapshot.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(vehiclesnapshot.FieldLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LatitudeCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(vehiclesnapshot.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(vehiclesnapshot.FieldLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongitudeCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldLongitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CoordType(); ok {
+		_spec.SetField(vehiclesnapshot.FieldCoordType, field.TypeString, value)
+	}
+	if _u.mutation.CoordTypeCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldCoordType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Heading(); ok {
+		_spec.SetField(vehiclesnapshot.FieldHeading, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeading(); ok {
+		_spec.AddField(vehiclesnapshot.FieldHeading, field.TypeInt, value)
+	}
+	if _u.mutation.HeadingCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldHeading, field.TypeInt)
+	}
+	if value, ok := _u.mutation.BatteryLevel(); ok {
+		_spec.SetField(vehiclesnapshot.FieldBatteryLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBatteryLevel(); ok {
+		_spec.AddField(vehiclesnapshot.FieldBatteryLevel, field.TypeInt, value)
+	}
+	if _u.mutation.BatteryLevelCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldBatteryLevel, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UsableBatteryLevel(); ok {
+		_spec.SetField(vehiclesnapshot.FieldUsableBatteryLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUsableBatteryLevel(); ok {
+		_spec.AddField(vehiclesnapshot.FieldUsableBatteryLevel, field.TypeInt, value)
+	}
+	if _u.mutation.UsableBatteryLevelCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldUsableBatteryLevel, field.TypeInt)
+	}
+	if value, ok := _u.mutation.BatteryRange(); ok {
+		_spec.SetField(vehiclesnapshot.FieldBatteryRange, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBatteryRange(); ok {
+		_spec.AddField(vehiclesnapshot.FieldBatteryRange, field.TypeFloat64, value)
+	}
+	if _u.mutation.BatteryRangeCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldBatteryRange, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.IdealBatteryRange(); ok {
+		_spec.SetField(vehiclesnapshot.FieldIdealBatteryRange, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedIdealBatteryRange(); ok {
+		_spec.AddField(vehiclesnapshot.FieldIdealBatteryRange, field.TypeFloat64, value)
+	}
+	if _u.mutation.IdealBatteryRangeCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldIdealBatteryRange, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EstBatteryRange(); ok {
+		_spec.SetField(vehiclesnapshot.FieldEstBatteryRange, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEstBatteryRange(); ok {
+		_spec.AddField(vehiclesnapshot.FieldEstBatteryRange, field.TypeFloat64, value)
+	}
+	if _u.mutation.EstBatteryRangeCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldEstBatteryRange, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ChargingState(); ok {
+		_spec.SetField(vehiclesnapshot.FieldChargingState, field.TypeString, value)
+	}
+	if _u.mutation.ChargingStateCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldChargingState, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChargerPower(); ok {
+		_spec.SetField(vehiclesnapshot.FieldChargerPower, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChargerPower(); ok {
+		_spec.AddField(vehiclesnapshot.FieldChargerPower, field.TypeInt, value)
+	}
+	if _u.mutation.ChargerPowerCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldChargerPower, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChargeEnergyAdded(); ok {
+		_spec.SetField(vehiclesnapshot.FieldChargeEnergyAdded, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedChargeEnergyAdded(); ok {
+		_spec.AddField(vehiclesnapshot.FieldChargeEnergyAdded, field.TypeFloat64, value)
+	}
+	if _u.mutation.ChargeEnergyAddedCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldChargeEnergyAdded, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ChargerVoltage(); ok {
+		_spec.SetField(vehiclesnapshot.FieldChargerVoltage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChargerVoltage(); ok {
+		_spec.AddField(vehiclesnapshot.FieldChargerVoltage, field.TypeInt, value)
+	}
+	if _u.mutation.ChargerVoltageCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldChargerVoltage, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChargerActualCurrent(); ok {
+		_spec.SetField(vehiclesnapshot.FieldChargerActualCurrent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChargerActualCurrent(); ok {
+		_spec.AddField(vehiclesnapshot.FieldChargerActualCurrent, field.TypeInt, value)
+	}
+	if _u.mutation.ChargerActualCurrentCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldChargerActualCurrent, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FastChargerPresent(); ok {
+		_spec.SetField(vehiclesnapshot.FieldFastChargerPresent, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.FastChargerType(); ok {
+		_spec.SetField(vehiclesnapshot.FieldFastChargerType, field.TypeString, value)
+	}
+	if _u.mutation.FastChargerTypeCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldFastChargerType, field.TypeString)
+	}
+	if value, ok := _u.mutation.BatteryHeaterOn(); ok {
+		_spec.SetField(vehiclesnapshot.FieldBatteryHeaterOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.OutsideTemp(); ok {
+		_spec.SetField(vehiclesnapshot.FieldOutsideTemp, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutsideTemp(); ok {
+		_spec.AddField(vehiclesnapshot.FieldOutsideTemp, field.TypeFloat64, value)
+	}
+	if _u.mutation.OutsideTempCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldOutsideTemp, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.InsideTemp(); ok {
+		_spec.SetField(vehiclesnapshot.FieldInsideTemp, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedInsideTemp(); ok {
+		_spec.AddField(vehiclesnapshot.FieldInsideTemp, field.TypeFloat64, value)
+	}
+	if _u.mutation.InsideTempCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldInsideTemp, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ClimateOn(); ok {
+		_spec.SetField(vehiclesnapshot.FieldClimateOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ClimateKeeperMode(); ok {
+		_spec.SetField(vehiclesnapshot.FieldClimateKeeperMode, field.TypeString, value)
+	}
+	if _u.mutation.ClimateKeeperModeCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldClimateKeeperMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.CabinOverheatCooling(); ok {
+		_spec.SetField(vehiclesnapshot.FieldCabinOverheatCooling, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SentryMode(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSentryMode, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Locked(); ok {
+		_spec.SetField(vehiclesnapshot.FieldLocked, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Odometer(); ok {
+		_spec.SetField(vehiclesnapshot.FieldOdometer, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOdometer(); ok {
+		_spec.AddField(vehiclesnapshot.FieldOdometer, field.TypeFloat64, value)
+	}
+	if _u.mutation.OdometerCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldOdometer, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CarVersion(); ok {
+		_spec.SetField(vehiclesnapshot.FieldCarVersion, field.TypeString, value)
+	}
+	if _u.mutation.CarVersionCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldCarVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.SoftwareUpdateStatus(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSoftwareUpdateStatus, field.TypeString, value)
+	}
+	if _u.mutation.SoftwareUpdateStatusCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldSoftwareUpdateStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.SoftwareUpdateVersion(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSoftwareUpdateVersion, field.TypeString, value)
+	}
+	if _u.mutation.SoftwareUpdateVersionCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldSoftwareUpdateVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.SoftwareUpdateDownloadPerc(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSoftwareUpdateDownloadPerc, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSoftwareUpdateDownloadPerc(); ok {
+		_spec.AddField(vehiclesnapshot.FieldSoftwareUpdateDownloadPerc, field.TypeInt, value)
+	}
+	if _u.mutation.SoftwareUpdateDownloadPercCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldSoftwareUpdateDownloadPerc, field.TypeInt)
+	}
+	if value, ok := _u.mutation.SoftwareUpdateInstallPerc(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSoftwareUpdateInstallPerc, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSoftwareUpdateInstallPerc(); ok {
+		_spec.AddField(vehiclesnapshot.FieldSoftwareUpdateInstallPerc, field.TypeInt, value)
+	}
+	if _u.mutation.SoftwareUpdateInstallPercCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldSoftwareUpdateInstallPerc, field.TypeInt)
+	}
+	if value, ok := _u.mutation.SoftwareUpdateExpectedDuration(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSoftwareUpdateExpectedDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSoftwareUpdateExpectedDuration(); ok {
+		_spec.AddField(vehiclesnapshot.FieldSoftwareUpdateExpectedDuration, field.TypeInt, value)
+	}
+	if _u.mutation.SoftwareUpdateExpectedDurationCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldSoftwareUpdateExpectedDuration, field.TypeInt)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vehiclesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VehicleSnapshotUpdateOne is the builder for updating a single VehicleSnapshot entity.
+type VehicleSnapshotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VehicleSnapshotMutation
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_u *VehicleSnapshotUpdateOne) SetVehicleID(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetVehicleID()
+	_u.mutation.SetVehicleID(v)
+	return _u
+}
+
+// SetNillableVehicleID sets the "vehicle_id" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableVehicleID(v *int) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetVehicleID(*v)
+	}
+	return _u
+}
+
+// AddVehicleID adds value to the "vehicle_id" field.
+func (_u *VehicleSnapshotUpdateOne) AddVehicleID(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddVehicleID(v)
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *VehicleSnapshotUpdateOne) SetState(v string) *VehicleSnapshotUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableState(v *string) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetShiftState sets the "shift_state" field.
+func (_u *VehicleSnapshotUpdateOne) SetShiftState(v string) *VehicleSnapshotUpdateOne {
+	_u.mutation.SetShiftState(v)
+	return _u
+}
+
+// SetNillableShiftState sets the "shift_state" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableShiftState(v *string) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetShiftState(*v)
+	}
+	return _u
+}
+
+// ClearShiftState clears the value of the "shift_state" field.
+func (_u *VehicleSnapshotUpdateOne) ClearShiftState() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearShiftState()
+	return _u
+}
+
+// SetSpeed sets the "speed" field.
+func (_u *VehicleSnapshotUpdateOne) SetSpeed(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetSpeed()
+	_u.mutation.SetSpeed(v)
+	return _u
+}
+
+// SetNillableSpeed sets the "speed" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableSpeed(v *float64) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetSpeed(*v)
+	}
+	return _u
+}
+
+// AddSpeed adds value to the "speed" field.
+func (_u *VehicleSnapshotUpdateOne) AddSpeed(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddSpeed(v)
+	return _u
+}
+
+// ClearSpeed clears the value of the "speed" field.
+func (_u *VehicleSnapshotUpdateOne) ClearSpeed() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearSpeed()
+	return _u
+}
+
+// SetPower sets the "power" field.
+func (_u *VehicleSnapshotUpdateOne) SetPower(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetPower()
+	_u.mutation.SetPower(v)
+	return _u
+}
+
+// SetNillablePower sets the "power" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillablePower(v *int) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetPower(*v)
+	}
+	return _u
+}
+
+// AddPower adds value to the "power" field.
+func (_u *VehicleSnapshotUpdateOne) AddPower(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddPower(v)
+	return _u
+}
+
+// ClearPower clears the value of the "power" field.
+func (_u *VehicleSnapshotUpdateOne) ClearPower() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearPower()
+	return _u
+}
+
+// SetLatitude sets the "latitude" field.
+func (_u *VehicleSnapshotUpdateOne) SetLatitude(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableLatitude(v *float64) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *VehicleSnapshotUpdateOne) AddLatitude(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (_u *VehicleSnapshotUpdateOne) ClearLatitude() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearLatitude()
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *VehicleSnapshotUpdateOne) SetLongitude(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableLongitude(v *float64) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *VehicleSnapshotUpdateOne) AddLongitude(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (_u *VehicleSnapshotUpdateOne) ClearLongitude() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearLongitude()
+	return _u
+}
+
+// SetCoordType sets the "coord_type" field.
+func (_u *VehicleSnapshotUpdateOne) SetCoordType(v string) *VehicleSnapshotUpdateOne {
+	_u.mutation.SetCoordType(v)
+	return _u
+}
+
+// SetNillableCoordType sets the "coord_type" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableCoordType(v *string) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetCoordType(*v)
+	}
+	return _u
+}
+
+// ClearCoordType clears the value of the "coord_type" field.
+func (_u *VehicleSnapshotUpdateOne) ClearCoordType() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearCoordType()
+	return _u
+}
+
+// SetHeading sets the "heading" field.
+func (_u *VehicleSnapshotUpdateOne) SetHeading(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetHeading()
+	_u.mutation.SetHeading(v)
+	return _u
+}
+
+// SetNillableHeading sets the "heading" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableHeading(v *int) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetHeading(*v)
+	}
+	return _u
+}
+
+// AddHeading adds value to the "heading" field.
+func (_u *VehicleSnapshotUpdateOne) AddHeading(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddHeading(v)
+	return _u
+}
+
+// ClearHeading clears the value of the "heading" field.
+func (_u *VehicleSnapshotUpdateOne) ClearHeading() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearHeading()
+	return _u
+}
+
+// SetBatteryLevel sets the "battery_level" field.
+func (_u *VehicleSnapshotUpdateOne) SetBatteryLevel(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetBatteryLevel()
+	_u.mutation.SetBatteryLevel(v)
+	return _u
+}
+
+// SetNillableBatteryLevel sets the "battery_level" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableBatteryLevel(v *int) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetBatteryLevel(*v)
+	}
+	return _u
+}
+
+// AddBatteryLevel adds value to the "battery_level" field.
+func (_u *VehicleSnapshotUpdateOne) AddBatteryLevel(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddBatteryLevel(v)
+	return _u
+}
+
+// ClearBatteryLevel clears the value of the "battery_level" field.
+func (_u *VehicleSnapshotUpdateOne) ClearBatteryLevel() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearBatteryLevel()
+	return _u
+}
+
+// SetUsableBatteryLevel sets the "usable_battery_level" field.
+func (_u *VehicleSnapshotUpdateOne) SetUsableBatteryLevel(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetUsableBatteryLevel()
+	_u.mutation.SetUsableBatteryLevel(v)
+	return _u
+}
+
+// SetNillableUsableBatteryLevel sets the "usable_battery_level" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableUsableBatteryLevel(v *int) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetUsableBatteryLevel(*v)
+	}
+	return _u
+}
+
+// AddUsableBatteryLevel adds value to the "usable_battery_level" field.
+func (_u *VehicleSnapshotUpdateOne) AddUsableBatteryLevel(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddUsableBatteryLevel(v)
+	return _u
+}
+
+// ClearUsableBatteryLevel clears the value of the "usable_battery_level" field.
+func (_u *VehicleSnapshotUpdateOne) ClearUsableBatteryLevel() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearUsableBatteryLevel()
+	return _u
+}
+
+// SetBatteryRange sets the "battery_range" field.
+func (_u *VehicleSnapshotUpdateOne) SetBatteryRange(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetBatteryRange()
+	_u.mutation.SetBatteryRange(v)
+	return _u
+}
+
+// SetNillableBatteryRange sets the "battery_range" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableBatteryRange(v *float64) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetBatteryRange(*v)
+	}
+	return _u
+}
+
+// AddBatteryRange adds value to the "battery_range" field.
+func (_u *VehicleSnapshotUpdateOne) AddBatteryRange(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddBatteryRange(v)
+	return _u
+}
+
+// ClearBatteryRange clears the value of the "battery_range" field.
+func (_u *VehicleSnapshotUpdateOne) ClearBatteryRange() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearBatteryRange()
+	return _u
+}
+
+// SetIdealBatteryRange sets the "ideal_battery_range" field.
+func (_u *VehicleSnapshotUpdateOne) SetIdealBatteryRange(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetIdealBatteryRange()
+	_u.mutation.SetIdealBatteryRange(v)
+	return _u
+}
+
+// SetNillableIdealBatteryRange sets the "ideal_battery_range" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableIdealBatteryRange(v *float64) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetIdealBatteryRange(*v)
+	}
+	return _u
+}
+
+// AddIdealBatteryRange adds value to the "ideal_battery_range" field.
+func (_u *VehicleSnapshotUpdateOne) AddIdealBatteryRange(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddIdealBatteryRange(v)
+	return _u
+}
+
+// ClearIdealBatteryRange clears the value of the "ideal_battery_range" field.
+func (_u *VehicleSnapshotUpdateOne) ClearIdealBatteryRange() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearIdealBatteryRange()
+	return _u
+}
+
+// SetEstBatteryRange sets the "est_battery_range" field.
+func (_u *VehicleSnapshotUpdateOne) SetEstBatteryRange(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetEstBatteryRange()
+	_u.mutation.SetEstBatteryRange(v)
+	return _u
+}
+
+// SetNillableEstBatteryRange sets the "est_battery_range" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableEstBatteryRange(v *float64) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetEstBatteryRange(*v)
+	}
+	return _u
+}
+
+// AddEstBatteryRange adds value to the "est_battery_range" field.
+func (_u *VehicleSnapshotUpdateOne) AddEstBatteryRange(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddEstBatteryRange(v)
+	return _u
+}
+
+// ClearEstBatteryRange clears the value of the "est_battery_range" field.
+func (_u *VehicleSnapshotUpdateOne) ClearEstBatteryRange() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearEstBatteryRange()
+	return _u
+}
+
+// SetChargingState sets the "charging_state" field.
+func (_u *VehicleSnapshotUpdateOne) SetChargingState(v string) *VehicleSnapshotUpdateOne {
+	_u.mutation.SetChargingState(v)
+	return _u
+}
+
+// SetNillableChargingState sets the "charging_state" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableChargingState(v *string) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetChargingState(*v)
+	}
+	return _u
+}
+
+// ClearChargingState clears the value of the "charging_state" field.
+func (_u *VehicleSnapshotUpdateOne) ClearChargingState() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearChargingState()
+	return _u
+}
+
+// SetChargerPower sets the "charger_power" field.
+func (_u *VehicleSnapshotUpdateOne) SetChargerPower(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetChargerPower()
+	_u.mutation.SetChargerPower(v)
+	return _u
+}
+
+// SetNillableChargerPower sets the "charger_power" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableChargerPower(v *int) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetChargerPower(*v)
+	}
+	return _u
+}
+
+// AddChargerPower adds value to the "charger_power" field.
+func (_u *VehicleSnapshotUpdateOne) AddChargerPower(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddChargerPower(v)
+	return _u
+}
+
+// ClearChargerPower clears the value of the "charger_power" field.
+func (_u *VehicleSnapshotUpdateOne) ClearChargerPower() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearChargerPower()
+	return _u
+}
+
+// SetChargeEnergyAdded sets the "charge_energy_added" field.
+func (_u *VehicleSnapshotUpdateOne) SetChargeEnergyAdded(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetChargeEnergyAdded()
+	_u.mutation.SetChargeEnergyAdded(v)
+	return _u
+}
+
+// SetNillableChargeEnergyAdded sets the "charge_energy_added" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableChargeEnergyAdded(v *float64) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetChargeEnergyAdded(*v)
+	}
+	return _u
+}
+
+// AddChargeEnergyAdded adds value to the "charge_energy_added" field.
+func (_u *VehicleSnapshotUpdateOne) AddChargeEnergyAdded(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddChargeEnergyAdded(v)
+	return _u
+}
+
+// ClearChargeEnergyAdded clears the value of the "charge_energy_added" field.
+func (_u *VehicleSnapshotUpdateOne) ClearChargeEnergyAdded() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearChargeEnergyAdded()
+	return _u
+}
+
+// SetChargerVoltage sets the "charger_voltage" field.
+func (_u *VehicleSnapshotUpdateOne) SetChargerVoltage(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetChargerVoltage()
+	_u.mutation.SetChargerVoltage(v)
+	return _u
+}
+
+// SetNillableChargerVoltage sets the "charger_voltage" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableChargerVoltage(v *int) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetChargerVoltage(*v)
+	}
+	return _u
+}
+
+// AddChargerVoltage adds value to the "charger_voltage" field.
+func (_u *VehicleSnapshotUpdateOne) AddChargerVoltage(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddChargerVoltage(v)
+	return _u
+}
+
+// ClearChargerVoltage clears the value of the "charger_voltage" field.
+func (_u *VehicleSnapshotUpdateOne) ClearChargerVoltage() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearChargerVoltage()
+	return _u
+}
+
+// SetChargerActualCurrent sets the "charger_actual_current" field.
+func (_u *VehicleSnapshotUpdateOne) SetChargerActualCurrent(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetChargerActualCurrent()
+	_u.mutation.SetChargerActualCurrent(v)
+	return _u
+}
+
+// SetNillableChargerActualCurrent sets the "charger_actual_current" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableChargerActualCurrent(v *int) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetChargerActualCurrent(*v)
+	}
+	return _u
+}
+
+// AddChargerActualCurrent adds value to the "charger_actual_current" field.
+func (_u *VehicleSnapshotUpdateOne) AddChargerActualCurrent(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddChargerActualCurrent(v)
+	return _u
+}
+
+// ClearChargerActualCurrent clears the value of the "charger_actual_current" field.
+func (_u *VehicleSnapshotUpdateOne) ClearChargerActualCurrent() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearChargerActualCurrent()
+	return _u
+}
+
+// SetFastChargerPresent sets the "fast_charger_present" field.
+func (_u *VehicleSnapshotUpdateOne) SetFastChargerPresent(v bool) *VehicleSnapshotUpdateOne {
+	_u.mutation.SetFastChargerPresent(v)
+	return _u
+}
+
+// SetNillableFastChargerPresent sets the "fast_charger_present" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableFastChargerPresent(v *bool) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetFastChargerPresent(*v)
+	}
+	return _u
+}
+
+// SetFastChargerType sets the "fast_charger_type" field.
+func (_u *VehicleSnapshotUpdateOne) SetFastChargerType(v string) *VehicleSnapshotUpdateOne {
+	_u.mutation.SetFastChargerType(v)
+	return _u
+}
+
+// SetNillableFastChargerType sets the "fast_charger_type" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableFastChargerType(v *string) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetFastChargerType(*v)
+	}
+	return _u
+}
+
+// ClearFastChargerType clears the value of the "fast_charger_type" field.
+func (_u *VehicleSnapshotUpdateOne) ClearFastChargerType() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearFastChargerType()
+	return _u
+}
+
+// SetBatteryHeaterOn sets the "battery_heater_on" field.
+func (_u *VehicleSnapshotUpdateOne) SetBatteryHeaterOn(v bool) *VehicleSnapshotUpdateOne {
+	_u.mutation.SetBatteryHeaterOn(v)
+	return _u
+}
+
+// SetNillableBatteryHeaterOn sets the "battery_heater_on" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableBatteryHeaterOn(v *bool) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetBatteryHeaterOn(*v)
+	}
+	return _u
+}
+
+// SetOutsideTemp sets the "outside_temp" field.
+func (_u *VehicleSnapshotUpdateOne) SetOutsideTemp(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetOutsideTemp()
+	_u.mutation.SetOutsideTemp(v)
+	return _u
+}
+
+// SetNillableOutsideTemp sets the "outside_temp" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableOutsideTemp(v *float64) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetOutsideTemp(*v)
+	}
+	return _u
+}
+
+// AddOutsideTemp adds value to the "outside_temp" field.
+func (_u *VehicleSnapshotUpdateOne) AddOutsideTemp(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddOutsideTemp(v)
+	return _u
+}
+
+// ClearOutsideTemp clears the value of the "outside_temp" field.
+func (_u *VehicleSnapshotUpdateOne) ClearOutsideTemp() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearOutsideTemp()
+	return _u
+}
+
+// SetInsideTemp sets the "inside_temp" field.
+func (_u *VehicleSnapshotUpdateOne) SetInsideTemp(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetInsideTemp()
+	_u.mutation.SetInsideTemp(v)
+	return _u
+}
+
+// SetNillableInsideTemp sets the "inside_temp" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableInsideTemp(v *float64) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetInsideTemp(*v)
+	}
+	return _u
+}
+
+// AddInsideTemp adds value to the "inside_temp" field.
+func (_u *VehicleSnapshotUpdateOne) AddInsideTemp(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddInsideTemp(v)
+	return _u
+}
+
+// ClearInsideTemp clears the value of the "inside_temp" field.
+func (_u *VehicleSnapshotUpdateOne) ClearInsideTemp() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearInsideTemp()
+	return _u
+}
+
+// SetClimateOn sets the "climate_on" field.
+func (_u *VehicleSnapshotUpdateOne) SetClimateOn(v bool) *VehicleSnapshotUpdateOne {
+	_u.mutation.SetClimateOn(v)
+	return _u
+}
+
+// SetNillableClimateOn sets the "climate_on" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableClimateOn(v *bool) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetClimateOn(*v)
+	}
+	return _u
+}
+
+// SetClimateKeeperMode sets the "climate_keeper_mode" field.
+func (_u *VehicleSnapshotUpdateOne) SetClimateKeeperMode(v string) *VehicleSnapshotUpdateOne {
+	_u.mutation.SetClimateKeeperMode(v)
+	return _u
+}
+
+// SetNillableClimateKeeperMode sets the "climate_keeper_mode" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableClimateKeeperMode(v *string) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetClimateKeeperMode(*v)
+	}
+	return _u
+}
+
+// ClearClimateKeeperMode clears the value of the "climate_keeper_mode" field.
+func (_u *VehicleSnapshotUpdateOne) ClearClimateKeeperMode() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearClimateKeeperMode()
+	return _u
+}
+
+// SetCabinOverheatCooling sets the "cabin_overheat_cooling" field.
+func (_u *VehicleSnapshotUpdateOne) SetCabinOverheatCooling(v bool) *VehicleSnapshotUpdateOne {
+	_u.mutation.SetCabinOverheatCooling(v)
+	return _u
+}
+
+// SetNillableCabinOverheatCooling sets the "cabin_overheat_cooling" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableCabinOverheatCooling(v *bool) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetCabinOverheatCooling(*v)
+	}
+	return _u
+}
+
+// SetSentryMode sets the "sentry_mode" field.
+func (_u *VehicleSnapshotUpdateOne) SetSentryMode(v bool) *VehicleSnapshotUpdateOne {
+	_u.mutation.SetSentryMode(v)
+	return _u
+}
+
+// SetNillableSentryMode sets the "sentry_mode" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableSentryMode(v *bool) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetSentryMode(*v)
+	}
+	return _u
+}
+
+// SetLocked sets the "locked" field.
+func (_u *VehicleSnapshotUpdateOne) SetLocked(v bool) *VehicleSnapshotUpdateOne {
+	_u.mutation.SetLocked(v)
+	return _u
+}
+
+// SetNillableLocked sets the "locked" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableLocked(v *bool) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetLocked(*v)
+	}
+	return _u
+}
+
+// SetOdometer sets the "odometer" field.
+func (_u *VehicleSnapshotUpdateOne) SetOdometer(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetOdometer()
+	_u.mutation.SetOdometer(v)
+	return _u
+}
+
+// SetNillableOdometer sets the "odometer" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableOdometer(v *float64) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetOdometer(*v)
+	}
+	return _u
+}
+
+// AddOdometer adds value to the "odometer" field.
+func (_u *VehicleSnapshotUpdateOne) AddOdometer(v float64) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddOdometer(v)
+	return _u
+}
+
+// ClearOdometer clears the value of the "odometer" field.
+func (_u *VehicleSnapshotUpdateOne) ClearOdometer() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearOdometer()
+	return _u
+}
+
+// SetCarVersion sets the "car_version" field.
+func (_u *VehicleSnapshotUpdateOne) SetCarVersion(v string) *VehicleSnapshotUpdateOne {
+	_u.mutation.SetCarVersion(v)
+	return _u
+}
+
+// SetNillableCarVersion sets the "car_version" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableCarVersion(v *string) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetCarVersion(*v)
+	}
+	return _u
+}
+
+// ClearCarVersion clears the value of the "car_version" field.
+func (_u *VehicleSnapshotUpdateOne) ClearCarVersion() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearCarVersion()
+	return _u
+}
+
+// SetSoftwareUpdateStatus sets the "software_update_status" field.
+func (_u *VehicleSnapshotUpdateOne) SetSoftwareUpdateStatus(v string) *VehicleSnapshotUpdateOne {
+	_u.mutation.SetSoftwareUpdateStatus(v)
+	return _u
+}
+
+// SetNillableSoftwareUpdateStatus sets the "software_update_status" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableSoftwareUpdateStatus(v *string) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetSoftwareUpdateStatus(*v)
+	}
+	return _u
+}
+
+// ClearSoftwareUpdateStatus clears the value of the "software_update_status" field.
+func (_u *VehicleSnapshotUpdateOne) ClearSoftwareUpdateStatus() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearSoftwareUpdateStatus()
+	return _u
+}
+
+// SetSoftwareUpdateVersion sets the "software_update_version" field.
+func (_u *VehicleSnapshotUpdateOne) SetSoftwareUpdateVersion(v string) *VehicleSnapshotUpdateOne {
+	_u.mutation.SetSoftwareUpdateVersion(v)
+	return _u
+}
+
+// SetNillableSoftwareUpdateVersion sets the "software_update_version" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableSoftwareUpdateVersion(v *string) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetSoftwareUpdateVersion(*v)
+	}
+	return _u
+}
+
+// ClearSoftwareUpdateVersion clears the value of the "software_update_version" field.
+func (_u *VehicleSnapshotUpdateOne) ClearSoftwareUpdateVersion() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearSoftwareUpdateVersion()
+	return _u
+}
+
+// SetSoftwareUpdateDownloadPerc sets the "software_update_download_perc" field.
+func (_u *VehicleSnapshotUpdateOne) SetSoftwareUpdateDownloadPerc(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetSoftwareUpdateDownloadPerc()
+	_u.mutation.SetSoftwareUpdateDownloadPerc(v)
+	return _u
+}
+
+// SetNillableSoftwareUpdateDownloadPerc sets the "software_update_download_perc" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableSoftwareUpdateDownloadPerc(v *int) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetSoftwareUpdateDownloadPerc(*v)
+	}
+	return _u
+}
+
+// AddSoftwareUpdateDownloadPerc adds value to the "software_update_download_perc" field.
+func (_u *VehicleSnapshotUpdateOne) AddSoftwareUpdateDownloadPerc(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddSoftwareUpdateDownloadPerc(v)
+	return _u
+}
+
+// ClearSoftwareUpdateDownloadPerc clears the value of the "software_update_download_perc" field.
+func (_u *VehicleSnapshotUpdateOne) ClearSoftwareUpdateDownloadPerc() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearSoftwareUpdateDownloadPerc()
+	return _u
+}
+
+// SetSoftwareUpdateInstallPerc sets the "software_update_install_perc" field.
+func (_u *VehicleSnapshotUpdateOne) SetSoftwareUpdateInstallPerc(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetSoftwareUpdateInstallPerc()
+	_u.mutation.SetSoftwareUpdateInstallPerc(v)
+	return _u
+}
+
+// SetNillableSoftwareUpdateInstallPerc sets the "software_update_install_perc" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableSoftwareUpdateInstallPerc(v *int) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetSoftwareUpdateInstallPerc(*v)
+	}
+	return _u
+}
+
+// AddSoftwareUpdateInstallPerc adds value to the "software_update_install_perc" field.
+func (_u *VehicleSnapshotUpdateOne) AddSoftwareUpdateInstallPerc(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddSoftwareUpdateInstallPerc(v)
+	return _u
+}
+
+// ClearSoftwareUpdateInstallPerc clears the value of the "software_update_install_perc" field.
+func (_u *VehicleSnapshotUpdateOne) ClearSoftwareUpdateInstallPerc() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearSoftwareUpdateInstallPerc()
+	return _u
+}
+
+// SetSoftwareUpdateExpectedDuration sets the "software_update_expected_duration" field.
+func (_u *VehicleSnapshotUpdateOne) SetSoftwareUpdateExpectedDuration(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.ResetSoftwareUpdateExpectedDuration()
+	_u.mutation.SetSoftwareUpdateExpectedDuration(v)
+	return _u
+}
+
+// SetNillableSoftwareUpdateExpectedDuration sets the "software_update_expected_duration" field if the given value is not nil.
+func (_u *VehicleSnapshotUpdateOne) SetNillableSoftwareUpdateExpectedDuration(v *int) *VehicleSnapshotUpdateOne {
+	if v != nil {
+		_u.SetSoftwareUpdateExpectedDuration(*v)
+	}
+	return _u
+}
+
+// AddSoftwareUpdateExpectedDuration adds value to the "software_update_expected_duration" field.
+func (_u *VehicleSnapshotUpdateOne) AddSoftwareUpdateExpectedDuration(v int) *VehicleSnapshotUpdateOne {
+	_u.mutation.AddSoftwareUpdateExpectedDuration(v)
+	return _u
+}
+
+// ClearSoftwareUpdateExpectedDuration clears the value of the "software_update_expected_duration" field.
+func (_u *VehicleSnapshotUpdateOne) ClearSoftwareUpdateExpectedDuration() *VehicleSnapshotUpdateOne {
+	_u.mutation.ClearSoftwareUpdateExpectedDuration()
+	return _u
+}
+
+// Mutation returns the VehicleSnapshotMutation object of the builder.
+func (_u *VehicleSnapshotUpdateOne) Mutation() *VehicleSnapshotMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the VehicleSnapshotUpdate builder.
+func (_u *VehicleSnapshotUpdateOne) Where(ps ...predicate.VehicleSnapshot) *VehicleSnapshotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VehicleSnapshotUpdateOne) Select(field string, fields ...string) *VehicleSnapshotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated VehicleSnapshot entity.
+func (_u *VehicleSnapshotUpdateOne) Save(ctx context.Context) (*VehicleSnapshot, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VehicleSnapshotUpdateOne) SaveX(ctx context.Context) *VehicleSnapshot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VehicleSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VehicleSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *VehicleSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *VehicleSnapshot, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vehiclesnapshot.Table, vehiclesnapshot.Columns, sqlgraph.NewFieldSpec(vehiclesnapshot.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VehicleSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vehiclesnapshot.FieldID)
+		for _, f := range fields {
+			if !vehiclesnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vehiclesnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VehicleID(); ok {
+		_spec.SetField(vehiclesnapshot.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVehicleID(); ok {
+		_spec.AddField(vehiclesnapshot.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(vehiclesnapshot.FieldState, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ShiftState(); ok {
+		_spec.SetField(vehiclesnapshot.FieldShiftState, field.TypeString, value)
+	}
+	if _u.mutation.ShiftStateCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldShiftState, field.TypeString)
+	}
+	if value, ok := _u.mutation.Speed(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSpeed(); ok {
+		_spec.AddField(vehiclesnapshot.FieldSpeed, field.TypeFloat64, value)
+	}
+	if _u.mutation.SpeedCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldSpeed, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Power(); ok {
+		_spec.SetField(vehiclesnapshot.FieldPower, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPower(); ok {
+		_spec.AddField(vehiclesnapshot.FieldPower, field.TypeInt, value)
+	}
+	if _u.mutation.PowerCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldPower, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(vehiclesnapshot.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(vehiclesnapshot.FieldLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LatitudeCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(vehiclesnapshot.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(vehiclesnapshot.FieldLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongitudeCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldLongitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CoordType(); ok {
+		_spec.SetField(vehiclesnapshot.FieldCoordType, field.TypeString, value)
+	}
+	if _u.mutation.CoordTypeCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldCoordType, field.TypeString)
+	}
+	if value, ok := _u.mutation.Heading(); ok {
+		_spec.SetField(vehiclesnapshot.FieldHeading, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeading(); ok {
+		_spec.AddField(vehiclesnapshot.FieldHeading, field.TypeInt, value)
+	}
+	if _u.mutation.HeadingCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldHeading, field.TypeInt)
+	}
+	if value, ok := _u.mutation.BatteryLevel(); ok {
+		_spec.SetField(vehiclesnapshot.FieldBatteryLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBatteryLevel(); ok {
+		_spec.AddField(vehiclesnapshot.FieldBatteryLevel, field.TypeInt, value)
+	}
+	if _u.mutation.BatteryLevelCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldBatteryLevel, field.TypeInt)
+	}
+	if value, ok := _u.mutation.UsableBatteryLevel(); ok {
+		_spec.SetField(vehiclesnapshot.FieldUsableBatteryLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUsableBatteryLevel(); ok {
+		_spec.AddField(vehiclesnapshot.FieldUsableBatteryLevel, field.TypeInt, value)
+	}
+	if _u.mutation.UsableBatteryLevelCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldUsableBatteryLevel, field.TypeInt)
+	}
+	if value, ok := _u.mutation.BatteryRange(); ok {
+		_spec.SetField(vehiclesnapshot.FieldBatteryRange, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBatteryRange(); ok {
+		_spec.AddField(vehiclesnapshot.FieldBatteryRange, field.TypeFloat64, value)
+	}
+	if _u.mutation.BatteryRangeCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldBatteryRange, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.IdealBatteryRange(); ok {
+		_spec.SetField(vehiclesnapshot.FieldIdealBatteryRange, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedIdealBatteryRange(); ok {
+		_spec.AddField(vehiclesnapshot.FieldIdealBatteryRange, field.TypeFloat64, value)
+	}
+	if _u.mutation.IdealBatteryRangeCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldIdealBatteryRange, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EstBatteryRange(); ok {
+		_spec.SetField(vehiclesnapshot.FieldEstBatteryRange, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEstBatteryRange(); ok {
+		_spec.AddField(vehiclesnapshot.FieldEstBatteryRange, field.TypeFloat64, value)
+	}
+	if _u.mutation.EstBatteryRangeCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldEstBatteryRange, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ChargingState(); ok {
+		_spec.SetField(vehiclesnapshot.FieldChargingState, field.TypeString, value)
+	}
+	if _u.mutation.ChargingStateCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldChargingState, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChargerPower(); ok {
+		_spec.SetField(vehiclesnapshot.FieldChargerPower, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChargerPower(); ok {
+		_spec.AddField(vehiclesnapshot.FieldChargerPower, field.TypeInt, value)
+	}
+	if _u.mutation.ChargerPowerCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldChargerPower, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChargeEnergyAdded(); ok {
+		_spec.SetField(vehiclesnapshot.FieldChargeEnergyAdded, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedChargeEnergyAdded(); ok {
+		_spec.AddField(vehiclesnapshot.FieldChargeEnergyAdded, field.TypeFloat64, value)
+	}
+	if _u.mutation.ChargeEnergyAddedCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldChargeEnergyAdded, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ChargerVoltage(); ok {
+		_spec.SetField(vehiclesnapshot.FieldChargerVoltage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChargerVoltage(); ok {
+		_spec.AddField(vehiclesnapshot.FieldChargerVoltage, field.TypeInt, value)
+	}
+	if _u.mutation.ChargerVoltageCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldChargerVoltage, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChargerActualCurrent(); ok {
+		_spec.SetField(vehiclesnapshot.FieldChargerActualCurrent, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChargerActualCurrent(); ok {
+		_spec.AddField(vehiclesnapshot.FieldChargerActualCurrent, field.TypeInt, value)
+	}
+	if _u.mutation.ChargerActualCurrentCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldChargerActualCurrent, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FastChargerPresent(); ok {
+		_spec.SetField(vehiclesnapshot.FieldFastChargerPresent, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.FastChargerType(); ok {
+		_spec.SetField(vehiclesnapshot.FieldFastChargerType, field.TypeString, value)
+	}
+	if _u.mutation.FastChargerTypeCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldFastChargerType, field.TypeString)
+	}
+	if value, ok := _u.mutation.BatteryHeaterOn(); ok {
+		_spec.SetField(vehiclesnapshot.FieldBatteryHeaterOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.OutsideTemp(); ok {
+		_spec.SetField(vehiclesnapshot.FieldOutsideTemp, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutsideTemp(); ok {
+		_spec.AddField(vehiclesnapshot.FieldOutsideTemp, field.TypeFloat64, value)
+	}
+	if _u.mutation.OutsideTempCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldOutsideTemp, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.InsideTemp(); ok {
+		_spec.SetField(vehiclesnapshot.FieldInsideTemp, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedInsideTemp(); ok {
+		_spec.AddField(vehiclesnapshot.FieldInsideTemp, field.TypeFloat64, value)
+	}
+	if _u.mutation.InsideTempCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldInsideTemp, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ClimateOn(); ok {
+		_spec.SetField(vehiclesnapshot.FieldClimateOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ClimateKeeperMode(); ok {
+		_spec.SetField(vehiclesnapshot.FieldClimateKeeperMode, field.TypeString, value)
+	}
+	if _u.mutation.ClimateKeeperModeCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldClimateKeeperMode, field.TypeString)
+	}
+	if value, ok := _u.mutation.CabinOverheatCooling(); ok {
+		_spec.SetField(vehiclesnapshot.FieldCabinOverheatCooling, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SentryMode(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSentryMode, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Locked(); ok {
+		_spec.SetField(vehiclesnapshot.FieldLocked, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Odometer(); ok {
+		_spec.SetField(vehiclesnapshot.FieldOdometer, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOdometer(); ok {
+		_spec.AddField(vehiclesnapshot.FieldOdometer, field.TypeFloat64, value)
+	}
+	if _u.mutation.OdometerCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldOdometer, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CarVersion(); ok {
+		_spec.SetField(vehiclesnapshot.FieldCarVersion, field.TypeString, value)
+	}
+	if _u.mutation.CarVersionCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldCarVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.SoftwareUpdateStatus(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSoftwareUpdateStatus, field.TypeString, value)
+	}
+	if _u.mutation.SoftwareUpdateStatusCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldSoftwareUpdateStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.SoftwareUpdateVersion(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSoftwareUpdateVersion, field.TypeString, value)
+	}
+	if _u.mutation.SoftwareUpdateVersionCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldSoftwareUpdateVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.SoftwareUpdateDownloadPerc(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSoftwareUpdateDownloadPerc, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSoftwareUpdateDownloadPerc(); ok {
+		_spec.AddField(vehiclesnapshot.FieldSoftwareUpdateDownloadPerc, field.TypeInt, value)
+	}
+	if _u.mutation.SoftwareUpdateDownloadPercCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldSoftwareUpdateDownloadPerc, field.TypeInt)
+	}
+	if value, ok := _u.mutation.SoftwareUpdateInstallPerc(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSoftwareUpdateInstallPerc, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSoftwareUpdateInstallPerc(); ok {
+		_spec.AddField(vehiclesnapshot.FieldSoftwareUpdateInstallPerc, field.TypeInt, value)
+	}
+	if _u.mutation.SoftwareUpdateInstallPercCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldSoftwareUpdateInstallPerc, field.TypeInt)
+	}
+	if value, ok := _u.mutation.SoftwareUpdateExpectedDuration(); ok {
+		_spec.SetField(vehiclesnapshot.FieldSoftwareUpdateExpectedDuration, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSoftwareUpdateExpectedDuration(); ok {
+		_spec.AddField(vehiclesnapshot.FieldSoftwareUpdateExpectedDuration, field.TypeInt, value)
+	}
+	if _u.mutation.SoftwareUpdateExpectedDurationCleared() {
+		_spec.ClearField(vehiclesnapshot.FieldSoftwareUpdateExpectedDuration, field.TypeInt)
+	}
+	_node = &VehicleSnapshot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vehiclesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,518 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"teslatrack/internal/data/ent/vehiclestateperiod"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Vehicle state period table
+type VehicleStatePeriod struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Associated vehicle ID
+	VehicleID int `json:"vehicle_id,omitempty"`
+	// State, e.g., online, asleep, offline, driving, charging, updating
+	State string `json:"state,omitempty"`
+	// Was sentry mode on during the period
+	SentryMode bool `json:"sentry_mode,omitempty"`
+	// Was climate keeper or cabin overheat protection running during the period
+	ClimateOn bool `json:"climate_on,omitempty"`
+	// Period start time
+	StartAt time.Time `json:"start_at,omitempty"`
+	// Period end time, null while the period is open
+	EndAt *time.Time `json:"end_at,omitempty"`
+	// State of charge at the start in percent
+	StartBatteryLevel int `json:"start_battery_level,omitempty"`
+	// State of charge at the end in percent
+	EndBatteryLevel int `json:"end_battery_level,omitempty"`
+	// Rated range at the start in km
+	StartRange float64 `json:"start_range,omitempty"`
+	// Rated range at the end in km
+	EndRange float64 `json:"end_range,omitempty"`
+	// Odometer at the start in km
+	StartOdometer float64 `json:"start_odometer,omitempty"`
+	// Odometer at the end in km
+	EndOdometer float64 `json:"end_odometer,omitempty"`
+	// WGS-84 latitude at the start
+	StartLatitude float64 `json:"start_latitude,omitempty"`
+	// WGS-84 longitude at the start
+	StartLongitude float64 `json:"start_longitude,omitempty"`
+	// WGS-84 latitude at the end
+	EndLatitude float64 `json:"end_latitude,omitempty"`
+	// WGS-84 longitude at the end
+	EndLongitude float64 `json:"end_longitude,omitempty"`
+	// Address at the start, resolved for drives and charging sessions
+	StartAddress string `json:"start_address,omitempty"`
+	// Address at the end, resolved for drives and charging sessions
+	EndAddress string `json:"end_address,omitempty"`
+	// Geofence at the start
+	StartGeofenceID *int `json:"start_geofence_id,omitempty"`
+	// Geofence at the end
+	EndGeofenceID *int `json:"end_geofence_id,omitempty"`
+	// Charging location, e.g., home, work, public
+	ChargeLocation string `json:"charge_location,omitempty"`
+	// Was a DC fast charger used
+	FastCharger bool `json:"fast_charger,omitempty"`
+	// Energy added while charging in kWh
+	EnergyAdded float64 `json:"energy_added,omitempty"`
+	// Energy added since plugged in as reported by the vehicle at the start of a charging session in kWh
+	StartEnergyAdded float64 `json:"start_energy_added,omitempty"`
+	// Energy used while driving in kWh, integrated from the power samples
+	EnergyUsed float64 `json:"energy_used,omitempty"`
+	// Seconds of the drive covered by the power integration
+	PowerSeconds int `json:"power_seconds,omitempty"`
+	// Time-weighted mean outside temperature while driving in Celsius
+	OutsideTemp *float64 `json:"outside_temp,omitempty"`
+	// Highest speed while driving in km/h
+	MaxSpeed float64 `json:"max_speed,omitempty"`
+	// Share of the drive with climate control on, from 0 to 1
+	ClimateRatio float64 `json:"climate_ratio,omitempty"`
+	// Charging cost, null when unknown
+	Cost *float64 `json:"cost,omitempty"`
+	// Was the cost entered manually, e.g., for a Supercharger session
+	CostManual bool `json:"cost_manual,omitempty"`
+	// Tariff the cost was calculated with
+	TariffID *int `json:"tariff_id,omitempty"`
+	// Name the owner gave a drive or charging session
+	Name string `json:"name,omitempty"`
+	// Tags the owner attached to a drive or charging session
+	Tags []string `json:"tags,omitempty"`
+	// Creation time
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Update time
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*VehicleStatePeriod) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case vehiclestateperiod.FieldTags:
+			values[i] = new([]byte)
+		case vehiclestateperiod.FieldSentryMode, vehiclestateperiod.FieldClimateOn, vehiclestateperiod.FieldFastCharger, vehiclestateperiod.FieldCostManual:
+			values[i] = new(sql.NullBool)
+		case vehiclestateperiod.FieldStartRange, vehiclestateperiod.FieldEndRange, vehiclestateperiod.FieldStartOdometer, vehiclestateperiod.FieldEndOdometer, vehiclestateperiod.FieldStartLatitude, vehiclestateperiod.FieldStartLongitude, vehiclestateperiod.FieldEndLatitude, vehiclestateperiod.FieldEndLongitude, vehiclestateperiod.FieldEnergyAdded, vehiclestateperiod.FieldStartEnergyAdded, vehiclestateperiod.FieldEnergyUsed, vehiclestateperiod.FieldOutsideTemp, vehiclestateperiod.FieldMaxSpeed, vehiclestateperiod.FieldClimateRatio, vehiclestateperiod.FieldCost:
+			values[i] = new(sql.NullFloat64)
+		case vehiclestateperiod.FieldID, vehiclestateperiod.FieldVehicleID, vehiclestateperiod.FieldStartBatteryLevel, vehiclestateperiod.FieldEndBatteryLevel, vehiclestateperiod.FieldStartGeofenceID, vehiclestateperiod.FieldEndGeofenceID, vehiclestateperiod.FieldPowerSeconds, vehiclestateperiod.FieldTariffID:
+			values[i] = new(sql.NullInt64)
+		case vehiclestateperiod.FieldState, vehiclestateperiod.FieldStartAddress, vehiclestateperiod.FieldEndAddress, vehiclestateperiod.FieldChargeLocation, vehiclestateperiod.FieldName:
+			values[i] = new(sql.NullString)
+		case vehiclestateperiod.FieldStartAt, vehiclestateperiod.FieldEndAt, vehiclestateperiod.FieldCreatedAt, vehiclestateperiod.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the VehicleStatePeriod fields.
+func (_m *VehicleStatePeriod) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case vehiclestateperiod.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case vehiclestateperiod.FieldVehicleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vehicle_id", values[i])
+			} else if value.Valid {
+				_m.VehicleID = int(value.Int64)
+			}
+		case vehiclestateperiod.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = value.String
+			}
+		case vehiclestateperiod.FieldSentryMode:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sentry_mode", values[i])
+			} else if value.Valid {
+				_m.SentryMode = value.Bool
+			}
+		case vehiclestateperiod.FieldClimateOn:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field climate_on", values[i])
+			} else if value.Valid {
+				_m.ClimateOn = value.Bool
+			}
+		case vehiclestateperiod.FieldStartAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_at", values[i])
+			} else if value.Valid {
+				_m.StartAt = value.Time
+			}
+		case vehiclestateperiod.FieldEndAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_at", values[i])
+			} else if value.Valid {
+				_m.EndAt = new(time.Time)
+				*_m.EndAt = value.Time
+			}
+		case vehiclestateperiod.FieldStartBatteryLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_battery_level", values[i])
+			} else if value.Valid {
+				_m.StartBatteryLevel = int(value.Int64)
+			}
+		case vehiclestateperiod.FieldEndBatteryLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_battery_level", values[i])
+			} else if value.Valid {
+				_m.EndBatteryLevel = int(value.Int64)
+			}
+		case vehiclestateperiod.FieldStartRange:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_range", values[i])
+			} else if value.Valid {
+				_m.StartRange = value.Float64
+			}
+		case vehiclestateperiod.FieldEndRange:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_range", values[i])
+			} else if value.Valid {
+				_m.EndRange = value.Float64
+			}
+		case vehiclestateperiod.FieldStartOdometer:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_odometer", values[i])
+			} else if value.Valid {
+				_m.StartOdometer = value.Float64
+			}
+		case vehiclestateperiod.FieldEndOdometer:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_odometer", values[i])
+			} else if value.Valid {
+				_m.EndOdometer = value.Float64
+			}
+		case vehiclestateperiod.FieldStartLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_latitude", values[i])
+			} else if value.Valid {
+				_m.StartLatitude = value.Float64
+			}
+		case vehiclestateperiod.FieldStartLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_longitude", values[i])
+			} else if value.Valid {
+				_m.StartLongitude = value.Float64
+			}
+		case vehiclestateperiod.FieldEndLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_latitude", values[i])
+			} else if value.Valid {
+				_m.EndLatitude = value.Float64
+			}
+		case vehiclestateperiod.FieldEndLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_longitude", values[i])
+			} else if value.Valid {
+				_m.EndLongitude = value.Float64
+			}
+		case vehiclestateperiod.FieldStartAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field start_address", values[i])
+			} else if value.Valid {
+				_m.StartAddress = value.String
+			}
+		case vehiclestateperiod.FieldEndAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field end_address", values[i])
+			} else if value.Valid {
+				_m.EndAddress = value.String
+			}
+		case vehiclestateperiod.FieldStartGeofenceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_geofence_id", values[i])
+			} else if value.Valid {
+				_m.StartGeofenceID = new(int)
+				*_m.StartGeofenceID = int(value.Int64)
+			}
+		case vehiclestateperiod.FieldEndGeofenceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_geofence_id", values[i])
+			} else if value.Valid {
+				_m.EndGeofenceID = new(int)
+				*_m.EndGeofenceID = int(value.Int64)
+			}
+		case vehiclestateperiod.FieldChargeLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field charge_location", values[i])
+			} else if value.Valid {
+				_m.ChargeLocation = value.String
+			}
+		case vehiclestateperiod.FieldFastCharger:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field fast_charger", values[i])
+			} else if value.Valid {
+				_m.FastCharger = value.Bool
+			}
+		case vehiclestateperiod.FieldEnergyAdded:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field energy_added", values[i])
+			} else if value.Valid {
+				_m.EnergyAdded = value.Float64
+			}
+		case vehiclestateperiod.FieldStartEnergyAdded:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_energy_added", values[i])
+			} else if value.Valid {
+				_m.StartEnergyAdded = value.Float64
+			}
+		case vehiclestateperiod.FieldEnergyUsed:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field energy_used", values[i])
+			} else if value.Valid {
+				_m.EnergyUsed = value.Float64
+			}
+		case vehiclestateperiod.FieldPowerSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field power_seconds", values[i])
+			} else if value.Valid {
+				_m.PowerSeconds = int(value.Int64)
+			}
+		case vehiclestateperiod.FieldOutsideTemp:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field outside_temp", values[i])
+			} else if value.Valid {
+				_m.OutsideTemp = new(float64)
+				*_m.OutsideTemp = value.Float64
+			}
+		case vehiclestateperiod.FieldMaxSpeed:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_speed", values[i])
+			} else if value.Valid {
+				_m.MaxSpeed = value.Float64
+			}
+		case vehiclestateperiod.FieldClimateRatio:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field climate_ratio", values[i])
+			} else if value.Valid {
+				_m.ClimateRatio = value.Float64
+			}
+		case vehiclestateperiod.FieldCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost", values[i])
+			} else if value.Valid {
+				_m.Cost = new(float64)
+				*_m.Cost = value.Float64
+			}
+		case vehiclestateperiod.FieldCostManual:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_manual", values[i])
+			} else if value.Valid {
+				_m.CostManual = value.Bool
+			}
+		case vehiclestateperiod.FieldTariffID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tariff_id", values[i])
+			} else if value.Valid {
+				_m.TariffID = new(int)
+				*_m.TariffID = int(value.Int64)
+			}
+		case vehiclestateperiod.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case vehiclestateperiod.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case vehiclestateperiod.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case vehiclestateperiod.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the VehicleStatePeriod.
+// This includes values selected through modifiers, order, etc.
+func (_m *VehicleStatePeriod) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this VehicleStatePeriod.
+// Note that you need to call VehicleStatePeriod.Unwrap() before calling this method if this VehicleStatePeriod
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *VehicleStatePeriod) Update() *VehicleStatePeriodUpdateOne {
+	return NewVehicleStatePeriodClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the VehicleStatePeriod entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *VehicleStatePeriod) Unwrap() *VehicleStatePeriod {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VehicleStatePeriod is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *VehicleStatePeriod) String() string {
+	var builder strings.Builder
+	builder.WriteString("VehicleStatePeriod(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("vehicle_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VehicleID))
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(_m.State)
+	builder.WriteString(", ")
+	builder.WriteString("sentry_mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SentryMode))
+	builder.WriteString(", ")
+	builder.WriteString("climate_on=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClimateOn))
+	builder.WriteString(", ")
+	builder.WriteString("start_at=")
+	builder.WriteString(_m.StartAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.EndAt; v != nil {
+		builder.WriteString("end_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("start_battery_level=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartBatteryLevel))
+	builder.WriteString(", ")
+	builder.WriteString("end_battery_level=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EndBatteryLevel))
+	builder.WriteString(", ")
+	builder.WriteString("start_range=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartRange))
+	builder.WriteString(", ")
+	builder.WriteString("end_range=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EndRange))
+	builder.WriteString(", ")
+	builder.WriteString("start_odometer=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartOdometer))
+	builder.WriteString(", ")
+	builder.WriteString("end_odometer=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EndOdometer))
+	builder.WriteString(", ")
+	builder.WriteString("start_latitude=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartLatitude))
+	builder.WriteString(", ")
+	builder.WriteString("start_longitude=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartLongitude))
+	builder.WriteString(", ")
+	builder.WriteString("end_latitude=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EndLatitude))
+	builder.WriteString(", ")
+	builder.WriteString("end_longitude=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EndLongitude))
+	builder.WriteString(", ")
+	builder.WriteString("start_address=")
+	builder.WriteString(_m.StartAddress)
+	builder.WriteString(", ")
+	builder.WriteString("end_address=")
+	builder.WriteString(_m.EndAddress)
+	builder.WriteString(", ")
+	if v := _m.StartGeofenceID; v != nil {
+		builder.WriteString("start_geofence_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.EndGeofenceID; v != nil {
+		builder.WriteString("end_geofence_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("charge_location=")
+	builder.WriteString(_m.ChargeLocation)
+	builder.WriteString(", ")
+	builder.WriteString("fast_charger=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FastCharger))
+	builder.WriteString(", ")
+	builder.WriteString("energy_added=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnergyAdded))
+	builder.WriteString(", ")
+	builder.WriteString("start_energy_added=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartEnergyAdded))
+	builder.WriteString(", ")
+	builder.WriteString("energy_used=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EnergyUsed))
+	builder.WriteString(", ")
+	builder.WriteString("power_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PowerSeconds))
+	builder.WriteString(", ")
+	if v := _m.OutsideTemp; v != nil {
+		builder.WriteString("outside_temp=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("max_speed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxSpeed))
+	builder.WriteString(", ")
+	builder.WriteString("climate_ratio=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClimateRatio))
+	builder.WriteString(", ")
+	if v := _m.Cost; v != nil {
+		builder.WriteString("cost=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cost_manual=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CostManual))
+	builder.WriteString(", ")
+	if v := _m.TariffID; v != nil {
+		builder.WriteString("tariff_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VehicleStatePeriods is a parsable slice of VehicleStatePeriod.
+type VehicleStatePeriods []*VehicleStatePeriod
@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vehiclestateperiod
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the vehiclestateperiod type in the database.
+	Label = "vehicle_state_period"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVehicleID holds the string denoting the vehicle_id field in the database.
+	FieldVehicleID = "vehicle_id"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldSentryMode holds the string denoting the sentry_mode field in the database.
+	FieldSentryMode = "sentry_mode"
+	// FieldClimateOn holds the string denoting the climate_on field in the database.
+	FieldClimateOn = "climate_on"
+	// FieldStartAt holds the string denoting the start_at field in the database.
+	FieldStartAt = "start_at"
+	// FieldEndAt holds the string denoting the end_at field in the database.
+	FieldEndAt = "end_at"
+	// FieldStartBatteryLevel holds the string denoting the start_battery_level field in the database.
+	FieldStartBatteryLevel = "start_battery_level"
+	// FieldEndBatteryLevel holds the string denoting the end_battery_level field in the database.
+	FieldEndBatteryLevel = "end_battery_level"
+	// FieldStartRange holds the string denoting the start_range field in the database.
+	FieldStartRange = "start_range"
+	// FieldEndRange holds the string denoting the end_range field in the database.
+	FieldEndRange = "end_range"
+	// FieldStartOdometer holds the string denoting the start_odometer field in the database.
+	FieldStartOdometer = "start_odometer"
+	// FieldEndOdometer holds the string denoting the end_odometer field in the database.
+	FieldEndOdometer = "end_odometer"
+	// FieldStartLatitude holds the string denoting the start_latitude field in the database.
+	FieldStartLatitude = "start_latitude"
+	// FieldStartLongitude holds the string denoting the start_longitude field in the database.
+	FieldStartLongitude = "start_longitude"
+	// FieldEndLatitude holds the string denoting the end_latitude field in the database.
+	FieldEndLatitude = "end_latitude"
+	// FieldEndLongitude holds the string denoting the end_longitude field in the database.
+	FieldEndLongitude = "end_longitude"
+	// FieldStartAddress holds the string denoting the start_address field in the database.
+	FieldStartAddress = "start_address"
+	// FieldEndAddress holds the string denoting the end_address field in the database.
+	FieldEndAddress = "end_address"
+	// FieldStartGeofenceID holds the string denoting the start_geofence_id field in the database.
+	FieldStartGeofenceID = "start_geofence_id"
+	// FieldEndGeofenceID holds the string denoting the end_geofence_id field in the database.
+	FieldEndGeofenceID = "end_geofence_id"
+	// FieldChargeLocation holds the string denoting the charge_location field in the database.
+	FieldChargeLocation = "charge_location"
+	// FieldFastCharger holds the string denoting the fast_charger field in the database.
+	FieldFastCharger = "fast_charger"
+	// FieldEnergyAdded holds the string denoting the energy_added field in the database.
+	FieldEnergyAdded = "energy_added"
+	// FieldStartEnergyAdded holds the string denoting the start_energy_added field in the database.
+	FieldStartEnergyAdded = "start_energy_added"
+	// FieldEnergyUsed holds the string denoting the energy_used field in the database.
+	FieldEnergyUsed = "energy_used"
+	// FieldPowerSeconds holds the string denoting the power_seconds field in the database.
+	FieldPowerSeconds = "power_seconds"
+	// FieldOutsideTemp holds the string denoting the outside_temp field in the database.
+	FieldOutsideTemp = "outside_temp"
+	// FieldMaxSpeed holds the string denoting the max_speed field in the database.
+	FieldMaxSpeed = "max_speed"
+	// FieldClimateRatio holds the string denoting the climate_ratio field in the database.
+	FieldClimateRatio = "climate_ratio"
+	// FieldCost holds the string denoting the cost field in the database.
+	FieldCost = "cost"
+	// FieldCostManual holds the string denoting the cost_manual field in the database.
+	FieldCostManual = "cost_manual"
+	// FieldTariffID holds the string denoting the tariff_id field in the database.
+	FieldTariffID = "tariff_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the vehiclestateperiod in the database.
+	Table = "vehicle_state_period"
+)
+
+// Columns holds all SQL columns for vehiclestateperiod fields.
+var Columns = []string{
+	FieldID,
+	FieldVehicleID,
+	FieldState,
+	FieldSentryMode,
+	FieldClimateOn,
+	FieldStartAt,
+	FieldEndAt,
+	FieldStartBatteryLevel,
+	FieldEndBatteryLevel,
+	FieldStartRange,
+	FieldEndRange,
+	FieldStartOdometer,
+	FieldEndOdometer,
+	FieldStartLatitude,
+	FieldStartLongitude,
+	FieldEndLatitude,
+	FieldEndLongitude,
+	FieldStartAddress,
+	FieldEndAddress,
+	FieldStartGeofenceID,
+	FieldEndGeofenceID,
+	FieldChargeLocation,
+	FieldFastCharger,
+	FieldEnergyAdded,
+	FieldStartEnergyAdded,
+	FieldEnergyUsed,
+	FieldPowerSeconds,
+	FieldOutsideTemp,
+	FieldMaxSpeed,
+	FieldClimateRatio,
+	FieldCost,
+	FieldCostManual,
+	FieldTariffID,
+	FieldName,
+	FieldTags,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultSentryMode holds the default value on creation for the "sentry_mode" field.
+	DefaultSentryMode bool
+	// DefaultClimateOn holds the default value on creation for the "climate_on" field.
+	DefaultClimateOn bool
+	// DefaultFastCharger holds the default value on creation for the "fast_charger" field.
+	DefaultFastCharger bool
+	// DefaultCostManual holds the default value on creation for the "cost_manual" field.
+	DefaultCostManual bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the VehicleStatePeriod queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVehicleID orders the results by the vehicle_id field.
+func ByVehicleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVehicleID, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// BySentryMode orders the results by the sentry_mode field.
+func BySentryMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSentryMode, opts...).ToFunc()
+}
+
+// ByClimateOn orders the results by the climate_on field.
+func ByClimateOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClimateOn, opts...).ToFunc()
+}
+
+// ByStartAt orders the results by the start_at field.
+func ByStartAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartAt, opts...).ToFunc()
+}
+
+// ByEndAt orders the results by the end_at field.
+func ByEndAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndAt, opts...).ToFunc()
+}
+
+// ByStartBatteryLevel orders the results by the start_battery_level field.
+func ByStartBatteryLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartBatteryLevel, opts...).ToFunc()
+}
+
+// ByEndBatteryLevel orders the results by the end_battery_level field.
+func ByEndBatteryLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndBatteryLevel, opts...).ToFunc()
+}
+
+// ByStartRange orders the results by the start_range field.
+func ByStartRange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartRange, opts...).ToFunc()
+}
+
+// ByEndRange orders the results by the end_range field.
+func ByEndRange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndRange, opts...).ToFunc()
+}
+
+// ByStartOdometer orders the results by the start_odometer field.
+func ByStartOdometer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartOdometer, opts...).ToFunc()
+}
+
+// ByEndOdometer orders the results by the end_odometer field.
+func ByEndOdometer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndOdometer, opts...).ToFunc()
+}
+
+// ByStartLatitude orders the results by the start_latitude field.
+func ByStartLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartLatitude, opts...).ToFunc()
+}
+
+// ByStartLongitude orders the results by the start_longitude field.
+func ByStartLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartLongitude, opts...).ToFunc()
+}
+
+// ByEndLatitude orders the results by the end_latitude field.
+func ByEndLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndLatitude, opts...).ToFunc()
+}
+
+// ByEndLongitude orders the results by the end_longitude field.
+func ByEndLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndLongitude, opts...).ToFunc()
+}
+
+// ByStartAddress orders the results by the start_address field.
+func ByStartAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartAddress, opts...).ToFunc()
+}
+
+// ByEndAddress orders the results by the end_address field.
+func ByEndAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndAddress, opts...).ToFunc()
+}
+
+// ByStartGeofenceID orders the results by the start_geofence_id field.
+func ByStartGeofenceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartGeofenceID, opts...).ToFunc()
+}
+
+// ByEndGeofenceID orders the results by the end_geofence_id field.
+func ByEndGeofenceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndGeofenceID, opts...).ToFunc()
+}
+
+// ByChargeLocation orders the results by the charge_location field.
+func ByChargeLocation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChargeLocation, opts...).ToFunc()
+}
+
+// ByFastCharger orders the results by the fast_charger field.
+func ByFastCharger(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFastCharger, opts...).ToFunc()
+}
+
+// ByEnergyAdded orders the results by the energy_added field.
+func ByEnergyAdded(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnergyAdded, opts...).ToFunc()
+}
+
+// ByStartEnergyAdded orders the results by the start_energy_added field.
+func ByStartEnergyAdded(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartEnergyAdded, opts...).ToFunc()
+}
+
+// ByEnergyUsed orders the results by the energy_used field.
+func ByEnergyUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnergyUsed, opts...).ToFunc()
+}
+
+// ByPowerSeconds orders the results by the power_seconds field.
+func ByPowerSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPowerSeconds, opts...).ToFunc()
+}
+
+// ByOutsideTemp orders the results by the outside_temp field.
+func ByOutsideTemp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutsideTemp, opts...).ToFunc()
+}
+
+// ByMaxSpeed orders the results by the max_speed field.
+func ByMaxSpeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxSpeed, opts...).ToFunc()
+}
+
+// ByClimateRatio orders the results by the climate_ratio field.
+func ByClimateRatio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClimateRatio, opts...).ToFunc()
+}
+
+// ByCost orders the results by the cost field.
+func ByCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCost, opts...).ToFunc()
+}
+
+// ByCostManual orders the results by the cost_manual field.
+func ByCostManual(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostManual, opts...).ToFunc()
+}
+
+// ByTariffID orders the results by the tariff_id field.
+func ByTariffID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTariffID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
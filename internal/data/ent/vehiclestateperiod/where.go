@@ -0,0 +1,1920 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vehiclestateperiod
+
+import (
+	"teslatrack/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldID, id))
+}
+
+// VehicleID applies equality check predicate on the "vehicle_id" field. It's identical to VehicleIDEQ.
+func VehicleID(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldState, v))
+}
+
+// SentryMode applies equality check predicate on the "sentry_mode" field. It's identical to SentryModeEQ.
+func SentryMode(v bool) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldSentryMode, v))
+}
+
+// ClimateOn applies equality check predicate on the "climate_on" field. It's identical to ClimateOnEQ.
+func ClimateOn(v bool) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldClimateOn, v))
+}
+
+// StartAt applies equality check predicate on the "start_at" field. It's identical to StartAtEQ.
+func StartAt(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartAt, v))
+}
+
+// EndAt applies equality check predicate on the "end_at" field. It's identical to EndAtEQ.
+func EndAt(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEndAt, v))
+}
+
+// StartBatteryLevel applies equality check predicate on the "start_battery_level" field. It's identical to StartBatteryLevelEQ.
+func StartBatteryLevel(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartBatteryLevel, v))
+}
+
+// EndBatteryLevel applies equality check predicate on the "end_battery_level" field. It's identical to EndBatteryLevelEQ.
+func EndBatteryLevel(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEndBatteryLevel, v))
+}
+
+// StartRange applies equality check predicate on the "start_range" field. It's identical to StartRangeEQ.
+func StartRange(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartRange, v))
+}
+
+// EndRange applies equality check predicate on the "end_range" field. It's identical to EndRangeEQ.
+func EndRange(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEndRange, v))
+}
+
+// StartOdometer applies equality check predicate on the "start_odometer" field. It's identical to StartOdometerEQ.
+func StartOdometer(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartOdometer, v))
+}
+
+// EndOdometer applies equality check predicate on the "end_odometer" field. It's identical to EndOdometerEQ.
+func EndOdometer(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEndOdometer, v))
+}
+
+// StartLatitude applies equality check predicate on the "start_latitude" field. It's identical to StartLatitudeEQ.
+func StartLatitude(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartLatitude, v))
+}
+
+// StartLongitude applies equality check predicate on the "start_longitude" field. It's identical to StartLongitudeEQ.
+func StartLongitude(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartLongitude, v))
+}
+
+// EndLatitude applies equality check predicate on the "end_latitude" field. It's identical to EndLatitudeEQ.
+func EndLatitude(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEndLatitude, v))
+}
+
+// EndLongitude applies equality check predicate on the "end_longitude" field. It's identical to EndLongitudeEQ.
+func EndLongitude(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEndLongitude, v))
+}
+
+// StartAddress applies equality check predicate on the "start_address" field. It's identical to StartAddressEQ.
+func StartAddress(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartAddress, v))
+}
+
+// EndAddress applies equality check predicate on the "end_address" field. It's identical to EndAddressEQ.
+func EndAddress(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEndAddress, v))
+}
+
+// StartGeofenceID applies equality check predicate on the "start_geofence_id" field. It's identical to StartGeofenceIDEQ.
+func StartGeofenceID(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartGeofenceID, v))
+}
+
+// EndGeofenceID applies equality check predicate on the "end_geofence_id" field. It's identical to EndGeofenceIDEQ.
+func EndGeofenceID(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEndGeofenceID, v))
+}
+
+// ChargeLocation applies equality check predicate on the "charge_location" field. It's identical to ChargeLocationEQ.
+func ChargeLocation(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldChargeLocation, v))
+}
+
+// FastCharger applies equality check predicate on the "fast_charger" field. It's identical to FastChargerEQ.
+func FastCharger(v bool) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldFastCharger, v))
+}
+
+// EnergyAdded applies equality check predicate on the "energy_added" field. It's identical to EnergyAddedEQ.
+func EnergyAdded(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEnergyAdded, v))
+}
+
+// StartEnergyAdded applies equality check predicate on the "start_energy_added" field. It's identical to StartEnergyAddedEQ.
+func StartEnergyAdded(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartEnergyAdded, v))
+}
+
+// EnergyUsed applies equality check predicate on the "energy_used" field. It's identical to EnergyUsedEQ.
+func EnergyUsed(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEnergyUsed, v))
+}
+
+// PowerSeconds applies equality check predicate on the "power_seconds" field. It's identical to PowerSecondsEQ.
+func PowerSeconds(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldPowerSeconds, v))
+}
+
+// OutsideTemp applies equality check predicate on the "outside_temp" field. It's identical to OutsideTempEQ.
+func OutsideTemp(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldOutsideTemp, v))
+}
+
+// MaxSpeed applies equality check predicate on the "max_speed" field. It's identical to MaxSpeedEQ.
+func MaxSpeed(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldMaxSpeed, v))
+}
+
+// ClimateRatio applies equality check predicate on the "climate_ratio" field. It's identical to ClimateRatioEQ.
+func ClimateRatio(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldClimateRatio, v))
+}
+
+// Cost applies equality check predicate on the "cost" field. It's identical to CostEQ.
+func Cost(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldCost, v))
+}
+
+// CostManual applies equality check predicate on the "cost_manual" field. It's identical to CostManualEQ.
+func CostManual(v bool) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldCostManual, v))
+}
+
+// TariffID applies equality check predicate on the "tariff_id" field. It's identical to TariffIDEQ.
+func TariffID(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldTariffID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldName, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// VehicleIDEQ applies the EQ predicate on the "vehicle_id" field.
+func VehicleIDEQ(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// VehicleIDNEQ applies the NEQ predicate on the "vehicle_id" field.
+func VehicleIDNEQ(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldVehicleID, v))
+}
+
+// VehicleIDIn applies the In predicate on the "vehicle_id" field.
+func VehicleIDIn(vs ...int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldVehicleID, vs...))
+}
+
+// VehicleIDNotIn applies the NotIn predicate on the "vehicle_id" field.
+func VehicleIDNotIn(vs ...int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldVehicleID, vs...))
+}
+
+// VehicleIDGT applies the GT predicate on the "vehicle_id" field.
+func VehicleIDGT(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldVehicleID, v))
+}
+
+// VehicleIDGTE applies the GTE predicate on the "vehicle_id" field.
+func VehicleIDGTE(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldVehicleID, v))
+}
+
+// VehicleIDLT applies the LT predicate on the "vehicle_id" field.
+func VehicleIDLT(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldVehicleID, v))
+}
+
+// VehicleIDLTE applies the LTE predicate on the "vehicle_id" field.
+func VehicleIDLTE(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldVehicleID, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldState, vs...))
+}
+
+// StateGT applies the GT predicate on the "state" field.
+func StateGT(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldState, v))
+}
+
+// StateGTE applies the GTE predicate on the "state" field.
+func StateGTE(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldState, v))
+}
+
+// StateLT applies the LT predicate on the "state" field.
+func StateLT(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldState, v))
+}
+
+// StateLTE applies the LTE predicate on the "state" field.
+func StateLTE(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldState, v))
+}
+
+// StateContains applies the Contains predicate on the "state" field.
+func StateContains(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldContains(FieldState, v))
+}
+
+// StateHasPrefix applies the HasPrefix predicate on the "state" field.
+func StateHasPrefix(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldHasPrefix(FieldState, v))
+}
+
+// StateHasSuffix applies the HasSuffix predicate on the "state" field.
+func StateHasSuffix(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldHasSuffix(FieldState, v))
+}
+
+// StateEqualFold applies the EqualFold predicate on the "state" field.
+func StateEqualFold(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEqualFold(FieldState, v))
+}
+
+// StateContainsFold applies the ContainsFold predicate on the "state" field.
+func StateContainsFold(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldContainsFold(FieldState, v))
+}
+
+// SentryModeEQ applies the EQ predicate on the "sentry_mode" field.
+func SentryModeEQ(v bool) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldSentryMode, v))
+}
+
+// SentryModeNEQ applies the NEQ predicate on the "sentry_mode" field.
+func SentryModeNEQ(v bool) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldSentryMode, v))
+}
+
+// ClimateOnEQ applies the EQ predicate on the "climate_on" field.
+func ClimateOnEQ(v bool) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldClimateOn, v))
+}
+
+// ClimateOnNEQ applies the NEQ predicate on the "climate_on" field.
+func ClimateOnNEQ(v bool) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldClimateOn, v))
+}
+
+// StartAtEQ applies the EQ predicate on the "start_at" field.
+func StartAtEQ(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartAt, v))
+}
+
+// StartAtNEQ applies the NEQ predicate on the "start_at" field.
+func StartAtNEQ(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldStartAt, v))
+}
+
+// StartAtIn applies the In predicate on the "start_at" field.
+func StartAtIn(vs ...time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldStartAt, vs...))
+}
+
+// StartAtNotIn applies the NotIn predicate on the "start_at" field.
+func StartAtNotIn(vs ...time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldStartAt, vs...))
+}
+
+// StartAtGT applies the GT predicate on the "start_at" field.
+func StartAtGT(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldStartAt, v))
+}
+
+// StartAtGTE applies the GTE predicate on the "start_at" field.
+func StartAtGTE(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldStartAt, v))
+}
+
+// StartAtLT applies the LT predicate on the "start_at" field.
+func StartAtLT(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldStartAt, v))
+}
+
+// StartAtLTE applies the LTE predicate on the "start_at" field.
+func StartAtLTE(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldStartAt, v))
+}
+
+// EndAtEQ applies the EQ predicate on the "end_at" field.
+func EndAtEQ(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEndAt, v))
+}
+
+// EndAtNEQ applies the NEQ predicate on the "end_at" field.
+func EndAtNEQ(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldEndAt, v))
+}
+
+// EndAtIn applies the In predicate on the "end_at" field.
+func EndAtIn(vs ...time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldEndAt, vs...))
+}
+
+// EndAtNotIn applies the NotIn predicate on the "end_at" field.
+func EndAtNotIn(vs ...time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldEndAt, vs...))
+}
+
+// EndAtGT applies the GT predicate on the "end_at" field.
+func EndAtGT(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldEndAt, v))
+}
+
+// EndAtGTE applies the GTE predicate on the "end_at" field.
+func EndAtGTE(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldEndAt, v))
+}
+
+// EndAtLT applies the LT predicate on the "end_at" field.
+func EndAtLT(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldEndAt, v))
+}
+
+// EndAtLTE applies the LTE predicate on the "end_at" field.
+func EndAtLTE(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldEndAt, v))
+}
+
+// EndAtIsNil applies the IsNil predicate on the "end_at" field.
+func EndAtIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldEndAt))
+}
+
+// EndAtNotNil applies the NotNil predicate on the "end_at" field.
+func EndAtNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldEndAt))
+}
+
+// StartBatteryLevelEQ applies the EQ predicate on the "start_battery_level" field.
+func StartBatteryLevelEQ(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartBatteryLevel, v))
+}
+
+// StartBatteryLevelNEQ applies the NEQ predicate on the "start_battery_level" field.
+func StartBatteryLevelNEQ(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldStartBatteryLevel, v))
+}
+
+// StartBatteryLevelIn applies the In predicate on the "start_battery_level" field.
+func StartBatteryLevelIn(vs ...int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldStartBatteryLevel, vs...))
+}
+
+// StartBatteryLevelNotIn applies the NotIn predicate on the "start_battery_level" field.
+func StartBatteryLevelNotIn(vs ...int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldStartBatteryLevel, vs...))
+}
+
+// StartBatteryLevelGT applies the GT predicate on the "start_battery_level" field.
+func StartBatteryLevelGT(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldStartBatteryLevel, v))
+}
+
+// StartBatteryLevelGTE applies the GTE predicate on the "start_battery_level" field.
+func StartBatteryLevelGTE(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldStartBatteryLevel, v))
+}
+
+// StartBatteryLevelLT applies the LT predicate on the "start_battery_level" field.
+func StartBatteryLevelLT(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldStartBatteryLevel, v))
+}
+
+// StartBatteryLevelLTE applies the LTE predicate on the "start_battery_level" field.
+func StartBatteryLevelLTE(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldStartBatteryLevel, v))
+}
+
+// StartBatteryLevelIsNil applies the IsNil predicate on the "start_battery_level" field.
+func StartBatteryLevelIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldStartBatteryLevel))
+}
+
+// StartBatteryLevelNotNil applies the NotNil predicate on the "start_battery_level" field.
+func StartBatteryLevelNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldStartBatteryLevel))
+}
+
+// EndBatteryLevelEQ applies the EQ predicate on the "end_battery_level" field.
+func EndBatteryLevelEQ(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEndBatteryLevel, v))
+}
+
+// EndBatteryLevelNEQ applies the NEQ predicate on the "end_battery_level" field.
+func EndBatteryLevelNEQ(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldEndBatteryLevel, v))
+}
+
+// EndBatteryLevelIn applies the In predicate on the "end_battery_level" field.
+func EndBatteryLevelIn(vs ...int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldEndBatteryLevel, vs...))
+}
+
+// EndBatteryLevelNotIn applies the NotIn predicate on the "end_battery_level" field.
+func EndBatteryLevelNotIn(vs ...int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldEndBatteryLevel, vs...))
+}
+
+// EndBatteryLevelGT applies the GT predicate on the "end_battery_level" field.
+func EndBatteryLevelGT(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldEndBatteryLevel, v))
+}
+
+// EndBatteryLevelGTE applies the GTE predicate on the "end_battery_level" field.
+func EndBatteryLevelGTE(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldEndBatteryLevel, v))
+}
+
+// EndBatteryLevelLT applies the LT predicate on the "end_battery_level" field.
+func EndBatteryLevelLT(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldEndBatteryLevel, v))
+}
+
+// EndBatteryLevelLTE applies the LTE predicate on the "end_battery_level" field.
+func EndBatteryLevelLTE(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldEndBatteryLevel, v))
+}
+
+// EndBatteryLevelIsNil applies the IsNil predicate on the "end_battery_level" field.
+func EndBatteryLevelIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldEndBatteryLevel))
+}
+
+// EndBatteryLevelNotNil applies the NotNil predicate on the "end_battery_level" field.
+func EndBatteryLevelNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldEndBatteryLevel))
+}
+
+// StartRangeEQ applies the EQ predicate on the "start_range" field.
+func StartRangeEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartRange, v))
+}
+
+// StartRangeNEQ applies the NEQ predicate on the "start_range" field.
+func StartRangeNEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldStartRange, v))
+}
+
+// StartRangeIn applies the In predicate on the "start_range" field.
+func StartRangeIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldStartRange, vs...))
+}
+
+// StartRangeNotIn applies the NotIn predicate on the "start_range" field.
+func StartRangeNotIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldStartRange, vs...))
+}
+
+// StartRangeGT applies the GT predicate on the "start_range" field.
+func StartRangeGT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldStartRange, v))
+}
+
+// StartRangeGTE applies the GTE predicate on the "start_range" field.
+func StartRangeGTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldStartRange, v))
+}
+
+// StartRangeLT applies the LT predicate on the "start_range" field.
+func StartRangeLT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldStartRange, v))
+}
+
+// StartRangeLTE applies the LTE predicate on the "start_range" field.
+func StartRangeLTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldStartRange, v))
+}
+
+// StartRangeIsNil applies the IsNil predicate on the "start_range" field.
+func StartRangeIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldStartRange))
+}
+
+// StartRangeNotNil applies the NotNil predicate on the "start_range" field.
+func StartRangeNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldStartRange))
+}
+
+// EndRangeEQ applies the EQ predicate on the "end_range" field.
+func EndRangeEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEndRange, v))
+}
+
+// EndRangeNEQ applies the NEQ predicate on the "end_range" field.
+func EndRangeNEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldEndRange, v))
+}
+
+// EndRangeIn applies the In predicate on the "end_range" field.
+func EndRangeIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldEndRange, vs...))
+}
+
+// EndRangeNotIn applies the NotIn predicate on the "end_range" field.
+func EndRangeNotIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldEndRange, vs...))
+}
+
+// EndRangeGT applies the GT predicate on the "end_range" field.
+func EndRangeGT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldEndRange, v))
+}
+
+// EndRangeGTE applies the GTE predicate on the "end_range" field.
+func EndRangeGTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldEndRange, v))
+}
+
+// EndRangeLT applies the LT predicate on the "end_range" field.
+func EndRangeLT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldEndRange, v))
+}
+
+// EndRangeLTE applies the LTE predicate on the "end_range" field.
+func EndRangeLTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldEndRange, v))
+}
+
+// EndRangeIsNil applies the IsNil predicate on the "end_range" field.
+func EndRangeIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldEndRange))
+}
+
+// EndRangeNotNil applies the NotNil predicate on the "end_range" field.
+func EndRangeNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldEndRange))
+}
+
+// StartOdometerEQ applies the EQ predicate on the "start_odometer" field.
+func StartOdometerEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartOdometer, v))
+}
+
+// StartOdometerNEQ applies the NEQ predicate on the "start_odometer" field.
+func StartOdometerNEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldStartOdometer, v))
+}
+
+// StartOdometerIn applies the In predicate on the "start_odometer" field.
+func StartOdometerIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldStartOdometer, vs...))
+}
+
+// StartOdometerNotIn applies the NotIn predicate on the "start_odometer" field.
+func StartOdometerNotIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldStartOdometer, vs...))
+}
+
+// StartOdometerGT applies the GT predicate on the "start_odometer" field.
+func StartOdometerGT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldStartOdometer, v))
+}
+
+// StartOdometerGTE applies the GTE predicate on the "start_odometer" field.
+func StartOdometerGTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldStartOdometer, v))
+}
+
+// StartOdometerLT applies the LT predicate on the "start_odometer" field.
+func StartOdometerLT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldStartOdometer, v))
+}
+
+// StartOdometerLTE applies the LTE predicate on the "start_odometer" field.
+func StartOdometerLTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldStartOdometer, v))
+}
+
+// StartOdometerIsNil applies the IsNil predicate on the "start_odometer" field.
+func StartOdometerIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldStartOdometer))
+}
+
+// StartOdometerNotNil applies the NotNil predicate on the "start_odometer" field.
+func StartOdometerNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldStartOdometer))
+}
+
+// EndOdometerEQ applies the EQ predicate on the "end_odometer" field.
+func EndOdometerEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEndOdometer, v))
+}
+
+// EndOdometerNEQ applies the NEQ predicate on the "end_odometer" field.
+func EndOdometerNEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldEndOdometer, v))
+}
+
+// EndOdometerIn applies the In predicate on the "end_odometer" field.
+func EndOdometerIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldEndOdometer, vs...))
+}
+
+// EndOdometerNotIn applies the NotIn predicate on the "end_odometer" field.
+func EndOdometerNotIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldEndOdometer, vs...))
+}
+
+// EndOdometerGT applies the GT predicate on the "end_odometer" field.
+func EndOdometerGT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldEndOdometer, v))
+}
+
+// EndOdometerGTE applies the GTE predicate on the "end_odometer" field.
+func EndOdometerGTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldEndOdometer, v))
+}
+
+// EndOdometerLT applies the LT predicate on the "end_odometer" field.
+func EndOdometerLT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldEndOdometer, v))
+}
+
+// EndOdometerLTE applies the LTE predicate on the "end_odometer" field.
+func EndOdometerLTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldEndOdometer, v))
+}
+
+// EndOdometerIsNil applies the IsNil predicate on the "end_odometer" field.
+func EndOdometerIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldEndOdometer))
+}
+
+// EndOdometerNotNil applies the NotNil predicate on the "end_odometer" field.
+func EndOdometerNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldEndOdometer))
+}
+
+// StartLatitudeEQ applies the EQ predicate on the "start_latitude" field.
+func StartLatitudeEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartLatitude, v))
+}
+
+// StartLatitudeNEQ applies the NEQ predicate on the "start_latitude" field.
+func StartLatitudeNEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldStartLatitude, v))
+}
+
+// StartLatitudeIn applies the In predicate on the "start_latitude" field.
+func StartLatitudeIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldStartLatitude, vs...))
+}
+
+// StartLatitudeNotIn applies the NotIn predicate on the "start_latitude" field.
+func StartLatitudeNotIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldStartLatitude, vs...))
+}
+
+// StartLatitudeGT applies the GT predicate on the "start_latitude" field.
+func StartLatitudeGT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldStartLatitude, v))
+}
+
+// StartLatitudeGTE applies the GTE predicate on the "start_latitude" field.
+func StartLatitudeGTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldStartLatitude, v))
+}
+
+// StartLatitudeLT applies the LT predicate on the "start_latitude" field.
+func StartLatitudeLT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldStartLatitude, v))
+}
+
+// StartLatitudeLTE applies the LTE predicate on the "start_latitude" field.
+func StartLatitudeLTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldStartLatitude, v))
+}
+
+// StartLatitudeIsNil applies the IsNil predicate on the "start_latitude" field.
+func StartLatitudeIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldStartLatitude))
+}
+
+// StartLatitudeNotNil applies the NotNil predicate on the "start_latitude" field.
+func StartLatitudeNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldStartLatitude))
+}
+
+// StartLongitudeEQ applies the EQ predicate on the "start_longitude" field.
+func StartLongitudeEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartLongitude, v))
+}
+
+// StartLongitudeNEQ applies the NEQ predicate on the "start_longitude" field.
+func StartLongitudeNEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldStartLongitude, v))
+}
+
+// StartLongitudeIn applies the In predicate on the "start_longitude" field.
+func StartLongitudeIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldStartLongitude, vs...))
+}
+
+// StartLongitudeNotIn applies the NotIn predicate on the "start_longitude" field.
+func StartLongitudeNotIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldStartLongitude, vs...))
+}
+
+// StartLongitudeGT applies the GT predicate on the "start_longitude" field.
+func StartLongitudeGT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldStartLongitude, v))
+}
+
+// StartLongitudeGTE applies the GTE predicate on the "start_longitude" field.
+func StartLongitudeGTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldStartLongitude, v))
+}
+
+// StartLongitudeLT applies the LT predicate on the "start_longitude" field.
+func StartLongitudeLT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldStartLongitude, v))
+}
+
+// StartLongitudeLTE applies the LTE predicate on the "start_longitude" field.
+func StartLongitudeLTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldStartLongitude, v))
+}
+
+// StartLongitudeIsNil applies the IsNil predicate on the "start_longitude" field.
+func StartLongitudeIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldStartLongitude))
+}
+
+// StartLongitudeNotNil applies the NotNil predicate on the "start_longitude" field.
+func StartLongitudeNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldStartLongitude))
+}
+
+// EndLatitudeEQ applies the EQ predicate on the "end_latitude" field.
+func EndLatitudeEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEndLatitude, v))
+}
+
+// EndLatitudeNEQ applies the NEQ predicate on the "end_latitude" field.
+func EndLatitudeNEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldEndLatitude, v))
+}
+
+// EndLatitudeIn applies the In predicate on the "end_latitude" field.
+func EndLatitudeIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldEndLatitude, vs...))
+}
+
+// EndLatitudeNotIn applies the NotIn predicate on the "end_latitude" field.
+func EndLatitudeNotIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldEndLatitude, vs...))
+}
+
+// EndLatitudeGT applies the GT predicate on the "end_latitude" field.
+func EndLatitudeGT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldEndLatitude, v))
+}
+
+// EndLatitudeGTE applies the GTE predicate on the "end_latitude" field.
+func EndLatitudeGTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldEndLatitude, v))
+}
+
+// EndLatitudeLT applies the LT predicate on the "end_latitude" field.
+func EndLatitudeLT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldEndLatitude, v))
+}
+
+// EndLatitudeLTE applies the LTE predicate on the "end_latitude" field.
+func EndLatitudeLTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldEndLatitude, v))
+}
+
+// EndLatitudeIsNil applies the IsNil predicate on the "end_latitude" field.
+func EndLatitudeIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldEndLatitude))
+}
+
+// EndLatitudeNotNil applies the NotNil predicate on the "end_latitude" field.
+func EndLatitudeNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldEndLatitude))
+}
+
+// EndLongitudeEQ applies the EQ predicate on the "end_longitude" field.
+func EndLongitudeEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEndLongitude, v))
+}
+
+// EndLongitudeNEQ applies the NEQ predicate on the "end_longitude" field.
+func EndLongitudeNEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldEndLongitude, v))
+}
+
+// EndLongitudeIn applies the In predicate on the "end_longitude" field.
+func EndLongitudeIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldEndLongitude, vs...))
+}
+
+// EndLongitudeNotIn applies the NotIn predicate on the "end_longitude" field.
+func EndLongitudeNotIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldEndLongitude, vs...))
+}
+
+// EndLongitudeGT applies the GT predicate on the "end_longitude" field.
+func EndLongitudeGT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldEndLongitude, v))
+}
+
+// EndLongitudeGTE applies the GTE predicate on the "end_longitude" field.
+func EndLongitudeGTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldEndLongitude, v))
+}
+
+// EndLongitudeLT applies the LT predicate on the "end_longitude" field.
+func EndLongitudeLT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldEndLongitude, v))
+}
+
+// EndLongitudeLTE applies the LTE predicate on the "end_longitude" field.
+func EndLongitudeLTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldEndLongitude, v))
+}
+
+// EndLongitudeIsNil applies the IsNil predicate on the "end_longitude" field.
+func EndLongitudeIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldEndLongitude))
+}
+
+// EndLongitudeNotNil applies the NotNil predicate on the "end_longitude" field.
+func EndLongitudeNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldEndLongitude))
+}
+
+// StartAddressEQ applies the EQ predicate on the "start_address" field.
+func StartAddressEQ(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartAddress, v))
+}
+
+// StartAddressNEQ applies the NEQ predicate on the "start_address" field.
+func StartAddressNEQ(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldStartAddress, v))
+}
+
+// StartAddressIn applies the In predicate on the "start_address" field.
+func StartAddressIn(vs ...string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldStartAddress, vs...))
+}
+
+// StartAddressNotIn applies the NotIn predicate on the "start_address" field.
+func StartAddressNotIn(vs ...string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldStartAddress, vs...))
+}
+
+// StartAddressGT applies the GT predicate on the "start_address" field.
+func StartAddressGT(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldStartAddress, v))
+}
+
+// StartAddressGTE applies the GTE predicate on the "start_address" field.
+func StartAddressGTE(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldStartAddress, v))
+}
+
+// StartAddressLT applies the LT predicate on the "start_address" field.
+func StartAddressLT(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldStartAddress, v))
+}
+
+// StartAddressLTE applies the LTE predicate on the "start_address" field.
+func StartAddressLTE(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldStartAddress, v))
+}
+
+// StartAddressContains applies the Contains predicate on the "start_address" field.
+func StartAddressContains(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldContains(FieldStartAddress, v))
+}
+
+// StartAddressHasPrefix applies the HasPrefix predicate on the "start_address" field.
+func StartAddressHasPrefix(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldHasPrefix(FieldStartAddress, v))
+}
+
+// StartAddressHasSuffix applies the HasSuffix predicate on the "start_address" field.
+func StartAddressHasSuffix(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldHasSuffix(FieldStartAddress, v))
+}
+
+// StartAddressIsNil applies the IsNil predicate on the "start_address" field.
+func StartAddressIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldStartAddress))
+}
+
+// StartAddressNotNil applies the NotNil predicate on the "start_address" field.
+func StartAddressNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldStartAddress))
+}
+
+// StartAddressEqualFold applies the EqualFold predicate on the "start_address" field.
+func StartAddressEqualFold(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEqualFold(FieldStartAddress, v))
+}
+
+// StartAddressContainsFold applies the ContainsFold predicate on the "start_address" field.
+func StartAddressContainsFold(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldContainsFold(FieldStartAddress, v))
+}
+
+// EndAddressEQ applies the EQ predicate on the "end_address" field.
+func EndAddressEQ(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEndAddress, v))
+}
+
+// EndAddressNEQ applies the NEQ predicate on the "end_address" field.
+func EndAddressNEQ(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldEndAddress, v))
+}
+
+// EndAddressIn applies the In predicate on the "end_address" field.
+func EndAddressIn(vs ...string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldEndAddress, vs...))
+}
+
+// EndAddressNotIn applies the NotIn predicate on the "end_address" field.
+func EndAddressNotIn(vs ...string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldEndAddress, vs...))
+}
+
+// EndAddressGT applies the GT predicate on the "end_address" field.
+func EndAddressGT(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldEndAddress, v))
+}
+
+// EndAddressGTE applies the GTE predicate on the "end_address" field.
+func EndAddressGTE(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldEndAddress, v))
+}
+
+// EndAddressLT applies the LT predicate on the "end_address" field.
+func EndAddressLT(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldEndAddress, v))
+}
+
+// EndAddressLTE applies the LTE predicate on the "end_address" field.
+func EndAddressLTE(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldEndAddress, v))
+}
+
+// EndAddressContains applies the Contains predicate on the "end_address" field.
+func EndAddressContains(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldContains(FieldEndAddress, v))
+}
+
+// EndAddressHasPrefix applies the HasPrefix predicate on the "end_address" field.
+func EndAddressHasPrefix(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldHasPrefix(FieldEndAddress, v))
+}
+
+// EndAddressHasSuffix applies the HasSuffix predicate on the "end_address" field.
+func EndAddressHasSuffix(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldHasSuffix(FieldEndAddress, v))
+}
+
+// EndAddressIsNil applies the IsNil predicate on the "end_address" field.
+func EndAddressIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldEndAddress))
+}
+
+// EndAddressNotNil applies the NotNil predicate on the "end_address" field.
+func EndAddressNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldEndAddress))
+}
+
+// EndAddressEqualFold applies the EqualFold predicate on the "end_address" field.
+func EndAddressEqualFold(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEqualFold(FieldEndAddress, v))
+}
+
+// EndAddressContainsFold applies the ContainsFold predicate on the "end_address" field.
+func EndAddressContainsFold(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldContainsFold(FieldEndAddress, v))
+}
+
+// StartGeofenceIDEQ applies the EQ predicate on the "start_geofence_id" field.
+func StartGeofenceIDEQ(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartGeofenceID, v))
+}
+
+// StartGeofenceIDNEQ applies the NEQ predicate on the "start_geofence_id" field.
+func StartGeofenceIDNEQ(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldStartGeofenceID, v))
+}
+
+// StartGeofenceIDIn applies the In predicate on the "start_geofence_id" field.
+func StartGeofenceIDIn(vs ...int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldStartGeofenceID, vs...))
+}
+
+// StartGeofenceIDNotIn applies the NotIn predicate on the "start_geofence_id" field.
+func StartGeofenceIDNotIn(vs ...int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldStartGeofenceID, vs...))
+}
+
+// StartGeofenceIDGT applies the GT predicate on the "start_geofence_id" field.
+func StartGeofenceIDGT(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldStartGeofenceID, v))
+}
+
+// StartGeofenceIDGTE applies the GTE predicate on the "start_geofence_id" field.
+func StartGeofenceIDGTE(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldStartGeofenceID, v))
+}
+
+// StartGeofenceIDLT applies the LT predicate on the "start_geofence_id" field.
+func StartGeofenceIDLT(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldStartGeofenceID, v))
+}
+
+// StartGeofenceIDLTE applies the LTE predicate on the "start_geofence_id" field.
+func StartGeofenceIDLTE(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldStartGeofenceID, v))
+}
+
+// StartGeofenceIDIsNil applies the IsNil predicate on the "start_geofence_id" field.
+func StartGeofenceIDIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldStartGeofenceID))
+}
+
+// StartGeofenceIDNotNil applies the NotNil predicate on the "start_geofence_id" field.
+func StartGeofenceIDNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldStartGeofenceID))
+}
+
+// EndGeofenceIDEQ applies the EQ predicate on the "end_geofence_id" field.
+func EndGeofenceIDEQ(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEndGeofenceID, v))
+}
+
+// EndGeofenceIDNEQ applies the NEQ predicate on the "end_geofence_id" field.
+func EndGeofenceIDNEQ(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldEndGeofenceID, v))
+}
+
+// EndGeofenceIDIn applies the In predicate on the "end_geofence_id" field.
+func EndGeofenceIDIn(vs ...int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldEndGeofenceID, vs...))
+}
+
+// EndGeofenceIDNotIn applies the NotIn predicate on the "end_geofence_id" field.
+func EndGeofenceIDNotIn(vs ...int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldEndGeofenceID, vs...))
+}
+
+// EndGeofenceIDGT applies the GT predicate on the "end_geofence_id" field.
+func EndGeofenceIDGT(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldEndGeofenceID, v))
+}
+
+// EndGeofenceIDGTE applies the GTE predicate on the "end_geofence_id" field.
+func EndGeofenceIDGTE(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldEndGeofenceID, v))
+}
+
+// EndGeofenceIDLT applies the LT predicate on the "end_geofence_id" field.
+func EndGeofenceIDLT(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldEndGeofenceID, v))
+}
+
+// EndGeofenceIDLTE applies the LTE predicate on the "end_geofence_id" field.
+func EndGeofenceIDLTE(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldEndGeofenceID, v))
+}
+
+// EndGeofenceIDIsNil applies the IsNil predicate on the "end_geofence_id" field.
+func EndGeofenceIDIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldEndGeofenceID))
+}
+
+// EndGeofenceIDNotNil applies the NotNil predicate on the "end_geofence_id" field.
+func EndGeofenceIDNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldEndGeofenceID))
+}
+
+// ChargeLocationEQ applies the EQ predicate on the "charge_location" field.
+func ChargeLocationEQ(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldChargeLocation, v))
+}
+
+// ChargeLocationNEQ applies the NEQ predicate on the "charge_location" field.
+func ChargeLocationNEQ(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldChargeLocation, v))
+}
+
+// ChargeLocationIn applies the In predicate on the "charge_location" field.
+func ChargeLocationIn(vs ...string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldChargeLocation, vs...))
+}
+
+// ChargeLocationNotIn applies the NotIn predicate on the "charge_location" field.
+func ChargeLocationNotIn(vs ...string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldChargeLocation, vs...))
+}
+
+// ChargeLocationGT applies the GT predicate on the "charge_location" field.
+func ChargeLocationGT(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldChargeLocation, v))
+}
+
+// ChargeLocationGTE applies the GTE predicate on the "charge_location" field.
+func ChargeLocationGTE(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldChargeLocation, v))
+}
+
+// ChargeLocationLT applies the LT predicate on the "charge_location" field.
+func ChargeLocationLT(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldChargeLocation, v))
+}
+
+// ChargeLocationLTE applies the LTE predicate on the "charge_location" field.
+func ChargeLocationLTE(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldChargeLocation, v))
+}
+
+// ChargeLocationContains applies the Contains predicate on the "charge_location" field.
+func ChargeLocationContains(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldContains(FieldChargeLocation, v))
+}
+
+// ChargeLocationHasPrefix applies the HasPrefix predicate on the "charge_location" field.
+func ChargeLocationHasPrefix(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldHasPrefix(FieldChargeLocation, v))
+}
+
+// ChargeLocationHasSuffix applies the HasSuffix predicate on the "charge_location" field.
+func ChargeLocationHasSuffix(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldHasSuffix(FieldChargeLocation, v))
+}
+
+// ChargeLocationIsNil applies the IsNil predicate on the "charge_location" field.
+func ChargeLocationIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldChargeLocation))
+}
+
+// ChargeLocationNotNil applies the NotNil predicate on the "charge_location" field.
+func ChargeLocationNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldChargeLocation))
+}
+
+// ChargeLocationEqualFold applies the EqualFold predicate on the "charge_location" field.
+func ChargeLocationEqualFold(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEqualFold(FieldChargeLocation, v))
+}
+
+// ChargeLocationContainsFold applies the ContainsFold predicate on the "charge_location" field.
+func ChargeLocationContainsFold(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldContainsFold(FieldChargeLocation, v))
+}
+
+// FastChargerEQ applies the EQ predicate on the "fast_charger" field.
+func FastChargerEQ(v bool) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldFastCharger, v))
+}
+
+// FastChargerNEQ applies the NEQ predicate on the "fast_charger" field.
+func FastChargerNEQ(v bool) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldFastCharger, v))
+}
+
+// EnergyAddedEQ applies the EQ predicate on the "energy_added" field.
+func EnergyAddedEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEnergyAdded, v))
+}
+
+// EnergyAddedNEQ applies the NEQ predicate on the "energy_added" field.
+func EnergyAddedNEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldEnergyAdded, v))
+}
+
+// EnergyAddedIn applies the In predicate on the "energy_added" field.
+func EnergyAddedIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldEnergyAdded, vs...))
+}
+
+// EnergyAddedNotIn applies the NotIn predicate on the "energy_added" field.
+func EnergyAddedNotIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldEnergyAdded, vs...))
+}
+
+// EnergyAddedGT applies the GT predicate on the "energy_added" field.
+func EnergyAddedGT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldEnergyAdded, v))
+}
+
+// EnergyAddedGTE applies the GTE predicate on the "energy_added" field.
+func EnergyAddedGTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldEnergyAdded, v))
+}
+
+// EnergyAddedLT applies the LT predicate on the "energy_added" field.
+func EnergyAddedLT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldEnergyAdded, v))
+}
+
+// EnergyAddedLTE applies the LTE predicate on the "energy_added" field.
+func EnergyAddedLTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldEnergyAdded, v))
+}
+
+// EnergyAddedIsNil applies the IsNil predicate on the "energy_added" field.
+func EnergyAddedIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldEnergyAdded))
+}
+
+// EnergyAddedNotNil applies the NotNil predicate on the "energy_added" field.
+func EnergyAddedNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldEnergyAdded))
+}
+
+// StartEnergyAddedEQ applies the EQ predicate on the "start_energy_added" field.
+func StartEnergyAddedEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldStartEnergyAdded, v))
+}
+
+// StartEnergyAddedNEQ applies the NEQ predicate on the "start_energy_added" field.
+func StartEnergyAddedNEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldStartEnergyAdded, v))
+}
+
+// StartEnergyAddedIn applies the In predicate on the "start_energy_added" field.
+func StartEnergyAddedIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldStartEnergyAdded, vs...))
+}
+
+// StartEnergyAddedNotIn applies the NotIn predicate on the "start_energy_added" field.
+func StartEnergyAddedNotIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldStartEnergyAdded, vs...))
+}
+
+// StartEnergyAddedGT applies the GT predicate on the "start_energy_added" field.
+func StartEnergyAddedGT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldStartEnergyAdded, v))
+}
+
+// StartEnergyAddedGTE applies the GTE predicate on the "start_energy_added" field.
+func StartEnergyAddedGTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldStartEnergyAdded, v))
+}
+
+// StartEnergyAddedLT applies the LT predicate on the "start_energy_added" field.
+func StartEnergyAddedLT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldStartEnergyAdded, v))
+}
+
+// StartEnergyAddedLTE applies the LTE predicate on the "start_energy_added" field.
+func StartEnergyAddedLTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldStartEnergyAdded, v))
+}
+
+// StartEnergyAddedIsNil applies the IsNil predicate on the "start_energy_added" field.
+func StartEnergyAddedIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldStartEnergyAdded))
+}
+
+// StartEnergyAddedNotNil applies the NotNil predicate on the "start_energy_added" field.
+func StartEnergyAddedNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldStartEnergyAdded))
+}
+
+// EnergyUsedEQ applies the EQ predicate on the "energy_used" field.
+func EnergyUsedEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldEnergyUsed, v))
+}
+
+// EnergyUsedNEQ applies the NEQ predicate on the "energy_used" field.
+func EnergyUsedNEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldEnergyUsed, v))
+}
+
+// EnergyUsedIn applies the In predicate on the "energy_used" field.
+func EnergyUsedIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldEnergyUsed, vs...))
+}
+
+// EnergyUsedNotIn applies the NotIn predicate on the "energy_used" field.
+func EnergyUsedNotIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldEnergyUsed, vs...))
+}
+
+// EnergyUsedGT applies the GT predicate on the "energy_used" field.
+func EnergyUsedGT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldEnergyUsed, v))
+}
+
+// EnergyUsedGTE applies the GTE predicate on the "energy_used" field.
+func EnergyUsedGTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldEnergyUsed, v))
+}
+
+// EnergyUsedLT applies the LT predicate on the "energy_used" field.
+func EnergyUsedLT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldEnergyUsed, v))
+}
+
+// EnergyUsedLTE applies the LTE predicate on the "energy_used" field.
+func EnergyUsedLTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldEnergyUsed, v))
+}
+
+// EnergyUsedIsNil applies the IsNil predicate on the "energy_used" field.
+func EnergyUsedIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldEnergyUsed))
+}
+
+// EnergyUsedNotNil applies the NotNil predicate on the "energy_used" field.
+func EnergyUsedNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldEnergyUsed))
+}
+
+// PowerSecondsEQ applies the EQ predicate on the "power_seconds" field.
+func PowerSecondsEQ(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldPowerSeconds, v))
+}
+
+// PowerSecondsNEQ applies the NEQ predicate on the "power_seconds" field.
+func PowerSecondsNEQ(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldPowerSeconds, v))
+}
+
+// PowerSecondsIn applies the In predicate on the "power_seconds" field.
+func PowerSecondsIn(vs ...int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldPowerSeconds, vs...))
+}
+
+// PowerSecondsNotIn applies the NotIn predicate on the "power_seconds" field.
+func PowerSecondsNotIn(vs ...int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldPowerSeconds, vs...))
+}
+
+// PowerSecondsGT applies the GT predicate on the "power_seconds" field.
+func PowerSecondsGT(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldPowerSeconds, v))
+}
+
+// PowerSecondsGTE applies the GTE predicate on the "power_seconds" field.
+func PowerSecondsGTE(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldPowerSeconds, v))
+}
+
+// PowerSecondsLT applies the LT predicate on the "power_seconds" field.
+func PowerSecondsLT(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldPowerSeconds, v))
+}
+
+// PowerSecondsLTE applies the LTE predicate on the "power_seconds" field.
+func PowerSecondsLTE(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldPowerSeconds, v))
+}
+
+// PowerSecondsIsNil applies the IsNil predicate on the "power_seconds" field.
+func PowerSecondsIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldPowerSeconds))
+}
+
+// PowerSecondsNotNil applies the NotNil predicate on the "power_seconds" field.
+func PowerSecondsNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldPowerSeconds))
+}
+
+// OutsideTempEQ applies the EQ predicate on the "outside_temp" field.
+func OutsideTempEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldOutsideTemp, v))
+}
+
+// OutsideTempNEQ applies the NEQ predicate on the "outside_temp" field.
+func OutsideTempNEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldOutsideTemp, v))
+}
+
+// OutsideTempIn applies the In predicate on the "outside_temp" field.
+func OutsideTempIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldOutsideTemp, vs...))
+}
+
+// OutsideTempNotIn applies the NotIn predicate on the "outside_temp" field.
+func OutsideTempNotIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldOutsideTemp, vs...))
+}
+
+// OutsideTempGT applies the GT predicate on the "outside_temp" field.
+func OutsideTempGT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldOutsideTemp, v))
+}
+
+// OutsideTempGTE applies the GTE predicate on the "outside_temp" field.
+func OutsideTempGTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldOutsideTemp, v))
+}
+
+// OutsideTempLT applies the LT predicate on the "outside_temp" field.
+func OutsideTempLT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldOutsideTemp, v))
+}
+
+// OutsideTempLTE applies the LTE predicate on the "outside_temp" field.
+func OutsideTempLTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldOutsideTemp, v))
+}
+
+// OutsideTempIsNil applies the IsNil predicate on the "outside_temp" field.
+func OutsideTempIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldOutsideTemp))
+}
+
+// OutsideTempNotNil applies the NotNil predicate on the "outside_temp" field.
+func OutsideTempNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldOutsideTemp))
+}
+
+// MaxSpeedEQ applies the EQ predicate on the "max_speed" field.
+func MaxSpeedEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldMaxSpeed, v))
+}
+
+// MaxSpeedNEQ applies the NEQ predicate on the "max_speed" field.
+func MaxSpeedNEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldMaxSpeed, v))
+}
+
+// MaxSpeedIn applies the In predicate on the "max_speed" field.
+func MaxSpeedIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldMaxSpeed, vs...))
+}
+
+// MaxSpeedNotIn applies the NotIn predicate on the "max_speed" field.
+func MaxSpeedNotIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldMaxSpeed, vs...))
+}
+
+// MaxSpeedGT applies the GT predicate on the "max_speed" field.
+func MaxSpeedGT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldMaxSpeed, v))
+}
+
+// MaxSpeedGTE applies the GTE predicate on the "max_speed" field.
+func MaxSpeedGTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldMaxSpeed, v))
+}
+
+// MaxSpeedLT applies the LT predicate on the "max_speed" field.
+func MaxSpeedLT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldMaxSpeed, v))
+}
+
+// MaxSpeedLTE applies the LTE predicate on the "max_speed" field.
+func MaxSpeedLTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldMaxSpeed, v))
+}
+
+// MaxSpeedIsNil applies the IsNil predicate on the "max_speed" field.
+func MaxSpeedIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldMaxSpeed))
+}
+
+// MaxSpeedNotNil applies the NotNil predicate on the "max_speed" field.
+func MaxSpeedNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldMaxSpeed))
+}
+
+// ClimateRatioEQ applies the EQ predicate on the "climate_ratio" field.
+func ClimateRatioEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldClimateRatio, v))
+}
+
+// ClimateRatioNEQ applies the NEQ predicate on the "climate_ratio" field.
+func ClimateRatioNEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldClimateRatio, v))
+}
+
+// ClimateRatioIn applies the In predicate on the "climate_ratio" field.
+func ClimateRatioIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldClimateRatio, vs...))
+}
+
+// ClimateRatioNotIn applies the NotIn predicate on the "climate_ratio" field.
+func ClimateRatioNotIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldClimateRatio, vs...))
+}
+
+// ClimateRatioGT applies the GT predicate on the "climate_ratio" field.
+func ClimateRatioGT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldClimateRatio, v))
+}
+
+// ClimateRatioGTE applies the GTE predicate on the "climate_ratio" field.
+func ClimateRatioGTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldClimateRatio, v))
+}
+
+// ClimateRatioLT applies the LT predicate on the "climate_ratio" field.
+func ClimateRatioLT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldClimateRatio, v))
+}
+
+// ClimateRatioLTE applies the LTE predicate on the "climate_ratio" field.
+func ClimateRatioLTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldClimateRatio, v))
+}
+
+// ClimateRatioIsNil applies the IsNil predicate on the "climate_ratio" field.
+func ClimateRatioIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldClimateRatio))
+}
+
+// ClimateRatioNotNil applies the NotNil predicate on the "climate_ratio" field.
+func ClimateRatioNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldClimateRatio))
+}
+
+// CostEQ applies the EQ predicate on the "cost" field.
+func CostEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldCost, v))
+}
+
+// CostNEQ applies the NEQ predicate on the "cost" field.
+func CostNEQ(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldCost, v))
+}
+
+// CostIn applies the In predicate on the "cost" field.
+func CostIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldCost, vs...))
+}
+
+// CostNotIn applies the NotIn predicate on the "cost" field.
+func CostNotIn(vs ...float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldCost, vs...))
+}
+
+// CostGT applies the GT predicate on the "cost" field.
+func CostGT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldCost, v))
+}
+
+// CostGTE applies the GTE predicate on the "cost" field.
+func CostGTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldCost, v))
+}
+
+// CostLT applies the LT predicate on the "cost" field.
+func CostLT(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldCost, v))
+}
+
+// CostLTE applies the LTE predicate on the "cost" field.
+func CostLTE(v float64) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldCost, v))
+}
+
+// CostIsNil applies the IsNil predicate on the "cost" field.
+func CostIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldCost))
+}
+
+// CostNotNil applies the NotNil predicate on the "cost" field.
+func CostNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldCost))
+}
+
+// CostManualEQ applies the EQ predicate on the "cost_manual" field.
+func CostManualEQ(v bool) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldCostManual, v))
+}
+
+// CostManualNEQ applies the NEQ predicate on the "cost_manual" field.
+func CostManualNEQ(v bool) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldCostManual, v))
+}
+
+// TariffIDEQ applies the EQ predicate on the "tariff_id" field.
+func TariffIDEQ(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldTariffID, v))
+}
+
+// TariffIDNEQ applies the NEQ predicate on the "tariff_id" field.
+func TariffIDNEQ(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldTariffID, v))
+}
+
+// TariffIDIn applies the In predicate on the "tariff_id" field.
+func TariffIDIn(vs ...int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldTariffID, vs...))
+}
+
+// TariffIDNotIn applies the NotIn predicate on the "tariff_id" field.
+func TariffIDNotIn(vs ...int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldTariffID, vs...))
+}
+
+// TariffIDGT applies the GT predicate on the "tariff_id" field.
+func TariffIDGT(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldTariffID, v))
+}
+
+// TariffIDGTE applies the GTE predicate on the "tariff_id" field.
+func TariffIDGTE(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldTariffID, v))
+}
+
+// TariffIDLT applies the LT predicate on the "tariff_id" field.
+func TariffIDLT(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldTariffID, v))
+}
+
+// TariffIDLTE applies the LTE predicate on the "tariff_id" field.
+func TariffIDLTE(v int) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldTariffID, v))
+}
+
+// TariffIDIsNil applies the IsNil predicate on the "tariff_id" field.
+func TariffIDIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldTariffID))
+}
+
+// TariffIDNotNil applies the NotNil predicate on the "tariff_id" field.
+func TariffIDNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldTariffID))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldContainsFold(FieldName, v))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotNull(FieldTags))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VehicleStatePeriod) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VehicleStatePeriod) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VehicleStatePeriod) predicate.VehicleStatePeriod {
+	return predicate.VehicleStatePeriod(sql.NotPredicates(p))
+}
@@ -0,0 +1,844 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/vehiclestateperiod"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VehicleStatePeriodCreate is the builder for creating a VehicleStatePeriod entity.
+type VehicleStatePeriodCreate struct {
+	config
+	mutation *VehicleStatePeriodMutation
+	hooks    []Hook
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_c *VehicleStatePeriodCreate) SetVehicleID(v int) *VehicleStatePeriodCreate {
+	_c.mutation.SetVehicleID(v)
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *VehicleStatePeriodCreate) SetState(v string) *VehicleStatePeriodCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetSentryMode sets the "sentry_mode" field.
+func (_c *VehicleStatePeriodCreate) SetSentryMode(v bool) *VehicleStatePeriodCreate {
+	_c.mutation.SetSentryMode(v)
+	return _c
+}
+
+// SetNillableSentryMode sets the "sentry_mode" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableSentryMode(v *bool) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetSentryMode(*v)
+	}
+	return _c
+}
+
+// SetClimateOn sets the "climate_on" field.
+func (_c *VehicleStatePeriodCreate) SetClimateOn(v bool) *VehicleStatePeriodCreate {
+	_c.mutation.SetClimateOn(v)
+	return _c
+}
+
+// SetNillableClimateOn sets the "climate_on" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableClimateOn(v *bool) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetClimateOn(*v)
+	}
+	return _c
+}
+
+// SetStartAt sets the "start_at" field.
+func (_c *VehicleStatePeriodCreate) SetStartAt(v time.Time) *VehicleStatePeriodCreate {
+	_c.mutation.SetStartAt(v)
+	return _c
+}
+
+// SetEndAt sets the "end_at" field.
+func (_c *VehicleStatePeriodCreate) SetEndAt(v time.Time) *VehicleStatePeriodCreate {
+	_c.mutation.SetEndAt(v)
+	return _c
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableEndAt(v *time.Time) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetEndAt(*v)
+	}
+	return _c
+}
+
+// SetStartBatteryLevel sets the "start_battery_level" field.
+func (_c *VehicleStatePeriodCreate) SetStartBatteryLevel(v int) *VehicleStatePeriodCreate {
+	_c.mutation.SetStartBatteryLevel(v)
+	return _c
+}
+
+// SetNillableStartBatteryLevel sets the "start_battery_level" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableStartBatteryLevel(v *int) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetStartBatteryLevel(*v)
+	}
+	return _c
+}
+
+// SetEndBatteryLevel sets the "end_battery_level" field.
+func (_c *VehicleStatePeriodCreate) SetEndBatteryLevel(v int) *VehicleStatePeriodCreate {
+	_c.mutation.SetEndBatteryLevel(v)
+	return _c
+}
+
+// SetNillableEndBatteryLevel sets the "end_battery_level" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableEndBatteryLevel(v *int) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetEndBatteryLevel(*v)
+	}
+	return _c
+}
+
+// SetStartRange sets the "start_range" field.
+func (_c *VehicleStatePeriodCreate) SetStartRange(v float64) *VehicleStatePeriodCreate {
+	_c.mutation.SetStartRange(v)
+	return _c
+}
+
+// SetNillableStartRange sets the "start_range" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableStartRange(v *float64) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetStartRange(*v)
+	}
+	return _c
+}
+
+// SetEndRange sets the "end_range" field.
+func (_c *VehicleStatePeriodCreate) SetEndRange(v float64) *VehicleStatePeriodCreate {
+	_c.mutation.SetEndRange(v)
+	return _c
+}
+
+// SetNillableEndRange sets the "end_range" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableEndRange(v *float64) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetEndRange(*v)
+	}
+	return _c
+}
+
+// SetStartOdometer sets the "start_odometer" field.
+func (_c *VehicleStatePeriodCreate) SetStartOdometer(v float64) *VehicleStatePeriodCreate {
+	_c.mutation.SetStartOdometer(v)
+	return _c
+}
+
+// SetNillableStartOdometer sets the "start_odometer" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableStartOdometer(v *float64) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetStartOdometer(*v)
+	}
+	return _c
+}
+
+// SetEndOdometer sets the "end_odometer" field.
+func (_c *VehicleStatePeriodCreate) SetEndOdometer(v float64) *VehicleStatePeriodCreate {
+	_c.mutation.SetEndOdometer(v)
+	return _c
+}
+
+// SetNillableEndOdometer sets the "end_odometer" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableEndOdometer(v *float64) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetEndOdometer(*v)
+	}
+	return _c
+}
+
+// SetStartLatitude sets the "start_latitude" field.
+func (_c *VehicleStatePeriodCreate) SetStartLatitude(v float64) *VehicleStatePeriodCreate {
+	_c.mutation.SetStartLatitude(v)
+	return _c
+}
+
+// SetNillableStartLatitude sets the "start_latitude" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableStartLatitude(v *float64) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetStartLatitude(*v)
+	}
+	return _c
+}
+
+// SetStartLongitude sets the "start_longitude" field.
+func (_c *VehicleStatePeriodCreate) SetStartLongitude(v float64) *VehicleStatePeriodCreate {
+	_c.mutation.SetStartLongitude(v)
+	return _c
+}
+
+// SetNillableStartLongitude sets the "start_longitude" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableStartLongitude(v *float64) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetStartLongitude(*v)
+	}
+	return _c
+}
+
+// SetEndLatitude sets the "end_latitude" field.
+func (_c *VehicleStatePeriodCreate) SetEndLatitude(v float64) *VehicleStatePeriodCreate {
+	_c.mutation.SetEndLatitude(v)
+	return _c
+}
+
+// SetNillableEndLatitude sets the "end_latitude" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableEndLatitude(v *float64) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetEndLatitude(*v)
+	}
+	return _c
+}
+
+// SetEndLongitude sets the "end_longitude" field.
+func (_c *VehicleStatePeriodCreate) SetEndLongitude(v float64) *VehicleStatePeriodCreate {
+	_c.mutation.SetEndLongitude(v)
+	return _c
+}
+
+// SetNillableEndLongitude sets the "end_longitude" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableEndLongitude(v *float64) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetEndLongitude(*v)
+	}
+	return _c
+}
+
+// SetStartAddress sets the "start_address" field.
+func (_c *VehicleStatePeriodCreate) SetStartAddress(v string) *VehicleStatePeriodCreate {
+	_c.mutation.SetStartAddress(v)
+	return _c
+}
+
+// SetNillableStartAddress sets the "start_address" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableStartAddress(v *string) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetStartAddress(*v)
+	}
+	return _c
+}
+
+// SetEndAddress sets the "end_address" field.
+func (_c *VehicleStatePeriodCreate) SetEndAddress(v string) *VehicleStatePeriodCreate {
+	_c.mutation.SetEndAddress(v)
+	return _c
+}
+
+// SetNillableEndAddress sets the "end_address" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableEndAddress(v *string) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetEndAddress(*v)
+	}
+	return _c
+}
+
+// SetStartGeofenceID sets the "start_geofence_id" field.
+func (_c *VehicleStatePeriodCreate) SetStartGeofenceID(v int) *VehicleStatePeriodCreate {
+	_c.mutation.SetStartGeofenceID(v)
+	return _c
+}
+
+// SetNillableStartGeofenceID sets the "start_geofence_id" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableStartGeofenceID(v *int) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetStartGeofenceID(*v)
+	}
+	return _c
+}
+
+// SetEndGeofenceID sets the "end_geofence_id" field.
+func (_c *VehicleStatePeriodCreate) SetEndGeofenceID(v int) *VehicleStatePeriodCreate {
+	_c.mutation.SetEndGeofenceID(v)
+	return _c
+}
+
+// SetNillableEndGeofenceID sets the "end_geofence_id" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableEndGeofenceID(v *int) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetEndGeofenceID(*v)
+	}
+	return _c
+}
+
+// SetChargeLocation sets the "charge_location" field.
+func (_c *VehicleStatePeriodCreate) SetChargeLocation(v string) *VehicleStatePeriodCreate {
+	_c.mutation.SetChargeLocation(v)
+	return _c
+}
+
+// SetNillableChargeLocation sets the "charge_location" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableChargeLocation(v *string) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetChargeLocation(*v)
+	}
+	return _c
+}
+
+// SetFastCharger sets the "fast_charger" field.
+func (_c *VehicleStatePeriodCreate) SetFastCharger(v bool) *VehicleStatePeriodCreate {
+	_c.mutation.SetFastCharger(v)
+	return _c
+}
+
+// SetNillableFastCharger sets the "fast_charger" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableFastCharger(v *bool) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetFastCharger(*v)
+	}
+	return _c
+}
+
+// SetEnergyAdded sets the "energy_added" field.
+func (_c *VehicleStatePeriodCreate) SetEnergyAdded(v float64) *VehicleStatePeriodCreate {
+	_c.mutation.SetEnergyAdded(v)
+	return _c
+}
+
+// SetNillableEnergyAdded sets the "energy_added" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableEnergyAdded(v *float64) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetEnergyAdded(*v)
+	}
+	return _c
+}
+
+// SetStartEnergyAdded sets the "start_energy_added" field.
+func (_c *VehicleStatePeriodCreate) SetStartEnergyAdded(v float64) *VehicleStatePeriodCreate {
+	_c.mutation.SetStartEnergyAdded(v)
+	return _c
+}
+
+// SetNillableStartEnergyAdded sets the "start_energy_added" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableStartEnergyAdded(v *float64) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetStartEnergyAdded(*v)
+	}
+	return _c
+}
+
+// SetEnergyUsed sets the "energy_used" field.
+func (_c *VehicleStatePeriodCreate) SetEnergyUsed(v float64) *VehicleStatePeriodCreate {
+	_c.mutation.SetEnergyUsed(v)
+	return _c
+}
+
+// SetNillableEnergyUsed sets the "energy_used" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableEnergyUsed(v *float64) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetEnergyUsed(*v)
+	}
+	return _c
+}
+
+// SetPowerSeconds sets the "power_seconds" field.
+func (_c *VehicleStatePeriodCreate) SetPowerSeconds(v int) *VehicleStatePeriodCreate {
+	_c.mutation.SetPowerSeconds(v)
+	return _c
+}
+
+// SetNillablePowerSeconds sets the "power_seconds" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillablePowerSeconds(v *int) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetPowerSeconds(*v)
+	}
+	return _c
+}
+
+// SetOutsideTemp sets the "outside_temp" field.
+func (_c *VehicleStatePeriodCreate) SetOutsideTemp(v float64) *VehicleStatePeriodCreate {
+	_c.mutation.SetOutsideTemp(v)
+	return _c
+}
+
+// SetNillableOutsideTemp sets the "outside_temp" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableOutsideTemp(v *float64) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetOutsideTemp(*v)
+	}
+	return _c
+}
+
+// SetMaxSpeed sets the "max_speed" field.
+func (_c *VehicleStatePeriodCreate) SetMaxSpeed(v float64) *VehicleStatePeriodCreate {
+	_c.mutation.SetMaxSpeed(v)
+	return _c
+}
+
+// SetNillableMaxSpeed sets the "max_speed" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableMaxSpeed(v *float64) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetMaxSpeed(*v)
+	}
+	return _c
+}
+
+// SetClimateRatio sets the "climate_ratio" field.
+func (_c *VehicleStatePeriodCreate) SetClimateRatio(v float64) *VehicleStatePeriodCreate {
+	_c.mutation.SetClimateRatio(v)
+	return _c
+}
+
+// SetNillableClimateRatio sets the "climate_ratio" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableClimateRatio(v *float64) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetClimateRatio(*v)
+	}
+	return _c
+}
+
+// SetCost sets the "cost" field.
+func (_c *VehicleStatePeriodCreate) SetCost(v float64) *VehicleStatePeriodCreate {
+	_c.mutation.SetCost(v)
+	return _c
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableCost(v *float64) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetCost(*v)
+	}
+	return _c
+}
+
+// SetCostManual sets the "cost_manual" field.
+func (_c *VehicleStatePeriodCreate) SetCostManual(v bool) *VehicleStatePeriodCreate {
+	_c.mutation.SetCostManual(v)
+	return _c
+}
+
+// SetNillableCostManual sets the "cost_manual" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableCostManual(v *bool) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetCostManual(*v)
+	}
+	return _c
+}
+
+// SetTariffID sets the "tariff_id" field.
+func (_c *VehicleStatePeriodCreate) SetTariffID(v int) *VehicleStatePeriodCreate {
+	_c.mutation.SetTariffID(v)
+	return _c
+}
+
+// SetNillableTariffID sets the "tariff_id" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableTariffID(v *int) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetTariffID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *VehicleStatePeriodCreate) SetName(v string) *VehicleStatePeriodCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableName(v *string) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetTags sets the "tags" field.
+func (_c *VehicleStatePeriodCreate) SetTags(v []string) *VehicleStatePeriodCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VehicleStatePeriodCreate) SetCreatedAt(v time.Time) *VehicleStatePeriodCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableCreatedAt(v *time.Time) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *VehicleStatePeriodCreate) SetUpdatedAt(v time.Time) *VehicleStatePeriodCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *VehicleStatePeriodCreate) SetNillableUpdatedAt(v *time.Time) *VehicleStatePeriodCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the VehicleStatePeriodMutation object of the builder.
+func (_c *VehicleStatePeriodCreate) Mutation() *VehicleStatePeriodMutation {
+	return _c.mutation
+}
+
+// Save creates the VehicleStatePeriod in the database.
+func (_c *VehicleStatePeriodCreate) Save(ctx context.Context) (*VehicleStatePeriod, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VehicleStatePeriodCreate) SaveX(ctx context.Context) *VehicleStatePeriod {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VehicleStatePeriodCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VehicleStatePeriodCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VehicleStatePeriodCreate) defaults() {
+	if _, ok := _c.mutation.SentryMode(); !ok {
+		v := vehiclestateperiod.DefaultSentryMode
+		_c.mutation.SetSentryMode(v)
+	}
+	if _, ok := _c.mutation.ClimateOn(); !ok {
+		v := vehiclestateperiod.DefaultClimateOn
+		_c.mutation.SetClimateOn(v)
+	}
+	if _, ok := _c.mutation.FastCharger(); !ok {
+		v := vehiclestateperiod.DefaultFastCharger
+		_c.mutation.SetFastCharger(v)
+	}
+	if _, ok := _c.mutation.CostManual(); !ok {
+		v := vehiclestateperiod.DefaultCostManual
+		_c.mutation.SetCostManual(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := vehiclestateperiod.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := vehiclestateperiod.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VehicleStatePeriodCreate) check() error {
+	if _, ok := _c.mutation.VehicleID(); !ok {
+		return &ValidationError{Name: "vehicle_id", err: errors.New(`ent: missing required field "VehicleStatePeriod.vehicle_id"`)}
+	}
+	if _, ok := _c.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "VehicleStatePeriod.state"`)}
+	}
+	if _, ok := _c.mutation.SentryMode(); !ok {
+		return &ValidationError{Name: "sentry_mode", err: errors.New(`ent: missing required field "VehicleStatePeriod.sentry_mode"`)}
+	}
+	if _, ok := _c.mutation.ClimateOn(); !ok {
+		return &ValidationError{Name: "climate_on", err: errors.New(`ent: missing required field "VehicleStatePeriod.climate_on"`)}
+	}
+	if _, ok := _c.mutation.StartAt(); !ok {
+		return &ValidationError{Name: "start_at", err: errors.New(`ent: missing required field "VehicleStatePeriod.start_at"`)}
+	}
+	if _, ok := _c.mutation.FastCharger(); !ok {
+		return &ValidationError{Name: "fast_charger", err: errors.New(`ent: missing required field "VehicleStatePeriod.fast_charger"`)}
+	}
+	if _, ok := _c.mutation.CostManual(); !ok {
+		return &ValidationError{Name: "cost_manual", err: errors.New(`ent: missing required field "VehicleStatePeriod.cost_manual"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VehicleStatePeriod.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "VehicleStatePeriod.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *VehicleStatePeriodCreate) sqlSave(ctx context.Context) (*VehicleStatePeriod, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VehicleStatePeriodCreate) createSpec() (*VehicleStatePeriod, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VehicleStatePeriod{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(vehiclestateperiod.Table, sqlgraph.NewFieldSpec(vehiclestateperiod.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.VehicleID(); ok {
+		_spec.SetField(vehiclestateperiod.FieldVehicleID, field.TypeInt, value)
+		_node.VehicleID = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(vehiclestateperiod.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := _c.mutation.SentryMode(); ok {
+		_spec.SetField(vehiclestateperiod.FieldSentryMode, field.TypeBool, value)
+		_node.SentryMode = value
+	}
+	if value, ok := _c.mutation.ClimateOn(); ok {
+		_spec.SetField(vehiclestateperiod.FieldClimateOn, field.TypeBool, value)
+		_node.ClimateOn = value
+	}
+	if value, ok := _c.mutation.StartAt(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartAt, field.TypeTime, value)
+		_node.StartAt = value
+	}
+	if value, ok := _c.mutation.EndAt(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndAt, field.TypeTime, value)
+		_node.EndAt = &value
+	}
+	if value, ok := _c.mutation.StartBatteryLevel(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartBatteryLevel, field.TypeInt, value)
+		_node.StartBatteryLevel = value
+	}
+	if value, ok := _c.mutation.EndBatteryLevel(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndBatteryLevel, field.TypeInt, value)
+		_node.EndBatteryLevel = value
+	}
+	if value, ok := _c.mutation.StartRange(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartRange, field.TypeFloat64, value)
+		_node.StartRange = value
+	}
+	if value, ok := _c.mutation.EndRange(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndRange, field.TypeFloat64, value)
+		_node.EndRange = value
+	}
+	if value, ok := _c.mutation.StartOdometer(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartOdometer, field.TypeFloat64, value)
+		_node.StartOdometer = value
+	}
+	if value, ok := _c.mutation.EndOdometer(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndOdometer, field.TypeFloat64, value)
+		_node.EndOdometer = value
+	}
+	if value, ok := _c.mutation.StartLatitude(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartLatitude, field.TypeFloat64, value)
+		_node.StartLatitude = value
+	}
+	if value, ok := _c.mutation.StartLongitude(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartLongitude, field.TypeFloat64, value)
+		_node.StartLongitude = value
+	}
+	if value, ok := _c.mutation.EndLatitude(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndLatitude, field.TypeFloat64, value)
+		_node.EndLatitude = value
+	}
+	if value, ok := _c.mutation.EndLongitude(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndLongitude, field.TypeFloat64, value)
+		_node.EndLongitude = value
+	}
+	if value, ok := _c.mutation.StartAddress(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartAddress, field.TypeString, value)
+		_node.StartAddress = value
+	}
+	if value, ok := _c.mutation.EndAddress(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndAddress, field.TypeString, value)
+		_node.EndAddress = value
+	}
+	if value, ok := _c.mutation.StartGeofenceID(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartGeofenceID, field.TypeInt, value)
+		_node.StartGeofenceID = &value
+	}
+	if value, ok := _c.mutation.EndGeofenceID(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndGeofenceID, field.TypeInt, value)
+		_node.EndGeofenceID = &value
+	}
+	if value, ok := _c.mutation.ChargeLocation(); ok {
+		_spec.SetField(vehiclestateperiod.FieldChargeLocation, field.TypeString, value)
+		_node.ChargeLocation = value
+	}
+	if value, ok := _c.mutation.FastCharger(); ok {
+		_spec.SetField(vehiclestateperiod.FieldFastCharger, field.TypeBool, value)
+		_node.FastCharger = value
+	}
+	if value, ok := _c.mutation.EnergyAdded(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEnergyAdded, field.TypeFloat64, value)
+		_node.EnergyAdded = value
+	}
+	if value, ok := _c.mutation.StartEnergyAdded(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartEnergyAdded, field.TypeFloat64, value)
+		_node.StartEnergyAdded = value
+	}
+	if value, ok := _c.mutation.EnergyUsed(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEnergyUsed, field.TypeFloat64, value)
+		_node.EnergyUsed = value
+	}
+	if value, ok := _c.mutation.PowerSeconds(); ok {
+		_spec.SetField(vehiclestateperiod.FieldPowerSeconds, field.TypeInt, value)
+		_node.PowerSeconds = value
+	}
+	if value, ok := _c.mutation.OutsideTemp(); ok {
+		_spec.SetField(vehiclestateperiod.FieldOutsideTemp, field.TypeFloat64, value)
+		_node.OutsideTemp = &value
+	}
+	if value, ok := _c.mutation.MaxSpeed(); ok {
+		_spec.SetField(vehiclestateperiod.FieldMaxSpeed, field.TypeFloat64, value)
+		_node.MaxSpeed = value
+	}
+	if value, ok := _c.mutation.ClimateRatio(); ok {
+		_spec.SetField(vehiclestateperiod.FieldClimateRatio, field.TypeFloat64, value)
+		_node.ClimateRatio = value
+	}
+	if value, ok := _c.mutation.Cost(); ok {
+		_spec.SetField(vehiclestateperiod.FieldCost, field.TypeFloat64, value)
+		_node.Cost = &value
+	}
+	if value, ok := _c.mutation.CostManual(); ok {
+		_spec.SetField(vehiclestateperiod.FieldCostManual, field.TypeBool, value)
+		_node.CostManual = value
+	}
+	if value, ok := _c.mutation.TariffID(); ok {
+		_spec.SetField(vehiclestateperiod.FieldTariffID, field.TypeInt, value)
+		_node.TariffID = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(vehiclestateperiod.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(vehiclestateperiod.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(vehiclestateperiod.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(vehiclestateperiod.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// VehicleStatePeriodCreateBulk is the builder for creating many VehicleStatePeriod entities in bulk.
+type VehicleStatePeriodCreateBulk struct {
+	config
+	err      error
+	builders []*VehicleStatePeriodCreate
+}
+
+// Save creates the VehicleStatePeriod entities in the database.
+func (_c *VehicleStatePeriodCreateBulk) Save(ctx context.Context) ([]*VehicleStatePeriod, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*VehicleStatePeriod, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VehicleStatePeriodMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VehicleStatePeriodCreateBulk) SaveX(ctx context.Context) []*VehicleStatePeriod {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VehicleStatePeriodCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VehicleStatePeriodCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"teslatrack/internal/data/ent/predicate"
+	"teslatrack/internal/data/ent/vehiclestateperiod"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// VehicleStatePeriodDelete is the builder for deleting a VehicleStatePeriod entity.
+type VehicleStatePeriodDelete struct {
+	config
+	hooks    []Hook
+	mutation *VehicleStatePeriodMutation
+}
+
+// Where appends a list predicates to the VehicleStatePeriodDelete builder.
+func (_d *VehicleStatePeriodDelete) Where(ps ...predicate.VehicleStatePeriod) *VehicleStatePeriodDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *VehicleStatePeriodDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *VehicleStatePeriodDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *VehicleStatePeriodDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(vehiclestateperiod.Table, sqlgraph.NewFieldSpec(vehiclestateperiod.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// VehicleStatePeriodDeleteOne is the builder for deleting a single VehicleStatePeriod entity.
+type VehicleStatePeriodDeleteOne struct {
+	_d *VehicleStatePeriodDelete
+}
+
+// Where appends a list predicates to the VehicleStatePeriodDelete builder.
+func (_d *VehicleStatePeriodDeleteOne) Where(ps ...predicate.VehicleStatePeriod) *VehicleStatePeriodDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *VehicleStatePeriodDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{vehiclestateperiod.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *VehicleStatePeriodDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,2301 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"teslatrack/internal/data/ent/predicate"
+	"teslatrack/internal/data/ent/vehiclestateperiod"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// VehicleStatePeriodUpdate is the builder for updating VehicleStatePeriod entities.
+type VehicleStatePeriodUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VehicleStatePeriodMutation
+}
+
+// Where appends a list predicates to the VehicleStatePeriodUpdate builder.
+func (_u *VehicleStatePeriodUpdate) Where(ps ...predicate.VehicleStatePeriod) *VehicleStatePeriodUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_u *VehicleStatePeriodUpdate) SetVehicleID(v int) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetVehicleID()
+	_u.mutation.SetVehicleID(v)
+	return _u
+}
+
+// SetNillableVehicleID sets the "vehicle_id" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableVehicleID(v *int) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetVehicleID(*v)
+	}
+	return _u
+}
+
+// AddVehicleID adds value to the "vehicle_id" field.
+func (_u *VehicleStatePeriodUpdate) AddVehicleID(v int) *VehicleStatePeriodUpdate {
+	_u.mutation.AddVehicleID(v)
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *VehicleStatePeriodUpdate) SetState(v string) *VehicleStatePeriodUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableState(v *string) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetSentryMode sets the "sentry_mode" field.
+func (_u *VehicleStatePeriodUpdate) SetSentryMode(v bool) *VehicleStatePeriodUpdate {
+	_u.mutation.SetSentryMode(v)
+	return _u
+}
+
+// SetNillableSentryMode sets the "sentry_mode" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableSentryMode(v *bool) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetSentryMode(*v)
+	}
+	return _u
+}
+
+// SetClimateOn sets the "climate_on" field.
+func (_u *VehicleStatePeriodUpdate) SetClimateOn(v bool) *VehicleStatePeriodUpdate {
+	_u.mutation.SetClimateOn(v)
+	return _u
+}
+
+// SetNillableClimateOn sets the "climate_on" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableClimateOn(v *bool) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetClimateOn(*v)
+	}
+	return _u
+}
+
+// SetStartAt sets the "start_at" field.
+func (_u *VehicleStatePeriodUpdate) SetStartAt(v time.Time) *VehicleStatePeriodUpdate {
+	_u.mutation.SetStartAt(v)
+	return _u
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableStartAt(v *time.Time) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetStartAt(*v)
+	}
+	return _u
+}
+
+// SetEndAt sets the "end_at" field.
+func (_u *VehicleStatePeriodUpdate) SetEndAt(v time.Time) *VehicleStatePeriodUpdate {
+	_u.mutation.SetEndAt(v)
+	return _u
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableEndAt(v *time.Time) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetEndAt(*v)
+	}
+	return _u
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (_u *VehicleStatePeriodUpdate) ClearEndAt() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearEndAt()
+	return _u
+}
+
+// SetStartBatteryLevel sets the "start_battery_level" field.
+func (_u *VehicleStatePeriodUpdate) SetStartBatteryLevel(v int) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetStartBatteryLevel()
+	_u.mutation.SetStartBatteryLevel(v)
+	return _u
+}
+
+// SetNillableStartBatteryLevel sets the "start_battery_level" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableStartBatteryLevel(v *int) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetStartBatteryLevel(*v)
+	}
+	return _u
+}
+
+// AddStartBatteryLevel adds value to the "start_battery_level" field.
+func (_u *VehicleStatePeriodUpdate) AddStartBatteryLevel(v int) *VehicleStatePeriodUpdate {
+	_u.mutation.AddStartBatteryLevel(v)
+	return _u
+}
+
+// ClearStartBatteryLevel clears the value of the "start_battery_level" field.
+func (_u *VehicleStatePeriodUpdate) ClearStartBatteryLevel() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearStartBatteryLevel()
+	return _u
+}
+
+// SetEndBatteryLevel sets the "end_battery_level" field.
+func (_u *VehicleStatePeriodUpdate) SetEndBatteryLevel(v int) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetEndBatteryLevel()
+	_u.mutation.SetEndBatteryLevel(v)
+	return _u
+}
+
+// SetNillableEndBatteryLevel sets the "end_battery_level" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableEndBatteryLevel(v *int) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetEndBatteryLevel(*v)
+	}
+	return _u
+}
+
+// AddEndBatteryLevel adds value to the "end_battery_level" field.
+func (_u *VehicleStatePeriodUpdate) AddEndBatteryLevel(v int) *VehicleStatePeriodUpdate {
+	_u.mutation.AddEndBatteryLevel(v)
+	return _u
+}
+
+// ClearEndBatteryLevel clears the value of the "end_battery_level" field.
+func (_u *VehicleStatePeriodUpdate) ClearEndBatteryLevel() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearEndBatteryLevel()
+	return _u
+}
+
+// SetStartRange sets the "start_range" field.
+func (_u *VehicleStatePeriodUpdate) SetStartRange(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetStartRange()
+	_u.mutation.SetStartRange(v)
+	return _u
+}
+
+// SetNillableStartRange sets the "start_range" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableStartRange(v *float64) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetStartRange(*v)
+	}
+	return _u
+}
+
+// AddStartRange adds value to the "start_range" field.
+func (_u *VehicleStatePeriodUpdate) AddStartRange(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.AddStartRange(v)
+	return _u
+}
+
+// ClearStartRange clears the value of the "start_range" field.
+func (_u *VehicleStatePeriodUpdate) ClearStartRange() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearStartRange()
+	return _u
+}
+
+// SetEndRange sets the "end_range" field.
+func (_u *VehicleStatePeriodUpdate) SetEndRange(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetEndRange()
+	_u.mutation.SetEndRange(v)
+	return _u
+}
+
+// SetNillableEndRange sets the "end_range" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableEndRange(v *float64) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetEndRange(*v)
+	}
+	return _u
+}
+
+// AddEndRange adds value to the "end_range" field.
+func (_u *VehicleStatePeriodUpdate) AddEndRange(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.AddEndRange(v)
+	return _u
+}
+
+// ClearEndRange clears the value of the "end_range" field.
+func (_u *VehicleStatePeriodUpdate) ClearEndRange() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearEndRange()
+	return _u
+}
+
+// SetStartOdometer sets the "start_odometer" field.
+func (_u *VehicleStatePeriodUpdate) SetStartOdometer(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetStartOdometer()
+	_u.mutation.SetStartOdometer(v)
+	return _u
+}
+
+// SetNillableStartOdometer sets the "start_odometer" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableStartOdometer(v *float64) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetStartOdometer(*v)
+	}
+	return _u
+}
+
+// AddStartOdometer adds value to the "start_odometer" field.
+func (_u *VehicleStatePeriodUpdate) AddStartOdometer(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.AddStartOdometer(v)
+	return _u
+}
+
+// ClearStartOdometer clears the value of the "start_odometer" field.
+func (_u *VehicleStatePeriodUpdate) ClearStartOdometer() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearStartOdometer()
+	return _u
+}
+
+// SetEndOdometer sets the "end_odometer" field.
+func (_u *VehicleStatePeriodUpdate) SetEndOdometer(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetEndOdometer()
+	_u.mutation.SetEndOdometer(v)
+	return _u
+}
+
+// SetNillableEndOdometer sets the "end_odometer" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableEndOdometer(v *float64) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetEndOdometer(*v)
+	}
+	return _u
+}
+
+// AddEndOdometer adds value to the "end_odometer" field.
+func (_u *VehicleStatePeriodUpdate) AddEndOdometer(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.AddEndOdometer(v)
+	return _u
+}
+
+// ClearEndOdometer clears the value of the "end_odometer" field.
+func (_u *VehicleStatePeriodUpdate) ClearEndOdometer() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearEndOdometer()
+	return _u
+}
+
+// SetStartLatitude sets the "start_latitude" field.
+func (_u *VehicleStatePeriodUpdate) SetStartLatitude(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetStartLatitude()
+	_u.mutation.SetStartLatitude(v)
+	return _u
+}
+
+// SetNillableStartLatitude sets the "start_latitude" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableStartLatitude(v *float64) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetStartLatitude(*v)
+	}
+	return _u
+}
+
+// AddStartLatitude adds value to the "start_latitude" field.
+func (_u *VehicleStatePeriodUpdate) AddStartLatitude(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.AddStartLatitude(v)
+	return _u
+}
+
+// ClearStartLatitude clears the value of the "start_latitude" field.
+func (_u *VehicleStatePeriodUpdate) ClearStartLatitude() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearStartLatitude()
+	return _u
+}
+
+// SetStartLongitude sets the "start_longitude" field.
+func (_u *VehicleStatePeriodUpdate) SetStartLongitude(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetStartLongitude()
+	_u.mutation.SetStartLongitude(v)
+	return _u
+}
+
+// SetNillableStartLongitude sets the "start_longitude" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableStartLongitude(v *float64) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetStartLongitude(*v)
+	}
+	return _u
+}
+
+// AddStartLongitude adds value to the "start_longitude" field.
+func (_u *VehicleStatePeriodUpdate) AddStartLongitude(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.AddStartLongitude(v)
+	return _u
+}
+
+// ClearStartLongitude clears the value of the "start_longitude" field.
+func (_u *VehicleStatePeriodUpdate) ClearStartLongitude() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearStartLongitude()
+	return _u
+}
+
+// SetEndLatitude sets the "end_latitude" field.
+func (_u *VehicleStatePeriodUpdate) SetEndLatitude(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetEndLatitude()
+	_u.mutation.SetEndLatitude(v)
+	return _u
+}
+
+// SetNillableEndLatitude sets the "end_latitude" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableEndLatitude(v *float64) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetEndLatitude(*v)
+	}
+	return _u
+}
+
+// AddEndLatitude adds value to the "end_latitude" field.
+func (_u *VehicleStatePeriodUpdate) AddEndLatitude(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.AddEndLatitude(v)
+	return _u
+}
+
+// ClearEndLatitude clears the value of the "end_latitude" field.
+func (_u *VehicleStatePeriodUpdate) ClearEndLatitude() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearEndLatitude()
+	return _u
+}
+
+// SetEndLongitude sets the "end_longitude" field.
+func (_u *VehicleStatePeriodUpdate) SetEndLongitude(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetEndLongitude()
+	_u.mutation.SetEndLongitude(v)
+	return _u
+}
+
+// SetNillableEndLongitude sets the "end_longitude" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableEndLongitude(v *float64) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetEndLongitude(*v)
+	}
+	return _u
+}
+
+// AddEndLongitude adds value to the "end_longitude" field.
+func (_u *VehicleStatePeriodUpdate) AddEndLongitude(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.AddEndLongitude(v)
+	return _u
+}
+
+// ClearEndLongitude clears the value of the "end_longitude" field.
+func (_u *VehicleStatePeriodUpdate) ClearEndLongitude() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearEndLongitude()
+	return _u
+}
+
+// SetStartAddress sets the "start_address" field.
+func (_u *VehicleStatePeriodUpdate) SetStartAddress(v string) *VehicleStatePeriodUpdate {
+	_u.mutation.SetStartAddress(v)
+	return _u
+}
+
+// SetNillableStartAddress sets the "start_address" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableStartAddress(v *string) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetStartAddress(*v)
+	}
+	return _u
+}
+
+// ClearStartAddress clears the value of the "start_address" field.
+func (_u *VehicleStatePeriodUpdate) ClearStartAddress() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearStartAddress()
+	return _u
+}
+
+// SetEndAddress sets the "end_address" field.
+func (_u *VehicleStatePeriodUpdate) SetEndAddress(v string) *VehicleStatePeriodUpdate {
+	_u.mutation.SetEndAddress(v)
+	return _u
+}
+
+// SetNillableEndAddress sets the "end_address" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableEndAddress(v *string) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetEndAddress(*v)
+	}
+	return _u
+}
+
+// ClearEndAddress clears the value of the "end_address" field.
+func (_u *VehicleStatePeriodUpdate) ClearEndAddress() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearEndAddress()
+	return _u
+}
+
+// SetStartGeofenceID sets the "start_geofence_id" field.
+func (_u *VehicleStatePeriodUpdate) SetStartGeofenceID(v int) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetStartGeofenceID()
+	_u.mutation.SetStartGeofenceID(v)
+	return _u
+}
+
+// SetNillableStartGeofenceID sets the "start_geofence_id" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableStartGeofenceID(v *int) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetStartGeofenceID(*v)
+	}
+	return _u
+}
+
+// AddStartGeofenceID adds value to the "start_geofence_id" field.
+func (_u *VehicleStatePeriodUpdate) AddStartGeofenceID(v int) *VehicleStatePeriodUpdate {
+	_u.mutation.AddStartGeofenceID(v)
+	return _u
+}
+
+// ClearStartGeofenceID clears the value of the "start_geofence_id" field.
+func (_u *VehicleStatePeriodUpdate) ClearStartGeofenceID() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearStartGeofenceID()
+	return _u
+}
+
+// SetEndGeofenceID sets the "end_geofence_id" field.
+func (_u *VehicleStatePeriodUpdate) SetEndGeofenceID(v int) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetEndGeofenceID()
+	_u.mutation.SetEndGeofenceID(v)
+	return _u
+}
+
+// SetNillableEndGeofenceID sets the "end_geofence_id" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableEndGeofenceID(v *int) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetEndGeofenceID(*v)
+	}
+	return _u
+}
+
+// AddEndGeofenceID adds value to the "end_geofence_id" field.
+func (_u *VehicleStatePeriodUpdate) AddEndGeofenceID(v int) *VehicleStatePeriodUpdate {
+	_u.mutation.AddEndGeofenceID(v)
+	return _u
+}
+
+// ClearEndGeofenceID clears the value of the "end_geofence_id" field.
+func (_u *VehicleStatePeriodUpdate) ClearEndGeofenceID() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearEndGeofenceID()
+	return _u
+}
+
+// SetChargeLocation sets the "charge_location" field.
+func (_u *VehicleStatePeriodUpdate) SetChargeLocation(v string) *VehicleStatePeriodUpdate {
+	_u.mutation.SetChargeLocation(v)
+	return _u
+}
+
+// SetNillableChargeLocation sets the "charge_location" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableChargeLocation(v *string) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetChargeLocation(*v)
+	}
+	return _u
+}
+
+// ClearChargeLocation clears the value of the "charge_location" field.
+func (_u *VehicleStatePeriodUpdate) ClearChargeLocation() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearChargeLocation()
+	return _u
+}
+
+// SetFastCharger sets the "fast_charger" field.
+func (_u *VehicleStatePeriodUpdate) SetFastCharger(v bool) *VehicleStatePeriodUpdate {
+	_u.mutation.SetFastCharger(v)
+	return _u
+}
+
+// SetNillableFastCharger sets the "fast_charger" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableFastCharger(v *bool) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetFastCharger(*v)
+	}
+	return _u
+}
+
+// SetEnergyAdded sets the "energy_added" field.
+func (_u *VehicleStatePeriodUpdate) SetEnergyAdded(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetEnergyAdded()
+	_u.mutation.SetEnergyAdded(v)
+	return _u
+}
+
+// SetNillableEnergyAdded sets the "energy_added" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableEnergyAdded(v *float64) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetEnergyAdded(*v)
+	}
+	return _u
+}
+
+// AddEnergyAdded adds value to the "energy_added" field.
+func (_u *VehicleStatePeriodUpdate) AddEnergyAdded(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.AddEnergyAdded(v)
+	return _u
+}
+
+// ClearEnergyAdded clears the value of the "energy_added" field.
+func (_u *VehicleStatePeriodUpdate) ClearEnergyAdded() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearEnergyAdded()
+	return _u
+}
+
+// SetStartEnergyAdded sets the "start_energy_added" field.
+func (_u *VehicleStatePeriodUpdate) SetStartEnergyAdded(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetStartEnergyAdded()
+	_u.mutation.SetStartEnergyAdded(v)
+	return _u
+}
+
+// SetNillableStartEnergyAdded sets the "start_energy_added" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableStartEnergyAdded(v *float64) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetStartEnergyAdded(*v)
+	}
+	return _u
+}
+
+// AddStartEnergyAdded adds value to the "start_energy_added" field.
+func (_u *VehicleStatePeriodUpdate) AddStartEnergyAdded(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.AddStartEnergyAdded(v)
+	return _u
+}
+
+// ClearStartEnergyAdded clears the value of the "start_energy_added" field.
+func (_u *VehicleStatePeriodUpdate) ClearStartEnergyAdded() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearStartEnergyAdded()
+	return _u
+}
+
+// SetEnergyUsed sets the "energy_used" field.
+func (_u *VehicleStatePeriodUpdate) SetEnergyUsed(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetEnergyUsed()
+	_u.mutation.SetEnergyUsed(v)
+	return _u
+}
+
+// SetNillableEnergyUsed sets the "energy_used" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableEnergyUsed(v *float64) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetEnergyUsed(*v)
+	}
+	return _u
+}
+
+// AddEnergyUsed adds value to the "energy_used" field.
+func (_u *VehicleStatePeriodUpdate) AddEnergyUsed(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.AddEnergyUsed(v)
+	return _u
+}
+
+// ClearEnergyUsed clears the value of the "energy_used" field.
+func (_u *VehicleStatePeriodUpdate) ClearEnergyUsed() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearEnergyUsed()
+	return _u
+}
+
+// SetPowerSeconds sets the "power_seconds" field.
+func (_u *VehicleStatePeriodUpdate) SetPowerSeconds(v int) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetPowerSeconds()
+	_u.mutation.SetPowerSeconds(v)
+	return _u
+}
+
+// SetNillablePowerSeconds sets the "power_seconds" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillablePowerSeconds(v *int) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetPowerSeconds(*v)
+	}
+	return _u
+}
+
+// AddPowerSeconds adds value to the "power_seconds" field.
+func (_u *VehicleStatePeriodUpdate) AddPowerSeconds(v int) *VehicleStatePeriodUpdate {
+	_u.mutation.AddPowerSeconds(v)
+	return _u
+}
+
+// ClearPowerSeconds clears the value of the "power_seconds" field.
+func (_u *VehicleStatePeriodUpdate) ClearPowerSeconds() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearPowerSeconds()
+	return _u
+}
+
+// SetOutsideTemp sets the "outside_temp" field.
+func (_u *VehicleStatePeriodUpdate) SetOutsideTemp(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetOutsideTemp()
+	_u.mutation.SetOutsideTemp(v)
+	return _u
+}
+
+// SetNillableOutsideTemp sets the "outside_temp" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableOutsideTemp(v *float64) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetOutsideTemp(*v)
+	}
+	return _u
+}
+
+// AddOutsideTemp adds value to the "outside_temp" field.
+func (_u *VehicleStatePeriodUpdate) AddOutsideTemp(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.AddOutsideTemp(v)
+	return _u
+}
+
+// ClearOutsideTemp clears the value of the "outside_temp" field.
+func (_u *VehicleStatePeriodUpdate) ClearOutsideTemp() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearOutsideTemp()
+	return _u
+}
+
+// SetMaxSpeed sets the "max_speed" field.
+func (_u *VehicleStatePeriodUpdate) SetMaxSpeed(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetMaxSpeed()
+	_u.mutation.SetMaxSpeed(v)
+	return _u
+}
+
+// SetNillableMaxSpeed sets the "max_speed" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableMaxSpeed(v *float64) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetMaxSpeed(*v)
+	}
+	return _u
+}
+
+// AddMaxSpeed adds value to the "max_speed" field.
+func (_u *VehicleStatePeriodUpdate) AddMaxSpeed(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.AddMaxSpeed(v)
+	return _u
+}
+
+// ClearMaxSpeed clears the value of the "max_speed" field.
+func (_u *VehicleStatePeriodUpdate) ClearMaxSpeed() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearMaxSpeed()
+	return _u
+}
+
+// SetClimateRatio sets the "climate_ratio" field.
+func (_u *VehicleStatePeriodUpdate) SetClimateRatio(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetClimateRatio()
+	_u.mutation.SetClimateRatio(v)
+	return _u
+}
+
+// SetNillableClimateRatio sets the "climate_ratio" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableClimateRatio(v *float64) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetClimateRatio(*v)
+	}
+	return _u
+}
+
+// AddClimateRatio adds value to the "climate_ratio" field.
+func (_u *VehicleStatePeriodUpdate) AddClimateRatio(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.AddClimateRatio(v)
+	return _u
+}
+
+// ClearClimateRatio clears the value of the "climate_ratio" field.
+func (_u *VehicleStatePeriodUpdate) ClearClimateRatio() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearClimateRatio()
+	return _u
+}
+
+// SetCost sets the "cost" field.
+func (_u *VehicleStatePeriodUpdate) SetCost(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetCost()
+	_u.mutation.SetCost(v)
+	return _u
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableCost(v *float64) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetCost(*v)
+	}
+	return _u
+}
+
+// AddCost adds value to the "cost" field.
+func (_u *VehicleStatePeriodUpdate) AddCost(v float64) *VehicleStatePeriodUpdate {
+	_u.mutation.AddCost(v)
+	return _u
+}
+
+// ClearCost clears the value of the "cost" field.
+func (_u *VehicleStatePeriodUpdate) ClearCost() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearCost()
+	return _u
+}
+
+// SetCostManual sets the "cost_manual" field.
+func (_u *VehicleStatePeriodUpdate) SetCostManual(v bool) *VehicleStatePeriodUpdate {
+	_u.mutation.SetCostManual(v)
+	return _u
+}
+
+// SetNillableCostManual sets the "cost_manual" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableCostManual(v *bool) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetCostManual(*v)
+	}
+	return _u
+}
+
+// SetTariffID sets the "tariff_id" field.
+func (_u *VehicleStatePeriodUpdate) SetTariffID(v int) *VehicleStatePeriodUpdate {
+	_u.mutation.ResetTariffID()
+	_u.mutation.SetTariffID(v)
+	return _u
+}
+
+// SetNillableTariffID sets the "tariff_id" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableTariffID(v *int) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetTariffID(*v)
+	}
+	return _u
+}
+
+// AddTariffID adds value to the "tariff_id" field.
+func (_u *VehicleStatePeriodUpdate) AddTariffID(v int) *VehicleStatePeriodUpdate {
+	_u.mutation.AddTariffID(v)
+	return _u
+}
+
+// ClearTariffID clears the value of the "tariff_id" field.
+func (_u *VehicleStatePeriodUpdate) ClearTariffID() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearTariffID()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *VehicleStatePeriodUpdate) SetName(v string) *VehicleStatePeriodUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdate) SetNillableName(v *string) *VehicleStatePeriodUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *VehicleStatePeriodUpdate) ClearName() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *VehicleStatePeriodUpdate) SetTags(v []string) *VehicleStatePeriodUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *VehicleStatePeriodUpdate) AppendTags(v []string) *VehicleStatePeriodUpdate {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *VehicleStatePeriodUpdate) ClearTags() *VehicleStatePeriodUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VehicleStatePeriodUpdate) SetUpdatedAt(v time.Time) *VehicleStatePeriodUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the VehicleStatePeriodMutation object of the builder.
+func (_u *VehicleStatePeriodUpdate) Mutation() *VehicleStatePeriodMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VehicleStatePeriodUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VehicleStatePeriodUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VehicleStatePeriodUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VehicleStatePeriodUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VehicleStatePeriodUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := vehiclestateperiod.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *VehicleStatePeriodUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vehiclestateperiod.Table, vehiclestateperiod.Columns, sqlgraph.NewFieldSpec(vehiclestateperiod.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VehicleID(); ok {
+		_spec.SetField(vehiclestateperiod.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVehicleID(); ok {
+		_spec.AddField(vehiclestateperiod.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(vehiclestateperiod.FieldState, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SentryMode(); ok {
+		_spec.SetField(vehiclestateperiod.FieldSentryMode, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ClimateOn(); ok {
+		_spec.SetField(vehiclestateperiod.FieldClimateOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.StartAt(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndAt(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndAtCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEndAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StartBatteryLevel(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartBatteryLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartBatteryLevel(); ok {
+		_spec.AddField(vehiclestateperiod.FieldStartBatteryLevel, field.TypeInt, value)
+	}
+	if _u.mutation.StartBatteryLevelCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldStartBatteryLevel, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EndBatteryLevel(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndBatteryLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndBatteryLevel(); ok {
+		_spec.AddField(vehiclestateperiod.FieldEndBatteryLevel, field.TypeInt, value)
+	}
+	if _u.mutation.EndBatteryLevelCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEndBatteryLevel, field.TypeInt)
+	}
+	if value, ok := _u.mutation.StartRange(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartRange, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStartRange(); ok {
+		_spec.AddField(vehiclestateperiod.FieldStartRange, field.TypeFloat64, value)
+	}
+	if _u.mutation.StartRangeCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldStartRange, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EndRange(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndRange, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEndRange(); ok {
+		_spec.AddField(vehiclestateperiod.FieldEndRange, field.TypeFloat64, value)
+	}
+	if _u.mutation.EndRangeCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEndRange, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.StartOdometer(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartOdometer, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStartOdometer(); ok {
+		_spec.AddField(vehiclestateperiod.FieldStartOdometer, field.TypeFloat64, value)
+	}
+	if _u.mutation.StartOdometerCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldStartOdometer, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EndOdometer(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndOdometer, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEndOdometer(); ok {
+		_spec.AddField(vehiclestateperiod.FieldEndOdometer, field.TypeFloat64, value)
+	}
+	if _u.mutation.EndOdometerCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEndOdometer, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.StartLatitude(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStartLatitude(); ok {
+		_spec.AddField(vehiclestateperiod.FieldStartLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.StartLatitudeCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldStartLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.StartLongitude(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStartLongitude(); ok {
+		_spec.AddField(vehiclestateperiod.FieldStartLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.StartLongitudeCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldStartLongitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EndLatitude(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEndLatitude(); ok {
+		_spec.AddField(vehiclestateperiod.FieldEndLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.EndLatitudeCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEndLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EndLongitude(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEndLongitude(); ok {
+		_spec.AddField(vehiclestateperiod.FieldEndLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.EndLongitudeCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEndLongitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.StartAddress(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartAddress, field.TypeString, value)
+	}
+	if _u.mutation.StartAddressCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldStartAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.EndAddress(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndAddress, field.TypeString, value)
+	}
+	if _u.mutation.EndAddressCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEndAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartGeofenceID(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartGeofenceID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartGeofenceID(); ok {
+		_spec.AddField(vehiclestateperiod.FieldStartGeofenceID, field.TypeInt, value)
+	}
+	if _u.mutation.StartGeofenceIDCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldStartGeofenceID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EndGeofenceID(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndGeofenceID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndGeofenceID(); ok {
+		_spec.AddField(vehiclestateperiod.FieldEndGeofenceID, field.TypeInt, value)
+	}
+	if _u.mutation.EndGeofenceIDCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEndGeofenceID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChargeLocation(); ok {
+		_spec.SetField(vehiclestateperiod.FieldChargeLocation, field.TypeString, value)
+	}
+	if _u.mutation.ChargeLocationCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldChargeLocation, field.TypeString)
+	}
+	if value, ok := _u.mutation.FastCharger(); ok {
+		_spec.SetField(vehiclestateperiod.FieldFastCharger, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EnergyAdded(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEnergyAdded, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEnergyAdded(); ok {
+		_spec.AddField(vehiclestateperiod.FieldEnergyAdded, field.TypeFloat64, value)
+	}
+	if _u.mutation.EnergyAddedCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEnergyAdded, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.StartEnergyAdded(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartEnergyAdded, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStartEnergyAdded(); ok {
+		_spec.AddField(vehiclestateperiod.FieldStartEnergyAdded, field.TypeFloat64, value)
+	}
+	if _u.mutation.StartEnergyAddedCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldStartEnergyAdded, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EnergyUsed(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEnergyUsed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEnergyUsed(); ok {
+		_spec.AddField(vehiclestateperiod.FieldEnergyUsed, field.TypeFloat64, value)
+	}
+	if _u.mutation.EnergyUsedCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEnergyUsed, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PowerSeconds(); ok {
+		_spec.SetField(vehiclestateperiod.FieldPowerSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPowerSeconds(); ok {
+		_spec.AddField(vehiclestateperiod.FieldPowerSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.PowerSecondsCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldPowerSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OutsideTemp(); ok {
+		_spec.SetField(vehiclestateperiod.FieldOutsideTemp, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutsideTemp(); ok {
+		_spec.AddField(vehiclestateperiod.FieldOutsideTemp, field.TypeFloat64, value)
+	}
+	if _u.mutation.OutsideTempCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldOutsideTemp, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MaxSpeed(); ok {
+		_spec.SetField(vehiclestateperiod.FieldMaxSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxSpeed(); ok {
+		_spec.AddField(vehiclestateperiod.FieldMaxSpeed, field.TypeFloat64, value)
+	}
+	if _u.mutation.MaxSpeedCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldMaxSpeed, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ClimateRatio(); ok {
+		_spec.SetField(vehiclestateperiod.FieldClimateRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedClimateRatio(); ok {
+		_spec.AddField(vehiclestateperiod.FieldClimateRatio, field.TypeFloat64, value)
+	}
+	if _u.mutation.ClimateRatioCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldClimateRatio, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Cost(); ok {
+		_spec.SetField(vehiclestateperiod.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCost(); ok {
+		_spec.AddField(vehiclestateperiod.FieldCost, field.TypeFloat64, value)
+	}
+	if _u.mutation.CostCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldCost, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CostManual(); ok {
+		_spec.SetField(vehiclestateperiod.FieldCostManual, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TariffID(); ok {
+		_spec.SetField(vehiclestateperiod.FieldTariffID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTariffID(); ok {
+		_spec.AddField(vehiclestateperiod.FieldTariffID, field.TypeInt, value)
+	}
+	if _u.mutation.TariffIDCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldTariffID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(vehiclestateperiod.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(vehiclestateperiod.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vehiclestateperiod.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(vehiclestateperiod.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vehiclestateperiod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VehicleStatePeriodUpdateOne is the builder for updating a single VehicleStatePeriod entity.
+type VehicleStatePeriodUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VehicleStatePeriodMutation
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_u *VehicleStatePeriodUpdateOne) SetVehicleID(v int) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetVehicleID()
+	_u.mutation.SetVehicleID(v)
+	return _u
+}
+
+// SetNillableVehicleID sets the "vehicle_id" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableVehicleID(v *int) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetVehicleID(*v)
+	}
+	return _u
+}
+
+// AddVehicleID adds value to the "vehicle_id" field.
+func (_u *VehicleStatePeriodUpdateOne) AddVehicleID(v int) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddVehicleID(v)
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *VehicleStatePeriodUpdateOne) SetState(v string) *VehicleStatePeriodUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableState(v *string) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// SetSentryMode sets the "sentry_mode" field.
+func (_u *VehicleStatePeriodUpdateOne) SetSentryMode(v bool) *VehicleStatePeriodUpdateOne {
+	_u.mutation.SetSentryMode(v)
+	return _u
+}
+
+// SetNillableSentryMode sets the "sentry_mode" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableSentryMode(v *bool) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetSentryMode(*v)
+	}
+	return _u
+}
+
+// SetClimateOn sets the "climate_on" field.
+func (_u *VehicleStatePeriodUpdateOne) SetClimateOn(v bool) *VehicleStatePeriodUpdateOne {
+	_u.mutation.SetClimateOn(v)
+	return _u
+}
+
+// SetNillableClimateOn sets the "climate_on" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableClimateOn(v *bool) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetClimateOn(*v)
+	}
+	return _u
+}
+
+// SetStartAt sets the "start_at" field.
+func (_u *VehicleStatePeriodUpdateOne) SetStartAt(v time.Time) *VehicleStatePeriodUpdateOne {
+	_u.mutation.SetStartAt(v)
+	return _u
+}
+
+// SetNillableStartAt sets the "start_at" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableStartAt(v *time.Time) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetStartAt(*v)
+	}
+	return _u
+}
+
+// SetEndAt sets the "end_at" field.
+func (_u *VehicleStatePeriodUpdateOne) SetEndAt(v time.Time) *VehicleStatePeriodUpdateOne {
+	_u.mutation.SetEndAt(v)
+	return _u
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableEndAt(v *time.Time) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetEndAt(*v)
+	}
+	return _u
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearEndAt() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearEndAt()
+	return _u
+}
+
+// SetStartBatteryLevel sets the "start_battery_level" field.
+func (_u *VehicleStatePeriodUpdateOne) SetStartBatteryLevel(v int) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetStartBatteryLevel()
+	_u.mutation.SetStartBatteryLevel(v)
+	return _u
+}
+
+// SetNillableStartBatteryLevel sets the "start_battery_level" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableStartBatteryLevel(v *int) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetStartBatteryLevel(*v)
+	}
+	return _u
+}
+
+// AddStartBatteryLevel adds value to the "start_battery_level" field.
+func (_u *VehicleStatePeriodUpdateOne) AddStartBatteryLevel(v int) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddStartBatteryLevel(v)
+	return _u
+}
+
+// ClearStartBatteryLevel clears the value of the "start_battery_level" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearStartBatteryLevel() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearStartBatteryLevel()
+	return _u
+}
+
+// SetEndBatteryLevel sets the "end_battery_level" field.
+func (_u *VehicleStatePeriodUpdateOne) SetEndBatteryLevel(v int) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetEndBatteryLevel()
+	_u.mutation.SetEndBatteryLevel(v)
+	return _u
+}
+
+// SetNillableEndBatteryLevel sets the "end_battery_level" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableEndBatteryLevel(v *int) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetEndBatteryLevel(*v)
+	}
+	return _u
+}
+
+// AddEndBatteryLevel adds value to the "end_battery_level" field.
+func (_u *VehicleStatePeriodUpdateOne) AddEndBatteryLevel(v int) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddEndBatteryLevel(v)
+	return _u
+}
+
+// ClearEndBatteryLevel clears the value of the "end_battery_level" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearEndBatteryLevel() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearEndBatteryLevel()
+	return _u
+}
+
+// SetStartRange sets the "start_range" field.
+func (_u *VehicleStatePeriodUpdateOne) SetStartRange(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetStartRange()
+	_u.mutation.SetStartRange(v)
+	return _u
+}
+
+// SetNillableStartRange sets the "start_range" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableStartRange(v *float64) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetStartRange(*v)
+	}
+	return _u
+}
+
+// AddStartRange adds value to the "start_range" field.
+func (_u *VehicleStatePeriodUpdateOne) AddStartRange(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddStartRange(v)
+	return _u
+}
+
+// ClearStartRange clears the value of the "start_range" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearStartRange() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearStartRange()
+	return _u
+}
+
+// SetEndRange sets the "end_range" field.
+func (_u *VehicleStatePeriodUpdateOne) SetEndRange(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetEndRange()
+	_u.mutation.SetEndRange(v)
+	return _u
+}
+
+// SetNillableEndRange sets the "end_range" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableEndRange(v *float64) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetEndRange(*v)
+	}
+	return _u
+}
+
+// AddEndRange adds value to the "end_range" field.
+func (_u *VehicleStatePeriodUpdateOne) AddEndRange(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddEndRange(v)
+	return _u
+}
+
+// ClearEndRange clears the value of the "end_range" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearEndRange() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearEndRange()
+	return _u
+}
+
+// SetStartOdometer sets the "start_odometer" field.
+func (_u *VehicleStatePeriodUpdateOne) SetStartOdometer(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetStartOdometer()
+	_u.mutation.SetStartOdometer(v)
+	return _u
+}
+
+// SetNillableStartOdometer sets the "start_odometer" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableStartOdometer(v *float64) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetStartOdometer(*v)
+	}
+	return _u
+}
+
+// AddStartOdometer adds value to the "start_odometer" field.
+func (_u *VehicleStatePeriodUpdateOne) AddStartOdometer(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddStartOdometer(v)
+	return _u
+}
+
+// ClearStartOdometer clears the value of the "start_odometer" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearStartOdometer() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearStartOdometer()
+	return _u
+}
+
+// SetEndOdometer sets the "end_odometer" field.
+func (_u *VehicleStatePeriodUpdateOne) SetEndOdometer(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetEndOdometer()
+	_u.mutation.SetEndOdometer(v)
+	return _u
+}
+
+// SetNillableEndOdometer sets the "end_odometer" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableEndOdometer(v *float64) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetEndOdometer(*v)
+	}
+	return _u
+}
+
+// AddEndOdometer adds value to the "end_odometer" field.
+func (_u *VehicleStatePeriodUpdateOne) AddEndOdometer(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddEndOdometer(v)
+	return _u
+}
+
+// ClearEndOdometer clears the value of the "end_odometer" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearEndOdometer() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearEndOdometer()
+	return _u
+}
+
+// SetStartLatitude sets the "start_latitude" field.
+func (_u *VehicleStatePeriodUpdateOne) SetStartLatitude(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetStartLatitude()
+	_u.mutation.SetStartLatitude(v)
+	return _u
+}
+
+// SetNillableStartLatitude sets the "start_latitude" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableStartLatitude(v *float64) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetStartLatitude(*v)
+	}
+	return _u
+}
+
+// AddStartLatitude adds value to the "start_latitude" field.
+func (_u *VehicleStatePeriodUpdateOne) AddStartLatitude(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddStartLatitude(v)
+	return _u
+}
+
+// ClearStartLatitude clears the value of the "start_latitude" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearStartLatitude() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearStartLatitude()
+	return _u
+}
+
+// SetStartLongitude sets the "start_longitude" field.
+func (_u *VehicleStatePeriodUpdateOne) SetStartLongitude(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetStartLongitude()
+	_u.mutation.SetStartLongitude(v)
+	return _u
+}
+
+// SetNillableStartLongitude sets the "start_longitude" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableStartLongitude(v *float64) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetStartLongitude(*v)
+	}
+	return _u
+}
+
+// AddStartLongitude adds value to the "start_longitude" field.
+func (_u *VehicleStatePeriodUpdateOne) AddStartLongitude(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddStartLongitude(v)
+	return _u
+}
+
+// ClearStartLongitude clears the value of the "start_longitude" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearStartLongitude() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearStartLongitude()
+	return _u
+}
+
+// SetEndLatitude sets the "end_latitude" field.
+func (_u *VehicleStatePeriodUpdateOne) SetEndLatitude(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetEndLatitude()
+	_u.mutation.SetEndLatitude(v)
+	return _u
+}
+
+// SetNillableEndLatitude sets the "end_latitude" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableEndLatitude(v *float64) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetEndLatitude(*v)
+	}
+	return _u
+}
+
+// AddEndLatitude adds value to the "end_latitude" field.
+func (_u *VehicleStatePeriodUpdateOne) AddEndLatitude(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddEndLatitude(v)
+	return _u
+}
+
+// ClearEndLatitude clears the value of the "end_latitude" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearEndLatitude() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearEndLatitude()
+	return _u
+}
+
+// SetEndLongitude sets the "end_longitude" field.
+func (_u *VehicleStatePeriodUpdateOne) SetEndLongitude(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetEndLongitude()
+	_u.mutation.SetEndLongitude(v)
+	return _u
+}
+
+// SetNillableEndLongitude sets the "end_longitude" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableEndLongitude(v *float64) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetEndLongitude(*v)
+	}
+	return _u
+}
+
+// AddEndLongitude adds value to the "end_longitude" field.
+func (_u *VehicleStatePeriodUpdateOne) AddEndLongitude(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddEndLongitude(v)
+	return _u
+}
+
+// ClearEndLongitude clears the value of the "end_longitude" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearEndLongitude() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearEndLongitude()
+	return _u
+}
+
+// SetStartAddress sets the "start_address" field.
+func (_u *VehicleStatePeriodUpdateOne) SetStartAddress(v string) *VehicleStatePeriodUpdateOne {
+	_u.mutation.SetStartAddress(v)
+	return _u
+}
+
+// SetNillableStartAddress sets the "start_address" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableStartAddress(v *string) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetStartAddress(*v)
+	}
+	return _u
+}
+
+// ClearStartAddress clears the value of the "start_address" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearStartAddress() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearStartAddress()
+	return _u
+}
+
+// SetEndAddress sets the "end_address" field.
+func (_u *VehicleStatePeriodUpdateOne) SetEndAddress(v string) *VehicleStatePeriodUpdateOne {
+	_u.mutation.SetEndAddress(v)
+	return _u
+}
+
+// SetNillableEndAddress sets the "end_address" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableEndAddress(v *string) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetEndAddress(*v)
+	}
+	return _u
+}
+
+// ClearEndAddress clears the value of the "end_address" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearEndAddress() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearEndAddress()
+	return _u
+}
+
+// SetStartGeofenceID sets the "start_geofence_id" field.
+func (_u *VehicleStatePeriodUpdateOne) SetStartGeofenceID(v int) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetStartGeofenceID()
+	_u.mutation.SetStartGeofenceID(v)
+	return _u
+}
+
+// SetNillableStartGeofenceID sets the "start_geofence_id" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableStartGeofenceID(v *int) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetStartGeofenceID(*v)
+	}
+	return _u
+}
+
+// AddStartGeofenceID adds value to the "start_geofence_id" field.
+func (_u *VehicleStatePeriodUpdateOne) AddStartGeofenceID(v int) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddStartGeofenceID(v)
+	return _u
+}
+
+// ClearStartGeofenceID clears the value of the "start_geofence_id" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearStartGeofenceID() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearStartGeofenceID()
+	return _u
+}
+
+// SetEndGeofenceID sets the "end_geofence_id" field.
+func (_u *VehicleStatePeriodUpdateOne) SetEndGeofenceID(v int) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetEndGeofenceID()
+	_u.mutation.SetEndGeofenceID(v)
+	return _u
+}
+
+// SetNillableEndGeofenceID sets the "end_geofence_id" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableEndGeofenceID(v *int) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetEndGeofenceID(*v)
+	}
+	return _u
+}
+
+// AddEndGeofenceID adds value to the "end_geofence_id" field.
+func (_u *VehicleStatePeriodUpdateOne) AddEndGeofenceID(v int) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddEndGeofenceID(v)
+	return _u
+}
+
+// ClearEndGeofenceID clears the value of the "end_geofence_id" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearEndGeofenceID() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearEndGeofenceID()
+	return _u
+}
+
+// SetChargeLocation sets the "charge_location" field.
+func (_u *VehicleStatePeriodUpdateOne) SetChargeLocation(v string) *VehicleStatePeriodUpdateOne {
+	_u.mutation.SetChargeLocation(v)
+	return _u
+}
+
+// SetNillableChargeLocation sets the "charge_location" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableChargeLocation(v *string) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetChargeLocation(*v)
+	}
+	return _u
+}
+
+// ClearChargeLocation clears the value of the "charge_location" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearChargeLocation() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearChargeLocation()
+	return _u
+}
+
+// SetFastCharger sets the "fast_charger" field.
+func (_u *VehicleStatePeriodUpdateOne) SetFastCharger(v bool) *VehicleStatePeriodUpdateOne {
+	_u.mutation.SetFastCharger(v)
+	return _u
+}
+
+// SetNillableFastCharger sets the "fast_charger" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableFastCharger(v *bool) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetFastCharger(*v)
+	}
+	return _u
+}
+
+// SetEnergyAdded sets the "energy_added" field.
+func (_u *VehicleStatePeriodUpdateOne) SetEnergyAdded(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetEnergyAdded()
+	_u.mutation.SetEnergyAdded(v)
+	return _u
+}
+
+// SetNillableEnergyAdded sets the "energy_added" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableEnergyAdded(v *float64) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetEnergyAdded(*v)
+	}
+	return _u
+}
+
+// AddEnergyAdded adds value to the "energy_added" field.
+func (_u *VehicleStatePeriodUpdateOne) AddEnergyAdded(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddEnergyAdded(v)
+	return _u
+}
+
+// ClearEnergyAdded clears the value of the "energy_added" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearEnergyAdded() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearEnergyAdded()
+	return _u
+}
+
+// SetStartEnergyAdded sets the "start_energy_added" field.
+func (_u *VehicleStatePeriodUpdateOne) SetStartEnergyAdded(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetStartEnergyAdded()
+	_u.mutation.SetStartEnergyAdded(v)
+	return _u
+}
+
+// SetNillableStartEnergyAdded sets the "start_energy_added" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableStartEnergyAdded(v *float64) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetStartEnergyAdded(*v)
+	}
+	return _u
+}
+
+// AddStartEnergyAdded adds value to the "start_energy_added" field.
+func (_u *VehicleStatePeriodUpdateOne) AddStartEnergyAdded(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddStartEnergyAdded(v)
+	return _u
+}
+
+// ClearStartEnergyAdded clears the value of the "start_energy_added" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearStartEnergyAdded() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearStartEnergyAdded()
+	return _u
+}
+
+// SetEnergyUsed sets the "energy_used" field.
+func (_u *VehicleStatePeriodUpdateOne) SetEnergyUsed(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetEnergyUsed()
+	_u.mutation.SetEnergyUsed(v)
+	return _u
+}
+
+// SetNillableEnergyUsed sets the "energy_used" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableEnergyUsed(v *float64) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetEnergyUsed(*v)
+	}
+	return _u
+}
+
+// AddEnergyUsed adds value to the "energy_used" field.
+func (_u *VehicleStatePeriodUpdateOne) AddEnergyUsed(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddEnergyUsed(v)
+	return _u
+}
+
+// ClearEnergyUsed clears the value of the "energy_used" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearEnergyUsed() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearEnergyUsed()
+	return _u
+}
+
+// SetPowerSeconds sets the "power_seconds" field.
+func (_u *VehicleStatePeriodUpdateOne) SetPowerSeconds(v int) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetPowerSeconds()
+	_u.mutation.SetPowerSeconds(v)
+	return _u
+}
+
+// SetNillablePowerSeconds sets the "power_seconds" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillablePowerSeconds(v *int) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetPowerSeconds(*v)
+	}
+	return _u
+}
+
+// AddPowerSeconds adds value to the "power_seconds" field.
+func (_u *VehicleStatePeriodUpdateOne) AddPowerSeconds(v int) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddPowerSeconds(v)
+	return _u
+}
+
+// ClearPowerSeconds clears the value of the "power_seconds" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearPowerSeconds() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearPowerSeconds()
+	return _u
+}
+
+// SetOutsideTemp sets the "outside_temp" field.
+func (_u *VehicleStatePeriodUpdateOne) SetOutsideTemp(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetOutsideTemp()
+	_u.mutation.SetOutsideTemp(v)
+	return _u
+}
+
+// SetNillableOutsideTemp sets the "outside_temp" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableOutsideTemp(v *float64) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetOutsideTemp(*v)
+	}
+	return _u
+}
+
+// AddOutsideTemp adds value to the "outside_temp" field.
+func (_u *VehicleStatePeriodUpdateOne) AddOutsideTemp(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddOutsideTemp(v)
+	return _u
+}
+
+// ClearOutsideTemp clears the value of the "outside_temp" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearOutsideTemp() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearOutsideTemp()
+	return _u
+}
+
+// SetMaxSpeed sets the "max_speed" field.
+func (_u *VehicleStatePeriodUpdateOne) SetMaxSpeed(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetMaxSpeed()
+	_u.mutation.SetMaxSpeed(v)
+	return _u
+}
+
+// SetNillableMaxSpeed sets the "max_speed" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableMaxSpeed(v *float64) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetMaxSpeed(*v)
+	}
+	return _u
+}
+
+// AddMaxSpeed adds value to the "max_speed" field.
+func (_u *VehicleStatePeriodUpdateOne) AddMaxSpeed(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddMaxSpeed(v)
+	return _u
+}
+
+// ClearMaxSpeed clears the value of the "max_speed" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearMaxSpeed() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearMaxSpeed()
+	return _u
+}
+
+// SetClimateRatio sets the "climate_ratio" field.
+func (_u *VehicleStatePeriodUpdateOne) SetClimateRatio(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetClimateRatio()
+	_u.mutation.SetClimateRatio(v)
+	return _u
+}
+
+// SetNillableClimateRatio sets the "climate_ratio" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableClimateRatio(v *float64) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetClimateRatio(*v)
+	}
+	return _u
+}
+
+// AddClimateRatio adds value to the "climate_ratio" field.
+func (_u *VehicleStatePeriodUpdateOne) AddClimateRatio(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddClimateRatio(v)
+	return _u
+}
+
+// ClearClimateRatio clears the value of the "climate_ratio" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearClimateRatio() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearClimateRatio()
+	return _u
+}
+
+// SetCost sets the "cost" field.
+func (_u *VehicleStatePeriodUpdateOne) SetCost(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetCost()
+	_u.mutation.SetCost(v)
+	return _u
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableCost(v *float64) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetCost(*v)
+	}
+	return _u
+}
+
+// AddCost adds value to the "cost" field.
+func (_u *VehicleStatePeriodUpdateOne) AddCost(v float64) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddCost(v)
+	return _u
+}
+
+// ClearCost clears the value of the "cost" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearCost() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearCost()
+	return _u
+}
+
+// SetCostManual sets the "cost_manual" field.
+func (_u *VehicleStatePeriodUpdateOne) SetCostManual(v bool) *VehicleStatePeriodUpdateOne {
+	_u.mutation.SetCostManual(v)
+	return _u
+}
+
+// SetNillableCostManual sets the "cost_manual" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableCostManual(v *bool) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetCostManual(*v)
+	}
+	return _u
+}
+
+// SetTariffID sets the "tariff_id" field.
+func (_u *VehicleStatePeriodUpdateOne) SetTariffID(v int) *VehicleStatePeriodUpdateOne {
+	_u.mutation.ResetTariffID()
+	_u.mutation.SetTariffID(v)
+	return _u
+}
+
+// SetNillableTariffID sets the "tariff_id" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableTariffID(v *int) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetTariffID(*v)
+	}
+	return _u
+}
+
+// AddTariffID adds value to the "tariff_id" field.
+func (_u *VehicleStatePeriodUpdateOne) AddTariffID(v int) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AddTariffID(v)
+	return _u
+}
+
+// ClearTariffID clears the value of the "tariff_id" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearTariffID() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearTariffID()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *VehicleStatePeriodUpdateOne) SetName(v string) *VehicleStatePeriodUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *VehicleStatePeriodUpdateOne) SetNillableName(v *string) *VehicleStatePeriodUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearName() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *VehicleStatePeriodUpdateOne) SetTags(v []string) *VehicleStatePeriodUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *VehicleStatePeriodUpdateOne) AppendTags(v []string) *VehicleStatePeriodUpdateOne {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *VehicleStatePeriodUpdateOne) ClearTags() *VehicleStatePeriodUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VehicleStatePeriodUpdateOne) SetUpdatedAt(v time.Time) *VehicleStatePeriodUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the VehicleStatePeriodMutation object of the builder.
+func (_u *VehicleStatePeriodUpdateOne) Mutation() *VehicleStatePeriodMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the VehicleStatePeriodUpdate builder.
+func (_u *VehicleStatePeriodUpdateOne) Where(ps ...predicate.VehicleStatePeriod) *VehicleStatePeriodUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VehicleStatePeriodUpdateOne) Select(field string, fields ...string) *VehicleStatePeriodUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated VehicleStatePeriod entity.
+func (_u *VehicleStatePeriodUpdateOne) Save(ctx context.Context) (*VehicleStatePeriod, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VehicleStatePeriodUpdateOne) SaveX(ctx context.Context) *VehicleStatePeriod {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VehicleStatePeriodUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VehicleStatePeriodUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VehicleStatePeriodUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := vehiclestateperiod.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *VehicleStatePeriodUpdateOne) sqlSave(ctx context.Context) (_node *VehicleStatePeriod, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vehiclestateperiod.Table, vehiclestateperiod.Columns, sqlgraph.NewFieldSpec(vehiclestateperiod.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VehicleStatePeriod.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vehiclestateperiod.FieldID)
+		for _, f := range fields {
+			if !vehiclestateperiod.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vehiclestateperiod.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.VehicleID(); ok {
+		_spec.SetField(vehiclestateperiod.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVehicleID(); ok {
+		_spec.AddField(vehiclestateperiod.FieldVehicleID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(vehiclestateperiod.FieldState, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SentryMode(); ok {
+		_spec.SetField(vehiclestateperiod.FieldSentryMode, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ClimateOn(); ok {
+		_spec.SetField(vehiclestateperiod.FieldClimateOn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.StartAt(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndAt(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndAtCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEndAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StartBatteryLevel(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartBatteryLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartBatteryLevel(); ok {
+		_spec.AddField(vehiclestateperiod.FieldStartBatteryLevel, field.TypeInt, value)
+	}
+	if _u.mutation.StartBatteryLevelCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldStartBatteryLevel, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EndBatteryLevel(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndBatteryLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndBatteryLevel(); ok {
+		_spec.AddField(vehiclestateperiod.FieldEndBatteryLevel, field.TypeInt, value)
+	}
+	if _u.mutation.EndBatteryLevelCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEndBatteryLevel, field.TypeInt)
+	}
+	if value, ok := _u.mutation.StartRange(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartRange, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStartRange(); ok {
+		_spec.AddField(vehiclestateperiod.FieldStartRange, field.TypeFloat64, value)
+	}
+	if _u.mutation.StartRangeCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldStartRange, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EndRange(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndRange, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEndRange(); ok {
+		_spec.AddField(vehiclestateperiod.FieldEndRange, field.TypeFloat64, value)
+	}
+	if _u.mutation.EndRangeCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEndRange, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.StartOdometer(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartOdometer, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStartOdometer(); ok {
+		_spec.AddField(vehiclestateperiod.FieldStartOdometer, field.TypeFloat64, value)
+	}
+	if _u.mutation.StartOdometerCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldStartOdometer, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EndOdometer(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndOdometer, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEndOdometer(); ok {
+		_spec.AddField(vehiclestateperiod.FieldEndOdometer, field.TypeFloat64, value)
+	}
+	if _u.mutation.EndOdometerCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEndOdometer, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.StartLatitude(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStartLatitude(); ok {
+		_spec.AddField(vehiclestateperiod.FieldStartLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.StartLatitudeCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldStartLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.StartLongitude(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStartLongitude(); ok {
+		_spec.AddField(vehiclestateperiod.FieldStartLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.StartLongitudeCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldStartLongitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EndLatitude(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEndLatitude(); ok {
+		_spec.AddField(vehiclestateperiod.FieldEndLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.EndLatitudeCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEndLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EndLongitude(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEndLongitude(); ok {
+		_spec.AddField(vehiclestateperiod.FieldEndLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.EndLongitudeCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEndLongitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.StartAddress(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartAddress, field.TypeString, value)
+	}
+	if _u.mutation.StartAddressCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldStartAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.EndAddress(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndAddress, field.TypeString, value)
+	}
+	if _u.mutation.EndAddressCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEndAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.StartGeofenceID(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartGeofenceID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartGeofenceID(); ok {
+		_spec.AddField(vehiclestateperiod.FieldStartGeofenceID, field.TypeInt, value)
+	}
+	if _u.mutation.StartGeofenceIDCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldStartGeofenceID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.EndGeofenceID(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEndGeofenceID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndGeofenceID(); ok {
+		_spec.AddField(vehiclestateperiod.FieldEndGeofenceID, field.TypeInt, value)
+	}
+	if _u.mutation.EndGeofenceIDCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEndGeofenceID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ChargeLocation(); ok {
+		_spec.SetField(vehiclestateperiod.FieldChargeLocation, field.TypeString, value)
+	}
+	if _u.mutation.ChargeLocationCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldChargeLocation, field.TypeString)
+	}
+	if value, ok := _u.mutation.FastCharger(); ok {
+		_spec.SetField(vehiclestateperiod.FieldFastCharger, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.EnergyAdded(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEnergyAdded, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEnergyAdded(); ok {
+		_spec.AddField(vehiclestateperiod.FieldEnergyAdded, field.TypeFloat64, value)
+	}
+	if _u.mutation.EnergyAddedCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEnergyAdded, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.StartEnergyAdded(); ok {
+		_spec.SetField(vehiclestateperiod.FieldStartEnergyAdded, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStartEnergyAdded(); ok {
+		_spec.AddField(vehiclestateperiod.FieldStartEnergyAdded, field.TypeFloat64, value)
+	}
+	if _u.mutation.StartEnergyAddedCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldStartEnergyAdded, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.EnergyUsed(); ok {
+		_spec.SetField(vehiclestateperiod.FieldEnergyUsed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEnergyUsed(); ok {
+		_spec.AddField(vehiclestateperiod.FieldEnergyUsed, field.TypeFloat64, value)
+	}
+	if _u.mutation.EnergyUsedCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldEnergyUsed, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.PowerSeconds(); ok {
+		_spec.SetField(vehiclestateperiod.FieldPowerSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPowerSeconds(); ok {
+		_spec.AddField(vehiclestateperiod.FieldPowerSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.PowerSecondsCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldPowerSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OutsideTemp(); ok {
+		_spec.SetField(vehiclestateperiod.FieldOutsideTemp, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOutsideTemp(); ok {
+		_spec.AddField(vehiclestateperiod.FieldOutsideTemp, field.TypeFloat64, value)
+	}
+	if _u.mutation.OutsideTempCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldOutsideTemp, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.MaxSpeed(); ok {
+		_spec.SetField(vehiclestateperiod.FieldMaxSpeed, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxSpeed(); ok {
+		_spec.AddField(vehiclestateperiod.FieldMaxSpeed, field.TypeFloat64, value)
+	}
+	if _u.mutation.MaxSpeedCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldMaxSpeed, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ClimateRatio(); ok {
+		_spec.SetField(vehiclestateperiod.FieldClimateRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedClimateRatio(); ok {
+		_spec.AddField(vehiclestateperiod.FieldClimateRatio, field.TypeFloat64, value)
+	}
+	if _u.mutation.ClimateRatioCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldClimateRatio, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Cost(); ok {
+		_spec.SetField(vehiclestateperiod.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCost(); ok {
+		_spec.AddField(vehiclestateperiod.FieldCost, field.TypeFloat64, value)
+	}
+	if _u.mutation.CostCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldCost, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CostManual(); ok {
+		_spec.SetField(vehiclestateperiod.FieldCostManual, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TariffID(); ok {
+		_spec.SetField(vehiclestateperiod.FieldTariffID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTariffID(); ok {
+		_spec.AddField(vehiclestateperiod.FieldTariffID, field.TypeInt, value)
+	}
+	if _u.mutation.TariffIDCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldTariffID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(vehiclestateperiod.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(vehiclestateperiod.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vehiclestateperiod.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(vehiclestateperiod.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(vehiclestateperiod.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &VehicleStatePeriod{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vehiclestateperiod.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,91 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"teslatrack/internal/biz"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// eventStreamKey is the Redis stream of the events shared by the instances.
+	eventStreamKey = keyPrefix + "events"
+	// eventIDKey is the counter of the IDs of the shared events.
+	eventIDKey = keyPrefix + "events:id"
+	// eventStreamLength is the approximate number of events kept in the stream.
+	eventStreamLength = 10000
+	// eventReadBlock bounds a blocking read of the stream.
+	eventReadBlock = 5 * time.Second
+	// eventReadRetry is the time waited before reading again after a failed read.
+	eventReadRetry = time.Second
+)
+
+// appendEventScript assigns an event the next ID and appends it to the stream, atomically,
+// so that the IDs increase in the order of the stream.
+var appendEventScript = redis.NewScript(`
+local id = redis.call("INCR", KEYS[1])
+redis.call("XADD", KEYS[2], "MAXLEN", "~", ARGV[2], "*", "id", id, "event", ARGV[1])
+return id`)
+
+var _ biz.EventStream = (*eventStream)(nil)
+
+// eventStream shares the events in a Redis stream. Without Redis the events are not shared.
+type eventStream struct {
+	data *Data
+}
+
+// NewEventStream creates a new eventStream.
+func NewEventStream(data *Data) biz.EventStream {
+	return &eventStream{data: data}
+}
+
+// Shared implements biz.EventStream.
+func (s *eventStream) Shared() bool {
+	return s.data.rdb != nil
+}
+
+// Append implements biz.EventStream.
+func (s *eventStream) Append(ctx context.Context, event []byte) error {
+	return appendEventScript.Run(ctx, s.data.rdb, []string{eventIDKey, eventStreamKey}, event, eventStreamLength).Err()
+}
+
+// Read implements biz.EventStream. A failing Redis is retried until ctx is done.
+func (s *eventStream) Read(ctx context.Context, deliver func(id uint64, event []byte)) error {
+	last := "$"
+	for {
+		streams, err := s.data.rdb.XRead(ctx, &redis.XReadArgs{
+			Streams: []string{eventStreamKey, last},
+			Block:   eventReadBlock,
+		}).Result()
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case errors.Is(err, redis.Nil):
+			continue
+		case err != nil:
+			s.data.log.Warnw("msg", "read event stream failed", "err", err)
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(eventReadRetry):
+			}
+			continue
+		}
+		for _, stream := range streams {
+			for _, msg := range stream.Messages {
+				last = msg.ID
+				id, err := strconv.ParseUint(fmt.Sprint(msg.Values["id"]), 10, 64)
+				event, ok := msg.Values["event"].(string)
+				if err != nil || !ok {
+					s.data.log.Errorw("msg", "invalid event in stream", "entry", msg.ID)
+					continue
+				}
+				deliver(id, []byte(event))
+			}
+		}
+	}
+}
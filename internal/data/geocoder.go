@@ -0,0 +1,89 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"teslatrack/internal/biz"
+	"teslatrack/internal/conf"
+	"teslatrack/pkg/geocode"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Geocoder providers selectable in the configuration.
+const (
+	GeocoderOffline   = "offline"
+	GeocoderNominatim = "nominatim"
+	GeocoderAmap      = "amap"
+)
+
+// defaultGeocoderTimeout bounds HTTP provider lookups so a slow provider never stalls polling.
+const defaultGeocoderTimeout = 5 * time.Second
+
+// geocoder adapts a geocode.Provider to biz.Geocoder.
+type geocoder struct {
+	name     string
+	provider geocode.Provider
+}
+
+var _ biz.Geocoder = (*geocoder)(nil)
+
+// NewGeocoder creates the configured geocoder, returns nil when geocoding is disabled.
+func NewGeocoder(c *conf.Data, logger log.Logger) (biz.Geocoder, error) {
+	gc := c.GetGeocoder()
+	if gc.GetProvider() == "" {
+		return nil, nil
+	}
+	timeout := defaultGeocoderTimeout
+	if gc.GetTimeout() != nil {
+		timeout = gc.GetTimeout().AsDuration()
+	}
+	client := &http.Client{Timeout: timeout}
+
+	var provider geocode.Provider
+	switch gc.GetProvider() {
+	case GeocoderOffline:
+		dataset, err := geocode.LoadFile(gc.GetDataset())
+		if err != nil {
+			return nil, fmt.Errorf("load geocoder dataset: %w", err)
+		}
+		log.NewHelper(logger).Infow("msg", "geocoder dataset loaded", "path", gc.GetDataset(), "places", dataset.Len())
+		provider = dataset
+	case GeocoderNominatim:
+		provider = &geocode.Nominatim{
+			Endpoint:  gc.GetEndpoint(),
+			Language:  gc.GetLanguage(),
+			UserAgent: "teslatrack",
+			Client:    client,
+		}
+	case GeocoderAmap:
+		provider = &geocode.Amap{Endpoint: gc.GetEndpoint(), Key: gc.GetKey(), Client: client}
+	default:
+		return nil, fmt.Errorf("unknown geocoder provider %q", gc.GetProvider())
+	}
+	return &geocoder{name: gc.GetProvider(), provider: provider}, nil
+}
+
+// Name implements biz.Geocoder.
+func (g *geocoder) Name() string {
+	return g.name
+}
+
+// Reverse implements biz.Geocoder.
+func (g *geocoder) Reverse(ctx context.Context, lat, lon float64) (*biz.Address, error) {
+	a, err := g.provider.Reverse(ctx, lat, lon)
+	if err != nil || a == nil {
+		return nil, err
+	}
+	return &biz.Address{
+		Name:      a.Name,
+		Road:      a.Road,
+		District:  a.District,
+		City:      a.City,
+		Province:  a.Province,
+		Country:   a.Country,
+		Formatted: a.String(),
+	}, nil
+}
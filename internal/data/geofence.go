@@ -0,0 +1,110 @@
+package data
+
+import (
+	"context"
+	"teslatrack/internal/biz"
+	"teslatrack/internal/data/ent"
+	"teslatrack/internal/data/ent/geofence"
+)
+
+var _ biz.GeofenceRepo = (*geofenceRepo)(nil)
+
+// geofenceRepo is the data layer implementation of GeofenceRepo.
+type geofenceRepo struct {
+	data *Data
+}
+
+// NewGeofenceRepo creates a new geofenceRepo.
+func NewGeofenceRepo(data *Data) biz.GeofenceRepo {
+	return &geofenceRepo{data: data}
+}
+
+// toBizGeofence converts an ent.Geofence model to a biz.Geofence model.
+func toBizGeofence(model *ent.Geofence) *biz.Geofence {
+	return &biz.Geofence{
+		ID:        model.ID,
+		UserID:    model.UserID,
+		Name:      model.Name,
+		Category:  model.Category,
+		Shape:     model.Shape,
+		Latitude:  model.Latitude,
+		Longitude: model.Longitude,
+		Radius:    model.Radius,
+		Polygon:   model.Polygon,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
+
+// Create implements biz.GeofenceRepo.
+func (r *geofenceRepo) Create(ctx context.Context, g *biz.Geofence) error {
+	model, err := r.data.db.Geofence.Create().
+		SetUserID(g.UserID).
+		SetName(g.Name).
+		SetCategory(g.Category).
+		SetShape(g.Shape).
+		SetLatitude(g.Latitude).
+		SetLongitude(g.Longitude).
+		SetRadius(g.Radius).
+		SetPolygon(g.Polygon).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	g.ID = model.ID
+	g.CreatedAt = model.CreatedAt
+	g.UpdatedAt = model.UpdatedAt
+	return nil
+}
+
+// Update implements biz.GeofenceRepo.
+func (r *geofenceRepo) Update(ctx context.Context, g *biz.Geofence) error {
+	model, err := r.data.db.Geofence.UpdateOneID(g.ID).
+		SetName(g.Name).
+		SetCategory(g.Category).
+		SetShape(g.Shape).
+		SetLatitude(g.Latitude).
+		SetLongitude(g.Longitude).
+		SetRadius(g.Radius).
+		SetPolygon(g.Polygon).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	g.CreatedAt = model.CreatedAt
+	g.UpdatedAt = model.UpdatedAt
+	return nil
+}
+
+// Delete implements biz.GeofenceRepo.
+func (r *geofenceRepo) Delete(ctx context.Context, id int) error {
+	return r.data.db.Geofence.DeleteOneID(id).Exec(ctx)
+}
+
+// FindOne implements biz.GeofenceRepo.
+func (r *geofenceRepo) FindOne(ctx context.Context, id int) (*biz.Geofence, error) {
+	model, err := r.data.db.Geofence.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toBizGeofence(model), nil
+}
+
+// ListByUser implements biz.GeofenceRepo.
+func (r *geofenceRepo) ListByUser(ctx context.Context, userID int) ([]*biz.Geofence, error) {
+	models, err := r.data.db.Geofence.Query().
+		Where(geofence.UserID(userID)).
+		Order(ent.Asc(geofence.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	geofences := make([]*biz.Geofence, 0, len(models))
+	for _, model := range models {
+		geofences = append(geofences, toBizGeofence(model))
+	}
+	return geofences, nil
+}
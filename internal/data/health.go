@@ -0,0 +1,32 @@
+package data
+
+import (
+	"context"
+	"teslatrack/internal/biz"
+)
+
+// A compile-time check to ensure that healthRepo implements the biz.HealthRepo interface.
+var _ biz.HealthRepo = (*healthRepo)(nil)
+
+// healthRepo checks the connectivity of the data stores.
+type healthRepo struct {
+	data *Data
+}
+
+// NewHealthRepo creates a new healthRepo.
+func NewHealthRepo(data *Data) biz.HealthRepo {
+	return &healthRepo{data: data}
+}
+
+// PingDatabase implements biz.HealthRepo.
+func (r *healthRepo) PingDatabase(ctx context.Context) error {
+	return r.data.sql.PingContext(ctx)
+}
+
+// PingRedis implements biz.HealthRepo.
+func (r *healthRepo) PingRedis(ctx context.Context) (bool, error) {
+	if r.data.rdb == nil {
+		return false, nil
+	}
+	return true, r.data.rdb.Ping(ctx).Err()
+}
@@ -0,0 +1,363 @@
+package data
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strconv"
+	"strings"
+	"teslatrack/internal/conf"
+	"teslatrack/internal/data/migrations"
+
+	"ariga.io/atlas/sql/migrate"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// migrationsTable records the version of the schema, in the format of golang-migrate so that
+// its CLI can operate the database too: a single row, dirty while a migration runs.
+const migrationsTable = "schema_migrations"
+
+var (
+	// ErrSchemaDirty is returned when a migration failed halfway; the schema must be
+	// repaired by hand, then its version set by Force.
+	ErrSchemaDirty = errors.New("the database schema is dirty, a migration failed halfway")
+	// ErrSchemaBehind is returned when migrations of the binary are not applied to the database.
+	ErrSchemaBehind = errors.New("the database schema is behind the binary")
+)
+
+// Migration is a versioned migration of the schema.
+type Migration struct {
+	Version int64
+	Name    string
+
+	up, down *migrate.LocalFile
+}
+
+// MigrationStatus is the state of the schema against the migrations of the binary.
+type MigrationStatus struct {
+	// Version is the version of the schema, 0 before the first migration.
+	Version int64
+	// Dirty reports that the migration to Version failed halfway.
+	Dirty bool
+	// Latest is the version of the last migration of the binary.
+	Latest int64
+	// Applied and Pending are the migrations up to Version and after it.
+	Applied, Pending []Migration
+}
+
+// Behind reports whether the binary needs migrations the database lacks.
+func (s *MigrationStatus) Behind() bool {
+	return s.Dirty || len(s.Pending) > 0
+}
+
+// Migrator applies the versioned migrations embedded in the binary to the database.
+type Migrator struct {
+	db         *sql.DB
+	dialect    string
+	migrations []Migration
+	log        *log.Helper
+}
+
+// NewMigrator opens the configured database for the migrate command.
+func NewMigrator(c *conf.Data_Database, logger log.Logger) (*Migrator, func(), error) {
+	name, ok := sqlDrivers[c.GetDriver()]
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported database driver %q, want mysql, postgres or sqlite3", c.GetDriver())
+	}
+	db, err := sql.Open(name, c.GetSource())
+	if err != nil {
+		return nil, nil, fmt.Errorf("open %s database: %w", c.Driver, err)
+	}
+	setPool(db, c)
+	m, err := newMigrator(db, c.Driver, migrations.FS, logger)
+	if err != nil {
+		_ = db.Close()
+		return nil, nil, err
+	}
+	return m, func() { _ = db.Close() }, nil
+}
+
+// newMigrator reads the migrations of a dialect from fsys, verifying them against the
+// checksums of their atlas.sum, so that an edited or a missing file is refused.
+func newMigrator(db *sql.DB, dialect string, fsys fs.FS, logger log.Logger) (*Migrator, error) {
+	ms, err := readMigrations(fsys, dialect)
+	if err != nil {
+		return nil, fmt.Errorf("read the %s migrations: %w", dialect, err)
+	}
+	return &Migrator{db: db, dialect: dialect, migrations: ms, log: log.NewHelper(logger)}, nil
+}
+
+// readMigrations reads the migrations of the directory of a dialect, ordered by version.
+func readMigrations(fsys fs.FS, dialect string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dialect)
+	if err != nil {
+		return nil, err
+	}
+	dir := &migrate.MemDir{}
+	byVersion := make(map[int64]*Migration)
+	for _, e := range entries {
+		b, err := fs.ReadFile(fsys, dialect+"/"+e.Name())
+		if err != nil {
+			return nil, err
+		}
+		if err := dir.WriteFile(e.Name(), b); err != nil {
+			return nil, err
+		}
+		base, up := strings.CutSuffix(e.Name(), ".up.sql")
+		if !up {
+			var down bool
+			if base, down = strings.CutSuffix(e.Name(), ".down.sql"); !down {
+				continue
+			}
+		}
+		v, name, _ := strings.Cut(base, "_")
+		version, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("migration %s: invalid version %q", e.Name(), v)
+		}
+		m := byVersion[version]
+		if m == nil {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		}
+		if up {
+			m.up = migrate.NewLocalFile(e.Name(), b)
+		} else {
+			m.down = migrate.NewLocalFile(e.Name(), b)
+		}
+	}
+	if err := migrate.Validate(dir); err != nil {
+		return nil, err
+	}
+	ms := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.up == nil {
+			return nil, fmt.Errorf("migration %d_%s: no up file", m.Version, m.Name)
+		}
+		ms = append(ms, *m)
+	}
+	slices.SortFunc(ms, func(a, b Migration) int { return cmp.Compare(a.Version, b.Version) })
+	return ms, nil
+}
+
+// Status returns the state of the schema.
+func (m *Migrator) Status(ctx context.Context) (*MigrationStatus, error) {
+	version, dirty, err := m.version(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s := &MigrationStatus{Version: version, Dirty: dirty}
+	if len(m.migrations) > 0 {
+		s.Latest = m.migrations[len(m.migrations)-1].Version
+	}
+	for _, mig := range m.migrations {
+		if mig.Version <= version {
+			s.Applied = append(s.Applied, mig)
+		} else {
+			s.Pending = append(s.Pending, mig)
+		}
+	}
+	return s, nil
+}
+
+// Up applies the pending migrations in order, returning those applied. It stops at the
+// first failing migration.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	s, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.Dirty {
+		return nil, fmt.Errorf("%w at version %d, see migrate force", ErrSchemaDirty, s.Version)
+	}
+	var applied []Migration
+	for _, mig := range s.Pending {
+		if err := m.run(ctx, mig.up, mig.Version); err != nil {
+			return applied, err
+		}
+		m.log.Infow("msg", "migration applied", "version", mig.Version, "name", mig.Name)
+		applied = append(applied, mig)
+	}
+	return applied, nil
+}
+
+// Down reverts the last steps applied migrations, newest first, returning those reverted.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	s, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.Dirty {
+		return nil, fmt.Errorf("%w at version %d, see migrate force", ErrSchemaDirty, s.Version)
+	}
+	if s.Version != 0 && !m.known(s.Version) {
+		return nil, fmt.Errorf("schema version %d is unknown to this binary", s.Version)
+	}
+	var reverted []Migration
+	for i := len(s.Applied) - 1; i >= 0 && len(reverted) < steps; i-- {
+		mig := s.Applied[i]
+		if mig.down == nil {
+			return reverted, fmt.Errorf("migration %d_%s cannot be reverted, it has no down file", mig.Version, mig.Name)
+		}
+		var target int64
+		if i > 0 {
+			target = s.Applied[i-1].Version
+		}
+		if err := m.run(ctx, mig.down, target); err != nil {
+			return reverted, err
+		}
+		m.log.Infow("msg", "migration reverted", "version", mig.Version, "name", mig.Name)
+		reverted = append(reverted, mig)
+	}
+	return reverted, nil
+}
+
+// Force records version as the clean version of the schema without running migrations,
+// to baseline a database created before the migrations or to recover from a failed one.
+func (m *Migrator) Force(ctx context.Context, version int64) error {
+	if version != 0 && !m.known(version) {
+		return fmt.Errorf("no migration of version %d", version)
+	}
+	if err := m.init(ctx); err != nil {
+		return err
+	}
+	return m.setVersion(ctx, m.db, version, false)
+}
+
+// known reports whether version is the version of a migration of the binary.
+func (m *Migrator) known(version int64) bool {
+	return slices.ContainsFunc(m.migrations, func(mig Migration) bool { return mig.Version == version })
+}
+
+// run runs the statements of a migration file, leaving the schema at version. PostgreSQL
+// and SQLite run it in a transaction. MySQL commits DDL implicitly, so the version is
+// recorded dirty first and a migration failing halfway leaves it dirty.
+func (m *Migrator) run(ctx context.Context, f *migrate.LocalFile, version int64) error {
+	stmts, err := f.Stmts()
+	if err != nil {
+		return fmt.Errorf("migration %s: %w", f.Name(), err)
+	}
+	if m.dialect == dialect.MySQL {
+		if err := m.setVersion(ctx, m.db, version, true); err != nil {
+			return err
+		}
+		for _, stmt := range stmts {
+			if _, err := m.db.ExecContext(ctx, stmt); err != nil {
+				return fmt.Errorf("migration %s: %w", f.Name(), err)
+			}
+		}
+		return m.setVersion(ctx, m.db, version, false)
+	}
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range stmts {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("migration %s: %w", f.Name(), err)
+		}
+	}
+	if err := m.setVersion(ctx, tx, version, false); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// init creates the migrations table unless it exists.
+func (m *Migrator) init(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+migrationsTable+
+		" (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)")
+	if err != nil {
+		return fmt.Errorf("create the %s table: %w", migrationsTable, err)
+	}
+	return nil
+}
+
+// version returns the recorded version of the schema, 0 if none.
+func (m *Migrator) version(ctx context.Context) (version int64, dirty bool, err error) {
+	if err := m.init(ctx); err != nil {
+		return 0, false, err
+	}
+	query, args := entsql.Dialect(m.dialect).
+		Select("version", "dirty").
+		From(entsql.Table(migrationsTable)).
+		Limit(1).
+		Query()
+	err = m.db.QueryRowContext(ctx, query, args...).Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
+
+// execer is a *sql.DB or a *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// setVersion replaces the recorded version of the schema; version 0 records none.
+func (m *Migrator) setVersion(ctx context.Context, ex execer, version int64, dirty bool) error {
+	query, args := entsql.Dialect(m.dialect).Delete(migrationsTable).Query()
+	if _, err := ex.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("record the schema version: %w", err)
+	}
+	if version == 0 {
+		return nil
+	}
+	query, args = entsql.Dialect(m.dialect).
+		Insert(migrationsTable).
+		Columns("version", "dirty").
+		Values(version, dirty).
+		Query()
+	if _, err := ex.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("record the schema version: %w", err)
+	}
+	return nil
+}
+
+// checkSchema applies the migrate policy of the configuration at startup.
+func checkSchema(ctx context.Context, m *Migrator, policy string, helper *log.Helper) error {
+	switch policy {
+	case "off":
+		return nil
+	case "up":
+		if _, err := m.Up(ctx); err != nil {
+			return fmt.Errorf("migrate the database schema: %w", err)
+		}
+	case "", "check", "require":
+	default:
+		return fmt.Errorf("unsupported database migrate policy %q, want check, require, up or off", policy)
+	}
+	s, err := m.Status(ctx)
+	if err != nil {
+		if policy == "" || policy == "check" {
+			helper.Warnw("msg", "cannot check the database schema version", "err", err)
+			return nil
+		}
+		return fmt.Errorf("check the database schema version: %w", err)
+	}
+	switch {
+	case !s.Behind():
+		if s.Version > s.Latest {
+			helper.Warnw("msg", "the database schema is ahead of the binary", "version", s.Version, "latest", s.Latest)
+		}
+		return nil
+	case policy == "require":
+		if s.Dirty {
+			return fmt.Errorf("%w at version %d, see migrate force", ErrSchemaDirty, s.Version)
+		}
+		return fmt.Errorf("%w: version %d, want %d, run migrate up", ErrSchemaBehind, s.Version, s.Latest)
+	default:
+		helper.Warnw("msg", "the database schema is behind the binary, run migrate up",
+			"version", s.Version, "latest", s.Latest, "pending", len(s.Pending), "dirty", s.Dirty)
+		return nil
+	}
+}
@@ -0,0 +1,212 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"teslatrack/internal/data/ent"
+	"teslatrack/internal/data/migrations"
+	"testing"
+	"testing/fstest"
+
+	"ariga.io/atlas/sql/migrate"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// newTestMigrator opens a Migrator of the embedded sqlite3 migrations on a private,
+// empty in-memory SQLite database.
+func newTestMigrator(t *testing.T) (*Migrator, *sql.DB) {
+	t.Helper()
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:migrate%d?mode=memory&cache=shared&_fk=1", testDatabases.Add(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+	m, err := newMigrator(db, "sqlite3", migrations.FS, log.DefaultLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m, db
+}
+
+// copyMigrations copies the embedded migrations of a dialect into a mutable file system.
+func copyMigrations(t *testing.T, dialect string) fstest.MapFS {
+	t.Helper()
+	fsys := fstest.MapFS{}
+	err := fs.WalkDir(migrations.FS, dialect, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		b, err := fs.ReadFile(migrations.FS, path)
+		fsys[path] = &fstest.MapFile{Data: b}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fsys
+}
+
+func TestReadMigrations(t *testing.T) {
+	var latest int64
+	for _, dialect := range []string{"mysql", "postgres", "sqlite3"} {
+		ms, err := readMigrations(migrations.FS, dialect)
+		if err != nil {
+			t.Fatalf("readMigrations(%s) error = %v", dialect, err)
+		}
+		if len(ms) == 0 {
+			t.Fatalf("readMigrations(%s) is empty", dialect)
+		}
+		for _, m := range ms {
+			if m.down == nil {
+				t.Errorf("migration %s %d_%s has no down file", dialect, m.Version, m.Name)
+			}
+			if _, err := m.up.Stmts(); err != nil {
+				t.Errorf("migration %s %d_%s: %v", dialect, m.Version, m.Name, err)
+			}
+		}
+		if latest != 0 && ms[len(ms)-1].Version != latest {
+			t.Errorf("latest %s migration = %d, want %d as the other dialects", dialect, ms[len(ms)-1].Version, latest)
+		}
+		latest = ms[len(ms)-1].Version
+	}
+}
+
+func TestReadMigrationsChecksum(t *testing.T) {
+	fsys := copyMigrations(t, "sqlite3")
+	ms, err := readMigrations(fsys, "sqlite3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	up := "sqlite3/" + ms[0].up.Name()
+	fsys[up].Data = append(fsys[up].Data, "DROP TABLE `user`;\n"...)
+	if _, err := readMigrations(fsys, "sqlite3"); !errors.Is(err, migrate.ErrChecksumMismatch) {
+		t.Errorf("readMigrations() with an edited migration error = %v, want %v", err, migrate.ErrChecksumMismatch)
+	}
+	delete(fsys, "sqlite3/atlas.sum")
+	if _, err := readMigrations(fsys, "sqlite3"); !errors.Is(err, migrate.ErrChecksumNotFound) {
+		t.Errorf("readMigrations() without atlas.sum error = %v, want %v", err, migrate.ErrChecksumNotFound)
+	}
+}
+
+func TestMigratorUpDown(t *testing.T) {
+	ctx := context.Background()
+	m, db := newTestMigrator(t)
+
+	s, err := m.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Version != 0 || len(s.Pending) != len(m.migrations) || !s.Behind() {
+		t.Fatalf("Status() of an empty database = %+v", s)
+	}
+	applied, err := m.Up(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != len(m.migrations) {
+		t.Errorf("Up() applied %d migrations, want %d", len(applied), len(m.migrations))
+	}
+	if s, err = m.Status(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if s.Version != s.Latest || s.Dirty || s.Behind() {
+		t.Errorf("Status() after Up() = %+v", s)
+	}
+	if applied, err = m.Up(ctx); err != nil || len(applied) != 0 {
+		t.Errorf("Up() when up to date = %d, %v", len(applied), err)
+	}
+
+	// The migrations build the schema of ent: inspecting the database plans no change.
+	client := ent.NewClient(ent.Driver(entsql.OpenDB("sqlite3", db)))
+	err = client.Schema.NamedDiff(ctx, "drift",
+		schema.WithDir(&migrate.MemDir{}),
+		schema.WithMigrationMode(schema.ModeInspect),
+		schema.WithDialect("sqlite3"),
+		schema.WithErrNoPlan(true),
+	)
+	if !errors.Is(err, migrate.ErrNoPlan) {
+		t.Errorf("the migrations differ from the ent schema, run internal/data/ent/migrate/main.go: %v", err)
+	}
+	if _, err := client.User.Query().Count(ctx); err != nil {
+		t.Errorf("query after Up() error = %v", err)
+	}
+
+	reverted, err := m.Down(ctx, len(m.migrations))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reverted) != len(m.migrations) {
+		t.Errorf("Down() reverted %d migrations, want %d", len(reverted), len(m.migrations))
+	}
+	if s, err = m.Status(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if s.Version != 0 || len(s.Applied) != 0 {
+		t.Errorf("Status() after Down() = %+v", s)
+	}
+	if _, err := client.User.Query().Count(ctx); err == nil {
+		t.Error("query after Down() succeeded, want the tables dropped")
+	}
+}
+
+func TestMigratorForce(t *testing.T) {
+	ctx := context.Background()
+	m, _ := newTestMigrator(t)
+	latest := m.migrations[len(m.migrations)-1].Version
+
+	if err := m.Force(ctx, 1); err == nil {
+		t.Error("Force(1) succeeded, want an unknown version refused")
+	}
+	if err := m.init(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.setVersion(ctx, m.db, latest, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(ctx); !errors.Is(err, ErrSchemaDirty) {
+		t.Errorf("Up() of a dirty schema error = %v, want %v", err, ErrSchemaDirty)
+	}
+	if _, err := m.Down(ctx, 1); !errors.Is(err, ErrSchemaDirty) {
+		t.Errorf("Down() of a dirty schema error = %v, want %v", err, ErrSchemaDirty)
+	}
+	if err := m.Force(ctx, latest); err != nil {
+		t.Fatal(err)
+	}
+	s, err := m.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Version != latest || s.Dirty {
+		t.Errorf("Status() after Force(%d) = %+v", latest, s)
+	}
+}
+
+func TestCheckSchema(t *testing.T) {
+	ctx := context.Background()
+	helper := log.NewHelper(log.DefaultLogger)
+	m, _ := newTestMigrator(t)
+
+	for _, policy := range []string{"", "check", "off"} {
+		if err := checkSchema(ctx, m, policy, helper); err != nil {
+			t.Errorf("checkSchema(%q) of a schema behind error = %v", policy, err)
+		}
+	}
+	if err := checkSchema(ctx, m, "require", helper); !errors.Is(err, ErrSchemaBehind) {
+		t.Errorf("checkSchema(require) of a schema behind error = %v, want %v", err, ErrSchemaBehind)
+	}
+	if err := checkSchema(ctx, m, "latest", helper); err == nil {
+		t.Error("checkSchema(latest) succeeded, want an unsupported policy refused")
+	}
+	if err := checkSchema(ctx, m, "up", helper); err != nil {
+		t.Fatalf("checkSchema(up) error = %v", err)
+	}
+	if err := checkSchema(ctx, m, "require", helper); err != nil {
+		t.Errorf("checkSchema(require) after up error = %v", err)
+	}
+}
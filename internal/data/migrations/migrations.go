@@ -0,0 +1,12 @@
+// Package migrations embeds the versioned migrations of the database schema. Each dialect
+// has a directory of golang-migrate files, <version>_<name>.up.sql and .down.sql, and the
+// atlas.sum checksum of its files, all generated from the ent schema by
+// internal/data/ent/migrate/main.go. Never edit an applied migration; add a new one.
+package migrations
+
+import "embed"
+
+// FS holds the mysql, postgres and sqlite3 migration directories.
+//
+//go:embed mysql postgres sqlite3
+var FS embed.FS
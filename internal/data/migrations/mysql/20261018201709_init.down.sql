@@ -0,0 +1,30 @@
+-- reverse: create "vehicle_state_period" table
+DROP TABLE `vehicle_state_period`;
+-- reverse: create "vehicle_snapshot" table
+DROP TABLE `vehicle_snapshot`;
+-- reverse: create "vehicle_rollup" table
+DROP TABLE `vehicle_rollup`;
+-- reverse: create "vehicle_event" table
+DROP TABLE `vehicle_event`;
+-- reverse: create "vehicle" table
+DROP TABLE `vehicle`;
+-- reverse: create "user" table
+DROP TABLE `user`;
+-- reverse: create "tire_pressure" table
+DROP TABLE `tire_pressure`;
+-- reverse: create "tariff" table
+DROP TABLE `tariff`;
+-- reverse: create "software_update" table
+DROP TABLE `software_update`;
+-- reverse: create "rollup_state" table
+DROP TABLE `rollup_state`;
+-- reverse: create "partner" table
+DROP TABLE `partner`;
+-- reverse: create "geofence" table
+DROP TABLE `geofence`;
+-- reverse: create "authorize_token" table
+DROP TABLE `authorize_token`;
+-- reverse: create "authorize" table
+DROP TABLE `authorize`;
+-- reverse: create "address" table
+DROP TABLE `address`;
@@ -0,0 +1,30 @@
+-- create "address" table
+CREATE TABLE `address` (`id` bigint NOT NULL AUTO_INCREMENT, `grid_latitude` bigint NOT NULL, `grid_longitude` bigint NOT NULL, `name` varchar(255) NULL, `road` varchar(255) NULL, `district` varchar(255) NULL, `city` varchar(255) NULL, `province` varchar(255) NULL, `country` varchar(255) NULL, `formatted` varchar(255) NULL, `provider` varchar(255) NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `address_grid_latitude_grid_longitude` (`grid_latitude`, `grid_longitude`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "authorize" table
+CREATE TABLE `authorize` (`id` bigint NOT NULL AUTO_INCREMENT, `client_id` varchar(255) NOT NULL, `client_secret` varchar(255) NOT NULL, `grant_type` varchar(255) NOT NULL, `redirect_uri` varchar(255) NOT NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `deleted` bool NOT NULL DEFAULT 0, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "authorize_token" table
+CREATE TABLE `authorize_token` (`id` bigint NOT NULL AUTO_INCREMENT, `tesla_code` longtext NOT NULL, `client_id` varchar(255) NOT NULL, `client_secret` varchar(255) NOT NULL, `access_token` longtext NOT NULL, `refresh_token` longtext NOT NULL, `scope` varchar(255) NOT NULL, `user_id` bigint NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `deleted` bool NOT NULL DEFAULT 0, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "geofence" table
+CREATE TABLE `geofence` (`id` bigint NOT NULL AUTO_INCREMENT, `user_id` bigint NOT NULL, `name` varchar(255) NOT NULL, `category` varchar(255) NOT NULL DEFAULT "other", `shape` varchar(255) NOT NULL, `latitude` double NULL, `longitude` double NULL, `radius` double NULL, `polygon` json NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `geofence_user_id` (`user_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "partner" table
+CREATE TABLE `partner` (`id` bigint NOT NULL AUTO_INCREMENT, `client_id` varchar(255) NOT NULL, `access_token` longtext NULL, `expires_in` bigint NULL, `token_type` varchar(125) NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `deleted` bool NOT NULL DEFAULT 0, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "rollup_state" table
+CREATE TABLE `rollup_state` (`id` bigint NOT NULL AUTO_INCREMENT, `vehicle_id` bigint NOT NULL, `time_zone` varchar(255) NOT NULL, `watermark` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `vehicle_id` (`vehicle_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "software_update" table
+CREATE TABLE `software_update` (`id` bigint NOT NULL AUTO_INCREMENT, `vehicle_id` bigint NOT NULL, `from_version` varchar(255) NULL, `version` varchar(255) NOT NULL, `status` varchar(255) NOT NULL, `download_perc` bigint NOT NULL DEFAULT 0, `install_perc` bigint NOT NULL DEFAULT 0, `expected_duration` bigint NOT NULL DEFAULT 0, `available_at` timestamp NOT NULL, `install_started_at` timestamp NULL, `completed_at` timestamp NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `softwareupdate_vehicle_id_available_at` (`vehicle_id`, `available_at`), INDEX `softwareupdate_vehicle_id_completed_at` (`vehicle_id`, `completed_at`), INDEX `softwareupdate_version` (`version`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "tariff" table
+CREATE TABLE `tariff` (`id` bigint NOT NULL AUTO_INCREMENT, `user_id` bigint NOT NULL, `name` varchar(255) NOT NULL, `kind` varchar(255) NOT NULL, `geofence_id` bigint NULL, `currency` varchar(255) NOT NULL DEFAULT "CNY", `price` double NOT NULL DEFAULT 0, `windows` json NULL, `time_zone` varchar(255) NOT NULL DEFAULT "Asia/Shanghai", `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `tariff_user_id` (`user_id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "tire_pressure" table
+CREATE TABLE `tire_pressure` (`id` bigint NOT NULL AUTO_INCREMENT, `vehicle_id` bigint NOT NULL, `pressure_fl` double NULL, `pressure_fr` double NULL, `pressure_rl` double NULL, `pressure_rr` double NULL, `seen_at_fl` timestamp NULL, `seen_at_fr` timestamp NULL, `seen_at_rl` timestamp NULL, `seen_at_rr` timestamp NULL, `recommended_front` double NULL, `recommended_rear` double NULL, `soft_warnings` bigint NOT NULL DEFAULT 0, `hard_warnings` bigint NOT NULL DEFAULT 0, `unit` varchar(255) NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `tirepressure_vehicle_id_created_at` (`vehicle_id`, `created_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "user" table
+CREATE TABLE `user` (`id` bigint NOT NULL AUTO_INCREMENT, `account` varchar(255) NOT NULL, `password` varchar(255) NOT NULL, `mobile` varchar(255) NULL, `open_id` varchar(255) NULL, `avatar` varchar(255) NULL, `nick_name` varchar(255) NULL, `introduction` varchar(255) NULL, `gender` tinyint NOT NULL DEFAULT 0, `asked_user_id` bigint NULL, `area_code` varchar(255) NULL, `time_zone` varchar(255) NOT NULL DEFAULT "Asia/Shanghai", `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `deleted` bool NOT NULL DEFAULT 0, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "vehicle" table
+CREATE TABLE `vehicle` (`id` bigint NOT NULL AUTO_INCREMENT, `vin` varchar(255) NOT NULL, `user_id` bigint NOT NULL, `display_name` varchar(255) NOT NULL, `access_type` varchar(255) NOT NULL, `state` tinyint NOT NULL, `in_service` tinyint NOT NULL DEFAULT 0, `calendar_enabled` tinyint NOT NULL DEFAULT 0, `car_type` varchar(255) NULL, `api_version` varchar(255) NULL, `raw_data` longtext NOT NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, `deleted` bool NOT NULL DEFAULT 0, PRIMARY KEY (`id`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "vehicle_event" table
+CREATE TABLE `vehicle_event` (`id` bigint NOT NULL AUTO_INCREMENT, `vehicle_id` bigint NOT NULL, `user_id` bigint NOT NULL, `type` varchar(255) NOT NULL, `summary` varchar(255) NOT NULL, `data` json NULL, `occurred_at` timestamp NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `vehicleevent_vehicle_id_occurred_at` (`vehicle_id`, `occurred_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "vehicle_rollup" table
+CREATE TABLE `vehicle_rollup` (`id` bigint NOT NULL AUTO_INCREMENT, `vehicle_id` bigint NOT NULL, `granularity` varchar(255) NOT NULL, `time_zone` varchar(255) NOT NULL, `start_at` timestamp NOT NULL, `end_at` timestamp NOT NULL, `drives` bigint NOT NULL DEFAULT 0, `distance` double NOT NULL DEFAULT 0, `drive_seconds` bigint NOT NULL DEFAULT 0, `energy_used` double NOT NULL DEFAULT 0, `efficiency` double NOT NULL DEFAULT 0, `max_speed` double NOT NULL DEFAULT 0, `charges` bigint NOT NULL DEFAULT 0, `energy_added` double NOT NULL DEFAULT 0, `charge_cost` double NOT NULL DEFAULT 0, `parked_seconds` bigint NOT NULL DEFAULT 0, `asleep_seconds` bigint NOT NULL DEFAULT 0, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `vehiclerollup_vehicle_id_granularity_time_zone_start_at` (`vehicle_id`, `granularity`, `time_zone`, `start_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "vehicle_snapshot" table
+CREATE TABLE `vehicle_snapshot` (`id` bigint NOT NULL AUTO_INCREMENT, `vehicle_id` bigint NOT NULL, `state` varchar(255) NOT NULL, `shift_state` varchar(255) NULL, `speed` double NULL, `power` bigint NULL, `latitude` double NULL, `longitude` double NULL, `coord_type` varchar(255) NULL, `heading` bigint NULL, `battery_level` bigint NULL, `usable_battery_level` bigint NULL, `battery_range` double NULL, `ideal_battery_range` double NULL, `est_battery_range` double NULL, `charging_state` varchar(255) NULL, `charger_power` bigint NULL, `charge_energy_added` double NULL, `charger_voltage` bigint NULL, `charger_actual_current` bigint NULL, `fast_charger_present` bool NOT NULL DEFAULT 0, `fast_charger_type` varchar(255) NULL, `battery_heater_on` bool NOT NULL DEFAULT 0, `outside_temp` double NULL, `inside_temp` double NULL, `climate_on` bool NOT NULL DEFAULT 0, `climate_keeper_mode` varchar(255) NULL, `cabin_overheat_cooling` bool NOT NULL DEFAULT 0, `sentry_mode` bool NOT NULL DEFAULT 0, `locked` bool NOT NULL DEFAULT 0, `odometer` double NULL, `car_version` varchar(255) NULL, `software_update_status` varchar(255) NULL, `software_update_version` varchar(255) NULL, `software_update_download_perc` bigint NULL, `software_update_install_perc` bigint NULL, `software_update_expected_duration` bigint NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `vehiclesnapshot_vehicle_id_created_at` (`vehicle_id`, `created_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- create "vehicle_state_period" table
+CREATE TABLE `vehicle_state_period` (`id` bigint NOT NULL AUTO_INCREMENT, `vehicle_id` bigint NOT NULL, `state` varchar(255) NOT NULL, `sentry_mode` bool NOT NULL DEFAULT 0, `climate_on` bool NOT NULL DEFAULT 0, `start_at` timestamp NOT NULL, `end_at` timestamp NULL, `start_battery_level` bigint NULL, `end_battery_level` bigint NULL, `start_range` double NULL, `end_range` double NULL, `start_odometer` double NULL, `end_odometer` double NULL, `start_latitude` double NULL, `start_longitude` double NULL, `end_latitude` double NULL, `end_longitude` double NULL, `start_address` varchar(255) NULL, `end_address` varchar(255) NULL, `start_geofence_id` bigint NULL, `end_geofence_id` bigint NULL, `charge_location` varchar(255) NULL, `fast_charger` bool NOT NULL DEFAULT 0, `energy_added` double NULL, `energy_used` double NULL, `power_seconds` bigint NULL, `outside_temp` double NULL, `max_speed` double NULL, `climate_ratio` double NULL, `cost` double NULL, `cost_manual` bool NOT NULL DEFAULT 0, `tariff_id` bigint NULL, `name` varchar(255) NULL, `tags` json NULL, `created_at` timestamp NOT NULL, `updated_at` timestamp NOT NULL, PRIMARY KEY (`id`), INDEX `vehiclestateperiod_vehicle_id_start_at` (`vehicle_id`, `start_at`), INDEX `vehiclestateperiod_vehicle_id_updated_at` (`vehicle_id`, `updated_at`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
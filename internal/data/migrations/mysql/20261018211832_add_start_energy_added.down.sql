@@ -0,0 +1,2 @@
+-- reverse: modify "vehicle_state_period" table
+ALTER TABLE `vehicle_state_period` DROP COLUMN `start_energy_added`;
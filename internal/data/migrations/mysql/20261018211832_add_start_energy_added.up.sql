@@ -0,0 +1,2 @@
+-- modify "vehicle_state_period" table
+ALTER TABLE `vehicle_state_period` ADD COLUMN `start_energy_added` double NULL;
@@ -0,0 +1,2 @@
+-- modify "user" table
+ALTER TABLE `user` ADD UNIQUE INDEX `user_account` (`account`);
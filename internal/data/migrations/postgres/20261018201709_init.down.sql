@@ -0,0 +1,56 @@
+-- reverse: create index "vehiclestateperiod_vehicle_id_updated_at" to table: "vehicle_state_period"
+DROP INDEX "vehiclestateperiod_vehicle_id_updated_at";
+-- reverse: create index "vehiclestateperiod_vehicle_id_start_at" to table: "vehicle_state_period"
+DROP INDEX "vehiclestateperiod_vehicle_id_start_at";
+-- reverse: create "vehicle_state_period" table
+DROP TABLE "vehicle_state_period";
+-- reverse: create index "vehiclesnapshot_vehicle_id_created_at" to table: "vehicle_snapshot"
+DROP INDEX "vehiclesnapshot_vehicle_id_created_at";
+-- reverse: create "vehicle_snapshot" table
+DROP TABLE "vehicle_snapshot";
+-- reverse: create index "vehiclerollup_vehicle_id_granularity_time_zone_start_at" to table: "vehicle_rollup"
+DROP INDEX "vehiclerollup_vehicle_id_granularity_time_zone_start_at";
+-- reverse: create "vehicle_rollup" table
+DROP TABLE "vehicle_rollup";
+-- reverse: create index "vehicleevent_vehicle_id_occurred_at" to table: "vehicle_event"
+DROP INDEX "vehicleevent_vehicle_id_occurred_at";
+-- reverse: create "vehicle_event" table
+DROP TABLE "vehicle_event";
+-- reverse: create "vehicle" table
+DROP TABLE "vehicle";
+-- reverse: create "user" table
+DROP TABLE "user";
+-- reverse: create index "tirepressure_vehicle_id_created_at" to table: "tire_pressure"
+DROP INDEX "tirepressure_vehicle_id_created_at";
+-- reverse: create "tire_pressure" table
+DROP TABLE "tire_pressure";
+-- reverse: create index "tariff_user_id" to table: "tariff"
+DROP INDEX "tariff_user_id";
+-- reverse: create "tariff" table
+DROP TABLE "tariff";
+-- reverse: create index "softwareupdate_version" to table: "software_update"
+DROP INDEX "softwareupdate_version";
+-- reverse: create index "softwareupdate_vehicle_id_completed_at" to table: "software_update"
+DROP INDEX "softwareupdate_vehicle_id_completed_at";
+-- reverse: create index "softwareupdate_vehicle_id_available_at" to table: "software_update"
+DROP INDEX "softwareupdate_vehicle_id_available_at";
+-- reverse: create "software_update" table
+DROP TABLE "software_update";
+-- reverse: create index "rollup_state_vehicle_id_key" to table: "rollup_state"
+DROP INDEX "rollup_state_vehicle_id_key";
+-- reverse: create "rollup_state" table
+DROP TABLE "rollup_state";
+-- reverse: create "partner" table
+DROP TABLE "partner";
+-- reverse: create index "geofence_user_id" to table: "geofence"
+DROP INDEX "geofence_user_id";
+-- reverse: create "geofence" table
+DROP TABLE "geofence";
+-- reverse: create "authorize_token" table
+DROP TABLE "authorize_token";
+-- reverse: create "authorize" table
+DROP TABLE "authorize";
+-- reverse: create index "address_grid_latitude_grid_longitude" to table: "address"
+DROP INDEX "address_grid_latitude_grid_longitude";
+-- reverse: create "address" table
+DROP TABLE "address";
@@ -0,0 +1,56 @@
+-- create "address" table
+CREATE TABLE "address" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "grid_latitude" bigint NOT NULL, "grid_longitude" bigint NOT NULL, "name" character varying NULL, "road" character varying NULL, "district" character varying NULL, "city" character varying NULL, "province" character varying NULL, "country" character varying NULL, "formatted" character varying NULL, "provider" character varying NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "address_grid_latitude_grid_longitude" to table: "address"
+CREATE UNIQUE INDEX "address_grid_latitude_grid_longitude" ON "address" ("grid_latitude", "grid_longitude");
+-- create "authorize" table
+CREATE TABLE "authorize" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "client_id" character varying NOT NULL, "client_secret" character varying NOT NULL, "grant_type" character varying NOT NULL, "redirect_uri" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "deleted" boolean NOT NULL DEFAULT false, PRIMARY KEY ("id"));
+-- create "authorize_token" table
+CREATE TABLE "authorize_token" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "tesla_code" text NOT NULL, "client_id" character varying NOT NULL, "client_secret" character varying NOT NULL, "access_token" text NOT NULL, "refresh_token" text NOT NULL, "scope" character varying NOT NULL, "user_id" bigint NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "deleted" boolean NOT NULL DEFAULT false, PRIMARY KEY ("id"));
+-- create "geofence" table
+CREATE TABLE "geofence" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "user_id" bigint NOT NULL, "name" character varying NOT NULL, "category" character varying NOT NULL DEFAULT 'other', "shape" character varying NOT NULL, "latitude" double precision NULL, "longitude" double precision NULL, "radius" double precision NULL, "polygon" jsonb NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "geofence_user_id" to table: "geofence"
+CREATE INDEX "geofence_user_id" ON "geofence" ("user_id");
+-- create "partner" table
+CREATE TABLE "partner" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "client_id" character varying NOT NULL, "access_token" text NULL, "expires_in" bigint NULL, "token_type" character varying NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "deleted" boolean NOT NULL DEFAULT false, PRIMARY KEY ("id"));
+-- create "rollup_state" table
+CREATE TABLE "rollup_state" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "vehicle_id" bigint NOT NULL, "time_zone" character varying NOT NULL, "watermark" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "rollup_state_vehicle_id_key" to table: "rollup_state"
+CREATE UNIQUE INDEX "rollup_state_vehicle_id_key" ON "rollup_state" ("vehicle_id");
+-- create "software_update" table
+CREATE TABLE "software_update" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "vehicle_id" bigint NOT NULL, "from_version" character varying NULL, "version" character varying NOT NULL, "status" character varying NOT NULL, "download_perc" bigint NOT NULL DEFAULT 0, "install_perc" bigint NOT NULL DEFAULT 0, "expected_duration" bigint NOT NULL DEFAULT 0, "available_at" timestamptz NOT NULL, "install_started_at" timestamptz NULL, "completed_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "softwareupdate_vehicle_id_available_at" to table: "software_update"
+CREATE INDEX "softwareupdate_vehicle_id_available_at" ON "software_update" ("vehicle_id", "available_at");
+-- create index "softwareupdate_vehicle_id_completed_at" to table: "software_update"
+CREATE INDEX "softwareupdate_vehicle_id_completed_at" ON "software_update" ("vehicle_id", "completed_at");
+-- create index "softwareupdate_version" to table: "software_update"
+CREATE INDEX "softwareupdate_version" ON "software_update" ("version");
+-- create "tariff" table
+CREATE TABLE "tariff" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "user_id" bigint NOT NULL, "name" character varying NOT NULL, "kind" character varying NOT NULL, "geofence_id" bigint NULL, "currency" character varying NOT NULL DEFAULT 'CNY', "price" double precision NOT NULL DEFAULT 0, "windows" jsonb NULL, "time_zone" character varying NOT NULL DEFAULT 'Asia/Shanghai', "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "tariff_user_id" to table: "tariff"
+CREATE INDEX "tariff_user_id" ON "tariff" ("user_id");
+-- create "tire_pressure" table
+CREATE TABLE "tire_pressure" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "vehicle_id" bigint NOT NULL, "pressure_fl" double precision NULL, "pressure_fr" double precision NULL, "pressure_rl" double precision NULL, "pressure_rr" double precision NULL, "seen_at_fl" timestamptz NULL, "seen_at_fr" timestamptz NULL, "seen_at_rl" timestamptz NULL, "seen_at_rr" timestamptz NULL, "recommended_front" double precision NULL, "recommended_rear" double precision NULL, "soft_warnings" bigint NOT NULL DEFAULT 0, "hard_warnings" bigint NOT NULL DEFAULT 0, "unit" character varying NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "tirepressure_vehicle_id_created_at" to table: "tire_pressure"
+CREATE INDEX "tirepressure_vehicle_id_created_at" ON "tire_pressure" ("vehicle_id", "created_at");
+-- create "user" table
+CREATE TABLE "user" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "account" character varying NOT NULL, "password" character varying NOT NULL, "mobile" character varying NULL, "open_id" character varying NULL, "avatar" character varying NULL, "nick_name" character varying NULL, "introduction" character varying NULL, "gender" smallint NOT NULL DEFAULT 0, "asked_user_id" bigint NULL, "area_code" character varying NULL, "time_zone" character varying NOT NULL DEFAULT 'Asia/Shanghai', "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "deleted" boolean NOT NULL DEFAULT false, PRIMARY KEY ("id"));
+-- create "vehicle" table
+CREATE TABLE "vehicle" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "vin" character varying NOT NULL, "user_id" bigint NOT NULL, "display_name" character varying NOT NULL, "access_type" character varying NOT NULL, "state" smallint NOT NULL, "in_service" smallint NOT NULL DEFAULT 0, "calendar_enabled" smallint NOT NULL DEFAULT 0, "car_type" character varying NULL, "api_version" character varying NULL, "raw_data" text NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "deleted" boolean NOT NULL DEFAULT false, PRIMARY KEY ("id"));
+-- create "vehicle_event" table
+CREATE TABLE "vehicle_event" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "vehicle_id" bigint NOT NULL, "user_id" bigint NOT NULL, "type" character varying NOT NULL, "summary" character varying NOT NULL, "data" jsonb NULL, "occurred_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "vehicleevent_vehicle_id_occurred_at" to table: "vehicle_event"
+CREATE INDEX "vehicleevent_vehicle_id_occurred_at" ON "vehicle_event" ("vehicle_id", "occurred_at");
+-- create "vehicle_rollup" table
+CREATE TABLE "vehicle_rollup" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "vehicle_id" bigint NOT NULL, "granularity" character varying NOT NULL, "time_zone" character varying NOT NULL, "start_at" timestamptz NOT NULL, "end_at" timestamptz NOT NULL, "drives" bigint NOT NULL DEFAULT 0, "distance" double precision NOT NULL DEFAULT 0, "drive_seconds" bigint NOT NULL DEFAULT 0, "energy_used" double precision NOT NULL DEFAULT 0, "efficiency" double precision NOT NULL DEFAULT 0, "max_speed" double precision NOT NULL DEFAULT 0, "charges" bigint NOT NULL DEFAULT 0, "energy_added" double precision NOT NULL DEFAULT 0, "charge_cost" double precision NOT NULL DEFAULT 0, "parked_seconds" bigint NOT NULL DEFAULT 0, "asleep_seconds" bigint NOT NULL DEFAULT 0, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "vehiclerollup_vehicle_id_granularity_time_zone_start_at" to table: "vehicle_rollup"
+CREATE UNIQUE INDEX "vehiclerollup_vehicle_id_granularity_time_zone_start_at" ON "vehicle_rollup" ("vehicle_id", "granularity", "time_zone", "start_at");
+-- create "vehicle_snapshot" table
+CREATE TABLE "vehicle_snapshot" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "vehicle_id" bigint NOT NULL, "state" character varying NOT NULL, "shift_state" character varying NULL, "speed" double precision NULL, "power" bigint NULL, "latitude" double precision NULL, "longitude" double precision NULL, "coord_type" character varying NULL, "heading" bigint NULL, "battery_level" bigint NULL, "usable_battery_level" bigint NULL, "battery_range" double precision NULL, "ideal_battery_range" double precision NULL, "est_battery_range" double precision NULL, "charging_state" character varying NULL, "charger_power" bigint NULL, "charge_energy_added" double precision NULL, "charger_voltage" bigint NULL, "charger_actual_current" bigint NULL, "fast_charger_present" boolean NOT NULL DEFAULT false, "fast_charger_type" character varying NULL, "battery_heater_on" boolean NOT NULL DEFAULT false, "outside_temp" double precision NULL, "inside_temp" double precision NULL, "climate_on" boolean NOT NULL DEFAULT false, "climate_keeper_mode" character varying NULL, "cabin_overheat_cooling" boolean NOT NULL DEFAULT false, "sentry_mode" boolean NOT NULL DEFAULT false, "locked" boolean NOT NULL DEFAULT false, "odometer" double precision NULL, "car_version" character varying NULL, "software_update_status" character varying NULL, "software_update_version" character varying NULL, "software_update_download_perc" bigint NULL, "software_update_install_perc" bigint NULL, "software_update_expected_duration" bigint NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "vehiclesnapshot_vehicle_id_created_at" to table: "vehicle_snapshot"
+CREATE INDEX "vehiclesnapshot_vehicle_id_created_at" ON "vehicle_snapshot" ("vehicle_id", "created_at");
+-- create "vehicle_state_period" table
+CREATE TABLE "vehicle_state_period" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "vehicle_id" bigint NOT NULL, "state" character varying NOT NULL, "sentry_mode" boolean NOT NULL DEFAULT false, "climate_on" boolean NOT NULL DEFAULT false, "start_at" timestamptz NOT NULL, "end_at" timestamptz NULL, "start_battery_level" bigint NULL, "end_battery_level" bigint NULL, "start_range" double precision NULL, "end_range" double precision NULL, "start_odometer" double precision NULL, "end_odometer" double precision NULL, "start_latitude" double precision NULL, "start_longitude" double precision NULL, "end_latitude" double precision NULL, "end_longitude" double precision NULL, "start_address" character varying NULL, "end_address" character varying NULL, "start_geofence_id" bigint NULL, "end_geofence_id" bigint NULL, "charge_location" character varying NULL, "fast_charger" boolean NOT NULL DEFAULT false, "energy_added" double precision NULL, "energy_used" double precision NULL, "power_seconds" bigint NULL, "outside_temp" double precision NULL, "max_speed" double precision NULL, "climate_ratio" double precision NULL, "cost" double precision NULL, "cost_manual" boolean NOT NULL DEFAULT false, "tariff_id" bigint NULL, "name" character varying NULL, "tags" jsonb NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "vehiclestateperiod_vehicle_id_start_at" to table: "vehicle_state_period"
+CREATE INDEX "vehiclestateperiod_vehicle_id_start_at" ON "vehicle_state_period" ("vehicle_id", "start_at");
+-- create index "vehiclestateperiod_vehicle_id_updated_at" to table: "vehicle_state_period"
+CREATE INDEX "vehiclestateperiod_vehicle_id_updated_at" ON "vehicle_state_period" ("vehicle_id", "updated_at");
@@ -0,0 +1,2 @@
+-- reverse: create index "user_account" to table: "user"
+DROP INDEX "user_account";
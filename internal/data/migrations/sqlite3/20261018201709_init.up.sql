@@ -0,0 +1,56 @@
+-- create "address" table
+CREATE TABLE `address` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `grid_latitude` integer NOT NULL, `grid_longitude` integer NOT NULL, `name` text NULL, `road` text NULL, `district` text NULL, `city` text NULL, `province` text NULL, `country` text NULL, `formatted` text NULL, `provider` text NULL, `created_at` datetime NOT NULL);
+-- create index "address_grid_latitude_grid_longitude" to table: "address"
+CREATE UNIQUE INDEX `address_grid_latitude_grid_longitude` ON `address` (`grid_latitude`, `grid_longitude`);
+-- create "authorize" table
+CREATE TABLE `authorize` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `client_id` text NOT NULL, `client_secret` text NOT NULL, `grant_type` text NOT NULL, `redirect_uri` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted` bool NOT NULL DEFAULT (false));
+-- create "authorize_token" table
+CREATE TABLE `authorize_token` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `tesla_code` text NOT NULL, `client_id` text NOT NULL, `client_secret` text NOT NULL, `access_token` text NOT NULL, `refresh_token` text NOT NULL, `scope` text NOT NULL, `user_id` integer NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted` bool NOT NULL DEFAULT (false));
+-- create "geofence" table
+CREATE TABLE `geofence` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `user_id` integer NOT NULL, `name` text NOT NULL, `category` text NOT NULL DEFAULT ('other'), `shape` text NOT NULL, `latitude` real NULL, `longitude` real NULL, `radius` real NULL, `polygon` json NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL);
+-- create index "geofence_user_id" to table: "geofence"
+CREATE INDEX `geofence_user_id` ON `geofence` (`user_id`);
+-- create "partner" table
+CREATE TABLE `partner` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `client_id` text NOT NULL, `access_token` text NULL, `expires_in` integer NULL, `token_type` text NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted` bool NOT NULL DEFAULT (false));
+-- create "rollup_state" table
+CREATE TABLE `rollup_state` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `vehicle_id` integer NOT NULL, `time_zone` text NOT NULL, `watermark` datetime NOT NULL, `updated_at` datetime NOT NULL);
+-- create index "rollup_state_vehicle_id_key" to table: "rollup_state"
+CREATE UNIQUE INDEX `rollup_state_vehicle_id_key` ON `rollup_state` (`vehicle_id`);
+-- create "software_update" table
+CREATE TABLE `software_update` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `vehicle_id` integer NOT NULL, `from_version` text NULL, `version` text NOT NULL, `status` text NOT NULL, `download_perc` integer NOT NULL DEFAULT (0), `install_perc` integer NOT NULL DEFAULT (0), `expected_duration` integer NOT NULL DEFAULT (0), `available_at` datetime NOT NULL, `install_started_at` datetime NULL, `completed_at` datetime NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL);
+-- create index "softwareupdate_vehicle_id_available_at" to table: "software_update"
+CREATE INDEX `softwareupdate_vehicle_id_available_at` ON `software_update` (`vehicle_id`, `available_at`);
+-- create index "softwareupdate_vehicle_id_completed_at" to table: "software_update"
+CREATE INDEX `softwareupdate_vehicle_id_completed_at` ON `software_update` (`vehicle_id`, `completed_at`);
+-- create index "softwareupdate_version" to table: "software_update"
+CREATE INDEX `softwareupdate_version` ON `software_update` (`version`);
+-- create "tariff" table
+CREATE TABLE `tariff` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `user_id` integer NOT NULL, `name` text NOT NULL, `kind` text NOT NULL, `geofence_id` integer NULL, `currency` text NOT NULL DEFAULT ('CNY'), `price` real NOT NULL DEFAULT (0), `windows` json NULL, `time_zone` text NOT NULL DEFAULT ('Asia/Shanghai'), `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL);
+-- create index "tariff_user_id" to table: "tariff"
+CREATE INDEX `tariff_user_id` ON `tariff` (`user_id`);
+-- create "tire_pressure" table
+CREATE TABLE `tire_pressure` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `vehicle_id` integer NOT NULL, `pressure_fl` real NULL, `pressure_fr` real NULL, `pressure_rl` real NULL, `pressure_rr` real NULL, `seen_at_fl` datetime NULL, `seen_at_fr` datetime NULL, `seen_at_rl` datetime NULL, `seen_at_rr` datetime NULL, `recommended_front` real NULL, `recommended_rear` real NULL, `soft_warnings` integer NOT NULL DEFAULT (0), `hard_warnings` integer NOT NULL DEFAULT (0), `unit` text NULL, `created_at` datetime NOT NULL);
+-- create index "tirepressure_vehicle_id_created_at" to table: "tire_pressure"
+CREATE INDEX `tirepressure_vehicle_id_created_at` ON `tire_pressure` (`vehicle_id`, `created_at`);
+-- create "user" table
+CREATE TABLE `user` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `account` text NOT NULL, `password` text NOT NULL, `mobile` text NULL, `open_id` text NULL, `avatar` text NULL, `nick_name` text NULL, `introduction` text NULL, `gender` integer NOT NULL DEFAULT (0), `asked_user_id` integer NULL, `area_code` text NULL, `time_zone` text NOT NULL DEFAULT ('Asia/Shanghai'), `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted` bool NOT NULL DEFAULT (false));
+-- create "vehicle" table
+CREATE TABLE `vehicle` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `vin` text NOT NULL, `user_id` integer NOT NULL, `display_name` text NOT NULL, `access_type` text NOT NULL, `state` integer NOT NULL, `in_service` integer NOT NULL DEFAULT (0), `calendar_enabled` integer NOT NULL DEFAULT (0), `car_type` text NULL, `api_version` text NULL, `raw_data` text NOT NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `deleted` bool NOT NULL DEFAULT (false));
+-- create "vehicle_event" table
+CREATE TABLE `vehicle_event` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `vehicle_id` integer NOT NULL, `user_id` integer NOT NULL, `type` text NOT NULL, `summary` text NOT NULL, `data` json NULL, `occurred_at` datetime NOT NULL, `created_at` datetime NOT NULL);
+-- create index "vehicleevent_vehicle_id_occurred_at" to table: "vehicle_event"
+CREATE INDEX `vehicleevent_vehicle_id_occurred_at` ON `vehicle_event` (`vehicle_id`, `occurred_at`);
+-- create "vehicle_rollup" table
+CREATE TABLE `vehicle_rollup` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `vehicle_id` integer NOT NULL, `granularity` text NOT NULL, `time_zone` text NOT NULL, `start_at` datetime NOT NULL, `end_at` datetime NOT NULL, `drives` integer NOT NULL DEFAULT (0), `distance` real NOT NULL DEFAULT (0), `drive_seconds` integer NOT NULL DEFAULT (0), `energy_used` real NOT NULL DEFAULT (0), `efficiency` real NOT NULL DEFAULT (0), `max_speed` real NOT NULL DEFAULT (0), `charges` integer NOT NULL DEFAULT (0), `energy_added` real NOT NULL DEFAULT (0), `charge_cost` real NOT NULL DEFAULT (0), `parked_seconds` integer NOT NULL DEFAULT (0), `asleep_seconds` integer NOT NULL DEFAULT (0), `updated_at` datetime NOT NULL);
+-- create index "vehiclerollup_vehicle_id_granularity_time_zone_start_at" to table: "vehicle_rollup"
+CREATE UNIQUE INDEX `vehiclerollup_vehicle_id_granularity_time_zone_start_at` ON `vehicle_rollup` (`vehicle_id`, `granularity`, `time_zone`, `start_at`);
+-- create "vehicle_snapshot" table
+CREATE TABLE `vehicle_snapshot` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `vehicle_id` integer NOT NULL, `state` text NOT NULL, `shift_state` text NULL, `speed` real NULL, `power` integer NULL, `latitude` real NULL, `longitude` real NULL, `coord_type` text NULL, `heading` integer NULL, `battery_level` integer NULL, `usable_battery_level` integer NULL, `battery_range` real NULL, `ideal_battery_range` real NULL, `est_battery_range` real NULL, `charging_state` text NULL, `charger_power` integer NULL, `charge_energy_added` real NULL, `charger_voltage` integer NULL, `charger_actual_current` integer NULL, `fast_charger_present` bool NOT NULL DEFAULT (false), `fast_charger_type` text NULL, `battery_heater_on` bool NOT NULL DEFAULT (false), `outside_temp` real NULL, `inside_temp` real NULL, `climate_on` bool NOT NULL DEFAULT (false), `climate_keeper_mode` text NULL, `cabin_overheat_cooling` bool NOT NULL DEFAULT (false), `sentry_mode` bool NOT NULL DEFAULT (false), `locked` bool NOT NULL DEFAULT (false), `odometer` real NULL, `car_version` text NULL, `software_update_status` text NULL, `software_update_version` text NULL, `software_update_download_perc` integer NULL, `software_update_install_perc` integer NULL, `software_update_expected_duration` integer NULL, `created_at` datetime NOT NULL);
+-- create index "vehiclesnapshot_vehicle_id_created_at" to table: "vehicle_snapshot"
+CREATE INDEX `vehiclesnapshot_vehicle_id_created_at` ON `vehicle_snapshot` (`vehicle_id`, `created_at`);
+-- create "vehicle_state_period" table
+CREATE TABLE `vehicle_state_period` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `vehicle_id` integer NOT NULL, `state` text NOT NULL, `sentry_mode` bool NOT NULL DEFAULT (false), `climate_on` bool NOT NULL DEFAULT (false), `start_at` datetime NOT NULL, `end_at` datetime NULL, `start_battery_level` integer NULL, `end_battery_level` integer NULL, `start_range` real NULL, `end_range` real NULL, `start_odometer` real NULL, `end_odometer` real NULL, `start_latitude` real NULL, `start_longitude` real NULL, `end_latitude` real NULL, `end_longitude` real NULL, `start_address` text NULL, `end_address` text NULL, `start_geofence_id` integer NULL, `end_geofence_id` integer NULL, `charge_location` text NULL, `fast_charger` bool NOT NULL DEFAULT (false), `energy_added` real NULL, `energy_used` real NULL, `power_seconds` integer NULL, `outside_temp` real NULL, `max_speed` real NULL, `climate_ratio` real NULL, `cost` real NULL, `cost_manual` bool NOT NULL DEFAULT (false), `tariff_id` integer NULL, `name` text NULL, `tags` json NULL, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL);
+-- create index "vehiclestateperiod_vehicle_id_start_at" to table: "vehicle_state_period"
+CREATE INDEX `vehiclestateperiod_vehicle_id_start_at` ON `vehicle_state_period` (`vehicle_id`, `start_at`);
+-- create index "vehiclestateperiod_vehicle_id_updated_at" to table: "vehicle_state_period"
+CREATE INDEX `vehiclestateperiod_vehicle_id_updated_at` ON `vehicle_state_period` (`vehicle_id`, `updated_at`);
@@ -0,0 +1,2 @@
+-- reverse: add column "start_energy_added" to table: "vehicle_state_period"
+ALTER TABLE `vehicle_state_period` DROP COLUMN `start_energy_added`;
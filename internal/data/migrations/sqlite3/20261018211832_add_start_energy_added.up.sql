@@ -0,0 +1,2 @@
+-- add column "start_energy_added" to table: "vehicle_state_period"
+ALTER TABLE `vehicle_state_period` ADD COLUMN `start_energy_added` real NULL;
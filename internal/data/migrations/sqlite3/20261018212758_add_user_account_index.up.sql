@@ -0,0 +1,2 @@
+-- create index "user_account" to table: "user"
+CREATE UNIQUE INDEX `user_account` ON `user` (`account`);
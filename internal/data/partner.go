@@ -5,8 +5,12 @@ import (
 	"teslatrack/internal/biz"
 	"teslatrack/internal/data/ent"
 	"teslatrack/internal/data/ent/partner"
+	"time"
 )
 
+// partnerCacheTTL is how long a partner is cached; updates invalidate it sooner.
+const partnerCacheTTL = 10 * time.Minute
+
 var _ biz.PartnerRepo = (*partnerRepo)(nil)
 
 type partnerRepo struct {
@@ -18,8 +22,16 @@ func NewPartnerRepo(data *Data) biz.PartnerRepo {
 	return &partnerRepo{data: data}
 }
 
+func partnerCacheKey(clientID string) string {
+	return "partner:" + clientID
+}
+
 // Get implements biz.PartnerRepo.
 func (p *partnerRepo) Get(ctx context.Context, clientID string) (*biz.Partner, error) {
+	var cached biz.Partner
+	if p.data.cacheGet(ctx, partnerCacheKey(clientID), &cached) {
+		return &cached, nil
+	}
 	po, err := p.data.db.Partner.
 		Query().
 		Where(partner.ClientID(clientID)).
@@ -27,7 +39,7 @@ func (p *partnerRepo) Get(ctx context.Context, clientID string) (*biz.Partner, e
 	if err != nil {
 		return nil, err
 	}
-	return &biz.Partner{
+	b := &biz.Partner{
 		ID:          po.ID,
 		ClientID:    po.ClientID,
 		AccessToken: po.AccessToken,
@@ -35,7 +47,9 @@ func (p *partnerRepo) Get(ctx context.Context, clientID string) (*biz.Partner, e
 		TokenType:   po.TokenType,
 		CreatedAt:   po.CreatedAt,
 		UpdatedAt:   po.UpdatedAt,
-	}, nil
+	}
+	p.data.cacheSet(ctx, partnerCacheKey(clientID), b, partnerCacheTTL)
+	return b, nil
 }
 
 // MustGet implements biz.PartnerRepo.
@@ -59,16 +73,24 @@ func (p *partnerRepo) Create(ctx context.Context, b *biz.Partner) error {
 		SetExpiresIn(int(b.ExpiresIn)).
 		SetTokenType(b.TokenType).
 		Save(ctx)
-	return err
+	if err != nil {
+		return err
+	}
+	p.data.cacheDel(ctx, partnerCacheKey(b.ClientID))
+	return nil
 }
 
 // Update implements biz.PartnerRepo.
 func (p *partnerRepo) Update(ctx context.Context, id int, b *biz.Partner) error {
-	_, err := p.data.db.Partner.
+	po, err := p.data.db.Partner.
 		UpdateOneID(id).
 		SetAccessToken(b.AccessToken).
 		SetExpiresIn(int(b.ExpiresIn)).
 		SetTokenType(b.TokenType).
 		Save(ctx)
-	return err
+	if err != nil {
+		return err
+	}
+	p.data.cacheDel(ctx, partnerCacheKey(po.ClientID))
+	return nil
 }
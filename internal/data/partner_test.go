@@ -0,0 +1,38 @@
+package data
+
+import (
+	"context"
+	"teslatrack/internal/biz"
+	"testing"
+)
+
+func TestPartnerRepo(t *testing.T) {
+	ctx := context.Background()
+	repo := NewPartnerRepo(newTestData(t))
+
+	if partner, err := repo.MustGet(ctx, "client"); err != nil || partner != nil {
+		t.Fatalf("MustGet of an unknown partner = %v, %v, want nil, nil", partner, err)
+	}
+	if err := repo.Create(ctx, &biz.Partner{ClientID: "client", AccessToken: "first", ExpiresIn: 3600, TokenType: "Bearer"}); err != nil {
+		t.Fatal(err)
+	}
+	partner, err := repo.MustGet(ctx, "client")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if partner == nil || partner.AccessToken != "first" {
+		t.Fatalf("MustGet = %+v, want the first token", partner)
+	}
+
+	// The update invalidates the cached partner.
+	if err := repo.Update(ctx, partner.ID, &biz.Partner{AccessToken: "second", ExpiresIn: 3600, TokenType: "Bearer"}); err != nil {
+		t.Fatal(err)
+	}
+	partner, err = repo.Get(ctx, "client")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if partner.AccessToken != "second" {
+		t.Errorf("Get after Update = %q, want the second token", partner.AccessToken)
+	}
+}
@@ -0,0 +1,168 @@
+package data
+
+import (
+	"context"
+	"teslatrack/internal/biz"
+	"teslatrack/internal/data/ent"
+	"teslatrack/internal/data/ent/rollupstate"
+	"teslatrack/internal/data/ent/vehiclerollup"
+	"time"
+)
+
+var _ biz.RollupRepo = (*rollupRepo)(nil)
+
+// rollupRepo is the data layer implementation of RollupRepo.
+type rollupRepo struct {
+	data *Data
+}
+
+// NewRollupRepo creates a new rollupRepo.
+func NewRollupRepo(data *Data) biz.RollupRepo {
+	return &rollupRepo{data: data}
+}
+
+// toBizRollup converts an ent.VehicleRollup model to a biz.Rollup model.
+func toBizRollup(model *ent.VehicleRollup) *biz.Rollup {
+	return &biz.Rollup{
+		ID:             model.ID,
+		VehicleID:      model.VehicleID,
+		Granularity:    model.Granularity,
+		TimeZone:       model.TimeZone,
+		StartAt:        model.StartAt,
+		EndAt:          model.EndAt,
+		Drives:         model.Drives,
+		Distance:       model.Distance,
+		DriveDuration:  time.Duration(model.DriveSeconds) * time.Second,
+		EnergyUsed:     model.EnergyUsed,
+		Efficiency:     model.Efficiency,
+		MaxSpeed:       model.MaxSpeed,
+		Charges:        model.Charges,
+		EnergyAdded:    model.EnergyAdded,
+		ChargeCost:     model.ChargeCost,
+		ParkedDuration: time.Duration(model.ParkedSeconds) * time.Second,
+		AsleepDuration: time.Duration(model.AsleepSeconds) * time.Second,
+	}
+}
+
+// Save implements biz.RollupRepo.
+func (r *rollupRepo) Save(ctx context.Context, rollup *biz.Rollup) error {
+	id, err := r.data.db.VehicleRollup.Query().
+		Where(
+			vehiclerollup.VehicleID(rollup.VehicleID),
+			vehiclerollup.Granularity(rollup.Granularity),
+			vehiclerollup.TimeZone(rollup.TimeZone),
+			vehiclerollup.StartAt(rollup.StartAt),
+		).
+		OnlyID(ctx)
+	if ent.IsNotFound(err) {
+		model, err := r.data.db.VehicleRollup.Create().
+			SetVehicleID(rollup.VehicleID).
+			SetGranularity(rollup.Granularity).
+			SetTimeZone(rollup.TimeZone).
+			SetStartAt(rollup.StartAt).
+			SetEndAt(rollup.EndAt).
+			SetDrives(rollup.Drives).
+			SetDistance(rollup.Distance).
+			SetDriveSeconds(int(rollup.DriveDuration.Seconds())).
+			SetEnergyUsed(rollup.EnergyUsed).
+			SetEfficiency(rollup.Efficiency).
+			SetMaxSpeed(rollup.MaxSpeed).
+			SetCharges(rollup.Charges).
+			SetEnergyAdded(rollup.EnergyAdded).
+			SetChargeCost(rollup.ChargeCost).
+			SetParkedSeconds(int(rollup.ParkedDuration.Seconds())).
+			SetAsleepSeconds(int(rollup.AsleepDuration.Seconds())).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		rollup.ID = model.ID
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	rollup.ID = id
+	return r.data.db.VehicleRollup.UpdateOneID(id).
+		SetEndAt(rollup.EndAt).
+		SetDrives(rollup.Drives).
+		SetDistance(rollup.Distance).
+		SetDriveSeconds(int(rollup.DriveDuration.Seconds())).
+		SetEnergyUsed(rollup.EnergyUsed).
+		SetEfficiency(rollup.Efficiency).
+		SetMaxSpeed(rollup.MaxSpeed).
+		SetCharges(rollup.Charges).
+		SetEnergyAdded(rollup.EnergyAdded).
+		SetChargeCost(rollup.ChargeCost).
+		SetParkedSeconds(int(rollup.ParkedDuration.Seconds())).
+		SetAsleepSeconds(int(rollup.AsleepDuration.Seconds())).
+		Exec(ctx)
+}
+
+// List implements biz.RollupRepo.
+func (r *rollupRepo) List(ctx context.Context, vehicleID int, granularity, timeZone string, from, to time.Time) ([]*biz.Rollup, error) {
+	models, err := r.data.db.VehicleRollup.Query().
+		Where(
+			vehiclerollup.VehicleID(vehicleID),
+			vehiclerollup.Granularity(granularity),
+			vehiclerollup.TimeZone(timeZone),
+			vehiclerollup.StartAtGTE(from),
+			vehiclerollup.StartAtLT(to),
+		).
+		Order(ent.Asc(vehiclerollup.FieldStartAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rollups := make([]*biz.Rollup, 0, len(models))
+	for _, model := range models {
+		rollups = append(rollups, toBizRollup(model))
+	}
+	return rollups, nil
+}
+
+// DeleteOtherTimeZones implements biz.RollupRepo.
+func (r *rollupRepo) DeleteOtherTimeZones(ctx context.Context, vehicleID int, timeZone string) error {
+	_, err := r.data.db.VehicleRollup.Delete().
+		Where(
+			vehiclerollup.VehicleID(vehicleID),
+			vehiclerollup.TimeZoneNEQ(timeZone),
+		).
+		Exec(ctx)
+	return err
+}
+
+// State implements biz.RollupRepo.
+func (r *rollupRepo) State(ctx context.Context, vehicleID int) (*biz.RollupState, error) {
+	model, err := r.data.db.RollupState.Query().
+		Where(rollupstate.VehicleID(vehicleID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &biz.RollupState{
+		VehicleID: model.VehicleID,
+		TimeZone:  model.TimeZone,
+		Watermark: model.Watermark,
+	}, nil
+}
+
+// SaveState implements biz.RollupRepo.
+func (r *rollupRepo) SaveState(ctx context.Context, state *biz.RollupState) error {
+	n, err := r.data.db.RollupState.Update().
+		Where(rollupstate.VehicleID(state.VehicleID)).
+		SetTimeZone(state.TimeZone).
+		SetWatermark(state.Watermark).
+		Save(ctx)
+	if err != nil || n > 0 {
+		return err
+	}
+	return r.data.db.RollupState.Create().
+		SetVehicleID(state.VehicleID).
+		SetTimeZone(state.TimeZone).
+		SetWatermark(state.Watermark).
+		Exec(ctx)
+}
@@ -0,0 +1,160 @@
+package data
+
+import (
+	"context"
+	"teslatrack/internal/biz"
+	"teslatrack/internal/data/ent"
+	"teslatrack/internal/data/ent/softwareupdate"
+	"time"
+)
+
+var _ biz.SoftwareUpdateRepo = (*softwareUpdateRepo)(nil)
+
+// softwareUpdateRepo is the data layer implementation of SoftwareUpdateRepo.
+type softwareUpdateRepo struct {
+	data *Data
+}
+
+// NewSoftwareUpdateRepo creates a new softwareUpdateRepo.
+func NewSoftwareUpdateRepo(data *Data) biz.SoftwareUpdateRepo {
+	return &softwareUpdateRepo{data: data}
+}
+
+// toBizSoftwareUpdate converts an ent.SoftwareUpdate model to a biz.SoftwareUpdate model.
+func toBizSoftwareUpdate(model *ent.SoftwareUpdate) *biz.SoftwareUpdate {
+	return &biz.SoftwareUpdate{
+		ID:               model.ID,
+		VehicleID:        model.VehicleID,
+		FromVersion:      model.FromVersion,
+		Version:          model.Version,
+		Status:           model.Status,
+		DownloadPerc:     model.DownloadPerc,
+		InstallPerc:      model.InstallPerc,
+		ExpectedDuration: time.Duration(model.ExpectedDuration) * time.Second,
+		AvailableAt:      model.AvailableAt,
+		InstallStartedAt: model.InstallStartedAt,
+		CompletedAt:      model.CompletedAt,
+	}
+}
+
+// Create implements biz.SoftwareUpdateRepo.
+func (r *softwareUpdateRepo) Create(ctx context.Context, u *biz.SoftwareUpdate) error {
+	model, err := r.data.db.SoftwareUpdate.Create().
+		SetVehicleID(u.VehicleID).
+		SetFromVersion(u.FromVersion).
+		SetVersion(u.Version).
+		SetStatus(u.Status).
+		SetDownloadPerc(u.DownloadPerc).
+		SetInstallPerc(u.InstallPerc).
+		SetExpectedDuration(int(u.ExpectedDuration.Seconds())).
+		SetAvailableAt(u.AvailableAt).
+		SetNillableInstallStartedAt(u.InstallStartedAt).
+		SetNillableCompletedAt(u.CompletedAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	u.ID = model.ID
+	return nil
+}
+
+// Update implements biz.SoftwareUpdateRepo.
+func (r *softwareUpdateRepo) Update(ctx context.Context, u *biz.SoftwareUpdate) error {
+	return r.data.db.SoftwareUpdate.UpdateOneID(u.ID).
+		SetStatus(u.Status).
+		SetDownloadPerc(u.DownloadPerc).
+		SetInstallPerc(u.InstallPerc).
+		SetExpectedDuration(int(u.ExpectedDuration.Seconds())).
+		SetNillableInstallStartedAt(u.InstallStartedAt).
+		SetNillableCompletedAt(u.CompletedAt).
+		Exec(ctx)
+}
+
+// LatestInstalled implements biz.SoftwareUpdateRepo.
+func (r *softwareUpdateRepo) LatestInstalled(ctx context.Context, vehicleID int) (*biz.SoftwareUpdate, error) {
+	return r.first(ctx, r.data.db.SoftwareUpdate.Query().
+		Where(softwareupdate.VehicleID(vehicleID), softwareupdate.CompletedAtNotNil()).
+		Order(ent.Desc(softwareupdate.FieldCompletedAt)))
+}
+
+// LatestPending implements biz.SoftwareUpdateRepo.
+func (r *softwareUpdateRepo) LatestPending(ctx context.Context, vehicleID int) (*biz.SoftwareUpdate, error) {
+	return r.first(ctx, r.data.db.SoftwareUpdate.Query().
+		Where(
+			softwareupdate.VehicleID(vehicleID),
+			softwareupdate.CompletedAtIsNil(),
+			softwareupdate.StatusNEQ(biz.SoftwareUpdateSuperseded),
+		).
+		Order(ent.Desc(softwareupdate.FieldAvailableAt)))
+}
+
+// first returns the first update of a query, nil if there is none.
+func (r *softwareUpdateRepo) first(ctx context.Context, query *ent.SoftwareUpdateQuery) (*biz.SoftwareUpdate, error) {
+	model, err := query.First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toBizSoftwareUpdate(model), nil
+}
+
+// ListByVehicle implements biz.SoftwareUpdateRepo.
+func (r *softwareUpdateRepo) ListByVehicle(ctx context.Context, vehicleID int) ([]*biz.SoftwareUpdate, error) {
+	models, err := r.data.db.SoftwareUpdate.Query().
+		Where(softwareupdate.VehicleID(vehicleID)).
+		Order(ent.Desc(softwareupdate.FieldAvailableAt), ent.Desc(softwareupdate.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	updates := make([]*biz.SoftwareUpdate, 0, len(models))
+	for _, model := range models {
+		updates = append(updates, toBizSoftwareUpdate(model))
+	}
+	return updates, nil
+}
+
+// ListInstalled implements biz.SoftwareUpdateRepo.
+func (r *softwareUpdateRepo) ListInstalled(ctx context.Context) ([]*biz.SoftwareUpdate, error) {
+	models, err := r.data.db.SoftwareUpdate.Query().
+		Where(softwareupdate.CompletedAtNotNil()).
+		Select(
+			softwareupdate.FieldVehicleID,
+			softwareupdate.FieldFromVersion,
+			softwareupdate.FieldVersion,
+			softwareupdate.FieldCompletedAt,
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	updates := make([]*biz.SoftwareUpdate, 0, len(models))
+	for _, model := range models {
+		updates = append(updates, toBizSoftwareUpdate(model))
+	}
+	return updates, nil
+}
+
+// ListInstalledPage implements biz.SoftwareUpdateRepo.
+func (r *softwareUpdateRepo) ListInstalledPage(ctx context.Context, vehicleID int, page *biz.TimelinePage) ([]*biz.SoftwareUpdate, error) {
+	models, err := r.data.db.SoftwareUpdate.Query().
+		Where(
+			softwareupdate.VehicleID(vehicleID),
+			softwareupdate.CompletedAtNotNil(),
+			softwareupdate.FromVersionNEQ(""),
+			timelinePage(softwareupdate.FieldCompletedAt, page),
+		).
+		Order(ent.Desc(softwareupdate.FieldCompletedAt), ent.Desc(softwareupdate.FieldID)).
+		Limit(page.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	updates := make([]*biz.SoftwareUpdate, 0, len(models))
+	for _, model := range models {
+		updates = append(updates, toBizSoftwareUpdate(model))
+	}
+	return updates, nil
+}
@@ -0,0 +1,290 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// keyPrefix namespaces the keys of the service in a shared Redis.
+const keyPrefix = "teslatrack:"
+
+// store is the key-value store of the caches, the rate limits, the locks and the OAuth
+// states: Redis when configured, shared by the instances of the service, and an in-memory
+// store otherwise, so a single-node deployment needs no Redis.
+type store interface {
+	// get returns the value of key, reporting false if it is missing or expired.
+	get(ctx context.Context, key string) ([]byte, bool, error)
+	// set stores the value of key for ttl.
+	set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// setNX stores the value of key for ttl unless key exists, reporting whether it was stored.
+	setNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error)
+	// take returns and deletes the value of key, reporting false if it is missing or expired.
+	take(ctx context.Context, key string) ([]byte, bool, error)
+	// del deletes keys.
+	del(ctx context.Context, keys ...string) error
+	// delIfEqual deletes key if its value is value, reporting whether it was deleted.
+	delIfEqual(ctx context.Context, key string, value []byte) (bool, error)
+	// expireIfEqual makes key expire ttl from now if its value is value, reporting whether it did.
+	expireIfEqual(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error)
+	// incr increments the counter of key, which expires ttl after its first increment.
+	incr(ctx context.Context, key string, ttl time.Duration) (int64, error)
+}
+
+// newStore creates the Redis store if a Redis client is configured, the in-memory store otherwise.
+func newStore(rdb *redis.Client) store {
+	if rdb == nil {
+		return newMemoryStore()
+	}
+	return &redisStore{rdb: rdb}
+}
+
+// getJSON decodes the value of key into v, reporting false if it is missing.
+func getJSON(ctx context.Context, s store, key string, v any) (bool, error) {
+	value, ok, err := s.get(ctx, key)
+	if err != nil || !ok {
+		return false, err
+	}
+	return true, json.Unmarshal(value, v)
+}
+
+// setJSON stores v encoded as JSON in key for ttl.
+func setJSON(ctx context.Context, s store, key string, v any, ttl time.Duration) error {
+	value, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return s.set(ctx, key, value, ttl)
+}
+
+// cacheGet reads the cached value of key into v, reporting false on a miss. A failing
+// store is logged and treated as a miss, so the database serves the read.
+func (d *Data) cacheGet(ctx context.Context, key string, v any) bool {
+	ok, err := getJSON(ctx, d.kv, "cache:"+key, v)
+	if err != nil {
+		d.log.WithContext(ctx).Warnw("msg", "cache get failed", "key", key, "err", err)
+		return false
+	}
+	return ok
+}
+
+// cacheSet caches v in key for ttl. A failing store is logged only.
+func (d *Data) cacheSet(ctx context.Context, key string, v any, ttl time.Duration) {
+	if err := setJSON(ctx, d.kv, "cache:"+key, v, ttl); err != nil {
+		d.log.WithContext(ctx).Warnw("msg", "cache set failed", "key", key, "err", err)
+	}
+}
+
+// cacheDel invalidates the cached values of keys. A failing store is logged only; the
+// values then expire with their ttl.
+func (d *Data) cacheDel(ctx context.Context, keys ...string) {
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = "cache:" + key
+	}
+	if err := d.kv.del(ctx, prefixed...); err != nil {
+		d.log.WithContext(ctx).Warnw("msg", "cache delete failed", "keys", keys, "err", err)
+	}
+}
+
+// redisStore is the store in Redis.
+type redisStore struct {
+	rdb *redis.Client
+}
+
+// delIfEqualScript deletes a key only if it still holds the expected value, atomically.
+var delIfEqualScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// expireIfEqualScript sets the expiry of a key only if it still holds the expected value, atomically.
+var expireIfEqualScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+
+// incrScript increments a counter and sets its expiry on the first increment, atomically.
+var incrScript = redis.NewScript(`
+local n = redis.call("INCR", KEYS[1])
+if n == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return n`)
+
+func (s *redisStore) get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := s.rdb.Get(ctx, keyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	return value, err == nil, err
+}
+
+func (s *redisStore) set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return s.rdb.Set(ctx, keyPrefix+key, value, ttl).Err()
+}
+
+func (s *redisStore) setNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	return s.rdb.SetNX(ctx, keyPrefix+key, value, ttl).Result()
+}
+
+func (s *redisStore) take(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := s.rdb.GetDel(ctx, keyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	return value, err == nil, err
+}
+
+func (s *redisStore) del(ctx context.Context, keys ...string) error {
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = keyPrefix + key
+	}
+	return s.rdb.Del(ctx, prefixed...).Err()
+}
+
+func (s *redisStore) delIfEqual(ctx context.Context, key string, value []byte) (bool, error) {
+	n, err := delIfEqualScript.Run(ctx, s.rdb, []string{keyPrefix + key}, value).Int()
+	return n == 1, err
+}
+
+func (s *redisStore) expireIfEqual(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	n, err := expireIfEqualScript.Run(ctx, s.rdb, []string{keyPrefix + key}, value, ttl.Milliseconds()).Int()
+	return n == 1, err
+}
+
+func (s *redisStore) incr(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	return incrScript.Run(ctx, s.rdb, []string{keyPrefix + key}, ttl.Milliseconds()).Int64()
+}
+
+// memorySweepInterval is the least time between two sweeps of the expired keys of the memory store.
+const memorySweepInterval = time.Minute
+
+// memoryStore is the store in memory, local to the instance.
+type memoryStore struct {
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+	swept   time.Time
+}
+
+type memoryEntry struct {
+	value []byte
+	// count is the value of a counter.
+	count     int64
+	expiresAt time.Time
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{entries: make(map[string]memoryEntry), swept: time.Now()}
+}
+
+// lookup returns the live entry of key, deleting it if expired. The caller holds mu.
+func (s *memoryStore) lookup(key string, now time.Time) (memoryEntry, bool) {
+	e, ok := s.entries[key]
+	if ok && !now.Before(e.expiresAt) {
+		delete(s.entries, key)
+		return memoryEntry{}, false
+	}
+	return e, ok
+}
+
+// put stores an entry, sweeping the expired entries from time to time so that keys never
+// read again do not accumulate. The caller holds mu.
+func (s *memoryStore) put(key string, value []byte, ttl time.Duration, now time.Time) {
+	if now.Sub(s.swept) >= memorySweepInterval {
+		for k, e := range s.entries {
+			if !now.Before(e.expiresAt) {
+				delete(s.entries, k)
+			}
+		}
+		s.swept = now
+	}
+	s.entries[key] = memoryEntry{value: value, expiresAt: now.Add(ttl)}
+}
+
+func (s *memoryStore) get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.lookup(key, time.Now())
+	return e.value, ok, nil
+}
+
+func (s *memoryStore) set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.put(key, value, ttl, time.Now())
+	return nil
+}
+
+func (s *memoryStore) setNX(_ context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if _, ok := s.lookup(key, now); ok {
+		return false, nil
+	}
+	s.put(key, value, ttl, now)
+	return true, nil
+}
+
+func (s *memoryStore) take(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.lookup(key, time.Now())
+	delete(s.entries, key)
+	return e.value, ok, nil
+}
+
+func (s *memoryStore) del(_ context.Context, keys ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range keys {
+		delete(s.entries, key)
+	}
+	return nil
+}
+
+func (s *memoryStore) delIfEqual(_ context.Context, key string, value []byte) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.lookup(key, time.Now())
+	if !ok || string(e.value) != string(value) {
+		return false, nil
+	}
+	delete(s.entries, key)
+	return true, nil
+}
+
+func (s *memoryStore) expireIfEqual(_ context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	e, ok := s.lookup(key, now)
+	if !ok || string(e.value) != string(value) {
+		return false, nil
+	}
+	e.expiresAt = now.Add(ttl)
+	s.entries[key] = e
+	return true, nil
+}
+
+func (s *memoryStore) incr(_ context.Context, key string, ttl time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	e, ok := s.lookup(key, now)
+	if !ok {
+		s.put(key, nil, ttl, now)
+		e = s.entries[key]
+	}
+	e.count++
+	s.entries[key] = e
+	return e.count, nil
+}
@@ -0,0 +1,97 @@
+package data
+
+import (
+	"context"
+	"teslatrack/internal/biz"
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreExpiry(t *testing.T) {
+	ctx := context.Background()
+	s := newMemoryStore()
+	if err := s.set(ctx, "key", []byte("value"), time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(2 * time.Millisecond)
+	if _, ok, _ := s.get(ctx, "key"); ok {
+		t.Error("get returned an expired value")
+	}
+	if ok, _ := s.setNX(ctx, "key", []byte("value"), time.Minute); !ok {
+		t.Error("setNX did not replace an expired value")
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	limiter := NewRateLimiter(newTestData(t))
+	for i, want := range []bool{true, true, false} {
+		if ok, err := limiter.Allow(ctx, "vehicle:refresh:1", 2, time.Minute); err != nil || ok != want {
+			t.Errorf("Allow #%d = %v, %v, want %v", i+1, ok, err, want)
+		}
+	}
+	if ok, _ := limiter.Allow(ctx, "vehicle:refresh:2", 2, time.Minute); !ok {
+		t.Error("Allow limited another key")
+	}
+}
+
+func TestLocker(t *testing.T) {
+	ctx := context.Background()
+	locker := NewLocker(newTestData(t))
+	lock, ok, err := locker.TryLock(ctx, "poller", time.Minute)
+	if err != nil || !ok {
+		t.Fatalf("TryLock = %v, %v, want the lock", ok, err)
+	}
+	if _, ok, _ := locker.TryLock(ctx, "poller", time.Minute); ok {
+		t.Error("TryLock acquired a held lock")
+	}
+	lock.Unlock()
+	if _, ok, _ := locker.TryLock(ctx, "poller", time.Minute); !ok {
+		t.Error("TryLock did not acquire a released lock")
+	}
+}
+
+func TestLockExtend(t *testing.T) {
+	ctx := context.Background()
+	locker := NewLocker(newTestData(t))
+	lock, ok, err := locker.TryLock(ctx, "rollup", 20*time.Millisecond)
+	if err != nil || !ok {
+		t.Fatalf("TryLock = %v, %v, want the lock", ok, err)
+	}
+	if ok, err := lock.Extend(ctx, time.Minute); err != nil || !ok {
+		t.Fatalf("Extend = %v, %v, want the lock extended", ok, err)
+	}
+	time.Sleep(30 * time.Millisecond)
+	if _, ok, _ := locker.TryLock(ctx, "rollup", time.Minute); ok {
+		t.Error("TryLock acquired an extended lock after its first ttl")
+	}
+
+	// A lock that expired and was acquired by another holder is not extended.
+	expired, _, _ := locker.TryLock(ctx, "poller", time.Millisecond)
+	time.Sleep(2 * time.Millisecond)
+	if _, ok, _ := locker.TryLock(ctx, "poller", time.Minute); !ok {
+		t.Fatal("TryLock did not acquire an expired lock")
+	}
+	if ok, err := expired.Extend(ctx, time.Minute); err != nil || ok {
+		t.Errorf("Extend of an expired lock = %v, %v, want false", ok, err)
+	}
+}
+
+func TestAuthorizeStateRepo(t *testing.T) {
+	ctx := context.Background()
+	repo := NewAuthorizeStateRepo(newTestData(t))
+	if err := repo.Save(ctx, "state", &biz.AuthorizeState{ClientID: "client", Nonce: "nonce"}, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	redirect, err := repo.Take(ctx, "state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if redirect == nil || redirect.ClientID != "client" || redirect.Nonce != "nonce" {
+		t.Errorf("Take = %+v, want the saved redirect", redirect)
+	}
+	// A state is taken once, so a callback cannot be replayed.
+	if redirect, err := repo.Take(ctx, "state"); err != nil || redirect != nil {
+		t.Errorf("Take of a taken state = %v, %v, want nil, nil", redirect, err)
+	}
+}
@@ -0,0 +1,114 @@
+package data
+
+import (
+	"context"
+	"teslatrack/internal/biz"
+	"teslatrack/internal/data/ent"
+	"teslatrack/internal/data/ent/tariff"
+)
+
+var _ biz.TariffRepo = (*tariffRepo)(nil)
+
+// tariffRepo is the data layer implementation of TariffRepo.
+type tariffRepo struct {
+	data *Data
+}
+
+// NewTariffRepo creates a new tariffRepo.
+func NewTariffRepo(data *Data) biz.TariffRepo {
+	return &tariffRepo{data: data}
+}
+
+// toBizTariff converts an ent.Tariff model to a biz.Tariff model.
+func toBizTariff(model *ent.Tariff) *biz.Tariff {
+	return &biz.Tariff{
+		ID:         model.ID,
+		UserID:     model.UserID,
+		Name:       model.Name,
+		Kind:       model.Kind,
+		GeofenceID: model.GeofenceID,
+		Currency:   model.Currency,
+		Price:      model.Price,
+		Windows:    model.Windows,
+		TimeZone:   model.TimeZone,
+		CreatedAt:  model.CreatedAt,
+		UpdatedAt:  model.UpdatedAt,
+	}
+}
+
+// Create implements biz.TariffRepo.
+func (r *tariffRepo) Create(ctx context.Context, t *biz.Tariff) error {
+	model, err := r.data.db.Tariff.Create().
+		SetUserID(t.UserID).
+		SetName(t.Name).
+		SetKind(t.Kind).
+		SetNillableGeofenceID(t.GeofenceID).
+		SetCurrency(t.Currency).
+		SetPrice(t.Price).
+		SetWindows(t.Windows).
+		SetTimeZone(t.TimeZone).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	t.ID = model.ID
+	t.CreatedAt = model.CreatedAt
+	t.UpdatedAt = model.UpdatedAt
+	return nil
+}
+
+// Update implements biz.TariffRepo.
+func (r *tariffRepo) Update(ctx context.Context, t *biz.Tariff) error {
+	update := r.data.db.Tariff.UpdateOneID(t.ID).
+		SetName(t.Name).
+		SetKind(t.Kind).
+		SetCurrency(t.Currency).
+		SetPrice(t.Price).
+		SetWindows(t.Windows).
+		SetTimeZone(t.TimeZone)
+	if t.GeofenceID != nil {
+		update.SetGeofenceID(*t.GeofenceID)
+	} else {
+		update.ClearGeofenceID()
+	}
+	model, err := update.Save(ctx)
+	if err != nil {
+		return err
+	}
+	t.CreatedAt = model.CreatedAt
+	t.UpdatedAt = model.UpdatedAt
+	return nil
+}
+
+// Delete implements biz.TariffRepo.
+func (r *tariffRepo) Delete(ctx context.Context, id int) error {
+	return r.data.db.Tariff.DeleteOneID(id).Exec(ctx)
+}
+
+// FindOne implements biz.TariffRepo.
+func (r *tariffRepo) FindOne(ctx context.Context, id int) (*biz.Tariff, error) {
+	model, err := r.data.db.Tariff.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toBizTariff(model), nil
+}
+
+// ListByUser implements biz.TariffRepo.
+func (r *tariffRepo) ListByUser(ctx context.Context, userID int) ([]*biz.Tariff, error) {
+	models, err := r.data.db.Tariff.Query().
+		Where(tariff.UserID(userID)).
+		Order(ent.Asc(tariff.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tariffs := make([]*biz.Tariff, 0, len(models))
+	for _, model := range models {
+		tariffs = append(tariffs, toBizTariff(model))
+	}
+	return tariffs, nil
+}
@@ -0,0 +1,30 @@
+package data
+
+import (
+	"teslatrack/internal/biz"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// timelinePage returns the predicate selecting one page of a timeline source by the given time column.
+// It satisfies the predicate type of every entity, as they are all selector functions.
+func timelinePage(column string, page *biz.TimelinePage) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		var preds []*sql.Predicate
+		if !page.From.IsZero() {
+			preds = append(preds, sql.GTE(s.C(column), page.From))
+		}
+		if !page.To.IsZero() {
+			preds = append(preds, sql.LT(s.C(column), page.To))
+		}
+		if !page.Before.IsZero() {
+			preds = append(preds, sql.Or(
+				sql.LT(s.C(column), page.Before),
+				sql.And(sql.EQ(s.C(column), page.Before), sql.LT(s.C("id"), page.BeforeID)),
+			))
+		}
+		if len(preds) > 0 {
+			s.Where(sql.And(preds...))
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package data
+
+import (
+	"context"
+	"teslatrack/internal/biz"
+	"teslatrack/internal/data/ent"
+	"teslatrack/internal/data/ent/tirepressure"
+	"time"
+)
+
+var _ biz.TirePressureRepo = (*tirePressureRepo)(nil)
+
+// tirePressureRepo is the data layer implementation of TirePressureRepo.
+type tirePressureRepo struct {
+	data *Data
+}
+
+// NewTirePressureRepo creates a new tirePressureRepo.
+func NewTirePressureRepo(data *Data) biz.TirePressureRepo {
+	return &tirePressureRepo{data: data}
+}
+
+// toBizTirePressure converts an ent.TirePressure model to a biz.TirePressure model.
+func toBizTirePressure(model *ent.TirePressure) *biz.TirePressure {
+	p := &biz.TirePressure{
+		ID:               model.ID,
+		VehicleID:        model.VehicleID,
+		Pressure:         [4]float64{model.PressureFl, model.PressureFr, model.PressureRl, model.PressureRr},
+		RecommendedFront: model.RecommendedFront,
+		RecommendedRear:  model.RecommendedRear,
+		SoftWarning:      unpackWheels(model.SoftWarnings),
+		HardWarning:      unpackWheels(model.HardWarnings),
+		Unit:             model.Unit,
+		CreatedAt:        model.CreatedAt,
+	}
+	for i, seen := range [4]*time.Time{model.SeenAtFl, model.SeenAtFr, model.SeenAtRl, model.SeenAtRr} {
+		if seen != nil {
+			p.SeenAt[i] = *seen
+		}
+	}
+	return p
+}
+
+// packWheels packs one flag per wheel into a bit mask.
+func packWheels(flags [4]bool) int {
+	var mask int
+	for i, flag := range flags {
+		if flag {
+			mask |= 1 << i
+		}
+	}
+	return mask
+}
+
+// unpackWheels unpacks a bit mask into one flag per wheel.
+func unpackWheels(mask int) [4]bool {
+	var flags [4]bool
+	for i := range flags {
+		flags[i] = mask&(1<<i) != 0
+	}
+	return flags
+}
+
+// nillableTime returns nil for the zero time.
+func nillableTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// Create implements biz.TirePressureRepo.
+func (r *tirePressureRepo) Create(ctx context.Context, p *biz.TirePressure) error {
+	model, err := r.data.db.TirePressure.Create().
+		SetVehicleID(p.VehicleID).
+		SetPressureFl(p.Pressure[0]).
+		SetPressureFr(p.Pressure[1]).
+		SetPressureRl(p.Pressure[2]).
+		SetPressureRr(p.Pressure[3]).
+		SetNillableSeenAtFl(nillableTime(p.SeenAt[0])).
+		SetNillableSeenAtFr(nillableTime(p.SeenAt[1])).
+		SetNillableSeenAtRl(nillableTime(p.SeenAt[2])).
+		SetNillableSeenAtRr(nillableTime(p.SeenAt[3])).
+		SetRecommendedFront(p.RecommendedFront).
+		SetRecommendedRear(p.RecommendedRear).
+		SetSoftWarnings(packWheels(p.SoftWarning)).
+		SetHardWarnings(packWheels(p.HardWarning)).
+		SetUnit(p.Unit).
+		SetCreatedAt(p.CreatedAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	p.ID = model.ID
+	return nil
+}
+
+// Latest implements biz.TirePressureRepo.
+func (r *tirePressureRepo) Latest(ctx context.Context, vehicleID int) (*biz.TirePressure, error) {
+	model, err := r.data.db.TirePressure.Query().
+		Where(tirepressure.VehicleID(vehicleID)).
+		Order(ent.Desc(tirepressure.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toBizTirePressure(model), nil
+}
+
+// ListByVehicle implements biz.TirePressureRepo.
+func (r *tirePressureRepo) ListByVehicle(ctx context.Context, vehicleID int, from, to time.Time) ([]*biz.TirePressure, error) {
+	models, err := r.data.db.TirePressure.Query().
+		Where(
+			tirepressure.VehicleID(vehicleID),
+			tirepressure.CreatedAtGTE(from),
+			tirepressure.CreatedAtLT(to),
+		).
+		Order(ent.Asc(tirepressure.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	readings := make([]*biz.TirePressure, 0, len(models))
+	for _, model := range models {
+		readings = append(readings, toBizTirePressure(model))
+	}
+	return readings, nil
+}
@@ -0,0 +1,45 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"teslatrack/internal/biz"
+	"teslatrack/internal/data/ent"
+)
+
+var _ biz.Transaction = (*Data)(nil)
+
+// txKey is the context key of the transaction run by InTx.
+type txKey struct{}
+
+// NewTransaction creates a new transaction.
+func NewTransaction(data *Data) biz.Transaction {
+	return data
+}
+
+// InTx implements biz.Transaction.
+// Transactions do not nest: fn runs in the transaction of the context if there is one.
+func (d *Data) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*ent.Tx); ok {
+		return fn(ctx)
+	}
+	tx, err := d.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rollback: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// client returns the client of the transaction of the context, or the client of the database.
+func (d *Data) client(ctx context.Context) *ent.Client {
+	if tx, ok := ctx.Value(txKey{}).(*ent.Tx); ok {
+		return tx.Client()
+	}
+	return d.db
+}
@@ -1,7 +1,10 @@
 package data
 
 import (
+	"context"
 	"teslatrack/internal/biz"
+	"teslatrack/internal/data/ent"
+	"teslatrack/internal/data/ent/user"
 )
 
 var _ biz.UserRepo = (*userRepo)(nil)
@@ -15,3 +18,68 @@ type userRepo struct {
 func NewUserRepo(data *Data) biz.UserRepo {
 	return &userRepo{data}
 }
+
+// toBizUser converts an ent.User model to a biz.User model.
+func toBizUser(model *ent.User) *biz.User {
+	return &biz.User{
+		ID:          model.ID,
+		Account:     model.Account,
+		Password:    model.Password,
+		TimeZone:    model.TimeZone,
+		AskedUserID: model.AskedUserID,
+	}
+}
+
+// FindOne implements biz.UserRepo.
+func (r *userRepo) FindOne(ctx context.Context, id int) (*biz.User, error) {
+	model, err := r.data.db.User.Query().
+		Where(user.ID(id), user.Deleted(false)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toBizUser(model), nil
+}
+
+// FindByAccount implements biz.UserRepo.
+func (r *userRepo) FindByAccount(ctx context.Context, account string) (*biz.User, error) {
+	model, err := r.data.db.User.Query().
+		Where(user.Account(account), user.Deleted(false)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toBizUser(model), nil
+}
+
+// Create implements biz.UserRepo.
+// An account already registered, e.g., by a concurrent signup, fails with biz.ErrAccountExists.
+func (r *userRepo) Create(ctx context.Context, u *biz.User) error {
+	create := r.data.db.User.Create().
+		SetAccount(u.Account).
+		SetPassword(u.Password).
+		SetTimeZone(u.TimeZone)
+	if u.AskedUserID != 0 {
+		create.SetAskedUserID(u.AskedUserID)
+	}
+	model, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return biz.ErrAccountExists
+		}
+		return err
+	}
+	u.ID = model.ID
+	return nil
+}
+
+// UpdateTimeZone implements biz.UserRepo.
+func (r *userRepo) UpdateTimeZone(ctx context.Context, id int, timeZone string) error {
+	return r.data.db.User.UpdateOneID(id).SetTimeZone(timeZone).Exec(ctx)
+}
@@ -0,0 +1,18 @@
+package data
+
+import (
+	"context"
+	"teslatrack/internal/biz"
+	"testing"
+)
+
+func TestUserRepoCreateExists(t *testing.T) {
+	ctx := context.Background()
+	repo := NewUserRepo(newTestData(t))
+	if err := repo.Create(ctx, &biz.User{Account: "alice", Password: "hash", TimeZone: biz.DefaultTimeZone}); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Create(ctx, &biz.User{Account: "alice", Password: "other", TimeZone: biz.DefaultTimeZone}); err != biz.ErrAccountExists {
+		t.Errorf("Create of a registered account error = %v, want %v", err, biz.ErrAccountExists)
+	}
+}
@@ -3,10 +3,19 @@ package data
 import (
 	"context"
 	"teslatrack/internal/biz"
+	"teslatrack/internal/data/ent"
+	"teslatrack/internal/data/ent/vehicle"
 )
 
 var _ biz.VehicleRepo = (*vehicleRepo)(nil)
 
+// vehicleStates maps the Tesla vehicle state to its stored representation.
+var vehicleStates = map[string]int8{
+	"offline": 0,
+	"online":  1,
+	"asleep":  2,
+}
+
 // vehicleRepo is the data layer implementation of VehicleRepo.
 type vehicleRepo struct {
 	data *Data
@@ -17,17 +26,124 @@ func NewVehicleRepo(data *Data) biz.VehicleRepo {
 	return &vehicleRepo{data}
 }
 
+// toBizVehicle converts an ent.Vehicle model to a biz.Vehicle model.
+func toBizVehicle(model *ent.Vehicle) *biz.Vehicle {
+	veh := &biz.Vehicle{
+		ID:          model.ID,
+		VIN:         model.Vin,
+		UserID:      model.UserID,
+		DisplayName: model.DisplayName,
+		AccessType:  model.AccessType,
+		RawData:     model.RawData,
+	}
+	for state, value := range vehicleStates {
+		if value == model.State {
+			veh.State = state
+		}
+	}
+	return veh
+}
+
 // CreateVehicle implements biz.VehicleRepo.
 func (v *vehicleRepo) CreateVehicle(ctx context.Context, veh *biz.Vehicle) error {
-	panic("unimplemented")
+	model, err := v.data.db.Vehicle.Create().
+		SetVin(veh.VIN).
+		SetUserID(veh.UserID).
+		SetDisplayName(veh.DisplayName).
+		SetAccessType(veh.AccessType).
+		SetState(vehicleStates[veh.State]).
+		SetRawData(veh.RawData).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	veh.ID = model.ID
+	return nil
 }
 
 // FindByUserID implements biz.VehicleRepo.
 func (v *vehicleRepo) FindByUserID(ctx context.Context, userID int) ([]*biz.Vehicle, error) {
-	panic("unimplemented")
+	models, err := v.data.db.Vehicle.Query().
+		Where(vehicle.UserID(userID), vehicle.Deleted(false)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vehicles := make([]*biz.Vehicle, 0, len(models))
+	for _, model := range models {
+		vehicles = append(vehicles, toBizVehicle(model))
+	}
+	return vehicles, nil
 }
 
 // FindOne implements biz.VehicleRepo.
 func (v *vehicleRepo) FindOne(ctx context.Context, id int) (*biz.Vehicle, error) {
-	panic("unimplemented")
+	model, err := v.data.db.Vehicle.Query().
+		Where(vehicle.ID(id), vehicle.Deleted(false)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toBizVehicle(model), nil
+}
+
+// FindByVIN implements biz.VehicleRepo.
+func (v *vehicleRepo) FindByVIN(ctx context.Context, vin string) (*biz.Vehicle, error) {
+	model, err := v.data.db.Vehicle.Query().
+		Where(vehicle.Vin(vin), vehicle.Deleted(false)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toBizVehicle(model), nil
+}
+
+// ListAll implements biz.VehicleRepo.
+func (v *vehicleRepo) ListAll(ctx context.Context) ([]*biz.Vehicle, error) {
+	models, err := v.data.db.Vehicle.Query().
+		Where(vehicle.Deleted(false)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vehicles := make([]*biz.Vehicle, 0, len(models))
+	for _, model := range models {
+		vehicles = append(vehicles, toBizVehicle(model))
+	}
+	return vehicles, nil
+}
+
+// SaveByVIN implements biz.VehicleRepo.
+func (v *vehicleRepo) SaveByVIN(ctx context.Context, veh *biz.Vehicle) (*biz.Vehicle, error) {
+	model, err := v.data.db.Vehicle.Query().
+		Where(vehicle.Vin(veh.VIN), vehicle.Deleted(false)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		if err := v.CreateVehicle(ctx, veh); err != nil {
+			return nil, err
+		}
+		return veh, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	update := model.Update().
+		SetDisplayName(veh.DisplayName).
+		SetAccessType(veh.AccessType).
+		SetState(vehicleStates[veh.State]).
+		SetRawData(veh.RawData)
+	if veh.UserID != 0 {
+		update.SetUserID(veh.UserID)
+	}
+	model, err = update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toBizVehicle(model), nil
 }
@@ -0,0 +1,70 @@
+package data
+
+import (
+	"context"
+	"teslatrack/internal/biz"
+	"teslatrack/internal/data/ent"
+	"teslatrack/internal/data/ent/vehicleevent"
+)
+
+var _ biz.VehicleEventRepo = (*vehicleEventRepo)(nil)
+
+// vehicleEventRepo is the data layer implementation of VehicleEventRepo.
+type vehicleEventRepo struct {
+	data *Data
+}
+
+// NewVehicleEventRepo creates a new vehicleEventRepo.
+func NewVehicleEventRepo(data *Data) biz.VehicleEventRepo {
+	return &vehicleEventRepo{data: data}
+}
+
+// toBizVehicleEvent converts an ent.VehicleEvent model to a biz.VehicleEvent model.
+func toBizVehicleEvent(model *ent.VehicleEvent) *biz.VehicleEvent {
+	return &biz.VehicleEvent{
+		ID:        model.ID,
+		VehicleID: model.VehicleID,
+		UserID:    model.UserID,
+		Type:      model.Type,
+		Summary:   model.Summary,
+		Data:      model.Data,
+		Time:      model.OccurredAt,
+	}
+}
+
+// Create implements biz.VehicleEventRepo.
+func (r *vehicleEventRepo) Create(ctx context.Context, e *biz.VehicleEvent) error {
+	model, err := r.data.db.VehicleEvent.Create().
+		SetVehicleID(e.VehicleID).
+		SetUserID(e.UserID).
+		SetType(e.Type).
+		SetSummary(e.Summary).
+		SetData(e.Data).
+		SetOccurredAt(e.Time).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	e.ID = model.ID
+	return nil
+}
+
+// ListPage implements biz.VehicleEventRepo.
+func (r *vehicleEventRepo) ListPage(ctx context.Context, vehicleID int, page *biz.TimelinePage) ([]*biz.VehicleEvent, error) {
+	models, err := r.data.db.VehicleEvent.Query().
+		Where(
+			vehicleevent.VehicleID(vehicleID),
+			timelinePage(vehicleevent.FieldOccurredAt, page),
+		).
+		Order(ent.Desc(vehicleevent.FieldOccurredAt), ent.Desc(vehicleevent.FieldID)).
+		Limit(page.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*biz.VehicleEvent, 0, len(models))
+	for _, model := range models {
+		events = append(events, toBizVehicleEvent(model))
+	}
+	return events, nil
+}
@@ -0,0 +1,195 @@
+package data
+
+import (
+	"context"
+	"strconv"
+	"teslatrack/internal/biz"
+	"teslatrack/internal/data/ent"
+	"teslatrack/internal/data/ent/vehiclesnapshot"
+	"teslatrack/pkg/geo"
+	"time"
+)
+
+// latestSnapshotCacheTTL is how long the latest snapshots of a vehicle are cached. New
+// snapshots replace them, so the ttl only bounds the memory of vehicles no longer read.
+const latestSnapshotCacheTTL = time.Hour
+
+func latestSnapshotCacheKey(vehicleID int) string {
+	return "vehicle:" + strconv.Itoa(vehicleID) + ":latest"
+}
+
+func latestDataSnapshotCacheKey(vehicleID int) string {
+	return "vehicle:" + strconv.Itoa(vehicleID) + ":latest_data"
+}
+
+var _ biz.VehicleSnapshotRepo = (*vehicleSnapshotRepo)(nil)
+
+// vehicleSnapshotRepo is the data layer implementation of VehicleSnapshotRepo.
+type vehicleSnapshotRepo struct {
+	data *Data
+}
+
+// NewVehicleSnapshotRepo creates a new vehicleSnapshotRepo.
+func NewVehicleSnapshotRepo(data *Data) biz.VehicleSnapshotRepo {
+	return &vehicleSnapshotRepo{data: data}
+}
+
+// toBizSnapshot converts an ent.VehicleSnapshot model to a biz.VehicleSnapshot model.
+func toBizSnapshot(model *ent.VehicleSnapshot) *biz.VehicleSnapshot {
+	return &biz.VehicleSnapshot{
+		ID:                   model.ID,
+		VehicleID:            model.VehicleID,
+		State:                model.State,
+		ShiftState:           model.ShiftState,
+		Speed:                model.Speed,
+		Power:                model.Power,
+		Latitude:             model.Latitude,
+		Longitude:            model.Longitude,
+		CoordType:            geo.CoordType(model.CoordType),
+		Heading:              model.Heading,
+		BatteryLevel:         model.BatteryLevel,
+		UsableBatteryLevel:   model.UsableBatteryLevel,
+		BatteryRange:         model.BatteryRange,
+		IdealBatteryRange:    model.IdealBatteryRange,
+		EstBatteryRange:      model.EstBatteryRange,
+		ChargingState:        model.ChargingState,
+		ChargerPower:         model.ChargerPower,
+		ChargeEnergyAdded:    model.ChargeEnergyAdded,
+		ChargerVoltage:       model.ChargerVoltage,
+		ChargerActualCurrent: model.ChargerActualCurrent,
+		FastChargerPresent:   model.FastChargerPresent,
+		FastChargerType:      model.FastChargerType,
+		BatteryHeaterOn:      model.BatteryHeaterOn,
+		OutsideTemp:          model.OutsideTemp,
+		InsideTemp:           model.InsideTemp,
+		ClimateOn:            model.ClimateOn,
+		ClimateKeeperMode:    model.ClimateKeeperMode,
+		CabinOverheatCooling: model.CabinOverheatCooling,
+		SentryMode:           model.SentryMode,
+		Locked:               model.Locked,
+		Odometer:             model.Odometer,
+		CarVersion:           model.CarVersion,
+		SoftwareUpdateStatus: model.SoftwareUpdateStatus,
+		CreatedAt:            model.CreatedAt,
+
+		SoftwareUpdateVersion:          model.SoftwareUpdateVersion,
+		SoftwareUpdateDownloadPerc:     model.SoftwareUpdateDownloadPerc,
+		SoftwareUpdateInstallPerc:      model.SoftwareUpdateInstallPerc,
+		SoftwareUpdateExpectedDuration: time.Duration(model.SoftwareUpdateExpectedDuration) * time.Second,
+	}
+}
+
+// Create implements biz.VehicleSnapshotRepo.
+func (r *vehicleSnapshotRepo) Create(ctx context.Context, s *biz.VehicleSnapshot) error {
+	model, err := r.data.db.VehicleSnapshot.Create().
+		SetVehicleID(s.VehicleID).
+		SetState(s.State).
+		SetShiftState(s.ShiftState).
+		SetSpeed(s.Speed).
+		SetPower(s.Power).
+		SetLatitude(s.Latitude).
+		SetLongitude(s.Longitude).
+		SetCoordType(string(s.CoordType)).
+		SetHeading(s.Heading).
+		SetBatteryLevel(s.BatteryLevel).
+		SetUsableBatteryLevel(s.UsableBatteryLevel).
+		SetBatteryRange(s.BatteryRange).
+		SetIdealBatteryRange(s.IdealBatteryRange).
+		SetEstBatteryRange(s.EstBatteryRange).
+		SetChargingState(s.ChargingState).
+		SetChargerPower(s.ChargerPower).
+		SetChargeEnergyAdded(s.ChargeEnergyAdded).
+		SetChargerVoltage(s.ChargerVoltage).
+		SetChargerActualCurrent(s.ChargerActualCurrent).
+		SetFastChargerPresent(s.FastChargerPresent).
+		SetFastChargerType(s.FastChargerType).
+		SetBatteryHeaterOn(s.BatteryHeaterOn).
+		SetOutsideTemp(s.OutsideTemp).
+		SetInsideTemp(s.InsideTemp).
+		SetClimateOn(s.ClimateOn).
+		SetClimateKeeperMode(s.ClimateKeeperMode).
+		SetCabinOverheatCooling(s.CabinOverheatCooling).
+		SetSentryMode(s.SentryMode).
+		SetLocked(s.Locked).
+		SetOdometer(s.Odometer).
+		SetCarVersion(s.CarVersion).
+		SetSoftwareUpdateStatus(s.SoftwareUpdateStatus).
+		SetSoftwareUpdateVersion(s.SoftwareUpdateVersion).
+		SetSoftwareUpdateDownloadPerc(s.SoftwareUpdateDownloadPerc).
+		SetSoftwareUpdateInstallPerc(s.SoftwareUpdateInstallPerc).
+		SetSoftwareUpdateExpectedDuration(int(s.SoftwareUpdateExpectedDuration.Seconds())).
+		SetCreatedAt(s.CreatedAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	s.ID = model.ID
+	// The latest snapshots are cached for the latest state reads.
+	r.data.cacheSet(ctx, latestSnapshotCacheKey(s.VehicleID), s, latestSnapshotCacheTTL)
+	if s.HasData() {
+		r.data.cacheSet(ctx, latestDataSnapshotCacheKey(s.VehicleID), s, latestSnapshotCacheTTL)
+	}
+	return nil
+}
+
+// Latest implements biz.VehicleSnapshotRepo.
+func (r *vehicleSnapshotRepo) Latest(ctx context.Context, vehicleID int) (*biz.VehicleSnapshot, error) {
+	var cached biz.VehicleSnapshot
+	if r.data.cacheGet(ctx, latestSnapshotCacheKey(vehicleID), &cached) {
+		return &cached, nil
+	}
+	model, err := r.data.db.VehicleSnapshot.Query().
+		Where(vehiclesnapshot.VehicleID(vehicleID)).
+		Order(ent.Desc(vehiclesnapshot.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	snapshot := toBizSnapshot(model)
+	r.data.cacheSet(ctx, latestSnapshotCacheKey(vehicleID), snapshot, latestSnapshotCacheTTL)
+	return snapshot, nil
+}
+
+// LatestWithData implements biz.VehicleSnapshotRepo.
+func (r *vehicleSnapshotRepo) LatestWithData(ctx context.Context, vehicleID int) (*biz.VehicleSnapshot, error) {
+	var cached biz.VehicleSnapshot
+	if r.data.cacheGet(ctx, latestDataSnapshotCacheKey(vehicleID), &cached) {
+		return &cached, nil
+	}
+	model, err := r.data.db.VehicleSnapshot.Query().
+		Where(vehiclesnapshot.VehicleID(vehicleID), vehiclesnapshot.State(biz.TeslaStateOnline)).
+		Order(ent.Desc(vehiclesnapshot.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	snapshot := toBizSnapshot(model)
+	r.data.cacheSet(ctx, latestDataSnapshotCacheKey(vehicleID), snapshot, latestSnapshotCacheTTL)
+	return snapshot, nil
+}
+
+// ListByVehicle implements biz.VehicleSnapshotRepo.
+func (r *vehicleSnapshotRepo) ListByVehicle(ctx context.Context, vehicleID int, from, to time.Time) ([]*biz.VehicleSnapshot, error) {
+	models, err := r.data.db.VehicleSnapshot.Query().
+		Where(
+			vehiclesnapshot.VehicleID(vehicleID),
+			vehiclesnapshot.CreatedAtGTE(from),
+			vehiclesnapshot.CreatedAtLT(to),
+		).
+		Order(ent.Asc(vehiclesnapshot.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]*biz.VehicleSnapshot, 0, len(models))
+	for _, model := range models {
+		snapshots = append(snapshots, toBizSnapshot(model))
+	}
+	return snapshots, nil
+}
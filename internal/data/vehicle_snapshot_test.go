@@ -0,0 +1,47 @@
+package data
+
+import (
+	"context"
+	"teslatrack/internal/biz"
+	"testing"
+	"time"
+)
+
+func TestVehicleSnapshotRepoLatest(t *testing.T) {
+	ctx := context.Background()
+	data := newTestData(t)
+	repo := NewVehicleSnapshotRepo(data)
+
+	if latest, err := repo.Latest(ctx, 1); err != nil || latest != nil {
+		t.Fatalf("Latest without snapshots = %v, %v, want nil, nil", latest, err)
+	}
+	now := time.Now().Truncate(time.Second)
+	online := &biz.VehicleSnapshot{VehicleID: 1, State: biz.TeslaStateOnline, BatteryLevel: 80, CreatedAt: now.Add(-time.Minute)}
+	asleep := &biz.VehicleSnapshot{VehicleID: 1, State: biz.TeslaStateAsleep, CreatedAt: now}
+	for _, s := range []*biz.VehicleSnapshot{online, asleep} {
+		if err := repo.Create(ctx, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Read twice: from the cache written by Create, then from the database.
+	for _, cached := range []bool{true, false} {
+		if !cached {
+			data.cacheDel(ctx, latestSnapshotCacheKey(1), latestDataSnapshotCacheKey(1))
+		}
+		latest, err := repo.Latest(ctx, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if latest == nil || latest.ID != asleep.ID || !latest.CreatedAt.Equal(now) {
+			t.Errorf("Latest (cached %v) = %+v, want the asleep snapshot", cached, latest)
+		}
+		withData, err := repo.LatestWithData(ctx, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if withData == nil || withData.ID != online.ID || withData.BatteryLevel != 80 {
+			t.Errorf("LatestWithData (cached %v) = %+v, want the online snapshot", cached, withData)
+		}
+	}
+}
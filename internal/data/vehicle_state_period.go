@@ -0,0 +1,396 @@
+package data
+
+import (
+	"context"
+	"teslatrack/internal/biz"
+	"teslatrack/internal/data/ent"
+	"teslatrack/internal/data/ent/vehiclestateperiod"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+)
+
+var _ biz.VehicleStatePeriodRepo = (*vehicleStatePeriodRepo)(nil)
+
+// vehicleStatePeriodRepo is the data layer implementation of VehicleStatePeriodRepo.
+type vehicleStatePeriodRepo struct {
+	data *Data
+}
+
+// NewVehicleStatePeriodRepo creates a new vehicleStatePeriodRepo.
+func NewVehicleStatePeriodRepo(data *Data) biz.VehicleStatePeriodRepo {
+	return &vehicleStatePeriodRepo{data: data}
+}
+
+// toBizStatePeriod converts an ent.VehicleStatePeriod model to a biz.VehicleStatePeriod model.
+func toBizStatePeriod(model *ent.VehicleStatePeriod) *biz.VehicleStatePeriod {
+	return &biz.VehicleStatePeriod{
+		ID:                model.ID,
+		VehicleID:         model.VehicleID,
+		State:             model.State,
+		SentryMode:        model.SentryMode,
+		ClimateOn:         model.ClimateOn,
+		StartAt:           model.StartAt,
+		EndAt:             model.EndAt,
+		StartBatteryLevel: model.StartBatteryLevel,
+		EndBatteryLevel:   model.EndBatteryLevel,
+		StartRange:        model.StartRange,
+		EndRange:          model.EndRange,
+		StartOdometer:     model.StartOdometer,
+		EndOdometer:       model.EndOdometer,
+		StartLatitude:     model.StartLatitude,
+		StartLongitude:    model.StartLongitude,
+		EndLatitude:       model.EndLatitude,
+		EndLongitude:      model.EndLongitude,
+		StartAddress:      model.StartAddress,
+		EndAddress:        model.EndAddress,
+		StartGeofenceID:   model.StartGeofenceID,
+		EndGeofenceID:     model.EndGeofenceID,
+		ChargeLocation:    model.ChargeLocation,
+		FastCharger:       model.FastCharger,
+		EnergyAdded:       model.EnergyAdded,
+		StartEnergyAdded:  model.StartEnergyAdded,
+		EnergyUsed:        model.EnergyUsed,
+		PowerDuration:     time.Duration(model.PowerSeconds) * time.Second,
+		OutsideTemp:       model.OutsideTemp,
+		MaxSpeed:          model.MaxSpeed,
+		ClimateRatio:      model.ClimateRatio,
+		Cost:              model.Cost,
+		CostManual:        model.CostManual,
+		TariffID:          model.TariffID,
+		Name:              model.Name,
+		Tags:              model.Tags,
+		UpdatedAt:         model.UpdatedAt,
+	}
+}
+
+// Create implements biz.VehicleStatePeriodRepo.
+func (r *vehicleStatePeriodRepo) Create(ctx context.Context, p *biz.VehicleStatePeriod) error {
+	model, err := r.data.client(ctx).VehicleStatePeriod.Create().
+		SetVehicleID(p.VehicleID).
+		SetState(p.State).
+		SetSentryMode(p.SentryMode).
+		SetClimateOn(p.ClimateOn).
+		SetStartAt(p.StartAt).
+		SetNillableEndAt(p.EndAt).
+		SetStartBatteryLevel(p.StartBatteryLevel).
+		SetEndBatteryLevel(p.EndBatteryLevel).
+		SetStartRange(p.StartRange).
+		SetEndRange(p.EndRange).
+		SetStartOdometer(p.StartOdometer).
+		SetEndOdometer(p.EndOdometer).
+		SetStartLatitude(p.StartLatitude).
+		SetStartLongitude(p.StartLongitude).
+		SetEndLatitude(p.EndLatitude).
+		SetEndLongitude(p.EndLongitude).
+		SetStartAddress(p.StartAddress).
+		SetEndAddress(p.EndAddress).
+		SetNillableStartGeofenceID(p.StartGeofenceID).
+		SetNillableEndGeofenceID(p.EndGeofenceID).
+		SetChargeLocation(p.ChargeLocation).
+		SetFastCharger(p.FastCharger).
+		SetEnergyAdded(p.EnergyAdded).
+		SetStartEnergyAdded(p.StartEnergyAdded).
+		SetEnergyUsed(p.EnergyUsed).
+		SetPowerSeconds(int(p.PowerDuration.Seconds())).
+		SetNillableOutsideTemp(p.OutsideTemp).
+		SetMaxSpeed(p.MaxSpeed).
+		SetClimateRatio(p.ClimateRatio).
+		SetNillableCost(p.Cost).
+		SetCostManual(p.CostManual).
+		SetNillableTariffID(p.TariffID).
+		SetName(p.Name).
+		SetTags(p.Tags).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	p.ID = model.ID
+	p.UpdatedAt = model.UpdatedAt
+	return nil
+}
+
+// Update implements biz.VehicleStatePeriodRepo.
+func (r *vehicleStatePeriodRepo) Update(ctx context.Context, p *biz.VehicleStatePeriod) error {
+	update := r.data.client(ctx).VehicleStatePeriod.UpdateOneID(p.ID)
+	setEnd(update, p)
+	setCost(update, p)
+	_, err := update.Save(ctx)
+	return err
+}
+
+// setEnd sets the end and the accumulated statistics of a period.
+func setEnd(update *ent.VehicleStatePeriodUpdateOne, p *biz.VehicleStatePeriod) {
+	update.
+		SetNillableEndAt(p.EndAt).
+		SetEndBatteryLevel(p.EndBatteryLevel).
+		SetEndRange(p.EndRange).
+		SetEndOdometer(p.EndOdometer).
+		SetEndLatitude(p.EndLatitude).
+		SetEndLongitude(p.EndLongitude).
+		SetEndAddress(p.EndAddress).
+		SetFastCharger(p.FastCharger).
+		SetEnergyAdded(p.EnergyAdded).
+		SetEnergyUsed(p.EnergyUsed).
+		SetPowerSeconds(int(p.PowerDuration.Seconds())).
+		SetNillableOutsideTemp(p.OutsideTemp).
+		SetMaxSpeed(p.MaxSpeed).
+		SetClimateRatio(p.ClimateRatio)
+	if p.EndGeofenceID != nil {
+		update.SetEndGeofenceID(*p.EndGeofenceID)
+	} else {
+		update.ClearEndGeofenceID()
+	}
+}
+
+// Save implements biz.VehicleStatePeriodRepo.
+func (r *vehicleStatePeriodRepo) Save(ctx context.Context, p *biz.VehicleStatePeriod) error {
+	update := r.data.client(ctx).VehicleStatePeriod.UpdateOneID(p.ID).
+		SetSentryMode(p.SentryMode).
+		SetClimateOn(p.ClimateOn).
+		SetStartAt(p.StartAt).
+		SetStartBatteryLevel(p.StartBatteryLevel).
+		SetStartRange(p.StartRange).
+		SetStartOdometer(p.StartOdometer).
+		SetStartLatitude(p.StartLatitude).
+		SetStartLongitude(p.StartLongitude).
+		SetStartAddress(p.StartAddress).
+		SetStartEnergyAdded(p.StartEnergyAdded).
+		SetChargeLocation(p.ChargeLocation).
+		SetName(p.Name).
+		SetTags(p.Tags)
+	if p.StartGeofenceID != nil {
+		update.SetStartGeofenceID(*p.StartGeofenceID)
+	} else {
+		update.ClearStartGeofenceID()
+	}
+	if p.OutsideTemp == nil {
+		update.ClearOutsideTemp()
+	}
+	setEnd(update, p)
+	setCost(update, p)
+	_, err := update.Save(ctx)
+	return err
+}
+
+// Delete implements biz.VehicleStatePeriodRepo.
+func (r *vehicleStatePeriodRepo) Delete(ctx context.Context, ids ...int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := r.data.client(ctx).VehicleStatePeriod.Delete().
+		Where(vehiclestateperiod.IDIn(ids...)).
+		Exec(ctx)
+	return err
+}
+
+// UpdateCost implements biz.VehicleStatePeriodRepo.
+func (r *vehicleStatePeriodRepo) UpdateCost(ctx context.Context, p *biz.VehicleStatePeriod) error {
+	update := r.data.client(ctx).VehicleStatePeriod.UpdateOneID(p.ID)
+	setCost(update, p)
+	_, err := update.Save(ctx)
+	return err
+}
+
+// setCost sets or clears the cost columns of a period.
+func setCost(update *ent.VehicleStatePeriodUpdateOne, p *biz.VehicleStatePeriod) {
+	update.SetCostManual(p.CostManual)
+	if p.Cost != nil {
+		update.SetCost(*p.Cost)
+	} else {
+		update.ClearCost()
+	}
+	if p.TariffID != nil {
+		update.SetTariffID(*p.TariffID)
+	} else {
+		update.ClearTariffID()
+	}
+}
+
+// FindOne implements biz.VehicleStatePeriodRepo.
+func (r *vehicleStatePeriodRepo) FindOne(ctx context.Context, id int) (*biz.VehicleStatePeriod, error) {
+	model, err := r.data.client(ctx).VehicleStatePeriod.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toBizStatePeriod(model), nil
+}
+
+// Current implements biz.VehicleStatePeriodRepo.
+func (r *vehicleStatePeriodRepo) Current(ctx context.Context, vehicleID int) (*biz.VehicleStatePeriod, error) {
+	model, err := r.data.client(ctx).VehicleStatePeriod.Query().
+		Where(vehiclestateperiod.VehicleID(vehicleID)).
+		Order(ent.Desc(vehiclestateperiod.FieldStartAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return toBizStatePeriod(model), nil
+}
+
+// ListByVehicle implements biz.VehicleStatePeriodRepo.
+func (r *vehicleStatePeriodRepo) ListByVehicle(ctx context.Context, vehicleID int, from, to time.Time) ([]*biz.VehicleStatePeriod, error) {
+	models, err := r.data.client(ctx).VehicleStatePeriod.Query().
+		Where(
+			vehiclestateperiod.VehicleID(vehicleID),
+			vehiclestateperiod.StartAtLT(to),
+			vehiclestateperiod.Or(
+				vehiclestateperiod.EndAtIsNil(),
+				vehiclestateperiod.EndAtGTE(from),
+			),
+		).
+		Order(ent.Asc(vehiclestateperiod.FieldStartAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	periods := make([]*biz.VehicleStatePeriod, 0, len(models))
+	for _, model := range models {
+		periods = append(periods, toBizStatePeriod(model))
+	}
+	return periods, nil
+}
+
+// ListPage implements biz.VehicleStatePeriodRepo.
+func (r *vehicleStatePeriodRepo) ListPage(ctx context.Context, vehicleID int, states []string, page *biz.TimelinePage) ([]*biz.VehicleStatePeriod, error) {
+	models, err := r.data.client(ctx).VehicleStatePeriod.Query().
+		Where(
+			vehiclestateperiod.VehicleID(vehicleID),
+			vehiclestateperiod.StateIn(states...),
+			timelinePage(vehiclestateperiod.FieldStartAt, page),
+		).
+		Order(ent.Desc(vehiclestateperiod.FieldStartAt), ent.Desc(vehiclestateperiod.FieldID)).
+		Limit(page.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	periods := make([]*biz.VehicleStatePeriod, 0, len(models))
+	for _, model := range models {
+		periods = append(periods, toBizStatePeriod(model))
+	}
+	return periods, nil
+}
+
+// ListUpdatedSince implements biz.VehicleStatePeriodRepo.
+func (r *vehicleStatePeriodRepo) ListUpdatedSince(ctx context.Context, vehicleID int, since time.Time) ([]*biz.VehicleStatePeriod, error) {
+	models, err := r.data.client(ctx).VehicleStatePeriod.Query().
+		Where(
+			vehiclestateperiod.VehicleID(vehicleID),
+			vehiclestateperiod.UpdatedAtGTE(since),
+		).
+		Order(ent.Asc(vehiclestateperiod.FieldStartAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	periods := make([]*biz.VehicleStatePeriod, 0, len(models))
+	for _, model := range models {
+		periods = append(periods, toBizStatePeriod(model))
+	}
+	return periods, nil
+}
+
+// List implements biz.VehicleStatePeriodRepo.
+func (r *vehicleStatePeriodRepo) List(ctx context.Context, f *biz.PeriodFilter) ([]*biz.VehicleStatePeriod, error) {
+	query := r.data.client(ctx).VehicleStatePeriod.Query().
+		Where(
+			vehiclestateperiod.VehicleIDIn(f.VehicleIDs...),
+			vehiclestateperiod.State(f.State),
+		)
+	if !f.From.IsZero() {
+		query.Where(vehiclestateperiod.StartAtGTE(f.From))
+	}
+	if !f.To.IsZero() {
+		query.Where(vehiclestateperiod.StartAtLT(f.To))
+	}
+	if f.GeofenceIDs != nil {
+		query.Where(vehiclestateperiod.Or(
+			vehiclestateperiod.StartGeofenceIDIn(f.GeofenceIDs...),
+			vehiclestateperiod.EndGeofenceIDIn(f.GeofenceIDs...),
+		))
+	}
+	if f.Tag != "" {
+		query.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(vehiclestateperiod.FieldTags, f.Tag))
+		})
+	}
+	query.Where(func(s *sql.Selector) {
+		periodListFilter(s, f)
+	})
+	dir := "DESC"
+	if f.Sort == biz.SortOldest {
+		dir = "ASC"
+	}
+	models, err := query.
+		Order(func(s *sql.Selector) {
+			s.OrderExpr(sql.Expr(periodSortKey(s, f.Sort, f.State) + " " + dir))
+			s.OrderExpr(sql.Expr(s.C(vehiclestateperiod.FieldID) + " " + dir))
+		}).
+		Limit(f.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	periods := make([]*biz.VehicleStatePeriod, 0, len(models))
+	for _, model := range models {
+		periods = append(periods, toBizStatePeriod(model))
+	}
+	return periods, nil
+}
+
+// periodListFilter adds the minimum distance and energy and the cursor of a history list to a selector.
+func periodListFilter(s *sql.Selector, f *biz.PeriodFilter) {
+	if f.MinDistance > 0 {
+		s.Where(compareKey(periodSortKey(s, biz.SortDistance, f.State), sql.OpGTE, f.MinDistance))
+	}
+	if f.MinEnergy > 0 {
+		s.Where(compareKey(periodSortKey(s, biz.SortEnergy, f.State), sql.OpGTE, f.MinEnergy))
+	}
+	if f.After == nil {
+		return
+	}
+	op := sql.OpLT
+	if f.Sort == biz.SortOldest {
+		op = sql.OpGT
+	}
+	var value any = f.After.Value
+	if f.Sort == biz.SortNewest || f.Sort == biz.SortOldest {
+		value = f.After.StartAt
+	}
+	key := periodSortKey(s, f.Sort, f.State)
+	s.Where(sql.Or(
+		compareKey(key, op, value),
+		sql.And(compareKey(key, sql.OpEQ, value), compareKey(s.C(vehiclestateperiod.FieldID), op, f.After.ID)),
+	))
+}
+
+// compareKey returns the predicate comparing a SQL expression to a value,
+// bound as an argument in the placeholder style of the dialect.
+func compareKey(key string, op sql.Op, value any) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.WriteString(key).WriteOp(op).Arg(value)
+	})
+}
+
+// periodSortKey returns the SQL expression a history list is sorted by.
+func periodSortKey(s *sql.Selector, sort, state string) string {
+	switch sort {
+	case biz.SortDistance:
+		return s.C(vehiclestateperiod.FieldEndOdometer) + " - " + s.C(vehiclestateperiod.FieldStartOdometer)
+	case biz.SortEnergy:
+		if state == biz.VehicleStateCharging {
+			return s.C(vehiclestateperiod.FieldEnergyAdded)
+		}
+		return s.C(vehiclestateperiod.FieldEnergyUsed)
+	default:
+		return s.C(vehiclestateperiod.FieldStartAt)
+	}
+}
@@ -0,0 +1,125 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"teslatrack/internal/biz"
+	"teslatrack/internal/data/ent/vehiclestateperiod"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+func TestVehicleStatePeriodRepoList(t *testing.T) {
+	ctx := context.Background()
+	repo := NewVehicleStatePeriodRepo(newTestData(t))
+
+	start := time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)
+	drives := []*biz.VehicleStatePeriod{
+		{StartOdometer: 100, EndOdometer: 112, Tags: []string{"work"}},
+		{StartOdometer: 112, EndOdometer: 150, Tags: []string{"trip", "work"}},
+		{StartOdometer: 150, EndOdometer: 153},
+		{StartOdometer: 153, EndOdometer: 190, Tags: []string{"trip"}},
+	}
+	for i, p := range drives {
+		p.VehicleID = 1
+		p.State = biz.VehicleStateDriving
+		p.StartAt = start.Add(time.Duration(i) * time.Hour)
+		end := p.StartAt.Add(30 * time.Minute)
+		p.EndAt = &end
+		if err := repo.Create(ctx, p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ids := func(periods []*biz.VehicleStatePeriod) []int {
+		ids := make([]int, len(periods))
+		for i, p := range periods {
+			ids[i] = p.ID
+		}
+		return ids
+	}
+	filter := func(f biz.PeriodFilter) *biz.PeriodFilter {
+		f.VehicleIDs = []int{1}
+		f.State = biz.VehicleStateDriving
+		f.Limit = 10
+		return &f
+	}
+	tests := []struct {
+		name   string
+		filter *biz.PeriodFilter
+		want   []int
+	}{
+		{"newest", filter(biz.PeriodFilter{Sort: biz.SortNewest}), []int{drives[3].ID, drives[2].ID, drives[1].ID, drives[0].ID}},
+		{"tag", filter(biz.PeriodFilter{Sort: biz.SortOldest, Tag: "work"}), []int{drives[0].ID, drives[1].ID}},
+		{"distance", filter(biz.PeriodFilter{Sort: biz.SortDistance, MinDistance: 10}), []int{drives[1].ID, drives[3].ID, drives[0].ID}},
+		{"after", filter(biz.PeriodFilter{Sort: biz.SortDistance, After: &biz.PeriodCursor{Value: 37, ID: drives[3].ID}}), []int{drives[0].ID, drives[2].ID}},
+	}
+	for _, tt := range tests {
+		periods, err := repo.List(ctx, tt.filter)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got := ids(periods); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: List = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPeriodListFilterPostgres(t *testing.T) {
+	s := sql.Dialect(dialect.Postgres).Select().From(sql.Table(vehiclestateperiod.Table))
+	periodListFilter(s, &biz.PeriodFilter{
+		State:       biz.VehicleStateDriving,
+		Sort:        biz.SortDistance,
+		MinDistance: 10,
+		MinEnergy:   2,
+		After:       &biz.PeriodCursor{Value: 37, ID: 4},
+	})
+	query, args := s.Query()
+	distance := `"vehicle_state_period"."end_odometer" - "vehicle_state_period"."start_odometer"`
+	want := `WHERE (` + distance + ` >= $1 AND "vehicle_state_period"."energy_used" >= $2) AND (` +
+		distance + ` < $3 OR (` + distance + ` = $4 AND "vehicle_state_period"."id" < $5))`
+	if !strings.HasSuffix(query, want) || strings.Contains(query, "?") {
+		t.Errorf("query = %s, want it ending with %s", query, want)
+	}
+	if len(args) != 5 {
+		t.Errorf("query has %d arguments, want 5", len(args))
+	}
+}
+
+func TestVehicleStatePeriodRepoInTx(t *testing.T) {
+	ctx := context.Background()
+	data := newTestData(t)
+	repo := NewVehicleStatePeriodRepo(data)
+
+	start := time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)
+	p := &biz.VehicleStatePeriod{VehicleID: 1, State: biz.VehicleStateDriving, StartAt: start, Name: "Commute"}
+	if err := repo.Create(ctx, p); err != nil {
+		t.Fatal(err)
+	}
+	fail := errors.New("fail")
+	err := NewTransaction(data).InTx(ctx, func(ctx context.Context) error {
+		p.Name = "Trip"
+		if err := repo.Save(ctx, p); err != nil {
+			return err
+		}
+		if err := repo.Create(ctx, &biz.VehicleStatePeriod{VehicleID: 1, State: biz.VehicleStateDriving, StartAt: start.Add(time.Hour)}); err != nil {
+			return err
+		}
+		return fail
+	})
+	if !errors.Is(err, fail) {
+		t.Fatalf("InTx error = %v, want %v", err, fail)
+	}
+	periods, err := repo.ListByVehicle(ctx, 1, start, start.Add(2*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(periods) != 1 || periods[0].Name != "Commute" {
+		t.Errorf("rolled back transaction left %d periods, want the period unchanged", len(periods))
+	}
+}
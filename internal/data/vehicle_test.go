@@ -0,0 +1,57 @@
+package data
+
+import (
+	"context"
+	"strings"
+	"teslatrack/internal/biz"
+	"testing"
+)
+
+func TestVehicleRepoSaveByVIN(t *testing.T) {
+	ctx := context.Background()
+	repo := NewVehicleRepo(newTestData(t))
+
+	// Raw vehicle payloads exceed the 255 characters of a plain string column.
+	raw := `{"vin":"LRW3E7EK0NC000001","option_codes":"` + strings.Repeat("X", 2048) + `"}`
+	created, err := repo.SaveByVIN(ctx, &biz.Vehicle{VIN: "LRW3E7EK0NC000001", UserID: 1, DisplayName: "Red", State: biz.TeslaStateOnline, RawData: raw})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID == 0 {
+		t.Fatal("SaveByVIN did not set the ID of a new vehicle")
+	}
+	updated, err := repo.SaveByVIN(ctx, &biz.Vehicle{VIN: "LRW3E7EK0NC000001", UserID: 1, DisplayName: "Blue", State: biz.TeslaStateAsleep, RawData: raw})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.ID != created.ID || updated.DisplayName != "Blue" || updated.State != biz.TeslaStateAsleep {
+		t.Errorf("SaveByVIN of an existing vehicle = %+v, want ID %d named Blue asleep", updated, created.ID)
+	}
+
+	// A token of no user must not take the vehicle from its owner.
+	kept, err := repo.SaveByVIN(ctx, &biz.Vehicle{VIN: "LRW3E7EK0NC000001", DisplayName: "Blue", State: biz.TeslaStateAsleep, RawData: raw})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kept.UserID != 1 {
+		t.Errorf("SaveByVIN without a user = owner %d, want 1", kept.UserID)
+	}
+
+	found, err := repo.FindByVIN(ctx, "LRW3E7EK0NC000001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found == nil || found.RawData != raw {
+		t.Errorf("FindByVIN = %+v, want the raw data kept whole", found)
+	}
+	if missing, err := repo.FindOne(ctx, created.ID+1); err != nil || missing != nil {
+		t.Errorf("FindOne of an unknown vehicle = %v, %v, want nil, nil", missing, err)
+	}
+	vehicles, err := repo.FindByUserID(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vehicles) != 1 {
+		t.Errorf("FindByUserID = %d vehicles, want 1", len(vehicles))
+	}
+}
@@ -0,0 +1,42 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"teslatrack/internal/conf"
+	"teslatrack/pkg/jwt"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	jwtmiddleware "github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+)
+
+// publicOperations are the operation prefixes reachable without signing in,
+// including the health, reflection and metadata services of the gRPC server.
+// The authorization redirect is not public, as the Tesla account is authorized for the signed-in user.
+var publicOperations = []string{
+	"/api.teslatrack.v1.Authorize/CreateAuthorize",
+	"/api.teslatrack.v1.Authorize/Callback",
+	"/api.teslatrack.v1.Signin/",
+	"/api.teslatrack.v1.Signup/",
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.",
+	"/kratos.api.Metadata/",
+}
+
+// NewAuthMiddleware requires a valid user access token on every non-public operation.
+func NewAuthMiddleware(c *conf.Server) middleware.Middleware {
+	return selector.Server(
+		jwtmiddleware.Server(
+			jwt.KeyFunc(c.GetAuth().GetJwtSecret()),
+			jwtmiddleware.WithClaims(jwt.NewClaims),
+		),
+	).Match(func(_ context.Context, operation string) bool {
+		for _, prefix := range publicOperations {
+			if strings.HasPrefix(operation, prefix) {
+				return false
+			}
+		}
+		return true
+	}).Build()
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"teslatrack/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+var _ transport.Server = (*EventReader)(nil)
+
+// EventReader is a background server that delivers the events shared by the instances of
+// the service to the subscribers of this instance. Without Redis the events are not shared
+// and the bus delivers them itself.
+type EventReader struct {
+	bus      *biz.EventBus
+	stop     chan struct{}
+	stopOnce sync.Once
+	log      *log.Helper
+}
+
+// NewEventReader creates a new EventReader.
+func NewEventReader(bus *biz.EventBus, logger log.Logger) *EventReader {
+	return &EventReader{
+		bus:  bus,
+		stop: make(chan struct{}),
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Start reads the shared events until the reader is stopped.
+func (r *EventReader) Start(ctx context.Context) error {
+	if !r.bus.Shared() {
+		r.log.Info("events not shared, delivered in process")
+		return nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-r.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	r.log.Info("event reader started")
+	return r.bus.Run(ctx)
+}
+
+// Stop stops the event reader.
+func (r *EventReader) Stop(ctx context.Context) error {
+	r.stopOnce.Do(func() { close(r.stop) })
+	return nil
+}
@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"teslatrack/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// eventRecorderBuffer is the number of events buffered while the recorder is saving.
+const eventRecorderBuffer = 256
+
+var _ transport.Server = (*EventRecorder)(nil)
+
+// EventRecorder is a background server that saves the notable events published on the bus
+// for the vehicle timeline. Events relayed from another instance are saved by that instance.
+type EventRecorder struct {
+	bus      *biz.EventBus
+	timeline *biz.TimelineUsecase
+	stop     chan struct{}
+	stopOnce sync.Once
+	log      *log.Helper
+}
+
+// NewEventRecorder creates a new EventRecorder.
+func NewEventRecorder(bus *biz.EventBus, timeline *biz.TimelineUsecase, logger log.Logger) *EventRecorder {
+	return &EventRecorder{
+		bus:      bus,
+		timeline: timeline,
+		stop:     make(chan struct{}),
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Start saves the published events until the recorder is stopped.
+func (r *EventRecorder) Start(ctx context.Context) error {
+	sub := r.bus.Subscribe(eventRecorderBuffer, func(e *biz.Event) bool {
+		return !e.Relayed && biz.NewVehicleEvent(e) != nil
+	})
+	defer func() {
+		sub.Close()
+		if dropped := sub.Dropped(); dropped > 0 {
+			r.log.Warnw("msg", "events dropped by the recorder", "dropped", dropped)
+		}
+	}()
+	r.log.Info("event recorder started")
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.stop:
+			return nil
+		case e := <-sub.C:
+			if err := r.timeline.Record(ctx, e); err != nil {
+				r.log.Errorw("msg", "recording event failed", "type", e.Type, "vehicleID", e.VehicleID, "err", err)
+			}
+		}
+	}
+}
+
+// Stop stops the event recorder.
+func (r *EventRecorder) Stop(ctx context.Context) error {
+	r.stopOnce.Do(func() { close(r.stop) })
+	return nil
+}
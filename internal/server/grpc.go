@@ -1,21 +1,45 @@
 package server
 
 import (
-	v1 "teslatrack/api/helloworld/v1"
+	v1 "teslatrack/api/teslatrack/v1"
 	"teslatrack/internal/conf"
 	"teslatrack/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, logger log.Logger) *grpc.Server {
+// Besides the teslatrack services it serves gRPC health checking, reflection
+// and the kratos metadata service.
+func NewGRPCServer(
+	c *conf.Server,
+	logger log.Logger,
+	mp metric.MeterProvider,
+	tp trace.TracerProvider,
+	authorize *service.AuthorizeService,
+	signin *service.SigninService,
+	signup *service.SignupService,
+	geofence *service.GeofenceService,
+	tariff *service.TariffService,
+	analytics *service.AnalyticsService,
+	statistics *service.StatisticsService,
+	user *service.UserService,
+	software *service.SoftwareService,
+	tire *service.TireService,
+	timeline *service.TimelineService,
+	vehicle *service.VehicleService,
+	drive *service.DriveService,
+	charging *service.ChargingService,
+	live *service.LiveService,
+) *grpc.Server {
+	// Share the middleware of the HTTP server, streaming RPCs included.
+	mw := newMiddleware(c, mp, tp, logger)
 	var opts = []grpc.ServerOption{
-		grpc.Middleware(
-			recovery.Recovery(),
-		),
+		grpc.Middleware(mw...),
+		grpc.StreamInterceptor(streamMiddleware(mw...)),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -27,6 +51,20 @@ func NewGRPCServer(c *conf.Server, greeter *service.GreeterService, logger log.L
 		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
 	}
 	srv := grpc.NewServer(opts...)
-	v1.RegisterGreeterServer(srv, greeter)
+	v1.RegisterAuthorizeServer(srv, authorize)
+	v1.RegisterSigninServer(srv, signin)
+	v1.RegisterSignupServer(srv, signup)
+	v1.RegisterGeofenceServer(srv, geofence)
+	v1.RegisterTariffServer(srv, tariff)
+	v1.RegisterAnalyticsServer(srv, analytics)
+	v1.RegisterStatisticsServer(srv, statistics)
+	v1.RegisterUserServer(srv, user)
+	v1.RegisterSoftwareServer(srv, software)
+	v1.RegisterTireServer(srv, tire)
+	v1.RegisterTimelineServer(srv, timeline)
+	v1.RegisterVehicleServer(srv, vehicle)
+	v1.RegisterDriveServer(srv, drive)
+	v1.RegisterChargingServer(srv, charging)
+	v1.RegisterLiveServer(srv, live)
 	return srv
 }
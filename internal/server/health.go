@@ -0,0 +1,273 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"teslatrack/internal/biz"
+	"teslatrack/internal/conf"
+	"teslatrack/pkg/sdnotify"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	kratoshttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+const (
+	// healthTimeout bounds the checks of a probe.
+	healthTimeout = 5 * time.Second
+	// readyInterval is the time between two readiness checks while waiting to notify systemd.
+	readyInterval = 2 * time.Second
+)
+
+var _ transport.Server = (*Health)(nil)
+
+// Health serves the liveness, readiness and diagnostics endpoints, and notifies systemd,
+// when run as a Type=notify service, once ready and then as a watchdog while live.
+//
+// The service is live while the poller is not stuck, so restarting it helps. It is ready
+// while its dependencies are reachable. The endpoints are outside the middleware:
+// the probes report the check statuses only, the diagnostics require the admin token.
+type Health struct {
+	health      *biz.HealthUsecase
+	poller      *Poller
+	adminToken  string
+	pollerStale time.Duration
+	stop        chan struct{}
+	stopOnce    sync.Once
+	log         *log.Helper
+}
+
+// healthResponse is the body of the probes and the diagnostics.
+type healthResponse struct {
+	Status   string            `json:"status"`
+	Checks   map[string]string `json:"checks,omitempty"`
+	Details  []*healthCheck    `json:"details,omitempty"`
+	Accounts []*accountHealth  `json:"accounts,omitempty"`
+}
+
+type healthCheck struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+type accountHealth struct {
+	TokenID         int64      `json:"token_id"`
+	UserID          int        `json:"user_id"`
+	Scope           string     `json:"scope"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	ExpiresAt       *time.Time `json:"expires_at,omitempty"`
+	Expired         bool       `json:"expired"`
+	HasRefreshToken bool       `json:"has_refresh_token"`
+	LastSuccess     *time.Time `json:"last_success,omitempty"`
+	LastFailure     *time.Time `json:"last_failure,omitempty"`
+	LastReason      string     `json:"last_reason,omitempty"`
+}
+
+// NewHealth creates a new Health from the server configuration.
+func NewHealth(c *conf.Server, health *biz.HealthUsecase, poller *Poller, logger log.Logger) *Health {
+	h := &Health{
+		health:      health,
+		poller:      poller,
+		adminToken:  c.GetHealth().GetAdminToken(),
+		pollerStale: 3 * poller.Interval(),
+		stop:        make(chan struct{}),
+		log:         log.NewHelper(logger),
+	}
+	if d := c.GetHealth().GetPollerStale(); d != nil {
+		h.pollerStale = d.AsDuration()
+	}
+	return h
+}
+
+// RegisterHTTP registers /healthz, /readyz and /admin/diagnostics.
+func (h *Health) RegisterHTTP(srv *kratoshttp.Server) {
+	srv.HandleFunc("/healthz", h.serveLive)
+	srv.HandleFunc("/readyz", h.serveReady)
+	srv.HandleFunc("/admin/diagnostics", h.serveDiagnostics)
+}
+
+// Live checks whether the poller is stuck.
+func (h *Health) Live() []*biz.HealthCheck {
+	return []*biz.HealthCheck{h.checkPoller()}
+}
+
+// Ready checks the dependencies and the poller.
+func (h *Health) Ready(ctx context.Context) []*biz.HealthCheck {
+	ctx, cancel := context.WithTimeout(ctx, healthTimeout)
+	defer cancel()
+	return append(h.health.Check(ctx), h.checkPoller())
+}
+
+func (h *Health) checkPoller() *biz.HealthCheck {
+	if !h.poller.Enabled() {
+		return &biz.HealthCheck{Name: "poller", Status: biz.HealthSkip, Message: "disabled"}
+	}
+	heartbeat := h.poller.Heartbeat()
+	switch {
+	case heartbeat.IsZero():
+		return &biz.HealthCheck{Name: "poller", Status: biz.HealthSkip, Message: "not started"}
+	case time.Since(heartbeat) > h.pollerStale:
+		return &biz.HealthCheck{Name: "poller", Status: biz.HealthFail, Message: "no collection since " + heartbeat.Format(time.RFC3339)}
+	}
+	return &biz.HealthCheck{Name: "poller", Status: biz.HealthOK, Message: "last heartbeat at " + heartbeat.Format(time.RFC3339)}
+}
+
+func (h *Health) serveLive(w http.ResponseWriter, _ *http.Request) {
+	writeHealth(w, newHealthResponse(h.Live(), false))
+}
+
+func (h *Health) serveReady(w http.ResponseWriter, r *http.Request) {
+	writeHealth(w, newHealthResponse(h.Ready(r.Context()), false))
+}
+
+// serveDiagnostics reports the checks with their messages and the token status of every account.
+func (h *Health) serveDiagnostics(w http.ResponseWriter, r *http.Request) {
+	if h.adminToken == "" {
+		http.NotFound(w, r)
+		return
+	}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(h.adminToken)) != 1 {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	resp := newHealthResponse(h.Ready(r.Context()), true)
+	accounts, err := h.health.Accounts(r.Context())
+	if err != nil {
+		h.log.WithContext(r.Context()).Errorw("msg", "list accounts failed", "err", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	for _, a := range accounts {
+		resp.Accounts = append(resp.Accounts, &accountHealth{
+			TokenID:         a.TokenID,
+			UserID:          a.UserID,
+			Scope:           a.Scope,
+			CreatedAt:       a.CreatedAt,
+			UpdatedAt:       a.UpdatedAt,
+			ExpiresAt:       timeOrNil(a.ExpiresAt),
+			Expired:         a.Expired,
+			HasRefreshToken: a.HasRefreshToken,
+			LastSuccess:     timeOrNil(a.Status.LastSuccess),
+			LastFailure:     timeOrNil(a.Status.LastFailure),
+			LastReason:      a.Status.LastReason,
+		})
+	}
+	// The report itself succeeded, whatever the checks.
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// newHealthResponse summarizes checks: fail if any fails, warn if any warns, ok otherwise.
+// The messages are only included in the details.
+func newHealthResponse(checks []*biz.HealthCheck, details bool) *healthResponse {
+	resp := &healthResponse{Status: biz.HealthOK, Checks: make(map[string]string, len(checks))}
+	for _, c := range checks {
+		resp.Checks[c.Name] = c.Status
+		if details {
+			resp.Details = append(resp.Details, &healthCheck{Name: c.Name, Status: c.Status, Message: c.Message})
+		}
+		switch {
+		case c.Status == biz.HealthFail:
+			resp.Status = biz.HealthFail
+		case c.Status == biz.HealthWarn && resp.Status == biz.HealthOK:
+			resp.Status = biz.HealthWarn
+		}
+	}
+	return resp
+}
+
+// writeHealth writes the response of a probe, with status 503 if a check fails.
+func writeHealth(w http.ResponseWriter, resp *healthResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if resp.Status == biz.HealthFail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func timeOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// Start notifies systemd once the service is ready, then keeps its watchdog from
+// restarting the service while it is live. It does nothing outside systemd.
+func (h *Health) Start(ctx context.Context) error {
+	if !sdnotify.Enabled() {
+		return nil
+	}
+	if !h.waitReady(ctx) {
+		return nil
+	}
+	if err := sdnotify.Notify(sdnotify.Ready); err != nil {
+		h.log.Errorw("msg", "systemd notify failed", "err", err)
+	}
+	interval := sdnotify.WatchdogInterval()
+	if interval == 0 {
+		return nil
+	}
+	h.log.Infow("msg", "systemd watchdog started", "interval", interval)
+	ticker := time.NewTicker(interval / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-h.stop:
+			return nil
+		case <-ticker.C:
+		}
+		// Without pings the watchdog restarts the service.
+		if resp := newHealthResponse(h.Live(), false); resp.Status == biz.HealthFail {
+			h.log.Warnw("msg", "service not live, skipping the watchdog ping", "checks", resp.Checks)
+			continue
+		}
+		if err := sdnotify.Notify(sdnotify.Watchdog); err != nil {
+			h.log.Errorw("msg", "systemd watchdog ping failed", "err", err)
+		}
+	}
+}
+
+// waitReady waits until the service is ready, reporting false if stopped before.
+func (h *Health) waitReady(ctx context.Context) bool {
+	ticker := time.NewTicker(readyInterval)
+	defer ticker.Stop()
+	for {
+		resp := newHealthResponse(h.Ready(ctx), false)
+		if resp.Status != biz.HealthFail {
+			return true
+		}
+		h.log.Infow("msg", "waiting for the service to be ready", "checks", resp.Checks)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-h.stop:
+			return false
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop tells systemd the service is stopping.
+func (h *Health) Stop(ctx context.Context) error {
+	h.stopOnce.Do(func() {
+		close(h.stop)
+		if sdnotify.Enabled() {
+			_ = sdnotify.Notify(sdnotify.Stopping)
+		}
+	})
+	return nil
+}
@@ -7,26 +7,42 @@ import (
 	"teslatrack/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-kratos/kratos/v2/middleware/logging"
-	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	kratoshttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // NewHTTPServer new an HTTP server.
 func NewHTTPServer(
 	c *conf.Server,
 	logger log.Logger,
+	mp metric.MeterProvider,
+	tp trace.TracerProvider,
 	redirector *Redirector,
+	health *Health,
 	partnerUsecase *biz.PartnerUsecase,
 	authorize *service.AuthorizeService,
+	signin *service.SigninService,
+	signup *service.SignupService,
+	geofence *service.GeofenceService,
+	route *service.RouteService,
+	tariff *service.TariffService,
+	analytics *service.AnalyticsService,
+	statistics *service.StatisticsService,
+	user *service.UserService,
+	software *service.SoftwareService,
+	tire *service.TireService,
+	timeline *service.TimelineService,
+	vehicle *service.VehicleService,
+	drive *service.DriveService,
+	charging *service.ChargingService,
+	live *service.LiveService,
 ) *kratoshttp.Server {
 	// Define server options.
 	var opts = []kratoshttp.ServerOption{
-		// Add middleware for recovery and logging.
-		kratoshttp.Middleware(
-			recovery.Recovery(),
-			logging.Server(logger),
-		),
+		// Add the middleware shared with the gRPC server: recovery, tracing, metrics, logging, auth and validation.
+		kratoshttp.Middleware(newMiddleware(c, mp, tp, logger)...),
 		// Add a filter for redirection.
 		kratoshttp.Filter(redirector.RedirectFilter),
 	}
@@ -44,11 +60,46 @@ func NewHTTPServer(
 	// Create a new HTTP server.
 	srv := kratoshttp.NewServer(opts...)
 
+	// Register the Prometheus metrics endpoint, outside the middleware and so without auth.
+	srv.Handle("/metrics", promhttp.Handler())
+	// Register the health probes and the admin diagnostics, outside the middleware as well.
+	health.RegisterHTTP(srv)
+
 	// Register static file server.
 	// srv.HandlePrefix("/", NewStaticServer())
 
 	// Register the Authorize service.
 	v1.RegisterAuthorizeHTTPServer(srv, authorize)
+	// Register the Signin service.
+	v1.RegisterSigninHTTPServer(srv, signin)
+	// Register the Signup service.
+	v1.RegisterSignupHTTPServer(srv, signup)
+	// Register the Geofence service.
+	v1.RegisterGeofenceHTTPServer(srv, geofence)
+	// Register the Tariff service.
+	v1.RegisterTariffHTTPServer(srv, tariff)
+	// Register the Analytics service.
+	v1.RegisterAnalyticsHTTPServer(srv, analytics)
+	// Register the Statistics service.
+	v1.RegisterStatisticsHTTPServer(srv, statistics)
+	// Register the User service.
+	v1.RegisterUserHTTPServer(srv, user)
+	// Register the Software service.
+	v1.RegisterSoftwareHTTPServer(srv, software)
+	// Register the Tire service.
+	v1.RegisterTireHTTPServer(srv, tire)
+	// Register the Timeline service.
+	v1.RegisterTimelineHTTPServer(srv, timeline)
+	// Register the Vehicle service.
+	v1.RegisterVehicleHTTPServer(srv, vehicle)
+	// Register the Drive service.
+	v1.RegisterDriveHTTPServer(srv, drive)
+	// Register the Charging service.
+	v1.RegisterChargingHTTPServer(srv, charging)
+	// Register the route export endpoints.
+	route.RegisterHTTP(srv)
+	// Register the live event endpoints.
+	live.RegisterHTTP(srv)
 
 	// Initialize partner usecase.
 	if err := partnerUsecase.Initialize(); err != nil {
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"context"
+	"teslatrack/internal/biz"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// holdLock extends a lock to ttl every third of ttl until done is closed, so that another
+// instance does not acquire it while a job runs longer than ttl.
+func holdLock(ctx context.Context, lock biz.Lock, ttl time.Duration, done <-chan struct{}, logger *log.Helper) {
+	ticker := time.NewTicker(ttl / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-done:
+			return
+		case <-ticker.C:
+			ok, err := lock.Extend(ctx, ttl)
+			if err != nil {
+				logger.Warnw("msg", "extend lock failed", "err", err)
+				continue
+			}
+			if !ok {
+				logger.Warn("lock expired while running")
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"teslatrack/internal/biz"
+	"teslatrack/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/metrics"
+	"github.com/go-kratos/kratos/v2/transport"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+const (
+	// scopeName is the instrumentation scope of the server metrics and spans.
+	scopeName = "teslatrack/internal/server"
+	// vehicleMetricsBuffer is the number of snapshots buffered while the gauges are updated.
+	vehicleMetricsBuffer = 64
+)
+
+// NewMeterProvider creates the meter provider of the service, exported in the Prometheus
+// format at /metrics of the HTTP server with the teslatrack_ prefix. It is also installed
+// as the global provider, which the Fleet API client records its requests with.
+func NewMeterProvider() (metric.MeterProvider, func(), error) {
+	exporter, err := prometheus.New(prometheus.WithNamespace("teslatrack"), prometheus.WithoutScopeInfo())
+	if err != nil {
+		return nil, nil, err
+	}
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(exporter))
+	otel.SetMeterProvider(mp)
+	cleanup := func() {
+		_ = mp.Shutdown(context.Background())
+	}
+	return mp, cleanup, nil
+}
+
+// metricsMiddleware records the latency and status of the requests per operation.
+func metricsMiddleware(mp metric.MeterProvider) middleware.Middleware {
+	meter := mp.Meter(scopeName)
+	seconds, _ := meter.Float64Histogram("server.request.duration",
+		metric.WithDescription("Request latency by transport and operation."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10))
+	requests, _ := meter.Int64Counter("server.requests",
+		metric.WithDescription("Requests by transport, operation, status code and error reason."))
+	return metrics.Server(metrics.WithSeconds(seconds), metrics.WithRequests(requests))
+}
+
+var _ transport.Server = (*VehicleMetrics)(nil)
+
+// VehicleMetrics is a background server exporting the latest data of every vehicle as gauges.
+// It is opt-in, as the vehicles multiply the exported series.
+type VehicleMetrics struct {
+	bus      *biz.EventBus
+	meter    metric.Meter
+	enabled  bool
+	stop     chan struct{}
+	stopOnce sync.Once
+	log      *log.Helper
+
+	mu       sync.Mutex
+	vehicles map[int]*vehicleGauges
+}
+
+// vehicleGauges holds the latest values of a vehicle.
+type vehicleGauges struct {
+	attrs        metric.MeasurementOption
+	batteryLevel int64
+	batteryRange float64
+	odometer     float64
+	chargerPower int64
+	insideTemp   float64
+	outsideTemp  float64
+}
+
+// NewVehicleMetrics creates a new VehicleMetrics from the server configuration.
+func NewVehicleMetrics(c *conf.Server, bus *biz.EventBus, mp metric.MeterProvider, logger log.Logger) *VehicleMetrics {
+	return &VehicleMetrics{
+		bus:      bus,
+		meter:    mp.Meter(scopeName),
+		enabled:  c.GetMetrics().GetVehicles(),
+		stop:     make(chan struct{}),
+		log:      log.NewHelper(logger),
+		vehicles: make(map[int]*vehicleGauges),
+	}
+}
+
+// Start updates the gauges from the vehicle snapshots until stopped.
+func (m *VehicleMetrics) Start(ctx context.Context) error {
+	if !m.enabled {
+		return nil
+	}
+	reg, err := m.register()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = reg.Unregister() }()
+	sub := m.bus.Subscribe(vehicleMetricsBuffer, func(e *biz.Event) bool {
+		return e.Type == biz.EventVehicleSnapshot
+	})
+	defer sub.Close()
+	m.log.Info("vehicle metrics started")
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-m.stop:
+			return nil
+		case e := <-sub.C:
+			m.update(e)
+		}
+	}
+}
+
+// Stop stops the vehicle metrics.
+func (m *VehicleMetrics) Stop(ctx context.Context) error {
+	m.stopOnce.Do(func() { close(m.stop) })
+	return nil
+}
+
+// update keeps the values of a snapshot carrying vehicle data. Sleeping vehicles keep
+// their last known values.
+func (m *VehicleMetrics) update(e *biz.Event) {
+	latest, ok := e.Payload.(*biz.VehicleLatestState)
+	if !ok || latest.Vehicle == nil || latest.Snapshot == nil {
+		return
+	}
+	s := latest.Snapshot
+	g := &vehicleGauges{
+		attrs: metric.WithAttributes(
+			attribute.Int("vehicle_id", latest.Vehicle.ID),
+			attribute.String("name", latest.Vehicle.DisplayName),
+		),
+		batteryLevel: int64(s.BatteryLevel),
+		batteryRange: s.BatteryRange,
+		odometer:     s.Odometer,
+		chargerPower: int64(s.ChargerPower),
+		insideTemp:   s.InsideTemp,
+		outsideTemp:  s.OutsideTemp,
+	}
+	m.mu.Lock()
+	m.vehicles[latest.Vehicle.ID] = g
+	m.mu.Unlock()
+}
+
+// register registers the gauges, observed from the kept values on every scrape.
+// Units the Prometheus exporter does not map to a suffix are part of the names.
+func (m *VehicleMetrics) register() (metric.Registration, error) {
+	batteryLevel, err := m.meter.Int64ObservableGauge("vehicle.battery_level",
+		metric.WithDescription("State of charge of the vehicle."), metric.WithUnit("%"))
+	if err != nil {
+		return nil, err
+	}
+	batteryRange, err := m.meter.Float64ObservableGauge("vehicle.range_km",
+		metric.WithDescription("Rated range of the vehicle."), metric.WithUnit("km"))
+	if err != nil {
+		return nil, err
+	}
+	odometer, err := m.meter.Float64ObservableGauge("vehicle.odometer_km",
+		metric.WithDescription("Odometer reading of the vehicle."), metric.WithUnit("km"))
+	if err != nil {
+		return nil, err
+	}
+	chargerPower, err := m.meter.Int64ObservableGauge("vehicle.charger_power_kw",
+		metric.WithDescription("Charger power of the vehicle."), metric.WithUnit("kW"))
+	if err != nil {
+		return nil, err
+	}
+	insideTemp, err := m.meter.Float64ObservableGauge("vehicle.inside_temperature",
+		metric.WithDescription("Inside temperature of the vehicle."), metric.WithUnit("Cel"))
+	if err != nil {
+		return nil, err
+	}
+	outsideTemp, err := m.meter.Float64ObservableGauge("vehicle.outside_temperature",
+		metric.WithDescription("Outside temperature of the vehicle."), metric.WithUnit("Cel"))
+	if err != nil {
+		return nil, err
+	}
+	return m.meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		for _, g := range m.vehicles {
+			o.ObserveInt64(batteryLevel, g.batteryLevel, g.attrs)
+			o.ObserveFloat64(batteryRange, g.batteryRange, g.attrs)
+			o.ObserveFloat64(odometer, g.odometer, g.attrs)
+			o.ObserveInt64(chargerPower, g.chargerPower, g.attrs)
+			o.ObserveFloat64(insideTemp, g.insideTemp, g.attrs)
+			o.ObserveFloat64(outsideTemp, g.outsideTemp, g.attrs)
+		}
+		return nil
+	}, batteryLevel, batteryRange, odometer, chargerPower, insideTemp, outsideTemp)
+}
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+	"teslatrack/internal/conf"
+	"teslatrack/pkg/validation"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/logging"
+	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	googlegrpc "google.golang.org/grpc"
+)
+
+// newMiddleware returns the middleware chain shared by the HTTP and gRPC servers.
+// Tracing and metrics come before auth and validation so that rejected requests are
+// traced and counted too, and the log lines of a request carry its trace ID.
+func newMiddleware(c *conf.Server, mp metric.MeterProvider, tp trace.TracerProvider, logger log.Logger) []middleware.Middleware {
+	return []middleware.Middleware{
+		recovery.Recovery(),
+		tracing.Server(tracing.WithTracerProvider(tp)),
+		metricsMiddleware(mp),
+		logging.Server(logger),
+		NewAuthMiddleware(c),
+		validation.Server(),
+	}
+}
+
+// streamMiddleware applies a middleware chain to streaming RPCs, which the kratos
+// gRPC server runs without its middleware. The handlers see a nil request,
+// so streaming RPCs validate their requests themselves.
+func streamMiddleware(m ...middleware.Middleware) googlegrpc.StreamServerInterceptor {
+	chain := middleware.Chain(m...)
+	return func(srv any, ss googlegrpc.ServerStream, _ *googlegrpc.StreamServerInfo, handler googlegrpc.StreamHandler) error {
+		_, err := chain(func(ctx context.Context, _ any) (any, error) {
+			return nil, handler(srv, grpc.NewWrappedStream(ctx, ss))
+		})(ss.Context(), nil)
+		return err
+	}
+}
@@ -0,0 +1,412 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"teslatrack/internal/biz"
+	"teslatrack/internal/conf"
+	"teslatrack/pkg/hass"
+	"teslatrack/pkg/tpms"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+const (
+	// mqttBuffer is the number of snapshots buffered while the bridge is publishing.
+	mqttBuffer = 64
+	// mqttCommandTimeout bounds a command sent from Home Assistant, including the refresh after it.
+	mqttCommandTimeout = 30 * time.Second
+)
+
+var _ transport.Server = (*MQTTBridge)(nil)
+
+// MQTTBridge is a background server that publishes the latest state of the vehicles of the configured
+// user to an MQTT broker, announced to Home Assistant with MQTT discovery. With commands enabled, locks,
+// climate, charging and wake up are controllable and their commands are sent to the vehicles of the user.
+type MQTTBridge struct {
+	bus      *biz.EventBus
+	vehicle  *biz.VehicleUsecase
+	tire     *biz.TireUsecase
+	command  *biz.VehicleCommandUsecase
+	client   *hass.Client
+	commands bool
+	userID   int
+	stop     chan struct{}
+	stopOnce sync.Once
+	log      *log.Helper
+
+	// mu guards devices, the published vehicles by node ID, republished on reconnect.
+	mu      sync.Mutex
+	devices map[string]*mqttDevice
+}
+
+// mqttDevice is a vehicle published to Home Assistant with its latest states.
+type mqttDevice struct {
+	device   hass.Device
+	state    *mqttState
+	location *mqttLocation
+	update   *mqttUpdate
+}
+
+// mqttState is the JSON state of a vehicle read by the sensors. Distances are in km,
+// temperatures in °C and tire pressures in bar, null when unknown.
+type mqttState struct {
+	State              string     `json:"state"`
+	BatteryLevel       *int       `json:"battery_level"`
+	UsableBatteryLevel *int       `json:"usable_battery_level"`
+	Range              *float64   `json:"range"`
+	ChargingState      string     `json:"charging_state"`
+	ChargerPower       *int       `json:"charger_power"`
+	Locked             bool       `json:"locked"`
+	ClimateOn          bool       `json:"climate_on"`
+	InsideTemp         *float64   `json:"inside_temp"`
+	OutsideTemp        *float64   `json:"outside_temp"`
+	Odometer           *float64   `json:"odometer"`
+	TpmsFl             *float64   `json:"tpms_fl"`
+	TpmsFr             *float64   `json:"tpms_fr"`
+	TpmsRl             *float64   `json:"tpms_rl"`
+	TpmsRr             *float64   `json:"tpms_rr"`
+	SoftwareVersion    string     `json:"software_version"`
+	LastSeen           *time.Time `json:"last_seen"`
+}
+
+// mqttLocation is the JSON attributes of the device tracker.
+type mqttLocation struct {
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	GPSAccuracy int     `json:"gps_accuracy"`
+	Heading     int     `json:"heading"`
+	Speed       float64 `json:"speed"`
+}
+
+// mqttUpdate is the JSON state of the software update entity.
+type mqttUpdate struct {
+	InstalledVersion string `json:"installed_version"`
+	LatestVersion    string `json:"latest_version"`
+	InProgress       bool   `json:"in_progress"`
+}
+
+// NewMQTTBridge creates a new MQTTBridge from the server configuration.
+func NewMQTTBridge(
+	c *conf.Server,
+	bus *biz.EventBus,
+	vehicle *biz.VehicleUsecase,
+	tire *biz.TireUsecase,
+	command *biz.VehicleCommandUsecase,
+	logger log.Logger,
+) *MQTTBridge {
+	b := &MQTTBridge{
+		bus:     bus,
+		vehicle: vehicle,
+		tire:    tire,
+		command: command,
+		stop:    make(chan struct{}),
+		log:     log.NewHelper(logger),
+		devices: make(map[string]*mqttDevice),
+	}
+	if m := c.GetMqtt(); m.GetEnabled() {
+		b.commands = m.Commands
+		b.userID = int(m.UserId)
+		b.client = hass.NewClient(hass.Options{
+			Broker:          m.Broker,
+			ClientID:        m.ClientId,
+			Username:        m.Username,
+			Password:        m.Password,
+			TopicPrefix:     m.TopicPrefix,
+			DiscoveryPrefix: m.DiscoveryPrefix,
+			OnConnect:       b.republish,
+		})
+	}
+	return b
+}
+
+// Start publishes the snapshots of the vehicles until the bridge is stopped.
+func (b *MQTTBridge) Start(ctx context.Context) error {
+	if b.client == nil {
+		b.log.Info("mqtt bridge disabled")
+		return nil
+	}
+	if b.userID == 0 {
+		return errors.New("mqtt: user_id is required to publish and control the vehicles of a user")
+	}
+	sub := b.bus.Subscribe(mqttBuffer, func(e *biz.Event) bool {
+		return e.Type == biz.EventVehicleSnapshot && e.UserID == b.userID
+	})
+	defer sub.Close()
+	if b.commands {
+		if err := b.client.HandleCommands(b.handleCommand); err != nil {
+			return err
+		}
+	}
+	// The client keeps retrying until the broker is reachable; snapshots received meanwhile
+	// are kept and published once connected.
+	go func() {
+		if err := b.client.Connect(ctx); err != nil && ctx.Err() == nil {
+			b.log.Errorw("msg", "mqtt connect failed", "err", err)
+		}
+	}()
+	b.log.Infow("msg", "mqtt bridge started", "userID", b.userID, "commands", b.commands)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-b.stop:
+			return nil
+		case e := <-sub.C:
+			if err := b.publish(ctx, e); err != nil {
+				b.log.Errorw("msg", "mqtt publish failed", "vehicleID", e.VehicleID, "err", err)
+			}
+		}
+	}
+}
+
+// Stop marks the vehicles unavailable and stops the bridge.
+func (b *MQTTBridge) Stop(ctx context.Context) error {
+	b.stopOnce.Do(func() {
+		close(b.stop)
+		if b.client != nil {
+			b.client.Close()
+		}
+	})
+	return nil
+}
+
+// publish publishes the state of a vehicle from a snapshot event, announcing the vehicle first.
+func (b *MQTTBridge) publish(ctx context.Context, e *biz.Event) error {
+	latest, ok := e.Payload.(*biz.VehicleLatestState)
+	if !ok || latest.Vehicle == nil {
+		return nil
+	}
+	if latest.Snapshot == nil {
+		// Keep publishing the last known data while the vehicle sleeps. The event is shared
+		// with the other subscribers, so it is copied rather than modified.
+		stored, err := b.vehicle.LatestState(ctx, e.UserID, e.VehicleID, false)
+		if err != nil {
+			return err
+		}
+		latest = &biz.VehicleLatestState{Vehicle: latest.Vehicle, LastSeenAt: latest.LastSeenAt, Snapshot: stored.Snapshot}
+	}
+	var tires *biz.TirePressure
+	if status, err := b.tire.Status(ctx, e.UserID, e.VehicleID); err != nil {
+		b.log.Warnw("msg", "tire status unavailable", "vehicleID", e.VehicleID, "err", err)
+	} else {
+		tires = status.Latest
+	}
+
+	nodeID := strings.ToLower(latest.Vehicle.VIN)
+	d := newMQTTDevice(latest, tires)
+	b.mu.Lock()
+	prev := b.devices[nodeID]
+	b.devices[nodeID] = d
+	b.mu.Unlock()
+	if !b.client.Connected() {
+		return nil
+	}
+	announce := prev == nil || prev.device.Name != d.device.Name || prev.device.SWVersion != d.device.SWVersion
+	return b.publishDevice(nodeID, d, announce)
+}
+
+// republish announces and publishes every known vehicle again after a reconnect.
+func (b *MQTTBridge) republish() {
+	b.mu.Lock()
+	devices := make(map[string]*mqttDevice, len(b.devices))
+	for nodeID, d := range b.devices {
+		devices[nodeID] = d
+	}
+	b.mu.Unlock()
+	for nodeID, d := range devices {
+		if err := b.publishDevice(nodeID, d, true); err != nil {
+			b.log.Errorw("msg", "mqtt republish failed", "node", nodeID, "err", err)
+		}
+	}
+}
+
+// publishDevice publishes the states of a vehicle, with its discovery configs if announce is set.
+func (b *MQTTBridge) publishDevice(nodeID string, d *mqttDevice, announce bool) error {
+	if announce {
+		if err := b.client.Discover(nodeID, d.device, b.entities(nodeID)...); err != nil {
+			return err
+		}
+	}
+	if err := b.client.Publish(nodeID, "state", d.state); err != nil {
+		return err
+	}
+	if d.location != nil {
+		if err := b.client.Publish(nodeID, "location", d.location); err != nil {
+			return err
+		}
+	}
+	if d.update != nil {
+		return b.client.Publish(nodeID, "update", d.update)
+	}
+	return nil
+}
+
+// handleCommand sends a command of a Home Assistant entity to the vehicle, if it belongs to the user of the bridge.
+func (b *MQTTBridge) handleCommand(nodeID, objectID, payload string) {
+	cmd := mqttCommand(objectID, payload)
+	if cmd == nil {
+		b.log.Warnw("msg", "unknown mqtt command", "node", nodeID, "entity", objectID, "payload", payload)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), mqttCommandTimeout)
+	defer cancel()
+	if err := b.command.SendByVIN(ctx, b.userID, strings.ToUpper(nodeID), cmd); err != nil {
+		b.log.Errorw("msg", "mqtt command failed", "node", nodeID, "command", cmd.Name, "err", err)
+	}
+}
+
+// mqttCommand maps the payload sent to an entity to a vehicle command, nil if unknown.
+func mqttCommand(objectID, payload string) *biz.VehicleCommand {
+	commands := map[string]map[string]string{
+		"lock":     {"LOCK": biz.CommandLock, "UNLOCK": biz.CommandUnlock},
+		"climate":  {"ON": biz.CommandClimateOn, "OFF": biz.CommandClimateOff},
+		"charging": {"ON": biz.CommandChargeStart, "OFF": biz.CommandChargeStop},
+		"wake_up":  {"PRESS": biz.CommandWakeUp},
+	}
+	if objectID == "charge_limit" {
+		limit, err := strconv.ParseFloat(payload, 64)
+		if err != nil {
+			return nil
+		}
+		return &biz.VehicleCommand{Name: biz.CommandSetChargeLimit, ChargeLimit: int(limit)}
+	}
+	if name, ok := commands[objectID][payload]; ok {
+		return &biz.VehicleCommand{Name: name}
+	}
+	return nil
+}
+
+// newMQTTDevice builds the published states of a vehicle.
+func newMQTTDevice(latest *biz.VehicleLatestState, tires *biz.TirePressure) *mqttDevice {
+	veh := latest.Vehicle
+	d := &mqttDevice{
+		device: hass.Device{
+			Identifiers:  []string{veh.VIN},
+			Name:         veh.DisplayName,
+			Manufacturer: "Tesla",
+		},
+		state: &mqttState{State: veh.State, LastSeen: latest.LastSeenAt},
+	}
+	if d.device.Name == "" {
+		d.device.Name = veh.VIN
+	}
+	if s := latest.Snapshot; s != nil {
+		version, _, _ := strings.Cut(s.CarVersion, " ")
+		d.device.SWVersion = version
+		d.state.BatteryLevel = &s.BatteryLevel
+		d.state.UsableBatteryLevel = &s.UsableBatteryLevel
+		d.state.Range = &s.BatteryRange
+		d.state.ChargingState = s.ChargingState
+		d.state.ChargerPower = &s.ChargerPower
+		d.state.Locked = s.Locked
+		d.state.ClimateOn = s.ClimateOn
+		d.state.InsideTemp = &s.InsideTemp
+		d.state.OutsideTemp = &s.OutsideTemp
+		d.state.Odometer = &s.Odometer
+		d.state.SoftwareVersion = version
+		if s.Latitude != 0 || s.Longitude != 0 {
+			d.location = &mqttLocation{Latitude: s.Latitude, Longitude: s.Longitude, GPSAccuracy: 10, Heading: s.Heading, Speed: s.Speed}
+		}
+		d.update = &mqttUpdate{InstalledVersion: version, LatestVersion: version}
+		if s.SoftwareUpdateVersion != "" {
+			d.update.LatestVersion = s.SoftwareUpdateVersion
+			d.update.InProgress = s.SoftwareUpdateStatus == "installing"
+		}
+	}
+	if tires != nil {
+		pressure := func(w tpms.Wheel) *float64 {
+			if p := tires.Pressure[w]; p > 0 {
+				return &p
+			}
+			return nil
+		}
+		d.state.TpmsFl = pressure(tpms.FrontLeft)
+		d.state.TpmsFr = pressure(tpms.FrontRight)
+		d.state.TpmsRl = pressure(tpms.RearLeft)
+		d.state.TpmsRr = pressure(tpms.RearRight)
+	}
+	return d
+}
+
+// entities returns the Home Assistant entities of a vehicle.
+func (b *MQTTBridge) entities(nodeID string) []hass.Entity {
+	sensor := func(objectID, name, field string, config map[string]any) hass.Entity {
+		if config == nil {
+			config = map[string]any{}
+		}
+		config["value_template"] = "{{ value_json." + field + " }}"
+		return hass.Entity{Component: "sensor", ObjectID: objectID, Name: name, StateTopic: "state", Config: config}
+	}
+	measurement := func(deviceClass, unit string) map[string]any {
+		return map[string]any{"device_class": deviceClass, "unit_of_measurement": unit, "state_class": "measurement"}
+	}
+	entities := []hass.Entity{
+		sensor("state", "State", "state", map[string]any{"icon": "mdi:car"}),
+		sensor("battery_level", "Battery", "battery_level", measurement("battery", "%")),
+		sensor("usable_battery_level", "Usable battery", "usable_battery_level", measurement("battery", "%")),
+		sensor("range", "Range", "range", map[string]any{"device_class": "distance", "unit_of_measurement": "km", "suggested_display_precision": 0}),
+		sensor("charging_state", "Charging state", "charging_state", map[string]any{"icon": "mdi:ev-station"}),
+		sensor("charger_power", "Charger power", "charger_power", measurement("power", "kW")),
+		sensor("inside_temp", "Inside temperature", "inside_temp", measurement("temperature", "°C")),
+		sensor("outside_temp", "Outside temperature", "outside_temp", measurement("temperature", "°C")),
+		sensor("odometer", "Odometer", "odometer", map[string]any{"device_class": "distance", "unit_of_measurement": "km", "state_class": "total_increasing", "suggested_display_precision": 0}),
+		sensor("tpms_fl", "Tire pressure front left", "tpms_fl", measurement("pressure", "bar")),
+		sensor("tpms_fr", "Tire pressure front right", "tpms_fr", measurement("pressure", "bar")),
+		sensor("tpms_rl", "Tire pressure rear left", "tpms_rl", measurement("pressure", "bar")),
+		sensor("tpms_rr", "Tire pressure rear right", "tpms_rr", measurement("pressure", "bar")),
+		sensor("last_seen", "Last seen", "last_seen", map[string]any{"device_class": "timestamp"}),
+		{Component: "device_tracker", ObjectID: "location", Name: "Location", Config: map[string]any{
+			"json_attributes_topic": b.client.Topic(nodeID, "location"),
+			"source_type":           "gps",
+		}},
+		{Component: "update", ObjectID: "software", Name: "Software", StateTopic: "update", Config: map[string]any{
+			"device_class": "firmware",
+		}},
+	}
+	if !b.commands {
+		return append(entities,
+			hass.Entity{Component: "binary_sensor", ObjectID: "lock", Name: "Doors", StateTopic: "state", Config: map[string]any{
+				"device_class":   "lock",
+				"value_template": "{{ 'OFF' if value_json.locked else 'ON' }}",
+			}},
+			hass.Entity{Component: "binary_sensor", ObjectID: "climate", Name: "Climate", StateTopic: "state", Config: map[string]any{
+				"icon":           "mdi:fan",
+				"value_template": "{{ 'ON' if value_json.climate_on else 'OFF' }}",
+			}},
+			hass.Entity{Component: "binary_sensor", ObjectID: "charging", Name: "Charging", StateTopic: "state", Config: map[string]any{
+				"device_class":   "battery_charging",
+				"value_template": "{{ 'ON' if value_json.charging_state == 'Charging' else 'OFF' }}",
+			}},
+		)
+	}
+	return append(entities,
+		hass.Entity{Component: "lock", ObjectID: "lock", Name: "Doors", StateTopic: "state", Command: true, Config: map[string]any{
+			"value_template": "{{ 'LOCKED' if value_json.locked else 'UNLOCKED' }}",
+		}},
+		hass.Entity{Component: "switch", ObjectID: "climate", Name: "Climate", StateTopic: "state", Command: true, Config: map[string]any{
+			"icon":           "mdi:fan",
+			"value_template": "{{ 'ON' if value_json.climate_on else 'OFF' }}",
+		}},
+		hass.Entity{Component: "switch", ObjectID: "charging", Name: "Charging", StateTopic: "state", Command: true, Config: map[string]any{
+			"icon":           "mdi:ev-station",
+			"value_template": "{{ 'ON' if value_json.charging_state == 'Charging' else 'OFF' }}",
+		}},
+		// The charge limit is not collected, so Home Assistant keeps the last value set.
+		hass.Entity{Component: "number", ObjectID: "charge_limit", Name: "Charge limit", Command: true, Config: map[string]any{
+			"min":                 biz.MinChargeLimit,
+			"max":                 biz.MaxChargeLimit,
+			"step":                1,
+			"unit_of_measurement": "%",
+			"optimistic":          true,
+		}},
+		hass.Entity{Component: "button", ObjectID: "wake_up", Name: "Wake up", Command: true, Config: map[string]any{
+			"icon": "mdi:sleep-off",
+		}},
+	)
+}
@@ -0,0 +1,168 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"teslatrack/internal/biz"
+	"teslatrack/internal/conf"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultPollInterval is used when no poller interval is configured.
+const defaultPollInterval = time.Minute
+
+var _ transport.Server = (*Poller)(nil)
+
+// Poller is a background server that periodically collects vehicle snapshots.
+type Poller struct {
+	collector *biz.CollectorUsecase
+	locker    biz.Locker
+	enabled   bool
+	interval  time.Duration
+	stop      chan struct{}
+	stopOnce  sync.Once
+	log       *log.Helper
+
+	// started is the Unix nano time the poller started, 0 before.
+	started atomic.Int64
+	// collected is the Unix nano time the last collection completed, 0 before the first.
+	collected atomic.Int64
+	// skipped is the Unix nano time a collection was last left to another instance, 0 before.
+	skipped atomic.Int64
+
+	duration metric.Float64Histogram
+	// tracer records a span per collection, the parent of its Fleet API and database spans.
+	tracer trace.Tracer
+}
+
+// NewPoller creates a new Poller from the server configuration.
+func NewPoller(c *conf.Server, collector *biz.CollectorUsecase, locker biz.Locker, mp metric.MeterProvider, tp trace.TracerProvider, logger log.Logger) (*Poller, error) {
+	poller := &Poller{
+		collector: collector,
+		locker:    locker,
+		tracer:    tp.Tracer(scopeName),
+		interval:  defaultPollInterval,
+		stop:      make(chan struct{}),
+		log:       log.NewHelper(logger),
+	}
+	if c.Poller != nil {
+		poller.enabled = c.Poller.Enabled
+		if c.Poller.Interval != nil {
+			poller.interval = c.Poller.Interval.AsDuration()
+		}
+	}
+	if err := poller.instrument(mp.Meter(scopeName)); err != nil {
+		return nil, err
+	}
+	return poller, nil
+}
+
+// instrument creates the poller metrics. The lag is the time since the last completed
+// collection; it grows past the interval when collections are slow or stuck.
+func (p *Poller) instrument(meter metric.Meter) error {
+	var err error
+	p.duration, err = meter.Float64Histogram("poller.collect.duration",
+		metric.WithDescription("Duration of a collection of all vehicles."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.5, 1, 2.5, 5, 10, 30, 60, 120))
+	if err != nil {
+		return err
+	}
+	_, err = meter.Float64ObservableGauge("poller.lag",
+		metric.WithDescription("Time since the last completed collection, absent before the first."),
+		metric.WithUnit("s"),
+		metric.WithFloat64Callback(func(_ context.Context, o metric.Float64Observer) error {
+			if collected := p.collected.Load(); collected > 0 {
+				o.Observe(time.Since(time.Unix(0, collected)).Seconds())
+			}
+			return nil
+		}))
+	return err
+}
+
+// Start runs a collection every interval until the poller is stopped.
+func (p *Poller) Start(ctx context.Context) error {
+	if !p.enabled {
+		p.log.Info("poller disabled")
+		return nil
+	}
+	p.started.Store(time.Now().UnixNano())
+	p.log.Infow("msg", "poller started", "interval", p.interval)
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		p.collect(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-p.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Enabled reports whether the poller collects.
+func (p *Poller) Enabled() bool {
+	return p.enabled
+}
+
+// Interval returns the time between two collections.
+func (p *Poller) Interval() time.Duration {
+	return p.interval
+}
+
+// Heartbeat returns the time the last collection completed or was left to another instance,
+// or the poller started if none did yet; zero if the poller is disabled or not started.
+func (p *Poller) Heartbeat() time.Time {
+	t := max(p.collected.Load(), p.skipped.Load())
+	if t == 0 {
+		t = p.started.Load()
+	}
+	if t == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, t)
+}
+
+// collect runs a collection in its own trace, unless another instance of the service ran
+// one during the interval. The lock is extended while the collection runs and then left to
+// expire rather than released, so that the instances collect once per interval between them.
+// Without the lock another instance may be collecting, so the collection is skipped.
+func (p *Poller) collect(ctx context.Context) {
+	start := time.Now()
+	ttl := p.interval - p.interval/10
+	lock, ok, err := p.locker.TryLock(ctx, "poller", ttl)
+	switch {
+	case err != nil:
+		p.log.Errorw("msg", "poller lock failed, collection skipped", "err", err)
+		return
+	case !ok:
+		p.log.Debug("collection left to another instance")
+		p.skipped.Store(start.UnixNano())
+		return
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go holdLock(ctx, lock, ttl, done, p.log)
+	ctx, span := p.tracer.Start(ctx, "poller.collect")
+	defer span.End()
+	if err := p.collector.Collect(ctx); err != nil {
+		span.RecordError(err)
+		p.log.WithContext(ctx).Errorw("msg", "collect failed", "err", err)
+	}
+	p.duration.Record(ctx, time.Since(start).Seconds())
+	p.collected.Store(time.Now().UnixNano())
+}
+
+// Stop stops the poller.
+func (p *Poller) Stop(ctx context.Context) error {
+	p.stopOnce.Do(func() { close(p.stop) })
+	return nil
+}
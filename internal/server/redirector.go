@@ -4,9 +4,12 @@ import (
 	"net/http"
 	"teslatrack/internal/biz"
 	"teslatrack/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
 )
 
 type Redirector struct {
+	authorizeUsecase      *biz.AuthorizeUsecase
 	authorizeTokenUsecase *biz.AuthorizeTokenUsecase
 	conf                  *conf.Server
 }
@@ -14,9 +17,18 @@ type Redirector struct {
 func (redirect *Redirector) RedirectFilter(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == redirect.conf.Tesla.Callback {
-			// tesla callback auth code
+			// the state must be one of a redirect in progress, against CSRF and replays
+			state, err := redirect.authorizeUsecase.VerifyState(r.Context(), r.URL.Query().Get("state"))
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			// tesla callback auth code, the tokens belong to the user who started the authorization
 			code := r.URL.Query().Get("code")
-			redirect.authorizeTokenUsecase.ExchangeCode(r.Context(), code)
+			if err := redirect.authorizeTokenUsecase.ExchangeCode(r.Context(), state.UserID, code); err != nil {
+				writeError(w, err)
+				return
+			}
 			http.ServeFile(w, r, "web/hello.html")
 			return
 		}
@@ -24,6 +36,17 @@ func (redirect *Redirector) RedirectFilter(next http.Handler) http.Handler {
 	})
 }
 
-func NewRedirector(conf *conf.Server, authorizeTokenUsecase *biz.AuthorizeTokenUsecase) *Redirector {
-	return &Redirector{conf: conf, authorizeTokenUsecase: authorizeTokenUsecase}
+// writeError writes the status and message of an error reason to a plain HTTP response.
+func writeError(w http.ResponseWriter, err error) {
+	e := errors.FromError(err)
+	if e.Code >= http.StatusInternalServerError {
+		// the message of a server failure is internal
+		http.Error(w, http.StatusText(int(e.Code)), int(e.Code))
+		return
+	}
+	http.Error(w, e.Message, int(e.Code))
+}
+
+func NewRedirector(conf *conf.Server, authorizeUsecase *biz.AuthorizeUsecase, authorizeTokenUsecase *biz.AuthorizeTokenUsecase) *Redirector {
+	return &Redirector{conf: conf, authorizeUsecase: authorizeUsecase, authorizeTokenUsecase: authorizeTokenUsecase}
 }
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"teslatrack/internal/biz"
+	"teslatrack/internal/conf"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// defaultRollupInterval is used when no rollup interval is configured.
+const defaultRollupInterval = 10 * time.Minute
+
+var _ transport.Server = (*RollupJob)(nil)
+
+// RollupJob is a background server that periodically refreshes the statistics rollups.
+type RollupJob struct {
+	rollup   *biz.RollupUsecase
+	locker   biz.Locker
+	enabled  bool
+	interval time.Duration
+	stop     chan struct{}
+	stopOnce sync.Once
+	log      *log.Helper
+}
+
+// NewRollupJob creates a new RollupJob from the server configuration.
+func NewRollupJob(c *conf.Server, rollup *biz.RollupUsecase, locker biz.Locker, logger log.Logger) *RollupJob {
+	job := &RollupJob{
+		rollup:   rollup,
+		locker:   locker,
+		interval: defaultRollupInterval,
+		stop:     make(chan struct{}),
+		log:      log.NewHelper(logger),
+	}
+	if c.Rollup != nil {
+		job.enabled = c.Rollup.Enabled
+		if c.Rollup.Interval != nil {
+			job.interval = c.Rollup.Interval.AsDuration()
+		}
+	}
+	return job
+}
+
+// Start refreshes the rollups every interval until the job is stopped.
+func (j *RollupJob) Start(ctx context.Context) error {
+	if !j.enabled {
+		j.log.Info("rollup job disabled")
+		return nil
+	}
+	j.log.Infow("msg", "rollup job started", "interval", j.interval)
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		j.run(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-j.stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// run refreshes the rollups unless another instance of the service did during the interval.
+// The lock is extended while the refresh runs and then left to expire rather than released,
+// so that the instances refresh once per interval between them. Without the lock another
+// instance may be refreshing, so the refresh is skipped.
+func (j *RollupJob) run(ctx context.Context) {
+	ttl := j.interval - j.interval/10
+	lock, ok, err := j.locker.TryLock(ctx, "rollup", ttl)
+	switch {
+	case err != nil:
+		j.log.Errorw("msg", "rollup lock failed, refresh skipped", "err", err)
+		return
+	case !ok:
+		j.log.Debug("rollup left to another instance")
+		return
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go holdLock(ctx, lock, ttl, done, j.log)
+	if err := j.rollup.Run(ctx); err != nil {
+		j.log.Errorw("msg", "rollup failed", "err", err)
+	}
+}
+
+// Stop stops the rollup job.
+func (j *RollupJob) Stop(ctx context.Context) error {
+	j.stopOnce.Do(func() { close(j.stop) })
+	return nil
+}
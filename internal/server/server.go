@@ -5,4 +5,4 @@ import (
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewRedirector)
+var ProviderSet = wire.NewSet(NewGRPCServer, NewHTTPServer, NewRedirector, NewPoller, NewRollupJob, NewEventRecorder, NewEventReader, NewMQTTBridge, NewMeterProvider, NewTracerProvider, NewVehicleMetrics, NewHealth)
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"teslatrack/internal/conf"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// Tracing exporters.
+const (
+	TracingExporterOTLP   = "otlp"
+	TracingExporterStdout = "stdout"
+)
+
+// serviceName is the service name of the traces unless OTEL_SERVICE_NAME is set.
+const serviceName = "teslatrack"
+
+// NewTracerProvider creates the tracer provider of the service from the server configuration,
+// a no-op provider when tracing is disabled. It is also installed as the global provider,
+// which the Fleet API client records its spans with.
+func NewTracerProvider(c *conf.Server) (trace.TracerProvider, func(), error) {
+	cfg := c.GetTracing()
+	if cfg.GetExporter() == "" {
+		tp := noop.NewTracerProvider()
+		otel.SetTracerProvider(tp)
+		return tp, func() {}, nil
+	}
+	exporter, err := newSpanExporter(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The attributes come first so that OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES override them.
+	res, err := resource.New(context.Background(),
+		resource.WithAttributes(semconv.ServiceName(serviceName)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithHost(),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	ratio := 1.0
+	if cfg.SampleRatio > 0 {
+		ratio = cfg.SampleRatio
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	)
+	otel.SetTracerProvider(tp)
+	cleanup := func() {
+		// Flush the spans still batched.
+		_ = tp.Shutdown(context.Background())
+	}
+	return tp, cleanup, nil
+}
+
+// newSpanExporter creates the exporter of the configuration. The stdout exporter prints
+// the spans, so traces can be followed offline in development.
+func newSpanExporter(cfg *conf.Server_Tracing) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case TracingExporterOTLP:
+		var opts []otlptracegrpc.Option
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		// The client connects lazily, so an unreachable collector does not stop the service.
+		return otlptracegrpc.New(context.Background(), opts...)
+	case TracingExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout), stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", cfg.Exporter)
+	}
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultAnalyticsRange is the range analysed when the request names no start.
+const defaultAnalyticsRange = 90 * 24 * time.Hour
+
+// AnalyticsService is the service implementation for the Analytics API.
+type AnalyticsService struct {
+	v1.UnimplementedAnalyticsServer
+
+	uc  *biz.AnalyticsUsecase
+	log *log.Helper
+}
+
+// NewAnalyticsService creates a new AnalyticsService.
+func NewAnalyticsService(uc *biz.AnalyticsUsecase, logger log.Logger) *AnalyticsService {
+	return &AnalyticsService{uc: uc, log: log.NewHelper(logger)}
+}
+
+// GetEfficiency handles the RPC for the efficiency analysis of a vehicle.
+func (s *AnalyticsService) GetEfficiency(ctx context.Context, req *v1.GetEfficiencyRequest) (*v1.GetEfficiencyReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from, to := analyticsRange(req.From, req.To)
+	report, err := s.uc.Efficiency(ctx, userID, int(req.VehicleId), from, to, biz.EfficiencyOptions{
+		TemperatureStep: req.TemperatureStep,
+		SpeedStep:       req.SpeedStep,
+		HistogramStep:   req.HistogramStep,
+	})
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetEfficiencyReply{
+		PackCapacity:  report.PackCapacity,
+		Total:         toEfficiencyBucket(&report.Total),
+		Drives:        make([]*v1.DriveEfficiency, 0, len(report.Drives)),
+		SkippedDrives: int32(report.Skipped),
+		ByTemperature: toEfficiencyBuckets(report.ByTemperature),
+		BySpeed:       toEfficiencyBuckets(report.BySpeed),
+		ByClimate:     toEfficiencyBuckets(report.ByClimate),
+		Distribution:  make([]*v1.HistogramBin, 0, len(report.Distribution)),
+	}
+	for _, d := range report.Drives {
+		reply.Drives = append(reply.Drives, &v1.DriveEfficiency{
+			DriveId:      int64(d.DriveID),
+			StartAt:      timestamppb.New(d.StartAt),
+			Distance:     d.Distance,
+			Duration:     int64(d.Duration.Seconds()),
+			EnergyUsed:   d.EnergyUsed,
+			Source:       d.Source,
+			Efficiency:   d.Efficiency(),
+			AverageSpeed: d.AverageSpeed(),
+			OutsideTemp:  d.OutsideTemp,
+			ClimateRatio: d.ClimateRatio,
+		})
+	}
+	for _, b := range report.Distribution {
+		reply.Distribution = append(reply.Distribution, &v1.HistogramBin{Lower: b.Lower, Upper: b.Upper, Count: int32(b.Count)})
+	}
+	return reply, nil
+}
+
+// GetBatteryDegradation handles the RPC for the battery degradation curve of a vehicle.
+func (s *AnalyticsService) GetBatteryDegradation(ctx context.Context, req *v1.GetBatteryDegradationRequest) (*v1.GetBatteryDegradationReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := biz.LoadLocation(req.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	from, to := time.Time{}, time.Now()
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+	report, err := s.uc.Degradation(ctx, userID, int(req.VehicleId), from, to, loc)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetBatteryDegradationReply{
+		Samples:                make([]*v1.RangeSample, 0, len(report.Samples)),
+		Points:                 make([]*v1.DegradationPoint, 0, len(report.Points)),
+		ReferenceRange:         report.ReferenceRange,
+		CurrentRange:           report.CurrentRange,
+		Degradation:            report.Degradation,
+		DegradationPer_10000Km: report.DegradationPer10000Km,
+	}
+	for _, sample := range report.Samples {
+		reply.Samples = append(reply.Samples, &v1.RangeSample{
+			Time:         timestamppb.New(sample.Time),
+			Odometer:     sample.Odometer,
+			BatteryLevel: int32(sample.BatteryLevel),
+			RatedRange:   sample.RatedRange,
+			IdealRange:   sample.IdealRange,
+			EstRange:     sample.EstRange,
+			Capacity:     sample.Capacity,
+		})
+	}
+	for _, p := range report.Points {
+		reply.Points = append(reply.Points, &v1.DegradationPoint{
+			Month:       timestamppb.New(p.Month),
+			Samples:     int32(p.Samples),
+			Odometer:    p.Odometer,
+			RatedRange:  p.RatedRange,
+			IdealRange:  p.IdealRange,
+			EstRange:    p.EstRange,
+			Capacity:    p.Capacity,
+			Degradation: p.Degradation,
+		})
+	}
+	return reply, nil
+}
+
+// ListIdleDrains handles the RPC for the idle drain report of a vehicle.
+func (s *AnalyticsService) ListIdleDrains(ctx context.Context, req *v1.ListIdleDrainsRequest) (*v1.ListIdleDrainsReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from, to := analyticsRange(req.From, req.To)
+	drains, err := s.uc.IdleDrains(ctx, userID, int(req.VehicleId), from, to)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListIdleDrainsReply{Drains: make([]*v1.IdleDrain, 0, len(drains))}
+	_, reply.CoordType = resolveCoordType(req.CoordType)
+	for _, d := range drains {
+		pos := toCoordinate(d.Latitude, d.Longitude, req.CoordType)
+		reply.Drains = append(reply.Drains, &v1.IdleDrain{
+			StartAt:           timestamppb.New(d.StartAt),
+			EndAt:             timestamppb.New(d.EndAt),
+			Latitude:          pos.Latitude,
+			Longitude:         pos.Longitude,
+			Address:           d.Address,
+			StartBatteryLevel: int32(d.StartBatteryLevel),
+			EndBatteryLevel:   int32(d.EndBatteryLevel),
+			RangeLoss:         d.RangeLoss,
+			AsleepDuration:    int64(d.AsleepDuration.Seconds()),
+			AsleepRangeLoss:   d.AsleepRangeLoss,
+			SentryDuration:    int64(d.SentryDuration.Seconds()),
+			SentryRangeLoss:   d.SentryRangeLoss,
+			ClimateDuration:   int64(d.ClimateDuration.Seconds()),
+			ClimateRangeLoss:  d.ClimateRangeLoss,
+			IdleDuration:      int64(d.IdleDuration.Seconds()),
+			IdleRangeLoss:     d.IdleRangeLoss,
+		})
+		reply.RangeLoss += d.RangeLoss
+		reply.Duration += int64(d.Duration().Seconds())
+	}
+	return reply, nil
+}
+
+// analyticsRange resolves the requested range, defaulting to the last 90 days.
+func analyticsRange(from, to *timestamppb.Timestamp) (time.Time, time.Time) {
+	end := time.Now()
+	if to != nil {
+		end = to.AsTime()
+	}
+	start := end.Add(-defaultAnalyticsRange)
+	if from != nil {
+		start = from.AsTime()
+	}
+	return start, end
+}
+
+// toEfficiencyBuckets maps efficiency buckets to the reply.
+func toEfficiencyBuckets(buckets []*biz.EfficiencyBucket) []*v1.EfficiencyBucket {
+	out := make([]*v1.EfficiencyBucket, 0, len(buckets))
+	for _, b := range buckets {
+		out = append(out, toEfficiencyBucket(b))
+	}
+	return out
+}
+
+// toEfficiencyBucket maps an efficiency bucket to the reply.
+func toEfficiencyBucket(b *biz.EfficiencyBucket) *v1.EfficiencyBucket {
+	return &v1.EfficiencyBucket{
+		Label:      b.Label,
+		Lower:      b.Lower,
+		Upper:      b.Upper,
+		Drives:     int32(b.Drives),
+		Distance:   b.Distance,
+		EnergyUsed: b.EnergyUsed,
+		Efficiency: b.Efficiency(),
+	}
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"context"
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/pkg/jwt"
+)
+
+// ErrUnauthorized is returned when a request carries no signed in user.
+var ErrUnauthorized = v1.ErrorUnauthorized("sign in required")
+
+// currentUserID returns the ID of the user authenticated by the jwt middleware.
+func currentUserID(ctx context.Context) (int, error) {
+	user, ok := jwt.FromContext(ctx)
+	if !ok || user.ID == 0 {
+		return 0, ErrUnauthorized
+	}
+	return int(user.ID), nil
+}
@@ -41,8 +41,12 @@ func (s *AuthorizeService) CreateAuthorize(ctx context.Context, req *v1.CreateAu
 // Redirect handles the RPC for initiating the authorization redirect.
 // It calls the Redirect use case and maps the result to the gRPC reply.
 func (s *AuthorizeService) Redirect(ctx context.Context, req *v1.RedirectRequest) (*v1.RedirectReply, error) {
-	// Call the business logic to get redirect parameters.
-	redirect, err := s.uc.Redirect(ctx, req.ClientId)
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Call the business logic to get redirect parameters, the Tesla account is authorized for the signed-in user.
+	redirect, err := s.uc.Redirect(ctx, userID, req.ClientId)
 	if err != nil {
 		return nil, err
 	}
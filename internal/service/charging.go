@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ChargingService is the service implementation for the Charging API.
+type ChargingService struct {
+	v1.UnimplementedChargingServer
+
+	uc  *biz.HistoryUsecase
+	log *log.Helper
+}
+
+// NewChargingService creates a new ChargingService.
+func NewChargingService(uc *biz.HistoryUsecase, logger log.Logger) *ChargingService {
+	return &ChargingService{uc: uc, log: log.NewHelper(logger)}
+}
+
+// ListChargingSessions handles the RPC for the charging sessions of the user.
+func (s *ChargingService) ListChargingSessions(ctx context.Context, req *v1.ListChargingSessionsRequest) (*v1.ListChargingSessionsReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Sort == biz.SortDistance {
+		return nil, biz.ErrHistorySortInvalid
+	}
+	query := &biz.HistoryQuery{
+		VehicleID:        int(req.VehicleId),
+		GeofenceID:       int(req.GeofenceId),
+		GeofenceCategory: req.GeofenceCategory,
+		Tag:              req.Tag,
+		MinEnergy:        req.MinEnergy,
+		Sort:             req.Sort,
+		Cursor:           req.Cursor,
+		Limit:            int(req.PageSize),
+	}
+	if req.From != nil {
+		query.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		query.To = req.To.AsTime()
+	}
+	list, err := s.uc.List(ctx, userID, biz.VehicleStateCharging, query)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListChargingSessionsReply{Sessions: make([]*v1.ChargingSessionInfo, 0, len(list.Periods)), NextCursor: list.NextCursor}
+	for _, p := range list.Periods {
+		reply.Sessions = append(reply.Sessions, toChargingSessionInfo(p))
+	}
+	return reply, nil
+}
+
+// GetChargingSession handles the RPC for a charging session with its charge curve.
+func (s *ChargingService) GetChargingSession(ctx context.Context, req *v1.GetChargingSessionRequest) (*v1.GetChargingSessionReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.uc.Get(ctx, userID, biz.VehicleStateCharging, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	samples, err := s.uc.Samples(ctx, session, int(req.MaxPoints))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetChargingSessionReply{Session: toChargingSessionInfo(session), Points: make([]*v1.ChargePoint, 0, len(samples))}
+	for _, sn := range samples {
+		reply.Points = append(reply.Points, &v1.ChargePoint{
+			Time:           timestamppb.New(sn.CreatedAt),
+			BatteryLevel:   int32(sn.BatteryLevel),
+			BatteryRange:   sn.BatteryRange,
+			ChargerPower:   int32(sn.ChargerPower),
+			ChargerVoltage: int32(sn.ChargerVoltage),
+			ChargerCurrent: int32(sn.ChargerActualCurrent),
+			EnergyAdded:    sn.ChargeEnergyAdded,
+		})
+	}
+	return reply, nil
+}
+
+// RenameChargingSession handles the RPC for renaming a charging session.
+func (s *ChargingService) RenameChargingSession(ctx context.Context, req *v1.RenameChargingSessionRequest) (*v1.ChargingSessionInfo, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.uc.Rename(ctx, userID, biz.VehicleStateCharging, int(req.Id), req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return toChargingSessionInfo(session), nil
+}
+
+// SetChargingSessionTags handles the RPC for tagging a charging session.
+func (s *ChargingService) SetChargingSessionTags(ctx context.Context, req *v1.SetChargingSessionTagsRequest) (*v1.ChargingSessionInfo, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.uc.SetTags(ctx, userID, biz.VehicleStateCharging, int(req.Id), req.Tags)
+	if err != nil {
+		return nil, err
+	}
+	return toChargingSessionInfo(session), nil
+}
+
+// MergeChargingSessions handles the RPC for merging charging sessions.
+func (s *ChargingService) MergeChargingSessions(ctx context.Context, req *v1.MergeChargingSessionsRequest) (*v1.ChargingSessionInfo, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.uc.Merge(ctx, userID, biz.VehicleStateCharging, toIDs(req.Ids))
+	if err != nil {
+		return nil, err
+	}
+	return toChargingSessionInfo(session), nil
+}
+
+// SplitChargingSession handles the RPC for splitting a charging session.
+func (s *ChargingService) SplitChargingSession(ctx context.Context, req *v1.SplitChargingSessionRequest) (*v1.SplitChargingSessionReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.At == nil {
+		return nil, biz.ErrSplitInvalid
+	}
+	first, second, err := s.uc.Split(ctx, userID, biz.VehicleStateCharging, int(req.Id), req.At.AsTime())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SplitChargingSessionReply{First: toChargingSessionInfo(first), Second: toChargingSessionInfo(second)}, nil
+}
+
+// toChargingSessionInfo maps a charging session to the reply.
+func toChargingSessionInfo(p *biz.VehicleStatePeriod) *v1.ChargingSessionInfo {
+	info := &v1.ChargingSessionInfo{
+		Id:                int64(p.ID),
+		VehicleId:         int64(p.VehicleID),
+		Name:              p.Name,
+		Tags:              p.Tags,
+		StartAt:           timestamppb.New(p.StartAt),
+		Duration:          int64(p.Duration().Seconds()),
+		Address:           p.StartAddress,
+		GeofenceId:        optionalID(p.StartGeofenceID),
+		ChargeLocation:    p.ChargeLocation,
+		FastCharger:       p.FastCharger,
+		StartBatteryLevel: int32(p.StartBatteryLevel),
+		EndBatteryLevel:   int32(p.EndBatteryLevel),
+		StartRange:        p.StartRange,
+		EndRange:          p.EndRange,
+		EnergyAdded:       p.EnergyAdded,
+		Cost:              p.Cost,
+		CostManual:        p.CostManual,
+	}
+	if p.EndAt != nil {
+		info.EndAt = timestamppb.New(*p.EndAt)
+	}
+	return info
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DriveService is the service implementation for the Drive API.
+type DriveService struct {
+	v1.UnimplementedDriveServer
+
+	uc  *biz.HistoryUsecase
+	log *log.Helper
+}
+
+// NewDriveService creates a new DriveService.
+func NewDriveService(uc *biz.HistoryUsecase, logger log.Logger) *DriveService {
+	return &DriveService{uc: uc, log: log.NewHelper(logger)}
+}
+
+// ListDrives handles the RPC for the drives of the user.
+func (s *DriveService) ListDrives(ctx context.Context, req *v1.ListDrivesRequest) (*v1.ListDrivesReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := &biz.HistoryQuery{
+		VehicleID:        int(req.VehicleId),
+		GeofenceID:       int(req.GeofenceId),
+		GeofenceCategory: req.GeofenceCategory,
+		Tag:              req.Tag,
+		MinDistance:      req.MinDistance,
+		MinEnergy:        req.MinEnergy,
+		Sort:             req.Sort,
+		Cursor:           req.Cursor,
+		Limit:            int(req.PageSize),
+	}
+	if req.From != nil {
+		query.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		query.To = req.To.AsTime()
+	}
+	list, err := s.uc.List(ctx, userID, biz.VehicleStateDriving, query)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListDrivesReply{Drives: make([]*v1.DriveInfo, 0, len(list.Periods)), NextCursor: list.NextCursor}
+	for _, p := range list.Periods {
+		reply.Drives = append(reply.Drives, toDriveInfo(p))
+	}
+	return reply, nil
+}
+
+// GetDrive handles the RPC for a drive with its track.
+func (s *DriveService) GetDrive(ctx context.Context, req *v1.GetDriveRequest) (*v1.GetDriveReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	drive, err := s.uc.Get(ctx, userID, biz.VehicleStateDriving, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	samples, err := s.uc.Samples(ctx, drive, int(req.MaxPoints))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetDriveReply{Drive: toDriveInfo(drive), Points: make([]*v1.DrivePoint, 0, len(samples))}
+	_, reply.CoordType = resolveCoordType(req.CoordType)
+	for _, sn := range samples {
+		pos := toCoordinate(sn.Latitude, sn.Longitude, req.CoordType)
+		reply.Points = append(reply.Points, &v1.DrivePoint{
+			Time:         timestamppb.New(sn.CreatedAt),
+			Latitude:     pos.Latitude,
+			Longitude:    pos.Longitude,
+			Heading:      int32(sn.Heading),
+			Speed:        sn.Speed,
+			Power:        int32(sn.Power),
+			BatteryLevel: int32(sn.BatteryLevel),
+			Odometer:     sn.Odometer,
+		})
+	}
+	return reply, nil
+}
+
+// RenameDrive handles the RPC for renaming a drive.
+func (s *DriveService) RenameDrive(ctx context.Context, req *v1.RenameDriveRequest) (*v1.DriveInfo, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	drive, err := s.uc.Rename(ctx, userID, biz.VehicleStateDriving, int(req.Id), req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return toDriveInfo(drive), nil
+}
+
+// SetDriveTags handles the RPC for tagging a drive.
+func (s *DriveService) SetDriveTags(ctx context.Context, req *v1.SetDriveTagsRequest) (*v1.DriveInfo, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	drive, err := s.uc.SetTags(ctx, userID, biz.VehicleStateDriving, int(req.Id), req.Tags)
+	if err != nil {
+		return nil, err
+	}
+	return toDriveInfo(drive), nil
+}
+
+// MergeDrives handles the RPC for merging drives.
+func (s *DriveService) MergeDrives(ctx context.Context, req *v1.MergeDrivesRequest) (*v1.DriveInfo, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	drive, err := s.uc.Merge(ctx, userID, biz.VehicleStateDriving, toIDs(req.Ids))
+	if err != nil {
+		return nil, err
+	}
+	return toDriveInfo(drive), nil
+}
+
+// SplitDrive handles the RPC for splitting a drive.
+func (s *DriveService) SplitDrive(ctx context.Context, req *v1.SplitDriveRequest) (*v1.SplitDriveReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.At == nil {
+		return nil, biz.ErrSplitInvalid
+	}
+	first, second, err := s.uc.Split(ctx, userID, biz.VehicleStateDriving, int(req.Id), req.At.AsTime())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SplitDriveReply{First: toDriveInfo(first), Second: toDriveInfo(second)}, nil
+}
+
+// toDriveInfo maps a drive to the reply.
+func toDriveInfo(p *biz.VehicleStatePeriod) *v1.DriveInfo {
+	info := &v1.DriveInfo{
+		Id:                int64(p.ID),
+		VehicleId:         int64(p.VehicleID),
+		Name:              p.Name,
+		Tags:              p.Tags,
+		StartAt:           timestamppb.New(p.StartAt),
+		Duration:          int64(p.Duration().Seconds()),
+		Distance:          p.Distance(),
+		StartAddress:      p.StartAddress,
+		EndAddress:        p.EndAddress,
+		StartGeofenceId:   optionalID(p.StartGeofenceID),
+		EndGeofenceId:     optionalID(p.EndGeofenceID),
+		StartBatteryLevel: int32(p.StartBatteryLevel),
+		EndBatteryLevel:   int32(p.EndBatteryLevel),
+		RangeUsed:         p.StartRange - p.EndRange,
+		EnergyUsed:        p.EnergyUsed,
+		MaxSpeed:          p.MaxSpeed,
+		OutsideTemp:       p.OutsideTemp,
+	}
+	if p.EndAt != nil {
+		info.EndAt = timestamppb.New(*p.EndAt)
+	}
+	if distance := p.Distance(); distance > 0 {
+		info.Consumption = p.EnergyUsed * 1000 / distance
+		if hours := p.Duration().Hours(); hours > 0 {
+			info.AverageSpeed = distance / hours
+		}
+	}
+	return info
+}
+
+// optionalID maps an optional ID to the reply.
+func optionalID(id *int) *int64 {
+	if id == nil {
+		return nil
+	}
+	v := int64(*id)
+	return &v
+}
+
+// toIDs maps the IDs of a request.
+func toIDs(ids []int64) []int {
+	out := make([]int, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, int(id))
+	}
+	return out
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/pkg/geo"
+)
+
+// coordTypes maps the API datum to the geo package.
+var coordTypes = map[v1.CoordType]geo.CoordType{
+	v1.CoordType_COORD_TYPE_WGS84: geo.WGS84,
+	v1.CoordType_COORD_TYPE_GCJ02: geo.GCJ02,
+	v1.CoordType_COORD_TYPE_BD09:  geo.BD09,
+}
+
+// resolveCoordType resolves the datum requested by the client.
+// Unspecified and unknown datums fall back to WGS-84.
+func resolveCoordType(coordType v1.CoordType) (geo.CoordType, v1.CoordType) {
+	if c, ok := coordTypes[coordType]; ok {
+		return c, coordType
+	}
+	return geo.WGS84, v1.CoordType_COORD_TYPE_WGS84
+}
+
+// toCoordinate converts a stored WGS-84 position to the datum requested by the client.
+func toCoordinate(lat, lon float64, coordType v1.CoordType) *v1.Coordinate {
+	to, coordType := resolveCoordType(coordType)
+	lat, lon = geo.Convert(lat, lon, geo.WGS84, to)
+	return &v1.Coordinate{Latitude: lat, Longitude: lon, CoordType: coordType}
+}
+
+// fromCoordinate converts a coordinate sent by the client to WGS-84 for storage.
+func fromCoordinate(c *v1.Coordinate, coordType v1.CoordType) (float64, float64) {
+	if c == nil {
+		return 0, 0
+	}
+	from, _ := resolveCoordType(coordType)
+	return geo.Convert(c.Latitude, c.Longitude, from, geo.WGS84)
+}
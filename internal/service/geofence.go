@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/internal/biz"
+	"teslatrack/pkg/geo"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// GeofenceService is the service implementation for the Geofence API.
+type GeofenceService struct {
+	v1.UnimplementedGeofenceServer
+
+	uc  *biz.GeofenceUsecase
+	log *log.Helper
+}
+
+// NewGeofenceService creates a new GeofenceService.
+func NewGeofenceService(uc *biz.GeofenceUsecase, logger log.Logger) *GeofenceService {
+	return &GeofenceService{uc: uc, log: log.NewHelper(logger)}
+}
+
+// CreateGeofence handles the RPC for creating a geofence of the signed in user.
+func (s *GeofenceService) CreateGeofence(ctx context.Context, req *v1.CreateGeofenceRequest) (*v1.GeofenceReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	g := toBizGeofence(req.Geofence, req.CoordType)
+	g.UserID = userID
+	if err := s.uc.Create(ctx, g); err != nil {
+		return nil, err
+	}
+	return &v1.GeofenceReply{Geofence: toGeofenceInfo(g, req.CoordType)}, nil
+}
+
+// UpdateGeofence handles the RPC for updating a geofence of the signed in user.
+func (s *GeofenceService) UpdateGeofence(ctx context.Context, req *v1.UpdateGeofenceRequest) (*v1.GeofenceReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	g := toBizGeofence(req.Geofence, req.CoordType)
+	g.ID = int(req.Id)
+	if err := s.uc.Update(ctx, userID, g); err != nil {
+		return nil, err
+	}
+	return &v1.GeofenceReply{Geofence: toGeofenceInfo(g, req.CoordType)}, nil
+}
+
+// DeleteGeofence handles the RPC for deleting a geofence of the signed in user.
+func (s *GeofenceService) DeleteGeofence(ctx context.Context, req *v1.DeleteGeofenceRequest) (*v1.DeleteGeofenceReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.Delete(ctx, userID, int(req.Id)); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteGeofenceReply{}, nil
+}
+
+// GetGeofence handles the RPC for getting a geofence of the signed in user.
+func (s *GeofenceService) GetGeofence(ctx context.Context, req *v1.GetGeofenceRequest) (*v1.GeofenceReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	g, err := s.uc.Get(ctx, userID, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GeofenceReply{Geofence: toGeofenceInfo(g, req.CoordType)}, nil
+}
+
+// ListGeofences handles the RPC for listing the geofences of the signed in user.
+func (s *GeofenceService) ListGeofences(ctx context.Context, req *v1.ListGeofencesRequest) (*v1.ListGeofencesReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	geofences, err := s.uc.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListGeofencesReply{Geofences: make([]*v1.GeofenceInfo, 0, len(geofences))}
+	for _, g := range geofences {
+		reply.Geofences = append(reply.Geofences, toGeofenceInfo(g, req.CoordType))
+	}
+	return reply, nil
+}
+
+// toBizGeofence maps a geofence sent in the given datum to the business model.
+func toBizGeofence(info *v1.GeofenceInfo, coordType v1.CoordType) *biz.Geofence {
+	if info == nil {
+		return &biz.Geofence{}
+	}
+	g := &biz.Geofence{
+		Name:     info.Name,
+		Category: info.Category,
+		Shape:    info.Shape,
+		Radius:   info.Radius,
+	}
+	g.Latitude, g.Longitude = fromCoordinate(info.Center, coordType)
+	for _, c := range info.Polygon {
+		lat, lon := fromCoordinate(c, coordType)
+		g.Polygon = append(g.Polygon, geo.Point{Lat: lat, Lon: lon})
+	}
+	return g
+}
+
+// toGeofenceInfo maps a geofence to the reply in the requested datum.
+func toGeofenceInfo(g *biz.Geofence, coordType v1.CoordType) *v1.GeofenceInfo {
+	info := &v1.GeofenceInfo{
+		Id:       int64(g.ID),
+		Name:     g.Name,
+		Category: g.Category,
+		Shape:    g.Shape,
+		Radius:   g.Radius,
+	}
+	if g.Shape == biz.GeofenceShapeCircle {
+		info.Center = toCoordinate(g.Latitude, g.Longitude, coordType)
+	}
+	for _, p := range g.Polygon {
+		info.Polygon = append(info.Polygon, toCoordinate(p.Lat, p.Lon, coordType))
+	}
+	return info
+}
@@ -0,0 +1,345 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/internal/biz"
+	"teslatrack/pkg/validation"
+
+	"github.com/go-kratos/kratos/v2/encoding"
+	"github.com/go-kratos/kratos/v2/encoding/json"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	kratoshttp "github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Operations of the live event endpoints, used by the middleware selectors.
+const (
+	OperationLiveStreamSSE       = "/api.teslatrack.v1.Live/StreamSSE"
+	OperationLiveStreamWebSocket = "/api.teslatrack.v1.Live/StreamWebSocket"
+)
+
+// Event types sent on live streams besides the bus events.
+const (
+	liveEventHeartbeat = "heartbeat"
+	liveEventReset     = "reset"
+)
+
+const (
+	// liveHeartbeatInterval is the idle time after which a heartbeat is sent.
+	// Failing heartbeats also detect the clients gone away.
+	liveHeartbeatInterval = 15 * time.Second
+	// liveWriteTimeout bounds the time spent writing a single event to a client.
+	liveWriteTimeout = 10 * time.Second
+)
+
+// ErrInvalidLastEventID is returned for a malformed Last-Event-ID header.
+var ErrInvalidLastEventID = v1.ErrorInvalidLastEventId("the last event id must be a positive integer")
+
+// liveUpgrader upgrades WebSocket requests. Any origin is accepted because
+// requests carry their access token rather than relying on cookies.
+var liveUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 4096,
+	CheckOrigin:     func(*http.Request) bool { return true },
+}
+
+// LiveService pushes the events of the vehicles of the user over gRPC,
+// Server-Sent Events and WebSockets.
+type LiveService struct {
+	v1.UnimplementedLiveServer
+
+	uc  *biz.LiveUsecase
+	log *log.Helper
+}
+
+// NewLiveService creates a new LiveService.
+func NewLiveService(uc *biz.LiveUsecase, logger log.Logger) *LiveService {
+	return &LiveService{uc: uc, log: log.NewHelper(logger)}
+}
+
+// StreamEvents handles the streaming RPC for the events of the user.
+func (s *LiveService) StreamEvents(req *v1.StreamEventsRequest, stream v1.Live_StreamEventsServer) error {
+	ctx := stream.Context()
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+	if err := validation.Validate(req); err != nil {
+		return err
+	}
+	live, err := s.uc.Subscribe(ctx, userID, int(req.VehicleId), req.Types, req.LastEventId)
+	if err != nil {
+		return err
+	}
+	defer live.Close()
+	heartbeat := func() error {
+		return stream.Send(&v1.LiveEvent{Type: liveEventHeartbeat, Time: timestamppb.Now()})
+	}
+	return pump(ctx, live, req.CoordType, stream.Send, heartbeat)
+}
+
+// RegisterHTTP registers the live event endpoints. Both accept the StreamEventsRequest
+// fields as query parameters and, since browsers cannot set headers on these requests,
+// the access token as access_token.
+//
+//	GET /api/v1/events?vehicle_id=1&types=drive.start&types=drive.end, Server-Sent Events resuming from Last-Event-ID
+//	GET /api/v1/events/ws?last_event_id=42, a WebSocket of JSON text messages
+func (s *LiveService) RegisterHTTP(srv *kratoshttp.Server) {
+	r := srv.Route("/")
+	r.GET("/api/v1/events", s.StreamSSE)
+	r.GET("/api/v1/events/ws", s.StreamWebSocket)
+}
+
+// StreamSSE streams the events as Server-Sent Events. An event whose client fell
+// behind ends the stream with an error event, the client reconnects with Last-Event-ID.
+func (s *LiveService) StreamSSE(ctx kratoshttp.Context) error {
+	kratoshttp.SetOperation(ctx, OperationLiveStreamSSE)
+	bearerFromQuery(ctx)
+	h := ctx.Middleware(func(c context.Context, _ any) (any, error) {
+		userID, err := currentUserID(c)
+		if err != nil {
+			return nil, err
+		}
+		req, err := bindStreamEventsRequest(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if id := ctx.Request().Header.Get("Last-Event-ID"); id != "" {
+			if req.LastEventId, err = strconv.ParseUint(id, 10, 64); err != nil {
+				return nil, ErrInvalidLastEventID
+			}
+		}
+		live, err := s.uc.Subscribe(c, userID, int(req.VehicleId), req.Types, req.LastEventId)
+		if err != nil {
+			return nil, err
+		}
+		defer live.Close()
+		w := &sseWriter{w: ctx.Response(), rc: http.NewResponseController(ctx.Response())}
+		// The stream outlives the request timeout of the server, a closed connection
+		// is detected by the failing writes instead.
+		err = pump(context.WithoutCancel(c), live, req.CoordType, w.send, w.heartbeat)
+		if err != nil && errors.FromError(err).Code == http.StatusTooManyRequests {
+			_ = w.write("event: error\ndata: %s\n\n", encodeLiveError(err))
+		}
+		return nil, nil
+	})
+	_, err := h(ctx, nil)
+	return err
+}
+
+// StreamWebSocket streams the events as JSON text messages over a WebSocket.
+// An event whose client fell behind closes it with 1013 (try again later),
+// the client reconnects with last_event_id.
+func (s *LiveService) StreamWebSocket(ctx kratoshttp.Context) error {
+	kratoshttp.SetOperation(ctx, OperationLiveStreamWebSocket)
+	bearerFromQuery(ctx)
+	h := ctx.Middleware(func(c context.Context, _ any) (any, error) {
+		userID, err := currentUserID(c)
+		if err != nil {
+			return nil, err
+		}
+		req, err := bindStreamEventsRequest(ctx)
+		if err != nil {
+			return nil, err
+		}
+		live, err := s.uc.Subscribe(c, userID, int(req.VehicleId), req.Types, req.LastEventId)
+		if err != nil {
+			return nil, err
+		}
+		defer live.Close()
+		conn, err := liveUpgrader.Upgrade(ctx.Response(), ctx.Request(), nil)
+		if err != nil {
+			// Upgrade already replied with an error.
+			s.log.WithContext(c).Debugw("msg", "websocket upgrade failed", "err", err)
+			return nil, nil
+		}
+		defer conn.Close()
+
+		// The stream outlives the request timeout of the server, it ends
+		// when the client closes the connection or stops answering pings.
+		streamCtx, cancel := context.WithCancel(context.WithoutCancel(c))
+		defer cancel()
+		go func() {
+			defer cancel()
+			conn.SetReadLimit(512)
+			_ = conn.SetReadDeadline(time.Now().Add(2 * liveHeartbeatInterval))
+			conn.SetPongHandler(func(string) error {
+				return conn.SetReadDeadline(time.Now().Add(2 * liveHeartbeatInterval))
+			})
+			for {
+				if _, _, err := conn.NextReader(); err != nil {
+					return
+				}
+			}
+		}()
+		send := func(e *v1.LiveEvent) error {
+			data, err := encoding.GetCodec(json.Name).Marshal(e)
+			if err != nil {
+				return err
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(liveWriteTimeout))
+			return conn.WriteMessage(websocket.TextMessage, data)
+		}
+		heartbeat := func() error {
+			return conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(liveWriteTimeout))
+		}
+		code, reason := websocket.CloseNormalClosure, ""
+		if err := pump(streamCtx, live, req.CoordType, send, heartbeat); err != nil {
+			code, reason = websocket.CloseInternalServerErr, errors.FromError(err).Reason
+			if errors.FromError(err).Code == http.StatusTooManyRequests {
+				code = websocket.CloseTryAgainLater
+			}
+		}
+		_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(liveWriteTimeout))
+		return nil, nil
+	})
+	_, err := h(ctx, nil)
+	return err
+}
+
+// pump sends the events of a live stream until ctx is done or sending fails.
+// A heartbeat opens the stream, followed by a reset event when events were missed
+// since the last event id.
+// Positions are sent in coordType. It returns biz.ErrLiveStreamOverflow when the client fell behind.
+func pump(ctx context.Context, stream *biz.LiveStream, coordType v1.CoordType, send func(*v1.LiveEvent) error, heartbeat func() error) error {
+	if err := heartbeat(); err != nil {
+		return err
+	}
+	if !stream.Complete {
+		if err := send(&v1.LiveEvent{Type: liveEventReset, Time: timestamppb.Now()}); err != nil {
+			return err
+		}
+	}
+	for _, e := range stream.Replay {
+		if err := send(toLiveEvent(e, coordType)); err != nil {
+			return err
+		}
+	}
+	ticker := time.NewTicker(liveHeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := heartbeat(); err != nil {
+				return err
+			}
+		case e, ok := <-stream.C:
+			if !ok {
+				return nil
+			}
+			if stream.Dropped() > 0 {
+				return biz.ErrLiveStreamOverflow
+			}
+			if err := send(toLiveEvent(e, coordType)); err != nil {
+				return err
+			}
+			ticker.Reset(liveHeartbeatInterval)
+		}
+	}
+}
+
+// bearerFromQuery moves the access_token query parameter to the Authorization header
+// unless the request has one, so that the jwt middleware authenticates browser streams.
+func bearerFromQuery(ctx kratoshttp.Context) {
+	header := ctx.Request().Header
+	if token := ctx.Query().Get("access_token"); token != "" && header.Get("Authorization") == "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+}
+
+// bindStreamEventsRequest reads the stream parameters from the query.
+// Types may also be given as a comma separated list.
+func bindStreamEventsRequest(ctx kratoshttp.Context) (*v1.StreamEventsRequest, error) {
+	var req v1.StreamEventsRequest
+	if err := ctx.BindQuery(&req); err != nil {
+		return nil, err
+	}
+	var types []string
+	for _, typ := range req.Types {
+		types = append(types, strings.Split(typ, ",")...)
+	}
+	req.Types = types
+	if err := validation.Validate(&req); err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+// sseWriter writes Server-Sent Events.
+type sseWriter struct {
+	w       http.ResponseWriter
+	rc      *http.ResponseController
+	started bool
+}
+
+func (w *sseWriter) send(e *v1.LiveEvent) error {
+	data, err := encoding.GetCodec(json.Name).Marshal(e)
+	if err != nil {
+		return err
+	}
+	if e.Id == 0 {
+		return w.write("event: %s\ndata: %s\n\n", e.Type, data)
+	}
+	return w.write("id: %d\nevent: %s\ndata: %s\n\n", e.Id, e.Type, data)
+}
+
+func (w *sseWriter) heartbeat() error {
+	return w.write(": %s\n\n", liveEventHeartbeat)
+}
+
+func (w *sseWriter) write(format string, args ...any) error {
+	if !w.started {
+		w.started = true
+		header := w.w.Header()
+		header.Set("Content-Type", "text/event-stream")
+		header.Set("Cache-Control", "no-cache")
+		header.Set("X-Accel-Buffering", "no")
+		w.w.WriteHeader(http.StatusOK)
+	}
+	// Not every ResponseWriter supports deadlines, the heartbeats still detect closed connections.
+	_ = w.rc.SetWriteDeadline(time.Now().Add(liveWriteTimeout))
+	if _, err := fmt.Fprintf(w.w, format, args...); err != nil {
+		return err
+	}
+	return w.rc.Flush()
+}
+
+// encodeLiveError encodes an error as the data of an SSE error event.
+func encodeLiveError(err error) []byte {
+	e := errors.FromError(err)
+	data, _ := encoding.GetCodec(json.Name).Marshal(&e.Status)
+	return data
+}
+
+// toLiveEvent maps a bus event to the stream message, positions in the datum.
+func toLiveEvent(e *biz.Event, coordType v1.CoordType) *v1.LiveEvent {
+	out := &v1.LiveEvent{Id: e.ID, Type: e.Type, VehicleId: int64(e.VehicleID), Time: timestamppb.New(e.Time)}
+	switch p := e.Payload.(type) {
+	case *biz.VehicleLatestState:
+		out.Payload = &v1.LiveEvent_Snapshot{Snapshot: toVehicleLatestState(p, coordType)}
+	case *biz.VehicleStateChange:
+		out.Payload = &v1.LiveEvent_StateChange{StateChange: &v1.LiveStateChange{From: p.From, To: p.To}}
+	case *biz.VehicleStatePeriod:
+		if p.State == biz.VehicleStateCharging {
+			out.Payload = &v1.LiveEvent_ChargingSession{ChargingSession: toChargingSessionInfo(p)}
+		} else {
+			out.Payload = &v1.LiveEvent_Drive{Drive: toDriveInfo(p)}
+		}
+	default:
+		if ve := biz.NewVehicleEvent(e); ve != nil {
+			out.Payload = &v1.LiveEvent_Event{Event: &v1.TimelineEvent{EventType: ve.Type, Summary: ve.Summary, Data: ve.Data}}
+		}
+	}
+	return out
+}
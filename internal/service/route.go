@@ -0,0 +1,250 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/internal/biz"
+	"teslatrack/pkg/geo"
+	"teslatrack/pkg/route"
+
+	"github.com/go-kratos/kratos/v2/log"
+	kratoshttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// Operations of the route export endpoints, used by the middleware selectors.
+const (
+	OperationRouteExportDrive = "/api.teslatrack.v1.Route/ExportDrive"
+	OperationRouteExportRange = "/api.teslatrack.v1.Route/ExportRange"
+	OperationRouteExportMonth = "/api.teslatrack.v1.Route/ExportMonth"
+)
+
+// ErrInvalidExportRequest is returned for malformed export parameters.
+var ErrInvalidExportRequest = v1.ErrorInvalidExportRequest("invalid export parameters")
+
+// RouteService streams drives as GPX, KML or GeoJSON files.
+// The files are written as they are encoded, so it is served by plain HTTP handlers
+// rather than a protobuf service.
+type RouteService struct {
+	uc  *biz.RouteUsecase
+	log *log.Helper
+}
+
+// NewRouteService creates a new RouteService.
+func NewRouteService(uc *biz.RouteUsecase, logger log.Logger) *RouteService {
+	return &RouteService{uc: uc, log: log.NewHelper(logger)}
+}
+
+// RegisterHTTP registers the export endpoints.
+//
+//	GET /api/v1/drives/{id}/route?format=gpx&coord_type=wgs84
+//	GET /api/v1/vehicles/{vehicle_id}/routes?from=2025-06-01&to=2025-06-08&format=kml
+//	GET /api/v1/vehicles/{vehicle_id}/routes/{month}?format=geojson, month as 2025-06, returns a zip archive
+//
+// GeoJSON is always in WGS-84, coord_type is only accepted for GPX and KML.
+func (s *RouteService) RegisterHTTP(srv *kratoshttp.Server) {
+	r := srv.Route("/")
+	r.GET("/api/v1/drives/{id}/route", s.ExportDrive)
+	r.GET("/api/v1/vehicles/{vehicle_id}/routes", s.ExportRange)
+	r.GET("/api/v1/vehicles/{vehicle_id}/routes/{month}", s.ExportMonth)
+}
+
+// ExportDrive streams a single drive.
+func (s *RouteService) ExportDrive(ctx kratoshttp.Context) error {
+	kratoshttp.SetOperation(ctx, OperationRouteExportDrive)
+	h := ctx.Middleware(func(c context.Context, _ any) (any, error) {
+		userID, err := currentUserID(c)
+		if err != nil {
+			return nil, err
+		}
+		driveID, err := strconv.Atoi(ctx.Vars().Get("id"))
+		if err != nil {
+			return nil, ErrInvalidExportRequest
+		}
+		format, coordType, err := exportOptions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		name := fmt.Sprintf("drive-%d", driveID)
+		w := newAttachmentWriter(ctx.Response(), format.ContentType(), name+"."+format.Extension())
+		enc, err := route.NewEncoder(w, format, name)
+		if err != nil {
+			return nil, ErrInvalidExportRequest
+		}
+		if err := s.uc.ExportDrive(c, userID, driveID, coordType, enc); err != nil {
+			return nil, err
+		}
+		return nil, enc.Close()
+	})
+	_, err := h(ctx, nil)
+	return err
+}
+
+// ExportRange streams every drive of a vehicle started in [from, to) as one file.
+// from and to accept RFC 3339 timestamps or dates, to defaults to now.
+func (s *RouteService) ExportRange(ctx kratoshttp.Context) error {
+	kratoshttp.SetOperation(ctx, OperationRouteExportRange)
+	h := ctx.Middleware(func(c context.Context, _ any) (any, error) {
+		userID, err := currentUserID(c)
+		if err != nil {
+			return nil, err
+		}
+		vehicleID, err := strconv.Atoi(ctx.Vars().Get("vehicle_id"))
+		if err != nil {
+			return nil, ErrInvalidExportRequest
+		}
+		format, coordType, err := exportOptions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		from, err := parseTime(ctx.Query().Get("from"), time.Time{})
+		if err != nil {
+			return nil, err
+		}
+		to, err := parseTime(ctx.Query().Get("to"), time.Now())
+		if err != nil {
+			return nil, err
+		}
+		name := fmt.Sprintf("vehicle-%d-routes", vehicleID)
+		w := newAttachmentWriter(ctx.Response(), format.ContentType(), name+"."+format.Extension())
+		enc, err := route.NewEncoder(w, format, name)
+		if err != nil {
+			return nil, ErrInvalidExportRequest
+		}
+		if _, err := s.uc.ExportRange(c, userID, vehicleID, from, to, coordType, enc); err != nil {
+			return nil, err
+		}
+		return nil, enc.Close()
+	})
+	_, err := h(ctx, nil)
+	return err
+}
+
+// ExportMonth streams a zip archive with one file per drive of a calendar month.
+// The month boundaries are taken in the time zone given by the tz query parameter, the server zone by default.
+func (s *RouteService) ExportMonth(ctx kratoshttp.Context) error {
+	kratoshttp.SetOperation(ctx, OperationRouteExportMonth)
+	h := ctx.Middleware(func(c context.Context, _ any) (any, error) {
+		userID, err := currentUserID(c)
+		if err != nil {
+			return nil, err
+		}
+		vehicleID, err := strconv.Atoi(ctx.Vars().Get("vehicle_id"))
+		if err != nil {
+			return nil, ErrInvalidExportRequest
+		}
+		format, coordType, err := exportOptions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loc := time.Local
+		if tz := ctx.Query().Get("tz"); tz != "" {
+			if loc, err = biz.LoadLocation(tz); err != nil {
+				return nil, err
+			}
+		}
+		month, err := time.ParseInLocation("2006-01", ctx.Vars().Get("month"), loc)
+		if err != nil {
+			return nil, ErrInvalidExportRequest
+		}
+		drives, err := s.uc.Drives(c, userID, vehicleID, month, month.AddDate(0, 1, 0))
+		if err != nil {
+			return nil, err
+		}
+
+		name := fmt.Sprintf("vehicle-%d-%s", vehicleID, month.Format("2006-01"))
+		zw := zip.NewWriter(newAttachmentWriter(ctx.Response(), "application/zip", name+".zip"))
+		for _, drive := range drives {
+			track, err := s.uc.Track(c, drive, coordType)
+			if err != nil {
+				return nil, err
+			}
+			fileName := fmt.Sprintf("%s-drive-%d.%s", drive.StartAt.In(loc).Format("2006-01-02T1504"), drive.ID, format.Extension())
+			f, err := zw.CreateHeader(&zip.FileHeader{Name: fileName, Method: zip.Deflate, Modified: drive.StartAt})
+			if err != nil {
+				return nil, err
+			}
+			enc, err := route.NewEncoder(f, format, track.Name)
+			if err != nil {
+				return nil, ErrInvalidExportRequest
+			}
+			if err := enc.Encode(track); err != nil {
+				return nil, err
+			}
+			if err := enc.Close(); err != nil {
+				return nil, err
+			}
+		}
+		return nil, zw.Close()
+	})
+	_, err := h(ctx, nil)
+	return err
+}
+
+// exportOptions parses the format and coord_type query parameters, defaulting to GPX in WGS-84.
+// GeoJSON is always in WGS-84 as RFC 7946 requires, other datums are rejected for it.
+func exportOptions(ctx kratoshttp.Context) (route.Format, geo.CoordType, error) {
+	format, coordType := route.GPX, geo.WGS84
+	if v := ctx.Query().Get("format"); v != "" {
+		f, ok := route.ParseFormat(v)
+		if !ok {
+			return "", "", ErrInvalidExportRequest
+		}
+		format = f
+	}
+	if v := ctx.Query().Get("coord_type"); v != "" {
+		c, ok := geo.ParseCoordType(v)
+		if !ok {
+			return "", "", ErrInvalidExportRequest
+		}
+		coordType = c
+	}
+	if format == route.GeoJSON && coordType != geo.WGS84 {
+		return "", "", ErrInvalidExportRequest
+	}
+	return format, coordType, nil
+}
+
+// parseTime parses an RFC 3339 timestamp or a date in the server time zone.
+func parseTime(v string, def time.Time) (time.Time, error) {
+	if v == "" {
+		return def, nil
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", v, time.Local)
+	if err != nil {
+		return time.Time{}, ErrInvalidExportRequest
+	}
+	return t, nil
+}
+
+// attachmentWriter sets the download headers on the first write, so errors raised
+// before any output can still be encoded as regular error replies.
+type attachmentWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	fileName    string
+	started     bool
+}
+
+func newAttachmentWriter(w http.ResponseWriter, contentType, fileName string) *attachmentWriter {
+	return &attachmentWriter{w: w, contentType: contentType, fileName: fileName}
+}
+
+// Write implements io.Writer.
+func (a *attachmentWriter) Write(p []byte) (int, error) {
+	if !a.started {
+		a.started = true
+		a.w.Header().Set("Content-Type", a.contentType)
+		a.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", a.fileName))
+		a.w.WriteHeader(http.StatusOK)
+	}
+	return a.w.Write(p)
+}
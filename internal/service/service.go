@@ -3,4 +3,4 @@ package service
 import "github.com/google/wire"
 
 // ProviderSet is service providers.
-var ProviderSet = wire.NewSet(NewGreeterService, NewAuthorizeService)
+var ProviderSet = wire.NewSet(NewAuthorizeService, NewGeofenceService, NewRouteService, NewTariffService, NewAnalyticsService, NewStatisticsService, NewUserService, NewSoftwareService, NewTireService, NewTimelineService, NewVehicleService, NewDriveService, NewChargingService, NewLiveService, NewSigninService, NewSignupService)
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// SigninService is the service implementation for the Signin API.
+type SigninService struct {
+	v1.UnimplementedSigninServer
+
+	uc  *biz.AccountUsecase
+	log *log.Helper
+}
+
+// NewSigninService creates a new SigninService.
+func NewSigninService(uc *biz.AccountUsecase, logger log.Logger) *SigninService {
+	return &SigninService{uc: uc, log: log.NewHelper(logger)}
+}
+
+// Identifier handles the RPC for signing in with account and password.
+// No refresh token is issued, clients sign in again once the access token expired.
+func (s *SigninService) Identifier(ctx context.Context, req *v1.IdentifierRequest) (*v1.IdentifierReply, error) {
+	token, err := s.uc.Signin(ctx, req.Account, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.IdentifierReply{AccessToken: token.Token, ExpireAt: token.ExpireAt.Unix()}, nil
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// SignupService is the service implementation for the Signup API.
+type SignupService struct {
+	v1.UnimplementedSignupServer
+
+	uc  *biz.AccountUsecase
+	log *log.Helper
+}
+
+// NewSignupService creates a new SignupService.
+func NewSignupService(uc *biz.AccountUsecase, logger log.Logger) *SignupService {
+	return &SignupService{uc: uc, log: log.NewHelper(logger)}
+}
+
+// CreateSignup handles the RPC for registering an account.
+func (s *SignupService) CreateSignup(ctx context.Context, req *v1.CreateSignupRequest) (*v1.CreateSignupReply, error) {
+	if _, err := s.uc.Signup(ctx, req.Account, req.Password, req.AskedCode); err != nil {
+		return nil, err
+	}
+	return &v1.CreateSignupReply{}, nil
+}
+
+// VerifySignup handles the RPC for checking whether an account is registered.
+func (s *SignupService) VerifySignup(ctx context.Context, req *v1.VerifySignupRequest) (*v1.VerifySignupReply, error) {
+	exists, err := s.uc.Exists(ctx, req.Account)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.VerifySignupReply{IsRepeated: exists}, nil
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SoftwareService is the service implementation for the Software API.
+type SoftwareService struct {
+	v1.UnimplementedSoftwareServer
+
+	uc  *biz.SoftwareUsecase
+	log *log.Helper
+}
+
+// NewSoftwareService creates a new SoftwareService.
+func NewSoftwareService(uc *biz.SoftwareUsecase, logger log.Logger) *SoftwareService {
+	return &SoftwareService{uc: uc, log: log.NewHelper(logger)}
+}
+
+// ListSoftwareUpdates handles the RPC for the software update history of a vehicle.
+func (s *SoftwareService) ListSoftwareUpdates(ctx context.Context, req *v1.ListSoftwareUpdatesRequest) (*v1.ListSoftwareUpdatesReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	updates, err := s.uc.History(ctx, userID, int(req.VehicleId))
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListSoftwareUpdatesReply{Updates: make([]*v1.SoftwareUpdateInfo, 0, len(updates))}
+	for _, u := range updates {
+		info := &v1.SoftwareUpdateInfo{
+			Id:               int64(u.ID),
+			FromVersion:      u.FromVersion,
+			Version:          u.Version,
+			Status:           u.Status,
+			DownloadPerc:     int32(u.DownloadPerc),
+			InstallPerc:      int32(u.InstallPerc),
+			ExpectedDuration: int64(u.ExpectedDuration.Seconds()),
+			AvailableAt:      timestamppb.New(u.AvailableAt),
+		}
+		if u.InstallStartedAt != nil {
+			info.InstallStartedAt = timestamppb.New(*u.InstallStartedAt)
+		}
+		if u.CompletedAt != nil {
+			info.CompletedAt = timestamppb.New(*u.CompletedAt)
+		}
+		reply.Updates = append(reply.Updates, info)
+	}
+	return reply, nil
+}
+
+// GetFleetVersions handles the RPC for the fleet version statistics.
+func (s *SoftwareService) GetFleetVersions(ctx context.Context, _ *v1.GetFleetVersionsRequest) (*v1.GetFleetVersionsReply, error) {
+	if _, err := currentUserID(ctx); err != nil {
+		return nil, err
+	}
+	fleet, err := s.uc.FleetVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetFleetVersionsReply{
+		Vehicles: int32(fleet.Vehicles),
+		Versions: make([]*v1.VersionCount, 0, len(fleet.Versions)),
+	}
+	for _, c := range fleet.Versions {
+		reply.Versions = append(reply.Versions, &v1.VersionCount{
+			Version:          c.Version,
+			Vehicles:         int32(c.Vehicles),
+			Share:            c.Share,
+			Installs:         int32(c.Installs),
+			RecentInstalls:   int32(c.RecentInstalls),
+			FirstInstalledAt: timestamppb.New(c.FirstInstalledAt),
+		})
+	}
+	return reply, nil
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultRollupCount is the number of buckets listed when the request names no start.
+const defaultRollupCount = 30
+
+// StatisticsService is the service implementation for the Statistics API.
+type StatisticsService struct {
+	v1.UnimplementedStatisticsServer
+
+	uc  *biz.RollupUsecase
+	log *log.Helper
+}
+
+// NewStatisticsService creates a new StatisticsService.
+func NewStatisticsService(uc *biz.RollupUsecase, logger log.Logger) *StatisticsService {
+	return &StatisticsService{uc: uc, log: log.NewHelper(logger)}
+}
+
+// ListRollups handles the RPC for listing the rollups of a vehicle.
+func (s *StatisticsService) ListRollups(ctx context.Context, req *v1.ListRollupsRequest) (*v1.ListRollupsReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	to := time.Now()
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+	from := rollupRangeStart(to, req.Granularity)
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	rollups, err := s.uc.List(ctx, userID, int(req.VehicleId), req.Granularity, from, to)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListRollupsReply{Rollups: make([]*v1.Rollup, 0, len(rollups))}
+	for _, r := range rollups {
+		reply.TimeZone = r.TimeZone
+		reply.Rollups = append(reply.Rollups, &v1.Rollup{
+			StartAt:        timestamppb.New(r.StartAt),
+			EndAt:          timestamppb.New(r.EndAt),
+			Drives:         int32(r.Drives),
+			Distance:       r.Distance,
+			DriveDuration:  int64(r.DriveDuration.Seconds()),
+			EnergyUsed:     r.EnergyUsed,
+			Efficiency:     r.Efficiency,
+			MaxSpeed:       r.MaxSpeed,
+			Charges:        int32(r.Charges),
+			EnergyAdded:    r.EnergyAdded,
+			ChargeCost:     r.ChargeCost,
+			ParkedDuration: int64(r.ParkedDuration.Seconds()),
+			AsleepDuration: int64(r.AsleepDuration.Seconds()),
+		})
+	}
+	return reply, nil
+}
+
+// rollupRangeStart returns the start of the default range ending at to.
+func rollupRangeStart(to time.Time, granularity string) time.Time {
+	switch granularity {
+	case biz.GranularityWeek:
+		return to.AddDate(0, 0, -7*defaultRollupCount)
+	case biz.GranularityMonth:
+		return to.AddDate(0, -defaultRollupCount, 0)
+	}
+	return to.AddDate(0, 0, -defaultRollupCount)
+}
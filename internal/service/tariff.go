@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/internal/biz"
+	"teslatrack/pkg/pricing"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ErrInvalidMonth is returned for months not formatted as YYYY-MM.
+var ErrInvalidMonth = v1.ErrorInvalidMonth("month must be YYYY-MM")
+
+// TariffService is the service implementation for the Tariff API.
+type TariffService struct {
+	v1.UnimplementedTariffServer
+
+	uc  *biz.TariffUsecase
+	log *log.Helper
+}
+
+// NewTariffService creates a new TariffService.
+func NewTariffService(uc *biz.TariffUsecase, logger log.Logger) *TariffService {
+	return &TariffService{uc: uc, log: log.NewHelper(logger)}
+}
+
+// CreateTariff handles the RPC for creating a tariff of the signed in user.
+func (s *TariffService) CreateTariff(ctx context.Context, req *v1.CreateTariffRequest) (*v1.TariffReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t := toBizTariff(req.Tariff)
+	t.UserID = userID
+	if err := s.uc.Create(ctx, t); err != nil {
+		return nil, err
+	}
+	return &v1.TariffReply{Tariff: toTariffInfo(t)}, nil
+}
+
+// UpdateTariff handles the RPC for updating a tariff of the signed in user.
+func (s *TariffService) UpdateTariff(ctx context.Context, req *v1.UpdateTariffRequest) (*v1.TariffReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t := toBizTariff(req.Tariff)
+	t.ID = int(req.Id)
+	if err := s.uc.Update(ctx, userID, t); err != nil {
+		return nil, err
+	}
+	return &v1.TariffReply{Tariff: toTariffInfo(t)}, nil
+}
+
+// DeleteTariff handles the RPC for deleting a tariff of the signed in user.
+func (s *TariffService) DeleteTariff(ctx context.Context, req *v1.DeleteTariffRequest) (*v1.DeleteTariffReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.uc.Delete(ctx, userID, int(req.Id)); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteTariffReply{}, nil
+}
+
+// ListTariffs handles the RPC for listing the tariffs of the signed in user.
+func (s *TariffService) ListTariffs(ctx context.Context, _ *v1.ListTariffsRequest) (*v1.ListTariffsReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tariffs, err := s.uc.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListTariffsReply{Tariffs: make([]*v1.TariffInfo, 0, len(tariffs))}
+	for _, t := range tariffs {
+		reply.Tariffs = append(reply.Tariffs, toTariffInfo(t))
+	}
+	return reply, nil
+}
+
+// SetChargeCost handles the RPC for entering the cost of a charging session.
+func (s *TariffService) SetChargeCost(ctx context.Context, req *v1.SetChargeCostRequest) (*v1.SetChargeCostReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.uc.SetManualCost(ctx, userID, int(req.Id), req.Cost)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SetChargeCostReply{Cost: session.Cost, Manual: session.CostManual}, nil
+}
+
+// RecalculateChargeCosts handles the RPC for pricing charging sessions again.
+func (s *TariffService) RecalculateChargeCosts(ctx context.Context, req *v1.RecalculateChargeCostsRequest) (*v1.RecalculateChargeCostsReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	from, to := time.Time{}, time.Now()
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+	updated, err := s.uc.Recalculate(ctx, userID, int(req.VehicleId), from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RecalculateChargeCostsReply{Updated: int32(updated)}, nil
+}
+
+// GetMonthlyCost handles the RPC for the monthly charging cost of a vehicle.
+func (s *TariffService) GetMonthlyCost(ctx context.Context, req *v1.GetMonthlyCostRequest) (*v1.GetMonthlyCostReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	month, err := parseMonth(req.Month, req.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.uc.Monthly(ctx, userID, int(req.VehicleId), month)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetMonthlyCostReply{
+		Sessions:         int32(m.Sessions),
+		EnergyAdded:      m.EnergyAdded,
+		Cost:             m.Cost,
+		UnpricedSessions: int32(m.UnpricedSessions),
+		Distance:         m.Distance,
+		CostPer_100Km:    m.CostPer100Km(),
+	}, nil
+}
+
+// parseMonth parses a YYYY-MM month in a time zone, Asia/Shanghai when empty.
+func parseMonth(month, timeZone string) (time.Time, error) {
+	loc, err := biz.LoadLocation(timeZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, err := time.ParseInLocation("2006-01", month, loc)
+	if err != nil {
+		return time.Time{}, ErrInvalidMonth
+	}
+	return t, nil
+}
+
+// toBizTariff maps a tariff sent by the client to the business model.
+func toBizTariff(info *v1.TariffInfo) *biz.Tariff {
+	if info == nil {
+		return &biz.Tariff{}
+	}
+	t := &biz.Tariff{
+		Name:     info.Name,
+		Kind:     info.Kind,
+		Currency: info.Currency,
+		Price:    info.Price,
+		TimeZone: info.TimeZone,
+	}
+	if info.GeofenceId != 0 {
+		id := int(info.GeofenceId)
+		t.GeofenceID = &id
+	}
+	for _, w := range info.Windows {
+		t.Windows = append(t.Windows, pricing.Window{Name: w.Name, Start: w.Start, End: w.End, Price: w.Price})
+	}
+	return t
+}
+
+// toTariffInfo maps a tariff to the reply.
+func toTariffInfo(t *biz.Tariff) *v1.TariffInfo {
+	info := &v1.TariffInfo{
+		Id:       int64(t.ID),
+		Name:     t.Name,
+		Kind:     t.Kind,
+		Currency: t.Currency,
+		Price:    t.Price,
+		TimeZone: t.TimeZone,
+	}
+	if t.GeofenceID != nil {
+		info.GeofenceId = int64(*t.GeofenceID)
+	}
+	for _, w := range t.Windows {
+		info.Windows = append(info.Windows, &v1.TariffWindow{Name: w.Name, Start: w.Start, End: w.End, Price: w.Price})
+	}
+	return info
+}
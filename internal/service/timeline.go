@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TimelineService is the service implementation for the Timeline API.
+type TimelineService struct {
+	v1.UnimplementedTimelineServer
+
+	uc  *biz.TimelineUsecase
+	log *log.Helper
+}
+
+// NewTimelineService creates a new TimelineService.
+func NewTimelineService(uc *biz.TimelineUsecase, logger log.Logger) *TimelineService {
+	return &TimelineService{uc: uc, log: log.NewHelper(logger)}
+}
+
+// ListTimeline handles the RPC for the timeline of a vehicle.
+func (s *TimelineService) ListTimeline(ctx context.Context, req *v1.ListTimelineRequest) (*v1.ListTimelineReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter := &biz.TimelineFilter{
+		Types:  req.Types,
+		Cursor: req.Cursor,
+		Limit:  int(req.PageSize),
+	}
+	if req.From != nil {
+		filter.From = req.From.AsTime()
+	}
+	if req.To != nil {
+		filter.To = req.To.AsTime()
+	}
+	timeline, err := s.uc.List(ctx, userID, int(req.VehicleId), filter)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListTimelineReply{
+		Items:      make([]*v1.TimelineItem, 0, len(timeline.Items)),
+		NextCursor: timeline.NextCursor,
+	}
+	for _, item := range timeline.Items {
+		reply.Items = append(reply.Items, toTimelineItem(item))
+	}
+	return reply, nil
+}
+
+// toTimelineItem maps a timeline item to the reply.
+func toTimelineItem(item *biz.TimelineItem) *v1.TimelineItem {
+	out := &v1.TimelineItem{Type: item.Type, StartAt: timestamppb.New(item.StartAt)}
+	if item.EndAt != nil {
+		out.EndAt = timestamppb.New(*item.EndAt)
+	}
+	switch {
+	case item.Period != nil:
+		p := item.Period
+		out.Id = int64(p.ID)
+		out.Detail = &v1.TimelineItem_Period{Period: &v1.TimelinePeriod{
+			StartBatteryLevel: int32(p.StartBatteryLevel),
+			EndBatteryLevel:   int32(p.EndBatteryLevel),
+			Distance:          max(p.EndOdometer-p.StartOdometer, 0),
+			StartAddress:      p.StartAddress,
+			EndAddress:        p.EndAddress,
+			EnergyAdded:       p.EnergyAdded,
+			EnergyUsed:        p.EnergyUsed,
+			Cost:              p.Cost,
+			ChargeLocation:    p.ChargeLocation,
+			FastCharger:       p.FastCharger,
+			SentryMode:        p.SentryMode,
+		}}
+	case item.Update != nil:
+		u := item.Update
+		out.Id = int64(u.ID)
+		update := &v1.TimelineSoftwareUpdate{FromVersion: u.FromVersion, Version: u.Version}
+		if u.InstallStartedAt != nil {
+			update.InstallStartedAt = timestamppb.New(*u.InstallStartedAt)
+		}
+		out.Detail = &v1.TimelineItem_SoftwareUpdate{SoftwareUpdate: update}
+	case item.Event != nil:
+		e := item.Event
+		out.Id = int64(e.ID)
+		out.Detail = &v1.TimelineItem_Event{Event: &v1.TimelineEvent{EventType: e.Type, Summary: e.Summary, Data: e.Data}}
+	}
+	return out
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/internal/biz"
+	"teslatrack/pkg/tpms"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultTireHistory is the history listed when the request names no start.
+const defaultTireHistory = 30 * 24 * time.Hour
+
+// ErrInvalidPressureUnit is returned for pressure units other than bar, kpa and psi.
+var ErrInvalidPressureUnit = v1.ErrorInvalidPressureUnit("unit must be bar, kpa or psi")
+
+// TireService is the service implementation for the Tire API.
+type TireService struct {
+	v1.UnimplementedTireServer
+
+	uc  *biz.TireUsecase
+	log *log.Helper
+}
+
+// NewTireService creates a new TireService.
+func NewTireService(uc *biz.TireUsecase, logger log.Logger) *TireService {
+	return &TireService{uc: uc, log: log.NewHelper(logger)}
+}
+
+// GetTireStatus handles the RPC for the tire status of a vehicle.
+func (s *TireService) GetTireStatus(ctx context.Context, req *v1.GetTireStatusRequest) (*v1.GetTireStatusReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status, err := s.uc.Status(ctx, userID, int(req.VehicleId))
+	if err != nil {
+		return nil, err
+	}
+	vehicleUnit := ""
+	if status.Latest != nil {
+		vehicleUnit = status.Latest.Unit
+	}
+	unit, err := pressureUnit(req.Unit, vehicleUnit)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.GetTireStatusReply{Unit: string(unit)}
+	if status.Latest == nil {
+		return reply, nil
+	}
+	reply.Latest = toTirePressureReading(status.Latest, unit)
+	for _, w := range tpms.Wheels {
+		alert := &v1.WheelAlert{Wheel: w.String(), Low: status.Low[w]}
+		for _, leak := range status.Leaks {
+			if leak.Wheel == w {
+				alert.LeakRate = tpms.Convert(leak.Rate, tpms.Bar, unit)
+			}
+		}
+		if alert.Low || alert.LeakRate > 0 {
+			reply.Alerts = append(reply.Alerts, alert)
+		}
+	}
+	return reply, nil
+}
+
+// ListTirePressures handles the RPC for the tire pressure history of a vehicle.
+func (s *TireService) ListTirePressures(ctx context.Context, req *v1.ListTirePressuresRequest) (*v1.ListTirePressuresReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	to := time.Now()
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+	from := to.Add(-defaultTireHistory)
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	readings, err := s.uc.History(ctx, userID, int(req.VehicleId), from, to)
+	if err != nil {
+		return nil, err
+	}
+	vehicleUnit := ""
+	if len(readings) > 0 {
+		vehicleUnit = readings[len(readings)-1].Unit
+	}
+	unit, err := pressureUnit(req.Unit, vehicleUnit)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListTirePressuresReply{Unit: string(unit), Readings: make([]*v1.TirePressureReading, 0, len(readings))}
+	for _, r := range readings {
+		reply.Readings = append(reply.Readings, toTirePressureReading(r, unit))
+	}
+	return reply, nil
+}
+
+// pressureUnit resolves the requested unit, falling back to the unit shown in the vehicle and then bar.
+func pressureUnit(requested, vehicle string) (tpms.Unit, error) {
+	if requested != "" {
+		unit, ok := tpms.ParseUnit(requested)
+		if !ok {
+			return "", ErrInvalidPressureUnit
+		}
+		return unit, nil
+	}
+	if unit, ok := tpms.ParseUnit(vehicle); ok {
+		return unit, nil
+	}
+	return tpms.Bar, nil
+}
+
+// toTirePressureReading maps a reading to the reply in the given unit.
+func toTirePressureReading(p *biz.TirePressure, unit tpms.Unit) *v1.TirePressureReading {
+	reading := &v1.TirePressureReading{Time: timestamppb.New(p.CreatedAt)}
+	for _, w := range tpms.Wheels {
+		wheel := &v1.WheelPressure{
+			Wheel:       w.String(),
+			Pressure:    tpms.Convert(p.Pressure[w], tpms.Bar, unit),
+			Recommended: tpms.Convert(p.Recommended(w), tpms.Bar, unit),
+			SoftWarning: p.SoftWarning[w],
+			HardWarning: p.HardWarning[w],
+		}
+		if !p.SeenAt[w].IsZero() {
+			wheel.SeenAt = timestamppb.New(p.SeenAt[w])
+		}
+		reading.Wheels = append(reading.Wheels, wheel)
+	}
+	return reading
+}
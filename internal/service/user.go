@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// UserService is the service implementation for the User API.
+type UserService struct {
+	v1.UnimplementedUserServer
+
+	uc  *biz.UserUsecase
+	log *log.Helper
+}
+
+// NewUserService creates a new UserService.
+func NewUserService(uc *biz.UserUsecase, logger log.Logger) *UserService {
+	return &UserService{uc: uc, log: log.NewHelper(logger)}
+}
+
+// GetUser handles the RPC for the signed in user.
+func (s *UserService) GetUser(ctx context.Context, _ *v1.GetUserRequest) (*v1.UserReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.uc.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UserReply{User: toUserInfo(u)}, nil
+}
+
+// UpdateTimeZone handles the RPC for changing the time zone of the signed in user.
+func (s *UserService) UpdateTimeZone(ctx context.Context, req *v1.UpdateTimeZoneRequest) (*v1.UserReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.uc.SetTimeZone(ctx, userID, req.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.UserReply{User: toUserInfo(u)}, nil
+}
+
+// toUserInfo maps a user to the reply.
+func toUserInfo(u *biz.User) *v1.UserInfo {
+	return &v1.UserInfo{
+		Id:       int64(u.ID),
+		Account:  u.Account,
+		TimeZone: u.TimeZone,
+	}
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+
+	v1 "teslatrack/api/teslatrack/v1"
+	"teslatrack/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// VehicleService is the service implementation for the Vehicle API.
+type VehicleService struct {
+	v1.UnimplementedVehicleServer
+
+	uc  *biz.VehicleUsecase
+	log *log.Helper
+}
+
+// NewVehicleService creates a new VehicleService.
+func NewVehicleService(uc *biz.VehicleUsecase, logger log.Logger) *VehicleService {
+	return &VehicleService{uc: uc, log: log.NewHelper(logger)}
+}
+
+// ListVehicles handles the RPC for the vehicles of the user.
+func (s *VehicleService) ListVehicles(ctx context.Context, _ *v1.ListVehiclesRequest) (*v1.ListVehiclesReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vehicles, err := s.uc.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListVehiclesReply{Vehicles: make([]*v1.VehicleInfo, 0, len(vehicles))}
+	for _, veh := range vehicles {
+		reply.Vehicles = append(reply.Vehicles, toVehicleInfo(veh))
+	}
+	return reply, nil
+}
+
+// GetVehicle handles the RPC for a vehicle of the user.
+func (s *VehicleService) GetVehicle(ctx context.Context, req *v1.GetVehicleRequest) (*v1.VehicleInfo, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	veh, err := s.uc.Get(ctx, userID, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return toVehicleInfo(veh), nil
+}
+
+// GetLatestState handles the RPC for the latest state of a vehicle.
+func (s *VehicleService) GetLatestState(ctx context.Context, req *v1.GetLatestStateRequest) (*v1.VehicleLatestState, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.uc.LatestState(ctx, userID, int(req.VehicleId), req.ForceRefresh)
+	if err != nil {
+		return nil, err
+	}
+	return toVehicleLatestState(state, req.CoordType), nil
+}
+
+// toVehicleInfo maps a vehicle to the reply.
+func toVehicleInfo(veh *biz.VehicleOverview) *v1.VehicleInfo {
+	info := &v1.VehicleInfo{
+		Id:          int64(veh.ID),
+		Vin:         veh.VIN,
+		DisplayName: veh.DisplayName,
+		AccessType:  veh.AccessType,
+		State:       veh.State,
+	}
+	if veh.LastSeenAt != nil {
+		info.LastSeenAt = timestamppb.New(*veh.LastSeenAt)
+	}
+	return info
+}
+
+// toVehicleLatestState maps the latest state of a vehicle to the reply, its position in the datum.
+func toVehicleLatestState(state *biz.VehicleLatestState, coordType v1.CoordType) *v1.VehicleLatestState {
+	out := &v1.VehicleLatestState{
+		Vehicle: toVehicleInfo(&biz.VehicleOverview{Vehicle: state.Vehicle, LastSeenAt: state.LastSeenAt}),
+	}
+	_, out.CoordType = resolveCoordType(coordType)
+	if sn := state.Snapshot; sn != nil {
+		out.DataAt = timestamppb.New(sn.CreatedAt)
+		out.BatteryLevel = int32(sn.BatteryLevel)
+		out.UsableBatteryLevel = int32(sn.UsableBatteryLevel)
+		out.BatteryRange = sn.BatteryRange
+		out.IdealBatteryRange = sn.IdealBatteryRange
+		out.EstBatteryRange = sn.EstBatteryRange
+		out.ChargingState = sn.ChargingState
+		out.ChargerPower = int32(sn.ChargerPower)
+		pos := toCoordinate(sn.Latitude, sn.Longitude, coordType)
+		out.Latitude, out.Longitude = pos.Latitude, pos.Longitude
+		out.Heading = int32(sn.Heading)
+		out.ShiftState = sn.ShiftState
+		out.Speed = sn.Speed
+		out.InsideTemp = sn.InsideTemp
+		out.OutsideTemp = sn.OutsideTemp
+		out.ClimateOn = sn.ClimateOn
+		out.ClimateKeeperMode = sn.ClimateKeeperMode
+		out.Locked = sn.Locked
+		out.SentryMode = sn.SentryMode
+		out.Odometer = sn.Odometer
+		out.CarVersion = sn.CarVersion
+		out.SoftwareUpdateStatus = sn.SoftwareUpdateStatus
+	}
+	return out
+}
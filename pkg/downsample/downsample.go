@@ -0,0 +1,57 @@
+// Package downsample reduces time series and tracks to a number of points
+// that keeps their visual shape.
+package downsample
+
+// LTTB selects at most threshold of the n points with the Largest-Triangle-Three-Buckets algorithm
+// and returns their indices in ascending order. The first and last points are always kept.
+// xy returns the coordinates of point i, e.g., the time and value of a sample or
+// the longitude and latitude of a position. All indices are returned when n <= threshold
+// or threshold < 3.
+func LTTB(n, threshold int, xy func(i int) (x, y float64)) []int {
+	if n <= threshold || threshold < 3 {
+		all := make([]int, n)
+		for i := range all {
+			all[i] = i
+		}
+		return all
+	}
+	selected := make([]int, 0, threshold)
+	selected = append(selected, 0)
+	// The points between the first and the last are split into threshold-2 buckets.
+	size := float64(n-2) / float64(threshold-2)
+	a := 0
+	for b := 0; b < threshold-2; b++ {
+		start := int(float64(b)*size) + 1
+		end := int(float64(b+1)*size) + 1
+		// The third vertex is the average of the next bucket, or the last point.
+		nextStart, nextEnd := end, min(int(float64(b+2)*size)+1, n)
+		if b == threshold-3 {
+			nextStart, nextEnd = n-1, n
+		}
+		var avgX, avgY float64
+		for i := nextStart; i < nextEnd; i++ {
+			x, y := xy(i)
+			avgX += x
+			avgY += y
+		}
+		count := float64(nextEnd - nextStart)
+		avgX /= count
+		avgY /= count
+
+		ax, ay := xy(a)
+		best, bestArea := start, -1.0
+		for i := start; i < end; i++ {
+			x, y := xy(i)
+			area := (ax-avgX)*(y-ay) - (ax-x)*(avgY-ay)
+			if area < 0 {
+				area = -area
+			}
+			if area > bestArea {
+				best, bestArea = i, area
+			}
+		}
+		selected = append(selected, best)
+		a = best
+	}
+	return append(selected, n-1)
+}
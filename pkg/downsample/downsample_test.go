@@ -0,0 +1,51 @@
+package downsample
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLTTBKeepsShortSeries(t *testing.T) {
+	got := LTTB(4, 10, func(i int) (float64, float64) { return float64(i), 0 })
+	if len(got) != 4 {
+		t.Fatalf("LTTB() = %v, want all 4 indices", got)
+	}
+	for i, idx := range got {
+		if idx != i {
+			t.Fatalf("LTTB()[%d] = %d, want %d", i, idx, i)
+		}
+	}
+}
+
+func TestLTTBThreshold(t *testing.T) {
+	const n = 1000
+	got := LTTB(n, 50, func(i int) (float64, float64) { return float64(i), math.Sin(float64(i) / 50) })
+	if len(got) != 50 {
+		t.Fatalf("len(LTTB()) = %d, want 50", len(got))
+	}
+	if got[0] != 0 || got[len(got)-1] != n-1 {
+		t.Errorf("LTTB() ends = %d, %d, want 0, %d", got[0], got[len(got)-1], n-1)
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i] <= got[i-1] {
+			t.Fatalf("LTTB() not ascending at %d: %v", i, got)
+		}
+	}
+}
+
+func TestLTTBKeepsSpike(t *testing.T) {
+	// A flat series with one spike: the spike must survive downsampling.
+	const n, spike = 100, 37
+	got := LTTB(n, 10, func(i int) (float64, float64) {
+		if i == spike {
+			return float64(i), 100
+		}
+		return float64(i), 0
+	})
+	for _, idx := range got {
+		if idx == spike {
+			return
+		}
+	}
+	t.Errorf("LTTB() = %v, want the spike at %d", got, spike)
+}
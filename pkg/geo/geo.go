@@ -0,0 +1,251 @@
+// Package geo converts coordinates between the datums used by map providers in China.
+//
+// GPS receivers report WGS-84 coordinates, while Chinese map SDKs (Amap, Tencent)
+// expect the obfuscated GCJ-02 datum and Baidu expects its own BD-09 datum derived
+// from GCJ-02. Positions outside mainland China are identical in all three datums.
+package geo
+
+import (
+	"math"
+	"strings"
+)
+
+// CoordType is a geodetic datum.
+type CoordType string
+
+const (
+	// WGS84 is the datum used by GPS and most international map providers.
+	WGS84 CoordType = "wgs84"
+	// GCJ02 is the datum required by Amap and Tencent maps in China.
+	GCJ02 CoordType = "gcj02"
+	// BD09 is the datum required by Baidu maps.
+	BD09 CoordType = "bd09"
+)
+
+const (
+	// krasovskyA is the semi-major axis of the Krasovsky 1940 ellipsoid used by GCJ-02.
+	krasovskyA = 6378245.0
+	// krasovskyEE is the square of the eccentricity of the Krasovsky 1940 ellipsoid.
+	krasovskyEE = 0.00669342162296594323
+	// bdPi is the constant used by the BD-09 transformation.
+	bdPi = math.Pi * 3000.0 / 180.0
+	// inverseTolerance is the precision in degrees of the iterative inversions, about 1cm.
+	inverseTolerance = 1e-7
+	// earthRadius is the mean radius of the earth in metres.
+	earthRadius = 6371008.8
+)
+
+// ParseCoordType parses a datum name such as "wgs", "gcj02" or "bd09ll".
+func ParseCoordType(s string) (CoordType, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "wgs", "wgs84", "wgs-84", "gps":
+		return WGS84, true
+	case "gcj", "gcj02", "gcj-02":
+		return GCJ02, true
+	case "bd", "bd09", "bd-09", "bd09ll":
+		return BD09, true
+	}
+	return "", false
+}
+
+// mainland is a coarse outline of mainland China and Hainan, accurate to a few kilometres
+// along the coast and borders. It leaves out Taiwan, Hong Kong, Macau and the neighbouring
+// countries, where GCJ-02 does not apply.
+var mainland = []Point{
+	// Western borders with Central and South Asia, heading south.
+	{39.4, 73.6}, {38.6, 74.9}, {37.2, 75.0}, {36.9, 75.4}, {35.7, 77.0}, {35.5, 77.8},
+	{34.8, 78.5}, {33.0, 79.3}, {32.5, 78.8}, {31.0, 79.0}, {30.4, 81.2}, {29.6, 82.2},
+	{29.2, 83.8}, {28.6, 85.0}, {28.2, 85.9}, {27.9, 87.0}, {27.9, 88.1}, {27.4, 88.8},
+	{28.0, 89.6}, {27.9, 91.6}, {28.2, 93.5}, {28.6, 95.4}, {29.3, 96.1}, {28.4, 97.3},
+	// Myanmar, Laos and Vietnam, heading east.
+	{28.3, 98.1}, {27.5, 98.7}, {26.0, 98.6}, {24.8, 97.6}, {24.0, 97.7}, {23.2, 98.7},
+	{22.2, 99.2}, {21.7, 100.0}, {21.2, 101.2}, {21.7, 101.8}, {22.4, 102.2}, {22.5, 104.0},
+	{23.4, 105.4}, {22.9, 106.7}, {22.0, 106.7}, {21.6, 107.8}, {21.5, 108.0},
+	// Hainan and the southern coast around Macau and Hong Kong.
+	{21.4, 108.5}, {19.3, 108.5}, {18.1, 108.9}, {18.1, 110.0}, {18.6, 110.6}, {19.7, 111.2},
+	{20.3, 110.9}, {21.1, 110.8}, {21.5, 111.8}, {21.9, 113.0}, {22.1, 113.3}, {22.22, 113.52},
+	{22.25, 113.62}, {22.47, 113.9}, {22.52, 114.05}, {22.56, 114.25}, {22.5, 114.45}, {22.45, 114.6},
+	// The eastern coast facing Taiwan, heading north to the Korean border.
+	{22.7, 115.2}, {22.7, 116.0}, {23.2, 116.9}, {23.6, 117.5}, {24.3, 118.2}, {25.0, 119.3},
+	{25.5, 119.9}, {26.7, 120.3}, {27.5, 121.0}, {28.5, 121.9}, {29.9, 122.4}, {30.9, 122.2},
+	{31.7, 121.9}, {32.4, 121.5}, {33.5, 120.7}, {34.5, 119.7}, {35.3, 119.6}, {36.2, 120.8},
+	{36.9, 122.6}, {37.5, 122.7}, {37.8, 120.6}, {37.3, 119.0}, {38.0, 118.9}, {38.8, 117.7},
+	{39.3, 118.5}, {39.8, 119.5}, {40.6, 121.0}, {40.9, 121.9}, {40.0, 121.8}, {38.7, 121.1},
+	{39.0, 122.2}, {39.8, 124.0}, {40.1, 124.4},
+	// North Korea and Russia, heading north and then west along the Amur.
+	{40.5, 124.9}, {41.1, 126.1}, {41.7, 126.9}, {41.45, 128.2}, {42.0, 128.1}, {42.4, 129.4},
+	{42.9, 129.8}, {42.4, 130.6}, {42.9, 131.0}, {44.4, 131.3}, {45.0, 131.9}, {45.3, 133.1},
+	{46.7, 134.0}, {48.4, 134.7}, {47.7, 132.6}, {48.9, 130.5}, {49.6, 127.8}, {50.3, 127.4},
+	{51.5, 126.8}, {52.9, 125.6}, {53.5, 123.5}, {53.3, 121.3}, {52.3, 120.6}, {50.3, 119.2},
+	{49.9, 117.8}, {49.85, 116.7},
+	// Mongolia, heading west.
+	{47.9, 115.6}, {47.7, 117.4}, {47.9, 118.8}, {47.1, 119.8}, {46.7, 119.9}, {46.2, 117.5},
+	{44.9, 113.8}, {43.7, 112.0}, {42.6, 109.5}, {42.4, 107.0}, {41.6, 105.0}, {42.6, 101.6},
+	{42.7, 96.4}, {44.3, 95.4}, {45.1, 93.5}, {46.5, 91.0}, {47.9, 90.1}, {49.1, 87.8},
+	// Kazakhstan and Kyrgyzstan, heading south-west.
+	{49.2, 87.3}, {48.5, 85.7}, {47.2, 85.5}, {47.0, 83.1}, {46.5, 82.3}, {45.2, 82.5},
+	{44.9, 79.9}, {43.2, 80.8}, {42.2, 80.2}, {41.0, 77.6}, {40.5, 75.5}, {39.9, 74.0},
+}
+
+// OutOfChina reports whether a position lies outside the area where GCJ-02 applies.
+func OutOfChina(lat, lon float64) bool {
+	if lon < 73 || lon > 135 || lat < 18 || lat > 54 {
+		return true
+	}
+	return !InPolygon(lat, lon, mainland)
+}
+
+// WGS84ToGCJ02 converts a WGS-84 position to GCJ-02.
+func WGS84ToGCJ02(lat, lon float64) (float64, float64) {
+	if OutOfChina(lat, lon) {
+		return lat, lon
+	}
+	dLat, dLon := delta(lat, lon)
+	return lat + dLat, lon + dLon
+}
+
+// GCJ02ToWGS84 converts a GCJ-02 position to WGS-84.
+// The forward transformation has no closed-form inverse, so the result is refined
+// iteratively until it maps back onto the input within about a centimetre.
+func GCJ02ToWGS84(lat, lon float64) (float64, float64) {
+	if OutOfChina(lat, lon) {
+		return lat, lon
+	}
+	return invert(lat, lon, lat, lon, WGS84ToGCJ02)
+}
+
+// GCJ02ToBD09 converts a GCJ-02 position to BD-09.
+func GCJ02ToBD09(lat, lon float64) (float64, float64) {
+	z := math.Sqrt(lon*lon+lat*lat) + 0.00002*math.Sin(lat*bdPi)
+	theta := math.Atan2(lat, lon) + 0.000003*math.Cos(lon*bdPi)
+	return z*math.Sin(theta) + 0.006, z*math.Cos(theta) + 0.0065
+}
+
+// BD09ToGCJ02 converts a BD-09 position to GCJ-02.
+// The commonly used closed-form approximation is off by up to 10cm,
+// so it is only used as the starting point of an iterative refinement.
+func BD09ToGCJ02(lat, lon float64) (float64, float64) {
+	x, y := lon-0.0065, lat-0.006
+	z := math.Sqrt(x*x+y*y) - 0.00002*math.Sin(y*bdPi)
+	theta := math.Atan2(y, x) - 0.000003*math.Cos(x*bdPi)
+	gcjLat, gcjLon := z*math.Sin(theta), z*math.Cos(theta)
+	return invert(lat, lon, gcjLat, gcjLon, GCJ02ToBD09)
+}
+
+// WGS84ToBD09 converts a WGS-84 position to BD-09.
+func WGS84ToBD09(lat, lon float64) (float64, float64) {
+	return GCJ02ToBD09(WGS84ToGCJ02(lat, lon))
+}
+
+// BD09ToWGS84 converts a BD-09 position to WGS-84.
+func BD09ToWGS84(lat, lon float64) (float64, float64) {
+	return GCJ02ToWGS84(BD09ToGCJ02(lat, lon))
+}
+
+// Convert converts a position between any two datums.
+// Unknown datums are treated as WGS-84.
+func Convert(lat, lon float64, from, to CoordType) (float64, float64) {
+	if from == to {
+		return lat, lon
+	}
+	switch from {
+	case GCJ02:
+		lat, lon = GCJ02ToWGS84(lat, lon)
+	case BD09:
+		lat, lon = BD09ToWGS84(lat, lon)
+	}
+	switch to {
+	case GCJ02:
+		return WGS84ToGCJ02(lat, lon)
+	case BD09:
+		return WGS84ToBD09(lat, lon)
+	}
+	return lat, lon
+}
+
+// Distance returns the great-circle distance in metres between two positions of the same datum.
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1, phi2 := lat1*math.Pi/180, lat2*math.Pi/180
+	dPhi := (lat2 - lat1) * math.Pi / 180
+	dLambda := (lon2 - lon1) * math.Pi / 180
+	a := math.Sin(dPhi/2)*math.Sin(dPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// Point is a position in degrees.
+type Point struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// InPolygon reports whether a position lies inside a polygon given by its vertices.
+// The polygon is closed implicitly and must not cross the antimeridian.
+func InPolygon(lat, lon float64, polygon []Point) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if (a.Lat > lat) != (b.Lat > lat) && lon < (b.Lon-a.Lon)*(lat-a.Lat)/(b.Lat-a.Lat)+a.Lon {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// PolygonArea returns the approximate area of a small polygon in square metres.
+func PolygonArea(polygon []Point) float64 {
+	if len(polygon) < 3 {
+		return 0
+	}
+	// Project onto a plane tangent at the first vertex, accurate for city sized polygons.
+	scaleLat := earthRadius * math.Pi / 180
+	scaleLon := scaleLat * math.Cos(polygon[0].Lat*math.Pi/180)
+	var sum float64
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		xi, yi := (polygon[i].Lon-polygon[0].Lon)*scaleLon, (polygon[i].Lat-polygon[0].Lat)*scaleLat
+		xj, yj := (polygon[j].Lon-polygon[0].Lon)*scaleLon, (polygon[j].Lat-polygon[0].Lat)*scaleLat
+		sum += xj*yi - xi*yj
+	}
+	return math.Abs(sum) / 2
+}
+
+// invert finds the position that forward maps onto (lat, lon), starting from a guess.
+func invert(lat, lon, guessLat, guessLon float64, forward func(lat, lon float64) (float64, float64)) (float64, float64) {
+	for i := 0; i < 30; i++ {
+		fLat, fLon := forward(guessLat, guessLon)
+		dLat, dLon := fLat-lat, fLon-lon
+		guessLat, guessLon = guessLat-dLat, guessLon-dLon
+		if math.Abs(dLat) < inverseTolerance && math.Abs(dLon) < inverseTolerance {
+			break
+		}
+	}
+	return guessLat, guessLon
+}
+
+// delta returns the GCJ-02 offset of a WGS-84 position in degrees.
+func delta(lat, lon float64) (float64, float64) {
+	dLat := transformLat(lon-105.0, lat-35.0)
+	dLon := transformLon(lon-105.0, lat-35.0)
+	radLat := lat / 180.0 * math.Pi
+	magic := math.Sin(radLat)
+	magic = 1 - krasovskyEE*magic*magic
+	sqrtMagic := math.Sqrt(magic)
+	dLat = (dLat * 180.0) / ((krasovskyA * (1 - krasovskyEE)) / (magic * sqrtMagic) * math.Pi)
+	dLon = (dLon * 180.0) / (krasovskyA / sqrtMagic * math.Cos(radLat) * math.Pi)
+	return dLat, dLon
+}
+
+func transformLat(x, y float64) float64 {
+	ret := -100.0 + 2.0*x + 3.0*y + 0.2*y*y + 0.1*x*y + 0.2*math.Sqrt(math.Abs(x))
+	ret += (20.0*math.Sin(6.0*x*math.Pi) + 20.0*math.Sin(2.0*x*math.Pi)) * 2.0 / 3.0
+	ret += (20.0*math.Sin(y*math.Pi) + 40.0*math.Sin(y/3.0*math.Pi)) * 2.0 / 3.0
+	ret += (160.0*math.Sin(y/12.0*math.Pi) + 320*math.Sin(y*math.Pi/30.0)) * 2.0 / 3.0
+	return ret
+}
+
+func transformLon(x, y float64) float64 {
+	ret := 300.0 + x + 2.0*y + 0.1*x*x + 0.1*x*y + 0.1*math.Sqrt(math.Abs(x))
+	ret += (20.0*math.Sin(6.0*x*math.Pi) + 20.0*math.Sin(2.0*x*math.Pi)) * 2.0 / 3.0
+	ret += (20.0*math.Sin(x*math.Pi) + 40.0*math.Sin(x/3.0*math.Pi)) * 2.0 / 3.0
+	ret += (150.0*math.Sin(x/12.0*math.Pi) + 300.0*math.Sin(x/30.0*math.Pi)) * 2.0 / 3.0
+	return ret
+}
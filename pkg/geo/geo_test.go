@@ -0,0 +1,145 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+const epsilon = 1e-9
+
+func near(a, b, tolerance float64) bool {
+	return math.Abs(a-b) < tolerance
+}
+
+func TestWGS84ToGCJ02(t *testing.T) {
+	lat, lon := WGS84ToGCJ02(39.915, 116.404)
+	if !near(lat, 39.91640428150164, epsilon) || !near(lon, 116.41024449916938, epsilon) {
+		t.Fatalf("unexpected GCJ-02 position %v,%v", lat, lon)
+	}
+}
+
+func TestGCJ02ToBD09(t *testing.T) {
+	lat, lon := GCJ02ToBD09(39.915, 116.404)
+	if !near(lat, 39.92133699351021, epsilon) || !near(lon, 116.41036949371029, epsilon) {
+		t.Fatalf("unexpected BD-09 position %v,%v", lat, lon)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	points := [][2]float64{
+		{39.915, 116.404},   // Beijing
+		{31.2304, 121.4737}, // Shanghai
+		{22.5431, 114.0579}, // Shenzhen
+		{43.8171, 125.3235}, // Changchun
+	}
+	for _, p := range points {
+		lat, lon := GCJ02ToWGS84(WGS84ToGCJ02(p[0], p[1]))
+		if !near(lat, p[0], 1e-6) || !near(lon, p[1], 1e-6) {
+			t.Errorf("GCJ-02 round trip of %v drifted to %v,%v", p, lat, lon)
+		}
+		lat, lon = BD09ToWGS84(WGS84ToBD09(p[0], p[1]))
+		if !near(lat, p[0], 1e-6) || !near(lon, p[1], 1e-6) {
+			t.Errorf("BD-09 round trip of %v drifted to %v,%v", p, lat, lon)
+		}
+	}
+}
+
+func TestOutOfChina(t *testing.T) {
+	lat, lon := WGS84ToGCJ02(37.7765494, -122.4195418)
+	if lat != 37.7765494 || lon != -122.4195418 {
+		t.Fatalf("positions outside China must not be shifted, got %v,%v", lat, lon)
+	}
+	cases := []struct {
+		name     string
+		lat, lon float64
+		out      bool
+	}{
+		{"Beijing", 39.915, 116.404, false},
+		{"Shanghai", 31.2304, 121.4737, false},
+		{"Shenzhen", 22.5431, 114.0579, false},
+		{"Zhuhai", 22.2710, 113.5767, false},
+		{"Xiamen", 24.4798, 118.0894, false},
+		{"Haikou", 20.0440, 110.3417, false},
+		{"Sanya", 18.2528, 109.5120, false},
+		{"Dalian", 38.9140, 121.6147, false},
+		{"Harbin", 45.8038, 126.5350, false},
+		{"Hailar", 49.2116, 119.7656, false},
+		{"Manzhouli", 49.5978, 117.3787, false},
+		{"Arxan", 47.1770, 119.9440, false},
+		{"Erenhot", 43.6530, 111.9770, false},
+		{"Urumqi", 43.8256, 87.6168, false},
+		{"Kashgar", 39.4704, 75.9898, false},
+		{"Lhasa", 29.6520, 91.1721, false},
+		{"Kunming", 25.0389, 102.7183, false},
+		{"Hong Kong", 22.3193, 114.1694, true},
+		{"Macau", 22.1987, 113.5439, true},
+		{"Taipei", 25.0330, 121.5654, true},
+		{"Kaohsiung", 22.6273, 120.3014, true},
+		{"Penghu", 23.5711, 119.5793, true},
+		{"Seoul", 37.5665, 126.9780, true},
+		{"Pyongyang", 39.0392, 125.7625, true},
+		{"Rason", 42.2569, 130.2977, true},
+		{"Ulaanbaatar", 47.8864, 106.9057, true},
+		{"Choibalsan", 48.0706, 114.5098, true},
+		{"Vladivostok", 43.1198, 131.8869, true},
+		{"Blagoveshchensk", 50.2907, 127.5272, true},
+		{"Almaty", 43.2220, 76.8512, true},
+		{"Kathmandu", 27.7172, 85.3240, true},
+		{"Hanoi", 21.0278, 105.8342, true},
+		{"Cao Bang", 22.6657, 106.2522, true},
+		{"Tokyo", 35.6762, 139.6503, true},
+	}
+	for _, c := range cases {
+		if got := OutOfChina(c.lat, c.lon); got != c.out {
+			t.Errorf("OutOfChina(%s) = %v, want %v", c.name, got, c.out)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	gcjLat, gcjLon := WGS84ToGCJ02(31.2304, 121.4737)
+	bdLat, bdLon := GCJ02ToBD09(gcjLat, gcjLon)
+	lat, lon := Convert(bdLat, bdLon, BD09, GCJ02)
+	if !near(lat, gcjLat, 1e-6) || !near(lon, gcjLon, 1e-6) {
+		t.Fatalf("BD-09 to GCJ-02 got %v,%v want %v,%v", lat, lon, gcjLat, gcjLon)
+	}
+	if lat, lon := Convert(31.2304, 121.4737, WGS84, WGS84); lat != 31.2304 || lon != 121.4737 {
+		t.Fatalf("identity conversion changed the position to %v,%v", lat, lon)
+	}
+}
+
+func TestParseCoordType(t *testing.T) {
+	cases := map[string]CoordType{"wgs": WGS84, "GCJ02": GCJ02, "bd09ll": BD09}
+	for in, want := range cases {
+		if got, ok := ParseCoordType(in); !ok || got != want {
+			t.Errorf("ParseCoordType(%q) = %v, %v", in, got, ok)
+		}
+	}
+	if _, ok := ParseCoordType("utm"); ok {
+		t.Error("ParseCoordType accepted an unknown datum")
+	}
+}
+
+func TestDistance(t *testing.T) {
+	// Tiananmen to the Shanghai Bund is about 1067km.
+	if d := Distance(39.9087, 116.3975, 31.2400, 121.4900); !near(d, 1067e3, 5e3) {
+		t.Fatalf("Distance = %v", d)
+	}
+	if d := Distance(31.2304, 121.4737, 31.2304, 121.4737); d != 0 {
+		t.Fatalf("Distance of the same position = %v", d)
+	}
+}
+
+func TestInPolygon(t *testing.T) {
+	// A square of about 1.1km around People's Square, Shanghai.
+	square := []Point{{31.225, 121.470}, {31.225, 121.480}, {31.235, 121.480}, {31.235, 121.470}}
+	if !InPolygon(31.2304, 121.4737, square) {
+		t.Error("position inside the square not detected")
+	}
+	if InPolygon(31.2400, 121.4737, square) {
+		t.Error("position north of the square detected as inside")
+	}
+	if area := PolygonArea(square); !near(area, 1.1119e3*0.9513e3, 2e4) {
+		t.Errorf("PolygonArea = %v", area)
+	}
+}
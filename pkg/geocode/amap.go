@@ -0,0 +1,108 @@
+package geocode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"teslatrack/pkg/geo"
+)
+
+// AmapURL is the Amap (Gaode) reverse geocoding endpoint.
+const AmapURL = "https://restapi.amap.com/v3/geocode/regeo"
+
+// Amap reverse geocodes with the Amap web service API, which expects GCJ-02 positions.
+type Amap struct {
+	// Endpoint is the regeo endpoint, AmapURL when empty.
+	Endpoint string
+	// Key is the web service key.
+	Key string
+	// Client is the HTTP client, http.DefaultClient when nil.
+	Client *http.Client
+}
+
+var _ Provider = (*Amap)(nil)
+
+// amapString is a string field that Amap encodes as an empty array when it has no value.
+type amapString string
+
+func (s *amapString) UnmarshalJSON(b []byte) error {
+	var v string
+	if err := json.Unmarshal(b, &v); err != nil {
+		*s = ""
+		return nil
+	}
+	*s = amapString(v)
+	return nil
+}
+
+type amapReply struct {
+	Status    string `json:"status"`
+	Info      string `json:"info"`
+	Regeocode struct {
+		FormattedAddress amapString `json:"formatted_address"`
+		AddressComponent struct {
+			Country      amapString `json:"country"`
+			Province     amapString `json:"province"`
+			City         amapString `json:"city"`
+			District     amapString `json:"district"`
+			Township     amapString `json:"township"`
+			Neighborhood struct {
+				Name amapString `json:"name"`
+			} `json:"neighborhood"`
+			StreetNumber struct {
+				Street amapString `json:"street"`
+				Number amapString `json:"number"`
+			} `json:"streetNumber"`
+		} `json:"addressComponent"`
+	} `json:"regeocode"`
+}
+
+// Reverse looks up the address of a WGS-84 position.
+func (a *Amap) Reverse(ctx context.Context, lat, lon float64) (*Address, error) {
+	endpoint := a.Endpoint
+	if endpoint == "" {
+		endpoint = AmapURL
+	}
+	lat, lon = geo.WGS84ToGCJ02(lat, lon)
+	query := url.Values{
+		"key":      []string{a.Key},
+		"location": []string{strconv.FormatFloat(lon, 'f', 6, 64) + "," + strconv.FormatFloat(lat, 'f', 6, 64)},
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var reply amapReply
+	if err := getJSON(a.Client, request, &reply); err != nil {
+		return nil, err
+	}
+	if reply.Status != "1" {
+		return nil, fmt.Errorf("geocode: amap: %s", reply.Info)
+	}
+
+	r := reply.Regeocode
+	c := r.AddressComponent
+	if r.FormattedAddress == "" {
+		return nil, nil
+	}
+	// Municipalities such as Beijing have no city, the province is the city.
+	city := c.City
+	if city == "" {
+		city = c.Province
+	}
+	address := &Address{
+		Name:      string(c.Neighborhood.Name),
+		Road:      string(c.StreetNumber.Street + c.StreetNumber.Number),
+		District:  string(c.District),
+		City:      string(city),
+		Province:  string(c.Province),
+		Country:   string(c.Country),
+		Formatted: string(r.FormattedAddress),
+	}
+	return address, nil
+}
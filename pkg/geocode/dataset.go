@@ -0,0 +1,172 @@
+package geocode
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"teslatrack/pkg/geo"
+)
+
+// DefaultMaxDistance is the distance in metres beyond which a dataset place is not considered near.
+const DefaultMaxDistance = 20000
+
+// Place is a named place of a dataset.
+type Place struct {
+	// Name is the name of the place.
+	Name string
+	// Latitude is the WGS-84 latitude.
+	Latitude float64
+	// Longitude is the WGS-84 longitude.
+	Longitude float64
+	// CountryCode is the ISO-3166 country code.
+	CountryCode string
+	// Admin1 is the first-level administrative division code, e.g., the province.
+	Admin1 string
+	// Population is the population, used to prefer larger places at equal distance.
+	Population int
+}
+
+// cell is the key of a one degree grid cell.
+type cell struct {
+	lat, lon int
+}
+
+// Dataset is an in-memory index of places for offline reverse geocoding.
+type Dataset struct {
+	// MaxDistance is the search radius in metres, DefaultMaxDistance when zero.
+	MaxDistance float64
+
+	grid map[cell][]*Place
+	size int
+}
+
+var _ Provider = (*Dataset)(nil)
+
+// NewDataset indexes places.
+func NewDataset(places []*Place) *Dataset {
+	d := &Dataset{grid: make(map[cell][]*Place)}
+	for _, p := range places {
+		c := cellOf(p.Latitude, p.Longitude)
+		d.grid[c] = append(d.grid[c], p)
+	}
+	d.size = len(places)
+	return d
+}
+
+// LoadFile loads a GeoNames dump such as cities500.txt or CN.txt.
+func LoadFile(path string) (*Dataset, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Load(f)
+}
+
+// Load reads places in the tab separated GeoNames format.
+// Only populated places (feature class P) and areas (class A) are kept.
+func Load(r io.Reader) (*Dataset, error) {
+	var places []*Place
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		cols := strings.Split(text, "\t")
+		if len(cols) < 15 {
+			return nil, fmt.Errorf("geocode: line %d: expected 15 columns, got %d", line, len(cols))
+		}
+		if cols[6] != "P" && cols[6] != "A" {
+			continue
+		}
+		lat, err := strconv.ParseFloat(cols[4], 64)
+		if err != nil {
+			return nil, fmt.Errorf("geocode: line %d: latitude: %w", line, err)
+		}
+		lon, err := strconv.ParseFloat(cols[5], 64)
+		if err != nil {
+			return nil, fmt.Errorf("geocode: line %d: longitude: %w", line, err)
+		}
+		population, _ := strconv.Atoi(cols[14])
+		places = append(places, &Place{
+			Name:        cols[1],
+			Latitude:    lat,
+			Longitude:   lon,
+			CountryCode: cols[8],
+			Admin1:      cols[10],
+			Population:  population,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return NewDataset(places), nil
+}
+
+// Len returns the number of places in the dataset.
+func (d *Dataset) Len() int {
+	return d.size
+}
+
+// Nearest finds the place closest to a position within the search radius.
+// It returns nil when no place is near.
+func (d *Dataset) Nearest(lat, lon float64) (*Place, float64) {
+	maxDistance := d.MaxDistance
+	if maxDistance <= 0 {
+		maxDistance = DefaultMaxDistance
+	}
+	// A degree of latitude is about 111km, longitude cells shrink towards the poles.
+	latCells := int(math.Ceil(maxDistance / 111000))
+	lonCells := latCells
+	if c := math.Cos(lat * math.Pi / 180); c > 0.01 {
+		lonCells = int(math.Ceil(maxDistance / (111000 * c)))
+	}
+	center := cellOf(lat, lon)
+
+	var (
+		best     *Place
+		bestDist = math.Inf(1)
+	)
+	for i := -latCells; i <= latCells; i++ {
+		for j := -lonCells; j <= lonCells; j++ {
+			for _, p := range d.grid[cell{center.lat + i, wrapLon(center.lon + j)}] {
+				dist := geo.Distance(lat, lon, p.Latitude, p.Longitude)
+				if dist > maxDistance {
+					continue
+				}
+				if dist < bestDist || (dist == bestDist && p.Population > best.Population) {
+					best, bestDist = p, dist
+				}
+			}
+		}
+	}
+	return best, bestDist
+}
+
+// Reverse returns the nearest place as an address.
+func (d *Dataset) Reverse(_ context.Context, lat, lon float64) (*Address, error) {
+	p, _ := d.Nearest(lat, lon)
+	if p == nil {
+		return nil, nil
+	}
+	return &Address{Name: p.Name, Country: p.CountryCode}, nil
+}
+
+func cellOf(lat, lon float64) cell {
+	return cell{int(math.Floor(lat)), int(math.Floor(lon))}
+}
+
+// wrapLon keeps a longitude cell index in [-180, 180).
+func wrapLon(lon int) int {
+	return ((lon+180)%360+360)%360 - 180
+}
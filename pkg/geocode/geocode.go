@@ -0,0 +1,49 @@
+// Package geocode resolves positions to human readable addresses.
+//
+// Offline lookups use a local GeoNames extract loaded into a Dataset, online lookups
+// go through the Nominatim or Amap reverse geocoding APIs. All providers take WGS-84
+// positions and convert them to the datum the service expects.
+package geocode
+
+import (
+	"context"
+	"strings"
+)
+
+// Address is a reverse geocoded position.
+type Address struct {
+	// Name is the name of the place, e.g., a point of interest or neighbourhood.
+	Name string
+	// Road is the road, including the house number when known.
+	Road string
+	// District is the district or suburb.
+	District string
+	// City is the city, town or village.
+	City string
+	// Province is the province or state.
+	Province string
+	// Country is the country.
+	Country string
+	// Formatted is the full address as formatted by the provider.
+	Formatted string
+}
+
+// String returns the formatted address, or joins its parts when the provider did not format it.
+func (a *Address) String() string {
+	if a.Formatted != "" {
+		return a.Formatted
+	}
+	var parts []string
+	for _, part := range []string{a.Name, a.Road, a.District, a.City, a.Province, a.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Provider reverse geocodes WGS-84 positions.
+// A nil address with a nil error means the provider knows no place near the position.
+type Provider interface {
+	Reverse(ctx context.Context, lat, lon float64) (*Address, error)
+}
@@ -0,0 +1,80 @@
+package geocode
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"teslatrack/pkg/geo"
+)
+
+const geonames = "1816670\tBeijing\tBeijing\t\t39.9075\t116.39723\tP\tPPLC\tCN\t\t22\t\t\t\t18960744\t\t49\tAsia/Shanghai\t2024-01-01\n" +
+	"1796236\tShanghai\tShanghai\t\t31.22222\t121.45806\tP\tPPLA\tCN\t\t23\t\t\t\t24874500\t\t12\tAsia/Shanghai\t2024-01-01\n" +
+	"1815286\tYangtze\tYangtze\t\t31.4\t121.9\tH\tSTM\tCN\t\t23\t\t\t\t0\t\t0\tAsia/Shanghai\t2024-01-01\n"
+
+func TestDatasetNearest(t *testing.T) {
+	d, err := Load(strings.NewReader(geonames))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Len() != 2 {
+		t.Fatalf("Len = %d, only populated places must be kept", d.Len())
+	}
+	address, err := d.Reverse(context.Background(), 31.2304, 121.4737)
+	if err != nil || address == nil || address.Name != "Shanghai" {
+		t.Fatalf("Reverse = %+v, %v", address, err)
+	}
+	if p, _ := d.Nearest(35.0, 118.0); p != nil {
+		t.Fatalf("Nearest far from any place = %+v", p)
+	}
+}
+
+func TestLoadMalformed(t *testing.T) {
+	if _, err := Load(strings.NewReader("1\tBroken\n")); err == nil {
+		t.Fatal("Load accepted a line with missing columns")
+	}
+}
+
+func TestNominatim(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("lat") != "31.230400" {
+			t.Errorf("lat = %s", r.URL.Query().Get("lat"))
+		}
+		_, _ = w.Write([]byte(`{"name":"People's Square","display_name":"People's Square, Huangpu, Shanghai, China",
+			"address":{"road":"Renmin Avenue","city_district":"Huangpu","city":"Shanghai","country":"China"}}`))
+	}))
+	defer server.Close()
+
+	address, err := (&Nominatim{Endpoint: server.URL}).Reverse(context.Background(), 31.2304, 121.4737)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address.City != "Shanghai" || address.District != "Huangpu" || address.Road != "Renmin Avenue" {
+		t.Fatalf("address = %+v", address)
+	}
+}
+
+func TestAmap(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lat, lon := geo.WGS84ToGCJ02(39.915, 116.404)
+		want := strconv.FormatFloat(lon, 'f', 6, 64) + "," + strconv.FormatFloat(lat, 'f', 6, 64)
+		if got := r.URL.Query().Get("location"); got != want {
+			t.Errorf("location = %s, want GCJ-02 %s", got, want)
+		}
+		_, _ = w.Write([]byte(`{"status":"1","info":"OK","regeocode":{"formatted_address":"北京市东城区东华门街道天安门",
+			"addressComponent":{"country":"中国","province":"北京市","city":[],"district":"东城区",
+			"neighborhood":{"name":"天安门"},"streetNumber":{"street":[],"number":[]}}}}`))
+	}))
+	defer server.Close()
+
+	address, err := (&Amap{Endpoint: server.URL, Key: "test"}).Reverse(context.Background(), 39.915, 116.404)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address.City != "北京市" || address.District != "东城区" || address.Name != "天安门" {
+		t.Fatalf("address = %+v", address)
+	}
+}
@@ -0,0 +1,117 @@
+package geocode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// NominatimURL is the public OpenStreetMap Nominatim endpoint.
+const NominatimURL = "https://nominatim.openstreetmap.org/reverse"
+
+// Nominatim reverse geocodes with the OpenStreetMap Nominatim API or a compatible local server.
+type Nominatim struct {
+	// Endpoint is the reverse endpoint, NominatimURL when empty.
+	Endpoint string
+	// Language is the preferred language of the address, e.g., zh-CN.
+	Language string
+	// UserAgent identifies the application as required by the Nominatim usage policy.
+	UserAgent string
+	// Client is the HTTP client, http.DefaultClient when nil.
+	Client *http.Client
+}
+
+var _ Provider = (*Nominatim)(nil)
+
+type nominatimReply struct {
+	Error       string `json:"error"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	Address     struct {
+		HouseNumber string `json:"house_number"`
+		Road        string `json:"road"`
+		Suburb      string `json:"suburb"`
+		District    string `json:"city_district"`
+		City        string `json:"city"`
+		Town        string `json:"town"`
+		Village     string `json:"village"`
+		State       string `json:"state"`
+		Country     string `json:"country"`
+	} `json:"address"`
+}
+
+// Reverse looks up the address of a WGS-84 position.
+func (n *Nominatim) Reverse(ctx context.Context, lat, lon float64) (*Address, error) {
+	endpoint := n.Endpoint
+	if endpoint == "" {
+		endpoint = NominatimURL
+	}
+	query := url.Values{
+		"format": []string{"jsonv2"},
+		"lat":    []string{strconv.FormatFloat(lat, 'f', 6, 64)},
+		"lon":    []string{strconv.FormatFloat(lon, 'f', 6, 64)},
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if n.Language != "" {
+		request.Header.Set("Accept-Language", n.Language)
+	}
+	if n.UserAgent != "" {
+		request.Header.Set("User-Agent", n.UserAgent)
+	}
+
+	var reply nominatimReply
+	if err := getJSON(n.Client, request, &reply); err != nil {
+		return nil, err
+	}
+	if reply.Error != "" {
+		// Nominatim reports positions without any nearby object as an error.
+		if strings.Contains(reply.Error, "Unable to geocode") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("geocode: nominatim: %s", reply.Error)
+	}
+
+	a := reply.Address
+	address := &Address{
+		Name:      reply.Name,
+		Road:      strings.TrimSpace(a.Road + " " + a.HouseNumber),
+		District:  firstNonEmpty(a.District, a.Suburb),
+		City:      firstNonEmpty(a.City, a.Town, a.Village),
+		Province:  a.State,
+		Country:   a.Country,
+		Formatted: reply.DisplayName,
+	}
+	return address, nil
+}
+
+// getJSON performs a request and decodes the JSON reply.
+func getJSON(client *http.Client, request *http.Request, v any) error {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("geocode: %s: unexpected status %s", request.URL.Host, response.Status)
+	}
+	return json.NewDecoder(response.Body).Decode(v)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,247 @@
+// Package hass publishes devices to Home Assistant over MQTT.
+//
+// Entities are announced with MQTT discovery: a retained config message per entity at
+// <discovery_prefix>/<component>/<node_id>/<object_id>/config. States are published as
+// retained JSON at <topic_prefix>/<node_id>/<subtopic> and the availability of the publisher
+// at <topic_prefix>/status, set to offline by the broker through the last will when the
+// connection is lost. Commands of controllable entities arrive at
+// <topic_prefix>/<node_id>/<object_id>/set.
+package hass
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+const (
+	// DefaultTopicPrefix is the prefix of the state, availability and command topics.
+	DefaultTopicPrefix = "teslatrack"
+	// DefaultDiscoveryPrefix is the discovery prefix Home Assistant listens to by default.
+	DefaultDiscoveryPrefix = "homeassistant"
+	// DefaultClientID is the MQTT client ID used unless configured.
+	DefaultClientID = "teslatrack"
+
+	// PayloadOnline and PayloadOffline are the availability payloads.
+	PayloadOnline  = "online"
+	PayloadOffline = "offline"
+
+	// timeout bounds the wait for the broker to acknowledge a connect, publish or subscribe.
+	timeout = 10 * time.Second
+	// qos is the quality of service of all messages, at least once.
+	qos = 1
+)
+
+// Options configures a Client.
+type Options struct {
+	// Broker is the URL of the broker, e.g., tcp://localhost:1883.
+	Broker   string
+	ClientID string
+	Username string
+	Password string
+	// TopicPrefix defaults to DefaultTopicPrefix.
+	TopicPrefix string
+	// DiscoveryPrefix defaults to DefaultDiscoveryPrefix.
+	DiscoveryPrefix string
+	// OnConnect is called after every connect and reconnect, once the publisher is online.
+	// Discovery configs should be published again there in case the broker lost them.
+	OnConnect func()
+}
+
+// Device is the device the entities belong to, shown as one device in Home Assistant.
+type Device struct {
+	Identifiers  []string `json:"identifiers"`
+	Name         string   `json:"name,omitempty"`
+	Manufacturer string   `json:"manufacturer,omitempty"`
+	Model        string   `json:"model,omitempty"`
+	SWVersion    string   `json:"sw_version,omitempty"`
+}
+
+// Entity is an entity of a device.
+type Entity struct {
+	// Component is the Home Assistant integration, e.g., sensor, binary_sensor, device_tracker,
+	// lock, switch, number, button or update.
+	Component string
+	// ObjectID identifies the entity within the device, e.g., battery_level.
+	ObjectID string
+	// Name is the name of the entity, shown after the name of the device.
+	Name string
+	// StateTopic is the subtopic of the device the state is read from, e.g., state. Empty for none.
+	StateTopic string
+	// Command adds the command topic of the entity.
+	Command bool
+	// Config holds further discovery options, e.g., device_class, unit_of_measurement or value_template.
+	Config map[string]any
+}
+
+// CommandHandler handles a command payload sent to an entity of a device.
+type CommandHandler func(nodeID, objectID, payload string)
+
+// Client publishes devices and their states to Home Assistant.
+type Client struct {
+	opts   Options
+	client mqtt.Client
+
+	mu      sync.Mutex
+	handler CommandHandler
+}
+
+// NewClient creates a Client. It does not connect until Connect is called.
+func NewClient(opts Options) *Client {
+	if opts.ClientID == "" {
+		opts.ClientID = DefaultClientID
+	}
+	if opts.TopicPrefix == "" {
+		opts.TopicPrefix = DefaultTopicPrefix
+	}
+	if opts.DiscoveryPrefix == "" {
+		opts.DiscoveryPrefix = DefaultDiscoveryPrefix
+	}
+	c := &Client{opts: opts}
+	o := mqtt.NewClientOptions().
+		AddBroker(opts.Broker).
+		SetClientID(opts.ClientID).
+		SetUsername(opts.Username).
+		SetPassword(opts.Password).
+		SetWill(c.StatusTopic(), PayloadOffline, qos, true).
+		SetAutoReconnect(true).
+		SetConnectRetry(true).
+		SetConnectTimeout(timeout).
+		// Commands call the vehicles and may take seconds, so they must not block the client.
+		SetOrderMatters(false).
+		SetOnConnectHandler(func(mqtt.Client) { go c.onConnect() })
+	c.client = mqtt.NewClient(o)
+	return c
+}
+
+// Connect connects to the broker. Once connected, the client reconnects on its own.
+func (c *Client) Connect(ctx context.Context) error {
+	token := c.client.Connect()
+	select {
+	case <-token.Done():
+		return token.Error()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close marks the publisher offline and disconnects.
+func (c *Client) Close() {
+	if c.Connected() {
+		_ = wait(c.client.Publish(c.StatusTopic(), qos, true, PayloadOffline))
+	}
+	c.client.Disconnect(250)
+}
+
+// Connected reports whether the client is connected to the broker.
+func (c *Client) Connected() bool {
+	return c.client.IsConnectionOpen()
+}
+
+// StatusTopic is the availability topic of the publisher.
+func (c *Client) StatusTopic() string {
+	return c.opts.TopicPrefix + "/status"
+}
+
+// Topic returns the topic of a subtopic of a device, e.g., teslatrack/<node_id>/state.
+func (c *Client) Topic(nodeID, subtopic string) string {
+	return c.opts.TopicPrefix + "/" + nodeID + "/" + subtopic
+}
+
+// CommandTopic returns the command topic of an entity.
+func (c *Client) CommandTopic(nodeID, objectID string) string {
+	return c.Topic(nodeID, objectID+"/set")
+}
+
+// ConfigTopic returns the discovery topic of an entity.
+func (c *Client) ConfigTopic(component, nodeID, objectID string) string {
+	return fmt.Sprintf("%s/%s/%s/%s/config", c.opts.DiscoveryPrefix, component, nodeID, objectID)
+}
+
+// Discover announces the entities of a device, identified by nodeID, to Home Assistant.
+func (c *Client) Discover(nodeID string, device Device, entities ...Entity) error {
+	for _, e := range entities {
+		config := make(map[string]any, len(e.Config)+8)
+		for k, v := range e.Config {
+			config[k] = v
+		}
+		config["name"] = e.Name
+		config["unique_id"] = nodeID + "_" + e.ObjectID
+		config["object_id"] = nodeID + "_" + e.ObjectID
+		config["device"] = device
+		config["availability_topic"] = c.StatusTopic()
+		if e.StateTopic != "" {
+			config["state_topic"] = c.Topic(nodeID, e.StateTopic)
+		}
+		if e.Command {
+			config["command_topic"] = c.CommandTopic(nodeID, e.ObjectID)
+		}
+		if err := c.publishJSON(c.ConfigTopic(e.Component, nodeID, e.ObjectID), config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Publish publishes a retained JSON state at a subtopic of a device.
+func (c *Client) Publish(nodeID, subtopic string, state any) error {
+	return c.publishJSON(c.Topic(nodeID, subtopic), state)
+}
+
+// HandleCommands subscribes to the command topics of all devices and calls the handler
+// for every command. The subscription is renewed on reconnect.
+func (c *Client) HandleCommands(handler CommandHandler) error {
+	c.mu.Lock()
+	c.handler = handler
+	c.mu.Unlock()
+	return c.subscribe()
+}
+
+// onConnect marks the publisher online and renews the command subscription.
+func (c *Client) onConnect() {
+	_ = wait(c.client.Publish(c.StatusTopic(), qos, true, PayloadOnline))
+	_ = c.subscribe()
+	if c.opts.OnConnect != nil {
+		c.opts.OnConnect()
+	}
+}
+
+// subscribe subscribes to the command topics if a handler is set.
+func (c *Client) subscribe() error {
+	c.mu.Lock()
+	handler := c.handler
+	c.mu.Unlock()
+	if handler == nil || !c.Connected() {
+		return nil
+	}
+	prefix := c.opts.TopicPrefix + "/"
+	return wait(c.client.Subscribe(prefix+"+/+/set", qos, func(_ mqtt.Client, m mqtt.Message) {
+		parts := strings.Split(strings.TrimPrefix(m.Topic(), prefix), "/")
+		if len(parts) != 3 {
+			return
+		}
+		handler(parts[0], parts[1], string(m.Payload()))
+	}))
+}
+
+// publishJSON publishes a retained JSON payload.
+func (c *Client) publishJSON(topic string, v any) error {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return wait(c.client.Publish(topic, qos, true, payload))
+}
+
+// wait waits for the broker to acknowledge a token.
+func wait(token mqtt.Token) error {
+	if !token.WaitTimeout(timeout) {
+		return fmt.Errorf("hass: mqtt operation timed out after %s", timeout)
+	}
+	return token.Error()
+}
@@ -0,0 +1,226 @@
+package hass
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	server "github.com/mochi-mqtt/server/v2"
+	"github.com/mochi-mqtt/server/v2/hooks/auth"
+	"github.com/mochi-mqtt/server/v2/listeners"
+)
+
+// startBroker starts an embedded broker and returns its URL.
+func startBroker(t *testing.T) (*server.Server, string) {
+	t.Helper()
+	broker := server.New(&server.Options{Logger: slog.New(slog.NewTextHandler(io.Discard, nil))})
+	if err := broker.AddHook(new(auth.AllowHook), nil); err != nil {
+		t.Fatal(err)
+	}
+	tcp := listeners.NewTCP(listeners.Config{ID: "tcp", Address: "127.0.0.1:0"})
+	if err := broker.AddListener(tcp); err != nil {
+		t.Fatal(err)
+	}
+	if err := broker.Serve(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = broker.Close() })
+	return broker, "tcp://" + tcp.Address()
+}
+
+// observe subscribes a second client, standing in for Home Assistant, to a topic filter.
+func observe(t *testing.T, url, filter string) (mqtt.Client, <-chan mqtt.Message) {
+	t.Helper()
+	messages := make(chan mqtt.Message, 64)
+	c := mqtt.NewClient(mqtt.NewClientOptions().AddBroker(url).SetClientID("observer"))
+	if err := wait(c.Connect()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Disconnect(0) })
+	if err := wait(c.Subscribe(filter, qos, func(_ mqtt.Client, m mqtt.Message) { messages <- m })); err != nil {
+		t.Fatal(err)
+	}
+	return c, messages
+}
+
+// next returns the next message of a topic, skipping the others.
+func next(t *testing.T, messages <-chan mqtt.Message, topic string) mqtt.Message {
+	t.Helper()
+	deadline := time.After(5 * time.Second)
+	for {
+		select {
+		case m := <-messages:
+			if m.Topic() == topic {
+				return m
+			}
+		case <-deadline:
+			t.Fatalf("no message at %s", topic)
+			return nil
+		}
+	}
+}
+
+// collect returns the messages of the topics, received in any order.
+func collect(t *testing.T, messages <-chan mqtt.Message, topics ...string) map[string]mqtt.Message {
+	t.Helper()
+	got := make(map[string]mqtt.Message, len(topics))
+	for _, topic := range topics {
+		got[topic] = nil
+	}
+	deadline := time.After(5 * time.Second)
+	for received := 0; received < len(topics); {
+		select {
+		case m := <-messages:
+			if prev, ok := got[m.Topic()]; ok && prev == nil {
+				got[m.Topic()] = m
+				received++
+			}
+		case <-deadline:
+			t.Fatalf("no message at all of %v", topics)
+		}
+	}
+	return got
+}
+
+func connect(t *testing.T, opts Options) *Client {
+	t.Helper()
+	c := NewClient(opts)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := c.Connect(ctx); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(c.Close)
+	return c
+}
+
+func TestDiscover(t *testing.T) {
+	_, url := startBroker(t)
+	c := connect(t, Options{Broker: url})
+	device := Device{Identifiers: []string{"5YJ3E1EA7JF000001"}, Name: "Model 3", Manufacturer: "Tesla"}
+	err := c.Discover("5yj3e1ea7jf000001", device,
+		Entity{Component: "sensor", ObjectID: "battery_level", Name: "Battery", StateTopic: "state",
+			Config: map[string]any{"device_class": "battery", "unit_of_measurement": "%", "value_template": "{{ value_json.battery_level }}"}},
+		Entity{Component: "lock", ObjectID: "lock", Name: "Doors", StateTopic: "state", Command: true},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The configs are retained, so Home Assistant receives them when it subscribes later.
+	_, messages := observe(t, url, "homeassistant/#")
+	sensor, lock := "homeassistant/sensor/5yj3e1ea7jf000001/battery_level/config", "homeassistant/lock/5yj3e1ea7jf000001/lock/config"
+	configs := collect(t, messages, sensor, lock)
+	m := configs[sensor]
+	if !m.Retained() {
+		t.Error("discovery config not retained")
+	}
+	var config map[string]any
+	if err := json.Unmarshal(m.Payload(), &config); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"name":               "Battery",
+		"unique_id":          "5yj3e1ea7jf000001_battery_level",
+		"state_topic":        "teslatrack/5yj3e1ea7jf000001/state",
+		"availability_topic": "teslatrack/status",
+		"device_class":       "battery",
+		"value_template":     "{{ value_json.battery_level }}",
+	}
+	for k, v := range want {
+		if config[k] != v {
+			t.Errorf("config[%s] = %v, want %v", k, config[k], v)
+		}
+	}
+	if _, ok := config["command_topic"]; ok {
+		t.Error("sensor has a command topic")
+	}
+	if ids := config["device"].(map[string]any)["identifiers"].([]any); len(ids) != 1 || ids[0] != "5YJ3E1EA7JF000001" {
+		t.Errorf("device identifiers = %v", ids)
+	}
+
+	config = nil
+	if err := json.Unmarshal(configs[lock].Payload(), &config); err != nil {
+		t.Fatal(err)
+	}
+	if config["command_topic"] != "teslatrack/5yj3e1ea7jf000001/lock/set" {
+		t.Errorf("command_topic = %v", config["command_topic"])
+	}
+}
+
+func TestPublish(t *testing.T) {
+	_, url := startBroker(t)
+	c := connect(t, Options{Broker: url, TopicPrefix: "cars"})
+	if err := c.Publish("vin1", "state", map[string]any{"battery_level": 80}); err != nil {
+		t.Fatal(err)
+	}
+	_, messages := observe(t, url, "cars/#")
+	m := next(t, messages, "cars/vin1/state")
+	if !m.Retained() || string(m.Payload()) != `{"battery_level":80}` {
+		t.Errorf("state = %s, retained %v", m.Payload(), m.Retained())
+	}
+}
+
+func TestAvailability(t *testing.T) {
+	broker, url := startBroker(t)
+	connected := make(chan struct{}, 4)
+	c := connect(t, Options{Broker: url, ClientID: "bridge", OnConnect: func() { connected <- struct{}{} }})
+	<-connected
+	_, messages := observe(t, url, "teslatrack/status")
+	if m := next(t, messages, "teslatrack/status"); string(m.Payload()) != PayloadOnline {
+		t.Fatalf("status = %s, want online", m.Payload())
+	}
+
+	// Losing the connection publishes the last will; the client then reconnects on its own.
+	cl, ok := broker.Clients.Get("bridge")
+	if !ok {
+		t.Fatal("bridge not connected")
+	}
+	cl.Stop(errors.New("connection lost"))
+	if m := next(t, messages, "teslatrack/status"); string(m.Payload()) != PayloadOffline {
+		t.Fatalf("status = %s, want offline", m.Payload())
+	}
+	select {
+	case <-connected:
+	case <-time.After(10 * time.Second):
+		t.Fatal("not reconnected")
+	}
+	if m := next(t, messages, "teslatrack/status"); string(m.Payload()) != PayloadOnline {
+		t.Fatalf("status = %s, want online", m.Payload())
+	}
+
+	c.Close()
+	if m := next(t, messages, "teslatrack/status"); string(m.Payload()) != PayloadOffline {
+		t.Fatalf("status = %s, want offline after close", m.Payload())
+	}
+}
+
+func TestHandleCommands(t *testing.T) {
+	_, url := startBroker(t)
+	c := connect(t, Options{Broker: url})
+	type command struct{ node, object, payload string }
+	commands := make(chan command, 1)
+	if err := c.HandleCommands(func(node, object, payload string) {
+		commands <- command{node, object, payload}
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	ha, _ := observe(t, url, "unused")
+	if err := wait(ha.Publish("teslatrack/vin1/lock/set", qos, false, "LOCK")); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case got := <-commands:
+		if got != (command{"vin1", "lock", "LOCK"}) {
+			t.Errorf("command = %+v", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("command not handled")
+	}
+}
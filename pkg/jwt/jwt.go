@@ -1,6 +1,17 @@
 package jwt
 
-import "time"
+import (
+	"context"
+	"errors"
+	"time"
+
+	jwtmiddleware "github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+)
+
+// ErrEmptySecret is returned when signing or verifying with an empty secret,
+// which anyone could forge tokens with.
+var ErrEmptySecret = errors.New("jwt: empty secret")
 
 // LoginUser
 type LoginUser struct {
@@ -11,3 +22,56 @@ type LoginUser struct {
 	Avatar    string    `json:"avatar"`
 	LoginTime time.Time `json:"login_time"`
 }
+
+// Claims is the payload of the access tokens issued to users.
+type Claims struct {
+	LoginUser
+	jwtv5.RegisteredClaims
+}
+
+// NewClaims returns empty claims for the kratos jwt server middleware.
+func NewClaims() jwtv5.Claims {
+	return &Claims{}
+}
+
+// Sign issues an HS256 access token for the user that expires after ttl.
+func Sign(secret string, user *LoginUser, ttl time.Duration) (string, time.Time, error) {
+	if secret == "" {
+		return "", time.Time{}, ErrEmptySecret
+	}
+	now := time.Now()
+	expireAt := now.Add(ttl)
+	claims := &Claims{
+		LoginUser: *user,
+		RegisteredClaims: jwtv5.RegisteredClaims{
+			IssuedAt:  jwtv5.NewNumericDate(now),
+			ExpiresAt: jwtv5.NewNumericDate(expireAt),
+		},
+	}
+	token, err := jwtv5.NewWithClaims(jwtv5.SigningMethodHS256, claims).SignedString([]byte(secret))
+	return token, expireAt, err
+}
+
+// KeyFunc returns the key function verifying tokens signed with secret.
+// Every token is rejected when secret is empty.
+func KeyFunc(secret string) jwtv5.Keyfunc {
+	return func(*jwtv5.Token) (any, error) {
+		if secret == "" {
+			return nil, ErrEmptySecret
+		}
+		return []byte(secret), nil
+	}
+}
+
+// FromContext returns the user authenticated by the kratos jwt middleware.
+func FromContext(ctx context.Context) (*LoginUser, bool) {
+	claims, ok := jwtmiddleware.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	c, ok := claims.(*Claims)
+	if !ok {
+		return nil, false
+	}
+	return &c.LoginUser, true
+}
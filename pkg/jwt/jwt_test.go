@@ -0,0 +1,75 @@
+package jwt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	jwtmiddleware "github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+)
+
+type headerCarrier map[string]string
+
+func (h headerCarrier) Get(key string) string      { return h[key] }
+func (h headerCarrier) Set(key, value string)      { h[key] = value }
+func (h headerCarrier) Add(key, value string)      { h[key] = value }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return []string{h[key]} }
+
+type testTransport struct {
+	transport.Transporter
+	header headerCarrier
+}
+
+func (t *testTransport) RequestHeader() transport.Header { return t.header }
+
+func TestSignAndVerify(t *testing.T) {
+	token, expireAt, err := Sign("secret", &LoginUser{ID: 42, NickName: "model3"}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if time.Until(expireAt) < 59*time.Minute {
+		t.Fatalf("expireAt = %v", expireAt)
+	}
+
+	ctx := transport.NewServerContext(context.Background(), &testTransport{header: headerCarrier{"Authorization": "Bearer " + token}})
+	mw := jwtmiddleware.Server(KeyFunc("secret"), jwtmiddleware.WithClaims(NewClaims))
+	_, err = mw(func(ctx context.Context, _ any) (any, error) {
+		user, ok := FromContext(ctx)
+		if !ok || user.ID != 42 {
+			t.Fatalf("FromContext = %+v, %v", user, ok)
+		}
+		return nil, nil
+	})(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx = transport.NewServerContext(context.Background(), &testTransport{header: headerCarrier{"Authorization": "Bearer " + token}})
+	if _, err := jwtmiddleware.Server(KeyFunc("other"), jwtmiddleware.WithClaims(NewClaims))(func(context.Context, any) (any, error) {
+		return nil, nil
+	})(ctx, nil); err == nil {
+		t.Fatal("token signed with another secret was accepted")
+	}
+}
+
+func TestEmptySecret(t *testing.T) {
+	if _, _, err := Sign("", &LoginUser{ID: 42}, time.Hour); err != ErrEmptySecret {
+		t.Fatalf("Sign with an empty secret error = %v, want %v", err, ErrEmptySecret)
+	}
+
+	// A token forged with the empty key must not pass a server configured without a secret.
+	claims := &Claims{LoginUser: LoginUser{ID: 42}, RegisteredClaims: jwtv5.RegisteredClaims{ExpiresAt: jwtv5.NewNumericDate(time.Now().Add(time.Hour))}}
+	token, err := jwtv5.NewWithClaims(jwtv5.SigningMethodHS256, claims).SignedString([]byte(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := transport.NewServerContext(context.Background(), &testTransport{header: headerCarrier{"Authorization": "Bearer " + token}})
+	if _, err := jwtmiddleware.Server(KeyFunc(""), jwtmiddleware.WithClaims(NewClaims))(func(context.Context, any) (any, error) {
+		return nil, nil
+	})(ctx, nil); err == nil {
+		t.Fatal("token signed with an empty secret was accepted")
+	}
+}
@@ -0,0 +1,189 @@
+// Package pricing prices charged energy under flat and time-of-use electricity tariffs.
+//
+// Time-of-use tariffs, such as the peak/valley pricing common for residential
+// meters in China, are a list of daily windows with their own price per kWh.
+// Energy is split across windows using the charge curve of a session.
+package pricing
+
+import (
+	"fmt"
+	"time"
+)
+
+// Window is a daily time span with its own price.
+// Windows may wrap midnight, e.g., a valley window from 22:00 to 06:00.
+type Window struct {
+	// Name labels the window, e.g., peak, flat or valley.
+	Name string `json:"name"`
+	// Start is the local start time as HH:MM, inclusive.
+	Start string `json:"start"`
+	// End is the local end time as HH:MM, exclusive.
+	End string `json:"end"`
+	// Price is the price per kWh.
+	Price float64 `json:"price"`
+}
+
+// DefaultWindowName labels energy charged outside all windows.
+const DefaultWindowName = "standard"
+
+// Schedule is a tariff: a default price and optional time-of-use windows.
+type Schedule struct {
+	// Price is the price per kWh outside all windows, the only price of a flat tariff.
+	Price float64
+	// Windows are the time-of-use windows, the first matching window wins.
+	Windows []Window
+	// Location is the time zone the windows are defined in, UTC when nil.
+	Location *time.Location
+
+	spans []span
+}
+
+// span is a parsed window in minutes since midnight.
+type span struct {
+	start, end int
+	window     *Window
+}
+
+func (sp span) contains(minute int) bool {
+	if sp.start < sp.end {
+		return minute >= sp.start && minute < sp.end
+	}
+	return minute >= sp.start || minute < sp.end
+}
+
+// parseClock parses HH:MM into minutes since midnight.
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("pricing: invalid time %q, expected HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Validate checks the windows and prepares the schedule for pricing.
+func (s *Schedule) Validate() error {
+	s.spans = s.spans[:0]
+	for i := range s.Windows {
+		w := &s.Windows[i]
+		start, err := parseClock(w.Start)
+		if err != nil {
+			return err
+		}
+		end, err := parseClock(w.End)
+		if err != nil {
+			return err
+		}
+		if start == end {
+			return fmt.Errorf("pricing: window %q is empty", w.Name)
+		}
+		if w.Price < 0 {
+			return fmt.Errorf("pricing: window %q has a negative price", w.Name)
+		}
+		s.spans = append(s.spans, span{start: start, end: end, window: w})
+	}
+	if s.Price < 0 {
+		return fmt.Errorf("pricing: negative price")
+	}
+	return nil
+}
+
+func (s *Schedule) location() *time.Location {
+	if s.Location == nil {
+		return time.UTC
+	}
+	return s.Location
+}
+
+// PriceAt returns the price per kWh and the window name at a time.
+func (s *Schedule) PriceAt(t time.Time) (float64, string) {
+	t = t.In(s.location())
+	minute := t.Hour()*60 + t.Minute()
+	for _, sp := range s.spans {
+		if sp.contains(minute) {
+			return sp.window.Price, sp.window.Name
+		}
+	}
+	return s.Price, DefaultWindowName
+}
+
+// nextBoundary returns the first window boundary strictly after t.
+func (s *Schedule) nextBoundary(t time.Time) time.Time {
+	t = t.In(s.location())
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	next := midnight.AddDate(0, 0, 1)
+	for _, sp := range s.spans {
+		for _, minute := range []int{sp.start, sp.end} {
+			b := midnight.Add(time.Duration(minute) * time.Minute)
+			if b.After(t) && b.Before(next) {
+				next = b
+			}
+		}
+	}
+	return next
+}
+
+// Sample is a point of a charge curve.
+type Sample struct {
+	// Time is the time of the sample.
+	Time time.Time
+	// Energy is the cumulative energy added in kWh.
+	Energy float64
+}
+
+// Breakdown is the energy and cost charged in one window.
+type Breakdown struct {
+	// Name is the window name.
+	Name string
+	// Energy is the energy charged in kWh.
+	Energy float64
+	// Cost is the cost of the energy.
+	Cost float64
+}
+
+// Cost prices a charge curve, samples oldest first. Energy added between two samples is
+// assumed to flow evenly and is split at window boundaries. A drop of the cumulative
+// energy is taken as a counter reset.
+func (s *Schedule) Cost(samples []Sample) (float64, []Breakdown) {
+	var (
+		total     float64
+		breakdown []Breakdown
+	)
+	add := func(at time.Time, energy float64) {
+		price, name := s.PriceAt(at)
+		cost := energy * price
+		total += cost
+		for i := range breakdown {
+			if breakdown[i].Name == name {
+				breakdown[i].Energy += energy
+				breakdown[i].Cost += cost
+				return
+			}
+		}
+		breakdown = append(breakdown, Breakdown{Name: name, Energy: energy, Cost: cost})
+	}
+
+	for i := 1; i < len(samples); i++ {
+		a, b := samples[i-1], samples[i]
+		energy := b.Energy - a.Energy
+		if energy < 0 {
+			energy = b.Energy
+		}
+		if energy == 0 {
+			continue
+		}
+		duration := b.Time.Sub(a.Time)
+		if duration <= 0 {
+			add(b.Time, energy)
+			continue
+		}
+		for cursor := a.Time; cursor.Before(b.Time); {
+			next := s.nextBoundary(cursor)
+			if next.After(b.Time) {
+				next = b.Time
+			}
+			add(cursor, energy*float64(next.Sub(cursor))/float64(duration))
+			cursor = next
+		}
+	}
+	return total, breakdown
+}
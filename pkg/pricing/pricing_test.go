@@ -0,0 +1,79 @@
+package pricing
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+var shanghai = time.FixedZone("CST", 8*3600)
+
+func peakValley() *Schedule {
+	s := &Schedule{
+		Price: 0.6,
+		Windows: []Window{
+			{Name: "valley", Start: "22:00", End: "06:00", Price: 0.3},
+			{Name: "peak", Start: "08:00", End: "11:00", Price: 1.0},
+		},
+		Location: shanghai,
+	}
+	return s
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestPriceAt(t *testing.T) {
+	s := peakValley()
+	if err := s.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	cases := map[int]string{23: "valley", 3: "valley", 9: "peak", 12: DefaultWindowName, 6: DefaultWindowName}
+	for hour, want := range cases {
+		if _, got := s.PriceAt(time.Date(2025, 6, 1, hour, 0, 0, 0, shanghai)); got != want {
+			t.Errorf("PriceAt(%02d:00) = %s, want %s", hour, got, want)
+		}
+	}
+}
+
+func TestCostSplitsAcrossWindows(t *testing.T) {
+	s := peakValley()
+	if err := s.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	// 8 kWh charged evenly from 21:00 to 23:00: 4 kWh standard, 4 kWh valley.
+	total, breakdown := s.Cost([]Sample{
+		{Time: time.Date(2025, 6, 1, 21, 0, 0, 0, shanghai), Energy: 0},
+		{Time: time.Date(2025, 6, 1, 23, 0, 0, 0, shanghai), Energy: 8},
+	})
+	if !near(total, 4*0.6+4*0.3) {
+		t.Fatalf("total = %v", total)
+	}
+	if len(breakdown) != 2 || !near(breakdown[1].Energy, 4) || breakdown[1].Name != "valley" {
+		t.Fatalf("breakdown = %+v", breakdown)
+	}
+}
+
+func TestCostCounterReset(t *testing.T) {
+	s := &Schedule{Price: 1}
+	if err := s.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	total, _ := s.Cost([]Sample{
+		{Time: start, Energy: 10},
+		{Time: start.Add(time.Minute), Energy: 12},
+		{Time: start.Add(2 * time.Minute), Energy: 1},
+	})
+	if !near(total, 3) {
+		t.Fatalf("total = %v, want 3", total)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	s := &Schedule{Windows: []Window{{Name: "broken", Start: "25:00", End: "06:00"}}}
+	if err := s.Validate(); err == nil {
+		t.Fatal("Validate accepted 25:00")
+	}
+}
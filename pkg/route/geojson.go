@@ -0,0 +1,86 @@
+package route
+
+import (
+	"encoding/json"
+	"io"
+)
+
+type geoJSONEncoder struct {
+	ew     *errWriter
+	opened bool
+	count  int
+	name   string
+}
+
+func newGeoJSONEncoder(w io.Writer, name string) *geoJSONEncoder {
+	return &geoJSONEncoder{ew: &errWriter{w: w}, name: name}
+}
+
+// geoJSONFeature is a drive as a LineString feature.
+// Per point values follow the coordinateProperties convention of togeojson,
+// every array is aligned with the coordinates.
+type geoJSONFeature struct {
+	Type       string `json:"type"`
+	Properties struct {
+		Name                 string `json:"name"`
+		Description          string `json:"description,omitempty"`
+		CoordinateProperties struct {
+			Times        []string  `json:"times"`
+			Speed        []float64 `json:"speed"`
+			Power        []int     `json:"power"`
+			BatteryLevel []int     `json:"battery_level"`
+		} `json:"coordinateProperties"`
+	} `json:"properties"`
+	Geometry struct {
+		Type        string       `json:"type"`
+		Coordinates [][2]float64 `json:"coordinates"`
+	} `json:"geometry"`
+}
+
+func (e *geoJSONEncoder) open() {
+	if e.opened {
+		return
+	}
+	e.opened = true
+	name, _ := json.Marshal(e.name)
+	e.ew.printf(`{"type":"FeatureCollection","name":%s,"features":[`+"\n", name)
+}
+
+// Encode implements Encoder.
+func (e *geoJSONEncoder) Encode(t *Track) error {
+	e.open()
+	f := &geoJSONFeature{Type: "Feature"}
+	f.Properties.Name = t.Name
+	f.Properties.Description = t.Description
+	f.Geometry.Type = "LineString"
+	cp := &f.Properties.CoordinateProperties
+	f.Geometry.Coordinates = make([][2]float64, 0, len(t.Points))
+	cp.Times = make([]string, 0, len(t.Points))
+	cp.Speed = make([]float64, 0, len(t.Points))
+	cp.Power = make([]int, 0, len(t.Points))
+	cp.BatteryLevel = make([]int, 0, len(t.Points))
+	for _, p := range t.Points {
+		f.Geometry.Coordinates = append(f.Geometry.Coordinates, [2]float64{p.Longitude, p.Latitude})
+		cp.Times = append(cp.Times, formatTime(p.Time))
+		cp.Speed = append(cp.Speed, p.Speed)
+		cp.Power = append(cp.Power, p.Power)
+		cp.BatteryLevel = append(cp.BatteryLevel, p.BatteryLevel)
+	}
+	body, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+	if e.count > 0 {
+		e.ew.printf(",\n")
+	}
+	e.count++
+	e.ew.printf("%s", body)
+	return e.ew.err
+}
+
+// Close implements Encoder.
+func (e *geoJSONEncoder) Close() error {
+	e.open()
+	e.ew.printf("\n]}\n")
+	return e.ew.err
+}
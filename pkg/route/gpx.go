@@ -0,0 +1,70 @@
+package route
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+const (
+	// gpxNamespace is the GPX 1.1 namespace.
+	gpxNamespace = "http://www.topografix.com/GPX/1/1"
+	// gpxTPXNamespace is the Garmin TrackPointExtension v2 namespace carrying speed and course.
+	gpxTPXNamespace = "http://www.garmin.com/xmlschemas/TrackPointExtension/v2"
+	// gpxTTNamespace carries the vehicle specific power and state of charge.
+	gpxTTNamespace = "https://github.com/luoyangwei/TeslaTrack/xmlschemas/v1"
+)
+
+type gpxEncoder struct {
+	ew     *errWriter
+	opened bool
+	name   string
+}
+
+func newGPXEncoder(w io.Writer, name string) *gpxEncoder {
+	return &gpxEncoder{ew: &errWriter{w: w}, name: name}
+}
+
+func (e *gpxEncoder) open() {
+	if e.opened {
+		return
+	}
+	e.opened = true
+	e.ew.printf(xml.Header)
+	e.ew.printf(`<gpx version="1.1" creator="TeslaTrack" xmlns=%q xmlns:gpxtpx=%q xmlns:tt=%q>`+"\n",
+		gpxNamespace, gpxTPXNamespace, gpxTTNamespace)
+	e.ew.printf("<metadata><name>%s</name></metadata>\n", escape(e.name))
+}
+
+// Encode implements Encoder.
+func (e *gpxEncoder) Encode(t *Track) error {
+	e.open()
+	e.ew.printf("<trk><name>%s</name>", escape(t.Name))
+	if t.Description != "" {
+		e.ew.printf("<desc>%s</desc>", escape(t.Description))
+	}
+	e.ew.printf("<type>driving</type>\n<trkseg>\n")
+	for _, p := range t.Points {
+		e.ew.printf(`<trkpt lat="%.7f" lon="%.7f"><time>%s</time>`, p.Latitude, p.Longitude, formatTime(p.Time))
+		// Garmin expects the speed in m/s.
+		e.ew.printf("<extensions><gpxtpx:TrackPointExtension><gpxtpx:speed>%.2f</gpxtpx:speed><gpxtpx:course>%d</gpxtpx:course></gpxtpx:TrackPointExtension>",
+			p.Speed/3.6, p.Heading)
+		e.ew.printf("<tt:power>%d</tt:power><tt:battery_level>%d</tt:battery_level></extensions></trkpt>\n", p.Power, p.BatteryLevel)
+	}
+	e.ew.printf("</trkseg>\n</trk>\n")
+	return e.ew.err
+}
+
+// Close implements Encoder.
+func (e *gpxEncoder) Close() error {
+	e.open()
+	e.ew.printf("</gpx>\n")
+	return e.ew.err
+}
+
+// escape escapes text for XML character data and attributes.
+func escape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
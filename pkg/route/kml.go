@@ -0,0 +1,73 @@
+package route
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+const (
+	kmlNamespace   = "http://www.opengis.net/kml/2.2"
+	kmlGxNamespace = "http://www.google.com/kml/ext/2.2"
+)
+
+type kmlEncoder struct {
+	ew     *errWriter
+	opened bool
+	name   string
+}
+
+func newKMLEncoder(w io.Writer, name string) *kmlEncoder {
+	return &kmlEncoder{ew: &errWriter{w: w}, name: name}
+}
+
+func (e *kmlEncoder) open() {
+	if e.opened {
+		return
+	}
+	e.opened = true
+	e.ew.printf(xml.Header)
+	e.ew.printf("<kml xmlns=%q xmlns:gx=%q>\n<Document>\n<name>%s</name>\n", kmlNamespace, kmlGxNamespace, escape(e.name))
+	e.ew.printf(`<Schema id="telemetry">` +
+		`<gx:SimpleArrayField name="speed" type="float"><displayName>Speed (km/h)</displayName></gx:SimpleArrayField>` +
+		`<gx:SimpleArrayField name="power" type="int"><displayName>Power (kW)</displayName></gx:SimpleArrayField>` +
+		`<gx:SimpleArrayField name="battery_level" type="int"><displayName>Battery (%%)</displayName></gx:SimpleArrayField>` +
+		"</Schema>\n")
+}
+
+// Encode implements Encoder.
+func (e *kmlEncoder) Encode(t *Track) error {
+	e.open()
+	e.ew.printf("<Placemark><name>%s</name>", escape(t.Name))
+	if t.Description != "" {
+		e.ew.printf("<description>%s</description>", escape(t.Description))
+	}
+	e.ew.printf("\n<gx:Track>\n")
+	for _, p := range t.Points {
+		e.ew.printf("<when>%s</when>\n", formatTime(p.Time))
+	}
+	for _, p := range t.Points {
+		e.ew.printf("<gx:coord>%.7f %.7f 0</gx:coord>\n", p.Longitude, p.Latitude)
+	}
+	e.ew.printf(`<ExtendedData><SchemaData schemaUrl="#telemetry">` + "\n")
+	e.ew.printf(`<gx:SimpleArrayData name="speed">`)
+	for _, p := range t.Points {
+		e.ew.printf("<gx:value>%.1f</gx:value>", p.Speed)
+	}
+	e.ew.printf("</gx:SimpleArrayData>\n" + `<gx:SimpleArrayData name="power">`)
+	for _, p := range t.Points {
+		e.ew.printf("<gx:value>%d</gx:value>", p.Power)
+	}
+	e.ew.printf("</gx:SimpleArrayData>\n" + `<gx:SimpleArrayData name="battery_level">`)
+	for _, p := range t.Points {
+		e.ew.printf("<gx:value>%d</gx:value>", p.BatteryLevel)
+	}
+	e.ew.printf("</gx:SimpleArrayData>\n</SchemaData></ExtendedData>\n</gx:Track>\n</Placemark>\n")
+	return e.ew.err
+}
+
+// Close implements Encoder.
+func (e *kmlEncoder) Close() error {
+	e.open()
+	e.ew.printf("</Document>\n</kml>\n")
+	return e.ew.err
+}
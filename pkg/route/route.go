@@ -0,0 +1,122 @@
+// Package route encodes recorded drives as GPX 1.1, KML and GeoJSON files.
+//
+// Encoders stream one track at a time, so a month of drives can be written
+// without holding every position in memory.
+package route
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Format is a route file format.
+type Format string
+
+const (
+	// GPX is the GPS Exchange Format 1.1.
+	GPX Format = "gpx"
+	// KML is the Keyhole Markup Language 2.2 used by Google Earth.
+	KML Format = "kml"
+	// GeoJSON is RFC 7946 GeoJSON, whose positions are WGS-84 only.
+	GeoJSON Format = "geojson"
+)
+
+// ParseFormat parses a format name or file extension, case insensitive.
+func ParseFormat(s string) (Format, bool) {
+	switch strings.ToLower(strings.TrimPrefix(s, ".")) {
+	case "gpx":
+		return GPX, true
+	case "kml":
+		return KML, true
+	case "geojson", "json":
+		return GeoJSON, true
+	}
+	return "", false
+}
+
+// ContentType returns the MIME type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case GPX:
+		return "application/gpx+xml"
+	case KML:
+		return "application/vnd.google-earth.kml+xml"
+	case GeoJSON:
+		return "application/geo+json"
+	}
+	return "application/octet-stream"
+}
+
+// Extension returns the file extension of the format without the dot.
+func (f Format) Extension() string {
+	return string(f)
+}
+
+// Point is a recorded position of a track.
+type Point struct {
+	// Time is the time the position was recorded.
+	Time time.Time
+	// Latitude is the latitude in degrees.
+	Latitude float64
+	// Longitude is the longitude in degrees.
+	Longitude float64
+	// Heading is the heading in degrees.
+	Heading int
+	// Speed is the speed in km/h.
+	Speed float64
+	// Power is the power draw (positive) or regeneration (negative) in kW.
+	Power int
+	// BatteryLevel is the state of charge in percent.
+	BatteryLevel int
+}
+
+// Track is a single drive.
+type Track struct {
+	// Name is the display name of the track.
+	Name string
+	// Description is an optional description, e.g., the start and end addresses.
+	Description string
+	// Points are the recorded positions, oldest first.
+	Points []Point
+}
+
+// Encoder writes tracks to a route file.
+type Encoder interface {
+	// Encode writes a track.
+	Encode(t *Track) error
+	// Close completes the file, it does not close the underlying writer.
+	Close() error
+}
+
+// NewEncoder creates an encoder writing a file named name in the format.
+func NewEncoder(w io.Writer, f Format, name string) (Encoder, error) {
+	switch f {
+	case GPX:
+		return newGPXEncoder(w, name), nil
+	case KML:
+		return newKMLEncoder(w, name), nil
+	case GeoJSON:
+		return newGeoJSONEncoder(w, name), nil
+	}
+	return nil, fmt.Errorf("route: unknown format %q", f)
+}
+
+// errWriter remembers the first write error so encoders can check it once.
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) printf(format string, args ...any) {
+	if ew.err != nil {
+		return
+	}
+	_, ew.err = fmt.Fprintf(ew.w, format, args...)
+}
+
+// formatTime formats a time as an RFC 3339 UTC timestamp.
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
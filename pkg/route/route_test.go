@@ -0,0 +1,106 @@
+package route
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+var track = &Track{
+	Name:        "Home & Work",
+	Description: "Pudong -> Huangpu",
+	Points: []Point{
+		{Time: time.Date(2025, 6, 1, 8, 30, 0, 0, time.UTC), Latitude: 31.2304, Longitude: 121.4737, Speed: 36, Power: 12, BatteryLevel: 80},
+		{Time: time.Date(2025, 6, 1, 8, 31, 0, 0, time.UTC), Latitude: 31.2310, Longitude: 121.4750, Speed: 54, Power: -5, BatteryLevel: 79},
+	},
+}
+
+func encode(t *testing.T, f Format) []byte {
+	var buf bytes.Buffer
+	enc, err := NewEncoder(&buf, f, "June <drives>")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := enc.Encode(track); err != nil {
+		t.Fatal(err)
+	}
+	if err := enc.Encode(track); err != nil {
+		t.Fatal(err)
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestGPX(t *testing.T) {
+	var gpx struct {
+		Tracks []struct {
+			Name   string `xml:"name"`
+			Points []struct {
+				Lat   float64 `xml:"lat,attr"`
+				Time  string  `xml:"time"`
+				Speed float64 `xml:"extensions>TrackPointExtension>speed"`
+				Power int     `xml:"extensions>power"`
+			} `xml:"trkseg>trkpt"`
+		} `xml:"trk"`
+	}
+	if err := xml.Unmarshal(encode(t, GPX), &gpx); err != nil {
+		t.Fatal(err)
+	}
+	if len(gpx.Tracks) != 2 || gpx.Tracks[0].Name != track.Name || len(gpx.Tracks[0].Points) != 2 {
+		t.Fatalf("gpx = %+v", gpx)
+	}
+	p := gpx.Tracks[0].Points[0]
+	if p.Lat != 31.2304 || p.Time != "2025-06-01T08:30:00Z" || p.Speed != 10 || p.Power != 12 {
+		t.Fatalf("point = %+v", p)
+	}
+}
+
+func TestKML(t *testing.T) {
+	var kml struct {
+		Placemarks []struct {
+			Name   string   `xml:"name"`
+			When   []string `xml:"Track>when"`
+			Coords []string `xml:"Track>coord"`
+		} `xml:"Document>Placemark"`
+	}
+	if err := xml.Unmarshal(encode(t, KML), &kml); err != nil {
+		t.Fatal(err)
+	}
+	if len(kml.Placemarks) != 2 || len(kml.Placemarks[0].When) != 2 {
+		t.Fatalf("kml = %+v", kml)
+	}
+	if !strings.HasPrefix(kml.Placemarks[0].Coords[0], "121.4737000 31.2304000") {
+		t.Fatalf("coord = %q, KML is longitude first", kml.Placemarks[0].Coords[0])
+	}
+}
+
+func TestGeoJSON(t *testing.T) {
+	var fc struct {
+		Type     string
+		Features []geoJSONFeature
+	}
+	if err := json.Unmarshal(encode(t, GeoJSON), &fc); err != nil {
+		t.Fatal(err)
+	}
+	if fc.Type != "FeatureCollection" || len(fc.Features) != 2 {
+		t.Fatalf("geojson = %+v", fc)
+	}
+	f := fc.Features[1]
+	if f.Geometry.Coordinates[1] != [2]float64{121.4750, 31.2310} || f.Properties.CoordinateProperties.BatteryLevel[1] != 79 {
+		t.Fatalf("feature = %+v", f)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	if f, ok := ParseFormat(".GPX"); !ok || f != GPX {
+		t.Fatalf("ParseFormat(.GPX) = %v, %v", f, ok)
+	}
+	if _, ok := ParseFormat("shp"); ok {
+		t.Fatal("ParseFormat accepted shp")
+	}
+}
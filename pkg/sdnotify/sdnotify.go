@@ -0,0 +1,57 @@
+// Package sdnotify implements the systemd service notification protocol, see sd_notify(3),
+// for services of Type=notify with a watchdog.
+package sdnotify
+
+import (
+	"net"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Service states sent to the service manager.
+const (
+	// Ready tells the service manager the service finished starting up.
+	Ready = "READY=1"
+	// Stopping tells the service manager the service is shutting down.
+	Stopping = "STOPPING=1"
+	// Watchdog keeps the watchdog from restarting the service.
+	Watchdog = "WATCHDOG=1"
+)
+
+// Enabled reports whether the service manager listens to notifications.
+func Enabled() bool {
+	return os.Getenv("NOTIFY_SOCKET") != ""
+}
+
+// Notify sends a state to the service manager. It does nothing unless Enabled.
+func Notify(state string) error {
+	socket := os.Getenv("NOTIFY_SOCKET")
+	if socket == "" {
+		return nil
+	}
+	// A leading @ denotes a socket in the abstract namespace.
+	if socket[0] == '@' {
+		socket = "\x00" + socket[1:]
+	}
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = conn.Write([]byte(state))
+	return err
+}
+
+// WatchdogInterval returns the time within which the service must send Watchdog,
+// 0 if the watchdog is disabled or meant for another process.
+func WatchdogInterval() time.Duration {
+	usec, err := strconv.ParseInt(os.Getenv("WATCHDOG_USEC"), 10, 64)
+	if err != nil || usec <= 0 {
+		return 0
+	}
+	if pid := os.Getenv("WATCHDOG_PID"); pid != "" && pid != strconv.Itoa(os.Getpid()) {
+		return 0
+	}
+	return time.Duration(usec) * time.Microsecond
+}
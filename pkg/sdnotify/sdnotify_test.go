@@ -0,0 +1,67 @@
+package sdnotify
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestNotify(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "notify.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	t.Setenv("NOTIFY_SOCKET", socket)
+	if !Enabled() {
+		t.Fatal("not enabled with NOTIFY_SOCKET set")
+	}
+	if err := Notify(Ready); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 64)
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, err := conn.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(buf[:n]); got != Ready {
+		t.Errorf("received %q, want %q", got, Ready)
+	}
+}
+
+func TestNotifyDisabled(t *testing.T) {
+	t.Setenv("NOTIFY_SOCKET", "")
+	if Enabled() {
+		t.Error("enabled without NOTIFY_SOCKET")
+	}
+	if err := Notify(Ready); err != nil {
+		t.Errorf("Notify without NOTIFY_SOCKET = %v, want nil", err)
+	}
+}
+
+func TestWatchdogInterval(t *testing.T) {
+	pid := strconv.Itoa(os.Getpid())
+	tests := []struct {
+		usec, pid string
+		want      time.Duration
+	}{
+		{"", "", 0},
+		{"invalid", "", 0},
+		{"30000000", "", 30 * time.Second},
+		{"30000000", pid, 30 * time.Second},
+		{"30000000", "1", 0},
+	}
+	for _, tt := range tests {
+		t.Setenv("WATCHDOG_USEC", tt.usec)
+		t.Setenv("WATCHDOG_PID", tt.pid)
+		if got := WatchdogInterval(); got != tt.want {
+			t.Errorf("WatchdogInterval(usec %q, pid %q) = %v, want %v", tt.usec, tt.pid, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// Package stats provides the small set of descriptive statistics used by the analytics.
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// Median returns the median of the values, 0 for no values.
+// The values are not modified.
+func Median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return (sorted[mid-1] + sorted[mid]) / 2
+}
+
+// Floor returns the lower edge of the fixed-width bin containing the value.
+// Bins are aligned to zero, so negative values fall into negative bins.
+func Floor(value, width float64) float64 {
+	if width <= 0 {
+		return value
+	}
+	return math.Floor(value/width) * width
+}
+
+// Bin is one bin of a histogram covering [Lower, Upper).
+type Bin struct {
+	Lower float64
+	Upper float64
+	Count int
+}
+
+// MaxBins is the most bins a histogram has.
+const MaxBins = 200
+
+// Histogram counts the values in fixed-width bins aligned to zero.
+// Bins between the lowest and the highest value are returned in order, including empty ones.
+// When the values span more than MaxBins bins, the bins are widened to a multiple of width.
+func Histogram(values []float64, width float64) []Bin {
+	if len(values) == 0 || width <= 0 {
+		return nil
+	}
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		lo, hi = math.Min(lo, v), math.Max(hi, v)
+	}
+	// Aligning both ends to the bins adds up to two bins to the span.
+	if k := (hi - lo) / width / (MaxBins - 2); math.IsInf(k, 1) {
+		width = (hi - lo) / (MaxBins - 2)
+	} else if k > 1 {
+		width *= math.Ceil(k)
+	}
+	lo = Floor(lo, width)
+	n := int((Floor(hi, width)-lo)/width) + 1
+	bins := make([]Bin, n)
+	for i := range bins {
+		bins[i].Lower = lo + float64(i)*width
+		bins[i].Upper = bins[i].Lower + width
+	}
+	for _, v := range values {
+		i := min(int((Floor(v, width)-lo)/width), n-1)
+		bins[i].Count++
+	}
+	return bins
+}
+
+// LinearFit fits y = slope*x + intercept by least squares.
+// It reports false when fewer than two distinct x values are given.
+func LinearFit(xs, ys []float64) (slope, intercept float64, ok bool) {
+	n := float64(min(len(xs), len(ys)))
+	if n < 2 {
+		return 0, 0, false
+	}
+	var sx, sy, sxx, sxy float64
+	for i := 0; i < int(n); i++ {
+		sx += xs[i]
+		sy += ys[i]
+		sxx += xs[i] * xs[i]
+		sxy += xs[i] * ys[i]
+	}
+	d := n*sxx - sx*sx
+	if d == 0 {
+		return 0, 0, false
+	}
+	slope = (n*sxy - sx*sy) / d
+	intercept = (sy - slope*sx) / n
+	return slope, intercept, true
+}
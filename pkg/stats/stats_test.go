@@ -0,0 +1,80 @@
+package stats
+
+import "testing"
+
+func TestMedian(t *testing.T) {
+	if m := Median([]float64{3, 1, 2}); m != 2 {
+		t.Errorf("Median of odd count = %v", m)
+	}
+	values := []float64{4, 1, 3, 2}
+	if m := Median(values); m != 2.5 {
+		t.Errorf("Median of even count = %v", m)
+	}
+	if values[0] != 4 {
+		t.Error("Median reordered its input")
+	}
+	if m := Median(nil); m != 0 {
+		t.Errorf("Median of no values = %v", m)
+	}
+}
+
+func TestFloor(t *testing.T) {
+	cases := []struct{ value, width, want float64 }{
+		{12, 5, 10},
+		{10, 5, 10},
+		{-0.5, 5, -5},
+		{7, 0, 7},
+	}
+	for _, c := range cases {
+		if got := Floor(c.value, c.width); got != c.want {
+			t.Errorf("Floor(%v, %v) = %v, want %v", c.value, c.width, got, c.want)
+		}
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	bins := Histogram([]float64{-3, 1, 4, 12, 14}, 5)
+	want := []Bin{{-5, 0, 1}, {0, 5, 2}, {5, 10, 0}, {10, 15, 2}}
+	if len(bins) != len(want) {
+		t.Fatalf("Histogram = %v", bins)
+	}
+	for i := range want {
+		if bins[i] != want[i] {
+			t.Errorf("bin %d = %v, want %v", i, bins[i], want[i])
+		}
+	}
+	if Histogram(nil, 5) != nil {
+		t.Error("Histogram of no values is not empty")
+	}
+}
+
+func TestHistogramMaxBins(t *testing.T) {
+	values := []float64{100, 150.5, 399.9}
+	for _, width := range []float64{0.001, 1e-300, 5e-324} {
+		bins := Histogram(values, width)
+		if len(bins) == 0 || len(bins) > MaxBins {
+			t.Fatalf("Histogram(width %v) = %d bins, want 1 to %d", width, len(bins), MaxBins)
+		}
+		count := 0
+		for _, b := range bins {
+			count += b.Count
+		}
+		if count != len(values) || bins[0].Lower > 100 || bins[len(bins)-1].Upper <= 399.9 {
+			t.Errorf("Histogram(width %v) covers [%v, %v) with %d values", width, bins[0].Lower, bins[len(bins)-1].Upper, count)
+		}
+	}
+	bins := Histogram([]float64{0, 999}, 1)
+	if width := bins[0].Upper - bins[0].Lower; width != 6 {
+		t.Errorf("Histogram widened 1 to %v, want 6, the smallest multiple fitting %d bins", width, MaxBins)
+	}
+}
+
+func TestLinearFit(t *testing.T) {
+	slope, intercept, ok := LinearFit([]float64{0, 1, 2, 3}, []float64{1, 3, 5, 7})
+	if !ok || slope != 2 || intercept != 1 {
+		t.Fatalf("LinearFit = %v, %v, %v", slope, intercept, ok)
+	}
+	if _, _, ok := LinearFit([]float64{1, 1}, []float64{2, 3}); ok {
+		t.Error("LinearFit accepted a single x value")
+	}
+}
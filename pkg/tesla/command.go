@@ -0,0 +1,104 @@
+package tesla
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const (
+	// VEHICLE_COMMAND_API is the API endpoint for sending a command to a vehicle.
+	VEHICLE_COMMAND_API = CN_BASE_URL + "/api/1/vehicles/%s/command/%s"
+	// VEHICLE_WAKE_UP_API is the API endpoint for waking up a vehicle.
+	VEHICLE_WAKE_UP_API = CN_BASE_URL + "/api/1/vehicles/%s/wake_up"
+)
+
+// Vehicle commands, see https://developer.tesla.cn/docs/fleet-api/endpoints/vehicle-commands.
+const (
+	COMMAND_DOOR_LOCK               = "door_lock"
+	COMMAND_DOOR_UNLOCK             = "door_unlock"
+	COMMAND_AUTO_CONDITIONING_START = "auto_conditioning_start"
+	COMMAND_AUTO_CONDITIONING_STOP  = "auto_conditioning_stop"
+	COMMAND_CHARGE_START            = "charge_start"
+	COMMAND_CHARGE_STOP             = "charge_stop"
+	COMMAND_SET_CHARGE_LIMIT        = "set_charge_limit"
+)
+
+// CommandResult is the response of a vehicle command.
+type CommandResult struct {
+	// Result reports whether the vehicle executed the command.
+	Result bool `json:"result"`
+	// Reason explains why the command was rejected, e.g., "already_set" or "is_charging".
+	Reason string `json:"reason"`
+}
+
+// CommandError is returned when the vehicle rejects a command.
+type CommandError struct {
+	// Command is the rejected command.
+	Command string
+	// Reason is the reason given by the vehicle.
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *CommandError) Error() string {
+	return fmt.Sprintf("tesla command %s rejected: %s", e.Command, e.Reason)
+}
+
+// SendCommand sends a command such as door_lock to a vehicle, with optional parameters
+// encoded as the JSON body, e.g., {"percent": 80} for set_charge_limit.
+// A command rejected by the vehicle is returned as *CommandError.
+func SendCommand(ctx context.Context, accessToken, vin, command string, params any) error {
+	result, err := post[CommandResult](ctx, accessToken, fmt.Sprintf(VEHICLE_COMMAND_API, vin, command), params)
+	if err != nil {
+		return err
+	}
+	if !result.Result {
+		return &CommandError{Command: command, Reason: result.Reason}
+	}
+	return nil
+}
+
+// WakeUp wakes a vehicle up. The vehicle is returned right away, usually still asleep;
+// it comes online within a few seconds.
+func WakeUp(ctx context.Context, accessToken, vin string) (*Vehicle, error) {
+	return post[Vehicle](ctx, accessToken, fmt.Sprintf(VEHICLE_WAKE_UP_API, vin), nil)
+}
+
+// post sends a POST request with a JSON body and decodes the response.
+func post[T any](ctx context.Context, accessToken, url string, params any) (*T, error) {
+	body := []byte("{}")
+	if params != nil {
+		var err error
+		if body, err = json.Marshal(params); err != nil {
+			return nil, errors.Join(err, fmt.Errorf("marshal request body error"))
+		}
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("must be to newRequest"))
+	}
+	requestAppendAuthorization(request, accessToken)
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("response error"))
+	}
+	defer response.Body.Close()
+
+	raw, _ := io.ReadAll(response.Body)
+
+	// Failed responses may carry no JSON body, e.g., when rate limited.
+	var data Response[T]
+	if err := json.Unmarshal(raw, &data); err != nil && response.StatusCode < http.StatusBadRequest {
+		return nil, errors.Join(err, fmt.Errorf("unmarshal response bytes error"))
+	}
+	if response.StatusCode >= http.StatusBadRequest || data.Error != "" {
+		return nil, &ResponseError{StatusCode: response.StatusCode, Code: data.Error, Description: data.ErrorDescription}
+	}
+	return &data.Response, nil
+}
@@ -0,0 +1,74 @@
+package tesla
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Metrics of the Fleet API requests, recorded with the global OpenTelemetry meter provider.
+// Every request is counted with its endpoint and status code, 0 when no response was received.
+var (
+	meter           = otel.Meter("teslatrack/pkg/tesla")
+	requestCount, _ = meter.Int64Counter("tesla.requests",
+		metric.WithDescription("Fleet API requests by endpoint and status code."))
+	requestDuration, _ = meter.Float64Histogram("tesla.request.duration",
+		metric.WithDescription("Fleet API request latency by endpoint."),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(0.1, 0.25, 0.5, 1, 2.5, 5, 10))
+	errorCount, _ = meter.Int64Counter("tesla.errors",
+		metric.WithDescription("Fleet API requests failing with an error status or without response, by endpoint."))
+	throttleCount, _ = meter.Int64Counter("tesla.throttles",
+		metric.WithDescription("Fleet API requests rate limited with status 429, by endpoint."))
+)
+
+// instrumentedTransport records the metrics of every request, and its outcome for
+// LastSuccess and FailingSince.
+type instrumentedTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	ctx := req.Context()
+	endpoint := attribute.String("endpoint", Endpoint(req.URL.Path))
+	code := 0
+	if resp != nil {
+		code = resp.StatusCode
+	}
+	requestDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(endpoint))
+	requestCount.Add(ctx, 1, metric.WithAttributes(endpoint, attribute.String("code", strconv.Itoa(code))))
+	if err != nil || code >= http.StatusBadRequest {
+		errorCount.Add(ctx, 1, metric.WithAttributes(endpoint))
+	}
+	if code == http.StatusTooManyRequests {
+		throttleCount.Add(ctx, 1, metric.WithAttributes(endpoint))
+	}
+	recordStatus(code, err)
+	return resp, err
+}
+
+// Endpoint returns the path of a Fleet API request with the vehicle replaced by {vin},
+// e.g., /api/1/vehicles/{vin}/vehicle_data, keeping the metric labels bounded.
+func Endpoint(path string) string {
+	endpoint, _ := splitVIN(path)
+	return endpoint
+}
+
+// splitVIN returns the endpoint of a request path and the vehicle it is about, empty for none.
+func splitVIN(path string) (endpoint, vin string) {
+	parts := strings.Split(path, "/")
+	for i := 1; i < len(parts); i++ {
+		if parts[i-1] == "vehicles" && parts[i] != "" {
+			vin, parts[i] = parts[i], "{vin}"
+		}
+	}
+	return strings.Join(parts, "/"), vin
+}
@@ -0,0 +1,86 @@
+package tesla
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestEndpoint(t *testing.T) {
+	tests := map[string]string{
+		"/api/1/vehicles":                             "/api/1/vehicles",
+		"/api/1/vehicles/":                            "/api/1/vehicles/",
+		"/api/1/vehicles/LRW3E7EK0NC000001/wake_up":   "/api/1/vehicles/{vin}/wake_up",
+		"/api/1/vehicles/LRW3E7EK0NC000001/command/x": "/api/1/vehicles/{vin}/command/x",
+	}
+	for path, want := range tests {
+		if got := Endpoint(path); got != want {
+			t.Errorf("Endpoint(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestInstrumentedTransport(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/1/vehicles/VIN1/vehicle_data" {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer srv.Close()
+	c := &http.Client{Transport: instrumentedTransport{base: http.DefaultTransport}}
+	for _, path := range []string{"/api/1/vehicles", "/api/1/vehicles/VIN1/vehicle_data", "/api/1/vehicles/VIN2/vehicle_data"} {
+		resp, err := c.Get(srv.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	sums := make(map[string]map[string]int64)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			data, ok := m.Data.(metricdata.Sum[int64])
+			if !ok {
+				continue
+			}
+			sums[m.Name] = make(map[string]int64)
+			for _, dp := range data.DataPoints {
+				endpoint, _ := dp.Attributes.Value(attribute.Key("endpoint"))
+				code, _ := dp.Attributes.Value(attribute.Key("code"))
+				sums[m.Name][endpoint.AsString()+" "+code.AsString()] += dp.Value
+			}
+		}
+	}
+	want := map[string]map[string]int64{
+		"tesla.requests": {
+			"/api/1/vehicles 200":                    1,
+			"/api/1/vehicles/{vin}/vehicle_data 429": 1,
+			"/api/1/vehicles/{vin}/vehicle_data 200": 1,
+		},
+		"tesla.errors":    {"/api/1/vehicles/{vin}/vehicle_data ": 1},
+		"tesla.throttles": {"/api/1/vehicles/{vin}/vehicle_data ": 1},
+	}
+	for name, points := range want {
+		for key, value := range points {
+			if got := sums[name][key]; got != value {
+				t.Errorf("%s{%s} = %d, want %d", name, key, got, value)
+			}
+		}
+		if len(sums[name]) != len(points) {
+			t.Errorf("%s = %v, want %v", name, sums[name], points)
+		}
+	}
+}
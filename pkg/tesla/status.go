@@ -0,0 +1,69 @@
+package tesla
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// lastSuccess is the Unix nano time of the last Fleet API request answered without a server error
+// and failingSince the one of the first request failing after it, 0 if none.
+var lastSuccess, failingSince atomic.Int64
+
+// LastSuccess returns the time of the last Fleet API request answered without a server error,
+// zero before the first. Client errors, e.g., an expired token or an asleep vehicle, are answers
+// of an available Fleet API.
+func LastSuccess() time.Time {
+	return unixNano(lastSuccess.Load())
+}
+
+// FailingSince returns the time of the first Fleet API request failing with a server error
+// or without response since the last success, zero if the last request succeeded.
+func FailingSince() time.Time {
+	return unixNano(failingSince.Load())
+}
+
+// recordStatus records the outcome of a request for LastSuccess and FailingSince.
+func recordStatus(code int, err error) {
+	now := time.Now().UnixNano()
+	if err != nil || code >= http.StatusInternalServerError {
+		failingSince.CompareAndSwap(0, now)
+		return
+	}
+	lastSuccess.Store(now)
+	failingSince.Store(0)
+}
+
+func unixNano(t int64) time.Time {
+	if t == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, t)
+}
+
+// TokenExpiry returns the expiry of an access token, read from the exp claim of the JWT
+// without verifying its signature.
+func TokenExpiry(accessToken string) (time.Time, error) {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return time.Time{}, errors.New("tesla: access token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, errors.Join(err, errors.New("tesla: decode access token claims error"))
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, errors.Join(err, errors.New("tesla: unmarshal access token claims error"))
+	}
+	if claims.Exp == 0 {
+		return time.Time{}, errors.New("tesla: access token has no expiry")
+	}
+	return time.Unix(claims.Exp, 0), nil
+}
@@ -0,0 +1,56 @@
+package tesla
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTokenExpiry(t *testing.T) {
+	claims := base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"https://auth.tesla.cn","exp":1767225600}`))
+	got, err := TokenExpiry("eyJhbGciOiJSUzI1NiJ9." + claims + ".c2ln")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Unix(1767225600, 0); !got.Equal(want) {
+		t.Errorf("TokenExpiry = %v, want %v", got, want)
+	}
+
+	noExp := base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"https://auth.tesla.cn"}`))
+	for _, token := range []string{"", "opaque", "a.!!.c", "a." + noExp + ".c"} {
+		if _, err := TokenExpiry(token); err == nil {
+			t.Errorf("TokenExpiry(%q) succeeded", token)
+		}
+	}
+}
+
+func TestRecordStatus(t *testing.T) {
+	start := time.Now()
+	recordStatus(http.StatusOK, nil)
+	if LastSuccess().Before(start) || !FailingSince().IsZero() {
+		t.Fatalf("after a success LastSuccess = %v, FailingSince = %v", LastSuccess(), FailingSince())
+	}
+	recordStatus(http.StatusUnauthorized, nil)
+	if !FailingSince().IsZero() {
+		t.Fatalf("FailingSince = %v after a client error, want zero", FailingSince())
+	}
+	failed := time.Now()
+	recordStatus(http.StatusServiceUnavailable, nil)
+	since := FailingSince()
+	if since.Before(failed) {
+		t.Fatalf("FailingSince = %v, want after %v", since, failed)
+	}
+	recordStatus(0, errors.New("connection refused"))
+	if !FailingSince().Equal(since) {
+		t.Errorf("FailingSince moved to %v on a second failure, want %v", FailingSince(), since)
+	}
+	if LastSuccess().After(failed) {
+		t.Errorf("LastSuccess = %v, moved on a failure", LastSuccess())
+	}
+	recordStatus(http.StatusOK, nil)
+	if !FailingSince().IsZero() {
+		t.Errorf("FailingSince = %v after a success, want zero", FailingSince())
+	}
+}
@@ -0,0 +1,61 @@
+package tesla
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer records a client span per Fleet API request with the global OpenTelemetry tracer provider.
+var tracer = otel.Tracer("teslatrack/pkg/tesla")
+
+// Span attributes of the Fleet API requests.
+const (
+	attrEndpoint   = attribute.Key("tesla.endpoint")
+	attrVINHash    = attribute.Key("tesla.vin_hash")
+	attrMethod     = attribute.Key("http.request.method")
+	attrStatusCode = attribute.Key("http.response.status_code")
+)
+
+// tracedTransport records a client span for every request, a child of the span of the
+// request context. The trace context is not propagated to Tesla.
+type tracedTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t tracedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint, vin := splitVIN(req.URL.Path)
+	attrs := []attribute.KeyValue{attrEndpoint.String(endpoint), attrMethod.String(req.Method)}
+	if vin != "" {
+		attrs = append(attrs, attrVINHash.String(VINHash(vin)))
+	}
+	ctx, span := tracer.Start(req.Context(), "tesla "+req.Method+" "+endpoint,
+		trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+	defer span.End()
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return resp, err
+	}
+	span.SetAttributes(attrStatusCode.Int(resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, strconv.Itoa(resp.StatusCode)+" "+http.StatusText(resp.StatusCode))
+	}
+	return resp, nil
+}
+
+// VINHash returns a short stable hash of a VIN, identifying a vehicle in traces and logs
+// without disclosing its VIN.
+func VINHash(vin string) string {
+	sum := sha256.Sum256([]byte(vin))
+	return hex.EncodeToString(sum[:8])
+}
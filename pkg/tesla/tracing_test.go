@@ -0,0 +1,83 @@
+package tesla
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracedTransport(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(tp)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("traceparent") != "" {
+			t.Error("trace context propagated to Tesla")
+		}
+		w.WriteHeader(http.StatusRequestTimeout)
+	}))
+	defer srv.Close()
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/api/1/vehicles/VIN1/vehicle_data", nil)
+	resp, err := (&http.Client{Transport: tracedTransport{base: http.DefaultTransport}}).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("recorded %d spans, want 2", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "tesla GET /api/1/vehicles/{vin}/vehicle_data" {
+		t.Errorf("name = %s", span.Name())
+	}
+	if span.SpanKind() != trace.SpanKindClient {
+		t.Errorf("kind = %v, want client", span.SpanKind())
+	}
+	if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Error("span is not a child of the request context span")
+	}
+	if span.Status().Code != codes.Error {
+		t.Errorf("status = %v, want error", span.Status())
+	}
+	want := map[attribute.Key]attribute.Value{
+		attrEndpoint:   attribute.StringValue("/api/1/vehicles/{vin}/vehicle_data"),
+		attrVINHash:    attribute.StringValue(VINHash("VIN1")),
+		attrStatusCode: attribute.IntValue(http.StatusRequestTimeout),
+	}
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	for k, v := range want {
+		if attrs[k] != v {
+			t.Errorf("%s = %v, want %v", k, attrs[k].Emit(), v.Emit())
+		}
+	}
+	for _, kv := range span.Attributes() {
+		if kv.Value.Emit() == "VIN1" {
+			t.Errorf("%s discloses the VIN", kv.Key)
+		}
+	}
+}
+
+func TestVINHash(t *testing.T) {
+	if VINHash("VIN1") != VINHash("VIN1") || VINHash("VIN1") == VINHash("VIN2") {
+		t.Error("VINHash is not a stable hash")
+	}
+	if len(VINHash("VIN1")) != 16 {
+		t.Errorf("VINHash length = %d, want 16", len(VINHash("VIN1")))
+	}
+}
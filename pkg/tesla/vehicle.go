@@ -1,6 +1,7 @@
 package tesla
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -22,10 +23,11 @@ const (
 // client is a global http client.
 var client *http.Client
 
-// init function initializes the http client.
+// init function initializes the http client, tracing and recording the metrics of every request.
 func init() {
 	client = &http.Client{
-		Timeout: 10 * time.Second,
+		Timeout:   10 * time.Second,
+		Transport: tracedTransport{base: instrumentedTransport{base: http.DefaultTransport}},
 	}
 }
 
@@ -37,6 +39,22 @@ type Response[T any] struct {
 	Messages         map[string]string `json:"messages,omitempty"`
 }
 
+// ResponseError is returned when the Tesla API answers with a failure status or an error body,
+// e.g., 401 for an expired token, 408 for an asleep vehicle or 429 when rate limited.
+type ResponseError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Code is the error of the response body, e.g., "vehicle unavailable".
+	Code string
+	// Description is the error description of the response body.
+	Description string
+}
+
+// Error implements the error interface.
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("tesla response error %d %s:%s", e.StatusCode, e.Code, e.Description)
+}
+
 // GranularAccess corresponds to the "granular_access" object in the JSON, representing fine-grained access settings.
 type GranularAccess struct {
 	// HidePrivate indicates whether to hide private information.
@@ -98,9 +116,9 @@ type Vehicle struct {
 }
 
 // GetVehices fetches the list of vehicles using the given access token.
-func GetVehices(accessToken string) ([]Vehicle, error) {
+func GetVehices(ctx context.Context, accessToken string) ([]Vehicle, error) {
 	// Create a new HTTP GET request to the VEHICLES_API endpoint.
-	request, err := http.NewRequest(http.MethodGet, VEHICLES_API, nil)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, VEHICLES_API, nil)
 	if err != nil {
 		return nil, errors.Join(err, fmt.Errorf("must be to newRequest"))
 	}
@@ -116,18 +134,17 @@ func GetVehices(accessToken string) ([]Vehicle, error) {
 
 	// Read the entire response body.
 	bytes, _ := io.ReadAll(response.Body)
-	// For debugging purposes, print the raw response body.
-	fmt.Println(string(bytes))
 
 	// Unmarshal the JSON response into our generic Response struct containing a slice of VehicleData.
+	// Failed responses may carry no JSON body, e.g., when rate limited.
 	var data Response[[]Vehicle]
-	if err := json.Unmarshal(bytes, &data); err != nil {
+	if err := json.Unmarshal(bytes, &data); err != nil && response.StatusCode < http.StatusBadRequest {
 		return nil, errors.Join(err, fmt.Errorf("unmarshal response bytes error"))
 	}
 
 	// Check if the API response contains an error.
-	if data.Error != "" {
-		return nil, fmt.Errorf("tesla response error %s:%s", data.Error, data.ErrorDescription)
+	if response.StatusCode >= http.StatusBadRequest || data.Error != "" {
+		return nil, &ResponseError{StatusCode: response.StatusCode, Code: data.Error, Description: data.ErrorDescription}
 	}
 
 	// Return the slice of vehicles from the response.
@@ -836,8 +853,8 @@ type VehicleData struct {
 //	tpms_pressure_fl, fr, rl, rr: (e.g., 3.1, 3.1, 3.15, 3) The tire pressure for each tire. (各轮胎胎压。)
 //	valet_mode: (e.g., false) Whether Valet Mode is enabled. (代客模式是否开启。)
 //	vehicle_name: (e.g., "grADOFIN") The custom name of the vehicle. (车辆自定义名称。)
-func GetVehiceData(accessToken, vin string) (*VehicleData, error) {
-	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf(VEHICLE_DATA_API, vin), nil)
+func GetVehiceData(ctx context.Context, accessToken, vin string) (*VehicleData, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(VEHICLE_DATA_API, vin), nil)
 	if err != nil {
 		return nil, errors.Join(err, fmt.Errorf("must be to newRequest"))
 	}
@@ -855,14 +872,15 @@ func GetVehiceData(accessToken, vin string) (*VehicleData, error) {
 	bytes, _ := io.ReadAll(response.Body)
 
 	// Unmarshal the JSON response into our generic Response struct containing a slice of VehicleData.
+	// Failed responses may carry no JSON body, e.g., when rate limited.
 	var data Response[VehicleData]
-	if err := json.Unmarshal(bytes, &data); err != nil {
+	if err := json.Unmarshal(bytes, &data); err != nil && response.StatusCode < http.StatusBadRequest {
 		return nil, errors.Join(err, fmt.Errorf("unmarshal response bytes error"))
 	}
 
 	// Check if the API response contains an error.
-	if data.Error != "" {
-		return nil, fmt.Errorf("tesla response error %s:%s", data.Error, data.ErrorDescription)
+	if response.StatusCode >= http.StatusBadRequest || data.Error != "" {
+		return nil, &ResponseError{StatusCode: response.StatusCode, Code: data.Error, Description: data.ErrorDescription}
 	}
 
 	// Return the slice of vehicles from the response.
@@ -1,6 +1,7 @@
 package tesla_test
 
 import (
+	"context"
 	"log"
 	"os"
 	"teslatrack/pkg/tesla"
@@ -24,9 +25,9 @@ func getVehicelVIN() string {
 }
 
 func TestGetVehices(t *testing.T) {
-	tesla.GetVehices(getAccessToken())
+	tesla.GetVehices(context.Background(), getAccessToken())
 }
 
 func TestGetVehiceData(t *testing.T) {
-	tesla.GetVehiceData(getAccessToken(), getVehicelVIN())
+	tesla.GetVehiceData(context.Background(), getAccessToken(), getVehicelVIN())
 }
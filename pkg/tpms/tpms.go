@@ -0,0 +1,147 @@
+// Package tpms converts tire pressures between units and detects slow leaks from their history.
+package tpms
+
+import (
+	"strings"
+	"time"
+
+	"teslatrack/pkg/stats"
+)
+
+// Unit is a pressure unit.
+type Unit string
+
+const (
+	// Bar is the unit reported by the Fleet API.
+	Bar Unit = "bar"
+	// KPa is kilopascal, common on Chinese market vehicles.
+	KPa Unit = "kpa"
+	// Psi is pounds per square inch.
+	Psi Unit = "psi"
+)
+
+// kPaPerUnit is the size of each unit in kPa.
+var kPaPerUnit = map[Unit]float64{
+	Bar: 100,
+	KPa: 1,
+	Psi: 6.894757293168361,
+}
+
+// ParseUnit parses a unit name such as GUISettings.GuiTirepressureUnits, e.g., "Psi" or "kPa".
+func ParseUnit(s string) (Unit, bool) {
+	u := Unit(strings.ToLower(strings.TrimSpace(s)))
+	_, ok := kPaPerUnit[u]
+	return u, ok
+}
+
+// Convert converts a pressure between units. Unknown units are treated as bar.
+func Convert(value float64, from, to Unit) float64 {
+	if from == to {
+		return value
+	}
+	f, ok := kPaPerUnit[from]
+	if !ok {
+		f = kPaPerUnit[Bar]
+	}
+	t, ok := kPaPerUnit[to]
+	if !ok {
+		t = kPaPerUnit[Bar]
+	}
+	return value * f / t
+}
+
+// Wheel identifies a wheel.
+type Wheel int
+
+const (
+	FrontLeft Wheel = iota
+	FrontRight
+	RearLeft
+	RearRight
+)
+
+// Wheels lists all wheels in the order of the pressure arrays.
+var Wheels = [4]Wheel{FrontLeft, FrontRight, RearLeft, RearRight}
+
+// String returns the short wheel name used by the Fleet API, e.g., "fl".
+func (w Wheel) String() string {
+	switch w {
+	case FrontLeft:
+		return "fl"
+	case FrontRight:
+		return "fr"
+	case RearLeft:
+		return "rl"
+	case RearRight:
+		return "rr"
+	}
+	return ""
+}
+
+// Front reports whether the wheel is on the front axle.
+func (w Wheel) Front() bool {
+	return w == FrontLeft || w == FrontRight
+}
+
+// Sample is the pressure of every wheel at one time. Zero pressures are unknown.
+type Sample struct {
+	Time     time.Time
+	Pressure [4]float64
+}
+
+// Leak is a wheel losing pressure faster than the other wheels.
+type Leak struct {
+	Wheel Wheel
+	// Rate is the pressure lost per day relative to the other wheels, in the unit of the samples.
+	Rate float64
+}
+
+// DetectLeaks finds the wheels that lose at least minRate per day relative to the other wheels.
+//
+// All wheels follow the ambient temperature alike, so the trend of each wheel is taken
+// against the mean of the others: a healthy set stays flat however the weather changes,
+// while a slow leak shows up as a steady decline long before a TPMS warning.
+// Samples must span at least minSpan to give a trend.
+func DetectLeaks(samples []Sample, minRate float64, minSpan time.Duration) []Leak {
+	if len(samples) < 3 || samples[len(samples)-1].Time.Sub(samples[0].Time) < minSpan {
+		return nil
+	}
+	var leaks []Leak
+	for _, w := range Wheels {
+		var days, deltas []float64
+		for _, s := range samples {
+			delta, ok := relativePressure(s.Pressure, w)
+			if !ok {
+				continue
+			}
+			days = append(days, s.Time.Sub(samples[0].Time).Hours()/24)
+			deltas = append(deltas, delta)
+		}
+		if len(days) < 3 || days[len(days)-1]-days[0] < minSpan.Hours()/24 {
+			continue
+		}
+		if slope, _, ok := stats.LinearFit(days, deltas); ok && -slope >= minRate {
+			leaks = append(leaks, Leak{Wheel: w, Rate: -slope})
+		}
+	}
+	return leaks
+}
+
+// relativePressure returns the pressure of a wheel minus the mean of the other known wheels.
+func relativePressure(pressure [4]float64, w Wheel) (float64, bool) {
+	if pressure[w] <= 0 {
+		return 0, false
+	}
+	var sum float64
+	var n int
+	for _, o := range Wheels {
+		if o != w && pressure[o] > 0 {
+			sum += pressure[o]
+			n++
+		}
+	}
+	if n == 0 {
+		return 0, false
+	}
+	return pressure[w] - sum/float64(n), true
+}
@@ -0,0 +1,57 @@
+package tpms
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestConvert(t *testing.T) {
+	if v := Convert(2.9, Bar, KPa); math.Abs(v-290) > 1e-9 {
+		t.Errorf("2.9 bar = %v kPa", v)
+	}
+	if v := Convert(42, Psi, Bar); math.Abs(v-2.8958) > 1e-3 {
+		t.Errorf("42 psi = %v bar", v)
+	}
+	if v := Convert(2.5, Bar, Bar); v != 2.5 {
+		t.Errorf("identity conversion = %v", v)
+	}
+}
+
+func TestParseUnit(t *testing.T) {
+	for in, want := range map[string]Unit{"Psi": Psi, "kPa": KPa, "Bar": Bar} {
+		if got, ok := ParseUnit(in); !ok || got != want {
+			t.Errorf("ParseUnit(%q) = %v, %v", in, got, ok)
+		}
+	}
+	if _, ok := ParseUnit("atm"); ok {
+		t.Error("ParseUnit accepted an unknown unit")
+	}
+}
+
+func TestDetectLeaks(t *testing.T) {
+	start := time.Date(2024, 11, 1, 8, 0, 0, 0, time.UTC)
+	var samples []Sample
+	for day := 0; day < 10; day++ {
+		// The weather swings every wheel by up to 0.2 bar, the rear left loses 0.03 bar a day.
+		ambient := 0.2 * math.Sin(float64(day))
+		p := 2.9 + ambient
+		samples = append(samples, Sample{
+			Time:     start.AddDate(0, 0, day),
+			Pressure: [4]float64{p, p + 0.01, p - 0.03*float64(day), p},
+		})
+	}
+	leaks := DetectLeaks(samples, 0.02, 3*24*time.Hour)
+	if len(leaks) != 1 || leaks[0].Wheel != RearLeft {
+		t.Fatalf("DetectLeaks = %+v", leaks)
+	}
+	if math.Abs(leaks[0].Rate-0.03) > 1e-9 {
+		t.Errorf("leak rate = %v", leaks[0].Rate)
+	}
+	if leaks := DetectLeaks(samples[:2], 0.02, 0); leaks != nil {
+		t.Errorf("DetectLeaks with two samples = %+v", leaks)
+	}
+	if leaks := DetectLeaks(samples, 0.02, 30*24*time.Hour); leaks != nil {
+		t.Errorf("DetectLeaks below the minimum span = %+v", leaks)
+	}
+}
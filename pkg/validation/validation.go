@@ -0,0 +1,122 @@
+// Package validation checks requests with the validators generated by protoc-gen-validate
+// and reports the violations as structured Kratos errors.
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+// Reason is the error reason of requests failing validation.
+const Reason = "VALIDATOR"
+
+// violation is a single field error of a generated validator.
+type violation interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError holds every violation found by ValidateAll.
+type multiError interface {
+	AllErrors() []error
+}
+
+// Validate validates a request with its generated ValidateAll or Validate method.
+// Requests without validator are valid. Violations are returned as a 400 error whose
+// metadata maps the snake_case path of every invalid field, e.g., geofence.polygon[2].latitude,
+// to the reason; gRPC clients receive them as the ErrorInfo of an InvalidArgument status.
+func Validate(req any) error {
+	var err error
+	switch v := req.(type) {
+	case interface{ ValidateAll() error }:
+		err = v.ValidateAll()
+	case interface{ Validate() error }:
+		err = v.Validate()
+	}
+	if err == nil {
+		return nil
+	}
+	fields := make(map[string]string)
+	var paths []string
+	collect(err, "", func(path, reason string) {
+		if _, ok := fields[path]; !ok {
+			paths = append(paths, path)
+		}
+		fields[path] = reason
+	})
+	if len(paths) == 0 {
+		return errors.BadRequest(Reason, err.Error()).WithCause(err)
+	}
+	message := paths[0] + ": " + fields[paths[0]]
+	if len(paths) > 1 {
+		message += fmt.Sprintf(" (and %d more)", len(paths)-1)
+	}
+	return errors.BadRequest(Reason, message).WithMetadata(fields).WithCause(err)
+}
+
+// Server is a middleware validating every request, see Validate.
+func Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			if err := Validate(req); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// collect walks the violations of err, descending into embedded messages.
+func collect(err error, prefix string, add func(path, reason string)) {
+	switch e := err.(type) {
+	case multiError:
+		for _, err := range e.AllErrors() {
+			collect(err, prefix, add)
+		}
+	case violation:
+		path := prefix + fieldPath(e.Field())
+		if cause := e.Cause(); cause != nil {
+			switch cause.(type) {
+			case violation, multiError:
+				collect(cause, path+".", add)
+			default:
+				add(path, e.Reason()+": "+cause.Error())
+			}
+			return
+		}
+		add(path, e.Reason())
+	}
+}
+
+// fieldPath converts a generated Go field name such as VehicleId, RedirectURI or
+// Polygon[2] to snake_case. Map keys and indexes in brackets are kept as is.
+func fieldPath(field string) string {
+	runes := []rune(field)
+	var b strings.Builder
+	bracket := false
+	for i, r := range runes {
+		switch {
+		case r == '[':
+			bracket = true
+		case r == ']':
+			bracket = false
+		case !bracket && unicode.IsUpper(r):
+			if i > 0 && runes[i-1] != '[' && runes[i-1] != '.' {
+				prev := runes[i-1]
+				next := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+				if unicode.IsLower(prev) || unicode.IsDigit(prev) || unicode.IsUpper(prev) && next {
+					b.WriteByte('_')
+				}
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,90 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+type testViolation struct {
+	field, reason string
+	cause         error
+}
+
+func (v testViolation) Field() string  { return v.field }
+func (v testViolation) Reason() string { return v.reason }
+func (v testViolation) Cause() error   { return v.cause }
+func (v testViolation) Error() string  { return v.field + ": " + v.reason }
+
+type testMultiError []error
+
+func (m testMultiError) AllErrors() []error { return m }
+func (m testMultiError) Error() string      { return "multiple violations" }
+
+type testRequest struct{ err error }
+
+func (r testRequest) ValidateAll() error { return r.err }
+
+func TestValidate(t *testing.T) {
+	if err := Validate(testRequest{}); err != nil {
+		t.Fatalf("valid request: %v", err)
+	}
+	if err := Validate(struct{}{}); err != nil {
+		t.Fatalf("request without validator: %v", err)
+	}
+
+	err := Validate(testRequest{err: testMultiError{
+		testViolation{field: "VehicleId", reason: "value must be greater than 0"},
+		testViolation{field: "Geofence", reason: "embedded message failed validation", cause: testMultiError{
+			testViolation{field: "Polygon[2]", reason: "embedded message failed validation", cause: testViolation{field: "Latitude", reason: "value must be inside range [-90, 90]"}},
+		}},
+	}})
+	e := errors.FromError(err)
+	if e.Code != 400 || e.Reason != Reason {
+		t.Fatalf("error = %v", err)
+	}
+	want := map[string]string{
+		"vehicle_id":                   "value must be greater than 0",
+		"geofence.polygon[2].latitude": "value must be inside range [-90, 90]",
+	}
+	if len(e.Metadata) != len(want) {
+		t.Fatalf("metadata = %v", e.Metadata)
+	}
+	for field, reason := range want {
+		if e.Metadata[field] != reason {
+			t.Errorf("metadata[%q] = %q, want %q", field, e.Metadata[field], reason)
+		}
+	}
+	if e.Message != "vehicle_id: value must be greater than 0 (and 1 more)" {
+		t.Errorf("message = %q", e.Message)
+	}
+}
+
+func TestServer(t *testing.T) {
+	called := false
+	h := Server()(func(context.Context, any) (any, error) {
+		called = true
+		return nil, nil
+	})
+	if _, err := h(context.Background(), testRequest{err: testViolation{field: "Id", reason: "value must be greater than 0"}}); err == nil || called {
+		t.Fatalf("invalid request reached the handler: %v", err)
+	}
+	if _, err := h(context.Background(), testRequest{}); err != nil || !called {
+		t.Fatalf("valid request: %v, called %v", err, called)
+	}
+}
+
+func TestFieldPath(t *testing.T) {
+	for in, want := range map[string]string{
+		"VehicleId":   "vehicle_id",
+		"RedirectURI": "redirect_uri",
+		"Polygon[2]":  "polygon[2]",
+		"Data[Key]":   "data[Key]",
+		"MaxPoints":   "max_points",
+	} {
+		if got := fieldPath(in); got != want {
+			t.Errorf("fieldPath(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	kratoslog "github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	zaplog "go.uber.org/zap"
 	zapcore "go.uber.org/zap/zapcore"
 	"gopkg.in/natefinch/lumberjack.v2"
@@ -13,6 +14,12 @@ import (
 
 var _ kratoslog.Logger = (*ZapLogger)(nil)
 
+// Keys of the trace and span IDs in the log lines.
+const (
+	TraceIDKey = "trace.id"
+	SpanIDKey  = "span.id"
+)
+
 // ZapLogger is a logger impl.
 type ZapLogger struct {
 	log  *zaplog.Logger
@@ -65,7 +72,12 @@ func (l *ZapLogger) Log(level kratoslog.Level, keyvals ...interface{}) error {
 	// Zap.Field is used when keyvals pairs appear
 	var data []zaplog.Field
 	for i := 0; i < len(keyvals); i += 2 {
-		data = append(data, zaplog.Any(fmt.Sprint(keyvals[i]), fmt.Sprint(keyvals[i+1])))
+		key, value := fmt.Sprint(keyvals[i]), fmt.Sprint(keyvals[i+1])
+		// Lines logged outside a span have no trace IDs.
+		if value == "" && (key == TraceIDKey || key == SpanIDKey) {
+			continue
+		}
+		data = append(data, zaplog.Any(key, value))
 	}
 	switch level {
 	case kratoslog.LevelDebug:
@@ -103,6 +115,14 @@ func NewZapLogger(encoder zapcore.EncoderConfig, level zaplog.AtomicLevel, opts
 	return &ZapLogger{log: zapLogger, Sync: zapLogger.Sync}
 }
 
+// WithTracing returns the logger with the trace and span IDs of the log context bound,
+// so that the lines logged with log.Helper.WithContext within a span carry its IDs.
+func (l *ZapLogger) WithTracing() kratoslog.Logger {
+	// Skip the frame of the kratos logger binding the IDs.
+	traced := &ZapLogger{log: l.log.WithOptions(zaplog.AddCallerSkip(1)), Sync: l.Sync}
+	return kratoslog.With(traced, TraceIDKey, tracing.TraceID(), SpanIDKey, tracing.SpanID())
+}
+
 // MustZapLogger return a zap logger
 func MustZapLogger() *ZapLogger {
 	encoder := zapcore.EncoderConfig{
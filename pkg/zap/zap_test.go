@@ -1,11 +1,14 @@
 package zap
 
 import (
+	"context"
 	"testing"
 
 	"github.com/go-kratos/kratos/v2/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/zap"
 	zapcore "go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 func TestZapLogger(t *testing.T) {
@@ -38,3 +41,28 @@ func TestZapLogger(t *testing.T) {
 	// zap stdout/stderr Sync bugs in OSX, see https://github.com/uber-go/zap/issues/370
 	_ = logger.Sync()
 }
+
+func TestZapLoggerWithTracing(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger := (&ZapLogger{log: zap.New(core)}).WithTracing()
+
+	log.NewHelper(logger).Infow("msg", "outside")
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "span")
+	defer span.End()
+	log.NewHelper(logger).WithContext(ctx).Infow("msg", "inside")
+
+	entries := logs.All()
+	if len(entries) != 2 {
+		t.Fatalf("logged %d entries, want 2", len(entries))
+	}
+	if fields := entries[0].ContextMap(); fields[TraceIDKey] != nil || fields[SpanIDKey] != nil {
+		t.Errorf("fields outside a span = %v, want no trace IDs", fields)
+	}
+	fields := entries[1].ContextMap()
+	if want := span.SpanContext().TraceID().String(); fields[TraceIDKey] != want {
+		t.Errorf("%s = %v, want %s", TraceIDKey, fields[TraceIDKey], want)
+	}
+	if want := span.SpanContext().SpanID().String(); fields[SpanIDKey] != want {
+		t.Errorf("%s = %v, want %s", SpanIDKey, fields[SpanIDKey], want)
+	}
+}